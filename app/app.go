@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -57,7 +58,6 @@ import (
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	tx "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
@@ -133,15 +133,31 @@ import (
 	appupgrade "github.com/tokenize-x/tx-chain/v7/app/upgrade"
 	appupgradev7 "github.com/tokenize-x/tx-chain/v7/app/upgrade/v7"
 	"github.com/tokenize-x/tx-chain/v7/docs"
+	"github.com/tokenize-x/tx-chain/v7/pkg/audit"
+	"github.com/tokenize-x/tx-chain/v7/pkg/blocktiming"
 	"github.com/tokenize-x/tx-chain/v7/pkg/config"
 	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/pkg/sigverify"
 	assetft "github.com/tokenize-x/tx-chain/v7/x/asset/ft"
 	assetftkeeper "github.com/tokenize-x/tx-chain/v7/x/asset/ft/keeper"
+	assetftposthandler "github.com/tokenize-x/tx-chain/v7/x/asset/ft/posthandler"
 	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
 	assetnft "github.com/tokenize-x/tx-chain/v7/x/asset/nft"
 	assetnftkeeper "github.com/tokenize-x/tx-chain/v7/x/asset/nft/keeper"
+	assetnftmarket "github.com/tokenize-x/tx-chain/v7/x/asset/nft/market"
+	assetnftmarketkeeper "github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/keeper"
+	assetnftmarkettypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
 	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+	"github.com/tokenize-x/tx-chain/v7/x/auction"
+	auctionkeeper "github.com/tokenize-x/tx-chain/v7/x/auction/keeper"
+	auctiontypes "github.com/tokenize-x/tx-chain/v7/x/auction/types"
 	"github.com/tokenize-x/tx-chain/v7/x/auth/ante"
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound"
+	autocompoundkeeper "github.com/tokenize-x/tx-chain/v7/x/autocompound/keeper"
+	autocompoundtypes "github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+	"github.com/tokenize-x/tx-chain/v7/x/bridge"
+	bridgekeeper "github.com/tokenize-x/tx-chain/v7/x/bridge/keeper"
+	bridgetypes "github.com/tokenize-x/tx-chain/v7/x/bridge/types"
 	"github.com/tokenize-x/tx-chain/v7/x/customparams"
 	customparamskeeper "github.com/tokenize-x/tx-chain/v7/x/customparams/keeper"
 	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
@@ -153,16 +169,38 @@ import (
 	"github.com/tokenize-x/tx-chain/v7/x/dex"
 	dexkeeper "github.com/tokenize-x/tx-chain/v7/x/dex/keeper"
 	dextypes "github.com/tokenize-x/tx-chain/v7/x/dex/types"
+	"github.com/tokenize-x/tx-chain/v7/x/epochs"
+	epochskeeper "github.com/tokenize-x/tx-chain/v7/x/epochs/keeper"
+	epochstypes "github.com/tokenize-x/tx-chain/v7/x/epochs/types"
 	"github.com/tokenize-x/tx-chain/v7/x/feemodel"
 	feemodelkeeper "github.com/tokenize-x/tx-chain/v7/x/feemodel/keeper"
 	feemodeltypes "github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral"
+	feereferralkeeper "github.com/tokenize-x/tx-chain/v7/x/feereferral/keeper"
+	feereferralposthandler "github.com/tokenize-x/tx-chain/v7/x/feereferral/posthandler"
+	feereferraltypes "github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+	"github.com/tokenize-x/tx-chain/v7/x/invariant"
+	invariantkeeper "github.com/tokenize-x/tx-chain/v7/x/invariant/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/label"
+	labelkeeper "github.com/tokenize-x/tx-chain/v7/x/label/keeper"
+	labeltypes "github.com/tokenize-x/tx-chain/v7/x/label/types"
+	"github.com/tokenize-x/tx-chain/v7/x/metatx"
+	metatxkeeper "github.com/tokenize-x/tx-chain/v7/x/metatx/keeper"
+	metatxtypes "github.com/tokenize-x/tx-chain/v7/x/metatx/types"
 	"github.com/tokenize-x/tx-chain/v7/x/pse"
 	psekeeper "github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
 	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+	"github.com/tokenize-x/tx-chain/v7/x/treasury"
+	treasurykeeper "github.com/tokenize-x/tx-chain/v7/x/treasury/keeper"
+	treasurytypes "github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+	"github.com/tokenize-x/tx-chain/v7/x/txtrace"
+	txtracekeeper "github.com/tokenize-x/tx-chain/v7/x/txtrace/keeper"
+	cwasm "github.com/tokenize-x/tx-chain/v7/x/wasm"
 	wasmcustomhandler "github.com/tokenize-x/tx-chain/v7/x/wasm/handler"
 	cwasmtypes "github.com/tokenize-x/tx-chain/v7/x/wasm/types"
 	"github.com/tokenize-x/tx-chain/v7/x/wbank"
 	wbankkeeper "github.com/tokenize-x/tx-chain/v7/x/wbank/keeper"
+	wgovkeeper "github.com/tokenize-x/tx-chain/v7/x/wgov/keeper"
 	"github.com/tokenize-x/tx-chain/v7/x/wibctransfer"
 	wibctransferkeeper "github.com/tokenize-x/tx-chain/v7/x/wibctransfer/keeper"
 	"github.com/tokenize-x/tx-chain/v7/x/wnft"
@@ -193,20 +231,26 @@ var (
 // getMaccPerms returns the module account permissions map.
 func getMaccPerms() map[string][]string {
 	perms := map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		icatypes.ModuleName:            nil,
-		wasmtypes.ModuleName:           {authtypes.Burner},
-		assetfttypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
-		assetnfttypes.ModuleName:       {authtypes.Burner},
+		authtypes.FeeCollectorName:      nil,
+		distrtypes.ModuleName:           nil,
+		minttypes.ModuleName:            {authtypes.Minter},
+		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:             {authtypes.Burner},
+		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:             nil,
+		wasmtypes.ModuleName:            {authtypes.Burner},
+		assetfttypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
+		assetfttypes.BuybackAccountName: {authtypes.Burner},
+		assetnfttypes.ModuleName:        {authtypes.Burner},
 		// the line is required by the nft module to have the module account stored in the account keeper
-		nft.ModuleName:      {},
-		psetypes.ModuleName: {authtypes.Minter},
+		nft.ModuleName:         {},
+		psetypes.ModuleName:    {authtypes.Minter},
+		bridgetypes.ModuleName: {authtypes.Minter, authtypes.Burner},
+		// the market module account holds the escrowed bids
+		assetnftmarkettypes.ModuleName: nil,
+		// the treasury module account holds the protocol-owned assets
+		treasurytypes.ModuleName: nil,
 	}
 
 	// Add PSE module accounts
@@ -249,6 +293,13 @@ type App struct {
 	keys  map[string]*storetypes.KVStoreKey
 	tkeys map[string]*storetypes.TransientStoreKey
 
+	// auditWriter records the writes of the audited stores, nil if the audit is disabled
+	auditWriter *audit.Writer
+	// sigVerifier verifies the signatures in parallel, nil if the parallel verification is disabled
+	sigVerifier *sigverify.Verifier
+	// blockTimings records the execution timings of the recent blocks
+	blockTimings *blocktiming.Recorder
+
 	// keepers
 	AccountKeeper  authkeeper.AccountKeeper
 	AuthzKeeper    authzkeeper.Keeper
@@ -270,6 +321,7 @@ type App struct {
 	FeeGrantKeeper         feegrantkeeper.Keeper
 	ConsensusParamsKeeper  consensusparamkeeper.Keeper
 	WasmKeeper             wasmkeeper.Keeper
+	WasmVM                 wasmtypes.WasmEngine
 	WasmPermissionedKeeper *wasmkeeper.PermissionedKeeper
 	GroupKeeper            groupkeeper.Keeper
 
@@ -282,6 +334,17 @@ type App struct {
 	DelayKeeper        delaykeeper.Keeper
 	DEXKeeper          dexkeeper.Keeper
 	PSEKeeper          psekeeper.Keeper
+	BridgeKeeper       bridgekeeper.Keeper
+	AuctionKeeper      auctionkeeper.Keeper
+	LabelKeeper        labelkeeper.Keeper
+	MetaTxKeeper       metatxkeeper.Keeper
+	FeeReferralKeeper  feereferralkeeper.Keeper
+	AutoCompoundKeeper autocompoundkeeper.Keeper
+	EpochsKeeper       epochskeeper.Keeper
+	NFTMarketKeeper    assetnftmarketkeeper.Keeper
+	TreasuryKeeper     treasurykeeper.Keeper
+	InvariantKeeper    *invariantkeeper.Keeper
+	TxTraceKeeper      *txtracekeeper.Keeper
 
 	// ModuleManager is the module manager
 	ModuleManager      *module.Manager
@@ -336,6 +399,11 @@ func New(
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetTxEncoder(txConfig.TxEncoder())
 
+	moduleLoggers, err := newModuleLoggers(logger, cast.ToString(appOpts.Get(LogLevelOverridesAppOption)))
+	if err != nil {
+		panic(err)
+	}
+
 	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey, authz.ModuleName, banktypes.StoreKey,
 		stakingtypes.StoreKey, minttypes.StoreKey,
@@ -347,7 +415,9 @@ func New(
 		ibctransfertypes.StoreKey, packetforwardtypes.StoreKey,
 		icahosttypes.StoreKey, icacontrollertypes.StoreKey, delaytypes.StoreKey,
 		customparamstypes.StoreKey, group.StoreKey, dextypes.StoreKey,
-		psetypes.StoreKey,
+		psetypes.StoreKey, bridgetypes.StoreKey, auctiontypes.StoreKey, labeltypes.StoreKey,
+		assetnftmarkettypes.StoreKey, metatxtypes.StoreKey, autocompoundtypes.StoreKey,
+		epochstypes.StoreKey, feereferraltypes.StoreKey, treasurytypes.StoreKey,
 	)
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, feemodeltypes.TransientStoreKey)
 
@@ -359,6 +429,7 @@ func New(
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
 		tkeys:             tkeys,
+		blockTimings:      blocktiming.NewRecorder(blocktiming.DefaultCapacity),
 	}
 
 	app.ParamsKeeper = initParamsKeeper(
@@ -404,11 +475,17 @@ func New(
 		app.interfaceRegistry,
 	)
 
+	app.CustomParamsKeeper = customparamskeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[customparamstypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	originalBankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
 		app.AccountKeeper,
-		app.ModuleAccountAddrs(),
+		app.BlockedModuleAccountAddrs(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		logger,
 	)
@@ -428,9 +505,10 @@ func New(
 		// pointer is used here because there is cycle in keeper dependencies:
 		// AssetFTKeeper -> WasmKeeper -> BankKeeper -> AssetFTKeeper
 		&app.WasmKeeper,
-		app.ModuleAccountAddrs(),
+		app.BlockedModuleAccountAddrs(),
 		// pointer is used here because there is cycle in keeper dependencies
 		&app.AssetFTKeeper,
+		app.CustomParamsKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		logger,
 	)
@@ -443,8 +521,6 @@ func New(
 		TextualCoinMetadataQueryFn: tx.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
 	}
 
-	var err error
-
 	txConfig, err = authtx.NewTxConfigWithOptions(
 		appCodec,
 		txConfigOpts,
@@ -475,9 +551,55 @@ func New(
 		&app.WasmKeeper,
 		app.WasmPermissionedKeeper,
 		&app.AccountKeeper,
+		app.CustomParamsKeeper,
+		// pointer is used here because the distribution keeper is created later
+		&app.DistrKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		moduleLoggers.Logger("x/"+assetfttypes.ModuleName),
 	)
 
+	if err := delayRouter.RegisterHandler(
+		&assetfttypes.DelayedSymbolReservationExpiration{},
+		assetftkeeper.NewDelaySymbolReservationExpirationHandler(app.AssetFTKeeper),
+	); err != nil {
+		panic(err)
+	}
+
+	if err := delayRouter.RegisterHandler(
+		&assetfttypes.DelayedIssuanceEscrowExpiration{},
+		assetftkeeper.NewDelayIssuanceEscrowExpirationHandler(app.AssetFTKeeper),
+	); err != nil {
+		panic(err)
+	}
+
+	if err := delayRouter.RegisterHandler(
+		&assetfttypes.DelayedSendRateLimitChange{},
+		assetftkeeper.NewDelaySendRateLimitChangeHandler(app.AssetFTKeeper),
+	); err != nil {
+		panic(err)
+	}
+
+	if err := delayRouter.RegisterHandler(
+		&assetfttypes.DelayedFeatureUpdate{},
+		assetftkeeper.NewDelayFeatureUpdateHandler(app.AssetFTKeeper),
+	); err != nil {
+		panic(err)
+	}
+
+	if err := delayRouter.RegisterHandler(
+		&assetfttypes.DelayedRateChange{},
+		assetftkeeper.NewDelayRateChangeHandler(app.AssetFTKeeper),
+	); err != nil {
+		panic(err)
+	}
+
+	if err := delayRouter.RegisterHandler(
+		&assetfttypes.DelayedRedenominationFinalization{},
+		assetftkeeper.NewDelayRedenominationFinalizationHandler(app.AssetFTKeeper),
+	); err != nil {
+		panic(err)
+	}
+
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[minttypes.StoreKey]),
@@ -539,6 +661,7 @@ func New(
 		stakingkeeper.NewQuerier(app.StakingKeeper),
 		interfaceRegistry.SigningContext().AddressCodec(),
 		interfaceRegistry.SigningContext().ValidatorAddressCodec(),
+		moduleLoggers.Logger("x/"+psetypes.ModuleName),
 	)
 
 	// register the staking hooks
@@ -558,12 +681,6 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	app.CustomParamsKeeper = customparamskeeper.NewKeeper(
-		runtime.NewKVStoreService(keys[customparamstypes.StoreKey]),
-		appCodec,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-	)
-
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[ibcexported.StoreKey]),
@@ -625,6 +742,7 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.CustomParamsKeeper,
 	)
 
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
@@ -681,9 +799,18 @@ func New(
 	// Set legacy router for backwards compatibility with gov v1beta1
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
+	// register the hooks executed after the messages of the passed proposals
+	govExecutionHooks := wgovkeeper.NewExecutionHooks(govKeeper.Proposals)
+	validatePSESchedule := func(ctx sdk.Context, _ uint64, _ sdk.Msg) error {
+		return app.PSEKeeper.ValidateStoredDistributionSchedule(ctx)
+	}
+	govExecutionHooks.Register(&psetypes.MsgUpdateExcludedAddresses{}, validatePSESchedule)
+	govExecutionHooks.Register(&psetypes.MsgUpdateClearingAccountMappings{}, validatePSESchedule)
+
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
-		// register the governance hooks
+			// register the governance hooks
+			govExecutionHooks,
 		),
 	)
 
@@ -745,7 +872,9 @@ func New(
 		))),
 		// TODO (v7): Remove legacy custom query handler, keep only GRPC queries.
 		wasmkeeper.WithQueryPlugins(wasmcustomhandler.NewTXChainQueryHandler(
-			assetftkeeper.NewQueryService(app.AssetFTKeeper, app.BankKeeper),
+			assetftkeeper.NewQueryService(
+				app.AssetFTKeeper, app.BankKeeper, app.TransferKeeper, app.IBCKeeper.ChannelKeeper,
+			),
 			assetnftkeeper.NewQueryService(app.AssetNFTKeeper),
 			app.NFTKeeper, app.GRPCQueryRouter(), appCodec,
 		)),
@@ -754,6 +883,11 @@ func New(
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
 		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
 	}
+	// keep the reference to the VM created by the keeper to check its health
+	wasmOpts = append(wasmOpts, wasmkeeper.WithWasmEngineDecorator(func(vm wasmtypes.WasmEngine) wasmtypes.WasmEngine {
+		app.WasmVM = vm
+		return vm
+	}))
 
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
@@ -779,6 +913,8 @@ func New(
 
 	// IBC transfer stack contains (from top to bottom):
 	// - wibctransfer
+	// - wibctransfer whitelist
+	// - wibctransfer memo
 	// - packetforward
 	// - ibchooks
 	// - ibctransfer
@@ -801,11 +937,15 @@ func New(
 
 	ibcWasmStack := wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper)
 
-	// initialize the gas limit for callbacks, recommended to be 10M for use with cosmwasm contracts
+	// initialize the gas limit for callbacks, recommended to be 10M for use with cosmwasm contracts,
+	// the lower limit might be set by the max callback gas of the IBC params
 	maxCallbackGas := uint64(10_000_000)
 
 	cbStack := ibccallbacks.NewIBCMiddleware(
-		ibcTransferStack, app.PacketForwardKeeper, ibcWasmStack, maxCallbackGas,
+		ibcTransferStack,
+		app.PacketForwardKeeper,
+		cwasm.NewIBCCallbacksContractKeeper(ibcWasmStack, app.AssetFTKeeper, app.CustomParamsKeeper),
+		maxCallbackGas,
 	)
 	app.TransferKeeper.WithICS4Wrapper(cbStack)
 
@@ -816,6 +956,9 @@ func New(
 		0,
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 	)
+	ibcTransferStack = wibctransfer.NewMemoMiddleware(ibcTransferStack, app.CustomParamsKeeper)
+	ibcTransferStack = wibctransfer.NewWhitelistMiddleware(ibcTransferStack, app.AssetFTKeeper)
+	ibcTransferStack = wibctransfer.NewBlockedAddrMiddleware(ibcTransferStack, app.BankKeeper)
 	ibcTransferStack = wibctransfer.NewPurposeMiddleware(ibcTransferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
@@ -823,7 +966,7 @@ func New(
 		AddRoute(ibctransfertypes.ModuleName, ibcTransferStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
-		AddRoute(wasmtypes.ModuleName, ibcWasmStack)
+		AddRoute(wasmtypes.ModuleName, cwasm.NewPausableIBCModule(ibcWasmStack, app.CustomParamsKeeper))
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	app.DEXKeeper = dexkeeper.NewKeeper(
@@ -842,6 +985,96 @@ func New(
 		panic(err)
 	}
 
+	app.BridgeKeeper = bridgekeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[bridgetypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.BankKeeper,
+		app.AssetFTKeeper,
+		interfaceRegistry.SigningContext().AddressCodec(),
+	)
+
+	app.AuctionKeeper = auctionkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[auctiontypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.BankKeeper,
+		app.DistrKeeper,
+		app.StakingKeeper,
+	)
+
+	app.LabelKeeper = labelkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[labeltypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.MetaTxKeeper = metatxkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[metatxtypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.AccountKeeper,
+		app.MsgServiceRouter(),
+	)
+
+	app.FeeReferralKeeper = feereferralkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[feereferraltypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.BankKeeper,
+		authtypes.FeeCollectorName,
+	)
+
+	app.AutoCompoundKeeper = autocompoundkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[autocompoundtypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.StakingKeeper,
+		app.DistrKeeper,
+	)
+
+	app.EpochsKeeper = epochskeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[epochstypes.StoreKey]),
+		appCodec,
+		moduleLoggers.Logger("x/"+epochstypes.ModuleName),
+	)
+	app.EpochsKeeper.SetHooks(
+		app.PSEKeeper.EpochHooks(),
+		app.AutoCompoundKeeper.EpochHooks(),
+	)
+
+	app.NFTMarketKeeper = assetnftmarketkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[assetnftmarkettypes.StoreKey]),
+		appCodec,
+		app.BankKeeper,
+		app.AssetNFTKeeper,
+		app.NFTKeeper,
+		moduleLoggers.Logger("x/"+assetnftmarkettypes.ModuleName),
+	)
+
+	app.TreasuryKeeper = treasurykeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[treasurytypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.BankKeeper,
+		moduleLoggers.Logger("x/"+treasurytypes.ModuleName),
+	)
+
+	app.InvariantKeeper = invariantkeeper.NewKeeper()
+	assetftkeeper.RegisterInvariants(app.InvariantKeeper, app.AssetFTKeeper)
+	assetnftkeeper.RegisterInvariants(app.InvariantKeeper, app.AssetNFTKeeper)
+	psekeeper.RegisterInvariants(app.InvariantKeeper, app.PSEKeeper)
+	wibctransferkeeper.RegisterInvariants(
+		app.InvariantKeeper, app.TransferKeeper, app.IBCKeeper.ChannelKeeper, app.BankKeeper,
+	)
+
+	app.TxTraceKeeper = txtracekeeper.NewKeeper(
+		cast.ToBool(appOpts.Get(TxTraceEnabledAppOption)),
+		app.CreateQueryContext,
+		txConfig.TxDecoder(),
+		app.MsgServiceRouter(),
+	)
+
 	/****  Module Options ****/
 
 	assetFTModule := assetft.NewAppModule(
@@ -850,6 +1083,8 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper.BaseKeeper,
 		app.ParamsKeeper,
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
 	)
 	assetNFTModule := assetnft.NewAppModule(
 		appCodec,
@@ -857,12 +1092,15 @@ func New(
 		app.NFTKeeper.Keeper,
 		app.WasmKeeper,
 		app.ParamsKeeper,
+		app.AccountKeeper,
 	)
 	feeModule := feemodel.NewAppModule(app.FeeModelKeeper, app.ParamsKeeper)
 
 	wnftModule := wnft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)
 
-	customParamsModule := customparams.NewAppModule(app.CustomParamsKeeper, app.ParamsKeeper)
+	customParamsModule := customparams.NewAppModule(
+		app.CustomParamsKeeper, app.ParamsKeeper, app.StakingKeeper, app.SlashingKeeper,
+	)
 	wstakingModule := wstaking.NewAppModule(
 		appCodec,
 		app.StakingKeeper,
@@ -924,7 +1162,20 @@ func New(
 		customParamsModule,
 		delayModule,
 		dex.NewAppModule(appCodec, app.DEXKeeper, app.AccountKeeper),
-		pse.NewAppModule(app.PSEKeeper),
+		pse.NewAppModule(
+			app.PSEKeeper, app.AccountKeeper, app.BankKeeper, stakingkeeper.NewQuerier(app.StakingKeeper),
+		),
+		bridge.NewAppModule(app.BridgeKeeper),
+		auction.NewAppModule(app.AuctionKeeper),
+		label.NewAppModule(app.LabelKeeper),
+		metatx.NewAppModule(app.MetaTxKeeper),
+		feereferral.NewAppModule(app.FeeReferralKeeper),
+		autocompound.NewAppModule(app.AutoCompoundKeeper),
+		epochs.NewAppModule(app.EpochsKeeper),
+		assetnftmarket.NewAppModule(app.NFTMarketKeeper),
+		treasury.NewAppModule(app.TreasuryKeeper),
+		invariant.NewAppModule(app.InvariantKeeper),
+		txtrace.NewAppModule(app.TxTraceKeeper),
 
 		// IBC modules
 		ibc.NewAppModule(app.IBCKeeper),
@@ -992,6 +1243,15 @@ func New(
 		delaytypes.ModuleName,
 		dextypes.ModuleName,
 		psetypes.ModuleName,
+		bridgetypes.ModuleName,
+		auctiontypes.ModuleName,
+		labeltypes.ModuleName,
+		assetnftmarkettypes.ModuleName,
+		metatxtypes.ModuleName,
+		feereferraltypes.ModuleName,
+		autocompoundtypes.ModuleName,
+		epochstypes.ModuleName,
+		treasurytypes.ModuleName,
 		// should be last
 		genutiltypes.ModuleName,
 	)
@@ -1026,6 +1286,15 @@ func New(
 		delaytypes.ModuleName,
 		dextypes.ModuleName,
 		psetypes.ModuleName,
+		bridgetypes.ModuleName,
+		auctiontypes.ModuleName,
+		labeltypes.ModuleName,
+		assetnftmarkettypes.ModuleName,
+		metatxtypes.ModuleName,
+		feereferraltypes.ModuleName,
+		autocompoundtypes.ModuleName,
+		epochstypes.ModuleName,
+		treasurytypes.ModuleName,
 		// should be last
 		genutiltypes.ModuleName,
 	)
@@ -1067,6 +1336,15 @@ func New(
 		// dex depends on auth(account) module
 		dextypes.ModuleName,
 		psetypes.ModuleName,
+		bridgetypes.ModuleName,
+		auctiontypes.ModuleName,
+		labeltypes.ModuleName,
+		assetnftmarkettypes.ModuleName,
+		metatxtypes.ModuleName,
+		feereferraltypes.ModuleName,
+		autocompoundtypes.ModuleName,
+		epochstypes.ModuleName,
+		treasurytypes.ModuleName,
 		// should be last
 		genutiltypes.ModuleName,
 	}
@@ -1074,6 +1352,8 @@ func New(
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
 
+	// the circuit breaker must be set before the services are registered to be applied to them
+	app.MsgServiceRouter().SetCircuit(cwasm.NewCircuitBreaker(app.CustomParamsKeeper))
 	app.configurator = module.NewConfigurator(
 		app.appCodec,
 		deterministicgastypes.NewDeterministicMsgServer(
@@ -1121,31 +1401,48 @@ func New(
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
 
+	if err := app.setupAudit(appOpts, homePath); err != nil {
+		panic(errors.Wrap(err, "failed to set up the audit log"))
+	}
+
+	app.setupSigVerify(appOpts)
+
 	// initialize BaseApp
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			HandlerOptions: authante.HandlerOptions{
-				AccountKeeper:   app.AccountKeeper,
-				BankKeeper:      app.BankKeeper,
-				SignModeHandler: txConfig.SignModeHandler(),
-				FeegrantKeeper:  app.FeeGrantKeeper,
-				SigGasConsumer:  authante.DefaultSigVerificationGasConsumer,
-				SigVerifyOptions: []authante.SigVerificationDecoratorOption{
-					authante.WithUnorderedTxGasCost(authante.DefaultUnorderedTxGasCost),
-					authante.WithMaxUnorderedTxTimeoutDuration(authante.DefaultMaxTimeoutDuration),
-				},
+	anteOptions := ante.HandlerOptions{
+		HandlerOptions: authante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			SignModeHandler: txConfig.SignModeHandler(),
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SigGasConsumer:  authante.DefaultSigVerificationGasConsumer,
+			// the referral is the only extension option accepted by the chain
+			ExtensionOptionChecker: feereferraltypes.IsFeePayerReferralExtension,
+			SigVerifyOptions: []authante.SigVerificationDecoratorOption{
+				authante.WithUnorderedTxGasCost(authante.DefaultUnorderedTxGasCost),
+				authante.WithMaxUnorderedTxTimeoutDuration(authante.DefaultMaxTimeoutDuration),
 			},
-			DeterministicGasConfig: deterministicGasConfig,
-			IBCKeeper:              app.IBCKeeper,
-			GovKeeper:              &app.GovKeeper,
-			FeeModelKeeper:         app.FeeModelKeeper,
-			WasmTXCounterStoreKey:  runtime.NewKVStoreService(keys[wasmtypes.StoreKey]),
-			WasmConfig:             wasmNodeConfig,
 		},
-	)
+		DeterministicGasConfig: deterministicGasConfig,
+		IBCKeeper:              app.IBCKeeper,
+		GovKeeper:              &app.GovKeeper,
+		FeeModelKeeper:         app.FeeModelKeeper,
+		AssetFTKeeper:          app.AssetFTKeeper,
+		FeeReferralKeeper:      app.FeeReferralKeeper,
+		WasmTXCounterStoreKey:  runtime.NewKVStoreService(keys[wasmtypes.StoreKey]),
+		WasmConfig:             wasmNodeConfig,
+		SigVerifier:            app.sigVerifier,
+	}
+	anteHandler, err := ante.NewAnteHandler(anteOptions)
 	if err != nil {
 		panic(err)
 	}
+	// the traced transactions are verified without the parallel verifier used by the block execution
+	anteOptions.SigVerifier = nil
+	tracedAnteDecorators, err := ante.NewAnteDecorators(anteOptions)
+	if err != nil {
+		panic(err)
+	}
+	app.TxTraceKeeper.SetAnteDecorators(tracedAnteDecorators)
 
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -1153,6 +1450,18 @@ func New(
 	app.SetAnteHandler(anteHandler)
 	app.SetEndBlocker(app.EndBlocker)
 
+	// The top-of-block auction wraps the default proposal handlers, so the winning bid and its bundle are placed
+	// first and the rest of the transactions are selected as before.
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app)
+	auctionProposalHandler := auction.NewProposalHandler(
+		app.AuctionKeeper,
+		txConfig.TxDecoder(),
+		defaultProposalHandler.PrepareProposalHandler(),
+		defaultProposalHandler.ProcessProposalHandler(),
+	)
+	app.SetPrepareProposal(auctionProposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(auctionProposalHandler.ProcessProposalHandler())
+
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
 	// defined as a chain, and have the same signature as antehandlers.
@@ -1161,24 +1470,17 @@ func New(
 	// meaning that both `runMsgs` and `postHandler` state will be committed if
 	// both are successful, and both will be reverted if any of the two fails.
 	//
-	// The SDK exposes a default postHandlers chain, which comprises of only
-	// one decorator: the Transaction Tips decorator. However, some chains do
-	// not need it by default, so feel free to comment the next line if you do
-	// not need tips.
-	// To read more about tips:
-	// https://docs.cosmos.network/main/core/tips.html
+	// The post handler routes the part of the fee paid by the successfully executed transaction to the referrer set
+	// by the fee_payer_referral extension option, and records the activity of the issuers executing the messages
+	// wrapped by the authz MsgExec and the meta-transactions.
 	//
 	// Please note that changing any of the anteHandler or postHandler chain is
 	// likely to be a state-machine breaking change, which needs a coordinated
 	// upgrade.
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
-	)
-	if err != nil {
-		panic(err)
-	}
-
-	app.SetPostHandler(postHandler)
+	app.SetPostHandler(sdk.ChainPostDecorators(
+		feereferralposthandler.NewReferralFeeDecorator(app.FeeReferralKeeper),
+		assetftposthandler.NewIssuerActivityDecorator(app.AssetFTKeeper, app.appCodec),
+	))
 
 	// must be before Loading version
 	// requires the snapshot store to be created and registered as a BaseAppOption
@@ -1272,18 +1574,29 @@ func (app *App) Name() string { return app.BaseApp.Name() }
 func (app *App) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
 // PreBlocker application updates every pre block.
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	app.blockTimings.StartBlock(req.Height, req.Time, len(req.Txs))
+	app.prefetchSignatures(ctx, req)
 	return app.ModuleManager.PreBlock(ctx)
 }
 
 // BeginBlocker application updates every begin block.
 func (app *App) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
+	defer app.blockTimings.RecordBeginBlock(time.Now())
 	return app.ModuleManager.BeginBlock(ctx)
 }
 
 // EndBlocker application updates every end block.
 func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
-	return app.ModuleManager.EndBlock(ctx)
+	start := time.Now()
+	app.blockTimings.RecordDeliverTx(start)
+	defer app.blockTimings.RecordEndBlock(start)
+	return app.ModuleManager.EndBlock(blocktiming.WithRecorder(ctx, app.blockTimings))
+}
+
+// RecentBlockTimings returns the execution timings of the recent blocks, starting from the oldest one.
+func (app *App) RecentBlockTimings() []blocktiming.BlockTimings {
+	return app.blockTimings.RecentBlocks()
 }
 
 // Configurator returns the app Configurator.
@@ -1323,6 +1636,15 @@ func (app *App) ModuleAccountAddrs() map[string]bool {
 	return modAccAddrs
 }
 
+// BlockedModuleAccountAddrs returns the module account addresses which can't receive the funds. The treasury can
+// receive the funds, so it can be funded by the transfers, e.g. from the pse clearing accounts.
+func (app *App) BlockedModuleAccountAddrs() map[string]bool {
+	modAccAddrs := app.ModuleAccountAddrs()
+	delete(modAccAddrs, authtypes.NewModuleAddress(treasurytypes.ModuleName).String())
+
+	return modAccAddrs
+}
+
 // LegacyAmino returns SimApp's amino codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -1400,6 +1722,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *serverapi.Server, _ serverconfig.APICo
 	// register app's OpenAPI routes.
 	apiSvr.Router.Handle("/static/openapi.json", http.FileServer(http.FS(docs.Docs)))
 	apiSvr.Router.HandleFunc("/", openapi.Handler(Name, "/static/openapi.json"))
+
+	// register the chain info used by the wallets.
+	apiSvr.Router.HandleFunc(config.ChainInfoPath, config.ChainInfoHandler(clientCtx))
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
@@ -1,11 +1,16 @@
 package app_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
 )
 
 func TestAppStateExport(t *testing.T) {
@@ -18,3 +23,38 @@ func TestAppStateExport(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, exportedApp.AppState)
 }
+
+func TestAppStateStreamExport(t *testing.T) {
+	requireT := require.New(t)
+
+	simApp := simapp.New()
+	requireT.NoError(simApp.FinalizeBlock())
+
+	buf := &bytes.Buffer{}
+	requireT.NoError(simApp.StreamAppGenesis(
+		&genutiltypes.AppGenesis{ChainID: "test"}, false, nil, []string{psetypes.ModuleName, assetfttypes.ModuleName}, buf,
+	))
+
+	appGenesis := &genutiltypes.AppGenesis{}
+	requireT.NoError(json.Unmarshal(buf.Bytes(), appGenesis))
+	requireT.Equal("test", appGenesis.ChainID)
+	requireT.NotNil(appGenesis.Consensus)
+
+	var appState map[string]json.RawMessage
+	requireT.NoError(json.Unmarshal(appGenesis.AppState, &appState))
+	requireT.Len(appState, 2)
+	requireT.Contains(appState, psetypes.ModuleName)
+	requireT.Contains(appState, assetfttypes.ModuleName)
+
+	// the module state is the same as the one exported at once
+	exportedApp, err := simApp.ExportAppStateAndValidators(false, nil, []string{psetypes.ModuleName})
+	requireT.NoError(err)
+	var exportedAppState map[string]json.RawMessage
+	requireT.NoError(json.Unmarshal(exportedApp.AppState, &exportedAppState))
+	requireT.JSONEq(string(exportedAppState[psetypes.ModuleName]), string(appState[psetypes.ModuleName]))
+
+	// nothing is written if the module doesn't exist
+	buf.Reset()
+	requireT.Error(simApp.StreamAppGenesis(&genutiltypes.AppGenesis{}, false, nil, []string{"unknown"}, buf))
+	requireT.Empty(buf.Bytes())
+}
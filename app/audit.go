@@ -0,0 +1,99 @@
+package app
+
+import (
+	"path/filepath"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cast"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/audit"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+const (
+	// AuditEnabledAppOption is the app option enabling the audit log of the state writes.
+	AuditEnabledAppOption = "audit.enabled"
+	// AuditStoresAppOption is the app option defining the comma-separated list of the audited stores.
+	AuditStoresAppOption = "audit.stores"
+	// AuditDirAppOption is the app option defining the directory of the audit files.
+	AuditDirAppOption = "audit.dir"
+	// AuditMaxFileSizeAppOption is the app option defining the size of the audit file after which the next file is
+	// started.
+	AuditMaxFileSizeAppOption = "audit.max_file_size"
+)
+
+// DefaultAuditStores are the stores audited if the stores aren't configured.
+var DefaultAuditStores = []string{assetfttypes.StoreKey, psetypes.StoreKey}
+
+// DefaultAuditDir returns the default directory of the audit files.
+func DefaultAuditDir(homePath string) string {
+	return filepath.Join(homePath, "data", "audit")
+}
+
+// setupAudit registers the listener recording the writes of the audited stores if the audit is enabled.
+func (app *App) setupAudit(appOpts servertypes.AppOptions, homePath string) error {
+	if !cast.ToBool(appOpts.Get(AuditEnabledAppOption)) {
+		return nil
+	}
+
+	stores := DefaultAuditStores
+	if storesOpt := strings.TrimSpace(cast.ToString(appOpts.Get(AuditStoresAppOption))); storesOpt != "" {
+		stores = nil
+		for _, store := range strings.Split(storesOpt, ",") {
+			if store = strings.TrimSpace(store); store != "" {
+				stores = append(stores, store)
+			}
+		}
+	}
+	storeKeys := make([]storetypes.StoreKey, 0, len(stores))
+	for _, store := range stores {
+		key, ok := app.keys[store]
+		if !ok {
+			return errors.Errorf("unknown audited store %q", store)
+		}
+		storeKeys = append(storeKeys, key)
+	}
+
+	dir := cast.ToString(appOpts.Get(AuditDirAppOption))
+	if dir == "" {
+		dir = DefaultAuditDir(homePath)
+	}
+	maxFileSize := cast.ToInt64(appOpts.Get(AuditMaxFileSizeAppOption))
+	if maxFileSize == 0 {
+		maxFileSize = audit.DefaultMaxFileSize
+	}
+
+	writer, err := audit.NewWriter(dir, maxFileSize)
+	if err != nil {
+		return err
+	}
+	app.auditWriter = writer
+
+	app.CommitMultiStore().AddListeners(storeKeys)
+	// The listeners registered by the other streaming services and their settings are kept.
+	streamingManager := app.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, audit.NewListener(writer, stores))
+	app.SetStreamingManager(streamingManager)
+	app.Logger().Info("audit log enabled", "dir", dir, "stores", strings.Join(stores, ","))
+
+	return nil
+}
+
+// Close closes the app, the audit log and the signature verifier.
+func (app *App) Close() error {
+	err := app.BaseApp.Close()
+	if app.sigVerifier != nil {
+		app.sigVerifier.Close()
+	}
+	if app.auditWriter != nil {
+		if closeErr := app.auditWriter.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
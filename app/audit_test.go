@@ -0,0 +1,46 @@
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/app"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+)
+
+type abciListenerMock struct {
+	storetypes.ABCIListener
+}
+
+func TestAudit_KeepsStreamingListeners(t *testing.T) {
+	requireT := require.New(t)
+
+	// the app is created to set up the sdk config
+	simapp.New()
+
+	listener := abciListenerMock{}
+	coreApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.AppOptionsMap{
+		flags.FlagHome:            t.TempDir(),
+		app.AuditEnabledAppOption: true,
+	}, func(bApp *baseapp.BaseApp) {
+		bApp.SetStreamingManager(storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{listener},
+			StopNodeOnErr: true,
+		})
+	})
+	t.Cleanup(func() {
+		requireT.NoError(coreApp.Close())
+	})
+
+	streamingManager := coreApp.StreamingManager()
+	requireT.True(streamingManager.StopNodeOnErr)
+	requireT.Len(streamingManager.ABCIListeners, 2)
+	requireT.Equal(listener, streamingManager.ABCIListeners[0])
+}
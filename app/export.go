@@ -3,15 +3,23 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"sort"
 
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	storetypes "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
@@ -54,6 +62,130 @@ func (app *App) ExportAppStateAndValidators(
 	}, err
 }
 
+// StreamAppGenesis exports the state of the application into the genesis document written to the writer. Unlike
+// ExportAppStateAndValidators, the state is written module by module, so the exported state of all the modules is
+// never held in memory at once. Only the modules from modulesToExport are exported, all of them if it is empty.
+func (app *App) StreamAppGenesis(
+	appGenesis *genutiltypes.AppGenesis,
+	forZeroHeight bool,
+	jailAllowedAddrs,
+	modulesToExport []string,
+	w io.Writer,
+) error {
+	if len(modulesToExport) == 0 {
+		modulesToExport = app.ModuleManager.OrderExportGenesis
+	}
+	// verify the modules before anything is written, so the output is never left truncated by the typo
+	for _, moduleName := range modulesToExport {
+		if _, ok := app.ModuleManager.Modules[moduleName]; !ok {
+			return errors.Errorf("module %q does not exist", moduleName)
+		}
+	}
+	modulesToExport = append([]string{}, modulesToExport...)
+	sort.Strings(modulesToExport)
+
+	ctx := app.NewContextLegacy(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	}
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	if err != nil {
+		return err
+	}
+
+	appGenesis.AppState = nil
+	appGenesis.InitialHeight = height
+	appGenesis.Consensus = genutiltypes.NewConsensusGenesis(app.GetConsensusParams(ctx), validators)
+	envelope, err := json.Marshal(appGenesis)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// the app state is appended to the envelope as its last field.
+	if _, err := w.Write(append(envelope[:len(envelope)-1], []byte(`,"app_state":{`)...)); err != nil {
+		return errors.WithStack(err)
+	}
+	first := true
+	for _, moduleName := range modulesToExport {
+		genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, []string{moduleName})
+		if err != nil {
+			return err
+		}
+		moduleState, ok := genState[moduleName]
+		if !ok {
+			// the module has no genesis
+			continue
+		}
+
+		key, err := json.Marshal(moduleName)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if !first {
+			key = append([]byte{','}, key...)
+		}
+		first = false
+		if _, err := w.Write(append(key, ':')); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := w.Write(moduleState); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	_, err = w.Write([]byte("}}"))
+	return errors.WithStack(err)
+}
+
+// CheckHeightExportable checks that the state of all the stores at the height is still stored in the db, so the app
+// can be loaded at this height to export the state. The error tells the earliest height available if the state is
+// pruned.
+func (app *App) CheckHeightExportable(db dbm.DB, height int64) error {
+	latestHeight := rootmulti.GetLatestVersion(db)
+	if height > latestHeight {
+		return errors.Errorf("height %d is greater than the latest height %d", height, latestHeight)
+	}
+
+	cms := rootmulti.NewStore(db, app.Logger(), metrics.NewNoOpMetrics())
+	for _, key := range app.keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	if err := cms.LoadLatestVersion(); err != nil {
+		return errors.Wrap(err, "failed to load the latest state")
+	}
+
+	commitInfo, err := cms.GetCommitInfo(height)
+	if err != nil {
+		return errors.Wrapf(err, "no state is committed at height %d", height)
+	}
+	// the stores added by the later upgrades are not in the commit info, so they are not checked.
+	for _, storeInfo := range commitInfo.StoreInfos {
+		key, ok := app.keys[storeInfo.Name]
+		if !ok {
+			continue
+		}
+		store, ok := cms.GetCommitKVStore(key).(*iavl.Store)
+		if !ok || store.VersionExists(height) {
+			continue
+		}
+
+		earliestHeight := latestHeight
+		if versions := store.GetAllVersions(); len(versions) > 0 {
+			earliestHeight = int64(versions[0])
+		}
+		return errors.Errorf(
+			"height %d is pruned in the %s store, the earliest height available is %d, "+
+				"export from the node running with the pruning=nothing to export older heights",
+			height, storeInfo.Name, earliestHeight,
+		)
+	}
+
+	return nil
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated	in favour of export at a block height.
 //
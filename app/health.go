@@ -0,0 +1,36 @@
+package app
+
+import (
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/pkg/errors"
+)
+
+// CheckWasmVM checks that the wasm VM responds.
+func (app *App) CheckWasmVM() error {
+	if app.WasmVM == nil {
+		return errors.New("wasm VM is not initialized")
+	}
+	if _, err := app.WasmVM.GetMetrics(); err != nil {
+		return errors.Wrap(err, "failed to get wasm VM metrics")
+	}
+
+	return nil
+}
+
+// PendingUpgradePlan returns the upgrade plan scheduled in the latest committed state, or nil if there is no plan.
+func (app *App) PendingUpgradePlan() (*upgradetypes.Plan, error) {
+	ctx, err := app.CreateQueryContext(0, false)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := app.UpgradeKeeper.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, upgradetypes.ErrNoUpgradePlanFound) {
+			return nil, nil //nolint:nilnil //returns nil if there is no plan
+		}
+		return nil, err
+	}
+
+	return &plan, nil
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"strings"
+
+	"cosmossdk.io/log"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// LogLevelOverridesAppOption is the app option defining the log levels of the custom modules, e.g. "x/pse:debug".
+const LogLevelOverridesAppOption = "log_level_overrides"
+
+// moduleLoggers creates the loggers of the custom modules.
+type moduleLoggers struct {
+	logger log.Logger
+	levels map[string]zerolog.Level
+}
+
+// newModuleLoggers parses the comma-separated list of the "module:level" pairs and returns the module loggers.
+func newModuleLoggers(logger log.Logger, overrides string) (moduleLoggers, error) {
+	levels := map[string]zerolog.Level{}
+	for _, item := range strings.Split(overrides, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		module, levelStr, found := strings.Cut(item, ":")
+		if !found || module == "" {
+			return moduleLoggers{}, errors.Errorf("expected \"module:level\" pair, got %q", item)
+		}
+		if _, exists := levels[module]; exists {
+			return moduleLoggers{}, errors.Errorf("duplicate module %q in log level overrides", module)
+		}
+		level, err := zerolog.ParseLevel(levelStr)
+		if err != nil {
+			return moduleLoggers{}, errors.Wrapf(err, "invalid log level of module %q", module)
+		}
+		levels[module] = level
+	}
+
+	return moduleLoggers{
+		logger: logger,
+		levels: levels,
+	}, nil
+}
+
+// Logger returns the logger of the module. If the level of the module is overridden, the logger uses it instead of
+// the level of the app logger. The module is expected to be in the "x/<module name>" form.
+func (l moduleLoggers) Logger(module string) log.Logger {
+	level, found := l.levels[module]
+	if !found {
+		return l.logger.With(log.ModuleKey, module)
+	}
+	zl, ok := l.logger.Impl().(*zerolog.Logger)
+	if !ok {
+		return l.logger.With(log.ModuleKey, module)
+	}
+
+	return log.NewCustomLogger(zl.With().Str(log.ModuleKey, module).Logger().Level(level))
+}
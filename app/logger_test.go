@@ -0,0 +1,47 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleLoggers(t *testing.T) {
+	requireT := require.New(t)
+
+	buf := &bytes.Buffer{}
+	logger := log.NewLogger(buf, log.OutputJSONOption(), log.LevelOption(zerolog.InfoLevel))
+
+	loggers, err := newModuleLoggers(logger, "x/pse:debug, x/assetft:error")
+	requireT.NoError(err)
+
+	loggers.Logger("x/pse").Debug("pse debug")
+	requireT.Contains(buf.String(), "pse debug")
+	requireT.Contains(buf.String(), `"module":"x/pse"`)
+
+	buf.Reset()
+	loggers.Logger("x/assetft").Info("assetft info")
+	requireT.Empty(buf.String())
+
+	loggers.Logger("x/other").Debug("other debug")
+	requireT.Empty(buf.String())
+	loggers.Logger("x/other").Info("other info")
+	requireT.Contains(buf.String(), `"module":"x/other"`)
+}
+
+func TestModuleLoggers_InvalidOverrides(t *testing.T) {
+	for _, overrides := range []string{
+		"x/pse",
+		":debug",
+		"x/pse:verbose",
+		"x/pse:debug,x/pse:info",
+	} {
+		t.Run(overrides, func(t *testing.T) {
+			_, err := newModuleLoggers(log.NewNopLogger(), overrides)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DeliverTxOnBranch executes the transaction against the state of the context the same way as the block
+// transactions are executed: the changes of the ante handler are kept if the messages fail. It is used by the offline
+// replay of the blocks with the parallel executor and must never be called while the node executes the blocks, since
+// the keepers are not safe for the concurrent use.
+func (app *App) DeliverTxOnBranch(ctx sdk.Context, txBytes []byte) (res *abci.ExecTxResult) {
+	var gasWanted uint64
+	defer func() {
+		if r := recover(); r != nil {
+			res = cosmoserrors.ResponseExecTxResultWithEvents(
+				errorsmod.Wrap(cosmoserrors.ErrPanic, fmt.Sprint(r)), gasWanted, ctx.GasMeter().GasConsumed(), nil, false,
+			)
+		}
+	}()
+
+	tx, err := app.TxDecode(txBytes)
+	if err != nil {
+		return cosmoserrors.ResponseExecTxResultWithEvents(err, 0, 0, nil, false)
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		gasWanted = feeTx.GetGas()
+	}
+
+	ctx = ctx.WithTxBytes(txBytes)
+	anteCtx, writeAnte := ctx.CacheContext()
+	newCtx, err := app.AnteHandler()(anteCtx, tx, false)
+	if !newCtx.IsZero() {
+		ctx = newCtx
+	}
+	if err != nil {
+		return cosmoserrors.ResponseExecTxResultWithEvents(err, gasWanted, ctx.GasMeter().GasConsumed(), nil, false)
+	}
+	writeAnte()
+	events := ctx.EventManager().ABCIEvents()
+
+	msgCtx, writeMsgs := ctx.CacheContext()
+	for _, msg := range tx.GetMsgs() {
+		handler := app.MsgServiceRouter().Handler(msg)
+		if handler == nil {
+			return cosmoserrors.ResponseExecTxResultWithEvents(
+				errorsmod.Wrapf(cosmoserrors.ErrUnknownRequest, "no message handler found for %T", msg),
+				gasWanted, ctx.GasMeter().GasConsumed(), events, false,
+			)
+		}
+		msgRes, err := handler(msgCtx, msg)
+		if err != nil {
+			return cosmoserrors.ResponseExecTxResultWithEvents(
+				err, gasWanted, ctx.GasMeter().GasConsumed(), events, false,
+			)
+		}
+		events = append(events, msgRes.Events...)
+	}
+	writeMsgs()
+
+	//nolint:gosec // the gas is limited by the gas wanted validated by the ante handler
+	return &abci.ExecTxResult{
+		GasWanted: int64(gasWanted),
+		GasUsed:   int64(ctx.GasMeter().GasConsumed()),
+		Events:    events,
+	}
+}
@@ -0,0 +1,111 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/occ"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+)
+
+func TestParallelExecution_Determinism(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	commit := func() {
+		requireT.NoError(testApp.FinalizeBlock())
+		_, err := testApp.Commit()
+		requireT.NoError(err)
+	}
+	commit()
+
+	ctx := testApp.NewUncachedContext(false, tmproto.Header{Height: testApp.LastBlockHeight(), Time: time.Now()})
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	const senderCount = 60
+	senders := make([]sdk.AccAddress, 0, senderCount)
+	privKeys := make([]*secp256k1.PrivKey, 0, senderCount)
+	for range senderCount {
+		sender, privKey := testApp.GenAccount(ctx)
+		requireT.NoError(testApp.FundAccount(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000_000))))
+		senders = append(senders, sender)
+		privKeys = append(privKeys, privKey)
+	}
+	recipients := make([]sdk.AccAddress, 0, 3)
+	for range cap(recipients) {
+		recipient, _ := testApp.GenAccount(ctx)
+		recipients = append(recipients, recipient)
+	}
+	commit()
+
+	// the transfer-heavy block: the senders pay to the shared recipients, some of them send to the other senders
+	// spending the received amounts, and some transfers fail
+	txs := make([][]byte, 0, senderCount)
+	for i, sender := range senders {
+		recipient := recipients[i%len(recipients)]
+		amount := int64(1_000_000)
+		switch {
+		case i%7 == 0:
+			recipient = senders[(i+1)%senderCount]
+		case i%11 == 0:
+			amount = 100_000_000
+		}
+		tx, err := testApp.GenTx(ctx, sdk.NewInt64Coin(bondDenom, 100_000), 200_000, privKeys[i], &banktypes.MsgSend{
+			FromAddress: sender.String(),
+			ToAddress:   recipient.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount)),
+		})
+		requireT.NoError(err)
+		txBytes, err := testApp.TxConfig().TxEncoder()(tx)
+		requireT.NoError(err)
+		txs = append(txs, txBytes)
+	}
+
+	feeCollector := testApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	accounts := append(append([]sdk.AccAddress{feeCollector}, senders...), recipients...)
+	type accountState struct {
+		Balance  sdk.Coins
+		Sequence uint64
+	}
+	dumpState := func(ctx sdk.Context) []accountState {
+		state := make([]accountState, 0, len(accounts))
+		for _, addr := range accounts {
+			var sequence uint64
+			if acc := testApp.AccountKeeper.GetAccount(ctx, addr); acc != nil {
+				sequence = acc.GetSequence()
+			}
+			state = append(state, accountState{
+				Balance:  testApp.BankKeeper.GetAllBalances(ctx, addr),
+				Sequence: sequence,
+			})
+		}
+		return state
+	}
+
+	seqCtx, _ := ctx.CacheContext()
+	seqResults, _ := occ.SequentialExecutor{}.Execute(seqCtx, txs, testApp.DeliverTxOnBranch)
+	for _, workers := range []int{1, 4, 16} {
+		parCtx, _ := ctx.CacheContext()
+		parResults, stats := occ.NewParallelExecutor(workers).Execute(parCtx, txs, testApp.DeliverTxOnBranch)
+		requireT.Equal(seqResults, parResults)
+		requireT.Equal(dumpState(seqCtx), dumpState(parCtx))
+		requireT.Equal(len(txs), stats.Txs)
+	}
+
+	var failed int
+	for _, res := range seqResults {
+		if res.Code != 0 {
+			failed++
+		}
+	}
+	requireT.Positive(failed)
+	requireT.Less(failed, len(txs))
+}
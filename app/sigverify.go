@@ -0,0 +1,50 @@
+package app
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/sigverify"
+	"github.com/tokenize-x/tx-chain/v7/x/auth/ante"
+)
+
+const (
+	// SigVerifyParallelAppOption is the app option enabling the parallel verification of the signatures.
+	SigVerifyParallelAppOption = "sigverify.parallel"
+	// SigVerifyWorkersAppOption is the app option defining the number of the signature verification workers.
+	SigVerifyWorkersAppOption = "sigverify.workers"
+)
+
+// setupSigVerify starts the parallel signature verifier if it is enabled.
+func (app *App) setupSigVerify(appOpts servertypes.AppOptions) {
+	if !cast.ToBool(appOpts.Get(SigVerifyParallelAppOption)) {
+		return
+	}
+
+	workers := cast.ToInt(appOpts.Get(SigVerifyWorkersAppOption))
+	app.sigVerifier = sigverify.NewVerifier(workers, sigverify.DefaultMaxPending)
+	app.Logger().Info("parallel signature verification enabled", "workers", workers)
+}
+
+// prefetchSignatures schedules the verification of the signatures of the block transactions, so they are verified
+// in parallel while the block is executed.
+func (app *App) prefetchSignatures(ctx sdk.Context, req *abci.RequestFinalizeBlock) {
+	if app.sigVerifier == nil {
+		return
+	}
+
+	// the results of the previous block, e.g. of the failed transactions, are not needed anymore
+	app.sigVerifier.Reset()
+
+	txs := make([]sdk.Tx, 0, len(req.Txs))
+	for _, txBytes := range req.Txs {
+		tx, err := app.TxDecode(txBytes)
+		if err != nil {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	ante.PrefetchSignatures(ctx, app.AccountKeeper, app.txConfig.SignModeHandler(), app.sigVerifier, txs)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
-	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	clientcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
@@ -84,7 +83,7 @@ func TestFullAppSimulation(t *testing.T) {
 		simApp.GetBaseApp(),
 		simtestutil.AppStateFn(simApp.AppCodec(), simApp.SimulationManager(), simApp.DefaultGenesis()),
 		simulationtypes.RandomAccounts,
-		simtestutil.BuildSimulationOperations(simApp, simApp.AppCodec(), cfg, moduletestutil.MakeTestTxConfig()),
+		simtestutil.BuildSimulationOperations(simApp, simApp.AppCodec(), cfg, simApp.TxConfig()),
 		simApp.ModuleAccountAddrs(),
 		cfg,
 		simApp.AppCodec(),
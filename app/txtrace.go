@@ -0,0 +1,5 @@
+package app
+
+// TxTraceEnabledAppOption is the app option enabling the tracing of the transactions against the historical state.
+// The tracing re-executes the transactions on the request, so it should be enabled only on the debug nodes.
+const TxTraceEnabledAppOption = "txtrace.enabled"
@@ -4,14 +4,25 @@ import (
 	"context"
 
 	addresscodec "cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
 	store "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	"github.com/tokenize-x/tx-chain/v7/app/upgrade"
+	assetnftmarkettypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+	auctiontypes "github.com/tokenize-x/tx-chain/v7/x/auction/types"
+	autocompoundtypes "github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+	bridgetypes "github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+	epochstypes "github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+	feereferraltypes "github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+	labeltypes "github.com/tokenize-x/tx-chain/v7/x/label/types"
+	metatxtypes "github.com/tokenize-x/tx-chain/v7/x/metatx/types"
 	pskeeper "github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	treasurytypes "github.com/tokenize-x/tx-chain/v7/x/treasury/types"
 	wbankkeeper "github.com/tokenize-x/tx-chain/v7/x/wbank/keeper"
 )
 
@@ -32,11 +43,54 @@ func New(
 	return upgrade.Upgrade{
 		Name: Name,
 		StoreUpgrades: store.StoreUpgrades{
-			Added:   []string{},
+			Added: []string{
+				bridgetypes.StoreKey, auctiontypes.StoreKey, labeltypes.StoreKey, assetnftmarkettypes.StoreKey,
+				metatxtypes.StoreKey, autocompoundtypes.StoreKey, epochstypes.StoreKey, feereferraltypes.StoreKey,
+				treasurytypes.StoreKey,
+			},
 			Deleted: []string{},
 		},
 		Upgrade: func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
-			return mm.RunMigrations(ctx, configurator, vm)
+			vm, err := mm.RunMigrations(ctx, configurator, vm)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := setPSEMinDelegationAmount(ctx, bankKeeper, stakingKeeper, pseKeeper); err != nil {
+				return nil, err
+			}
+
+			return vm, nil
 		},
 	}
 }
+
+// setPSEMinDelegationAmount sets the minimum delegation amount accruing the pse score to one display unit of the bond
+// denom. The default amount is kept if the bond denom has no metadata or no display unit.
+func setPSEMinDelegationAmount(
+	ctx context.Context,
+	bankKeeper wbankkeeper.BaseKeeperWrapper,
+	stakingKeeper *stakingkeeper.Keeper,
+	pseKeeper pskeeper.Keeper,
+) error {
+	bondDenom, err := stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	logger := sdk.UnwrapSDKContext(ctx).Logger()
+	metadata, found := bankKeeper.GetDenomMetaData(ctx, bondDenom)
+	if !found {
+		logger.Warn("bond denom metadata not found, keeping the default pse min delegation amount",
+			"denom", bondDenom)
+		return nil
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom != "" && unit.Denom == metadata.Display {
+			return pseKeeper.SetMinDelegationAmount(ctx, sdkmath.NewIntWithDecimal(1, int(unit.Exponent)))
+		}
+	}
+
+	logger.Warn("bond denom display unit not found, keeping the default pse min delegation amount",
+		"denom", bondDenom)
+	return nil
+}
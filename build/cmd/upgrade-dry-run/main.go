@@ -0,0 +1,80 @@
+// Package main contains the tool executing the upgrade handler against the exported genesis in-process. It loads the
+// genesis exported by `txd export` into the app, schedules and executes the upgrade and prints the differences of the
+// module params, supplies and pse schedules caused by the upgrade.
+//
+// Usage:
+//
+//	go run ./cmd/upgrade-dry-run --genesis exported-genesis.json --upgrade v7
+//
+// The exported state is imported by the current binary, so the store migrations of the existing modules are executed
+// only if they are triggered by the upgrade handler. The modules missing in the genesis are initialized by the upgrade
+// as the new modules.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/app"
+	appupgradev7 "github.com/tokenize-x/tx-chain/v7/app/upgrade/v7"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+)
+
+func main() {
+	genesisPath := flag.String("genesis", "", "Path to the genesis exported by txd export")
+	upgradeName := flag.String("upgrade", appupgradev7.Name, "Name of the upgrade to execute")
+	flag.Parse()
+
+	if err := run(*genesisPath, *upgradeName); err != nil {
+		fmt.Fprintf(os.Stderr, "upgrade dry run failed: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(genesisPath, upgradeName string) error {
+	if genesisPath == "" {
+		return errors.New("genesis path is required")
+	}
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read genesis %s", genesisPath)
+	}
+
+	var genesisHeader struct {
+		ChainID string `json:"chain_id"` //nolint:tagliatelle
+	}
+	if err := json.Unmarshal(genesis, &genesisHeader); err != nil {
+		return errors.Wrap(err, "failed to unmarshal genesis")
+	}
+	network, err := config.NetworkConfigByChainID(constant.ChainID(genesisHeader.ChainID))
+	if err != nil {
+		return err
+	}
+	app.ChosenNetwork = network
+	network.SetSDKConfig()
+
+	fmt.Printf("loading genesis of %s\n", genesisHeader.ChainID)
+	simApp, _, appState, initChainReq, _ := simapp.NewWithGenesis(genesis)
+
+	fmt.Printf("executing upgrade %s at height %d\n", upgradeName, initChainReq.InitialHeight+1)
+	before, after, err := simApp.DryRunUpgrade(upgradeName, initChainReq, appState)
+	if err != nil {
+		return err
+	}
+
+	diff := simapp.DiffUpgradeSnapshots(before, after)
+	if len(diff) == 0 {
+		fmt.Println("no changes of params, supplies and pse schedules")
+		return nil
+	}
+	for _, line := range diff {
+		fmt.Println(line)
+	}
+	return nil
+}
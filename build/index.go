@@ -20,15 +20,17 @@ var Commands = map[string]types.Command{
 		)
 		return nil
 	}, Description: "Builds txd binaries"},
-	"build/txd": {Fn: txchain.BuildTXd, Description: "Builds txd binary"},
-	"generate":  {Fn: txchain.Generate, Description: "Generates artifacts"},
-	"setup":     {Fn: tools.InstallAll, Description: "Installs all the required tools"},
+	"build/txd":      {Fn: txchain.BuildTXd, Description: "Builds txd binary"},
+	"build/txfaucet": {Fn: txchain.BuildFaucetLocally, Description: "Builds txfaucet binary"},
+	"generate":       {Fn: txchain.Generate, Description: "Generates artifacts"},
+	"setup":          {Fn: tools.InstallAll, Description: "Installs all the required tools"},
 	"images": {Fn: func(ctx context.Context, deps types.DepsFunc) error {
 		deps(
 			txchain.BuildTXdDockerImage,
 			txchain.BuildGaiaDockerImage,
 			txchain.BuildHermesDockerImage,
 			txchain.BuildOsmosisDockerImage,
+			txchain.BuildFaucetDockerImage,
 		)
 		return nil
 	}, Description: "Builds txd docker images"},
@@ -36,6 +38,7 @@ var Commands = map[string]types.Command{
 	"images/gaiad":   {Fn: txchain.BuildGaiaDockerImage, Description: "Builds gaia docker image"},
 	"images/hermes":  {Fn: txchain.BuildHermesDockerImage, Description: "Builds hermes docker image"},
 	"images/osmosis": {Fn: txchain.BuildOsmosisDockerImage, Description: "Builds osmosis docker image"},
+	"images/faucet":  {Fn: txchain.BuildFaucetDockerImage, Description: "Builds faucet docker image"},
 	"integration-tests": {
 		Fn:          txchain.RunAllIntegrationTests(false),
 		Description: "Runs all safe integration tests",
@@ -52,6 +55,10 @@ var Commands = map[string]types.Command{
 		Fn:          txchain.RunIntegrationTestsIBC(true),
 		Description: "Runs all IBC integration tests including unsafe",
 	},
+	"integration-tests/ibc-clock-skew": {
+		Fn:          txchain.RunIntegrationTestsIBCClockSkew(false),
+		Description: "Runs safe IBC integration tests with the clocks of the peer chains skewed",
+	},
 	"integration-tests/modules": {
 		Fn:          txchain.RunIntegrationTestsModules(false),
 		Description: "Runs safe modules integration tests",
@@ -0,0 +1,66 @@
+package txchain
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/tokenize-x/tx-crust/build/config"
+	"github.com/tokenize-x/tx-crust/build/docker"
+	dockerbasic "github.com/tokenize-x/tx-crust/build/docker/basic"
+	"github.com/tokenize-x/tx-crust/build/golang"
+	txcrusttools "github.com/tokenize-x/tx-crust/build/tools"
+	"github.com/tokenize-x/tx-crust/build/types"
+)
+
+const (
+	faucetBinaryName = "txfaucet"
+	// faucetImageName is the name of the image used by the faucet profile of znet.
+	faucetImageName  = "faucet"
+	faucetBinaryPath = "bin/" + faucetBinaryName
+)
+
+// BuildFaucetLocally builds faucet locally.
+func BuildFaucetLocally(ctx context.Context, deps types.DepsFunc) error {
+	return golang.Build(ctx, deps, golang.BinaryBuildConfig{
+		TargetPlatform: txcrusttools.TargetPlatformLocal,
+		PackagePath:    "cmd/txfaucet",
+		BinOutputPath:  faucetBinaryPath,
+		CGOEnabled:     false,
+	})
+}
+
+// BuildFaucetInDocker builds faucet in docker.
+func BuildFaucetInDocker(ctx context.Context, deps types.DepsFunc) error {
+	return golang.Build(ctx, deps, golang.BinaryBuildConfig{
+		TargetPlatform: txcrusttools.TargetPlatformLinuxLocalArchInDocker,
+		PackagePath:    "cmd/txfaucet",
+		BinOutputPath:  filepath.Join(faucetContextDir(), faucetBinaryPath),
+		CGOEnabled:     false,
+	})
+}
+
+// BuildFaucetDockerImage builds docker image of the faucet deployed by znet.
+func BuildFaucetDockerImage(ctx context.Context, deps types.DepsFunc) error {
+	deps(BuildFaucetInDocker)
+
+	dockerfile, err := dockerbasic.Execute(dockerbasic.Data{
+		From:   docker.AlpineImage,
+		Binary: faucetBinaryPath,
+	})
+	if err != nil {
+		return err
+	}
+
+	return docker.BuildImage(ctx, docker.BuildImageConfig{
+		ContextDir: faucetContextDir(),
+		ImageName:  faucetImageName,
+		Dockerfile: dockerfile,
+		Versions:   []string{config.ZNetVersion},
+	})
+}
+
+func faucetContextDir() string {
+	return filepath.Join(
+		"bin", ".cache", faucetBinaryName, txcrusttools.TargetPlatformLinuxLocalArchInDocker.String(),
+	)
+}
@@ -29,6 +29,17 @@ const (
 	TestExport = "export"
 )
 
+const (
+	// defaultTimeoutCommit is the timeout commit used by znet chains unless the test run overrides it.
+	defaultTimeoutCommit = 500 * time.Millisecond
+	// modulesTimeoutCommit is the timeout commit used by the modules tests. The shorter block time lets the tests
+	// awaiting the chain time (e.g. scheduled pse distributions) reach it faster.
+	modulesTimeoutCommit = 250 * time.Millisecond
+	// ibcClockSkew is the skew of the peer chain clocks applied by the IBC tests run with the clock skew. It is
+	// positive for gaia and negative for osmosis, so the clocks of the peers drift in both directions relative to txd.
+	ibcClockSkew = 10 * time.Second
+)
+
 // Test run unit tests in tx-chain repo.
 func Test(ctx context.Context, deps types.DepsFunc) error {
 	deps(CompileAllSmartContracts)
@@ -56,9 +67,10 @@ func RunIntegrationTestsModules(runUnsafe bool) types.CommandFunc {
 
 		znetConfig := defaultZNetConfig()
 		znetConfig.Profiles = []string{apps.Profile3TXd}
+		znetConfig.TimeoutCommit = modulesTimeoutCommit
 		znetConfig.CoverageOutputFile = "coverage/coreum-integration-tests-modules"
 
-		return runIntegrationTests(ctx, deps, runUnsafe, false, znetConfig, TestModules)
+		return runIntegrationTests(ctx, deps, runUnsafe, false, znetConfig, nil, TestModules)
 	}
 }
 
@@ -71,7 +83,7 @@ func RunIntegrationTestsStress(runUnsafe bool) types.CommandFunc {
 		znetConfig.Profiles = []string{apps.Profile3TXd, apps.ProfileDEX}
 		znetConfig.CoverageOutputFile = "coverage/coreum-integration-tests-stress"
 
-		return runIntegrationTests(ctx, deps, runUnsafe, false, znetConfig, TestStress)
+		return runIntegrationTests(ctx, deps, runUnsafe, false, znetConfig, nil, TestStress)
 	}
 }
 
@@ -85,7 +97,26 @@ func RunIntegrationTestsIBC(runUnsafe bool) types.CommandFunc {
 		znetConfig := defaultZNetConfig()
 		znetConfig.Profiles = []string{apps.Profile3TXd, apps.ProfileIBC}
 
-		return runIntegrationTests(ctx, deps, runUnsafe, false, znetConfig, TestIBC)
+		return runIntegrationTests(ctx, deps, runUnsafe, false, znetConfig, nil, TestIBC)
+	}
+}
+
+// RunIntegrationTestsIBCClockSkew returns function running IBC integration tests with the clocks of the peer chains
+// skewed relative to txd.
+func RunIntegrationTestsIBCClockSkew(runUnsafe bool) types.CommandFunc {
+	return func(ctx context.Context, deps types.DepsFunc) error {
+		deps(CompileIBCSmartContracts, CompileAssetExtensionSmartContracts, CompileDEXSmartContracts,
+			BuildTXdLocally, BuildTXdDockerImage, BuildGaiaDockerImage, BuildOsmosisDockerImage,
+			BuildHermesDockerImage)
+
+		znetConfig := defaultZNetConfig()
+		znetConfig.Profiles = []string{apps.Profile3TXd, apps.ProfileIBC}
+
+		testFlags := []string{
+			"--gaia-clock-skew=" + ibcClockSkew.String(),
+			"--osmosis-clock-skew=" + (-ibcClockSkew).String(),
+		}
+		return runIntegrationTests(ctx, deps, runUnsafe, false, znetConfig, testFlags, TestIBC)
 	}
 }
 
@@ -100,7 +131,9 @@ func RunIntegrationTestsUpgrade(runUnsafe bool) types.CommandFunc {
 		znetConfig.Profiles = []string{apps.Profile3TXd, apps.ProfileIBC}
 		znetConfig.TXdVersion = "v6.0.0"
 
-		return runIntegrationTests(ctx, deps, runUnsafe, true, znetConfig, TestUpgrade, TestIBC, TestModules)
+		return runIntegrationTests(
+			ctx, deps, runUnsafe, true, znetConfig, nil, TestUpgrade, TestIBC, TestModules,
+		)
 	}
 }
 
@@ -117,6 +150,7 @@ func runIntegrationTests(
 	runUnsafe bool,
 	runExport bool,
 	znetConfig *infra.ConfigFactory,
+	testFlags []string,
 	testDirs ...string,
 ) error {
 	// General flags for all tests
@@ -139,6 +173,7 @@ func runIntegrationTests(
 	if runUnsafe {
 		regularFlags = append(regularFlags, "--run-unsafe")
 	}
+	regularFlags = append(regularFlags, testFlags...)
 	for _, testDir := range testDirs {
 		if err := golang.RunTests(ctx, deps, golang.TestConfig{
 			PackagePath: filepath.Join(integrationTestsDir, testDir),
@@ -193,7 +228,7 @@ func runIntegrationTests(
 func defaultZNetConfig() *infra.ConfigFactory {
 	return &infra.ConfigFactory{
 		EnvName:       "znet",
-		TimeoutCommit: 500 * time.Millisecond,
+		TimeoutCommit: defaultTimeoutCommit,
 		HomeDir:       filepath.Join(lo.Must(os.UserHomeDir()), ".crust", "znet"),
 		RootDir:       ".",
 		TXdUpgrades:   TXdUpgrades(),
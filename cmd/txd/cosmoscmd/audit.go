@@ -0,0 +1,70 @@
+package cosmoscmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/app"
+	"github.com/tokenize-x/tx-chain/v7/pkg/audit"
+)
+
+// AuditCmd returns the command managing the audit log of the node.
+func AuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "audit",
+		Short:                      "Audit log subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(AuditVerifyCmd())
+
+	return cmd
+}
+
+// AuditVerifyCmd returns the command verifying the hash chain of the audit log.
+func AuditVerifyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify [dir]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Verify the hash chain of the audit log",
+		Long: fmt.Sprintf(`Verify the hash chain of the audit log recorded by the node with the audit enabled.
+The directory configured in app.toml is used if not provided.
+
+Example:
+$ %s audit verify
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := ""
+			if len(args) > 0 {
+				dir = args[0]
+			}
+			if dir == "" {
+				serverCtx := server.GetServerContextFromCmd(cmd)
+				dir = cast.ToString(serverCtx.Viper.Get(app.AuditDirAppOption))
+				if dir == "" {
+					dir = app.DefaultAuditDir(serverCtx.Config.RootDir)
+				}
+			}
+
+			result, err := audit.Verify(dir)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package cosmoscmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+)
+
+const (
+	// FlagCodecLocal is the flag of the codec types command printing the types known by the binary.
+	FlagCodecLocal = "local"
+	// FlagCodecDiff is the flag of the codec types command comparing the types of the node and the binary.
+	FlagCodecDiff = "diff"
+)
+
+// CodecCmd returns the command grouping the queries of the codec types.
+func CodecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "codec",
+		Short:                      "Querying commands for the codec types",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(CodecTypesCmd())
+
+	return cmd
+}
+
+// CodecTypesCmd returns the command listing the types registered in the codec.
+func CodecTypesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "types",
+		Args:  cobra.NoArgs,
+		Short: "List the Msg, Query and Any types registered in the codec",
+		Long: fmt.Sprintf(`List the interfaces with the type URLs of their implementations packed into Any,
+including the messages, and the gRPC query methods registered in the codec of the node.
+With --%s the types known by this binary are listed instead.
+With --%s the types of the node are compared with the types of this binary, the command fails if
+the node uses the types unknown to the binary, which would fail to decode them.
+
+Example:
+$ %s query codec types
+$ %s query codec types --%s
+`, FlagCodecLocal, FlagCodecDiff, version.AppName, version.AppName, FlagCodecDiff),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			local, err := cmd.Flags().GetBool(FlagCodecLocal)
+			if err != nil {
+				return err
+			}
+			diff, err := cmd.Flags().GetBool(FlagCodecDiff)
+			if err != nil {
+				return err
+			}
+			if local && diff {
+				return errors.Errorf("flags --%s and --%s are mutually exclusive", FlagCodecLocal, FlagCodecDiff)
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			localRegistry := config.NewTypeRegistry(clientCtx.InterfaceRegistry)
+			if local {
+				return printJSON(clientCtx, localRegistry)
+			}
+
+			clientCtx, err = client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			nodeRegistry, err := config.QueryTypeRegistry(cmd.Context(), clientCtx)
+			if err != nil {
+				return err
+			}
+			if !diff {
+				return printJSON(clientCtx, nodeRegistry)
+			}
+
+			registryDiff := localRegistry.Diff(nodeRegistry)
+			if err := printJSON(clientCtx, registryDiff); err != nil {
+				return err
+			}
+			if len(registryDiff.MissingTypes) > 0 {
+				return errors.Errorf("the binary doesn't know %d types of the node", len(registryDiff.MissingTypes))
+			}
+
+			return nil
+		},
+	}
+	cmd.Flags().Bool(FlagCodecLocal, false, "List the types known by this binary instead of the node")
+	cmd.Flags().Bool(FlagCodecDiff, false, "Compare the types of the node with the types known by this binary")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func printJSON(clientCtx client.Context, v any) error {
+	output, err := json.Marshal(v)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return clientCtx.WithOutputFormat(flags.OutputFormatJSON).PrintRaw(output)
+}
@@ -0,0 +1,260 @@
+package cosmoscmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/blocktiming"
+)
+
+const (
+	// FlagConsensusLagHealthAddress is the flag of the health address of the node the block timings are fetched from.
+	FlagConsensusLagHealthAddress = "health-address"
+	// FlagConsensusLagBlocks is the flag of the number of the recent blocks reported.
+	FlagConsensusLagBlocks = "blocks"
+
+	consensusLagRequestTimeout = 10 * time.Second
+)
+
+// ConsensusLagReport is the breakdown of the execution time of the recent blocks.
+type ConsensusLagReport struct {
+	Blocks     int                        `json:"blocks"`
+	BeginBlock PhaseTiming                `json:"begin_block"`
+	DeliverTx  PhaseTiming                `json:"deliver_tx"`
+	EndBlock   PhaseTiming                `json:"end_block"`
+	SubTimings []ModulePhaseTiming        `json:"sub_timings,omitempty"`
+	Slowest    blocktiming.BlockTimings   `json:"slowest"`
+	Recent     []blocktiming.BlockTimings `json:"recent"`
+}
+
+// PhaseTiming is the timing of the block phase over the reported blocks.
+type PhaseTiming struct {
+	Average time.Duration `json:"average"`
+	Max     time.Duration `json:"max"`
+	// Share is the share of the phase in the total execution time of the blocks, in percent.
+	Share float64 `json:"share"`
+}
+
+// ModulePhaseTiming is the timing of the part of the phase executed by the module over the reported blocks.
+type ModulePhaseTiming struct {
+	Module  string        `json:"module"`
+	Phase   string        `json:"phase"`
+	Average time.Duration `json:"average"`
+	Max     time.Duration `json:"max"`
+}
+
+// DebugCmd returns the debug command extended with the node diagnostics.
+func DebugCmd(debugCmd *cobra.Command) *cobra.Command {
+	debugCmd.AddCommand(ConsensusLagCmd(), ParallelReplayCmd())
+	return debugCmd
+}
+
+// ConsensusLagCmd returns the command reporting the execution timings of the recent blocks split by the phases.
+func ConsensusLagCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-lag",
+		Args:  cobra.NoArgs,
+		Short: "Report the execution timings of the recent blocks split by the phases",
+		Long: fmt.Sprintf(`Report the execution timings of the recent blocks executed by the node, split by BeginBlock,
+DeliverTx and EndBlock, together with the sub-timings of the modules' end blockers (pse, assetft).
+The timings are fetched from the health endpoint of the node, which must be enabled with --%s.
+
+Example:
+$ %s debug consensus-lag --%s 127.0.0.1:26659 --%s 20
+`, FlagHealthAddress, version.AppName, FlagConsensusLagHealthAddress, FlagConsensusLagBlocks),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := cmd.Flags().GetString(FlagConsensusLagHealthAddress)
+			if err != nil {
+				return err
+			}
+			blocksLimit, err := cmd.Flags().GetInt(FlagConsensusLagBlocks)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := fetchBlockTimings(cmd.Context(), address)
+			if err != nil {
+				return err
+			}
+			if len(blocks) == 0 {
+				return errors.New("node hasn't recorded any block yet")
+			}
+			if blocksLimit > 0 && len(blocks) > blocksLimit {
+				blocks = blocks[len(blocks)-blocksLimit:]
+			}
+			report := newConsensusLagReport(blocks)
+
+			if output == flags.OutputFormatJSON {
+				return json.NewEncoder(cmd.OutOrStdout()).Encode(report)
+			}
+			return printConsensusLagReport(cmd.OutOrStdout(), report)
+		},
+	}
+	cmd.Flags().String(
+		FlagConsensusLagHealthAddress, "127.0.0.1:26659", "The health address of the node the timings are fetched from",
+	)
+	cmd.Flags().Int(FlagConsensusLagBlocks, 20, "The number of the recent blocks reported, all recorded if 0")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+func fetchBlockTimings(ctx context.Context, address string) ([]blocktiming.BlockTimings, error) {
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+	ctx, cancel := context.WithTimeout(ctx, consensusLagRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address+blockTimingsPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create block timings request")
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch block timings from %s", address)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body) //nolint:errcheck // the body is used only for the error message
+		return nil, errors.Errorf("failed to fetch block timings, status: %s, body: %s", res.Status, body)
+	}
+
+	var blocks []blocktiming.BlockTimings
+	if err := json.NewDecoder(res.Body).Decode(&blocks); err != nil {
+		return nil, errors.Wrap(err, "failed to decode block timings")
+	}
+	return blocks, nil
+}
+
+// newConsensusLagReport builds the report of the block timings, the blocks must not be empty.
+func newConsensusLagReport(blocks []blocktiming.BlockTimings) ConsensusLagReport {
+	report := ConsensusLagReport{
+		Blocks: len(blocks),
+		Recent: blocks,
+	}
+
+	var beginBlockTotal, deliverTxTotal, endBlockTotal time.Duration
+	subTimings := map[[2]string]*ModulePhaseTiming{}
+	subTimingsTotal := map[[2]string]time.Duration{}
+	for _, block := range blocks {
+		beginBlockTotal += block.BeginBlock
+		deliverTxTotal += block.DeliverTx
+		endBlockTotal += block.EndBlock
+		report.BeginBlock.Max = max(report.BeginBlock.Max, block.BeginBlock)
+		report.DeliverTx.Max = max(report.DeliverTx.Max, block.DeliverTx)
+		report.EndBlock.Max = max(report.EndBlock.Max, block.EndBlock)
+		if block.Total() > report.Slowest.Total() {
+			report.Slowest = block
+		}
+
+		for _, subTiming := range block.SubTimings {
+			key := [2]string{subTiming.Module, subTiming.Phase}
+			timing, ok := subTimings[key]
+			if !ok {
+				timing = &ModulePhaseTiming{Module: subTiming.Module, Phase: subTiming.Phase}
+				subTimings[key] = timing
+			}
+			timing.Max = max(timing.Max, subTiming.Duration)
+			subTimingsTotal[key] += subTiming.Duration
+		}
+	}
+
+	count := time.Duration(len(blocks))
+	total := beginBlockTotal + deliverTxTotal + endBlockTotal
+	report.BeginBlock = newPhaseTiming(beginBlockTotal, total, count, report.BeginBlock.Max)
+	report.DeliverTx = newPhaseTiming(deliverTxTotal, total, count, report.DeliverTx.Max)
+	report.EndBlock = newPhaseTiming(endBlockTotal, total, count, report.EndBlock.Max)
+
+	for key, timing := range subTimings {
+		// the average is computed over all the reported blocks to be comparable with the phases
+		timing.Average = subTimingsTotal[key] / count
+		report.SubTimings = append(report.SubTimings, *timing)
+	}
+	sort.Slice(report.SubTimings, func(i, j int) bool {
+		if report.SubTimings[i].Average != report.SubTimings[j].Average {
+			return report.SubTimings[i].Average > report.SubTimings[j].Average
+		}
+		if report.SubTimings[i].Module != report.SubTimings[j].Module {
+			return report.SubTimings[i].Module < report.SubTimings[j].Module
+		}
+		return report.SubTimings[i].Phase < report.SubTimings[j].Phase
+	})
+
+	return report
+}
+
+func newPhaseTiming(phaseTotal, total, count, maxDuration time.Duration) PhaseTiming {
+	timing := PhaseTiming{
+		Average: phaseTotal / count,
+		Max:     maxDuration,
+	}
+	if total > 0 {
+		timing.Share = float64(phaseTotal) / float64(total) * 100
+	}
+	return timing
+}
+
+func printConsensusLagReport(out io.Writer, report ConsensusLagReport) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Phases over %d blocks:\n", report.Blocks)
+	fmt.Fprintln(w, "PHASE\tAVERAGE\tMAX\tSHARE")
+	for _, phase := range []struct {
+		name   string
+		timing PhaseTiming
+	}{
+		{name: blocktiming.PhaseBeginBlock, timing: report.BeginBlock},
+		{name: blocktiming.PhaseDeliverTx, timing: report.DeliverTx},
+		{name: blocktiming.PhaseEndBlock, timing: report.EndBlock},
+	} {
+		fmt.Fprintf(
+			w, "%s\t%s\t%s\t%.1f%%\n",
+			phase.name, formatDuration(phase.timing.Average), formatDuration(phase.timing.Max), phase.timing.Share,
+		)
+	}
+
+	if len(report.SubTimings) > 0 {
+		fmt.Fprintln(w, "\nModule sub-timings:")
+		fmt.Fprintln(w, "MODULE\tPHASE\tAVERAGE\tMAX")
+		for _, timing := range report.SubTimings {
+			fmt.Fprintf(
+				w, "%s\t%s\t%s\t%s\n",
+				timing.Module, timing.Phase, formatDuration(timing.Average), formatDuration(timing.Max),
+			)
+		}
+	}
+
+	fmt.Fprintln(w, "\nRecent blocks:")
+	fmt.Fprintln(w, "HEIGHT\tTXS\tBEGIN_BLOCK\tDELIVER_TX\tEND_BLOCK\tTOTAL")
+	for _, block := range report.Recent {
+		fmt.Fprintf(
+			w, "%d\t%d\t%s\t%s\t%s\t%s\n",
+			block.Height, block.TxCount, formatDuration(block.BeginBlock), formatDuration(block.DeliverTx),
+			formatDuration(block.EndBlock), formatDuration(block.Total()),
+		)
+	}
+	fmt.Fprintf(w, "\nSlowest block: %d (%s)\n", report.Slowest.Height, formatDuration(report.Slowest.Total()))
+
+	return w.Flush()
+}
+
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
@@ -0,0 +1,69 @@
+package cosmoscmd
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/blocktiming"
+)
+
+type blockTimingsProviderMock []blocktiming.BlockTimings
+
+func (m blockTimingsProviderMock) RecentBlockTimings() []blocktiming.BlockTimings {
+	return m
+}
+
+func TestConsensusLagReport(t *testing.T) {
+	requireT := require.New(t)
+
+	blocks := blockTimingsProviderMock{
+		{
+			Height:     10,
+			TxCount:    1,
+			BeginBlock: time.Millisecond,
+			DeliverTx:  4 * time.Millisecond,
+			EndBlock:   5 * time.Millisecond,
+			SubTimings: []blocktiming.SubTiming{
+				{Module: "pse", Phase: "distribution", Duration: 4 * time.Millisecond},
+				{Module: "assetft", Phase: "buyback", Duration: time.Millisecond},
+			},
+		},
+		{
+			Height:     11,
+			BeginBlock: time.Millisecond,
+			DeliverTx:  2 * time.Millisecond,
+			EndBlock:   7 * time.Millisecond,
+			SubTimings: []blocktiming.SubTiming{
+				{Module: "assetft", Phase: "buyback", Duration: 5 * time.Millisecond},
+			},
+		},
+	}
+
+	hs := &healthServer{timings: blocks}
+	server := httptest.NewServer(hs.handler())
+	defer server.Close()
+
+	fetchedBlocks, err := fetchBlockTimings(context.Background(), server.URL)
+	requireT.NoError(err)
+	requireT.Len(fetchedBlocks, 2)
+
+	report := newConsensusLagReport(fetchedBlocks)
+	requireT.Equal(2, report.Blocks)
+	requireT.Equal(PhaseTiming{Average: time.Millisecond, Max: time.Millisecond, Share: 10}, report.BeginBlock)
+	requireT.Equal(PhaseTiming{Average: 3 * time.Millisecond, Max: 4 * time.Millisecond, Share: 30}, report.DeliverTx)
+	requireT.Equal(PhaseTiming{Average: 6 * time.Millisecond, Max: 7 * time.Millisecond, Share: 60}, report.EndBlock)
+	requireT.Equal([]ModulePhaseTiming{
+		{Module: "assetft", Phase: "buyback", Average: 3 * time.Millisecond, Max: 5 * time.Millisecond},
+		{Module: "pse", Phase: "distribution", Average: 2 * time.Millisecond, Max: 4 * time.Millisecond},
+	}, report.SubTimings)
+	requireT.Equal(int64(10), report.Slowest.Height)
+
+	// the timings aren't available if the app doesn't provide them
+	hs.timings = nil
+	_, err = fetchBlockTimings(context.Background(), server.URL)
+	requireT.Error(err)
+}
@@ -0,0 +1,155 @@
+package cosmoscmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tokenize-x/tx-chain/v7/app"
+)
+
+// FlagModules is the flag defining the modules to export.
+const FlagModules = "modules"
+
+// ExportCmd dumps the app state to JSON. It replaces the export command of the SDK to export the selected modules
+// only, to fail early with the helpful error if the height is pruned, and to stream the output instead of building
+// the whole genesis in memory.
+func ExportCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export state to JSON",
+		Long: fmt.Sprintf(`Export state to JSON.
+The state of all the modules is exported unless the modules are selected.
+
+Example:
+$ %s export --height 1000000 --modules assetft,pse --output-document genesis.json
+`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			if _, err := os.Stat(config.GenesisFile()); err != nil {
+				return errors.WithStack(err)
+			}
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+			jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+			modulesToExport, _ := cmd.Flags().GetStringSlice(FlagModules)
+			if len(modulesToExport) == 0 {
+				modulesToExport, _ = cmd.Flags().GetStringSlice(server.FlagModulesToExport)
+			}
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer db.Close() //nolint:errcheck // the export result doesn't depend on it
+
+			txApp, err := loadExportApp(serverCtx.Logger, db, height, serverCtx.Viper)
+			if err != nil {
+				return errors.Wrap(err, "error exporting state")
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+			// set current binary version
+			appGenesis.AppName = version.AppName
+			appGenesis.AppVersion = version.Version
+
+			var out io.Writer = cmd.OutOrStdout()
+			if outputDocument != "" {
+				f, err := os.Create(outputDocument)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				defer f.Close()
+				out = f
+			}
+
+			bufOut := bufio.NewWriter(out)
+			if err := txApp.StreamAppGenesis(
+				appGenesis, forZeroHeight, jailAllowedAddrs, modulesToExport, bufOut,
+			); err != nil {
+				return errors.Wrap(err, "error exporting state")
+			}
+			return errors.WithStack(bufOut.Flush())
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Bool(server.FlagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(
+		server.FlagJailAllowedAddrs, []string{},
+		"Comma-separated list of operator addresses of jailed validators to unjail",
+	)
+	cmd.Flags().StringSlice(
+		FlagModules, []string{},
+		"Comma-separated list of modules to export, e.g. assetft,pse. If empty, will export all modules",
+	)
+	cmd.Flags().StringSlice(server.FlagModulesToExport, []string{}, "Comma-separated list of modules to export")
+	if err := cmd.Flags().MarkDeprecated(server.FlagModulesToExport, "use --"+FlagModules+" instead"); err != nil {
+		panic(err)
+	}
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// loadExportApp creates a new app loaded at the given height, -1 means the latest height.
+func loadExportApp(
+	logger log.Logger,
+	db dbm.DB,
+	height int64,
+	appOpts servertypes.AppOptions,
+) (*app.App, error) {
+	// this check is necessary as we use the flag in x/upgrade.
+	// we can exit more gracefully by checking the flag here.
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return nil, errors.New("application home not set")
+	}
+
+	viperAppOpts, ok := appOpts.(*viper.Viper)
+	if !ok {
+		return nil, errors.New("appOpts is not viper.Viper")
+	}
+
+	// overwrite the FlagInvCheckPeriod
+	viperAppOpts.Set(server.FlagInvCheckPeriod, 1)
+	appOpts = viperAppOpts
+
+	if height == -1 {
+		return app.New(logger, db, nil, true, appOpts), nil
+	}
+
+	txApp := app.New(logger, db, nil, false, appOpts)
+	if err := txApp.CheckHeightExportable(db, height); err != nil {
+		return nil, err
+	}
+	if err := txApp.LoadHeight(height); err != nil {
+		return nil, err
+	}
+	return txApp, nil
+}
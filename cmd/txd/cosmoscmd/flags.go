@@ -16,6 +16,9 @@ import (
 	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
 )
 
+// FlagNetworkConfig is the flag of the JSON or YAML file with the config of the custom network.
+const FlagNetworkConfig = "network-config"
+
 // OverwriteDefaultChainIDFlags searches for the DefaultChainID flag and replaces its value of the current default.
 func OverwriteDefaultChainIDFlags(parentCmd *cobra.Command) {
 	for _, cmd := range parentCmd.Commands() {
@@ -37,8 +40,23 @@ func PreProcessFlags() (config.NetworkConfig, error) {
 	// Dummy flag to turn off printing usage of this flag set
 	help := flagSet.BoolP(flagHelp, "h", false, "")
 	chainID := flagSet.String(flags.FlagChainID, string(app.DefaultChainID), "The network chain ID")
+	networkConfigPath := flagSet.String(FlagNetworkConfig, "", "The file with the config of the custom network")
 	//nolint:errcheck // since we have set ExitOnError on flagset, we don't need to check for errors here
 	flagSet.Parse(os.Args[1:])
+	// register the custom network, its chain ID is used unless another one is set explicitly
+	if *networkConfigPath != "" {
+		customNetwork, err := config.LoadNetworkConfigFile(*networkConfigPath)
+		if err != nil {
+			return config.NetworkConfig{}, err
+		}
+		if err := config.RegisterNetworkConfig(customNetwork); err != nil {
+			return config.NetworkConfig{}, err
+		}
+		if !flagSet.Changed(flags.FlagChainID) {
+			*chainID = string(customNetwork.ChainID())
+			os.Args = append(os.Args, fmt.Sprintf("--%s=%s", flags.FlagChainID, *chainID))
+		}
+	}
 	// get chain config
 	network, err := config.NetworkConfigByChainID(constant.ChainID(*chainID))
 	if err != nil {
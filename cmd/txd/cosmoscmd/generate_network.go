@@ -23,6 +23,9 @@ const (
 
 	// FlagValidatorName defines a name of the validator.
 	FlagValidatorName = "validator-name"
+
+	// FlagAccountManifest defines a path of the manifest of the accounts funded in the genesis.
+	FlagAccountManifest = "account-manifest"
 )
 
 // GenerateGenesisCmd returns a cobra command that generates the gensis file, given an input config.
@@ -55,6 +58,22 @@ func GenerateGenesisCmd(basicManager module.BasicManager) *cobra.Command {
 				sdk.DefaultBondDenom = genCfg.Denom
 			}
 
+			manifestPath, err := cmd.Flags().GetString(FlagAccountManifest)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("failed to read %s flag", FlagAccountManifest))
+			}
+			if manifestPath != "" {
+				manifest, err := config.LoadAccountManifest(manifestPath)
+				if err != nil {
+					return err
+				}
+				balances, err := manifest.BankBalances(sdk.DefaultBondDenom, sdk.GetConfig().GetCoinType())
+				if err != nil {
+					return err
+				}
+				genCfg.BankBalances = append(genCfg.BankBalances, balances...)
+			}
+
 			genDoc, err := config.GenDocFromInput(ctx, genCfg, cosmosClientCtx, basicManager)
 			if err != nil {
 				return err
@@ -72,6 +91,7 @@ func GenerateGenesisCmd(basicManager module.BasicManager) *cobra.Command {
 	cmd.Flags().String(FlagOutputPath, "", "file path for the generated genesis file")
 	cmd.Flags().String(FlagInputPath, "", "file path for the input config file")
 	cmd.Flags().StringArray(FlagValidatorName, []string{}, "list of the validator names to generate")
+	cmd.Flags().String(FlagAccountManifest, "", "file path for the manifest of the accounts funded in the genesis")
 
 	return cmd
 }
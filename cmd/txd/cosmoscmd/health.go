@@ -0,0 +1,247 @@
+package cosmoscmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/blocktiming"
+)
+
+const (
+	// FlagHealthAddress is the flag of the address the health endpoint listens on.
+	FlagHealthAddress = "health.address"
+	// FlagHealthMaxBlockAge is the flag of the max age of the latest block for the node to be ready.
+	FlagHealthMaxBlockAge = "health.max-block-age"
+
+	blockTimingsPath = "/debug/block-timings"
+
+	healthReadHeaderTimeout = 5 * time.Second
+	healthShutdownTimeout   = 5 * time.Second
+)
+
+// nodeHealthChecker provides the health details of the app.
+type nodeHealthChecker interface {
+	CheckWasmVM() error
+	PendingUpgradePlan() (*upgradetypes.Plan, error)
+}
+
+// blockTimingsProvider provides the execution timings of the recent blocks.
+type blockTimingsProvider interface {
+	RecentBlockTimings() []blocktiming.BlockTimings
+}
+
+// statusClient provides the status of the comet node.
+type statusClient interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+}
+
+// HealthStatus is the status reported by the health endpoints.
+type HealthStatus struct {
+	Healthy           bool           `json:"healthy"`
+	Ready             bool           `json:"ready"`
+	CatchingUp        bool           `json:"catching_up"`
+	LatestBlockHeight int64          `json:"latest_block_height"`
+	LatestBlockTime   time.Time      `json:"latest_block_time"`
+	LatestBlockAge    string         `json:"latest_block_age"`
+	WasmVMHealthy     bool           `json:"wasm_vm_healthy"`
+	PendingUpgrade    *UpgradeStatus `json:"pending_upgrade,omitempty"`
+	Errors            []string       `json:"errors,omitempty"`
+}
+
+// UpgradeStatus describes the scheduled upgrade.
+type UpgradeStatus struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info,omitempty"`
+}
+
+// healthServer serves the liveness (/healthz) and readiness (/readyz) endpoints of the node, and the execution
+// timings of the recent blocks (/debug/block-timings).
+type healthServer struct {
+	app         nodeHealthChecker
+	timings     blockTimingsProvider
+	status      statusClient
+	maxBlockAge time.Duration
+	now         func() time.Time
+}
+
+func addHealthFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(
+		FlagHealthAddress,
+		"",
+		"The address the /healthz, /readyz and /debug/block-timings endpoints listen on, e.g. 0.0.0.0:26659, "+
+			"the endpoints are disabled if empty",
+	)
+	startCmd.Flags().Duration(
+		FlagHealthMaxBlockAge,
+		time.Minute,
+		"The max age of the latest block for the node to be reported as ready",
+	)
+}
+
+// healthAppCreator returns the app creator keeping the reference to the created app for the health server.
+func healthAppCreator(appCreator servertypes.AppCreator, hs *healthServer) servertypes.AppCreator {
+	return func(
+		logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions,
+	) servertypes.Application {
+		createdApp := appCreator(logger, db, traceStore, appOpts)
+		if checker, ok := createdApp.(nodeHealthChecker); ok {
+			hs.app = checker
+		}
+		if timings, ok := createdApp.(blockTimingsProvider); ok {
+			hs.timings = timings
+		}
+		return createdApp
+	}
+}
+
+// startHealthServer starts the health server if its address is configured. The server is stopped together with
+// the node.
+func (hs *healthServer) startHealthServer(
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	ctx context.Context,
+	g *errgroup.Group,
+) error {
+	address := svrCtx.Viper.GetString(FlagHealthAddress)
+	if address == "" {
+		return nil
+	}
+	if hs.app == nil {
+		return errors.New("app doesn't provide the health details")
+	}
+
+	hs.maxBlockAge = svrCtx.Viper.GetDuration(FlagHealthMaxBlockAge)
+	hs.now = time.Now
+	if clientCtx.Client != nil {
+		hs.status = clientCtx.Client
+	} else {
+		rpcClient, err := client.NewClientFromNode(svrCtx.Config.RPC.ListenAddress)
+		if err != nil {
+			return errors.Wrap(err, "failed to create comet RPC client")
+		}
+		hs.status = rpcClient
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on health address %s", address)
+	}
+	httpServer := &http.Server{
+		Handler:           hs.handler(),
+		ReadHeaderTimeout: healthReadHeaderTimeout,
+	}
+
+	g.Go(func() error {
+		svrCtx.Logger.Info("starting health server", "address", address)
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return errors.Wrap(err, "health server failed")
+		}
+		return nil
+	})
+	g.Go(func() error {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), healthShutdownTimeout)
+		defer cancel()
+		return httpServer.Shutdown(shutdownCtx) //nolint:contextcheck // the node context is already canceled
+	})
+
+	return nil
+}
+
+func (hs *healthServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		status := hs.checkHealth(r.Context())
+		writeHealthStatus(w, status, status.Healthy)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		status := hs.checkHealth(r.Context())
+		writeHealthStatus(w, status, status.Ready)
+	})
+	mux.HandleFunc(blockTimingsPath, func(w http.ResponseWriter, r *http.Request) {
+		if hs.timings == nil {
+			http.Error(w, "app doesn't provide the block timings", http.StatusNotImplemented)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		//nolint:errchkjson,errcheck // the timings can't be returned if writing the response fails
+		json.NewEncoder(w).Encode(hs.timings.RecentBlockTimings())
+	})
+
+	return mux
+}
+
+// checkHealth collects the health status. The node is healthy if both comet and the wasm VM respond, and it is
+// ready if it is healthy, isn't catching up and the latest block is not older than the max block age.
+func (hs *healthServer) checkHealth(ctx context.Context) HealthStatus {
+	var status HealthStatus
+
+	cometHealthy := false
+	nodeStatus, err := hs.status.Status(ctx)
+	if err != nil {
+		status.Errors = append(status.Errors, "failed to get node status: "+err.Error())
+	} else {
+		cometHealthy = true
+		status.CatchingUp = nodeStatus.SyncInfo.CatchingUp
+		status.LatestBlockHeight = nodeStatus.SyncInfo.LatestBlockHeight
+		status.LatestBlockTime = nodeStatus.SyncInfo.LatestBlockTime
+	}
+
+	if err := hs.app.CheckWasmVM(); err != nil {
+		status.Errors = append(status.Errors, err.Error())
+	} else {
+		status.WasmVMHealthy = true
+	}
+
+	plan, err := hs.app.PendingUpgradePlan()
+	switch {
+	case err != nil:
+		status.Errors = append(status.Errors, "failed to get pending upgrade: "+err.Error())
+	case plan != nil:
+		status.PendingUpgrade = &UpgradeStatus{
+			Name:   plan.Name,
+			Height: plan.Height,
+			Info:   plan.Info,
+		}
+	}
+
+	status.Healthy = cometHealthy && status.WasmVMHealthy
+	status.Ready = status.Healthy && !status.CatchingUp
+	if cometHealthy {
+		blockAge := hs.now().Sub(status.LatestBlockTime)
+		status.LatestBlockAge = blockAge.Round(time.Millisecond).String()
+		if blockAge > hs.maxBlockAge {
+			status.Ready = false
+			status.Errors = append(status.Errors, "latest block is older than "+hs.maxBlockAge.String())
+		}
+	}
+
+	return status
+}
+
+func writeHealthStatus(w http.ResponseWriter, status HealthStatus, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if ok {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	//nolint:errchkjson,errcheck // the status can't be returned if writing the response fails
+	json.NewEncoder(w).Encode(status)
+}
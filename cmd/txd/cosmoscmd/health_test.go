@@ -0,0 +1,124 @@
+package cosmoscmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type healthCheckerMock struct {
+	wasmVMErr error
+	plan      *upgradetypes.Plan
+}
+
+func (m healthCheckerMock) CheckWasmVM() error {
+	return m.wasmVMErr
+}
+
+func (m healthCheckerMock) PendingUpgradePlan() (*upgradetypes.Plan, error) {
+	return m.plan, nil
+}
+
+type statusClientMock struct {
+	status *coretypes.ResultStatus
+	err    error
+}
+
+func (m statusClientMock) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return m.status, m.err
+}
+
+func TestHealthServer(t *testing.T) {
+	now := time.Now()
+	plan := &upgradetypes.Plan{Name: "v7", Height: 100}
+
+	testCases := []struct {
+		name          string
+		checker       healthCheckerMock
+		status        statusClientMock
+		expectHealthy bool
+		expectReady   bool
+	}{
+		{
+			name:    "ready",
+			checker: healthCheckerMock{plan: plan},
+			status: statusClientMock{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+				LatestBlockHeight: 10,
+				LatestBlockTime:   now.Add(-time.Second),
+			}}},
+			expectHealthy: true,
+			expectReady:   true,
+		},
+		{
+			name:    "catching_up",
+			checker: healthCheckerMock{},
+			status: statusClientMock{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+				LatestBlockTime: now.Add(-time.Second),
+				CatchingUp:      true,
+			}}},
+			expectHealthy: true,
+		},
+		{
+			name:    "old_block",
+			checker: healthCheckerMock{},
+			status: statusClientMock{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+				LatestBlockTime: now.Add(-2 * time.Minute),
+			}}},
+			expectHealthy: true,
+		},
+		{
+			name:    "wasm_vm_failure",
+			checker: healthCheckerMock{wasmVMErr: errors.New("vm failure")},
+			status: statusClientMock{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+				LatestBlockTime: now.Add(-time.Second),
+			}}},
+		},
+		{
+			name:    "node_failure",
+			checker: healthCheckerMock{},
+			status:  statusClientMock{err: errors.New("node failure")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+
+			hs := &healthServer{
+				app:         tc.checker,
+				status:      tc.status,
+				maxBlockAge: time.Minute,
+				now:         func() time.Time { return now },
+			}
+			handler := hs.handler()
+
+			for path, expected := range map[string]bool{
+				"/healthz": tc.expectHealthy,
+				"/readyz":  tc.expectReady,
+			} {
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+				if expected {
+					requireT.Equal(http.StatusOK, rec.Code, path)
+				} else {
+					requireT.Equal(http.StatusServiceUnavailable, rec.Code, path)
+				}
+
+				var status HealthStatus
+				requireT.NoError(json.Unmarshal(rec.Body.Bytes(), &status))
+				requireT.Equal(tc.expectHealthy, status.Healthy)
+				requireT.Equal(tc.expectReady, status.Ready)
+				requireT.Equal(tc.checker.plan != nil, status.PendingUpgrade != nil)
+				requireT.Equal(!tc.expectReady, len(status.Errors) > 0 || status.CatchingUp)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package cosmoscmd
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	txkeyring "github.com/tokenize-x/tx-chain/v7/pkg/keyring"
+)
+
+const (
+	// FlagEncryptedArchive is the flag encrypting the exported key archive with the passphrase.
+	FlagEncryptedArchive = "encrypted-archive"
+	// FlagVerifyOnly is the flag verifying the key archive without importing the keys.
+	FlagVerifyOnly = "verify-only"
+)
+
+// ExportBulkKeysCmd exports the keys of the keyring to the archive.
+func ExportBulkKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-bulk [archive-file] [key-names...]",
+		Short: "Export keys to the archive",
+		Long: `Exports the keys of the keyring to the archive file.
+When key names are provided, only these keys are exported, otherwise all the keys are exported.
+
+The local keys are exported with their private keys, so they are accepted only when the archive is encrypted with
+the passphrase using the --encrypted-archive flag. The ledger keys are exported as the derivation paths on the device,
+and the offline and multisig keys as their public keys.
+
+Usage:
+  txd keys export-bulk keys.json --encrypted-archive           # export all keys
+  txd keys export-bulk keys.json alice bob --encrypted-archive # export only alice and bob`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: runExportBulkKeys,
+	}
+	cmd.Flags().Bool(FlagEncryptedArchive, false, "Encrypt the archive with the passphrase")
+
+	return cmd
+}
+
+// ImportBulkKeysCmd imports the keys from the archive to the keyring.
+func ImportBulkKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-bulk [archive-file]",
+		Short: "Import keys from the archive",
+		Long: `Imports the keys from the archive file created by the export-bulk command.
+The checksum of the archive and the address of each imported key are verified. The keys whose names already exist
+in the keyring are skipped. The ledger keys are derived by the connected device.
+
+Usage:
+  txd keys import-bulk keys.json               # import all keys
+  txd keys import-bulk keys.json --verify-only # verify the archive only`,
+		Args: cobra.ExactArgs(1),
+		RunE: runImportBulkKeys,
+	}
+	cmd.Flags().Bool(FlagVerifyOnly, false, "Verify the archive without importing the keys")
+
+	return cmd
+}
+
+func runExportBulkKeys(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	encrypted, err := cmd.Flags().GetBool(FlagEncryptedArchive)
+	if err != nil {
+		return err
+	}
+
+	keys, err := txkeyring.ExportKeys(clientCtx.Keyring, clientCtx.Codec, args[1:], encrypted)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		cmd.Println("No keys found in the keyring.")
+		return nil
+	}
+
+	var passphrase string
+	if encrypted {
+		buf := bufio.NewReader(clientCtx.Input)
+		passphrase, err = input.GetPassword("Enter passphrase to encrypt the archive:", buf)
+		if err != nil {
+			return err
+		}
+		repeated, err := input.GetPassword("Repeat the passphrase:", buf)
+		if err != nil {
+			return err
+		}
+		if passphrase != repeated {
+			return errors.New("passphrases don't match")
+		}
+	}
+
+	archive, err := txkeyring.WriteArchive(keys, passphrase)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(args[0], archive, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write archive %q", args[0])
+	}
+
+	cmd.Printf("Exported %d key(s) to %q.\n", len(keys), args[0])
+	return nil
+}
+
+func runImportBulkKeys(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	verifyOnly, err := cmd.Flags().GetBool(FlagVerifyOnly)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return errors.Wrapf(err, "failed to read archive %q", args[0])
+	}
+
+	var passphrase string
+	if txkeyring.IsArchiveEncrypted(data) {
+		passphrase, err = input.GetPassword("Enter passphrase to decrypt the archive:", bufio.NewReader(clientCtx.Input))
+		if err != nil {
+			return err
+		}
+	}
+
+	keys, err := txkeyring.ReadArchive(data, passphrase)
+	if err != nil {
+		return err
+	}
+	if verifyOnly {
+		for _, key := range keys {
+			cmd.Printf("  %s (%s): %s\n", key.Name, key.Type, key.Address)
+		}
+		cmd.Printf("Archive %q is valid and contains %d key(s).\n", args[0], len(keys))
+		return nil
+	}
+
+	imported := 0
+	for _, key := range keys {
+		if _, err := clientCtx.Keyring.Key(key.Name); err == nil {
+			cmd.Printf("  Skipping %q (already exists)\n", key.Name)
+			continue
+		}
+
+		if err := txkeyring.ImportKey(clientCtx.Keyring, clientCtx.Codec, key); err != nil {
+			cmd.PrintErrf("  Warning: could not import %q: %v\n", key.Name, err)
+			continue
+		}
+
+		imported++
+	}
+
+	cmd.Printf("Imported %d of %d key(s) from %q.\n", imported, len(keys), args[0])
+	return nil
+}
@@ -0,0 +1,190 @@
+package cosmoscmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"regexp"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/yaml"
+
+	labeltypes "github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+const (
+	// FlagWithLabels is the flag annotating the known addresses in the query output with their labels.
+	FlagWithLabels = "with-labels"
+
+	addressLabelsField = "address_labels"
+	labelsPageLimit    = 100
+)
+
+// addressLabelsQueryClient provides the labels registered in the label module.
+type addressLabelsQueryClient interface {
+	AddressLabels(
+		ctx context.Context, in *labeltypes.QueryAddressLabelsRequest, opts ...grpc.CallOption,
+	) (*labeltypes.QueryAddressLabelsResponse, error)
+}
+
+// installAddressLabelsWrapper adds the --with-labels flag to the query command and wraps the RunE function of all
+// the leaf commands, so the addresses found in the output are annotated with their labels.
+func installAddressLabelsWrapper(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(
+		FlagWithLabels,
+		false,
+		"Annotate the addresses in the output with their labels registered in the label module",
+	)
+
+	cmds := []*cobra.Command{cmd}
+	for len(cmds) > 0 {
+		cmd := cmds[len(cmds)-1]
+		cmds = cmds[:len(cmds)-1]
+		cmds = append(cmds, cmd.Commands()...)
+
+		if cmd.HasSubCommands() || cmd.RunE == nil {
+			continue
+		}
+
+		originalRunE := cmd.RunE
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			withLabels, err := cmd.Flags().GetBool(FlagWithLabels)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if !withLabels {
+				return originalRunE(cmd, args)
+			}
+
+			// Capture the output produced by the original command handler.
+			originalOutput := cmd.OutOrStdout()
+			buf := &bytes.Buffer{}
+			clientCtx := client.GetClientContextFromCmd(cmd).WithOutput(buf)
+			cmd.SetOut(buf)
+			if err := client.SetCmdClientContext(cmd, clientCtx); err != nil {
+				return errors.WithStack(err)
+			}
+
+			runErr := originalRunE(cmd, args)
+			cmd.SetOut(originalOutput)
+			if runErr != nil {
+				originalOutput.Write(buf.Bytes()) //nolint:errcheck // the error of the command is returned anyway
+				return runErr
+			}
+
+			queryClientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			output, err := annotateAddressLabels(
+				cmd.Context(), labeltypes.NewQueryClient(queryClientCtx), buf.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = originalOutput.Write(output)
+			return errors.WithStack(err)
+		}
+	}
+}
+
+// annotateAddressLabels appends the labels of the account addresses found in the output. The labels are added as
+// the extra field of the JSON object, or as the extra YAML entry if the output is printed in the text format.
+func annotateAddressLabels(ctx context.Context, queryClient addressLabelsQueryClient, output []byte) ([]byte, error) {
+	addresses := findAccountAddresses(output)
+	if len(addresses) == 0 {
+		return output, nil
+	}
+
+	labels, err := queryAddressLabels(ctx, queryClient)
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]string{}
+	for _, address := range addresses {
+		if name, ok := labels[address]; ok {
+			found[address] = name
+		}
+	}
+	if len(found) == 0 {
+		return output, nil
+	}
+
+	trimmedOutput := bytes.TrimSpace(output)
+	if bytes.HasPrefix(trimmedOutput, []byte("{")) && bytes.HasSuffix(trimmedOutput, []byte("}")) {
+		foundJSON, err := json.Marshal(found)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		body := bytes.TrimSpace(trimmedOutput[1 : len(trimmedOutput)-1])
+		annotated := bytes.NewBufferString("{")
+		if len(body) > 0 {
+			annotated.Write(body)
+			annotated.WriteString(",")
+		}
+		annotated.WriteString(`"` + addressLabelsField + `":`)
+		annotated.Write(foundJSON)
+		annotated.WriteString("}\n")
+		return annotated.Bytes(), nil
+	}
+
+	foundYAML, err := yaml.Marshal(map[string]map[string]string{addressLabelsField: found})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	annotated := bytes.NewBuffer(bytes.TrimRight(output, "\n"))
+	if annotated.Len() > 0 {
+		annotated.WriteString("\n")
+	}
+	annotated.Write(foundYAML)
+	return annotated.Bytes(), nil
+}
+
+// findAccountAddresses returns the unique valid account addresses found in the output in the order of appearance.
+func findAccountAddresses(output []byte) []string {
+	addressRegex := regexp.MustCompile(
+		`\b` + regexp.QuoteMeta(sdk.GetConfig().GetBech32AccountAddrPrefix()) + `1[02-9ac-hj-np-z]+\b`,
+	)
+
+	addresses := make([]string, 0)
+	seen := map[string]struct{}{}
+	for _, match := range addressRegex.FindAll(output, -1) {
+		address := string(match)
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
+
+// queryAddressLabels returns the names of all the labeled addresses.
+func queryAddressLabels(ctx context.Context, queryClient addressLabelsQueryClient) (map[string]string, error) {
+	labels := map[string]string{}
+	pageReq := &query.PageRequest{Limit: labelsPageLimit}
+	for {
+		res, err := queryClient.AddressLabels(ctx, &labeltypes.QueryAddressLabelsRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query address labels")
+		}
+		for _, label := range res.Labels {
+			labels[label.Address] = label.Name
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return labels, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: labelsPageLimit}
+	}
+}
@@ -0,0 +1,74 @@
+package cosmoscmd
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	labeltypes "github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+type labelQueryClientMock struct {
+	labels []labeltypes.AddressLabel
+}
+
+func (m labelQueryClientMock) AddressLabels(
+	_ context.Context, req *labeltypes.QueryAddressLabelsRequest, _ ...grpc.CallOption,
+) (*labeltypes.QueryAddressLabelsResponse, error) {
+	// the labels are returned one per page to test the pagination
+	index := 0
+	if len(req.Pagination.Key) > 0 {
+		index = int(req.Pagination.Key[0])
+	}
+	res := &labeltypes.QueryAddressLabelsResponse{
+		Labels:     m.labels[index : index+1],
+		Pagination: &query.PageResponse{},
+	}
+	if index+1 < len(m.labels) {
+		res.Pagination.NextKey = []byte{byte(index + 1)}
+	}
+	return res, nil
+}
+
+func TestAnnotateAddressLabels(t *testing.T) {
+	requireT := require.New(t)
+
+	exchange := sdk.AccAddress("exchange____________").String()
+	clearing := sdk.AccAddress("clearing____________").String()
+	unknown := sdk.AccAddress("unknown_____________").String()
+	queryClient := labelQueryClientMock{labels: []labeltypes.AddressLabel{
+		{Address: exchange, Name: "Exchange hot wallet", Category: "exchange"},
+		{Address: clearing, Name: "Clearing account"},
+	}}
+
+	// the labels are added to the JSON object
+	output, err := annotateAddressLabels(context.Background(), queryClient, []byte(
+		`{"from":"`+exchange+`","to":"`+unknown+`","other":"`+exchange+`"}`+"\n",
+	))
+	requireT.NoError(err)
+	requireT.JSONEq(
+		`{"from":"`+exchange+`","to":"`+unknown+`","other":"`+exchange+`",`+
+			`"address_labels":{"`+exchange+`":"Exchange hot wallet"}}`,
+		string(output),
+	)
+
+	// the labels are added as the YAML entry
+	output, err = annotateAddressLabels(context.Background(), queryClient, []byte(
+		"accounts:\n- "+clearing+"\n- "+exchange+"\n",
+	))
+	requireT.NoError(err)
+	requireT.YAMLEq(
+		"accounts:\n- "+clearing+"\n- "+exchange+"\naddress_labels:\n  "+
+			clearing+": Clearing account\n  "+exchange+": Exchange hot wallet\n",
+		string(output),
+	)
+
+	// the output is not modified if there are no labeled addresses
+	output, err = annotateAddressLabels(context.Background(), queryClient, []byte(`{"to":"`+unknown+`"}`))
+	requireT.NoError(err)
+	requireT.Equal(`{"to":"`+unknown+`"}`, string(output))
+}
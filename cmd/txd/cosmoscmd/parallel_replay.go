@@ -0,0 +1,258 @@
+package cosmoscmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	coreheader "cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/app"
+	"github.com/tokenize-x/tx-chain/v7/pkg/occ"
+)
+
+// FlagParallelReplayWorkers is the flag of the number of the workers of the parallel executor.
+const FlagParallelReplayWorkers = "workers"
+
+// ParallelReplayReport is the result of the replay of the blocks with the sequential and the parallel executors.
+type ParallelReplayReport struct {
+	Blocks     []ParallelReplayBlock `json:"blocks"`
+	Txs        int                   `json:"txs"`
+	Conflicts  int                   `json:"conflicts"`
+	Sequential time.Duration         `json:"sequential"`
+	Parallel   time.Duration         `json:"parallel"`
+	// Failed is the number of the transactions failed by the sequential executor.
+	Failed int `json:"failed"`
+	// Mismatches is the number of the transactions whose results differ between the executors.
+	Mismatches int `json:"mismatches"`
+}
+
+// ParallelReplayBlock is the result of the replay of the block.
+type ParallelReplayBlock struct {
+	Height     int64         `json:"height"`
+	Txs        int           `json:"txs"`
+	Conflicts  int           `json:"conflicts"`
+	Sequential time.Duration `json:"sequential"`
+	Parallel   time.Duration `json:"parallel"`
+	// Failed is the number of the transactions failed by the sequential executor.
+	Failed int `json:"failed"`
+	// MismatchedTxs are the indexes of the transactions whose results differ between the executors.
+	MismatchedTxs []int `json:"mismatched_txs,omitempty"`
+}
+
+// ParallelReplayCmd returns the command replaying the stored blocks with the sequential and the parallel executors
+// and comparing the results.
+func ParallelReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "parallel-replay [from-height] [to-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Replay the stored blocks with the parallel executor and compare the results with the sequential one",
+		Long: fmt.Sprintf(`Replay the stored blocks with the parallel executor and compare the results with the sequential one.
+The transactions of each block are executed against the state committed at the previous height, once one by one and
+once in parallel with the optimistic concurrency control, and the results of the transactions, including the events,
+are compared. The begin and end blockers are not executed, so the results may differ from the ones of the node, but
+both executors see the same state. Nothing is written to the node databases.
+The command opens the databases of the node, so the node must be stopped, and the state of the previous heights must
+not be pruned.
+
+Example:
+$ %s debug parallel-replay 1000000 1000100 --%s 8
+`, version.AppName, FlagParallelReplayWorkers),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid from height %q", args[0])
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid to height %q", args[1])
+			}
+			if fromHeight < 2 || toHeight < fromHeight {
+				return errors.Errorf("invalid height range %d-%d", fromHeight, toHeight)
+			}
+			workers, err := cmd.Flags().GetInt(FlagParallelReplayWorkers)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+			// the signatures are verified by the ante handler, the prefetch done for the node blocks is not used
+			serverCtx.Viper.Set(app.SigVerifyParallelAppOption, false)
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			blockStore := cmtstore.NewBlockStore(blockStoreDB)
+			defer blockStore.Close() //nolint:errcheck // the replay result doesn't depend on it
+			if fromHeight < blockStore.Base() || toHeight > blockStore.Height() {
+				return errors.Errorf(
+					"blocks %d-%d are not in the block store, available blocks: %d-%d",
+					fromHeight, toHeight, blockStore.Base(), blockStore.Height(),
+				)
+			}
+
+			appDB, err := dbm.NewDB(
+				"application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"),
+			)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			defer appDB.Close() //nolint:errcheck // the replay result doesn't depend on it
+
+			txApp := app.New(serverCtx.Logger, appDB, nil, true, serverCtx.Viper)
+			if latest := txApp.LastBlockHeight(); toHeight > latest+1 {
+				return errors.Errorf("state of height %d is not committed, latest height: %d", toHeight-1, latest)
+			}
+
+			report := ParallelReplayReport{}
+			for height := fromHeight; height <= toHeight; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return errors.Errorf("block %d is not in the block store", height)
+				}
+				blockReport, err := replayTxs(
+					blockContextProvider(txApp, block), block.Txs.ToSliceOfBytes(), txApp.DeliverTxOnBranch, workers,
+				)
+				if err != nil {
+					return err
+				}
+				blockReport.Height = height
+				report.add(blockReport)
+			}
+
+			if output == flags.OutputFormatJSON {
+				err = json.NewEncoder(cmd.OutOrStdout()).Encode(report)
+			} else {
+				err = printParallelReplayReport(cmd.OutOrStdout(), report)
+			}
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if report.Mismatches > 0 {
+				return errors.Errorf("results of %d transactions differ between the executors", report.Mismatches)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Int(FlagParallelReplayWorkers, 0, "The number of the workers of the parallel executor, GOMAXPROCS if 0")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+func (r *ParallelReplayReport) add(block ParallelReplayBlock) {
+	r.Blocks = append(r.Blocks, block)
+	r.Txs += block.Txs
+	r.Failed += block.Failed
+	r.Conflicts += block.Conflicts
+	r.Sequential += block.Sequential
+	r.Parallel += block.Parallel
+	r.Mismatches += len(block.MismatchedTxs)
+}
+
+// blockContextProvider returns the function creating the context of the block on the new branch of the state
+// committed at the previous height.
+func blockContextProvider(txApp *app.App, block *cmttypes.Block) func() (sdk.Context, error) {
+	return func() (sdk.Context, error) {
+		ms, err := txApp.CommitMultiStore().CacheMultiStoreWithVersion(block.Height - 1)
+		if err != nil {
+			return sdk.Context{}, errors.Wrapf(err, "failed to load the state of height %d", block.Height-1)
+		}
+
+		ctx := txApp.NewUncachedContext(false, *block.Header.ToProto()).
+			WithMultiStore(ms).
+			WithHeaderHash(block.Hash()).
+			WithHeaderInfo(coreheader.Info{
+				ChainID: block.ChainID,
+				Height:  block.Height,
+				Time:    block.Time,
+				Hash:    block.Hash(),
+				AppHash: block.AppHash,
+			}).
+			WithExecMode(sdk.ExecModeFinalize).
+			WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+		return ctx.WithConsensusParams(txApp.GetConsensusParams(ctx)), nil
+	}
+}
+
+// replayTxs executes the transactions with the sequential and the parallel executor, each on its own context
+// returned by newCtx, and compares the results.
+func replayTxs(
+	newCtx func() (sdk.Context, error),
+	txs [][]byte,
+	deliver occ.DeliverTxFunc,
+	workers int,
+) (ParallelReplayBlock, error) {
+	seqCtx, err := newCtx()
+	if err != nil {
+		return ParallelReplayBlock{}, err
+	}
+	parCtx, err := newCtx()
+	if err != nil {
+		return ParallelReplayBlock{}, err
+	}
+
+	seqResults, seqStats := occ.SequentialExecutor{}.Execute(seqCtx, txs, deliver)
+	parResults, parStats := occ.NewParallelExecutor(workers).Execute(parCtx, txs, deliver)
+
+	block := ParallelReplayBlock{
+		Txs:        len(txs),
+		Conflicts:  parStats.Conflicts,
+		Sequential: seqStats.Duration,
+		Parallel:   parStats.Duration,
+	}
+	for i := range seqResults {
+		if seqResults[i].Code != 0 {
+			block.Failed++
+		}
+		if !reflect.DeepEqual(seqResults[i], parResults[i]) {
+			block.MismatchedTxs = append(block.MismatchedTxs, i)
+		}
+	}
+
+	return block, nil
+}
+
+func printParallelReplayReport(out io.Writer, report ParallelReplayReport) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "HEIGHT\tTXS\tFAILED\tCONFLICTS\tSEQUENTIAL\tPARALLEL\tMISMATCHES")
+	for _, block := range report.Blocks {
+		fmt.Fprintf(
+			w, "%d\t%d\t%d\t%d\t%s\t%s\t%d\n",
+			block.Height, block.Txs, block.Failed, block.Conflicts, block.Sequential, block.Parallel,
+			len(block.MismatchedTxs),
+		)
+	}
+	fmt.Fprintf(
+		w, "TOTAL\t%d\t%d\t%d\t%s\t%s\t%d\n",
+		report.Txs, report.Failed, report.Conflicts, report.Sequential, report.Parallel, report.Mismatches,
+	)
+
+	return w.Flush()
+}
@@ -0,0 +1,143 @@
+package cosmoscmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdknetwork "github.com/cosmos/cosmos-sdk/testutil/network"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/app"
+	txchainclitestutil "github.com/tokenize-x/tx-chain/v7/testutil/cli"
+	"github.com/tokenize-x/tx-chain/v7/testutil/network"
+)
+
+// TestParallelReplayCmd replays the blocks produced and exported by the node with both executors.
+func TestParallelReplayCmd(t *testing.T) {
+	requireT := require.New(t)
+
+	// the application database is kept on disk, next to the block store, the way the node stores it
+	cfg := network.DefaultConfig(t)
+	cfg.CleanupDir = false
+	cfg.AppConstructor = func(val sdknetwork.ValidatorI) servertypes.Application {
+		appDB, err := dbm.NewDB(
+			"application", dbm.GoLevelDBBackend, filepath.Join(val.GetCtx().Config.RootDir, "data"),
+		)
+		requireT.NoError(err)
+		return app.New(
+			val.GetCtx().Logger,
+			appDB,
+			nil,
+			true,
+			simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+			baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(cfg.ChainID),
+		)
+	}
+	testNetwork, err := sdknetwork.New(t, t.TempDir(), cfg)
+	requireT.NoError(err)
+	// the node is stopped before the replay, since the replay opens its databases
+	var cleanupOnce sync.Once
+	cleanup := func() { cleanupOnce.Do(testNetwork.Cleanup) }
+	t.Cleanup(cleanup)
+
+	val := testNetwork.Validators[0]
+	const transfers = 3
+	for range transfers {
+		recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		bankTx := bankcli.NewTxCmd(addresscodec.NewBech32Codec(app.ChosenNetwork.Provider.GetAddressPrefix()))
+		_, err := txchainclitestutil.ExecTxCmd(val.ClientCtx, testNetwork, bankTx, []string{
+			"send", val.Address.String(), recipient.String(), "100" + cfg.BondDenom,
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, "12345"+cfg.BondDenom),
+		})
+		requireT.NoError(err)
+	}
+	toHeight, err := testNetwork.LatestHeight()
+	requireT.NoError(err)
+	cleanup()
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Logger = log.NewNopLogger()
+	serverCtx.Viper.Set(flags.FlagHome, val.Ctx.Config.RootDir)
+	cmd := ParallelReplayCmd()
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{
+		"2", fmt.Sprint(toHeight),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, val.Ctx.Config.RootDir),
+		fmt.Sprintf("--%s=%s", flags.FlagOutput, flags.OutputFormatJSON),
+	})
+	requireT.NoError(cmd.ExecuteContext(context.WithValue(context.Background(), server.ServerContextKey, serverCtx)))
+
+	var report ParallelReplayReport
+	requireT.NoError(json.Unmarshal(out.Bytes(), &report))
+	requireT.Len(report.Blocks, int(toHeight-1))
+	requireT.Equal(transfers, report.Txs)
+	requireT.Zero(report.Failed)
+	requireT.Zero(report.Mismatches)
+}
+
+func TestReplayTxs(t *testing.T) {
+	requireT := require.New(t)
+
+	storeKey := storetypes.NewKVStoreKey("replay")
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	requireT.NoError(cms.LoadLatestVersion())
+	newCtx := func() (sdk.Context, error) {
+		return sdk.NewContext(cms.CacheMultiStore(), tmproto.Header{}, false, log.NewNopLogger()), nil
+	}
+
+	// each transaction increments the shared counter kept in the store
+	deliverStored := func(ctx sdk.Context, txBytes []byte) *abci.ExecTxResult {
+		kvStore := ctx.KVStore(storeKey)
+		counter := len(kvStore.Get([]byte("counter")))
+		kvStore.Set([]byte("counter"), make([]byte, counter+1))
+		kvStore.Set(txBytes, []byte{0x01})
+		return &abci.ExecTxResult{Data: []byte(fmt.Sprint(counter))}
+	}
+	txs := [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}
+
+	block, err := replayTxs(newCtx, txs, deliverStored, 4)
+	requireT.NoError(err)
+	requireT.Equal(3, block.Txs)
+	requireT.Empty(block.MismatchedTxs)
+
+	// the counter kept in memory is not tracked by the parallel executor, so the results differ
+	var counter int
+	deliverInMemory := func(ctx sdk.Context, txBytes []byte) *abci.ExecTxResult {
+		ctx.KVStore(storeKey).Set(txBytes, []byte{0x01})
+		counter++
+		return &abci.ExecTxResult{Data: []byte(fmt.Sprint(counter))}
+	}
+
+	block, err = replayTxs(newCtx, txs, deliverInMemory, 1)
+	requireT.NoError(err)
+	requireT.Equal([]int{0, 1, 2}, block.MismatchedTxs)
+}
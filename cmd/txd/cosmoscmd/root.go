@@ -7,6 +7,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"cosmossdk.io/log"
@@ -37,15 +38,18 @@ import (
 	tx "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	rosettaCmd "github.com/cosmos/rosetta/cmd"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	"github.com/spf13/viper"
 
 	"github.com/tokenize-x/tx-chain/v7/app"
+	"github.com/tokenize-x/tx-chain/v7/pkg/audit"
 	txchainclient "github.com/tokenize-x/tx-chain/v7/pkg/client"
 	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+	assetftcli "github.com/tokenize-x/tx-chain/v7/x/asset/ft/client/cli"
+	psecli "github.com/tokenize-x/tx-chain/v7/x/pse/client/cli"
 )
 
 const ledgerAppName = "Coreum"
@@ -134,10 +138,13 @@ func NewRootCmd() *cobra.Command {
 	}
 
 	for _, cmd := range rootCmd.Commands() {
-		if cmd.Use == "tx" {
+		switch cmd.Use {
+		case "tx":
+			addDraftProposalCmds(cmd)
 			installAwaitBroadcastModeWrapper(cmd)
 			addQueryGasPriceToAllLeaves(cmd)
-			break
+		case "query":
+			installAddressLabelsWrapper(cmd)
 		}
 	}
 
@@ -185,9 +192,33 @@ func initAppConfig() (string, interface{}) {
 		MemoryCacheSize uint32
 	}
 
+	// AuditConfig defines the configuration of the audit log of the state writes.
+	type AuditConfig struct {
+		Enabled     bool   `mapstructure:"enabled"`
+		Stores      string `mapstructure:"stores"`
+		Dir         string `mapstructure:"dir"`
+		MaxFileSize int64  `mapstructure:"max_file_size"`
+	}
+
+	// SigVerifyConfig defines the configuration of the parallel signature verification.
+	type SigVerifyConfig struct {
+		Parallel bool `mapstructure:"parallel"`
+		Workers  int  `mapstructure:"workers"`
+	}
+
+	// TxTraceConfig defines the configuration of the tracing of the transactions against the historical state.
+	type TxTraceConfig struct {
+		Enabled bool `mapstructure:"enabled"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config
-		WASM WASMConfig
+		WASM      WASMConfig
+		Audit     AuditConfig
+		SigVerify SigVerifyConfig
+		TxTrace   TxTraceConfig
+		// LogLevelOverrides defines the log levels of the custom modules.
+		LogLevelOverrides string `mapstructure:"log_level_overrides"`
 	}
 
 	defaultWasmNodeConfig := wasmtypes.DefaultNodeConfig()
@@ -197,15 +228,45 @@ func initAppConfig() (string, interface{}) {
 			QueryGasLimit:   defaultWasmNodeConfig.SmartQueryGasLimit,
 			MemoryCacheSize: defaultWasmNodeConfig.MemoryCacheSize,
 		},
+		Audit: AuditConfig{
+			Stores:      strings.Join(app.DefaultAuditStores, ","),
+			MaxFileSize: audit.DefaultMaxFileSize,
+		},
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate + `
+	customAppTemplate := `
+# Comma-separated list of "module:level" pairs overriding the log level of the custom modules, e.g. "x/pse:debug".
+log_level_overrides = "{{ .LogLevelOverrides }}"
+` + serverconfig.DefaultConfigTemplate + `
 [wasm]
 # This is the maximum sdk gas (wasm and storage) that we allow for any x/wasm "smart" queries
 query_gas_limit = {{ .WASM.QueryGasLimit }}
 # This defines the memory size for Wasm modules that we can keep cached to speed-up instantiation
 # The value is in MiB not bytes
 memory_cache_size = {{ .WASM.MemoryCacheSize }}
+
+[audit]
+# Enables recording of the state writes of the audited stores to the append-only hash-chained files.
+# The files can be verified with the "audit verify" command.
+enabled = {{ .Audit.Enabled }}
+# Comma-separated list of the audited stores.
+stores = "{{ .Audit.Stores }}"
+# Directory of the audit files, "<home>/data/audit" is used if empty.
+dir = "{{ .Audit.Dir }}"
+# Size of the audit file in bytes after which the next file is started.
+max_file_size = {{ .Audit.MaxFileSize }}
+
+[sigverify]
+# Enables the verification of the secp256k1 signatures of the block transactions in the pool of workers.
+# The results are the same as without it, so the option may differ between the nodes.
+parallel = {{ .SigVerify.Parallel }}
+# Number of the verification workers, GOMAXPROCS is used if zero.
+workers = {{ .SigVerify.Workers }}
+
+[txtrace]
+# Enables the gRPC endpoint re-executing the transactions against the historical state to trace them.
+# The execution is done on the request, so it should be enabled only on the debug nodes.
+enabled = {{ .TxTrace.Enabled }}
 `
 
 	return customAppTemplate, customAppConfig
@@ -221,20 +282,45 @@ func initRootCmd(
 
 	rootCmd.AddCommand(
 		InitCmd(basicManager, app.DefaultNodeHome),
-		debug.Cmd(),
+		DebugCmd(debug.Cmd()),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
 		snapshot.Cmd(newApp),
 		GenerateGenesisCmd(basicManager),
+		AuditCmd(),
+		VerifyUpgradeCmd(),
 	)
 
-	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport, addModuleInitFlags)
+	hs := &healthServer{}
+	server.AddCommandsWithStartCmdOptions(
+		rootCmd,
+		app.DefaultNodeHome,
+		healthAppCreator(newApp, hs),
+		nil,
+		server.StartCmdOptions{
+			AddFlags: func(startCmd *cobra.Command) {
+				addModuleInitFlags(startCmd)
+				addHealthFlags(startCmd)
+				addVerifyUpgradeFlags(startCmd)
+			},
+			PostSetup: hs.startHealthServer,
+		},
+	)
+
+	// the export command of the SDK is replaced by the one exporting the selected modules and streaming the output.
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			rootCmd.RemoveCommand(cmd)
+		}
+	}
+	rootCmd.AddCommand(ExportCmd(app.DefaultNodeHome))
+	wrapStartCmdWithUpgradeVerification(rootCmd)
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	keysCmd := keys.Commands()
-	keysCmd.AddCommand(MigrateKeyringCmd())
+	keysCmd.AddCommand(MigrateKeyringCmd(), ExportBulkKeysCmd(), ImportBulkKeysCmd())
 	rootCmd.AddCommand(
-		server.StatusCommand(),
+		StatusCmd(),
 		genesisCommand(encodingConfig.TxConfig, basicManager),
 		queryCommand(),
 		txCommand(),
@@ -299,6 +385,7 @@ func queryCommand() *cobra.Command {
 		server.QueryBlocksCmd(),
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
+		CodecCmd(),
 	)
 
 	return cmd
@@ -328,6 +415,19 @@ func txCommand() *cobra.Command {
 	return cmd
 }
 
+// addDraftProposalCmds adds the commands drafting the proposals of the custom modules to the gov tx command.
+func addDraftProposalCmds(txCmd *cobra.Command) {
+	for _, cmd := range txCmd.Commands() {
+		if cmd.Name() == govtypes.ModuleName {
+			cmd.AddCommand(
+				psecli.CmdDraftDistributionScheduleProposal(),
+				assetftcli.CmdDraftParamsProposal(),
+			)
+			return
+		}
+	}
+}
+
 const broadcastModeBlock = "block"
 
 type txWriter struct {
@@ -462,48 +562,6 @@ func newApp(
 	)
 }
 
-// appExport creates a new app (optionally at a given height) and exports state.
-func appExport(
-	logger log.Logger,
-	db dbm.DB,
-	traceStore io.Writer,
-	height int64,
-	forZeroHeight bool,
-	jailAllowedAddrs []string,
-	appOpts servertypes.AppOptions,
-	modulesToExport []string,
-) (servertypes.ExportedApp, error) {
-	var txApp *app.App
-
-	// this check is necessary as we use the flag in x/upgrade.
-	// we can exit more gracefully by checking the flag here.
-	homePath, ok := appOpts.Get(flags.FlagHome).(string)
-	if !ok || homePath == "" {
-		return servertypes.ExportedApp{}, errors.New("application home not set")
-	}
-
-	viperAppOpts, ok := appOpts.(*viper.Viper)
-	if !ok {
-		return servertypes.ExportedApp{}, errors.New("appOpts is not viper.Viper")
-	}
-
-	// overwrite the FlagInvCheckPeriod
-	viperAppOpts.Set(server.FlagInvCheckPeriod, 1)
-	appOpts = viperAppOpts
-
-	if height != -1 {
-		txApp = app.New(logger, db, traceStore, false, appOpts)
-
-		if err := txApp.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
-		}
-	} else {
-		txApp = app.New(logger, db, traceStore, true, appOpts)
-	}
-
-	return txApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
-}
-
 func tempDir() string {
 	dir, err := os.MkdirTemp("", "txd")
 	if err != nil {
@@ -0,0 +1,175 @@
+package cosmoscmd
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cmtservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	feemodeltypes "github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+const (
+	// FlagExtended is the flag of the status command extending the node status with the chain info.
+	FlagExtended = "extended"
+
+	chainInfoKey = "chain_info"
+)
+
+// ChainInfo is the app level info of the chain reported by the extended status.
+type ChainInfo struct {
+	MinGasPrice              sdk.DecCoin    `json:"min_gas_price"`
+	PendingUpgrade           *UpgradeStatus `json:"pending_upgrade,omitempty"`
+	NextPSEDistribution      *time.Time     `json:"next_pse_distribution,omitempty"`
+	PSEDistributionsDisabled bool           `json:"pse_distributions_disabled"`
+	AssetFTDenoms            uint64         `json:"assetft_denoms"`
+	WasmCodes                uint64         `json:"wasm_codes"`
+}
+
+// chainInfoQueryClients are the query clients of the modules providing the chain info.
+type chainInfoQueryClients struct {
+	feemodel feemodeltypes.QueryClient
+	upgrade  upgradetypes.QueryClient
+	pse      psetypes.QueryClient
+	assetft  assetfttypes.QueryClient
+	wasm     wasmtypes.QueryClient
+}
+
+func newChainInfoQueryClients(clientCtx client.Context) chainInfoQueryClients {
+	return chainInfoQueryClients{
+		feemodel: feemodeltypes.NewQueryClient(clientCtx),
+		upgrade:  upgradetypes.NewQueryClient(clientCtx),
+		pse:      psetypes.NewQueryClient(clientCtx),
+		assetft:  assetfttypes.NewQueryClient(clientCtx),
+		wasm:     wasmtypes.NewQueryClient(clientCtx),
+	}
+}
+
+// StatusCmd returns the status command of the node extended with the chain info if the extended flag is set.
+func StatusCmd() *cobra.Command {
+	cmd := server.StatusCommand()
+	cmd.Long = `Query remote node for status.
+With --extended the status is merged with the chain info: the current min gas price, pending upgrade plan,
+next PSE distribution time, number of assetft denoms and number of wasm codes.`
+	statusRunE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		extended, err := cmd.Flags().GetBool(FlagExtended)
+		if err != nil {
+			return err
+		}
+		if !extended {
+			return statusRunE(cmd, args)
+		}
+
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+		status, err := cmtservice.GetNodeStatus(cmd.Context(), clientCtx)
+		if err != nil {
+			return err
+		}
+		chainInfo, err := queryChainInfo(cmd.Context(), newChainInfoQueryClients(clientCtx))
+		if err != nil {
+			return err
+		}
+		output, err := extendStatus(status, chainInfo)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.WithOutputFormat(flags.OutputFormatJSON).PrintRaw(output)
+	}
+	cmd.Flags().Bool(FlagExtended, false, "Extend the node status with the chain info")
+
+	return cmd
+}
+
+func queryChainInfo(ctx context.Context, clients chainInfoQueryClients) (ChainInfo, error) {
+	var info ChainInfo
+
+	gasPriceRes, err := clients.feemodel.MinGasPrice(ctx, &feemodeltypes.QueryMinGasPriceRequest{})
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query min gas price")
+	}
+	info.MinGasPrice = gasPriceRes.MinGasPrice
+
+	planRes, err := clients.upgrade.CurrentPlan(ctx, &upgradetypes.QueryCurrentPlanRequest{})
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query upgrade plan")
+	}
+	if plan := planRes.Plan; plan != nil {
+		info.PendingUpgrade = &UpgradeStatus{
+			Name:   plan.Name,
+			Height: plan.Height,
+			Info:   plan.Info,
+		}
+	}
+
+	distributionsRes, err := clients.pse.ScheduledDistributions(ctx, &psetypes.QueryScheduledDistributionsRequest{})
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query pse scheduled distributions")
+	}
+	info.PSEDistributionsDisabled = distributionsRes.DisableDistributions
+	if len(distributionsRes.ScheduledDistributions) > 0 {
+		next := time.Unix(int64(distributionsRes.ScheduledDistributions[0].Timestamp), 0).UTC()
+		info.NextPSEDistribution = &next
+	}
+
+	tokensRes, err := clients.assetft.Tokens(ctx, &assetfttypes.QueryTokensRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query assetft tokens")
+	}
+	if tokensRes.Pagination != nil {
+		info.AssetFTDenoms = tokensRes.Pagination.Total
+	}
+
+	codesRes, err := clients.wasm.Codes(ctx, &wasmtypes.QueryCodesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query wasm codes")
+	}
+	if codesRes.Pagination != nil {
+		info.WasmCodes = codesRes.Pagination.Total
+	}
+
+	return info, nil
+}
+
+// extendStatus merges the chain info into the node status under the chain_info key.
+func extendStatus(status any, chainInfo ChainInfo) ([]byte, error) {
+	statusJSON, err := cmtjson.Marshal(status)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal node status")
+	}
+	extended := map[string]json.RawMessage{}
+	if err := json.Unmarshal(statusJSON, &extended); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal node status")
+	}
+	extended[chainInfoKey], err = json.Marshal(chainInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal chain info")
+	}
+
+	out, err := json.Marshal(extended)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal extended status")
+	}
+	return out, nil
+}
@@ -0,0 +1,126 @@
+package cosmoscmd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	feemodeltypes "github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+type feemodelQueryClientMock struct {
+	feemodeltypes.QueryClient
+}
+
+func (feemodelQueryClientMock) MinGasPrice(
+	context.Context, *feemodeltypes.QueryMinGasPriceRequest, ...grpc.CallOption,
+) (*feemodeltypes.QueryMinGasPriceResponse, error) {
+	return &feemodeltypes.QueryMinGasPriceResponse{
+		MinGasPrice: sdk.NewDecCoinFromDec("ucore", sdkmath.LegacyMustNewDecFromStr("0.0625")),
+	}, nil
+}
+
+type upgradeQueryClientMock struct {
+	upgradetypes.QueryClient
+	plan *upgradetypes.Plan
+}
+
+func (m upgradeQueryClientMock) CurrentPlan(
+	context.Context, *upgradetypes.QueryCurrentPlanRequest, ...grpc.CallOption,
+) (*upgradetypes.QueryCurrentPlanResponse, error) {
+	return &upgradetypes.QueryCurrentPlanResponse{Plan: m.plan}, nil
+}
+
+type pseQueryClientMock struct {
+	psetypes.QueryClient
+	distributions []psetypes.ScheduledDistribution
+}
+
+func (m pseQueryClientMock) ScheduledDistributions(
+	context.Context, *psetypes.QueryScheduledDistributionsRequest, ...grpc.CallOption,
+) (*psetypes.QueryScheduledDistributionsResponse, error) {
+	return &psetypes.QueryScheduledDistributionsResponse{ScheduledDistributions: m.distributions}, nil
+}
+
+type assetftQueryClientMock struct {
+	assetfttypes.QueryClient
+}
+
+func (assetftQueryClientMock) Tokens(
+	_ context.Context, req *assetfttypes.QueryTokensRequest, _ ...grpc.CallOption,
+) (*assetfttypes.QueryTokensResponse, error) {
+	if req.Issuer != "" || !req.Pagination.CountTotal {
+		return nil, errors.New("unexpected tokens request")
+	}
+	return &assetfttypes.QueryTokensResponse{Pagination: &query.PageResponse{Total: 12}}, nil
+}
+
+type wasmQueryClientMock struct {
+	wasmtypes.QueryClient
+}
+
+func (wasmQueryClientMock) Codes(
+	context.Context, *wasmtypes.QueryCodesRequest, ...grpc.CallOption,
+) (*wasmtypes.QueryCodesResponse, error) {
+	return &wasmtypes.QueryCodesResponse{Pagination: &query.PageResponse{Total: 3}}, nil
+}
+
+func TestExtendedStatus(t *testing.T) {
+	requireT := require.New(t)
+
+	nextDistribution := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	clients := chainInfoQueryClients{
+		feemodel: feemodelQueryClientMock{},
+		upgrade:  upgradeQueryClientMock{plan: &upgradetypes.Plan{Name: "v7", Height: 100}},
+		pse: pseQueryClientMock{distributions: []psetypes.ScheduledDistribution{
+			{Timestamp: uint64(nextDistribution.Unix())},
+			{Timestamp: uint64(nextDistribution.AddDate(0, 1, 0).Unix())},
+		}},
+		assetft: assetftQueryClientMock{},
+		wasm:    wasmQueryClientMock{},
+	}
+
+	info, err := queryChainInfo(context.Background(), clients)
+	requireT.NoError(err)
+	requireT.Equal("0.062500000000000000ucore", info.MinGasPrice.String())
+	requireT.Equal(&UpgradeStatus{Name: "v7", Height: 100}, info.PendingUpgrade)
+	requireT.Equal(nextDistribution, *info.NextPSEDistribution)
+	requireT.EqualValues(12, info.AssetFTDenoms)
+	requireT.EqualValues(3, info.WasmCodes)
+
+	// the chain info is omitted if not available
+	clients.upgrade = upgradeQueryClientMock{}
+	clients.pse = pseQueryClientMock{}
+	info, err = queryChainInfo(context.Background(), clients)
+	requireT.NoError(err)
+	requireT.Nil(info.PendingUpgrade)
+	requireT.Nil(info.NextPSEDistribution)
+
+	// the chain info is merged into the node status
+	out, err := extendStatus(&coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 10},
+	}, info)
+	requireT.NoError(err)
+	var extended struct {
+		SyncInfo struct {
+			LatestBlockHeight string `json:"latest_block_height"`
+		} `json:"sync_info"`
+		ChainInfo ChainInfo `json:"chain_info"`
+	}
+	requireT.NoError(json.Unmarshal(out, &extended))
+	requireT.Equal("10", extended.SyncInfo.LatestBlockHeight)
+	requireT.Equal(info, extended.ChainInfo)
+}
@@ -0,0 +1,314 @@
+package cosmoscmd
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/x/upgrade/plan"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagBinary is the flag of the binary verified against the upgrade plan.
+	FlagBinary = "binary"
+	// FlagOffline is the flag skipping the comparison of the upgrade plan with the one scheduled on chain.
+	FlagOffline = "offline"
+	// FlagVerifyUpgradeBinary is the flag of the start command refusing to start the node if the binary doesn't
+	// match the checksum of the upgrade plan written by the node reaching the upgrade height.
+	FlagVerifyUpgradeBinary = "verify-upgrade-binary"
+
+	anyPlatform = "any"
+)
+
+// UpgradeVerification is the result of the verification of the binary against the upgrade plan.
+type UpgradeVerification struct {
+	Name           string `json:"name"`
+	Height         int64  `json:"height"`
+	Platform       string `json:"platform"`
+	Binary         string `json:"binary"`
+	Checksum       string `json:"checksum"`
+	ChainPlanMatch bool   `json:"chain_plan_match"`
+}
+
+// VerifyUpgradeCmd returns the command verifying the binary against the checksums embedded in the upgrade plan.
+func VerifyUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-upgrade [plan.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verify the binary against the checksums embedded in the upgrade plan",
+		Long: fmt.Sprintf(`Verify the binary against the checksums embedded in the upgrade plan.
+The plan file has the format of the upgrade-info.json file written by the node at the upgrade height, and its info
+must contain the cosmovisor-compatible binaries with the checksums, e.g.
+{"binaries":{"linux/amd64":"https://example.com/txd?checksum=sha256:<hex>"}}.
+The checksum of the platform of the binary is used, or the one of "any" if there is no such checksum.
+The plan is compared with the plan scheduled on chain unless --%s is set.
+
+Example:
+$ %s verify-upgrade upgrade-info.json --binary ./txd --node https://rpc.example.com:443
+`, FlagOffline, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			upgradePlan, err := readUpgradePlanFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			binary, err := cmd.Flags().GetString(FlagBinary)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if binary == "" {
+				if binary, err = os.Executable(); err != nil {
+					return errors.Wrap(err, "failed to get the path of the running binary")
+				}
+			}
+
+			result, err := verifyUpgradeBinary(upgradePlan, binary)
+			if err != nil {
+				return err
+			}
+
+			offline, err := cmd.Flags().GetBool(FlagOffline)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if !offline {
+				clientCtx, err := client.GetClientQueryContext(cmd)
+				if err != nil {
+					return err
+				}
+				res, err := upgradetypes.NewQueryClient(clientCtx).CurrentPlan(
+					cmd.Context(), &upgradetypes.QueryCurrentPlanRequest{},
+				)
+				if err != nil {
+					return errors.Wrap(err, "failed to query the upgrade plan scheduled on chain")
+				}
+				if err := compareUpgradePlans(upgradePlan, res.Plan); err != nil {
+					return err
+				}
+				result.ChainPlanMatch = true
+			}
+
+			out, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagBinary, "", "The binary to verify, the running binary is verified if empty")
+	cmd.Flags().Bool(FlagOffline, false, "Skip the comparison with the upgrade plan scheduled on chain")
+
+	return cmd
+}
+
+func addVerifyUpgradeFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(
+		FlagVerifyUpgradeBinary,
+		false,
+		"Refuse to start if the binary doesn't match the checksum of the upgrade plan written at the upgrade height "+
+			"to data/"+upgradetypes.UpgradeInfoFilename,
+	)
+}
+
+// wrapStartCmdWithUpgradeVerification makes the start command verify the running binary against the upgrade plan
+// written by the node at the upgrade height before the node is started, if the verification is enabled.
+func wrapStartCmdWithUpgradeVerification(rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() != "start" {
+			continue
+		}
+		preRunE := cmd.PreRunE
+		cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+			if preRunE != nil {
+				if err := preRunE(cmd, args); err != nil {
+					return err
+				}
+			}
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			if !serverCtx.Viper.GetBool(FlagVerifyUpgradeBinary) {
+				return nil
+			}
+			return verifyRunningBinary(filepath.Join(serverCtx.Config.RootDir, "data"))
+		}
+	}
+}
+
+// verifyRunningBinary verifies the running binary against the upgrade plan written to the data dir. Nothing is
+// verified if the node has never reached the upgrade height.
+func verifyRunningBinary(dataDir string) error {
+	upgradePlan, err := readUpgradePlanFile(filepath.Join(dataDir, upgradetypes.UpgradeInfoFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	binary, err := os.Executable()
+	if err != nil {
+		return errors.Wrap(err, "failed to get the path of the running binary")
+	}
+	if _, err := verifyUpgradeBinary(upgradePlan, binary); err != nil {
+		return errors.Wrapf(err, "the binary doesn't match the upgrade %q", upgradePlan.Name)
+	}
+
+	return nil
+}
+
+// verifyUpgradeBinary verifies the binary against the checksum embedded in the info of the upgrade plan for the
+// platform of the binary.
+func verifyUpgradeBinary(upgradePlan upgradetypes.Plan, binary string) (UpgradeVerification, error) {
+	info, err := plan.ParseInfo(upgradePlan.Info, plan.ParseOptionEnforceChecksum(true))
+	if err != nil {
+		return UpgradeVerification{}, errors.Wrap(err, "invalid upgrade plan info")
+	}
+	if err := info.Binaries.ValidateBasic(true); err != nil {
+		return UpgradeVerification{}, errors.Wrap(err, "invalid upgrade plan binaries")
+	}
+
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	binaryURL, ok := info.Binaries[platform]
+	if !ok {
+		if binaryURL, ok = info.Binaries[anyPlatform]; !ok {
+			return UpgradeVerification{}, errors.Errorf("upgrade plan has no binary for %s", platform)
+		}
+		platform = anyPlatform
+	}
+
+	checksum, err := checksumFromURL(binaryURL)
+	if err != nil {
+		return UpgradeVerification{}, err
+	}
+	actualChecksum, err := fileChecksum(binary, checksum)
+	if err != nil {
+		return UpgradeVerification{}, err
+	}
+	if actualChecksum != checksum {
+		return UpgradeVerification{}, errors.Errorf(
+			"checksum mismatch of %s for %s, expected: %s, actual: %s", binary, platform, checksum, actualChecksum,
+		)
+	}
+
+	return UpgradeVerification{
+		Name:     upgradePlan.Name,
+		Height:   upgradePlan.Height,
+		Platform: platform,
+		Binary:   binary,
+		Checksum: checksum,
+	}, nil
+}
+
+// compareUpgradePlans checks that the upgrade plan is the one scheduled on chain.
+func compareUpgradePlans(upgradePlan upgradetypes.Plan, chainPlan *upgradetypes.Plan) error {
+	if chainPlan == nil {
+		return errors.New("no upgrade plan is scheduled on chain")
+	}
+	if upgradePlan.Name != chainPlan.Name ||
+		upgradePlan.Height != chainPlan.Height ||
+		strings.TrimSpace(upgradePlan.Info) != strings.TrimSpace(chainPlan.Info) {
+		return errors.Errorf(
+			"upgrade plan %q at height %d doesn't match the plan %q at height %d scheduled on chain",
+			upgradePlan.Name, upgradePlan.Height, chainPlan.Name, chainPlan.Height,
+		)
+	}
+
+	return nil
+}
+
+// checksumFromURL returns the checksum of the binary URL in the go-getter format, e.g. sha256:<hex>.
+func checksumFromURL(binaryURL string) (string, error) {
+	u, err := neturl.Parse(binaryURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid binary url %s", binaryURL)
+	}
+	checksum := strings.ToLower(u.Query().Get("checksum"))
+	if checksum == "" {
+		return "", errors.Errorf("binary url %s has no checksum", binaryURL)
+	}
+
+	return checksum, nil
+}
+
+// fileChecksum computes the checksum of the file with the algorithm of the expected checksum.
+func fileChecksum(path, expectedChecksum string) (string, error) {
+	algorithm, _, ok := strings.Cut(expectedChecksum, ":")
+	if !ok {
+		return "", errors.Errorf("checksum %s must have the format <type>:<hex>", expectedChecksum)
+	}
+
+	var h hash.Hash
+	switch algorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return "", errors.Errorf("unsupported checksum type %s", algorithm)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to open binary %s", path)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", errors.Wrapf(err, "failed to read binary %s", path)
+	}
+
+	return algorithm + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// readUpgradePlanFile reads the upgrade plan. The height is accepted both as the number written to
+// upgrade-info.json and as the string used in the proposals.
+func readUpgradePlanFile(path string) (upgradetypes.Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return upgradetypes.Plan{}, errors.Wrapf(err, "failed to read upgrade plan %s", path)
+	}
+
+	var planFile struct {
+		Name   string          `json:"name"`
+		Height json.RawMessage `json:"height"`
+		Info   string          `json:"info"`
+	}
+	if err := json.Unmarshal(data, &planFile); err != nil {
+		return upgradetypes.Plan{}, errors.Wrapf(err, "failed to parse upgrade plan %s", path)
+	}
+	height, err := strconv.ParseInt(strings.Trim(string(planFile.Height), `"`), 10, 64)
+	if err != nil {
+		return upgradetypes.Plan{}, errors.Wrapf(err, "invalid height of upgrade plan %s", path)
+	}
+
+	upgradePlan := upgradetypes.Plan{
+		Name:   planFile.Name,
+		Height: height,
+		Info:   planFile.Info,
+	}
+	if err := upgradePlan.ValidateBasic(); err != nil {
+		return upgradetypes.Plan{}, errors.Wrapf(err, "invalid upgrade plan %s", path)
+	}
+
+	return upgradePlan, nil
+}
@@ -0,0 +1,124 @@
+package cosmoscmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyUpgradeBinary(t *testing.T) {
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "txd")
+	content := []byte("txd binary")
+	require.NoError(t, os.WriteFile(binary, content, 0o600))
+	sum := sha256.Sum256(content)
+	checksum := "sha256:" + hex.EncodeToString(sum[:])
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+
+	planInfo := func(binaries map[string]string) string {
+		info, err := json.Marshal(map[string]any{"binaries": binaries})
+		require.NoError(t, err)
+		return string(info)
+	}
+
+	testCases := []struct {
+		name             string
+		info             string
+		expectedPlatform string
+		expectErr        bool
+	}{
+		{
+			name: "matching checksum of the platform",
+			info: planInfo(map[string]string{
+				platform: "https://example.com/txd?checksum=" + checksum,
+				"any":    "https://example.com/txd?checksum=sha256:00",
+			}),
+			expectedPlatform: platform,
+		},
+		{
+			name:             "matching checksum of any platform",
+			info:             planInfo(map[string]string{"any": "https://example.com/txd?checksum=" + checksum}),
+			expectedPlatform: "any",
+		},
+		{
+			name:      "mismatching checksum",
+			info:      planInfo(map[string]string{platform: "https://example.com/txd?checksum=sha256:00"}),
+			expectErr: true,
+		},
+		{
+			name:      "missing checksum",
+			info:      planInfo(map[string]string{platform: "https://example.com/txd"}),
+			expectErr: true,
+		},
+		{
+			name:      "unsupported checksum type",
+			info:      planInfo(map[string]string{platform: "https://example.com/txd?checksum=crc32:00"}),
+			expectErr: true,
+		},
+		{
+			name:      "missing platform",
+			info:      planInfo(map[string]string{"other/arch": "https://example.com/txd?checksum=" + checksum}),
+			expectErr: true,
+		},
+		{
+			name:      "blank info",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := verifyUpgradeBinary(upgradetypes.Plan{Name: "v7", Height: 100, Info: tc.info}, binary)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, UpgradeVerification{
+				Name:     "v7",
+				Height:   100,
+				Platform: tc.expectedPlatform,
+				Binary:   binary,
+				Checksum: checksum,
+			}, result)
+		})
+	}
+}
+
+func TestReadUpgradePlanFile(t *testing.T) {
+	dir := t.TempDir()
+	info := `{"binaries":{"any":"https://example.com/txd?checksum=sha256:00"}}`
+	infoJSON, err := json.Marshal(info)
+	require.NoError(t, err)
+
+	for _, height := range []string{"100", `"100"`} {
+		path := filepath.Join(dir, "plan.json")
+		require.NoError(t, os.WriteFile(
+			path, fmt.Appendf(nil, `{"name":"v7","height":%s,"info":%s}`, height, infoJSON), 0o600,
+		))
+		upgradePlan, err := readUpgradePlanFile(path)
+		require.NoError(t, err)
+		require.Equal(t, upgradetypes.Plan{Name: "v7", Height: 100, Info: info}, upgradePlan)
+	}
+
+	// the missing upgrade info means the node has never reached the upgrade height
+	require.NoError(t, verifyRunningBinary(dir))
+}
+
+func TestCompareUpgradePlans(t *testing.T) {
+	upgradePlan := upgradetypes.Plan{Name: "v7", Height: 100, Info: "info"}
+
+	require.NoError(t, compareUpgradePlans(upgradePlan, &upgradetypes.Plan{Name: "v7", Height: 100, Info: "info"}))
+	require.Error(t, compareUpgradePlans(upgradePlan, nil))
+	require.Error(t, compareUpgradePlans(upgradePlan, &upgradetypes.Plan{Name: "v8", Height: 100, Info: "info"}))
+	require.Error(t, compareUpgradePlans(upgradePlan, &upgradetypes.Plan{Name: "v7", Height: 101, Info: "info"}))
+	require.Error(t, compareUpgradePlans(upgradePlan, &upgradetypes.Plan{Name: "v7", Height: 100, Info: "other"}))
+}
@@ -29,6 +29,9 @@ func main() {
 	rootCmd := cosmoscmd.NewRootCmd()
 	cosmoscmd.OverwriteDefaultChainIDFlags(rootCmd)
 	rootCmd.PersistentFlags().String(flags.FlagChainID, string(app.DefaultChainID), "The network chain ID")
+	rootCmd.PersistentFlags().String(
+		cosmoscmd.FlagNetworkConfig, "", "The JSON or YAML file with the config of the custom network",
+	)
 	if err := svrcmd.Execute(rootCmd, txChainEnvPrefix, app.DefaultNodeHome); err != nil {
 		//nolint:errcheck // we are already exiting the app so we don't check error.
 		fmt.Fprintln(rootCmd.OutOrStderr(), err)
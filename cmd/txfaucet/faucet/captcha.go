@@ -0,0 +1,81 @@
+package faucet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const captchaRequestTimeout = 10 * time.Second
+
+// ErrCaptchaFailed is returned if the captcha is not solved.
+var ErrCaptchaFailed = errors.New("captcha verification failed")
+
+// CaptchaVerifier verifies the captcha solved by the user requesting the funds.
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// SiteVerifyCaptchaVerifier verifies the captcha using the siteverify API shared by reCAPTCHA, hCaptcha and
+// Cloudflare Turnstile.
+type SiteVerifyCaptchaVerifier struct {
+	verifyURL string
+	secret    string
+	client    *http.Client
+}
+
+// NewSiteVerifyCaptchaVerifier returns the captcha verifier calling the siteverify endpoint of the provider.
+func NewSiteVerifyCaptchaVerifier(verifyURL, secret string) SiteVerifyCaptchaVerifier {
+	return SiteVerifyCaptchaVerifier{
+		verifyURL: verifyURL,
+		secret:    secret,
+		client:    &http.Client{Timeout: captchaRequestTimeout},
+	}
+}
+
+// Verify verifies the captcha token.
+func (v SiteVerifyCaptchaVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	if token == "" {
+		return errors.Wrap(ErrCaptchaFailed, "captcha token is empty")
+	}
+
+	form := url.Values{
+		"secret":   {v.secret},
+		"response": {token},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "captcha verification request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("captcha verification request failed, status code: %d", resp.StatusCode)
+	}
+
+	var res struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return errors.Wrap(err, "failed to decode captcha verification response")
+	}
+	if !res.Success {
+		return errors.Wrapf(ErrCaptchaFailed, "error codes: %s", strings.Join(res.ErrorCodes, ","))
+	}
+
+	return nil
+}
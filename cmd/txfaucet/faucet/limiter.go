@@ -0,0 +1,71 @@
+package faucet
+
+import (
+	"sync"
+	"time"
+)
+
+type window struct {
+	start time.Time
+	count int
+}
+
+// RateLimiter limits the number of the requests per key, e.g. the IP or the address, in the fixed time windows.
+type RateLimiter struct {
+	limit  int
+	period time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	windows   map[string]window
+	lastPrune time.Time
+}
+
+// NewRateLimiter returns the rate limiter allowing the limit of the requests per key in the period. The limiter
+// allows all the requests if the limit is not positive.
+func NewRateLimiter(limit int, period time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:   limit,
+		period:  period,
+		now:     time.Now,
+		windows: map[string]window{},
+	}
+}
+
+// Allow returns true and records the request if the limit of the key is not reached.
+func (l *RateLimiter) Allow(key string) bool {
+	if l.limit <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.pruneLocked(now)
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.period {
+		w = window{start: now}
+	}
+	if w.count >= l.limit {
+		return false
+	}
+	w.count++
+	l.windows[key] = w
+
+	return true
+}
+
+// pruneLocked removes the expired windows, so the memory used by the limiter doesn't grow with the number of keys.
+func (l *RateLimiter) pruneLocked(now time.Time) {
+	if now.Sub(l.lastPrune) < l.period {
+		return
+	}
+	l.lastPrune = now
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= l.period {
+			delete(l.windows, key)
+		}
+	}
+}
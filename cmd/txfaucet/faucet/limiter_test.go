@@ -0,0 +1,32 @@
+package faucet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	requireT := require.New(t)
+
+	now := time.Now()
+	limiter := NewRateLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	requireT.True(limiter.Allow("a"))
+	requireT.True(limiter.Allow("a"))
+	requireT.False(limiter.Allow("a"))
+	requireT.True(limiter.Allow("b"))
+
+	// the new window is started once the period passes
+	now = now.Add(time.Minute)
+	requireT.True(limiter.Allow("a"))
+	requireT.Len(limiter.windows, 1)
+
+	// the limit is disabled
+	limiter = NewRateLimiter(0, time.Minute)
+	for range 10 {
+		requireT.True(limiter.Allow("a"))
+	}
+}
@@ -0,0 +1,199 @@
+package faucet
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+)
+
+const (
+	// FundPath is the path of the endpoint funding the address.
+	FundPath = "/api/faucet/v1/fund"
+	// StatusPath is the path of the endpoint reporting the faucet is running.
+	StatusPath = "/api/faucet/v1/status"
+
+	maxRequestBodySize = 4 * 1024
+
+	resultFunded        = "funded"
+	resultInvalid       = "invalid"
+	resultRateLimited   = "rate_limited"
+	resultCaptchaFailed = "captcha_failed"
+	resultQueueFull     = "queue_full"
+	resultInternalError = "internal_error"
+)
+
+// Funder sends the funds to the recipient.
+type Funder interface {
+	Transfer(ctx context.Context, recipient sdk.AccAddress, amount sdk.Coins) (*sdk.TxResponse, error)
+}
+
+// Config is the config of the faucet server.
+type Config struct {
+	// Amount is the amount sent to the address per request.
+	Amount sdk.Coins
+	// IPLimiter limits the requests per IP of the client.
+	IPLimiter *RateLimiter
+	// AddressLimiter limits the requests per funded address.
+	AddressLimiter *RateLimiter
+	// CaptchaVerifier verifies the captcha of the request, the captcha is not required if it is nil.
+	CaptchaVerifier CaptchaVerifier
+	// TrustForwardedFor enables taking the IP of the client from the X-Forwarded-For header set by the proxy.
+	TrustForwardedFor bool
+}
+
+// FundRequest is the request of the fund endpoint.
+type FundRequest struct {
+	Address      string `json:"address"`
+	CaptchaToken string `json:"captcha_token,omitempty"`
+}
+
+// FundResponse is the response of the fund endpoint.
+type FundResponse struct {
+	TxHash string `json:"tx_hash"`
+	Amount string `json:"amount"`
+}
+
+// ErrorResponse is the response returned if the request fails.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server serves the faucet HTTP API.
+type Server struct {
+	config   Config
+	funder   Funder
+	logger   log.Logger
+	requests *prometheus.CounterVec
+	registry *prometheus.Registry
+}
+
+// NewServer returns the new faucet server.
+func NewServer(config Config, funder Funder, logger log.Logger) (*Server, error) {
+	if !config.Amount.IsValid() || config.Amount.IsZero() {
+		return nil, errors.Errorf("invalid amount %s", config.Amount)
+	}
+	if config.IPLimiter == nil {
+		config.IPLimiter = NewRateLimiter(0, 0)
+	}
+	if config.AddressLimiter == nil {
+		config.AddressLimiter = NewRateLimiter(0, 0)
+	}
+
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "faucet_requests_total",
+		Help: "The number of the fund requests by result.",
+	}, []string{"result"})
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(requests); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &Server{
+		config:   config,
+		funder:   funder,
+		logger:   logger,
+		requests: requests,
+		registry: registry,
+	}, nil
+}
+
+// Handler returns the handler of the faucet API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+FundPath, s.fund)
+	mux.HandleFunc("GET "+StatusPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
+
+// MetricsHandler returns the handler exposing the prometheus metrics of the faucet.
+func (s *Server) MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{})
+}
+
+func (s *Server) fund(w http.ResponseWriter, r *http.Request) {
+	var req FundRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize)).Decode(&req); err != nil {
+		s.fail(w, resultInvalid, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	recipient, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		s.fail(w, resultInvalid, http.StatusBadRequest, "invalid address")
+		return
+	}
+
+	remoteIP := s.remoteIP(r)
+	if !s.config.IPLimiter.Allow(remoteIP) || !s.config.AddressLimiter.Allow(recipient.String()) {
+		s.fail(w, resultRateLimited, http.StatusTooManyRequests, "rate limit exceeded, try again later")
+		return
+	}
+
+	if s.config.CaptchaVerifier != nil {
+		if err := s.config.CaptchaVerifier.Verify(r.Context(), req.CaptchaToken, remoteIP); err != nil {
+			if errors.Is(err, ErrCaptchaFailed) {
+				s.fail(w, resultCaptchaFailed, http.StatusForbidden, "captcha verification failed")
+				return
+			}
+			s.logger.Error("captcha verification failed", "error", err)
+			s.fail(w, resultInternalError, http.StatusInternalServerError, "captcha verification is unavailable")
+			return
+		}
+	}
+
+	txRes, err := s.funder.Transfer(r.Context(), recipient, s.config.Amount)
+	if err != nil {
+		if errors.Is(err, client.ErrTransferQueueFull) {
+			s.fail(w, resultQueueFull, http.StatusServiceUnavailable, "faucet is busy, try again later")
+			return
+		}
+		s.logger.Error("failed to send funds", "address", recipient.String(), "error", err)
+		s.fail(w, resultInternalError, http.StatusInternalServerError, "failed to send funds")
+		return
+	}
+
+	s.requests.WithLabelValues(resultFunded).Inc()
+	s.logger.Info("funds sent", "address", recipient.String(), "txHash", txRes.TxHash)
+	writeJSON(w, http.StatusOK, FundResponse{
+		TxHash: txRes.TxHash,
+		Amount: s.config.Amount.String(),
+	})
+}
+
+// remoteIP returns the IP of the client. The last address of the X-Forwarded-For header is used if the proxy
+// is trusted, since it is the one appended by the proxy, while the previous ones might be set by the client.
+func (s *Server) remoteIP(r *http.Request) string {
+	if s.config.TrustForwardedFor {
+		if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+			return strings.TrimSpace(forwardedFor[strings.LastIndex(forwardedFor, ",")+1:])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (s *Server) fail(w http.ResponseWriter, result string, statusCode int, message string) {
+	s.requests.WithLabelValues(result).Inc()
+	writeJSON(w, statusCode, ErrorResponse{Error: message})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	//nolint:errcheck // the client might be gone already, there is nothing to do with the error
+	json.NewEncoder(w).Encode(body)
+}
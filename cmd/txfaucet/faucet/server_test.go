@@ -0,0 +1,116 @@
+package faucet_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/cmd/txfaucet/faucet"
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+)
+
+type funderMock struct {
+	funded []sdk.AccAddress
+	err    error
+}
+
+func (f *funderMock) Transfer(_ context.Context, recipient sdk.AccAddress, _ sdk.Coins) (*sdk.TxResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.funded = append(f.funded, recipient)
+	return &sdk.TxResponse{TxHash: "HASH"}, nil
+}
+
+type captchaVerifierMock struct{}
+
+func (captchaVerifierMock) Verify(_ context.Context, token, _ string) error {
+	if token != "solved" {
+		return faucet.ErrCaptchaFailed
+	}
+	return nil
+}
+
+func TestServer_Fund(t *testing.T) {
+	requireT := require.New(t)
+
+	funder := &funderMock{}
+	server, err := faucet.NewServer(faucet.Config{
+		Amount:            sdk.NewCoins(sdk.NewInt64Coin("ucore", 100)),
+		IPLimiter:         faucet.NewRateLimiter(2, time.Hour),
+		AddressLimiter:    faucet.NewRateLimiter(1, time.Hour),
+		CaptchaVerifier:   captchaVerifierMock{},
+		TrustForwardedFor: true,
+	}, funder, log.NewNopLogger())
+	requireT.NoError(err)
+	handler := server.Handler()
+
+	address1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	address2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	address3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the address is funded
+	code, body := fund(t, handler, "1.1.1.1", faucet.FundRequest{Address: address1.String(), CaptchaToken: "solved"})
+	requireT.Equal(http.StatusOK, code)
+	var res faucet.FundResponse
+	requireT.NoError(json.Unmarshal(body, &res))
+	requireT.Equal("HASH", res.TxHash)
+	requireT.Equal("100ucore", res.Amount)
+	requireT.Equal([]sdk.AccAddress{address1}, funder.funded)
+
+	// the invalid address is rejected
+	code, _ = fund(t, handler, "1.1.1.1", faucet.FundRequest{Address: "invalid", CaptchaToken: "solved"})
+	requireT.Equal(http.StatusBadRequest, code)
+
+	// the address can't be funded twice in the period
+	code, _ = fund(t, handler, "2.2.2.2", faucet.FundRequest{Address: address1.String(), CaptchaToken: "solved"})
+	requireT.Equal(http.StatusTooManyRequests, code)
+
+	// the captcha must be solved
+	code, _ = fund(t, handler, "1.1.1.1", faucet.FundRequest{Address: address2.String()})
+	requireT.Equal(http.StatusForbidden, code)
+
+	// the IP limit is reached
+	code, _ = fund(t, handler, "1.1.1.1", faucet.FundRequest{Address: address3.String(), CaptchaToken: "solved"})
+	requireT.Equal(http.StatusTooManyRequests, code)
+	requireT.Len(funder.funded, 1)
+
+	// the busy faucet is reported
+	funder.err = errors.WithStack(client.ErrTransferQueueFull)
+	code, _ = fund(t, handler, "3.3.3.3", faucet.FundRequest{Address: address3.String(), CaptchaToken: "solved"})
+	requireT.Equal(http.StatusServiceUnavailable, code)
+
+	// the status is reported
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, faucet.StatusPath, nil))
+	requireT.Equal(http.StatusOK, rec.Code)
+
+	// the results are counted
+	rec = httptest.NewRecorder()
+	server.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	requireT.Contains(rec.Body.String(), `faucet_requests_total{result="funded"} 1`)
+	requireT.Contains(rec.Body.String(), `faucet_requests_total{result="rate_limited"} 2`)
+}
+
+func fund(t *testing.T, handler http.Handler, ip string, req faucet.FundRequest) (int, []byte) {
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	httpReq := httptest.NewRequest(http.MethodPost, faucet.FundPath, bytes.NewReader(body))
+	// the first address is set by the client, so it must be ignored
+	httpReq.Header.Set("X-Forwarded-For", "9.9.9.9, "+ip)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httpReq)
+
+	return rec.Code, rec.Body.Bytes()
+}
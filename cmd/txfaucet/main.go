@@ -0,0 +1,333 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/tokenize-x/tx-chain/v7/cmd/txfaucet/faucet"
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	txchainkeyring "github.com/tokenize-x/tx-chain/v7/pkg/keyring"
+)
+
+const (
+	flagAddress           = "address"
+	flagMonitoringAddress = "monitoring-address"
+	flagChainID           = "chain-id"
+	flagNode              = "node"
+	flagKeyPathMnemonic   = "key-path-mnemonic"
+	flagTransferAmount    = "transfer-amount"
+	flagDenom             = "denom"
+	flagIPRateLimit       = "ip-rate-limit"
+	flagAddressRateLimit  = "address-rate-limit"
+	flagRateLimitPeriod   = "rate-limit-period"
+	flagTrustForwardedFor = "trust-forwarded-for"
+	flagBatchSize         = "batch-size"
+	flagBatchInterval     = "batch-interval"
+	flagQueueSize         = "queue-size"
+	flagCaptchaVerifyURL  = "captcha-verify-url"
+	flagCaptchaSecretPath = "captcha-secret-path"
+
+	faucetKeyName     = "faucet"
+	readHeaderTimeout = 5 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := rootCmd().ExecuteContext(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
+
+func rootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "txfaucet",
+		Short:        "Faucet funding the accounts on the test networks",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd)
+		},
+	}
+
+	cmd.Flags().String(flagAddress, ":8090", "The address the faucet API listens on")
+	cmd.Flags().String(flagMonitoringAddress, ":8091", "The address the prometheus metrics are served on")
+	cmd.Flags().String(flagChainID, string(constant.ChainIDDev), "The chain ID of the network")
+	cmd.Flags().String(flagNode, "localhost:9090", "The gRPC address of the node, prefix it with https:// to use TLS")
+	cmd.Flags().String(flagKeyPathMnemonic, "", "The path of the file containing the mnemonic of the faucet account")
+	cmd.Flags().Int64(flagTransferAmount, 100_000_000, "The amount sent per request")
+	cmd.Flags().String(flagDenom, "", "The denom sent per request, the bond denom of the chain is used if empty")
+	cmd.Flags().Int(flagIPRateLimit, 20, "The max number of the requests per IP in the period, 0 disables the limit")
+	cmd.Flags().Int(
+		flagAddressRateLimit, 5, "The max number of the requests per address in the period, 0 disables the limit",
+	)
+	cmd.Flags().Duration(flagRateLimitPeriod, time.Hour, "The period of the rate limits")
+	cmd.Flags().Bool(
+		flagTrustForwardedFor, false, "Take the IP of the client from the X-Forwarded-For header set by the proxy",
+	)
+	cmd.Flags().Int(flagBatchSize, client.DefaultTransferBatchSize, "The max number of the transfers per transaction")
+	cmd.Flags().Duration(
+		flagBatchInterval, client.DefaultTransferBatchInterval, "The time the transfer waits for the others to batch",
+	)
+	cmd.Flags().Int(flagQueueSize, client.DefaultTransferQueueSize, "The max number of the transfers waiting to be sent")
+	cmd.Flags().String(
+		flagCaptchaVerifyURL,
+		"",
+		"The siteverify URL of the captcha provider (reCAPTCHA, hCaptcha or Turnstile), the captcha is disabled if empty",
+	)
+	cmd.Flags().String(flagCaptchaSecretPath, "", "The path of the file containing the secret of the captcha provider")
+
+	return cmd
+}
+
+//nolint:funlen // the function just wires the services together
+func run(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	logger := log.NewLogger(cmd.OutOrStdout())
+
+	chainID, _ := cmd.Flags().GetString(flagChainID)
+	network, err := config.NetworkConfigByChainID(constant.ChainID(chainID))
+	if err != nil {
+		return err
+	}
+	network.SetSDKConfig()
+
+	node, _ := cmd.Flags().GetString(flagNode)
+	clientCtx := client.NewContext(client.DefaultContextConfig(), auth.AppModuleBasic{}, bank.AppModuleBasic{})
+	grpcClient, err := dialGRPC(node, clientCtx.SDKContext().Codec)
+	if err != nil {
+		return err
+	}
+	defer grpcClient.Close()
+
+	keyPathMnemonic, _ := cmd.Flags().GetString(flagKeyPathMnemonic)
+	mnemonic, err := os.ReadFile(keyPathMnemonic)
+	if err != nil {
+		return errors.Wrap(err, "failed to read the mnemonic of the faucet account")
+	}
+	kr := txchainkeyring.NewConcurrentSafeKeyring(keyring.NewInMemory(clientCtx.SDKContext().Codec))
+	keyInfo, err := kr.NewAccount(
+		faucetKeyName,
+		strings.TrimSpace(string(mnemonic)),
+		"",
+		hd.CreateHDPath(constant.CoinType, 0, 0).String(),
+		hd.Secp256k1,
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to import the faucet account")
+	}
+	faucetAddress, err := keyInfo.GetAddress()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	clientCtx = clientCtx.
+		WithChainID(chainID).
+		WithKeyring(kr).
+		WithFromName(faucetKeyName).
+		WithFromAddress(faucetAddress).
+		WithBroadcastMode(flags.BroadcastSync).
+		WithGRPCClient(grpcClient).
+		WithAwaitTx(true)
+	txf := client.Factory{}.
+		WithKeybase(kr).
+		WithChainID(chainID).
+		WithTxConfig(clientCtx.TxConfig()).
+		WithSimulateAndExecute(true)
+
+	amount, err := transferAmount(ctx, cmd, clientCtx)
+	if err != nil {
+		return err
+	}
+
+	queueConfig := client.DefaultTransferQueueConfig()
+	queueConfig.BatchSize, _ = cmd.Flags().GetInt(flagBatchSize)
+	queueConfig.BatchInterval, _ = cmd.Flags().GetDuration(flagBatchInterval)
+	queueConfig.QueueSize, _ = cmd.Flags().GetInt(flagQueueSize)
+	queue, err := client.NewTransferQueue(clientCtx, txf, queueConfig)
+	if err != nil {
+		return err
+	}
+
+	serverConfig, err := serverConfig(cmd, amount)
+	if err != nil {
+		return err
+	}
+	server, err := faucet.NewServer(serverConfig, queue, logger)
+	if err != nil {
+		return err
+	}
+
+	address, _ := cmd.Flags().GetString(flagAddress)
+	monitoringAddress, _ := cmd.Flags().GetString(flagMonitoringAddress)
+	logger.Info(
+		"starting faucet",
+		"address", address,
+		"faucetAddress", faucetAddress.String(),
+		"amount", amount.String(),
+	)
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return ignoreCanceled(queue.Run(ctx))
+	})
+	g.Go(func() error {
+		return serve(ctx, address, server.Handler())
+	})
+	g.Go(func() error {
+		return serve(ctx, monitoringAddress, server.MetricsHandler())
+	})
+
+	return g.Wait()
+}
+
+func transferAmount(ctx context.Context, cmd *cobra.Command, clientCtx client.Context) (sdk.Coins, error) {
+	amount, _ := cmd.Flags().GetInt64(flagTransferAmount)
+	denom, _ := cmd.Flags().GetString(flagDenom)
+	if denom == "" {
+		paramsRes, err := stakingtypes.NewQueryClient(clientCtx).Params(ctx, &stakingtypes.QueryParamsRequest{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query the bond denom")
+		}
+		denom = paramsRes.Params.BondDenom
+	}
+
+	coin := sdk.NewCoin(denom, sdkmath.NewInt(amount))
+	if err := coin.Validate(); err != nil || !coin.IsPositive() {
+		return nil, errors.Errorf("invalid transfer amount %s", coin)
+	}
+
+	return sdk.NewCoins(coin), nil
+}
+
+func serverConfig(cmd *cobra.Command, amount sdk.Coins) (faucet.Config, error) {
+	ipRateLimit, _ := cmd.Flags().GetInt(flagIPRateLimit)
+	addressRateLimit, _ := cmd.Flags().GetInt(flagAddressRateLimit)
+	rateLimitPeriod, _ := cmd.Flags().GetDuration(flagRateLimitPeriod)
+	trustForwardedFor, _ := cmd.Flags().GetBool(flagTrustForwardedFor)
+
+	cfg := faucet.Config{
+		Amount:            amount,
+		IPLimiter:         faucet.NewRateLimiter(ipRateLimit, rateLimitPeriod),
+		AddressLimiter:    faucet.NewRateLimiter(addressRateLimit, rateLimitPeriod),
+		TrustForwardedFor: trustForwardedFor,
+	}
+
+	captchaVerifyURL, _ := cmd.Flags().GetString(flagCaptchaVerifyURL)
+	if captchaVerifyURL == "" {
+		return cfg, nil
+	}
+	captchaSecretPath, _ := cmd.Flags().GetString(flagCaptchaSecretPath)
+	if captchaSecretPath == "" {
+		return faucet.Config{}, errors.Errorf("--%s is required if the captcha is enabled", flagCaptchaSecretPath)
+	}
+	secret, err := os.ReadFile(captchaSecretPath)
+	if err != nil {
+		return faucet.Config{}, errors.Wrap(err, "failed to read the captcha secret")
+	}
+	cfg.CaptchaVerifier = faucet.NewSiteVerifyCaptchaVerifier(captchaVerifyURL, strings.TrimSpace(string(secret)))
+
+	return cfg, nil
+}
+
+func dialGRPC(node string, cdc codec.Codec) (*grpc.ClientConn, error) {
+	pc, ok := cdc.(codec.GRPCCodecProvider)
+	if !ok {
+		return nil, errors.New("failed to cast codec to codec.GRPCCodecProvider")
+	}
+
+	host := node
+	creds := insecure.NewCredentials()
+	if strings.Contains(node, "://") {
+		parsedURL, err := url.Parse(node)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse grpc URL")
+		}
+		host = parsedURL.Host
+		if parsedURL.Scheme == "https" {
+			creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		}
+	}
+
+	grpcClient, err := grpc.NewClient(
+		host,
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(pc.GRPCCodec())),
+		grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial grpc")
+	}
+
+	return grpcClient, nil
+}
+
+// serve serves the handler until the ctx is canceled.
+func serve(ctx context.Context, address string, handler http.Handler) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on %s", address)
+	}
+	httpServer := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return errors.Wrapf(err, "server listening on %s failed", address)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func ignoreCanceled(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
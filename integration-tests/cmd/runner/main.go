@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/tokenize-x/tx-chain/v7/integration-tests/runner"
+)
+
+// Example:
+//
+//	go run ./cmd/runner -package ./ibc -report flake-report.json -- -tags=integrationtests -timeout=1h
+func main() {
+	var (
+		packagePath string
+		maxRetries  int
+		reportFile  string
+	)
+	flag.StringVar(&packagePath, "package", "", "path of the package containing integration tests")
+	flag.IntVar(&maxRetries, "max-retries", 2, "maximum number of retries of the tests failed because of infrastructure")
+	flag.StringVar(&reportFile, "report", "flake-report.json", "path of the file the flake report is stored to")
+	flag.Parse()
+
+	if packagePath == "" {
+		fmt.Fprintln(os.Stderr, "package flag is required")
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	report, err := runner.Run(ctx, runner.Config{
+		PackagePath: packagePath,
+		Flags:       flag.Args(),
+		MaxRetries:  maxRetries,
+		ReportFile:  reportFile,
+		Output:      os.Stdout,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+
+	for _, name := range report.Quarantined {
+		fmt.Fprintf(os.Stderr, "quarantined flaky test: %s\n", name)
+	}
+	if !report.Passed {
+		os.Exit(1)
+	}
+}
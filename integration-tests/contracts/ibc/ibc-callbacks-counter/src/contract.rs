@@ -50,7 +50,8 @@ pub fn execute(
             channel,
             amount,
             recipient,
-        } => transfer_funds(env, channel, amount, recipient),
+            timeout_seconds,
+        } => transfer_funds(env, channel, amount, recipient, timeout_seconds),
     }
 }
 
@@ -59,12 +60,13 @@ pub fn transfer_funds(
     channel: String,
     amount: Coin,
     recipient: String,
+    timeout_seconds: Option<u64>,
 ) -> Result<Response, ContractError> {
     let msg = TransferMsgBuilder::new(
         channel.to_string(),
         recipient.to_string(),
         amount.clone(),
-        env.block.time.plus_minutes(5),
+        env.block.time.plus_seconds(timeout_seconds.unwrap_or(300)),
     )
     .with_src_callback(IbcSrcCallback {
         address: env.contract.address,
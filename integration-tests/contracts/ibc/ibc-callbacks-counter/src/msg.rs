@@ -12,6 +12,8 @@ pub enum ExecuteMsg {
         channel: String,
         amount: Coin,
         recipient: String,
+        // timeout_seconds overrides the default timeout of the transfer of 5 minutes
+        timeout_seconds: Option<u64>,
     },
 }
 
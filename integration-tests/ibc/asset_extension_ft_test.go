@@ -4,6 +4,7 @@ package ibc
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 	"time"
@@ -28,7 +29,7 @@ import (
 	"github.com/tokenize-x/tx-tools/pkg/retry"
 )
 
-func TestExtensionIBCFailsWithIBCProhibitedAmount(t *testing.T) {
+func TestExtensionIBCBlockedByExtension(t *testing.T) {
 	t.Parallel()
 
 	requireT := require.New(t)
@@ -45,7 +46,7 @@ func TestExtensionIBCFailsWithIBCProhibitedAmount(t *testing.T) {
 	})
 
 	codeID, err := chains.TXChain.Wasm.DeployWASMContract(
-		ctx, chains.TXChain.TxFactory().WithSimulateAndExecute(true), txIssuer, testcontracts.AssetExtensionWasm,
+		ctx, chains.TXChain.TxFactory().WithSimulateAndExecute(true), txIssuer, testcontracts.IBCBlockWasm,
 	)
 	requireT.NoError(err)
 
@@ -74,7 +75,7 @@ func TestExtensionIBCFailsWithIBCProhibitedAmount(t *testing.T) {
 
 	transferCoin := sdk.NewCoin(
 		assetfttypes.BuildDenom(issueMsg.Subunit, txIssuer),
-		sdkmath.NewInt(testcontracts.AmountBlockIBCTrigger),
+		sdkmath.NewInt(100),
 	)
 	gaiaChain := chains.Gaia
 	_, err = txChain.ExecuteIBCTransfer(
@@ -86,7 +87,7 @@ func TestExtensionIBCFailsWithIBCProhibitedAmount(t *testing.T) {
 		gaiaChain.ChainContext,
 		gaiaChain.GenAccount(),
 	)
-	requireT.ErrorContains(err, "IBC feature is disabled.")
+	requireT.ErrorContains(err, testcontracts.IBCBlockError)
 }
 
 func TestExtensionIBCFailsIfNotEnabled(t *testing.T) {
@@ -900,89 +901,35 @@ func TestExtensionIBCAssetFTSendCommissionAndBurnRate(t *testing.T) {
 	requireT.NoError(err)
 
 	// ********** TX-Chain to Gaia **********
-	// IBC transfer trigger amount that ignores send commission rate.
-	sendCoin = sdk.NewCoin(denom, sdkmath.NewInt(testcontracts.AmountIgnoreSendCommissionRateTrigger))
-	burntAmount = issueMsg.BurnRate.Mul(sdkmath.LegacyNewDecFromInt(sendCoin.Amount)).RoundInt()
 	receiveCoinGaia := sdk.NewCoin(ConvertToIBCDenom(gaiaToTXChannelID, sendCoin.Denom), sendCoin.Amount)
 
-	ibcTransferAndAssertBalanceChanges(
-		ctx,
-		t,
-		txChain.ChainContext,
-		txChain.TxFactoryAuto(),
-		txSender,
-		sendCoin,
-		gaiaChain.ChainContext,
-		gaiaRecipient1,
-		receiveCoinGaia,
-		map[string]sdkmath.Int{
-			txChain.MustConvertToBech32Address(txSender):              sendCoin.Amount.Add(burntAmount).Neg(),
-			txChain.MustConvertToBech32Address(txToGaiaEscrowAddress): sendCoin.Amount,
-		},
-		map[string]sdkmath.Int{
-			gaiaChain.MustConvertToBech32Address(gaiaRecipient1): sendCoin.Amount,
-		},
-	)
-
-	// IBC transfer trigger amount that ignores burn rate.
-	sendCoin = sdk.NewCoin(denom, sdkmath.NewInt(testcontracts.AmountIgnoreBurnRateTrigger))
-	sendCommissionAmount = issueMsg.SendCommissionRate.Mul(sdkmath.LegacyNewDecFromInt(sendCoin.Amount)).RoundInt()
-	receiveCoinGaia = sdk.NewCoin(ConvertToIBCDenom(gaiaToTXChannelID, sendCoin.Denom), sendCoin.Amount)
-
-	ibcTransferAndAssertBalanceChanges(
-		ctx,
-		t,
-		txChain.ChainContext,
-		txChain.TxFactoryAuto(),
-		txSender,
-		sendCoin,
-		gaiaChain.ChainContext,
-		gaiaRecipient1,
-		receiveCoinGaia,
-		map[string]sdkmath.Int{
-			txChain.MustConvertToBech32Address(txSender): sendCoin.Amount.
-				Add(sendCommissionAmount).Neg(),
-			txChain.MustConvertToBech32Address(txToGaiaEscrowAddress): sendCoin.Amount,
-		},
-		map[string]sdkmath.Int{
-			gaiaChain.MustConvertToBech32Address(gaiaRecipient1): sendCoin.Amount,
-		},
-	)
-
-	sendCoin = sdk.NewCoin(denom, sdkmath.NewInt(1000))
-	burntAmount = issueMsg.BurnRate.Mul(sdkmath.LegacyNewDecFromInt(sendCoin.Amount)).TruncateInt()
-	sendCommissionAmount = issueMsg.SendCommissionRate.Mul(sdkmath.LegacyNewDecFromInt(sendCoin.Amount)).TruncateInt()
-	receiveCoinGaia = sdk.NewCoin(ConvertToIBCDenom(gaiaToTXChannelID, sendCoin.Denom), sendCoin.Amount)
-
 	adminCommissionAmount := sdkmath.
 		LegacyNewDecFromInt(sendCommissionAmount).
 		Mul(sdkmath.LegacyMustNewDecFromStr("0.5")).
 		TruncateInt()
 
-	// Normal IBC transfer.
-	ibcTransferAndAssertBalanceChanges(
-		ctx,
-		t,
-		txChain.ChainContext,
-		txChain.TxFactoryAuto(),
-		txSender,
-		sendCoin,
-		gaiaChain.ChainContext,
-		gaiaRecipient2,
-		receiveCoinGaia,
-		map[string]sdkmath.Int{
-			txChain.MustConvertToBech32Address(txSender): sendCoin.Amount.
-				Add(sendCommissionAmount).Add(burntAmount).Neg(),
-			txChain.MustConvertToBech32Address(txIssuer):              adminCommissionAmount,
-			txChain.MustConvertToBech32Address(txToGaiaEscrowAddress): sendCoin.Amount,
-		},
-		map[string]sdkmath.Int{
-			gaiaChain.MustConvertToBech32Address(gaiaRecipient2): sendCoin.Amount,
-		},
-	)
-
-	sendCoin = sdk.NewCoin(denom, sdkmath.NewInt(testcontracts.AmountIgnoreSendCommissionRateTrigger))
-	receiveCoinGaia = sdk.NewCoin(ConvertToIBCDenom(gaiaToTXChannelID, sendCoin.Denom), sendCoin.Amount)
+	for _, gaiaRecipient := range []sdk.AccAddress{gaiaRecipient1, gaiaRecipient2} {
+		ibcTransferAndAssertBalanceChanges(
+			ctx,
+			t,
+			txChain.ChainContext,
+			txChain.TxFactoryAuto(),
+			txSender,
+			sendCoin,
+			gaiaChain.ChainContext,
+			gaiaRecipient,
+			receiveCoinGaia,
+			map[string]sdkmath.Int{
+				txChain.MustConvertToBech32Address(txSender): sendCoin.Amount.
+					Add(sendCommissionAmount).Add(burntAmount).Neg(),
+				txChain.MustConvertToBech32Address(txIssuer):              adminCommissionAmount,
+				txChain.MustConvertToBech32Address(txToGaiaEscrowAddress): sendCoin.Amount,
+			},
+			map[string]sdkmath.Int{
+				gaiaChain.MustConvertToBech32Address(gaiaRecipient): sendCoin.Amount,
+			},
+		)
+	}
 
 	// ********** Gaia to TX-Chain (send back) **********
 	// IBC transfer back to issuer address.
@@ -1005,9 +952,6 @@ func TestExtensionIBCAssetFTSendCommissionAndBurnRate(t *testing.T) {
 		},
 	)
 
-	sendCoin = sdk.NewCoin(denom, sdkmath.NewInt(1000))
-	receiveCoinGaia = sdk.NewCoin(ConvertToIBCDenom(gaiaToTXChannelID, sendCoin.Denom), sendCoin.Amount)
-
 	// IBC transfer back to non-issuer address.
 	ibcTransferAndAssertBalanceChanges(
 		ctx,
@@ -1030,6 +974,148 @@ func TestExtensionIBCAssetFTSendCommissionAndBurnRate(t *testing.T) {
 	)
 }
 
+// TestExtensionIBCCommissionOverride checks that the extension can refund the burn amount and the send commission
+// of the IBC transfers to the sender.
+func TestExtensionIBCCommissionOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewChainsTestingContext(t)
+	requireT := require.New(t)
+
+	txChain := chains.TXChain
+	gaiaChain := chains.Gaia
+
+	gaiaToTXChannelID := gaiaChain.AwaitForIBCChannelID(
+		ctx, t, ibctransfertypes.PortID, txChain.ChainContext,
+	)
+	txToGaiaChannelID := txChain.AwaitForIBCChannelID(
+		ctx, t, ibctransfertypes.PortID, gaiaChain.ChainContext,
+	)
+	txToGaiaEscrowAddress := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, txToGaiaChannelID)
+
+	burnRate := sdkmath.LegacyMustNewDecFromStr("0.1")
+	sendCommissionRate := sdkmath.LegacyMustNewDecFromStr("0.2")
+	sendAmount := sdkmath.NewInt(1000)
+	burntAmount := burnRate.Mul(sdkmath.LegacyNewDecFromInt(sendAmount)).TruncateInt()
+	sendCommissionAmount := sendCommissionRate.Mul(sdkmath.LegacyNewDecFromInt(sendAmount)).TruncateInt()
+
+	tests := []struct {
+		name                  string
+		issuanceMsg           testcontracts.CommissionOverrideIssuanceMsg
+		senderChange          sdkmath.Int
+		adminCommissionChange sdkmath.Int
+	}{
+		{
+			name: "ignore_burn_rate",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{
+				IgnoreBurnRate: true,
+			},
+			senderChange:          sendAmount.Add(sendCommissionAmount).Neg(),
+			adminCommissionChange: sendCommissionAmount,
+		},
+		{
+			name: "ignore_send_commission_rate",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{
+				IgnoreSendCommissionRate: true,
+			},
+			senderChange:          sendAmount.Add(burntAmount).Neg(),
+			adminCommissionChange: sdkmath.ZeroInt(),
+		},
+	}
+
+	for _, tt := range tests {
+		txIssuer := txChain.GenAccount()
+		txSender := txChain.GenAccount()
+		gaiaRecipient := gaiaChain.GenAccount()
+
+		txChain.FundAccountsWithOptions(ctx, t, []integration.AccWithBalancesOptions{
+			{
+				Acc: txIssuer,
+				Options: integration.BalancesOptions{
+					Amount: txChain.QueryAssetFTParams(ctx, t).IssueFee.Amount.
+						Add(sdkmath.NewInt(1_000_000)). // added one million for contract upload
+						Add(sdkmath.NewInt(2 * 500_000)),
+				},
+			}, {
+				Acc: txSender,
+				Options: integration.BalancesOptions{
+					Amount: sdkmath.NewInt(500_000),
+				},
+			},
+		})
+
+		codeID, err := txChain.Wasm.DeployWASMContract(
+			ctx, txChain.TxFactory().WithSimulateAndExecute(true), txIssuer, testcontracts.CommissionOverrideWasm,
+		)
+		requireT.NoError(err, tt.name)
+
+		issuanceMsgBytes, err := json.Marshal(tt.issuanceMsg)
+		requireT.NoError(err, tt.name)
+
+		issueMsg := &assetfttypes.MsgIssue{
+			Issuer:             txIssuer.String(),
+			Symbol:             "mysymbol",
+			Subunit:            "mysubunit",
+			Precision:          8,
+			InitialAmount:      sdkmath.NewInt(1_000_000),
+			BurnRate:           burnRate,
+			SendCommissionRate: sendCommissionRate,
+			Features: []assetfttypes.Feature{
+				assetfttypes.Feature_ibc,
+				assetfttypes.Feature_extension,
+			},
+			ExtensionSettings: &assetfttypes.ExtensionIssueSettings{
+				CodeId:      codeID,
+				Label:       "testing-ibc",
+				IssuanceMsg: issuanceMsgBytes,
+			},
+		}
+		_, err = client.BroadcastTx(
+			ctx,
+			txChain.ClientContext.WithFromAddress(txIssuer),
+			txChain.TxFactoryAuto(),
+			issueMsg,
+		)
+		requireT.NoError(err, tt.name)
+		denom := assetfttypes.BuildDenom(issueMsg.Subunit, txIssuer)
+
+		_, err = client.BroadcastTx(
+			ctx,
+			txChain.ClientContext.WithFromAddress(txIssuer),
+			txChain.TxFactoryAuto(),
+			&banktypes.MsgSend{
+				FromAddress: txIssuer.String(),
+				ToAddress:   txSender.String(),
+				Amount: sdk.NewCoins(sdk.NewCoin(denom,
+					sendAmount.Add(burntAmount).Add(sendCommissionAmount),
+				)),
+			},
+		)
+		requireT.NoError(err, tt.name)
+
+		sendCoin := sdk.NewCoin(denom, sendAmount)
+		ibcTransferAndAssertBalanceChanges(
+			ctx,
+			t,
+			txChain.ChainContext,
+			txChain.TxFactoryAuto(),
+			txSender,
+			sendCoin,
+			gaiaChain.ChainContext,
+			gaiaRecipient,
+			sdk.NewCoin(ConvertToIBCDenom(gaiaToTXChannelID, denom), sendAmount),
+			map[string]sdkmath.Int{
+				txChain.MustConvertToBech32Address(txSender):              tt.senderChange,
+				txChain.MustConvertToBech32Address(txIssuer):              tt.adminCommissionChange,
+				txChain.MustConvertToBech32Address(txToGaiaEscrowAddress): sendAmount,
+			},
+			map[string]sdkmath.Int{
+				gaiaChain.MustConvertToBech32Address(gaiaRecipient): sendAmount,
+			},
+		)
+	}
+}
+
 func TestExtensionIBCRejectedTransferWithWhitelistingAndFreezing(t *testing.T) {
 	t.Parallel()
 
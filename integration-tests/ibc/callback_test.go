@@ -3,6 +3,7 @@
 package ibc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -63,25 +64,7 @@ func TestIBCWASMCallback(t *testing.T) {
 
 	// ********** Deploy contract **********
 
-	// instantiate the contract and set the initial adapter state.
-	initialPayload, err := json.Marshal(ibcwasm.HooksCounterState{
-		Count: 2024, // This is the initial counter value for contract instantiator. We don't use this value.
-	})
-	requireT.NoError(err)
-
-	txContractAddr, _, err := txChain.Wasm.DeployAndInstantiateWASMContract(
-		ctx,
-		txChain.TxFactoryAuto(),
-		txContractAdmin,
-		ibcwasm.IBCCallbacksCounter,
-		integration.InstantiateConfig{
-			Admin:      txContractAdmin,
-			AccessType: wasmtypes.AccessTypeUnspecified,
-			Payload:    initialPayload,
-			Label:      "ibc_callbacks_counter",
-		},
-	)
-	requireT.NoError(err)
+	txContractAddr := deployIBCCallbacksCounter(ctx, t, txChain, txContractAdmin)
 
 	_, txContract, err := bech32.DecodeAndConvert(txContractAddr)
 	requireT.NoError(err)
@@ -177,8 +160,123 @@ func TestIBCWASMCallback(t *testing.T) {
 	)
 }
 
+// TestIBCWASMCallbackFailureAndTimeout tests the source callbacks of the ibc-callbacks-counter WASM contract deployed
+// on TX-Chain for the IBC transfers to Gaia which fail or time out.
+func TestIBCWASMCallbackFailureAndTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewChainsTestingContext(t)
+	requireT := require.New(t)
+	txChain := chains.TXChain
+	gaiaChain := chains.Gaia
+
+	gaiaChain.AwaitForIBCChannelID(
+		ctx, t, ibctransfertypes.PortID, txChain.ChainContext,
+	)
+	txToGaiaChannelID := txChain.AwaitForIBCChannelID(
+		ctx, t, ibctransfertypes.PortID, gaiaChain.ChainContext,
+	)
+
+	txContractAdmin := txChain.GenAccount()
+	txSender := txChain.GenAccount()
+	gaiaReceiver := gaiaChain.GenAccount()
+
+	txChain.Faucet.FundAccounts(ctx, t,
+		integration.FundedAccount{
+			Address: txContractAdmin,
+			Amount:  txChain.NewCoin(sdkmath.NewInt(20_000_000)),
+		},
+		integration.FundedAccount{
+			Address: txSender,
+			Amount:  txChain.NewCoin(sdkmath.NewInt(20_000_000)),
+		},
+	)
+
+	txContractAddr := deployIBCCallbacksCounter(ctx, t, txChain, txContractAdmin)
+	_, txContract, err := bech32.DecodeAndConvert(txContractAddr)
+	requireT.NoError(err)
+
+	contractBalance := txChain.NewCoin(sdkmath.NewInt(20_000_000))
+	txChain.Faucet.FundAccounts(ctx, t,
+		integration.FundedAccount{
+			Address: txContract,
+			Amount:  contractBalance,
+		},
+	)
+
+	executeTransferFunds := func(msg transferFunds) {
+		payload, err := json.Marshal(map[string]transferFunds{
+			"transfer_funds": msg,
+		})
+		requireT.NoError(err)
+
+		_, err = txChain.Wasm.ExecuteWASMContract(
+			ctx,
+			txChain.TxFactory().WithGas(2_000_000),
+			txSender,
+			txContractAddr,
+			payload,
+			sdk.Coin{},
+		)
+		requireT.NoError(err)
+	}
+
+	// The transfer to the invalid recipient is rejected by Gaia, the error acknowledgement triggers the
+	// src_callback incrementing the counter by 1 and the funds are refunded to the contract.
+	executeTransferFunds(transferFunds{
+		Channel:   txToGaiaChannelID,
+		Amount:    txChain.NewCoin(sdkmath.NewInt(1)),
+		Recipient: "invalid",
+	})
+	awaitCounterContractState(ctx, t, txChain, txContractAddr, txContractAddr, 1, sdk.Coins{})
+	requireT.NoError(txChain.AwaitForBalance(ctx, t, txContract, contractBalance))
+
+	// The transfer timing out triggers the src_callback incrementing the counter by 10 and the funds are refunded to
+	// the contract.
+	executeTransferFunds(transferFunds{
+		Channel:        txToGaiaChannelID,
+		Amount:         txChain.NewCoin(sdkmath.NewInt(1)),
+		Recipient:      gaiaChain.MustConvertToBech32Address(gaiaReceiver),
+		TimeoutSeconds: 1,
+	})
+	awaitCounterContractState(ctx, t, txChain, txContractAddr, txContractAddr, 11, sdk.Coins{})
+	requireT.NoError(txChain.AwaitForBalance(ctx, t, txContract, contractBalance))
+}
+
+func deployIBCCallbacksCounter(
+	ctx context.Context,
+	t *testing.T,
+	txChain integration.TXChain,
+	admin sdk.AccAddress,
+) string {
+	t.Helper()
+
+	// instantiate the contract and set the initial adapter state.
+	initialPayload, err := json.Marshal(ibcwasm.HooksCounterState{
+		Count: 2024, // This is the initial counter value for contract instantiator. We don't use this value.
+	})
+	require.NoError(t, err)
+
+	contractAddr, _, err := txChain.Wasm.DeployAndInstantiateWASMContract(
+		ctx,
+		txChain.TxFactoryAuto(),
+		admin,
+		ibcwasm.IBCCallbacksCounter,
+		integration.InstantiateConfig{
+			Admin:      admin,
+			AccessType: wasmtypes.AccessTypeUnspecified,
+			Payload:    initialPayload,
+			Label:      "ibc_callbacks_counter",
+		},
+	)
+	require.NoError(t, err)
+
+	return contractAddr
+}
+
 type transferFunds struct {
-	Channel   string   `json:"channel"`
-	Amount    sdk.Coin `json:"amount"`
-	Recipient string   `json:"recipient"`
+	Channel        string   `json:"channel"`
+	Amount         sdk.Coin `json:"amount"`
+	Recipient      string   `json:"recipient"`
+	TimeoutSeconds uint64   `json:"timeout_seconds,omitempty"`
 }
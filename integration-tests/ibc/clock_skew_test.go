@@ -0,0 +1,134 @@
+//go:build integrationtests
+
+package ibc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/tokenize-x/tx-chain/v7/integration-tests"
+	"github.com/tokenize-x/tx-chain/v7/testutil/integration"
+)
+
+// clockSkewTimeoutMargin is the margin added on top of the clock skew to the timeouts of the transfers, so the
+// transfers are relayed before they time out.
+const clockSkewTimeoutMargin = time.Minute
+
+func TestIBCClientsToleratingClockSkew(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewChainsTestingContext(t)
+	requireT := require.New(t)
+	txChain := chains.TXChain
+
+	for _, peerChain := range []integration.Chain{chains.Gaia, chains.Osmosis} {
+		txTime, err := txChain.LatestBlockTime(ctx)
+		requireT.NoError(err)
+		peerTime, err := peerChain.LatestBlockTime(ctx)
+		requireT.NoError(err)
+		skew := peerTime.Sub(txTime).Abs()
+		t.Logf("Clock skew between %s and %s: %s.", txChain.ChainSettings.ChainID, peerChain.ChainSettings.ChainID, skew)
+
+		for _, side := range []struct {
+			chain     integration.ChainContext
+			peer      integration.ChainContext
+			chainTime time.Time
+		}{
+			{chain: txChain.ChainContext, peer: peerChain.ChainContext, chainTime: txTime},
+			{chain: peerChain.ChainContext, peer: txChain.ChainContext, chainTime: peerTime},
+		} {
+			channelID := side.chain.AwaitForIBCChannelID(ctx, t, ibctransfertypes.PortID, side.peer)
+			clientState, err := side.chain.GetChannelClientState(ctx, ibctransfertypes.PortID, channelID)
+			requireT.NoError(err)
+
+			// the headers of the peer are accepted only if they are not ahead of the chain by more than the drift
+			requireT.Greater(
+				clientState.MaxClockDrift, skew,
+				"max clock drift of the %s client on %s doesn't tolerate the skew",
+				side.peer.ChainSettings.ChainID, side.chain.ChainSettings.ChainID,
+			)
+			requireT.Positive(clientState.TrustingPeriod)
+			requireT.Less(clientState.TrustingPeriod, clientState.UnbondingPeriod)
+
+			// the client is not expired from the point of view of the skewed clock
+			consensusState, err := side.chain.GetChannelLatestConsensusState(ctx, ibctransfertypes.PortID, channelID)
+			requireT.NoError(err)
+			requireT.Less(
+				side.chainTime.Add(skew).Sub(consensusState.Timestamp), clientState.TrustingPeriod,
+				"the %s client on %s is expired under the skew",
+				side.peer.ChainSettings.ChainID, side.chain.ChainSettings.ChainID,
+			)
+		}
+	}
+}
+
+func TestIBCTransferTimeoutUnderClockSkew(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewChainsTestingContext(t)
+	requireT := require.New(t)
+	txChain := chains.TXChain
+	gaiaChain := chains.Gaia
+
+	gaiaToTXChannelID := gaiaChain.AwaitForIBCChannelID(ctx, t, ibctransfertypes.PortID, txChain.ChainContext)
+	skew := gaiaChain.ChainSettings.ClockSkew.Abs()
+
+	sendCoin := txChain.NewCoin(sdkmath.NewInt(1000))
+	txf := txChain.TxFactory().WithGas(txChain.GasLimitByMsgs(&ibctransfertypes.MsgTransfer{}))
+
+	// the transfer timing out after the skew and the margin on the gaia clock is delivered
+	txSender := txChain.GenAccount()
+	gaiaRecipient := gaiaChain.GenAccount()
+	txChain.FundAccountWithOptions(ctx, t, txSender, integration.BalancesOptions{
+		Messages: []sdk.Msg{&ibctransfertypes.MsgTransfer{}},
+		Amount:   sendCoin.Amount,
+	})
+
+	gaiaTime, err := gaiaChain.LatestBlockTime(ctx)
+	requireT.NoError(err)
+	_, err = txChain.ExecuteIBCTransferWithTimeout(
+		ctx, t, txf, txSender, sendCoin, gaiaChain.ChainContext, gaiaRecipient,
+		gaiaTime.Add(skew+clockSkewTimeoutMargin),
+	)
+	requireT.NoError(err)
+	requireT.NoError(gaiaChain.AwaitForBalance(
+		ctx, t, gaiaRecipient,
+		sdk.NewCoin(ConvertToIBCDenom(gaiaToTXChannelID, sendCoin.Denom), sendCoin.Amount),
+	))
+
+	// the transfer timing out before the gaia clock shifted back by the skew is refunded
+	txSender = txChain.GenAccount()
+	gaiaRecipient = gaiaChain.GenAccount()
+	txChain.FundAccountWithOptions(ctx, t, txSender, integration.BalancesOptions{
+		Messages: []sdk.Msg{&ibctransfertypes.MsgTransfer{}},
+		Amount:   sendCoin.Amount,
+	})
+
+	gaiaTime, err = gaiaChain.LatestBlockTime(ctx)
+	requireT.NoError(err)
+	_, err = txChain.ExecuteIBCTransferWithTimeout(
+		ctx, t, txf, txSender, sendCoin, gaiaChain.ChainContext, gaiaRecipient,
+		gaiaTime.Add(-skew-5*time.Second),
+	)
+	// the transfer is rejected right away if the txd client of gaia has seen the timeout already
+	if err != nil {
+		requireT.True(strings.Contains(err.Error(), ibcchanneltypes.ErrTimeoutElapsed.Error()), err.Error())
+	}
+	requireT.NoError(txChain.AwaitForBalance(ctx, t, txSender, sendCoin))
+
+	bankClient := banktypes.NewQueryClient(gaiaChain.ClientContext)
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: gaiaChain.MustConvertToBech32Address(gaiaRecipient),
+		Denom:   ConvertToIBCDenom(gaiaToTXChannelID, sendCoin.Denom),
+	})
+	requireT.NoError(err)
+	requireT.Equal("0", balanceRes.Balance.Amount.String())
+}
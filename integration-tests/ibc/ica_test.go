@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/gogoproto/proto"
 	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
@@ -19,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	integrationtests "github.com/tokenize-x/tx-chain/v7/integration-tests"
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
 	"github.com/tokenize-x/tx-chain/v7/testutil/integration"
 	"github.com/tokenize-x/tx-tools/pkg/retry"
 )
@@ -68,6 +70,72 @@ func TestICADeterministicGas(t *testing.T) {
 	requireT.EqualValues(txRes.GasUsed, chains.TXChain.GasLimitByMsgs(&icacontrollertypes.MsgRegisterInterchainAccount{}))
 }
 
+// TestICAGovController tests the interchain account controlled by the governance.
+func TestICAGovController(t *testing.T) {
+	requireT := require.New(t)
+
+	ctx, chains := integrationtests.NewChainsTestingContext(t)
+	txChain := chains.TXChain
+	hostChain := chains.Gaia
+
+	_, controllerToHostConnectionID := txChain.AwaitForIBCClientAndConnectionIDs(
+		ctx, t, hostChain.ChainSettings.ChainID,
+	)
+
+	// the account is registered once per connection, so it might be registered by the previous run
+	if _, err := client.QueryGovICAAddress(ctx, txChain.ClientContext, controllerToHostConnectionID); err != nil {
+		msgRegisterInterchainAccount, err := client.NewGovICARegistrationMsg(controllerToHostConnectionID)
+		requireT.NoError(err)
+		txChain.Governance.ProposalFromMsgAndVote(
+			ctx, t, nil,
+			"-", "Register governance ICA", "Register the interchain account of the governance on Gaia",
+			govtypesv1.OptionYes,
+			msgRegisterInterchainAccount,
+		)
+	}
+
+	var hostICAAddress string
+	requireT.NoError(txChain.AwaitState(ctx, func(ctx context.Context) error {
+		address, err := client.QueryGovICAAddress(ctx, txChain.ClientContext, controllerToHostConnectionID)
+		if err != nil {
+			return retry.Retryable(errors.Errorf("ICA account is not ready yet, %s", err))
+		}
+		hostICAAddress = address
+		return nil
+	}))
+	_, hostICAAcc, err := bech32.DecodeAndConvert(hostICAAddress)
+	requireT.NoError(err)
+
+	t.Logf("Governance account is created on the host chain: %s", hostICAAddress)
+
+	hostChain.Faucet.FundAccounts(ctx, t, integration.FundedAccount{
+		Address: hostICAAcc,
+		Amount:  hostChain.NewCoin(sdkmath.NewIntWithDecimal(1, 8)),
+	})
+
+	hostRecipient := hostChain.GenAccount()
+	amtToSendOnHost := hostChain.NewCoin(sdkmath.NewIntWithDecimal(1, 6))
+	msgSendTx, err := client.NewGovICAExecutionMsg(
+		hostChain.ClientContext.Codec(),
+		controllerToHostConnectionID,
+		time.Hour,
+		&banktypes.MsgSend{
+			FromAddress: hostICAAddress,
+			ToAddress:   hostChain.MustConvertToBech32Address(hostRecipient),
+			Amount:      sdk.NewCoins(amtToSendOnHost),
+		},
+	)
+	requireT.NoError(err)
+	txChain.Governance.ProposalFromMsgAndVote(
+		ctx, t, nil,
+		"-", "Send from governance ICA", "Send the funds from the interchain account of the governance on Gaia",
+		govtypesv1.OptionYes,
+		msgSendTx,
+	)
+
+	requireT.NoError(hostChain.AwaitForBalance(ctx, t, hostRecipient, amtToSendOnHost))
+}
+
 func testICAIntegration(
 	ctx context.Context,
 	t *testing.T,
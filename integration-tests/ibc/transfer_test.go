@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	ibcchanneltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -22,6 +24,7 @@ import (
 	integrationtests "github.com/tokenize-x/tx-chain/v7/integration-tests"
 	"github.com/tokenize-x/tx-chain/v7/pkg/client"
 	"github.com/tokenize-x/tx-chain/v7/testutil/integration"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 	"github.com/tokenize-x/tx-tools/pkg/retry"
 )
 
@@ -389,3 +392,96 @@ func TestRejectedTransfer(t *testing.T) {
 	requireT.NoError(err)
 	requireT.Equal("0", resp.Balance.Amount.String())
 }
+
+func TestGovernanceBlockedAddressTransfer(t *testing.T) {
+	t.Parallel()
+
+	ctx, chains := integrationtests.NewChainsTestingContext(t)
+	requireT := require.New(t)
+	txChain := chains.TXChain
+	gaiaChain := chains.Gaia
+
+	gaiaToTXChannelID := gaiaChain.AwaitForIBCChannelID(
+		ctx, t, ibctransfertypes.PortID, txChain.ChainContext,
+	)
+
+	txSender := txChain.GenAccount()
+	txBlocked := txChain.GenAccount()
+	gaiaRecipient := gaiaChain.GenAccount()
+
+	sendToGaiaCoin := txChain.NewCoin(sdkmath.NewInt(1000))
+	txChain.FundAccountWithOptions(ctx, t, txSender, integration.BalancesOptions{
+		Messages: []sdk.Msg{&ibctransfertypes.MsgTransfer{}},
+		Amount:   sendToGaiaCoin.Amount,
+	})
+	gaiaChain.Faucet.FundAccounts(ctx, t, integration.FundedAccount{
+		Address: gaiaRecipient,
+		Amount:  gaiaChain.NewCoin(sdkmath.NewIntFromUint64(1000000)),
+	})
+
+	// the address is added to the blocked addresses by the governance
+	customParamsClient := customparamstypes.NewQueryClient(txChain.ClientContext)
+	bankParamsRes, err := customParamsClient.BankParams(ctx, &customparamstypes.QueryBankParamsRequest{})
+	requireT.NoError(err)
+	initialParams := bankParamsRes.Params
+	defer func() {
+		t.Logf("Restoring initial bank params.")
+		txChain.Governance.ProposalFromMsgAndVote(
+			ctx, t, nil,
+			"-", "-", "-", govtypesv1.OptionYes,
+			&customparamstypes.MsgUpdateBankParams{
+				Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				BankParams: initialParams,
+			},
+		)
+	}()
+
+	newParams := customparamstypes.BankParams{
+		BlockedAddresses: append(append([]string{}, initialParams.BlockedAddresses...), txBlocked.String()),
+	}
+	txChain.Governance.ProposalFromMsgAndVote(
+		ctx, t, nil,
+		"-", "-", "-", govtypesv1.OptionYes,
+		&customparamstypes.MsgUpdateBankParams{
+			Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+			BankParams: newParams,
+		},
+	)
+
+	_, err = txChain.ExecuteIBCTransfer(
+		ctx,
+		t,
+		txChain.TxFactory().WithGas(txChain.GasLimitByMsgs(&ibctransfertypes.MsgTransfer{})),
+		txSender,
+		sendToGaiaCoin,
+		gaiaChain.ChainContext,
+		gaiaRecipient,
+	)
+	requireT.NoError(err)
+	sendToTXCoin := sdk.NewCoin(ConvertToIBCDenom(gaiaToTXChannelID, sendToGaiaCoin.Denom), sendToGaiaCoin.Amount)
+	requireT.NoError(gaiaChain.AwaitForBalance(ctx, t, gaiaRecipient, sendToTXCoin))
+
+	// the transfer to the blocked address is rejected by tx-chain
+	_, err = gaiaChain.ExecuteIBCTransfer(
+		ctx,
+		t,
+		gaiaChain.TxFactoryAuto(),
+		gaiaRecipient,
+		sendToTXCoin,
+		txChain.ChainContext,
+		txBlocked,
+	)
+	requireT.NoError(err)
+
+	// funds should be returned to gaia
+	requireT.NoError(gaiaChain.AwaitForBalance(ctx, t, gaiaRecipient, sendToTXCoin))
+
+	// funds should not be received on tx-chain
+	bankClient := banktypes.NewQueryClient(txChain.ClientContext)
+	resp, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: txBlocked.String(),
+		Denom:   sendToGaiaCoin.Denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal("0", resp.Balance.Amount.String())
+}
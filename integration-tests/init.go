@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"github.com/tokenize-x/tx-chain/v7/app"
 	"github.com/tokenize-x/tx-chain/v7/pkg/client"
@@ -48,6 +51,7 @@ var (
 	chains         Chains
 	chainsSyncOnce sync.Once
 	runUnsafe      bool
+	recorder       *integration.Recorder
 )
 
 // flag variables.
@@ -57,14 +61,19 @@ var (
 
 	txFundingMnemonic string
 	txStakerMnemonics stringsFlag
+	txAccountManifest string
+	txRecordingDir    string
+	txReplayRecording string
 
 	gaiaGRPCAddress     string
 	gaiaRPCAddress      string
 	gaiaFundingMnemonic string
+	gaiaClockSkew       time.Duration
 
 	osmosisGRPCAddress     string
 	osmosisRPCAddress      string
 	osmosisFundingMnemonic string
+	osmosisClockSkew       time.Duration
 )
 
 //nolint:lll // this function contains flag description and mnemonic which cannot be broken down.
@@ -75,12 +84,17 @@ func init() {
 	flag.StringVar(&txRPCAddress, "tx-rpc-address", "http://localhost:26657", "RPC address of txd node started by znet")
 	flag.StringVar(&txFundingMnemonic, "tx-funding-mnemonic", "sad hobby filter tray ordinary gap half web cat hard call mystery describe member round trend friend beyond such clap frozen segment fan mistake", "Funding account mnemonic required by tests")
 	flag.Var(&txStakerMnemonics, "tx-staker-mnemonic", "Staker account mnemonics required by tests, supports multiple")
+	flag.StringVar(&txAccountManifest, "tx-account-manifest", "", "Path of the manifest of the accounts provisioned by znet, the default manifest is used if not set")
+	flag.StringVar(&txRecordingDir, "tx-recording-dir", "", "Directory the interactions of each test with txd node are recorded to, nothing is recorded if not set")
+	flag.StringVar(&txReplayRecording, "tx-replay-recording", "", "Path of the recording whose queries are replayed against txd node by TestReplayRecording")
 	flag.StringVar(&gaiaGRPCAddress, "gaia-grpc-address", "localhost:9080", "GRPC address of gaia node started by znet")
 	flag.StringVar(&gaiaRPCAddress, "gaia-rpc-address", "http://localhost:26557", "RPC address of gaia node started by znet")
 	flag.StringVar(&gaiaFundingMnemonic, "gaia-funding-mnemonic", "sad hobby filter tray ordinary gap half web cat hard call mystery describe member round trend friend beyond such clap frozen segment fan mistake", "Funding account mnemonic required by tests")
+	flag.DurationVar(&gaiaClockSkew, "gaia-clock-skew", 0, "Controlled skew of the gaia clock relative to txd applied by the tests, e.g. 10s or -10s")
 	flag.StringVar(&osmosisGRPCAddress, "osmosis-grpc-address", "localhost:9070", "GRPC address of osmosis node started by znet")
 	flag.StringVar(&osmosisRPCAddress, "osmosis-rpc-address", "http://localhost:26457", "RPC address of osmosis node started by znet")
 	flag.StringVar(&osmosisFundingMnemonic, "osmosis-funding-mnemonic", "sad hobby filter tray ordinary gap half web cat hard call mystery describe member round trend friend beyond such clap frozen segment fan mistake", "Funding account mnemonic required by tests")
+	flag.DurationVar(&osmosisClockSkew, "osmosis-clock-skew", 0, "Controlled skew of the osmosis clock relative to txd applied by the tests, e.g. 10s or -10s")
 
 	// accept testing flags
 	testing.Init()
@@ -106,7 +120,12 @@ func init() {
 
 	// ********** TX-Chain **********
 
-	txGRPCClient, err := integration.DialGRPCClient(txGRPCAddress)
+	var txGRPCDialOptions []grpc.DialOption
+	if txRecordingDir != "" {
+		recorder = integration.NewRecorder()
+		txGRPCDialOptions = append(txGRPCDialOptions, recorder.DialOption())
+	}
+	txGRPCClient, err := integration.DialGRPCClient(txGRPCAddress, txGRPCDialOptions...)
 	if err != nil {
 		panic(errors.WithStack(err))
 	}
@@ -132,16 +151,28 @@ func init() {
 	app.ChosenNetwork = network
 	network.SetSDKConfig()
 
-	txRPCClient, err := sdkclient.NewClientFromNode(txRPCAddress)
+	var txRPCClient rpcclient.Client
+	txRPCClient, err = sdkclient.NewClientFromNode(txRPCAddress)
 	if err != nil {
 		panic(errors.WithStack(err))
 	}
+	if recorder != nil {
+		txRPCClient = recorder.WrapRPCClient(txRPCClient)
+	}
+
+	txAccounts := integration.DefaultAccountManifest()
+	if txAccountManifest != "" {
+		txAccounts, err = config.LoadAccountManifest(txAccountManifest)
+		if err != nil {
+			panic(errors.WithStack(err))
+		}
+	}
 
 	chains.TXChain = integration.NewTXChain(integration.NewChain(
 		txGRPCClient,
 		txRPCClient,
 		txSettings,
-		txFundingMnemonic), txStakerMnemonics)
+		txFundingMnemonic), txStakerMnemonics, txAccounts)
 }
 
 // NewTXChainTestingContext returns the configured tx-chain chain and new context for the integration tests.
@@ -149,13 +180,14 @@ func NewTXChainTestingContext(t *testing.T) (context.Context, integration.TXChai
 	testCtx, testCtxCancel := context.WithCancel(ctx)
 	t.Cleanup(testCtxCancel)
 
-	return testCtx, chains.TXChain
+	return withRecording(testCtx, t), chains.TXChain
 }
 
 // NewChainsTestingContext returns the configured chains and new context for the integration tests.
 func NewChainsTestingContext(t *testing.T) (context.Context, Chains) {
 	testCtx, testCtxCancel := context.WithCancel(ctx)
 	t.Cleanup(testCtxCancel)
+	testCtx = withRecording(testCtx, t)
 
 	chainsSyncOnce.Do(func() {
 		queryCtx, queryCtxCancel := context.WithTimeout(ctx, client.DefaultContextConfig().TimeoutConfig.RequestTimeout)
@@ -168,6 +200,7 @@ func NewChainsTestingContext(t *testing.T) (context.Context, Chains) {
 		gaiaSettings.GasPrice = sdkmath.LegacyMustNewDecFromStr("1.0")
 		gaiaSettings.CoinType = sdk.CoinType // gaia coin type
 		gaiaSettings.RPCAddress = gaiaRPCAddress
+		gaiaSettings.ClockSkew = gaiaClockSkew
 
 		gaiaRPClient, err := sdkclient.NewClientFromNode(gaiaRPCAddress)
 		if err != nil {
@@ -188,6 +221,7 @@ func NewChainsTestingContext(t *testing.T) (context.Context, Chains) {
 		osmosisChainSettings.GasPrice = sdkmath.LegacyMustNewDecFromStr("0.01")
 		osmosisChainSettings.CoinType = sdk.CoinType // osmosis coin type
 		osmosisChainSettings.RPCAddress = osmosisRPCAddress
+		osmosisChainSettings.ClockSkew = osmosisClockSkew
 
 		osmosisRPClient, err := sdkclient.NewClientFromNode(osmosisRPCAddress)
 		if err != nil {
@@ -203,3 +237,23 @@ func NewChainsTestingContext(t *testing.T) (context.Context, Chains) {
 
 	return testCtx, chains
 }
+
+// TXReplayRecording returns the path of the recording to replay against txd node, empty if not set.
+func TXReplayRecording() string {
+	return txReplayRecording
+}
+
+// withRecording records the interactions of the test with txd node if the recording is enabled.
+func withRecording(testCtx context.Context, t *testing.T) context.Context {
+	if recorder == nil {
+		return testCtx
+	}
+
+	t.Cleanup(func() {
+		path, err := recorder.Save(t.Name(), txRecordingDir)
+		require.NoError(t, err)
+		t.Logf("Interactions with txd node recorded to %s", path)
+	})
+
+	return integration.WithRecording(testCtx, t.Name())
+}
@@ -3,6 +3,7 @@
 package modules
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 	"time"
@@ -1580,31 +1581,11 @@ func TestAssetFTExtensionBurnRate(t *testing.T) {
 		&recipient1: 400,
 	})
 
-	// send trigger amount from recipient1 to recipient2 (burn must not apply if the extension decides)
-	sendMsg = &banktypes.MsgSend{
-		FromAddress: recipient1.String(),
-		ToAddress:   recipient2.String(),
-		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(testcontracts.AmountIgnoreBurnRateTrigger))),
-	}
-
-	_, err = client.BroadcastTx(
-		ctx,
-		chain.ClientContext.WithFromAddress(recipient1),
-		chain.TxFactoryAuto(),
-		sendMsg,
-	)
-	requireT.NoError(err)
-	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
-		&admin:      560,
-		&recipient1: 292, // 400 - 108 (AmountIgnoreBurnRateTrigger)
-		&recipient2: 108, // AmountIgnoreBurnRateTrigger
-	})
-
 	// send from recipient1 to recipient2 (burn must apply)
 	sendMsg = &banktypes.MsgSend{
 		FromAddress: recipient1.String(),
 		ToAddress:   recipient2.String(),
-		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(200))),
 	}
 
 	_, err = client.BroadcastTx(
@@ -1616,8 +1597,8 @@ func TestAssetFTExtensionBurnRate(t *testing.T) {
 	requireT.NoError(err)
 	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
 		&admin:      560,
-		&recipient1: 182, // 292 - 100 - 10 (10% burn rate)
-		&recipient2: 208, // 108 + 100
+		&recipient1: 180, // 400 - 200 - 20 (10% burn rate)
+		&recipient2: 200,
 	})
 
 	// send from recipient2 to admin (burn must not apply)
@@ -1636,8 +1617,8 @@ func TestAssetFTExtensionBurnRate(t *testing.T) {
 	requireT.NoError(err)
 	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
 		&admin:      660, // 560 + 100
-		&recipient1: 182,
-		&recipient2: 98, // 208 - 100 - 10 (10% burn rate)
+		&recipient1: 180,
+		&recipient2: 90, // 200 - 100 - 10 (10% burn rate)
 	})
 }
 
@@ -1736,32 +1717,11 @@ func TestAssetFTExtensionSendCommissionRate(t *testing.T) {
 		&extension:  20, // 50% of the commission to the extension
 	})
 
-	// send trigger amount from recipient1 to recipient2 (send commission rate must not apply)
-	sendMsg = &banktypes.MsgSend{
-		FromAddress: recipient1.String(),
-		ToAddress:   recipient2.String(),
-		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(testcontracts.AmountIgnoreSendCommissionRateTrigger))),
-	}
-
-	_, err = client.BroadcastTx(
-		ctx,
-		chain.ClientContext.WithFromAddress(recipient1),
-		chain.TxFactoryAuto(),
-		sendMsg,
-	)
-	requireT.NoError(err)
-	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
-		&admin:      580,
-		&recipient1: 291, // 400 - 109 (AmountIgnoreSendCommissionRateTrigger)
-		&recipient2: 109, // AmountIgnoreSendCommissionRateTrigger
-		&extension:  20,
-	})
-
 	// send from recipient1 to recipient2 (send commission rate must apply)
 	sendMsg = &banktypes.MsgSend{
 		FromAddress: recipient1.String(),
 		ToAddress:   recipient2.String(),
-		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(200))),
 	}
 
 	_, err = client.BroadcastTx(
@@ -1772,10 +1732,10 @@ func TestAssetFTExtensionSendCommissionRate(t *testing.T) {
 	)
 	requireT.NoError(err)
 	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
-		&admin:      585, // 580 + 5 (50% of the commission to the admin)
-		&recipient1: 181, // 291 - 100 - 10 (10% commission from sender)
-		&recipient2: 209, // 109 + 100
-		&extension:  25,  // 20 + 5 (50% of the commission to the extension)
+		&admin:      590, // 580 + 10 (50% of the commission to the admin)
+		&recipient1: 180, // 400 - 200 - 20 (10% commission from sender)
+		&recipient2: 200,
+		&extension:  30, // 20 + 10 (50% of the commission to the extension)
 	})
 
 	// send from recipient2 to admin (send commission rate must apply if the extension decides)
@@ -1793,10 +1753,10 @@ func TestAssetFTExtensionSendCommissionRate(t *testing.T) {
 	)
 	requireT.NoError(err)
 	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
-		&admin:      690, // 585 + 100 + 5 (50% of the commission to the admin)
-		&recipient1: 181,
-		&recipient2: 99, // 209 - 100 - 10 (10% commission from sender)
-		&extension:  30, // 25 + 5 (50% of the commission to the extension)
+		&admin:      695, // 590 + 100 + 5 (50% of the commission to the admin)
+		&recipient1: 180,
+		&recipient2: 90, // 200 - 100 - 10 (10% commission from sender)
+		&extension:  35, // 30 + 5 (50% of the commission to the extension)
 	})
 }
 
@@ -1982,3 +1942,239 @@ func TestAssetFTExtensionDEX(t *testing.T) {
 	requireT.True(acc1BalanceRes.LockedInDEX.IsZero())
 	requireT.True(acc1BalanceRes.ExpectedToReceiveInDEX.IsZero())
 }
+
+// TestAssetFTExtensionRejectAll checks that the transfers rejected by the extension fail.
+func TestAssetFTExtensionRejectAll(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTXChainTestingContext(t)
+
+	requireT := require.New(t)
+	admin := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	denom := issueFTWithExtensionContract(
+		ctx, t, chain, admin, testcontracts.RejectAllWasm, struct{}{}, sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0),
+	)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: admin.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(admin),
+		chain.TxFactoryAuto(),
+		sendMsg,
+	)
+	requireT.ErrorContains(err, testcontracts.RejectAllTransferError)
+
+	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
+		&admin: 1000,
+	})
+}
+
+// TestAssetFTExtensionCommissionOverride checks that the extension can refund the burn amount and the send
+// commission to the sender.
+func TestAssetFTExtensionCommissionOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTXChainTestingContext(t)
+
+	requireT := require.New(t)
+
+	tests := []struct {
+		name         string
+		issuanceMsg  testcontracts.CommissionOverrideIssuanceMsg
+		distribution func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64
+	}{
+		{
+			name:        "apply_rates",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{},
+			distribution: func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64 {
+				return map[*sdk.AccAddress]int64{
+					&admin:      570, // 1000 - 400 - 40 (10% burn rate) + 10 (commission of the second transfer)
+					&recipient1: 280, // 400 - 100 - 10 (10% burn rate) - 10 (10% commission)
+					&recipient2: 100,
+				}
+			},
+		},
+		{
+			name: "ignore_burn_rate",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{
+				IgnoreBurnRate: true,
+			},
+			distribution: func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64 {
+				return map[*sdk.AccAddress]int64{
+					&admin:      610, // 1000 - 400 + 10 (commission of the second transfer)
+					&recipient1: 290, // 400 - 100 - 10 (10% commission)
+					&recipient2: 100,
+				}
+			},
+		},
+		{
+			name: "ignore_send_commission_rate",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{
+				IgnoreSendCommissionRate: true,
+			},
+			distribution: func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64 {
+				return map[*sdk.AccAddress]int64{
+					&admin:      560, // 1000 - 400 - 40 (10% burn rate)
+					&recipient1: 290, // 400 - 100 - 10 (10% burn rate)
+					&recipient2: 100,
+				}
+			},
+		},
+		{
+			name: "ignore_both",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{
+				IgnoreBurnRate:           true,
+				IgnoreSendCommissionRate: true,
+			},
+			distribution: func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64 {
+				return map[*sdk.AccAddress]int64{
+					&admin:      600, // 1000 - 400
+					&recipient1: 300, // 400 - 100
+					&recipient2: 100,
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		admin := chain.GenAccount()
+		recipient1 := chain.GenAccount()
+		recipient2 := chain.GenAccount()
+		chain.FundAccountWithOptions(ctx, t, recipient1, integration.BalancesOptions{
+			Amount: sdkmath.NewInt(500_000),
+		})
+
+		denom := issueFTWithExtensionContract(
+			ctx, t, chain, admin, testcontracts.CommissionOverrideWasm, tt.issuanceMsg,
+			sdkmath.LegacyMustNewDecFromStr("0.10"), sdkmath.LegacyMustNewDecFromStr("0.10"),
+		)
+
+		_, err := client.BroadcastTx(
+			ctx,
+			chain.ClientContext.WithFromAddress(admin),
+			chain.TxFactoryAuto(),
+			&banktypes.MsgSend{
+				FromAddress: admin.String(),
+				ToAddress:   recipient1.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(400))),
+			},
+		)
+		requireT.NoError(err, tt.name)
+
+		_, err = client.BroadcastTx(
+			ctx,
+			chain.ClientContext.WithFromAddress(recipient1),
+			chain.TxFactoryAuto(),
+			&banktypes.MsgSend{
+				FromAddress: recipient1.String(),
+				ToAddress:   recipient2.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+			},
+		)
+		requireT.NoError(err, tt.name)
+
+		assertCoinDistribution(ctx, chain.ClientContext, t, denom, tt.distribution(admin, recipient1, recipient2))
+	}
+}
+
+// TestAssetFTExtensionGasBurner checks that the gas consumed by the extension is charged to the sender.
+func TestAssetFTExtensionGasBurner(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTXChainTestingContext(t)
+
+	requireT := require.New(t)
+
+	gasUsed := make([]int64, 0, 2)
+	for _, iterations := range []uint64{0, 500} {
+		admin := chain.GenAccount()
+		recipient := chain.GenAccount()
+
+		denom := issueFTWithExtensionContract(
+			ctx, t, chain, admin, testcontracts.GasBurnerWasm,
+			testcontracts.GasBurnerIssuanceMsg{Iterations: iterations},
+			sdkmath.LegacyNewDec(0), sdkmath.LegacyNewDec(0),
+		)
+
+		res, err := client.BroadcastTx(
+			ctx,
+			chain.ClientContext.WithFromAddress(admin),
+			chain.TxFactoryAuto(),
+			&banktypes.MsgSend{
+				FromAddress: admin.String(),
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
+			},
+		)
+		requireT.NoError(err)
+		gasUsed = append(gasUsed, res.GasUsed)
+
+		assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
+			&admin:     900,
+			&recipient: 100,
+		})
+	}
+
+	requireT.Greater(gasUsed[1], gasUsed[0])
+}
+
+func issueFTWithExtensionContract(
+	ctx context.Context,
+	t *testing.T,
+	chain integration.TXChain,
+	admin sdk.AccAddress,
+	wasm []byte,
+	issuanceMsg any,
+	burnRate, sendCommissionRate sdkmath.LegacyDec,
+) string {
+	t.Helper()
+
+	requireT := require.New(t)
+
+	chain.FundAccountWithOptions(ctx, t, admin, integration.BalancesOptions{
+		Amount: chain.QueryAssetFTParams(ctx, t).IssueFee.Amount.
+			Add(sdkmath.NewInt(1_000_000)). // added 1 million for smart contract upload
+			Add(sdkmath.NewInt(2 * 500_000)),
+	})
+
+	codeID, err := chain.Wasm.DeployWASMContract(
+		ctx, chain.TxFactory().WithSimulateAndExecute(true), admin, wasm,
+	)
+	requireT.NoError(err)
+
+	issuanceMsgBytes, err := json.Marshal(issuanceMsg)
+	requireT.NoError(err)
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        admin.String(),
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_extension,
+		},
+		ExtensionSettings: &assetfttypes.ExtensionIssueSettings{
+			CodeId:      codeID,
+			Label:       "testing-extension",
+			IssuanceMsg: issuanceMsgBytes,
+		},
+		BurnRate:           burnRate,
+		SendCommissionRate: sendCommissionRate,
+	}
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(admin),
+		chain.TxFactoryAuto(),
+		issueMsg,
+	)
+	requireT.NoError(err)
+
+	return assetfttypes.BuildDenom(issueMsg.Subunit, admin)
+}
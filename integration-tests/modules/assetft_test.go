@@ -12,6 +12,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9191,3 +9192,123 @@ func TestAssetFTClearAdmin(t *testing.T) {
 	requireT.Error(err)
 	assertT.True(cosmoserrors.ErrUnauthorized.Is(err))
 }
+
+// TestAssetFTWatchComplianceActions tests that the compliance actions affecting the account are delivered to
+// the subscriber.
+func TestAssetFTWatchComplianceActions(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTXChainTestingContext(t)
+
+	requireT := require.New(t)
+
+	issuer := chain.GenAccount()
+	account := chain.GenAccount()
+	issueFeeAmount := chain.QueryAssetFTParams(ctx, t).IssueFee.Amount
+
+	chain.FundAccountWithOptions(ctx, t, issuer, integration.BalancesOptions{
+		Messages: []sdk.Msg{
+			&assetfttypes.MsgIssue{},
+			&banktypes.MsgSend{},
+			&assetfttypes.MsgFreeze{},
+			&assetfttypes.MsgClawback{},
+		},
+		Amount: issueFeeAmount,
+	})
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_freezing,
+			assetfttypes.Feature_clawback,
+		},
+	}
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   account.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1000))),
+	}
+	_, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, sendMsg)),
+		issueMsg, sendMsg,
+	)
+	requireT.NoError(err)
+
+	rpcClient, err := rpchttp.New(chain.ChainSettings.RPCAddress, "/websocket")
+	requireT.NoError(err)
+	requireT.NoError(rpcClient.Start())
+	t.Cleanup(func() {
+		requireT.NoError(rpcClient.Stop())
+	})
+
+	watchCtx, watchCancel := context.WithTimeout(ctx, time.Minute)
+	defer watchCancel()
+	actions, err := client.WatchComplianceActions(watchCtx, chain.ClientContext.WithRPCClient(rpcClient), account)
+	requireT.NoError(err)
+
+	freezeMsg := &assetfttypes.MsgFreeze{
+		Sender:  issuer.String(),
+		Account: account.String(),
+		Coin:    sdk.NewCoin(denom, sdkmath.NewInt(300)),
+	}
+	freezeRes, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
+	)
+	requireT.NoError(err)
+
+	clawbackMsg := &assetfttypes.MsgClawback{
+		Sender:  issuer.String(),
+		Account: account.String(),
+		Coin:    sdk.NewCoin(denom, sdkmath.NewInt(400)),
+	}
+	clawbackRes, err := client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(clawbackMsg)),
+		clawbackMsg,
+	)
+	requireT.NoError(err)
+
+	expectedActions := []client.ComplianceAction{
+		{
+			Height: freezeRes.Height,
+			TxHash: freezeRes.TxHash,
+			Event: assetfttypes.EventComplianceAction{
+				Account: account.String(),
+				Denom:   denom,
+				Action:  assetfttypes.COMPLIANCE_ACTION_TYPE_FREEZE,
+				Amount:  sdkmath.NewInt(300),
+				Sender:  issuer.String(),
+			},
+		},
+		{
+			Height: clawbackRes.Height,
+			TxHash: clawbackRes.TxHash,
+			Event: assetfttypes.EventComplianceAction{
+				Account: account.String(),
+				Denom:   denom,
+				Action:  assetfttypes.COMPLIANCE_ACTION_TYPE_CLAWBACK,
+				Amount:  sdkmath.NewInt(400),
+				Sender:  issuer.String(),
+			},
+		},
+	}
+	for _, expectedAction := range expectedActions {
+		select {
+		case <-watchCtx.Done():
+			requireT.FailNow("compliance action not received", expectedAction.Event.Action.String())
+		case action := <-actions:
+			requireT.Equal(expectedAction, action)
+		}
+	}
+}
@@ -251,6 +251,41 @@ func TestAuthUnexpectedSequenceNumber(t *testing.T) {
 	require.True(t, cosmoserrors.ErrWrongSequence.Is(err))
 }
 
+// TestAuthTxTimeoutTimestamp tests that the transaction is rejected once its timeout timestamp passes.
+func TestAuthTxTimeoutTimestamp(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTXChainTestingContext(t)
+
+	sender := chain.GenAccount()
+
+	msg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   sender.String(),
+		Amount:      sdk.NewCoins(chain.NewCoin(sdkmath.NewInt(1))),
+	}
+	chain.FundAccountWithOptions(ctx, t, sender, integration.BalancesOptions{
+		Messages: []sdk.Msg{msg, msg},
+		Amount:   sdkmath.NewInt(2),
+	})
+
+	clientCtx := chain.ClientContext.WithFromAddress(sender)
+
+	// the timestamp in the past
+	_, err := client.BroadcastTx(ctx,
+		clientCtx,
+		client.WithTimeoutDuration(chain.TxFactory(), -time.Minute).WithGas(chain.GasLimitByMsgs(msg)),
+		msg)
+	require.True(t, cosmoserrors.ErrTxTimeout.Is(err))
+
+	// the timestamp in the future
+	_, err = client.BroadcastTx(ctx,
+		clientCtx,
+		client.WithTimeoutDuration(chain.TxFactory(), time.Minute).WithGas(chain.GasLimitByMsgs(msg)),
+		msg)
+	require.NoError(t, err)
+}
+
 // TestUnorderedTransactions tests that unordered transactions are processed correctly.
 // It sends multiple transactions with the same sender using asynchronous broadcast mode,
 // and checks that they are processed within at most two consecutive blocks,
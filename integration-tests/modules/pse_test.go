@@ -207,15 +207,21 @@ func TestPSEDistribution(t *testing.T) {
 	govParams, err := chain.Governance.QueryGovParams(ctx)
 	requireT.NoError(err)
 	distributionStartTime := time.Now().Add(10 * time.Second).Add(*govParams.ExpeditedVotingPeriod)
+	// the distributions are processed at the end of the minute epochs, so they are scheduled a minute apart
+	distributionTimes := []time.Time{
+		distributionStartTime.Add(60 * time.Second),
+		distributionStartTime.Add(120 * time.Second),
+		distributionStartTime.Add(180 * time.Second),
+	}
 
 	chain.Governance.ExpeditedProposalFromMsgAndVote(
 		ctx, t, nil, "-", "-", "-", govtypesv1.OptionYes,
 		&psetypes.MsgUpdateDistributionSchedule{
 			Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 			Schedule: []psetypes.ScheduledDistribution{
-				{Timestamp: uint64(distributionStartTime.Add(30 * time.Second).Unix()), Allocations: allocations},
-				{Timestamp: uint64(distributionStartTime.Add(60 * time.Second).Unix()), Allocations: allocations},
-				{Timestamp: uint64(distributionStartTime.Add(90 * time.Second).Unix()), Allocations: allocations},
+				{Timestamp: uint64(distributionTimes[0].Unix()), Allocations: allocations},
+				{Timestamp: uint64(distributionTimes[1].Unix()), Allocations: allocations},
+				{Timestamp: uint64(distributionTimes[2].Unix()), Allocations: allocations},
 			},
 		},
 		&psetypes.MsgUpdateClearingAccountMappings{
@@ -243,7 +249,7 @@ func TestPSEDistribution(t *testing.T) {
 	requireT.NoError(err)
 	height := header.Height
 
-	height, events, err := awaitScheduledDistributionEvent(ctx, chain, height)
+	height, events, err := awaitScheduledDistributionEvent(ctx, t, chain, height, distributionTimes[0])
 	requireT.NoError(err)
 	t.Logf("Distribution 1 at height: %d", height)
 
@@ -290,7 +296,7 @@ func TestPSEDistribution(t *testing.T) {
 	// ============================================================
 	t.Log("=== Distribution 2: Re-included delegator should receive rewards ===")
 
-	height, events, err = awaitScheduledDistributionEvent(ctx, chain, height)
+	height, events, err = awaitScheduledDistributionEvent(ctx, t, chain, height, distributionTimes[1])
 	requireT.NoError(err)
 	t.Logf("Distribution 2 at height: %d", height)
 
@@ -328,7 +334,7 @@ func TestPSEDistribution(t *testing.T) {
 	// ============================================================
 	t.Log("=== Distribution 3: All delegators receive rewards ===")
 
-	height, events, err = awaitScheduledDistributionEvent(ctx, chain, height)
+	height, events, err = awaitScheduledDistributionEvent(ctx, t, chain, height, distributionTimes[2])
 	requireT.NoError(err)
 	t.Logf("Distribution 3 at height: %d", height)
 
@@ -827,12 +833,18 @@ func (e communityDistributedEvent) find(delegatorAddress string) *psetypes.Event
 
 func awaitScheduledDistributionEvent(
 	ctx context.Context,
+	t *testing.T,
 	chain integration.TXChain,
 	startHeight int64,
+	scheduledTime time.Time,
 ) (int64, communityDistributedEvent, error) {
+	// the distribution is processed at the end of the first minute epoch after the scheduled time, so wait for the
+	// chain time to pass it instead of relying on the local clock
+	chain.AwaitUntilChainTime(ctx, t, scheduledTime)
+
 	var observedHeight int64
 	err := chain.AwaitState(ctx, func(ctx context.Context) error {
-		query := fmt.Sprintf("tx.pse.v1.EventAllocationDistributed.mode='EndBlock' AND block.height>%d", startHeight)
+		query := fmt.Sprintf("tx.pse.v1.EventAllocationDistributed.mode='BeginBlock' AND block.height>%d", startHeight)
 		blocks, err := chain.ClientContext.RPCClient().BlockSearch(ctx, query, nil, nil, "")
 		if err != nil {
 			return err
@@ -844,7 +856,8 @@ func awaitScheduledDistributionEvent(
 		observedHeight = blocks.Blocks[0].Block.Height
 		return nil
 	},
-		integration.WithAwaitStateTimeout(40*time.Second),
+		// the epochs are processed by the begin blocker, so the distribution might wait for the whole epoch
+		integration.WithAwaitStateTimeout(90*time.Second),
 	)
 	if err != nil {
 		return 0, nil, err
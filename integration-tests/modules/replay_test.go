@@ -0,0 +1,38 @@
+//go:build integrationtests
+
+package modules
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/tokenize-x/tx-chain/v7/integration-tests"
+	"github.com/tokenize-x/tx-chain/v7/testutil/integration"
+)
+
+// TestReplayRecording replays the queries of the recording set with the tx-replay-recording flag against txd node
+// and reports the responses which differ from the recorded ones.
+func TestReplayRecording(t *testing.T) {
+	t.Parallel()
+
+	path := integrationtests.TXReplayRecording()
+	if path == "" {
+		t.Skip("no recording to replay")
+	}
+
+	ctx, chain := integrationtests.NewTXChainTestingContext(t)
+	requireT := require.New(t)
+
+	recording, err := integration.LoadRecording(path)
+	requireT.NoError(err)
+
+	diffs, err := integration.Replay(ctx, chain.ClientContext.GRPCClient(), recording)
+	requireT.NoError(err)
+	for _, diff := range diffs {
+		diffJSON, err := json.MarshalIndent(diff, "", "  ")
+		requireT.NoError(err)
+		t.Errorf("Replayed response of %s differs from the recorded one:\n%s", diff.Method, diffJSON)
+	}
+}
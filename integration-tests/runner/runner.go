@@ -0,0 +1,363 @@
+// Package runner executes integration test packages and retries the tests which failed because of
+// the infrastructure rather than because of the broken assertions.
+package runner
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FailureClass is the class of the test failure.
+type FailureClass string
+
+// Failure classes.
+const (
+	// FailureClassNone means the test has not failed.
+	FailureClassNone FailureClass = ""
+	// FailureClassChainUnreachable means the chain node could not be reached by the test.
+	FailureClassChainUnreachable FailureClass = "chain_unreachable"
+	// FailureClassTimeout means the test has not observed the expected state on time.
+	FailureClassTimeout FailureClass = "timeout"
+	// FailureClassAssertion means the test assertion has failed.
+	FailureClassAssertion FailureClass = "assertion"
+)
+
+// IsInfra returns true if the failure is caused by the infrastructure, so the test might be retried.
+func (c FailureClass) IsInfra() bool {
+	return c == FailureClassChainUnreachable || c == FailureClassTimeout
+}
+
+// the order matters, the unreachable patterns are checked first since the unreachable node is usually reported
+// together with the timeout.
+var (
+	chainUnreachablePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`connection refused`),
+		regexp.MustCompile(`connection reset by peer`),
+		regexp.MustCompile(`no such host`),
+		regexp.MustCompile(`code = Unavailable`),
+		regexp.MustCompile(`error reading server preface`),
+		regexp.MustCompile(`post failed: Post "http`),
+		regexp.MustCompile(`broken pipe`),
+	}
+	timeoutPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`context deadline exceeded`),
+		regexp.MustCompile(`code = DeadlineExceeded`),
+		regexp.MustCompile(`timed out waiting for tx to be included in a block`),
+		regexp.MustCompile(`tx already exists in cache`),
+		regexp.MustCompile(`i/o timeout`),
+	}
+)
+
+// errorTraceMarker starts the failure report of the testify assertion.
+const errorTraceMarker = "Error Trace:"
+
+// Classify returns the failure class based on the output of the single test. If the output contains the failed
+// assertions, each of them is classified separately and any assertion not caused by the infrastructure makes the
+// failure the assertion one, even if the infra errors are logged by the test too.
+func Classify(output string) FailureClass {
+	traces := strings.Split(output, errorTraceMarker)
+	if len(traces) == 1 {
+		return classifyText(output)
+	}
+
+	classes := make([]FailureClass, 0, len(traces)-1)
+	for _, trace := range traces[1:] {
+		classes = append(classes, classifyText(trace))
+	}
+
+	return mergeClasses(classes)
+}
+
+func classifyText(text string) FailureClass {
+	for _, p := range chainUnreachablePatterns {
+		if p.MatchString(text) {
+			return FailureClassChainUnreachable
+		}
+	}
+	for _, p := range timeoutPatterns {
+		if p.MatchString(text) {
+			return FailureClassTimeout
+		}
+	}
+
+	return FailureClassAssertion
+}
+
+// mergeClasses returns the class of the failure consisting of the failures of the given classes, the assertion
+// failure takes precedence over the infra ones.
+func mergeClasses(classes []FailureClass) FailureClass {
+	if len(classes) == 0 {
+		return FailureClassAssertion
+	}
+
+	class := FailureClassTimeout
+	for _, c := range classes {
+		switch c {
+		case FailureClassAssertion:
+			return FailureClassAssertion
+		case FailureClassChainUnreachable:
+			class = FailureClassChainUnreachable
+		}
+	}
+
+	return class
+}
+
+// Config is the runner configuration.
+type Config struct {
+	// PackagePath is the path of the go package containing the tests.
+	PackagePath string
+	// Flags are passed to the `go test` command.
+	Flags []string
+	// MaxRetries is the maximum number of the retries executed for the infra-class failures.
+	MaxRetries int
+	// ReportFile is the path of the file the flake report is stored to. The report is not stored if empty.
+	ReportFile string
+	// Output receives the output of the tests. The output is discarded if nil.
+	Output io.Writer
+}
+
+// AttemptReport is the result of the single test attempt.
+type AttemptReport struct {
+	Passed  bool          `json:"passed"`
+	Class   FailureClass  `json:"class,omitempty"`
+	Elapsed time.Duration `json:"elapsed"`
+	Output  string        `json:"output,omitempty"`
+}
+
+// TestReport is the result of all the attempts of the test.
+type TestReport struct {
+	Name        string          `json:"name"`
+	Passed      bool            `json:"passed"`
+	Quarantined bool            `json:"quarantined"`
+	Attempts    []AttemptReport `json:"attempts"`
+}
+
+// Report is the machine-readable flake report.
+type Report struct {
+	PackagePath string       `json:"package_path"`
+	StartedAt   time.Time    `json:"started_at"`
+	FinishedAt  time.Time    `json:"finished_at"`
+	Passed      bool         `json:"passed"`
+	Tests       []TestReport `json:"tests"`
+	// Quarantined contains the tests which failed because of the infrastructure and passed on retry.
+	Quarantined []string `json:"quarantined"`
+}
+
+// Run runs the tests of the package and retries the ones which failed because of the infrastructure.
+// Each retry executes a new test process, so the tests generate and fund fresh accounts.
+func Run(ctx context.Context, cfg Config) (Report, error) {
+	report := Report{
+		PackagePath: cfg.PackagePath,
+		StartedAt:   time.Now().UTC(),
+	}
+
+	results, err := runTests(ctx, cfg, nil)
+	if err != nil {
+		return Report{}, err
+	}
+
+	tests := map[string]*TestReport{}
+	for name, res := range results {
+		tests[name] = &TestReport{
+			Name:     name,
+			Passed:   res.Passed,
+			Attempts: []AttemptReport{res},
+		}
+	}
+
+	for retry := 0; retry < cfg.MaxRetries; retry++ {
+		toRetry := make([]string, 0)
+		for name, test := range tests {
+			lastAttempt := test.Attempts[len(test.Attempts)-1]
+			if !lastAttempt.Passed && lastAttempt.Class.IsInfra() {
+				toRetry = append(toRetry, name)
+			}
+		}
+		if len(toRetry) == 0 {
+			break
+		}
+
+		results, err := runTests(ctx, cfg, toRetry)
+		if err != nil {
+			return Report{}, err
+		}
+		for _, name := range toRetry {
+			res, ok := results[name]
+			if !ok {
+				// the test has not been executed at all, so we treat it as the infra failure
+				res = AttemptReport{Class: FailureClassChainUnreachable}
+			}
+			test := tests[name]
+			test.Attempts = append(test.Attempts, res)
+			test.Passed = res.Passed
+			test.Quarantined = res.Passed
+		}
+	}
+
+	report.Passed = true
+	for _, test := range tests {
+		report.Tests = append(report.Tests, *test)
+		if !test.Passed {
+			report.Passed = false
+		}
+		if test.Quarantined {
+			report.Quarantined = append(report.Quarantined, test.Name)
+		}
+	}
+	sort.Slice(report.Tests, func(i, j int) bool {
+		return report.Tests[i].Name < report.Tests[j].Name
+	})
+	sort.Strings(report.Quarantined)
+	report.FinishedAt = time.Now().UTC()
+
+	if cfg.ReportFile != "" {
+		if err := storeReport(cfg.ReportFile, report); err != nil {
+			return Report{}, err
+		}
+	}
+
+	return report, nil
+}
+
+func runTests(ctx context.Context, cfg Config, testNames []string) (map[string]AttemptReport, error) {
+	args := append([]string{"test", "-json"}, cfg.Flags...)
+	if len(testNames) > 0 {
+		args = append(args, "-run", "^("+strings.Join(testNames, "|")+")$")
+	}
+	args = append(args, cfg.PackagePath)
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	results, parseErr := parseEvents(stdout, cfg.Output)
+	waitErr := cmd.Wait()
+	if ctx.Err() != nil {
+		return nil, errors.WithStack(ctx.Err())
+	}
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	// the command fails if any of the tests fails, so the error is returned only if there is no failed test
+	// to explain it, e.g. when the package can't be compiled, the retried tests missing in the results are
+	// handled by the caller
+	if waitErr != nil && len(testNames) == 0 {
+		for _, res := range results {
+			if !res.Passed {
+				return results, nil
+			}
+		}
+		return nil, errors.Wrapf(waitErr, "go test failed without reporting a failed test")
+	}
+
+	return results, nil
+}
+
+// testEvent is the event produced by the `go test -json` command.
+type testEvent struct {
+	Action  string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+func parseEvents(r io.Reader, output io.Writer) (map[string]AttemptReport, error) {
+	// outputs contain the output of the top-level tests including their subtests, ownOutputs contain the output
+	// of each test and subtest on its own, so each failure is classified separately
+	outputs := map[string]*strings.Builder{}
+	ownOutputs := map[string]*strings.Builder{}
+	failedSubtests := map[string]bool{}
+	classes := map[string][]FailureClass{}
+	results := map[string]AttemptReport{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var ev testEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			// non-JSON lines are printed by the build failures
+			if output != nil {
+				_, _ = output.Write(append(scanner.Bytes(), '\n'))
+			}
+			continue
+		}
+		if ev.Output != "" && output != nil {
+			_, _ = io.WriteString(output, ev.Output)
+		}
+
+		// only the top-level tests are retried, the output of subtests is attributed to the parent
+		if ev.Test == "" {
+			continue
+		}
+		name := strings.SplitN(ev.Test, "/", 2)[0]
+		if _, ok := outputs[name]; !ok {
+			outputs[name] = &strings.Builder{}
+		}
+		if _, ok := ownOutputs[ev.Test]; !ok {
+			ownOutputs[ev.Test] = &strings.Builder{}
+		}
+
+		switch ev.Action {
+		case "output":
+			outputs[name].WriteString(ev.Output)
+			ownOutputs[ev.Test].WriteString(ev.Output)
+		case "pass", "skip":
+			if ev.Test != name {
+				continue
+			}
+			results[name] = AttemptReport{
+				Passed:  true,
+				Elapsed: time.Duration(ev.Elapsed * float64(time.Second)),
+			}
+		case "fail":
+			ownOutput := ownOutputs[ev.Test].String()
+			// the test failed only because of its failed subtests is already classified by them
+			if !failedSubtests[ev.Test] || strings.Contains(ownOutput, errorTraceMarker) {
+				classes[name] = append(classes[name], Classify(ownOutput))
+			}
+			if i := strings.LastIndex(ev.Test, "/"); i >= 0 {
+				failedSubtests[ev.Test[:i]] = true
+			}
+			if ev.Test != name {
+				continue
+			}
+			results[name] = AttemptReport{
+				Class:   mergeClasses(classes[name]),
+				Elapsed: time.Duration(ev.Elapsed * float64(time.Second)),
+				Output:  outputs[name].String(),
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return results, nil
+}
+
+func storeReport(path string, report Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(os.WriteFile(path, data, 0o600))
+}
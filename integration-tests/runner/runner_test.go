@@ -0,0 +1,104 @@
+package runner
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		name   string
+		output string
+		class  FailureClass
+	}{
+		{
+			name:   "unreachable",
+			output: `rpc error: code = Unavailable desc = connection error: dial tcp 127.0.0.1:9090: connect: refused`,
+			class:  FailureClassChainUnreachable,
+		},
+		{
+			name:   "timeout",
+			output: `Error: context deadline exceeded`,
+			class:  FailureClassTimeout,
+		},
+		{
+			name:   "tx_timeout",
+			output: `timed out waiting for tx to be included in a block`,
+			class:  FailureClassTimeout,
+		},
+		{
+			name:   "assertion",
+			output: "Error: Not equal:\n expected: 10\n actual  : 9",
+			class:  FailureClassAssertion,
+		},
+		{
+			// the sequence mismatch is caused by the test itself, not by the infrastructure
+			name:   "sequence_mismatch",
+			output: "Error: account sequence mismatch, expected 5, got 4: incorrect account sequence",
+			class:  FailureClassAssertion,
+		},
+		{
+			name: "assertion_with_infra_logs",
+			output: "retrying: connection refused\n" +
+				"    Error Trace:\tfoo_test.go:10\n    Error:      \tNot equal:\n expected: 10\n actual  : 9\n" +
+				"--- FAIL: TestFoo (1.00s)\n",
+			class: FailureClassAssertion,
+		},
+		{
+			name: "infra_assertion",
+			output: "    Error Trace:\tfoo_test.go:10\n    Error:      \tReceived unexpected error:\n" +
+				"context deadline exceeded\n--- FAIL: TestFoo (1.00s)\n",
+			class: FailureClassTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.class, Classify(tc.output))
+		})
+	}
+}
+
+func TestParseEvents(t *testing.T) {
+	events := strings.Join([]string{
+		`{"Action":"run","Test":"TestA"}`,
+		`{"Action":"output","Test":"TestA","Output":"ok\n"}`,
+		`{"Action":"pass","Test":"TestA","Elapsed":1.5}`,
+		`{"Action":"run","Test":"TestB"}`,
+		`{"Action":"run","Test":"TestB/sub"}`,
+		`{"Action":"output","Test":"TestB/sub","Output":"code = Unavailable\n"}`,
+		`{"Action":"fail","Test":"TestB/sub","Elapsed":1}`,
+		`{"Action":"fail","Test":"TestB","Elapsed":2}`,
+		`{"Action":"run","Test":"TestC"}`,
+		`{"Action":"output","Test":"TestC","Output":"Error: Not equal\n"}`,
+		`{"Action":"fail","Test":"TestC","Elapsed":3}`,
+		`{"Action":"run","Test":"TestD"}`,
+		`{"Action":"run","Test":"TestD/infra"}`,
+		`{"Action":"output","Test":"TestD/infra","Output":"code = Unavailable\n"}`,
+		`{"Action":"fail","Test":"TestD/infra","Elapsed":1}`,
+		`{"Action":"run","Test":"TestD/assertion"}`,
+		`{"Action":"output","Test":"TestD/assertion","Output":"Error Trace:\tfoo_test.go:10\n"}`,
+		`{"Action":"output","Test":"TestD/assertion","Output":"Error: Not equal\n"}`,
+		`{"Action":"fail","Test":"TestD/assertion","Elapsed":1}`,
+		`{"Action":"output","Test":"TestD","Output":"--- FAIL: TestD (2.00s)\n"}`,
+		`{"Action":"fail","Test":"TestD","Elapsed":2}`,
+		`FAIL`,
+	}, "\n")
+
+	results, err := parseEvents(strings.NewReader(events), nil)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	require.True(t, results["TestA"].Passed)
+	require.False(t, results["TestB"].Passed)
+	require.Equal(t, FailureClassChainUnreachable, results["TestB"].Class)
+	require.True(t, results["TestB"].Class.IsInfra())
+	require.False(t, results["TestC"].Passed)
+	require.Equal(t, FailureClassAssertion, results["TestC"].Class)
+	require.False(t, results["TestC"].Class.IsInfra())
+	// the assertion failure of one subtest isn't hidden by the infra failure of another one
+	require.False(t, results["TestD"].Passed)
+	require.Equal(t, FailureClassAssertion, results["TestD"].Class)
+}
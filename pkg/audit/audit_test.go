@@ -0,0 +1,88 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/audit"
+)
+
+func TestWriterAndVerify(t *testing.T) {
+	requireT := require.New(t)
+
+	dir := t.TempDir()
+	// the small size forces the rotation after each block
+	writer, err := audit.NewWriter(dir, 200)
+	requireT.NoError(err)
+
+	pairs := func(store string) []*storetypes.StoreKVPair {
+		return []*storetypes.StoreKVPair{
+			{StoreKey: store, Key: []byte{0x01}, Value: []byte("value")},
+			{StoreKey: store, Key: []byte{0x02}, Delete: true},
+		}
+	}
+	requireT.NoError(writer.Write(1, pairs("assetft")))
+	requireT.NoError(writer.Write(2, nil))
+	requireT.NoError(writer.Write(3, pairs("pse")))
+	requireT.NoError(writer.Close())
+
+	// the chain is resumed after the restart
+	writer, err = audit.NewWriter(dir, 200)
+	requireT.NoError(err)
+	requireT.NoError(writer.Write(4, pairs("assetft")))
+	requireT.NoError(writer.Close())
+
+	result, err := audit.Verify(dir)
+	requireT.NoError(err)
+	requireT.EqualValues(6, result.Records)
+	requireT.EqualValues(4, result.LastHeight)
+	requireT.Greater(result.Files, 1)
+
+	// the modification of any record breaks the chain
+	files, err := filepath.Glob(filepath.Join(dir, "audit-*.jsonl"))
+	requireT.NoError(err)
+	content, err := os.ReadFile(files[0])
+	requireT.NoError(err)
+	requireT.NoError(os.WriteFile(files[0], bytes.Replace(content, []byte(`"height":1`), []byte(`"height":0`), 1), 0o600))
+
+	_, err = audit.Verify(dir)
+	requireT.ErrorContains(err, "hash mismatch")
+
+	// the removal of the file breaks the chain
+	requireT.NoError(os.WriteFile(files[0], content, 0o600))
+	_, err = audit.Verify(dir)
+	requireT.NoError(err)
+	requireT.NoError(os.Remove(files[0]))
+	_, err = audit.Verify(dir)
+	requireT.ErrorContains(err, "expected sequence")
+}
+
+func TestListener(t *testing.T) {
+	requireT := require.New(t)
+
+	dir := t.TempDir()
+	writer, err := audit.NewWriter(dir, audit.DefaultMaxFileSize)
+	requireT.NoError(err)
+	listener := audit.NewListener(writer, []string{"assetft"})
+
+	// only the writes of the audited stores are recorded
+	ctx := sdk.Context{}.WithContext(context.Background()).WithBlockHeight(5)
+	requireT.NoError(listener.ListenCommit(ctx, abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+		{StoreKey: "assetft", Key: []byte{0x01}, Value: []byte("value")},
+		{StoreKey: "bank", Key: []byte{0x01}, Value: []byte("value")},
+	}))
+	requireT.NoError(writer.Close())
+
+	result, err := audit.Verify(dir)
+	requireT.NoError(err)
+	requireT.EqualValues(1, result.Records)
+	requireT.EqualValues(5, result.LastHeight)
+}
@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ storetypes.ABCIListener = &Listener{}
+
+// Listener is the ABCI listener recording the state writes committed in each block to the audit log.
+// The stores must be registered with the listeners of the commit multi store to be present in the change set.
+type Listener struct {
+	writer *Writer
+	stores map[string]struct{}
+}
+
+// NewListener returns the listener recording the writes of the provided stores.
+func NewListener(writer *Writer, stores []string) *Listener {
+	storeSet := make(map[string]struct{}, len(stores))
+	for _, store := range stores {
+		storeSet[store] = struct{}{}
+	}
+
+	return &Listener{
+		writer: writer,
+		stores: storeSet,
+	}
+}
+
+// ListenFinalizeBlock implements the storetypes.ABCIListener interface, the writes are recorded on commit only.
+func (l *Listener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit records the writes of the audited stores.
+func (l *Listener) ListenCommit(
+	ctx context.Context,
+	_ abci.ResponseCommit,
+	changeSet []*storetypes.StoreKVPair,
+) error {
+	pairs := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		if _, ok := l.stores[pair.StoreKey]; ok {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	return l.writer.Write(sdk.UnwrapSDKContext(ctx).BlockHeight(), pairs)
+}
@@ -0,0 +1,35 @@
+// Package audit records the state writes of the selected stores to the append-only hash-chained files.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Record is the single state write recorded to the audit log. Each record contains the hash of the previous one,
+// so any modification, removal or reordering of the records breaks the chain.
+type Record struct {
+	Seq      uint64 `json:"seq"`
+	Height   int64  `json:"height"`
+	Store    string `json:"store"`
+	Delete   bool   `json:"delete"`
+	Key      []byte `json:"key"`
+	Value    []byte `json:"value,omitempty"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// ComputeHash returns the hash of the record computed over all its fields except the hash itself.
+func (r Record) ComputeHash() (string, error) {
+	r.Hash = ""
+	bz, err := json.Marshal(r)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal audit record")
+	}
+	sum := sha256.Sum256(bz)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,79 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// VerifyResult is the summary of the verified audit log.
+type VerifyResult struct {
+	Files      int    `json:"files"`
+	Records    uint64 `json:"records"`
+	LastHeight int64  `json:"last_height"`
+	LastHash   string `json:"last_hash"`
+}
+
+// Verify checks the chain of the records stored in the audit files of the directory. It returns the error pointing
+// to the first record which is modified or doesn't follow the previous one.
+func Verify(dir string) (VerifyResult, error) {
+	files, err := listFiles(dir)
+	if err != nil {
+		return VerifyResult{}, err
+	}
+	if len(files) == 0 {
+		return VerifyResult{}, errors.Errorf("no audit files found in %s", dir)
+	}
+
+	result := VerifyResult{}
+	for _, path := range files {
+		if err := verifyFile(path, &result); err != nil {
+			return result, err
+		}
+		result.Files++
+	}
+
+	return result, nil
+}
+
+func verifyFile(path string, result *VerifyResult) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open audit file %s", path)
+	}
+	defer file.Close()
+
+	scanner := newScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return errors.Wrapf(err, "%s:%d: invalid audit record", path, line)
+		}
+		if record.Seq != result.Records+1 {
+			return errors.Errorf("%s:%d: expected sequence %d, got %d", path, line, result.Records+1, record.Seq)
+		}
+		if record.PrevHash != result.LastHash {
+			return errors.Errorf("%s:%d: previous hash doesn't match the hash of record %d", path, line, result.Records)
+		}
+		if record.Height < result.LastHeight {
+			return errors.Errorf(
+				"%s:%d: height %d is lower than the height %d of the previous record",
+				path, line, record.Height, result.LastHeight,
+			)
+		}
+		hash, err := record.ComputeHash()
+		if err != nil {
+			return err
+		}
+		if hash != record.Hash {
+			return errors.Errorf("%s:%d: record %d is modified, hash mismatch", path, line, record.Seq)
+		}
+
+		result.Records = record.Seq
+		result.LastHeight = record.Height
+		result.LastHash = record.Hash
+	}
+
+	return errors.Wrapf(scanner.Err(), "failed to read audit file %s", path)
+}
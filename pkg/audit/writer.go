@@ -0,0 +1,201 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxFileSize is the default size of the audit file after which the next file is started.
+	DefaultMaxFileSize = 100 * 1024 * 1024
+
+	filePattern = "audit-*.jsonl"
+	fileFormat  = "audit-%012d.jsonl"
+)
+
+// Writer appends the records to the audit files in the directory, starting the next file once the current one
+// exceeds the max size. The chain continues across the files and the node restarts.
+type Writer struct {
+	dir         string
+	maxFileSize int64
+
+	mu        sync.Mutex
+	file      *os.File
+	fileIndex uint64
+	fileSize  int64
+	seq       uint64
+	lastHash  string
+}
+
+// NewWriter returns the writer resuming the chain from the last record found in the directory.
+func NewWriter(dir string, maxFileSize int64) (*Writer, error) {
+	if maxFileSize <= 0 {
+		return nil, errors.Errorf("max audit file size must be positive, got %d", maxFileSize)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create audit directory %s", dir)
+	}
+
+	w := &Writer{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+	}
+
+	files, err := listFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return w, w.openFile(1)
+	}
+
+	lastFile := files[len(files)-1]
+	if _, err := fmt.Sscanf(filepath.Base(lastFile), fileFormat, &w.fileIndex); err != nil {
+		return nil, errors.Wrapf(err, "unexpected audit file name %s", lastFile)
+	}
+	last, err := readLastRecord(lastFile)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		w.seq = last.Seq
+		w.lastHash = last.Hash
+	}
+
+	return w, w.openFile(w.fileIndex)
+}
+
+// Write appends the state writes of the block to the audit log.
+func (w *Writer) Write(height int64, pairs []*storetypes.StoreKVPair) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, pair := range pairs {
+		record := Record{
+			Seq:      w.seq + 1,
+			Height:   height,
+			Store:    pair.StoreKey,
+			Delete:   pair.Delete,
+			Key:      pair.Key,
+			Value:    pair.Value,
+			PrevHash: w.lastHash,
+		}
+		hash, err := record.ComputeHash()
+		if err != nil {
+			return err
+		}
+		record.Hash = hash
+
+		line, err := json.Marshal(record)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal audit record")
+		}
+		line = append(line, '\n')
+
+		if w.fileSize > 0 && w.fileSize+int64(len(line)) > w.maxFileSize {
+			if err := w.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := w.file.Write(line)
+		w.fileSize += int64(n)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write audit record to %s", w.file.Name())
+		}
+
+		w.seq = record.Seq
+		w.lastHash = record.Hash
+	}
+
+	return errors.Wrapf(w.file.Sync(), "failed to sync audit file %s", w.file.Name())
+}
+
+// Close closes the current audit file.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.file.Close()
+}
+
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close audit file %s", w.file.Name())
+	}
+
+	return w.openFile(w.fileIndex + 1)
+}
+
+func (w *Writer) openFile(index uint64) error {
+	path := filepath.Join(w.dir, fmt.Sprintf(fileFormat, index))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open audit file %s", path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "failed to stat audit file %s", path)
+	}
+
+	w.file = file
+	w.fileIndex = index
+	w.fileSize = info.Size()
+
+	return nil
+}
+
+// listFiles returns the audit files in the directory in the order they were written.
+func listFiles(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, filePattern))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list audit files in %s", dir)
+	}
+	// the index is zero padded, so the lexical order is the order of the files
+	sort.Strings(files)
+
+	return files, nil
+}
+
+func readLastRecord(path string) (*Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open audit file %s", path)
+	}
+	defer file.Close()
+
+	var last *Record
+	scanner := newScanner(file)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal audit record in %s", path)
+		}
+		last = &record
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read audit file %s", path)
+	}
+
+	return last, nil
+}
+
+func newScanner(file *os.File) *bufio.Scanner {
+	const maxLineSize = 64 * 1024 * 1024
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	return scanner
+}
@@ -0,0 +1,177 @@
+package blocktiming
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+)
+
+const (
+	// PhaseBeginBlock is the label of the begin block phase.
+	PhaseBeginBlock = "begin_block"
+	// PhaseDeliverTx is the label of the execution of the block transactions.
+	PhaseDeliverTx = "deliver_tx"
+	// PhaseEndBlock is the label of the end block phase.
+	PhaseEndBlock = "end_block"
+
+	// DefaultCapacity is the default number of the recent blocks kept by the recorder.
+	DefaultCapacity = 100
+)
+
+type recorderKey struct{}
+
+// SubTiming is the timing of the part of the phase executed by the module.
+type SubTiming struct {
+	Module   string        `json:"module"`
+	Phase    string        `json:"phase"`
+	Duration time.Duration `json:"duration"`
+}
+
+// BlockTimings are the execution timings of the block.
+type BlockTimings struct {
+	Height     int64         `json:"height"`
+	Time       time.Time     `json:"time"`
+	TxCount    int           `json:"tx_count"`
+	BeginBlock time.Duration `json:"begin_block"`
+	DeliverTx  time.Duration `json:"deliver_tx"`
+	EndBlock   time.Duration `json:"end_block"`
+	SubTimings []SubTiming   `json:"sub_timings,omitempty"`
+}
+
+// Total returns the total execution time of the block.
+func (bt BlockTimings) Total() time.Duration {
+	return bt.BeginBlock + bt.DeliverTx + bt.EndBlock
+}
+
+// Recorder keeps the execution timings of the recent blocks in memory. The timings are local to the node and never
+// affect the state.
+type Recorder struct {
+	mu            sync.Mutex
+	capacity      int
+	blocks        []BlockTimings
+	current       BlockTimings
+	beginBlockEnd time.Time
+	now           func() time.Time
+}
+
+// NewRecorder returns the recorder keeping the timings of the given number of the recent blocks.
+func NewRecorder(capacity int) *Recorder {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Recorder{
+		capacity: capacity,
+		blocks:   make([]BlockTimings, 0, capacity),
+		now:      time.Now,
+	}
+}
+
+// StartBlock starts the recording of the block.
+func (r *Recorder) StartBlock(height int64, blockTime time.Time, txCount int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.current = BlockTimings{
+		Height:  height,
+		Time:    blockTime,
+		TxCount: txCount,
+	}
+	r.beginBlockEnd = time.Time{}
+}
+
+// RecordBeginBlock records the begin block phase started at the given time.
+func (r *Recorder) RecordBeginBlock(start time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.beginBlockEnd = r.now()
+	r.current.BeginBlock = r.beginBlockEnd.Sub(start)
+	measure(PhaseBeginBlock, start)
+}
+
+// RecordDeliverTx records the execution of the transactions, lasting from the end of the begin block phase till the
+// given start of the end block phase.
+func (r *Recorder) RecordDeliverTx(endBlockStart time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.beginBlockEnd.IsZero() {
+		return
+	}
+	r.current.DeliverTx = endBlockStart.Sub(r.beginBlockEnd)
+	measure(PhaseDeliverTx, r.beginBlockEnd)
+}
+
+// RecordEndBlock records the end block phase started at the given time and completes the recording of the block.
+func (r *Recorder) RecordEndBlock(start time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.current.EndBlock = r.now().Sub(start)
+	measure(PhaseEndBlock, start)
+
+	if len(r.blocks) == r.capacity {
+		r.blocks = append(r.blocks[:0], r.blocks[1:]...)
+	}
+	r.blocks = append(r.blocks, r.current)
+	r.current = BlockTimings{}
+	r.beginBlockEnd = time.Time{}
+}
+
+// RecentBlocks returns the timings of the recent blocks, starting from the oldest one.
+func (r *Recorder) RecentBlocks() []BlockTimings {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	blocks := make([]BlockTimings, len(r.blocks))
+	copy(blocks, r.blocks)
+	return blocks
+}
+
+func (r *Recorder) recordSubTiming(module, phase string, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.current.SubTimings = append(r.current.SubTimings, SubTiming{
+		Module:   module,
+		Phase:    phase,
+		Duration: duration,
+	})
+}
+
+// WithRecorder returns the context passing the recorder to the modules reporting the sub-timings.
+func WithRecorder(ctx sdk.Context, r *Recorder) sdk.Context {
+	return ctx.WithValue(recorderKey{}, r)
+}
+
+// MeasureSince reports the duration of the part of the phase executed by the module. The duration is emitted to the
+// telemetry labeled by the module and the phase, and recorded by the recorder passed in the context if any.
+func MeasureSince(ctx context.Context, start time.Time, module, phase string) {
+	measureSinceWithLabels(
+		[]string{"block", "sub_timing"},
+		start,
+		[]metrics.Label{telemetry.NewLabel("module", module), telemetry.NewLabel("phase", phase)},
+	)
+	if r, ok := ctx.Value(recorderKey{}).(*Recorder); ok && r != nil {
+		r.recordSubTiming(module, phase, time.Since(start))
+	}
+}
+
+func measure(phase string, start time.Time) {
+	measureSinceWithLabels(
+		[]string{"block", "phase"},
+		start,
+		[]metrics.Label{telemetry.NewLabel("phase", phase)},
+	)
+}
+
+func measureSinceWithLabels(keys []string, start time.Time, labels []metrics.Label) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), labels)
+}
@@ -0,0 +1,56 @@
+package blocktiming
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	requireT := require.New(t)
+
+	start := time.Now()
+	now := start
+	r := NewRecorder(2)
+	r.now = func() time.Time { return now }
+
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	recordBlock := func(height int64) {
+		r.StartBlock(height, start, 3)
+
+		now = now.Add(time.Millisecond)
+		r.RecordBeginBlock(now.Add(-time.Millisecond))
+
+		now = now.Add(5 * time.Millisecond)
+		endBlockStart := now
+		r.RecordDeliverTx(endBlockStart)
+
+		MeasureSince(WithRecorder(ctx, r), now, "pse", "distribution")
+		now = now.Add(2 * time.Millisecond)
+		r.RecordEndBlock(endBlockStart)
+	}
+
+	// the module measuring the time without the recorder doesn't fail
+	MeasureSince(context.Background(), now, "pse", "distribution")
+
+	recordBlock(1)
+	recordBlock(2)
+	recordBlock(3)
+
+	blocks := r.RecentBlocks()
+	requireT.Len(blocks, 2)
+	requireT.Equal(int64(2), blocks[0].Height)
+	requireT.Equal(int64(3), blocks[1].Height)
+	requireT.Equal(3, blocks[1].TxCount)
+	requireT.Equal(time.Millisecond, blocks[1].BeginBlock)
+	requireT.Equal(5*time.Millisecond, blocks[1].DeliverTx)
+	requireT.Equal(2*time.Millisecond, blocks[1].EndBlock)
+	requireT.Equal(8*time.Millisecond, blocks[1].Total())
+	requireT.Len(blocks[1].SubTimings, 1)
+	requireT.Equal("pse", blocks[1].SubTimings[0].Module)
+	requireT.Equal("distribution", blocks[1].SubTimings[0].Phase)
+}
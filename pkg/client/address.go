@@ -0,0 +1,121 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/pkg/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+)
+
+// CoinTypeCosmos is the coin type used by the most of the cosmos chains.
+const CoinTypeCosmos uint32 = 118
+
+// Address prefixes of the foreign chains.
+const (
+	AddressPrefixGaia    = "cosmos"
+	AddressPrefixOsmosis = "osmo"
+)
+
+var (
+	// ErrAddressPrefixMismatch is returned if the address has the prefix different from the expected one.
+	ErrAddressPrefixMismatch = errors.New("address prefix mismatch")
+	// ErrCoinTypeMismatch is returned if the address is converted between the chains using different coin types.
+	ErrCoinTypeMismatch = errors.New("coin type mismatch")
+)
+
+// AddressConfig is the config of the addresses of the chain.
+type AddressConfig struct {
+	// AddressPrefix is the bech32 prefix of the account addresses.
+	AddressPrefix string
+	// CoinType is the SLIP44 coin type used to derive the keys of the accounts.
+	CoinType uint32
+}
+
+// Address configs of the foreign chains.
+var (
+	GaiaAddressConfig = AddressConfig{
+		AddressPrefix: AddressPrefixGaia,
+		CoinType:      CoinTypeCosmos,
+	}
+	OsmosisAddressConfig = AddressConfig{
+		AddressPrefix: AddressPrefixOsmosis,
+		CoinType:      CoinTypeCosmos,
+	}
+)
+
+// AddressConfigByChainID returns the address config of the predefined TX network.
+func AddressConfigByChainID(chainID constant.ChainID) (AddressConfig, error) {
+	networkConfig, err := config.NetworkConfigByChainID(chainID)
+	if err != nil {
+		return AddressConfig{}, err
+	}
+
+	return AddressConfig{
+		AddressPrefix: networkConfig.Provider.GetAddressPrefix(),
+		CoinType:      constant.CoinType,
+	}, nil
+}
+
+// ConvertToBech32Address encodes the address using the prefix of the chain.
+func ConvertToBech32Address(address sdk.AccAddress, addressConfig AddressConfig) (string, error) {
+	if addressConfig.AddressPrefix == "" {
+		return "", errors.New("address prefix must not be empty")
+	}
+	if err := sdk.VerifyAddressFormat(address); err != nil {
+		return "", errors.Wrap(err, "invalid address")
+	}
+
+	bech32Address, err := bech32.ConvertAndEncode(addressConfig.AddressPrefix, address)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to encode address with prefix %s", addressConfig.AddressPrefix)
+	}
+
+	return bech32Address, nil
+}
+
+// ParseBech32Address decodes the bech32 address and verifies it has the prefix of the chain.
+func ParseBech32Address(address string, addressConfig AddressConfig) (sdk.AccAddress, error) {
+	prefix, decoded, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid bech32 address %s", address)
+	}
+	if prefix != addressConfig.AddressPrefix {
+		return nil, errors.Wrapf(
+			ErrAddressPrefixMismatch,
+			"expected prefix %s, got %s", addressConfig.AddressPrefix, prefix,
+		)
+	}
+	if err := sdk.VerifyAddressFormat(decoded); err != nil {
+		return nil, errors.Wrapf(err, "invalid address %s", address)
+	}
+
+	return decoded, nil
+}
+
+// ConvertBech32Address converts the bech32 address of one chain to the address of the other one. The conversion
+// is rejected if the chains use different coin types, since the same mnemonic derives different keys then, so the
+// converted address is not controlled by the owner of the source one.
+func ConvertBech32Address(address string, from, to AddressConfig) (string, error) {
+	if from.CoinType != to.CoinType {
+		return "", errors.Wrapf(
+			ErrCoinTypeMismatch,
+			"address of coin type %d can't be converted to the address of coin type %d", from.CoinType, to.CoinType,
+		)
+	}
+
+	return ConvertBech32AddressUnsafe(address, from, to)
+}
+
+// ConvertBech32AddressUnsafe converts the bech32 address of one chain to the address of the other one without
+// checking the coin types. It is intended for the addresses not derived from the mnemonic, like the module accounts
+// and the contracts.
+func ConvertBech32AddressUnsafe(address string, from, to AddressConfig) (string, error) {
+	decoded, err := ParseBech32Address(address, from)
+	if err != nil {
+		return "", err
+	}
+
+	return ConvertToBech32Address(decoded, to)
+}
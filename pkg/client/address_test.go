@@ -0,0 +1,67 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+)
+
+func TestAddressConversion(t *testing.T) {
+	requireT := require.New(t)
+
+	testnetConfig, err := client.AddressConfigByChainID(constant.ChainIDTest)
+	requireT.NoError(err)
+	requireT.Equal(client.AddressConfig{
+		AddressPrefix: constant.AddressPrefixTest,
+		CoinType:      constant.CoinType,
+	}, testnetConfig)
+
+	_, err = client.AddressConfigByChainID("unknown-1")
+	requireT.Error(err)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testnetAddress, err := client.ConvertToBech32Address(address, testnetConfig)
+	requireT.NoError(err)
+	requireT.Regexp("^testcore1", testnetAddress)
+
+	gaiaAddress, err := client.ConvertToBech32Address(address, client.GaiaAddressConfig)
+	requireT.NoError(err)
+	requireT.Regexp("^cosmos1", gaiaAddress)
+
+	// the address is parsed only with the expected prefix
+	parsed, err := client.ParseBech32Address(gaiaAddress, client.GaiaAddressConfig)
+	requireT.NoError(err)
+	requireT.Equal(address, parsed)
+	_, err = client.ParseBech32Address(gaiaAddress, client.OsmosisAddressConfig)
+	requireT.ErrorIs(err, client.ErrAddressPrefixMismatch)
+
+	// the address is converted between the chains of the same coin type
+	osmosisAddress, err := client.ConvertBech32Address(gaiaAddress, client.GaiaAddressConfig, client.OsmosisAddressConfig)
+	requireT.NoError(err)
+	requireT.Regexp("^osmo1", osmosisAddress)
+
+	// the arbitrary prefix is supported
+	customAddress, err := client.ConvertBech32Address(gaiaAddress, client.GaiaAddressConfig, client.AddressConfig{
+		AddressPrefix: "custom",
+		CoinType:      client.CoinTypeCosmos,
+	})
+	requireT.NoError(err)
+	requireT.Regexp("^custom1", customAddress)
+
+	// the coin type mismatch is rejected unless the unsafe conversion is used
+	_, err = client.ConvertBech32Address(gaiaAddress, client.GaiaAddressConfig, testnetConfig)
+	requireT.ErrorIs(err, client.ErrCoinTypeMismatch)
+	converted, err := client.ConvertBech32AddressUnsafe(gaiaAddress, client.GaiaAddressConfig, testnetConfig)
+	requireT.NoError(err)
+	requireT.Equal(testnetAddress, converted)
+
+	// the empty prefix is rejected
+	_, err = client.ConvertToBech32Address(address, client.AddressConfig{})
+	requireT.Error(err)
+}
@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/pkg/errors"
+)
+
+const ibcDenomPrefix = "ibc/"
+
+// ChainBalanceSource is the chain the balances of the token are aggregated from.
+type ChainBalanceSource struct {
+	// ClientCtx is the client context of the chain. Its chain ID identifies the chain in the breakdown.
+	ClientCtx Context
+	// Addresses are the bech32 addresses of the accounts on the chain. Use ConvertBech32Address to get the addresses
+	// of the same keys on the chains using the same coin type.
+	Addresses []string
+	// TracePaths are the trace paths of the token received over IBC, e.g. "transfer/channel-0". If they are empty,
+	// every IBC denom held by the accounts is resolved using the IBC transfer query of the chain.
+	TracePaths []string
+}
+
+// DenomBalance is the balance of the account in the denom representing the token on the chain.
+type DenomBalance struct {
+	Address string
+	// Denom is the denom held by the account, the IBC denom if the token is received over IBC.
+	Denom string
+	// Path is the full ICS-20 path of the denom, e.g. "transfer/channel-0/ucore". It is empty if the token is native
+	// to the chain.
+	Path   string
+	Amount sdkmath.Int
+}
+
+// ChainBalance is the balance of the token held by the accounts on the chain.
+type ChainBalance struct {
+	ChainID string
+	// Balances are the non-zero balances of the accounts in every denom representing the token.
+	Balances []DenomBalance
+	Total    sdkmath.Int
+}
+
+// AggregatedBalance is the balance of the token held by the accounts on all the chains.
+type AggregatedBalance struct {
+	// BaseDenom is the denom of the token on the chain it is issued on.
+	BaseDenom string
+	Chains    []ChainBalance
+	Total     sdkmath.Int
+}
+
+// QueryAggregatedBalance sums the balances of the token held by the accounts on the chains, both in the base denom
+// and in the IBC denoms tracing back to it. It is intended to aggregate the balances of the TX chain tokens on the
+// chains connected over IBC, e.g. Gaia and Osmosis.
+func QueryAggregatedBalance(
+	ctx context.Context,
+	baseDenom string,
+	sources []ChainBalanceSource,
+	opts ...PaginationOption,
+) (AggregatedBalance, error) {
+	if err := sdk.ValidateDenom(baseDenom); err != nil {
+		return AggregatedBalance{}, errors.WithStack(err)
+	}
+
+	aggregated := AggregatedBalance{
+		BaseDenom: baseDenom,
+		Chains:    make([]ChainBalance, 0, len(sources)),
+		Total:     sdkmath.ZeroInt(),
+	}
+	for _, source := range sources {
+		chainID := source.ClientCtx.ChainID()
+		bankClient := banktypes.NewQueryClient(source.ClientCtx)
+		transferClient := ibctransfertypes.NewQueryClient(source.ClientCtx)
+		queryDenom := func(hash string) (ibctransfertypes.Denom, error) {
+			requestCtx, cancel := context.WithTimeout(ctx, source.ClientCtx.config.TimeoutConfig.RequestTimeout)
+			defer cancel()
+
+			res, err := transferClient.Denom(requestCtx, &ibctransfertypes.QueryDenomRequest{Hash: hash})
+			if err != nil {
+				return ibctransfertypes.Denom{}, errors.Wrapf(err, "failed to resolve IBC denom %s", hash)
+			}
+			if res.Denom == nil {
+				return ibctransfertypes.Denom{}, errors.Errorf("IBC denom %s is not found", hash)
+			}
+			return *res.Denom, nil
+		}
+		queryBalances := func(address string) (sdk.Coins, error) {
+			return QueryAllBalances(ctx, bankClient, address, opts...)
+		}
+
+		resolver, err := newDenomResolver(baseDenom, source.TracePaths, queryDenom)
+		if err != nil {
+			return AggregatedBalance{}, errors.Wrapf(err, "chain %s", chainID)
+		}
+
+		chainBalance, err := aggregateChainBalance(chainID, source.Addresses, resolver, queryBalances)
+		if err != nil {
+			return AggregatedBalance{}, errors.Wrapf(err, "chain %s", chainID)
+		}
+
+		aggregated.Chains = append(aggregated.Chains, chainBalance)
+		aggregated.Total = aggregated.Total.Add(chainBalance.Total)
+	}
+
+	return aggregated, nil
+}
+
+func aggregateChainBalance(
+	chainID string,
+	addresses []string,
+	resolver *denomResolver,
+	queryBalances func(address string) (sdk.Coins, error),
+) (ChainBalance, error) {
+	chainBalance := ChainBalance{
+		ChainID: chainID,
+		Total:   sdkmath.ZeroInt(),
+	}
+	for _, address := range addresses {
+		balances, err := queryBalances(address)
+		if err != nil {
+			return ChainBalance{}, errors.Wrapf(err, "failed to query balances of %s", address)
+		}
+		for _, balance := range balances {
+			path, ok, err := resolver.resolve(balance.Denom)
+			if err != nil {
+				return ChainBalance{}, err
+			}
+			if !ok || !balance.Amount.IsPositive() {
+				continue
+			}
+			chainBalance.Balances = append(chainBalance.Balances, DenomBalance{
+				Address: address,
+				Denom:   balance.Denom,
+				Path:    path,
+				Amount:  balance.Amount,
+			})
+			chainBalance.Total = chainBalance.Total.Add(balance.Amount)
+		}
+	}
+
+	return chainBalance, nil
+}
+
+// denomResolver decides whether the denom held on the chain represents the token and caches the resolved IBC
+// denoms, so each of them is queried once.
+type denomResolver struct {
+	baseDenom      string
+	queryDenom     func(hash string) (ibctransfertypes.Denom, error)
+	pathsOnly      bool
+	resolvedDenoms map[string]resolvedDenom
+}
+
+type resolvedDenom struct {
+	path    string
+	matches bool
+}
+
+func newDenomResolver(
+	baseDenom string,
+	tracePaths []string,
+	queryDenom func(hash string) (ibctransfertypes.Denom, error),
+) (*denomResolver, error) {
+	resolver := &denomResolver{
+		baseDenom:      baseDenom,
+		queryDenom:     queryDenom,
+		pathsOnly:      len(tracePaths) > 0,
+		resolvedDenoms: map[string]resolvedDenom{},
+	}
+	for _, path := range tracePaths {
+		denom := ibctransfertypes.ExtractDenomFromPath(strings.TrimSuffix(path, "/") + "/" + baseDenom)
+		if denom.IsNative() || denom.Base != baseDenom {
+			return nil, errors.Errorf("invalid trace path %q", path)
+		}
+		if err := denom.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid trace path %q", path)
+		}
+		resolver.resolvedDenoms[denom.IBCDenom()] = resolvedDenom{
+			path:    denom.Path(),
+			matches: true,
+		}
+	}
+
+	return resolver, nil
+}
+
+// resolve returns the trace path of the denom and whether the denom represents the token.
+func (r *denomResolver) resolve(denom string) (string, bool, error) {
+	if denom == r.baseDenom {
+		return "", true, nil
+	}
+	if !strings.HasPrefix(denom, ibcDenomPrefix) {
+		return "", false, nil
+	}
+	if resolved, ok := r.resolvedDenoms[denom]; ok {
+		return resolved.path, resolved.matches, nil
+	}
+	if r.pathsOnly {
+		return "", false, nil
+	}
+
+	trace, err := r.queryDenom(strings.TrimPrefix(denom, ibcDenomPrefix))
+	if err != nil {
+		return "", false, err
+	}
+	if trace.IBCDenom() != denom {
+		return "", false, errors.Errorf("IBC denom %s is resolved to the path %s of the different denom", denom, trace.Path())
+	}
+	resolved := resolvedDenom{
+		path:    trace.Path(),
+		matches: trace.Base == r.baseDenom,
+	}
+	r.resolvedDenoms[denom] = resolved
+
+	return resolved.path, resolved.matches, nil
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateChainBalance(t *testing.T) {
+	requireT := require.New(t)
+
+	const baseDenom = "ucore"
+	directDenom := ibctransfertypes.NewDenom(baseDenom, ibctransfertypes.NewHop("transfer", "channel-0"))
+	forwardedDenom := ibctransfertypes.NewDenom(
+		baseDenom,
+		ibctransfertypes.NewHop("transfer", "channel-1"),
+		ibctransfertypes.NewHop("transfer", "channel-2"),
+	)
+	otherDenom := ibctransfertypes.NewDenom("uatom", ibctransfertypes.NewHop("transfer", "channel-0"))
+	balances := map[string]sdk.Coins{
+		"addr1": sdk.NewCoins(
+			sdk.NewInt64Coin(directDenom.IBCDenom(), 100),
+			sdk.NewInt64Coin(otherDenom.IBCDenom(), 1_000),
+			sdk.NewInt64Coin("uosmo", 1_000),
+		),
+		"addr2": sdk.NewCoins(
+			sdk.NewInt64Coin(directDenom.IBCDenom(), 20),
+			sdk.NewInt64Coin(forwardedDenom.IBCDenom(), 3),
+			sdk.NewInt64Coin(baseDenom, 7),
+		),
+	}
+	queryBalances := func(address string) (sdk.Coins, error) {
+		return balances[address], nil
+	}
+	denoms := map[string]ibctransfertypes.Denom{}
+	for _, denom := range []ibctransfertypes.Denom{directDenom, forwardedDenom, otherDenom} {
+		denoms[denom.Hash().String()] = denom
+	}
+	queryCount := 0
+	queryDenom := func(hash string) (ibctransfertypes.Denom, error) {
+		queryCount++
+		denom, ok := denoms[hash]
+		if !ok {
+			return ibctransfertypes.Denom{}, errors.Errorf("denom %s not found", hash)
+		}
+		return denom, nil
+	}
+
+	// all the IBC denoms are resolved by the query
+	resolver, err := newDenomResolver(baseDenom, nil, queryDenom)
+	requireT.NoError(err)
+	chainBalance, err := aggregateChainBalance("chain", []string{"addr1", "addr2"}, resolver, queryBalances)
+	requireT.NoError(err)
+	requireT.Equal("chain", chainBalance.ChainID)
+	requireT.Equal(sdkmath.NewInt(130), chainBalance.Total)
+	requireT.ElementsMatch([]DenomBalance{
+		{Address: "addr1", Denom: directDenom.IBCDenom(), Path: "transfer/channel-0/ucore", Amount: sdkmath.NewInt(100)},
+		{Address: "addr2", Denom: directDenom.IBCDenom(), Path: "transfer/channel-0/ucore", Amount: sdkmath.NewInt(20)},
+		{
+			Address: "addr2",
+			Denom:   forwardedDenom.IBCDenom(),
+			Path:    "transfer/channel-1/transfer/channel-2/ucore",
+			Amount:  sdkmath.NewInt(3),
+		},
+		{Address: "addr2", Denom: baseDenom, Amount: sdkmath.NewInt(7)},
+	}, chainBalance.Balances)
+	// every denom is queried once
+	requireT.Equal(3, queryCount)
+
+	// only the denoms of the trace paths are counted without querying
+	queryCount = 0
+	resolver, err = newDenomResolver(baseDenom, []string{"transfer/channel-0"}, queryDenom)
+	requireT.NoError(err)
+	chainBalance, err = aggregateChainBalance("chain", []string{"addr1", "addr2"}, resolver, queryBalances)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(127), chainBalance.Total)
+	requireT.Len(chainBalance.Balances, 3)
+	requireT.Zero(queryCount)
+
+	// the invalid trace path is rejected
+	_, err = newDenomResolver(baseDenom, []string{"channel-0"}, queryDenom)
+	requireT.Error(err)
+
+	// the unknown IBC denom fails the aggregation
+	balances["addr3"] = sdk.NewCoins(sdk.NewInt64Coin("ibc/"+ibctransfertypes.NewDenom("unknown").Hash().String(), 1))
+	resolver, err = newDenomResolver(baseDenom, nil, queryDenom)
+	requireT.NoError(err)
+	_, err = aggregateChainBalance("chain", []string{"addr3"}, resolver, queryBalances)
+	requireT.Error(err)
+}
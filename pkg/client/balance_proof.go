@@ -0,0 +1,178 @@
+package client
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+)
+
+const bankStoreQueryPath = "/store/" + banktypes.StoreKey + "/key"
+
+// BalanceProof is the bank balance of the account at the height together with the merkle proof of it.
+type BalanceProof struct {
+	Address sdk.AccAddress
+	Balance sdk.Coin
+	// Height is the height the balance is queried at. The app hash committing to the state at this height is
+	// included in the header of the next block.
+	Height int64
+	// Value is the raw value of the balance in the bank store, it is empty if the balance is zero.
+	Value []byte
+	// Proof is the ICS-23 proof of the value, or of its absence if the balance is zero.
+	Proof *cmtcrypto.ProofOps
+	// AppHash is the app hash of the next block reported by the node. It is informational only, the proof must be
+	// verified against the trusted header using VerifyBalanceProof.
+	AppHash []byte
+}
+
+// QueryBalanceProof queries the bank balance of the address at the height with the merkle proof. The height must be
+// positive and lower than the latest height of the chain, since the state is committed by the next block. The
+// height must not be pruned by the node.
+func QueryBalanceProof(
+	ctx context.Context,
+	clientCtx Context,
+	address sdk.AccAddress,
+	denom string,
+	height int64,
+) (BalanceProof, error) {
+	if height <= 0 {
+		return BalanceProof{}, errors.Errorf("height must be positive, got %d", height)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return BalanceProof{}, errors.WithStack(err)
+	}
+	rpcClient := clientCtx.RPCClient()
+	if rpcClient == nil {
+		return BalanceProof{}, errors.New("rpc client is not set")
+	}
+	key, err := balanceStoreKey(address, denom)
+	if err != nil {
+		return BalanceProof{}, err
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, clientCtx.config.TimeoutConfig.RequestTimeout)
+	defer cancel()
+
+	res, err := rpcClient.ABCIQueryWithOptions(requestCtx, bankStoreQueryPath, key, rpcclient.ABCIQueryOptions{
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return BalanceProof{}, errors.Wrap(err, "failed to query balance proof")
+	}
+	proof, err := newBalanceProof(address, denom, res.Response)
+	if err != nil {
+		return BalanceProof{}, err
+	}
+
+	nextHeight := proof.Height + 1
+	commit, err := rpcClient.Commit(requestCtx, &nextHeight)
+	if err != nil {
+		return BalanceProof{}, errors.Wrapf(err, "failed to get the header of the block %d", nextHeight)
+	}
+	proof.AppHash = commit.Header.AppHash
+
+	return proof, nil
+}
+
+// VerifyBalanceProof verifies the balance proof against the trusted header of the block following the height of the
+// proof. The header must be obtained from the trusted source, e.g. verified by the light client.
+func VerifyBalanceProof(proof BalanceProof, trustedHeader cmttypes.Header) error {
+	if trustedHeader.Height != proof.Height+1 {
+		return errors.Errorf(
+			"header height must be %d for the proof at height %d, got %d",
+			proof.Height+1, proof.Height, trustedHeader.Height,
+		)
+	}
+	if proof.Proof == nil {
+		return errors.New("proof is empty")
+	}
+
+	key, err := balanceStoreKey(proof.Address, proof.Balance.Denom)
+	if err != nil {
+		return err
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(banktypes.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+
+	proofRuntime := rootmulti.DefaultProofRuntime()
+	if len(proof.Value) == 0 {
+		if !proof.Balance.Amount.IsZero() {
+			return errors.Errorf("balance %s doesn't match the empty value", proof.Balance)
+		}
+		if err := proofRuntime.VerifyAbsence(proof.Proof, trustedHeader.AppHash, keyPath); err != nil {
+			return errors.Wrap(err, "invalid balance absence proof")
+		}
+		return nil
+	}
+
+	amount, err := banktypes.BalanceValueCodec.Decode(proof.Value)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode balance value")
+	}
+	if !amount.Equal(proof.Balance.Amount) {
+		return errors.Errorf("balance %s doesn't match the proven amount %s", proof.Balance, amount)
+	}
+	if err := proofRuntime.VerifyValue(proof.Proof, trustedHeader.AppHash, keyPath, proof.Value); err != nil {
+		return errors.Wrap(err, "invalid balance proof")
+	}
+
+	return nil
+}
+
+func newBalanceProof(address sdk.AccAddress, denom string, res abci.ResponseQuery) (BalanceProof, error) {
+	if res.Code != 0 {
+		return BalanceProof{}, errors.Errorf("balance proof query failed with code %d: %s", res.Code, res.Log)
+	}
+	if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return BalanceProof{}, errors.New("balance proof is empty, the height might be pruned")
+	}
+	key, err := balanceStoreKey(address, denom)
+	if err != nil {
+		return BalanceProof{}, err
+	}
+	if !bytes.Equal(res.Key, key) {
+		return BalanceProof{}, errors.Errorf("unexpected key %X in the balance proof response", res.Key)
+	}
+
+	amount := sdkmath.ZeroInt()
+	if len(res.Value) > 0 {
+		amount, err = banktypes.BalanceValueCodec.Decode(res.Value)
+		if err != nil {
+			return BalanceProof{}, errors.Wrap(err, "failed to decode balance value")
+		}
+	}
+
+	return BalanceProof{
+		Address: address,
+		Balance: sdk.NewCoin(denom, amount),
+		Height:  res.Height,
+		Value:   res.Value,
+		Proof:   res.ProofOps,
+	}, nil
+}
+
+func balanceStoreKey(address sdk.AccAddress, denom string) ([]byte, error) {
+	key, err := collections.EncodeKeyWithPrefix(
+		banktypes.BalancesPrefix.Bytes(),
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+		collections.Join(address, denom),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build balance store key")
+	}
+
+	return key, nil
+}
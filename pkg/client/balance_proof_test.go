@@ -0,0 +1,89 @@
+package client
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceProof(t *testing.T) {
+	requireT := require.New(t)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	balance := sdk.NewInt64Coin("ucore", 100)
+
+	db := dbm.NewMemDB()
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	bankKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	cms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	requireT.NoError(cms.LoadLatestVersion())
+
+	key, err := balanceStoreKey(address, balance.Denom)
+	requireT.NoError(err)
+	value, err := banktypes.BalanceValueCodec.Encode(balance.Amount)
+	requireT.NoError(err)
+	cms.GetKVStore(bankKey).Set(key, value)
+	commitID := cms.Commit()
+	header := cmttypes.Header{Height: commitID.Version + 1, AppHash: commitID.Hash}
+
+	queryProof := func(denom string) BalanceProof {
+		key, err := balanceStoreKey(address, denom)
+		requireT.NoError(err)
+		res, err := cms.Query(&storetypes.RequestQuery{
+			Path:   "/" + banktypes.StoreKey + "/key",
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		requireT.NoError(err)
+		proof, err := newBalanceProof(address, denom, abci.ResponseQuery{
+			Key:      res.Key,
+			Value:    res.Value,
+			ProofOps: res.ProofOps,
+			Height:   res.Height,
+		})
+		requireT.NoError(err)
+		return proof
+	}
+
+	// the existing balance is proven
+	proof := queryProof(balance.Denom)
+	requireT.Equal(balance.String(), proof.Balance.String())
+	requireT.NoError(VerifyBalanceProof(proof, header))
+
+	// the zero balance is proven by the absence proof
+	zeroProof := queryProof("uother")
+	requireT.True(zeroProof.Balance.IsZero())
+	requireT.NoError(VerifyBalanceProof(zeroProof, header))
+
+	// the proof must be verified against the header of the next block
+	requireT.Error(VerifyBalanceProof(proof, cmttypes.Header{Height: commitID.Version, AppHash: commitID.Hash}))
+
+	// the proof doesn't match the other app hash
+	requireT.Error(VerifyBalanceProof(proof, cmttypes.Header{Height: header.Height, AppHash: []byte("invalid")}))
+
+	// the tampered balance is rejected
+	tamperedProof := proof
+	tamperedProof.Balance.Amount = sdkmath.NewInt(1000)
+	requireT.Error(VerifyBalanceProof(tamperedProof, header))
+	tamperedValue, err := banktypes.BalanceValueCodec.Encode(sdkmath.NewInt(1000))
+	requireT.NoError(err)
+	tamperedProof.Value = tamperedValue
+	requireT.Error(VerifyBalanceProof(tamperedProof, header))
+
+	// the absence proof doesn't prove the existing balance to be zero
+	tamperedProof = zeroProof
+	tamperedProof.Balance = sdk.NewInt64Coin(balance.Denom, 0)
+	requireT.Error(VerifyBalanceProof(tamperedProof, header))
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+)
+
+const maxChainInfoSize = 1 << 20
+
+// FetchChainInfo fetches the chain info from the API server of the node and validates it. The apiURL is the base URL
+// of the API server, e.g. "https://rest.example.com". The client is expected to call it at startup instead of
+// hardcoding the chain metadata. If the httpClient is nil the default one is used.
+func FetchChainInfo(ctx context.Context, httpClient *http.Client, apiURL string) (config.ChainInfo, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, strings.TrimSuffix(apiURL, "/")+config.ChainInfoPath, nil,
+	)
+	if err != nil {
+		return config.ChainInfo{}, errors.WithStack(err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return config.ChainInfo{}, errors.Wrap(err, "failed to fetch chain info")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxChainInfoSize))
+	if err != nil {
+		return config.ChainInfo{}, errors.Wrap(err, "failed to read chain info")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return config.ChainInfo{}, errors.Errorf(
+			"failed to fetch chain info, status: %d, body: %s", resp.StatusCode, strings.TrimSpace(string(body)),
+		)
+	}
+
+	var chainInfo config.ChainInfo
+	if err := json.Unmarshal(body, &chainInfo); err != nil {
+		return config.ChainInfo{}, errors.Wrap(err, "failed to decode chain info")
+	}
+	if err := chainInfo.Validate(); err != nil {
+		return config.ChainInfo{}, errors.Wrap(err, "invalid chain info")
+	}
+
+	return chainInfo, nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	feemodeltypes "github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+)
+
+func TestFetchChainInfo(t *testing.T) {
+	requireT := require.New(t)
+
+	chainInfo := config.ChainInfo{
+		ChainID: string(constant.ChainIDDev),
+		Bech32Prefixes: config.Bech32Prefixes{
+			Account:   constant.AddressPrefixDev,
+			Validator: constant.AddressPrefixDev + "valoper",
+			Consensus: constant.AddressPrefixDev + "valcons",
+		},
+		CoinType:     constant.CoinType,
+		FeeDenom:     constant.DenomDev,
+		StakingDenom: constant.DenomDev,
+		Denoms: []config.DenomInfo{
+			{Denom: constant.DenomDev, Display: constant.DenomDevDisplay, Precision: 6},
+		},
+		FeeModel: config.FeeModelInfo{
+			MinGasPrice: sdk.NewDecCoinFromDec(constant.DenomDev, sdkmath.LegacyMustNewDecFromStr("0.0625")),
+			Params:      feemodeltypes.DefaultParams().Model,
+		},
+		Features: config.ChainFeatures{
+			AssetFT: []string{"minting", "burning"},
+		},
+	}
+
+	var served any = chainInfo
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != config.ChainInfoPath {
+			http.NotFound(w, req)
+			return
+		}
+		requireT.NoError(json.NewEncoder(w).Encode(served))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	fetched, err := FetchChainInfo(ctx, server.Client(), server.URL+"/")
+	requireT.NoError(err)
+	requireT.Equal(chainInfo.ChainID, fetched.ChainID)
+	requireT.Equal(chainInfo.Denoms, fetched.Denoms)
+	requireT.Equal(chainInfo.FeeModel.MinGasPrice.String(), fetched.FeeModel.MinGasPrice.String())
+	requireT.Equal(chainInfo.FeeModel.Params.String(), fetched.FeeModel.Params.String())
+	requireT.Equal(chainInfo.Features, fetched.Features)
+
+	// the invalid chain info is rejected
+	chainInfo.FeeDenom = "ucore"
+	served = chainInfo
+	_, err = FetchChainInfo(ctx, server.Client(), server.URL)
+	requireT.ErrorContains(err, "invalid chain info")
+
+	// the error status is reported
+	_, err = FetchChainInfo(ctx, server.Client(), server.URL+"/unknown")
+	requireT.ErrorContains(err, "status: 404")
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+const (
+	complianceActionsSubscriber         = "compliance-actions-watcher"
+	complianceActionsUnsubscribeTimeout = 10 * time.Second
+)
+
+// ComplianceAction is the compliance action affecting the watched account.
+type ComplianceAction struct {
+	Height int64
+	TxHash string
+	Event  assetfttypes.EventComplianceAction
+}
+
+// WatchComplianceActions subscribes to the compliance actions (freezes, unfreezes and clawbacks) affecting the
+// accounts. The transactions are filtered by the node using the event queries, so only the ones affecting the
+// accounts are delivered. The RPC client of the context must support the event subscriptions and be started.
+// The returned channel is closed once the ctx is canceled or the subscriptions are terminated by the node.
+func WatchComplianceActions(
+	ctx context.Context,
+	clientCtx Context,
+	accounts ...sdk.AccAddress,
+) (<-chan ComplianceAction, error) {
+	if len(accounts) == 0 {
+		return nil, errors.New("at least one account is required")
+	}
+	eventsClient, ok := clientCtx.RPCClient().(rpcclient.EventsClient)
+	if !ok {
+		return nil, errors.New("rpc client doesn't support event subscriptions")
+	}
+
+	subscriptions := make(map[string]<-chan coretypes.ResultEvent, len(accounts))
+	for _, account := range accounts {
+		address := account.String()
+		if _, found := subscriptions[address]; found {
+			continue
+		}
+		results, err := eventsClient.Subscribe(ctx, complianceActionsSubscriber, complianceActionsQuery(address))
+		if err != nil {
+			unsubscribeComplianceActions(eventsClient)
+			return nil, errors.Wrapf(err, "failed to subscribe to compliance actions of %s", address)
+		}
+		subscriptions[address] = results
+	}
+
+	actions := make(chan ComplianceAction)
+	var wg sync.WaitGroup
+	for address, results := range subscriptions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forwardComplianceActions(ctx, address, results, actions)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		unsubscribeComplianceActions(eventsClient)
+		close(actions)
+	}()
+
+	return actions, nil
+}
+
+// complianceActionsQuery returns the event query matching the transactions with the compliance actions affecting the
+// account. The attributes of the typed events are JSON encoded, so the address is quoted.
+func complianceActionsQuery(address string) string {
+	return fmt.Sprintf(
+		"%s='%s' AND %s.account='\"%s\"'",
+		cmttypes.EventTypeKey,
+		cmttypes.EventTx,
+		gogoproto.MessageName(&assetfttypes.EventComplianceAction{}),
+		address,
+	)
+}
+
+func forwardComplianceActions(
+	ctx context.Context,
+	address string,
+	results <-chan coretypes.ResultEvent,
+	actions chan<- ComplianceAction,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case result, ok := <-results:
+			if !ok {
+				return
+			}
+			txEvent, ok := result.Data.(cmttypes.EventDataTx)
+			if !ok {
+				continue
+			}
+			for _, action := range complianceActionsFromTx(txEvent.TxResult, address) {
+				select {
+				case <-ctx.Done():
+					return
+				case actions <- action:
+				}
+			}
+		}
+	}
+}
+
+// complianceActionsFromTx returns the compliance actions of the tx affecting the account. The tx might contain the
+// actions affecting other accounts too, so they are filtered out.
+func complianceActionsFromTx(txResult abci.TxResult, address string) []ComplianceAction {
+	eventType := gogoproto.MessageName(&assetfttypes.EventComplianceAction{})
+	txHash := fmt.Sprintf("%X", cmttypes.Tx(txResult.Tx).Hash())
+
+	var actions []ComplianceAction
+	for _, event := range txResult.Result.Events {
+		if event.Type != eventType {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		complianceEvent, ok := msg.(*assetfttypes.EventComplianceAction)
+		if !ok || complianceEvent.Account != address {
+			continue
+		}
+		actions = append(actions, ComplianceAction{
+			Height: txResult.Height,
+			TxHash: txHash,
+			Event:  *complianceEvent,
+		})
+	}
+
+	return actions
+}
+
+func unsubscribeComplianceActions(eventsClient rpcclient.EventsClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), complianceActionsUnsubscribeTimeout)
+	defer cancel()
+	// The error is ignored since the subscriptions are dropped by the node anyway once the connection is closed.
+	_ = eventsClient.UnsubscribeAll(ctx, complianceActionsSubscriber)
+}
@@ -92,6 +92,7 @@ type Context struct {
 	clientCtx          client.Context
 	awaitTx            bool
 	unsignedSimulation bool
+	gasAdjuster        *GasAdjuster
 }
 
 // NewContext returns new context.
@@ -152,6 +153,18 @@ func (c Context) WithGasAdjustment(adj float64) Context {
 	return c
 }
 
+// GasAdjuster returns the gas adjuster learning the gas adjustment from the out-of-gas failures.
+func (c Context) GasAdjuster() *GasAdjuster {
+	return c.gasAdjuster
+}
+
+// WithGasAdjuster returns context with the gas adjuster. If it is set, the gas adjustment of the simulated
+// transactions is multiplied by the multiplier learned for their messages.
+func (c Context) WithGasAdjuster(adjuster *GasAdjuster) Context {
+	c.gasAdjuster = adjuster
+	return c
+}
+
 // WithGasPriceAdjustment returns context with new gas price adjustment.
 func (c Context) WithGasPriceAdjustment(adj sdkmath.LegacyDec) Context {
 	c.config.GasConfig.GasPriceAdjustment = adj
@@ -0,0 +1,248 @@
+package client
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultGasAdjustmentStep is the factor the learned gas multiplier is multiplied by after each out-of-gas failure.
+	DefaultGasAdjustmentStep = 1.25
+	// DefaultMaxGasMultiplier is the upper bound of the learned gas multiplier.
+	DefaultMaxGasMultiplier = 3.0
+	// DefaultGasMultiplierDecay is the part of the learned extra gas removed after each successful transaction.
+	DefaultGasMultiplierDecay = 0.1
+)
+
+// GasAdjusterConfig is the config of the GasAdjuster.
+type GasAdjusterConfig struct {
+	// CachePath is the path of the file the learned multipliers are persisted to. The cache is disabled if empty.
+	CachePath string
+	// Step is the factor the multiplier is multiplied by after each out-of-gas failure.
+	Step float64
+	// MaxMultiplier is the upper bound of the multiplier.
+	MaxMultiplier float64
+	// Decay is the part of the extra gas removed from the multiplier after each successful transaction.
+	Decay float64
+}
+
+// DefaultGasAdjusterConfig returns the default gas adjuster config.
+func DefaultGasAdjusterConfig() GasAdjusterConfig {
+	return GasAdjusterConfig{
+		Step:          DefaultGasAdjustmentStep,
+		MaxMultiplier: DefaultMaxGasMultiplier,
+		Decay:         DefaultGasMultiplierDecay,
+	}
+}
+
+// GasHint is the gas multiplier learned for the message type and denom.
+type GasHint struct {
+	Multiplier float64 `json:"multiplier"`
+	Failures   uint64  `json:"failures"`
+}
+
+// GasAdjuster learns the gas adjustment from the out-of-gas failures of the simulated transactions.
+// The simulation can't predict the gas consumed by the nondeterministic parts of the execution (e.g. the asset ft
+// extensions), so the adjuster tracks the failures per message type and denom and bumps the multiplier applied on top
+// of the gas adjustment of the factory for the subsequent transactions containing the same messages.
+type GasAdjuster struct {
+	config GasAdjusterConfig
+
+	mu    sync.Mutex
+	hints map[string]GasHint
+}
+
+// NewGasAdjuster returns the new gas adjuster loading the hints from the cache file if it is configured.
+func NewGasAdjuster(config GasAdjusterConfig) (*GasAdjuster, error) {
+	if config.Step <= 1 {
+		return nil, errors.Errorf("gas adjustment step must be greater than 1, got %f", config.Step)
+	}
+	if config.MaxMultiplier < 1 {
+		return nil, errors.Errorf("max gas multiplier must not be less than 1, got %f", config.MaxMultiplier)
+	}
+	if config.Decay < 0 || config.Decay > 1 {
+		return nil, errors.Errorf("gas multiplier decay must be between 0 and 1, got %f", config.Decay)
+	}
+
+	a := &GasAdjuster{
+		config: config,
+		hints:  map[string]GasHint{},
+	}
+	if config.CachePath == "" {
+		return a, nil
+	}
+
+	raw, err := os.ReadFile(config.CachePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return a, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read gas hints from %s", config.CachePath)
+	}
+	if err := json.Unmarshal(raw, &a.hints); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal gas hints from %s", config.CachePath)
+	}
+
+	return a, nil
+}
+
+// Multiplier returns the multiplier to apply to the gas adjustment of the transaction containing the messages.
+// It is the highest multiplier learned for any of the messages.
+func (a *GasAdjuster) Multiplier(msgs ...sdk.Msg) float64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	multiplier := 1.0
+	for _, key := range gasHintKeys(msgs) {
+		if hint, ok := a.hints[key]; ok {
+			multiplier = math.Max(multiplier, hint.Multiplier)
+		}
+	}
+
+	return multiplier
+}
+
+// Hints returns the copy of the learned hints.
+func (a *GasAdjuster) Hints() map[string]GasHint {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	hints := make(map[string]GasHint, len(a.hints))
+	for key, hint := range a.hints {
+		hints[key] = hint
+	}
+
+	return hints
+}
+
+// RecordOutOfGas bumps the multipliers of the messages of the transaction which has run out of gas.
+func (a *GasAdjuster) RecordOutOfGas(msgs ...sdk.Msg) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, key := range gasHintKeys(msgs) {
+		hint, ok := a.hints[key]
+		if !ok {
+			hint.Multiplier = 1
+		}
+		hint.Multiplier = math.Min(hint.Multiplier*a.config.Step, a.config.MaxMultiplier)
+		hint.Failures++
+		a.hints[key] = hint
+	}
+
+	return a.persist()
+}
+
+// RecordSuccess decays the multipliers of the messages of the successfully executed transaction, so the gas
+// overestimated after the past failures is reduced over time.
+func (a *GasAdjuster) RecordSuccess(msgs ...sdk.Msg) error {
+	if a.config.Decay == 0 {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var changed bool
+	for _, key := range gasHintKeys(msgs) {
+		hint, ok := a.hints[key]
+		if !ok {
+			continue
+		}
+		hint.Multiplier -= (hint.Multiplier - 1) * a.config.Decay
+		a.hints[key] = hint
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	return a.persist()
+}
+
+func (a *GasAdjuster) persist() error {
+	if a.config.CachePath == "" {
+		return nil
+	}
+
+	raw, err := json.MarshalIndent(a.hints, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal gas hints")
+	}
+	if err := os.MkdirAll(filepath.Dir(a.config.CachePath), 0o700); err != nil {
+		return errors.Wrapf(err, "failed to create directory of %s", a.config.CachePath)
+	}
+	if err := os.WriteFile(a.config.CachePath, raw, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write gas hints to %s", a.config.CachePath)
+	}
+
+	return nil
+}
+
+// gasHintKeys returns the sorted unique keys of the messages built from the message type URL and the denoms
+// the message operates on.
+func gasHintKeys(msgs []sdk.Msg) []string {
+	unique := map[string]struct{}{}
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		denoms := msgDenoms(msg)
+		if len(denoms) == 0 {
+			unique[typeURL] = struct{}{}
+			continue
+		}
+		for _, denom := range denoms {
+			unique[typeURL+"/"+denom] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(unique))
+	for key := range unique {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// msgDenoms returns the denoms of the message taken from its Coin, Amount or Denom field. The getters aren't
+// generated for all the messages, that's why the fields are read using reflection.
+func msgDenoms(msg sdk.Msg) []string {
+	v := reflect.ValueOf(msg)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if coin, ok := fieldValue[sdk.Coin](v, "Coin"); ok {
+		return []string{coin.Denom}
+	}
+	if coins, ok := fieldValue[sdk.Coins](v, "Amount"); ok {
+		return coins.Denoms()
+	}
+	if denom, ok := fieldValue[string](v, "Denom"); ok && denom != "" {
+		return []string{denom}
+	}
+
+	return nil
+}
+
+func fieldValue[T any](v reflect.Value, name string) (T, bool) {
+	field := v.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		var zero T
+		return zero, false
+	}
+	value, ok := field.Interface().(T)
+
+	return value, ok
+}
@@ -0,0 +1,68 @@
+package client_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestGasAdjuster(t *testing.T) {
+	requireT := require.New(t)
+
+	config := client.DefaultGasAdjusterConfig()
+	config.CachePath = filepath.Join(t.TempDir(), "gas", "hints.json")
+	adjuster, err := client.NewGasAdjuster(config)
+	requireT.NoError(err)
+
+	mintExt := &assetfttypes.MsgMint{Coin: sdk.NewInt64Coin("ext", 1)}
+	mintOther := &assetfttypes.MsgMint{Coin: sdk.NewInt64Coin("other", 1)}
+	send := &banktypes.MsgSend{Amount: sdk.NewCoins(sdk.NewCoin("ext", sdkmath.NewInt(1)))}
+
+	requireT.InDelta(1.0, adjuster.Multiplier(mintExt), 1e-9)
+
+	// the failures bump the multiplier of the message type and denom only
+	requireT.NoError(adjuster.RecordOutOfGas(mintExt))
+	requireT.InDelta(1.25, adjuster.Multiplier(mintExt), 1e-9)
+	requireT.NoError(adjuster.RecordOutOfGas(mintExt))
+	requireT.InDelta(1.5625, adjuster.Multiplier(mintExt), 1e-9)
+	requireT.InDelta(1.0, adjuster.Multiplier(mintOther), 1e-9)
+	requireT.InDelta(1.0, adjuster.Multiplier(send), 1e-9)
+
+	// the highest multiplier of the messages is used
+	requireT.InDelta(1.5625, adjuster.Multiplier(mintOther, mintExt), 1e-9)
+
+	// the multiplier is capped
+	for range 10 {
+		requireT.NoError(adjuster.RecordOutOfGas(mintExt))
+	}
+	requireT.InDelta(config.MaxMultiplier, adjuster.Multiplier(mintExt), 1e-9)
+	requireT.EqualValues(12, adjuster.Hints()[sdk.MsgTypeURL(mintExt)+"/ext"].Failures)
+
+	// the success decays the multiplier
+	requireT.NoError(adjuster.RecordSuccess(mintExt))
+	requireT.InDelta(2.8, adjuster.Multiplier(mintExt), 1e-9)
+
+	// the hints are loaded from the cache
+	loaded, err := client.NewGasAdjuster(config)
+	requireT.NoError(err)
+	requireT.Equal(adjuster.Hints(), loaded.Hints())
+}
+
+func TestGasAdjuster_InvalidConfig(t *testing.T) {
+	config := client.DefaultGasAdjusterConfig()
+	config.Step = 1
+	_, err := client.NewGasAdjuster(config)
+	require.Error(t, err)
+
+	config = client.DefaultGasAdjusterConfig()
+	config.Decay = 2
+	_, err = client.NewGasAdjuster(config)
+	require.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/pkg/errors"
+)
+
+// GovICAOwner returns the owner of the interchain accounts controlled by the governance, which is the address of the
+// governance module executing the proposal messages.
+func GovICAOwner() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// NewGovICARegistrationMsg returns the message registering the interchain account controlled by the governance on the
+// host chain of the connection. The message must be executed by the governance proposal. The unordered channel is
+// used, so the timed out packet doesn't close it.
+func NewGovICARegistrationMsg(connectionID string) (*icacontrollertypes.MsgRegisterInterchainAccount, error) {
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, GovICAOwner(), "", channeltypes.UNORDERED)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "invalid interchain account registration")
+	}
+
+	return msg, nil
+}
+
+// NewGovICAExecutionMsg returns the message executing the host messages by the interchain account controlled by the
+// governance on the host chain of the connection. The message must be executed by the governance proposal, the
+// relative timeout is counted from the time the proposal is executed, not submitted. The codec must know the types
+// of the host messages.
+func NewGovICAExecutionMsg(
+	cdc codec.Codec,
+	connectionID string,
+	relativeTimeout time.Duration,
+	hostMsgs ...proto.Message,
+) (*icacontrollertypes.MsgSendTx, error) {
+	if len(hostMsgs) == 0 {
+		return nil, errors.New("at least one host message is required")
+	}
+	if relativeTimeout <= 0 {
+		return nil, errors.New("relative timeout must be positive")
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, hostMsgs, icatypes.EncodingProtobuf)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize host messages")
+	}
+	msg := icacontrollertypes.NewMsgSendTx(
+		GovICAOwner(),
+		connectionID,
+		uint64(relativeTimeout),
+		icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		},
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "invalid interchain account execution")
+	}
+
+	return msg, nil
+}
+
+// QueryGovICAAddress returns the address of the interchain account controlled by the governance on the host chain of
+// the connection. It fails if the account hasn't been registered yet.
+func QueryGovICAAddress(ctx context.Context, clientCtx Context, connectionID string) (string, error) {
+	res, err := icacontrollertypes.NewQueryClient(clientCtx).InterchainAccount(
+		ctx,
+		&icacontrollertypes.QueryInterchainAccountRequest{
+			Owner:        GovICAOwner(),
+			ConnectionId: connectionID,
+		},
+	)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to query interchain account of the governance on %s", connectionID)
+	}
+
+	return res.Address, nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+)
+
+func TestGovICAMsgs(t *testing.T) {
+	requireT := require.New(t)
+
+	const connectionID = "connection-0"
+
+	registrationMsg, err := NewGovICARegistrationMsg(connectionID)
+	requireT.NoError(err)
+	requireT.Equal(GovICAOwner(), registrationMsg.Owner)
+	requireT.Equal(connectionID, registrationMsg.ConnectionId)
+	requireT.Equal(channeltypes.UNORDERED, registrationMsg.Ordering)
+
+	_, err = NewGovICARegistrationMsg("")
+	requireT.Error(err)
+
+	cdc := config.NewEncodingConfig(bank.AppModuleBasic{}).Codec
+	hostMsg := &banktypes.MsgSend{
+		FromAddress: "cosmos1ica",
+		ToAddress:   "cosmos1recipient",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+	}
+	executionMsg, err := NewGovICAExecutionMsg(cdc, connectionID, time.Hour, hostMsg)
+	requireT.NoError(err)
+	requireT.Equal(GovICAOwner(), executionMsg.Owner)
+	requireT.Equal(connectionID, executionMsg.ConnectionId)
+	requireT.Equal(uint64(time.Hour), executionMsg.RelativeTimeout)
+	requireT.Equal(icatypes.EXECUTE_TX, executionMsg.PacketData.Type)
+
+	decodedMsgs, err := icatypes.DeserializeCosmosTx(cdc, executionMsg.PacketData.Data, icatypes.EncodingProtobuf)
+	requireT.NoError(err)
+	requireT.Len(decodedMsgs, 1)
+	requireT.Equal(hostMsg, decodedMsgs[0])
+
+	_, err = NewGovICAExecutionMsg(cdc, connectionID, time.Hour)
+	requireT.ErrorContains(err, "at least one host message is required")
+	_, err = NewGovICAExecutionMsg(cdc, connectionID, 0, hostMsg)
+	requireT.ErrorContains(err, "relative timeout must be positive")
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"encoding/json"
+	"time"
+
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/pkg/errors"
+)
+
+// PFMIntermediateReceiver is the receiver of the ICS-20 transfer on the intermediate chains of the forwarded
+// transfer. The packet forward middleware derives the intermediate receiver itself, so the invalid bech32 string is
+// recommended to be used to never lock the funds on the account of the intermediate chain.
+const PFMIntermediateReceiver = "pfm"
+
+// ForwardHop is the hop of the ICS-20 transfer forwarded by the packet forward middleware.
+type ForwardHop struct {
+	// Receiver is the receiver on the chain the transfer is forwarded to. Use PFMIntermediateReceiver if the
+	// transfer is forwarded further.
+	Receiver string
+	// Port is the port of the forwarded transfer, the transfer port is used if it is empty.
+	Port string
+	// Channel is the channel the transfer is forwarded through.
+	Channel string
+	// Timeout is the timeout of the forwarded transfer, the timeout of the middleware is used if it is zero.
+	Timeout time.Duration
+	// Retries is the number of the retries of the forwarded transfer, the middleware default is used if it is nil.
+	Retries *uint8
+}
+
+type forwardMemo struct {
+	Forward forwardMetadata `json:"forward"`
+}
+
+type forwardMetadata struct {
+	Receiver string                      `json:"receiver"`
+	Port     string                      `json:"port"`
+	Channel  string                      `json:"channel"`
+	Timeout  packetforwardtypes.Duration `json:"timeout,omitempty"`
+	Retries  *uint8                      `json:"retries,omitempty"`
+	Next     *forwardMemo                `json:"next,omitempty"`
+}
+
+// ICS20Receiver returns the receiver of the ICS-20 transfer to the address on the destination chain. The address
+// is verified to belong to the destination chain, since the funds sent to the address with the wrong prefix are
+// refunded only after the acknowledgement timeout.
+func ICS20Receiver(address string, destination AddressConfig) (string, error) {
+	decoded, err := ParseBech32Address(address, destination)
+	if err != nil {
+		return "", err
+	}
+
+	return ConvertToBech32Address(decoded, destination)
+}
+
+// BuildForwardMemo builds the memo of the ICS-20 transfer forwarded by the packet forward middleware through the
+// hops. The hops are executed in the provided order, so the receiver of the last hop is the final receiver of the
+// funds.
+func BuildForwardMemo(hops ...ForwardHop) (string, error) {
+	if len(hops) == 0 {
+		return "", errors.New("at least one hop must be provided")
+	}
+
+	var next *forwardMemo
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := hops[i]
+		if hop.Port == "" {
+			hop.Port = ibctransfertypes.PortID
+		}
+		if hop.Timeout < 0 {
+			return "", errors.Errorf("hop %d: timeout must not be negative", i)
+		}
+
+		metadata := packetforwardtypes.ForwardMetadata{
+			Receiver: hop.Receiver,
+			Port:     hop.Port,
+			Channel:  hop.Channel,
+		}
+		if err := metadata.Validate(); err != nil {
+			return "", errors.Wrapf(err, "hop %d", i)
+		}
+
+		next = &forwardMemo{
+			Forward: forwardMetadata{
+				Receiver: hop.Receiver,
+				Port:     hop.Port,
+				Channel:  hop.Channel,
+				Timeout:  packetforwardtypes.Duration(hop.Timeout),
+				Retries:  hop.Retries,
+				Next:     next,
+			},
+		}
+	}
+
+	memo, err := json.Marshal(next)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return string(memo), nil
+}
@@ -0,0 +1,80 @@
+package client_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+)
+
+func TestICS20Receiver(t *testing.T) {
+	requireT := require.New(t)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	gaiaAddress, err := client.ConvertToBech32Address(address, client.GaiaAddressConfig)
+	requireT.NoError(err)
+
+	receiver, err := client.ICS20Receiver(gaiaAddress, client.GaiaAddressConfig)
+	requireT.NoError(err)
+	requireT.Equal(gaiaAddress, receiver)
+
+	_, err = client.ICS20Receiver(gaiaAddress, client.OsmosisAddressConfig)
+	requireT.ErrorIs(err, client.ErrAddressPrefixMismatch)
+}
+
+func TestBuildForwardMemo(t *testing.T) {
+	requireT := require.New(t)
+
+	retries := uint8(2)
+	memo, err := client.BuildForwardMemo(
+		client.ForwardHop{
+			Receiver: client.PFMIntermediateReceiver,
+			Channel:  "channel-1",
+			Timeout:  time.Minute,
+			Retries:  &retries,
+		},
+		client.ForwardHop{
+			Receiver: "osmo1receiver",
+			Port:     "transfer",
+			Channel:  "channel-2",
+		},
+	)
+	requireT.NoError(err)
+	requireT.JSONEq(`{
+		"forward": {
+			"receiver": "pfm",
+			"port": "transfer",
+			"channel": "channel-1",
+			"timeout": 60000000000,
+			"retries": 2,
+			"next": {
+				"forward": {
+					"receiver": "osmo1receiver",
+					"port": "transfer",
+					"channel": "channel-2"
+				}
+			}
+		}
+	}`, memo)
+
+	// the memo is accepted by the middleware
+	var metadata packetforwardtypes.PacketMetadata
+	requireT.NoError(json.Unmarshal([]byte(memo), &metadata))
+	requireT.NoError(metadata.Forward.Validate())
+	requireT.Equal("channel-1", metadata.Forward.Channel)
+	requireT.Equal(packetforwardtypes.Duration(time.Minute), metadata.Forward.Timeout)
+
+	// the invalid hops are rejected
+	_, err = client.BuildForwardMemo()
+	requireT.Error(err)
+	_, err = client.BuildForwardMemo(client.ForwardHop{Channel: "channel-1"})
+	requireT.Error(err)
+	_, err = client.BuildForwardMemo(client.ForwardHop{Receiver: "pfm", Channel: "invalid channel"})
+	requireT.Error(err)
+}
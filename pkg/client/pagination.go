@@ -0,0 +1,221 @@
+package client
+
+// This file contains helpers used to iterate over paginated gRPC queries, so the consumers don't need
+// to reimplement the loops over `PageRequest` on their own.
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// PaginationConfig stores the config of the paginated queries.
+type PaginationConfig struct {
+	// PageLimit is the number of items requested per page. Zero value means the default limit of the node.
+	PageLimit uint64
+	// PageInterval is the minimum interval between the requests for the subsequent pages. It might be used to
+	// limit the rate of the requests sent to the node.
+	PageInterval time.Duration
+}
+
+// PaginationOption modifies the pagination config.
+type PaginationOption func(cfg *PaginationConfig)
+
+// WithPageLimit sets the number of items requested per page.
+func WithPageLimit(limit uint64) PaginationOption {
+	return func(cfg *PaginationConfig) {
+		cfg.PageLimit = limit
+	}
+}
+
+// WithPageInterval sets the minimum interval between the requests for the subsequent pages.
+func WithPageInterval(interval time.Duration) PaginationOption {
+	return func(cfg *PaginationConfig) {
+		cfg.PageInterval = interval
+	}
+}
+
+// NewPaginationConfig returns the pagination config with the options applied.
+func NewPaginationConfig(opts ...PaginationOption) PaginationConfig {
+	var cfg PaginationConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// PageRequest returns the page request for the page key.
+func (cfg PaginationConfig) PageRequest(pageKey []byte) *query.PageRequest {
+	return &query.PageRequest{
+		Key:   pageKey,
+		Limit: cfg.PageLimit,
+	}
+}
+
+// Paginate calls fetch for the subsequent pages until the returned next key is empty and returns all the fetched items.
+// The fetch function receives nil page key for the first page.
+func Paginate[T any](
+	ctx context.Context,
+	fetch func(pageKey []byte) ([]T, []byte, error),
+	opts ...PaginationOption,
+) ([]T, error) {
+	cfg := NewPaginationConfig(opts...)
+
+	var (
+		items   []T
+		pageKey []byte
+	)
+	for {
+		pageStart := time.Now()
+		page, nextKey, err := fetch(pageKey)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(nextKey) == 0 {
+			return items, nil
+		}
+		pageKey = nextKey
+
+		if wait := cfg.PageInterval - time.Since(pageStart); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, errors.WithStack(ctx.Err())
+			case <-time.After(wait):
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+}
+
+// QueryAllBalances returns all the balances of the account.
+func QueryAllBalances(
+	ctx context.Context,
+	bankClient banktypes.QueryClient,
+	address string,
+	opts ...PaginationOption,
+) (sdk.Coins, error) {
+	cfg := NewPaginationConfig(opts...)
+	balances, err := Paginate(ctx, func(pageKey []byte) ([]sdk.Coin, []byte, error) {
+		res, err := bankClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    address,
+			Pagination: cfg.PageRequest(pageKey),
+		})
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		return res.Balances, nextKey(res.Pagination), nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewCoins(balances...), nil
+}
+
+// QueryAllDelegations returns all the delegations of the delegator.
+func QueryAllDelegations(
+	ctx context.Context,
+	stakingClient stakingtypes.QueryClient,
+	delegator string,
+	opts ...PaginationOption,
+) ([]stakingtypes.DelegationResponse, error) {
+	cfg := NewPaginationConfig(opts...)
+	return Paginate(ctx, func(pageKey []byte) ([]stakingtypes.DelegationResponse, []byte, error) {
+		res, err := stakingClient.DelegatorDelegations(ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: delegator,
+			Pagination:    cfg.PageRequest(pageKey),
+		})
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		return res.DelegationResponses, nextKey(res.Pagination), nil
+	}, opts...)
+}
+
+// QueryAllFTTokens returns all the fungible tokens issued by the issuer.
+func QueryAllFTTokens(
+	ctx context.Context,
+	ftClient assetfttypes.QueryClient,
+	issuer string,
+	opts ...PaginationOption,
+) ([]assetfttypes.Token, error) {
+	cfg := NewPaginationConfig(opts...)
+	return Paginate(ctx, func(pageKey []byte) ([]assetfttypes.Token, []byte, error) {
+		res, err := ftClient.Tokens(ctx, &assetfttypes.QueryTokensRequest{
+			Issuer:     issuer,
+			Pagination: cfg.PageRequest(pageKey),
+		})
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		return res.Tokens, nextKey(res.Pagination), nil
+	}, opts...)
+}
+
+// QueryAllPSEScheduledDistributions returns all the future PSE scheduled distributions.
+// The query is not paginated on the chain side, so the schedule is fetched with the single request.
+func QueryAllPSEScheduledDistributions(
+	ctx context.Context,
+	pseClient psetypes.QueryClient,
+	opts ...PaginationOption,
+) ([]psetypes.ScheduledDistribution, error) {
+	return Paginate(ctx, func(_ []byte) ([]psetypes.ScheduledDistribution, []byte, error) {
+		res, err := pseClient.ScheduledDistributions(ctx, &psetypes.QueryScheduledDistributionsRequest{})
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		return res.ScheduledDistributions, nil, nil
+	}, opts...)
+}
+
+// QueryAllPSEScores returns the scores of all the accounts and the height they are taken at. All the pages are
+// queried at the height of the first one, so the node must keep the state of that height until the query finishes.
+func QueryAllPSEScores(
+	ctx context.Context,
+	pseClient psetypes.QueryClient,
+	opts ...PaginationOption,
+) ([]psetypes.AccountScore, int64, error) {
+	cfg := NewPaginationConfig(opts...)
+	var height int64
+	scores, err := Paginate(ctx, func(pageKey []byte) ([]psetypes.AccountScore, []byte, error) {
+		queryCtx := ctx
+		if height != 0 {
+			queryCtx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		}
+		res, err := pseClient.ScoresSnapshot(queryCtx, &psetypes.QueryScoresSnapshotRequest{
+			Height:     height,
+			Pagination: cfg.PageRequest(pageKey),
+		})
+		if err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		height = res.Height
+		return res.Scores, nextKey(res.Pagination), nil
+	}, opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return scores, height, nil
+}
+
+func nextKey(pageRes *query.PageResponse) []byte {
+	if pageRes == nil {
+		return nil
+	}
+	return pageRes.NextKey
+}
@@ -0,0 +1,60 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+)
+
+func TestPaginate(t *testing.T) {
+	requireT := require.New(t)
+
+	pages := map[string][]int{
+		"":   {1, 2},
+		"p2": {3, 4},
+		"p3": {5},
+	}
+	nextKeys := map[string][]byte{
+		"":   []byte("p2"),
+		"p2": []byte("p3"),
+	}
+
+	var requestedKeys []string
+	fetch := func(pageKey []byte) ([]int, []byte, error) {
+		requestedKeys = append(requestedKeys, string(pageKey))
+		return pages[string(pageKey)], nextKeys[string(pageKey)], nil
+	}
+
+	items, err := client.Paginate(context.Background(), fetch)
+	requireT.NoError(err)
+	requireT.Equal([]int{1, 2, 3, 4, 5}, items)
+	requireT.Equal([]string{"", "p2", "p3"}, requestedKeys)
+
+	// the pages are requested not more often than the interval
+	requestedKeys = nil
+	start := time.Now()
+	items, err = client.Paginate(context.Background(), fetch, client.WithPageInterval(50*time.Millisecond))
+	requireT.NoError(err)
+	requireT.Equal([]int{1, 2, 3, 4, 5}, items)
+	requireT.GreaterOrEqual(time.Since(start), 100*time.Millisecond)
+
+	// the error of fetch is returned
+	errFetch := errors.New("fetch failed")
+	_, err = client.Paginate(context.Background(), func(pageKey []byte) ([]int, []byte, error) {
+		return nil, nil, errFetch
+	})
+	requireT.ErrorIs(err, errFetch)
+
+	// the pagination is interrupted if the context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err = client.Paginate(ctx, func(pageKey []byte) ([]int, []byte, error) {
+		cancel()
+		return pages[string(pageKey)], nextKeys[string(pageKey)], nil
+	}, client.WithPageInterval(time.Hour))
+	requireT.ErrorIs(err, context.Canceled)
+}
@@ -0,0 +1,177 @@
+package portfolio
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// BalancesDelta is the change of the balances of the account.
+type BalancesDelta struct {
+	Address string
+	// Balances are the current balances of the account.
+	Balances sdk.Coins
+	// Increased are the amounts the balances increased by.
+	Increased sdk.Coins
+	// Decreased are the amounts the balances decreased by.
+	Decreased sdk.Coins
+}
+
+// DelegationChange is the change of the delegation of the account to the validator. The amounts are zero if the
+// delegation doesn't exist.
+type DelegationChange struct {
+	ValidatorAddress string
+	Previous         sdkmath.Int
+	Current          sdkmath.Int
+}
+
+// DelegationsDelta is the change of the delegations of the account.
+type DelegationsDelta struct {
+	Address string
+	// Delegations are the current delegations of the account.
+	Delegations []stakingtypes.DelegationResponse
+	// Changes are the changes of the delegated amounts sorted by the validator address.
+	Changes []DelegationChange
+}
+
+// ScoreDelta is the change of the PSE score of the account.
+type ScoreDelta struct {
+	Address  string
+	Previous sdkmath.Int
+	Current  sdkmath.Int
+}
+
+// PendingDistributionsDelta is the change of the PSE distributions scheduled on the chain.
+type PendingDistributionsDelta struct {
+	// Distributions are the current pending distributions sorted by the timestamp.
+	Distributions []psetypes.ScheduledDistribution
+	// Updated are the distributions which are scheduled or whose allocations are changed, e.g. by the fundings.
+	Updated []psetypes.ScheduledDistribution
+	// Removed are the distributions which are not pending anymore, usually since they are processed.
+	Removed []psetypes.ScheduledDistribution
+}
+
+func balancesDelta(address string, previous, current sdk.Coins) (BalancesDelta, bool) {
+	delta := BalancesDelta{
+		Address:   address,
+		Balances:  current,
+		Increased: sdk.NewCoins(),
+		Decreased: sdk.NewCoins(),
+	}
+	for _, coin := range current {
+		if diff := coin.Amount.Sub(previous.AmountOf(coin.Denom)); diff.IsPositive() {
+			delta.Increased = delta.Increased.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	for _, coin := range previous {
+		if diff := coin.Amount.Sub(current.AmountOf(coin.Denom)); diff.IsPositive() {
+			delta.Decreased = delta.Decreased.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	return delta, !delta.Increased.IsZero() || !delta.Decreased.IsZero()
+}
+
+func delegationsDelta(
+	address string,
+	previous, current []stakingtypes.DelegationResponse,
+) (DelegationsDelta, bool) {
+	previousAmounts := delegatedAmounts(previous)
+	currentAmounts := delegatedAmounts(current)
+
+	delta := DelegationsDelta{
+		Address:     address,
+		Delegations: current,
+	}
+	for validator, currentAmount := range currentAmounts {
+		previousAmount, ok := previousAmounts[validator]
+		if !ok {
+			previousAmount = sdkmath.ZeroInt()
+		}
+		if !previousAmount.Equal(currentAmount) {
+			delta.Changes = append(delta.Changes, DelegationChange{
+				ValidatorAddress: validator,
+				Previous:         previousAmount,
+				Current:          currentAmount,
+			})
+		}
+	}
+	for validator, previousAmount := range previousAmounts {
+		if _, ok := currentAmounts[validator]; !ok {
+			delta.Changes = append(delta.Changes, DelegationChange{
+				ValidatorAddress: validator,
+				Previous:         previousAmount,
+				Current:          sdkmath.ZeroInt(),
+			})
+		}
+	}
+	sort.Slice(delta.Changes, func(i, j int) bool {
+		return delta.Changes[i].ValidatorAddress < delta.Changes[j].ValidatorAddress
+	})
+
+	return delta, len(delta.Changes) > 0
+}
+
+func delegatedAmounts(delegations []stakingtypes.DelegationResponse) map[string]sdkmath.Int {
+	amounts := make(map[string]sdkmath.Int, len(delegations))
+	for _, delegation := range delegations {
+		amount, ok := amounts[delegation.Delegation.ValidatorAddress]
+		if !ok {
+			amount = sdkmath.ZeroInt()
+		}
+		amounts[delegation.Delegation.ValidatorAddress] = amount.Add(delegation.Balance.Amount)
+	}
+	return amounts
+}
+
+func scoreDelta(address string, previous, current sdkmath.Int) (ScoreDelta, bool) {
+	return ScoreDelta{
+		Address:  address,
+		Previous: previous,
+		Current:  current,
+	}, !previous.Equal(current)
+}
+
+func pendingDistributionsDelta(
+	previous, current []psetypes.ScheduledDistribution,
+) (PendingDistributionsDelta, bool) {
+	previousByTimestamp := make(map[uint64]psetypes.ScheduledDistribution, len(previous))
+	for _, distribution := range previous {
+		previousByTimestamp[distribution.Timestamp] = distribution
+	}
+	currentTimestamps := make(map[uint64]struct{}, len(current))
+
+	delta := PendingDistributionsDelta{
+		Distributions: current,
+	}
+	for _, distribution := range current {
+		currentTimestamps[distribution.Timestamp] = struct{}{}
+		previousDistribution, ok := previousByTimestamp[distribution.Timestamp]
+		if !ok || !equalAllocations(previousDistribution.Allocations, distribution.Allocations) {
+			delta.Updated = append(delta.Updated, distribution)
+		}
+	}
+	for _, distribution := range previous {
+		if _, ok := currentTimestamps[distribution.Timestamp]; !ok {
+			delta.Removed = append(delta.Removed, distribution)
+		}
+	}
+
+	return delta, len(delta.Updated) > 0 || len(delta.Removed) > 0
+}
+
+func equalAllocations(a, b []psetypes.ClearingAccountAllocation) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ClearingAccount != b[i].ClearingAccount || !a[i].Amount.Equal(b[i].Amount) {
+			return false
+		}
+	}
+	return true
+}
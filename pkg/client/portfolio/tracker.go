@@ -0,0 +1,399 @@
+// Package portfolio provides the watch-only tracker of the accounts' balances, delegations, PSE scores and the
+// pending PSE distributions. It is intended for the custodians watching the accounts they don't hold the keys of.
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+const (
+	// DefaultRefreshInterval is the default interval the whole portfolio is queried at.
+	DefaultRefreshInterval = 30 * time.Second
+	// DefaultRequestTimeout is the default timeout of the queries of the single refresh.
+	DefaultRequestTimeout = 10 * time.Second
+
+	trackerSubscriber  = "portfolio-tracker"
+	unsubscribeTimeout = 10 * time.Second
+)
+
+// Config is the config of the Tracker.
+type Config struct {
+	// RefreshInterval is the interval the whole portfolio is queried at. The scores and the pending distributions
+	// change without the transactions of the accounts, so they are tracked by the periodic queries.
+	RefreshInterval time.Duration
+	// RequestTimeout is the timeout of the queries of the single refresh.
+	RequestTimeout time.Duration
+	// Subscribe enables the subscriptions to the transactions spending or receiving the coins of the accounts, so
+	// the accounts are refreshed once they are changed instead of waiting for the next periodic refresh. The RPC
+	// client of the context must support the event subscriptions and be started.
+	Subscribe bool
+	// PaginationOptions are applied to the paginated queries.
+	PaginationOptions []client.PaginationOption
+}
+
+// DefaultConfig returns the default tracker config.
+func DefaultConfig() Config {
+	return Config{
+		RefreshInterval: DefaultRefreshInterval,
+		RequestTimeout:  DefaultRequestTimeout,
+		Subscribe:       true,
+	}
+}
+
+// Callbacks are called with the changes of the portfolio. The nil callbacks are skipped. The callbacks are called
+// sequentially from the goroutine running the tracker, so they must not block for long.
+type Callbacks struct {
+	OnBalances             func(BalancesDelta)
+	OnDelegations          func(DelegationsDelta)
+	OnScore                func(ScoreDelta)
+	OnPendingDistributions func(PendingDistributionsDelta)
+	// OnError is called if the refresh fails. The cached state is kept and the refresh is retried the next time.
+	OnError func(error)
+}
+
+// Account is the cached state of the watched account.
+type Account struct {
+	Address     string
+	Balances    sdk.Coins
+	Delegations []stakingtypes.DelegationResponse
+	Score       sdkmath.Int
+}
+
+// Snapshot is the cached state of the portfolio.
+type Snapshot struct {
+	// Accounts are the states of the watched accounts in the order they are passed to the tracker. The accounts
+	// which are not loaded yet are skipped.
+	Accounts []Account
+	// PendingDistributions are the PSE distributions scheduled on the chain.
+	PendingDistributions []psetypes.ScheduledDistribution
+}
+
+type querier interface {
+	balances(ctx context.Context, address string) (sdk.Coins, error)
+	delegations(ctx context.Context, address string) ([]stakingtypes.DelegationResponse, error)
+	score(ctx context.Context, address string) (sdkmath.Int, error)
+	pendingDistributions(ctx context.Context) ([]psetypes.ScheduledDistribution, error)
+}
+
+// Tracker tracks the portfolio of the accounts. It keeps the last known state of the accounts and calls the
+// callbacks with the differences found by the subsequent refreshes.
+type Tracker struct {
+	config       Config
+	addresses    []string
+	query        querier
+	eventsClient rpcclient.EventsClient
+	callbacks    Callbacks
+
+	mu                   sync.RWMutex
+	accounts             map[string]Account
+	pendingDistributions []psetypes.ScheduledDistribution
+}
+
+// NewTracker returns the new tracker of the accounts. The accounts are queried only once the Run is called.
+func NewTracker(
+	clientCtx client.Context,
+	config Config,
+	callbacks Callbacks,
+	accounts ...sdk.AccAddress,
+) (*Tracker, error) {
+	var eventsClient rpcclient.EventsClient
+	if config.Subscribe {
+		var ok bool
+		eventsClient, ok = clientCtx.RPCClient().(rpcclient.EventsClient)
+		if !ok {
+			return nil, errors.New("rpc client doesn't support event subscriptions")
+		}
+	}
+
+	return newTracker(
+		chainQuerier{
+			bankClient:        banktypes.NewQueryClient(clientCtx),
+			stakingClient:     stakingtypes.NewQueryClient(clientCtx),
+			pseClient:         psetypes.NewQueryClient(clientCtx),
+			paginationOptions: config.PaginationOptions,
+		},
+		eventsClient,
+		config,
+		callbacks,
+		accounts...,
+	)
+}
+
+func newTracker(
+	query querier,
+	eventsClient rpcclient.EventsClient,
+	config Config,
+	callbacks Callbacks,
+	accounts ...sdk.AccAddress,
+) (*Tracker, error) {
+	if len(accounts) == 0 {
+		return nil, errors.New("at least one account is required")
+	}
+	if config.RefreshInterval <= 0 {
+		return nil, errors.Errorf("refresh interval must be positive, got %s", config.RefreshInterval)
+	}
+	if config.RequestTimeout <= 0 {
+		return nil, errors.Errorf("request timeout must be positive, got %s", config.RequestTimeout)
+	}
+
+	addresses := make([]string, 0, len(accounts))
+	seen := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		address := account.String()
+		if _, ok := seen[address]; ok {
+			continue
+		}
+		seen[address] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	return &Tracker{
+		config:       config,
+		addresses:    addresses,
+		query:        query,
+		eventsClient: eventsClient,
+		callbacks:    callbacks,
+		accounts:     make(map[string]Account, len(addresses)),
+	}, nil
+}
+
+// Snapshot returns the cached state of the portfolio.
+func (t *Tracker) Snapshot() Snapshot {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	snapshot := Snapshot{
+		Accounts:             make([]Account, 0, len(t.addresses)),
+		PendingDistributions: t.pendingDistributions,
+	}
+	for _, address := range t.addresses {
+		if account, ok := t.accounts[address]; ok {
+			snapshot.Accounts = append(snapshot.Accounts, account)
+		}
+	}
+
+	return snapshot
+}
+
+// Run tracks the portfolio until the ctx is canceled. The whole portfolio is refreshed immediately and then at the
+// refresh interval, the accounts are refreshed in between once the subscriptions report their transactions.
+// The first refresh reports the loaded state as the changes from the empty one. If the subscriptions are terminated
+// by the node, the tracker falls back to the periodic refreshes. Run must be called only once.
+func (t *Tracker) Run(ctx context.Context) error {
+	changed := make(chan string)
+	if t.eventsClient != nil {
+		subscriptions, err := t.subscribe(ctx)
+		if err != nil {
+			return err
+		}
+		defer t.unsubscribe()
+
+		for address, accountSubscriptions := range subscriptions {
+			for _, results := range accountSubscriptions {
+				go forwardChanges(ctx, address, results, changed)
+			}
+		}
+	}
+
+	t.refresh(ctx)
+
+	ticker := time.NewTicker(t.config.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-ticker.C:
+			t.refresh(ctx)
+		case address := <-changed:
+			t.refreshAccount(ctx, address)
+		}
+	}
+}
+
+// refresh queries the whole portfolio.
+func (t *Tracker) refresh(ctx context.Context) {
+	for _, address := range t.addresses {
+		t.refreshAccount(ctx, address)
+	}
+	t.refreshPendingDistributions(ctx)
+}
+
+func (t *Tracker) refreshAccount(ctx context.Context, address string) {
+	ctx, cancel := context.WithTimeout(ctx, t.config.RequestTimeout)
+	defer cancel()
+
+	balances, err := t.query.balances(ctx, address)
+	if err != nil {
+		t.reportError(errors.Wrapf(err, "failed to query balances of %s", address))
+		return
+	}
+	delegations, err := t.query.delegations(ctx, address)
+	if err != nil {
+		t.reportError(errors.Wrapf(err, "failed to query delegations of %s", address))
+		return
+	}
+	score, err := t.query.score(ctx, address)
+	if err != nil {
+		t.reportError(errors.Wrapf(err, "failed to query score of %s", address))
+		return
+	}
+
+	current := Account{
+		Address:     address,
+		Balances:    balances,
+		Delegations: delegations,
+		Score:       score,
+	}
+	t.mu.Lock()
+	previous, ok := t.accounts[address]
+	t.accounts[address] = current
+	t.mu.Unlock()
+	if !ok {
+		previous = Account{
+			Address: address,
+			Score:   sdkmath.ZeroInt(),
+		}
+	}
+
+	if delta, changed := balancesDelta(address, previous.Balances, current.Balances); changed &&
+		t.callbacks.OnBalances != nil {
+		t.callbacks.OnBalances(delta)
+	}
+	if delta, changed := delegationsDelta(address, previous.Delegations, current.Delegations); changed &&
+		t.callbacks.OnDelegations != nil {
+		t.callbacks.OnDelegations(delta)
+	}
+	if delta, changed := scoreDelta(address, previous.Score, current.Score); changed && t.callbacks.OnScore != nil {
+		t.callbacks.OnScore(delta)
+	}
+}
+
+func (t *Tracker) refreshPendingDistributions(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, t.config.RequestTimeout)
+	defer cancel()
+
+	current, err := t.query.pendingDistributions(ctx)
+	if err != nil {
+		t.reportError(errors.Wrap(err, "failed to query pending distributions"))
+		return
+	}
+
+	t.mu.Lock()
+	previous := t.pendingDistributions
+	t.pendingDistributions = current
+	t.mu.Unlock()
+
+	if delta, changed := pendingDistributionsDelta(previous, current); changed &&
+		t.callbacks.OnPendingDistributions != nil {
+		t.callbacks.OnPendingDistributions(delta)
+	}
+}
+
+func (t *Tracker) reportError(err error) {
+	if t.callbacks.OnError != nil {
+		t.callbacks.OnError(err)
+	}
+}
+
+// subscribe subscribes to the transactions spending or receiving the coins of the accounts. The event queries don't
+// support the alternatives, so each account is subscribed twice.
+func (t *Tracker) subscribe(ctx context.Context) (map[string][]<-chan coretypes.ResultEvent, error) {
+	subscriptions := make(map[string][]<-chan coretypes.ResultEvent, len(t.addresses))
+	for _, address := range t.addresses {
+		for _, query := range accountQueries(address) {
+			results, err := t.eventsClient.Subscribe(ctx, trackerSubscriber, query)
+			if err != nil {
+				t.unsubscribe()
+				return nil, errors.Wrapf(err, "failed to subscribe to transactions of %s", address)
+			}
+			subscriptions[address] = append(subscriptions[address], results)
+		}
+	}
+
+	return subscriptions, nil
+}
+
+func (t *Tracker) unsubscribe() {
+	ctx, cancel := context.WithTimeout(context.Background(), unsubscribeTimeout)
+	defer cancel()
+	// The error is ignored since the subscriptions are dropped by the node anyway once the connection is closed.
+	_ = t.eventsClient.UnsubscribeAll(ctx, trackerSubscriber)
+}
+
+func accountQueries(address string) []string {
+	return []string{
+		fmt.Sprintf("%s='%s' AND %s.%s='%s'",
+			cmttypes.EventTypeKey, cmttypes.EventTx, banktypes.EventTypeCoinSpent, banktypes.AttributeKeySpender, address,
+		),
+		fmt.Sprintf("%s='%s' AND %s.%s='%s'",
+			cmttypes.EventTypeKey, cmttypes.EventTx, banktypes.EventTypeCoinReceived, banktypes.AttributeKeyReceiver, address,
+		),
+	}
+}
+
+// forwardChanges reports the account as changed once the subscription delivers its transaction.
+func forwardChanges(
+	ctx context.Context,
+	address string,
+	results <-chan coretypes.ResultEvent,
+	changed chan<- string,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-results:
+			if !ok {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case changed <- address:
+			}
+		}
+	}
+}
+
+type chainQuerier struct {
+	bankClient        banktypes.QueryClient
+	stakingClient     stakingtypes.QueryClient
+	pseClient         psetypes.QueryClient
+	paginationOptions []client.PaginationOption
+}
+
+func (q chainQuerier) balances(ctx context.Context, address string) (sdk.Coins, error) {
+	return client.QueryAllBalances(ctx, q.bankClient, address, q.paginationOptions...)
+}
+
+func (q chainQuerier) delegations(ctx context.Context, address string) ([]stakingtypes.DelegationResponse, error) {
+	return client.QueryAllDelegations(ctx, q.stakingClient, address, q.paginationOptions...)
+}
+
+func (q chainQuerier) score(ctx context.Context, address string) (sdkmath.Int, error) {
+	res, err := q.pseClient.Score(ctx, &psetypes.QueryScoreRequest{Address: address})
+	if err != nil {
+		return sdkmath.Int{}, errors.WithStack(err)
+	}
+	return res.Score, nil
+}
+
+func (q chainQuerier) pendingDistributions(ctx context.Context) ([]psetypes.ScheduledDistribution, error) {
+	return client.QueryAllPSEScheduledDistributions(ctx, q.pseClient, q.paginationOptions...)
+}
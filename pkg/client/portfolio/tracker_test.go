@@ -0,0 +1,168 @@
+package portfolio
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+type fakeQuerier struct {
+	balancesByAddress    map[string]sdk.Coins
+	delegationsByAddress map[string][]stakingtypes.DelegationResponse
+	scores               map[string]sdkmath.Int
+	distributions        []psetypes.ScheduledDistribution
+	err                  error
+}
+
+func (q *fakeQuerier) balances(_ context.Context, address string) (sdk.Coins, error) {
+	return q.balancesByAddress[address], q.err
+}
+
+func (q *fakeQuerier) delegations(_ context.Context, address string) ([]stakingtypes.DelegationResponse, error) {
+	return q.delegationsByAddress[address], q.err
+}
+
+func (q *fakeQuerier) score(_ context.Context, address string) (sdkmath.Int, error) {
+	score, ok := q.scores[address]
+	if !ok {
+		score = sdkmath.ZeroInt()
+	}
+	return score, q.err
+}
+
+func (q *fakeQuerier) pendingDistributions(_ context.Context) ([]psetypes.ScheduledDistribution, error) {
+	return q.distributions, q.err
+}
+
+func TestTracker(t *testing.T) {
+	requireT := require.New(t)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	address := account.String()
+	const validator = "devcorevaloper1"
+
+	query := &fakeQuerier{
+		balancesByAddress: map[string]sdk.Coins{
+			address: sdk.NewCoins(sdk.NewInt64Coin("ucore", 100), sdk.NewInt64Coin("uatom", 5)),
+		},
+		delegationsByAddress: map[string][]stakingtypes.DelegationResponse{},
+		scores:               map[string]sdkmath.Int{address: sdkmath.NewInt(10)},
+		distributions:        []psetypes.ScheduledDistribution{distribution(100, 1000), distribution(200, 2000)},
+	}
+
+	var (
+		balances      []BalancesDelta
+		delegations   []DelegationsDelta
+		scores        []ScoreDelta
+		distributions []PendingDistributionsDelta
+		errs          []error
+	)
+	tracker, err := newTracker(query, nil, DefaultConfig(), Callbacks{
+		OnBalances:             func(delta BalancesDelta) { balances = append(balances, delta) },
+		OnDelegations:          func(delta DelegationsDelta) { delegations = append(delegations, delta) },
+		OnScore:                func(delta ScoreDelta) { scores = append(scores, delta) },
+		OnPendingDistributions: func(delta PendingDistributionsDelta) { distributions = append(distributions, delta) },
+		OnError:                func(err error) { errs = append(errs, err) },
+	}, account, account)
+	requireT.NoError(err)
+
+	ctx := context.Background()
+
+	// the first refresh reports the loaded state
+	tracker.refresh(ctx)
+	requireT.Len(balances, 1)
+	requireT.Equal(query.balancesByAddress[address].String(), balances[0].Increased.String())
+	requireT.True(balances[0].Decreased.IsZero())
+	requireT.Empty(delegations)
+	requireT.Len(scores, 1)
+	requireT.Equal(sdkmath.ZeroInt().String(), scores[0].Previous.String())
+	requireT.Equal(sdkmath.NewInt(10).String(), scores[0].Current.String())
+	requireT.Len(distributions, 1)
+	requireT.Len(distributions[0].Updated, 2)
+
+	snapshot := tracker.Snapshot()
+	requireT.Len(snapshot.Accounts, 1)
+	requireT.Equal(address, snapshot.Accounts[0].Address)
+	requireT.Len(snapshot.PendingDistributions, 2)
+
+	// nothing is reported if nothing changed
+	tracker.refresh(ctx)
+	requireT.Len(balances, 1)
+	requireT.Len(scores, 1)
+	requireT.Len(distributions, 1)
+
+	// the account delegates and the first distribution is processed while the second one is funded
+	query.balancesByAddress[address] = sdk.NewCoins(sdk.NewInt64Coin("ucore", 40), sdk.NewInt64Coin("uatom", 5))
+	query.delegationsByAddress[address] = []stakingtypes.DelegationResponse{
+		stakingtypes.NewDelegationResp(address, validator, sdkmath.LegacyNewDec(60), sdk.NewInt64Coin("ucore", 60)),
+	}
+	query.scores[address] = sdkmath.NewInt(25)
+	query.distributions = []psetypes.ScheduledDistribution{distribution(200, 2500)}
+	tracker.refresh(ctx)
+
+	requireT.Len(balances, 2)
+	requireT.True(balances[1].Increased.IsZero())
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin("ucore", 60)).String(), balances[1].Decreased.String())
+	requireT.Len(delegations, 1)
+	requireT.Equal([]DelegationChange{{
+		ValidatorAddress: validator,
+		Previous:         sdkmath.ZeroInt(),
+		Current:          sdkmath.NewInt(60),
+	}}, delegations[0].Changes)
+	requireT.Len(scores, 2)
+	requireT.Equal(sdkmath.NewInt(25).String(), scores[1].Current.String())
+	requireT.Len(distributions, 2)
+	requireT.Equal([]psetypes.ScheduledDistribution{distribution(200, 2500)}, distributions[1].Updated)
+	requireT.Equal([]psetypes.ScheduledDistribution{distribution(100, 1000)}, distributions[1].Removed)
+
+	// the undelegation removes the delegation
+	query.delegationsByAddress[address] = nil
+	tracker.refreshAccount(ctx, address)
+	requireT.Len(delegations, 2)
+	requireT.Equal(sdkmath.ZeroInt().String(), delegations[1].Changes[0].Current.String())
+
+	// the failed refresh keeps the cached state
+	query.err = errors.New("node is down")
+	query.balancesByAddress[address] = nil
+	tracker.refresh(ctx)
+	requireT.Len(errs, 2)
+	requireT.Len(balances, 2)
+	requireT.Equal("5uatom,40ucore", tracker.Snapshot().Accounts[0].Balances.String())
+}
+
+func TestNewTracker_Validation(t *testing.T) {
+	requireT := require.New(t)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	_, err := newTracker(&fakeQuerier{}, nil, DefaultConfig(), Callbacks{})
+	requireT.ErrorContains(err, "at least one account is required")
+
+	config := DefaultConfig()
+	config.RefreshInterval = 0
+	_, err = newTracker(&fakeQuerier{}, nil, config, Callbacks{}, account)
+	requireT.ErrorContains(err, "refresh interval must be positive")
+
+	config = DefaultConfig()
+	config.RequestTimeout = 0
+	_, err = newTracker(&fakeQuerier{}, nil, config, Callbacks{}, account)
+	requireT.ErrorContains(err, "request timeout must be positive")
+}
+
+func distribution(timestamp uint64, amount int64) psetypes.ScheduledDistribution {
+	return psetypes.ScheduledDistribution{
+		Timestamp: timestamp,
+		Allocations: []psetypes.ClearingAccountAllocation{{
+			ClearingAccount: psetypes.ClearingAccountCommunity,
+			Amount:          sdkmath.NewInt(amount),
+		}},
+	}
+}
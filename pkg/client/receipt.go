@@ -0,0 +1,265 @@
+package client
+
+// This file contains helpers turning the result of the transaction included in a block into the structured receipt
+// with the decoded events and the balance changes of the involved accounts, so the services can persist it directly.
+
+import (
+	"context"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// ReceiptConfig stores the config of the transaction receipt.
+type ReceiptConfig struct {
+	// Counterparties are the named accounts, besides the signer, the balance changes are computed for.
+	Counterparties []ReceiptCounterparty
+	// PaginationOptions are applied to the balance queries.
+	PaginationOptions []PaginationOption
+}
+
+// ReceiptCounterparty is the named account the balance changes are computed for.
+type ReceiptCounterparty struct {
+	Name    string
+	Address string
+}
+
+// ReceiptOption modifies the receipt config.
+type ReceiptOption func(cfg *ReceiptConfig)
+
+// WithReceiptCounterparty adds the named account the balance changes are computed for, e.g. the recipient of the
+// transfer or the contract.
+func WithReceiptCounterparty(name, address string) ReceiptOption {
+	return func(cfg *ReceiptConfig) {
+		cfg.Counterparties = append(cfg.Counterparties, ReceiptCounterparty{
+			Name:    name,
+			Address: address,
+		})
+	}
+}
+
+// WithReceiptPagination sets the pagination options of the balance queries.
+func WithReceiptPagination(opts ...PaginationOption) ReceiptOption {
+	return func(cfg *ReceiptConfig) {
+		cfg.PaginationOptions = opts
+	}
+}
+
+// NewReceiptConfig returns the receipt config with the options applied.
+func NewReceiptConfig(opts ...ReceiptOption) ReceiptConfig {
+	var cfg ReceiptConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// TxEvent is the event emitted by the transaction.
+type TxEvent struct {
+	Type string
+	// MsgIndex is the index of the message emitting the event, it is -1 if the event is emitted by the ante or post
+	// handler, e.g. the fee payment.
+	MsgIndex int
+	// Typed is the decoded typed event, it is nil if the event isn't the typed one, e.g. the bank transfer event.
+	Typed proto.Message
+	// Attributes are the raw attributes of the event.
+	Attributes map[string]string
+}
+
+// BalanceDiff is the change of the balances of the account caused by the block including the transaction.
+type BalanceDiff struct {
+	Name    string
+	Address string
+	Before  sdk.Coins
+	After   sdk.Coins
+	// Received is the positive part of the change.
+	Received sdk.Coins
+	// Spent is the negative part of the change, including the fee paid by the signer.
+	Spent sdk.Coins
+}
+
+// TxReceipt is the structured result of the transaction included in a block.
+type TxReceipt struct {
+	TxHash    string
+	Height    int64
+	Timestamp string
+	GasWanted int64
+	GasUsed   int64
+	Events    []TxEvent
+	// Signer is the balance change of the signer of the transaction.
+	Signer BalanceDiff
+	// Counterparties are the balance changes of the named counterparties in the order of the options.
+	Counterparties []BalanceDiff
+}
+
+// BroadcastTxWithReceipt broadcasts the transaction the same way BroadcastTx does, awaits its inclusion in a block
+// and returns its receipt.
+func BroadcastTxWithReceipt(
+	ctx context.Context,
+	clientCtx Context,
+	txf Factory,
+	msgs []sdk.Msg,
+	opts ...ReceiptOption,
+) (TxReceipt, error) {
+	signer := clientCtx.FromAddress()
+	if len(signer) == 0 {
+		key, err := clientCtx.Keyring().Key(clientCtx.FromName())
+		if err != nil {
+			return TxReceipt{}, errors.Wrapf(err, "failed to get key %q from the keyring", clientCtx.FromName())
+		}
+		signer, err = key.GetAddress()
+		if err != nil {
+			return TxReceipt{}, errors.WithStack(err)
+		}
+	}
+
+	txRes, err := BroadcastTx(ctx, clientCtx, txf, msgs...)
+	if err != nil {
+		return TxReceipt{}, err
+	}
+
+	return QueryTxReceipt(ctx, clientCtx, txRes, signer, opts...)
+}
+
+// QueryTxReceipt returns the receipt of the transaction. If the transaction isn't included in a block yet, it is
+// awaited. The balance changes are computed by querying the balances at the height preceding the block including the
+// transaction and at the height of the block, so the node must keep the state of both heights. The changes include
+// the effects of the other transactions in the same block touching the same accounts.
+func QueryTxReceipt(
+	ctx context.Context,
+	clientCtx Context,
+	txRes *sdk.TxResponse,
+	signer sdk.AccAddress,
+	opts ...ReceiptOption,
+) (TxReceipt, error) {
+	cfg := NewReceiptConfig(opts...)
+
+	if txRes.Height == 0 {
+		var err error
+		txRes, err = AwaitTx(ctx, clientCtx, txRes.TxHash)
+		if err != nil {
+			return TxReceipt{}, err
+		}
+	}
+
+	events, err := decodeTxEvents(txRes.Events)
+	if err != nil {
+		return TxReceipt{}, err
+	}
+
+	bankClient := banktypes.NewQueryClient(clientCtx)
+	queryBalanceDiff := func(name, address string) (BalanceDiff, error) {
+		before, err := queryBalancesAtHeight(ctx, clientCtx, bankClient, address, txRes.Height-1, cfg.PaginationOptions)
+		if err != nil {
+			return BalanceDiff{}, err
+		}
+		after, err := queryBalancesAtHeight(ctx, clientCtx, bankClient, address, txRes.Height, cfg.PaginationOptions)
+		if err != nil {
+			return BalanceDiff{}, err
+		}
+		return newBalanceDiff(name, address, before, after), nil
+	}
+
+	receipt := TxReceipt{
+		TxHash:         txRes.TxHash,
+		Height:         txRes.Height,
+		Timestamp:      txRes.Timestamp,
+		GasWanted:      txRes.GasWanted,
+		GasUsed:        txRes.GasUsed,
+		Events:         events,
+		Counterparties: make([]BalanceDiff, 0, len(cfg.Counterparties)),
+	}
+	receipt.Signer, err = queryBalanceDiff(clientCtx.FromName(), signer.String())
+	if err != nil {
+		return TxReceipt{}, err
+	}
+	for _, counterparty := range cfg.Counterparties {
+		diff, err := queryBalanceDiff(counterparty.Name, counterparty.Address)
+		if err != nil {
+			return TxReceipt{}, errors.Wrapf(err, "counterparty %s", counterparty.Name)
+		}
+		receipt.Counterparties = append(receipt.Counterparties, diff)
+	}
+
+	return receipt, nil
+}
+
+func queryBalancesAtHeight(
+	ctx context.Context,
+	clientCtx Context,
+	bankClient banktypes.QueryClient,
+	address string,
+	height int64,
+	opts []PaginationOption,
+) (sdk.Coins, error) {
+	queryCtx := metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	requestCtx, cancel := context.WithTimeout(queryCtx, clientCtx.config.TimeoutConfig.RequestTimeout)
+	defer cancel()
+
+	balances, err := QueryAllBalances(requestCtx, bankClient, address, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query balances of %s at height %d", address, height)
+	}
+	return balances, nil
+}
+
+func decodeTxEvents(abciEvents []abci.Event) ([]TxEvent, error) {
+	events := make([]TxEvent, 0, len(abciEvents))
+	for _, abciEvent := range abciEvents {
+		event := TxEvent{
+			Type:       abciEvent.Type,
+			MsgIndex:   -1,
+			Attributes: make(map[string]string, len(abciEvent.Attributes)),
+		}
+		for _, attr := range abciEvent.Attributes {
+			event.Attributes[attr.Key] = attr.Value
+		}
+		if msgIndex, ok := event.Attributes[msgIndexAttribute]; ok {
+			index, err := strconv.Atoi(msgIndex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid message index of the event %s", abciEvent.Type)
+			}
+			event.MsgIndex = index
+		}
+		// the type of the typed event is the proto name of the message, the other events aren't registered
+		if proto.MessageType(abciEvent.Type) != nil {
+			typed, err := sdk.ParseTypedEvent(abciEvent)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode the event %s", abciEvent.Type)
+			}
+			event.Typed = typed
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func newBalanceDiff(name, address string, before, after sdk.Coins) BalanceDiff {
+	diff := BalanceDiff{
+		Name:     name,
+		Address:  address,
+		Before:   before,
+		After:    after,
+		Received: sdk.NewCoins(),
+		Spent:    sdk.NewCoins(),
+	}
+	for _, coin := range after {
+		if amount := coin.Amount.Sub(before.AmountOf(coin.Denom)); amount.IsPositive() {
+			diff.Received = diff.Received.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	for _, coin := range before {
+		if amount := coin.Amount.Sub(after.AmountOf(coin.Denom)); amount.IsPositive() {
+			diff.Spent = diff.Spent.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return diff
+}
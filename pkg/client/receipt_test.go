@@ -0,0 +1,60 @@
+package client
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	feereferraltypes "github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+func TestDecodeTxEvents(t *testing.T) {
+	requireT := require.New(t)
+
+	typedEvent, err := sdk.TypedEventToEvent(&feereferraltypes.EventReferrerUnregistered{
+		Address: "devcore1referrer",
+	})
+	requireT.NoError(err)
+	typedEvent.Attributes = append(typedEvent.Attributes, abci.EventAttribute{Key: msgIndexAttribute, Value: "1"})
+	feeEvent := abci.Event{
+		Type: "tx",
+		Attributes: []abci.EventAttribute{
+			{Key: "fee", Value: "100ucore"},
+		},
+	}
+
+	events, err := decodeTxEvents([]abci.Event{feeEvent, abci.Event(typedEvent)})
+	requireT.NoError(err)
+	requireT.Len(events, 2)
+
+	requireT.Equal("tx", events[0].Type)
+	requireT.Equal(-1, events[0].MsgIndex)
+	requireT.Nil(events[0].Typed)
+	requireT.Equal(map[string]string{"fee": "100ucore"}, events[0].Attributes)
+
+	requireT.Equal(1, events[1].MsgIndex)
+	requireT.Equal(&feereferraltypes.EventReferrerUnregistered{Address: "devcore1referrer"}, events[1].Typed)
+
+	// the typed event which can't be decoded is reported
+	typedEvent.Attributes[0].Value = "invalid"
+	_, err = decodeTxEvents([]abci.Event{abci.Event(typedEvent)})
+	requireT.Error(err)
+}
+
+func TestNewBalanceDiff(t *testing.T) {
+	requireT := require.New(t)
+
+	before := sdk.NewCoins(sdk.NewInt64Coin("ucore", 1_000), sdk.NewInt64Coin("uatom", 50))
+	after := sdk.NewCoins(sdk.NewInt64Coin("ucore", 900), sdk.NewInt64Coin("uosmo", 30))
+	diff := newBalanceDiff("signer", "addr1", before, after)
+	requireT.Equal("signer", diff.Name)
+	requireT.Equal("addr1", diff.Address)
+	requireT.Equal("30uosmo", diff.Received.String())
+	requireT.Equal("50uatom,100ucore", diff.Spent.String())
+
+	diff = newBalanceDiff("recipient", "addr2", before, before)
+	requireT.True(diff.Received.IsZero())
+	requireT.True(diff.Spent.IsZero())
+}
@@ -0,0 +1,215 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultTransferBatchSize is the default maximum number of transfers sent in one transaction.
+	DefaultTransferBatchSize = 50
+	// DefaultTransferBatchInterval is the default time the first queued transfer waits for the others.
+	DefaultTransferBatchInterval = time.Second
+	// DefaultTransferQueueSize is the default maximum number of transfers waiting for the batch.
+	DefaultTransferQueueSize = 1000
+)
+
+// ErrTransferQueueFull is returned if the transfer can't be queued because the queue is full.
+var ErrTransferQueueFull = errors.New("transfer queue is full")
+
+// TransferQueueConfig is the config of the TransferQueue.
+type TransferQueueConfig struct {
+	// BatchSize is the maximum number of transfers sent in one transaction.
+	BatchSize int
+	// BatchInterval is the time the first queued transfer waits for the others before the batch is sent.
+	BatchInterval time.Duration
+	// QueueSize is the maximum number of transfers waiting for the batch.
+	QueueSize int
+}
+
+// DefaultTransferQueueConfig returns the default transfer queue config.
+func DefaultTransferQueueConfig() TransferQueueConfig {
+	return TransferQueueConfig{
+		BatchSize:     DefaultTransferBatchSize,
+		BatchInterval: DefaultTransferBatchInterval,
+		QueueSize:     DefaultTransferQueueSize,
+	}
+}
+
+type transferRequest struct {
+	output banktypes.Output
+	result chan transferResult
+}
+
+type transferResult struct {
+	txRes *sdk.TxResponse
+	err   error
+}
+
+type broadcastFunc func(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+
+// TransferQueue sends the transfers from the single account in batches. The transfers queued concurrently are
+// collected into one bank multi-send transaction, so the sender doesn't need to manage the account sequence and
+// the number of transactions is reduced when the transfers are requested at high rate, e.g. by the faucet.
+type TransferQueue struct {
+	config    TransferQueueConfig
+	sender    sdk.AccAddress
+	broadcast broadcastFunc
+	requests  chan transferRequest
+	stopped   chan struct{}
+	stopErr   error
+}
+
+// NewTransferQueue returns the new transfer queue sending the funds from the address of the client context.
+// The transactions are broadcast only once the Run is called.
+func NewTransferQueue(clientCtx Context, txf Factory, config TransferQueueConfig) (*TransferQueue, error) {
+	if len(clientCtx.FromAddress()) == 0 {
+		return nil, errors.New("from address must be set in the client context")
+	}
+
+	return newTransferQueue(
+		clientCtx.FromAddress(),
+		func(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+			return BroadcastTx(ctx, clientCtx, txf, msgs...)
+		},
+		config,
+	)
+}
+
+func newTransferQueue(
+	sender sdk.AccAddress,
+	broadcast broadcastFunc,
+	config TransferQueueConfig,
+) (*TransferQueue, error) {
+	if config.BatchSize <= 0 {
+		return nil, errors.Errorf("batch size must be positive, got %d", config.BatchSize)
+	}
+	if config.BatchInterval < 0 {
+		return nil, errors.Errorf("batch interval must not be negative, got %s", config.BatchInterval)
+	}
+	if config.QueueSize <= 0 {
+		return nil, errors.Errorf("queue size must be positive, got %d", config.QueueSize)
+	}
+
+	return &TransferQueue{
+		config:    config,
+		sender:    sender,
+		broadcast: broadcast,
+		requests:  make(chan transferRequest, config.QueueSize),
+		stopped:   make(chan struct{}),
+	}, nil
+}
+
+// Transfer queues the transfer and waits until the transaction containing it is executed. The response of the
+// transaction is shared by all the transfers of the batch.
+func (q *TransferQueue) Transfer(
+	ctx context.Context,
+	recipient sdk.AccAddress,
+	amount sdk.Coins,
+) (*sdk.TxResponse, error) {
+	if len(recipient) == 0 {
+		return nil, errors.New("recipient must be set")
+	}
+	if !amount.IsValid() || amount.IsZero() {
+		return nil, errors.Errorf("invalid amount %s", amount)
+	}
+
+	req := transferRequest{
+		output: banktypes.NewOutput(recipient, amount),
+		result: make(chan transferResult, 1),
+	}
+	select {
+	case q.requests <- req:
+	default:
+		return nil, errors.WithStack(ErrTransferQueueFull)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.WithStack(ctx.Err())
+	case res := <-req.result:
+		return res.txRes, res.err
+	case <-q.stopped:
+		// the transfer might be queued after the queue was drained, so nobody replies to it
+		return nil, q.stopErr
+	}
+}
+
+// Run sends the queued transfers until the ctx is canceled. The batch is sent once it is full or the batch interval
+// passes since the first transfer of the batch was received. Run must be called only once.
+func (q *TransferQueue) Run(ctx context.Context) error {
+	for {
+		var batch []transferRequest
+		select {
+		case <-ctx.Done():
+			return q.stop(errors.WithStack(ctx.Err()))
+		case req := <-q.requests:
+			batch = append(batch, req)
+		}
+
+		timer := time.NewTimer(q.config.BatchInterval)
+	collect:
+		for len(batch) < q.config.BatchSize {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				reply(batch, nil, errors.WithStack(ctx.Err()))
+				return q.stop(errors.WithStack(ctx.Err()))
+			case req := <-q.requests:
+				batch = append(batch, req)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+
+		q.send(ctx, batch)
+	}
+}
+
+func (q *TransferQueue) send(ctx context.Context, batch []transferRequest) {
+	outputs := make([]banktypes.Output, 0, len(batch))
+	total := sdk.NewCoins()
+	for _, req := range batch {
+		outputs = append(outputs, req.output)
+		total = total.Add(req.output.Coins...)
+	}
+
+	txRes, err := q.broadcast(ctx, &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(q.sender, total)},
+		Outputs: outputs,
+	})
+	reply(batch, txRes, err)
+}
+
+// stop rejects the queued transfers and the transfers queued later with the error.
+func (q *TransferQueue) stop(err error) error {
+	q.stopErr = err
+	close(q.stopped)
+	q.drain(err)
+	return err
+}
+
+func (q *TransferQueue) drain(err error) {
+	for {
+		select {
+		case req := <-q.requests:
+			reply([]transferRequest{req}, nil, err)
+		default:
+			return
+		}
+	}
+}
+
+func reply(batch []transferRequest, txRes *sdk.TxResponse, err error) {
+	for _, req := range batch {
+		req.result <- transferResult{
+			txRes: txRes,
+			err:   err,
+		}
+	}
+}
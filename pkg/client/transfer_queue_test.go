@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferQueue(t *testing.T) {
+	requireT := require.New(t)
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	var (
+		mu   sync.Mutex
+		msgs []*banktypes.MsgMultiSend
+	)
+	queue, err := newTransferQueue(sender, func(ctx context.Context, txMsgs ...sdk.Msg) (*sdk.TxResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		msg := txMsgs[0].(*banktypes.MsgMultiSend)
+		msgs = append(msgs, msg)
+		if msg.Outputs[0].Coins.AmountOf("fail").IsPositive() {
+			return nil, errors.New("broadcast failed")
+		}
+		return &sdk.TxResponse{TxHash: msg.Outputs[0].Address}, nil
+	}, TransferQueueConfig{
+		BatchSize:     3,
+		BatchInterval: time.Hour,
+		QueueSize:     10,
+	})
+	requireT.NoError(err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- queue.Run(ctx)
+	}()
+
+	// the full batch is sent without waiting for the interval
+	recipients := make([]sdk.AccAddress, 0, 3)
+	for range 3 {
+		recipients = append(recipients, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	}
+	txHashes := make(chan string, len(recipients))
+	for _, recipient := range recipients {
+		go func() {
+			txRes, err := queue.Transfer(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("ucore", 10)))
+			if err != nil {
+				txHashes <- err.Error()
+				return
+			}
+			txHashes <- txRes.TxHash
+		}()
+	}
+	firstTxHash := <-txHashes
+	requireT.Equal(firstTxHash, <-txHashes)
+	requireT.Equal(firstTxHash, <-txHashes)
+
+	mu.Lock()
+	requireT.Len(msgs, 1)
+	requireT.Len(msgs[0].Outputs, 3)
+	requireT.Equal(sender.String(), msgs[0].Inputs[0].Address)
+	requireT.Equal("30ucore", msgs[0].Inputs[0].Coins.String())
+	mu.Unlock()
+
+	// the broadcast error is returned to all the transfers of the batch
+	failed := make(chan error, 3)
+	for range 3 {
+		go func() {
+			_, err := queue.Transfer(ctx, recipients[0], sdk.NewCoins(sdk.NewInt64Coin("fail", 1)))
+			failed <- err
+		}()
+	}
+	for range 3 {
+		requireT.ErrorContains(<-failed, "broadcast failed")
+	}
+
+	// the invalid amount is rejected
+	_, err = queue.Transfer(ctx, recipients[0], sdk.NewCoins())
+	requireT.Error(err)
+
+	// the queued transfers are rejected once the queue is stopped
+	pending := make(chan error, 1)
+	go func() {
+		_, err := queue.Transfer(t.Context(), recipients[0], sdk.NewCoins(sdk.NewInt64Coin("ucore", 1)))
+		pending <- err
+	}()
+	requireT.Eventually(func() bool {
+		return len(queue.requests) == 0
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+	requireT.ErrorIs(<-pending, context.Canceled)
+	requireT.ErrorIs(<-runErr, context.Canceled)
+}
+
+func TestTransferQueue_Full(t *testing.T) {
+	requireT := require.New(t)
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	queue, err := newTransferQueue(sender, nil, TransferQueueConfig{
+		BatchSize:     1,
+		BatchInterval: time.Second,
+		QueueSize:     1,
+	})
+	requireT.NoError(err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	// the queue is not running, so the first transfer waits in the queue
+	_, err = queue.Transfer(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("ucore", 1)))
+	requireT.ErrorIs(err, context.DeadlineExceeded)
+	_, err = queue.Transfer(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("ucore", 1)))
+	requireT.ErrorIs(err, ErrTransferQueueFull)
+}
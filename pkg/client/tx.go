@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/mempool"
@@ -35,6 +36,14 @@ import (
 // It will help users by removing the need to import tx package from cosmos sdk and help avoid package name collision.
 type Factory = tx.Factory
 
+// WithTimeoutDuration returns a copy of the factory with the timeout timestamp set to the duration from now.
+// The transaction not included in a block before the timestamp is rejected by the chain, so it can't be executed later
+// than expected. The timestamp is fixed when the function is called, so it should be called right before broadcasting.
+// Factory is an alias of the cosmos sdk type, that's why it is a function and not a method.
+func WithTimeoutDuration(txf Factory, duration time.Duration) Factory {
+	return txf.WithTimeoutTimestamp(time.Now().Add(duration))
+}
+
 // Sign signs a given tx with a named key. The bytes signed over are canonical.
 // The resulting signature will be added to the transaction builder overwriting the previous
 // ones if overwrite=true (otherwise, the signature will be appended).
@@ -92,7 +101,12 @@ func BroadcastTx(ctx context.Context, clientCtx Context, txf Factory, msgs ...sd
 		return nil, err
 	}
 
-	return BroadcastRawTx(ctx, clientCtx, txBytes)
+	txRes, err := BroadcastRawTx(ctx, clientCtx, txBytes)
+	if err := recordGasOutcome(clientCtx, txf, err, msgs...); err != nil {
+		return nil, err
+	}
+
+	return txRes, err
 }
 
 // GenerateUnsignedTx generates an unsigned tx.
@@ -156,8 +170,12 @@ func CalculateGas(
 	if txf.GasAdjustment() == 0 {
 		txf = txf.WithGasAdjustment(clientCtx.GasAdjustment())
 	}
+	gasAdjustment := txf.GasAdjustment()
+	if adjuster := clientCtx.GasAdjuster(); adjuster != nil {
+		gasAdjustment *= adjuster.Multiplier(msgs...)
+	}
 
-	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
+	return simRes, uint64(gasAdjustment * float64(simRes.GasInfo.GasUsed)), nil
 }
 
 // BuildTxForSimulation build transaction for the gas simulation.
@@ -476,6 +494,24 @@ func broadcastTxSync(ctx context.Context, clientCtx Context, txBytes []byte) (*s
 	return res.TxResponse, nil
 }
 
+// recordGasOutcome feeds the result of the simulated transaction to the gas adjuster of the context.
+// The gas limit set explicitly is not learned from, since it doesn't come from the simulation.
+func recordGasOutcome(clientCtx Context, txf Factory, broadcastErr error, msgs ...sdk.Msg) error {
+	adjuster := clientCtx.GasAdjuster()
+	if adjuster == nil || !txf.SimulateAndExecute() {
+		return nil
+	}
+
+	switch {
+	case broadcastErr == nil:
+		return adjuster.RecordSuccess(msgs...)
+	case cosmoserrors.ErrOutOfGas.Is(broadcastErr):
+		return adjuster.RecordOutOfGas(msgs...)
+	default:
+		return nil
+	}
+}
+
 func processTxCommitError(ctx context.Context, err error) error {
 	if errors.Is(err, ctx.Err()) {
 		return errors.WithStack(err)
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"slices"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+const msgIndexAttribute = "msg_index"
+
+// Order of the message in the transaction composed by the Tx. The messages creating the assets are executed first,
+// then the ones minting them, so the messages using the assets can be added in any order.
+const (
+	msgOrderIssue = iota
+	msgOrderMint
+	msgOrderOther
+)
+
+// MsgResult is the result of the message executed in the transaction.
+type MsgResult struct {
+	// Index is the index of the message in the transaction.
+	Index int
+	// Msg is the executed message.
+	Msg sdk.Msg
+	// Response is the response of the message, it is nil if the result of the transaction hasn't been awaited.
+	Response sdktx.MsgResponse
+	// Events are the events emitted by the message.
+	Events []abci.Event
+}
+
+// TxResult is the result of the transaction broadcast by the Tx.
+type TxResult struct {
+	TxResponse *sdk.TxResponse
+	Msgs       []MsgResult
+}
+
+// Tx composes the messages of the single transaction.
+//
+//	res, err := client.NewTx(clientCtx, txf).
+//		Send(recipient, coins...).
+//		IssueFT(&assetfttypes.MsgIssue{Symbol: "ABC", Subunit: "uabc", Precision: 6}).
+//		Memo("issuance").
+//		SignAndBroadcast(ctx)
+type Tx struct {
+	clientCtx Context
+	txf       Factory
+	msgs      []sdk.Msg
+	memo      string
+	broadcast func(ctx context.Context, clientCtx Context, txf Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+}
+
+// NewTx returns the new Tx signed by the from address of the context.
+func NewTx(clientCtx Context, txf Factory) *Tx {
+	return &Tx{
+		clientCtx: clientCtx,
+		txf:       txf,
+		broadcast: BroadcastTx,
+	}
+}
+
+// IssueFT adds the message issuing the fungible token. The issuer is set to the from address if it is empty.
+func (t *Tx) IssueFT(msg *assetfttypes.MsgIssue) *Tx {
+	issue := *msg
+	if issue.Issuer == "" {
+		issue.Issuer = t.clientCtx.FromAddress().String()
+	}
+	return t.Msg(&issue)
+}
+
+// MintFT adds the message minting the fungible token to the recipient, the issuer receives the minted coin if the
+// recipient is nil.
+func (t *Tx) MintFT(coin sdk.Coin, recipient sdk.AccAddress) *Tx {
+	msg := &assetfttypes.MsgMint{
+		Sender: t.clientCtx.FromAddress().String(),
+		Coin:   coin,
+	}
+	if recipient != nil {
+		msg.Recipient = recipient.String()
+	}
+	return t.Msg(msg)
+}
+
+// Send adds the message sending the coins from the from address to the recipient.
+func (t *Tx) Send(recipient sdk.AccAddress, coins ...sdk.Coin) *Tx {
+	return t.Msg(&banktypes.MsgSend{
+		FromAddress: t.clientCtx.FromAddress().String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(coins...),
+	})
+}
+
+// Msg adds the messages to the transaction.
+func (t *Tx) Msg(msgs ...sdk.Msg) *Tx {
+	t.msgs = append(t.msgs, msgs...)
+	return t
+}
+
+// Memo sets the memo of the transaction.
+func (t *Tx) Memo(memo string) *Tx {
+	t.memo = memo
+	return t
+}
+
+// Msgs returns the messages in the order they are executed in the transaction. The messages issuing the assets are
+// executed first, then the ones minting them and then the rest in the order they were added.
+func (t *Tx) Msgs() []sdk.Msg {
+	msgs := slices.Clone(t.msgs)
+	slices.SortStableFunc(msgs, func(a, b sdk.Msg) int {
+		return msgOrder(a) - msgOrder(b)
+	})
+	return msgs
+}
+
+// SignAndBroadcast signs and broadcasts the transaction. The gas is estimated once for all the messages. The
+// responses of the messages are returned only if the transaction result is awaited by the context.
+func (t *Tx) SignAndBroadcast(ctx context.Context) (*TxResult, error) {
+	msgs := t.Msgs()
+	if len(msgs) == 0 {
+		return nil, errors.New("at least one message is required")
+	}
+
+	txRes, err := t.broadcast(ctx, t.clientCtx, t.txf.WithMemo(t.memo), msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	msgResults, err := parseMsgResults(t.clientCtx, txRes, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TxResult{
+		TxResponse: txRes,
+		Msgs:       msgResults,
+	}, nil
+}
+
+func parseMsgResults(clientCtx Context, txRes *sdk.TxResponse, msgs []sdk.Msg) ([]MsgResult, error) {
+	results := make([]MsgResult, 0, len(msgs))
+	for i, msg := range msgs {
+		results = append(results, MsgResult{
+			Index: i,
+			Msg:   msg,
+		})
+	}
+
+	for _, event := range txRes.Events {
+		for _, attr := range event.Attributes {
+			if attr.Key != msgIndexAttribute {
+				continue
+			}
+			i, err := strconv.Atoi(attr.Value)
+			if err != nil || i < 0 || i >= len(results) {
+				return nil, errors.Errorf("invalid message index %q of the event %s", attr.Value, event.Type)
+			}
+			results[i].Events = append(results[i].Events, event)
+			break
+		}
+	}
+
+	if txRes.Data == "" {
+		return results, nil
+	}
+	data, err := hex.DecodeString(txRes.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction data")
+	}
+	var txMsgData sdk.TxMsgData
+	if err := clientCtx.Codec().Unmarshal(data, &txMsgData); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal transaction data")
+	}
+	if len(txMsgData.MsgResponses) != len(results) {
+		return nil, errors.Errorf(
+			"number of message responses %d doesn't match the number of messages %d",
+			len(txMsgData.MsgResponses), len(results),
+		)
+	}
+	for i, msgResponse := range txMsgData.MsgResponses {
+		if err := clientCtx.InterfaceRegistry().UnpackAny(msgResponse, &results[i].Response); err != nil {
+			return nil, errors.Wrapf(err, "failed to unpack response of the message %d", i)
+		}
+	}
+
+	return results, nil
+}
+
+func msgOrder(msg sdk.Msg) int {
+	switch msg.(type) {
+	case *assetfttypes.MsgIssue, *assetnfttypes.MsgIssueClass:
+		return msgOrderIssue
+	case *assetfttypes.MsgMint, *assetnfttypes.MsgMint:
+		return msgOrderMint
+	default:
+		return msgOrderOther
+	}
+}
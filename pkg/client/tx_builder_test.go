@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	assetft "github.com/tokenize-x/tx-chain/v7/x/asset/ft"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestTx(t *testing.T) {
+	requireT := require.New(t)
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	clientCtx := NewContext(DefaultContextConfig(), bank.AppModuleBasic{}, assetft.AppModuleBasic{}).
+		WithFromAddress(sender)
+
+	denom := assetfttypes.BuildDenom("uabc", sender)
+	tx := NewTx(clientCtx, Factory{}).
+		Send(recipient, sdk.NewInt64Coin(denom, 10)).
+		MintFT(sdk.NewInt64Coin(denom, 100), nil).
+		IssueFT(&assetfttypes.MsgIssue{
+			Symbol:        "ABC",
+			Subunit:       "uabc",
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(1000),
+		}).
+		Memo("memo")
+
+	// the messages are executed in the order the assets are issued and minted before they are used
+	msgs := tx.Msgs()
+	requireT.Len(msgs, 3)
+	requireT.IsType(&assetfttypes.MsgIssue{}, msgs[0])
+	requireT.Equal(sender.String(), msgs[0].(*assetfttypes.MsgIssue).Issuer)
+	requireT.IsType(&assetfttypes.MsgMint{}, msgs[1])
+	requireT.IsType(&banktypes.MsgSend{}, msgs[2])
+
+	var responses []*codectypes.Any
+	for _, msgResponse := range []sdk.Msg{
+		&assetfttypes.EmptyResponse{}, &assetfttypes.EmptyResponse{}, &banktypes.MsgSendResponse{},
+	} {
+		msgResponseAny, err := codectypes.NewAnyWithValue(msgResponse)
+		requireT.NoError(err)
+		responses = append(responses, msgResponseAny)
+	}
+	data, err := clientCtx.Codec().Marshal(&sdk.TxMsgData{MsgResponses: responses})
+	requireT.NoError(err)
+
+	tx.broadcast = func(_ context.Context, _ Context, txf Factory, txMsgs ...sdk.Msg) (*sdk.TxResponse, error) {
+		requireT.Equal("memo", txf.Memo())
+		requireT.Equal(msgs, txMsgs)
+		return &sdk.TxResponse{
+			Data: hex.EncodeToString(data),
+			Events: []abci.Event{
+				{Type: "tx", Attributes: []abci.EventAttribute{{Key: "fee"}}},
+				{Type: "issue", Attributes: []abci.EventAttribute{{Key: msgIndexAttribute, Value: "0"}}},
+				{Type: "transfer", Attributes: []abci.EventAttribute{{Key: msgIndexAttribute, Value: "2"}}},
+			},
+		}, nil
+	}
+
+	res, err := tx.SignAndBroadcast(t.Context())
+	requireT.NoError(err)
+	requireT.Len(res.Msgs, 3)
+	for i, msgRes := range res.Msgs {
+		requireT.Equal(i, msgRes.Index)
+		requireT.Equal(msgs[i], msgRes.Msg)
+	}
+	requireT.IsType(&assetfttypes.EmptyResponse{}, res.Msgs[0].Response)
+	requireT.IsType(&banktypes.MsgSendResponse{}, res.Msgs[2].Response)
+	requireT.Len(res.Msgs[0].Events, 1)
+	requireT.Equal("issue", res.Msgs[0].Events[0].Type)
+	requireT.Empty(res.Msgs[1].Events)
+	requireT.Len(res.Msgs[2].Events, 1)
+	requireT.Equal("transfer", res.Msgs[2].Events[0].Type)
+
+	// the transaction without messages is rejected
+	_, err = NewTx(clientCtx, Factory{}).Memo("memo").SignAndBroadcast(t.Context())
+	requireT.Error(err)
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"os"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// AccountManifest lists the named accounts provisioned and funded in the genesis of the dev network.
+type AccountManifest struct {
+	Accounts []ManifestAccount `json:"accounts"`
+}
+
+// ManifestAccount is the account of the AccountManifest.
+type ManifestAccount struct {
+	Role     string      `json:"role"`
+	Mnemonic string      `json:"mnemonic"`
+	Balance  sdkmath.Int `json:"balance"`
+}
+
+// LoadAccountManifest reads the account manifest from the YAML file.
+func LoadAccountManifest(path string) (AccountManifest, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return AccountManifest{}, errors.Wrapf(err, "failed to read account manifest file %s", path)
+	}
+	return ParseAccountManifest(content)
+}
+
+// ParseAccountManifest parses the YAML account manifest.
+func ParseAccountManifest(content []byte) (AccountManifest, error) {
+	var manifest AccountManifest
+	if err := yaml.UnmarshalStrict(content, &manifest); err != nil {
+		return AccountManifest{}, errors.Wrap(err, "failed to parse account manifest")
+	}
+
+	roles := make(map[string]struct{}, len(manifest.Accounts))
+	for _, acc := range manifest.Accounts {
+		if acc.Role == "" {
+			return AccountManifest{}, errors.New("account role must not be empty")
+		}
+		if _, exists := roles[acc.Role]; exists {
+			return AccountManifest{}, errors.Errorf("duplicated account role %s", acc.Role)
+		}
+		roles[acc.Role] = struct{}{}
+		if acc.Balance.IsNil() || acc.Balance.IsNegative() {
+			return AccountManifest{}, errors.Errorf("invalid balance of the account %s", acc.Role)
+		}
+	}
+
+	return manifest, nil
+}
+
+// Account returns the account of the role.
+func (m AccountManifest) Account(role string) (ManifestAccount, error) {
+	for _, acc := range m.Accounts {
+		if acc.Role == role {
+			return acc, nil
+		}
+	}
+	return ManifestAccount{}, errors.Errorf("account with role %s not found in the manifest", role)
+}
+
+// BankBalances returns the genesis balances funding the accounts of the manifest.
+// The addresses are encoded using the prefix of the global SDK config.
+func (m AccountManifest) BankBalances(denom string, coinType uint32) ([]banktypes.Balance, error) {
+	balances := make([]banktypes.Balance, 0, len(m.Accounts))
+	for _, acc := range m.Accounts {
+		address, err := acc.Address(coinType)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, banktypes.Balance{
+			Address: address.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(denom, acc.Balance)),
+		})
+	}
+	return balances, nil
+}
+
+// Address derives the address of the account from its mnemonic.
+func (acc ManifestAccount) Address(coinType uint32) (sdk.AccAddress, error) {
+	derivedPriv, err := hd.Secp256k1.Derive()(acc.Mnemonic, "", hd.CreateHDPath(coinType, 0, 0).String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to derive the key of the account %s", acc.Role)
+	}
+	return sdk.AccAddress(hd.Secp256k1.Generate()(derivedPriv).PubKey().Address()), nil
+}
@@ -0,0 +1,64 @@
+package config_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+)
+
+//nolint:lll // mnemonics can't be broken down.
+const testAccountManifest = `
+accounts:
+  - role: foundation-1
+    mnemonic: "inside federal army alone category ivory tell noble waste alley traffic infant derive major speed element celery rail material chase idle ordinary rebuild wink"
+    balance: "1000"
+  - role: alliance-1
+    mnemonic: "pilot unique other differ mail toilet leave tuition pig neither chaos flower tone bread addict give lawsuit scatter throw run strike charge picture wild"
+    balance: "2000"
+`
+
+func TestAccountManifest(t *testing.T) {
+	requireT := require.New(t)
+
+	manifest, err := config.ParseAccountManifest([]byte(testAccountManifest))
+	requireT.NoError(err)
+	requireT.Len(manifest.Accounts, 2)
+
+	acc, err := manifest.Account("alliance-1")
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(2000), acc.Balance)
+	_, err = manifest.Account("team-1")
+	requireT.Error(err)
+
+	// addresses are derived deterministically
+	addr1, err := acc.Address(constant.CoinType)
+	requireT.NoError(err)
+	addr2, err := acc.Address(constant.CoinType)
+	requireT.NoError(err)
+	requireT.Equal(addr1, addr2)
+
+	balances, err := manifest.BankBalances(constant.DenomDev, constant.CoinType)
+	requireT.NoError(err)
+	requireT.Len(balances, 2)
+	requireT.Equal(addr1.String(), balances[1].Address)
+	requireT.Equal("2000"+constant.DenomDev, balances[1].Coins.String())
+
+	// duplicated roles are rejected
+	_, err = config.ParseAccountManifest([]byte(testAccountManifest + `
+  - role: foundation-1
+    mnemonic: "x"
+    balance: "1"
+`))
+	requireT.Error(err)
+
+	// unknown fields are rejected
+	_, err = config.ParseAccountManifest([]byte(`
+accounts:
+  - name: foundation-1
+`))
+	requireT.Error(err)
+}
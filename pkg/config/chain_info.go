@@ -0,0 +1,243 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+	feemodeltypes "github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+)
+
+// ChainInfoPath is the path the API server of the node serves the chain info at.
+const ChainInfoPath = "/chain-info"
+
+// ChainInfo is the chain metadata required by the wallets, so they don't have to hardcode it.
+type ChainInfo struct {
+	ChainID        string         `json:"chain_id"`
+	Bech32Prefixes Bech32Prefixes `json:"bech32_prefixes"`
+	CoinType       uint32         `json:"coin_type"`
+	// FeeDenom is the denom the fees are paid in.
+	FeeDenom string `json:"fee_denom"`
+	// StakingDenom is the denom the validators are bonded in.
+	StakingDenom string        `json:"staking_denom"`
+	Denoms       []DenomInfo   `json:"denoms"`
+	FeeModel     FeeModelInfo  `json:"fee_model"`
+	Features     ChainFeatures `json:"features"`
+}
+
+// Bech32Prefixes are the bech32 prefixes of the addresses and the public keys.
+type Bech32Prefixes struct {
+	Account      string `json:"account"`
+	AccountPub   string `json:"account_pub"`
+	Validator    string `json:"validator"`
+	ValidatorPub string `json:"validator_pub"`
+	Consensus    string `json:"consensus"`
+	ConsensusPub string `json:"consensus_pub"`
+}
+
+// DenomInfo describes the denom of the chain.
+type DenomInfo struct {
+	// Denom is the base denom, e.g. "ucore".
+	Denom string `json:"denom"`
+	// Display is the denom presented to the users, e.g. "core".
+	Display string `json:"display"`
+	// Precision is the number of the decimal places of the display denom.
+	Precision uint32 `json:"precision"`
+}
+
+// FeeModelInfo describes the fee model of the chain.
+type FeeModelInfo struct {
+	// MinGasPrice is the minimum gas price at the time the info was generated.
+	MinGasPrice sdk.DecCoin               `json:"min_gas_price"`
+	Params      feemodeltypes.ModelParams `json:"params"`
+}
+
+// ChainFeatures lists the features supported by the chain.
+type ChainFeatures struct {
+	// AssetFT are the features the fungible tokens may be issued with.
+	AssetFT []string `json:"asset_ft"`
+	// AssetNFT are the features the non-fungible token classes may be issued with.
+	AssetNFT []string `json:"asset_nft"`
+}
+
+// Validate checks that the chain info is complete and consistent.
+func (ci ChainInfo) Validate() error {
+	if ci.ChainID == "" {
+		return errors.New("chain ID must not be empty")
+	}
+	if ci.Bech32Prefixes.Account == "" || ci.Bech32Prefixes.Validator == "" || ci.Bech32Prefixes.Consensus == "" {
+		return errors.New("bech32 prefixes must not be empty")
+	}
+	if len(ci.Denoms) == 0 {
+		return errors.New("denoms must not be empty")
+	}
+	denoms := make(map[string]struct{}, len(ci.Denoms))
+	for _, denom := range ci.Denoms {
+		if err := sdk.ValidateDenom(denom.Denom); err != nil {
+			return errors.Wrapf(err, "invalid denom %q", denom.Denom)
+		}
+		if denom.Display == "" {
+			return errors.Errorf("display denom of %s must not be empty", denom.Denom)
+		}
+		if _, found := denoms[denom.Denom]; found {
+			return errors.Errorf("duplicated denom %s", denom.Denom)
+		}
+		denoms[denom.Denom] = struct{}{}
+	}
+	if _, found := denoms[ci.FeeDenom]; !found {
+		return errors.Errorf("fee denom %q is not described", ci.FeeDenom)
+	}
+	if _, found := denoms[ci.StakingDenom]; !found {
+		return errors.Errorf("staking denom %q is not described", ci.StakingDenom)
+	}
+	if ci.FeeModel.MinGasPrice.Denom != ci.FeeDenom {
+		return errors.Errorf(
+			"min gas price denom %q doesn't match the fee denom %q", ci.FeeModel.MinGasPrice.Denom, ci.FeeDenom,
+		)
+	}
+	if ci.FeeModel.MinGasPrice.Amount.IsNil() || !ci.FeeModel.MinGasPrice.Amount.IsPositive() {
+		return errors.New("min gas price must be positive")
+	}
+
+	return errors.Wrap(ci.FeeModel.Params.ValidateBasic(), "invalid fee model params")
+}
+
+// QueryChainInfo returns the chain info of the node. The bech32 prefixes and the coin type are taken from the SDK
+// config, the rest is queried from the node.
+func QueryChainInfo(ctx context.Context, clientCtx cosmosclient.Context) (ChainInfo, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return ChainInfo{}, err
+	}
+	nodeStatus, err := node.Status(ctx)
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query node status")
+	}
+
+	feemodelClient := feemodeltypes.NewQueryClient(clientCtx)
+	feemodelParamsRes, err := feemodelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query fee model params")
+	}
+	minGasPriceRes, err := feemodelClient.MinGasPrice(ctx, &feemodeltypes.QueryMinGasPriceRequest{})
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query min gas price")
+	}
+	stakingParamsRes, err := stakingtypes.NewQueryClient(clientCtx).Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return ChainInfo{}, errors.Wrap(err, "failed to query staking params")
+	}
+
+	feeDenom := minGasPriceRes.MinGasPrice.Denom
+	stakingDenom := stakingParamsRes.Params.BondDenom
+	denoms := []string{feeDenom}
+	if stakingDenom != feeDenom {
+		denoms = append(denoms, stakingDenom)
+	}
+	denomInfos := make([]DenomInfo, 0, len(denoms))
+	for _, denom := range denoms {
+		denomInfo, err := queryDenomInfo(ctx, clientCtx, denom)
+		if err != nil {
+			return ChainInfo{}, err
+		}
+		denomInfos = append(denomInfos, denomInfo)
+	}
+
+	sdkConfig := sdk.GetConfig()
+	return ChainInfo{
+		ChainID: nodeStatus.NodeInfo.Network,
+		Bech32Prefixes: Bech32Prefixes{
+			Account:      sdkConfig.GetBech32AccountAddrPrefix(),
+			AccountPub:   sdkConfig.GetBech32AccountPubPrefix(),
+			Validator:    sdkConfig.GetBech32ValidatorAddrPrefix(),
+			ValidatorPub: sdkConfig.GetBech32ValidatorPubPrefix(),
+			Consensus:    sdkConfig.GetBech32ConsensusAddrPrefix(),
+			ConsensusPub: sdkConfig.GetBech32ConsensusPubPrefix(),
+		},
+		CoinType:     constant.CoinType,
+		FeeDenom:     feeDenom,
+		StakingDenom: stakingDenom,
+		Denoms:       denomInfos,
+		FeeModel: FeeModelInfo{
+			MinGasPrice: minGasPriceRes.MinGasPrice,
+			Params:      feemodelParamsRes.Params.Model,
+		},
+		Features: ChainFeatures{
+			AssetFT:  enumNames(assetfttypes.Feature_name),
+			AssetNFT: enumNames(assetnfttypes.ClassFeature_name),
+		},
+	}, nil
+}
+
+// ChainInfoHandler returns the http handler serving the chain info of the node as JSON.
+func ChainInfoHandler(clientCtx cosmosclient.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		chainInfo, err := QueryChainInfo(req.Context(), clientCtx)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		output, err := json.Marshal(chainInfo)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(output)
+	}
+}
+
+// queryDenomInfo returns the info of the denom based on its bank metadata. The denom without the metadata or the
+// display unit is described with zero precision.
+func queryDenomInfo(ctx context.Context, clientCtx cosmosclient.Context, denom string) (DenomInfo, error) {
+	res, err := banktypes.NewQueryClient(clientCtx).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return DenomInfo{Denom: denom, Display: denom}, nil
+		}
+		return DenomInfo{}, errors.Wrapf(err, "failed to query metadata of %s", denom)
+	}
+
+	denomInfo := DenomInfo{Denom: denom, Display: denom}
+	for _, unit := range res.Metadata.DenomUnits {
+		if unit.Denom != "" && unit.Denom == res.Metadata.Display {
+			denomInfo.Display = unit.Denom
+			denomInfo.Precision = unit.Exponent
+		}
+	}
+
+	return denomInfo, nil
+}
+
+func enumNames(names map[int32]string) []string {
+	values := make([]int32, 0, len(names))
+	for value := range names {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, names[value])
+	}
+
+	return result
+}
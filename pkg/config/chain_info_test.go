@@ -0,0 +1,129 @@
+package config_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/network"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	feemodeltypes "github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+)
+
+func validChainInfo() config.ChainInfo {
+	return config.ChainInfo{
+		ChainID: string(constant.ChainIDDev),
+		Bech32Prefixes: config.Bech32Prefixes{
+			Account:      constant.AddressPrefixDev,
+			AccountPub:   constant.AddressPrefixDev + "pub",
+			Validator:    constant.AddressPrefixDev + "valoper",
+			ValidatorPub: constant.AddressPrefixDev + "valoperpub",
+			Consensus:    constant.AddressPrefixDev + "valcons",
+			ConsensusPub: constant.AddressPrefixDev + "valconspub",
+		},
+		CoinType:     constant.CoinType,
+		FeeDenom:     constant.DenomDev,
+		StakingDenom: constant.DenomDev,
+		Denoms: []config.DenomInfo{
+			{Denom: constant.DenomDev, Display: constant.DenomDevDisplay, Precision: 6},
+		},
+		FeeModel: config.FeeModelInfo{
+			MinGasPrice: sdk.NewDecCoinFromDec(constant.DenomDev, sdkmath.LegacyMustNewDecFromStr("0.0625")),
+			Params:      feemodeltypes.DefaultParams().Model,
+		},
+	}
+}
+
+func TestChainInfoValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		modify      func(*config.ChainInfo)
+		expectedErr string
+	}{
+		{
+			name:   "valid",
+			modify: func(*config.ChainInfo) {},
+		},
+		{
+			name:        "empty_chain_id",
+			modify:      func(ci *config.ChainInfo) { ci.ChainID = "" },
+			expectedErr: "chain ID must not be empty",
+		},
+		{
+			name:        "empty_prefix",
+			modify:      func(ci *config.ChainInfo) { ci.Bech32Prefixes.Validator = "" },
+			expectedErr: "bech32 prefixes must not be empty",
+		},
+		{
+			name: "duplicated_denom",
+			modify: func(ci *config.ChainInfo) {
+				ci.Denoms = append(ci.Denoms, ci.Denoms[0])
+			},
+			expectedErr: "duplicated denom",
+		},
+		{
+			name:        "undescribed_fee_denom",
+			modify:      func(ci *config.ChainInfo) { ci.FeeDenom = "ucore" },
+			expectedErr: "fee denom \"ucore\" is not described",
+		},
+		{
+			name:        "undescribed_staking_denom",
+			modify:      func(ci *config.ChainInfo) { ci.StakingDenom = "ucore" },
+			expectedErr: "staking denom \"ucore\" is not described",
+		},
+		{
+			name:        "zero_min_gas_price",
+			modify:      func(ci *config.ChainInfo) { ci.FeeModel.MinGasPrice.Amount = sdkmath.LegacyZeroDec() },
+			expectedErr: "min gas price must be positive",
+		},
+		{
+			name:        "invalid_fee_model_params",
+			modify:      func(ci *config.ChainInfo) { ci.FeeModel.Params.MaxBlockGas = 0 },
+			expectedErr: "invalid fee model params",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chainInfo := validChainInfo()
+			tc.modify(&chainInfo)
+			err := chainInfo.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestQueryChainInfo(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+	clientCtx := testNetwork.Validators[0].ClientCtx
+
+	chainInfo, err := config.QueryChainInfo(context.Background(), clientCtx)
+	requireT.NoError(err)
+	requireT.NoError(chainInfo.Validate())
+	requireT.Equal(testNetwork.Config.ChainID, chainInfo.ChainID)
+	requireT.Equal(sdk.GetConfig().GetBech32AccountAddrPrefix(), chainInfo.Bech32Prefixes.Account)
+	requireT.Contains(chainInfo.Features.AssetFT, assetfttypes.Feature_whitelisting.String())
+
+	// the handler serves the same info
+	recorder := httptest.NewRecorder()
+	config.ChainInfoHandler(clientCtx)(recorder, httptest.NewRequest(http.MethodGet, config.ChainInfoPath, nil))
+	requireT.Equal(http.StatusOK, recorder.Code)
+	var served config.ChainInfo
+	requireT.NoError(json.Unmarshal(recorder.Body.Bytes(), &served))
+	requireT.Equal(chainInfo.ChainID, served.ChainID)
+	requireT.Equal(chainInfo.Denoms, served.Denoms)
+}
@@ -31,6 +31,7 @@ import (
 	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
 	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 	dextypes "github.com/tokenize-x/tx-chain/v7/x/dex/types"
+	feemodeltypes "github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
 )
 
 // GenesisInitConfig is used to pass genesis creating parameters to txd.
@@ -48,6 +49,7 @@ type GenesisInitConfig struct {
 	ModuleBalances     []ModuleBalance               `json:"module_balances"`
 	Validators         []GenesisInitValidator        `json:"validators"`
 	DEXConfig          GenesisDEXConfig              `json:"dex_config"`
+	FeeModelConfig     GenesisFeeModelConfig         `json:"fee_model_config"`
 	GenTxs             []json.RawMessage             `json:"gen_txs"`
 }
 
@@ -84,6 +86,14 @@ type GenesisDEXConfig struct {
 	MaxOrdersPerDenom uint64 `json:"max_orders_per_denom"`
 }
 
+// GenesisFeeModelConfig is the fee model config of the GenesisInitConfig.
+//
+//nolint:tagliatelle
+type GenesisFeeModelConfig struct {
+	InitialGasPrice       sdkmath.LegacyDec `json:"initial_gas_price"`
+	MaxGasPriceMultiplier sdkmath.LegacyDec `json:"max_gas_price_multiplier"`
+}
+
 // ModuleBalance defines a module account with its initial balance for genesis.
 //
 //nolint:tagliatelle
@@ -184,6 +194,20 @@ func GenDocFromInput(
 	}
 	appGenState[dextypes.ModuleName] = cdc.MustMarshalJSON(dexGenesis)
 
+	// fee model params
+	feemodelGenesis := feemodeltypes.DefaultGenesisState()
+	if !cfg.FeeModelConfig.InitialGasPrice.IsNil() {
+		feemodelGenesis.Params.Model.InitialGasPrice = cfg.FeeModelConfig.InitialGasPrice
+		feemodelGenesis.MinGasPrice.Amount = cfg.FeeModelConfig.InitialGasPrice
+	}
+	if !cfg.FeeModelConfig.MaxGasPriceMultiplier.IsNil() {
+		feemodelGenesis.Params.Model.MaxGasPriceMultiplier = cfg.FeeModelConfig.MaxGasPriceMultiplier
+	}
+	if err := feemodelGenesis.Validate(); err != nil {
+		return types.GenesisDoc{}, errors.Wrap(err, "invalid fee model config")
+	}
+	appGenState[feemodeltypes.ModuleName] = cdc.MustMarshalJSON(feemodelGenesis)
+
 	// genutil state
 	genutilState := genutiltypes.DefaultGenesisState()
 	for _, validatorInfo := range cfg.Validators {
@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -15,7 +16,10 @@ import (
 	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
 )
 
-var networkConfigs map[constant.ChainID]NetworkConfig
+var (
+	networkConfigsMu sync.RWMutex
+	networkConfigs   map[constant.ChainID]NetworkConfig
+)
 
 func init() {
 	// configs
@@ -157,6 +161,9 @@ func (c NetworkConfig) EncodeGenesis(
 
 // NetworkConfigByChainID returns predefined NetworkConfig for a ChainID.
 func NetworkConfigByChainID(id constant.ChainID) (NetworkConfig, error) {
+	networkConfigsMu.RLock()
+	defer networkConfigsMu.RUnlock()
+
 	nc, found := networkConfigs[id]
 	if !found {
 		return NetworkConfig{}, errors.Errorf("chainID %s not found", id)
@@ -167,6 +174,29 @@ func NetworkConfigByChainID(id constant.ChainID) (NetworkConfig, error) {
 	return nc, nil
 }
 
+// RegisterNetworkConfig registers the config of the custom network, so it is returned by NetworkConfigByChainID.
+// The chain IDs of the already registered networks, including the predefined ones, can't be registered again.
+func RegisterNetworkConfig(nc NetworkConfig) error {
+	if nc.Provider == nil {
+		return errors.New("network config provider must be set")
+	}
+	id := nc.ChainID()
+	if id == "" {
+		return errors.New("chain ID must not be empty")
+	}
+
+	networkConfigsMu.Lock()
+	defer networkConfigsMu.Unlock()
+
+	if _, found := networkConfigs[id]; found {
+		return errors.Errorf("chainID %s is already registered", id)
+	}
+	nc.NodeConfig = nc.NodeConfig.clone()
+	networkConfigs[id] = nc
+
+	return nil
+}
+
 // ValPrefixFromAddressPrefix returns validator operator prefix.
 func ValPrefixFromAddressPrefix(addressPrefix string) string {
 	return addressPrefix + "valoper"
@@ -0,0 +1,143 @@
+package config
+
+import (
+	"os"
+	"regexp"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+)
+
+var addressPrefixRegex = regexp.MustCompile(`^[a-z][a-z0-9]{0,31}$`)
+
+// NetworkConfigFile is the config of the custom network, e.g. the private deployment or the devnet of the fork,
+// loaded from the JSON or YAML file.
+//
+//nolint:tagliatelle
+type NetworkConfigFile struct {
+	ChainID           constant.ChainID      `json:"chain_id"`
+	Denom             string                `json:"denom"`
+	DisplayDenom      string                `json:"display_denom"`
+	AddressPrefix     string                `json:"address_prefix"`
+	GenesisTime       time.Time             `json:"genesis_time"`
+	SeedPeers         []string              `json:"seed_peers"`
+	Gov               NetworkConfigFileGov  `json:"gov"`
+	MinSelfDelegation sdkmath.Int           `json:"min_self_delegation"`
+	FeeModel          GenesisFeeModelConfig `json:"fee_model"`
+	DEX               GenesisDEXConfig      `json:"dex"`
+	BankBalances      []banktypes.Balance   `json:"bank_balances"`
+	ModuleBalances    []ModuleBalance       `json:"module_balances"`
+}
+
+// NetworkConfigFileGov is the gov config of the NetworkConfigFile. The periods are the Go duration strings,
+// e.g. "4h".
+//
+//nolint:tagliatelle
+type NetworkConfigFileGov struct {
+	MinDeposit            sdk.Coins `json:"min_deposit"`
+	ExpeditedMinDeposit   sdk.Coins `json:"expedited_min_deposit"`
+	VotingPeriod          string    `json:"voting_period"`
+	ExpeditedVotingPeriod string    `json:"expedited_voting_period"`
+}
+
+// LoadNetworkConfigFile reads the network config from the JSON or YAML file.
+func LoadNetworkConfigFile(path string) (NetworkConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return NetworkConfig{}, errors.Wrapf(err, "failed to read network config file %s", path)
+	}
+	return ParseNetworkConfigFile(content)
+}
+
+// ParseNetworkConfigFile parses the JSON or YAML network config.
+func ParseNetworkConfigFile(content []byte) (NetworkConfig, error) {
+	var file NetworkConfigFile
+	if err := yaml.UnmarshalStrict(content, &file); err != nil {
+		return NetworkConfig{}, errors.Wrap(err, "failed to parse network config")
+	}
+	return file.NetworkConfig()
+}
+
+// NetworkConfig validates the file and converts it to the NetworkConfig with the genesis generated from it.
+func (f NetworkConfigFile) NetworkConfig() (NetworkConfig, error) {
+	if f.ChainID == "" {
+		return NetworkConfig{}, errors.New("chain ID must not be empty")
+	}
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return NetworkConfig{}, errors.Wrapf(err, "invalid denom %q", f.Denom)
+	}
+	if err := sdk.ValidateDenom(f.DisplayDenom); err != nil {
+		return NetworkConfig{}, errors.Wrapf(err, "invalid display denom %q", f.DisplayDenom)
+	}
+	if !addressPrefixRegex.MatchString(f.AddressPrefix) {
+		return NetworkConfig{}, errors.Errorf("invalid address prefix %q", f.AddressPrefix)
+	}
+	if f.GenesisTime.IsZero() {
+		return NetworkConfig{}, errors.New("genesis time must be set")
+	}
+	for _, balance := range f.BankBalances {
+		if _, err := sdk.GetFromBech32(balance.Address, f.AddressPrefix); err != nil {
+			return NetworkConfig{}, errors.Wrapf(err, "invalid address %q of the bank balance", balance.Address)
+		}
+		if err := balance.Coins.Validate(); err != nil {
+			return NetworkConfig{}, errors.Wrapf(err, "invalid coins of the bank balance of %s", balance.Address)
+		}
+	}
+
+	votingPeriod, err := parseOptionalDuration(f.Gov.VotingPeriod)
+	if err != nil {
+		return NetworkConfig{}, errors.Wrap(err, "invalid voting period")
+	}
+	expeditedVotingPeriod, err := parseOptionalDuration(f.Gov.ExpeditedVotingPeriod)
+	if err != nil {
+		return NetworkConfig{}, errors.Wrap(err, "invalid expedited voting period")
+	}
+
+	return NetworkConfig{
+		Provider: DynamicConfigProvider{
+			GenesisInitConfig: GenesisInitConfig{
+				ChainID:       f.ChainID,
+				Denom:         f.Denom,
+				DisplayDenom:  f.DisplayDenom,
+				AddressPrefix: f.AddressPrefix,
+				GenesisTime:   f.GenesisTime,
+				GovConfig: GenesisInitGovConfig{
+					MinDeposit:            f.Gov.MinDeposit,
+					ExpeditedMinDeposit:   f.Gov.ExpeditedMinDeposit,
+					VotingPeriod:          votingPeriod,
+					ExpeditedVotingPeriod: expeditedVotingPeriod,
+				},
+				CustomParamsConfig: GenesisInitCustomParamsConfig{
+					MinSelfDelegation: f.MinSelfDelegation,
+				},
+				BankBalances:   f.BankBalances,
+				ModuleBalances: f.ModuleBalances,
+				DEXConfig:      f.DEX,
+				FeeModelConfig: f.FeeModel,
+			},
+		},
+		NodeConfig: NodeConfig{
+			SeedPeers: f.SeedPeers,
+		},
+	}, nil
+}
+
+func parseOptionalDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if d < 0 {
+		return 0, errors.Errorf("duration %s must not be negative", value)
+	}
+	return d, nil
+}
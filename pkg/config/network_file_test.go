@@ -0,0 +1,85 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+)
+
+const testNetworkConfigFile = `
+chain_id: acme-devnet-1
+denom: uacme
+display_denom: acme
+address_prefix: acme
+genesis_time: "2025-01-02T03:04:05Z"
+seed_peers:
+  - "0df493af80fbaad41b9b26d6f4520b39ceb1d210@seed.acme.dev:26656"
+gov:
+  min_deposit:
+    - denom: uacme
+      amount: "1000"
+  voting_period: 4h
+min_self_delegation: "20000"
+fee_model:
+  initial_gas_price: "0.1"
+dex:
+  max_orders_per_denom: 50
+bank_balances:
+  - address: %s
+    coins:
+      - denom: uacme
+        amount: "1000000"
+`
+
+func TestNetworkConfigFile(t *testing.T) {
+	requireT := require.New(t)
+
+	address, err := sdk.Bech32ifyAddressBytes("acme", make([]byte, 20))
+	requireT.NoError(err)
+
+	network, err := config.ParseNetworkConfigFile([]byte(fmt.Sprintf(testNetworkConfigFile, address)))
+	requireT.NoError(err)
+	requireT.Equal(constant.ChainID("acme-devnet-1"), network.ChainID())
+	requireT.Equal("uacme", network.Denom())
+	requireT.Equal("acme", network.Provider.GetAddressPrefix())
+	requireT.Equal([]string{"0df493af80fbaad41b9b26d6f4520b39ceb1d210@seed.acme.dev:26656"}, network.NodeConfig.SeedPeers)
+
+	provider, ok := network.Provider.(config.DynamicConfigProvider)
+	requireT.True(ok)
+	requireT.Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), provider.GenesisTime)
+	requireT.Equal(4*time.Hour, provider.GovConfig.VotingPeriod)
+	requireT.Equal(sdkmath.NewInt(20_000), provider.CustomParamsConfig.MinSelfDelegation)
+	requireT.Equal(sdkmath.LegacyMustNewDecFromStr("0.1"), provider.FeeModelConfig.InitialGasPrice)
+	requireT.True(provider.FeeModelConfig.MaxGasPriceMultiplier.IsNil())
+	requireT.Equal(uint64(50), provider.DEXConfig.MaxOrdersPerDenom)
+	requireT.Len(provider.BankBalances, 1)
+
+	// the custom network is registered once and returned by the chain ID
+	requireT.NoError(config.RegisterNetworkConfig(network))
+	requireT.Error(config.RegisterNetworkConfig(network))
+	registered, err := config.NetworkConfigByChainID("acme-devnet-1")
+	requireT.NoError(err)
+	requireT.Equal(network.ChainID(), registered.ChainID())
+
+	// the predefined networks can't be overridden
+	mainnet, err := config.NetworkConfigByChainID(constant.ChainIDMain)
+	requireT.NoError(err)
+	requireT.Error(config.RegisterNetworkConfig(mainnet))
+
+	// the addresses must use the prefix of the network
+	devAddress, err := sdk.Bech32ifyAddressBytes(constant.AddressPrefixDev, make([]byte, 20))
+	requireT.NoError(err)
+	_, err = config.ParseNetworkConfigFile([]byte(fmt.Sprintf(testNetworkConfigFile, devAddress)))
+	requireT.ErrorContains(err, "invalid address")
+
+	// unknown fields are rejected
+	_, err = config.ParseNetworkConfigFile([]byte("chain_id: acme-devnet-2\nunknown: 1\n"))
+	requireT.Error(err)
+}
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
+	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// MsgInterfaceName is the name of the interface implemented by the messages.
+const MsgInterfaceName = "cosmos.base.v1beta1.Msg"
+
+// TypeRegistry describes the types the codec is able to resolve. The client decoding the transactions, the events
+// or the query responses of the node must know all the types of the node, otherwise it fails with the "unable to
+// resolve type URL" error once the node starts using the type, e.g. after the upgrade.
+type TypeRegistry struct {
+	// Interfaces maps the names of the interfaces to the sorted type URLs of their implementations, packed into Any.
+	// The messages are the implementations of MsgInterfaceName.
+	Interfaces map[string][]string `json:"interfaces"`
+	// QueryMethods are the sorted full names of the gRPC query methods, e.g. "/cosmos.bank.v1beta1.Query/Balance".
+	QueryMethods []string `json:"query_methods"`
+}
+
+// TypeRegistryDiff is the difference between the registry of the client and the registry of the node.
+type TypeRegistryDiff struct {
+	// MissingTypes are the type URLs known by the node, but not by the client.
+	MissingTypes []string `json:"missing_types,omitempty"`
+	// ExtraTypes are the type URLs known by the client, but not by the node.
+	ExtraTypes []string `json:"extra_types,omitempty"`
+	// MissingQueryMethods are the query methods served by the node, but unknown to the client.
+	MissingQueryMethods []string `json:"missing_query_methods,omitempty"`
+	// ExtraQueryMethods are the query methods known by the client, but not served by the node.
+	ExtraQueryMethods []string `json:"extra_query_methods,omitempty"`
+}
+
+// NewTypeRegistry returns the registry of the types known by the interface registry, e.g. the one of the encoding
+// config of the client.
+func NewTypeRegistry(interfaceRegistry codectypes.InterfaceRegistry) TypeRegistry {
+	registry := TypeRegistry{
+		Interfaces: make(map[string][]string),
+	}
+	for _, iface := range interfaceRegistry.ListAllInterfaces() {
+		registry.Interfaces[iface] = sortedCopy(interfaceRegistry.ListImplementations(iface))
+	}
+
+	interfaceRegistry.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for i := range fd.Services().Len() {
+			sd := fd.Services().Get(i)
+			if isMsgService(sd.Options()) {
+				continue
+			}
+			for j := range sd.Methods().Len() {
+				registry.QueryMethods = append(registry.QueryMethods, queryMethodName(
+					string(sd.FullName()), string(sd.Methods().Get(j).Name()),
+				))
+			}
+		}
+		return true
+	})
+	sort.Strings(registry.QueryMethods)
+
+	return registry
+}
+
+// QueryTypeRegistry returns the registry of the types known by the node. The types are fetched using the reflection
+// services of the node.
+func QueryTypeRegistry(ctx context.Context, conn grpc.ClientConnInterface) (TypeRegistry, error) {
+	reflectionClient := reflection.NewReflectionServiceClient(conn)
+	ifacesRes, err := reflectionClient.ListAllInterfaces(ctx, &reflection.ListAllInterfacesRequest{})
+	if err != nil {
+		return TypeRegistry{}, errors.Wrap(err, "failed to query interfaces")
+	}
+
+	registry := TypeRegistry{
+		Interfaces: make(map[string][]string, len(ifacesRes.InterfaceNames)),
+	}
+	for _, iface := range ifacesRes.InterfaceNames {
+		implsRes, err := reflectionClient.ListImplementations(ctx, &reflection.ListImplementationsRequest{
+			InterfaceName: iface,
+		})
+		if err != nil {
+			return TypeRegistry{}, errors.Wrapf(err, "failed to query implementations of %s", iface)
+		}
+		registry.Interfaces[iface] = sortedCopy(implsRes.ImplementationMessageNames)
+	}
+
+	filesRes, err := reflectionv1.NewReflectionServiceClient(conn).FileDescriptors(
+		ctx, &reflectionv1.FileDescriptorsRequest{},
+	)
+	if err != nil {
+		return TypeRegistry{}, errors.Wrap(err, "failed to query file descriptors")
+	}
+	for _, fd := range filesRes.Files {
+		for _, sd := range fd.Service {
+			if isMsgService(sd.Options) {
+				continue
+			}
+			for _, md := range sd.Method {
+				registry.QueryMethods = append(registry.QueryMethods, queryMethodName(
+					fd.GetPackage()+"."+sd.GetName(), md.GetName(),
+				))
+			}
+		}
+	}
+	sort.Strings(registry.QueryMethods)
+
+	return registry, nil
+}
+
+// VerifyTypeRegistry checks that the client knows all the types of the node packed into Any. The types known only by
+// the client aren't reported as the error, since the client might be upgraded before the node. The query methods
+// aren't verified, since the client usually doesn't use the queries of all the modules, use Diff to compare them.
+func VerifyTypeRegistry(
+	ctx context.Context, conn grpc.ClientConnInterface, interfaceRegistry codectypes.InterfaceRegistry,
+) error {
+	nodeRegistry, err := QueryTypeRegistry(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	diff := NewTypeRegistry(interfaceRegistry).Diff(nodeRegistry)
+	if len(diff.MissingTypes) > 0 {
+		return errors.Errorf(
+			"client registry doesn't know the types of the node: %s", strings.Join(diff.MissingTypes, ", "),
+		)
+	}
+
+	return nil
+}
+
+// TypeURLs returns the sorted type URLs of all the implementations.
+func (r TypeRegistry) TypeURLs() []string {
+	typeURLs := make([]string, 0)
+	for _, impls := range r.Interfaces {
+		typeURLs = append(typeURLs, impls...)
+	}
+	sort.Strings(typeURLs)
+	return slices.Compact(typeURLs)
+}
+
+// Diff returns the difference between the registry of the client and the registry of the node.
+func (r TypeRegistry) Diff(node TypeRegistry) TypeRegistryDiff {
+	var diff TypeRegistryDiff
+	diff.MissingTypes, diff.ExtraTypes = diffSorted(r.TypeURLs(), node.TypeURLs())
+	diff.MissingQueryMethods, diff.ExtraQueryMethods = diffSorted(
+		sortedCopy(r.QueryMethods), sortedCopy(node.QueryMethods),
+	)
+	return diff
+}
+
+// diffSorted returns the items found only in the node list and the items found only in the client list.
+func diffSorted(client, node []string) ([]string, []string) {
+	var missing, extra []string
+	for _, item := range node {
+		if _, found := slices.BinarySearch(client, item); !found {
+			missing = append(missing, item)
+		}
+	}
+	for _, item := range client {
+		if _, found := slices.BinarySearch(node, item); !found {
+			extra = append(extra, item)
+		}
+	}
+	return missing, extra
+}
+
+func isMsgService(opts proto.Message) bool {
+	if opts == nil {
+		return false
+	}
+	// the options of the fetched descriptors are nil if they aren't set
+	if serviceOpts, ok := opts.(*descriptorpb.ServiceOptions); ok && serviceOpts == nil {
+		return false
+	}
+	isMsg, ok := proto.GetExtension(opts, msgv1.E_Service).(bool)
+	return ok && isMsg
+}
+
+func queryMethodName(service, method string) string {
+	return fmt.Sprintf("/%s/%s", service, method)
+}
+
+func sortedCopy(items []string) []string {
+	sorted := slices.Clone(items)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,37 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+)
+
+func TestTypeRegistry(t *testing.T) {
+	requireT := require.New(t)
+
+	registry := config.NewTypeRegistry(config.NewEncodingConfig(bank.AppModuleBasic{}).InterfaceRegistry)
+	requireT.Contains(registry.Interfaces[config.MsgInterfaceName], "/cosmos.bank.v1beta1.MsgSend")
+	requireT.Contains(registry.TypeURLs(), "/cosmos.bank.v1beta1.MsgSend")
+	requireT.Contains(registry.QueryMethods, "/cosmos.bank.v1beta1.Query/Balance")
+	// msg services aren't the query methods
+	requireT.NotContains(registry.QueryMethods, "/cosmos.bank.v1beta1.Msg/Send")
+
+	node := config.TypeRegistry{
+		Interfaces: map[string][]string{
+			config.MsgInterfaceName: {"/cosmos.bank.v1beta1.MsgSend", "/coreum.unknown.v1.MsgUnknown"},
+		},
+		QueryMethods: []string{"/cosmos.bank.v1beta1.Query/Balance", "/coreum.unknown.v1.Query/Unknown"},
+	}
+	diff := registry.Diff(node)
+	requireT.Equal([]string{"/coreum.unknown.v1.MsgUnknown"}, diff.MissingTypes)
+	requireT.Equal([]string{"/coreum.unknown.v1.Query/Unknown"}, diff.MissingQueryMethods)
+	requireT.NotContains(diff.ExtraTypes, "/cosmos.bank.v1beta1.MsgSend")
+	requireT.Contains(diff.ExtraTypes, "/cosmos.bank.v1beta1.MsgMultiSend")
+	requireT.NotContains(diff.ExtraQueryMethods, "/cosmos.bank.v1beta1.Query/Balance")
+
+	requireT.Empty(registry.Diff(registry).MissingTypes)
+	requireT.Empty(registry.Diff(registry).ExtraQueryMethods)
+}
@@ -0,0 +1,305 @@
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+)
+
+// ArchiveVersion is the version of the key archive format.
+const ArchiveVersion = 1
+
+const (
+	archiveSaltSize    = 16
+	archiveKeySize     = 32
+	archiveArgonTime   = 3
+	archiveArgonMemory = 64 * 1024
+	archiveArgonLanes  = 4
+)
+
+// Archived key types.
+const (
+	ArchivedKeyTypeLocal   = "local"
+	ArchivedKeyTypeLedger  = "ledger"
+	ArchivedKeyTypeOffline = "offline"
+	ArchivedKeyTypeMulti   = "multi"
+)
+
+// Archive is the file the keys are exported to. The payload is the JSON list of the archived keys, encrypted with
+// AES-GCM using the key derived from the passphrase by argon2id if the archive is encrypted. The checksum is the
+// SHA-256 hash of the plain payload, verified when the archive is read.
+type Archive struct {
+	Version   uint32 `json:"version"`
+	Encrypted bool   `json:"encrypted"`
+	Salt      []byte `json:"salt,omitempty"`
+	Nonce     []byte `json:"nonce,omitempty"`
+	Checksum  string `json:"checksum"`
+	Payload   []byte `json:"payload"`
+}
+
+// ArchivedKey is the key stored in the archive. The private key is stored for the local keys only, the ledger keys
+// are stored as the derivation path on the device, and the offline and multisig keys as the public keys.
+type ArchivedKey struct {
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	Address    string          `json:"address"`
+	PubKey     json.RawMessage `json:"pub_key"`
+	Algo       string          `json:"algo,omitempty"`
+	PrivKey    string          `json:"priv_key,omitempty"`
+	LedgerPath *hd.BIP44Params `json:"ledger_path,omitempty"`
+}
+
+// ExportKeys returns the archived keys with the provided names, or all the keys of the keyring if no names are
+// provided. The local keys are exported only if includePrivKeys is set.
+func ExportKeys(
+	kr keyring.Keyring,
+	cdc codec.JSONCodec,
+	names []string,
+	includePrivKeys bool,
+) ([]ArchivedKey, error) {
+	var records []*keyring.Record
+	if len(names) == 0 {
+		var err error
+		records, err = kr.List()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list keys")
+		}
+	}
+	for _, name := range names {
+		record, err := kr.Key(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get key %q", name)
+		}
+		records = append(records, record)
+	}
+
+	keys := make([]ArchivedKey, 0, len(records))
+	for _, record := range records {
+		key, err := archiveRecord(record, cdc, includePrivKeys)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to export key %q", record.Name)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// ImportKey saves the archived key in the keyring and verifies that the imported key has the archived address.
+// The ledger keys are derived by the connected device.
+func ImportKey(kr keyring.Keyring, cdc codec.JSONCodec, key ArchivedKey) error {
+	var (
+		record *keyring.Record
+		err    error
+	)
+	switch key.Type {
+	case ArchivedKeyTypeLocal:
+		if err := kr.ImportPrivKeyHex(key.Name, key.PrivKey, key.Algo); err != nil {
+			return errors.Wrap(err, "failed to import private key")
+		}
+		record, err = kr.Key(key.Name)
+	case ArchivedKeyTypeLedger:
+		if key.LedgerPath == nil {
+			return errors.New("ledger path is missing")
+		}
+		record, err = kr.SaveLedgerKey(
+			key.Name,
+			hd.Secp256k1,
+			sdk.GetConfig().GetBech32AccountAddrPrefix(),
+			key.LedgerPath.CoinType,
+			key.LedgerPath.Account,
+			key.LedgerPath.AddressIndex,
+		)
+	case ArchivedKeyTypeOffline, ArchivedKeyTypeMulti:
+		var pubKey types.PubKey
+		if err := cdc.UnmarshalInterfaceJSON(key.PubKey, &pubKey); err != nil {
+			return errors.Wrap(err, "failed to decode public key")
+		}
+		if key.Type == ArchivedKeyTypeOffline {
+			record, err = kr.SaveOfflineKey(key.Name, pubKey)
+		} else {
+			record, err = kr.SaveMultisig(key.Name, pubKey)
+		}
+	default:
+		return errors.Errorf("unknown key type %q", key.Type)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to save key")
+	}
+
+	address, err := record.GetAddress()
+	if err != nil {
+		return err
+	}
+	if address.String() != key.Address {
+		if err := kr.Delete(key.Name); err != nil {
+			return errors.Wrap(err, "failed to delete key with mismatched address")
+		}
+		return errors.Errorf("imported address %s doesn't match archived address %s", address, key.Address)
+	}
+
+	return nil
+}
+
+// WriteArchive returns the archive of the keys, encrypted with the passphrase if it is not empty. The local keys
+// are accepted only by the encrypted archive.
+func WriteArchive(keys []ArchivedKey, passphrase string) ([]byte, error) {
+	for _, key := range keys {
+		if key.PrivKey != "" && passphrase == "" {
+			return nil, errors.Errorf("private key of %q can be exported only to the encrypted archive", key.Name)
+		}
+	}
+
+	payload, err := json.Marshal(keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode keys")
+	}
+	checksum := sha256.Sum256(payload)
+	archive := Archive{
+		Version:  ArchiveVersion,
+		Checksum: hex.EncodeToString(checksum[:]),
+		Payload:  payload,
+	}
+
+	if passphrase != "" {
+		archive.Encrypted = true
+		archive.Salt = make([]byte, archiveSaltSize)
+		if _, err := rand.Read(archive.Salt); err != nil {
+			return nil, errors.Wrap(err, "failed to generate salt")
+		}
+		aead, err := newArchiveCipher(passphrase, archive.Salt)
+		if err != nil {
+			return nil, err
+		}
+		archive.Nonce = make([]byte, aead.NonceSize())
+		if _, err := rand.Read(archive.Nonce); err != nil {
+			return nil, errors.Wrap(err, "failed to generate nonce")
+		}
+		archive.Payload = aead.Seal(nil, archive.Nonce, payload, nil)
+	}
+
+	return json.MarshalIndent(archive, "", "  ")
+}
+
+// IsArchiveEncrypted reports whether the archive is encrypted, so the passphrase is required to read it.
+func IsArchiveEncrypted(data []byte) bool {
+	var archive Archive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return false
+	}
+	return archive.Encrypted
+}
+
+// ReadArchive decrypts the archive if it is encrypted, verifies its checksum and returns the archived keys.
+func ReadArchive(data []byte, passphrase string) ([]ArchivedKey, error) {
+	var archive Archive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return nil, errors.Wrap(err, "failed to decode archive")
+	}
+	if archive.Version != ArchiveVersion {
+		return nil, errors.Errorf("unsupported archive version %d", archive.Version)
+	}
+
+	payload := archive.Payload
+	if archive.Encrypted {
+		aead, err := newArchiveCipher(passphrase, archive.Salt)
+		if err != nil {
+			return nil, err
+		}
+		if len(archive.Nonce) != aead.NonceSize() {
+			return nil, errors.New("invalid archive nonce")
+		}
+		payload, err = aead.Open(nil, archive.Nonce, archive.Payload, nil)
+		if err != nil {
+			return nil, errors.New("failed to decrypt archive, the passphrase is invalid or the archive is corrupted")
+		}
+	}
+
+	checksum := sha256.Sum256(payload)
+	if hex.EncodeToString(checksum[:]) != archive.Checksum {
+		return nil, errors.New("archive checksum mismatch")
+	}
+
+	var keys []ArchivedKey
+	if err := json.Unmarshal(payload, &keys); err != nil {
+		return nil, errors.Wrap(err, "failed to decode keys")
+	}
+	for _, key := range keys {
+		if key.PrivKey != "" && !archive.Encrypted {
+			return nil, errors.Errorf("private key of %q is stored in the unencrypted archive", key.Name)
+		}
+	}
+
+	return keys, nil
+}
+
+func archiveRecord(record *keyring.Record, cdc codec.JSONCodec, includePrivKeys bool) (ArchivedKey, error) {
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return ArchivedKey{}, err
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return ArchivedKey{}, err
+	}
+	pubKeyJSON, err := cdc.MarshalInterfaceJSON(pubKey)
+	if err != nil {
+		return ArchivedKey{}, errors.Wrap(err, "failed to encode public key")
+	}
+
+	key := ArchivedKey{
+		Name:    record.Name,
+		Address: address.String(),
+		PubKey:  pubKeyJSON,
+	}
+	switch record.GetType() {
+	case keyring.TypeLocal:
+		if !includePrivKeys {
+			return ArchivedKey{}, errors.New("local keys are exported only to the encrypted archive")
+		}
+		privKey, ok := record.GetLocal().PrivKey.GetCachedValue().(types.PrivKey)
+		if !ok {
+			return ArchivedKey{}, errors.New("failed to decode private key")
+		}
+		key.Type = ArchivedKeyTypeLocal
+		key.Algo = privKey.Type()
+		key.PrivKey = hex.EncodeToString(privKey.Bytes())
+	case keyring.TypeLedger:
+		key.Type = ArchivedKeyTypeLedger
+		key.LedgerPath = record.GetLedger().GetPath()
+	case keyring.TypeOffline:
+		key.Type = ArchivedKeyTypeOffline
+	case keyring.TypeMulti:
+		key.Type = ArchivedKeyTypeMulti
+	default:
+		return ArchivedKey{}, errors.Errorf("unsupported key type %s", record.GetType())
+	}
+
+	return key, nil
+}
+
+func newArchiveCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	if passphrase == "" {
+		return nil, errors.New("passphrase is required")
+	}
+	if len(salt) != archiveSaltSize {
+		return nil, errors.New("invalid archive salt")
+	}
+	key := argon2.IDKey([]byte(passphrase), salt, archiveArgonTime, archiveArgonMemory, archiveArgonLanes, archiveKeySize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cipher")
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,98 @@
+package keyring
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/stretchr/testify/require"
+)
+
+// TestArchiveExportImport checks that the keys are restored from the archive.
+//
+//nolint:lll // this code contains mnemonic that cannot be broken down.
+func TestArchiveExportImport(t *testing.T) {
+	t.Parallel()
+	requireT := require.New(t)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	srcKeyring := keyring.NewInMemory(cdc)
+
+	localAddr1 := importMnemonic(srcKeyring, "system voyage notice mother enrich glow person blur winter clog equip dignity will bicycle stumble purse shock casino wet fan neglect essay vote school")
+	localAddr2 := importMnemonic(srcKeyring, "dinner liar trust decrease angry apart ladder dance leisure flock super hollow such much ridge planet pill crazy inherit limit submit size absurd drive")
+	offlinePubKey := secp256k1.GenPrivKey().PubKey()
+	_, err := srcKeyring.SaveOfflineKey("offline", offlinePubKey)
+	requireT.NoError(err)
+	_, err = srcKeyring.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(2, []types.PubKey{
+		offlinePubKey,
+		secp256k1.GenPrivKey().PubKey(),
+	}))
+	requireT.NoError(err)
+
+	// the private keys can't be exported to the unencrypted archive
+	_, err = ExportKeys(srcKeyring, cdc, nil, false)
+	requireT.Error(err)
+	keys, err := ExportKeys(srcKeyring, cdc, []string{"offline", "multi"}, false)
+	requireT.NoError(err)
+	plainArchive, err := WriteArchive(keys, "")
+	requireT.NoError(err)
+	requireT.False(IsArchiveEncrypted(plainArchive))
+
+	keys, err = ExportKeys(srcKeyring, cdc, nil, true)
+	requireT.NoError(err)
+	requireT.Len(keys, 4)
+	_, err = WriteArchive(keys, "")
+	requireT.Error(err)
+	archive, err := WriteArchive(keys, "passphrase")
+	requireT.NoError(err)
+	requireT.True(IsArchiveEncrypted(archive))
+
+	// the archive is verified
+	_, err = ReadArchive(archive, "invalid")
+	requireT.Error(err)
+	var tampered Archive
+	requireT.NoError(json.Unmarshal(plainArchive, &tampered))
+	tampered.Payload = []byte(`[]`)
+	tamperedArchive, err := json.Marshal(tampered)
+	requireT.NoError(err)
+	_, err = ReadArchive(tamperedArchive, "")
+	requireT.ErrorContains(err, "checksum mismatch")
+
+	importedKeys, err := ReadArchive(archive, "passphrase")
+	requireT.NoError(err)
+	requireT.Equal(keys, importedKeys)
+
+	dstKeyring := keyring.NewInMemory(cdc)
+	for _, key := range importedKeys {
+		requireT.NoError(ImportKey(dstKeyring, cdc, key))
+	}
+	for _, key := range keys {
+		record, err := dstKeyring.Key(key.Name)
+		requireT.NoError(err)
+		address, err := record.GetAddress()
+		requireT.NoError(err)
+		requireT.Equal(key.Address, address.String())
+	}
+	_, err = dstKeyring.KeyByAddress(localAddr1)
+	requireT.NoError(err)
+	_, err = dstKeyring.KeyByAddress(localAddr2)
+	requireT.NoError(err)
+
+	// the key is rejected if the address doesn't match
+	keys, err = ExportKeys(srcKeyring, cdc, []string{"offline"}, false)
+	requireT.NoError(err)
+	key := keys[0]
+	key.Name = "mismatch"
+	key.Address = localAddr1.String()
+	requireT.ErrorContains(ImportKey(dstKeyring, cdc, key), "doesn't match")
+	_, err = dstKeyring.Key("mismatch")
+	requireT.Error(err)
+}
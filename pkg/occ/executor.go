@@ -0,0 +1,139 @@
+// Package occ provides the experimental executor running the transactions of the block in parallel with the
+// optimistic concurrency control. It is used only by the offline replay of the stored blocks, the node executes the
+// blocks sequentially.
+package occ
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeliverTxFunc executes the transaction and writes its changes to the store of the context. The executors pass the
+// context with the gas meter and the event manager of the transaction.
+type DeliverTxFunc func(ctx sdk.Context, txBytes []byte) *abci.ExecTxResult
+
+// Stats describes the execution of the block.
+type Stats struct {
+	// Txs is the number of the executed transactions.
+	Txs int
+	// Conflicts is the number of the transactions re-executed because they read the keys written by the preceding
+	// transactions.
+	Conflicts int
+	// Duration is the total execution time.
+	Duration time.Duration
+}
+
+// Executor executes the transactions of the block.
+type Executor interface {
+	// Execute executes the transactions in the order of the block and returns their results. The result must be the
+	// same as of the sequential execution, including the state written to the store of the context.
+	Execute(ctx sdk.Context, txs [][]byte, deliver DeliverTxFunc) ([]*abci.ExecTxResult, Stats)
+}
+
+var _ Executor = SequentialExecutor{}
+
+// SequentialExecutor executes the transactions one by one.
+type SequentialExecutor struct{}
+
+// Execute executes the transactions one by one.
+func (SequentialExecutor) Execute(ctx sdk.Context, txs [][]byte, deliver DeliverTxFunc) ([]*abci.ExecTxResult, Stats) {
+	start := time.Now()
+	results := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, txBytes := range txs {
+		results = append(results, deliver(txContext(ctx), txBytes))
+	}
+
+	return results, Stats{
+		Txs:      len(txs),
+		Duration: time.Since(start),
+	}
+}
+
+var _ Executor = &ParallelExecutor{}
+
+// ParallelExecutor executes all the transactions of the block speculatively in parallel against the state before
+// the block, recording the keys read and written by each of them. Then the results are committed in the order of the
+// block. The transaction which read a key written by the preceding committed transaction is re-executed against the
+// current state, so the outcome is the same as of the sequential execution.
+//
+// Only the state kept in the stores is tracked, so the transactions must not depend on the in-memory state of the
+// keepers modified by the preceding transactions of the block.
+type ParallelExecutor struct {
+	workers int
+}
+
+// NewParallelExecutor returns the parallel executor running the workers, GOMAXPROCS is used if workers is not
+// positive.
+func NewParallelExecutor(workers int) *ParallelExecutor {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return &ParallelExecutor{workers: workers}
+}
+
+type speculativeResult struct {
+	result *abci.ExecTxResult
+	store  *trackedMultiStore
+	access *accessSet
+}
+
+// Execute executes the transactions in parallel and commits them in the order of the block.
+func (e *ParallelExecutor) Execute(
+	ctx sdk.Context, txs [][]byte, deliver DeliverTxFunc,
+) ([]*abci.ExecTxResult, Stats) {
+	start := time.Now()
+	ms := ctx.MultiStore()
+
+	speculative := make([]speculativeResult, len(txs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(e.workers)
+	for range e.workers {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				speculative[i] = execute(ctx, ms, txs[i], deliver)
+			}
+		}()
+	}
+	for i := range txs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	stats := Stats{Txs: len(txs)}
+	results := make([]*abci.ExecTxResult, 0, len(txs))
+	committed := newAccessSet()
+	for i, res := range speculative {
+		if res.access.conflicts(committed) {
+			stats.Conflicts++
+			res = execute(ctx, ms, txs[i], deliver)
+		}
+		res.store.Write()
+		committed.merge(res.access)
+		results = append(results, res.result)
+	}
+
+	stats.Duration = time.Since(start)
+	return results, stats
+}
+
+func execute(ctx sdk.Context, ms storetypes.MultiStore, txBytes []byte, deliver DeliverTxFunc) speculativeResult {
+	access := newAccessSet()
+	store := newTrackedMultiStore(ms, access)
+	return speculativeResult{
+		result: deliver(txContext(ctx).WithMultiStore(store), txBytes),
+		store:  store,
+		access: access,
+	}
+}
+
+func txContext(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+}
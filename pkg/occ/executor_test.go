@@ -0,0 +1,163 @@
+package occ_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/occ"
+)
+
+var (
+	balancesKey = storetypes.NewKVStoreKey("balances")
+	statsKey    = storetypes.NewKVStoreKey("stats")
+)
+
+// deliverTransfer executes the toy transactions: "from:to:amount" transferring the amount between the accounts and
+// "total" summing up the balances of all the accounts.
+func deliverTransfer(ctx sdk.Context, txBytes []byte) *abci.ExecTxResult {
+	balances := ctx.KVStore(balancesKey)
+	getBalance := func(account string) uint64 {
+		value := balances.Get([]byte(account))
+		if value == nil {
+			return 0
+		}
+		return binary.BigEndian.Uint64(value)
+	}
+	setBalance := func(account string, amount uint64) {
+		balances.Set([]byte(account), binary.BigEndian.AppendUint64(nil, amount))
+	}
+
+	tx := string(txBytes)
+	if tx == "total" {
+		iter := balances.Iterator(nil, nil)
+		defer iter.Close()
+		var total uint64
+		for ; iter.Valid(); iter.Next() {
+			total += binary.BigEndian.Uint64(iter.Value())
+		}
+		ctx.KVStore(statsKey).Set([]byte("total"), binary.BigEndian.AppendUint64(nil, total))
+		return &abci.ExecTxResult{Data: []byte(fmt.Sprint(total))}
+	}
+
+	var from, to string
+	var amount uint64
+	parts := strings.Split(tx, ":")
+	from, to = parts[0], parts[1]
+	if _, err := fmt.Sscan(parts[2], &amount); err != nil {
+		return &abci.ExecTxResult{Code: 2, Log: err.Error()}
+	}
+	fromBalance := getBalance(from)
+	if fromBalance < amount {
+		return &abci.ExecTxResult{Code: 1, Log: "insufficient funds"}
+	}
+	setBalance(from, fromBalance-amount)
+	setBalance(to, getBalance(to)+amount)
+	return &abci.ExecTxResult{Data: []byte(fmt.Sprint(fromBalance - amount))}
+}
+
+func newContext(t *testing.T, accounts int) sdk.Context {
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(balancesKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(statsKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms.CacheMultiStore(), tmproto.Header{}, false, log.NewNopLogger())
+	for i := range accounts {
+		ctx.KVStore(balancesKey).Set([]byte(account(i)), binary.BigEndian.AppendUint64(nil, 100))
+	}
+	return ctx
+}
+
+func account(i int) string {
+	return fmt.Sprintf("account%03d", i)
+}
+
+func dumpState(ctx sdk.Context) map[string]string {
+	state := map[string]string{}
+	for _, key := range []storetypes.StoreKey{balancesKey, statsKey} {
+		iter := ctx.KVStore(key).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			state[key.Name()+"/"+string(iter.Key())] = string(iter.Value())
+		}
+		iter.Close()
+	}
+	return state
+}
+
+func TestParallelExecutor_Determinism(t *testing.T) {
+	for _, accounts := range []int{3, 20, 500} {
+		for _, workers := range []int{1, 4, 16} {
+			t.Run(fmt.Sprintf("accounts=%d,workers=%d", accounts, workers), func(t *testing.T) {
+				requireT := require.New(t)
+				r := rand.New(rand.NewSource(int64(accounts*100 + workers)))
+
+				for range 10 {
+					txs := make([][]byte, 0, 200)
+					for range cap(txs) {
+						if r.Intn(50) == 0 {
+							txs = append(txs, []byte("total"))
+							continue
+						}
+						txs = append(txs, fmt.Appendf(nil, "%s:%s:%d",
+							account(r.Intn(accounts)), account(r.Intn(accounts)), r.Intn(150)))
+					}
+
+					seqCtx := newContext(t, accounts)
+					seqResults, seqStats := occ.SequentialExecutor{}.Execute(seqCtx, txs, deliverTransfer)
+					parCtx := newContext(t, accounts)
+					parResults, parStats := occ.NewParallelExecutor(workers).Execute(parCtx, txs, deliverTransfer)
+
+					requireT.Equal(seqResults, parResults)
+					requireT.Equal(dumpState(seqCtx), dumpState(parCtx))
+					requireT.Equal(len(txs), seqStats.Txs)
+					requireT.Equal(len(txs), parStats.Txs)
+					requireT.Zero(seqStats.Conflicts)
+				}
+			})
+		}
+	}
+}
+
+func TestParallelExecutor_Conflicts(t *testing.T) {
+	requireT := require.New(t)
+
+	// the transfers between the disjoint accounts don't conflict
+	ctx := newContext(t, 4)
+	_, stats := occ.NewParallelExecutor(4).Execute(ctx, [][]byte{
+		[]byte(account(0) + ":" + account(1) + ":10"),
+		[]byte(account(2) + ":" + account(3) + ":10"),
+	}, deliverTransfer)
+	requireT.Zero(stats.Conflicts)
+
+	// the second transfer spends the balance received in the first one
+	ctx = newContext(t, 3)
+	results, stats := occ.NewParallelExecutor(4).Execute(ctx, [][]byte{
+		[]byte(account(0) + ":" + account(1) + ":100"),
+		[]byte(account(1) + ":" + account(2) + ":200"),
+	}, deliverTransfer)
+	requireT.Equal(1, stats.Conflicts)
+	requireT.Zero(results[1].Code)
+
+	// the iteration conflicts with the write to the iterated range
+	ctx = newContext(t, 3)
+	results, stats = occ.NewParallelExecutor(4).Execute(ctx, [][]byte{
+		[]byte(account(0) + ":" + account(5) + ":10"),
+		[]byte("total"),
+	}, deliverTransfer)
+	requireT.Equal(1, stats.Conflicts)
+	requireT.Equal("300", string(results[1].Data))
+}
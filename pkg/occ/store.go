@@ -0,0 +1,180 @@
+package occ
+
+import (
+	"bytes"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+type keyRange struct {
+	start []byte
+	end   []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) && (r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// accessSet is the set of the keys read and written by the transaction. The set is used by a single transaction
+// executed in a single goroutine, so it isn't synchronized.
+type accessSet struct {
+	reads  map[storetypes.StoreKey]map[string]struct{}
+	ranges map[storetypes.StoreKey][]keyRange
+	writes map[storetypes.StoreKey]map[string]struct{}
+}
+
+func newAccessSet() *accessSet {
+	return &accessSet{
+		reads:  map[storetypes.StoreKey]map[string]struct{}{},
+		ranges: map[storetypes.StoreKey][]keyRange{},
+		writes: map[storetypes.StoreKey]map[string]struct{}{},
+	}
+}
+
+func (s *accessSet) read(storeKey storetypes.StoreKey, key []byte) {
+	addKey(s.reads, storeKey, key)
+}
+
+func (s *accessSet) iterate(storeKey storetypes.StoreKey, start, end []byte) {
+	s.ranges[storeKey] = append(s.ranges[storeKey], keyRange{
+		start: bytes.Clone(start),
+		end:   bytes.Clone(end),
+	})
+}
+
+func (s *accessSet) write(storeKey storetypes.StoreKey, key []byte) {
+	addKey(s.writes, storeKey, key)
+}
+
+// conflicts returns true if the transaction read any of the keys written by the transactions committed after its
+// speculative execution started.
+func (s *accessSet) conflicts(committed *accessSet) bool {
+	for storeKey, writes := range committed.writes {
+		reads := s.reads[storeKey]
+		ranges := s.ranges[storeKey]
+		for key := range writes {
+			if _, exists := reads[key]; exists {
+				return true
+			}
+			for _, r := range ranges {
+				if r.contains([]byte(key)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// merge adds the writes of the other set to the set.
+func (s *accessSet) merge(other *accessSet) {
+	for storeKey, writes := range other.writes {
+		for key := range writes {
+			addKey(s.writes, storeKey, []byte(key))
+		}
+	}
+}
+
+func addKey(keys map[storetypes.StoreKey]map[string]struct{}, storeKey storetypes.StoreKey, key []byte) {
+	storeKeys, exists := keys[storeKey]
+	if !exists {
+		storeKeys = map[string]struct{}{}
+		keys[storeKey] = storeKeys
+	}
+	storeKeys[string(key)] = struct{}{}
+}
+
+var _ storetypes.KVStore = &trackedKVStore{}
+
+// trackedKVStore records the keys accessed through the store in the access set.
+type trackedKVStore struct {
+	storetypes.KVStore
+
+	storeKey storetypes.StoreKey
+	access   *accessSet
+}
+
+func (s *trackedKVStore) Get(key []byte) []byte {
+	s.access.read(s.storeKey, key)
+	return s.KVStore.Get(key)
+}
+
+func (s *trackedKVStore) Has(key []byte) bool {
+	s.access.read(s.storeKey, key)
+	return s.KVStore.Has(key)
+}
+
+func (s *trackedKVStore) Set(key, value []byte) {
+	s.access.write(s.storeKey, key)
+	s.KVStore.Set(key, value)
+}
+
+func (s *trackedKVStore) Delete(key []byte) {
+	s.access.write(s.storeKey, key)
+	s.KVStore.Delete(key)
+}
+
+func (s *trackedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.access.iterate(s.storeKey, start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s *trackedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.access.iterate(s.storeKey, start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// CacheWrap branches the tracked store, so the accesses through the branch are tracked too.
+func (s *trackedKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *trackedKVStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+// cacheMultiStore is the alias embedded into the tracked store, so the embedded field doesn't collide with the
+// CacheMultiStore method.
+type cacheMultiStore = storetypes.CacheMultiStore
+
+var _ storetypes.CacheMultiStore = &trackedMultiStore{}
+
+// trackedMultiStore returns the substores recording the accessed keys in the access set of the transaction.
+type trackedMultiStore struct {
+	cacheMultiStore
+
+	access *accessSet
+}
+
+func newTrackedMultiStore(parent storetypes.MultiStore, access *accessSet) *trackedMultiStore {
+	return &trackedMultiStore{
+		cacheMultiStore: parent.CacheMultiStore(),
+		access:          access,
+	}
+}
+
+func (s *trackedMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return &trackedKVStore{
+		KVStore:  s.cacheMultiStore.GetKVStore(key),
+		storeKey: key,
+		access:   s.access,
+	}
+}
+
+func (s *trackedMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return s.GetKVStore(key)
+}
+
+func (s *trackedMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newTrackedMultiStore(s.cacheMultiStore, s.access)
+}
+
+func (s *trackedMultiStore) CacheWrap() storetypes.CacheWrap {
+	return s.CacheMultiStore()
+}
+
+func (s *trackedMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheMultiStore()
+}
@@ -0,0 +1,154 @@
+// Package proposal provides the helpers used to draft the governance proposals of the custom modules.
+package proposal
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// FlagProposalFile is the flag defining the path of the drafted proposal file.
+	FlagProposalFile = "proposal-file"
+	// DefaultProposalFile is the default path of the drafted proposal file.
+	DefaultProposalFile = "proposal.json"
+)
+
+// Proposal is the proposal file accepted by the `tx gov submit-proposal` command.
+type Proposal struct {
+	Messages  []json.RawMessage `json:"messages"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
+// Authority returns the address of the governance module executing the proposal messages.
+func Authority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// AddFlags adds the flags of the draft commands.
+func AddFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagProposalFile, DefaultProposalFile, "Path of the drafted proposal file")
+}
+
+// PromptString prompts the user for the value. If the default value is not empty, it is returned when the user enters
+// the empty value.
+func PromptString(inBuf *bufio.Reader, label, defaultValue string) (string, error) {
+	if defaultValue != "" {
+		label += " [" + defaultValue + "]"
+	}
+	value, err := input.GetString(label, inBuf)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read %q", label)
+	}
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	return value, nil
+}
+
+// Draft validates the messages, prompts the user for the proposal details and writes the submit-ready proposal file
+// to the path defined by the FlagProposalFile flag.
+func Draft(cmd *cobra.Command, inBuf *bufio.Reader, cdc codec.Codec, msgs ...sdk.Msg) error {
+	proposal, err := Build(cmd, inBuf, cdc, msgs...)
+	if err != nil {
+		return err
+	}
+
+	path, err := cmd.Flags().GetString(FlagProposalFile)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	raw, err := json.MarshalIndent(proposal, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal proposal")
+	}
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to write proposal to %s", path)
+	}
+
+	cmd.Printf("The proposal has been written to %s, submit it with the `tx gov submit-proposal %s` command.\n",
+		path, path)
+
+	return nil
+}
+
+// Build validates the messages and prompts the user for the proposal details.
+func Build(cmd *cobra.Command, inBuf *bufio.Reader, cdc codec.Codec, msgs ...sdk.Msg) (Proposal, error) {
+	if len(msgs) == 0 {
+		return Proposal{}, errors.New("at least one message is required")
+	}
+
+	messages := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return Proposal{}, errors.Wrapf(err, "invalid message %s", sdk.MsgTypeURL(msg))
+			}
+		}
+		raw, err := cdc.MarshalInterfaceJSON(msg)
+		if err != nil {
+			return Proposal{}, errors.Wrapf(err, "failed to marshal message %s", sdk.MsgTypeURL(msg))
+		}
+		messages = append(messages, raw)
+	}
+
+	title, err := promptRequired(inBuf, "Enter proposal title")
+	if err != nil {
+		return Proposal{}, err
+	}
+	summary, err := promptRequired(inBuf, "Enter proposal summary")
+	if err != nil {
+		return Proposal{}, err
+	}
+	metadata, err := PromptString(inBuf, "Enter proposal metadata (e.g. ipfs://CID)", "")
+	if err != nil {
+		return Proposal{}, err
+	}
+	deposit, err := promptRequired(inBuf, "Enter proposal deposit")
+	if err != nil {
+		return Proposal{}, err
+	}
+	if _, err := sdk.ParseCoinsNormalized(deposit); err != nil {
+		return Proposal{}, errors.Wrapf(err, "invalid deposit %q", deposit)
+	}
+	expedited, err := input.GetConfirmation("Expedited proposal?", inBuf, cmd.ErrOrStderr())
+	if err != nil {
+		return Proposal{}, errors.Wrap(err, "failed to read expedited confirmation")
+	}
+
+	return Proposal{
+		Messages:  messages,
+		Metadata:  metadata,
+		Deposit:   deposit,
+		Title:     title,
+		Summary:   summary,
+		Expedited: expedited,
+	}, nil
+}
+
+func promptRequired(inBuf *bufio.Reader, label string) (string, error) {
+	value, err := PromptString(inBuf, label, "")
+	if err != nil {
+		return "", err
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", errors.Errorf("%q can't be empty", label)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,167 @@
+package sigverify
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"runtime"
+	"sync"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// DefaultMaxPending is the default maximum number of the signatures scheduled for the verification and not consumed
+// yet.
+const DefaultMaxPending = 20_000
+
+// Signature is the signature to verify.
+type Signature struct {
+	PubKey    cryptotypes.PubKey
+	SignBytes []byte
+	Signature []byte
+}
+
+type result struct {
+	sig   Signature
+	done  chan struct{}
+	valid bool
+}
+
+// Verifier verifies the signatures in the pool of workers. The signatures may be scheduled in advance, e.g. for all
+// the transactions of the block, so the results are ready once the transactions are executed. The result only depends
+// on the verified signature, so using the verifier never changes the outcome of the execution.
+type Verifier struct {
+	jobs       chan *result
+	maxPending int
+
+	mu      sync.Mutex
+	pending map[[sha256.Size]byte]*result
+
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewVerifier returns the verifier running the workers, GOMAXPROCS is used if workers is not positive.
+func NewVerifier(workers, maxPending int) *Verifier {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if maxPending <= 0 {
+		maxPending = DefaultMaxPending
+	}
+
+	v := &Verifier{
+		jobs:       make(chan *result, maxPending),
+		maxPending: maxPending,
+		pending:    map[[sha256.Size]byte]*result{},
+	}
+	v.wg.Add(workers)
+	for range workers {
+		go func() {
+			defer v.wg.Done()
+			for r := range v.jobs {
+				r.valid = r.sig.PubKey.VerifySignature(r.sig.SignBytes, r.sig.Signature)
+				close(r.done)
+			}
+		}()
+	}
+
+	return v
+}
+
+// Prefetch schedules the verification of the signatures. The signatures exceeding the limit of the pending ones are
+// ignored and verified when requested.
+func (v *Verifier) Prefetch(sigs ...Signature) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for _, sig := range sigs {
+		v.scheduleLocked(sig)
+	}
+}
+
+// VerifyAll returns true if all the signatures are valid. The signatures not scheduled before are verified in
+// parallel.
+func (v *Verifier) VerifyAll(sigs ...Signature) bool {
+	results := make([]*result, 0, len(sigs))
+	v.mu.Lock()
+	for _, sig := range sigs {
+		key := resultKey(sig)
+		r, ok := v.pending[key]
+		if ok {
+			delete(v.pending, key)
+		} else {
+			r = v.scheduleLocked(sig)
+			delete(v.pending, key)
+		}
+		results = append(results, r)
+	}
+	v.mu.Unlock()
+
+	valid := true
+	for i, r := range results {
+		if r == nil {
+			// the pool is full, so the signature is verified in place
+			if !sigs[i].PubKey.VerifySignature(sigs[i].SignBytes, sigs[i].Signature) {
+				valid = false
+			}
+			continue
+		}
+		<-r.done
+		if !r.valid {
+			valid = false
+		}
+	}
+
+	return valid
+}
+
+// Reset drops the results not consumed yet.
+func (v *Verifier) Reset() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	clear(v.pending)
+}
+
+// Close stops the workers.
+func (v *Verifier) Close() {
+	v.closeOnce.Do(func() {
+		close(v.jobs)
+		v.wg.Wait()
+	})
+}
+
+func (v *Verifier) scheduleLocked(sig Signature) *result {
+	key := resultKey(sig)
+	if r, ok := v.pending[key]; ok {
+		return r
+	}
+	if len(v.pending) >= v.maxPending {
+		return nil
+	}
+
+	r := &result{
+		sig:  sig,
+		done: make(chan struct{}),
+	}
+	select {
+	case v.jobs <- r:
+		v.pending[key] = r
+		return r
+	default:
+		return nil
+	}
+}
+
+func resultKey(sig Signature) [sha256.Size]byte {
+	hasher := sha256.New()
+	for _, field := range [][]byte{[]byte(sig.PubKey.Type()), sig.PubKey.Bytes(), sig.SignBytes, sig.Signature} {
+		var length [binary.MaxVarintLen64]byte
+		hasher.Write(length[:binary.PutUvarint(length[:], uint64(len(field)))])
+		hasher.Write(field)
+	}
+
+	var key [sha256.Size]byte
+	copy(key[:], hasher.Sum(nil))
+	return key
+}
@@ -0,0 +1,87 @@
+package sigverify_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/sigverify"
+)
+
+func TestVerifier(t *testing.T) {
+	requireT := require.New(t)
+
+	verifier := sigverify.NewVerifier(4, 3)
+	defer verifier.Close()
+
+	sigs := genSignatures(t, 5)
+	invalid := sigs[0]
+	invalid.SignBytes = []byte("modified")
+
+	// the signatures above the limit of the pending ones are verified in place
+	verifier.Prefetch(sigs...)
+	requireT.True(verifier.VerifyAll(sigs...))
+	requireT.True(verifier.VerifyAll(sigs[0]))
+	requireT.False(verifier.VerifyAll(sigs[1], invalid))
+
+	// the invalid prefetched signature stays invalid
+	verifier.Prefetch(invalid)
+	requireT.False(verifier.VerifyAll(invalid))
+
+	verifier.Prefetch(sigs[2], invalid)
+	verifier.Reset()
+	requireT.True(verifier.VerifyAll(sigs[2]))
+	requireT.False(verifier.VerifyAll(invalid))
+}
+
+// The benchmarks compare the verification of the signatures of the block transactions one by one with the
+// verification by the pool of workers, e.g. `go test -bench . -cpu 1,8 ./pkg/sigverify/`.
+func BenchmarkBlockSequential(b *testing.B) {
+	sigs := genSignatures(b, 1000)
+
+	b.ResetTimer()
+	for range b.N {
+		for _, sig := range sigs {
+			if !sig.PubKey.VerifySignature(sig.SignBytes, sig.Signature) {
+				b.Fatal("invalid signature")
+			}
+		}
+	}
+	b.ReportMetric(float64(b.N*len(sigs))/b.Elapsed().Seconds(), "sigs/s")
+}
+
+func BenchmarkBlockParallel(b *testing.B) {
+	sigs := genSignatures(b, 1000)
+	verifier := sigverify.NewVerifier(0, sigverify.DefaultMaxPending)
+	defer verifier.Close()
+
+	b.ResetTimer()
+	for range b.N {
+		verifier.Prefetch(sigs...)
+		for _, sig := range sigs {
+			if !verifier.VerifyAll(sig) {
+				b.Fatal("invalid signature")
+			}
+		}
+	}
+	b.ReportMetric(float64(b.N*len(sigs))/b.Elapsed().Seconds(), "sigs/s")
+}
+
+func genSignatures(t testing.TB, count int) []sigverify.Signature {
+	sigs := make([]sigverify.Signature, 0, count)
+	for i := range count {
+		privKey := secp256k1.GenPrivKey()
+		signBytes := []byte(fmt.Sprintf("transaction %d", i))
+		sig, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+		sigs = append(sigs, sigverify.Signature{
+			PubKey:    privKey.PubKey(),
+			SignBytes: signBytes,
+			Signature: sig,
+		})
+	}
+
+	return sigs
+}
@@ -0,0 +1,45 @@
+package integration
+
+import (
+	_ "embed"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
+)
+
+// DefaultAccountManifestYAML is the manifest of the accounts provisioned by znet in the dev network.
+//
+//go:embed accounts.yaml
+var DefaultAccountManifestYAML []byte
+
+// Well-known roles of the accounts provisioned by znet.
+const (
+	RoleFoundation1  = "foundation-1"
+	RoleFoundation2  = "foundation-2"
+	RoleAlliance1    = "alliance-1"
+	RoleAlliance2    = "alliance-2"
+	RolePartnership1 = "partnership-1"
+	RoleInvestors1   = "investors-1"
+	RoleTeam1        = "team-1"
+)
+
+// DefaultAccountManifest returns the manifest of the accounts provisioned by znet in the dev network.
+func DefaultAccountManifest() config.AccountManifest {
+	manifest, err := config.ParseAccountManifest(DefaultAccountManifestYAML)
+	if err != nil {
+		panic(errors.Wrap(err, "invalid default account manifest"))
+	}
+	return manifest
+}
+
+// RoleAccount imports the account of the role provisioned by znet into the ClientContext Keyring and returns
+// its address.
+func (c TXChain) RoleAccount(role string) sdk.AccAddress {
+	acc, err := c.Accounts.Account(role)
+	if err != nil {
+		panic(err)
+	}
+	return c.ImportMnemonic(acc.Mnemonic)
+}
@@ -58,6 +58,9 @@ type ChainSettings struct {
 	GasPrice      sdkmath.LegacyDec
 	CoinType      uint32
 	RPCAddress    string
+	// ClockSkew is the controlled skew of the chain clock relative to the other chains. It is added to the block
+	// time of the chain seen by the tests, so the timeouts are computed as if the clock of the chain drifted.
+	ClockSkew time.Duration
 }
 
 // ChainContext is a types used to store the components required for the test chains subcomponents.
@@ -98,9 +101,17 @@ func (c ChainContext) GenAccount() sdk.AccAddress {
 	return c.ImportMnemonic(mnemonic)
 }
 
-// MustConvertToBech32Address converts the address to bech32 address string.
+// AddressConfig returns the address config of the chain.
+func (c ChainContext) AddressConfig() client.AddressConfig {
+	return client.AddressConfig{
+		AddressPrefix: c.ChainSettings.AddressPrefix,
+		CoinType:      c.ChainSettings.CoinType,
+	}
+}
+
+// MustConvertToBech32Address converts the address to bech32 address string using the prefix of the chain.
 func (c ChainContext) MustConvertToBech32Address(address sdk.AccAddress) string {
-	bech32Address, err := bech32.ConvertAndEncode(c.ChainSettings.AddressPrefix, address)
+	bech32Address, err := client.ConvertToBech32Address(address, c.AddressConfig())
 	if err != nil {
 		panic(err)
 	}
@@ -407,8 +418,8 @@ func QueryChainSettings(ctx context.Context, grpcClient *grpc.ClientConn) ChainS
 	}
 }
 
-// DialGRPCClient creates the grpc connection for the given URL.
-func DialGRPCClient(grpcURL string) (*grpc.ClientConn, error) {
+// DialGRPCClient creates the grpc connection for the given URL, the options are applied on top of the default ones.
+func DialGRPCClient(grpcURL string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	encodingConfig := config.NewEncodingConfig(
 		auth.AppModuleBasic{},
 		authz.AppModuleBasic{},
@@ -434,8 +445,10 @@ func DialGRPCClient(grpcURL string) (*grpc.ClientConn, error) {
 	if parsedURL.Scheme == "https" {
 		grpcClient, err := grpc.NewClient(
 			host,
-			grpc.WithDefaultCallOptions(grpc.ForceCodec(pc.GRPCCodec())),
-			grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
+			append([]grpc.DialOption{
+				grpc.WithDefaultCallOptions(grpc.ForceCodec(pc.GRPCCodec())),
+				grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
+			}, opts...)...,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to dial grpc")
@@ -450,11 +463,13 @@ func DialGRPCClient(grpcURL string) (*grpc.ClientConn, error) {
 	// http - insecure
 	grpcClient, err := grpc.NewClient(
 		host,
-		grpc.WithDefaultCallOptions(
-			grpc.ForceCodec(pc.GRPCCodec()),
-			grpc.MaxCallRecvMsgSize(1e+8),
-		),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		append([]grpc.DialOption{
+			grpc.WithDefaultCallOptions(
+				grpc.ForceCodec(pc.GRPCCodec()),
+				grpc.MaxCallRecvMsgSize(1e+8),
+			),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}, opts...)...,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial grpc")
@@ -2,8 +2,12 @@ package integration
 
 import (
 	"context"
+	"testing"
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
 	"github.com/tokenize-x/tx-tools/pkg/retry"
 )
 
@@ -71,3 +75,31 @@ func (c ChainContext) AwaitState(
 	})
 	return err
 }
+
+// AwaitUntilChainTime waits until the chain produces the block with the time equal to or after the provided one.
+// Use it instead of wall-clock sleeps in tests depending on the block time, since the chain and the test clocks
+// are not synchronized.
+func (c ChainContext) AwaitUntilChainTime(
+	ctx context.Context,
+	t *testing.T,
+	ts time.Time,
+	opts ...awaitStateOptionsFunc,
+) {
+	t.Helper()
+
+	// the state isn't expected to be reached before the time, so the timeout is counted from it
+	opts = append([]awaitStateOptionsFunc{
+		WithAwaitStateTimeout(max(time.Until(ts), 0) + DefaultAwaitStateTimeout),
+	}, opts...)
+	require.NoError(t, c.AwaitState(ctx, func(ctx context.Context) error {
+		header, err := c.LatestBlockHeader(ctx)
+		if err != nil {
+			return err
+		}
+		if header.Time.Before(ts) {
+			return errors.Errorf("chain time %s is before %s", header.Time, ts)
+		}
+
+		return nil
+	}, opts...))
+}
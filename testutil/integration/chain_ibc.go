@@ -128,15 +128,8 @@ func (c ChainContext) ExecuteTimingOutIBCTransfer(
 		recipientChainContext,
 	)
 
-	tmQueryClient := cmtservice.NewServiceClient(recipientChainContext.ClientContext)
-	latestBlockRes, err := tmQueryClient.GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+	headerTime, err := recipientChainContext.LatestBlockTime(ctx)
 	require.NoError(t, err)
-	var headerTime time.Time
-	if latestBlockRes.SdkBlock != nil {
-		headerTime = latestBlockRes.GetSdkBlock().GetHeader().Time
-	} else {
-		headerTime = latestBlockRes.GetBlock().GetHeader().Time // we keep it to keep the compatibility with old versions
-	}
 
 	ibcSend := ibctransfertypes.MsgTransfer{
 		SourcePort:       ibctransfertypes.PortID,
@@ -155,6 +148,64 @@ func (c ChainContext) ExecuteTimingOutIBCTransfer(
 	)
 }
 
+// ExecuteIBCTransferWithTimeout executes IBC transfer which times out at the timestamp of the recipient chain.
+func (c ChainContext) ExecuteIBCTransferWithTimeout(
+	ctx context.Context,
+	t *testing.T,
+	txf client.Factory,
+	senderAddress sdk.AccAddress,
+	coin sdk.Coin,
+	recipientChainContext ChainContext,
+	recipientAddress sdk.AccAddress,
+	timeout time.Time,
+) (*sdk.TxResponse, error) {
+	t.Helper()
+
+	sender := c.MustConvertToBech32Address(senderAddress)
+	receiver := recipientChainContext.MustConvertToBech32Address(recipientAddress)
+	t.Logf("Sending IBC transfer from %s, to %s, %s, timing out at %s.", sender, receiver, coin.String(), timeout)
+
+	recipientChannelID := c.AwaitForIBCChannelID(
+		ctx,
+		t,
+		ibctransfertypes.PortID,
+		recipientChainContext,
+	)
+
+	ibcSend := ibctransfertypes.MsgTransfer{
+		SourcePort:       ibctransfertypes.PortID,
+		SourceChannel:    recipientChannelID,
+		Token:            coin,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutTimestamp: uint64(timeout.UnixNano()),
+	}
+
+	return c.BroadcastTxWithSigner(
+		ctx,
+		txf,
+		senderAddress,
+		&ibcSend,
+	)
+}
+
+// LatestBlockTime returns the time of the latest block of the chain shifted by the clock skew of the chain.
+func (c ChainContext) LatestBlockTime(ctx context.Context) (time.Time, error) {
+	tmQueryClient := cmtservice.NewServiceClient(c.ClientContext)
+	latestBlockRes, err := tmQueryClient.GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+	if err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+	var headerTime time.Time
+	if latestBlockRes.SdkBlock != nil {
+		headerTime = latestBlockRes.GetSdkBlock().GetHeader().Time
+	} else {
+		headerTime = latestBlockRes.GetBlock().GetHeader().Time // we keep it to keep the compatibility with old versions
+	}
+
+	return headerTime.Add(c.ChainSettings.ClockSkew), nil
+}
+
 // AwaitForBalance queries for the balance with retry and timeout.
 func (c ChainContext) AwaitForBalance(
 	ctx context.Context,
@@ -286,6 +337,60 @@ func (c ChainContext) GetLatestConsensusHeight(
 	ctx context.Context,
 	portID, channelID string,
 ) (ibcclienttypes.Height, error) {
+	cs, err := c.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return ibcclienttypes.Height{}, err
+	}
+
+	return cs.LatestHeight, nil
+}
+
+// GetChannelLatestConsensusState returns the consensus state of the counterparty chain at the latest height of the
+// tendermint client of the provided IBC port and channelID.
+func (c ChainContext) GetChannelLatestConsensusState(
+	ctx context.Context,
+	portID, channelID string,
+) (*ibctmlightclienttypes.ConsensusState, error) {
+	height, err := c.GetLatestConsensusHeight(ctx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	queryClient := ibcchanneltypes.NewQueryClient(c.ClientContext)
+	consensusRes, err := queryClient.ChannelConsensusState(ctx, &ibcchanneltypes.QueryChannelConsensusStateRequest{
+		PortId:         portID,
+		ChannelId:      channelID,
+		RevisionNumber: height.RevisionNumber,
+		RevisionHeight: height.RevisionHeight,
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var consensusState exported.ConsensusState
+	if err := c.ClientContext.InterfaceRegistry().UnpackAny(
+		consensusRes.ConsensusState,
+		&consensusState,
+	); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	tmConsensusState, ok := consensusState.(*ibctmlightclienttypes.ConsensusState)
+	if !ok {
+		return nil, sdkerrors.Wrap(
+			cosmoserrors.ErrInvalidType,
+			"consensus state could not be cast as *ibctmlightclienttypes.ConsensusState",
+		)
+	}
+
+	return tmConsensusState, nil
+}
+
+// GetChannelClientState returns the tendermint client state for provided IBC port and channelID.
+func (c ChainContext) GetChannelClientState(
+	ctx context.Context,
+	portID, channelID string,
+) (*ibctmlightclienttypes.ClientState, error) {
 	queryClient := ibcchanneltypes.NewQueryClient(c.ClientContext)
 	req := &ibcchanneltypes.QueryChannelClientStateRequest{
 		PortId:    portID,
@@ -294,7 +399,7 @@ func (c ChainContext) GetLatestConsensusHeight(
 
 	clientRes, err := queryClient.ChannelClientState(ctx, req)
 	if err != nil {
-		return ibcclienttypes.Height{}, err
+		return nil, err
 	}
 
 	var clientState exported.ClientState
@@ -302,11 +407,11 @@ func (c ChainContext) GetLatestConsensusHeight(
 		clientRes.IdentifiedClientState.ClientState,
 		&clientState,
 	); err != nil {
-		return ibcclienttypes.Height{}, err
+		return nil, err
 	}
 
 	if clientState.ClientType() != exported.Tendermint {
-		return ibcclienttypes.Height{}, sdkerrors.Wrapf(
+		return nil, sdkerrors.Wrapf(
 			cosmoserrors.ErrInvalidType,
 			"invalid client state type. expected type: %s, got: %s",
 			exported.Tendermint,
@@ -316,13 +421,13 @@ func (c ChainContext) GetLatestConsensusHeight(
 
 	cs, ok := clientState.(*ibctmlightclienttypes.ClientState)
 	if !ok {
-		return ibcclienttypes.Height{}, sdkerrors.Wrap(
+		return nil, sdkerrors.Wrap(
 			cosmoserrors.ErrInvalidType,
 			"client state could not be cast as *ibctmlightclienttypes.ClientState",
 		)
 	}
 
-	return cs.LatestHeight, nil
+	return cs, nil
 }
 
 // AwaitForIBCClientAndConnectionIDs returns the clientID and channel for the peer chain.
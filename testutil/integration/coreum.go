@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tokenize-x/tx-chain/v7/pkg/client"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config"
 	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
 	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
 	"github.com/tokenize-x/tx-chain/v7/x/deterministicgas"
@@ -25,15 +26,17 @@ type TXChain struct {
 	Chain
 	Governance             Governance
 	DeterministicGasConfig deterministicgas.Config
+	Accounts               config.AccountManifest
 }
 
 // NewTXChain returns a new instance of the TXChain.
-func NewTXChain(chain Chain, stakerMnemonics []string) TXChain {
+func NewTXChain(chain Chain, stakerMnemonics []string, accounts config.AccountManifest) TXChain {
 	gov := NewGovernance(chain.ChainContext, stakerMnemonics, chain.Faucet)
 	return TXChain{
 		Chain:                  chain,
 		Governance:             gov,
 		DeterministicGasConfig: deterministicgas.DefaultConfig(),
+		Accounts:               accounts,
 	}
 }
 
@@ -0,0 +1,351 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// broadcastTxMethod is the gRPC method broadcasting the tx, which is not replayed.
+const broadcastTxMethod = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+
+// InteractionKind is the kind of the recorded chain interaction.
+type InteractionKind string
+
+const (
+	// InteractionKindQuery is the gRPC call, including the tx simulation and the tx broadcast over gRPC.
+	InteractionKindQuery InteractionKind = "query"
+	// InteractionKindBroadcast is the tx broadcast over the RPC.
+	InteractionKindBroadcast InteractionKind = "broadcast"
+)
+
+// Interaction is the chain interaction captured by the recorder.
+type Interaction struct {
+	Kind         InteractionKind `json:"kind"`
+	Method       string          `json:"method"`
+	Time         time.Time       `json:"time"`
+	RequestType  string          `json:"request_type,omitempty"`
+	Request      []byte          `json:"request"`
+	RequestJSON  json.RawMessage `json:"request_json,omitempty"`
+	ResponseType string          `json:"response_type,omitempty"`
+	Response     []byte          `json:"response,omitempty"`
+	ResponseJSON json.RawMessage `json:"response_json,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+// Recording is the replayable artifact holding the chain interactions of the test in the order they were made.
+type Recording struct {
+	Name         string        `json:"name"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// LoadRecording loads the recording saved by the recorder.
+func LoadRecording(path string) (Recording, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Recording{}, errors.Wrapf(err, "failed to read recording %s", path)
+	}
+	var recording Recording
+	if err := json.Unmarshal(bz, &recording); err != nil {
+		return Recording{}, errors.Wrapf(err, "failed to unmarshal recording %s", path)
+	}
+
+	return recording, nil
+}
+
+type recordingNameKey struct{}
+
+// WithRecording returns the context whose chain interactions are captured by the recorder under the name.
+func WithRecording(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, recordingNameKey{}, name)
+}
+
+func recordingName(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(recordingNameKey{}).(string)
+	return name, ok
+}
+
+// Recorder captures the chain interactions made with the contexts returned by WithRecording.
+type Recorder struct {
+	mu         sync.Mutex
+	recordings map[string]*Recording
+}
+
+// NewRecorder returns a new instance of the Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		recordings: map[string]*Recording{},
+	}
+}
+
+// DialOption returns the gRPC dial option installing the recorder on the connection.
+func (r *Recorder) DialOption() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(r.UnaryClientInterceptor())
+}
+
+// UnaryClientInterceptor returns the gRPC interceptor capturing the calls.
+func (r *Recorder) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		startTime := time.Now().UTC()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		name, ok := recordingName(ctx)
+		if !ok {
+			return err
+		}
+
+		interaction := Interaction{
+			Kind:   InteractionKindQuery,
+			Method: method,
+			Time:   startTime,
+		}
+		if err != nil {
+			interaction.Error = err.Error()
+		}
+		if reqMsg, ok := req.(proto.Message); ok {
+			interaction.RequestType, interaction.Request, interaction.RequestJSON = marshalInteractionMessage(reqMsg)
+		}
+		if replyMsg, ok := reply.(proto.Message); ok {
+			interaction.ResponseType = proto.MessageName(replyMsg)
+			if err == nil {
+				_, interaction.Response, interaction.ResponseJSON = marshalInteractionMessage(replyMsg)
+			}
+		}
+		r.record(name, interaction)
+
+		return err
+	}
+}
+
+// WrapRPCClient returns the RPC client capturing the broadcast txs.
+func (r *Recorder) WrapRPCClient(client rpcclient.Client) rpcclient.Client {
+	return recordingRPCClient{
+		Client:   client,
+		recorder: r,
+	}
+}
+
+// Recording returns the chain interactions captured under the name.
+func (r *Recorder) Recording(name string) Recording {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	recording, ok := r.recordings[name]
+	if !ok {
+		return Recording{Name: name}
+	}
+
+	return Recording{
+		Name:         recording.Name,
+		Interactions: append([]Interaction{}, recording.Interactions...),
+	}
+}
+
+// Save writes the chain interactions captured under the name to the directory and forgets them.
+// The name of the file is derived from the recording name.
+func (r *Recorder) Save(name, dir string) (string, error) {
+	recording := r.Recording(name)
+	bz, err := json.MarshalIndent(recording, "", "  ")
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal recording %s", name)
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", errors.Wrapf(err, "failed to create recording dir %s", dir)
+	}
+	path := filepath.Join(dir, strings.NewReplacer("/", "_", " ", "_").Replace(name)+".json")
+	if err := os.WriteFile(path, bz, 0o600); err != nil {
+		return "", errors.Wrapf(err, "failed to write recording %s", path)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.recordings, name)
+
+	return path, nil
+}
+
+func (r *Recorder) record(name string, interaction Interaction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	recording, ok := r.recordings[name]
+	if !ok {
+		recording = &Recording{Name: name}
+		r.recordings[name] = recording
+	}
+	recording.Interactions = append(recording.Interactions, interaction)
+}
+
+type recordingRPCClient struct {
+	rpcclient.Client
+	recorder *Recorder
+}
+
+func (c recordingRPCClient) BroadcastTxSync(
+	ctx context.Context,
+	tx cmttypes.Tx,
+) (*coretypes.ResultBroadcastTx, error) {
+	startTime := time.Now().UTC()
+	res, err := c.Client.BroadcastTxSync(ctx, tx)
+	c.recordBroadcast(ctx, "broadcast_tx_sync", startTime, tx, res, err)
+	return res, err
+}
+
+func (c recordingRPCClient) BroadcastTxAsync(
+	ctx context.Context,
+	tx cmttypes.Tx,
+) (*coretypes.ResultBroadcastTx, error) {
+	startTime := time.Now().UTC()
+	res, err := c.Client.BroadcastTxAsync(ctx, tx)
+	c.recordBroadcast(ctx, "broadcast_tx_async", startTime, tx, res, err)
+	return res, err
+}
+
+func (c recordingRPCClient) BroadcastTxCommit(
+	ctx context.Context,
+	tx cmttypes.Tx,
+) (*coretypes.ResultBroadcastTxCommit, error) {
+	startTime := time.Now().UTC()
+	res, err := c.Client.BroadcastTxCommit(ctx, tx)
+	c.recordBroadcast(ctx, "broadcast_tx_commit", startTime, tx, res, err)
+	return res, err
+}
+
+func (c recordingRPCClient) recordBroadcast(
+	ctx context.Context,
+	method string,
+	startTime time.Time,
+	tx cmttypes.Tx,
+	res any,
+	err error,
+) {
+	name, ok := recordingName(ctx)
+	if !ok {
+		return
+	}
+
+	interaction := Interaction{
+		Kind:    InteractionKindBroadcast,
+		Method:  method,
+		Time:    startTime,
+		Request: tx,
+	}
+	if err != nil {
+		interaction.Error = err.Error()
+	} else if resJSON, err := cmtjson.Marshal(res); err == nil {
+		interaction.ResponseJSON = resJSON
+	}
+	c.recorder.record(name, interaction)
+}
+
+// ReplayDiff is the difference between the recorded and replayed response of the query.
+type ReplayDiff struct {
+	Method           string          `json:"method"`
+	RequestJSON      json.RawMessage `json:"request_json,omitempty"`
+	RecordedResponse json.RawMessage `json:"recorded_response,omitempty"`
+	ReplayedResponse json.RawMessage `json:"replayed_response,omitempty"`
+	RecordedError    string          `json:"recorded_error,omitempty"`
+	ReplayedError    string          `json:"replayed_error,omitempty"`
+}
+
+// Replay re-executes the recorded queries against the node and returns the ones whose responses differ from the
+// recorded ones. The broadcast txs are not replayed, and the queries are executed against the latest state of
+// the node.
+func Replay(ctx context.Context, conn grpc.ClientConnInterface, recording Recording) ([]ReplayDiff, error) {
+	diffs := make([]ReplayDiff, 0)
+	for _, interaction := range recording.Interactions {
+		if interaction.Kind != InteractionKindQuery || isBroadcastMethod(interaction.Method) {
+			continue
+		}
+
+		req, err := newInteractionMessage(interaction.RequestType, interaction.Request)
+		if err != nil {
+			return nil, err
+		}
+		reply, err := newInteractionMessage(interaction.ResponseType, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var replayedError string
+		var replayedResponse []byte
+		if err := conn.Invoke(ctx, interaction.Method, req, reply); err != nil {
+			replayedError = err.Error()
+		} else if replayedResponse, err = proto.Marshal(reply); err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal response of %s", interaction.Method)
+		}
+
+		if replayedError == interaction.Error && bytes.Equal(replayedResponse, interaction.Response) {
+			continue
+		}
+		diff := ReplayDiff{
+			Method:           interaction.Method,
+			RequestJSON:      interaction.RequestJSON,
+			RecordedResponse: interaction.ResponseJSON,
+			RecordedError:    interaction.Error,
+			ReplayedError:    replayedError,
+		}
+		if replayedError == "" {
+			_, _, diff.ReplayedResponse = marshalInteractionMessage(reply)
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+func isBroadcastMethod(method string) bool {
+	return method == broadcastTxMethod
+}
+
+func marshalInteractionMessage(msg proto.Message) (string, []byte, json.RawMessage) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return proto.MessageName(msg), nil, nil
+	}
+	// the JSON is stored for readability only, so the messages which can't be rendered are stored without it
+	msgJSON, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return proto.MessageName(msg), bz, nil
+	}
+
+	return proto.MessageName(msg), bz, msgJSON
+}
+
+func newInteractionMessage(typeName string, bz []byte) (proto.Message, error) {
+	msgType := proto.MessageType(typeName)
+	if msgType == nil {
+		return nil, errors.Errorf("unknown message type %q", typeName)
+	}
+	msg, ok := reflect.New(msgType.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, errors.Errorf("type %q is not a proto message", typeName)
+	}
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s", typeName)
+	}
+
+	return msg, nil
+}
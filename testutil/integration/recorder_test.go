@@ -0,0 +1,92 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const balanceMethod = "/cosmos.bank.v1beta1.Query/Balance"
+
+// bankConnMock answers the balance queries with the configured balances.
+type bankConnMock struct {
+	grpc.ClientConnInterface
+	balances map[string]sdk.Coin
+}
+
+func (m bankConnMock) Invoke(_ context.Context, method string, req, reply any, _ ...grpc.CallOption) error {
+	if method != balanceMethod {
+		return errors.Errorf("unexpected method %s", method)
+	}
+	balance, ok := m.balances[req.(*banktypes.QueryBalanceRequest).Address]
+	if !ok {
+		return errors.New("account not found")
+	}
+	reply.(*banktypes.QueryBalanceResponse).Balance = &balance
+	return nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	requireT := require.New(t)
+
+	conn := bankConnMock{balances: map[string]sdk.Coin{
+		"account1": sdk.NewInt64Coin("denom", 100),
+		"account2": sdk.NewInt64Coin("denom", 200),
+	}}
+	recorder := NewRecorder()
+	interceptor := recorder.UnaryClientInterceptor()
+	invoker := func(
+		ctx context.Context, method string, req, reply any, _ *grpc.ClientConn, opts ...grpc.CallOption,
+	) error {
+		return conn.Invoke(ctx, method, req, reply, opts...)
+	}
+	query := func(ctx context.Context, address string) error {
+		return interceptor(ctx, balanceMethod, &banktypes.QueryBalanceRequest{
+			Address: address,
+			Denom:   "denom",
+		}, &banktypes.QueryBalanceResponse{}, nil, invoker)
+	}
+
+	// the interactions are recorded only with the recording context
+	requireT.NoError(query(context.Background(), "account1"))
+	ctx := WithRecording(context.Background(), "test")
+	requireT.NoError(query(ctx, "account1"))
+	requireT.NoError(query(ctx, "account2"))
+	requireT.Error(query(ctx, "account3"))
+
+	recording := recorder.Recording("test")
+	requireT.Len(recording.Interactions, 3)
+	requireT.Equal(balanceMethod, recording.Interactions[0].Method)
+	requireT.Equal(InteractionKindQuery, recording.Interactions[0].Kind)
+	requireT.JSONEq(`{"balance":{"denom":"denom","amount":"100"}}`, string(recording.Interactions[0].ResponseJSON))
+	requireT.Equal("account not found", recording.Interactions[2].Error)
+
+	// the recording is saved and loaded
+	path, err := recorder.Save("test", t.TempDir())
+	requireT.NoError(err)
+	requireT.Empty(recorder.Recording("test").Interactions)
+	loadedRecording, err := LoadRecording(path)
+	requireT.NoError(err)
+	requireT.Equal(recording.Interactions[0].Response, loadedRecording.Interactions[0].Response)
+
+	// nothing differs if the node behaves the same
+	diffs, err := Replay(context.Background(), conn, loadedRecording)
+	requireT.NoError(err)
+	requireT.Empty(diffs)
+
+	// the changed responses are reported
+	conn.balances["account2"] = sdk.NewInt64Coin("denom", 300)
+	conn.balances["account3"] = sdk.NewInt64Coin("denom", 400)
+	diffs, err = Replay(context.Background(), conn, loadedRecording)
+	requireT.NoError(err)
+	requireT.Len(diffs, 2)
+	requireT.JSONEq(`{"balance":{"denom":"denom","amount":"200"}}`, string(diffs[0].RecordedResponse))
+	requireT.JSONEq(`{"balance":{"denom":"denom","amount":"300"}}`, string(diffs[0].ReplayedResponse))
+	requireT.Equal("account not found", diffs[1].RecordedError)
+	requireT.Empty(diffs[1].ReplayedError)
+}
@@ -0,0 +1,100 @@
+package simapp
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/pkg/errors"
+)
+
+// GovAuthority returns the address of the gov module used as the authority of the gov-gated messages.
+func (s *App) GovAuthority() string {
+	return s.GovKeeper.GetAuthority()
+}
+
+// ExecuteGovProposal submits the proposal containing the messages through the gov msg server and executes it
+// the same way the gov end blocker executes the passed proposal, but without waiting for the voting period.
+// The messages are executed atomically, if one of them fails, the state changes done by all of them are discarded,
+// the proposal is marked as failed and the error is returned. The proposal execution hooks are executed for the
+// passed proposal.
+func (s *App) ExecuteGovProposal(ctx sdk.Context, msgs ...sdk.Msg) error {
+	params, err := s.GovKeeper.Params.Get(ctx)
+	if err != nil {
+		return errors.Wrap(err, "can't get gov params")
+	}
+
+	proposer, _ := s.GenAccount(ctx)
+	deposit := sdk.NewCoins(params.MinDeposit...)
+	if err := s.FundAccount(ctx, proposer, deposit); err != nil {
+		return err
+	}
+
+	submitMsg, err := govv1.NewMsgSubmitProposal(
+		msgs, deposit, proposer.String(), "", "simapp proposal", "simapp proposal", false,
+	)
+	if err != nil {
+		return errors.Wrap(err, "can't create submit proposal message")
+	}
+	res, err := govkeeper.NewMsgServerImpl(&s.GovKeeper).SubmitProposal(ctx, submitMsg)
+	if err != nil {
+		return errors.Wrap(err, "can't submit proposal")
+	}
+
+	proposal, err := s.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+	if err != nil {
+		return errors.Wrapf(err, "can't get proposal %d", res.ProposalId)
+	}
+	if proposal.Status != govv1.StatusVotingPeriod {
+		return errors.Errorf("proposal %d is not in the voting period, status: %s", proposal.Id, proposal.Status)
+	}
+
+	if err := s.GovKeeper.RefundAndDeleteDeposits(ctx, proposal.Id); err != nil {
+		return errors.Wrapf(err, "can't refund deposits of proposal %d", proposal.Id)
+	}
+	if err := s.GovKeeper.ActiveProposalsQueue.Remove(
+		ctx, collections.Join(*proposal.VotingEndTime, proposal.Id),
+	); err != nil {
+		return errors.Wrapf(err, "can't remove proposal %d from the active queue", proposal.Id)
+	}
+
+	execErr := s.executeProposalMsgs(ctx, msgs)
+	if execErr == nil {
+		proposal.Status = govv1.StatusPassed
+	} else {
+		proposal.Status = govv1.StatusFailed
+		proposal.FailedReason = execErr.Error()
+	}
+	if err := s.GovKeeper.SetProposal(ctx, proposal); err != nil {
+		return errors.Wrapf(err, "can't set proposal %d", proposal.Id)
+	}
+
+	// as in the gov end blocker, the failure of the hooks doesn't affect the proposal
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := s.GovKeeper.Hooks().AfterProposalVotingPeriodEnded(cacheCtx, proposal.Id); err == nil {
+		writeCache()
+	}
+
+	return execErr
+}
+
+func (s *App) executeProposalMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	var events sdk.Events
+	for i, msg := range msgs {
+		handler := s.MsgServiceRouter().Handler(msg)
+		if handler == nil {
+			return errors.Errorf("no handler for message %d (%s)", i, sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return errors.Wrapf(err, "message %d (%s) failed on execution", i, sdk.MsgTypeURL(msg))
+		}
+		events = append(events, res.GetEvents()...)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package simapp_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+)
+
+func TestExecuteGovProposal(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	updateMsg := func(minSelfDelegation int64) *customparamstypes.MsgUpdateStakingParams {
+		return &customparamstypes.MsgUpdateStakingParams{
+			Authority: testApp.GovAuthority(),
+			StakingParams: customparamstypes.StakingParams{
+				MinSelfDelegation: sdkmath.NewInt(minSelfDelegation),
+			},
+		}
+	}
+	minSelfDelegation := func() sdkmath.Int {
+		params, err := testApp.CustomParamsKeeper.GetStakingParams(ctx)
+		requireT.NoError(err)
+		return params.MinSelfDelegation
+	}
+	lastProposal := func() govv1.Proposal {
+		var proposal govv1.Proposal
+		requireT.NoError(testApp.GovKeeper.Proposals.Walk(ctx, nil, func(_ uint64, p govv1.Proposal) (bool, error) {
+			proposal = p
+			return false, nil
+		}))
+		return proposal
+	}
+
+	// the passed proposal is executed
+	requireT.NoError(testApp.ExecuteGovProposal(ctx, updateMsg(10)))
+	requireT.Equal(sdkmath.NewInt(10).String(), minSelfDelegation().String())
+	proposal := lastProposal()
+	requireT.Equal(govv1.StatusPassed, proposal.Status)
+	deposits, err := testApp.GovKeeper.GetDeposits(ctx, proposal.Id)
+	requireT.NoError(err)
+	requireT.Empty(deposits)
+
+	// the messages of the failed proposal are discarded
+	failingMsg := &banktypes.MsgSend{
+		FromAddress: testApp.GovAuthority(),
+		ToAddress:   sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+	}
+	err = testApp.ExecuteGovProposal(ctx, updateMsg(20), failingMsg)
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.Equal(sdkmath.NewInt(10).String(), minSelfDelegation().String())
+	proposal = lastProposal()
+	requireT.Equal(govv1.StatusFailed, proposal.Status)
+	requireT.NotEmpty(proposal.FailedReason)
+
+	// the proposal can't be submitted if the message isn't signed by the gov module
+	invalidMsg := updateMsg(30)
+	invalidMsg.Authority = sdk.AccAddress("invalid").String()
+	requireT.Error(testApp.ExecuteGovProposal(ctx, invalidMsg))
+	requireT.Equal(sdkmath.NewInt(10).String(), minSelfDelegation().String())
+}
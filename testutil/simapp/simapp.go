@@ -59,9 +59,10 @@ var IgnoredModulesForExport = map[string]struct{}{
 
 // Settings for the simapp initialization.
 type Settings struct {
-	db        dbm.DB
-	logger    log.Logger
-	startTime time.Time
+	db         dbm.DB
+	logger     log.Logger
+	startTime  time.Time
+	appOptions map[string]any
 }
 
 var sdkConfigOnce = &sync.Once{}
@@ -85,6 +86,17 @@ func WithCustomLogger(logger log.Logger) Option {
 	}
 }
 
+// WithAppOption returns the simapp Option setting the app option, e.g. the one enabling the optional feature.
+func WithAppOption(key string, value any) Option {
+	return func(s Settings) Settings {
+		if s.appOptions == nil {
+			s.appOptions = map[string]any{}
+		}
+		s.appOptions[key] = value
+		return s
+	}
+}
+
 // WithStartTime returns the simapp Option to run with different start time.
 func WithStartTime(startTime time.Time) Option {
 	return func(s Settings) Settings {
@@ -120,7 +132,11 @@ func New(options ...Option) *App {
 		network.SetSDKConfig()
 	})
 
-	coreApp := app.New(settings.logger, settings.db, nil, true, simtestutil.NewAppOptionsWithFlagHome(tempDir()))
+	appOptions := simtestutil.AppOptionsMap{flags.FlagHome: tempDir()}
+	for key, value := range settings.appOptions {
+		appOptions[key] = value
+	}
+	coreApp := app.New(settings.logger, settings.db, nil, true, appOptions)
 	pubKey, err := cryptocodec.ToCmtPubKeyInterface(ed25519.GenPrivKey().PubKey())
 	if err != nil {
 		panic(fmt.Sprintf("can't generate validator pub key genesisState: %v", err))
@@ -0,0 +1,244 @@
+package simapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// upgradeBlockInterval is the time between the blocks produced by the upgrade dry run.
+const upgradeBlockInterval = 5 * time.Second
+
+// UpgradeSnapshot is the state compared before and after the upgrade.
+type UpgradeSnapshot struct {
+	// Params contains the params of the modules exported to genesis, the modules without params are skipped.
+	Params      map[string]json.RawMessage
+	Supply      sdk.Coins
+	PSESchedule []psetypes.ScheduledDistribution
+}
+
+// DryRunUpgrade executes the upgrade against the app initialized from the exported genesis. It finalizes the first
+// block, schedules the upgrade at the next height and executes it by finalizing the next block, the same way it
+// happens on the chain. The modules missing in the genesis are removed from the module version map, so they are
+// initialized by the upgrade as the new modules. It returns the snapshots taken before and after the upgrade.
+func (s *App) DryRunUpgrade(
+	name string,
+	initChainReq *abci.RequestInitChain,
+	appState map[string]json.RawMessage,
+) (UpgradeSnapshot, UpgradeSnapshot, error) {
+	if !s.UpgradeKeeper.HasHandler(name) {
+		return UpgradeSnapshot{}, UpgradeSnapshot{}, errors.Errorf("upgrade handler %s is not registered", name)
+	}
+
+	height := initChainReq.InitialHeight
+	if height == 0 {
+		height = 1
+	}
+	blockTime := initChainReq.Time
+	if err := s.finalizeAndCommitBlock(height, blockTime); err != nil {
+		return UpgradeSnapshot{}, UpgradeSnapshot{}, err
+	}
+
+	ctx := s.NewUncachedContext(false, cmtproto.Header{
+		ChainID: initChainReq.ChainId,
+		Height:  height + 1,
+		Time:    blockTime.Add(upgradeBlockInterval),
+	})
+	versionStore := runtime.NewKVStoreService(s.GetKey(upgradetypes.StoreKey)).OpenKVStore(ctx)
+	for _, moduleName := range s.ModuleManager.ModuleNames() {
+		if _, found := appState[moduleName]; found {
+			continue
+		}
+		if err := versionStore.Delete(append([]byte{upgradetypes.VersionMapByte}, moduleName...)); err != nil {
+			return UpgradeSnapshot{}, UpgradeSnapshot{}, err
+		}
+	}
+
+	before, err := s.TakeUpgradeSnapshot()
+	if err != nil {
+		return UpgradeSnapshot{}, UpgradeSnapshot{}, err
+	}
+
+	if err := s.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{
+		Name:   name,
+		Height: height + 1,
+	}); err != nil {
+		return UpgradeSnapshot{}, UpgradeSnapshot{}, errors.Wrapf(err, "failed to schedule upgrade %s", name)
+	}
+
+	if err := s.finalizeAndCommitBlock(height+1, blockTime.Add(upgradeBlockInterval)); err != nil {
+		return UpgradeSnapshot{}, UpgradeSnapshot{}, errors.Wrapf(err, "failed to execute upgrade %s", name)
+	}
+
+	doneHeight, err := s.UpgradeKeeper.GetDoneHeight(s.latestContext(), name)
+	if err != nil {
+		return UpgradeSnapshot{}, UpgradeSnapshot{}, err
+	}
+	if doneHeight != height+1 {
+		return UpgradeSnapshot{}, UpgradeSnapshot{}, errors.Errorf("upgrade %s hasn't been applied", name)
+	}
+
+	after, err := s.TakeUpgradeSnapshot()
+	if err != nil {
+		return UpgradeSnapshot{}, UpgradeSnapshot{}, err
+	}
+
+	return before, after, nil
+}
+
+// TakeUpgradeSnapshot returns the snapshot of the latest state. Only the modules stored in the module version map
+// are exported since the state of the modules not initialized yet is empty.
+func (s *App) TakeUpgradeSnapshot() (UpgradeSnapshot, error) {
+	ctx := s.latestContext()
+
+	versionMap, err := s.UpgradeKeeper.GetModuleVersionMap(ctx)
+	if err != nil {
+		return UpgradeSnapshot{}, err
+	}
+	var modules []string
+	for _, moduleName := range s.ModuleManager.ModuleNames() {
+		if _, ignored := IgnoredModulesForExport[moduleName]; ignored {
+			continue
+		}
+		if _, initialized := versionMap[moduleName]; initialized {
+			modules = append(modules, moduleName)
+		}
+	}
+	genesis, err := s.ModuleManager.ExportGenesisForModules(ctx, s.AppCodec(), modules)
+	if err != nil {
+		return UpgradeSnapshot{}, errors.Wrap(err, "failed to export genesis")
+	}
+
+	snapshot := UpgradeSnapshot{
+		Params: make(map[string]json.RawMessage),
+	}
+	for moduleName, moduleGenesis := range genesis {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(moduleGenesis, &fields); err != nil {
+			return UpgradeSnapshot{}, errors.Wrapf(err, "failed to unmarshal %s genesis", moduleName)
+		}
+		params, found := fields["params"]
+		if !found {
+			continue
+		}
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, params); err != nil {
+			return UpgradeSnapshot{}, errors.Wrapf(err, "failed to compact %s params", moduleName)
+		}
+		snapshot.Params[moduleName] = compacted.Bytes()
+	}
+
+	s.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		snapshot.Supply = snapshot.Supply.Add(coin)
+		return false
+	})
+
+	snapshot.PSESchedule, err = s.PSEKeeper.GetDistributionSchedule(ctx)
+	if err != nil {
+		return UpgradeSnapshot{}, errors.Wrap(err, "failed to get pse distribution schedule")
+	}
+
+	return snapshot, nil
+}
+
+// DiffUpgradeSnapshots returns the human-readable differences between the snapshots.
+func DiffUpgradeSnapshots(before, after UpgradeSnapshot) []string {
+	var diff []string
+
+	for _, moduleName := range sortedKeys(before.Params, after.Params) {
+		beforeParams, afterParams := before.Params[moduleName], after.Params[moduleName]
+		if !bytes.Equal(beforeParams, afterParams) {
+			diff = append(diff, fmt.Sprintf(
+				"params %s: %s -> %s", moduleName, valueOrNone(beforeParams), valueOrNone(afterParams),
+			))
+		}
+	}
+
+	denoms := make(map[string]struct{})
+	for _, coin := range before.Supply.Add(after.Supply...) {
+		denoms[coin.Denom] = struct{}{}
+	}
+	for _, denom := range sortedKeys(denoms) {
+		beforeAmount, afterAmount := before.Supply.AmountOf(denom), after.Supply.AmountOf(denom)
+		if !beforeAmount.Equal(afterAmount) {
+			diff = append(diff, fmt.Sprintf("supply %s: %s -> %s", denom, beforeAmount, afterAmount))
+		}
+	}
+
+	beforeSchedule := scheduleByTimestamp(before.PSESchedule)
+	afterSchedule := scheduleByTimestamp(after.PSESchedule)
+	for _, timestamp := range sortedKeys(beforeSchedule, afterSchedule) {
+		beforeDistribution, afterDistribution := beforeSchedule[timestamp], afterSchedule[timestamp]
+		if beforeDistribution != afterDistribution {
+			diff = append(diff, fmt.Sprintf(
+				"pse schedule %s: %s -> %s",
+				timestamp, valueOrNone([]byte(beforeDistribution)), valueOrNone([]byte(afterDistribution)),
+			))
+		}
+	}
+
+	return diff
+}
+
+func (s *App) finalizeAndCommitBlock(height int64, blockTime time.Time) error {
+	if _, err := s.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: height,
+		Time:   blockTime,
+	}); err != nil {
+		return err
+	}
+	_, err := s.Commit()
+	return err
+}
+
+func (s *App) latestContext() sdk.Context {
+	return s.NewUncachedContext(false, cmtproto.Header{Height: s.LastBlockHeight()})
+}
+
+// scheduleByTimestamp returns the allocations of the distributions keyed by the formatted timestamps.
+func scheduleByTimestamp(schedule []psetypes.ScheduledDistribution) map[string]string {
+	distributions := make(map[string]string, len(schedule))
+	for _, distribution := range schedule {
+		allocations := make([]string, 0, len(distribution.Allocations))
+		for _, allocation := range distribution.Allocations {
+			allocations = append(allocations, fmt.Sprintf("%s=%s", allocation.ClearingAccount, allocation.Amount))
+		}
+		sort.Strings(allocations)
+		timestamp := time.Unix(int64(distribution.Timestamp), 0).UTC().Format(time.RFC3339)
+		distributions[timestamp] = fmt.Sprintf("%v", allocations)
+	}
+	return distributions
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	keys := make(map[string]struct{})
+	for _, m := range maps {
+		for key := range m {
+			keys[key] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func valueOrNone(value []byte) string {
+	if len(value) == 0 {
+		return "<none>"
+	}
+	return string(value)
+}
@@ -0,0 +1,164 @@
+package simapp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+
+	appupgradev7 "github.com/tokenize-x/tx-chain/v7/app/upgrade/v7"
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	assetnftmarkettypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+	auctiontypes "github.com/tokenize-x/tx-chain/v7/x/auction/types"
+	autocompoundtypes "github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+	bridgetypes "github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+	epochstypes "github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+	feereferraltypes "github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+	labeltypes "github.com/tokenize-x/tx-chain/v7/x/label/types"
+	metatxtypes "github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+	treasurytypes "github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+func TestDryRunUpgrade(t *testing.T) {
+	requireT := require.New(t)
+
+	upgradedApp, initChainReq, genesisAppState := newUpgradedApp(requireT, nil)
+	before, after, err := upgradedApp.DryRunUpgrade(appupgradev7.Name, initChainReq, genesisAppState)
+	requireT.NoError(err)
+
+	requireT.NotContains(before.Params, bridgetypes.ModuleName)
+	requireT.Contains(after.Params, bridgetypes.ModuleName)
+	requireT.NotContains(before.Params, auctiontypes.ModuleName)
+	requireT.Contains(after.Params, auctiontypes.ModuleName)
+	requireT.NotContains(before.Params, metatxtypes.ModuleName)
+	requireT.Contains(after.Params, metatxtypes.ModuleName)
+	requireT.NotContains(before.Params, autocompoundtypes.ModuleName)
+	requireT.Contains(after.Params, autocompoundtypes.ModuleName)
+	requireT.NotContains(before.Params, feereferraltypes.ModuleName)
+	requireT.Contains(after.Params, feereferraltypes.ModuleName)
+	requireT.Equal(before.Supply, after.Supply)
+
+	diff := simapp.DiffUpgradeSnapshots(before, after)
+	requireT.Len(diff, 5)
+	requireT.Contains(diff[0], "params auction: <none> -> ")
+	requireT.Contains(diff[1], "params autocompound: <none> -> ")
+	requireT.Contains(diff[2], "params bridge: <none> -> ")
+	requireT.Contains(diff[3], "params feereferral: <none> -> ")
+	requireT.Contains(diff[4], "params metatx: <none> -> ")
+
+	_, _, err = upgradedApp.DryRunUpgrade("unknown", initChainReq, genesisAppState)
+	requireT.ErrorContains(err, "upgrade handler unknown is not registered")
+}
+
+func TestDryRunUpgrade_PSEMinDelegationAmount(t *testing.T) {
+	testCases := []struct {
+		name           string
+		displayUnit    *banktypes.DenomUnit
+		expectedAmount sdkmath.Int
+	}{
+		{
+			name:           "no_metadata",
+			expectedAmount: psetypes.DefaultParams().MinDelegationAmount,
+		},
+		{
+			name:           "no_display_unit",
+			displayUnit:    &banktypes.DenomUnit{Denom: "other", Exponent: 6},
+			expectedAmount: psetypes.DefaultParams().MinDelegationAmount,
+		},
+		{
+			name:           "display_unit",
+			displayUnit:    &banktypes.DenomUnit{Denom: "display", Exponent: 6},
+			expectedAmount: sdkmath.NewInt(1_000_000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+
+			var setMetadata func(bondDenom string, bankGenesis *banktypes.GenesisState)
+			if tc.displayUnit != nil {
+				setMetadata = func(bondDenom string, bankGenesis *banktypes.GenesisState) {
+					bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, banktypes.Metadata{
+						Name:    bondDenom,
+						Symbol:  bondDenom,
+						Base:    bondDenom,
+						Display: "display",
+						DenomUnits: []*banktypes.DenomUnit{
+							{Denom: bondDenom, Exponent: 0},
+							tc.displayUnit,
+						},
+					})
+				}
+			}
+
+			upgradedApp, initChainReq, genesisAppState := newUpgradedApp(requireT, setMetadata)
+			_, _, err := upgradedApp.DryRunUpgrade(appupgradev7.Name, initChainReq, genesisAppState)
+			requireT.NoError(err)
+
+			params, err := upgradedApp.PSEKeeper.GetParams(upgradedApp.NewUncachedContext(false, cmtproto.Header{}))
+			requireT.NoError(err)
+			requireT.Equal(tc.expectedAmount.String(), params.MinDelegationAmount.String())
+		})
+	}
+}
+
+// newUpgradedApp returns the app initialized from the genesis exported by the app of the previous version. The bank
+// genesis is modified by setBankGenesis if it is set.
+func newUpgradedApp(
+	requireT *require.Assertions,
+	setBankGenesis func(bondDenom string, bankGenesis *banktypes.GenesisState),
+) (simapp.App, *abci.RequestInitChain, map[string]json.RawMessage) {
+	sourceApp := simapp.New()
+	requireT.NoError(sourceApp.FinalizeBlock())
+	_, err := sourceApp.Commit()
+	requireT.NoError(err)
+
+	exported, err := sourceApp.ExportAppStateAndValidators(false, nil, nil)
+	requireT.NoError(err)
+
+	// the modules added by the upgrade are missing in the exported genesis of the previous version
+	var appState map[string]json.RawMessage
+	requireT.NoError(json.Unmarshal(exported.AppState, &appState))
+	delete(appState, bridgetypes.ModuleName)
+	delete(appState, auctiontypes.ModuleName)
+	delete(appState, labeltypes.ModuleName)
+	delete(appState, assetnftmarkettypes.ModuleName)
+	delete(appState, metatxtypes.ModuleName)
+	delete(appState, autocompoundtypes.ModuleName)
+	delete(appState, epochstypes.ModuleName)
+	delete(appState, feereferraltypes.ModuleName)
+	delete(appState, treasurytypes.ModuleName)
+
+	if setBankGenesis != nil {
+		bondDenom, err := sourceApp.StakingKeeper.BondDenom(sourceApp.NewContext(false))
+		requireT.NoError(err)
+		var bankGenesis banktypes.GenesisState
+		sourceApp.AppCodec().MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis)
+		setBankGenesis(bondDenom, &bankGenesis)
+		appState[banktypes.ModuleName] = sourceApp.AppCodec().MustMarshalJSON(&bankGenesis)
+	}
+
+	appStateBytes, err := json.Marshal(appState)
+	requireT.NoError(err)
+
+	genesis := genutiltypes.NewAppGenesisWithVersion(string(constant.ChainIDDev), appStateBytes)
+	genesis.InitialHeight = exported.Height + 1
+	genesis.Consensus = &genutiltypes.ConsensusGenesis{
+		Validators: exported.Validators,
+		Params:     cmttypes.DefaultConsensusParams(),
+	}
+	genesisBytes, err := json.Marshal(genesis)
+	requireT.NoError(err)
+
+	upgradedApp, _, genesisAppState, initChainReq, _ := simapp.NewWithGenesis(genesisBytes)
+	return upgradedApp, initChainReq, genesisAppState
+}
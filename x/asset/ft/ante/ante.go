@@ -0,0 +1,100 @@
+package ante
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// Keeper interface exposes methods required by ante handler decorator of fungible tokens.
+type Keeper interface {
+	ValidateSymbolReservation(ctx sdk.Context, issuer sdk.AccAddress, symbol, subunit string) error
+	RecordIssuerActivity(ctx sdk.Context, signer sdk.AccAddress) error
+}
+
+// IssueDecorator refuses transactions issuing the same symbol more than once or issuing the symbol reserved
+// by another issuer. Such transactions would fail during the execution anyway, but rejecting them early keeps
+// them out of the mempool, so they can't be used to frontrun the issuance.
+type IssueDecorator struct {
+	keeper Keeper
+}
+
+// NewIssueDecorator creates ante decorator refusing transactions with conflicting issuances.
+func NewIssueDecorator(keeper Keeper) IssueDecorator {
+	return IssueDecorator{
+		keeper: keeper,
+	}
+}
+
+// AnteHandle handles transaction in ante decorator.
+func (id IssueDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	symbols := map[string]struct{}{}
+	for _, msg := range tx.GetMsgs() {
+		var issueMsg *types.MsgIssue
+		switch typedMsg := msg.(type) {
+		case *types.MsgIssue:
+			issueMsg = typedMsg
+		case *types.MsgIssueEscrowed:
+			issueMsg = &typedMsg.Issue
+		default:
+			continue
+		}
+
+		symbol := types.NormalizeSymbolForKey(issueMsg.Symbol)
+		if _, exists := symbols[symbol]; exists {
+			return ctx, sdkerrors.Wrapf(cosmoserrors.ErrInvalidRequest, "symbol %s is issued twice", issueMsg.Symbol)
+		}
+		symbols[symbol] = struct{}{}
+
+		issuer, err := sdk.AccAddressFromBech32(issueMsg.Issuer)
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid issuer %s", issueMsg.Issuer)
+		}
+		if err := id.keeper.ValidateSymbolReservation(ctx, issuer, issueMsg.Symbol, issueMsg.Subunit); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IssuerActivityDecorator records the transactions signed by the issuers who designated the successor, so the
+// successor can't claim the admin rights while the issuer is active. The messages executed on behalf of the issuer
+// inside the authz MsgExec and the meta-transactions are recorded by the post handler once they are executed.
+type IssuerActivityDecorator struct {
+	keeper Keeper
+}
+
+// NewIssuerActivityDecorator creates ante decorator recording the activity of the issuers.
+func NewIssuerActivityDecorator(keeper Keeper) IssuerActivityDecorator {
+	return IssuerActivityDecorator{
+		keeper: keeper,
+	}
+}
+
+// AnteHandle handles transaction in ante decorator.
+func (iad IssuerActivityDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(cosmoserrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	for _, signer := range signers {
+		if err := iad.keeper.RecordIssuerActivity(ctx, signer); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,245 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/proposal"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// CmdDraftParamsProposal returns the command drafting the MsgUpdateParams proposal.
+func CmdDraftParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft-assetft-params",
+		Args:  cobra.NoArgs,
+		Short: fmt.Sprintf("Draft the proposal updating the %s parameters", types.ModuleName),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Draft the proposal updating the %s parameters.
+The command prompts for every parameter, offering the current value as the default, validates the parameters and
+writes the proposal ready to be submitted with the "tx gov submit-proposal" command.
+
+Example:
+$ %s tx gov draft-assetft-params --%s=params-proposal.json
+`,
+				types.ModuleName, version.AppName, proposal.FlagProposalFile,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return errors.Wrap(err, "failed to query current params")
+			}
+
+			inBuf := bufio.NewReader(clientCtx.Input)
+			params, err := promptParams(inBuf, res.Params)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateParams{
+				Authority: proposal.Authority(),
+				Params:    params,
+			}
+
+			return proposal.Draft(cmd, inBuf, clientCtx.Codec, msg)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	proposal.AddFlags(cmd)
+
+	return cmd
+}
+
+func promptParams(inBuf *bufio.Reader, params types.Params) (types.Params, error) {
+	var err error
+	if params.IssueFee, err = promptCoin(inBuf, "issue fee", params.IssueFee); err != nil {
+		return types.Params{}, err
+	}
+	if params.TokenUpgradeDecisionTimeout, err = promptTime(
+		inBuf, "token upgrade decision timeout", params.TokenUpgradeDecisionTimeout,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.TokenUpgradeGracePeriod, err = promptDuration(
+		inBuf, "token upgrade grace period", params.TokenUpgradeGracePeriod,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.SymbolClaimDeposit, err = promptCoin(inBuf, "symbol claim deposit", params.SymbolClaimDeposit); err != nil {
+		return types.Params{}, err
+	}
+	if params.ReferralFeeRatio, err = promptDec(inBuf, "referral fee ratio", params.ReferralFeeRatio); err != nil {
+		return types.Params{}, err
+	}
+	if params.SymbolReservationDeposit, err = promptCoin(
+		inBuf, "symbol reservation deposit", params.SymbolReservationDeposit,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.SymbolReservationPeriod, err = promptDuration(
+		inBuf, "symbol reservation period", params.SymbolReservationPeriod,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.SendRateLimitChangeDelay, err = promptDuration(
+		inBuf, "send rate limit change delay", params.SendRateLimitChangeDelay,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.FeatureUpdateDelay, err = promptDuration(
+		inBuf, "feature update delay", params.FeatureUpdateDelay,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.MaxPinnedExtensionCodes, err = promptUint32(
+		inBuf, "max pinned extension codes", params.MaxPinnedExtensionCodes,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.CommissionBuybackRatio, err = promptDec(
+		inBuf, "commission buyback ratio", params.CommissionBuybackRatio,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.BuybackInterval, err = promptDuration(inBuf, "buyback interval", params.BuybackInterval); err != nil {
+		return types.Params{}, err
+	}
+	if params.BuybackDestination, err = promptBuybackDestination(
+		inBuf, "buyback destination", params.BuybackDestination,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.LegalHoldAuthority, err = proposal.PromptString(
+		inBuf, "Enter legal hold authority", params.LegalHoldAuthority,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.ObserverGasLimit, err = promptUint64(inBuf, "observer gas limit", params.ObserverGasLimit); err != nil {
+		return types.Params{}, err
+	}
+	if params.MinRateChangeNotice, err = promptDuration(
+		inBuf, "min rate change notice", params.MinRateChangeNotice,
+	); err != nil {
+		return types.Params{}, err
+	}
+	if params.MinIssuerRecoveryInactivityPeriod, err = promptDuration(
+		inBuf, "min issuer recovery inactivity period", params.MinIssuerRecoveryInactivityPeriod,
+	); err != nil {
+		return types.Params{}, err
+	}
+
+	return params, nil
+}
+
+func promptCoin(inBuf *bufio.Reader, name string, current sdk.Coin) (sdk.Coin, error) {
+	value, err := proposal.PromptString(inBuf, "Enter "+name, current.String())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	coin, err := sdk.ParseCoinNormalized(value)
+	if err != nil {
+		return sdk.Coin{}, errors.Wrapf(err, "invalid %s %q", name, value)
+	}
+
+	return coin, nil
+}
+
+func promptTime(inBuf *bufio.Reader, name string, current time.Time) (time.Time, error) {
+	value, err := proposal.PromptString(inBuf, "Enter "+name+" (RFC3339)", current.UTC().Format(time.RFC3339))
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid %s %q", name, value)
+	}
+
+	return t.UTC(), nil
+}
+
+func promptDuration(inBuf *bufio.Reader, name string, current time.Duration) (time.Duration, error) {
+	value, err := proposal.PromptString(inBuf, "Enter "+name, current.String())
+	if err != nil {
+		return 0, err
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s %q", name, value)
+	}
+
+	return d, nil
+}
+
+func promptDec(inBuf *bufio.Reader, name string, current sdkmath.LegacyDec) (sdkmath.LegacyDec, error) {
+	value, err := proposal.PromptString(inBuf, "Enter "+name, current.String())
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	dec, err := sdkmath.LegacyNewDecFromStr(value)
+	if err != nil {
+		return sdkmath.LegacyDec{}, errors.Wrapf(err, "invalid %s %q", name, value)
+	}
+
+	return dec, nil
+}
+
+func promptUint32(inBuf *bufio.Reader, name string, current uint32) (uint32, error) {
+	value, err := proposal.PromptString(inBuf, "Enter "+name, strconv.FormatUint(uint64(current), 10))
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s %q", name, value)
+	}
+
+	return uint32(v), nil
+}
+
+func promptUint64(inBuf *bufio.Reader, name string, current uint64) (uint64, error) {
+	value, err := proposal.PromptString(inBuf, "Enter "+name, strconv.FormatUint(current, 10))
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s %q", name, value)
+	}
+
+	return v, nil
+}
+
+func promptBuybackDestination(
+	inBuf *bufio.Reader,
+	name string,
+	current types.BuybackDestination,
+) (types.BuybackDestination, error) {
+	value, err := proposal.PromptString(inBuf, "Enter "+name, current.String())
+	if err != nil {
+		return 0, err
+	}
+	destination, ok := types.BuybackDestination_value[value]
+	if !ok {
+		return 0, errors.Errorf("invalid %s %q", name, value)
+	}
+
+	return types.BuybackDestination(destination), nil
+}
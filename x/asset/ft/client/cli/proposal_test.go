@@ -0,0 +1,73 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/proposal"
+	txchainclitestutil "github.com/tokenize-x/tx-chain/v7/testutil/cli"
+	"github.com/tokenize-x/tx-chain/v7/testutil/network"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/client/cli"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestDraftParamsProposal(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	var paramsRes types.QueryParamsResponse
+	txchainclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryParams(), []string{}, &paramsRes)
+
+	// the issue fee and the symbol reservation period are changed, the rest is kept
+	issueFee := sdk.NewInt64Coin(paramsRes.Params.IssueFee.Denom, 123)
+	lines := []string{
+		issueFee.String(), "", "", "", "", "", "72h", "", "", "", "", "", "", "", "", "", "",
+		"Update assetft params", "Cheaper issuance", "", "1000udevcore", "y",
+	}
+
+	path := filepath.Join(t.TempDir(), "proposal.json")
+	inputCtx := ctx.WithInput(strings.NewReader(strings.Join(lines, "\n") + "\n"))
+	_, err := clitestutil.ExecTestCLICmd(
+		inputCtx, cli.CmdDraftParamsProposal(), []string{fmt.Sprintf("--%s=%s", proposal.FlagProposalFile, path)},
+	)
+	requireT.NoError(err)
+
+	raw, err := os.ReadFile(path)
+	requireT.NoError(err)
+	var draft proposal.Proposal
+	requireT.NoError(json.Unmarshal(raw, &draft))
+	requireT.True(draft.Expedited)
+	requireT.Len(draft.Messages, 1)
+
+	var msg sdk.Msg
+	requireT.NoError(ctx.Codec.UnmarshalInterfaceJSON(draft.Messages[0], &msg))
+	paramsMsg, ok := msg.(*types.MsgUpdateParams)
+	requireT.True(ok)
+
+	expectedParams := paramsRes.Params
+	expectedParams.IssueFee = issueFee
+	expectedParams.SymbolReservationPeriod = 72 * time.Hour
+	requireT.Equal(expectedParams.String(), paramsMsg.Params.String())
+
+	// negative referral fee ratio is rejected
+	lines = []string{
+		"", "", "", "", "-0.1", "", "", "", "", "", "", "", "", "", "", "", "",
+		"Title", "Summary", "", "1000udevcore", "n",
+	}
+	inputCtx = ctx.WithInput(strings.NewReader(strings.Join(lines, "\n") + "\n"))
+	_, err = clitestutil.ExecTestCLICmd(inputCtx, cli.CmdDraftParamsProposal(), []string{
+		fmt.Sprintf("--%s=%s", proposal.FlagProposalFile, filepath.Join(t.TempDir(), "invalid.json")),
+	})
+	requireT.Error(err)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
@@ -25,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdQueryToken())
 	cmd.AddCommand(CmdQueryTokens())
+	cmd.AddCommand(CmdQueryTokensByDenoms())
 	cmd.AddCommand(CmdTokenUpgradeStatuses())
 	cmd.AddCommand(CmdQueryBalance())
 	cmd.AddCommand(CmdQueryFrozenBalance())
@@ -33,6 +35,32 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryWhitelistedBalances())
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryDEXSettings())
+	cmd.AddCommand(CmdQueryVerifiedSymbols())
+	cmd.AddCommand(CmdQueryVerifiedSymbol())
+	cmd.AddCommand(CmdQuerySymbolClaims())
+	cmd.AddCommand(CmdQueryReferrerStats())
+	cmd.AddCommand(CmdQuerySymbolReservation())
+	cmd.AddCommand(CmdQueryMintAllowance())
+	cmd.AddCommand(CmdQueryResolveDenom())
+	cmd.AddCommand(CmdQueryIssuePresets())
+	cmd.AddCommand(CmdQueryIssuePreset())
+	cmd.AddCommand(CmdQueryDustPolicy())
+	cmd.AddCommand(CmdQueryDustOptOut())
+	cmd.AddCommand(CmdQueryIssuanceEscrow())
+	cmd.AddCommand(CmdQuerySendRateLimits())
+	cmd.AddCommand(CmdQuerySendRateLimitHeadroom())
+	cmd.AddCommand(CmdQueryPendingFeatureUpdate())
+	cmd.AddCommand(CmdQueryBuybackStats())
+	cmd.AddCommand(CmdQuerySupplyBreakdown())
+	cmd.AddCommand(CmdQueryConvertAmount())
+	cmd.AddCommand(CmdQueryLegalHolds())
+	cmd.AddCommand(CmdQueryLegalHold())
+	cmd.AddCommand(CmdQueryFreezeExemptions())
+	cmd.AddCommand(CmdQueryRoles())
+	cmd.AddCommand(CmdQueryAccountRoles())
+	cmd.AddCommand(CmdQueryRedenomination())
+	cmd.AddCommand(CmdQueryRedenominations())
+	cmd.AddCommand(CmdQueryIssuerRecovery())
 
 	return cmd
 }
@@ -118,6 +146,41 @@ $ %[1]s query %s token [denom]
 	return cmd
 }
 
+// CmdQueryTokensByDenoms returns the QueryTokensByDenoms cobra command.
+func CmdQueryTokensByDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokens-by-denoms [denom1] [denom2] ...",
+		Args:  cobra.RangeArgs(1, types.MaxTokensQueryLimit),
+		Short: "Query fungible tokens by denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible token details by the list of denoms.
+
+Example:
+$ %[1]s query %s tokens-by-denoms [denom1] [denom2]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokensByDenoms(cmd.Context(), &types.QueryTokensByDenomsRequest{
+				Denoms: args,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdTokenUpgradeStatuses returns the CmdTokenUpgradeStatuses cobra command.
 func CmdTokenUpgradeStatuses() *cobra.Command {
 	cmd := &cobra.Command{
@@ -430,3 +493,987 @@ $ %[1]s query %s dex-settings [denom]
 
 	return cmd
 }
+
+// CmdQueryVerifiedSymbols returns the QueryVerifiedSymbols cobra command.
+func CmdQueryVerifiedSymbols() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verified-symbols",
+		Args:  cobra.NoArgs,
+		Short: "Query verified symbols",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query symbols verified by the governance.
+
+Example:
+$ %[1]s query %s verified-symbols
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VerifiedSymbols(cmd.Context(), &types.QueryVerifiedSymbolsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "verified-symbols")
+
+	return cmd
+}
+
+// CmdQueryVerifiedSymbol returns the QueryVerifiedSymbol cobra command.
+func CmdQueryVerifiedSymbol() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verified-symbol [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query verified symbol",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the denom verified by the governance for the symbol.
+
+Example:
+$ %[1]s query %s verified-symbol [symbol]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			symbol := args[0]
+			res, err := queryClient.VerifiedSymbol(cmd.Context(), &types.QueryVerifiedSymbolRequest{
+				Symbol: symbol,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySymbolClaims returns the QuerySymbolClaims cobra command.
+func CmdQuerySymbolClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbol-claims",
+		Args:  cobra.NoArgs,
+		Short: "Query pending symbol claims",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query symbol claims waiting for the governance decision.
+
+Example:
+$ %[1]s query %s symbol-claims
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SymbolClaims(cmd.Context(), &types.QuerySymbolClaimsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "symbol-claims")
+
+	return cmd
+}
+
+// CmdQueryReferrerStats returns the QueryReferrerStats cobra command.
+func CmdQueryReferrerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referrer-stats [referrer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query referrer statistics",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of referred issuances and the issue fees earned by the referrer.
+
+Example:
+$ %[1]s query %s referrer-stats [referrer]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			referrer := args[0]
+			res, err := queryClient.ReferrerStats(cmd.Context(), &types.QueryReferrerStatsRequest{
+				Referrer: referrer,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySymbolReservation returns the QuerySymbolReservation cobra command.
+func CmdQuerySymbolReservation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbol-reservation [symbol]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query symbol reservation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the active reservation of the symbol.
+
+Example:
+$ %[1]s query %s symbol-reservation [symbol]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			symbol := args[0]
+			res, err := queryClient.SymbolReservation(cmd.Context(), &types.QuerySymbolReservationRequest{
+				Symbol: symbol,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryMintAllowance returns the QueryMintAllowance cobra command.
+func CmdQueryMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-allowance [grantee] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query mint allowance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the mint allowance of the grantee together with the amount remaining in the current period.
+
+Example:
+$ %[1]s query %s mint-allowance [grantee] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintAllowance(cmd.Context(), &types.QueryMintAllowanceRequest{
+				Grantee: args[0],
+				Denom:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryResolveDenom returns the QueryResolveDenom cobra command.
+func CmdQueryResolveDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-denom [ibc-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Resolve the IBC denom to its trace, origin chain and token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resolve the IBC denom to its trace and the chains it comes from. If the base denom is the token issued
+by the module, the token is returned as well.
+
+Example:
+$ %[1]s query %s resolve-denom ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ResolveDenom(cmd.Context(), &types.QueryResolveDenomRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryIssuePresets returns the QueryIssuePresets cobra command.
+func CmdQueryIssuePresets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-presets",
+		Args:  cobra.NoArgs,
+		Short: "Query issue presets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query issue presets curated by the governance.
+
+Example:
+$ %[1]s query %s issue-presets
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.IssuePresets(cmd.Context(), &types.QueryIssuePresetsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "issue-presets")
+
+	return cmd
+}
+
+// CmdQueryIssuePreset returns the QueryIssuePreset cobra command.
+func CmdQueryIssuePreset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-preset [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query issue preset",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the issue preset curated by the governance.
+
+Example:
+$ %[1]s query %s issue-preset security-token-v1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IssuePreset(cmd.Context(), &types.QueryIssuePresetRequest{
+				Name: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDustPolicy returns the QueryDustPolicy cobra command.
+func CmdQueryDustPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dust-policy [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query dust policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the dust policy of the token.
+
+Example:
+$ %[1]s query %s dust-policy [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DustPolicy(cmd.Context(), &types.QueryDustPolicyRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDustOptOut returns the QueryDustOptOut cobra command.
+func CmdQueryDustOptOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dust-opt-out [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query dust opt-out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the account is excluded from the dust sweeping of the token.
+
+Example:
+$ %[1]s query %s dust-opt-out [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DustOptOut(cmd.Context(), &types.QueryDustOptOutRequest{
+				Account: args[0],
+				Denom:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryIssuanceEscrow returns the QueryIssuanceEscrow cobra command.
+func CmdQueryIssuanceEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuance-escrow [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query issuance escrow",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the escrow holding the initial supply of the token until the buyer pays for it.
+
+Example:
+$ %[1]s query %s issuance-escrow [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IssuanceEscrow(cmd.Context(), &types.QueryIssuanceEscrowRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySendRateLimits returns the QuerySendRateLimits cobra command.
+func CmdQuerySendRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-rate-limits [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query send rate limits",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the send rate limits configured by the account.
+
+Example:
+$ %[1]s query %s send-rate-limits [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SendRateLimits(cmd.Context(), &types.QuerySendRateLimitsRequest{
+				Account:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send rate limits")
+
+	return cmd
+}
+
+// CmdQuerySendRateLimitHeadroom returns the QuerySendRateLimitHeadroom cobra command.
+func CmdQuerySendRateLimitHeadroom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-rate-limit-headroom [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query send rate limit headroom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of the denom the account may still send within the current send rate limit window.
+
+Example:
+$ %[1]s query %s send-rate-limit-headroom [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SendRateLimitHeadroom(cmd.Context(), &types.QuerySendRateLimitHeadroomRequest{
+				Account: args[0],
+				Denom:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryPendingFeatureUpdate returns the QueryPendingFeatureUpdate cobra command.
+func CmdQueryPendingFeatureUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-feature-update [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query pending feature update",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the update of the token features announced by the admin and waiting for the delay to pass.
+
+Example:
+$ %[1]s query %s pending-feature-update [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingFeatureUpdate(cmd.Context(), &types.QueryPendingFeatureUpdateRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryBuybackStats returns the QueryBuybackStats cobra command.
+func CmdQueryBuybackStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buyback-stats",
+		Args:  cobra.NoArgs,
+		Short: "Query buyback stats",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the running totals of the send commissions burnt or transferred to the community pool by the buyback.
+
+Example:
+$ %[1]s query %s buyback-stats
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BuybackStats(cmd.Context(), &types.QueryBuybackStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySupplyBreakdown returns the QuerySupplyBreakdown cobra command.
+func CmdQuerySupplyBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-breakdown [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query supply breakdown of the token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative amounts of the token minted and burnt by the holders, the burn rate, the admin and the buyback, together with the current supply.
+
+Example:
+$ %[1]s query %s supply-breakdown [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SupplyBreakdown(cmd.Context(), &types.QuerySupplyBreakdownRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryConvertAmount return ConvertAmount cobra command.
+func CmdQueryConvertAmount() *cobra.Command {
+	directions := map[string]types.ConversionDirection{
+		"to-display": types.CONVERSION_DIRECTION_TO_DISPLAY,
+		"to-subunit": types.CONVERSION_DIRECTION_TO_SUBUNIT,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "convert-amount [denom] [amount] [to-display|to-subunit]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Convert the amount of the token between the subunit and the display unit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the amount of the token between the subunit and the display unit using the precision of the token stored on chain.
+
+Example:
+$ %[1]s query %[2]s convert-amount [denom] 1500000 to-display
+$ %[1]s query %[2]s convert-amount [denom] 1.5 to-subunit
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			direction, ok := directions[args[2]]
+			if !ok {
+				return errors.Errorf("invalid conversion direction %s, expected to-display or to-subunit", args[2])
+			}
+
+			res, err := queryClient.ConvertAmount(cmd.Context(), &types.QueryConvertAmountRequest{
+				Denom:     args[0],
+				Amount:    args[1],
+				Direction: direction,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryLegalHolds returns the QueryLegalHolds cobra command.
+func CmdQueryLegalHolds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "legal-holds [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query legal holds",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the legal holds placed on the account.
+
+Example:
+$ %[1]s query %s legal-holds [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LegalHolds(cmd.Context(), &types.QueryLegalHoldsRequest{
+				Account:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "legal holds")
+
+	return cmd
+}
+
+// CmdQueryLegalHold returns the QueryLegalHold cobra command.
+func CmdQueryLegalHold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "legal-hold [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query legal hold",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the legal hold of the denom placed on the account together with the approvals of its release.
+
+Example:
+$ %[1]s query %s legal-hold [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LegalHold(cmd.Context(), &types.QueryLegalHoldRequest{
+				Account: args[0],
+				Denom:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFreezeExemptions returns the QueryFreezeExemptions cobra command.
+func CmdQueryFreezeExemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-exemptions [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query freeze exemptions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accounts exempted from the global freeze of the token, including the expired
+exemptions not removed yet.
+
+Example:
+$ %[1]s query %s freeze-exemptions [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FreezeExemptions(cmd.Context(), &types.QueryFreezeExemptionsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "freeze exemptions")
+
+	return cmd
+}
+
+// CmdQueryRoles returns the QueryRoles cobra command.
+func CmdQueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query roles",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the roles of the token assigned to the accounts.
+
+Example:
+$ %[1]s query %s roles [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Roles(cmd.Context(), &types.QueryRolesRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "roles")
+
+	return cmd
+}
+
+// CmdQueryAccountRoles returns the QueryAccountRoles cobra command.
+func CmdQueryAccountRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-roles [denom] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query account roles",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the roles of the token assigned to the account.
+
+Example:
+$ %[1]s query %s account-roles [denom] [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountRoles(cmd.Context(), &types.QueryAccountRolesRequest{
+				Denom:   args[0],
+				Account: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRedenomination returns the QueryRedenomination cobra command.
+func CmdQueryRedenomination() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redenomination [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query redenomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redenomination of the token together with the amount of the old token not swapped yet.
+
+Example:
+$ %[1]s query %s redenomination [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Redenomination(cmd.Context(), &types.QueryRedenominationRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRedenominations returns the QueryRedenominations cobra command.
+func CmdQueryRedenominations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redenominations",
+		Args:  cobra.NoArgs,
+		Short: "Query redenominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redenominations of the tokens.
+
+Example:
+$ %[1]s query %s redenominations
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Redenominations(cmd.Context(), &types.QueryRedenominationsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redenominations")
+
+	return cmd
+}
+
+// CmdQueryIssuerRecovery returns the QueryIssuerRecovery cobra command.
+func CmdQueryIssuerRecovery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuer-recovery [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query issuer recovery",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the successor designated by the issuer together with the time after which the successor
+can claim the admin rights if the issuer stays inactive.
+
+Example:
+$ %[1]s query %s issuer-recovery [issuer]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IssuerRecovery(cmd.Context(), &types.QueryIssuerRecoveryRequest{
+				Issuer: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -12,7 +14,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/pkg/errors"
@@ -40,6 +44,15 @@ const (
 	ExtensionIssuanceMsgFlag = "extension-issuance-msg"
 	DEXUnifiedRefAmountFlag  = "dex-unified-ref-amount"
 	DEXWhitelistedDenomsFlag = "dex-whitelisted-denoms"
+	ReferrerFlag             = "referrer"
+	PeriodFlag               = "period"
+	PresetFlag               = "preset"
+	DestinationFlag          = "destination"
+	RelativeFlag             = "relative"
+	DenomFlag                = "denom"
+	EnableFeaturesFlag       = "enable"
+	DisableFeaturesFlag      = "disable"
+	RecipientsFlag           = "recipients"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -59,29 +72,51 @@ func GetTxCmd() *cobra.Command {
 		CmdTxFreeze(),
 		CmdTxUnfreeze(),
 		CmdTxSetFrozen(),
+		CmdTxFreezeRate(),
 		CmdTxGloballyFreeze(),
 		CmdTxGloballyUnfreeze(),
 		CmdTxClawback(),
+		CmdTxBatchClawback(),
 		CmdTxSetWhitelistedLimit(),
 		CmdTxTransferAdmin(),
 		CmdTxClearAdmin(),
 		CmdGrantAuthorization(),
 		CmdUpdateDEXUnifiedRefAmount(),
 		CmdUpdateDEXWhitelistedDenoms(),
+		CmdTxClaimSymbol(),
+		CmdTxReserveSymbol(),
+		CmdTxGrantMintAllowance(),
+		CmdTxRevokeMintAllowance(),
+		CmdTxSetDustPolicy(),
+		CmdTxSetDustOptOut(),
+		CmdTxSweepDust(),
+		CmdTxSignBurnPermit(),
+		CmdTxBurnFrom(),
+		CmdTxIssueEscrowed(),
+		CmdTxSettleEscrow(),
+		CmdTxSetSendRateLimit(),
+		CmdTxUpdateFeatures(),
+		CmdTxPlaceLegalHold(),
+		CmdTxApproveLegalHoldRelease(),
+		CmdTxSetObserverContract(),
+		CmdTxSetFreezeExemption(),
+		CmdTxRemoveFreezeExemption(),
+		CmdTxAssignRole(),
+		CmdTxRevokeRole(),
+		CmdTxScheduleRateChange(),
+		CmdTxStartRedenomination(),
+		CmdTxRedenominate(),
+		CmdTxSetIssuerRecovery(),
+		CmdTxCancelIssuerRecovery(),
+		CmdTxClaimIssuerRecovery(),
 	)
 
 	return cmd
 }
 
 // CmdTxIssue returns Issue cobra command.
-//
-//nolint:funlen // Despite the length function is still manageable
 func CmdTxIssue() *cobra.Command {
-	var allowedFeatures []string
-	for _, n := range types.Feature_name {
-		allowedFeatures = append(allowedFeatures, n)
-	}
-	sort.Strings(allowedFeatures)
+	allowedFeatures := allowedIssueFeatures()
 	cmd := &cobra.Command{
 		//nolint:lll // breaking this down will make it look worse when printed to user screen.
 		Use:   fmt.Sprintf("issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features="+strings.Join(allowedFeatures, ",")+" --burn-rate=0.12 --send-commission-rate=0.2 --uri https://my-token-meta.invalid/1 --uri-hash e000624 --extension-code-id=1 --extension-label=my-extension --extension-funds=100000ABC-%s --extension-instantiation-msg={} --dex-unified-ref-amount=1000.5", constant.AddressSampleTest),
@@ -102,140 +137,397 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 				return errors.WithStack(err)
 			}
 
-			issuer := clientCtx.GetFromAddress()
-			symbol := args[0]
-			subunit := args[1]
-			precision, err := strconv.ParseUint(args[2], 10, 32)
+			msg, err := issueMsgFromArgs(cmd, clientCtx.GetFromAddress(), args, allowedFeatures)
 			if err != nil {
-				return sdkerrors.Wrap(err, "invalid precision")
+				return err
 			}
 
-			// if the initial amount wasn't provided the amount is zero
-			initialAmount := sdkmath.ZeroInt()
-			if args[3] != "" {
-				var ok bool
-				initialAmount, ok = sdkmath.NewIntFromString(args[3])
-				if !ok {
-					return sdkerrors.Wrapf(types.ErrInvalidInput, "initial_amount is not a number or is too big")
-				}
-			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addIssueFlags(cmd, allowedFeatures)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxIssueEscrowed returns IssueEscrowed cobra command.
+func CmdTxIssueEscrowed() *cobra.Command {
+	allowedFeatures := allowedIssueFeatures()
+	cmd := &cobra.Command{
+		//nolint:lll // breaking this down will make it look worse when printed to user screen.
+		Use:   "issue-escrowed [symbol] [subunit] [precision] [initial_amount] [description] [buyer] [payment] [settlement_period] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12 --send-commission-rate=0.2 --uri https://my-token-meta.invalid/1 --uri-hash e000624 --dex-unified-ref-amount=1000.5",
+		Args:  cobra.ExactArgs(8),
+		Short: "Issue new fungible token with the initial supply escrowed for the buyer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Issues new fungible token with the initial supply escrowed for the buyer. The supply is released
+to the buyer once the buyer pays the payment to the issuer within the settlement period, otherwise it is refunded
+to the issuer.
 
-			featuresString, err := cmd.Flags().GetStringSlice(FeaturesFlag)
+Example:
+$ %s tx %s issue-escrowed WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" [buyer] 1000000ucore 24h --from [issuer]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return errors.WithStack(err)
 			}
 
-			burnRate := sdkmath.LegacyNewDec(0)
-			burnRateStr, err := cmd.Flags().GetString(BurnRateFlag)
+			issueMsg, err := issueMsgFromArgs(cmd, clientCtx.GetFromAddress(), args[:5], allowedFeatures)
 			if err != nil {
-				return errors.WithStack(err)
-			}
-			if len(burnRateStr) > 0 {
-				burnRate, err = sdkmath.LegacyNewDecFromStr(burnRateStr)
-				if err != nil {
-					return errors.Wrapf(err, "invalid burn-rate")
-				}
+				return err
 			}
 
-			sendCommissionRate := sdkmath.LegacyNewDec(0)
-			sendCommissionFeeStr, err := cmd.Flags().GetString(SendCommissionRateFlag)
+			payment, err := sdk.ParseCoinNormalized(args[6])
 			if err != nil {
-				return errors.WithStack(err)
+				return sdkerrors.Wrap(err, "invalid payment")
 			}
-			if len(sendCommissionFeeStr) > 0 {
-				sendCommissionRate, err = sdkmath.LegacyNewDecFromStr(sendCommissionFeeStr)
-				if err != nil {
-					return errors.Wrapf(err, "invalid send-commission-rate")
-				}
+
+			settlementPeriod, err := time.ParseDuration(args[7])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid settlement period")
 			}
 
-			var features []types.Feature
-			for _, str := range featuresString {
-				feature, ok := types.Feature_value[str]
-				if !ok {
-					return errors.Errorf("unknown feature '%s',allowed features: %s", str, strings.Join(allowedFeatures, ","))
-				}
-				features = append(features, types.Feature(feature))
+			msg := &types.MsgIssueEscrowed{
+				Issue:            *issueMsg,
+				Buyer:            args[5],
+				Payment:          payment,
+				SettlementPeriod: settlementPeriod,
 			}
-			description := args[4]
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addIssueFlags(cmd, allowedFeatures)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
 
-			uri, err := cmd.Flags().GetString(URIFlag)
+// CmdTxSettleEscrow returns SettleEscrow cobra command.
+func CmdTxSettleEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-escrow [denom] --from [buyer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Pay for the escrowed initial supply of the token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pay for the escrowed initial supply of the token. The payment is sent to the issuer and
+the escrowed supply is released to the buyer.
+
+Example:
+$ %s tx %s settle-escrow ABC-%s --from [buyer]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return errors.WithStack(err)
 			}
-			uriHash, err := cmd.Flags().GetString(URIHashFlag)
+
+			msg := &types.MsgSettleEscrow{
+				Buyer: clientCtx.GetFromAddress().String(),
+				Denom: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetSendRateLimit returns SetSendRateLimit cobra command.
+func CmdTxSetSendRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-send-rate-limit [amount] [window] --denom [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Limit the amount the sender may send within each window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Limit the amount of the denom the sender may send within each window. If the denom is not
+provided, the limit applies to each denom without its own limit. The zero amount removes the limit.
+Stricter limits apply immediately, while looser limits and removals apply after the delay set in the module params.
+
+Example:
+$ %s tx %s set-send-rate-limit 100000 24h --denom ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return errors.WithStack(err)
 			}
 
-			var extensionSettings *types.ExtensionIssueSettings
-			extensionCodeID, err := cmd.Flags().GetUint64(ExtensionCodeIDFlag)
+			amount, ok := sdkmath.NewIntFromString(args[0])
+			if !ok {
+				return sdkerrors.Wrap(types.ErrInvalidInput, "invalid amount")
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrInvalidInput, "invalid window")
+			}
+
+			denom, err := cmd.Flags().GetString(DenomFlag)
 			if err != nil {
 				return errors.WithStack(err)
 			}
-			//nolint:nestif // The optional params should not be parsed if the main param does not exist
-			if extensionCodeID > 0 {
-				extensionSettings = &types.ExtensionIssueSettings{CodeId: extensionCodeID}
 
-				extensionSettings.Label, err = cmd.Flags().GetString(ExtensionLabelFlag)
-				if err != nil {
-					return errors.WithStack(err)
-				}
+			msg := &types.MsgSetSendRateLimit{
+				Sender: clientCtx.GetFromAddress().String(),
+				Denom:  denom,
+				Amount: amount,
+				Window: window,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-				extensionFunds, err := cmd.Flags().GetString(ExtensionFundsFlag)
-				if err != nil {
-					return errors.WithStack(err)
-				}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(DenomFlag, "", "The denom the limit applies to, all the denoms if not provided.")
 
-				if len(extensionFunds) > 0 {
-					extensionSettings.Funds, err = sdk.ParseCoinsNormalized(extensionFunds)
-					if err != nil {
-						return sdkerrors.Wrap(err, "invalid amount")
-					}
-				}
+	return cmd
+}
 
-				extensionIssuanceMsg, err := cmd.Flags().GetString(ExtensionIssuanceMsgFlag)
-				if err != nil {
-					return errors.WithStack(err)
-				}
+// CmdTxUpdateFeatures returns UpdateFeatures cobra command.
+func CmdTxUpdateFeatures() *cobra.Command {
+	allowedFeatures := allowedIssueFeatures()
+	cmd := &cobra.Command{
+		Use:   "update-features [denom] --enable [features] --disable [features] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Announce the update of the token features",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Announce the update of the token features, applied after the delay set in the module params.
+The admin may enable only the features which don't let it mint the new supply or seize the balances of the holders,
+and may not disable the burning and ibc features. The new update replaces the pending one.
 
-				extensionSettings.IssuanceMsg = []byte(extensionIssuanceMsg)
+Example:
+$ %s tx %s update-features ABC-%s --enable freezing --disable minting --from [admin]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
 			}
 
-			var dexSettings *types.DEXSettings
-			unifiedRefAmountStr, err := cmd.Flags().GetString(DEXUnifiedRefAmountFlag)
+			enableFeatures, err := featuresFromFlag(cmd, EnableFeaturesFlag, allowedFeatures)
 			if err != nil {
-				return errors.WithStack(err)
+				return err
 			}
-			if len(unifiedRefAmountStr) > 0 {
-				unifiedRefAmount, err := sdkmath.LegacyNewDecFromStr(unifiedRefAmountStr)
-				if err != nil {
-					return errors.Wrapf(err, "invalid %s value", DEXUnifiedRefAmountFlag)
-				}
-				dexSettings = &types.DEXSettings{
-					UnifiedRefAmount: &unifiedRefAmount,
-				}
+			disableFeatures, err := featuresFromFlag(cmd, DisableFeaturesFlag, allowedFeatures)
+			if err != nil {
+				return err
 			}
 
-			msg := &types.MsgIssue{
-				Issuer:             issuer.String(),
-				Symbol:             symbol,
-				Subunit:            subunit,
-				Precision:          uint32(precision),
-				InitialAmount:      initialAmount,
-				Description:        description,
-				Features:           features,
-				BurnRate:           burnRate,
-				SendCommissionRate: sendCommissionRate,
-				URI:                uri,
-				URIHash:            uriHash,
-				ExtensionSettings:  extensionSettings,
-				DEXSettings:        dexSettings,
+			msg := &types.MsgUpdateFeatures{
+				Sender:          clientCtx.GetFromAddress().String(),
+				Denom:           args[0],
+				EnableFeatures:  enableFeatures,
+				DisableFeatures: disableFeatures,
 			}
-
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(
+		EnableFeaturesFlag, []string{}, "Features to enable, allowed values: "+strings.Join(allowedFeatures, ","),
+	)
+	cmd.Flags().StringSlice(
+		DisableFeaturesFlag, []string{}, "Features to disable, allowed values: "+strings.Join(allowedFeatures, ","),
+	)
+
+	return cmd
+}
+
+func featuresFromFlag(cmd *cobra.Command, flag string, allowedFeatures []string) ([]types.Feature, error) {
+	featuresString, err := cmd.Flags().GetStringSlice(flag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	features := make([]types.Feature, 0, len(featuresString))
+	for _, str := range featuresString {
+		feature, ok := types.Feature_value[str]
+		if !ok {
+			return nil, errors.Errorf("unknown feature '%s',allowed features: %s", str, strings.Join(allowedFeatures, ","))
+		}
+		features = append(features, types.Feature(feature))
+	}
+
+	return features, nil
+}
+
+func allowedIssueFeatures() []string {
+	var allowedFeatures []string
+	for _, n := range types.Feature_name {
+		allowedFeatures = append(allowedFeatures, n)
+	}
+	sort.Strings(allowedFeatures)
+
+	return allowedFeatures
+}
+
+// issueMsgFromArgs builds the issue message from the arguments and flags of the issue commands.
+//
+//nolint:funlen // Despite the length function is still manageable
+func issueMsgFromArgs(
+	cmd *cobra.Command, issuer sdk.AccAddress, args, allowedFeatures []string,
+) (*types.MsgIssue, error) {
+	symbol := args[0]
+	subunit := args[1]
+	precision, err := strconv.ParseUint(args[2], 10, 32)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid precision")
+	}
+
+	// if the initial amount wasn't provided the amount is zero
+	initialAmount := sdkmath.ZeroInt()
+	if args[3] != "" {
+		var ok bool
+		initialAmount, ok = sdkmath.NewIntFromString(args[3])
+		if !ok {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "initial_amount is not a number or is too big")
+		}
+	}
+
+	featuresString, err := cmd.Flags().GetStringSlice(FeaturesFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	burnRate := sdkmath.LegacyNewDec(0)
+	burnRateStr, err := cmd.Flags().GetString(BurnRateFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(burnRateStr) > 0 {
+		burnRate, err = sdkmath.LegacyNewDecFromStr(burnRateStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid burn-rate")
+		}
+	}
+
+	sendCommissionRate := sdkmath.LegacyNewDec(0)
+	sendCommissionFeeStr, err := cmd.Flags().GetString(SendCommissionRateFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(sendCommissionFeeStr) > 0 {
+		sendCommissionRate, err = sdkmath.LegacyNewDecFromStr(sendCommissionFeeStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid send-commission-rate")
+		}
+	}
+
+	var features []types.Feature
+	for _, str := range featuresString {
+		feature, ok := types.Feature_value[str]
+		if !ok {
+			return nil, errors.Errorf("unknown feature '%s',allowed features: %s", str, strings.Join(allowedFeatures, ","))
+		}
+		features = append(features, types.Feature(feature))
+	}
+	description := args[4]
+
+	uri, err := cmd.Flags().GetString(URIFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	uriHash, err := cmd.Flags().GetString(URIHashFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var extensionSettings *types.ExtensionIssueSettings
+	extensionCodeID, err := cmd.Flags().GetUint64(ExtensionCodeIDFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	//nolint:nestif // The optional params should not be parsed if the main param does not exist
+	if extensionCodeID > 0 {
+		extensionSettings = &types.ExtensionIssueSettings{CodeId: extensionCodeID}
+
+		extensionSettings.Label, err = cmd.Flags().GetString(ExtensionLabelFlag)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		extensionFunds, err := cmd.Flags().GetString(ExtensionFundsFlag)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		if len(extensionFunds) > 0 {
+			extensionSettings.Funds, err = sdk.ParseCoinsNormalized(extensionFunds)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "invalid amount")
+			}
+		}
+
+		extensionIssuanceMsg, err := cmd.Flags().GetString(ExtensionIssuanceMsgFlag)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		extensionSettings.IssuanceMsg = []byte(extensionIssuanceMsg)
+	}
+
+	var dexSettings *types.DEXSettings
+	unifiedRefAmountStr, err := cmd.Flags().GetString(DEXUnifiedRefAmountFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(unifiedRefAmountStr) > 0 {
+		unifiedRefAmount, err := sdkmath.LegacyNewDecFromStr(unifiedRefAmountStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s value", DEXUnifiedRefAmountFlag)
+		}
+		dexSettings = &types.DEXSettings{
+			UnifiedRefAmount: &unifiedRefAmount,
+		}
+	}
+
+	referrer, err := cmd.Flags().GetString(ReferrerFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	preset, err := cmd.Flags().GetString(PresetFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &types.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             symbol,
+		Subunit:            subunit,
+		Precision:          uint32(precision),
+		InitialAmount:      initialAmount,
+		Description:        description,
+		Features:           features,
+		BurnRate:           burnRate,
+		SendCommissionRate: sendCommissionRate,
+		URI:                uri,
+		URIHash:            uriHash,
+		ExtensionSettings:  extensionSettings,
+		DEXSettings:        dexSettings,
+		Referrer:           referrer,
+		Preset:             preset,
+	}, nil
+}
+
+// addIssueFlags adds the flags of the issue commands.
+func addIssueFlags(cmd *cobra.Command, allowedFeatures []string) {
 	//nolint:lll // breaking this down will make it look worse when printed to user screen.
 	cmd.Flags().StringSlice(FeaturesFlag, []string{}, "Features to be enabled on fungible token. e.g --features="+strings.Join(allowedFeatures, ","))
 	//nolint:lll // breaking this down will make it look worse when printed to user screen.
@@ -252,10 +544,10 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 	cmd.Flags().String(ExtensionIssuanceMsgFlag, "{}", "Optional json encoded data to pass to WASM on instantiation by the ft issuer.")
 	//nolint:lll // breaking this down will make it look worse when printed to user screen.
 	cmd.Flags().String(DEXUnifiedRefAmountFlag, "", "DEX unified ref amount is the approximate amount you need to buy 1USD, used to define the price tick size.")
-
-	flags.AddTxFlagsToCmd(cmd)
-
-	return cmd
+	//nolint:lll // breaking this down will make it look worse when printed to user screen.
+	cmd.Flags().String(ReferrerFlag, "", "Address of the account which referred the issuer and receives the part of the issue fee.")
+	//nolint:lll // breaking this down will make it look worse when printed to user screen.
+	cmd.Flags().String(PresetFlag, "", "Name of the issue preset defining the features, burn rate, send commission rate and DEX settings of the token.")
 }
 
 // CmdTxMint returns Mint cobra command.
@@ -265,11 +557,15 @@ func CmdTxMint() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "mint new amount of fungible token",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Mint new amount of fungible token.
+			fmt.Sprintf(`Mint new amount of fungible token. The amount might be split among at most %d recipients, then
+it is the total minted amount.
 
 Example:
 $ %s tx %s mint 100000ABC-%s --from [sender]
+$ %s tx %s mint 100000ABC-%s --recipients [address1]=60000,[address2]=40000 --from [sender]
 `,
+				types.MaxMintRecipients,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
 		),
@@ -285,15 +581,36 @@ $ %s tx %s mint 100000ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
+			recipientsArgs, err := cmd.Flags().GetStringSlice(RecipientsFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			recipients := make([]types.MintRecipient, 0, len(recipientsArgs))
+			for _, arg := range recipientsArgs {
+				address, amountString, ok := strings.Cut(arg, "=")
+				if !ok {
+					return errors.Errorf("invalid recipient %q, expected format is address=amount", arg)
+				}
+				amount, ok := sdkmath.NewIntFromString(amountString)
+				if !ok {
+					return errors.Errorf("invalid amount of recipient %q", arg)
+				}
+				recipients = append(recipients, types.MintRecipient{
+					Address: address,
+					Amount:  amount,
+				})
+			}
+
 			amount, err := sdk.ParseCoinNormalized(args[0])
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
 
 			msg := &types.MsgMint{
-				Sender:    sender.String(),
-				Recipient: recipient,
-				Coin:      amount,
+				Sender:     sender.String(),
+				Recipient:  recipient,
+				Coin:       amount,
+				Recipients: recipients,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -306,6 +623,11 @@ $ %s tx %s mint 100000ABC-%s --from [sender]
 		"",
 		"Address to send minted tokens to, if not specified minted tokens are sent to the issuer",
 	)
+	cmd.Flags().StringSlice(
+		RecipientsFlag,
+		nil,
+		"Recipients the minted tokens are split among, in the address=amount format, the amounts sum up to the minted one",
+	)
 
 	return cmd
 }
@@ -356,19 +678,19 @@ $ %s tx %s burn 50000udevcore --from [sender]  # Burn governance denom
 	return cmd
 }
 
-// CmdTxFreeze returns Freeze cobra command.
-//
-//nolint:dupl // most code is identical between Freeze/Unfreeze cmd, but reusing logic is not beneficial here.
-func CmdTxFreeze() *cobra.Command {
+// CmdTxPlaceLegalHold returns PlaceLegalHold cobra command.
+func CmdTxPlaceLegalHold() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "freeze [account_address] [amount] --from [sender]",
+		Use:   "place-legal-hold [account_address] [amount] --from [admin]",
 		Args:  cobra.ExactArgs(2),
-		Short: "Freeze any amount of fungible token for the specific account",
+		Short: "Place the legal hold of the fungible token on the account",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Freeze a portion of fungible token.
+			fmt.Sprintf(`Place the legal hold of the fungible token on the account. While the hold is active, the account
+can't receive the token and the held amount can't be spent. The amount is added to the amount already held and the
+approvals of the release are reset. The hold is released once both the admin and the legal hold authority approve it.
 
 Example:
-$ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
+$ %s tx %s place-legal-hold [account_address] 100000ABC-%s --from [admin]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -379,16 +701,14 @@ $ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			account := args[0]
 			amount, err := sdk.ParseCoinNormalized(args[1])
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
 
-			msg := &types.MsgFreeze{
-				Sender:  sender.String(),
-				Account: account,
+			msg := &types.MsgPlaceLegalHold{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
 				Coin:    amount,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -400,19 +720,18 @@ $ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxUnfreeze returns Unfreeze cobra command.
-//
-//nolint:dupl // most code is identical between Freeze/Unfreeze cmd, but reusing logic is not beneficial here.
-func CmdTxUnfreeze() *cobra.Command {
+// CmdTxApproveLegalHoldRelease returns ApproveLegalHoldRelease cobra command.
+func CmdTxApproveLegalHoldRelease() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unfreeze [account_address] [amount] --from [sender]",
+		Use:   "approve-legal-hold-release [account_address] [denom] --from [sender]",
 		Args:  cobra.ExactArgs(2),
-		Short: "Unfreeze a portion of the frozen fungible tokens",
+		Short: "Approve the release of the legal hold placed on the account",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Unfreezes a portion of the frozen fungible token.
+			fmt.Sprintf(`Approve the release of the legal hold placed on the account by the admin of the token or by
+the legal hold authority. The hold is released once both of them approve it.
 
 Example:
-$ %s tx %s unfreeze [account_address] 100000ABC-%s --from [sender]
+$ %s tx %s approve-legal-hold-release [account_address] ABC-%s --from [sender]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -423,19 +742,11 @@ $ %s tx %s unfreeze [account_address] 100000ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			account := args[0]
-			amount, err := sdk.ParseCoinNormalized(args[1])
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid amount")
-			}
-
-			msg := &types.MsgUnfreeze{
-				Sender:  sender.String(),
-				Account: account,
-				Coin:    amount,
+			msg := &types.MsgApproveLegalHoldRelease{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Denom:   args[1],
 			}
-
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -445,19 +756,1187 @@ $ %s tx %s unfreeze [account_address] 100000ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxSetFrozen returns SetFrozen cobra command.
-//
-//nolint:dupl // most code is identical between Freeze/Unfreeze cmd, but reusing logic is not beneficial here.
-func CmdTxSetFrozen() *cobra.Command {
+// CmdTxSetObserverContract returns SetObserverContract cobra command.
+func CmdTxSetObserverContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-observer-contract [denom] [contract_address] --from [sender]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Set the smart contract notified about the transfers of the token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the smart contract notified about the transfers of the token. The observer can't reject the
+transfers, it is intended for the accounting only. Omit the contract address to remove the observer.
+
+Example:
+$ %s tx %s set-observer-contract ABC-%s [contract_address] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgSetObserverContract{
+				Sender: clientCtx.GetFromAddress().String(),
+				Denom:  args[0],
+			}
+			if len(args) > 1 {
+				msg.Contract = args[1]
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxFreeze returns Freeze cobra command.
+//
+//nolint:dupl // most code is identical between Freeze/Unfreeze cmd, but reusing logic is not beneficial here.
+func CmdTxFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Freeze any amount of fungible token for the specific account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze a portion of fungible token.
+
+Example:
+$ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgFreeze{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUnfreeze returns Unfreeze cobra command.
+//
+//nolint:dupl // most code is identical between Freeze/Unfreeze cmd, but reusing logic is not beneficial here.
+func CmdTxUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unfreeze a portion of the frozen fungible tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreezes a portion of the frozen fungible token.
+
+Example:
+$ %s tx %s unfreeze [account_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgUnfreeze{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetFrozen returns SetFrozen cobra command.
+//
+//nolint:dupl // most code is identical between Freeze/Unfreeze cmd, but reusing logic is not beneficial here.
+func CmdTxSetFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-frozen [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set absolute frozen amount for the specific account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set absolute frozen amount for the specific account.
+
+Example:
+$ %s tx %s set-frozen [account_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgSetFrozen{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxFreezeRate returns FreezeRate cobra command.
+func CmdTxFreezeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-rate [denom] [rate] [account]... --from [sender]",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "Freeze the rate of the balance of each account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the frozen amount of each account to the rate of its balance at the execution time.
+The rate is a number between 0 and 1. The frozen amount replaces the previous one and isn't updated once the balance
+changes later. At most %d accounts can be frozen at once.
+
+Example:
+$ %s tx %s freeze-rate ABC-%s 0.5 [account1] [account2] --from [sender]
+`,
+				types.MaxFreezeRateBatchSize, version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			rate, err := sdkmath.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid rate")
+			}
+
+			msg := &types.MsgFreezeRate{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Denom:    args[0],
+				Rate:     rate,
+				Accounts: args[2:],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClawback returns Clawback cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [account_address] [amount] --from [sender] --recipient [recipient]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Confiscates any amount of fungible token from the specific account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Confiscate a portion of fungible token. The tokens are sent to the admin unless the recipient is
+provided.
+
+Example:
+$ %s tx %s clawback [account_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+			recipient, err := cmd.Flags().GetString(RecipientFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgClawback{
+				Sender:    sender.String(),
+				Account:   account,
+				Coin:      amount,
+				Recipient: recipient,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(
+		RecipientFlag,
+		"",
+		"Address to send clawed back tokens to, if not specified clawed back tokens are sent to the admin",
+	)
+
+	return cmd
+}
+
+// CmdTxBatchClawback returns BatchClawback cobra command.
+func CmdTxBatchClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-clawback [account_address=amount]... --from [sender] --recipient [recipient]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Confiscates fungible tokens from multiple accounts atomically",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Confiscate fungible tokens from multiple accounts atomically. The tokens are sent to the admin
+unless the recipient is provided. At most %d entries can be clawed back at once.
+
+Example:
+$ %s tx %s batch-clawback [account1]=100000ABC-%s [account2]=500ABC-%s --from [sender]
+`,
+				types.MaxClawbackBatchSize, version.AppName, types.ModuleName,
+				constant.AddressSampleTest, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			entries := make([]types.ClawbackEntry, 0, len(args))
+			for _, arg := range args {
+				account, amountString, ok := strings.Cut(arg, "=")
+				if !ok {
+					return errors.Errorf("invalid entry %q, expected format is account_address=amount", arg)
+				}
+				amount, err := sdk.ParseCoinNormalized(amountString)
+				if err != nil {
+					return sdkerrors.Wrapf(err, "invalid amount of entry %q", arg)
+				}
+				entries = append(entries, types.ClawbackEntry{
+					Account: account,
+					Coin:    amount,
+				})
+			}
+			recipient, err := cmd.Flags().GetString(RecipientFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgBatchClawback{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Recipient: recipient,
+				Entries:   entries,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(
+		RecipientFlag,
+		"",
+		"Address to send clawed back tokens to, if not specified clawed back tokens are sent to the admin",
+	)
+
+	return cmd
+}
+
+// CmdTxSetWhitelistedLimit returns SetWhitelistedLimit cobra command.
+func CmdTxSetWhitelistedLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-whitelisted-limit [account_address] [amount] --from [sender] --relative",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set whitelisted limit on an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set whitelisted limit on an account. If the limit is relative, it is set to the balance of
+the account at the execution time increased by the amount.
+
+Example:
+$ %s tx %s set-whitelisted-limit [account_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			relative, err := cmd.Flags().GetBool(RelativeFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgSetWhitelistedLimit{
+				Sender:   sender.String(),
+				Account:  account,
+				Coin:     amount,
+				Relative: relative,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(RelativeFlag, false, "Set the limit to the current balance of the account increased by the amount")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGloballyFreeze returns GlobalFreeze cobra command.
+func CmdTxGloballyFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "globally-freeze [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "freezes fungible token so no operations are allowed with it before unfrozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freezes fungible token so no operations are allowed with it before unfrozen.
+This operation is idempotent so global freeze of already frozen token does nothing.
+
+Example:
+$ %s tx %s globally-freeze ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgGloballyFreeze{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGloballyUnfreeze returns GlobalUnfreeze cobra command.
+func CmdTxGloballyUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "globally-unfreeze [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "unfreezes fungible token and unblocks basic operations on it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreezes fungible token and unblocks basic operations on it.
+This operation is idempotent so global unfreezing of non-frozen token does nothing.
+
+Example:
+$ %s tx %s globally-unfreeze ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgGloballyUnfreeze{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxTransferAdmin returns TransferAdmin cobra command.
+func CmdTxTransferAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-admin [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Change admin of a fungible token to the specific account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change admin of a fungible token.
+
+Example:
+$ %s tx %s transfer-admin [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+			err = sdk.ValidateDenom(denom)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid denom")
+			}
+
+			msg := &types.MsgTransferAdmin{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClearAdmin returns ClearAdmin cobra command.
+func CmdTxClearAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-admin [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove admin of a fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove admin of a fungible token.
+
+Example:
+$ %s tx %s clear-admin ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			err = sdk.ValidateDenom(denom)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid denom")
+			}
+
+			msg := &types.MsgClearAdmin{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClaimSymbol returns ClaimSymbol cobra command.
+func CmdTxClaimSymbol() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-symbol [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim the symbol of a fungible token to be verified",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the symbol of a fungible token to be verified. The symbol claim deposit is locked until the
+claim is approved or rejected by the governance.
+
+Example:
+$ %s tx %s claim-symbol ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			err = sdk.ValidateDenom(denom)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid denom")
+			}
+
+			msg := &types.MsgClaimSymbol{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxReserveSymbol returns ReserveSymbol cobra command.
+func CmdTxReserveSymbol() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-symbol [symbol] [subunit] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Reserve the symbol and subunit for the upcoming issuance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reserve the symbol and subunit for the upcoming issuance. Until the reservation expires the symbol
+can be issued only by the issuer holding the reservation. The symbol reservation deposit is returned once the token
+is issued and burnt if the reservation expires.
+
+Example:
+$ %s tx %s reserve-symbol ABC uabc --from [issuer]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgReserveSymbol{
+				Issuer:  clientCtx.GetFromAddress().String(),
+				Symbol:  args[0],
+				Subunit: args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGrantMintAllowance returns GrantMintAllowance cobra command.
+func CmdTxGrantMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-mint-allowance [grantee] [cap] [expiration] --period [period] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Allow the grantee to mint the token up to the cap within each period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow the grantee to mint the token up to the cap within each period until the expiration
+provided as Unix timestamp. The minted amount is reset at the end of each period. If the period is not provided,
+the cap is never reset. The grant replaces the existing allowance of the grantee.
+
+Example:
+$ %s tx %s grant-mint-allowance [grantee] 100000ABC-%s 1767225600 --period 24h --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			mintCap, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid cap")
+			}
+
+			expiration, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid expiration")
+			}
+
+			period, err := cmd.Flags().GetDuration(PeriodFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgGrantMintAllowance{
+				Sender:         clientCtx.GetFromAddress().String(),
+				Grantee:        args[0],
+				Cap:            mintCap,
+				Period:         period,
+				ExpirationTime: time.Unix(expiration, 0).UTC(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Duration(PeriodFlag, 0, "The duration of the window after which the minted amount is reset.")
+
+	return cmd
+}
+
+// CmdTxRevokeMintAllowance returns RevokeMintAllowance cobra command.
+func CmdTxRevokeMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-mint-allowance [grantee] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Revoke the mint allowance of the grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the mint allowance of the grantee.
+
+Example:
+$ %s tx %s revoke-mint-allowance [grantee] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgRevokeMintAllowance{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Grantee: args[0],
+				Denom:   args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetDustPolicy returns SetDustPolicy cobra command.
+func CmdTxSetDustPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dust-policy [denom] [threshold] --destination=issuer|community-pool --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the dust policy of the token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the dust policy allowing anyone to sweep the balances lower than the threshold to the issuer
+or the community pool. The zero threshold removes the policy.
+
+Example:
+$ %s tx %s set-dust-policy ABC-%s 100 --destination=community-pool --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			threshold, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return sdkerrors.Wrap(types.ErrInvalidInput, "invalid threshold")
+			}
+
+			destinationString, err := cmd.Flags().GetString(DestinationFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			destinationName := "DUST_DESTINATION_" + strings.ToUpper(strings.ReplaceAll(destinationString, "-", "_"))
+			destination, ok := types.DustDestination_value[destinationName]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown destination %q", destinationString)
+			}
+
+			msg := &types.MsgSetDustPolicy{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Denom:       args[0],
+				Threshold:   threshold,
+				Destination: types.DustDestination(destination),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(DestinationFlag, "issuer", "Where the swept dust is sent to, issuer or community-pool.")
+
+	return cmd
+}
+
+// CmdTxSetDustOptOut returns SetDustOptOut cobra command.
+func CmdTxSetDustOptOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dust-opt-out [account] [denom] [opt_out] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Exclude the account from the dust sweeping of the token or include it back",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exclude the account from the dust sweeping of the token or include it back.
+The sender must be the account itself or the admin of the token.
+
+Example:
+$ %s tx %s set-dust-opt-out [account] ABC-%s true --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			optOut, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(types.ErrInvalidInput, "invalid opt_out")
+			}
+
+			msg := &types.MsgSetDustOptOut{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Denom:   args[1],
+				OptOut:  optOut,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSweepDust returns SweepDust cobra command.
+func CmdTxSweepDust() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-dust [denom] [account]... --from [sender]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Sweep the dust balances of the accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sweep the balances lower than the threshold of the dust policy to the destination of the policy.
+The accounts not eligible for the sweeping are skipped. At most %d accounts can be swept at once.
+
+Example:
+$ %s tx %s sweep-dust ABC-%s [account1] [account2] --from [sender]
+`,
+				types.MaxDustSweepBatchSize, version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgSweepDust{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Denom:    args[0],
+				Accounts: args[1:],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// BurnPermitSignature is the output of the sign-burn-permit command passed to the burn-from command.
+type BurnPermitSignature struct {
+	PubKey    []byte `json:"pub_key"`
+	Signature []byte `json:"signature"`
+}
+
+// CmdTxSignBurnPermit returns SignBurnPermit cobra command.
+func CmdTxSignBurnPermit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-burn-permit [burner_address] [amount] [nonce] [expiration] --from [holder]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Sign the permit allowing the admin of the token to burn the coins of the holder",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign the permit allowing the admin of the token to burn the coins of the holder. The command
+doesn't broadcast anything, it prints the public key and the signature passed to the burn-from command.
+The expiration is the unix timestamp in seconds, each nonce can be used once.
+
+Example:
+$ %s tx %s sign-burn-permit [burner_address] 100000ABC-%s 1 1767225600 --from [holder]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			burner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid burner address")
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+			nonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid nonce")
+			}
+			expiration, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid expiration")
+			}
+
+			permit := types.NewBurnPermit(
+				clientCtx.ChainID, clientCtx.GetFromAddress(), burner, amount, nonce, time.Unix(expiration, 0).UTC(),
+			)
+			signBytes, err := permit.SignBytes()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			signature, pubKey, err := clientCtx.Keyring.SignByAddress(
+				clientCtx.GetFromAddress(), signBytes, signing.SignMode_SIGN_MODE_DIRECT,
+			)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+				return errors.Errorf("unsupported key type %s, secp256k1 key is required", pubKey.Type())
+			}
+
+			out, err := json.Marshal(BurnPermitSignature{
+				PubKey:    pubKey.Bytes(),
+				Signature: signature,
+			})
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBurnFrom returns BurnFrom cobra command.
+func CmdTxBurnFrom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-from [account_address] [amount] [nonce] [expiration] [pub_key] [signature] --from [sender]",
+		Args:  cobra.ExactArgs(6),
+		Short: "Burn the coins of the account with the burn permit signed by the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the coins of the account with the burn permit signed by the account. The public key and
+the signature are base64 encoded, as printed by the sign-burn-permit command.
+
+Example:
+$ %s tx %s burn-from [account_address] 100000ABC-%s 1 1767225600 [pub_key] [signature] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+			nonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid nonce")
+			}
+			expiration, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid expiration")
+			}
+			pubKey, err := base64.StdEncoding.DecodeString(args[4])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid public key")
+			}
+			signature, err := base64.StdEncoding.DecodeString(args[5])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid signature")
+			}
+
+			msg := &types.MsgBurnFrom{
+				Sender:         clientCtx.GetFromAddress().String(),
+				Account:        args[0],
+				Coin:           amount,
+				Nonce:          nonce,
+				ExpirationTime: time.Unix(expiration, 0).UTC(),
+				PubKey:         pubKey,
+				Signature:      signature,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
+func CmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [message_type=\"mint\"|\"burn\"] --from <granter> --burn-limit 10ucore --mint-limit 10ucore",
+		Short: "Grant authorization to an address",
+		Long: fmt.Sprintf(`Grant authorization to an address.
+Examples:
+$ %s tx grant <grantee_addr> mint --mint-limit 100ucore --expiration 1667979596
+
+$ %s tx grant <grantee_addr> burn --burn-limit 100ucore --expiration 1667979596
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization authz.Authorization
+			switch args[1] {
+			case "mint":
+				limit, err := cmd.Flags().GetString(MintLimitFlag)
+				if err != nil {
+					return err
+				}
+
+				limitCoins, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				if !limitCoins.IsAllPositive() {
+					return errors.New("mint-limit should be greater than zero")
+				}
+				authorization = types.NewMintAuthorization(limitCoins)
+			case "burn":
+				limit, err := cmd.Flags().GetString(BurnLimitFlag)
+				if err != nil {
+					return err
+				}
+
+				limitCoins, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				if !limitCoins.IsAllPositive() {
+					return errors.New("burn-limit should be greater than zero")
+				}
+				authorization = types.NewBurnAuthorization(limitCoins)
+			default:
+				return errors.Errorf("invalid authorization types, %s", args[1])
+			}
+
+			expire, err := getExpireTime(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantMsg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expire)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), grantMsg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(ExpirationFlag, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry.")
+	cmd.Flags().String(BurnLimitFlag, "", "The Amount that is allowed to be burnt.")
+	cmd.Flags().String(MintLimitFlag, "", "The Amount that is allowed to be minted.")
+	return cmd
+}
+
+// CmdUpdateDEXUnifiedRefAmount returns UpdateDEXUnifiedRefAmount cobra command.
+func CmdUpdateDEXUnifiedRefAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-dex-unified-ref-amount [denom] [unified-ref-amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Update DEX unified ref amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update DEX unified ref amount.
+Example:
+$ %s tx %s update-dex-unified-ref-amount ABC-%s 1000.5 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			if err = sdk.ValidateDenom(denom); err != nil {
+				return sdkerrors.Wrap(err, "invalid denom")
+			}
+			unifiedRefAmount, err := sdkmath.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return errors.Wrapf(err, "invalid %s value", DEXUnifiedRefAmountFlag)
+			}
+
+			msg := &types.MsgUpdateDEXUnifiedRefAmount{
+				Sender:           sender.String(),
+				Denom:            denom,
+				UnifiedRefAmount: unifiedRefAmount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdUpdateDEXWhitelistedDenoms returns UpdateDEXWhitelistedDenoms cobra command.
+func CmdUpdateDEXWhitelistedDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-dex-whitelisted-denoms [denom] --dex-whitelisted-denoms [dex-whitelisted-denoms] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update DEX whitelisted denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update DEX whitelisted denoms.
+Example:
+$ %s tx %s update-dex-whitelisted-denoms denom1 --dex-whitelisted-denoms denom2,denom3 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			if err = sdk.ValidateDenom(denom); err != nil {
+				return sdkerrors.Wrap(err, "invalid denom")
+			}
+
+			whitelistedDenoms, err := cmd.Flags().GetStringSlice(DEXWhitelistedDenomsFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			for _, whitelistedDenom := range whitelistedDenoms {
+				if err = sdk.ValidateDenom(whitelistedDenom); err != nil {
+					return sdkerrors.Wrapf(err, "invalid denom %s", whitelistedDenom)
+				}
+			}
+
+			msg := &types.MsgUpdateDEXWhitelistedDenoms{
+				Sender:            sender.String(),
+				Denom:             denom,
+				WhitelistedDenoms: whitelistedDenoms,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	//nolint:lll // breaking this down will make it look worse when printed to user screen.
+	cmd.Flags().StringSlice(DEXWhitelistedDenomsFlag, []string{}, "Denoms to add to be whitelisted to be traded with in DEX.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
+	exp, err := cmd.Flags().GetInt64(ExpirationFlag)
+	if err != nil {
+		return nil, err
+	}
+	if exp == 0 {
+		return nil, nil //nolint:nilnil //the intent of this function is to simplify return nil time.
+	}
+	e := time.Unix(exp, 0)
+	return &e, nil
+}
+
+// CmdTxSetFreezeExemption returns SetFreezeExemption cobra command.
+func CmdTxSetFreezeExemption() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-frozen [account_address] [amount] --from [sender]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Set absolute frozen amount for the specific account",
+		Use:   "set-freeze-exemption [account_address] [denom] [expiration] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Exempt the account from the global freeze of the token",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Set absolute frozen amount for the specific account.
+			fmt.Sprintf(`Exempt the account from the global freeze of the token until the expiration provided as Unix
+timestamp. The exemption replaces the existing exemption of the account.
 
 Example:
-$ %s tx %s set-frozen [account_address] 100000ABC-%s --from [sender]
+$ %s tx %s set-freeze-exemption [account_address] ABC-%s 1767225600 --from [sender]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -468,17 +1947,16 @@ $ %s tx %s set-frozen [account_address] 100000ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			account := args[0]
-			amount, err := sdk.ParseCoinNormalized(args[1])
+			expiration, err := strconv.ParseInt(args[2], 10, 64)
 			if err != nil {
-				return sdkerrors.Wrap(err, "invalid amount")
+				return sdkerrors.Wrap(err, "invalid expiration")
 			}
 
-			msg := &types.MsgSetFrozen{
-				Sender:  sender.String(),
-				Account: account,
-				Coin:    amount,
+			msg := &types.MsgSetFreezeExemption{
+				Sender:         clientCtx.GetFromAddress().String(),
+				Account:        args[0],
+				Denom:          args[1],
+				ExpirationTime: time.Unix(expiration, 0).UTC(),
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -489,19 +1967,17 @@ $ %s tx %s set-frozen [account_address] 100000ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxClawback returns Clawback cobra command.
-//
-//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
-func CmdTxClawback() *cobra.Command {
+// CmdTxRemoveFreezeExemption returns RemoveFreezeExemption cobra command.
+func CmdTxRemoveFreezeExemption() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "clawback [account_address] [amount] --from [sender]",
+		Use:   "remove-freeze-exemption [account_address] [denom] --from [sender]",
 		Args:  cobra.ExactArgs(2),
-		Short: "Confiscates any amount of fungible token from the specific account",
+		Short: "Remove the exemption of the account from the global freeze of the token",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Confiscate a portion of fungible token.
+			fmt.Sprintf(`Remove the exemption of the account from the global freeze of the token.
 
 Example:
-$ %s tx %s clawback [account_address] 100000ABC-%s --from [sender]
+$ %s tx %s remove-freeze-exemption [account_address] ABC-%s --from [sender]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -512,17 +1988,10 @@ $ %s tx %s clawback [account_address] 100000ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			account := args[0]
-			amount, err := sdk.ParseCoinNormalized(args[1])
-			if err != nil {
-				return sdkerrors.Wrap(err, "invalid amount")
-			}
-
-			msg := &types.MsgClawback{
-				Sender:  sender.String(),
-				Account: account,
-				Coin:    amount,
+			msg := &types.MsgRemoveFreezeExemption{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Denom:   args[1],
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -533,19 +2002,19 @@ $ %s tx %s clawback [account_address] 100000ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxSetWhitelistedLimit returns SetWhitelistedLimit cobra command.
-//
-//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
-func CmdTxSetWhitelistedLimit() *cobra.Command {
+// CmdTxAssignRole returns AssignRole cobra command.
+func CmdTxAssignRole() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-whitelisted-limit [account_address] [amount] --from [sender]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Set whitelisted limit on an account",
+		Use:   "assign-role [account_address] [denom] [role] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Assign the role of the token to the account",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Set whitelisted limit on an account.
+			fmt.Sprintf(`Assign the role of the token to the account. The supported roles are admin, minter and compliance.
+The minter mints and burns the token, the compliance freezes, whitelists and claws back the token and the admin has the
+permissions of both and assigns and revokes the minter and compliance roles.
 
 Example:
-$ %s tx %s set-whitelisted-limit [account_address] 100000ABC-%s --from [sender]
+$ %s tx %s assign-role [account_address] ABC-%s minter --from [sender]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -556,17 +2025,16 @@ $ %s tx %s set-whitelisted-limit [account_address] 100000ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			account := args[0]
-			amount, err := sdk.ParseCoinNormalized(args[1])
+			role, err := parseRole(args[2])
 			if err != nil {
-				return sdkerrors.Wrap(err, "invalid amount")
+				return err
 			}
 
-			msg := &types.MsgSetWhitelistedLimit{
-				Sender:  sender.String(),
-				Account: account,
-				Coin:    amount,
+			msg := &types.MsgAssignRole{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Denom:   args[1],
+				Role:    role,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -577,18 +2045,17 @@ $ %s tx %s set-whitelisted-limit [account_address] 100000ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxGloballyFreeze returns GlobalFreeze cobra command.
-func CmdTxGloballyFreeze() *cobra.Command {
+// CmdTxRevokeRole returns RevokeRole cobra command.
+func CmdTxRevokeRole() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "globally-freeze [denom] --from [sender]",
-		Args:  cobra.ExactArgs(1),
-		Short: "freezes fungible token so no operations are allowed with it before unfrozen",
+		Use:   "revoke-role [account_address] [denom] [role] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Revoke the role of the token from the account",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Freezes fungible token so no operations are allowed with it before unfrozen.
-This operation is idempotent so global freeze of already frozen token does nothing.
+			fmt.Sprintf(`Revoke the role of the token from the account. The supported roles are admin, minter and compliance.
 
 Example:
-$ %s tx %s globally-freeze ABC-%s --from [sender]
+$ %s tx %s revoke-role [account_address] ABC-%s minter --from [sender]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -599,12 +2066,16 @@ $ %s tx %s globally-freeze ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			denom := args[0]
+			role, err := parseRole(args[2])
+			if err != nil {
+				return err
+			}
 
-			msg := &types.MsgGloballyFreeze{
-				Sender: sender.String(),
-				Denom:  denom,
+			msg := &types.MsgRevokeRole{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Denom:   args[1],
+				Role:    role,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -615,18 +2086,19 @@ $ %s tx %s globally-freeze ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxGloballyUnfreeze returns GlobalUnfreeze cobra command.
-func CmdTxGloballyUnfreeze() *cobra.Command {
+// CmdTxScheduleRateChange returns ScheduleRateChange cobra command.
+func CmdTxScheduleRateChange() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "globally-unfreeze [denom] --from [sender]",
-		Args:  cobra.ExactArgs(1),
-		Short: "unfreezes fungible token and unblocks basic operations on it",
+		Use:   "schedule-rate-change [denom] [burn_rate] [send_commission_rate] [effective_time] --from [admin]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Schedule the change of the burn and send commission rates of the token",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Unfreezes fungible token and unblocks basic operations on it.
-This operation is idempotent so global unfreezing of non-frozen token does nothing.
+			fmt.Sprintf(`Schedule the change of the burn and send commission rates of the token, applied at the effective
+time provided as Unix timestamp. The effective time must be at least the min rate change notice set in the module params
+ahead. The new change replaces the pending one.
 
 Example:
-$ %s tx %s globally-unfreeze ABC-%s --from [sender]
+$ %s tx %s schedule-rate-change ABC-%s 0.01 0.02 1767225600 --from [admin]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -637,12 +2109,25 @@ $ %s tx %s globally-unfreeze ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			denom := args[0]
+			burnRate, err := sdkmath.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid burn rate")
+			}
+			sendCommissionRate, err := sdkmath.LegacyNewDecFromStr(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid send commission rate")
+			}
+			effectiveTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid effective time")
+			}
 
-			msg := &types.MsgGloballyUnfreeze{
-				Sender: sender.String(),
-				Denom:  denom,
+			msg := &types.MsgScheduleRateChange{
+				Sender:             clientCtx.GetFromAddress().String(),
+				Denom:              args[0],
+				BurnRate:           burnRate,
+				SendCommissionRate: sendCommissionRate,
+				EffectiveTime:      time.Unix(effectiveTime, 0).UTC(),
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -653,17 +2138,19 @@ $ %s tx %s globally-unfreeze ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxTransferAdmin returns TransferAdmin cobra command.
-func CmdTxTransferAdmin() *cobra.Command {
+// CmdTxStartRedenomination returns StartRedenomination cobra command.
+func CmdTxStartRedenomination() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transfer-admin [account_address] [denom] --from [sender]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Change admin of a fungible token to the specific account",
+		Use:   "start-redenomination [denom] [new_subunit] [new_symbol] [ratio] [swap_deadline] --from [admin]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Start the redenomination of the token",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Change admin of a fungible token.
+			fmt.Sprintf(`Issue the new token replacing the existing one and lock the existing token, so the holders
+swap it for the new token until the swap deadline provided as Unix timestamp. The ratio is the amount of the new token
+units received for one unit of the old token.
 
 Example:
-$ %s tx %s transfer-admin [account_address] ABC-%s --from [sender]
+$ %s tx %s start-redenomination ABC-%s abc2 ABC2 10 1767225600 --from [admin]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -674,18 +2161,22 @@ $ %s tx %s transfer-admin [account_address] ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			account := args[0]
-			denom := args[1]
-			err = sdk.ValidateDenom(denom)
+			ratio, err := strconv.ParseUint(args[3], 10, 64)
 			if err != nil {
-				return sdkerrors.Wrap(err, "invalid denom")
+				return sdkerrors.Wrap(err, "invalid ratio")
+			}
+			swapDeadline, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid swap deadline")
 			}
 
-			msg := &types.MsgTransferAdmin{
-				Sender:  sender.String(),
-				Account: account,
-				Denom:   denom,
+			msg := &types.MsgStartRedenomination{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Denom:        args[0],
+				NewSubunit:   args[1],
+				NewSymbol:    args[2],
+				Ratio:        ratio,
+				SwapDeadline: time.Unix(swapDeadline, 0).UTC(),
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -696,17 +2187,18 @@ $ %s tx %s transfer-admin [account_address] ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxClearAdmin returns ClearAdmin cobra command.
-func CmdTxClearAdmin() *cobra.Command {
+// CmdTxRedenominate returns Redenominate cobra command.
+func CmdTxRedenominate() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "clear-admin [denom] --from [sender]",
+		Use:   "redenominate [amount] --from [sender]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Remove admin of a fungible token",
+		Short: "Swap the redenominated token for the new one",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Remove admin of a fungible token.
+			fmt.Sprintf(`Swap the amount of the redenominated token for the new token at the ratio of the
+redenomination. The swap is possible until the swap deadline.
 
 Example:
-$ %s tx %s clear-admin ABC-%s --from [sender]
+$ %s tx %s redenominate 100000ABC-%s --from [sender]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -717,16 +2209,14 @@ $ %s tx %s clear-admin ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			denom := args[0]
-			err = sdk.ValidateDenom(denom)
+			amount, err := sdk.ParseCoinNormalized(args[0])
 			if err != nil {
-				return sdkerrors.Wrap(err, "invalid denom")
+				return sdkerrors.Wrap(err, "invalid amount")
 			}
 
-			msg := &types.MsgClearAdmin{
-				Sender: sender.String(),
-				Denom:  denom,
+			msg := &types.MsgRedenominate{
+				Sender: clientCtx.GetFromAddress().String(),
+				Coin:   amount,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -737,96 +2227,61 @@ $ %s tx %s clear-admin ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
-func CmdGrantAuthorization() *cobra.Command {
+// CmdTxSetIssuerRecovery returns SetIssuerRecovery cobra command.
+func CmdTxSetIssuerRecovery() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant [grantee] [message_type=\"mint\"|\"burn\"] --from <granter> --burn-limit 10ucore --mint-limit 10ucore",
-		Short: "Grant authorization to an address",
-		Long: fmt.Sprintf(`Grant authorization to an address.
-Examples:
-$ %s tx grant <grantee_addr> mint --mint-limit 100ucore --expiration 1667979596
+		Use:   "set-issuer-recovery [successor] [inactivity_period] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Designate the successor claiming the admin rights of the tokens if the issuer is inactive",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Designate the successor allowed to claim the admin rights of the tokens of the issuer once
+the issuer doesn't sign any transaction for the inactivity period. The inactivity period must not be shorter than the
+min issuer recovery inactivity period set in the module params. The new designation replaces the existing one.
 
-$ %s tx grant <grantee_addr> burn --burn-limit 100ucore --expiration 1667979596
-`, version.AppName, version.AppName),
-		Args: cobra.ExactArgs(2),
+Example:
+$ %s tx %s set-issuer-recovery %s 8760h --from [issuer]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
-				return err
-			}
-
-			grantee, err := sdk.AccAddressFromBech32(args[0])
-			if err != nil {
-				return err
-			}
-
-			var authorization authz.Authorization
-			switch args[1] {
-			case "mint":
-				limit, err := cmd.Flags().GetString(MintLimitFlag)
-				if err != nil {
-					return err
-				}
-
-				limitCoins, err := sdk.ParseCoinsNormalized(limit)
-				if err != nil {
-					return err
-				}
-
-				if !limitCoins.IsAllPositive() {
-					return errors.New("mint-limit should be greater than zero")
-				}
-				authorization = types.NewMintAuthorization(limitCoins)
-			case "burn":
-				limit, err := cmd.Flags().GetString(BurnLimitFlag)
-				if err != nil {
-					return err
-				}
-
-				limitCoins, err := sdk.ParseCoinsNormalized(limit)
-				if err != nil {
-					return err
-				}
-
-				if !limitCoins.IsAllPositive() {
-					return errors.New("burn-limit should be greater than zero")
-				}
-				authorization = types.NewBurnAuthorization(limitCoins)
-			default:
-				return errors.Errorf("invalid authorization types, %s", args[1])
+				return errors.WithStack(err)
 			}
 
-			expire, err := getExpireTime(cmd)
+			inactivityPeriod, err := time.ParseDuration(args[1])
 			if err != nil {
-				return err
+				return sdkerrors.Wrap(types.ErrInvalidInput, "invalid inactivity period")
 			}
 
-			grantMsg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expire)
-			if err != nil {
-				return err
+			msg := &types.MsgSetIssuerRecovery{
+				Sender:           clientCtx.GetFromAddress().String(),
+				Successor:        args[0],
+				InactivityPeriod: inactivityPeriod,
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), grantMsg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().Int64(ExpirationFlag, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry.")
-	cmd.Flags().String(BurnLimitFlag, "", "The Amount that is allowed to be burnt.")
-	cmd.Flags().String(MintLimitFlag, "", "The Amount that is allowed to be minted.")
+
 	return cmd
 }
 
-// CmdUpdateDEXUnifiedRefAmount returns UpdateDEXUnifiedRefAmount cobra command.
-func CmdUpdateDEXUnifiedRefAmount() *cobra.Command {
+// CmdTxCancelIssuerRecovery returns CancelIssuerRecovery cobra command.
+func CmdTxCancelIssuerRecovery() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-dex-unified-ref-amount [denom] [unified-ref-amount] --from [sender]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Update DEX unified ref amount",
+		Use:   "cancel-issuer-recovery --from [issuer]",
+		Args:  cobra.NoArgs,
+		Short: "Cancel the designation of the successor of the issuer",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Update DEX unified ref amount.
+			fmt.Sprintf(`Cancel the designation of the successor of the issuer.
+
 Example:
-$ %s tx %s update-dex-unified-ref-amount ABC-%s 1000.5 --from [sender]
+$ %s tx %s cancel-issuer-recovery --from [issuer]
 `,
-				version.AppName, types.ModuleName, constant.AddressSampleTest,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -835,20 +2290,8 @@ $ %s tx %s update-dex-unified-ref-amount ABC-%s 1000.5 --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			denom := args[0]
-			if err = sdk.ValidateDenom(denom); err != nil {
-				return sdkerrors.Wrap(err, "invalid denom")
-			}
-			unifiedRefAmount, err := sdkmath.LegacyNewDecFromStr(args[1])
-			if err != nil {
-				return errors.Wrapf(err, "invalid %s value", DEXUnifiedRefAmountFlag)
-			}
-
-			msg := &types.MsgUpdateDEXUnifiedRefAmount{
-				Sender:           sender.String(),
-				Denom:            denom,
-				UnifiedRefAmount: unifiedRefAmount,
+			msg := &types.MsgCancelIssuerRecovery{
+				Sender: clientCtx.GetFromAddress().String(),
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -859,18 +2302,20 @@ $ %s tx %s update-dex-unified-ref-amount ABC-%s 1000.5 --from [sender]
 	return cmd
 }
 
-// CmdUpdateDEXWhitelistedDenoms returns UpdateDEXWhitelistedDenoms cobra command.
-func CmdUpdateDEXWhitelistedDenoms() *cobra.Command {
+// CmdTxClaimIssuerRecovery returns ClaimIssuerRecovery cobra command.
+func CmdTxClaimIssuerRecovery() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-dex-whitelisted-denoms [denom] --dex-whitelisted-denoms [dex-whitelisted-denoms] --from [sender]",
+		Use:   "claim-issuer-recovery [issuer] --from [successor]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Update DEX whitelisted denoms",
+		Short: "Claim the admin rights of the tokens of the inactive issuer",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Update DEX whitelisted denoms.
+			fmt.Sprintf(`Claim the admin rights of the tokens administered by the issuer which stayed inactive for the
+inactivity period of the designation. Only the successor designated by the issuer can claim them.
+
 Example:
-$ %s tx %s update-dex-whitelisted-denoms denom1 --dex-whitelisted-denoms denom2,denom3 --from [sender]
+$ %s tx %s claim-issuer-recovery %s --from [successor]
 `,
-				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -879,48 +2324,23 @@ $ %s tx %s update-dex-whitelisted-denoms denom1 --dex-whitelisted-denoms denom2,
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			denom := args[0]
-			if err = sdk.ValidateDenom(denom); err != nil {
-				return sdkerrors.Wrap(err, "invalid denom")
-			}
-
-			whitelistedDenoms, err := cmd.Flags().GetStringSlice(DEXWhitelistedDenomsFlag)
-			if err != nil {
-				return errors.WithStack(err)
-			}
-
-			for _, whitelistedDenom := range whitelistedDenoms {
-				if err = sdk.ValidateDenom(whitelistedDenom); err != nil {
-					return sdkerrors.Wrapf(err, "invalid denom %s", whitelistedDenom)
-				}
-			}
-
-			msg := &types.MsgUpdateDEXWhitelistedDenoms{
-				Sender:            sender.String(),
-				Denom:             denom,
-				WhitelistedDenoms: whitelistedDenoms,
+			msg := &types.MsgClaimIssuerRecovery{
+				Sender: clientCtx.GetFromAddress().String(),
+				Issuer: args[0],
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	//nolint:lll // breaking this down will make it look worse when printed to user screen.
-	cmd.Flags().StringSlice(DEXWhitelistedDenomsFlag, []string{}, "Denoms to add to be whitelisted to be traded with in DEX.")
-
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
-	exp, err := cmd.Flags().GetInt64(ExpirationFlag)
-	if err != nil {
-		return nil, err
-	}
-	if exp == 0 {
-		return nil, nil //nolint:nilnil //the intent of this function is to simplify return nil time.
+func parseRole(roleString string) (types.Role, error) {
+	role, ok := types.Role_value["ROLE_"+strings.ToUpper(roleString)]
+	if !ok {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidInput, "unknown role %q", roleString)
 	}
-	e := time.Unix(exp, 0)
-	return &e, nil
+	return types.Role(role), nil
 }
@@ -36,6 +36,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			URIHash:            token.URIHash,
 			Admin:              token.Admin,
 			ExtensionCWAddress: token.ExtensionCWAddress,
+			ObserverCWAddress:  token.ObserverCWAddress,
 		}
 
 		if err := k.SetDefinition(ctx, issuer, subunit, definition); err != nil {
@@ -99,6 +100,120 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+
+	for _, verifiedSymbol := range genState.VerifiedSymbols {
+		if err := k.SetVerifiedSymbol(ctx, verifiedSymbol); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, claim := range genState.SymbolClaims {
+		if err := k.SetSymbolClaim(ctx, claim); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, stats := range genState.ReferrerStats {
+		if err := k.SetReferrerStats(ctx, stats); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetBuybackStats(ctx, genState.BuybackStats); err != nil {
+		panic(err)
+	}
+
+	for _, breakdown := range genState.SupplyBreakdowns {
+		if err := k.SetSupplyBreakdown(ctx, breakdown); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, hold := range genState.LegalHolds {
+		if err := k.SetLegalHold(ctx, hold); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.ImportFreezeExemptions(ctx, genState.FreezeExemptions); err != nil {
+		panic(err)
+	}
+
+	if err := k.ImportRoleAssignments(ctx, genState.RoleAssignments); err != nil {
+		panic(err)
+	}
+
+	for _, reservation := range genState.SymbolReservations {
+		if err := k.SetSymbolReservation(ctx, reservation); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, allowance := range genState.MintAllowances {
+		if err := k.SetMintAllowance(ctx, allowance); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, preset := range genState.IssuePresets {
+		if err := k.SetIssuePreset(ctx, preset); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.ImportDustPolicies(ctx, genState.DustPolicies); err != nil {
+		panic(err)
+	}
+
+	if err := k.ImportDustOptOuts(ctx, genState.DustOptOuts); err != nil {
+		panic(err)
+	}
+
+	if err := k.ImportUsedBurnPermits(ctx, genState.UsedBurnPermits); err != nil {
+		panic(err)
+	}
+
+	for _, escrow := range genState.IssuanceEscrows {
+		if err := k.SetIssuanceEscrow(ctx, escrow); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, limit := range genState.SendRateLimits {
+		if err := k.SetSendRateLimitRecord(ctx, limit); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, usage := range genState.SendRateLimitUsages {
+		if err := k.SetSendRateLimitUsage(ctx, usage); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, update := range genState.PendingFeatureUpdates {
+		if err := k.SetPendingFeatureUpdate(ctx, update); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, change := range genState.PendingRateChanges {
+		if err := k.SetPendingRateChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, redenomination := range genState.Redenominations {
+		if err := k.SetRedenomination(ctx, redenomination); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, recovery := range genState.IssuerRecoveries {
+		if err := k.SetIssuerRecovery(ctx, recovery); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the asset module's exported genesis.
@@ -145,6 +260,113 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	verifiedSymbols, _, err := k.GetVerifiedSymbols(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	symbolClaims, _, err := k.GetSymbolClaims(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	referrerStats, _, err := k.GetAllReferrerStats(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	symbolReservations, _, err := k.GetSymbolReservations(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	mintAllowances, _, err := k.GetMintAllowances(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	issuePresets, _, err := k.GetIssuePresets(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	dustPolicies, _, err := k.GetDustPolicies(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	dustOptOuts, _, err := k.GetDustOptOuts(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	usedBurnPermits, _, err := k.GetUsedBurnPermits(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	issuanceEscrows, _, err := k.GetIssuanceEscrows(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	sendRateLimits, _, err := k.GetAllSendRateLimits(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	sendRateLimitUsages, _, err := k.GetSendRateLimitUsages(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	pendingFeatureUpdates, _, err := k.GetPendingFeatureUpdates(
+		ctx, &query.PageRequest{Limit: query.PaginationMaxLimit},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	pendingRateChanges, _, err := k.GetPendingRateChanges(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	redenominations, _, err := k.GetRedenominations(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	issuerRecoveries, _, err := k.GetIssuerRecoveries(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	buybackStats, err := k.GetBuybackStats(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	supplyBreakdowns, _, err := k.GetSupplyBreakdowns(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	legalHolds, _, err := k.GetAllLegalHolds(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	freezeExemptions, _, err := k.GetAllFreezeExemptions(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	roleAssignments, _, err := k.GetAllRoleAssignments(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		panic(err)
@@ -159,5 +381,26 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		DEXLockedBalances:            dexLockedBalances,
 		DEXExpectedToReceiveBalances: dexExpectedToReceiveBalances,
 		DEXSettings:                  dexSettings,
+		VerifiedSymbols:              verifiedSymbols,
+		SymbolClaims:                 symbolClaims,
+		ReferrerStats:                referrerStats,
+		SymbolReservations:           symbolReservations,
+		MintAllowances:               mintAllowances,
+		IssuePresets:                 issuePresets,
+		DustPolicies:                 dustPolicies,
+		DustOptOuts:                  dustOptOuts,
+		UsedBurnPermits:              usedBurnPermits,
+		IssuanceEscrows:              issuanceEscrows,
+		SendRateLimits:               sendRateLimits,
+		SendRateLimitUsages:          sendRateLimitUsages,
+		PendingFeatureUpdates:        pendingFeatureUpdates,
+		BuybackStats:                 buybackStats,
+		SupplyBreakdowns:             supplyBreakdowns,
+		LegalHolds:                   legalHolds,
+		FreezeExemptions:             freezeExemptions,
+		RoleAssignments:              roleAssignments,
+		PendingRateChanges:           pendingRateChanges,
+		Redenominations:              redenominations,
+		IssuerRecoveries:             issuerRecoveries,
 	}
 }
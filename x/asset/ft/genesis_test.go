@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/ed25519"
@@ -55,6 +56,9 @@ func TestInitAndExportGenesis(t *testing.T) {
 		if i%2 == 0 {
 			token.GloballyFrozen = true
 		}
+		if i == 1 {
+			token.ObserverCWAddress = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+		}
 		tokens = append(tokens, token)
 		requireT.NoError(ftKeeper.SetDenomMetadata(
 			ctx,
@@ -146,6 +150,122 @@ func TestInitAndExportGenesis(t *testing.T) {
 			DEXSettings: types.DEXSettings{},
 		})
 
+	// used burn permits
+	var usedBurnPermits []types.UsedBurnPermit
+	for i := range 3 {
+		usedBurnPermits = append(usedBurnPermits, types.UsedBurnPermit{
+			Holder: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Nonce:  uint64(i),
+		})
+	}
+
+	// send rate limits
+	var sendRateLimits []types.SendRateLimit
+	var sendRateLimitUsages []types.SendRateLimitUsage
+	for i := range 3 {
+		account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+		sendRateLimits = append(sendRateLimits, types.SendRateLimit{
+			Account: account,
+			Denom:   fmt.Sprintf("denom%d", i),
+			Amount:  sdkmath.NewInt(int64(100 * (i + 1))),
+			Window:  time.Hour,
+			PendingChange: &types.SendRateLimitChange{
+				Amount:        sdkmath.ZeroInt(),
+				EffectiveTime: time.Unix(1_700_000_000, 0).UTC(),
+			},
+		})
+		sendRateLimitUsages = append(sendRateLimitUsages, types.SendRateLimitUsage{
+			Account:         account,
+			Denom:           fmt.Sprintf("denom%d", i),
+			WindowResetTime: time.Unix(1_700_000_000, 0).UTC(),
+			WindowSent:      sdkmath.NewInt(int64(i)),
+		})
+	}
+
+	// pending feature updates
+	var pendingFeatureUpdates []types.FeatureUpdate
+	for i := range 2 {
+		pendingFeatureUpdates = append(pendingFeatureUpdates, types.FeatureUpdate{
+			Denom:           tokens[i].Denom,
+			EnableFeatures:  []types.Feature{types.Feature_burning},
+			DisableFeatures: []types.Feature{types.Feature_freezing},
+			EffectiveTime:   time.Unix(1_700_000_000, 0).UTC(),
+		})
+	}
+
+	// pending rate changes, also returned with the tokens
+	var pendingRateChanges []types.RateChange
+	for i := range 2 {
+		change := types.RateChange{
+			Denom:              tokens[i].Denom,
+			BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.01"),
+			SendCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.02"),
+			EffectiveTime:      time.Unix(1_700_000_000, 0).UTC(),
+		}
+		pendingRateChanges = append(pendingRateChanges, change)
+		tokens[i].PendingRateChange = &change
+	}
+
+	// redenominations
+	redenominations := []types.Redenomination{
+		{
+			Denom:         tokens[3].Denom,
+			NewDenom:      tokens[4].Denom,
+			Ratio:         10,
+			SwapDeadline:  time.Unix(1_700_000_000, 0).UTC(),
+			SwappedAmount: sdkmath.NewInt(100),
+		},
+		{
+			Denom:         tokens[2].Denom,
+			NewDenom:      tokens[3].Denom,
+			Ratio:         1,
+			SwapDeadline:  time.Unix(1_600_000_000, 0).UTC(),
+			SwappedAmount: sdkmath.NewInt(50),
+			Finalized:     true,
+		},
+	}
+
+	// issuer recoveries
+	var issuerRecoveries []types.IssuerRecovery
+	for i := range 2 {
+		issuerRecoveries = append(issuerRecoveries, types.IssuerRecovery{
+			Issuer:           sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Successor:        sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			InactivityPeriod: time.Duration(i+1) * types.DefaultMinIssuerRecoveryInactivityPeriod,
+			LastActivityTime: time.Unix(1_700_000_000+int64(i), 0).UTC(),
+		})
+	}
+
+	// legal holds
+	var legalHolds []types.LegalHold
+	for i := range 2 {
+		legalHolds = append(legalHolds, types.LegalHold{
+			Account:              sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Amount:               sdk.NewInt64Coin(tokens[i].Denom, int64(10*(i+1))),
+			AdminReleaseApproved: i == 0,
+		})
+	}
+
+	// freeze exemptions
+	var freezeExemptions []types.FreezeExemption
+	for i := range 2 {
+		freezeExemptions = append(freezeExemptions, types.FreezeExemption{
+			Denom:          tokens[i].Denom,
+			Account:        sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			ExpirationTime: time.Unix(1_700_000_000+int64(i), 0).UTC(),
+		})
+	}
+
+	// role assignments
+	var roleAssignments []types.RoleAssignment
+	for i := range 2 {
+		roleAssignments = append(roleAssignments, types.RoleAssignment{
+			Denom:   tokens[i].Denom,
+			Account: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Role:    types.ROLE_COMPLIANCE,
+		})
+	}
+
 	genState := types.GenesisState{
 		Params:                       types.DefaultParams(),
 		Tokens:                       tokens,
@@ -155,6 +275,21 @@ func TestInitAndExportGenesis(t *testing.T) {
 		DEXLockedBalances:            dexLockedBalances,
 		DEXExpectedToReceiveBalances: dexExpectedToReceiveBalances,
 		DEXSettings:                  dexSettings,
+		UsedBurnPermits:              usedBurnPermits,
+		SendRateLimits:               sendRateLimits,
+		SendRateLimitUsages:          sendRateLimitUsages,
+		PendingFeatureUpdates:        pendingFeatureUpdates,
+		LegalHolds:                   legalHolds,
+		FreezeExemptions:             freezeExemptions,
+		RoleAssignments:              roleAssignments,
+		PendingRateChanges:           pendingRateChanges,
+		Redenominations:              redenominations,
+		IssuerRecoveries:             issuerRecoveries,
+		BuybackStats: types.BuybackStats{
+			Burnt:              sdk.NewCoins(sdk.NewInt64Coin(tokens[0].Denom, 100)),
+			CommunityPool:      sdk.NewCoins(sdk.NewInt64Coin(tokens[1].Denom, 50)),
+			LastProcessingTime: time.Unix(1_700_000_000, 0).UTC(),
+		},
 	}
 
 	// init the keeper
@@ -229,4 +364,15 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.ElementsMatch(genState.DEXExpectedToReceiveBalances, exportedGenState.DEXExpectedToReceiveBalances)
 	assertT.ElementsMatch(genState.DEXLockedBalances, exportedGenState.DEXLockedBalances)
 	assertT.ElementsMatch(genState.DEXSettings, exportedGenState.DEXSettings)
+	assertT.ElementsMatch(genState.UsedBurnPermits, exportedGenState.UsedBurnPermits)
+	assertT.ElementsMatch(genState.SendRateLimits, exportedGenState.SendRateLimits)
+	assertT.ElementsMatch(genState.SendRateLimitUsages, exportedGenState.SendRateLimitUsages)
+	assertT.ElementsMatch(genState.PendingFeatureUpdates, exportedGenState.PendingFeatureUpdates)
+	assertT.ElementsMatch(genState.LegalHolds, exportedGenState.LegalHolds)
+	assertT.ElementsMatch(genState.FreezeExemptions, exportedGenState.FreezeExemptions)
+	assertT.ElementsMatch(genState.RoleAssignments, exportedGenState.RoleAssignments)
+	assertT.ElementsMatch(genState.PendingRateChanges, exportedGenState.PendingRateChanges)
+	assertT.ElementsMatch(genState.Redenominations, exportedGenState.Redenominations)
+	assertT.ElementsMatch(genState.IssuerRecoveries, exportedGenState.IssuerRecoveries)
+	assertT.Equal(genState.BuybackStats, exportedGenState.BuybackStats)
 }
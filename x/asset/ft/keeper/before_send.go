@@ -9,6 +9,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 	"github.com/tokenize-x/tx-chain/v7/x/wasm"
 	cwasmtypes "github.com/tokenize-x/tx-chain/v7/x/wasm/types"
 	wibctransfertypes "github.com/tokenize-x/tx-chain/v7/x/wibctransfer/types"
@@ -72,6 +73,12 @@ func (k Keeper) applyFeatures(ctx sdk.Context, input banktypes.Input, outputs []
 			return sdkerrors.Wrapf(err, "invalid address %s", output.Address)
 		}
 		for _, coin := range output.Coins {
+			if !isFeaturesBypassed(ctx) {
+				if err := k.useSendRateLimit(ctx, sender, coin); err != nil {
+					return err
+				}
+			}
+
 			def, err := k.getDefinitionOrNil(ctx, coin.Denom)
 			if err != nil {
 				return err
@@ -88,6 +95,15 @@ func (k Keeper) applyFeatures(ctx sdk.Context, input banktypes.Input, outputs []
 				continue
 			}
 
+			// The transfers initiated by the module after the own validation, like the dust sweeping to the community
+			// pool, are executed without the features.
+			if isFeaturesBypassed(ctx) {
+				if err := k.bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(coin)); err != nil {
+					return err
+				}
+				continue
+			}
+
 			// This check is effective when IBC transfer is acknowledged by the peer chain or timed out.
 			// It happens in the following situations:
 			// - when transfer succeeded
@@ -102,15 +118,30 @@ func (k Keeper) applyFeatures(ctx sdk.Context, input banktypes.Input, outputs []
 				if err := k.bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(coin)); err != nil {
 					return err
 				}
+				k.notifyTransfer(ctx, *def, sender, recipient, coin)
 				continue
 			}
 
+			// The token replaced by the redenomination can be only swapped for the new one.
+			if err := k.validateNotRedenominated(ctx, def.Denom); err != nil {
+				return err
+			}
+
+			useExtension := def.IsFeatureEnabled(types.Feature_extension)
+			if useExtension {
+				bypassed, err := k.isExtensionBypassed(ctx)
+				if err != nil {
+					return err
+				}
+				useExtension = !bypassed
+			}
+
 			burnAmount := k.CalculateRate(ctx, def.BurnRate, sender, coin)
 			commissionAmount := k.CalculateRate(ctx, def.SendCommissionRate, sender, coin)
 
 			senderOrReceiverIsAdmin := def.Admin == sender.String() || def.Admin == recipient.String()
 
-			if !senderOrReceiverIsAdmin && !def.IsFeatureEnabled(types.Feature_extension) {
+			if !senderOrReceiverIsAdmin && !useExtension {
 				if err := k.applyCommissionAndBurnRate(ctx, sender, def, commissionAmount, burnAmount); err != nil {
 					return err
 				}
@@ -124,18 +155,23 @@ func (k Keeper) applyFeatures(ctx sdk.Context, input banktypes.Input, outputs []
 				return err
 			}
 
-			if def.IsFeatureEnabled(types.Feature_extension) {
+			if useExtension {
 				if err := k.invokeAssetExtensionExtensionTransferMethod(
 					ctx, sender, recipient, *def, coin, commissionAmount, burnAmount,
 				); err != nil {
 					return err
 				}
+				// the transfer executed by the extension contract itself is the part of the already notified transfer
+				if def.ExtensionCWAddress != sender.String() {
+					k.notifyTransfer(ctx, *def, sender, recipient, coin)
+				}
 				continue
 			}
 
 			if err := k.bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(coin)); err != nil {
 				return err
 			}
+			k.notifyTransfer(ctx, *def, sender, recipient, coin)
 		}
 	}
 
@@ -145,12 +181,48 @@ func (k Keeper) applyFeatures(ctx sdk.Context, input banktypes.Input, outputs []
 	return nil
 }
 
+type featuresBypassedKey struct{}
+
+// withFeaturesBypassed marks the context so the transfers executed with it skip the features of the tokens.
+func withFeaturesBypassed(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(featuresBypassedKey{}, true)
+}
+
+func isFeaturesBypassed(ctx sdk.Context) bool {
+	bypassed, ok := ctx.Value(featuresBypassedKey{}).(bool)
+	return ok && bypassed
+}
+
+// isExtensionBypassed returns true if the extension tokens must be transferred as the plain tokens because the wasm
+// execution is paused, or an error if such transfers are rejected while it is paused.
+func (k Keeper) isExtensionBypassed(ctx sdk.Context) (bool, error) {
+	wasmParams, err := k.customParamsKeeper.GetWasmParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !wasmParams.Paused {
+		return false, nil
+	}
+	if !wasmParams.PlainExtensionTransfers {
+		return false, customparamstypes.ErrWasmPaused.Wrap("the token with the extension can't be transferred")
+	}
+
+	return true, nil
+}
+
 func (k Keeper) applyCommissionAndBurnRate(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
 	def *types.Definition,
 	commissionAmount, burnAmount sdkmath.Int,
 ) error {
+	if commissionAmount.IsPositive() {
+		var err error
+		if commissionAmount, err = k.routeCommissionToBuyback(ctx, sender, def.Denom, commissionAmount); err != nil {
+			return err
+		}
+	}
+
 	if commissionAmount.IsPositive() {
 		adminAddr, err := sdk.AccAddressFromBech32(def.Admin)
 		if err != nil {
@@ -163,7 +235,7 @@ func (k Keeper) applyCommissionAndBurnRate(
 	}
 
 	if burnAmount.IsPositive() {
-		if err := k.burnIfSpendable(ctx, sender, *def, burnAmount); err != nil {
+		if err := k.burnIfSpendable(ctx, sender, *def, burnAmount, burnCategoryBurnRate); err != nil {
 			return err
 		}
 	}
@@ -227,6 +299,7 @@ func (k Keeper) invokeAssetExtensionExtensionTransferMethod(
 		return sdkerrors.Wrapf(err, "failed to marshal contract msg")
 	}
 
+	k.recordExtensionCall(ctx, extensionContract, ExtensionTransferMethod)
 	_, err = k.wasmPermissionedKeeper.Sudo(
 		ctx,
 		extensionContract,
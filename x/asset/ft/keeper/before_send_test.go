@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -35,7 +36,9 @@ func TestApplyRate(t *testing.T) {
 	issuer := genAccount()
 	dummyAddress := genAccount()
 	key := storetypes.NewKVStoreKey(types.StoreKey)
-	assetFTKeeper := assetftkeeper.NewKeeper(nil, runtime.NewKVStoreService(key), nil, nil, nil, nil, nil, nil, "")
+	assetFTKeeper := assetftkeeper.NewKeeper(
+		nil, runtime.NewKVStoreService(key), nil, nil, nil, nil, nil, nil, nil, nil, "", log.NewNopLogger(),
+	)
 
 	testCases := []struct {
 		name         string
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// FeatureUpdateKeeper defines methods required to apply the pending feature updates.
+type FeatureUpdateKeeper interface {
+	ApplyPendingFeatureUpdate(ctx sdk.Context, data *types.DelayedFeatureUpdate) error
+}
+
+// NewDelayFeatureUpdateHandler handles the pending feature update.
+func NewDelayFeatureUpdateHandler(
+	keeper FeatureUpdateKeeper,
+) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.DelayedFeatureUpdate)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.ApplyPendingFeatureUpdate(ctx, msg)
+	}
+}
@@ -2,11 +2,17 @@ package keeper
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
 )
@@ -18,12 +24,14 @@ var _ types.QueryServer = QueryService{}
 //nolint:interfacebloat // breaking down this interface is not beneficial.
 type QueryKeeper interface {
 	GetParams(ctx sdk.Context) (types.Params, error)
+	GetTokens(ctx sdk.Context, pagination *query.PageRequest) ([]types.Token, *query.PageResponse, error)
 	GetIssuerTokens(
 		ctx sdk.Context,
 		issuer sdk.AccAddress,
 		pagination *query.PageRequest,
 	) ([]types.Token, *query.PageResponse, error)
 	GetToken(ctx sdk.Context, denom string) (types.Token, error)
+	GetTokensByDenoms(ctx sdk.Context, denoms []string) ([]types.Token, []string, error)
 	GetTokenUpgradeStatuses(ctx sdk.Context, denom string) (types.TokenUpgradeStatuses, error)
 	GetFrozenBalances(
 		ctx sdk.Context,
@@ -40,25 +48,104 @@ type QueryKeeper interface {
 	GetDEXLockedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetDEXExpectedToReceivedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetDEXSettings(ctx sdk.Context, denom string) (types.DEXSettings, error)
+	GetVerifiedSymbols(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.VerifiedSymbol, *query.PageResponse, error)
+	GetVerifiedSymbol(ctx sdk.Context, symbol string) (types.VerifiedSymbol, error)
+	GetSymbolClaims(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.SymbolClaim, *query.PageResponse, error)
+	GetReferrerStats(ctx sdk.Context, referrer sdk.AccAddress) (types.ReferrerStats, error)
+	GetSymbolReservation(ctx sdk.Context, symbol string) (types.SymbolReservation, error)
+	GetMintAllowance(ctx sdk.Context, denom string, grantee sdk.AccAddress) (types.MintAllowance, error)
+	GetIssuePresets(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.IssuePreset, *query.PageResponse, error)
+	GetIssuePreset(ctx sdk.Context, name string) (types.IssuePreset, error)
+	GetDustPolicy(ctx sdk.Context, denom string) (types.DustPolicy, error)
+	IsDustOptedOut(ctx sdk.Context, denom string, addr sdk.AccAddress) (bool, error)
+	GetIssuanceEscrow(ctx sdk.Context, denom string) (types.IssuanceEscrow, error)
+	GetSendRateLimits(
+		ctx sdk.Context,
+		account sdk.AccAddress,
+		pagination *query.PageRequest,
+	) ([]types.SendRateLimit, *query.PageResponse, error)
+	GetSendRateLimitHeadroom(
+		ctx sdk.Context,
+		account sdk.AccAddress,
+		denom string,
+	) (types.SendRateLimit, sdkmath.Int, *time.Time, error)
+	GetPendingFeatureUpdate(ctx sdk.Context, denom string) (types.FeatureUpdate, error)
+	GetBuybackStats(ctx sdk.Context) (types.BuybackStats, error)
+	GetPendingBuyback(ctx sdk.Context) sdk.Coins
+	GetSupplyBreakdown(ctx sdk.Context, denom string) (types.SupplyBreakdown, error)
+	GetPrecision(ctx sdk.Context, denom string) (uint32, error)
+	GetLegalHolds(
+		ctx sdk.Context,
+		addr sdk.AccAddress,
+		pagination *query.PageRequest,
+	) ([]types.LegalHold, *query.PageResponse, error)
+	GetLegalHold(ctx sdk.Context, addr sdk.AccAddress, denom string) (types.LegalHold, error)
+	GetFreezeExemptions(
+		ctx sdk.Context,
+		denom string,
+		pagination *query.PageRequest,
+	) ([]types.FreezeExemption, *query.PageResponse, error)
+	GetRoleAssignments(
+		ctx sdk.Context,
+		denom string,
+		pagination *query.PageRequest,
+	) ([]types.RoleAssignment, *query.PageResponse, error)
+	GetAccountRoles(ctx sdk.Context, denom string, addr sdk.AccAddress) ([]types.Role, error)
+	GetRedenomination(ctx sdk.Context, denom string) (types.Redenomination, error)
+	GetRedenominations(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) ([]types.Redenomination, *query.PageResponse, error)
+	GetUnswappedAmount(ctx sdk.Context, redenomination types.Redenomination) sdkmath.Int
+	GetIssuerRecovery(ctx sdk.Context, issuer sdk.AccAddress) (types.IssuerRecovery, error)
 }
 
 // BankKeeper represents required methods of bank keeper.
 type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
+
+// TransferKeeper represents required methods of IBC transfer keeper.
+type TransferKeeper interface {
+	GetDenom(ctx sdk.Context, denomHash cmtbytes.HexBytes) (ibctransfertypes.Denom, bool)
+}
+
+// ChannelKeeper represents required methods of IBC channel keeper.
+type ChannelKeeper interface {
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
 }
 
 // QueryService serves grpc query requests for assets module.
 type QueryService struct {
-	keeper     QueryKeeper
-	bankKeeper BankKeeper
+	keeper         QueryKeeper
+	bankKeeper     BankKeeper
+	transferKeeper TransferKeeper
+	channelKeeper  ChannelKeeper
 }
 
 // NewQueryService initiates the new instance of query service.
-func NewQueryService(keeper QueryKeeper, bankKeeper BankKeeper) QueryService {
+func NewQueryService(
+	keeper QueryKeeper,
+	bankKeeper BankKeeper,
+	transferKeeper TransferKeeper,
+	channelKeeper ChannelKeeper,
+) QueryService {
 	return QueryService{
-		keeper:     keeper,
-		bankKeeper: bankKeeper,
+		keeper:         keeper,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
 	}
 }
 
@@ -73,11 +160,28 @@ func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest
 
 // Tokens returns fungible tokens query result.
 func (qs QueryService) Tokens(ctx context.Context, req *types.QueryTokensRequest) (*types.QueryTokensResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// all the tokens are returned if the issuer is not provided
+	if req.Issuer == "" {
+		if req.Pagination != nil && req.Pagination.Limit > types.MaxTokensQueryLimit {
+			req.Pagination.Limit = types.MaxTokensQueryLimit
+		}
+		tokens, pageRes, err := qs.keeper.GetTokens(sdkCtx, req.Pagination)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryTokensResponse{
+			Pagination: pageRes,
+			Tokens:     tokens,
+		}, nil
+	}
+
 	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
 	if err != nil {
-		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "issuer is required and must be valid account address")
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "issuer must be valid account address")
 	}
-	tokens, pageRes, err := qs.keeper.GetIssuerTokens(sdk.UnwrapSDKContext(ctx), issuer, req.Pagination)
+	tokens, pageRes, err := qs.keeper.GetIssuerTokens(sdkCtx, issuer, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +204,22 @@ func (qs QueryService) Token(ctx context.Context, req *types.QueryTokenRequest)
 	}, nil
 }
 
+// TokensByDenoms queries fungible tokens by denoms.
+func (qs QueryService) TokensByDenoms(
+	ctx context.Context,
+	req *types.QueryTokensByDenomsRequest,
+) (*types.QueryTokensByDenomsResponse, error) {
+	tokens, notFoundDenoms, err := qs.keeper.GetTokensByDenoms(sdk.UnwrapSDKContext(ctx), req.GetDenoms())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTokensByDenomsResponse{
+		Tokens:         tokens,
+		NotFoundDenoms: notFoundDenoms,
+	}, nil
+}
+
 // TokenUpgradeStatuses returns the token upgrade statuses of a specified denom.
 func (qs QueryService) TokenUpgradeStatuses(
 	ctx context.Context,
@@ -138,7 +258,7 @@ func (qs QueryService) Balance(
 		return nil, err
 	}
 
-	return &types.QueryBalanceResponse{
+	res := &types.QueryBalanceResponse{
 		Balance:                qs.bankKeeper.GetBalance(ctx, account, denom).Amount,
 		Whitelisted:            qs.keeper.GetWhitelistedBalance(sdkCtx, account, denom).Amount,
 		Frozen:                 frozenBalance.Amount,
@@ -146,7 +266,23 @@ func (qs QueryService) Balance(
 		LockedInVesting:        vestingLocked,
 		LockedInDEX:            dexLocked,
 		ExpectedToReceiveInDEX: expectedToReceiveInDEX,
-	}, nil
+	}
+
+	// the display amounts are returned only for the denoms with the precision stored in the metadata
+	if precision, err := qs.keeper.GetPrecision(sdkCtx, denom); err == nil {
+		res.Display = &types.BalanceDisplay{
+			Precision:              precision,
+			Balance:                types.ToDisplayAmount(res.Balance, precision),
+			Whitelisted:            types.ToDisplayAmount(res.Whitelisted, precision),
+			Frozen:                 types.ToDisplayAmount(res.Frozen, precision),
+			Locked:                 types.ToDisplayAmount(res.Locked, precision),
+			LockedInVesting:        types.ToDisplayAmount(res.LockedInVesting, precision),
+			LockedInDEX:            types.ToDisplayAmount(res.LockedInDEX, precision),
+			ExpectedToReceiveInDEX: types.ToDisplayAmount(res.ExpectedToReceiveInDEX, precision),
+		}
+	}
+
+	return res, nil
 }
 
 // FrozenBalances lists frozen balances on a given account.
@@ -244,3 +380,546 @@ func (qs QueryService) DEXSettings(
 		DEXSettings: settings,
 	}, nil
 }
+
+// VerifiedSymbols returns all the verified symbols.
+func (qs QueryService) VerifiedSymbols(
+	goCtx context.Context,
+	req *types.QueryVerifiedSymbolsRequest,
+) (*types.QueryVerifiedSymbolsResponse, error) {
+	verifiedSymbols, pageRes, err := qs.keeper.GetVerifiedSymbols(sdk.UnwrapSDKContext(goCtx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVerifiedSymbolsResponse{
+		Pagination:      pageRes,
+		VerifiedSymbols: verifiedSymbols,
+	}, nil
+}
+
+// VerifiedSymbol returns the verified symbol.
+func (qs QueryService) VerifiedSymbol(
+	goCtx context.Context,
+	req *types.QueryVerifiedSymbolRequest,
+) (*types.QueryVerifiedSymbolResponse, error) {
+	verifiedSymbol, err := qs.keeper.GetVerifiedSymbol(sdk.UnwrapSDKContext(goCtx), req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVerifiedSymbolResponse{
+		VerifiedSymbol: verifiedSymbol,
+	}, nil
+}
+
+// SymbolClaims returns all the pending symbol claims.
+func (qs QueryService) SymbolClaims(
+	goCtx context.Context,
+	req *types.QuerySymbolClaimsRequest,
+) (*types.QuerySymbolClaimsResponse, error) {
+	claims, pageRes, err := qs.keeper.GetSymbolClaims(sdk.UnwrapSDKContext(goCtx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySymbolClaimsResponse{
+		Pagination:   pageRes,
+		SymbolClaims: claims,
+	}, nil
+}
+
+// ReferrerStats returns the cumulative statistics of the issuances referred by the account.
+func (qs QueryService) ReferrerStats(
+	goCtx context.Context,
+	req *types.QueryReferrerStatsRequest,
+) (*types.QueryReferrerStatsResponse, error) {
+	referrer, err := sdk.AccAddressFromBech32(req.Referrer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid referrer address")
+	}
+
+	stats, err := qs.keeper.GetReferrerStats(sdk.UnwrapSDKContext(goCtx), referrer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReferrerStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+// SymbolReservation returns the reservation of the symbol.
+func (qs QueryService) SymbolReservation(
+	goCtx context.Context,
+	req *types.QuerySymbolReservationRequest,
+) (*types.QuerySymbolReservationResponse, error) {
+	reservation, err := qs.keeper.GetSymbolReservation(sdk.UnwrapSDKContext(goCtx), req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySymbolReservationResponse{
+		SymbolReservation: reservation,
+	}, nil
+}
+
+// MintAllowance returns the mint allowance of the grantee.
+func (qs QueryService) MintAllowance(
+	goCtx context.Context,
+	req *types.QueryMintAllowanceRequest,
+) (*types.QueryMintAllowanceResponse, error) {
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid grantee address")
+	}
+
+	allowance, err := qs.keeper.GetMintAllowance(sdk.UnwrapSDKContext(goCtx), req.Denom, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMintAllowanceResponse{
+		MintAllowance: allowance,
+		Remaining:     allowance.Remaining(),
+	}, nil
+}
+
+// IssuePresets returns the issue presets.
+func (qs QueryService) IssuePresets(
+	goCtx context.Context,
+	req *types.QueryIssuePresetsRequest,
+) (*types.QueryIssuePresetsResponse, error) {
+	presets, pageRes, err := qs.keeper.GetIssuePresets(sdk.UnwrapSDKContext(goCtx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIssuePresetsResponse{
+		Pagination: pageRes,
+		Presets:    presets,
+	}, nil
+}
+
+// IssuePreset returns the issue preset.
+func (qs QueryService) IssuePreset(
+	goCtx context.Context,
+	req *types.QueryIssuePresetRequest,
+) (*types.QueryIssuePresetResponse, error) {
+	preset, err := qs.keeper.GetIssuePreset(sdk.UnwrapSDKContext(goCtx), req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIssuePresetResponse{
+		Preset: preset,
+	}, nil
+}
+
+// ResolveDenom resolves the IBC denom to its trace, the chains it comes from and the token of the module if the base
+// denom is issued by it.
+func (qs QueryService) ResolveDenom(
+	goCtx context.Context,
+	req *types.QueryResolveDenomRequest,
+) (*types.QueryResolveDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hexHash, ok := strings.CutPrefix(req.Denom, ibctransfertypes.DenomPrefix+"/")
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "denom %q is not the IBC denom", req.Denom)
+	}
+	hash, err := ibctransfertypes.ParseHexHash(hexHash)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid IBC denom %q: %s", req.Denom, err)
+	}
+	denom, found := qs.transferKeeper.GetDenom(ctx, hash)
+	if !found || denom.IsNative() {
+		return nil, sdkerrors.Wrapf(cosmoserrors.ErrNotFound, "IBC denom %q not found", req.Denom)
+	}
+
+	// the first hop is the channel on this chain the token has been received on
+	firstHop := denom.Trace[0]
+	counterpartyChainID, err := qs.counterpartyChainID(ctx, firstHop.PortId, firstHop.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryResolveDenomResponse{
+		Denom:               denom,
+		Path:                denom.Path(),
+		CounterpartyChainId: counterpartyChainID,
+	}
+	if len(denom.Trace) == 1 {
+		res.OriginChainId = counterpartyChainID
+	}
+
+	token, err := qs.moduleToken(ctx, denom.Base)
+	if err != nil {
+		return nil, err
+	}
+	if token != nil {
+		res.Token = token
+		res.OriginChainId = ctx.ChainID()
+	}
+
+	return res, nil
+}
+
+// moduleToken returns the token of the module if the denom is issued by it, and nil otherwise.
+func (qs QueryService) moduleToken(ctx sdk.Context, denom string) (*types.Token, error) {
+	// the denom in the other format can't be issued by the module
+	if _, _, err := types.DeconstructDenom(denom); err != nil {
+		return nil, nil //nolint:nilnil // nil token means that the denom is not issued by the module
+	}
+	token, err := qs.keeper.GetToken(ctx, denom)
+	if err != nil {
+		if types.ErrTokenNotFound.Is(err) {
+			return nil, nil //nolint:nilnil // nil token means that the denom is not issued by the module
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (qs QueryService) counterpartyChainID(ctx sdk.Context, portID, channelID string) (string, error) {
+	_, clientState, err := qs.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return "", err
+	}
+	// only the tendermint light client provides the chain ID
+	chainIDClientState, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return "", nil
+	}
+
+	return chainIDClientState.GetChainID(), nil
+}
+
+// DustPolicy returns the dust policy of the token.
+func (qs QueryService) DustPolicy(
+	goCtx context.Context,
+	req *types.QueryDustPolicyRequest,
+) (*types.QueryDustPolicyResponse, error) {
+	policy, err := qs.keeper.GetDustPolicy(sdk.UnwrapSDKContext(goCtx), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDustPolicyResponse{
+		DustPolicy: policy,
+	}, nil
+}
+
+// DustOptOut returns whether the account is excluded from the dust sweeping of the token.
+func (qs QueryService) DustOptOut(
+	goCtx context.Context,
+	req *types.QueryDustOptOutRequest,
+) (*types.QueryDustOptOutResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	optOut, err := qs.keeper.IsDustOptedOut(sdk.UnwrapSDKContext(goCtx), req.Denom, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDustOptOutResponse{
+		OptOut: optOut,
+	}, nil
+}
+
+// IssuanceEscrow returns the escrow holding the initial supply of the token.
+func (qs QueryService) IssuanceEscrow(
+	goCtx context.Context,
+	req *types.QueryIssuanceEscrowRequest,
+) (*types.QueryIssuanceEscrowResponse, error) {
+	escrow, err := qs.keeper.GetIssuanceEscrow(sdk.UnwrapSDKContext(goCtx), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIssuanceEscrowResponse{
+		IssuanceEscrow: escrow,
+	}, nil
+}
+
+// SendRateLimits returns the send rate limits configured by the account.
+func (qs QueryService) SendRateLimits(
+	goCtx context.Context,
+	req *types.QuerySendRateLimitsRequest,
+) (*types.QuerySendRateLimitsResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	limits, pageRes, err := qs.keeper.GetSendRateLimits(sdk.UnwrapSDKContext(goCtx), account, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySendRateLimitsResponse{
+		Pagination:     pageRes,
+		SendRateLimits: limits,
+	}, nil
+}
+
+// SendRateLimitHeadroom returns the amount of the denom the account may still send within the current window.
+func (qs QueryService) SendRateLimitHeadroom(
+	goCtx context.Context,
+	req *types.QuerySendRateLimitHeadroomRequest,
+) (*types.QuerySendRateLimitHeadroomResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	limit, remaining, windowResetTime, err := qs.keeper.GetSendRateLimitHeadroom(
+		sdk.UnwrapSDKContext(goCtx), account, req.Denom,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySendRateLimitHeadroomResponse{
+		SendRateLimit:   limit,
+		Remaining:       remaining,
+		WindowResetTime: windowResetTime,
+	}, nil
+}
+
+// PendingFeatureUpdate returns the update of the token features waiting for the announcement delay to pass.
+func (qs QueryService) PendingFeatureUpdate(
+	goCtx context.Context,
+	req *types.QueryPendingFeatureUpdateRequest,
+) (*types.QueryPendingFeatureUpdateResponse, error) {
+	update, err := qs.keeper.GetPendingFeatureUpdate(sdk.UnwrapSDKContext(goCtx), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingFeatureUpdateResponse{
+		FeatureUpdate: update,
+	}, nil
+}
+
+// BuybackStats returns the running totals of the buyback and the balance waiting for the next processing.
+func (qs QueryService) BuybackStats(
+	goCtx context.Context,
+	_ *types.QueryBuybackStatsRequest,
+) (*types.QueryBuybackStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	stats, err := qs.keeper.GetBuybackStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBuybackStatsResponse{
+		Stats:   stats,
+		Pending: qs.keeper.GetPendingBuyback(ctx),
+	}, nil
+}
+
+// SupplyBreakdown returns the cumulative amounts of the token minted and burnt by each burn category together with
+// the current supply.
+func (qs QueryService) SupplyBreakdown(
+	goCtx context.Context,
+	req *types.QuerySupplyBreakdownRequest,
+) (*types.QuerySupplyBreakdownResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	token, err := qs.keeper.GetToken(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	breakdown, err := qs.keeper.GetSupplyBreakdown(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	supply := qs.bankKeeper.GetSupply(ctx, req.Denom).Amount
+
+	return &types.QuerySupplyBreakdownResponse{
+		Breakdown:     breakdown,
+		Supply:        supply,
+		SupplyDisplay: types.ToDisplayAmount(supply, token.Precision),
+	}, nil
+}
+
+// ConvertAmount converts the amount between the subunit and the display unit using the precision of the token.
+func (qs QueryService) ConvertAmount(
+	goCtx context.Context,
+	req *types.QueryConvertAmountRequest,
+) (*types.QueryConvertAmountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	precision, err := qs.keeper.GetPrecision(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	var amount string
+	switch req.Direction {
+	case types.CONVERSION_DIRECTION_TO_DISPLAY:
+		subunits, ok := sdkmath.NewIntFromString(req.Amount)
+		if !ok || subunits.IsNegative() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid subunit amount %q", req.Amount)
+		}
+		amount = types.ToDisplayAmount(subunits, precision)
+	case types.CONVERSION_DIRECTION_TO_SUBUNIT:
+		subunits, err := types.ToSubunitAmount(req.Amount, precision)
+		if err != nil {
+			return nil, err
+		}
+		amount = subunits.String()
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid conversion direction %s", req.Direction)
+	}
+
+	return &types.QueryConvertAmountResponse{
+		Amount:    amount,
+		Precision: precision,
+	}, nil
+}
+
+// LegalHolds returns the legal holds placed on the account.
+func (qs QueryService) LegalHolds(
+	goCtx context.Context,
+	req *types.QueryLegalHoldsRequest,
+) (*types.QueryLegalHoldsResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	holds, pageRes, err := qs.keeper.GetLegalHolds(sdk.UnwrapSDKContext(goCtx), account, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLegalHoldsResponse{
+		Pagination: pageRes,
+		LegalHolds: holds,
+	}, nil
+}
+
+// LegalHold returns the legal hold of the denom placed on the account.
+func (qs QueryService) LegalHold(
+	goCtx context.Context,
+	req *types.QueryLegalHoldRequest,
+) (*types.QueryLegalHoldResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	hold, err := qs.keeper.GetLegalHold(sdk.UnwrapSDKContext(goCtx), account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLegalHoldResponse{LegalHold: hold}, nil
+}
+
+// FreezeExemptions returns the accounts exempted from the global freeze of the token.
+func (qs QueryService) FreezeExemptions(
+	goCtx context.Context,
+	req *types.QueryFreezeExemptionsRequest,
+) (*types.QueryFreezeExemptionsResponse, error) {
+	exemptions, pageRes, err := qs.keeper.GetFreezeExemptions(sdk.UnwrapSDKContext(goCtx), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFreezeExemptionsResponse{
+		Pagination:       pageRes,
+		FreezeExemptions: exemptions,
+	}, nil
+}
+
+// Roles returns the roles of the token assigned to the accounts.
+func (qs QueryService) Roles(
+	goCtx context.Context,
+	req *types.QueryRolesRequest,
+) (*types.QueryRolesResponse, error) {
+	assignments, pageRes, err := qs.keeper.GetRoleAssignments(sdk.UnwrapSDKContext(goCtx), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRolesResponse{
+		Pagination:      pageRes,
+		RoleAssignments: assignments,
+	}, nil
+}
+
+// AccountRoles returns the roles of the token assigned to the account.
+func (qs QueryService) AccountRoles(
+	goCtx context.Context,
+	req *types.QueryAccountRolesRequest,
+) (*types.QueryAccountRolesResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	roles, err := qs.keeper.GetAccountRoles(sdk.UnwrapSDKContext(goCtx), req.Denom, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountRolesResponse{Roles: roles}, nil
+}
+
+// Redenomination returns the redenomination of the token together with the amount of the old token not swapped yet.
+func (qs QueryService) Redenomination(
+	goCtx context.Context,
+	req *types.QueryRedenominationRequest,
+) (*types.QueryRedenominationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	redenomination, err := qs.keeper.GetRedenomination(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedenominationResponse{
+		Redenomination:  redenomination,
+		UnswappedAmount: qs.keeper.GetUnswappedAmount(ctx, redenomination),
+	}, nil
+}
+
+// Redenominations returns the redenominations of the tokens.
+func (qs QueryService) Redenominations(
+	goCtx context.Context,
+	req *types.QueryRedenominationsRequest,
+) (*types.QueryRedenominationsResponse, error) {
+	redenominations, pageRes, err := qs.keeper.GetRedenominations(sdk.UnwrapSDKContext(goCtx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedenominationsResponse{
+		Pagination:      pageRes,
+		Redenominations: redenominations,
+	}, nil
+}
+
+// IssuerRecovery returns the successor designated by the issuer.
+func (qs QueryService) IssuerRecovery(
+	goCtx context.Context,
+	req *types.QueryIssuerRecoveryRequest,
+) (*types.QueryIssuerRecoveryResponse, error) {
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid issuer address")
+	}
+
+	recovery, err := qs.keeper.GetIssuerRecovery(sdk.UnwrapSDKContext(goCtx), issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIssuerRecoveryResponse{
+		IssuerRecovery: recovery,
+		ClaimableTime:  recovery.ClaimableTime(),
+	}, nil
+}
@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+type channelKeeperMock struct {
+	chainIDs map[string]string
+}
+
+func (m channelKeeperMock) GetChannelClientState(
+	_ sdk.Context, portID, channelID string,
+) (string, ibcexported.ClientState, error) {
+	chainID, ok := m.chainIDs[portID+"/"+channelID]
+	if !ok {
+		return "", nil, cosmoserrors.ErrNotFound
+	}
+
+	return "07-tendermint-0", &ibctm.ClientState{ChainId: chainID}, nil
+}
+
+func TestQueryService_ResolveDenom(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{ChainID: "txchain-test"}).WithBlockTime(time.Now())
+
+	queryService := keeper.NewQueryService(
+		testApp.AssetFTKeeper,
+		testApp.BankKeeper,
+		testApp.TransferKeeper,
+		channelKeeperMock{
+			chainIDs: map[string]string{
+				"transfer/channel-0": "gaia",
+				"transfer/channel-1": "osmosis",
+			},
+		},
+	)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := testApp.AssetFTKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(100),
+	})
+	requireT.NoError(err)
+
+	singleHopDenom := ibctransfertypes.NewDenom("uatom", ibctransfertypes.NewHop("transfer", "channel-0"))
+	multiHopDenom := ibctransfertypes.NewDenom(
+		"uatom", ibctransfertypes.NewHop("transfer", "channel-1"), ibctransfertypes.NewHop("transfer", "channel-7"),
+	)
+	returnedTokenDenom := ibctransfertypes.NewDenom(
+		denom, ibctransfertypes.NewHop("transfer", "channel-1"), ibctransfertypes.NewHop("transfer", "channel-7"),
+	)
+	for _, d := range []ibctransfertypes.Denom{singleHopDenom, multiHopDenom, returnedTokenDenom} {
+		testApp.TransferKeeper.SetDenom(ctx, d)
+	}
+
+	// single hop
+	res, err := queryService.ResolveDenom(ctx, &types.QueryResolveDenomRequest{Denom: singleHopDenom.IBCDenom()})
+	requireT.NoError(err)
+	requireT.Equal(singleHopDenom, res.Denom)
+	requireT.Equal("transfer/channel-0/uatom", res.Path)
+	requireT.Equal("gaia", res.CounterpartyChainId)
+	requireT.Equal("gaia", res.OriginChainId)
+	requireT.Nil(res.Token)
+
+	// multi hop, the origin chain is unknown
+	res, err = queryService.ResolveDenom(ctx, &types.QueryResolveDenomRequest{Denom: multiHopDenom.IBCDenom()})
+	requireT.NoError(err)
+	requireT.Equal(multiHopDenom, res.Denom)
+	requireT.Equal("transfer/channel-1/transfer/channel-7/uatom", res.Path)
+	requireT.Equal("osmosis", res.CounterpartyChainId)
+	requireT.Empty(res.OriginChainId)
+	requireT.Nil(res.Token)
+
+	// multi hop of the token issued by the module
+	res, err = queryService.ResolveDenom(ctx, &types.QueryResolveDenomRequest{Denom: returnedTokenDenom.IBCDenom()})
+	requireT.NoError(err)
+	requireT.Equal(returnedTokenDenom, res.Denom)
+	requireT.Equal("osmosis", res.CounterpartyChainId)
+	requireT.Equal("txchain-test", res.OriginChainId)
+	requireT.NotNil(res.Token)
+	requireT.Equal(denom, res.Token.Denom)
+	requireT.Equal(issuer.String(), res.Token.Issuer)
+
+	// not the IBC denom
+	_, err = queryService.ResolveDenom(ctx, &types.QueryResolveDenomRequest{Denom: denom})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// invalid hash
+	_, err = queryService.ResolveDenom(ctx, &types.QueryResolveDenomRequest{Denom: "ibc/invalid"})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// unknown denom
+	_, err = queryService.ResolveDenom(ctx, &types.QueryResolveDenomRequest{
+		Denom: ibctransfertypes.NewDenom("unknown", ibctransfertypes.NewHop("transfer", "channel-0")).IBCDenom(),
+	})
+	requireT.ErrorIs(err, cosmoserrors.ErrNotFound)
+}
+
+func TestQueryService_Tokens(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+	queryService := keeper.NewQueryService(
+		testApp.AssetFTKeeper, testApp.BankKeeper, testApp.TransferKeeper, channelKeeperMock{},
+	)
+
+	issuer1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issuer2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	for i, issuer := range []sdk.AccAddress{issuer1, issuer1, issuer2} {
+		subunit := fmt.Sprintf("uabc%d", i)
+		_, err := testApp.AssetFTKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(100),
+		})
+		requireT.NoError(err)
+	}
+
+	// the tokens of the issuer
+	res, err := queryService.Tokens(ctx, &types.QueryTokensRequest{Issuer: issuer1.String()})
+	requireT.NoError(err)
+	requireT.Len(res.Tokens, 2)
+
+	// all the tokens are returned without the issuer
+	res, err = queryService.Tokens(ctx, &types.QueryTokensRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	requireT.NoError(err)
+	requireT.Len(res.Tokens, 1)
+	requireT.EqualValues(3, res.Pagination.Total)
+
+	_, err = queryService.Tokens(ctx, &types.QueryTokensRequest{Issuer: "invalid"})
+	requireT.ErrorIs(err, cosmoserrors.ErrInvalidAddress)
+}
+
+func TestQueryService_DisplayAmounts(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+	queryService := keeper.NewQueryService(
+		testApp.AssetFTKeeper, testApp.BankKeeper, testApp.TransferKeeper, channelKeeperMock{},
+	)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := testApp.AssetFTKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1_500_000),
+	})
+	requireT.NoError(err)
+
+	// the balance is returned in both units
+	balanceRes, err := queryService.Balance(ctx, &types.QueryBalanceRequest{
+		Account: issuer.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(1_500_000).String(), balanceRes.Balance.String())
+	requireT.NotNil(balanceRes.Display)
+	requireT.EqualValues(6, balanceRes.Display.Precision)
+	requireT.Equal("1.5", balanceRes.Display.Balance)
+	requireT.Equal("0", balanceRes.Display.Frozen)
+
+	// the display amounts aren't returned for the denom without metadata
+	balanceRes, err = queryService.Balance(ctx, &types.QueryBalanceRequest{
+		Account: issuer.String(),
+		Denom:   "unknown",
+	})
+	requireT.NoError(err)
+	requireT.Nil(balanceRes.Display)
+
+	supplyRes, err := queryService.SupplyBreakdown(ctx, &types.QuerySupplyBreakdownRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal("1.5", supplyRes.SupplyDisplay)
+
+	// conversions
+	convertRes, err := queryService.ConvertAmount(ctx, &types.QueryConvertAmountRequest{
+		Denom:     denom,
+		Amount:    "1234567",
+		Direction: types.CONVERSION_DIRECTION_TO_DISPLAY,
+	})
+	requireT.NoError(err)
+	requireT.Equal("1.234567", convertRes.Amount)
+	requireT.EqualValues(6, convertRes.Precision)
+
+	convertRes, err = queryService.ConvertAmount(ctx, &types.QueryConvertAmountRequest{
+		Denom:     denom,
+		Amount:    "1.23",
+		Direction: types.CONVERSION_DIRECTION_TO_SUBUNIT,
+	})
+	requireT.NoError(err)
+	requireT.Equal("1230000", convertRes.Amount)
+
+	_, err = queryService.ConvertAmount(ctx, &types.QueryConvertAmountRequest{
+		Denom:     denom,
+		Amount:    "1.2345678",
+		Direction: types.CONVERSION_DIRECTION_TO_SUBUNIT,
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	_, err = queryService.ConvertAmount(ctx, &types.QueryConvertAmountRequest{
+		Denom:     denom,
+		Amount:    "1.5",
+		Direction: types.CONVERSION_DIRECTION_TO_DISPLAY,
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	_, err = queryService.ConvertAmount(ctx, &types.QueryConvertAmountRequest{
+		Denom:  denom,
+		Amount: "1",
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	_, err = queryService.ConvertAmount(ctx, &types.QueryConvertAmountRequest{
+		Denom:     "unknown",
+		Amount:    "1",
+		Direction: types.CONVERSION_DIRECTION_TO_DISPLAY,
+	})
+	requireT.ErrorIs(err, types.ErrTokenNotFound)
+}
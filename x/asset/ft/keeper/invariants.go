@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	invarianttypes "github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+)
+
+const (
+	// FrozenBalancesInvariantRoute is the route of the frozen balances invariant.
+	FrozenBalancesInvariantRoute = "frozen-balances"
+	// DEXLockedBalancesInvariantRoute is the route of the DEX locked balances invariant.
+	DEXLockedBalancesInvariantRoute = "dex-locked-balances"
+	// WhitelistedBalancesInvariantRoute is the route of the whitelisted balances invariant.
+	WhitelistedBalancesInvariantRoute = "whitelisted-balances"
+	// SupplyInvariantRoute is the route of the supply invariant.
+	SupplyInvariantRoute = "supply"
+)
+
+// RegisterInvariants registers the asset ft module invariants.
+func RegisterInvariants(ir invarianttypes.Registry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, FrozenBalancesInvariantRoute, FrozenBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, DEXLockedBalancesInvariantRoute, DEXLockedBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, WhitelistedBalancesInvariantRoute, WhitelistedBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, SupplyInvariantRoute, SupplyInvariant(k))
+}
+
+// FrozenBalancesInvariant checks that the frozen balances are stored only for the issued tokens with the freezing
+// feature enabled. The frozen amount isn't compared with the balance since the admin is allowed to freeze more than
+// the account holds.
+func FrozenBalancesInvariant(k Keeper) invarianttypes.InvariantFunc {
+	return func(ctx sdk.Context) (string, bool) {
+		return k.featureBalancesInvariant(
+			ctx, FrozenBalancesInvariantRoute, types.FrozenBalancesKeyPrefix, types.Feature_freezing,
+		)
+	}
+}
+
+// WhitelistedBalancesInvariant checks that the whitelisted balances are stored only for the issued tokens with the
+// whitelisting feature enabled.
+func WhitelistedBalancesInvariant(k Keeper) invarianttypes.InvariantFunc {
+	return func(ctx sdk.Context) (string, bool) {
+		return k.featureBalancesInvariant(
+			ctx, WhitelistedBalancesInvariantRoute, types.WhitelistedBalancesKeyPrefix, types.Feature_whitelisting,
+		)
+	}
+}
+
+// DEXLockedBalancesInvariant checks that the amount locked by the DEX doesn't exceed the balance of the account.
+func DEXLockedBalancesInvariant(k Keeper) invarianttypes.InvariantFunc {
+	return func(ctx sdk.Context) (string, bool) {
+		var violations []string
+		count := 0
+		err := k.dexLockedAccountsBalanceStore(ctx).IterateAllBalances(func(addr sdk.AccAddress, locked sdk.Coin) bool {
+			count++
+			balance := k.bankKeeper.GetBalance(ctx, addr, locked.Denom)
+			if balance.IsLT(locked) {
+				violations = append(violations, fmt.Sprintf(
+					"account %s: locked %s is greater than the balance %s", addr, locked, balance,
+				))
+			}
+			return false
+		})
+
+		return formatBalancesInvariant(DEXLockedBalancesInvariantRoute, count, violations, err)
+	}
+}
+
+// SupplyInvariant checks that the supply of each token equals the minted amount minus the amounts burnt by all the
+// burn categories.
+func SupplyInvariant(k Keeper) invarianttypes.InvariantFunc {
+	return func(ctx sdk.Context) (string, bool) {
+		var violations []string
+		count := 0
+		err := k.IterateAllDefinitions(ctx, func(def types.Definition) (bool, error) {
+			count++
+			breakdown, err := k.GetSupplyBreakdown(ctx, def.Denom)
+			if err != nil {
+				return true, err
+			}
+			supply := k.bankKeeper.GetSupply(ctx, def.Denom).Amount
+			if !breakdown.Supply().Equal(supply) {
+				violations = append(violations, fmt.Sprintf(
+					"token %s: minted %s - burnt %s is not equal to the supply %s",
+					def.Denom, breakdown.Minted, breakdown.Burnt(), supply,
+				))
+			}
+			return false, nil
+		})
+		if err != nil {
+			return invarianttypes.FormatInvariant(
+				types.ModuleName, SupplyInvariantRoute, fmt.Sprintf("failed to check: %s", err),
+			), true
+		}
+		if len(violations) > 0 {
+			return invarianttypes.FormatInvariant(types.ModuleName, SupplyInvariantRoute, fmt.Sprintf(
+				"%d of %d tokens have invalid supply:\n%s", len(violations), count, strings.Join(violations, "\n"),
+			)), true
+		}
+
+		return invarianttypes.FormatInvariant(
+			types.ModuleName, SupplyInvariantRoute, fmt.Sprintf("%d tokens have valid supply", count),
+		), false
+	}
+}
+
+func (k Keeper) featureBalancesInvariant(
+	ctx sdk.Context,
+	route string,
+	keyPrefix []byte,
+	feature types.Feature,
+) (string, bool) {
+	definitions := make(map[string]*types.Definition)
+	var violations []string
+	count := 0
+	var defErr error
+	err := newBalanceStore(k.cdc, runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix).
+		IterateAllBalances(func(addr sdk.AccAddress, coin sdk.Coin) bool {
+			count++
+			if coin.IsNegative() {
+				violations = append(violations, fmt.Sprintf("account %s: negative amount %s", addr, coin))
+			}
+
+			def, ok := definitions[coin.Denom]
+			if !ok {
+				def, defErr = k.getDefinitionOrNil(ctx, coin.Denom)
+				if defErr != nil {
+					return true
+				}
+				definitions[coin.Denom] = def
+			}
+			switch {
+			case def == nil:
+				violations = append(violations, fmt.Sprintf("account %s: token %s is not issued", addr, coin.Denom))
+			case !def.IsFeatureEnabled(feature):
+				violations = append(violations, fmt.Sprintf(
+					"account %s: feature %s is disabled for %s", addr, feature, coin.Denom,
+				))
+			}
+			return false
+		})
+	if err == nil {
+		err = defErr
+	}
+
+	return formatBalancesInvariant(route, count, violations, err)
+}
+
+func formatBalancesInvariant(route string, count int, violations []string, err error) (string, bool) {
+	if err != nil {
+		return invarianttypes.FormatInvariant(types.ModuleName, route, fmt.Sprintf("failed to check: %s", err)), true
+	}
+	if len(violations) > 0 {
+		return invarianttypes.FormatInvariant(types.ModuleName, route, fmt.Sprintf(
+			"%d of %d balances are invalid:\n%s", len(violations), count, strings.Join(violations, "\n"),
+		)), true
+	}
+
+	return invarianttypes.FormatInvariant(types.ModuleName, route, fmt.Sprintf("%d balances are valid", count)), false
+}
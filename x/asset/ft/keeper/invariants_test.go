@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestInvariants(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	freezableDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FRZ",
+		Subunit:       "ufrz",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []types.Feature{types.Feature_freezing, types.Feature_whitelisting},
+	})
+	requireT.NoError(err)
+	plainDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "PLN",
+		Subunit:       "upln",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	// the frozen amount may exceed the balance
+	requireT.NoError(ftKeeper.SetFrozen(ctx, issuer, recipient, sdk.NewInt64Coin(freezableDenom, 500)))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewInt64Coin(freezableDenom, 10)))
+	requireT.NoError(testApp.BankKeeper.SendCoins(
+		ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(plainDenom, 100)),
+	))
+	requireT.NoError(ftKeeper.DEXIncreaseLocked(ctx, recipient, sdk.NewInt64Coin(plainDenom, 100)))
+
+	for _, invariant := range []func(ctx sdk.Context) (string, bool){
+		keeper.FrozenBalancesInvariant(ftKeeper),
+		keeper.WhitelistedBalancesInvariant(ftKeeper),
+		keeper.DEXLockedBalancesInvariant(ftKeeper),
+	} {
+		msg, broken := invariant(ctx)
+		requireT.False(broken, msg)
+	}
+
+	// the balances of the tokens without the features
+	ftKeeper.SetFrozenBalances(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin(plainDenom, 1)))
+	msg, broken := keeper.FrozenBalancesInvariant(ftKeeper)(ctx)
+	requireT.True(broken)
+	requireT.Contains(msg, plainDenom)
+
+	ftKeeper.SetWhitelistedBalances(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("unknown", 1)))
+	msg, broken = keeper.WhitelistedBalancesInvariant(ftKeeper)(ctx)
+	requireT.True(broken)
+	requireT.Contains(msg, "unknown")
+
+	// the locked amount exceeding the balance
+	ftKeeper.SetDEXLockedBalances(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin(plainDenom, 101)))
+	msg, broken = keeper.DEXLockedBalancesInvariant(ftKeeper)(ctx)
+	requireT.True(broken)
+	requireT.Contains(msg, recipient.String())
+}
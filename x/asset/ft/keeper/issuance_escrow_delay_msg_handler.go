@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// IssuanceEscrowExpirationKeeper defines methods required to expire the issuance escrows.
+type IssuanceEscrowExpirationKeeper interface {
+	ExpireIssuanceEscrow(ctx sdk.Context, data *types.DelayedIssuanceEscrowExpiration) error
+}
+
+// NewDelayIssuanceEscrowExpirationHandler handles the issuance escrow expiration.
+func NewDelayIssuanceEscrowExpirationHandler(
+	keeper IssuanceEscrowExpirationKeeper,
+) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.DelayedIssuanceEscrowExpiration)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.ExpireIssuanceEscrow(ctx, msg)
+	}
+}
@@ -20,8 +20,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/pkg/errors"
 
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 	"github.com/tokenize-x/tx-chain/v7/x/wasm"
 	cwasmtypes "github.com/tokenize-x/tx-chain/v7/x/wasm/types"
 	wibctransfertypes "github.com/tokenize-x/tx-chain/v7/x/wibctransfer/types"
@@ -46,7 +48,11 @@ type Keeper struct {
 	wasmKeeper             cwasmtypes.WasmKeeper
 	wasmPermissionedKeeper types.WasmPermissionedKeeper
 	accountKeeper          types.AccountKeeper
+	customParamsKeeper     types.CustomParamsKeeper
+	distributionKeeper     types.DistributionKeeper
 	authority              string
+	logger                 log.Logger
+	transferListeners      *transferListeners
 }
 
 // NewKeeper creates a new instance of the Keeper.
@@ -59,7 +65,10 @@ func NewKeeper(
 	wasmKeeper cwasmtypes.WasmKeeper,
 	wasmPermissionedKeeper types.WasmPermissionedKeeper,
 	accountKeeper types.AccountKeeper,
+	customParamsKeeper types.CustomParamsKeeper,
+	distributionKeeper types.DistributionKeeper,
 	authority string,
+	logger log.Logger,
 ) Keeper {
 	return Keeper{
 		cdc:                    cdc,
@@ -70,7 +79,11 @@ func NewKeeper(
 		wasmKeeper:             wasmKeeper,
 		wasmPermissionedKeeper: wasmPermissionedKeeper,
 		accountKeeper:          accountKeeper,
+		customParamsKeeper:     customParamsKeeper,
+		distributionKeeper:     distributionKeeper,
 		authority:              authority,
+		logger:                 logger,
+		transferListeners:      &transferListeners{},
 	}
 }
 
@@ -128,6 +141,9 @@ func (k Keeper) GetIssuerTokens(
 	issuer sdk.AccAddress,
 	pagination *query.PageRequest,
 ) ([]types.Token, *query.PageResponse, error) {
+	if pagination != nil && pagination.Limit > types.MaxTokensQueryLimit {
+		pagination.Limit = types.MaxTokensQueryLimit
+	}
 	definitions, pageResponse, err := k.getIssuerDefinitions(ctx, issuer, pagination)
 	if err != nil {
 		return nil, nil, err
@@ -141,6 +157,43 @@ func (k Keeper) GetIssuerTokens(
 	return tokens, pageResponse, nil
 }
 
+// GetTokensByDenoms returns fungible tokens by the denoms, the denoms of the tokens not issued by the module are
+// returned separately.
+func (k Keeper) GetTokensByDenoms(ctx sdk.Context, denoms []string) ([]types.Token, []string, error) {
+	if len(denoms) > types.MaxTokensQueryLimit {
+		return nil, nil, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "number of denoms must not exceed %d", types.MaxTokensQueryLimit,
+		)
+	}
+
+	tokens := make([]types.Token, 0, len(denoms))
+	var notFoundDenoms []string
+	queried := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if _, found := queried[denom]; found {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "duplicated denom %s", denom)
+		}
+		queried[denom] = struct{}{}
+
+		definition, err := k.GetDefinition(ctx, denom)
+		if err != nil {
+			if errors.Is(err, types.ErrInvalidDenom) || errors.Is(err, types.ErrTokenNotFound) {
+				notFoundDenoms = append(notFoundDenoms, denom)
+				continue
+			}
+			return nil, nil, err
+		}
+
+		token, err := k.getTokenFullInfo(ctx, definition)
+		if err != nil {
+			return nil, nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, notFoundDenoms, nil
+}
+
 // IterateAllDefinitions iterates over all token definitions and applies the provided callback.
 // If true is returned from the callback, iteration is halted.
 func (k Keeper) IterateAllDefinitions(ctx sdk.Context, cb func(types.Definition) (bool, error)) error {
@@ -204,6 +257,14 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 //
 //nolint:funlen // breaking down this function will make it less readable.
 func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, version uint32) (string, error) {
+	if settings.Preset != "" {
+		preset, err := k.GetIssuePreset(ctx, settings.Preset)
+		if err != nil {
+			return "", err
+		}
+		settings = preset.Apply(settings)
+	}
+
 	if err := types.ValidateSubunit(settings.Subunit); err != nil {
 		return "", sdkerrors.Wrapf(err, "provided subunit: %s", settings.Subunit)
 	}
@@ -242,12 +303,23 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 		)
 	}
 
+	if err := k.consumeSymbolReservation(ctx, settings); err != nil {
+		return "", err
+	}
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		return "", err
 	}
+	referralFee := sdk.NewCoin(params.IssueFee.Denom, sdkmath.ZeroInt())
 	if params.IssueFee.IsPositive() {
-		if err = k.burnIssueFee(ctx, settings, params); err != nil {
+		if referralFee, err = k.burnIssueFee(ctx, settings, params); err != nil {
+			return "", err
+		}
+	}
+
+	if settings.Referrer != nil {
+		if err = k.recordReferral(ctx, settings, denom, referralFee); err != nil {
 			return "", err
 		}
 	}
@@ -277,6 +349,14 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 			return "", types.ErrInvalidInput.Wrap("extension settings must be provided")
 		}
 
+		wasmParams, err := k.customParamsKeeper.GetWasmParams(ctx)
+		if err != nil {
+			return "", err
+		}
+		if wasmParams.Paused {
+			return "", customparamstypes.ErrWasmPaused.Wrap("the token with the extension can't be issued")
+		}
+
 		if len(settings.ExtensionSettings.IssuanceMsg) == 0 {
 			settings.ExtensionSettings.IssuanceMsg = []byte("{}")
 		}
@@ -304,6 +384,10 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 			return "", sdkerrors.Wrapf(err, "error instantiating cw contract")
 		}
 
+		if err := k.pinExtensionCode(ctx, settings.ExtensionSettings.CodeId); err != nil {
+			return "", err
+		}
+
 		definition.ExtensionCWAddress = contractAddress.String()
 	}
 
@@ -356,11 +440,12 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 		URIHash:            settings.URIHash,
 		Admin:              settings.Issuer.String(),
 		DEXSettings:        settings.DEXSettings,
+		Preset:             settings.Preset,
 	}); err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssued event: %s", err)
 	}
 
-	k.logger(ctx).Debug(
+	k.logger.Debug(
 		"issued new fungible token",
 		"denom", denom,
 		"settings", settings,
@@ -441,21 +526,72 @@ func (k Keeper) SetDenomMetadata(
 	return nil
 }
 
-// Mint mints new fungible token.
+// Mint mints new fungible token. The sender must be either allowed to use the minting feature or hold the
+// mint allowance for the token.
 func (k Keeper) Mint(ctx sdk.Context, sender, recipient sdk.AccAddress, coin sdk.Coin) error {
+	def, err := k.authorizeMint(ctx, sender, coin)
+	if err != nil {
+		return err
+	}
+
+	return k.mintIfReceivable(ctx, def, coin.Amount, recipient)
+}
+
+// MintToRecipients mints new fungible token directly to multiple recipients. The coin is the total minted amount,
+// the maximum mintable amount and the mint allowance are applied to it. Each recipient is credited the same way as
+// by Mint, so the whitelisting, the extension and the other receiving rules are applied to each of them.
+func (k Keeper) MintToRecipients(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	coin sdk.Coin,
+	recipients []types.MintRecipient,
+) error {
+	if err := types.ValidateMintRecipients(coin.Amount, recipients); err != nil {
+		return err
+	}
+	def, err := k.authorizeMint(ctx, sender, coin)
+	if err != nil {
+		return err
+	}
+
+	for _, recipient := range recipients {
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid recipient address %s", recipient.Address)
+		}
+		if err := k.mintIfReceivable(ctx, def, recipient.Amount, recipientAddr); err != nil {
+			return sdkerrors.Wrapf(err, "failed to mint to %s", recipient.Address)
+		}
+	}
+
+	return nil
+}
+
+// authorizeMint checks that the sender is allowed to mint the coin and uses the mint allowance if the sender isn't
+// allowed to use the minting feature.
+func (k Keeper) authorizeMint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (types.Definition, error) {
 	if coin.Amount.GT(types.MaxMintableAmount) {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "minting amount is greater than maximum allowed")
+		return types.Definition{}, sdkerrors.Wrapf(types.ErrInvalidInput, "minting amount is greater than maximum allowed")
 	}
 	def, err := k.GetDefinition(ctx, coin.Denom)
 	if err != nil {
-		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+		return types.Definition{}, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+	if err := k.validateNotRedenominated(ctx, def.Denom); err != nil {
+		return types.Definition{}, err
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
-		return err
+	isAllowed, err := k.isFeatureAllowed(ctx, def, sender, types.Feature_minting)
+	if err != nil {
+		return types.Definition{}, err
+	}
+	if !isAllowed {
+		if err := k.useMintAllowance(ctx, def, sender, coin.Amount); err != nil {
+			return types.Definition{}, err
+		}
 	}
 
-	return k.mintIfReceivable(ctx, def, coin.Amount, recipient)
+	return def, nil
 }
 
 // Burn burns fungible tokens.
@@ -496,12 +632,12 @@ func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) erro
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	err = def.CheckFeatureAllowed(sender, types.Feature_burning)
+	err = k.checkFeatureAllowed(ctx, def, sender, types.Feature_burning)
 	if err != nil {
 		return err
 	}
 
-	return k.burnIfSpendable(ctx, sender, def, coin.Amount)
+	return k.burnIfSpendable(ctx, sender, def, coin.Amount, burnCategoryHolders)
 }
 
 // Freeze freezes specified token from the specified account.
@@ -528,7 +664,7 @@ func (k Keeper) Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Co
 		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFrozenAmountChanged event: %s", err)
 	}
 
-	return nil
+	return k.emitComplianceAction(ctx, sender, addr, coin.Denom, types.COMPLIANCE_ACTION_TYPE_FREEZE, coin.Amount)
 }
 
 // Unfreeze unfreezes specified tokens from the specified account.
@@ -563,7 +699,7 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFrozenAmountChanged event: %s", err)
 	}
 
-	return nil
+	return k.emitComplianceAction(ctx, sender, addr, coin.Denom, types.COMPLIANCE_ACTION_TYPE_UNFREEZE, coin.Amount)
 }
 
 // SetFrozen sets frozen amount on the specified account.
@@ -589,6 +725,35 @@ func (k Keeper) SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk
 		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFrozenAmountChanged event: %s", err)
 	}
 
+	return k.emitComplianceAction(ctx, sender, addr, coin.Denom, types.COMPLIANCE_ACTION_TYPE_SET_FROZEN, coin.Amount)
+}
+
+// FreezeRate sets the frozen amount of each account to the rate of its balance at the execution time.
+// The frozen amount replaces the previous one, so the explicit freezes of the accounts are overridden.
+func (k Keeper) FreezeRate(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	rate sdkmath.LegacyDec,
+	accounts []sdk.AccAddress,
+) error {
+	if err := types.ValidateFreezeRate(rate); err != nil {
+		return err
+	}
+	if len(accounts) > types.MaxFreezeRateBatchSize {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "at most %d accounts can be frozen at once", types.MaxFreezeRateBatchSize,
+		)
+	}
+
+	for _, addr := range accounts {
+		balance := k.bankKeeper.GetBalance(ctx, addr, denom)
+		frozenAmount := types.ComputeRateFrozenAmount(rate, balance.Amount)
+		if err := k.SetFrozen(ctx, sender, addr, sdk.NewCoin(denom, frozenAmount)); err != nil {
+			return sdkerrors.Wrapf(err, "failed to freeze the rate of the balance of %s", addr)
+		}
+	}
+
 	return nil
 }
 
@@ -599,7 +764,7 @@ func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom str
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+	if err = k.checkFeatureAllowed(ctx, def, sender, types.Feature_freezing); err != nil {
 		return err
 	}
 
@@ -613,7 +778,7 @@ func (k Keeper) GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom s
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+	if err = k.checkFeatureAllowed(ctx, def, sender, types.Feature_freezing); err != nil {
 		return err
 	}
 
@@ -660,7 +825,13 @@ func (k Keeper) GetFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom str
 	}
 
 	if isGloballyFrozen {
-		return k.bankKeeper.GetBalance(ctx, addr, denom), nil
+		isExempt, err := k.IsExemptFromGlobalFreeze(ctx, denom, addr)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if !isExempt {
+			return k.bankKeeper.GetBalance(ctx, addr, denom), nil
+		}
 	}
 	return k.frozenAccountBalanceStore(ctx, addr).Balance(denom), nil
 }
@@ -684,29 +855,73 @@ func (k Keeper) SetGlobalFreeze(ctx sdk.Context, denom string, frozen bool) erro
 	return store.Delete(types.CreateGlobalFreezeKey(denom))
 }
 
-// Clawback confiscates specified token from the specified account.
-func (k Keeper) Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+// Clawback confiscates specified token from the specified account to the recipient. The sender receives the token if
+// the recipient is nil.
+func (k Keeper) Clawback(ctx sdk.Context, sender, addr, recipient sdk.AccAddress, coin sdk.Coin) error {
 	if !coin.IsPositive() {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "clawback amount should be positive")
 	}
 
+	if recipient == nil {
+		recipient = sender
+	} else if err := k.validateClawbackRecipient(ctx, recipient); err != nil {
+		return err
+	}
+
+	return k.clawback(ctx, sender, addr, recipient, coin)
+}
+
+// BatchClawback confiscates the tokens from multiple accounts to the recipient atomically. The sender receives the
+// tokens if the recipient is nil.
+func (k Keeper) BatchClawback(
+	ctx sdk.Context,
+	sender, recipient sdk.AccAddress,
+	entries []types.ClawbackEntry,
+) error {
+	if err := types.ValidateClawbackEntries(entries); err != nil {
+		return err
+	}
+
+	if recipient == nil {
+		recipient = sender
+	} else if err := k.validateClawbackRecipient(ctx, recipient); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		addr, err := sdk.AccAddressFromBech32(entry.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account address %s", entry.Account)
+		}
+		if err := k.clawback(ctx, sender, addr, recipient, entry.Coin); err != nil {
+			return sdkerrors.Wrapf(err, "can't claw back from account %s", entry.Account)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) clawback(ctx sdk.Context, sender, addr, recipient sdk.AccAddress, coin sdk.Coin) error {
 	if err := k.validateClawbackAllowed(ctx, sender, addr, coin); err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.SendCoins(ctx, addr, sender, sdk.NewCoins(coin)); err != nil {
-		return sdkerrors.Wrapf(err, "can't send coins from account %s to issuer %s", addr.String(), sender.String())
+	if err := k.bankKeeper.SendCoins(ctx, addr, recipient, sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrapf(
+			err, "can't send coins from account %s to recipient %s", addr.String(), recipient.String(),
+		)
 	}
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventAmountClawedBack{
-		Account: addr.String(),
-		Denom:   coin.Denom,
-		Amount:  coin.Amount,
+		Account:   addr.String(),
+		Denom:     coin.Denom,
+		Amount:    coin.Amount,
+		Recipient: recipient.String(),
 	}); err != nil {
 		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventAmountClawedBack event: %s", err)
 	}
 
-	return nil
+	return k.emitComplianceAction(ctx, sender, addr, coin.Denom, types.COMPLIANCE_ACTION_TYPE_CLAWBACK, coin.Amount)
 }
 
 // SetWhitelistedBalance sets whitelisted limit for the account.
@@ -724,7 +939,7 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin's balance can't be whitelisted")
 	}
 
-	if err = def.CheckFeatureAllowed(sender, types.Feature_whitelisting); err != nil {
+	if err = k.checkFeatureAllowed(ctx, def, sender, types.Feature_whitelisting); err != nil {
 		return err
 	}
 
@@ -744,6 +959,20 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 	return nil
 }
 
+// SetRelativeWhitelistedBalance sets whitelisted limit for the account to its balance at the execution time
+// increased by the amount of the coin.
+func (k Keeper) SetRelativeWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+	if coin.IsNil() || coin.IsNegative() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "whitelisted limit amount should be greater than or equal to 0")
+	}
+	if coin.Amount.GT(types.MaxMintableAmount) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "relative whitelisted limit is greater than maximum allowed")
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, coin.Denom)
+	return k.SetWhitelistedBalance(ctx, sender, addr, sdk.NewCoin(coin.Denom, balance.Amount.Add(coin.Amount)))
+}
+
 // GetAccountsWhitelistedBalances returns the whitelisted balance of all the account.
 func (k Keeper) GetAccountsWhitelistedBalances(
 	ctx sdk.Context,
@@ -776,6 +1005,20 @@ func (k Keeper) GetWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, deno
 	return k.whitelistedAccountBalanceStore(ctx, addr).Balance(denom)
 }
 
+// ValidateWhitelistedReceiver returns an error if the whitelisting is enabled for the token and the balance
+// whitelisted for the account isn't enough to receive the coin. The denoms of other tokens are always accepted.
+func (k Keeper) ValidateWhitelistedReceiver(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	def, err := k.getDefinitionOrNil(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if def == nil || !def.IsFeatureEnabled(types.Feature_whitelisting) || def.HasAdminPrivileges(addr) {
+		return nil
+	}
+
+	return k.validateWhitelistedBalance(ctx, addr, coin)
+}
+
 // SetWhitelistedBalances sets the whitelisted balances of a specified account.
 // Pay attention that the sdk.NewCoins() sanitizes/removes the empty coins, hence if you
 // need set zero amount use the slice []sdk.Coins.
@@ -808,21 +1051,28 @@ func (k Keeper) GetSpendableBalance(
 	if err != nil {
 		return sdk.Coin{}, err
 	}
-	// the spendable balance counts the frozen balance
-	if def != nil && def.IsFeatureEnabled(types.Feature_freezing) {
+	if def == nil {
+		return sdk.NewCoin(denom, notLockedAmt), nil
+	}
+
+	// the spendable balance counts the frozen balance and the amount held by the legal hold
+	heldAmt, err := k.getLegalHoldAmount(ctx, addr, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	notFrozenAmt := balance.Amount.Sub(heldAmt)
+	if def.IsFeatureEnabled(types.Feature_freezing) {
 		frozenBalance, err := k.GetFrozenBalance(ctx, addr, denom)
 		if err != nil {
 			return sdk.Coin{}, err
 		}
-		notFrozenAmt := balance.Amount.Sub(frozenBalance.Amount)
-		if notFrozenAmt.IsNegative() {
-			return sdk.NewCoin(denom, sdkmath.ZeroInt()), nil
-		}
-		spendableAmount := sdkmath.MinInt(notLockedAmt, notFrozenAmt)
-		return sdk.NewCoin(denom, spendableAmount), nil
+		notFrozenAmt = notFrozenAmt.Sub(frozenBalance.Amount)
+	}
+	if notFrozenAmt.IsNegative() {
+		return sdk.NewCoin(denom, sdkmath.ZeroInt()), nil
 	}
 
-	return sdk.NewCoin(denom, notLockedAmt), nil
+	return sdk.NewCoin(denom, sdkmath.MinInt(notLockedAmt, notFrozenAmt)), nil
 }
 
 // TransferAdmin changes admin of a fungible token.
@@ -848,6 +1098,11 @@ func (k Keeper) TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, deno
 		return err
 	}
 
+	// the roles delegated by the previous admin aren't effective under the new one
+	if err := k.revokeAllRoles(ctx, sender, denom); err != nil {
+		return err
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferred{
 		Denom:         denom,
 		PreviousAdmin: previousAdmin,
@@ -884,6 +1139,11 @@ func (k Keeper) ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string)
 		def.SendCommissionRate = sdkmath.LegacyZeroDec()
 	}
 
+	// the rates can't be changed by anyone once the admin is cleared
+	if err := k.cancelPendingRateChange(ctx, denom); err != nil {
+		return err
+	}
+
 	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
 		return err
 	}
@@ -917,6 +1177,15 @@ func (k Keeper) mintIfReceivable(
 		ctx = cwasmtypes.WithSmartContractRecipient(ctx, recipient.String())
 	}
 
+	// The clear bank keeper used by the module checks only the module accounts blocked by the app.
+	bankParams, err := k.customParamsKeeper.GetBankParams(ctx)
+	if err != nil {
+		return err
+	}
+	if bankParams.IsBlockedAddress(recipient) {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
 	if err := k.validateCoinReceivable(ctx, recipient, def, amount); err != nil {
 		return sdkerrors.Wrapf(err, "coins are not receivable")
 	}
@@ -934,6 +1203,10 @@ func (k Keeper) mintIfReceivable(
 			recipient.String(),
 		)
 	}
+	if err := k.recordMint(ctx, def.Denom, amount); err != nil {
+		return err
+	}
+	k.notifyMint(ctx, recipient, coinsToMint[0])
 
 	return nil
 }
@@ -943,12 +1216,22 @@ func (k Keeper) burnIfSpendable(
 	account sdk.AccAddress,
 	def types.Definition,
 	amount sdkmath.Int,
+	category burnCategory,
 ) error {
 	if err := k.validateCoinSpendable(ctx, account, def, amount); err != nil {
 		return sdkerrors.Wrapf(err, "coins are not spendable")
 	}
 
-	return k.burn(ctx, account, sdk.NewCoins(sdk.NewCoin(def.Denom, amount)))
+	coinToBurn := sdk.NewCoin(def.Denom, amount)
+	if err := k.burn(ctx, account, sdk.NewCoins(coinToBurn)); err != nil {
+		return err
+	}
+	if err := k.recordBurn(ctx, def.Denom, amount, category); err != nil {
+		return err
+	}
+	k.notifyBurn(ctx, account, coinToBurn)
+
+	return nil
 }
 
 func (k Keeper) burn(ctx sdk.Context, account sdk.AccAddress, coinsToBurn sdk.Coins) error {
@@ -988,11 +1271,11 @@ func (k Keeper) validateCoinSpendable(
 	}
 
 	if def.IsFeatureEnabled(types.Feature_freezing) {
-		isGloballyFrozen, err := k.isGloballyFrozen(ctx, def.Denom)
+		isGloballyFrozen, err := k.isGloballyFrozenFor(ctx, def, addr)
 		if err != nil {
 			return err
 		}
-		if isGloballyFrozen && !def.HasAdminPrivileges(addr) {
+		if isGloballyFrozen {
 			return sdkerrors.Wrapf(types.ErrGloballyFrozen, "%s is globally frozen", def.Denom)
 		}
 	}
@@ -1019,14 +1302,23 @@ func (k Keeper) validateCoinSpendable(
 		return err
 	}
 
-	if def.IsFeatureEnabled(types.Feature_freezing) && !def.HasAdminPrivileges(addr) {
+	checkFrozen := def.IsFeatureEnabled(types.Feature_freezing) && !def.HasAdminPrivileges(addr)
+	frozenAmt := sdkmath.ZeroInt()
+	if checkFrozen {
 		frozenBalance, err := k.GetFrozenBalance(ctx, addr, def.Denom)
 		if err != nil {
 			return err
 		}
-		frozenAmt := frozenBalance.Amount
+		frozenAmt = frozenBalance.Amount
+	}
+	// the legal hold is applied regardless of the features, so it can't be lifted by disabling the freezing
+	heldAmt, err := k.getLegalHoldAmount(ctx, addr, def.Denom)
+	if err != nil {
+		return err
+	}
+	if checkFrozen || heldAmt.IsPositive() {
 		balance := k.bankKeeper.GetBalance(ctx, addr, def.Denom)
-		notFrozenAmt := balance.Amount.Sub(frozenAmt)
+		notFrozenAmt := balance.Amount.Sub(frozenAmt).Sub(heldAmt)
 		if notFrozenAmt.LT(amount) {
 			return sdkerrors.Wrapf(cosmoserrors.ErrInsufficientFunds, "%s%s is not available, available %s%s",
 				amount.String(), def.Denom, notFrozenAmt.String(), def.Denom)
@@ -1074,6 +1366,10 @@ func (k Keeper) validateCoinReceivable(
 		return nil
 	}
 
+	if err := k.validateNotUnderLegalHold(ctx, addr, def.Denom); err != nil {
+		return err
+	}
+
 	if def.IsFeatureEnabled(types.Feature_whitelisting) && !def.HasAdminPrivileges(addr) {
 		if err := k.validateWhitelistedBalance(ctx, addr, sdk.NewCoin(def.Denom, amount)); err != nil {
 			return err
@@ -1131,6 +1427,48 @@ func (k Keeper) getIssuerDefinitions(
 	)
 }
 
+func (k Keeper) emitComplianceAction(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	denom string,
+	action types.ComplianceActionType,
+	amount sdkmath.Int,
+) error {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventComplianceAction{
+		Account: addr.String(),
+		Denom:   denom,
+		Action:  action,
+		Amount:  amount,
+		Sender:  sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventComplianceAction event: %s", err)
+	}
+
+	return nil
+}
+
+// GetPrecision returns the precision of the display unit of the denom stored in the bank metadata.
+func (k Keeper) GetPrecision(ctx sdk.Context, denom string) (uint32, error) {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", denom)
+	}
+	precision, found := precisionFromMetadata(metadata)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidInput, "precision of %s denom not found", denom)
+	}
+	return precision, nil
+}
+
+func precisionFromMetadata(metadata banktypes.Metadata) (uint32, bool) {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent, true
+		}
+	}
+	return 0, false
+}
+
 func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (types.Token, error) {
 	subunit, _, err := types.DeconstructDenom(definition.Denom)
 	if err != nil {
@@ -1142,15 +1480,8 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 		return types.Token{}, sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", definition.Denom)
 	}
 
-	precision := -1
-	for _, unit := range metadata.DenomUnits {
-		if unit.Denom == metadata.Display {
-			precision = int(unit.Exponent)
-			break
-		}
-	}
-
-	if precision < 0 {
+	precision, found := precisionFromMetadata(metadata)
+	if !found {
 		return types.Token{}, sdkerrors.Wrap(types.ErrInvalidInput, "precision not found")
 	}
 
@@ -1164,11 +1495,21 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 		return types.Token{}, err
 	}
 
+	verified, err := k.isSymbolVerifiedForDenom(ctx, metadata.Symbol, definition.Denom)
+	if err != nil {
+		return types.Token{}, err
+	}
+
+	pendingRateChange, err := k.getPendingRateChangeOrNil(ctx, definition.Denom)
+	if err != nil {
+		return types.Token{}, err
+	}
+
 	return types.Token{
 		Denom:              definition.Denom,
 		Issuer:             definition.Issuer,
 		Symbol:             metadata.Symbol,
-		Precision:          uint32(precision),
+		Precision:          precision,
 		Subunit:            subunit,
 		Description:        metadata.Description,
 		Features:           definition.Features,
@@ -1181,6 +1522,9 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 		Admin:              definition.Admin,
 		ExtensionCWAddress: definition.ExtensionCWAddress,
 		DEXSettings:        dexSettings,
+		Verified:           verified,
+		ObserverCWAddress:  definition.ObserverCWAddress,
+		PendingRateChange:  pendingRateChange,
 	}, nil
 }
 
@@ -1254,7 +1598,7 @@ func (k Keeper) freezingChecks(ctx sdk.Context, sender, addr sdk.AccAddress, coi
 		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin's balance can't be frozen")
 	}
 
-	return def.CheckFeatureAllowed(sender, types.Feature_freezing)
+	return k.checkFeatureAllowed(ctx, def, sender, types.Feature_freezing)
 }
 
 func (k Keeper) isGloballyFrozen(ctx sdk.Context, denom string) (bool, error) {
@@ -1265,6 +1609,13 @@ func (k Keeper) isGloballyFrozen(ctx sdk.Context, denom string) (bool, error) {
 	return bytes.Equal(isGloballyFrozen, types.StoreTrue), nil
 }
 
+func (k Keeper) validateClawbackRecipient(ctx sdk.Context, recipient sdk.AccAddress) error {
+	if _, isModuleAccount := k.accountKeeper.GetAccount(ctx, recipient).(*authtypes.ModuleAccount); isModuleAccount {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "claw back to module accounts is prohibited")
+	}
+	return nil
+}
+
 func (k Keeper) validateClawbackAllowed(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
 	def, err := k.GetDefinition(ctx, coin.Denom)
 	if err != nil {
@@ -1279,7 +1630,11 @@ func (k Keeper) validateClawbackAllowed(ctx sdk.Context, sender, addr sdk.AccAdd
 		return err
 	}
 
-	return def.CheckFeatureAllowed(sender, types.Feature_clawback)
+	if err := k.validateCoinNotHeld(ctx, addr, coin); err != nil {
+		return err
+	}
+
+	return k.checkFeatureAllowed(ctx, def, sender, types.Feature_clawback)
 }
 
 // whitelistedAccountBalanceStore gets the store for the whitelisted balances of an account.
@@ -1306,21 +1661,36 @@ func (k Keeper) validateWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress,
 	return nil
 }
 
-// logger returns the Keeper logger.
-func (k Keeper) logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+types.ModuleName)
-}
-
-func (k Keeper) burnIssueFee(ctx sdk.Context, settings types.IssueSettings, params types.Params) error {
+func (k Keeper) burnIssueFee(ctx sdk.Context, settings types.IssueSettings, params types.Params) (sdk.Coin, error) {
 	if err := k.checkIssueFeeIsLimitedToCore(ctx, params); err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	if err := k.validateCoinIsNotLockedByDEXAndBank(ctx, settings.Issuer, params.IssueFee); err != nil {
-		return sdkerrors.Wrap(err, "out of funds to pay for issue fee")
+		return sdk.Coin{}, sdkerrors.Wrap(err, "out of funds to pay for issue fee")
+	}
+
+	feeToBurn := params.IssueFee
+	referralFee := sdk.NewCoin(params.IssueFee.Denom, sdkmath.ZeroInt())
+	if settings.Referrer != nil && params.ReferralFeeRatio.IsPositive() {
+		referralFee.Amount = params.ReferralFeeRatio.MulInt(params.IssueFee.Amount).TruncateInt()
+		if referralFee.IsPositive() {
+			if err := k.bankKeeper.SendCoins(
+				ctx, settings.Issuer, settings.Referrer, sdk.NewCoins(referralFee),
+			); err != nil {
+				return sdk.Coin{}, sdkerrors.Wrapf(err, "can't send referral fee to %s", settings.Referrer.String())
+			}
+			feeToBurn = feeToBurn.Sub(referralFee)
+		}
+	}
+
+	if feeToBurn.IsPositive() {
+		if err := k.burn(ctx, settings.Issuer, sdk.NewCoins(feeToBurn)); err != nil {
+			return sdk.Coin{}, err
+		}
 	}
 
-	return k.burn(ctx, settings.Issuer, sdk.NewCoins(params.IssueFee))
+	return referralFee, nil
 }
 
 func (k Keeper) checkIssueFeeIsLimitedToCore(ctx sdk.Context, params types.Params) error {
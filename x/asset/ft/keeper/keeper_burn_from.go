@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// BurnFrom burns the coins from the account with the consent of the account given by the signed burn permit. Only
+// the admin of the token can burn the coins and each permit can be used once.
+func (k Keeper) BurnFrom(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	coin sdk.Coin,
+	nonce uint64,
+	expirationTime time.Time,
+	pubKey, signature []byte,
+) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "burn amount must be positive")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+	if !def.HasAdminPrivileges(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can burn the coins of the account")
+	}
+
+	permit := types.NewBurnPermit(ctx.ChainID(), addr, sender, coin, nonce, expirationTime)
+	if permit.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrap(types.ErrInvalidBurnPermit, "permit is expired")
+	}
+	used, err := k.IsBurnPermitUsed(ctx, addr, nonce)
+	if err != nil {
+		return err
+	}
+	if used {
+		return sdkerrors.Wrapf(types.ErrInvalidBurnPermit, "permit with nonce %d is already used", nonce)
+	}
+	if err := permit.VerifySignature(pubKey, signature); err != nil {
+		return err
+	}
+
+	if err := k.setBurnPermitUsed(ctx, addr, nonce); err != nil {
+		return err
+	}
+
+	if err := k.burnIfSpendable(ctx, addr, def, coin.Amount, burnCategoryAdmin); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAmountBurnedFrom{
+		Account: addr.String(),
+		Burner:  sender.String(),
+		Denom:   coin.Denom,
+		Amount:  coin.Amount,
+		Nonce:   nonce,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventAmountBurnedFrom event: %s", err)
+	}
+
+	return nil
+}
+
+// IsBurnPermitUsed returns true if the burn permit with the nonce signed by the holder is already used.
+func (k Keeper) IsBurnPermitUsed(ctx sdk.Context, holder sdk.AccAddress, nonce uint64) (bool, error) {
+	return k.storeService.OpenKVStore(ctx).Has(types.CreateBurnPermitNonceKey(holder, nonce))
+}
+
+// GetUsedBurnPermits returns the nonces of the used burn permits.
+func (k Keeper) GetUsedBurnPermits(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.UsedBurnPermit, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.BurnPermitNonceKeyPrefix)
+	permits := make([]types.UsedBurnPermit, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var permit types.UsedBurnPermit
+		if err := k.cdc.Unmarshal(value, &permit); err != nil {
+			return err
+		}
+		permits = append(permits, permit)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return permits, pageRes, nil
+}
+
+// ImportUsedBurnPermits imports the nonces of the used burn permits.
+func (k Keeper) ImportUsedBurnPermits(ctx sdk.Context, permits []types.UsedBurnPermit) error {
+	for _, permit := range permits {
+		holder, err := sdk.AccAddressFromBech32(permit.Holder)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid holder address: %s", err)
+		}
+		if err := k.setBurnPermitUsed(ctx, holder, permit.Nonce); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) setBurnPermitUsed(ctx sdk.Context, holder sdk.AccAddress, nonce uint64) error {
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateBurnPermitNonceKey(holder, nonce),
+		k.cdc.MustMarshal(&types.UsedBurnPermit{
+			Holder: holder.String(),
+			Nonce:  nonce,
+		}),
+	)
+}
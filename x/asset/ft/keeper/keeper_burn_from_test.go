@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_BurnFrom(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		ChainID: "test-chain",
+		Time:    time.Now(),
+	})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holderKey := secp256k1.GenPrivKey()
+	holder := sdk.AccAddress(holderKey.PubKey().Address())
+	otherKey := secp256k1.GenPrivKey()
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []types.Feature{types.Feature_freezing},
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	coin := sdk.NewInt64Coin(denom, 30)
+	expiration := ctx.BlockTime().Add(time.Hour).UTC()
+	sign := func(key *secp256k1.PrivKey, permit types.BurnPermit) []byte {
+		signBytes, err := permit.SignBytes()
+		requireT.NoError(err)
+		signature, err := key.Sign(signBytes)
+		requireT.NoError(err)
+		return signature
+	}
+	permit := types.NewBurnPermit(ctx.ChainID(), holder, issuer, coin, 1, expiration)
+	signature := sign(holderKey, permit)
+	holderPubKey := holderKey.PubKey().Bytes()
+
+	// only the admin can burn the coins
+	requireT.ErrorIs(
+		ftKeeper.BurnFrom(ctx, holder, holder, coin, 1, expiration, holderPubKey, signature),
+		cosmoserrors.ErrUnauthorized,
+	)
+
+	// the permit must be signed by the holder
+	requireT.ErrorIs(
+		ftKeeper.BurnFrom(
+			ctx, issuer, holder, coin, 1, expiration,
+			otherKey.PubKey().Bytes(), sign(otherKey, permit),
+		),
+		types.ErrInvalidBurnPermit,
+	)
+
+	// the signed terms must match
+	requireT.ErrorIs(
+		ftKeeper.BurnFrom(ctx, issuer, holder, sdk.NewInt64Coin(denom, 31), 1, expiration, holderPubKey, signature),
+		types.ErrInvalidBurnPermit,
+	)
+	requireT.ErrorIs(
+		ftKeeper.BurnFrom(ctx, issuer, holder, coin, 2, expiration, holderPubKey, signature),
+		types.ErrInvalidBurnPermit,
+	)
+	requireT.ErrorIs(
+		ftKeeper.BurnFrom(
+			ctx.WithChainID("other-chain"), issuer, holder, coin, 1, expiration, holderPubKey, signature,
+		),
+		types.ErrInvalidBurnPermit,
+	)
+
+	// the expired permit can't be used
+	requireT.ErrorIs(
+		ftKeeper.BurnFrom(
+			ctx.WithBlockTime(expiration), issuer, holder, coin, 1, expiration, holderPubKey, signature,
+		),
+		types.ErrInvalidBurnPermit,
+	)
+
+	// the frozen coins can't be burnt
+	cacheCtx, _ := ctx.CacheContext()
+	requireT.NoError(ftKeeper.Freeze(cacheCtx, issuer, holder, sdk.NewInt64Coin(denom, 80)))
+	requireT.ErrorIs(
+		ftKeeper.BurnFrom(cacheCtx, issuer, holder, coin, 1, expiration, holderPubKey, signature),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	supply := bankKeeper.GetSupply(ctx, denom)
+	requireT.NoError(ftKeeper.BurnFrom(ctx, issuer, holder, coin, 1, expiration, holderPubKey, signature))
+	requireT.Equal("70", bankKeeper.GetBalance(ctx, holder, denom).Amount.String())
+	requireT.Equal(supply.Amount.SubRaw(30).String(), bankKeeper.GetSupply(ctx, denom).Amount.String())
+
+	burntEvents, err := event.FindTypedEvents[*types.EventAmountBurnedFrom](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventAmountBurnedFrom{{
+		Account: holder.String(),
+		Burner:  issuer.String(),
+		Denom:   denom,
+		Amount:  sdkmath.NewInt(30),
+		Nonce:   1,
+	}}, burntEvents)
+
+	// the permit can't be reused
+	used, err := ftKeeper.IsBurnPermitUsed(ctx, holder, 1)
+	requireT.NoError(err)
+	requireT.True(used)
+	requireT.ErrorIs(
+		ftKeeper.BurnFrom(ctx, issuer, holder, coin, 1, expiration, holderPubKey, signature),
+		types.ErrInvalidBurnPermit,
+	)
+	usedPermits, _, err := ftKeeper.GetUsedBurnPermits(ctx, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.UsedBurnPermit{{Holder: holder.String(), Nonce: 1}}, usedPermits)
+}
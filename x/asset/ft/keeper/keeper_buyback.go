@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// GetBuybackStats returns the running totals of the buyback.
+func (k Keeper) GetBuybackStats(ctx sdk.Context) (types.BuybackStats, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.BuybackStatsKey)
+	if err != nil {
+		return types.BuybackStats{}, err
+	}
+	if bz == nil {
+		return types.BuybackStats{
+			Burnt:         sdk.NewCoins(),
+			CommunityPool: sdk.NewCoins(),
+		}, nil
+	}
+	var stats types.BuybackStats
+	if err := k.cdc.Unmarshal(bz, &stats); err != nil {
+		return types.BuybackStats{}, err
+	}
+
+	return stats, nil
+}
+
+// SetBuybackStats stores the running totals of the buyback.
+func (k Keeper) SetBuybackStats(ctx sdk.Context, stats types.BuybackStats) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.BuybackStatsKey, k.cdc.MustMarshal(&stats))
+}
+
+// GetPendingBuyback returns the balance accumulated in the buyback account since the last processing.
+func (k Keeper) GetPendingBuyback(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.BuybackAccountName))
+}
+
+// ProcessBuyback burns the balance accumulated in the buyback account or transfers it to the community pool, depending
+// on the params, once the buyback interval passes since the last processing. Should be called from EndBlock.
+func (k Keeper) ProcessBuyback(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	stats, err := k.GetBuybackStats(ctx)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockTime().Before(stats.LastProcessingTime.Add(params.BuybackInterval)) {
+		return nil
+	}
+	stats.LastProcessingTime = ctx.BlockTime()
+
+	amount := k.GetPendingBuyback(ctx)
+	if !amount.IsZero() {
+		// the failure of the processing must not halt the chain, the balance is kept for the next run
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.executeBuyback(cacheCtx, params.BuybackDestination, amount); err != nil {
+			k.logger.Error("failed to process buyback", "amount", amount.String(), "error", err)
+		} else {
+			writeCache()
+			switch params.BuybackDestination {
+			case types.BUYBACK_DESTINATION_COMMUNITY_POOL:
+				stats.CommunityPool = stats.CommunityPool.Add(amount...)
+			default:
+				stats.Burnt = stats.Burnt.Add(amount...)
+			}
+		}
+	}
+
+	return k.SetBuybackStats(ctx, stats)
+}
+
+// routeCommissionToBuyback sends the part of the send commission defined by the commission buyback ratio param to
+// the buyback account and returns the remaining commission.
+func (k Keeper) routeCommissionToBuyback(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	commissionAmount sdkmath.Int,
+) (sdkmath.Int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	buybackAmount := params.CommissionBuybackRatio.MulInt(commissionAmount).TruncateInt()
+	if !buybackAmount.IsPositive() {
+		return commissionAmount, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.BuybackAccountName, sdk.NewCoins(sdk.NewCoin(denom, buybackAmount)),
+	); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return commissionAmount.Sub(buybackAmount), nil
+}
+
+func (k Keeper) executeBuyback(ctx sdk.Context, destination types.BuybackDestination, amount sdk.Coins) error {
+	switch destination {
+	case types.BUYBACK_DESTINATION_BURN:
+		if err := k.bankKeeper.BurnCoins(ctx, types.BuybackAccountName, amount); err != nil {
+			return sdkerrors.Wrap(err, "can't burn the buyback balance")
+		}
+		for _, coin := range amount {
+			if err := k.recordBurn(ctx, coin.Denom, coin.Amount, burnCategoryBuyback); err != nil {
+				return err
+			}
+		}
+	case types.BUYBACK_DESTINATION_COMMUNITY_POOL:
+		if k.distributionKeeper == nil {
+			return sdkerrors.Wrap(types.ErrInvalidState, "distribution keeper is not set")
+		}
+		// the commissions are already collected, so the features must not be applied to the transfer
+		if err := k.distributionKeeper.FundCommunityPool(
+			withFeaturesBypassed(ctx), amount, authtypes.NewModuleAddress(types.BuybackAccountName),
+		); err != nil {
+			return sdkerrors.Wrap(err, "can't send the buyback balance to the community pool")
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown buyback destination %d", destination)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBuybackProcessed{
+		Destination: destination,
+		Amount:      amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventBuybackProcessed event: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_Buyback(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Now().UTC()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Time: startTime})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.CommissionBuybackRatio = sdkmath.LegacyMustNewDecFromStr("0.4")
+	params.BuybackInterval = time.Hour
+	params.BuybackDestination = types.BUYBACK_DESTINATION_BURN
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		InitialAmount:      sdkmath.NewInt(2_000),
+		SendCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.25"),
+	})
+	requireT.NoError(err)
+
+	recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient1, sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000))))
+
+	// the part of the commission is routed to the buyback account
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient1, recipient2, sdk.NewCoins(sdk.NewInt64Coin(denom, 400))))
+	requireT.Equal(sdkmath.NewInt(500), bankKeeper.GetBalance(ctx, recipient1, denom).Amount)
+	requireT.Equal(sdkmath.NewInt(1_060), bankKeeper.GetBalance(ctx, issuer, denom).Amount)
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 40)), ftKeeper.GetPendingBuyback(ctx))
+
+	// the accumulated balance is burnt
+	requireT.NoError(ftKeeper.ProcessBuyback(ctx))
+	requireT.True(ftKeeper.GetPendingBuyback(ctx).IsZero())
+	requireT.Equal(sdkmath.NewInt(1_960), bankKeeper.GetSupply(ctx, denom).Amount)
+
+	stats, err := ftKeeper.GetBuybackStats(ctx)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 40)).String(), stats.Burnt.String())
+	requireT.True(stats.CommunityPool.IsZero())
+	requireT.Equal(startTime, stats.LastProcessingTime)
+
+	// the balance is not processed before the interval passes
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient1, recipient2, sdk.NewCoins(sdk.NewInt64Coin(denom, 400))))
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	requireT.NoError(ftKeeper.ProcessBuyback(ctx))
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 40)), ftKeeper.GetPendingBuyback(ctx))
+
+	// the accumulated balance is transferred to the community pool
+	params.BuybackDestination = types.BUYBACK_DESTINATION_COMMUNITY_POOL
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	requireT.NoError(ftKeeper.ProcessBuyback(ctx))
+	requireT.True(ftKeeper.GetPendingBuyback(ctx).IsZero())
+	requireT.Equal(sdkmath.NewInt(1_960), bankKeeper.GetSupply(ctx, denom).Amount)
+
+	stats, err = ftKeeper.GetBuybackStats(ctx)
+	requireT.NoError(err)
+	requireT.Equal(types.BuybackStats{
+		Burnt:              sdk.NewCoins(sdk.NewInt64Coin(denom, 40)),
+		CommunityPool:      sdk.NewCoins(sdk.NewInt64Coin(denom, 40)),
+		LastProcessingTime: startTime.Add(time.Hour),
+	}, stats)
+}
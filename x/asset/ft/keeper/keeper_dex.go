@@ -66,7 +66,7 @@ func (k Keeper) DEXExecuteActions(ctx sdk.Context, actions types.DEXActions) err
 	}
 
 	for _, send := range actions.Send {
-		k.logger(ctx).Debug(
+		k.logger.Debug(
 			"DEX sending coin",
 			"from", send.FromAddress.String(),
 			"to", send.ToAddress.String(),
@@ -199,7 +199,7 @@ func (k Keeper) UpdateDEXWhitelistedDenoms(
 
 // DEXIncreaseExpectedToReceive increases the expected to receive amount for the specified account.
 func (k Keeper) DEXIncreaseExpectedToReceive(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
-	k.logger(ctx).Debug("DEX increasing expected to receive coin", "address", addr.String(), "coin", coin.String())
+	k.logger.Debug("DEX increasing expected to receive coin", "address", addr.String(), "coin", coin.String())
 	if !coin.IsPositive() {
 		return sdkerrors.Wrap(
 			cosmoserrors.ErrInvalidCoins, "amount to increase DEX expected to receive must be positive",
@@ -233,7 +233,7 @@ func (k Keeper) DEXIncreaseExpectedToReceive(ctx sdk.Context, addr sdk.AccAddres
 
 // DEXDecreaseExpectedToReceive decreases the expected to receive amount for the specified account.
 func (k Keeper) DEXDecreaseExpectedToReceive(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
-	k.logger(ctx).Debug("DEX decreasing expected to receive coin", "address", addr.String(), "coin", coin.String())
+	k.logger.Debug("DEX decreasing expected to receive coin", "address", addr.String(), "coin", coin.String())
 	if !coin.IsPositive() {
 		return sdkerrors.Wrap(
 			cosmoserrors.ErrInvalidCoins, "amount to decrease DEX expected to receive must be positive",
@@ -302,7 +302,7 @@ func (k Keeper) SetDEXExpectedToReceiveBalances(ctx sdk.Context, addr sdk.AccAdd
 
 // DEXIncreaseLocked locks specified token for the specified account.
 func (k Keeper) DEXIncreaseLocked(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
-	k.logger(ctx).Debug("DEX increasing locked coin", "addr", addr.String(), "coin", coin.String())
+	k.logger.Debug("DEX increasing locked coin", "addr", addr.String(), "coin", coin.String())
 	if !coin.IsPositive() {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "amount to lock DEX tokens must be positive")
 	}
@@ -328,7 +328,7 @@ func (k Keeper) DEXIncreaseLocked(ctx sdk.Context, addr sdk.AccAddress, coin sdk
 
 // DEXDecreaseLocked unlocks specified tokens from the specified account.
 func (k Keeper) DEXDecreaseLocked(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
-	k.logger(ctx).Debug("DEX decrease locked coin", "address", addr.String(), "coin", coin.String())
+	k.logger.Debug("DEX decrease locked coin", "address", addr.String(), "coin", coin.String())
 	if !coin.IsPositive() {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "amount to unlock DEX tokens must be positive")
 	}
@@ -469,6 +469,14 @@ func (k Keeper) dexInvokeAssetExtensionWithAmount(
 	}
 
 	if receiveDef.IsFeatureEnabled(types.Feature_extension) {
+		bypassed, err := k.isExtensionBypassed(ctx)
+		if err != nil {
+			return err
+		}
+		if bypassed {
+			return nil
+		}
+
 		extensionContract, err := sdk.AccAddressFromBech32(receiveDef.ExtensionCWAddress)
 		if err != nil {
 			return err
@@ -499,6 +507,7 @@ func (k Keeper) invokeAssetExtensionPlaceOrderMethod(
 		return sdkerrors.Wrapf(err, "failed to marshal contract msg")
 	}
 
+	k.recordExtensionCall(ctx, extensionContract, ExtensionPlaceOrderMethod)
 	_, err = k.wasmPermissionedKeeper.Sudo(
 		ctx,
 		extensionContract,
@@ -548,6 +557,10 @@ func (k Keeper) dexChecksForDenom(
 }
 
 func (k Keeper) dexChecksForDefinition(ctx sdk.Context, acc sdk.AccAddress, def types.Definition) error {
+	if err := k.validateNotRedenominated(ctx, def.Denom); err != nil {
+		return err
+	}
+
 	if def.IsFeatureEnabled(types.Feature_dex_block) {
 		return sdkerrors.Wrapf(
 			cosmoserrors.ErrUnauthorized,
@@ -568,13 +581,13 @@ func (k Keeper) dexChecksForDefinition(ctx sdk.Context, acc sdk.AccAddress, def
 	}
 
 	if def.IsFeatureEnabled(types.Feature_freezing) {
-		isGloballyFrozen, err := k.isGloballyFrozen(ctx, def.Denom)
+		// sill allow the admin and the exempted accounts to do the trade, to follow same logic as we have in the
+		// sending
+		isGloballyFrozen, err := k.isGloballyFrozenFor(ctx, def, acc)
 		if err != nil {
 			return err
 		}
-		if isGloballyFrozen &&
-			// sill allow the admin to do the trade, to follow same logic as we have in the sending
-			!def.HasAdminPrivileges(acc) {
+		if isGloballyFrozen {
 			return sdkerrors.Wrapf(
 				cosmoserrors.ErrUnauthorized,
 				"usage of %s for DEX is blocked because the token is globally frozen",
@@ -706,6 +719,11 @@ func (k Keeper) dexLockedAccountBalanceStore(ctx sdk.Context, addr sdk.AccAddres
 	return newBalanceStore(k.cdc, runtime.KVStoreAdapter(store), types.CreateDEXLockedBalancesKey(addr))
 }
 
+func (k Keeper) dexLockedAccountsBalanceStore(ctx sdk.Context) balanceStore {
+	store := k.storeService.OpenKVStore(ctx)
+	return newBalanceStore(k.cdc, runtime.KVStoreAdapter(store), types.DEXLockedBalancesKeyPrefix)
+}
+
 func (k Keeper) getDEXSettingsOrNil(ctx sdk.Context, denom string) (*types.DEXSettings, error) {
 	dexSettings, err := k.GetDEXSettings(ctx, denom)
 	if err != nil {
@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	"github.com/tokenize-x/tx-chain/v7/x/wasm"
+)
+
+// SetDustPolicy sets the dust policy of the token. Only the admin of the token can set it. The zero threshold
+// removes the policy.
+func (k Keeper) SetDustPolicy(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	threshold sdkmath.Int,
+	destination types.DustDestination,
+) error {
+	if err := types.ValidateDustPolicyTerms(threshold, destination); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if !def.HasAdminPrivileges(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can set the dust policy")
+	}
+
+	if threshold.IsZero() {
+		if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateDustPolicyKey(denom)); err != nil {
+			return err
+		}
+	} else if err := k.setDustPolicy(ctx, types.DustPolicy{
+		Denom:       denom,
+		Threshold:   threshold,
+		Destination: destination,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDustPolicyChanged{
+		Denom:       denom,
+		Threshold:   threshold,
+		Destination: destination,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDustPolicyChanged event: %s", err)
+	}
+
+	return nil
+}
+
+// SetDustOptOut excludes the account from the dust sweeping of the token or includes it back. The sender must be
+// the account itself or the admin of the token.
+func (k Keeper) SetDustOptOut(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, optOut bool) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if !sender.Equals(addr) && !def.HasAdminPrivileges(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only the account or admin can set the dust opt-out")
+	}
+
+	if err := k.setDustOptOut(ctx, denom, addr, optOut); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDustOptOutChanged{
+		Denom:   denom,
+		Account: addr.String(),
+		OptOut:  optOut,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDustOptOutChanged event: %s", err)
+	}
+
+	return nil
+}
+
+// SweepDust transfers the balances lower than the threshold of the dust policy from the accounts to the destination
+// of the policy. The accounts not eligible for the sweeping are skipped with the event explaining the reason.
+func (k Keeper) SweepDust(ctx sdk.Context, sender sdk.AccAddress, denom string, accounts []sdk.AccAddress) error {
+	if len(accounts) > types.MaxDustSweepBatchSize {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "at most %d accounts can be swept at once", types.MaxDustSweepBatchSize,
+		)
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	policy, err := k.GetDustPolicy(ctx, denom)
+	if err != nil {
+		return err
+	}
+	isGloballyFrozen, err := k.isGloballyFrozen(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if isGloballyFrozen {
+		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "%s is globally frozen", denom)
+	}
+
+	for _, addr := range accounts {
+		reason, amount, err := k.checkDustSweepable(ctx, def, policy, addr)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventDustSweepSkipped{
+				Denom:   denom,
+				Account: addr.String(),
+				Reason:  reason,
+			}); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDustSweepSkipped event: %s", err)
+			}
+			continue
+		}
+
+		if err := k.sendDust(ctx, def, policy.Destination, addr, sdk.NewCoins(sdk.NewCoin(denom, amount))); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDustSwept{
+			Denom:       denom,
+			Account:     addr.String(),
+			Amount:      amount,
+			Destination: policy.Destination,
+			Sender:      sender.String(),
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDustSwept event: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// ImportDustPolicies imports the dust policies from genesis state.
+func (k Keeper) ImportDustPolicies(ctx sdk.Context, policies []types.DustPolicy) error {
+	for _, policy := range policies {
+		if err := k.setDustPolicy(ctx, policy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportDustOptOuts imports the dust opt-outs from genesis state.
+func (k Keeper) ImportDustOptOuts(ctx sdk.Context, optOuts []types.DustOptOut) error {
+	for _, optOut := range optOuts {
+		addr, err := sdk.AccAddressFromBech32(optOut.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid account address: %s", err)
+		}
+		if err := k.setDustOptOut(ctx, optOut.Denom, addr, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDustPolicy returns the dust policy of the token.
+func (k Keeper) GetDustPolicy(ctx sdk.Context, denom string) (types.DustPolicy, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateDustPolicyKey(denom))
+	if err != nil {
+		return types.DustPolicy{}, err
+	}
+	if bz == nil {
+		return types.DustPolicy{}, sdkerrors.Wrapf(types.ErrDustPolicyNotFound, "denom: %s", denom)
+	}
+	var policy types.DustPolicy
+	if err := k.cdc.Unmarshal(bz, &policy); err != nil {
+		return types.DustPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// GetDustPolicies returns all the dust policies.
+func (k Keeper) GetDustPolicies(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.DustPolicy, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DustPolicyKeyPrefix)
+	policies := make([]types.DustPolicy, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var policy types.DustPolicy
+		if err := k.cdc.Unmarshal(value, &policy); err != nil {
+			return err
+		}
+		policies = append(policies, policy)
+		return nil
+	})
+
+	return policies, pageRes, err
+}
+
+// IsDustOptedOut returns true if the account is excluded from the dust sweeping of the token.
+func (k Keeper) IsDustOptedOut(ctx sdk.Context, denom string, addr sdk.AccAddress) (bool, error) {
+	return k.storeService.OpenKVStore(ctx).Has(types.CreateDustOptOutKey(denom, addr))
+}
+
+// GetDustOptOuts returns all the dust opt-outs.
+func (k Keeper) GetDustOptOuts(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.DustOptOut, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DustOptOutKeyPrefix)
+	optOuts := make([]types.DustOptOut, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var optOut types.DustOptOut
+		if err := k.cdc.Unmarshal(value, &optOut); err != nil {
+			return err
+		}
+		optOuts = append(optOuts, optOut)
+		return nil
+	})
+
+	return optOuts, pageRes, err
+}
+
+func (k Keeper) setDustPolicy(ctx sdk.Context, policy types.DustPolicy) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.CreateDustPolicyKey(policy.Denom), k.cdc.MustMarshal(&policy))
+}
+
+func (k Keeper) setDustOptOut(ctx sdk.Context, denom string, addr sdk.AccAddress, optOut bool) error {
+	key := types.CreateDustOptOutKey(denom, addr)
+	if !optOut {
+		return k.storeService.OpenKVStore(ctx).Delete(key)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(key, k.cdc.MustMarshal(&types.DustOptOut{
+		Denom:   denom,
+		Account: addr.String(),
+	}))
+}
+
+// checkDustSweepable returns the reason why the balance of the account can't be swept or the swept amount.
+func (k Keeper) checkDustSweepable(
+	ctx sdk.Context,
+	def types.Definition,
+	policy types.DustPolicy,
+	addr sdk.AccAddress,
+) (string, sdkmath.Int, error) {
+	optedOut, err := k.IsDustOptedOut(ctx, def.Denom, addr)
+	if err != nil {
+		return "", sdkmath.Int{}, err
+	}
+	if optedOut {
+		return types.DustSweepSkipReasonOptedOut, sdkmath.Int{}, nil
+	}
+	if def.Issuer == addr.String() || def.HasAdminPrivileges(addr) {
+		return types.DustSweepSkipReasonIssuerOrAdmin, sdkmath.Int{}, nil
+	}
+	if _, isModuleAccount := k.accountKeeper.GetAccount(ctx, addr).(*authtypes.ModuleAccount); isModuleAccount {
+		return types.DustSweepSkipReasonModuleAccount, sdkmath.Int{}, nil
+	}
+	if wasm.IsSmartContract(ctx, addr, k.wasmKeeper) {
+		return types.DustSweepSkipReasonSmartContract, sdkmath.Int{}, nil
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, def.Denom)
+	if balance.IsZero() {
+		return types.DustSweepSkipReasonZeroBalance, sdkmath.Int{}, nil
+	}
+	if balance.Amount.GTE(policy.Threshold) {
+		return types.DustSweepSkipReasonAboveThreshold, sdkmath.Int{}, nil
+	}
+	if k.frozenAccountBalanceStore(ctx, addr).Balance(def.Denom).IsPositive() {
+		return types.DustSweepSkipReasonFrozen, sdkmath.Int{}, nil
+	}
+	heldAmt, err := k.getLegalHoldAmount(ctx, addr, def.Denom)
+	if err != nil {
+		return "", sdkmath.Int{}, err
+	}
+	if heldAmt.IsPositive() {
+		return types.DustSweepSkipReasonLegalHold, sdkmath.Int{}, nil
+	}
+	if err := k.validateCoinIsNotLockedByDEXAndBank(ctx, addr, balance); err != nil {
+		return types.DustSweepSkipReasonLocked, sdkmath.Int{}, nil //nolint:nilerr // the locked balance is skipped
+	}
+
+	return "", balance.Amount, nil
+}
+
+func (k Keeper) sendDust(
+	ctx sdk.Context,
+	def types.Definition,
+	destination types.DustDestination,
+	addr sdk.AccAddress,
+	coins sdk.Coins,
+) error {
+	switch destination {
+	case types.DUST_DESTINATION_ISSUER:
+		issuer, err := sdk.AccAddressFromBech32(def.Issuer)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, addr, issuer, coins); err != nil {
+			return sdkerrors.Wrapf(err, "can't send dust from account %s to issuer %s", addr.String(), def.Issuer)
+		}
+		return nil
+	case types.DUST_DESTINATION_COMMUNITY_POOL:
+		if k.distributionKeeper == nil {
+			return sdkerrors.Wrap(types.ErrInvalidState, "distribution keeper is not set")
+		}
+		// the dust is already validated, so the features must not be applied to the transfer to the community pool
+		if err := k.distributionKeeper.FundCommunityPool(withFeaturesBypassed(ctx), coins, addr); err != nil {
+			return sdkerrors.Wrapf(err, "can't send dust from account %s to the community pool", addr.String())
+		}
+		return nil
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown dust destination %d", destination)
+	}
+}
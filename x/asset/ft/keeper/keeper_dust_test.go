@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_SweepDust(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	sweeper := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	dust := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	optedOut := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	rich := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	frozen := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	empty := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	moduleAccount := authtypes.NewModuleAddress(distributiontypes.ModuleName)
+
+	// the rates must not be applied to the swept dust
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "ABC",
+		Subunit:            "uabc",
+		Precision:          6,
+		InitialAmount:      sdkmath.NewInt(1000),
+		Features:           []types.Feature{types.Feature_freezing},
+		BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.5"),
+		SendCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.5"),
+	})
+	requireT.NoError(err)
+
+	for addr, amount := range map[string]int64{
+		dust.String():     10,
+		optedOut.String(): 5,
+		rich.String():     200,
+		frozen.String():   5,
+	} {
+		requireT.NoError(bankKeeper.SendCoins(
+			ctx, issuer, sdk.MustAccAddressFromBech32(addr), sdk.NewCoins(sdk.NewInt64Coin(denom, amount)),
+		))
+	}
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, frozen, sdk.NewInt64Coin(denom, 1)))
+
+	// the sweeping requires the policy
+	requireT.ErrorIs(ftKeeper.SweepDust(ctx, sweeper, denom, []sdk.AccAddress{dust}), types.ErrDustPolicyNotFound)
+
+	// only the admin can set the policy
+	requireT.ErrorIs(
+		ftKeeper.SetDustPolicy(ctx, sweeper, denom, sdkmath.NewInt(50), types.DUST_DESTINATION_COMMUNITY_POOL),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.NoError(
+		ftKeeper.SetDustPolicy(ctx, issuer, denom, sdkmath.NewInt(50), types.DUST_DESTINATION_COMMUNITY_POOL),
+	)
+	policy, err := ftKeeper.GetDustPolicy(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.DUST_DESTINATION_COMMUNITY_POOL, policy.Destination)
+
+	// only the account itself or the admin can opt out
+	requireT.ErrorIs(ftKeeper.SetDustOptOut(ctx, sweeper, optedOut, denom, true), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.SetDustOptOut(ctx, optedOut, optedOut, denom, true))
+	isOptedOut, err := ftKeeper.IsDustOptedOut(ctx, denom, optedOut)
+	requireT.NoError(err)
+	requireT.True(isOptedOut)
+
+	// the globally frozen token can't be swept
+	requireT.NoError(ftKeeper.SetGlobalFreeze(ctx, denom, true))
+	requireT.ErrorIs(ftKeeper.SweepDust(ctx, sweeper, denom, []sdk.AccAddress{dust}), types.ErrGloballyFrozen)
+	requireT.NoError(ftKeeper.SetGlobalFreeze(ctx, denom, false))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.SweepDust(
+		ctx, sweeper, denom, []sdk.AccAddress{dust, optedOut, rich, frozen, empty, issuer, moduleAccount},
+	))
+	requireT.Equal("0", bankKeeper.GetBalance(ctx, dust, denom).Amount.String())
+	requireT.Equal("5", bankKeeper.GetBalance(ctx, optedOut, denom).Amount.String())
+	requireT.Equal("200", bankKeeper.GetBalance(ctx, rich, denom).Amount.String())
+	requireT.Equal("5", bankKeeper.GetBalance(ctx, frozen, denom).Amount.String())
+	feePool, err := testApp.DistrKeeper.FeePool.Get(ctx)
+	requireT.NoError(err)
+	requireT.Equal("10", feePool.CommunityPool.AmountOf(denom).TruncateInt().String())
+
+	swept, err := event.FindTypedEvents[*types.EventDustSwept](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	skippedEvents, err := event.FindTypedEvents[*types.EventDustSweepSkipped](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	skipped := map[string]string{}
+	for _, skippedEvent := range skippedEvents {
+		skipped[skippedEvent.Account] = skippedEvent.Reason
+	}
+	requireT.Len(swept, 1)
+	requireT.Equal(dust.String(), swept[0].Account)
+	requireT.Equal("10", swept[0].Amount.String())
+	requireT.Equal(sweeper.String(), swept[0].Sender)
+	requireT.Equal(map[string]string{
+		optedOut.String():      types.DustSweepSkipReasonOptedOut,
+		rich.String():          types.DustSweepSkipReasonAboveThreshold,
+		frozen.String():        types.DustSweepSkipReasonFrozen,
+		empty.String():         types.DustSweepSkipReasonZeroBalance,
+		issuer.String():        types.DustSweepSkipReasonIssuerOrAdmin,
+		moduleAccount.String(): types.DustSweepSkipReasonModuleAccount,
+	}, skipped)
+
+	// the dust is sent to the issuer after the opt-in
+	requireT.NoError(ftKeeper.SetDustPolicy(ctx, issuer, denom, sdkmath.NewInt(50), types.DUST_DESTINATION_ISSUER))
+	requireT.NoError(ftKeeper.SetDustOptOut(ctx, issuer, optedOut, denom, false))
+	issuerBalance := bankKeeper.GetBalance(ctx, issuer, denom)
+	requireT.NoError(ftKeeper.SweepDust(ctx, sweeper, denom, []sdk.AccAddress{optedOut}))
+	requireT.Equal("0", bankKeeper.GetBalance(ctx, optedOut, denom).Amount.String())
+	requireT.Equal(issuerBalance.Amount.AddRaw(5).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+
+	// the zero threshold removes the policy
+	requireT.NoError(ftKeeper.SetDustPolicy(ctx, issuer, denom, sdkmath.ZeroInt(), types.DUST_DESTINATION_ISSUER))
+	_, err = ftKeeper.GetDustPolicy(ctx, denom)
+	requireT.ErrorIs(err, types.ErrDustPolicyNotFound)
+}
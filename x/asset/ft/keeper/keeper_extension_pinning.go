@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// PinExtensionCodes pins the codes of the extensions of all the issued tokens in the wasm VM cache, up to the limit
+// defined by the params. It is used to pin the codes of the tokens issued before the pinning was introduced.
+func (k Keeper) PinExtensionCodes(ctx sdk.Context) error {
+	codeIDs := make([]uint64, 0)
+	seenCodeIDs := make(map[uint64]struct{})
+	err := k.IterateAllDefinitions(ctx, func(def types.Definition) (bool, error) {
+		if def.ExtensionCWAddress == "" {
+			return false, nil
+		}
+		extensionContract, err := sdk.AccAddressFromBech32(def.ExtensionCWAddress)
+		if err != nil {
+			return true, err
+		}
+		contractInfo := k.wasmKeeper.GetContractInfo(ctx, extensionContract)
+		if contractInfo == nil {
+			return false, nil
+		}
+		if _, ok := seenCodeIDs[contractInfo.CodeID]; ok {
+			return false, nil
+		}
+		seenCodeIDs[contractInfo.CodeID] = struct{}{}
+		codeIDs = append(codeIDs, contractInfo.CodeID)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, codeID := range codeIDs {
+		if err := k.pinExtensionCode(ctx, codeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPinnedExtensionCodes returns the extension codes pinned by the module.
+func (k Keeper) GetPinnedExtensionCodes(ctx sdk.Context) ([]uint64, error) {
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
+		types.PinnedExtensionCodeKeyPrefix,
+	)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	codeIDs := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		codeIDs = append(codeIDs, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return codeIDs, nil
+}
+
+// pinExtensionCode pins the extension code in the wasm VM cache, so the calls of the extension don't load the
+// contract from the disk. The codes pinned by the module are limited by the MaxPinnedExtensionCodes param, the codes
+// pinned by other means are not counted and not pinned again.
+func (k Keeper) pinExtensionCode(ctx sdk.Context, codeID uint64) error {
+	if k.wasmKeeper.IsPinnedCode(ctx, codeID) {
+		return nil
+	}
+
+	kvStore := k.storeService.OpenKVStore(ctx)
+	key := types.CreatePinnedExtensionCodeKey(codeID)
+	// the code pinned by the module before and unpinned by the governance later is pinned again without
+	// taking the new slot
+	pinnedBefore, err := kvStore.Has(key)
+	if err != nil {
+		return err
+	}
+	if !pinnedBefore {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return err
+		}
+		pinnedCodeIDs, err := k.GetPinnedExtensionCodes(ctx)
+		if err != nil {
+			return err
+		}
+		if uint64(len(pinnedCodeIDs)) >= uint64(params.MaxPinnedExtensionCodes) {
+			k.logger.Info(
+				"limit of pinned extension codes is reached, code is not pinned",
+				"codeID", codeID,
+				"limit", params.MaxPinnedExtensionCodes,
+			)
+			return nil
+		}
+	}
+
+	if err := k.wasmPermissionedKeeper.PinCode(ctx, codeID); err != nil {
+		return sdkerrors.Wrapf(err, "failed to pin extension code %d", codeID)
+	}
+
+	return kvStore.Set(key, types.StoreTrue)
+}
+
+// recordExtensionCall records the call of the extension contract in the telemetry, labeled by whether the code of the
+// contract is pinned, so the cache hits of the extension calls might be monitored.
+func (k Keeper) recordExtensionCall(ctx sdk.Context, extensionContract sdk.AccAddress, method string) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	// the gas is not charged to keep the gas consumption independent of the telemetry configuration of the node
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	contractInfo := k.wasmKeeper.GetContractInfo(ctx, extensionContract)
+	if contractInfo == nil {
+		return
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "extension", "call"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("method", method),
+			telemetry.NewLabel("pinned", strconv.FormatBool(k.wasmKeeper.IsPinnedCode(ctx, contractInfo.CodeID))),
+		},
+	)
+}
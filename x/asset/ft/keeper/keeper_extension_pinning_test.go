@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	testcontracts "github.com/tokenize-x/tx-chain/v7/x/asset/ft/keeper/test-contracts"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_Extension_PinCodes(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:    time.Now(),
+		AppHash: []byte("some-hash"),
+	})
+
+	ftKeeper := testApp.AssetFTKeeper
+	wasmKeeper := testApp.WasmKeeper
+
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.MaxPinnedExtensionCodes = 1
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	codeID1, _, err := testApp.WasmPermissionedKeeper.Create(
+		ctx, issuer, testcontracts.AssetExtensionWasm, &wasmtypes.AllowEverybody,
+	)
+	requireT.NoError(err)
+	codeID2, _, err := testApp.WasmPermissionedKeeper.Create(
+		ctx, issuer, testcontracts.RejectAllWasm, &wasmtypes.AllowEverybody,
+	)
+	requireT.NoError(err)
+
+	issue := func(subunit string, codeID uint64) {
+		_, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     8,
+			InitialAmount: sdkmath.NewInt(100),
+			Features:      []types.Feature{types.Feature_extension},
+			ExtensionSettings: &types.ExtensionIssueSettings{
+				CodeId: codeID,
+			},
+		})
+		requireT.NoError(err)
+	}
+
+	// the code of the first extension is pinned on issuance
+	issue("extensiona", codeID1)
+	requireT.True(wasmKeeper.IsPinnedCode(ctx, codeID1))
+
+	// the code of the second extension is not pinned because of the limit
+	issue("extensionb", codeID2)
+	requireT.False(wasmKeeper.IsPinnedCode(ctx, codeID2))
+
+	pinnedCodeIDs, err := ftKeeper.GetPinnedExtensionCodes(ctx)
+	requireT.NoError(err)
+	requireT.Equal([]uint64{codeID1}, pinnedCodeIDs)
+
+	// the code unpinned by the governance is pinned again without taking the new slot
+	requireT.NoError(testApp.WasmPermissionedKeeper.UnpinCode(ctx, codeID1))
+	issue("extensionc", codeID1)
+	requireT.True(wasmKeeper.IsPinnedCode(ctx, codeID1))
+
+	// once the limit is increased the codes of the issued extensions are pinned
+	params.MaxPinnedExtensionCodes = 2
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+	requireT.NoError(ftKeeper.PinExtensionCodes(ctx))
+	requireT.True(wasmKeeper.IsPinnedCode(ctx, codeID2))
+
+	pinnedCodeIDs, err = ftKeeper.GetPinnedExtensionCodes(ctx)
+	requireT.NoError(err)
+	requireT.Equal([]uint64{codeID1, codeID2}, pinnedCodeIDs)
+}
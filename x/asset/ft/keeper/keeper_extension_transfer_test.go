@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -24,13 +25,11 @@ import (
 )
 
 var (
-	AmountDisallowedTrigger               = sdkmath.NewInt(testcontracts.AmountDisallowedTrigger)
-	AmountBurningTrigger                  = sdkmath.NewInt(testcontracts.AmountBurningTrigger)
-	AmountMintingTrigger                  = sdkmath.NewInt(testcontracts.AmountMintingTrigger)
-	AmountIgnoreBurnRateTrigger           = sdkmath.NewInt(testcontracts.AmountIgnoreBurnRateTrigger)
-	AmountIgnoreSendCommissionRateTrigger = sdkmath.NewInt(testcontracts.AmountIgnoreSendCommissionRateTrigger)
-	AmountDEXExpectToSpendTrigger         = sdkmath.NewInt(testcontracts.AmountDEXExpectToSpendTrigger)
-	AmountDEXExpectToReceiveTrigger       = sdkmath.NewInt(testcontracts.AmountDEXExpectToReceiveTrigger)
+	AmountDisallowedTrigger         = sdkmath.NewInt(testcontracts.AmountDisallowedTrigger)
+	AmountBurningTrigger            = sdkmath.NewInt(testcontracts.AmountBurningTrigger)
+	AmountMintingTrigger            = sdkmath.NewInt(testcontracts.AmountMintingTrigger)
+	AmountDEXExpectToSpendTrigger   = sdkmath.NewInt(testcontracts.AmountDEXExpectToSpendTrigger)
+	AmountDEXExpectToReceiveTrigger = sdkmath.NewInt(testcontracts.AmountDEXExpectToReceiveTrigger)
 )
 
 func TestKeeper_Extension_Issue(t *testing.T) {
@@ -701,18 +700,7 @@ func TestKeeper_Extension_BurnRate_BankSend(t *testing.T) {
 		&admin:     475, // 1100 - 500 - 125 (25% burn)
 	})
 
-	// send trigger amount from recipient1 to recipient2 (burn must not apply if the extension decides)
 	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(
-		sdk.NewCoin(denom, AmountIgnoreBurnRateTrigger),
-	))
-	requireT.NoError(err)
-
-	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
-		&recipient:  392, // 500 - 108 (AmountIgnoreBurnRateTrigger)
-		&recipient2: 108, // 108 (AmountIgnoreBurnRateTrigger)
-		&admin:      475,
-	})
 
 	// send from recipient1 to recipient2 (burn must apply)
 	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(
@@ -721,20 +709,20 @@ func TestKeeper_Extension_BurnRate_BankSend(t *testing.T) {
 	requireT.NoError(err)
 
 	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
-		&recipient:  267, // 392 - 100 - 25 (25% burn)
-		&recipient2: 208, // 108 + 100
+		&recipient:  375, // 500 - 100 - 25 (25% burn)
+		&recipient2: 100,
 		&admin:      475,
 	})
 
 	// send from recipient to admin account (burn must apply if the extension decides)
 	err = bankKeeper.SendCoins(ctx, recipient, admin, sdk.NewCoins(
-		sdk.NewCoin(denom, sdkmath.NewInt(213)),
+		sdk.NewCoin(denom, sdkmath.NewInt(300)),
 	))
 	requireT.NoError(err)
 
 	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
-		&recipient2: 208,
-		&admin:      688, // 474 + 213
+		&recipient2: 100,
+		&admin:      775, // 475 + 300
 	})
 }
 
@@ -992,19 +980,7 @@ func TestKeeper_Extension_SendCommissionRate_BankSend(t *testing.T) {
 		&extension: 63, // 63 (50% of the commission to the extension)
 	})
 
-	// send trigger amount from recipient1 to recipient2 (send commission rate must not apply)
 	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(
-		sdk.NewCoin(denom, AmountIgnoreSendCommissionRateTrigger),
-	))
-	requireT.NoError(err)
-
-	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
-		&recipient:  391, // 500 - 109 (AmountIgnoreSendCommissionRateTrigger)
-		&recipient2: 109, // AmountIgnoreSendCommissionRateTrigger
-		&admin:      62,
-		&extension:  63,
-	})
 
 	// send from recipient1 to recipient2 (send commission rate must apply)
 	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(
@@ -1013,10 +989,10 @@ func TestKeeper_Extension_SendCommissionRate_BankSend(t *testing.T) {
 	requireT.NoError(err)
 
 	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
-		&recipient:  266, // 391 - 100 - 25 (25% commission rate from the sender)
-		&recipient2: 209, // 109 + 100
-		&admin:      74,  // 62 + 12 (50% of the commission to the admin)
-		&extension:  76,  // 63 + 13 (50% of the commission to the extension)
+		&recipient:  375, // 500 - 100 - 25 (25% commission rate from the sender)
+		&recipient2: 100,
+		&admin:      74, // 62 + 12 (50% of the commission to the admin)
+		&extension:  76, // 63 + 13 (50% of the commission to the extension)
 	})
 
 	// send from recipient to admin account (send commission rate must apply if the extension decides)
@@ -1026,8 +1002,8 @@ func TestKeeper_Extension_SendCommissionRate_BankSend(t *testing.T) {
 	requireT.NoError(err)
 
 	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
-		&recipient:  141, // 266 - 100 - 25 (25% commission rate from the sender)
-		&recipient2: 209,
+		&recipient:  250, // 375 - 100 - 25 (25% commission rate from the sender)
+		&recipient2: 100,
 		&admin:      186, // 74 + 100 + 12 (50% of the commission to the admin)
 		&extension:  89,  // 76 + 13 (50% of the commission to the extension)
 	})
@@ -1046,13 +1022,112 @@ func TestKeeper_Extension_SendCommissionRate_BankSend(t *testing.T) {
 	requireT.NoError(err)
 
 	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
-		&recipient:  1, // 141 - 112 - 28 (25% commission rate from the sender)
-		&recipient2: 321,
+		&recipient:  110, // 250 - 112 - 28 (25% commission rate from the sender)
+		&recipient2: 212, // 100 + 112
 		&admin:      186, // previous admin does not receive anything
 		&extension:  117, // 89 + 28 (100% of the commission to the extension, since there is no admin)
 	})
 }
 
+func TestKeeper_Extension_CommissionOverride(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:    time.Now(),
+		AppHash: []byte("some-hash"),
+	})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	testCases := []struct {
+		name         string
+		issuanceMsg  testcontracts.CommissionOverrideIssuanceMsg
+		distribution func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64
+	}{
+		{
+			name:        "apply_rates",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{},
+			distribution: func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64 {
+				return map[*sdk.AccAddress]int64{
+					&admin:      570, // 1000 - 400 - 40 (10% burn rate) + 10 (commission of the second transfer)
+					&recipient1: 280, // 400 - 100 - 10 (10% burn rate) - 10 (10% commission)
+					&recipient2: 100,
+				}
+			},
+		},
+		{
+			name: "ignore_burn_rate",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{
+				IgnoreBurnRate: true,
+			},
+			distribution: func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64 {
+				return map[*sdk.AccAddress]int64{
+					&admin:      610, // 1000 - 400 + 10 (commission of the second transfer)
+					&recipient1: 290, // 400 - 100 - 10 (10% commission)
+					&recipient2: 100,
+				}
+			},
+		},
+		{
+			name: "ignore_send_commission_rate",
+			issuanceMsg: testcontracts.CommissionOverrideIssuanceMsg{
+				IgnoreSendCommissionRate: true,
+			},
+			distribution: func(admin, recipient1, recipient2 sdk.AccAddress) map[*sdk.AccAddress]int64 {
+				return map[*sdk.AccAddress]int64{
+					&admin:      560, // 1000 - 400 - 40 (10% burn rate)
+					&recipient1: 290, // 400 - 100 - 10 (10% burn rate)
+					&recipient2: 100,
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+			codeID, _, err := testApp.WasmPermissionedKeeper.Create(
+				ctx, admin, testcontracts.CommissionOverrideWasm, &wasmtypes.AllowEverybody,
+			)
+			requireT.NoError(err)
+
+			issuanceMsg, err := json.Marshal(tc.issuanceMsg)
+			requireT.NoError(err)
+
+			denom, err := assetKeeper.Issue(ctx, types.IssueSettings{
+				Issuer:        admin,
+				Symbol:        "DEF",
+				Subunit:       "def",
+				Precision:     6,
+				InitialAmount: sdkmath.NewInt(1000),
+				Features:      []types.Feature{types.Feature_extension},
+				ExtensionSettings: &types.ExtensionIssueSettings{
+					CodeId:      codeID,
+					IssuanceMsg: issuanceMsg,
+				},
+				BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.10"),
+				SendCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.10"),
+			})
+			requireT.NoError(err)
+
+			requireT.NoError(bankKeeper.SendCoins(ctx, admin, recipient1, sdk.NewCoins(
+				sdk.NewCoin(denom, sdkmath.NewInt(400)),
+			)))
+			requireT.NoError(bankKeeper.SendCoins(ctx, recipient1, recipient2, sdk.NewCoins(
+				sdk.NewCoin(denom, sdkmath.NewInt(100)),
+			)))
+
+			ba.assertCoinDistribution(denom, tc.distribution(admin, recipient1, recipient2))
+		})
+	}
+}
+
 func TestKeeper_Extension_ClearAdmin(t *testing.T) {
 	requireT := require.New(t)
 
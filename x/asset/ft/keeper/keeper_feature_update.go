@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// UpdateFeatures updates the features of the token. The update made by the admin is restricted to the features
+// which don't harm the holders and is applied after the announcement delay, so the holders might react before it.
+// The update made by governance is applied immediately. The new update of the admin replaces the pending one.
+func (k Keeper) UpdateFeatures(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	enableFeatures, disableFeatures []types.Feature,
+) error {
+	if err := types.ValidateFeatureUpdate(enableFeatures, disableFeatures); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	// the resulting features are validated against the current ones to reject the update early, they are validated
+	// again once the update is applied
+	if _, err := types.ApplyFeatureUpdate(def.Features, enableFeatures, disableFeatures); err != nil {
+		return err
+	}
+
+	if sender.String() == k.authority {
+		return k.applyFeatureUpdate(ctx, def, enableFeatures, disableFeatures)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can update the features of the token")
+	}
+	if err := types.ValidateAdminFeatureUpdate(enableFeatures, disableFeatures); err != nil {
+		return err
+	}
+
+	if err := k.cancelPendingFeatureUpdate(ctx, denom); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	update := types.FeatureUpdate{
+		Denom:           denom,
+		EnableFeatures:  enableFeatures,
+		DisableFeatures: disableFeatures,
+		EffectiveTime:   ctx.BlockTime().Add(params.FeatureUpdateDelay),
+	}
+	if err := k.SetPendingFeatureUpdate(ctx, update); err != nil {
+		return err
+	}
+	if err := k.delayKeeper.DelayExecution(
+		ctx,
+		featureUpdateID(denom),
+		&types.DelayedFeatureUpdate{Denom: denom},
+		params.FeatureUpdateDelay,
+	); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFeatureUpdateScheduled{
+		Denom:           denom,
+		EnableFeatures:  enableFeatures,
+		DisableFeatures: disableFeatures,
+		EffectiveTime:   update.EffectiveTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFeatureUpdateScheduled event: %s", err)
+	}
+
+	return nil
+}
+
+// ApplyPendingFeatureUpdate applies the feature update announced by the admin once the announcement delay passes.
+// The update which is no longer valid, because the features have been changed by governance in the meantime, is
+// dropped.
+func (k Keeper) ApplyPendingFeatureUpdate(ctx sdk.Context, data *types.DelayedFeatureUpdate) error {
+	update, err := k.getPendingFeatureUpdateOrNil(ctx, data.Denom)
+	if err != nil {
+		return err
+	}
+	// the update has been replaced in the meantime
+	if update == nil || ctx.BlockTime().Before(update.EffectiveTime) {
+		return nil
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreatePendingFeatureUpdateKey(data.Denom)); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, data.Denom)
+	if err != nil {
+		return err
+	}
+	if _, err := types.ApplyFeatureUpdate(def.Features, update.EnableFeatures, update.DisableFeatures); err != nil {
+		k.logger.Info("dropping invalid feature update", "denom", data.Denom, "error", err)
+		return nil
+	}
+
+	return k.applyFeatureUpdate(ctx, def, update.EnableFeatures, update.DisableFeatures)
+}
+
+// SetPendingFeatureUpdate stores the pending feature update.
+func (k Keeper) SetPendingFeatureUpdate(ctx sdk.Context, update types.FeatureUpdate) error {
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreatePendingFeatureUpdateKey(update.Denom),
+		k.cdc.MustMarshal(&update),
+	)
+}
+
+// GetPendingFeatureUpdate returns the pending feature update of the denom.
+func (k Keeper) GetPendingFeatureUpdate(ctx sdk.Context, denom string) (types.FeatureUpdate, error) {
+	update, err := k.getPendingFeatureUpdateOrNil(ctx, denom)
+	if err != nil {
+		return types.FeatureUpdate{}, err
+	}
+	if update == nil {
+		return types.FeatureUpdate{}, sdkerrors.Wrapf(types.ErrFeatureUpdateNotFound, "denom: %s", denom)
+	}
+
+	return *update, nil
+}
+
+// GetPendingFeatureUpdates returns all the pending feature updates.
+func (k Keeper) GetPendingFeatureUpdates(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.FeatureUpdate, *query.PageResponse, error) {
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
+		types.PendingFeatureUpdateKeyPrefix,
+	)
+	updates := make([]types.FeatureUpdate, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var update types.FeatureUpdate
+		if err := k.cdc.Unmarshal(value, &update); err != nil {
+			return err
+		}
+		updates = append(updates, update)
+		return nil
+	})
+
+	return updates, pageRes, err
+}
+
+func (k Keeper) applyFeatureUpdate(
+	ctx sdk.Context,
+	def types.Definition,
+	enableFeatures, disableFeatures []types.Feature,
+) error {
+	features, err := types.ApplyFeatureUpdate(def.Features, enableFeatures, disableFeatures)
+	if err != nil {
+		return err
+	}
+
+	subunit, issuer, err := types.DeconstructDenom(def.Denom)
+	if err != nil {
+		return err
+	}
+
+	previousFeatures := def.Features
+	def.Features = features
+	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFeaturesUpdated{
+		Denom:            def.Denom,
+		PreviousFeatures: previousFeatures,
+		CurrentFeatures:  features,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFeaturesUpdated event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) cancelPendingFeatureUpdate(ctx sdk.Context, denom string) error {
+	update, err := k.getPendingFeatureUpdateOrNil(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		return nil
+	}
+
+	if err := k.delayKeeper.RemoveExecuteAfter(ctx, featureUpdateID(denom), update.EffectiveTime); err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(types.CreatePendingFeatureUpdateKey(denom))
+}
+
+func (k Keeper) getPendingFeatureUpdateOrNil(ctx sdk.Context, denom string) (*types.FeatureUpdate, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreatePendingFeatureUpdateKey(denom))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var update types.FeatureUpdate
+	if err := k.cdc.Unmarshal(bz, &update); err != nil {
+		return nil, err
+	}
+
+	return &update, nil
+}
+
+func featureUpdateID(denom string) string {
+	return fmt.Sprintf("%s-feature-update-%s", types.ModuleName, denom)
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_UpdateFeatures(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	delayKeeper := testApp.DelayKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	updateDelay := params.FeatureUpdateDelay
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting, types.Feature_burning},
+	})
+	requireT.NoError(err)
+
+	assertFeatures := func(ctx sdk.Context, expected ...types.Feature) {
+		def, err := ftKeeper.GetDefinition(ctx, denom)
+		requireT.NoError(err)
+		requireT.Equal(expected, def.Features)
+	}
+
+	// only the admin can update the features
+	requireT.ErrorIs(
+		ftKeeper.UpdateFeatures(ctx, randomAddr, denom, []types.Feature{types.Feature_freezing}, nil),
+		cosmoserrors.ErrUnauthorized,
+	)
+
+	// the admin can't enable the features letting it take the balances of the holders
+	requireT.ErrorIs(
+		ftKeeper.UpdateFeatures(ctx, issuer, denom, []types.Feature{types.Feature_clawback}, nil),
+		types.ErrInvalidInput,
+	)
+	// the admin can't disable the features protecting the holders
+	requireT.ErrorIs(
+		ftKeeper.UpdateFeatures(ctx, issuer, denom, nil, []types.Feature{types.Feature_burning}),
+		types.ErrInvalidInput,
+	)
+	// the immutable features can't be updated
+	requireT.ErrorIs(
+		ftKeeper.UpdateFeatures(ctx, govAddr, denom, []types.Feature{types.Feature_whitelisting}, nil),
+		types.ErrInvalidInput,
+	)
+	// the feature which is already enabled can't be enabled again
+	requireT.ErrorIs(
+		ftKeeper.UpdateFeatures(ctx, issuer, denom, []types.Feature{types.Feature_burning}, nil),
+		types.ErrInvalidInput,
+	)
+
+	// the update of the admin is scheduled
+	requireT.NoError(ftKeeper.UpdateFeatures(
+		ctx, issuer, denom, []types.Feature{types.Feature_freezing}, []types.Feature{types.Feature_minting},
+	))
+	update, err := ftKeeper.GetPendingFeatureUpdate(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.FeatureUpdate{
+		Denom:           denom,
+		EnableFeatures:  []types.Feature{types.Feature_freezing},
+		DisableFeatures: []types.Feature{types.Feature_minting},
+		EffectiveTime:   ctx.BlockTime().Add(updateDelay),
+	}, update)
+	assertFeatures(ctx, types.Feature_minting, types.Feature_burning)
+
+	// the pending update is replaced by the new one
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updateDelay / 2))
+	requireT.NoError(ftKeeper.UpdateFeatures(ctx, issuer, denom, []types.Feature{types.Feature_ibc}, nil))
+	update, err = ftKeeper.GetPendingFeatureUpdate(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(ctx.BlockTime().Add(updateDelay), update.EffectiveTime)
+
+	// the replaced update is not applied
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updateDelay / 2))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	assertFeatures(ctx, types.Feature_minting, types.Feature_burning)
+
+	// the update is applied after the delay
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updateDelay / 2))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	assertFeatures(ctx, types.Feature_minting, types.Feature_burning, types.Feature_ibc)
+	_, err = ftKeeper.GetPendingFeatureUpdate(ctx, denom)
+	requireT.ErrorIs(err, types.ErrFeatureUpdateNotFound)
+
+	// the update of governance is applied immediately
+	requireT.NoError(ftKeeper.UpdateFeatures(
+		ctx, govAddr, denom, []types.Feature{types.Feature_clawback}, []types.Feature{types.Feature_burning},
+	))
+	assertFeatures(ctx, types.Feature_minting, types.Feature_ibc, types.Feature_clawback)
+
+	// the update which is no longer valid when the delay passes is dropped
+	requireT.NoError(ftKeeper.UpdateFeatures(ctx, issuer, denom, []types.Feature{types.Feature_freezing}, nil))
+	requireT.NoError(ftKeeper.UpdateFeatures(ctx, govAddr, denom, []types.Feature{types.Feature_freezing}, nil))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(updateDelay))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	assertFeatures(ctx, types.Feature_minting, types.Feature_ibc, types.Feature_clawback, types.Feature_freezing)
+	_, err = ftKeeper.GetPendingFeatureUpdate(ctx, denom)
+	requireT.ErrorIs(err, types.ErrFeatureUpdateNotFound)
+}
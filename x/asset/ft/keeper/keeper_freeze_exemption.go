@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// SetFreezeExemption exempts the account from the global freeze of the token until the expiration time, or changes
+// the expiration time of the existing exemption. The exemption is set by the admin of the token with the freezing
+// feature enabled.
+func (k Keeper) SetFreezeExemption(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	denom string,
+	expirationTime time.Time,
+) error {
+	if !expirationTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "expiration time must be in the future")
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if err := def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+		return err
+	}
+	if def.HasAdminPrivileges(addr) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "admin is not affected by the global freeze")
+	}
+
+	if err := k.setFreezeExemption(ctx, types.FreezeExemption{
+		Denom:          denom,
+		Account:        addr.String(),
+		ExpirationTime: expirationTime,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFreezeExemptionAdded{
+		Denom:          denom,
+		Account:        addr.String(),
+		ExpirationTime: expirationTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFreezeExemptionAdded event: %s", err)
+	}
+
+	return nil
+}
+
+// RemoveFreezeExemption removes the exemption of the account from the global freeze of the token. The expired
+// exemptions are removed the same way.
+func (k Keeper) RemoveFreezeExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if !def.HasAdminPrivileges(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can remove the freeze exemption")
+	}
+
+	key := types.CreateFreezeExemptionKey(denom, addr)
+	kvStore := k.storeService.OpenKVStore(ctx)
+	found, err := kvStore.Has(key)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(
+			types.ErrFreezeExemptionNotFound, "freeze exemption of %s for %s not found", addr, denom,
+		)
+	}
+	if err := kvStore.Delete(key); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFreezeExemptionRemoved{
+		Denom:   denom,
+		Account: addr.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFreezeExemptionRemoved event: %s", err)
+	}
+
+	return nil
+}
+
+// ImportFreezeExemptions imports the freeze exemptions from genesis state.
+func (k Keeper) ImportFreezeExemptions(ctx sdk.Context, exemptions []types.FreezeExemption) error {
+	for _, exemption := range exemptions {
+		if err := k.setFreezeExemption(ctx, exemption); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFreezeExemptions returns the freeze exemptions of the denom, including the expired ones.
+func (k Keeper) GetFreezeExemptions(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.FreezeExemption, *query.PageResponse, error) {
+	return k.getFreezeExemptions(ctx, types.CreateFreezeExemptionsPrefix(denom), pagination)
+}
+
+// GetAllFreezeExemptions returns the freeze exemptions of all the denoms, including the expired ones.
+func (k Keeper) GetAllFreezeExemptions(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.FreezeExemption, *query.PageResponse, error) {
+	return k.getFreezeExemptions(ctx, types.FreezeExemptionKeyPrefix, pagination)
+}
+
+// IsExemptFromGlobalFreeze returns true if the account has the unexpired exemption from the global freeze of the
+// denom.
+func (k Keeper) IsExemptFromGlobalFreeze(ctx sdk.Context, denom string, addr sdk.AccAddress) (bool, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateFreezeExemptionKey(denom, addr))
+	if err != nil {
+		return false, err
+	}
+	if bz == nil {
+		return false, nil
+	}
+	var exemption types.FreezeExemption
+	if err := k.cdc.Unmarshal(bz, &exemption); err != nil {
+		return false, err
+	}
+
+	return ctx.BlockTime().Before(exemption.ExpirationTime), nil
+}
+
+func (k Keeper) getFreezeExemptions(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	pagination *query.PageRequest,
+) ([]types.FreezeExemption, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	exemptions := make([]types.FreezeExemption, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var exemption types.FreezeExemption
+		if err := k.cdc.Unmarshal(value, &exemption); err != nil {
+			return err
+		}
+		exemptions = append(exemptions, exemption)
+		return nil
+	})
+
+	return exemptions, pageRes, err
+}
+
+func (k Keeper) setFreezeExemption(ctx sdk.Context, exemption types.FreezeExemption) error {
+	addr, err := sdk.AccAddressFromBech32(exemption.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid account address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateFreezeExemptionKey(exemption.Denom, addr),
+		k.cdc.MustMarshal(&exemption),
+	)
+}
+
+// isGloballyFrozenFor returns true if the token is globally frozen for the account, so the account is neither the
+// admin of the token nor exempted from the global freeze.
+func (k Keeper) isGloballyFrozenFor(ctx sdk.Context, def types.Definition, addr sdk.AccAddress) (bool, error) {
+	isGloballyFrozen, err := k.isGloballyFrozen(ctx, def.Denom)
+	if err != nil {
+		return false, err
+	}
+	if !isGloballyFrozen || def.HasAdminPrivileges(addr) {
+		return false, nil
+	}
+	isExempt, err := k.IsExemptFromGlobalFreeze(ctx, def.Denom, addr)
+	if err != nil {
+		return false, err
+	}
+
+	return !isExempt, nil
+}
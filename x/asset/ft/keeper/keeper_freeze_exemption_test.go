@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_FreezeExemption(t *testing.T) {
+	requireT := require.New(t)
+
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Time: startTime})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	marketMaker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1_000),
+		Features:      []types.Feature{types.Feature_freezing},
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, marketMaker, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	expirationTime := startTime.Add(time.Hour)
+
+	// only the admin exempts the accounts, with the expiration time in the future
+	requireT.ErrorIs(
+		ftKeeper.SetFreezeExemption(ctx, holder, marketMaker, denom, expirationTime),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.ErrorIs(
+		ftKeeper.SetFreezeExemption(ctx, issuer, marketMaker, denom, startTime),
+		types.ErrInvalidInput,
+	)
+	requireT.ErrorIs(
+		ftKeeper.SetFreezeExemption(ctx, issuer, issuer, denom, expirationTime),
+		types.ErrInvalidInput,
+	)
+	requireT.NoError(ftKeeper.SetFreezeExemption(ctx, issuer, marketMaker, denom, expirationTime))
+
+	addedEvents, err := event.FindTypedEvents[*types.EventFreezeExemptionAdded](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventFreezeExemptionAdded{{
+		Denom:          denom,
+		Account:        marketMaker.String(),
+		ExpirationTime: expirationTime,
+	}}, addedEvents)
+
+	exemptions, _, err := ftKeeper.GetFreezeExemptions(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.FreezeExemption{{
+		Denom:          denom,
+		Account:        marketMaker.String(),
+		ExpirationTime: expirationTime,
+	}}, exemptions)
+
+	// the exempted account operates during the global freeze
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+		types.ErrGloballyFrozen,
+	)
+	requireT.NoError(bankKeeper.SendCoins(ctx, marketMaker, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	frozenBalance, err := ftKeeper.GetFrozenBalance(ctx, marketMaker, denom)
+	requireT.NoError(err)
+	requireT.True(frozenBalance.IsZero())
+
+	// the individual freeze still applies to the exempted account
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, marketMaker, sdk.NewInt64Coin(denom, 85)))
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, marketMaker, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, marketMaker, sdk.NewInt64Coin(denom, 85)))
+
+	// the expired exemption isn't applied anymore
+	ctx = ctx.WithBlockTime(expirationTime)
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, marketMaker, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+		types.ErrGloballyFrozen,
+	)
+
+	// the expiration time is extended
+	requireT.NoError(ftKeeper.SetFreezeExemption(ctx, issuer, marketMaker, denom, expirationTime.Add(time.Hour)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, marketMaker, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	// the removed exemption isn't applied anymore
+	requireT.ErrorIs(ftKeeper.RemoveFreezeExemption(ctx, holder, marketMaker, denom), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(ftKeeper.RemoveFreezeExemption(ctx, issuer, holder, denom), types.ErrFreezeExemptionNotFound)
+	requireT.NoError(ftKeeper.RemoveFreezeExemption(ctx, issuer, marketMaker, denom))
+
+	removedEvents, err := event.FindTypedEvents[*types.EventFreezeExemptionRemoved](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventFreezeExemptionRemoved{{
+		Denom:   denom,
+		Account: marketMaker.String(),
+	}}, removedEvents)
+
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, marketMaker, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+		types.ErrGloballyFrozen,
+	)
+	exemptions, _, err = ftKeeper.GetFreezeExemptions(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Empty(exemptions)
+}
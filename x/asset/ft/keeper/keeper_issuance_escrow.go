@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// IssueEscrowed issues the token and locks its initial supply on the module account until the buyer pays for it.
+// If the buyer doesn't pay within the settlement period, the supply is refunded to the issuer.
+func (k Keeper) IssueEscrowed(
+	ctx sdk.Context,
+	settings types.IssueSettings,
+	buyer sdk.AccAddress,
+	payment sdk.Coin,
+	settlementPeriod time.Duration,
+) (string, error) {
+	if !settings.InitialAmount.IsPositive() {
+		return "", sdkerrors.Wrap(types.ErrInvalidInput, "initial amount of the escrowed issuance must be positive")
+	}
+	if settings.ExtensionSettings != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidInput, "tokens with the extension can't be issued with the escrow")
+	}
+	if buyer.Equals(settings.Issuer) {
+		return "", sdkerrors.Wrap(types.ErrInvalidInput, "buyer must be different from the issuer")
+	}
+	if !payment.IsValid() || !payment.IsPositive() {
+		return "", sdkerrors.Wrapf(cosmoserrors.ErrInvalidCoins, "invalid payment %s", payment.String())
+	}
+	if settlementPeriod <= 0 {
+		return "", sdkerrors.Wrap(types.ErrInvalidInput, "settlement period must be positive")
+	}
+
+	denom, err := k.Issue(ctx, settings)
+	if err != nil {
+		return "", err
+	}
+	if payment.Denom == denom {
+		return "", sdkerrors.Wrap(types.ErrInvalidInput, "payment can't be made with the issued token")
+	}
+
+	amount := sdk.NewCoins(sdk.NewCoin(denom, settings.InitialAmount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, settings.Issuer, types.ModuleName, amount); err != nil {
+		return "", sdkerrors.Wrapf(err, "can't escrow initial supply %s", amount.String())
+	}
+
+	escrow := types.IssuanceEscrow{
+		Denom:    denom,
+		Issuer:   settings.Issuer.String(),
+		Buyer:    buyer.String(),
+		Amount:   settings.InitialAmount,
+		Payment:  payment,
+		Deadline: ctx.BlockTime().Add(settlementPeriod),
+	}
+	if err := k.SetIssuanceEscrow(ctx, escrow); err != nil {
+		return "", err
+	}
+
+	if err := k.delayKeeper.DelayExecution(
+		ctx,
+		issuanceEscrowID(denom),
+		&types.DelayedIssuanceEscrowExpiration{Denom: denom},
+		settlementPeriod,
+	); err != nil {
+		return "", err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuanceEscrowCreated{
+		Denom:    escrow.Denom,
+		Issuer:   escrow.Issuer,
+		Buyer:    escrow.Buyer,
+		Amount:   escrow.Amount,
+		Payment:  escrow.Payment,
+		Deadline: escrow.Deadline,
+	}); err != nil {
+		return "", sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssuanceEscrowCreated event: %s", err)
+	}
+
+	return denom, nil
+}
+
+// SettleEscrow transfers the payment from the buyer to the issuer and releases the escrowed supply to the buyer.
+func (k Keeper) SettleEscrow(ctx sdk.Context, buyer sdk.AccAddress, denom string) error {
+	escrow, err := k.GetIssuanceEscrow(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if escrow.Buyer != buyer.String() {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "only the buyer %s can settle the escrow", escrow.Buyer)
+	}
+	if !ctx.BlockTime().Before(escrow.Deadline) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "settlement deadline %s has passed", escrow.Deadline)
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if err := k.validateCoinReceivable(ctx, buyer, def, escrow.Amount); err != nil {
+		return sdkerrors.Wrapf(err, "coins are not receivable")
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(escrow.Issuer)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid issuer address %s", escrow.Issuer)
+	}
+	if err := k.payForEscrow(ctx, buyer, issuer, escrow.Payment); err != nil {
+		return err
+	}
+
+	amount := sdk.NewCoins(sdk.NewCoin(denom, escrow.Amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, amount); err != nil {
+		return sdkerrors.Wrapf(err, "can't release escrowed supply %s", amount.String())
+	}
+
+	if err := k.delayKeeper.RemoveExecuteAfter(ctx, issuanceEscrowID(denom), escrow.Deadline); err != nil {
+		return err
+	}
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateIssuanceEscrowKey(denom)); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuanceEscrowSettled{
+		Denom:   escrow.Denom,
+		Issuer:  escrow.Issuer,
+		Buyer:   escrow.Buyer,
+		Amount:  escrow.Amount,
+		Payment: escrow.Payment,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssuanceEscrowSettled event: %s", err)
+	}
+
+	return nil
+}
+
+// ExpireIssuanceEscrow refunds the escrowed supply to the issuer if the buyer hasn't paid before the deadline.
+func (k Keeper) ExpireIssuanceEscrow(ctx sdk.Context, data *types.DelayedIssuanceEscrowExpiration) error {
+	escrow, err := k.getIssuanceEscrowOrNil(ctx, data.Denom)
+	if err != nil {
+		return err
+	}
+	// the escrow has been already settled by the buyer
+	if escrow == nil || ctx.BlockTime().Before(escrow.Deadline) {
+		return nil
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(escrow.Issuer)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid issuer address %s", escrow.Issuer)
+	}
+	amount := sdk.NewCoins(sdk.NewCoin(escrow.Denom, escrow.Amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, issuer, amount); err != nil {
+		return sdkerrors.Wrapf(err, "can't refund escrowed supply %s", amount.String())
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateIssuanceEscrowKey(escrow.Denom)); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuanceEscrowRefunded{
+		Denom:  escrow.Denom,
+		Issuer: escrow.Issuer,
+		Amount: escrow.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssuanceEscrowRefunded event: %s", err)
+	}
+
+	return nil
+}
+
+// SetIssuanceEscrow stores the issuance escrow.
+func (k Keeper) SetIssuanceEscrow(ctx sdk.Context, escrow types.IssuanceEscrow) error {
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateIssuanceEscrowKey(escrow.Denom),
+		k.cdc.MustMarshal(&escrow),
+	)
+}
+
+// GetIssuanceEscrow returns the issuance escrow of the denom.
+func (k Keeper) GetIssuanceEscrow(ctx sdk.Context, denom string) (types.IssuanceEscrow, error) {
+	escrow, err := k.getIssuanceEscrowOrNil(ctx, denom)
+	if err != nil {
+		return types.IssuanceEscrow{}, err
+	}
+	if escrow == nil {
+		return types.IssuanceEscrow{}, sdkerrors.Wrapf(types.ErrIssuanceEscrowNotFound, "denom: %s", denom)
+	}
+
+	return *escrow, nil
+}
+
+// GetIssuanceEscrows returns all the issuance escrows.
+func (k Keeper) GetIssuanceEscrows(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.IssuanceEscrow, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.IssuanceEscrowKeyPrefix)
+	escrows := make([]types.IssuanceEscrow, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var escrow types.IssuanceEscrow
+		if err := k.cdc.Unmarshal(value, &escrow); err != nil {
+			return err
+		}
+		escrows = append(escrows, escrow)
+		return nil
+	})
+
+	return escrows, pageRes, err
+}
+
+// payForEscrow transfers the payment from the buyer to the issuer. If the payment is made with the fungible token,
+// the transfer must be allowed by the features of that token. The burn rate and send commission are not applied.
+func (k Keeper) payForEscrow(ctx sdk.Context, buyer, issuer sdk.AccAddress, payment sdk.Coin) error {
+	def, err := k.GetDefinition(ctx, payment.Denom)
+	switch {
+	case err == nil:
+		if err := k.validateCoinSpendable(ctx, buyer, def, payment.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "coins are not spendable")
+		}
+		if err := k.validateCoinReceivable(ctx, issuer, def, payment.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "coins are not receivable")
+		}
+	case sdkerrors.IsOf(err, types.ErrInvalidDenom, types.ErrTokenNotFound):
+		if err := k.validateCoinIsNotLockedByDEXAndBank(ctx, buyer, payment); err != nil {
+			return sdkerrors.Wrap(err, "out of funds to pay for the escrowed supply")
+		}
+	default:
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, buyer, issuer, sdk.NewCoins(payment)); err != nil {
+		return sdkerrors.Wrapf(err, "can't pay %s for the escrowed supply", payment.String())
+	}
+
+	return nil
+}
+
+func (k Keeper) getIssuanceEscrowOrNil(ctx sdk.Context, denom string) (*types.IssuanceEscrow, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateIssuanceEscrowKey(denom))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var escrow types.IssuanceEscrow
+	if err := k.cdc.Unmarshal(bz, &escrow); err != nil {
+		return nil, err
+	}
+
+	return &escrow, nil
+}
+
+func issuanceEscrowID(denom string) string {
+	return fmt.Sprintf("%s-issuance-escrow-%s", types.ModuleName, denom)
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_IssueEscrowed(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	bankKeeper := testApp.BankKeeper
+	delayKeeper := testApp.DelayKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	ftParams := types.DefaultParams()
+	ftParams.IssueFee = sdk.NewInt64Coin(constant.DenomDev, 0)
+	requireT.NoError(ftKeeper.SetParams(ctx, ftParams))
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	buyer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	payment := sdk.NewInt64Coin(constant.DenomDev, 1_000)
+
+	settings := func(symbol string, features ...types.Feature) types.IssueSettings {
+		return types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        symbol,
+			Subunit:       "u" + symbol,
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(100),
+			Features:      features,
+		}
+	}
+
+	// the buyer can't be the issuer
+	_, err := ftKeeper.IssueEscrowed(ctx, settings("abc"), issuer, payment, time.Hour)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// the initial supply must be escrowed
+	emptySettings := settings("abc")
+	emptySettings.InitialAmount = sdkmath.ZeroInt()
+	_, err = ftKeeper.IssueEscrowed(ctx, emptySettings, buyer, payment, time.Hour)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// issue the token and check the initial supply is escrowed
+	denom, err := ftKeeper.IssueEscrowed(ctx, settings("abc", types.Feature_whitelisting), buyer, payment, time.Hour)
+	requireT.NoError(err)
+	requireT.True(bankKeeper.GetBalance(ctx, issuer, denom).IsZero())
+	requireT.Equal(sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, moduleAddress, denom).Amount)
+	escrow, err := ftKeeper.GetIssuanceEscrow(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.IssuanceEscrow{
+		Denom:    denom,
+		Issuer:   issuer.String(),
+		Buyer:    buyer.String(),
+		Amount:   sdkmath.NewInt(100),
+		Payment:  payment,
+		Deadline: ctx.BlockTime().Add(time.Hour),
+	}, escrow)
+
+	// only the buyer can settle the escrow
+	requireT.ErrorIs(ftKeeper.SettleEscrow(ctx, issuer, denom), cosmoserrors.ErrUnauthorized)
+
+	// the features of the token apply to the released supply
+	requireT.ErrorIs(ftKeeper.SettleEscrow(ctx, buyer, denom), types.ErrWhitelistedLimitExceeded)
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, buyer, sdk.NewInt64Coin(denom, 100)))
+
+	// the buyer must have the payment
+	requireT.ErrorIs(ftKeeper.SettleEscrow(ctx, buyer, denom), cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(payment)))
+
+	// settle the escrow
+	requireT.NoError(ftKeeper.SettleEscrow(ctx, buyer, denom))
+	requireT.Equal(sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, buyer, denom).Amount)
+	requireT.True(bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).IsZero())
+	requireT.Equal(payment, bankKeeper.GetBalance(ctx, issuer, constant.DenomDev))
+	requireT.True(bankKeeper.GetBalance(ctx, moduleAddress, denom).IsZero())
+	_, err = ftKeeper.GetIssuanceEscrow(ctx, denom)
+	requireT.ErrorIs(err, types.ErrIssuanceEscrowNotFound)
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	// issue another token and let the escrow expire
+	denom, err = ftKeeper.IssueEscrowed(ctx, settings("xyz"), buyer, payment, time.Hour)
+	requireT.NoError(err)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(payment)))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.ErrorIs(ftKeeper.SettleEscrow(ctx, buyer, denom), types.ErrInvalidInput)
+
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, issuer, denom).Amount)
+	requireT.True(bankKeeper.GetBalance(ctx, moduleAddress, denom).IsZero())
+	requireT.Equal(payment, bankKeeper.GetBalance(ctx, buyer, constant.DenomDev))
+	_, err = ftKeeper.GetIssuanceEscrow(ctx, denom)
+	requireT.ErrorIs(err, types.ErrIssuanceEscrowNotFound)
+	requireT.ErrorIs(ftKeeper.SettleEscrow(ctx, buyer, denom), types.ErrIssuanceEscrowNotFound)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// UpdateIssuePreset is a governance operation to create or replace the issue preset. The tokens issued with
+// the previous version of the preset are not affected.
+func (k Keeper) UpdateIssuePreset(ctx sdk.Context, authority string, preset types.IssuePreset) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	if err := preset.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.SetIssuePreset(ctx, preset); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuePresetSet{
+		Preset: preset,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssuePresetSet event: %s", err)
+	}
+
+	return nil
+}
+
+// RemoveIssuePreset is a governance operation to remove the issue preset.
+func (k Keeper) RemoveIssuePreset(ctx sdk.Context, authority, name string) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	if _, err := k.GetIssuePreset(ctx, name); err != nil {
+		return err
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateIssuePresetKey(name)); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuePresetRemoved{
+		Name: name,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssuePresetRemoved event: %s", err)
+	}
+
+	return nil
+}
+
+// SetIssuePreset stores the issue preset.
+func (k Keeper) SetIssuePreset(ctx sdk.Context, preset types.IssuePreset) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.CreateIssuePresetKey(preset.Name), k.cdc.MustMarshal(&preset))
+}
+
+// GetIssuePreset returns the issue preset.
+func (k Keeper) GetIssuePreset(ctx sdk.Context, name string) (types.IssuePreset, error) {
+	preset, err := k.getIssuePresetOrNil(ctx, name)
+	if err != nil {
+		return types.IssuePreset{}, err
+	}
+	if preset == nil {
+		return types.IssuePreset{}, sdkerrors.Wrapf(types.ErrIssuePresetNotFound, "name: %s", name)
+	}
+
+	return *preset, nil
+}
+
+// GetIssuePresets returns all the issue presets.
+func (k Keeper) GetIssuePresets(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.IssuePreset, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.IssuePresetKeyPrefix)
+	presets := make([]types.IssuePreset, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var preset types.IssuePreset
+		if err := k.cdc.Unmarshal(value, &preset); err != nil {
+			return err
+		}
+		presets = append(presets, preset)
+		return nil
+	})
+
+	return presets, pageRes, err
+}
+
+func (k Keeper) getIssuePresetOrNil(ctx sdk.Context, name string) (*types.IssuePreset, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateIssuePresetKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var preset types.IssuePreset
+	if err := k.cdc.Unmarshal(bz, &preset); err != nil {
+		return nil, err
+	}
+
+	return &preset, nil
+}
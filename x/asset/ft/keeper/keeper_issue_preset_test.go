@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_IssuePreset(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	unifiedRefAmount := sdkmath.LegacyNewDec(10)
+	preset := types.IssuePreset{
+		Name:        "security-token-v1",
+		Description: "Regulated security token",
+		Features: []types.Feature{
+			types.Feature_freezing,
+			types.Feature_whitelisting,
+			types.Feature_clawback,
+			types.Feature_dex_unified_ref_amount_change,
+		},
+		BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.01"),
+		SendCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.02"),
+		DEXSettings: &types.DEXSettings{
+			UnifiedRefAmount: &unifiedRefAmount,
+		},
+	}
+
+	// only the governance can set the preset
+	requireT.ErrorIs(ftKeeper.UpdateIssuePreset(ctx, issuer.String(), preset), govtypes.ErrInvalidSigner)
+	invalidPreset := preset
+	invalidPreset.Name = "X"
+	requireT.ErrorIs(ftKeeper.UpdateIssuePreset(ctx, authority, invalidPreset), types.ErrInvalidInput)
+	// the DEX settings must be allowed by the features of the preset
+	invalidPreset = preset
+	invalidPreset.Features = preset.Features[:3]
+	requireT.ErrorIs(ftKeeper.UpdateIssuePreset(ctx, authority, invalidPreset), types.ErrFeatureDisabled)
+	requireT.NoError(ftKeeper.UpdateIssuePreset(ctx, authority, preset))
+
+	presets, _, err := ftKeeper.GetIssuePresets(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(presets, 1)
+	requireT.Equal(preset.Name, presets[0].Name)
+
+	// the token is issued with the settings of the preset
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "SEC",
+		Subunit:       "usec",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(100),
+		Preset:        preset.Name,
+	})
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(preset.Features, token.Features)
+	requireT.Equal(preset.BurnRate.String(), token.BurnRate.String())
+	requireT.Equal(preset.SendCommissionRate.String(), token.SendCommissionRate.String())
+	dexSettings, err := ftKeeper.GetDEXSettings(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(unifiedRefAmount.String(), dexSettings.UnifiedRefAmount.String())
+
+	// the unknown preset can't be used
+	_, err = ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "SEC2",
+		Subunit:       "usec2",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(100),
+		Preset:        "unknown",
+	})
+	requireT.ErrorIs(err, types.ErrIssuePresetNotFound)
+
+	// the removal doesn't affect the issued tokens
+	requireT.ErrorIs(ftKeeper.RemoveIssuePreset(ctx, issuer.String(), preset.Name), govtypes.ErrInvalidSigner)
+	requireT.NoError(ftKeeper.RemoveIssuePreset(ctx, authority, preset.Name))
+	requireT.ErrorIs(ftKeeper.RemoveIssuePreset(ctx, authority, preset.Name), types.ErrIssuePresetNotFound)
+	_, err = ftKeeper.GetIssuePreset(ctx, preset.Name)
+	requireT.ErrorIs(err, types.ErrIssuePresetNotFound)
+
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(preset.Features, token.Features)
+}
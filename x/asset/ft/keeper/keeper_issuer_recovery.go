@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// DesignateSuccessor designates the successor allowed to claim the admin rights of the tokens of the issuer once the
+// issuer doesn't sign any transaction for the inactivity period. The inactivity period can't be shorter than the min
+// issuer recovery inactivity period. The new designation replaces the existing one.
+func (k Keeper) DesignateSuccessor(
+	ctx sdk.Context,
+	issuer, successor sdk.AccAddress,
+	inactivityPeriod time.Duration,
+) error {
+	if issuer.Equals(successor) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "issuer can't be its own successor")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if inactivityPeriod < params.MinIssuerRecoveryInactivityPeriod {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"inactivity period must not be shorter than %s", params.MinIssuerRecoveryInactivityPeriod,
+		)
+	}
+
+	if err := k.SetIssuerRecovery(ctx, types.IssuerRecovery{
+		Issuer:           issuer.String(),
+		Successor:        successor.String(),
+		InactivityPeriod: inactivityPeriod,
+		LastActivityTime: ctx.BlockTime(),
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuerRecoverySet{
+		Issuer:           issuer.String(),
+		Successor:        successor.String(),
+		InactivityPeriod: inactivityPeriod,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssuerRecoverySet event: %s", err)
+	}
+
+	return nil
+}
+
+// CancelIssuerRecovery cancels the designation of the successor made by the issuer.
+func (k Keeper) CancelIssuerRecovery(ctx sdk.Context, issuer sdk.AccAddress) error {
+	recovery, err := k.GetIssuerRecovery(ctx, issuer)
+	if err != nil {
+		return err
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateIssuerRecoveryKey(issuer)); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuerRecoveryCancelled{
+		Issuer:    recovery.Issuer,
+		Successor: recovery.Successor,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssuerRecoveryCancelled event: %s", err)
+	}
+
+	return nil
+}
+
+// ClaimIssuerRecovery transfers the admin rights of the tokens issued and still administered by the issuer to the
+// successor designated by the issuer, once the issuer stays inactive for the inactivity period. The tokens whose admin
+// rights were transferred or cleared by the issuer are not affected. The designation is removed after the claim.
+func (k Keeper) ClaimIssuerRecovery(ctx sdk.Context, sender, issuer sdk.AccAddress) ([]string, error) {
+	recovery, err := k.GetIssuerRecovery(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if recovery.Successor != sender.String() {
+		return nil, sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized, "only the successor can claim the issuer recovery of %s", issuer,
+		)
+	}
+	if claimableTime := recovery.ClaimableTime(); ctx.BlockTime().Before(claimableTime) {
+		return nil, sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized,
+			"issuer recovery can't be claimed before %s", claimableTime.UTC().Format(time.RFC3339),
+		)
+	}
+
+	// the denoms are collected first, since the admin transfer modifies the iterated store
+	denoms, err := k.getDenomsAdministeredByIssuer(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, denom := range denoms {
+		if err := k.TransferAdmin(ctx, issuer, sender, denom); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateIssuerRecoveryKey(issuer)); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssuerRecoveryClaimed{
+		Issuer:    recovery.Issuer,
+		Successor: recovery.Successor,
+		Denoms:    denoms,
+	}); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssuerRecoveryClaimed event: %s", err)
+	}
+
+	return denoms, nil
+}
+
+// RecordIssuerActivity resets the inactivity period of the issuer recovery if the account signing the transaction
+// designated the successor.
+func (k Keeper) RecordIssuerActivity(ctx sdk.Context, signer sdk.AccAddress) error {
+	recovery, err := k.getIssuerRecoveryOrNil(ctx, signer)
+	if err != nil {
+		return err
+	}
+	if recovery == nil || recovery.LastActivityTime.Equal(ctx.BlockTime()) {
+		return nil
+	}
+
+	recovery.LastActivityTime = ctx.BlockTime()
+	return k.SetIssuerRecovery(ctx, *recovery)
+}
+
+// SetIssuerRecovery stores the issuer recovery.
+func (k Keeper) SetIssuerRecovery(ctx sdk.Context, recovery types.IssuerRecovery) error {
+	issuer, err := sdk.AccAddressFromBech32(recovery.Issuer)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid issuer address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(types.CreateIssuerRecoveryKey(issuer), k.cdc.MustMarshal(&recovery))
+}
+
+// GetIssuerRecovery returns the successor designated by the issuer.
+func (k Keeper) GetIssuerRecovery(ctx sdk.Context, issuer sdk.AccAddress) (types.IssuerRecovery, error) {
+	recovery, err := k.getIssuerRecoveryOrNil(ctx, issuer)
+	if err != nil {
+		return types.IssuerRecovery{}, err
+	}
+	if recovery == nil {
+		return types.IssuerRecovery{}, sdkerrors.Wrapf(
+			types.ErrIssuerRecoveryNotFound, "issuer recovery of %s not found", issuer,
+		)
+	}
+
+	return *recovery, nil
+}
+
+// GetIssuerRecoveries returns the successors designated by the issuers.
+func (k Keeper) GetIssuerRecoveries(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.IssuerRecovery, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.IssuerRecoveryKeyPrefix)
+	recoveries := make([]types.IssuerRecovery, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var recovery types.IssuerRecovery
+		if err := k.cdc.Unmarshal(value, &recovery); err != nil {
+			return err
+		}
+		recoveries = append(recoveries, recovery)
+		return nil
+	})
+
+	return recoveries, pageRes, err
+}
+
+func (k Keeper) getIssuerRecoveryOrNil(ctx sdk.Context, issuer sdk.AccAddress) (*types.IssuerRecovery, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateIssuerRecoveryKey(issuer))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var recovery types.IssuerRecovery
+	if err := k.cdc.Unmarshal(bz, &recovery); err != nil {
+		return nil, err
+	}
+
+	return &recovery, nil
+}
+
+// getDenomsAdministeredByIssuer returns the denoms of the tokens issued by the issuer, for which the issuer is still
+// the admin.
+func (k Keeper) getDenomsAdministeredByIssuer(ctx sdk.Context, issuer sdk.AccAddress) ([]string, error) {
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CreateIssuerTokensPrefix(issuer),
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var denoms []string
+	for ; iterator.Valid(); iterator.Next() {
+		var def types.Definition
+		if err := k.cdc.Unmarshal(iterator.Value(), &def); err != nil {
+			return nil, err
+		}
+		if def.IsAdmin(issuer) {
+			denoms = append(denoms, def.Denom)
+		}
+	}
+
+	return denoms, nil
+}
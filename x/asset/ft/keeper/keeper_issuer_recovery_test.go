@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_IssuerRecovery(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now().UTC())
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	period := params.MinIssuerRecoveryInactivityPeriod
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	successor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	issue := func(symbol string) string {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        symbol,
+			Subunit:       "u" + symbol,
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(1000),
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	denom1 := issue("abc")
+	denom2 := issue("def")
+	denom3 := issue("ghi")
+
+	// the role delegated by the issuer is revoked when the successor claims the admin
+	requireT.NoError(ftKeeper.AssignRole(ctx, issuer, randomAddr, denom1, types.ROLE_MINTER))
+
+	// the admin of one token is transferred and of another one is cleared
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, randomAddr, denom2))
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom3))
+
+	// the issuer can't be its own successor
+	requireT.ErrorIs(ftKeeper.DesignateSuccessor(ctx, issuer, issuer, period), types.ErrInvalidInput)
+	// the inactivity period must respect the min period
+	requireT.ErrorIs(
+		ftKeeper.DesignateSuccessor(ctx, issuer, successor, period-time.Second), types.ErrInvalidInput,
+	)
+	// nothing to cancel or claim
+	requireT.ErrorIs(ftKeeper.CancelIssuerRecovery(ctx, issuer), types.ErrIssuerRecoveryNotFound)
+	_, err = ftKeeper.ClaimIssuerRecovery(ctx, successor, issuer)
+	requireT.ErrorIs(err, types.ErrIssuerRecoveryNotFound)
+
+	// the designation is cancelled
+	requireT.NoError(ftKeeper.DesignateSuccessor(ctx, issuer, successor, period))
+	requireT.NoError(ftKeeper.CancelIssuerRecovery(ctx, issuer))
+	_, err = ftKeeper.GetIssuerRecovery(ctx, issuer)
+	requireT.ErrorIs(err, types.ErrIssuerRecoveryNotFound)
+
+	// the successor is designated
+	requireT.NoError(ftKeeper.DesignateSuccessor(ctx, issuer, successor, period))
+	recovery, err := ftKeeper.GetIssuerRecovery(ctx, issuer)
+	requireT.NoError(err)
+	requireT.Equal(types.IssuerRecovery{
+		Issuer:           issuer.String(),
+		Successor:        successor.String(),
+		InactivityPeriod: period,
+		LastActivityTime: ctx.BlockTime(),
+	}, recovery)
+
+	// the activity of the issuer resets the inactivity period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(period / 2))
+	requireT.NoError(ftKeeper.RecordIssuerActivity(ctx, issuer))
+	// the activity of other accounts is ignored
+	requireT.NoError(ftKeeper.RecordIssuerActivity(ctx, randomAddr))
+	recovery, err = ftKeeper.GetIssuerRecovery(ctx, issuer)
+	requireT.NoError(err)
+	requireT.Equal(ctx.BlockTime(), recovery.LastActivityTime)
+
+	// the recovery can't be claimed before the inactivity period passes
+	ctx = ctx.WithBlockTime(recovery.ClaimableTime().Add(-time.Second))
+	_, err = ftKeeper.ClaimIssuerRecovery(ctx, successor, issuer)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// only the successor can claim the recovery
+	ctx = ctx.WithBlockTime(recovery.ClaimableTime())
+	_, err = ftKeeper.ClaimIssuerRecovery(ctx, randomAddr, issuer)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the successor claims the admin of the tokens still administered by the issuer
+	denoms, err := ftKeeper.ClaimIssuerRecovery(ctx, successor, issuer)
+	requireT.NoError(err)
+	requireT.Equal([]string{denom1}, denoms)
+
+	def, err := ftKeeper.GetDefinition(ctx, denom1)
+	requireT.NoError(err)
+	requireT.True(def.IsAdmin(successor))
+	def, err = ftKeeper.GetDefinition(ctx, denom2)
+	requireT.NoError(err)
+	requireT.True(def.IsAdmin(randomAddr))
+	def, err = ftKeeper.GetDefinition(ctx, denom3)
+	requireT.NoError(err)
+	requireT.Empty(def.Admin)
+
+	roles, err := ftKeeper.GetAccountRoles(ctx, denom1, randomAddr)
+	requireT.NoError(err)
+	requireT.Empty(roles)
+
+	// the designation is removed after the claim
+	_, err = ftKeeper.GetIssuerRecovery(ctx, issuer)
+	requireT.ErrorIs(err, types.ErrIssuerRecoveryNotFound)
+}
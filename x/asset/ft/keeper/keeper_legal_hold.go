@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// PlaceLegalHold increases the amount of the denom held on the account and resets the approvals of the release
+// collected so far. The legal hold is placed by the admin of the token with the freezing feature enabled, and only
+// while the legal hold authority is set, so each hold can be released.
+func (k Keeper) PlaceLegalHold(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "legal hold amount should be positive")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.LegalHoldAuthority == "" {
+		return sdkerrors.Wrap(types.ErrInvalidState, "legal hold authority is not set")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+	if def.HasAdminPrivileges(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin's balance can't be held")
+	}
+	if err := def.CheckFeatureAllowed(sender, types.Feature_freezing); err != nil {
+		return err
+	}
+
+	previousAmount, err := k.getLegalHoldAmount(ctx, addr, coin.Denom)
+	if err != nil {
+		return err
+	}
+	hold := types.LegalHold{
+		Account: addr.String(),
+		Amount:  sdk.NewCoin(coin.Denom, previousAmount.Add(coin.Amount)),
+	}
+	if err := k.SetLegalHold(ctx, hold); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventLegalHoldChanged{
+		Account:        addr.String(),
+		Denom:          coin.Denom,
+		PreviousAmount: previousAmount,
+		CurrentAmount:  hold.Amount.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventLegalHoldChanged event: %s", err)
+	}
+
+	return k.emitComplianceAction(ctx, sender, addr, coin.Denom, types.COMPLIANCE_ACTION_TYPE_LEGAL_HOLD, coin.Amount)
+}
+
+// ApproveLegalHoldRelease records the approval of the release of the legal hold by the admin of the token or by the
+// legal hold authority. The hold is released once both of them approve it.
+func (k Keeper) ApproveLegalHoldRelease(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	hold, err := k.GetLegalHold(ctx, addr, denom)
+	if err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	isAdmin := def.IsAdmin(sender)
+	isAuthority := params.LegalHoldAuthority == sender.String()
+	switch {
+	case isAdmin && isAuthority:
+		return sdkerrors.Wrap(
+			cosmoserrors.ErrUnauthorized, "the release must be approved by the admin and the authority separately",
+		)
+	case isAdmin:
+		hold.AdminReleaseApproved = true
+	case isAuthority:
+		hold.AuthorityReleaseApproved = true
+	default:
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized, "address %s is unauthorized to approve the release of the legal hold", sender,
+		)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventLegalHoldReleaseApproved{
+		Account:  addr.String(),
+		Denom:    denom,
+		Approver: sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventLegalHoldReleaseApproved event: %s", err)
+	}
+
+	if !hold.IsReleaseApproved() {
+		return k.SetLegalHold(ctx, hold)
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateLegalHoldKey(addr, denom)); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventLegalHoldChanged{
+		Account:        addr.String(),
+		Denom:          denom,
+		PreviousAmount: hold.Amount.Amount,
+		CurrentAmount:  sdkmath.ZeroInt(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventLegalHoldChanged event: %s", err)
+	}
+
+	return k.emitComplianceAction(
+		ctx, sender, addr, denom, types.COMPLIANCE_ACTION_TYPE_LEGAL_HOLD_RELEASE, hold.Amount.Amount,
+	)
+}
+
+// SetLegalHold stores the legal hold.
+func (k Keeper) SetLegalHold(ctx sdk.Context, hold types.LegalHold) error {
+	account, err := sdk.AccAddressFromBech32(hold.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid account address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateLegalHoldKey(account, hold.Amount.Denom),
+		k.cdc.MustMarshal(&hold),
+	)
+}
+
+// GetLegalHold returns the legal hold of the denom placed on the account.
+func (k Keeper) GetLegalHold(ctx sdk.Context, addr sdk.AccAddress, denom string) (types.LegalHold, error) {
+	hold, err := k.getLegalHoldOrNil(ctx, addr, denom)
+	if err != nil {
+		return types.LegalHold{}, err
+	}
+	if hold == nil {
+		return types.LegalHold{}, sdkerrors.Wrapf(
+			types.ErrLegalHoldNotFound, "legal hold of %s on %s not found", denom, addr,
+		)
+	}
+
+	return *hold, nil
+}
+
+// GetLegalHolds returns the legal holds placed on the account.
+func (k Keeper) GetLegalHolds(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.LegalHold, *query.PageResponse, error) {
+	return k.getLegalHolds(ctx, types.CreateLegalHoldsPrefix(addr), pagination)
+}
+
+// GetAllLegalHolds returns the legal holds placed on all the accounts.
+func (k Keeper) GetAllLegalHolds(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.LegalHold, *query.PageResponse, error) {
+	return k.getLegalHolds(ctx, types.LegalHoldKeyPrefix, pagination)
+}
+
+func (k Keeper) getLegalHolds(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	pagination *query.PageRequest,
+) ([]types.LegalHold, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	holds := make([]types.LegalHold, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var hold types.LegalHold
+		if err := k.cdc.Unmarshal(value, &hold); err != nil {
+			return err
+		}
+		holds = append(holds, hold)
+		return nil
+	})
+
+	return holds, pageRes, err
+}
+
+func (k Keeper) getLegalHoldOrNil(ctx sdk.Context, addr sdk.AccAddress, denom string) (*types.LegalHold, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateLegalHoldKey(addr, denom))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var hold types.LegalHold
+	if err := k.cdc.Unmarshal(bz, &hold); err != nil {
+		return nil, err
+	}
+
+	return &hold, nil
+}
+
+// getLegalHoldAmount returns the amount of the denom held on the account, zero if the account has no legal hold.
+func (k Keeper) getLegalHoldAmount(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdkmath.Int, error) {
+	hold, err := k.getLegalHoldOrNil(ctx, addr, denom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if hold == nil {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	return hold.Amount.Amount, nil
+}
+
+// validateCoinNotHeld checks that the coin may be taken from the account without touching the held amount.
+func (k Keeper) validateCoinNotHeld(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	heldAmt, err := k.getLegalHoldAmount(ctx, addr, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if !heldAmt.IsPositive() {
+		return nil
+	}
+
+	notHeldAmt := k.bankKeeper.GetBalance(ctx, addr, coin.Denom).Amount.Sub(heldAmt)
+	if notHeldAmt.LT(coin.Amount) {
+		return sdkerrors.Wrapf(types.ErrLegalHold, "%s is not available, %s%s is held on %s",
+			coin, heldAmt, coin.Denom, addr)
+	}
+
+	return nil
+}
+
+// validateNotUnderLegalHold checks that the account has no legal hold of the denom, so it may receive the denom.
+func (k Keeper) validateNotUnderLegalHold(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	heldAmt, err := k.getLegalHoldAmount(ctx, addr, denom)
+	if err != nil {
+		return err
+	}
+	if heldAmt.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrLegalHold, "%s can't receive %s while it is under the legal hold", addr, denom)
+	}
+
+	return nil
+}
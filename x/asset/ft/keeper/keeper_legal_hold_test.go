@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_LegalHold(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1_000),
+		Features: []types.Feature{
+			types.Feature_freezing,
+			types.Feature_clawback,
+		},
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// the legal hold can't be placed without the authority
+	requireT.ErrorIs(
+		ftKeeper.PlaceLegalHold(ctx, issuer, holder, sdk.NewInt64Coin(denom, 60)),
+		types.ErrInvalidState,
+	)
+
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.LegalHoldAuthority = authority.String()
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+
+	// only the admin places the legal hold, but not on their own account
+	requireT.ErrorIs(
+		ftKeeper.PlaceLegalHold(ctx, holder, holder, sdk.NewInt64Coin(denom, 60)),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.ErrorIs(
+		ftKeeper.PlaceLegalHold(ctx, issuer, issuer, sdk.NewInt64Coin(denom, 60)),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.NoError(ftKeeper.PlaceLegalHold(ctx, issuer, holder, sdk.NewInt64Coin(denom, 40)))
+	requireT.NoError(ftKeeper.PlaceLegalHold(ctx, issuer, holder, sdk.NewInt64Coin(denom, 20)))
+
+	hold, err := ftKeeper.GetLegalHold(ctx, holder, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.LegalHold{
+		Account: holder.String(),
+		Amount:  sdk.NewInt64Coin(denom, 60),
+	}, hold)
+
+	// the held amount can't be spent
+	spendable, err := ftKeeper.GetSpendableBalance(ctx, holder, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(denom, 40), spendable)
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 41))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	// the frozen amount is added to the held one
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, holder, sdk.NewInt64Coin(denom, 20)))
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 11))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, holder, sdk.NewInt64Coin(denom, 20)))
+
+	// the held account can't receive the token
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+		types.ErrLegalHold,
+	)
+
+	// the held amount can't be clawed back
+	requireT.ErrorIs(
+		ftKeeper.Clawback(ctx, issuer, holder, nil, sdk.NewInt64Coin(denom, 31)),
+		types.ErrLegalHold,
+	)
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, holder, nil, sdk.NewInt64Coin(denom, 30)))
+
+	// the release requires both the admin and the authority
+	requireT.ErrorIs(
+		ftKeeper.ApproveLegalHoldRelease(ctx, holder, holder, denom),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.NoError(ftKeeper.ApproveLegalHoldRelease(ctx, issuer, holder, denom))
+	hold, err = ftKeeper.GetLegalHold(ctx, holder, denom)
+	requireT.NoError(err)
+	requireT.True(hold.AdminReleaseApproved)
+	requireT.False(hold.IsReleaseApproved())
+
+	// the new hold resets the approvals
+	requireT.NoError(ftKeeper.PlaceLegalHold(ctx, issuer, holder, sdk.NewInt64Coin(denom, 1)))
+	requireT.NoError(ftKeeper.ApproveLegalHoldRelease(ctx, authority, holder, denom))
+	hold, err = ftKeeper.GetLegalHold(ctx, holder, denom)
+	requireT.NoError(err)
+	requireT.False(hold.AdminReleaseApproved)
+	requireT.True(hold.AuthorityReleaseApproved)
+
+	requireT.NoError(ftKeeper.ApproveLegalHoldRelease(ctx, issuer, holder, denom))
+	_, err = ftKeeper.GetLegalHold(ctx, holder, denom)
+	requireT.ErrorIs(err, types.ErrLegalHoldNotFound)
+	holds, _, err := ftKeeper.GetAllLegalHolds(ctx, nil)
+	requireT.NoError(err)
+	requireT.Empty(holds)
+
+	// the released account sends and receives the token again
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 70))))
+}
+
+func TestKeeper_LegalHold_AdminIsAuthority(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.LegalHoldAuthority = issuer.String()
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1_000),
+		Features: []types.Feature{
+			types.Feature_freezing,
+		},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.PlaceLegalHold(ctx, issuer, holder, sdk.NewInt64Coin(denom, 10)))
+
+	// the admin being the authority can't release the hold alone
+	requireT.ErrorIs(
+		ftKeeper.ApproveLegalHoldRelease(ctx, issuer, holder, denom),
+		cosmoserrors.ErrUnauthorized,
+	)
+	_, err = ftKeeper.GetLegalHold(ctx, holder, denom)
+	requireT.NoError(err)
+}
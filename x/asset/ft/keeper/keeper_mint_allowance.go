@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// GrantMintAllowance allows the grantee to mint the token up to the cap within each period until the expiration
+// time. Only the account allowed to mint the token may grant the allowance. The grant replaces the existing allowance
+// of the grantee.
+func (k Keeper) GrantMintAllowance(
+	ctx sdk.Context,
+	sender, grantee sdk.AccAddress,
+	mintCap sdk.Coin,
+	period time.Duration,
+	expirationTime time.Time,
+) error {
+	if err := types.ValidateMintAllowanceTerms(mintCap, period); err != nil {
+		return err
+	}
+	if !ctx.BlockTime().Before(expirationTime) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "expiration time must be in the future")
+	}
+
+	def, err := k.GetDefinition(ctx, mintCap.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", mintCap.Denom)
+	}
+	if err := def.CheckFeatureAllowed(sender, types.Feature_minting); err != nil {
+		return err
+	}
+
+	allowance := types.MintAllowance{
+		Granter:         sender.String(),
+		Grantee:         grantee.String(),
+		Cap:             mintCap,
+		Period:          period,
+		ExpirationTime:  expirationTime,
+		PeriodResetTime: ctx.BlockTime().Add(period),
+		PeriodMinted:    sdkmath.ZeroInt(),
+	}
+	if err := k.SetMintAllowance(ctx, allowance); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMintAllowanceGranted{
+		Granter:        allowance.Granter,
+		Grantee:        allowance.Grantee,
+		Cap:            allowance.Cap,
+		Period:         allowance.Period,
+		ExpirationTime: allowance.ExpirationTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventMintAllowanceGranted event: %s", err)
+	}
+
+	return nil
+}
+
+// RevokeMintAllowance removes the mint allowance of the grantee.
+func (k Keeper) RevokeMintAllowance(ctx sdk.Context, sender, grantee sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if !def.HasAdminPrivileges(sender) {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "only admin can revoke the mint allowance")
+	}
+
+	if _, err := k.GetMintAllowance(ctx, denom, grantee); err != nil {
+		return err
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateMintAllowanceKey(denom, grantee)); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMintAllowanceRevoked{
+		Granter: sender.String(),
+		Grantee: grantee.String(),
+		Denom:   denom,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventMintAllowanceRevoked event: %s", err)
+	}
+
+	return nil
+}
+
+// SetMintAllowance stores the mint allowance.
+func (k Keeper) SetMintAllowance(ctx sdk.Context, allowance types.MintAllowance) error {
+	grantee, err := sdk.AccAddressFromBech32(allowance.Grantee)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid grantee address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateMintAllowanceKey(allowance.Cap.Denom, grantee),
+		k.cdc.MustMarshal(&allowance),
+	)
+}
+
+// GetMintAllowance returns the mint allowance of the grantee with the minted amount reset if the period has ended.
+func (k Keeper) GetMintAllowance(ctx sdk.Context, denom string, grantee sdk.AccAddress) (types.MintAllowance, error) {
+	allowance, err := k.getMintAllowanceOrNil(ctx, denom, grantee)
+	if err != nil {
+		return types.MintAllowance{}, err
+	}
+	if allowance == nil {
+		return types.MintAllowance{}, sdkerrors.Wrapf(
+			types.ErrMintAllowanceNotFound, "denom: %s, grantee: %s", denom, grantee.String(),
+		)
+	}
+
+	return allowance.WithPeriodReset(ctx.BlockTime()), nil
+}
+
+// GetMintAllowances returns all the mint allowances.
+func (k Keeper) GetMintAllowances(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.MintAllowance, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.MintAllowanceKeyPrefix)
+	allowances := make([]types.MintAllowance, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var allowance types.MintAllowance
+		if err := k.cdc.Unmarshal(value, &allowance); err != nil {
+			return err
+		}
+		allowances = append(allowances, allowance)
+		return nil
+	})
+
+	return allowances, pageRes, err
+}
+
+// useMintAllowance consumes the amount from the allowance of the sender, returning the original authorization
+// error if the sender has no allowance.
+func (k Keeper) useMintAllowance(
+	ctx sdk.Context,
+	def types.Definition,
+	sender sdk.AccAddress,
+	amount sdkmath.Int,
+) error {
+	unauthorizedErr := def.CheckFeatureAllowed(sender, types.Feature_minting)
+	if !def.IsFeatureEnabled(types.Feature_minting) {
+		return unauthorizedErr
+	}
+
+	allowance, err := k.getMintAllowanceOrNil(ctx, def.Denom, sender)
+	if err != nil {
+		return err
+	}
+	if allowance == nil {
+		return unauthorizedErr
+	}
+
+	granter, err := sdk.AccAddressFromBech32(allowance.Granter)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid granter address: %s", err)
+	}
+	// the allowance is valid only while the granter keeps the privileges it was granted with
+	if !def.HasAdminPrivileges(granter) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized, "granter %s of the mint allowance is not the admin anymore", allowance.Granter,
+		)
+	}
+	if allowance.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(
+			types.ErrMintAllowanceExceeded, "mint allowance expired at %s", allowance.ExpirationTime,
+		)
+	}
+
+	updated := allowance.WithPeriodReset(ctx.BlockTime())
+	updated.PeriodMinted = updated.PeriodMinted.Add(amount)
+	if updated.PeriodMinted.GT(updated.Cap.Amount) {
+		return sdkerrors.Wrapf(
+			types.ErrMintAllowanceExceeded,
+			"requested amount %s is greater than remaining %s",
+			amount, allowance.WithPeriodReset(ctx.BlockTime()).Remaining(),
+		)
+	}
+
+	return k.SetMintAllowance(ctx, updated)
+}
+
+func (k Keeper) getMintAllowanceOrNil(
+	ctx sdk.Context,
+	denom string,
+	grantee sdk.AccAddress,
+) (*types.MintAllowance, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateMintAllowanceKey(denom, grantee))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var allowance types.MintAllowance
+	if err := k.cdc.Unmarshal(bz, &allowance); err != nil {
+		return nil, err
+	}
+
+	return &allowance, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_MintAllowance(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newAdmin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(100),
+		Features:      []types.Feature{types.Feature_minting},
+	})
+	requireT.NoError(err)
+
+	mintCap := sdk.NewInt64Coin(denom, 100)
+	expirationTime := ctx.BlockTime().Add(10 * time.Hour)
+
+	// the grantee can't mint without the allowance
+	requireT.ErrorIs(
+		ftKeeper.Mint(ctx, grantee, recipient, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized,
+	)
+	_, err = ftKeeper.GetMintAllowance(ctx, denom, grantee)
+	requireT.ErrorIs(err, types.ErrMintAllowanceNotFound)
+
+	// only the admin can grant the allowance
+	requireT.ErrorIs(
+		ftKeeper.GrantMintAllowance(ctx, grantee, recipient, mintCap, time.Hour, expirationTime),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.ErrorIs(
+		ftKeeper.GrantMintAllowance(ctx, issuer, grantee, mintCap, time.Hour, ctx.BlockTime()),
+		types.ErrInvalidInput,
+	)
+	requireT.NoError(ftKeeper.GrantMintAllowance(ctx, issuer, grantee, mintCap, time.Hour, expirationTime))
+
+	// mint within the cap
+	requireT.NoError(ftKeeper.Mint(ctx, grantee, recipient, sdk.NewInt64Coin(denom, 60)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 60), bankKeeper.GetBalance(ctx, recipient, denom))
+	allowance, err := ftKeeper.GetMintAllowance(ctx, denom, grantee)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(denom, 40), allowance.Remaining())
+
+	// the cap can't be exceeded within the period
+	requireT.ErrorIs(
+		ftKeeper.Mint(ctx, grantee, recipient, sdk.NewInt64Coin(denom, 41)), types.ErrMintAllowanceExceeded,
+	)
+	requireT.NoError(ftKeeper.Mint(ctx, grantee, recipient, sdk.NewInt64Coin(denom, 40)))
+
+	// the minted amount is reset once the period ends, the periods stay aligned to the grant time
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(150 * time.Minute))
+	allowance, err = ftKeeper.GetMintAllowance(ctx, denom, grantee)
+	requireT.NoError(err)
+	requireT.Equal(mintCap, allowance.Remaining())
+	requireT.Equal(ctx.BlockTime().Add(30*time.Minute), allowance.PeriodResetTime)
+	requireT.NoError(ftKeeper.Mint(ctx, grantee, recipient, sdk.NewInt64Coin(denom, 100)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 200), bankKeeper.GetBalance(ctx, recipient, denom))
+
+	// the allowance becomes invalid once the granter is not the admin anymore
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, newAdmin, denom))
+	requireT.ErrorIs(
+		ftKeeper.Mint(ctx, grantee, recipient, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized,
+	)
+
+	// the new admin replaces the allowance and the grantee can't mint after the expiration
+	requireT.NoError(ftKeeper.GrantMintAllowance(ctx, newAdmin, grantee, mintCap, 0, ctx.BlockTime().Add(time.Hour)))
+	requireT.NoError(ftKeeper.Mint(ctx, grantee, recipient, sdk.NewInt64Coin(denom, 10)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.ErrorIs(
+		ftKeeper.Mint(ctx, grantee, recipient, sdk.NewInt64Coin(denom, 10)), types.ErrMintAllowanceExceeded,
+	)
+
+	// only the admin can revoke the allowance
+	requireT.ErrorIs(ftKeeper.RevokeMintAllowance(ctx, issuer, grantee, denom), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.RevokeMintAllowance(ctx, newAdmin, grantee, denom))
+	_, err = ftKeeper.GetMintAllowance(ctx, denom, grantee)
+	requireT.ErrorIs(err, types.ErrMintAllowanceNotFound)
+	requireT.ErrorIs(ftKeeper.RevokeMintAllowance(ctx, newAdmin, grantee, denom), types.ErrMintAllowanceNotFound)
+}
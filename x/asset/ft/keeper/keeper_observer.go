@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	"github.com/tokenize-x/tx-chain/v7/x/wasm"
+)
+
+// ObserverTransferMethod the function name of the observer smart contract, which will be invoked after the transfer.
+const ObserverTransferMethod = "observe_transfer"
+
+// sudoObserverTransferMsg contains the fields passed to the observer method call.
+//
+//nolint:tagliatelle // these will be exposed to rust and must be snake case.
+type sudoObserverTransferMsg struct {
+	Sender    string      `json:"sender"`
+	Recipient string      `json:"recipient"`
+	Denom     string      `json:"denom"`
+	Amount    sdkmath.Int `json:"amount"`
+}
+
+type observerNotifyingKey struct{}
+
+// SetObserverContract sets the smart contract notified about the transfers of the token. Only the admin of the token
+// can set it. The empty contract removes the observer.
+func (k Keeper) SetObserverContract(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	contract sdk.AccAddress,
+) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if !def.HasAdminPrivileges(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can set the observer contract")
+	}
+
+	previousContract := def.ObserverCWAddress
+	def.ObserverCWAddress = ""
+	if !contract.Empty() {
+		if !wasm.IsSmartContract(ctx, contract, k.wasmKeeper) {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "observer %s is not a smart contract", contract)
+		}
+		def.ObserverCWAddress = contract.String()
+	}
+
+	subunit, issuer, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventObserverContractChanged{
+		Denom:            denom,
+		PreviousContract: previousContract,
+		CurrentContract:  def.ObserverCWAddress,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventObserverContractChanged event: %s", err)
+	}
+
+	return nil
+}
+
+// notifyObserver calls the observer contract of the token after the transfer. The observer is used for the
+// accounting only, so it can't reject the transfer: its failure is reported by the event and its state changes are
+// discarded. The transfers executed by the observer itself are not reported to it.
+func (k Keeper) notifyObserver(
+	ctx sdk.Context,
+	def types.Definition,
+	sender, recipient sdk.AccAddress,
+	coin sdk.Coin,
+) {
+	if def.ObserverCWAddress == "" {
+		return
+	}
+	if notifying, ok := ctx.Value(observerNotifyingKey{}).(bool); ok && notifying {
+		return
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		k.logger.Error("failed to get params to notify the observer", "denom", def.Denom, "error", err)
+		return
+	}
+	if params.ObserverGasLimit == 0 {
+		return
+	}
+
+	err = k.invokeObserverTransferMethod(ctx, def, sender, recipient, coin, params.ObserverGasLimit)
+	if err == nil {
+		return
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventObserverNotificationFailed{
+		Denom:     def.Denom,
+		Contract:  def.ObserverCWAddress,
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+		Reason:    err.Error(),
+	}); err != nil {
+		k.logger.Error("failed to emit EventObserverNotificationFailed event", "error", err)
+	}
+}
+
+// invokeObserverTransferMethod calls the observer contract on the cached context with the gas meter limited to
+// the observer gas limit. The gas used by the contract is charged to the transaction.
+func (k Keeper) invokeObserverTransferMethod(
+	ctx sdk.Context,
+	def types.Definition,
+	sender, recipient sdk.AccAddress,
+	coin sdk.Coin,
+	gasLimit uint64,
+) (err error) {
+	observerContract, err := sdk.AccAddressFromBech32(def.ObserverCWAddress)
+	if err != nil {
+		return err
+	}
+
+	contractMsgBytes, err := json.Marshal(map[string]interface{}{
+		ObserverTransferMethod: sudoObserverTransferMsg{
+			Sender:    sender.String(),
+			Recipient: recipient.String(),
+			Denom:     coin.Denom,
+			Amount:    coin.Amount,
+		},
+	})
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to marshal contract msg")
+	}
+
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithValue(observerNotifyingKey{}, true)
+	defer func() {
+		if r := recover(); r != nil {
+			// the out of gas panic comes from the observer gas meter, so it fails the observer only
+			err = errors.Errorf("panic: %v", r)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "observer contract")
+	}()
+
+	if _, err := k.wasmPermissionedKeeper.Sudo(cacheCtx, observerContract, contractMsgBytes); err != nil {
+		return errors.Errorf("wasm error: %s", err)
+	}
+	writeCache()
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_ObserverContract(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1_000),
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// only the admin sets the observer and it must be the smart contract
+	requireT.ErrorIs(ftKeeper.SetObserverContract(ctx, holder, denom, nil), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(ftKeeper.SetObserverContract(ctx, issuer, denom, recipient), types.ErrInvalidInput)
+
+	// the observer which fails to process the transfer doesn't reject it
+	def, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	def.ObserverCWAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	subunit, _, err := types.DeconstructDenom(denom)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.SetDefinition(ctx, issuer, subunit, def))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.Equal(sdkmath.NewInt(10), bankKeeper.GetBalance(ctx, recipient, denom).Amount)
+
+	failedEvents, err := event.FindTypedEvents[*types.EventObserverNotificationFailed](
+		ctx.EventManager().ABCIEvents(),
+	)
+	requireT.NoError(err)
+	requireT.Len(failedEvents, 1)
+	requireT.Equal(denom, failedEvents[0].Denom)
+	requireT.Equal(def.ObserverCWAddress, failedEvents[0].Contract)
+	requireT.Equal(holder.String(), failedEvents[0].Sender)
+	requireT.Equal(recipient.String(), failedEvents[0].Recipient)
+	requireT.NotEmpty(failedEvents[0].Reason)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(def.ObserverCWAddress, token.ObserverCWAddress)
+
+	// the zero gas limit disables the notifications
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.ObserverGasLimit = 0
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	_, err = event.FindTypedEvents[*types.EventObserverNotificationFailed](ctx.EventManager().ABCIEvents())
+	requireT.Error(err)
+
+	// the empty contract removes the observer
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.SetObserverContract(ctx, issuer, denom, nil))
+	changedEvents, err := event.FindTypedEvents[*types.EventObserverContractChanged](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventObserverContractChanged{{
+		Denom:            denom,
+		PreviousContract: def.ObserverCWAddress,
+	}}, changedEvents)
+
+	def, err = ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(def.ObserverCWAddress)
+}
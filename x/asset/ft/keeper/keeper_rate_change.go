@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// ScheduleRateChange schedules the change of the burn and send commission rates of the token, applied at the
+// effective time. The effective time must be at least the min rate change notice ahead, so the holders and the
+// services integrating the token might announce the change before it. The new change replaces the pending one.
+func (k Keeper) ScheduleRateChange(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	burnRate, sendCommissionRate sdkmath.LegacyDec,
+	effectiveTime time.Time,
+) error {
+	if err := types.ValidateRateChange(burnRate, sendCommissionRate); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can schedule the rate change of the token")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if minEffectiveTime := ctx.BlockTime().Add(params.MinRateChangeNotice); effectiveTime.Before(minEffectiveTime) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"effective time must not be earlier than %s", minEffectiveTime.UTC().Format(time.RFC3339),
+		)
+	}
+
+	if err := k.cancelPendingRateChange(ctx, denom); err != nil {
+		return err
+	}
+
+	change := types.RateChange{
+		Denom:              denom,
+		BurnRate:           burnRate,
+		SendCommissionRate: sendCommissionRate,
+		EffectiveTime:      effectiveTime,
+	}
+	if err := k.SetPendingRateChange(ctx, change); err != nil {
+		return err
+	}
+	if err := k.delayKeeper.DelayExecution(
+		ctx,
+		rateChangeID(denom),
+		&types.DelayedRateChange{Denom: denom},
+		effectiveTime.Sub(ctx.BlockTime()),
+	); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRateChangeScheduled{
+		Denom:              denom,
+		BurnRate:           burnRate,
+		SendCommissionRate: sendCommissionRate,
+		EffectiveTime:      effectiveTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRateChangeScheduled event: %s", err)
+	}
+
+	return nil
+}
+
+// ApplyPendingRateChange applies the rate change scheduled by the admin once the effective time comes.
+func (k Keeper) ApplyPendingRateChange(ctx sdk.Context, data *types.DelayedRateChange) error {
+	change, err := k.getPendingRateChangeOrNil(ctx, data.Denom)
+	if err != nil {
+		return err
+	}
+	// the change has been replaced or cancelled in the meantime
+	if change == nil || ctx.BlockTime().Before(change.EffectiveTime) {
+		return nil
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreatePendingRateChangeKey(data.Denom)); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, data.Denom)
+	if err != nil {
+		return err
+	}
+	subunit, issuer, err := types.DeconstructDenom(def.Denom)
+	if err != nil {
+		return err
+	}
+
+	previousBurnRate := def.BurnRate
+	previousSendCommissionRate := def.SendCommissionRate
+	def.BurnRate = change.BurnRate
+	def.SendCommissionRate = change.SendCommissionRate
+	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRatesChanged{
+		Denom:                      def.Denom,
+		PreviousBurnRate:           previousBurnRate,
+		PreviousSendCommissionRate: previousSendCommissionRate,
+		BurnRate:                   def.BurnRate,
+		SendCommissionRate:         def.SendCommissionRate,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRatesChanged event: %s", err)
+	}
+
+	return nil
+}
+
+// SetPendingRateChange stores the pending rate change.
+func (k Keeper) SetPendingRateChange(ctx sdk.Context, change types.RateChange) error {
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreatePendingRateChangeKey(change.Denom),
+		k.cdc.MustMarshal(&change),
+	)
+}
+
+// GetPendingRateChange returns the pending rate change of the denom.
+func (k Keeper) GetPendingRateChange(ctx sdk.Context, denom string) (types.RateChange, error) {
+	change, err := k.getPendingRateChangeOrNil(ctx, denom)
+	if err != nil {
+		return types.RateChange{}, err
+	}
+	if change == nil {
+		return types.RateChange{}, sdkerrors.Wrapf(types.ErrRateChangeNotFound, "denom: %s", denom)
+	}
+
+	return *change, nil
+}
+
+// GetPendingRateChanges returns all the pending rate changes.
+func (k Keeper) GetPendingRateChanges(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.RateChange, *query.PageResponse, error) {
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)),
+		types.PendingRateChangeKeyPrefix,
+	)
+	changes := make([]types.RateChange, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var change types.RateChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+
+	return changes, pageRes, err
+}
+
+func (k Keeper) cancelPendingRateChange(ctx sdk.Context, denom string) error {
+	change, err := k.getPendingRateChangeOrNil(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if change == nil {
+		return nil
+	}
+
+	if err := k.delayKeeper.RemoveExecuteAfter(ctx, rateChangeID(denom), change.EffectiveTime); err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(types.CreatePendingRateChangeKey(denom))
+}
+
+func (k Keeper) getPendingRateChangeOrNil(ctx sdk.Context, denom string) (*types.RateChange, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreatePendingRateChangeKey(denom))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var change types.RateChange
+	if err := k.cdc.Unmarshal(bz, &change); err != nil {
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+func rateChangeID(denom string) string {
+	return fmt.Sprintf("%s-rate-change-%s", types.ModuleName, denom)
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_ScheduleRateChange(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	delayKeeper := testApp.DelayKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	notice := params.MinRateChangeNotice
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "ABC",
+		Subunit:            "uabc",
+		Precision:          6,
+		InitialAmount:      sdkmath.NewInt(1000),
+		BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.01"),
+		SendCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.02"),
+	})
+	requireT.NoError(err)
+
+	assertRates := func(ctx sdk.Context, burnRate, sendCommissionRate string) {
+		def, err := ftKeeper.GetDefinition(ctx, denom)
+		requireT.NoError(err)
+		requireT.Equal(sdkmath.LegacyMustNewDecFromStr(burnRate).String(), def.BurnRate.String())
+		requireT.Equal(sdkmath.LegacyMustNewDecFromStr(sendCommissionRate).String(), def.SendCommissionRate.String())
+	}
+
+	burnRate := sdkmath.LegacyMustNewDecFromStr("0.03")
+	sendCommissionRate := sdkmath.LegacyMustNewDecFromStr("0.04")
+	effectiveTime := ctx.BlockTime().Add(notice)
+
+	// only the admin can schedule the change
+	requireT.ErrorIs(
+		ftKeeper.ScheduleRateChange(ctx, randomAddr, denom, burnRate, sendCommissionRate, effectiveTime),
+		cosmoserrors.ErrUnauthorized,
+	)
+	// the effective time must respect the notice period
+	requireT.ErrorIs(
+		ftKeeper.ScheduleRateChange(
+			ctx, issuer, denom, burnRate, sendCommissionRate, effectiveTime.Add(-time.Second),
+		),
+		types.ErrInvalidInput,
+	)
+
+	// the change is scheduled and returned with the token
+	requireT.NoError(ftKeeper.ScheduleRateChange(ctx, issuer, denom, burnRate, sendCommissionRate, effectiveTime))
+	change, err := ftKeeper.GetPendingRateChange(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.RateChange{
+		Denom:              denom,
+		BurnRate:           burnRate,
+		SendCommissionRate: sendCommissionRate,
+		EffectiveTime:      effectiveTime,
+	}, change)
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(&change, token.PendingRateChange)
+	assertRates(ctx, "0.01", "0.02")
+
+	// the pending change is replaced by the new one
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(notice / 2))
+	effectiveTime = ctx.BlockTime().Add(notice)
+	requireT.NoError(ftKeeper.ScheduleRateChange(
+		ctx, issuer, denom, sdkmath.LegacyZeroDec(), sendCommissionRate, effectiveTime,
+	))
+
+	// the replaced change is not applied
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(notice / 2))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	assertRates(ctx, "0.01", "0.02")
+
+	// the change is applied at the effective time
+	ctx = ctx.WithBlockTime(effectiveTime)
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	assertRates(ctx, "0", "0.04")
+	_, err = ftKeeper.GetPendingRateChange(ctx, denom)
+	requireT.ErrorIs(err, types.ErrRateChangeNotFound)
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Nil(token.PendingRateChange)
+
+	// clearing the admin cancels the pending change
+	requireT.NoError(ftKeeper.ScheduleRateChange(
+		ctx, issuer, denom, burnRate, sendCommissionRate, ctx.BlockTime().Add(notice),
+	))
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom))
+	_, err = ftKeeper.GetPendingRateChange(ctx, denom)
+	requireT.ErrorIs(err, types.ErrRateChangeNotFound)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(notice))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	assertRates(ctx, "0", "0")
+}
@@ -0,0 +1,384 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// StartRedenomination issues the new token with the same settings as the redenominated one and locks the old token,
+// so it can be only swapped for the new token at the ratio until the swap deadline. The new token is issued by the
+// issuer of the old token without the issue fee and the initial amount, its supply is minted by the swaps.
+//
+//nolint:funlen // breaking down this function will make it less readable.
+func (k Keeper) StartRedenomination(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom, newSubunit, newSymbol string,
+	ratio uint64,
+	swapDeadline time.Time,
+) (string, error) {
+	if err := types.ValidateRedenominationRatio(ratio); err != nil {
+		return "", err
+	}
+	if !swapDeadline.After(ctx.BlockTime()) {
+		return "", sdkerrors.Wrap(types.ErrInvalidInput, "swap deadline must be in the future")
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return "", sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if !def.IsAdmin(sender) && sender.String() != k.authority {
+		return "", sdkerrors.Wrap(
+			cosmoserrors.ErrUnauthorized, "only admin or governance can start the redenomination of the token",
+		)
+	}
+	if def.IsFeatureEnabled(types.Feature_extension) {
+		return "", sdkerrors.Wrap(types.ErrInvalidInput, "tokens with the extension can't be redenominated")
+	}
+
+	redenomination, err := k.getRedenominationOrNil(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if redenomination != nil {
+		return "", sdkerrors.Wrapf(types.ErrRedenominated, "denom %s has been already redenominated", denom)
+	}
+	escrow, err := k.getIssuanceEscrowOrNil(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if escrow != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidInput, "token with the pending issuance escrow can't be redenominated")
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supply.Mul(sdkmath.NewIntFromUint64(ratio)).GT(types.MaxMintableAmount) {
+		return "", sdkerrors.Wrap(
+			types.ErrInvalidInput, "supply of the redenominated token is greater than maximum allowed",
+		)
+	}
+
+	if err := types.ValidateSubunit(newSubunit); err != nil {
+		return "", sdkerrors.Wrapf(err, "provided subunit: %s", newSubunit)
+	}
+	if err := types.ValidateSymbol(newSymbol); err != nil {
+		return "", sdkerrors.Wrapf(err, "provided symbol: %s", newSymbol)
+	}
+
+	_, issuer, err := types.DeconstructDenom(def.Denom)
+	if err != nil {
+		return "", err
+	}
+	newDenom := types.BuildDenom(newSubunit, issuer)
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, newDenom); found {
+		return "", sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"subunit %s already registered for the address %s",
+			newSubunit,
+			issuer.String(),
+		)
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, def.Denom)
+	if !found {
+		return "", sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", def.Denom)
+	}
+	precision, found := precisionFromMetadata(metadata)
+	if !found {
+		return "", sdkerrors.Wrapf(types.ErrInvalidInput, "precision of %s denom not found", def.Denom)
+	}
+
+	if err := k.consumeSymbolReservation(ctx, types.IssueSettings{
+		Issuer:  issuer,
+		Symbol:  newSymbol,
+		Subunit: newSubunit,
+	}); err != nil {
+		return "", err
+	}
+	if err := k.SetSymbol(ctx, newSymbol, issuer); err != nil {
+		return "", sdkerrors.Wrapf(err, "provided symbol: %s", newSymbol)
+	}
+
+	newDef := types.Definition{
+		Denom:              newDenom,
+		Issuer:             def.Issuer,
+		Features:           def.Features,
+		BurnRate:           def.BurnRate,
+		SendCommissionRate: def.SendCommissionRate,
+		Version:            types.CurrentTokenVersion,
+		URI:                def.URI,
+		URIHash:            def.URIHash,
+		Admin:              def.Admin,
+	}
+	if err := k.SetDenomMetadata(
+		ctx, newDenom, newSymbol, metadata.Description, def.URI, def.URIHash, precision,
+	); err != nil {
+		return "", err
+	}
+	if err := k.SetDefinition(ctx, issuer, newSubunit, newDef); err != nil {
+		return "", err
+	}
+
+	dexSettings, err := k.getDEXSettingsOrNil(ctx, def.Denom)
+	if err != nil {
+		return "", err
+	}
+	if dexSettings != nil {
+		if err := k.SetDEXSettings(ctx, newDenom, *dexSettings); err != nil {
+			return "", err
+		}
+	}
+
+	if err := k.SetRedenomination(ctx, types.Redenomination{
+		Denom:         def.Denom,
+		NewDenom:      newDenom,
+		Ratio:         ratio,
+		SwapDeadline:  swapDeadline,
+		SwappedAmount: sdkmath.ZeroInt(),
+	}); err != nil {
+		return "", err
+	}
+	if err := k.delayKeeper.DelayExecution(
+		ctx,
+		redenominationID(def.Denom),
+		&types.DelayedRedenominationFinalization{Denom: def.Denom},
+		swapDeadline.Sub(ctx.BlockTime()),
+	); err != nil {
+		return "", err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIssued{
+		Denom:              newDenom,
+		Issuer:             newDef.Issuer,
+		Symbol:             newSymbol,
+		Subunit:            newSubunit,
+		Precision:          precision,
+		Description:        metadata.Description,
+		InitialAmount:      sdkmath.ZeroInt(),
+		Features:           newDef.Features,
+		BurnRate:           newDef.BurnRate,
+		SendCommissionRate: newDef.SendCommissionRate,
+		URI:                newDef.URI,
+		URIHash:            newDef.URIHash,
+		Admin:              newDef.Admin,
+		DEXSettings:        dexSettings,
+	}); err != nil {
+		return "", sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssued event: %s", err)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedenominationStarted{
+		Denom:        def.Denom,
+		NewDenom:     newDenom,
+		Ratio:        ratio,
+		SwapDeadline: swapDeadline,
+		Sender:       sender.String(),
+	}); err != nil {
+		return "", sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRedenominationStarted event: %s", err)
+	}
+
+	return newDenom, nil
+}
+
+// Redenominate swaps the old token of the sender for the new one at the ratio of the redenomination. The old token is
+// escrowed on the module account and burnt when the swap deadline passes. If the new token has the whitelisting
+// enabled, the whitelisted limit of the sender is increased by the received amount, so the swap keeps the holdings
+// allowed by the admin.
+func (k Keeper) Redenominate(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
+	redenomination, err := k.GetRedenomination(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if !ctx.BlockTime().Before(redenomination.SwapDeadline) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "swap deadline %s has passed", redenomination.SwapDeadline)
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if err := k.validateCoinSpendable(ctx, sender, def, coin.Amount); err != nil {
+		return sdkerrors.Wrapf(err, "coins are not spendable")
+	}
+	newDef, err := k.GetDefinition(ctx, redenomination.NewDenom)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		withFeaturesBypassed(ctx), sender, types.ModuleName, sdk.NewCoins(coin),
+	); err != nil {
+		return sdkerrors.Wrapf(err, "can't escrow redenominated coins %s", coin.String())
+	}
+
+	received := sdk.NewCoin(newDef.Denom, coin.Amount.Mul(sdkmath.NewIntFromUint64(redenomination.Ratio)))
+	if newDef.IsFeatureEnabled(types.Feature_whitelisting) && !newDef.HasAdminPrivileges(sender) {
+		if err := k.increaseWhitelistedBalance(ctx, sender, received); err != nil {
+			return err
+		}
+	}
+	if err := k.mintIfReceivable(ctx, newDef, received.Amount, sender); err != nil {
+		return err
+	}
+
+	redenomination.SwappedAmount = redenomination.SwappedAmount.Add(coin.Amount)
+	if err := k.SetRedenomination(ctx, redenomination); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedenominated{
+		Account:  sender.String(),
+		Swapped:  coin,
+		Received: received,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRedenominated event: %s", err)
+	}
+
+	return nil
+}
+
+// FinalizeRedenomination burns the old token swapped by the holders once the swap deadline passes. The old token not
+// swapped by the holders stays locked on their accounts.
+func (k Keeper) FinalizeRedenomination(ctx sdk.Context, data *types.DelayedRedenominationFinalization) error {
+	redenomination, err := k.getRedenominationOrNil(ctx, data.Denom)
+	if err != nil {
+		return err
+	}
+	if redenomination == nil || redenomination.Finalized || ctx.BlockTime().Before(redenomination.SwapDeadline) {
+		return nil
+	}
+
+	if redenomination.SwappedAmount.IsPositive() {
+		swapped := sdk.NewCoins(sdk.NewCoin(redenomination.Denom, redenomination.SwappedAmount))
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, swapped); err != nil {
+			return sdkerrors.Wrapf(err, "can't burn redenominated coins %s", swapped.String())
+		}
+		if err := k.recordBurn(ctx, redenomination.Denom, redenomination.SwappedAmount, burnCategoryHolders); err != nil {
+			return err
+		}
+	}
+
+	redenomination.Finalized = true
+	if err := k.SetRedenomination(ctx, *redenomination); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedenominationFinalized{
+		Denom:           redenomination.Denom,
+		NewDenom:        redenomination.NewDenom,
+		SwappedAmount:   redenomination.SwappedAmount,
+		UnswappedAmount: k.bankKeeper.GetSupply(ctx, redenomination.Denom).Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRedenominationFinalized event: %s", err)
+	}
+
+	return nil
+}
+
+// GetUnswappedAmount returns the amount of the old token held by the accounts and not swapped for the new one.
+func (k Keeper) GetUnswappedAmount(ctx sdk.Context, redenomination types.Redenomination) sdkmath.Int {
+	unswapped := k.bankKeeper.GetSupply(ctx, redenomination.Denom).Amount
+	if !redenomination.Finalized {
+		// the swapped amount is escrowed on the module account until the finalization
+		unswapped = unswapped.Sub(redenomination.SwappedAmount)
+	}
+	return unswapped
+}
+
+// SetRedenomination stores the redenomination.
+func (k Keeper) SetRedenomination(ctx sdk.Context, redenomination types.Redenomination) error {
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateRedenominationKey(redenomination.Denom),
+		k.cdc.MustMarshal(&redenomination),
+	)
+}
+
+// GetRedenomination returns the redenomination of the denom.
+func (k Keeper) GetRedenomination(ctx sdk.Context, denom string) (types.Redenomination, error) {
+	redenomination, err := k.getRedenominationOrNil(ctx, denom)
+	if err != nil {
+		return types.Redenomination{}, err
+	}
+	if redenomination == nil {
+		return types.Redenomination{}, sdkerrors.Wrapf(types.ErrRedenominationNotFound, "denom: %s", denom)
+	}
+
+	return *redenomination, nil
+}
+
+// GetRedenominations returns all the redenominations.
+func (k Keeper) GetRedenominations(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.Redenomination, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RedenominationKeyPrefix)
+	redenominations := make([]types.Redenomination, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var redenomination types.Redenomination
+		if err := k.cdc.Unmarshal(value, &redenomination); err != nil {
+			return err
+		}
+		redenominations = append(redenominations, redenomination)
+		return nil
+	})
+
+	return redenominations, pageRes, err
+}
+
+// validateNotRedenominated returns an error if the token has been replaced by the redenomination.
+func (k Keeper) validateNotRedenominated(ctx sdk.Context, denom string) error {
+	redenomination, err := k.getRedenominationOrNil(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if redenomination != nil {
+		return sdkerrors.Wrapf(
+			types.ErrRedenominated, "denom %s is replaced by %s", denom, redenomination.NewDenom,
+		)
+	}
+
+	return nil
+}
+
+func (k Keeper) increaseWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	previous, current := k.whitelistedAccountBalanceStore(ctx, addr).AddBalance(coin)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWhitelistedAmountChanged{
+		Account:        addr.String(),
+		Denom:          coin.Denom,
+		PreviousAmount: previous.Amount,
+		CurrentAmount:  current.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventWhitelistedAmountChanged event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) getRedenominationOrNil(ctx sdk.Context, denom string) (*types.Redenomination, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateRedenominationKey(denom))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var redenomination types.Redenomination
+	if err := k.cdc.Unmarshal(bz, &redenomination); err != nil {
+		return nil, err
+	}
+
+	return &redenomination, nil
+}
+
+func redenominationID(denom string) string {
+	return fmt.Sprintf("%s-redenomination-%s", types.ModuleName, denom)
+}
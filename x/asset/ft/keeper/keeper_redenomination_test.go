@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_Redenomination(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	delayKeeper := testApp.DelayKeeper
+	bankKeeper := testApp.BankKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC token",
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_whitelisting,
+		},
+		BurnRate: sdkmath.LegacyMustNewDecFromStr("0.01"),
+		URI:      "https://my-token-meta.invalid/1",
+	})
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, holder, sdk.NewInt64Coin(denom, 300)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+
+	swapDeadline := ctx.BlockTime().Add(time.Hour)
+
+	// only the admin or the governance can start the redenomination
+	_, err = ftKeeper.StartRedenomination(ctx, randomAddr, denom, "uabcx", "ABCX", 10, swapDeadline)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	// the swap deadline must be in the future
+	_, err = ftKeeper.StartRedenomination(ctx, issuer, denom, "uabcx", "ABCX", 10, ctx.BlockTime())
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	// the new subunit must not be used by the issuer
+	_, err = ftKeeper.StartRedenomination(ctx, issuer, denom, "uabc", "ABCX", 10, swapDeadline)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	newDenom, err := ftKeeper.StartRedenomination(ctx, issuer, denom, "uabcx", "ABCX", 10, swapDeadline)
+	requireT.NoError(err)
+	requireT.Equal(types.BuildDenom("uabcx", issuer), newDenom)
+
+	// the new token copies the settings of the old one
+	newToken, err := ftKeeper.GetToken(ctx, newDenom)
+	requireT.NoError(err)
+	requireT.Equal("ABCX", newToken.Symbol)
+	requireT.Equal(uint32(6), newToken.Precision)
+	requireT.Equal("ABC token", newToken.Description)
+	requireT.Equal([]types.Feature{types.Feature_minting, types.Feature_whitelisting}, newToken.Features)
+	requireT.Equal("0.010000000000000000", newToken.BurnRate.String())
+	requireT.Equal("https://my-token-meta.invalid/1", newToken.URI)
+	requireT.Equal(issuer.String(), newToken.Admin)
+	requireT.True(bankKeeper.GetSupply(ctx, newDenom).IsZero())
+
+	// the old token is locked
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, holder, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+		types.ErrRedenominated,
+	)
+	requireT.ErrorIs(
+		ftKeeper.Mint(ctx, issuer, issuer, sdk.NewInt64Coin(denom, 1)),
+		types.ErrRedenominated,
+	)
+	_, err = ftKeeper.StartRedenomination(ctx, issuer, denom, "uabcy", "ABCY", 10, swapDeadline)
+	requireT.ErrorIs(err, types.ErrRedenominated)
+
+	// the holder swaps the old token and the whitelisted limit follows the swap
+	requireT.NoError(ftKeeper.Redenominate(ctx, holder, sdk.NewInt64Coin(denom, 100)))
+	requireT.Equal("200", bankKeeper.GetBalance(ctx, holder, denom).Amount.String())
+	requireT.Equal("1000", bankKeeper.GetBalance(ctx, holder, newDenom).Amount.String())
+	requireT.Equal("1000", ftKeeper.GetWhitelistedBalance(ctx, holder, newDenom).Amount.String())
+	// the swap isn't possible above the balance
+	requireT.ErrorIs(
+		ftKeeper.Redenominate(ctx, holder, sdk.NewInt64Coin(denom, 201)),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	// the new token isn't redenominated
+	requireT.ErrorIs(
+		ftKeeper.Redenominate(ctx, holder, sdk.NewInt64Coin(newDenom, 1)),
+		types.ErrRedenominationNotFound,
+	)
+
+	redenomination, err := ftKeeper.GetRedenomination(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.Redenomination{
+		Denom:         denom,
+		NewDenom:      newDenom,
+		Ratio:         10,
+		SwapDeadline:  swapDeadline,
+		SwappedAmount: sdkmath.NewInt(100),
+	}, redenomination)
+	requireT.Equal("900", ftKeeper.GetUnswappedAmount(ctx, redenomination).String())
+
+	// the swap deadline passes
+	ctx = ctx.WithBlockTime(swapDeadline)
+	requireT.ErrorIs(
+		ftKeeper.Redenominate(ctx, holder, sdk.NewInt64Coin(denom, 100)),
+		types.ErrInvalidInput,
+	)
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+
+	redenomination, err = ftKeeper.GetRedenomination(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(redenomination.Finalized)
+	requireT.Equal("900", bankKeeper.GetSupply(ctx, denom).Amount.String())
+	requireT.Equal("900", ftKeeper.GetUnswappedAmount(ctx, redenomination).String())
+	// the unswapped old token stays locked
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, holder, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+		types.ErrRedenominated,
+	)
+
+	msg, broken := keeper.SupplyInvariant(ftKeeper)(ctx)
+	requireT.False(broken, msg)
+
+	// the governance starts the redenomination of another token
+	denom2, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "udef",
+		Precision:     6,
+		InitialAmount: types.MaxMintableAmount,
+	})
+	requireT.NoError(err)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	// the supply multiplied by the ratio must be mintable
+	_, err = ftKeeper.StartRedenomination(ctx, govAddr, denom2, "udefx", "DEFX", 2, ctx.BlockTime().Add(time.Hour))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	newDenom2, err := ftKeeper.StartRedenomination(
+		ctx, govAddr, denom2, "udefx", "DEFX", 1, ctx.BlockTime().Add(time.Hour),
+	)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.Redenominate(ctx, issuer, sdk.NewInt64Coin(denom2, 1000)))
+	requireT.Equal("1000", bankKeeper.GetBalance(ctx, issuer, newDenom2).Amount.String())
+
+	redenominations, _, err := ftKeeper.GetRedenominations(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(redenominations, 2)
+}
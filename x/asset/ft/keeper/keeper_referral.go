@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// GetReferrerStats returns the cumulative statistics of the issuances referred by the account.
+func (k Keeper) GetReferrerStats(ctx sdk.Context, referrer sdk.AccAddress) (types.ReferrerStats, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateReferrerStatsKey(referrer))
+	if err != nil {
+		return types.ReferrerStats{}, err
+	}
+	if bz == nil {
+		return types.ReferrerStats{
+			Referrer:   referrer.String(),
+			FeesEarned: sdk.NewCoins(),
+		}, nil
+	}
+	var stats types.ReferrerStats
+	if err := k.cdc.Unmarshal(bz, &stats); err != nil {
+		return types.ReferrerStats{}, err
+	}
+
+	return stats, nil
+}
+
+// SetReferrerStats stores the referrer statistics.
+func (k Keeper) SetReferrerStats(ctx sdk.Context, stats types.ReferrerStats) error {
+	referrer, err := sdk.AccAddressFromBech32(stats.Referrer)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid referrer address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(types.CreateReferrerStatsKey(referrer), k.cdc.MustMarshal(&stats))
+}
+
+// GetAllReferrerStats returns the statistics of all the referrers.
+func (k Keeper) GetAllReferrerStats(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.ReferrerStats, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ReferrerStatsKeyPrefix)
+	allStats := make([]types.ReferrerStats, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var stats types.ReferrerStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+		allStats = append(allStats, stats)
+		return nil
+	})
+
+	return allStats, pageRes, err
+}
+
+func (k Keeper) recordReferral(
+	ctx sdk.Context,
+	settings types.IssueSettings,
+	denom string,
+	referralFee sdk.Coin,
+) error {
+	stats, err := k.GetReferrerStats(ctx, settings.Referrer)
+	if err != nil {
+		return err
+	}
+
+	stats.ReferredIssuances++
+	if referralFee.IsPositive() {
+		stats.FeesEarned = stats.FeesEarned.Add(referralFee)
+	}
+
+	if err := k.SetReferrerStats(ctx, stats); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventReferralFeePaid{
+		Referrer: settings.Referrer.String(),
+		Issuer:   settings.Issuer.String(),
+		Denom:    denom,
+		Fee:      referralFee,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventReferralFeePaid event: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// AssignRole assigns the role of the token to the account. The admin of the token assigns any role, the accounts
+// with the admin role assign the minter and compliance roles.
+func (k Keeper) AssignRole(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, role types.Role) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if err := k.checkRoleManagementAllowed(ctx, def, sender, role); err != nil {
+		return err
+	}
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "admin has the permissions of all the roles")
+	}
+
+	hasRole, err := k.HasRole(ctx, denom, addr, role)
+	if err != nil {
+		return err
+	}
+	if hasRole {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "role %s of %s is already assigned to %s", role, denom, addr)
+	}
+
+	if err := k.setRole(ctx, types.RoleAssignment{
+		Denom:   denom,
+		Account: addr.String(),
+		Role:    role,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleAssigned{
+		Denom:   denom,
+		Account: addr.String(),
+		Role:    role,
+		Sender:  sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleAssigned event: %s", err)
+	}
+
+	return nil
+}
+
+// RevokeRole revokes the role of the token from the account. The admin of the token revokes any role, the accounts
+// with the admin role revoke the minter and compliance roles.
+func (k Keeper) RevokeRole(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, role types.Role) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	if err := k.checkRoleManagementAllowed(ctx, def, sender, role); err != nil {
+		return err
+	}
+
+	key := types.CreateRoleKey(denom, addr, role)
+	kvStore := k.storeService.OpenKVStore(ctx)
+	found, err := kvStore.Has(key)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRoleNotFound, "role %s of %s is not assigned to %s", role, denom, addr)
+	}
+	if err := kvStore.Delete(key); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleRevoked{
+		Denom:   denom,
+		Account: addr.String(),
+		Role:    role,
+		Sender:  sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleRevoked event: %s", err)
+	}
+
+	return nil
+}
+
+// ImportRoleAssignments imports the role assignments from genesis state.
+func (k Keeper) ImportRoleAssignments(ctx sdk.Context, assignments []types.RoleAssignment) error {
+	for _, assignment := range assignments {
+		if err := k.setRole(ctx, assignment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasRole returns true if the role of the denom is assigned to the account.
+func (k Keeper) HasRole(ctx sdk.Context, denom string, addr sdk.AccAddress, role types.Role) (bool, error) {
+	return k.storeService.OpenKVStore(ctx).Has(types.CreateRoleKey(denom, addr, role))
+}
+
+// GetAccountRoles returns the roles of the denom assigned to the account.
+func (k Keeper) GetAccountRoles(ctx sdk.Context, denom string, addr sdk.AccAddress) ([]types.Role, error) {
+	assignments, _, err := k.getRoleAssignments(
+		ctx, types.CreateAccountRolesPrefix(denom, addr), &query.PageRequest{Limit: query.PaginationMaxLimit},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]types.Role, 0, len(assignments))
+	for _, assignment := range assignments {
+		roles = append(roles, assignment.Role)
+	}
+	return roles, nil
+}
+
+// GetRoleAssignments returns the roles of the denom assigned to the accounts.
+func (k Keeper) GetRoleAssignments(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.RoleAssignment, *query.PageResponse, error) {
+	return k.getRoleAssignments(ctx, types.CreateRolesPrefix(denom), pagination)
+}
+
+// GetAllRoleAssignments returns the roles of all the denoms assigned to the accounts.
+func (k Keeper) GetAllRoleAssignments(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.RoleAssignment, *query.PageResponse, error) {
+	return k.getRoleAssignments(ctx, types.RoleKeyPrefix, pagination)
+}
+
+func (k Keeper) getRoleAssignments(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	pagination *query.PageRequest,
+) ([]types.RoleAssignment, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	assignments := make([]types.RoleAssignment, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var assignment types.RoleAssignment
+		if err := k.cdc.Unmarshal(value, &assignment); err != nil {
+			return err
+		}
+		assignments = append(assignments, assignment)
+		return nil
+	})
+
+	return assignments, pageRes, err
+}
+
+// revokeAllRoles revokes all the roles of the denom. The roles are delegated by the admin, so they are revoked when
+// the admin is transferred.
+func (k Keeper) revokeAllRoles(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	kvStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(kvStore), types.CreateRolesPrefix(denom))
+	iterator := store.Iterator(nil, nil)
+	// the assignments are collected first, since the store can't be modified while it is iterated
+	var assignments []types.RoleAssignment
+	for ; iterator.Valid(); iterator.Next() {
+		var assignment types.RoleAssignment
+		if err := k.cdc.Unmarshal(iterator.Value(), &assignment); err != nil {
+			iterator.Close()
+			return err
+		}
+		assignments = append(assignments, assignment)
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, assignment := range assignments {
+		addr, err := sdk.AccAddressFromBech32(assignment.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "invalid account address: %s", err)
+		}
+		if err := kvStore.Delete(types.CreateRoleKey(denom, addr, assignment.Role)); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleRevoked{
+			Denom:   denom,
+			Account: assignment.Account,
+			Role:    assignment.Role,
+			Sender:  sender.String(),
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleRevoked event: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setRole(ctx sdk.Context, assignment types.RoleAssignment) error {
+	addr, err := sdk.AccAddressFromBech32(assignment.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid account address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateRoleKey(assignment.Denom, addr, assignment.Role),
+		k.cdc.MustMarshal(&assignment),
+	)
+}
+
+// checkRoleManagementAllowed returns error if the sender isn't allowed to assign and revoke the role.
+func (k Keeper) checkRoleManagementAllowed(
+	ctx sdk.Context,
+	def types.Definition,
+	sender sdk.AccAddress,
+	role types.Role,
+) error {
+	if err := types.ValidateRole(role); err != nil {
+		return err
+	}
+	if def.IsAdmin(sender) {
+		return nil
+	}
+	if role != types.ROLE_ADMIN && def.Admin != "" {
+		hasAdminRole, err := k.HasRole(ctx, def.Denom, sender, types.ROLE_ADMIN)
+		if err != nil {
+			return err
+		}
+		if hasAdminRole {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(
+		cosmoserrors.ErrUnauthorized, "address %s is unauthorized to manage the %s role", sender, role,
+	)
+}
+
+// isFeatureAllowed returns true if the feature is allowed for the address by the definition or by the roles assigned
+// to the address. The roles are delegated by the admin, so they are effective only while the token has the admin.
+func (k Keeper) isFeatureAllowed(
+	ctx sdk.Context,
+	def types.Definition,
+	addr sdk.AccAddress,
+	feature types.Feature,
+) (bool, error) {
+	if def.IsFeatureAllowed(addr, feature) {
+		return true, nil
+	}
+	if def.Admin == "" || (!def.IsFeatureEnabled(feature) && feature != types.Feature_burning) {
+		return false, nil
+	}
+
+	roles, err := k.GetAccountRoles(ctx, def.Denom, addr)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		if types.RoleGrantsFeature(role, feature) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkFeatureAllowed returns error if the feature is allowed neither by the definition nor by the roles assigned to
+// the address.
+func (k Keeper) checkFeatureAllowed(
+	ctx sdk.Context,
+	def types.Definition,
+	addr sdk.AccAddress,
+	feature types.Feature,
+) error {
+	isAllowed, err := k.isFeatureAllowed(ctx, def, addr, feature)
+	if err != nil {
+		return err
+	}
+	if isAllowed {
+		return nil
+	}
+
+	return def.CheckFeatureAllowed(addr, feature)
+}
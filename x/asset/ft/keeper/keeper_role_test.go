@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_Roles(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	officer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	treasurer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	manager := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1_000),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_freezing,
+			types.Feature_clawback,
+		},
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// the accounts without the roles can't use the features
+	requireT.ErrorIs(ftKeeper.Mint(ctx, treasurer, treasurer, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(ftKeeper.Freeze(ctx, officer, holder, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized)
+
+	// only the admin assigns the admin role
+	requireT.ErrorIs(
+		ftKeeper.AssignRole(ctx, holder, manager, denom, types.ROLE_ADMIN),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.ErrorIs(
+		ftKeeper.AssignRole(ctx, issuer, manager, denom, types.ROLE_UNSPECIFIED),
+		types.ErrInvalidInput,
+	)
+	requireT.ErrorIs(
+		ftKeeper.AssignRole(ctx, issuer, issuer, denom, types.ROLE_MINTER),
+		types.ErrInvalidInput,
+	)
+	requireT.NoError(ftKeeper.AssignRole(ctx, issuer, manager, denom, types.ROLE_ADMIN))
+	requireT.ErrorIs(
+		ftKeeper.AssignRole(ctx, issuer, manager, denom, types.ROLE_ADMIN),
+		types.ErrInvalidInput,
+	)
+
+	// the account with the admin role assigns the minter and compliance roles, but not the admin one
+	requireT.ErrorIs(
+		ftKeeper.AssignRole(ctx, manager, holder, denom, types.ROLE_ADMIN),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.NoError(ftKeeper.AssignRole(ctx, manager, treasurer, denom, types.ROLE_MINTER))
+	requireT.NoError(ftKeeper.AssignRole(ctx, manager, officer, denom, types.ROLE_COMPLIANCE))
+
+	assignedEvents, err := event.FindTypedEvents[*types.EventRoleAssigned](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventRoleAssigned{
+		{Denom: denom, Account: manager.String(), Role: types.ROLE_ADMIN, Sender: issuer.String()},
+		{Denom: denom, Account: treasurer.String(), Role: types.ROLE_MINTER, Sender: manager.String()},
+		{Denom: denom, Account: officer.String(), Role: types.ROLE_COMPLIANCE, Sender: manager.String()},
+	}, assignedEvents)
+
+	roles, err := ftKeeper.GetAccountRoles(ctx, denom, treasurer)
+	requireT.NoError(err)
+	requireT.Equal([]types.Role{types.ROLE_MINTER}, roles)
+
+	assignments, _, err := ftKeeper.GetRoleAssignments(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch([]types.RoleAssignment{
+		{Denom: denom, Account: manager.String(), Role: types.ROLE_ADMIN},
+		{Denom: denom, Account: treasurer.String(), Role: types.ROLE_MINTER},
+		{Denom: denom, Account: officer.String(), Role: types.ROLE_COMPLIANCE},
+	}, assignments)
+
+	// the minter mints and burns, but doesn't freeze
+	requireT.NoError(ftKeeper.Mint(ctx, treasurer, treasurer, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.Burn(ctx, treasurer, sdk.NewInt64Coin(denom, 5)))
+	requireT.ErrorIs(ftKeeper.Freeze(ctx, treasurer, holder, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized)
+
+	// the compliance officer freezes and claws back, but doesn't mint
+	requireT.NoError(ftKeeper.Freeze(ctx, officer, holder, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.Unfreeze(ctx, officer, holder, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, officer, denom))
+	requireT.NoError(ftKeeper.GloballyUnfreeze(ctx, officer, denom))
+	requireT.NoError(ftKeeper.Clawback(ctx, officer, holder, issuer, sdk.NewInt64Coin(denom, 10)))
+	requireT.ErrorIs(ftKeeper.Mint(ctx, officer, officer, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized)
+
+	// the role doesn't enable the disabled feature
+	requireT.ErrorIs(
+		ftKeeper.SetWhitelistedBalance(ctx, officer, holder, sdk.NewInt64Coin(denom, 10)),
+		types.ErrFeatureDisabled,
+	)
+
+	// the account with the admin role uses the permissions of both roles
+	requireT.NoError(ftKeeper.Mint(ctx, manager, manager, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.Freeze(ctx, manager, holder, sdk.NewInt64Coin(denom, 10)))
+
+	// the revoked role isn't effective anymore
+	requireT.ErrorIs(
+		ftKeeper.RevokeRole(ctx, manager, manager, denom, types.ROLE_ADMIN),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.ErrorIs(
+		ftKeeper.RevokeRole(ctx, manager, holder, denom, types.ROLE_MINTER),
+		types.ErrRoleNotFound,
+	)
+	requireT.NoError(ftKeeper.RevokeRole(ctx, manager, treasurer, denom, types.ROLE_MINTER))
+	requireT.ErrorIs(ftKeeper.Mint(ctx, treasurer, treasurer, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized)
+
+	revokedEvents, err := event.FindTypedEvents[*types.EventRoleRevoked](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventRoleRevoked{
+		{Denom: denom, Account: treasurer.String(), Role: types.ROLE_MINTER, Sender: manager.String()},
+	}, revokedEvents)
+
+	roles, err = ftKeeper.GetAccountRoles(ctx, denom, treasurer)
+	requireT.NoError(err)
+	requireT.Empty(roles)
+
+	// the roles aren't effective once the admin is cleared
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom))
+	requireT.ErrorIs(ftKeeper.Freeze(ctx, officer, holder, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(
+		ftKeeper.AssignRole(ctx, manager, treasurer, denom, types.ROLE_MINTER),
+		cosmoserrors.ErrUnauthorized,
+	)
+}
+
+func TestKeeper_Roles_TransferAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newAdmin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	officer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	treasurer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1_000),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_freezing,
+			types.Feature_clawback,
+		},
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	requireT.NoError(ftKeeper.AssignRole(ctx, issuer, treasurer, denom, types.ROLE_MINTER))
+	requireT.NoError(ftKeeper.AssignRole(ctx, issuer, officer, denom, types.ROLE_COMPLIANCE))
+	requireT.NoError(ftKeeper.Mint(ctx, treasurer, treasurer, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.Freeze(ctx, officer, holder, sdk.NewInt64Coin(denom, 10)))
+
+	// the roles delegated by the previous admin are revoked together with the admin transfer
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, newAdmin, denom))
+
+	requireT.ErrorIs(ftKeeper.Mint(ctx, treasurer, treasurer, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(ftKeeper.Freeze(ctx, officer, holder, sdk.NewInt64Coin(denom, 10)), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(
+		ftKeeper.Clawback(ctx, officer, holder, officer, sdk.NewInt64Coin(denom, 10)),
+		cosmoserrors.ErrUnauthorized,
+	)
+
+	assignments, _, err := ftKeeper.GetRoleAssignments(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Empty(assignments)
+
+	revokedEvents, err := event.FindTypedEvents[*types.EventRoleRevoked](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.ElementsMatch([]*types.EventRoleRevoked{
+		{Denom: denom, Account: treasurer.String(), Role: types.ROLE_MINTER, Sender: issuer.String()},
+		{Denom: denom, Account: officer.String(), Role: types.ROLE_COMPLIANCE, Sender: issuer.String()},
+	}, revokedEvents)
+
+	// the new admin delegates the roles again
+	requireT.NoError(ftKeeper.AssignRole(ctx, newAdmin, treasurer, denom, types.ROLE_MINTER))
+	requireT.NoError(ftKeeper.Mint(ctx, treasurer, treasurer, sdk.NewInt64Coin(denom, 10)))
+}
@@ -0,0 +1,376 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// SetSendRateLimit sets the limit of the amount of the denom the account may send within each window. The empty
+// denom limits each denom of the account which has no own limit, the zero amount removes the limit.
+// The change is applied immediately if it makes the limit applied to the denom stricter, otherwise it is applied
+// after the change delay, so the compromised key can't lift the limit before the owner reacts.
+func (k Keeper) SetSendRateLimit(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	denom string,
+	amount sdkmath.Int,
+	window time.Duration,
+) error {
+	if err := types.ValidateSendRateLimitTerms(denom, amount, window); err != nil {
+		return err
+	}
+
+	limit, err := k.getSendRateLimitOrNil(ctx, account, denom)
+	if err != nil {
+		return err
+	}
+	if limit == nil && !amount.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrSendRateLimitNotFound, "account: %s, denom: %q", account, denom)
+	}
+	if limit == nil && denom != "" {
+		// the limit of all the denoms applies to the denom until its own limit is set, so the own limit can't loosen
+		// it immediately
+		if limit, err = k.getSendRateLimitOrNil(ctx, account, ""); err != nil {
+			return err
+		}
+		if limit != nil {
+			limit.Denom = denom
+			limit.PendingChange = nil
+		}
+	}
+
+	if limit == nil {
+		return k.applySendRateLimitChange(ctx, types.SendRateLimit{
+			Account: account.String(),
+			Denom:   denom,
+		}, amount, window)
+	}
+
+	if err := k.cancelPendingSendRateLimitChange(ctx, *limit); err != nil {
+		return err
+	}
+	limit.PendingChange = nil
+
+	if !limit.IsLoosenedBy(amount, window) {
+		return k.applySendRateLimitChange(ctx, *limit, amount, window)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	limit.PendingChange = &types.SendRateLimitChange{
+		Amount:        amount,
+		Window:        window,
+		EffectiveTime: ctx.BlockTime().Add(params.SendRateLimitChangeDelay),
+	}
+	if err := k.SetSendRateLimitRecord(ctx, *limit); err != nil {
+		return err
+	}
+	if err := k.delayKeeper.DelayExecution(
+		ctx,
+		sendRateLimitChangeID(account, denom),
+		&types.DelayedSendRateLimitChange{Account: limit.Account, Denom: denom},
+		params.SendRateLimitChangeDelay,
+	); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSendRateLimitChangeScheduled{
+		Account:       limit.Account,
+		Denom:         denom,
+		Amount:        amount,
+		Window:        window,
+		EffectiveTime: limit.PendingChange.EffectiveTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidState, "failed to emit EventSendRateLimitChangeScheduled event: %s", err,
+		)
+	}
+
+	return nil
+}
+
+// ApplyPendingSendRateLimitChange applies the pending change of the send rate limit once the change delay passes.
+func (k Keeper) ApplyPendingSendRateLimitChange(ctx sdk.Context, data *types.DelayedSendRateLimitChange) error {
+	account, err := sdk.AccAddressFromBech32(data.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid account address: %s", err)
+	}
+	limit, err := k.getSendRateLimitOrNil(ctx, account, data.Denom)
+	if err != nil {
+		return err
+	}
+	// the change has been replaced in the meantime
+	if limit == nil || limit.PendingChange == nil || ctx.BlockTime().Before(limit.PendingChange.EffectiveTime) {
+		return nil
+	}
+
+	change := *limit.PendingChange
+	limit.PendingChange = nil
+
+	return k.applySendRateLimitChange(ctx, *limit, change.Amount, change.Window)
+}
+
+// SetSendRateLimitRecord stores the send rate limit.
+func (k Keeper) SetSendRateLimitRecord(ctx sdk.Context, limit types.SendRateLimit) error {
+	account, err := sdk.AccAddressFromBech32(limit.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid account address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateSendRateLimitKey(account, limit.Denom),
+		k.cdc.MustMarshal(&limit),
+	)
+}
+
+// GetSendRateLimits returns the send rate limits of the account.
+func (k Keeper) GetSendRateLimits(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.SendRateLimit, *query.PageResponse, error) {
+	return k.getSendRateLimits(ctx, types.CreateSendRateLimitsPrefix(account), pagination)
+}
+
+// GetAllSendRateLimits returns the send rate limits of all the accounts.
+func (k Keeper) GetAllSendRateLimits(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.SendRateLimit, *query.PageResponse, error) {
+	return k.getSendRateLimits(ctx, types.SendRateLimitKeyPrefix, pagination)
+}
+
+// GetSendRateLimitHeadroom returns the send rate limit applied to the denom sent by the account, the amount the
+// account may still send within the current window and the time the window ends at, if any window is in progress.
+func (k Keeper) GetSendRateLimitHeadroom(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	denom string,
+) (types.SendRateLimit, sdkmath.Int, *time.Time, error) {
+	limit, err := k.getAppliedSendRateLimitOrNil(ctx, account, denom)
+	if err != nil {
+		return types.SendRateLimit{}, sdkmath.Int{}, nil, err
+	}
+	if limit == nil {
+		return types.SendRateLimit{}, sdkmath.Int{}, nil, sdkerrors.Wrapf(
+			types.ErrSendRateLimitNotFound, "account: %s, denom: %s", account, denom,
+		)
+	}
+
+	usage, err := k.getSendRateLimitUsageOrNil(ctx, account, denom)
+	if err != nil {
+		return types.SendRateLimit{}, sdkmath.Int{}, nil, err
+	}
+	if usage == nil || !usage.IsWindowInProgress(ctx.BlockTime()) {
+		return *limit, limit.Amount, nil, nil
+	}
+
+	return *limit, usage.Remaining(*limit, ctx.BlockTime()), &usage.WindowResetTime, nil
+}
+
+// SetSendRateLimitUsage stores the amount sent within the current window.
+func (k Keeper) SetSendRateLimitUsage(ctx sdk.Context, usage types.SendRateLimitUsage) error {
+	account, err := sdk.AccAddressFromBech32(usage.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid account address: %s", err)
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateSendRateLimitUsageKey(account, usage.Denom),
+		k.cdc.MustMarshal(&usage),
+	)
+}
+
+// GetSendRateLimitUsages returns the amounts sent by all the accounts within the current windows.
+func (k Keeper) GetSendRateLimitUsages(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.SendRateLimitUsage, *query.PageResponse, error) {
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SendRateLimitUsageKeyPrefix,
+	)
+	usages := make([]types.SendRateLimitUsage, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var usage types.SendRateLimitUsage
+		if err := k.cdc.Unmarshal(value, &usage); err != nil {
+			return err
+		}
+		usages = append(usages, usage)
+		return nil
+	})
+
+	return usages, pageRes, err
+}
+
+// useSendRateLimit adds the coin to the amount sent by the sender within the current window of the send rate limit
+// applied to the denom. The window starts with the first send after the previous window ends.
+func (k Keeper) useSendRateLimit(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
+	limit, err := k.getAppliedSendRateLimitOrNil(ctx, sender, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if limit == nil {
+		return nil
+	}
+
+	usage, err := k.getSendRateLimitUsageOrNil(ctx, sender, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if usage == nil || !usage.IsWindowInProgress(ctx.BlockTime()) {
+		usage = &types.SendRateLimitUsage{
+			Account:         sender.String(),
+			Denom:           coin.Denom,
+			WindowResetTime: ctx.BlockTime().Add(limit.Window),
+			WindowSent:      sdkmath.ZeroInt(),
+		}
+	}
+
+	remaining := usage.Remaining(*limit, ctx.BlockTime())
+	if coin.Amount.GT(remaining) {
+		return sdkerrors.Wrapf(
+			types.ErrSendRateLimitExceeded,
+			"sending %s exceeds the remaining %s%s until %s",
+			coin, remaining, coin.Denom, usage.WindowResetTime,
+		)
+	}
+	usage.WindowSent = usage.WindowSent.Add(coin.Amount)
+
+	return k.SetSendRateLimitUsage(ctx, *usage)
+}
+
+// getAppliedSendRateLimitOrNil returns the own send rate limit of the denom or the limit of all the denoms.
+func (k Keeper) getAppliedSendRateLimitOrNil(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	denom string,
+) (*types.SendRateLimit, error) {
+	limit, err := k.getSendRateLimitOrNil(ctx, account, denom)
+	if err != nil || limit != nil {
+		return limit, err
+	}
+
+	return k.getSendRateLimitOrNil(ctx, account, "")
+}
+
+func (k Keeper) applySendRateLimitChange(
+	ctx sdk.Context,
+	limit types.SendRateLimit,
+	amount sdkmath.Int,
+	window time.Duration,
+) error {
+	if amount.IsPositive() {
+		limit.Amount = amount
+		limit.Window = window
+		if err := k.SetSendRateLimitRecord(ctx, limit); err != nil {
+			return err
+		}
+	} else {
+		account, err := sdk.AccAddressFromBech32(limit.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "invalid account address: %s", err)
+		}
+		if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateSendRateLimitKey(account, limit.Denom)); err != nil {
+			return err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSendRateLimitChanged{
+		Account: limit.Account,
+		Denom:   limit.Denom,
+		Amount:  amount,
+		Window:  window,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventSendRateLimitChanged event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) cancelPendingSendRateLimitChange(ctx sdk.Context, limit types.SendRateLimit) error {
+	if limit.PendingChange == nil {
+		return nil
+	}
+	account, err := sdk.AccAddressFromBech32(limit.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid account address: %s", err)
+	}
+
+	return k.delayKeeper.RemoveExecuteAfter(
+		ctx, sendRateLimitChangeID(account, limit.Denom), limit.PendingChange.EffectiveTime,
+	)
+}
+
+func (k Keeper) getSendRateLimits(
+	ctx sdk.Context,
+	keyPrefix []byte,
+	pagination *query.PageRequest,
+) ([]types.SendRateLimit, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	limits := make([]types.SendRateLimit, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var limit types.SendRateLimit
+		if err := k.cdc.Unmarshal(value, &limit); err != nil {
+			return err
+		}
+		limits = append(limits, limit)
+		return nil
+	})
+
+	return limits, pageRes, err
+}
+
+func (k Keeper) getSendRateLimitOrNil(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	denom string,
+) (*types.SendRateLimit, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateSendRateLimitKey(account, denom))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var limit types.SendRateLimit
+	if err := k.cdc.Unmarshal(bz, &limit); err != nil {
+		return nil, err
+	}
+
+	return &limit, nil
+}
+
+func (k Keeper) getSendRateLimitUsageOrNil(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	denom string,
+) (*types.SendRateLimitUsage, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateSendRateLimitUsageKey(account, denom))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var usage types.SendRateLimitUsage
+	if err := k.cdc.Unmarshal(bz, &usage); err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+func sendRateLimitChangeID(account sdk.AccAddress, denom string) string {
+	return fmt.Sprintf("%s-send-rate-limit-%s-%s", types.ModuleName, account, denom)
+}
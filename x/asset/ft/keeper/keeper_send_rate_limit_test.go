@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_SendRateLimit(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	bankKeeper := testApp.BankKeeper
+	delayKeeper := testApp.DelayKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	params, err := ftKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	changeDelay := params.SendRateLimitChangeDelay
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, account, sdk.NewCoins(
+		sdk.NewInt64Coin("denom1", 1_000),
+		sdk.NewInt64Coin("denom2", 1_000),
+	)))
+	send := func(ctx sdk.Context, coin sdk.Coin) error {
+		return bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(coin))
+	}
+
+	// the limit which doesn't exist can't be removed
+	requireT.ErrorIs(
+		ftKeeper.SetSendRateLimit(ctx, account, "denom1", sdkmath.ZeroInt(), 0),
+		types.ErrSendRateLimitNotFound,
+	)
+	_, _, _, err = ftKeeper.GetSendRateLimitHeadroom(ctx, account, "denom1")
+	requireT.ErrorIs(err, types.ErrSendRateLimitNotFound)
+
+	// the first limit applies immediately
+	requireT.NoError(ftKeeper.SetSendRateLimit(ctx, account, "denom1", sdkmath.NewInt(100), time.Hour))
+	requireT.NoError(send(ctx, sdk.NewInt64Coin("denom1", 60)))
+	requireT.ErrorIs(send(ctx, sdk.NewInt64Coin("denom1", 50)), types.ErrSendRateLimitExceeded)
+	requireT.NoError(send(ctx, sdk.NewInt64Coin("denom2", 500)))
+
+	limit, remaining, windowResetTime, err := ftKeeper.GetSendRateLimitHeadroom(ctx, account, "denom1")
+	requireT.NoError(err)
+	requireT.Equal(types.SendRateLimit{
+		Account: account.String(),
+		Denom:   "denom1",
+		Amount:  sdkmath.NewInt(100),
+		Window:  time.Hour,
+	}, limit)
+	requireT.Equal(sdkmath.NewInt(40), remaining)
+	requireT.Equal(ctx.BlockTime().Add(time.Hour), *windowResetTime)
+
+	// the window is reset
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, remaining, windowResetTime, err = ftKeeper.GetSendRateLimitHeadroom(ctx, account, "denom1")
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(100), remaining)
+	requireT.Nil(windowResetTime)
+	requireT.NoError(send(ctx, sdk.NewInt64Coin("denom1", 100)))
+	requireT.ErrorIs(send(ctx, sdk.NewInt64Coin("denom1", 1)), types.ErrSendRateLimitExceeded)
+
+	// the stricter limit applies immediately
+	requireT.NoError(ftKeeper.SetSendRateLimit(ctx, account, "", sdkmath.NewInt(200), 2*time.Hour))
+	requireT.NoError(send(ctx, sdk.NewInt64Coin("denom2", 200)))
+	requireT.ErrorIs(send(ctx, sdk.NewInt64Coin("denom2", 1)), types.ErrSendRateLimitExceeded)
+
+	// the own limit of the denom can't loosen the limit of all the denoms immediately
+	requireT.NoError(ftKeeper.SetSendRateLimit(ctx, account, "denom2", sdkmath.NewInt(300), 2*time.Hour))
+	requireT.ErrorIs(send(ctx, sdk.NewInt64Coin("denom2", 1)), types.ErrSendRateLimitExceeded)
+	limits, _, err := ftKeeper.GetSendRateLimits(ctx, account, nil)
+	requireT.NoError(err)
+	requireT.Len(limits, 3)
+	requireT.Equal(types.SendRateLimit{
+		Account: account.String(),
+		Denom:   "denom2",
+		Amount:  sdkmath.NewInt(200),
+		Window:  2 * time.Hour,
+		PendingChange: &types.SendRateLimitChange{
+			Amount:        sdkmath.NewInt(300),
+			Window:        2 * time.Hour,
+			EffectiveTime: ctx.BlockTime().Add(changeDelay),
+		},
+	}, limits[2])
+
+	// the removal is delayed too
+	requireT.NoError(ftKeeper.SetSendRateLimit(ctx, account, "denom1", sdkmath.ZeroInt(), 0))
+	requireT.ErrorIs(send(ctx, sdk.NewInt64Coin("denom1", 1)), types.ErrSendRateLimitExceeded)
+
+	// the pending changes are applied after the delay
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(changeDelay))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	limits, _, err = ftKeeper.GetSendRateLimits(ctx, account, nil)
+	requireT.NoError(err)
+	requireT.Len(limits, 2)
+	requireT.Nil(limits[1].PendingChange)
+	requireT.Equal(sdkmath.NewInt(300), limits[1].Amount)
+
+	// the denom without own limit falls back to the limit of all the denoms
+	requireT.NoError(send(ctx, sdk.NewInt64Coin("denom1", 200)))
+	requireT.ErrorIs(send(ctx, sdk.NewInt64Coin("denom1", 1)), types.ErrSendRateLimitExceeded)
+	requireT.NoError(send(ctx, sdk.NewInt64Coin("denom2", 300)))
+
+	// the pending change is replaced by the stricter one
+	requireT.NoError(ftKeeper.SetSendRateLimit(ctx, account, "", sdkmath.NewInt(1_000), 2*time.Hour))
+	requireT.NoError(ftKeeper.SetSendRateLimit(ctx, account, "", sdkmath.NewInt(100), 2*time.Hour))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(changeDelay))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	limit, _, _, err = ftKeeper.GetSendRateLimitHeadroom(ctx, account, "denom1")
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(100), limit.Amount)
+	requireT.Nil(limit.PendingChange)
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+}
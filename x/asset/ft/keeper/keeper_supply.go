@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// burnCategory is the category of the burn tracked by the supply breakdown.
+type burnCategory int
+
+const (
+	burnCategoryHolders burnCategory = iota
+	burnCategoryBurnRate
+	burnCategoryAdmin
+	burnCategoryBuyback
+)
+
+// GetSupplyBreakdown returns the cumulative amounts of the token minted and burnt.
+func (k Keeper) GetSupplyBreakdown(ctx sdk.Context, denom string) (types.SupplyBreakdown, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateSupplyBreakdownKey(denom))
+	if err != nil {
+		return types.SupplyBreakdown{}, err
+	}
+	if bz == nil {
+		return types.NewSupplyBreakdown(denom), nil
+	}
+	var breakdown types.SupplyBreakdown
+	if err := k.cdc.Unmarshal(bz, &breakdown); err != nil {
+		return types.SupplyBreakdown{}, err
+	}
+
+	return breakdown, nil
+}
+
+// SetSupplyBreakdown stores the cumulative amounts of the token minted and burnt.
+func (k Keeper) SetSupplyBreakdown(ctx sdk.Context, breakdown types.SupplyBreakdown) error {
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateSupplyBreakdownKey(breakdown.Denom), k.cdc.MustMarshal(&breakdown),
+	)
+}
+
+// GetSupplyBreakdowns returns the supply breakdowns of all the tokens.
+func (k Keeper) GetSupplyBreakdowns(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.SupplyBreakdown, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SupplyBreakdownKeyPrefix)
+	breakdowns := make([]types.SupplyBreakdown, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var breakdown types.SupplyBreakdown
+		if err := k.cdc.Unmarshal(value, &breakdown); err != nil {
+			return err
+		}
+		breakdowns = append(breakdowns, breakdown)
+		return nil
+	})
+
+	return breakdowns, pageRes, err
+}
+
+// InitSupplyBreakdowns sets the minted amount of the tokens issued before the supply breakdown was introduced to
+// their current supply, so the burns are tracked from that point.
+func (k Keeper) InitSupplyBreakdowns(ctx sdk.Context) error {
+	return k.IterateAllDefinitions(ctx, func(def types.Definition) (bool, error) {
+		has, err := k.storeService.OpenKVStore(ctx).Has(types.CreateSupplyBreakdownKey(def.Denom))
+		if err != nil || has {
+			return err != nil, err
+		}
+		breakdown := types.NewSupplyBreakdown(def.Denom)
+		breakdown.Minted = k.bankKeeper.GetSupply(ctx, def.Denom).Amount
+		return false, k.SetSupplyBreakdown(ctx, breakdown)
+	})
+}
+
+func (k Keeper) recordMint(ctx sdk.Context, denom string, amount sdkmath.Int) error {
+	breakdown, err := k.GetSupplyBreakdown(ctx, denom)
+	if err != nil {
+		return err
+	}
+	breakdown.Minted = breakdown.Minted.Add(amount)
+
+	return k.SetSupplyBreakdown(ctx, breakdown)
+}
+
+func (k Keeper) recordBurn(ctx sdk.Context, denom string, amount sdkmath.Int, category burnCategory) error {
+	breakdown, err := k.GetSupplyBreakdown(ctx, denom)
+	if err != nil {
+		return err
+	}
+	switch category {
+	case burnCategoryBurnRate:
+		breakdown.BurntByBurnRate = breakdown.BurntByBurnRate.Add(amount)
+	case burnCategoryAdmin:
+		breakdown.BurntByAdmin = breakdown.BurntByAdmin.Add(amount)
+	case burnCategoryBuyback:
+		breakdown.BurntByBuyback = breakdown.BurntByBuyback.Add(amount)
+	default:
+		breakdown.BurntByHolders = breakdown.BurntByHolders.Add(amount)
+	}
+
+	return k.SetSupplyBreakdown(ctx, breakdown)
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_SupplyBreakdown(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		ChainID: "test-chain",
+		Time:    time.Now(),
+	})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holderKey := secp256k1.GenPrivKey()
+	holder := sdk.AccAddress(holderKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting, types.Feature_burning},
+		BurnRate:      sdkmath.LegacyMustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, issuer, sdk.NewInt64Coin(denom, 500)))
+	requireT.NoError(ftKeeper.Burn(ctx, issuer, sdk.NewInt64Coin(denom, 200)))
+
+	// the burn rate applies to the transfers between the holders
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 400))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// the admin burns the coins of the holder with the permit
+	coin := sdk.NewInt64Coin(denom, 50)
+	expiration := ctx.BlockTime().Add(time.Hour).UTC()
+	permit := types.NewBurnPermit(ctx.ChainID(), holder, issuer, coin, 1, expiration)
+	signBytes, err := permit.SignBytes()
+	requireT.NoError(err)
+	signature, err := holderKey.Sign(signBytes)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.BurnFrom(
+		ctx, issuer, holder, coin, 1, expiration, holderKey.PubKey().Bytes(), signature,
+	))
+
+	breakdown, err := ftKeeper.GetSupplyBreakdown(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(denom, breakdown.Denom)
+	requireT.Equal(sdkmath.NewInt(1500).String(), breakdown.Minted.String())
+	requireT.Equal(sdkmath.NewInt(200).String(), breakdown.BurntByHolders.String())
+	requireT.Equal(sdkmath.NewInt(10).String(), breakdown.BurntByBurnRate.String())
+	requireT.Equal(sdkmath.NewInt(50).String(), breakdown.BurntByAdmin.String())
+	requireT.True(breakdown.BurntByBuyback.IsZero())
+	requireT.Equal(bankKeeper.GetSupply(ctx, denom).Amount.String(), breakdown.Supply().String())
+
+	msg, broken := keeper.SupplyInvariant(ftKeeper)(ctx)
+	requireT.False(broken, msg)
+
+	// the breakdown not matching the bank supply
+	breakdown.BurntByHolders = breakdown.BurntByHolders.AddRaw(1)
+	requireT.NoError(ftKeeper.SetSupplyBreakdown(ctx, breakdown))
+	msg, broken = keeper.SupplyInvariant(ftKeeper)(ctx)
+	requireT.True(broken)
+	requireT.Contains(msg, denom)
+}
@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// ClaimSymbol creates the pending claim to mark the symbol of the token as verified.
+// The deposit defined in the params is locked on the module account until the claim is resolved by the governance.
+func (k Keeper) ClaimSymbol(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can claim the symbol")
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", denom)
+	}
+	symbol := metadata.Symbol
+
+	verifiedSymbol, err := k.getVerifiedSymbolOrNil(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if verifiedSymbol != nil {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "symbol %s is already verified for denom %s", symbol, verifiedSymbol.Denom,
+		)
+	}
+
+	symbolClaim, err := k.getSymbolClaimOrNil(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if symbolClaim != nil {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "symbol %s is already claimed for denom %s", symbol, symbolClaim.Denom,
+		)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	deposit := params.SymbolClaimDeposit
+	if deposit.IsPositive() {
+		if err := k.validateCoinIsNotLockedByDEXAndBank(ctx, sender, deposit); err != nil {
+			return sdkerrors.Wrap(err, "out of funds to pay for symbol claim deposit")
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx, sender, types.ModuleName, sdk.NewCoins(deposit),
+		); err != nil {
+			return sdkerrors.Wrapf(err, "can't lock symbol claim deposit %s", deposit.String())
+		}
+	}
+
+	claim := types.SymbolClaim{
+		Symbol:  symbol,
+		Denom:   denom,
+		Claimer: sender.String(),
+		Deposit: deposit,
+	}
+	if err := k.SetSymbolClaim(ctx, claim); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSymbolClaimed{
+		Symbol:  claim.Symbol,
+		Denom:   claim.Denom,
+		Claimer: claim.Claimer,
+		Deposit: claim.Deposit,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventSymbolClaimed event: %s", err)
+	}
+
+	return nil
+}
+
+// ResolveSymbolClaim is a governance operation to approve or reject the pending symbol claim.
+// If the claim is approved, the symbol is marked as verified and the deposit is returned to the claimer,
+// otherwise the deposit is burnt.
+func (k Keeper) ResolveSymbolClaim(ctx sdk.Context, authority, symbol string, approved bool) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	claim, err := k.GetSymbolClaim(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	claimer, err := sdk.AccAddressFromBech32(claim.Claimer)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid claimer address: %s", err)
+	}
+
+	if approved {
+		if err := k.SetVerifiedSymbol(ctx, types.VerifiedSymbol{
+			Symbol: claim.Symbol,
+			Denom:  claim.Denom,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if claim.Deposit.IsPositive() {
+		deposit := sdk.NewCoins(claim.Deposit)
+		if approved {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, deposit); err != nil {
+				return sdkerrors.Wrapf(err, "can't return symbol claim deposit %s", deposit.String())
+			}
+		} else if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit); err != nil {
+			return sdkerrors.Wrapf(err, "can't burn symbol claim deposit %s", deposit.String())
+		}
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateSymbolClaimKey(claim.Symbol)); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSymbolClaimResolved{
+		Symbol:   claim.Symbol,
+		Denom:    claim.Denom,
+		Claimer:  claim.Claimer,
+		Approved: approved,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventSymbolClaimResolved event: %s", err)
+	}
+
+	return nil
+}
+
+// SetVerifiedSymbol stores the verified symbol.
+func (k Keeper) SetVerifiedSymbol(ctx sdk.Context, verifiedSymbol types.VerifiedSymbol) error {
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateVerifiedSymbolKey(verifiedSymbol.Symbol),
+		k.cdc.MustMarshal(&verifiedSymbol),
+	)
+}
+
+// GetVerifiedSymbol returns the verified symbol.
+func (k Keeper) GetVerifiedSymbol(ctx sdk.Context, symbol string) (types.VerifiedSymbol, error) {
+	verifiedSymbol, err := k.getVerifiedSymbolOrNil(ctx, symbol)
+	if err != nil {
+		return types.VerifiedSymbol{}, err
+	}
+	if verifiedSymbol == nil {
+		return types.VerifiedSymbol{}, sdkerrors.Wrapf(types.ErrVerifiedSymbolNotFound, "symbol: %s", symbol)
+	}
+
+	return *verifiedSymbol, nil
+}
+
+// GetVerifiedSymbols returns all the verified symbols.
+func (k Keeper) GetVerifiedSymbols(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.VerifiedSymbol, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.VerifiedSymbolKeyPrefix)
+	verifiedSymbols := make([]types.VerifiedSymbol, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var verifiedSymbol types.VerifiedSymbol
+		if err := k.cdc.Unmarshal(value, &verifiedSymbol); err != nil {
+			return err
+		}
+		verifiedSymbols = append(verifiedSymbols, verifiedSymbol)
+		return nil
+	})
+
+	return verifiedSymbols, pageRes, err
+}
+
+// SetSymbolClaim stores the pending symbol claim.
+func (k Keeper) SetSymbolClaim(ctx sdk.Context, claim types.SymbolClaim) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.CreateSymbolClaimKey(claim.Symbol), k.cdc.MustMarshal(&claim))
+}
+
+// GetSymbolClaim returns the pending symbol claim.
+func (k Keeper) GetSymbolClaim(ctx sdk.Context, symbol string) (types.SymbolClaim, error) {
+	claim, err := k.getSymbolClaimOrNil(ctx, symbol)
+	if err != nil {
+		return types.SymbolClaim{}, err
+	}
+	if claim == nil {
+		return types.SymbolClaim{}, sdkerrors.Wrapf(types.ErrSymbolClaimNotFound, "symbol: %s", symbol)
+	}
+
+	return *claim, nil
+}
+
+// GetSymbolClaims returns all the pending symbol claims.
+func (k Keeper) GetSymbolClaims(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.SymbolClaim, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SymbolClaimKeyPrefix)
+	claims := make([]types.SymbolClaim, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var claim types.SymbolClaim
+		if err := k.cdc.Unmarshal(value, &claim); err != nil {
+			return err
+		}
+		claims = append(claims, claim)
+		return nil
+	})
+
+	return claims, pageRes, err
+}
+
+func (k Keeper) getVerifiedSymbolOrNil(ctx sdk.Context, symbol string) (*types.VerifiedSymbol, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateVerifiedSymbolKey(symbol))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var verifiedSymbol types.VerifiedSymbol
+	if err := k.cdc.Unmarshal(bz, &verifiedSymbol); err != nil {
+		return nil, err
+	}
+
+	return &verifiedSymbol, nil
+}
+
+func (k Keeper) getSymbolClaimOrNil(ctx sdk.Context, symbol string) (*types.SymbolClaim, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateSymbolClaimKey(symbol))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var claim types.SymbolClaim
+	if err := k.cdc.Unmarshal(bz, &claim); err != nil {
+		return nil, err
+	}
+
+	return &claim, nil
+}
+
+func (k Keeper) isSymbolVerifiedForDenom(ctx sdk.Context, symbol, denom string) (bool, error) {
+	verifiedSymbol, err := k.getVerifiedSymbolOrNil(ctx, symbol)
+	if err != nil {
+		return false, err
+	}
+
+	return verifiedSymbol != nil && verifiedSymbol.Denom == denom, nil
+}
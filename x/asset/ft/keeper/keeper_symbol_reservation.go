@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// ReserveSymbol reserves the symbol and subunit for the issuer for the period defined in the params.
+// The deposit is locked on the module account. It is returned once the token is issued using the reservation
+// and burnt if the reservation expires.
+func (k Keeper) ReserveSymbol(ctx sdk.Context, issuer sdk.AccAddress, symbol, subunit string) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.SymbolReservationPeriod == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "symbol reservations are disabled")
+	}
+
+	if err := types.ValidateSymbol(symbol); err != nil {
+		return sdkerrors.Wrapf(err, "provided symbol: %s", symbol)
+	}
+	if err := types.ValidateSubunit(subunit); err != nil {
+		return sdkerrors.Wrapf(err, "provided subunit: %s", subunit)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, types.BuildDenom(subunit, issuer)); found {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "subunit %s already registered for the address %s", subunit, issuer.String(),
+		)
+	}
+	isSymbolDuplicated, err := k.isSymbolDuplicated(ctx, types.NormalizeSymbolForKey(symbol), issuer)
+	if err != nil {
+		return err
+	}
+	if isSymbolDuplicated {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "symbol %s already registered for the address %s", symbol, issuer.String(),
+		)
+	}
+
+	reservation, err := k.getActiveSymbolReservationOrNil(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if reservation != nil {
+		return sdkerrors.Wrapf(
+			types.ErrSymbolReserved, "symbol %s is reserved until %s", symbol, reservation.ExpirationTime,
+		)
+	}
+
+	deposit := params.SymbolReservationDeposit
+	if deposit.IsPositive() {
+		if err := k.validateCoinIsNotLockedByDEXAndBank(ctx, issuer, deposit); err != nil {
+			return sdkerrors.Wrap(err, "out of funds to pay for symbol reservation deposit")
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx, issuer, types.ModuleName, sdk.NewCoins(deposit),
+		); err != nil {
+			return sdkerrors.Wrapf(err, "can't lock symbol reservation deposit %s", deposit.String())
+		}
+	}
+
+	reservation = &types.SymbolReservation{
+		Symbol:         symbol,
+		Subunit:        subunit,
+		Issuer:         issuer.String(),
+		Deposit:        deposit,
+		ExpirationTime: ctx.BlockTime().Add(params.SymbolReservationPeriod),
+	}
+	if err := k.SetSymbolReservation(ctx, *reservation); err != nil {
+		return err
+	}
+
+	if err := k.delayKeeper.DelayExecution(
+		ctx,
+		symbolReservationID(symbol),
+		&types.DelayedSymbolReservationExpiration{Symbol: symbol},
+		params.SymbolReservationPeriod,
+	); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSymbolReserved{
+		Symbol:         reservation.Symbol,
+		Subunit:        reservation.Subunit,
+		Issuer:         reservation.Issuer,
+		Deposit:        reservation.Deposit,
+		ExpirationTime: reservation.ExpirationTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventSymbolReserved event: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateSymbolReservation returns an error if the symbol is reserved for another issuer or subunit.
+func (k Keeper) ValidateSymbolReservation(ctx sdk.Context, issuer sdk.AccAddress, symbol, subunit string) error {
+	reservation, err := k.getActiveSymbolReservationOrNil(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if reservation == nil {
+		return nil
+	}
+	if reservation.Issuer != issuer.String() || reservation.Subunit != subunit {
+		return sdkerrors.Wrapf(
+			types.ErrSymbolReserved,
+			"symbol %s is reserved for subunit %s of %s until %s",
+			symbol, reservation.Subunit, reservation.Issuer, reservation.ExpirationTime,
+		)
+	}
+
+	return nil
+}
+
+// ExpireSymbolReservation removes the expired reservation and burns its deposit.
+func (k Keeper) ExpireSymbolReservation(ctx sdk.Context, data *types.DelayedSymbolReservationExpiration) error {
+	reservation, err := k.getSymbolReservationOrNil(ctx, data.Symbol)
+	if err != nil {
+		return err
+	}
+	// the reservation has been already consumed by the issuance
+	if reservation == nil || ctx.BlockTime().Before(reservation.ExpirationTime) {
+		return nil
+	}
+
+	if reservation.Deposit.IsPositive() {
+		deposit := sdk.NewCoins(reservation.Deposit)
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit); err != nil {
+			return sdkerrors.Wrapf(err, "can't burn symbol reservation deposit %s", deposit.String())
+		}
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(types.CreateSymbolReservationKey(reservation.Symbol))
+}
+
+// SetSymbolReservation stores the symbol reservation.
+func (k Keeper) SetSymbolReservation(ctx sdk.Context, reservation types.SymbolReservation) error {
+	return k.storeService.OpenKVStore(ctx).Set(
+		types.CreateSymbolReservationKey(reservation.Symbol),
+		k.cdc.MustMarshal(&reservation),
+	)
+}
+
+// GetSymbolReservation returns the symbol reservation.
+func (k Keeper) GetSymbolReservation(ctx sdk.Context, symbol string) (types.SymbolReservation, error) {
+	reservation, err := k.getSymbolReservationOrNil(ctx, symbol)
+	if err != nil {
+		return types.SymbolReservation{}, err
+	}
+	if reservation == nil {
+		return types.SymbolReservation{}, sdkerrors.Wrapf(types.ErrSymbolReservationNotFound, "symbol: %s", symbol)
+	}
+
+	return *reservation, nil
+}
+
+// GetSymbolReservations returns all the symbol reservations.
+func (k Keeper) GetSymbolReservations(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.SymbolReservation, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SymbolReservationKeyPrefix)
+	reservations := make([]types.SymbolReservation, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var reservation types.SymbolReservation
+		if err := k.cdc.Unmarshal(value, &reservation); err != nil {
+			return err
+		}
+		reservations = append(reservations, reservation)
+		return nil
+	})
+
+	return reservations, pageRes, err
+}
+
+// consumeSymbolReservation returns the deposit of the reservation made by the issuer and removes it.
+func (k Keeper) consumeSymbolReservation(ctx sdk.Context, settings types.IssueSettings) error {
+	if err := k.ValidateSymbolReservation(ctx, settings.Issuer, settings.Symbol, settings.Subunit); err != nil {
+		return err
+	}
+
+	reservation, err := k.getActiveSymbolReservationOrNil(ctx, settings.Symbol)
+	if err != nil {
+		return err
+	}
+	if reservation == nil {
+		return nil
+	}
+
+	if reservation.Deposit.IsPositive() {
+		deposit := sdk.NewCoins(reservation.Deposit)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, settings.Issuer, deposit); err != nil {
+			return sdkerrors.Wrapf(err, "can't return symbol reservation deposit %s", deposit.String())
+		}
+	}
+
+	if err := k.delayKeeper.RemoveExecuteAfter(
+		ctx, symbolReservationID(reservation.Symbol), reservation.ExpirationTime,
+	); err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(types.CreateSymbolReservationKey(reservation.Symbol))
+}
+
+func (k Keeper) getActiveSymbolReservationOrNil(ctx sdk.Context, symbol string) (*types.SymbolReservation, error) {
+	reservation, err := k.getSymbolReservationOrNil(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if reservation == nil || !ctx.BlockTime().Before(reservation.ExpirationTime) {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+
+	return reservation, nil
+}
+
+func (k Keeper) getSymbolReservationOrNil(ctx sdk.Context, symbol string) (*types.SymbolReservation, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateSymbolReservationKey(symbol))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil //returns nil if data not found
+	}
+	var reservation types.SymbolReservation
+	if err := k.cdc.Unmarshal(bz, &reservation); err != nil {
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+func symbolReservationID(symbol string) string {
+	return fmt.Sprintf("%s-symbol-reservation-%s", types.ModuleName, types.NormalizeSymbolForKey(symbol))
+}
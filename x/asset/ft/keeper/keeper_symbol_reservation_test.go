@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_ReserveSymbol(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	bankKeeper := testApp.BankKeeper
+	stakingKeeper := testApp.StakingKeeper
+	delayKeeper := testApp.DelayKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = constant.DenomDev
+	requireT.NoError(stakingKeeper.SetParams(ctx, stakingParams))
+
+	deposit := sdk.NewInt64Coin(constant.DenomDev, 1_000)
+	ftParams := types.DefaultParams()
+	ftParams.IssueFee = sdk.NewInt64Coin(constant.DenomDev, 0)
+	ftParams.SymbolReservationDeposit = deposit
+	ftParams.SymbolReservationPeriod = time.Hour
+	requireT.NoError(ftKeeper.SetParams(ctx, ftParams))
+
+	issuer1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issuer2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, issuer1, sdk.NewCoins(deposit)))
+	requireT.NoError(testApp.FundAccount(ctx, issuer2, sdk.NewCoins(deposit)))
+
+	settings := func(issuer sdk.AccAddress, subunit string) types.IssueSettings {
+		return types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        "ABC",
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(100),
+		}
+	}
+
+	// reserve the symbol and check the deposit is locked
+	requireT.NoError(ftKeeper.ReserveSymbol(ctx, issuer1, "ABC", "uabc"))
+	requireT.True(bankKeeper.GetBalance(ctx, issuer1, constant.DenomDev).IsZero())
+	reservation, err := ftKeeper.GetSymbolReservation(ctx, "abc")
+	requireT.NoError(err)
+	requireT.Equal(types.SymbolReservation{
+		Symbol:         "ABC",
+		Subunit:        "uabc",
+		Issuer:         issuer1.String(),
+		Deposit:        deposit,
+		ExpirationTime: ctx.BlockTime().Add(time.Hour),
+	}, reservation)
+
+	// the symbol can't be reserved twice
+	requireT.ErrorIs(ftKeeper.ReserveSymbol(ctx, issuer2, "abc", "uabc"), types.ErrSymbolReserved)
+
+	// the reserved symbol can't be issued by another issuer or with another subunit
+	_, err = ftKeeper.Issue(ctx, settings(issuer2, "uabc"))
+	requireT.ErrorIs(err, types.ErrSymbolReserved)
+	requireT.ErrorIs(ftKeeper.ValidateSymbolReservation(ctx, issuer2, "ABC", "uabc"), types.ErrSymbolReserved)
+	_, err = ftKeeper.Issue(ctx, settings(issuer1, "uabc2"))
+	requireT.ErrorIs(err, types.ErrSymbolReserved)
+
+	// issue the token consuming the reservation and check the deposit is returned
+	_, err = ftKeeper.Issue(ctx, settings(issuer1, "uabc"))
+	requireT.NoError(err)
+	requireT.Equal(deposit, bankKeeper.GetBalance(ctx, issuer1, constant.DenomDev))
+	_, err = ftKeeper.GetSymbolReservation(ctx, "ABC")
+	requireT.ErrorIs(err, types.ErrSymbolReservationNotFound)
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	// the symbol can be issued by another issuer once the reservation is consumed
+	_, err = ftKeeper.Issue(ctx, settings(issuer2, "uabc"))
+	requireT.NoError(err)
+
+	// reserve the symbol again and let the reservation expire
+	requireT.NoError(ftKeeper.ReserveSymbol(ctx, issuer1, "XYZ", "uxyz"))
+	supplyBefore := bankKeeper.GetSupply(ctx, constant.DenomDev)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	_, err = ftKeeper.GetSymbolReservation(ctx, "XYZ")
+	requireT.ErrorIs(err, types.ErrSymbolReservationNotFound)
+	requireT.Equal(supplyBefore.Sub(deposit), bankKeeper.GetSupply(ctx, constant.DenomDev))
+	requireT.NoError(ftKeeper.ValidateSymbolReservation(ctx, issuer2, "XYZ", "uxyz"))
+
+	// reservations are disabled if the period is zero
+	ftParams.SymbolReservationPeriod = 0
+	requireT.NoError(ftKeeper.SetParams(ctx, ftParams))
+	requireT.ErrorIs(ftKeeper.ReserveSymbol(ctx, issuer2, "XYZ", "uxyz"), types.ErrInvalidInput)
+}
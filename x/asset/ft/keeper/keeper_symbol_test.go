@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestKeeper_ClaimSymbol(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	bankKeeper := testApp.BankKeeper
+	stakingKeeper := testApp.StakingKeeper
+	ftKeeper := testApp.AssetFTKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = constant.DenomDev
+	requireT.NoError(stakingKeeper.SetParams(ctx, stakingParams))
+
+	deposit := sdk.NewInt64Coin(constant.DenomDev, 1_000)
+	ftParams := types.DefaultParams()
+	ftParams.IssueFee = sdk.NewInt64Coin(constant.DenomDev, 0)
+	ftParams.SymbolClaimDeposit = deposit
+	requireT.NoError(ftKeeper.SetParams(ctx, ftParams))
+
+	issuer1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issuer2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, issuer1, sdk.NewCoins(deposit)))
+	requireT.NoError(testApp.FundAccount(ctx, issuer2, sdk.NewCoins(deposit.Add(deposit))))
+
+	issue := func(issuer sdk.AccAddress) string {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        "ABC",
+			Subunit:       "uabc",
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(100),
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	denom1 := issue(issuer1)
+	denom2 := issue(issuer2)
+
+	// only admin can claim the symbol
+	requireT.ErrorIs(ftKeeper.ClaimSymbol(ctx, issuer2, denom1), cosmoserrors.ErrUnauthorized)
+
+	// claim symbol and check the deposit is locked
+	requireT.NoError(ftKeeper.ClaimSymbol(ctx, issuer1, denom1))
+	requireT.True(bankKeeper.GetBalance(ctx, issuer1, constant.DenomDev).IsZero())
+	claim, err := ftKeeper.GetSymbolClaim(ctx, "abc")
+	requireT.NoError(err)
+	requireT.Equal(types.SymbolClaim{
+		Symbol:  "ABC",
+		Denom:   denom1,
+		Claimer: issuer1.String(),
+		Deposit: deposit,
+	}, claim)
+
+	// symbol can't be claimed twice
+	requireT.ErrorIs(ftKeeper.ClaimSymbol(ctx, issuer2, denom2), types.ErrInvalidInput)
+
+	// only governance can resolve the claim
+	requireT.Error(ftKeeper.ResolveSymbolClaim(ctx, issuer1.String(), "ABC", true))
+
+	// reject the claim and check the deposit is burnt
+	supplyBefore := bankKeeper.GetSupply(ctx, constant.DenomDev)
+	requireT.NoError(ftKeeper.ResolveSymbolClaim(ctx, authority, "ABC", false))
+	requireT.Equal(supplyBefore.Sub(deposit), bankKeeper.GetSupply(ctx, constant.DenomDev))
+	_, err = ftKeeper.GetSymbolClaim(ctx, "ABC")
+	requireT.ErrorIs(err, types.ErrSymbolClaimNotFound)
+	_, err = ftKeeper.GetVerifiedSymbol(ctx, "ABC")
+	requireT.ErrorIs(err, types.ErrVerifiedSymbolNotFound)
+
+	// claim by another issuer and approve it
+	requireT.NoError(ftKeeper.ClaimSymbol(ctx, issuer2, denom2))
+	claims, _, err := ftKeeper.GetSymbolClaims(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(claims, 1)
+	requireT.NoError(ftKeeper.ResolveSymbolClaim(ctx, authority, "abc", true))
+	requireT.Equal(deposit.Add(deposit), bankKeeper.GetBalance(ctx, issuer2, constant.DenomDev))
+
+	verifiedSymbol, err := ftKeeper.GetVerifiedSymbol(ctx, "ABC")
+	requireT.NoError(err)
+	requireT.Equal(types.VerifiedSymbol{Symbol: "ABC", Denom: denom2}, verifiedSymbol)
+	verifiedSymbols, _, err := ftKeeper.GetVerifiedSymbols(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.VerifiedSymbol{verifiedSymbol}, verifiedSymbols)
+	claims, _, err = ftKeeper.GetSymbolClaims(ctx, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(claims)
+
+	// check the verified flag of the tokens
+	token1, err := ftKeeper.GetToken(ctx, denom1)
+	requireT.NoError(err)
+	requireT.False(token1.Verified)
+	token2, err := ftKeeper.GetToken(ctx, denom2)
+	requireT.NoError(err)
+	requireT.True(token2.Verified)
+
+	// verified symbol can't be claimed
+	requireT.ErrorIs(ftKeeper.ClaimSymbol(ctx, issuer1, denom1), types.ErrInvalidInput)
+}
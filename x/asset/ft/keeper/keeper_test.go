@@ -25,6 +25,7 @@ import (
 	"github.com/tokenize-x/tx-chain/v7/testutil/event"
 	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 	wbankkeeper "github.com/tokenize-x/tx-chain/v7/x/wbank/keeper"
 	wibctransfertypes "github.com/tokenize-x/tx-chain/v7/x/wibctransfer/types"
 )
@@ -575,6 +576,78 @@ func TestKeeper_Mint(t *testing.T) {
 	requireT.Equal(sdkmath.NewInt(977), totalSupply.Supply.AmountOf(mintableDenom))
 }
 
+func TestKeeper_MintToRecipients(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "mintable",
+		Subunit:       "mintable",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(777),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_whitelisting,
+		},
+	})
+	requireT.NoError(err)
+	recipients := []types.MintRecipient{
+		{Address: recipient1.String(), Amount: sdkmath.NewInt(60)},
+		{Address: recipient2.String(), Amount: sdkmath.NewInt(40)},
+	}
+
+	// the amounts must sum up to the minted amount
+	requireT.ErrorIs(
+		ftKeeper.MintToRecipients(ctx, issuer, sdk.NewCoin(denom, sdkmath.NewInt(99)), recipients),
+		types.ErrInvalidInput,
+	)
+
+	// the whitelisting is applied to each recipient, the failed mint is reverted by the transaction
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient1, sdk.NewCoin(denom, sdkmath.NewInt(60))))
+	cacheCtx, _ := ctx.CacheContext()
+	requireT.ErrorIs(
+		ftKeeper.MintToRecipients(cacheCtx, issuer, sdk.NewCoin(denom, sdkmath.NewInt(100)), recipients),
+		types.ErrWhitelistedLimitExceeded,
+	)
+
+	// only the issuer can mint
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient2, sdk.NewCoin(denom, sdkmath.NewInt(40))))
+	requireT.ErrorIs(
+		ftKeeper.MintToRecipients(ctx, recipient1, sdk.NewCoin(denom, sdkmath.NewInt(100)), recipients),
+		cosmoserrors.ErrUnauthorized,
+	)
+
+	// the recipients blocked by the governance are rejected
+	requireT.NoError(testApp.CustomParamsKeeper.SetBankParams(ctx, customparamstypes.BankParams{
+		BlockedAddresses: []string{recipient2.String()},
+	}))
+	cacheCtx, _ = ctx.CacheContext()
+	requireT.ErrorIs(
+		ftKeeper.MintToRecipients(cacheCtx, issuer, sdk.NewCoin(denom, sdkmath.NewInt(100)), recipients),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.NoError(testApp.CustomParamsKeeper.SetBankParams(ctx, customparamstypes.DefaultBankParams()))
+
+	requireT.NoError(ftKeeper.MintToRecipients(ctx, issuer, sdk.NewCoin(denom, sdkmath.NewInt(100)), recipients))
+	requireT.Equal(sdkmath.NewInt(60), bankKeeper.GetBalance(ctx, recipient1, denom).Amount)
+	requireT.Equal(sdkmath.NewInt(40), bankKeeper.GetBalance(ctx, recipient2, denom).Amount)
+	requireT.Equal(sdkmath.NewInt(777), bankKeeper.GetBalance(ctx, issuer, denom).Amount)
+
+	totalSupply, err := bankKeeper.TotalSupply(ctx, &banktypes.QueryTotalSupplyRequest{})
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(877), totalSupply.Supply.AmountOf(denom))
+}
+
 func TestKeeper_Burn(t *testing.T) {
 	requireT := require.New(t)
 
@@ -1655,40 +1728,40 @@ func TestKeeper_Clawback(t *testing.T) {
 
 	// try to clawback non-existent denom
 	nonExistentDenom := types.BuildDenom("nonexist", issuer)
-	err = ftKeeper.Clawback(ctx, issuer, from, sdk.NewCoin(nonExistentDenom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, issuer, from, nil, sdk.NewCoin(nonExistentDenom, sdkmath.NewInt(10)))
 	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
 
 	// try to clawback clawbackDisabled Token
-	err = ftKeeper.Clawback(ctx, issuer, from, sdk.NewCoin(clawbackDisabledDenom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, issuer, from, nil, sdk.NewCoin(clawbackDisabledDenom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, types.ErrFeatureDisabled)
 
 	// try to clawback by non issuer address
 	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.Clawback(ctx, randomAddr, from, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, randomAddr, from, nil, sdk.NewCoin(denom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to clawback from issuer address
 	randomAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.Clawback(ctx, randomAddr, issuer, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, randomAddr, issuer, nil, sdk.NewCoin(denom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to clawback from module address
 	moduleAddr := accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
-	err = ftKeeper.Clawback(ctx, issuer, moduleAddr, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, issuer, moduleAddr, nil, sdk.NewCoin(denom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to clawback 0 balance
-	err = ftKeeper.Clawback(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(0)))
+	err = ftKeeper.Clawback(ctx, issuer, from, nil, sdk.NewCoin(denom, sdkmath.NewInt(0)))
 	requireT.ErrorIs(err, cosmoserrors.ErrInvalidCoins)
 
 	// try to clawback more than balance
-	err = ftKeeper.Clawback(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(110)))
+	err = ftKeeper.Clawback(ctx, issuer, from, nil, sdk.NewCoin(denom, sdkmath.NewInt(110)))
 	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
 
 	// try to clawback locked balance
 	err = ftKeeper.DEXIncreaseLocked(ctx, from, sdk.NewCoin(denom, sdkmath.NewInt(100)))
 	requireT.NoError(err)
-	err = ftKeeper.Clawback(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(40)))
+	err = ftKeeper.Clawback(ctx, issuer, from, nil, sdk.NewCoin(denom, sdkmath.NewInt(40)))
 	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
 	err = ftKeeper.DEXDecreaseLocked(ctx, from, sdk.NewCoin(denom, sdkmath.NewInt(100)))
 	requireT.NoError(err)
@@ -1696,7 +1769,7 @@ func TestKeeper_Clawback(t *testing.T) {
 	// clawback, query balance
 	issuerBalanceBefore := bankKeeper.GetBalance(ctx, issuer, denom)
 	accountBalanceBefore := bankKeeper.GetBalance(ctx, from, denom)
-	err = ftKeeper.Clawback(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(40)))
+	err = ftKeeper.Clawback(ctx, issuer, from, nil, sdk.NewCoin(denom, sdkmath.NewInt(40)))
 	requireT.NoError(err)
 	issuerBalanceAfter := bankKeeper.GetBalance(ctx, issuer, denom)
 	accountBalanceAfter := bankKeeper.GetBalance(ctx, from, denom)
@@ -1706,12 +1779,90 @@ func TestKeeper_Clawback(t *testing.T) {
 	// clawback frozen token, query balance
 	err = ftKeeper.Freeze(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(60)))
 	requireT.NoError(err)
-	err = ftKeeper.Clawback(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(60)))
+	err = ftKeeper.Clawback(ctx, issuer, from, nil, sdk.NewCoin(denom, sdkmath.NewInt(60)))
 	requireT.NoError(err)
 	accountBalance := bankKeeper.GetBalance(ctx, from, denom)
 	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(0)), accountBalance)
 }
 
+func TestKeeper_BatchClawback(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	accountKeeper := testApp.AccountKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1_000),
+		Features:      []types.Feature{types.Feature_clawback},
+	})
+	requireT.NoError(err)
+
+	account1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	custodian := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account1, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account2, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// claw back to the custodian
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account1, custodian, sdk.NewInt64Coin(denom, 10)))
+	requireT.Equal(sdkmath.NewInt(90), bankKeeper.GetBalance(ctx, account1, denom).Amount)
+	requireT.Equal(sdkmath.NewInt(10), bankKeeper.GetBalance(ctx, custodian, denom).Amount)
+
+	// claw back to the module account is prohibited
+	moduleAddr := accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	requireT.ErrorIs(
+		ftKeeper.Clawback(ctx, issuer, account1, moduleAddr, sdk.NewInt64Coin(denom, 10)),
+		cosmoserrors.ErrUnauthorized,
+	)
+
+	// the batch is applied atomically, so the failing entry reverts the whole batch
+	cacheCtx, _ := ctx.CacheContext()
+	err = ftKeeper.BatchClawback(cacheCtx, issuer, custodian, []types.ClawbackEntry{
+		{Account: account1.String(), Coin: sdk.NewInt64Coin(denom, 50)},
+		{Account: account2.String(), Coin: sdk.NewInt64Coin(denom, 150)},
+	})
+	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
+
+	// duplicated entries are rejected
+	err = ftKeeper.BatchClawback(ctx, issuer, custodian, []types.ClawbackEntry{
+		{Account: account1.String(), Coin: sdk.NewInt64Coin(denom, 50)},
+		{Account: account1.String(), Coin: sdk.NewInt64Coin(denom, 10)},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// batch claw back to the custodian
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.BatchClawback(ctx, issuer, custodian, []types.ClawbackEntry{
+		{Account: account1.String(), Coin: sdk.NewInt64Coin(denom, 50)},
+		{Account: account2.String(), Coin: sdk.NewInt64Coin(denom, 100)},
+	}))
+	requireT.Equal(sdkmath.NewInt(40), bankKeeper.GetBalance(ctx, account1, denom).Amount)
+	requireT.True(bankKeeper.GetBalance(ctx, account2, denom).IsZero())
+	requireT.Equal(sdkmath.NewInt(160), bankKeeper.GetBalance(ctx, custodian, denom).Amount)
+
+	clawbackEvents, err := event.FindTypedEvents[*types.EventAmountClawedBack](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventAmountClawedBack{
+		{Account: account1.String(), Denom: denom, Amount: sdkmath.NewInt(50), Recipient: custodian.String()},
+		{Account: account2.String(), Denom: denom, Amount: sdkmath.NewInt(100), Recipient: custodian.String()},
+	}, clawbackEvents)
+
+	// the admin receives the tokens if the recipient is not set
+	requireT.NoError(ftKeeper.BatchClawback(ctx, issuer, nil, []types.ClawbackEntry{
+		{Account: account1.String(), Coin: sdk.NewInt64Coin(denom, 40)},
+	}))
+	requireT.Equal(sdkmath.NewInt(840), bankKeeper.GetBalance(ctx, issuer, denom).Amount)
+}
+
 func TestKeeper_Whitelist(t *testing.T) {
 	requireT := require.New(t)
 	assertT := assert.New(t)
@@ -1787,10 +1938,22 @@ func TestKeeper_Whitelist(t *testing.T) {
 		[]banktypes.Output{{Address: recipient.String(), Coins: coinsToSend}})
 	requireT.True(types.ErrWhitelistedLimitExceeded.Is(err))
 
+	// the receiver is validated against the whitelisted balance, the other denoms and the admin are accepted
+	requireT.ErrorIs(
+		ftKeeper.ValidateWhitelistedReceiver(ctx, recipient, sdk.NewCoin(denom, sdkmath.NewInt(1))),
+		types.ErrWhitelistedLimitExceeded,
+	)
+	requireT.NoError(ftKeeper.ValidateWhitelistedReceiver(ctx, issuer, sdk.NewCoin(denom, sdkmath.NewInt(1))))
+	requireT.NoError(
+		ftKeeper.ValidateWhitelistedReceiver(ctx, recipient, sdk.NewCoin(unwhitelistableDenom, sdkmath.NewInt(1))),
+	)
+	requireT.NoError(ftKeeper.ValidateWhitelistedReceiver(ctx, recipient, sdk.NewCoin("ibc/ABC", sdkmath.NewInt(1))))
+
 	// set whitelisted balance to 100
 	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(100))))
 	whitelistedBalance = ftKeeper.GetWhitelistedBalance(ctx, recipient, denom)
 	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(100)).String(), whitelistedBalance.String())
+	requireT.NoError(ftKeeper.ValidateWhitelistedReceiver(ctx, recipient, sdk.NewCoin(denom, sdkmath.NewInt(100))))
 
 	// test query all whitelisted balances
 	allBalances, pageRes, err := ftKeeper.GetAccountsWhitelistedBalances(ctx, &query.PageRequest{})
@@ -2117,6 +2280,59 @@ func TestKeeper_GetIssuerTokens(t *testing.T) {
 	requireT.Len(tokens, numberOfTokens)
 }
 
+func TestKeeper_GetTokensByDenoms(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denoms := make([]string, 0, 2)
+	for range 2 {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:             addr,
+			Symbol:             "ABC" + uuid.NewString()[:4],
+			Subunit:            "abc" + uuid.NewString()[:4],
+			Precision:          8,
+			InitialAmount:      sdkmath.NewInt(10),
+			Features:           []types.Feature{types.Feature_freezing},
+			BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.1"),
+			SendCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.2"),
+		})
+		requireT.NoError(err)
+		denoms = append(denoms, denom)
+	}
+
+	notIssuedDenom := types.BuildDenom("notissued", addr)
+	tokens, notFoundDenoms, err := ftKeeper.GetTokensByDenoms(
+		ctx, []string{denoms[1], notIssuedDenom, constant.DenomDev, denoms[0]},
+	)
+	requireT.NoError(err)
+	requireT.Len(tokens, 2)
+	requireT.Equal(denoms[1], tokens[0].Denom)
+	requireT.Equal(denoms[0], tokens[1].Denom)
+	for _, token := range tokens {
+		requireT.Equal([]types.Feature{types.Feature_freezing}, token.Features)
+		requireT.Equal(sdkmath.LegacyMustNewDecFromStr("0.1"), token.BurnRate)
+		requireT.Equal(sdkmath.LegacyMustNewDecFromStr("0.2"), token.SendCommissionRate)
+	}
+	requireT.Equal([]string{notIssuedDenom, constant.DenomDev}, notFoundDenoms)
+
+	// duplicated denoms
+	_, _, err = ftKeeper.GetTokensByDenoms(ctx, []string{denoms[0], denoms[0]})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// too many denoms
+	tooManyDenoms := make([]string, 0, types.MaxTokensQueryLimit+1)
+	for i := range types.MaxTokensQueryLimit + 1 {
+		tooManyDenoms = append(tooManyDenoms, types.BuildDenom(fmt.Sprintf("abc%d", i), addr))
+	}
+	_, _, err = ftKeeper.GetTokensByDenoms(ctx, tooManyDenoms)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
+
 type bankAssertion struct {
 	t   require.TestingT
 	bk  wbankkeeper.BaseKeeperWrapper
@@ -2692,44 +2908,44 @@ func TestKeeper_TransferAdmin_Clawback(t *testing.T) {
 
 	// try to clawback non-existent denom
 	nonExistentDenom := types.BuildDenom("nonexist", admin)
-	err = ftKeeper.Clawback(ctx, admin, account, sdk.NewCoin(nonExistentDenom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, admin, account, nil, sdk.NewCoin(nonExistentDenom, sdkmath.NewInt(10)))
 	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
 
 	// try to clawback clawbackDisabled Token
-	err = ftKeeper.Clawback(ctx, admin, account, sdk.NewCoin(clawbackDisabledDenom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, admin, account, nil, sdk.NewCoin(clawbackDisabledDenom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, types.ErrFeatureDisabled)
 
 	// try to clawback by non admin address
 	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.Clawback(ctx, randomAddr, account, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, randomAddr, account, nil, sdk.NewCoin(denom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to clawback from admin address
 	randomAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.Clawback(ctx, randomAddr, admin, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, randomAddr, admin, nil, sdk.NewCoin(denom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to clawback from module address
 	moduleAddr := accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
-	err = ftKeeper.Clawback(ctx, admin, moduleAddr, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, admin, moduleAddr, nil, sdk.NewCoin(denom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to clawback from the original issuer address which is not admin anymore
-	err = ftKeeper.Clawback(ctx, issuer, account, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Clawback(ctx, issuer, account, nil, sdk.NewCoin(denom, sdkmath.NewInt(10)))
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to clawback 0 balance
-	err = ftKeeper.Clawback(ctx, admin, account, sdk.NewCoin(denom, sdkmath.NewInt(0)))
+	err = ftKeeper.Clawback(ctx, admin, account, nil, sdk.NewCoin(denom, sdkmath.NewInt(0)))
 	requireT.ErrorIs(err, cosmoserrors.ErrInvalidCoins)
 
 	// try to clawback more than balance
-	err = ftKeeper.Clawback(ctx, admin, account, sdk.NewCoin(denom, sdkmath.NewInt(110)))
+	err = ftKeeper.Clawback(ctx, admin, account, nil, sdk.NewCoin(denom, sdkmath.NewInt(110)))
 	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
 
 	// clawback, query balance
 	issuerBalanceBefore := bankKeeper.GetBalance(ctx, admin, denom)
 	accountBalanceBefore := bankKeeper.GetBalance(ctx, account, denom)
-	err = ftKeeper.Clawback(ctx, admin, account, sdk.NewCoin(denom, sdkmath.NewInt(40)))
+	err = ftKeeper.Clawback(ctx, admin, account, nil, sdk.NewCoin(denom, sdkmath.NewInt(40)))
 	requireT.NoError(err)
 	issuerBalanceAfter := bankKeeper.GetBalance(ctx, admin, denom)
 	accountBalanceAfter := bankKeeper.GetBalance(ctx, account, denom)
@@ -2739,7 +2955,7 @@ func TestKeeper_TransferAdmin_Clawback(t *testing.T) {
 	// clawback frozen token, query balance
 	err = ftKeeper.Freeze(ctx, admin, account, sdk.NewCoin(denom, sdkmath.NewInt(60)))
 	requireT.NoError(err)
-	err = ftKeeper.Clawback(ctx, admin, account, sdk.NewCoin(denom, sdkmath.NewInt(60)))
+	err = ftKeeper.Clawback(ctx, admin, account, nil, sdk.NewCoin(denom, sdkmath.NewInt(60)))
 	requireT.NoError(err)
 	accountBalance := bankKeeper.GetBalance(ctx, account, denom)
 	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(0)), accountBalance)
@@ -3124,3 +3340,176 @@ func TestKeeper_ClearAdmin(t *testing.T) {
 	err = bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))))
 	requireT.NoError(err)
 }
+
+func TestKeeper_Issue_WithReferrer(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	bankKeeper := testApp.BankKeeper
+	stakingKeeper := testApp.StakingKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = constant.DenomDev
+	requireT.NoError(stakingKeeper.SetParams(ctx, stakingParams))
+
+	ftParams := types.DefaultParams()
+	ftParams.IssueFee = sdk.NewInt64Coin(constant.DenomDev, 1_000)
+	ftParams.ReferralFeeRatio = sdkmath.LegacyMustNewDecFromStr("0.25")
+	requireT.NoError(ftKeeper.SetParams(ctx, ftParams))
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	referrer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, issuer, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 2_000))))
+
+	supplyBefore := bankKeeper.GetSupply(ctx, constant.DenomDev)
+	for _, subunit := range []string{"abc", "def"} {
+		_, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(777),
+			Referrer:      referrer,
+		})
+		requireT.NoError(err)
+	}
+
+	// 25% of the fee is sent to the referrer, the rest is burnt
+	requireT.True(bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).IsZero())
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 500), bankKeeper.GetBalance(ctx, referrer, constant.DenomDev))
+	requireT.Equal(
+		supplyBefore.Sub(sdk.NewInt64Coin(constant.DenomDev, 1_500)),
+		bankKeeper.GetSupply(ctx, constant.DenomDev),
+	)
+
+	stats, err := ftKeeper.GetReferrerStats(ctx, referrer)
+	requireT.NoError(err)
+	requireT.Equal(types.ReferrerStats{
+		Referrer:          referrer.String(),
+		ReferredIssuances: 2,
+		FeesEarned:        sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 500)),
+	}, stats)
+
+	// stats of the account without referrals are empty
+	stats, err = ftKeeper.GetReferrerStats(ctx, issuer)
+	requireT.NoError(err)
+	requireT.Zero(stats.ReferredIssuances)
+	requireT.Empty(stats.FeesEarned)
+}
+
+func TestKeeper_FreezeRate(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	empty := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []types.Feature{types.Feature_freezing},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 101))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 40))))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, holder2, sdk.NewInt64Coin(denom, 30)))
+
+	rate := sdkmath.LegacyMustNewDecFromStr("0.5")
+
+	// only the admin can freeze
+	requireT.ErrorIs(
+		ftKeeper.FreezeRate(ctx, holder1, denom, rate, []sdk.AccAddress{holder1}), cosmoserrors.ErrUnauthorized,
+	)
+	// the admin's balance can't be frozen
+	requireT.ErrorIs(
+		ftKeeper.FreezeRate(ctx, issuer, denom, rate, []sdk.AccAddress{holder1, issuer}), cosmoserrors.ErrUnauthorized,
+	)
+	// the rate must not exceed 1
+	requireT.ErrorIs(
+		ftKeeper.FreezeRate(ctx, issuer, denom, sdkmath.LegacyNewDec(2), []sdk.AccAddress{holder1}),
+		types.ErrInvalidInput,
+	)
+
+	// the frozen amount is rounded down and replaces the previous one
+	requireT.NoError(ftKeeper.FreezeRate(ctx, issuer, denom, rate, []sdk.AccAddress{holder1, holder2, empty}))
+	for addr, expected := range map[string]string{
+		holder1.String(): "50",
+		holder2.String(): "20",
+		empty.String():   "0",
+	} {
+		frozen, err := ftKeeper.GetFrozenBalance(ctx, sdk.MustAccAddressFromBech32(addr), denom)
+		requireT.NoError(err)
+		requireT.Equal(expected, frozen.Amount.String())
+	}
+
+	// the frozen amount isn't updated once the balance changes
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	frozen, err := ftKeeper.GetFrozenBalance(ctx, holder1, denom)
+	requireT.NoError(err)
+	requireT.Equal("50", frozen.Amount.String())
+
+	// the zero rate unfreezes the balance
+	requireT.NoError(ftKeeper.FreezeRate(ctx, issuer, denom, sdkmath.LegacyZeroDec(), []sdk.AccAddress{holder1}))
+	frozen, err = ftKeeper.GetFrozenBalance(ctx, holder1, denom)
+	requireT.NoError(err)
+	requireT.True(frozen.Amount.IsZero())
+}
+
+func TestKeeper_SetRelativeWhitelistedBalance(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []types.Feature{types.Feature_whitelisting},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 100)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 60))))
+
+	// only the admin can set the limit
+	requireT.ErrorIs(
+		ftKeeper.SetRelativeWhitelistedBalance(ctx, recipient, recipient, sdk.NewInt64Coin(denom, 10)),
+		cosmoserrors.ErrUnauthorized,
+	)
+
+	// the limit is the current balance increased by the amount
+	requireT.NoError(ftKeeper.SetRelativeWhitelistedBalance(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 10)))
+	requireT.Equal("70", ftKeeper.GetWhitelistedBalance(ctx, recipient, denom).Amount.String())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+		types.ErrWhitelistedLimitExceeded,
+	)
+
+	// the zero relative amount blocks receiving more tokens
+	requireT.NoError(ftKeeper.SetRelativeWhitelistedBalance(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 0)))
+	requireT.Equal("70", ftKeeper.GetWhitelistedBalance(ctx, recipient, denom).Amount.String())
+}
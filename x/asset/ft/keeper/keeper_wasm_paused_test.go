@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+)
+
+func TestKeeper_Extension_WasmPaused(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	customParamsKeeper := testApp.CustomParamsKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	requireT.NoError(customParamsKeeper.SetWasmParams(ctx, customparamstypes.WasmParams{Paused: true}))
+
+	// the token with the extension can't be issued while the execution is paused
+	_, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "EXT",
+		Subunit:       "uext",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(100),
+		Features:      []types.Feature{types.Feature_extension},
+		ExtensionSettings: &types.ExtensionIssueSettings{
+			CodeId: 1,
+		},
+	})
+	requireT.ErrorIs(err, customparamstypes.ErrWasmPaused)
+
+	// the extension is attached directly to not depend on the contract
+	subunit := "uabc"
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       subunit,
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(100),
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	def, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	def.Features = append(def.Features, types.Feature_extension)
+	def.ExtensionCWAddress = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	requireT.NoError(ftKeeper.SetDefinition(ctx, issuer, subunit, def))
+
+	// the transfers are rejected by default
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+		customparamstypes.ErrWasmPaused,
+	)
+
+	// the transfers are executed as the plain ones bypassing the extension
+	requireT.NoError(customParamsKeeper.SetWasmParams(ctx, customparamstypes.WasmParams{
+		Paused:                  true,
+		PlainExtensionTransfers: true,
+	}))
+	requireT.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 10), bankKeeper.GetBalance(ctx, recipient, denom))
+
+	// the extension is called again once the execution is resumed
+	requireT.NoError(customParamsKeeper.SetWasmParams(ctx, customparamstypes.DefaultWasmParams()))
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))),
+		types.ErrExtensionCallFailed,
+	)
+}
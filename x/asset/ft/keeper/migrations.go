@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v4 "github.com/tokenize-x/tx-chain/v7/x/asset/ft/migrations/v4"
+	v6 "github.com/tokenize-x/tx-chain/v7/x/asset/ft/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,5 +23,11 @@ func NewMigrator(ftKeeper Keeper, paramsKeeper v4.ParamsKeeper) Migrator {
 
 // Migrate5to6 migrates from version 5 to 6.
 func (m Migrator) Migrate5to6(ctx sdk.Context) error {
-	return nil
+	if err := v6.MigrateParams(ctx, m.ftKeeper, m.ftKeeper.stakingKeeper); err != nil {
+		return err
+	}
+	if err := m.ftKeeper.InitSupplyBreakdowns(ctx); err != nil {
+		return err
+	}
+	return m.ftKeeper.PinExtensionCodes(ctx)
 }
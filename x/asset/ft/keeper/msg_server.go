@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -19,14 +20,24 @@ var _ types.MsgServer = MsgServer{}
 type MsgKeeper interface {
 	Issue(ctx sdk.Context, settings types.IssueSettings) (string, error)
 	Mint(ctx sdk.Context, sender, recipient sdk.AccAddress, coin sdk.Coin) error
+	MintToRecipients(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin, recipients []types.MintRecipient) error
 	Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error
 	Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	FreezeRate(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom string,
+		rate sdkmath.LegacyDec,
+		accounts []sdk.AccAddress,
+	) error
 	GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
-	Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	Clawback(ctx sdk.Context, sender, addr, recipient sdk.AccAddress, coin sdk.Coin) error
+	BatchClawback(ctx sdk.Context, sender, recipient sdk.AccAddress, entries []types.ClawbackEntry) error
 	SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	SetRelativeWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
 	ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	AddDelayedTokenUpgradeV1(ctx sdk.Context, sender sdk.AccAddress, denom string, ibcEnabled bool) error
@@ -43,6 +54,82 @@ type MsgKeeper interface {
 		denom string,
 		whitelistedDenoms []string,
 	) error
+	ClaimSymbol(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	ResolveSymbolClaim(ctx sdk.Context, authority, symbol string, approved bool) error
+	ReserveSymbol(ctx sdk.Context, issuer sdk.AccAddress, symbol, subunit string) error
+	GrantMintAllowance(
+		ctx sdk.Context,
+		sender, grantee sdk.AccAddress,
+		mintCap sdk.Coin,
+		period time.Duration,
+		expirationTime time.Time,
+	) error
+	RevokeMintAllowance(ctx sdk.Context, sender, grantee sdk.AccAddress, denom string) error
+	UpdateIssuePreset(ctx sdk.Context, authority string, preset types.IssuePreset) error
+	RemoveIssuePreset(ctx sdk.Context, authority, name string) error
+	SetDustPolicy(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom string,
+		threshold sdkmath.Int,
+		destination types.DustDestination,
+	) error
+	SetDustOptOut(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, optOut bool) error
+	SweepDust(ctx sdk.Context, sender sdk.AccAddress, denom string, accounts []sdk.AccAddress) error
+	BurnFrom(
+		ctx sdk.Context,
+		sender, addr sdk.AccAddress,
+		coin sdk.Coin,
+		nonce uint64,
+		expirationTime time.Time,
+		pubKey, signature []byte,
+	) error
+	IssueEscrowed(
+		ctx sdk.Context,
+		settings types.IssueSettings,
+		buyer sdk.AccAddress,
+		payment sdk.Coin,
+		settlementPeriod time.Duration,
+	) (string, error)
+	SettleEscrow(ctx sdk.Context, buyer sdk.AccAddress, denom string) error
+	SetSendRateLimit(
+		ctx sdk.Context,
+		account sdk.AccAddress,
+		denom string,
+		amount sdkmath.Int,
+		window time.Duration,
+	) error
+	UpdateFeatures(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom string,
+		enableFeatures, disableFeatures []types.Feature,
+	) error
+	PlaceLegalHold(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	ApproveLegalHoldRelease(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	SetObserverContract(ctx sdk.Context, sender sdk.AccAddress, denom string, contract sdk.AccAddress) error
+	SetFreezeExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, expirationTime time.Time) error
+	RemoveFreezeExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	AssignRole(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, role types.Role) error
+	RevokeRole(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, role types.Role) error
+	ScheduleRateChange(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom string,
+		burnRate, sendCommissionRate sdkmath.LegacyDec,
+		effectiveTime time.Time,
+	) error
+	StartRedenomination(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom, newSubunit, newSymbol string,
+		ratio uint64,
+		swapDeadline time.Time,
+	) (string, error)
+	Redenominate(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error
+	DesignateSuccessor(ctx sdk.Context, issuer, successor sdk.AccAddress, inactivityPeriod time.Duration) error
+	CancelIssuerRecovery(ctx sdk.Context, issuer sdk.AccAddress) error
+	ClaimIssuerRecovery(ctx sdk.Context, sender, issuer sdk.AccAddress) ([]string, error)
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -59,26 +146,11 @@ func NewMsgServer(keeper MsgKeeper) MsgServer {
 
 // Issue defines a tx handler to issue a new fungible token.
 func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.EmptyResponse, error) {
-	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	settings, err := issueSettings(req)
 	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid issuer in MsgIssue")
+		return nil, err
 	}
-	_, err = ms.keeper.Issue(sdk.UnwrapSDKContext(ctx), types.IssueSettings{
-		Issuer:             issuer,
-		Symbol:             req.Symbol,
-		Subunit:            req.Subunit,
-		Precision:          req.Precision,
-		Description:        req.Description,
-		InitialAmount:      req.InitialAmount,
-		Features:           req.Features,
-		BurnRate:           req.BurnRate,
-		SendCommissionRate: req.SendCommissionRate,
-		URI:                req.URI,
-		URIHash:            req.URIHash,
-		ExtensionSettings:  req.ExtensionSettings,
-		DEXSettings:        req.DEXSettings,
-	})
-	if err != nil {
+	if _, err := ms.keeper.Issue(sdk.UnwrapSDKContext(ctx), settings); err != nil {
 		return nil, err
 	}
 
@@ -93,6 +165,13 @@ func (ms MsgServer) Mint(goCtx context.Context, req *types.MsgMint) (*types.Empt
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
 	}
 
+	if len(req.Recipients) > 0 {
+		if err := ms.keeper.MintToRecipients(ctx, sender, req.Coin, req.Recipients); err != nil {
+			return nil, err
+		}
+		return &types.EmptyResponse{}, nil
+	}
+
 	recipient := sender
 	if req.Recipient != "" {
 		recipient, err = sdk.AccAddressFromBech32(req.Recipient)
@@ -188,6 +267,30 @@ func (ms MsgServer) SetFrozen(goCtx context.Context, req *types.MsgSetFrozen) (*
 	return &types.EmptyResponse{}, nil
 }
 
+// FreezeRate freezes the rate of the balances of the accounts.
+func (ms MsgServer) FreezeRate(goCtx context.Context, req *types.MsgFreezeRate) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	accounts := make([]sdk.AccAddress, 0, len(req.Accounts))
+	for _, account := range req.Accounts {
+		addr, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account address %s", account)
+		}
+		accounts = append(accounts, addr)
+	}
+
+	if err := ms.keeper.FreezeRate(ctx, sender, req.Denom, req.Rate, accounts); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // GloballyFreeze globally freezes fungible token.
 func (ms MsgServer) GloballyFreeze(goCtx context.Context, req *types.MsgGloballyFreeze) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -221,7 +324,7 @@ func (ms MsgServer) GloballyUnfreeze(
 	return &types.EmptyResponse{}, nil
 }
 
-// Clawback confiscates a part of fungible tokens from an account to the issuer.
+// Clawback confiscates a part of fungible tokens from an account to the admin or the recipient.
 func (ms MsgServer) Clawback(goCtx context.Context, req *types.MsgClawback) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(req.Sender)
@@ -234,8 +337,37 @@ func (ms MsgServer) Clawback(goCtx context.Context, req *types.MsgClawback) (*ty
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	err = ms.keeper.Clawback(ctx, sender, account, req.Coin)
+	var recipient sdk.AccAddress
+	if req.Recipient != "" {
+		if recipient, err = sdk.AccAddressFromBech32(req.Recipient); err != nil {
+			return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid recipient address")
+		}
+	}
+
+	err = ms.keeper.Clawback(ctx, sender, account, recipient, req.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BatchClawback confiscates the tokens from multiple accounts to the admin or the recipient atomically.
+func (ms MsgServer) BatchClawback(goCtx context.Context, req *types.MsgBatchClawback) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
 	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	var recipient sdk.AccAddress
+	if req.Recipient != "" {
+		if recipient, err = sdk.AccAddressFromBech32(req.Recipient); err != nil {
+			return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid recipient address")
+		}
+	}
+
+	if err := ms.keeper.BatchClawback(ctx, sender, recipient, req.Entries); err != nil {
 		return nil, err
 	}
 
@@ -258,7 +390,11 @@ func (ms MsgServer) SetWhitelistedLimit(
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	err = ms.keeper.SetWhitelistedBalance(ctx, sender, account, req.Coin)
+	if req.Relative {
+		err = ms.keeper.SetRelativeWhitelistedBalance(ctx, sender, account, req.Coin)
+	} else {
+		err = ms.keeper.SetWhitelistedBalance(ctx, sender, account, req.Coin)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -349,3 +485,593 @@ func (ms MsgServer) UpdateDEXWhitelistedDenoms(
 
 	return &types.EmptyResponse{}, nil
 }
+
+// ClaimSymbol claims the symbol of the token to be verified.
+func (ms MsgServer) ClaimSymbol(goCtx context.Context, req *types.MsgClaimSymbol) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.ClaimSymbol(ctx, sender, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ResolveSymbolClaim is a governance operation that approves or rejects the pending symbol claim.
+func (ms MsgServer) ResolveSymbolClaim(
+	goCtx context.Context,
+	req *types.MsgResolveSymbolClaim,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.ResolveSymbolClaim(
+		sdk.UnwrapSDKContext(goCtx), req.Authority, req.Symbol, req.Approved,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ReserveSymbol reserves the symbol and subunit for the issuer.
+func (ms MsgServer) ReserveSymbol(goCtx context.Context, req *types.MsgReserveSymbol) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid issuer address")
+	}
+
+	if err := ms.keeper.ReserveSymbol(ctx, issuer, req.Symbol, req.Subunit); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// GrantMintAllowance grants the mint allowance to the grantee.
+func (ms MsgServer) GrantMintAllowance(
+	goCtx context.Context,
+	req *types.MsgGrantMintAllowance,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid grantee address")
+	}
+
+	if err := ms.keeper.GrantMintAllowance(ctx, sender, grantee, req.Cap, req.Period, req.ExpirationTime); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeMintAllowance revokes the mint allowance of the grantee.
+func (ms MsgServer) RevokeMintAllowance(
+	goCtx context.Context,
+	req *types.MsgRevokeMintAllowance,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid grantee address")
+	}
+
+	if err := ms.keeper.RevokeMintAllowance(ctx, sender, grantee, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetIssuePreset is a governance operation that creates or replaces the issue preset.
+func (ms MsgServer) SetIssuePreset(
+	goCtx context.Context,
+	req *types.MsgSetIssuePreset,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateIssuePreset(sdk.UnwrapSDKContext(goCtx), req.Authority, req.Preset); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveIssuePreset is a governance operation that removes the issue preset.
+func (ms MsgServer) RemoveIssuePreset(
+	goCtx context.Context,
+	req *types.MsgRemoveIssuePreset,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.RemoveIssuePreset(sdk.UnwrapSDKContext(goCtx), req.Authority, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetDustPolicy sets the dust policy of the token.
+func (ms MsgServer) SetDustPolicy(
+	goCtx context.Context,
+	req *types.MsgSetDustPolicy,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.SetDustPolicy(ctx, sender, req.Denom, req.Threshold, req.Destination); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetDustOptOut excludes the account from the dust sweeping or includes it back.
+func (ms MsgServer) SetDustOptOut(
+	goCtx context.Context,
+	req *types.MsgSetDustOptOut,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.SetDustOptOut(ctx, sender, account, req.Denom, req.OptOut); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SweepDust sweeps the dust balances of the accounts.
+func (ms MsgServer) SweepDust(
+	goCtx context.Context,
+	req *types.MsgSweepDust,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	accounts := make([]sdk.AccAddress, 0, len(req.Accounts))
+	for _, account := range req.Accounts {
+		addr, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account address %s", account)
+		}
+		accounts = append(accounts, addr)
+	}
+
+	if err := ms.keeper.SweepDust(ctx, sender, req.Denom, accounts); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BurnFrom burns the coins from the account with the burn permit signed by the account.
+func (ms MsgServer) BurnFrom(
+	goCtx context.Context,
+	req *types.MsgBurnFrom,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.BurnFrom(
+		ctx, sender, account, req.Coin, req.Nonce, req.ExpirationTime, req.PubKey, req.Signature,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// IssueEscrowed issues new fungible token with the initial supply escrowed for the buyer.
+func (ms MsgServer) IssueEscrowed(
+	goCtx context.Context,
+	req *types.MsgIssueEscrowed,
+) (*types.EmptyResponse, error) {
+	settings, err := issueSettings(&req.Issue)
+	if err != nil {
+		return nil, err
+	}
+	buyer, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid buyer address")
+	}
+
+	if _, err := ms.keeper.IssueEscrowed(
+		sdk.UnwrapSDKContext(goCtx), settings, buyer, req.Payment, req.SettlementPeriod,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SettleEscrow pays for the escrowed initial supply of the token and releases it to the buyer.
+func (ms MsgServer) SettleEscrow(
+	goCtx context.Context,
+	req *types.MsgSettleEscrow,
+) (*types.EmptyResponse, error) {
+	buyer, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid buyer address")
+	}
+
+	if err := ms.keeper.SettleEscrow(sdk.UnwrapSDKContext(goCtx), buyer, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetSendRateLimit sets the send rate limit of the sender.
+func (ms MsgServer) SetSendRateLimit(
+	goCtx context.Context,
+	req *types.MsgSetSendRateLimit,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.SetSendRateLimit(
+		sdk.UnwrapSDKContext(goCtx), sender, req.Denom, req.Amount, req.Window,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateFeatures updates the features of the token.
+func (ms MsgServer) UpdateFeatures(
+	goCtx context.Context,
+	req *types.MsgUpdateFeatures,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.UpdateFeatures(
+		sdk.UnwrapSDKContext(goCtx), sender, req.Denom, req.EnableFeatures, req.DisableFeatures,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// PlaceLegalHold places the legal hold on the account.
+func (ms MsgServer) PlaceLegalHold(goCtx context.Context, req *types.MsgPlaceLegalHold) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.PlaceLegalHold(sdk.UnwrapSDKContext(goCtx), sender, account, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ApproveLegalHoldRelease approves the release of the legal hold.
+func (ms MsgServer) ApproveLegalHoldRelease(
+	goCtx context.Context,
+	req *types.MsgApproveLegalHoldRelease,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.ApproveLegalHoldRelease(sdk.UnwrapSDKContext(goCtx), sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetObserverContract sets the observer contract of the token.
+func (ms MsgServer) SetObserverContract(
+	goCtx context.Context,
+	req *types.MsgSetObserverContract,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	var contract sdk.AccAddress
+	if req.Contract != "" {
+		if contract, err = sdk.AccAddressFromBech32(req.Contract); err != nil {
+			return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid contract address")
+		}
+	}
+
+	if err := ms.keeper.SetObserverContract(sdk.UnwrapSDKContext(goCtx), sender, req.Denom, contract); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetFreezeExemption exempts the account from the global freeze of the token.
+func (ms MsgServer) SetFreezeExemption(
+	goCtx context.Context,
+	req *types.MsgSetFreezeExemption,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.SetFreezeExemption(
+		sdk.UnwrapSDKContext(goCtx), sender, account, req.Denom, req.ExpirationTime,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveFreezeExemption removes the exemption of the account from the global freeze of the token.
+func (ms MsgServer) RemoveFreezeExemption(
+	goCtx context.Context,
+	req *types.MsgRemoveFreezeExemption,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.RemoveFreezeExemption(sdk.UnwrapSDKContext(goCtx), sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// AssignRole assigns the role of the token to the account.
+func (ms MsgServer) AssignRole(
+	goCtx context.Context,
+	req *types.MsgAssignRole,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.AssignRole(sdk.UnwrapSDKContext(goCtx), sender, account, req.Denom, req.Role); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeRole revokes the role of the token from the account.
+func (ms MsgServer) RevokeRole(
+	goCtx context.Context,
+	req *types.MsgRevokeRole,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.RevokeRole(sdk.UnwrapSDKContext(goCtx), sender, account, req.Denom, req.Role); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ScheduleRateChange schedules the change of the rates of the token.
+func (ms MsgServer) ScheduleRateChange(
+	goCtx context.Context,
+	req *types.MsgScheduleRateChange,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.ScheduleRateChange(
+		sdk.UnwrapSDKContext(goCtx), sender, req.Denom, req.BurnRate, req.SendCommissionRate, req.EffectiveTime,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// StartRedenomination starts the redenomination of the token.
+func (ms MsgServer) StartRedenomination(
+	goCtx context.Context,
+	req *types.MsgStartRedenomination,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := ms.keeper.StartRedenomination(
+		sdk.UnwrapSDKContext(goCtx), sender, req.Denom, req.NewSubunit, req.NewSymbol, req.Ratio, req.SwapDeadline,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Redenominate swaps the old token for the new one.
+func (ms MsgServer) Redenominate(
+	goCtx context.Context,
+	req *types.MsgRedenominate,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.Redenominate(sdk.UnwrapSDKContext(goCtx), sender, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetIssuerRecovery designates the successor of the issuer.
+func (ms MsgServer) SetIssuerRecovery(
+	goCtx context.Context,
+	req *types.MsgSetIssuerRecovery,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	successor, err := sdk.AccAddressFromBech32(req.Successor)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid successor address")
+	}
+
+	if err := ms.keeper.DesignateSuccessor(
+		sdk.UnwrapSDKContext(goCtx), sender, successor, req.InactivityPeriod,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CancelIssuerRecovery cancels the designation of the successor of the issuer.
+func (ms MsgServer) CancelIssuerRecovery(
+	goCtx context.Context,
+	req *types.MsgCancelIssuerRecovery,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.CancelIssuerRecovery(sdk.UnwrapSDKContext(goCtx), sender); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClaimIssuerRecovery transfers the admin rights of the tokens of the inactive issuer to the successor.
+func (ms MsgServer) ClaimIssuerRecovery(
+	goCtx context.Context,
+	req *types.MsgClaimIssuerRecovery,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid issuer address")
+	}
+
+	if _, err := ms.keeper.ClaimIssuerRecovery(sdk.UnwrapSDKContext(goCtx), sender, issuer); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+func issueSettings(req *types.MsgIssue) (types.IssueSettings, error) {
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return types.IssueSettings{}, sdkerrors.Wrap(types.ErrInvalidInput, "invalid issuer in MsgIssue")
+	}
+	var referrer sdk.AccAddress
+	if req.Referrer != "" {
+		referrer, err = sdk.AccAddressFromBech32(req.Referrer)
+		if err != nil {
+			return types.IssueSettings{}, sdkerrors.Wrap(types.ErrInvalidInput, "invalid referrer in MsgIssue")
+		}
+	}
+
+	return types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             req.Symbol,
+		Subunit:            req.Subunit,
+		Precision:          req.Precision,
+		Description:        req.Description,
+		InitialAmount:      req.InitialAmount,
+		Features:           req.Features,
+		BurnRate:           req.BurnRate,
+		SendCommissionRate: req.SendCommissionRate,
+		URI:                req.URI,
+		URIHash:            req.URIHash,
+		ExtensionSettings:  req.ExtensionSettings,
+		DEXSettings:        req.DEXSettings,
+		Referrer:           referrer,
+		Preset:             req.Preset,
+	}, nil
+}
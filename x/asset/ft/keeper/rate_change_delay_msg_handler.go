@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// RateChangeKeeper defines methods required to apply the pending rate changes.
+type RateChangeKeeper interface {
+	ApplyPendingRateChange(ctx sdk.Context, data *types.DelayedRateChange) error
+}
+
+// NewDelayRateChangeHandler handles the pending rate change.
+func NewDelayRateChangeHandler(
+	keeper RateChangeKeeper,
+) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.DelayedRateChange)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.ApplyPendingRateChange(ctx, msg)
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// RedenominationFinalizationKeeper defines methods required to finalize the redenominations.
+type RedenominationFinalizationKeeper interface {
+	FinalizeRedenomination(ctx sdk.Context, data *types.DelayedRedenominationFinalization) error
+}
+
+// NewDelayRedenominationFinalizationHandler handles the redenomination finalization.
+func NewDelayRedenominationFinalizationHandler(
+	keeper RedenominationFinalizationKeeper,
+) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.DelayedRedenominationFinalization)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.FinalizeRedenomination(ctx, msg)
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// SendRateLimitChangeKeeper defines methods required to apply the pending send rate limit changes.
+type SendRateLimitChangeKeeper interface {
+	ApplyPendingSendRateLimitChange(ctx sdk.Context, data *types.DelayedSendRateLimitChange) error
+}
+
+// NewDelaySendRateLimitChangeHandler handles the pending send rate limit change.
+func NewDelaySendRateLimitChangeHandler(
+	keeper SendRateLimitChangeKeeper,
+) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.DelayedSendRateLimitChange)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.ApplyPendingSendRateLimitChange(ctx, msg)
+	}
+}
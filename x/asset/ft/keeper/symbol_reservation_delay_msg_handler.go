@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// SymbolReservationExpirationKeeper defines methods required to expire the symbol reservations.
+type SymbolReservationExpirationKeeper interface {
+	ExpireSymbolReservation(ctx sdk.Context, data *types.DelayedSymbolReservationExpiration) error
+}
+
+// NewDelaySymbolReservationExpirationHandler handles the symbol reservation expiration.
+func NewDelaySymbolReservationExpirationHandler(
+	keeper SymbolReservationExpirationKeeper,
+) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.DelayedSymbolReservationExpiration)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.ExpireSymbolReservation(ctx, msg)
+	}
+}
@@ -8,18 +8,47 @@ import (
 var (
 	//go:embed asset-extension/artifacts/asset_extension.wasm
 	AssetExtensionWasm []byte
+	//go:embed extension-reject-all/artifacts/extension_reject_all.wasm
+	RejectAllWasm []byte
+	//go:embed extension-commission-override/artifacts/extension_commission_override.wasm
+	CommissionOverrideWasm []byte
+	//go:embed extension-ibc-block/artifacts/extension_ibc_block.wasm
+	IBCBlockWasm []byte
+	//go:embed extension-gas-burner/artifacts/extension_gas_burner.wasm
+	GasBurnerWasm []byte
 )
 
 // Check contract.rs for constant values defined in contract.
+// The burn rate, send commission and IBC behaviours are covered by the focused contracts. The remaining triggers
+// are kept because the tests using them need the same token to both accept and reject transfers, or to let the
+// extension mint, burn and inspect the calling smart contract and the DEX orders, which no focused contract does.
 const (
-	AmountDisallowedTrigger               = 7
-	AmountBurningTrigger                  = 101
-	AmountMintingTrigger                  = 105
-	AmountIgnoreBurnRateTrigger           = 108
-	AmountIgnoreSendCommissionRateTrigger = 109
-	AmountBlockIBCTrigger                 = 110
-	AmountBlockSmartContractTrigger       = 111
-	IDDEXOrderSuffixTrigger               = "blocked"
-	AmountDEXExpectToSpendTrigger         = 103_000_000
-	AmountDEXExpectToReceiveTrigger       = 104_000_000
+	AmountDisallowedTrigger         = 7
+	AmountBurningTrigger            = 101
+	AmountMintingTrigger            = 105
+	AmountBlockSmartContractTrigger = 111
+	IDDEXOrderSuffixTrigger         = "blocked"
+	AmountDEXExpectToSpendTrigger   = 103_000_000
+	AmountDEXExpectToReceiveTrigger = 104_000_000
 )
+
+// Check error.rs of the focused contracts for the errors returned by them.
+const (
+	RejectAllTransferError = "Transfers are rejected by the extension."
+	RejectAllDEXOrderError = "DEX orders are rejected by the extension."
+	IBCBlockError          = "IBC transfers are blocked by the extension."
+)
+
+// CommissionOverrideIssuanceMsg is the issuance message of the commission override contract.
+type CommissionOverrideIssuanceMsg struct {
+	// IgnoreBurnRate refunds the burn amount to the sender instead of burning it.
+	IgnoreBurnRate bool `json:"ignore_burn_rate"`
+	// IgnoreSendCommissionRate refunds the send commission to the sender instead of sending it to the admin.
+	IgnoreSendCommissionRate bool `json:"ignore_send_commission_rate"`
+}
+
+// GasBurnerIssuanceMsg is the issuance message of the gas burner contract.
+type GasBurnerIssuanceMsg struct {
+	// Iterations is the number of storage writes executed by the contract on each transfer.
+	Iterations uint64 `json:"iterations"`
+}
@@ -0,0 +1,182 @@
+use crate::error::ContractError;
+use crate::msg::{DEXOrder, ExecuteMsg, InstantiateMsg, QueryMsg, SudoMsg, TransferContext};
+use crate::state::{DENOM, ISSUANCE_MSG};
+use cosmwasm_std::{entry_point, to_json_binary, CosmosMsg};
+use cosmwasm_std::{Binary, Deps, DepsMut, Env, MessageInfo, Response, StdResult, Uint128};
+use cw2::set_contract_version;
+use tx_wasm_sdk::types::cosmos::bank::v1beta1::MsgSend;
+use tx_wasm_sdk::types::cosmos::base::v1beta1::Coin;
+use tx_wasm_sdk::types::tx::asset::ft::v1::{
+    MsgBurn, QueryTokenRequest, QueryTokenResponse, Token,
+};
+
+// version info for migration info
+const CONTRACT_NAME: &str = env!("CARGO_PKG_NAME");
+const CONTRACT_VERSION: &str = env!("CARGO_PKG_VERSION");
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn instantiate(
+    deps: DepsMut,
+    _env: Env,
+    info: MessageInfo,
+    msg: InstantiateMsg,
+) -> Result<Response, ContractError> {
+    set_contract_version(deps.storage, CONTRACT_NAME, CONTRACT_VERSION)?;
+
+    DENOM.save(deps.storage, &msg.denom)?;
+    ISSUANCE_MSG.save(deps.storage, &msg.issuance_msg)?;
+
+    Ok(Response::new()
+        .add_attribute("method", "instantiate")
+        .add_attribute("owner", info.sender))
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn execute(
+    _deps: DepsMut,
+    _env: Env,
+    _info: MessageInfo,
+    msg: ExecuteMsg,
+) -> Result<Response, ContractError> {
+    match msg {}
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn query(deps: Deps, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
+    match msg {
+        QueryMsg::QueryIssuanceMsg {} => to_json_binary(&ISSUANCE_MSG.load(deps.storage)?),
+    }
+}
+
+#[entry_point]
+pub fn sudo(deps: DepsMut, env: Env, msg: SudoMsg) -> Result<Response, ContractError> {
+    match msg {
+        SudoMsg::ExtensionTransfer {
+            sender,
+            recipient,
+            transfer_amount,
+            commission_amount,
+            burn_amount,
+            context,
+        } => sudo_extension_transfer(
+            deps,
+            env,
+            transfer_amount,
+            sender,
+            recipient,
+            commission_amount,
+            burn_amount,
+            context,
+        ),
+        SudoMsg::ExtensionPlaceOrder {
+            order,
+            spent,
+            received,
+        } => sudo_extension_place_order(order, spent, received),
+    }
+}
+
+// The extension forwards the transfers and overrides the handling of the send commission and burn amount configured
+// by the issuance message. The ignored amounts are refunded to the sender, otherwise the send commission is sent to
+// the admin and the burn amount is burnt.
+#[allow(clippy::too_many_arguments)]
+pub fn sudo_extension_transfer(
+    deps: DepsMut,
+    env: Env,
+    amount: Uint128,
+    sender: String,
+    recipient: String,
+    commission_amount: Uint128,
+    burn_amount: Uint128,
+    _context: TransferContext,
+) -> Result<Response, ContractError> {
+    if amount.is_zero() {
+        return Err(ContractError::InvalidAmountError {});
+    }
+
+    let rsp = Response::new().add_attribute("method", "execute_transfer");
+    let contract = env.contract.address.as_str();
+    if recipient == contract {
+        return Ok(rsp.add_attribute("skip_checks", "self_recipient"));
+    }
+
+    let denom = DENOM.load(deps.storage)?;
+    let settings = ISSUANCE_MSG.load(deps.storage)?;
+
+    let mut response = rsp.add_message(send_msg(contract, &recipient, &denom, amount));
+
+    if !commission_amount.is_zero() {
+        if settings.ignore_send_commission_rate {
+            response = response
+                .add_attribute("send_commission_rate_refund", commission_amount.to_string())
+                .add_message(send_msg(contract, &sender, &denom, commission_amount));
+        } else {
+            let token = query_token(deps.as_ref(), &denom)?;
+            // the commission is kept by the extension if the token has no admin
+            if !token.admin.is_empty() {
+                response = response
+                    .add_attribute(
+                        "admin_send_commission_amount",
+                        commission_amount.to_string(),
+                    )
+                    .add_message(send_msg(contract, &token.admin, &denom, commission_amount));
+            }
+        }
+    }
+
+    if !burn_amount.is_zero() {
+        if settings.ignore_burn_rate {
+            response = response
+                .add_attribute("burn_rate_refund", burn_amount.to_string())
+                .add_message(send_msg(contract, &sender, &denom, burn_amount));
+        } else {
+            response = response
+                .add_attribute("burn_amount", burn_amount)
+                .add_message(burn_msg(contract, &denom, burn_amount));
+        }
+    }
+
+    Ok(response)
+}
+
+pub fn sudo_extension_place_order(
+    order: DEXOrder,
+    _spent: Coin,
+    _received: Coin,
+) -> Result<Response, ContractError> {
+    Ok(Response::new()
+        .add_attribute("method", "extension_place_order")
+        .add_attribute("order_id", order.id))
+}
+
+fn send_msg(contract: &str, recipient: &str, denom: &str, amount: Uint128) -> CosmosMsg {
+    let msg = MsgSend {
+        from_address: contract.to_string(),
+        to_address: recipient.to_string(),
+        amount: [Coin {
+            denom: denom.to_string(),
+            amount: amount.to_string(),
+        }]
+        .to_vec(),
+    };
+    CosmosMsg::Any(msg.to_any())
+}
+
+fn burn_msg(contract: &str, denom: &str, amount: Uint128) -> CosmosMsg {
+    let msg = MsgBurn {
+        sender: contract.to_string(),
+        coin: Some(Coin {
+            denom: denom.to_string(),
+            amount: amount.to_string(),
+        }),
+    };
+    CosmosMsg::Any(msg.to_any())
+}
+
+fn query_token(deps: Deps, denom: &str) -> StdResult<Token> {
+    let request = QueryTokenRequest {
+        denom: denom.to_string(),
+    };
+    let token: QueryTokenResponse = request.query(&deps.querier)?;
+    Ok(token.token.unwrap_or_default())
+}
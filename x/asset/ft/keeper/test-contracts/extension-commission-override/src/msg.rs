@@ -0,0 +1,77 @@
+use cosmwasm_schema::{cw_serde, QueryResponses};
+use cosmwasm_std::Uint128;
+use tx_wasm_sdk::types::cosmos::base::v1beta1::Coin;
+
+#[cw_serde]
+pub struct InstantiateMsg {
+    pub denom: String,
+    pub issuance_msg: IssuanceMsg,
+}
+
+#[cw_serde]
+#[derive(Default)]
+pub struct IssuanceMsg {
+    // refunds the burn amount to the sender instead of burning it
+    #[serde(default)]
+    pub ignore_burn_rate: bool,
+    // refunds the send commission to the sender instead of sending it to the admin
+    #[serde(default)]
+    pub ignore_send_commission_rate: bool,
+}
+
+#[cw_serde]
+pub enum ExecuteMsg {}
+
+#[cw_serde]
+#[derive(QueryResponses)]
+pub enum QueryMsg {
+    #[returns(IssuanceMsg)]
+    QueryIssuanceMsg {},
+}
+
+#[cw_serde]
+pub struct DEXOrder {
+    pub creator: String,
+    #[serde(rename = "type")]
+    pub order_type: String,
+    pub id: String,
+    pub sequence: u64,
+    pub base_denom: String,
+    pub quote_denom: String,
+    pub price: Option<String>,
+    pub quantity: Uint128,
+    pub side: String,
+}
+
+#[cw_serde]
+pub enum SudoMsg {
+    ExtensionTransfer {
+        recipient: String,
+        sender: String,
+        transfer_amount: Uint128,
+        commission_amount: Uint128,
+        burn_amount: Uint128,
+        context: TransferContext,
+    },
+    ExtensionPlaceOrder {
+        order: DEXOrder,
+        spent: Coin,
+        received: Coin,
+    },
+}
+
+#[cw_serde]
+pub struct TransferContext {
+    pub sender_is_smart_contract: bool,
+    pub recipient_is_smart_contract: bool,
+    pub ibc_purpose: IBCPurpose,
+}
+
+#[cw_serde]
+pub enum IBCPurpose {
+    None,
+    Out,
+    In,
+    Ack,
+    Timeout,
+}
@@ -0,0 +1,5 @@
+#![allow(deprecated)]
+pub mod contract;
+pub mod error;
+pub mod msg;
+pub mod state;
@@ -0,0 +1,6 @@
+use crate::msg::IssuanceMsg;
+use cw_storage_plus::Item;
+
+pub const DENOM: Item<String> = Item::new("state");
+pub const ISSUANCE_MSG: Item<IssuanceMsg> = Item::new("issuance_msg");
+pub const COUNTER: Item<u64> = Item::new("counter");
@@ -0,0 +1,14 @@
+use cosmwasm_std::StdError;
+use thiserror::Error;
+
+#[derive(Error, Debug)]
+pub enum ContractError {
+    #[error("{0}")]
+    Std(#[from] StdError),
+
+    #[error("Invalid amount.")]
+    InvalidAmountError {},
+
+    #[error("IBC transfers are blocked by the extension.")]
+    IBCBlocked {},
+}
@@ -0,0 +1,5 @@
+use crate::msg::IssuanceMsg;
+use cw_storage_plus::Item;
+
+pub const DENOM: Item<String> = Item::new("state");
+pub const ISSUANCE_MSG: Item<IssuanceMsg> = Item::new("issuance_msg");
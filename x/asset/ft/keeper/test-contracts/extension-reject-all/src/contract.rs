@@ -0,0 +1,108 @@
+use crate::error::ContractError;
+use crate::msg::{DEXOrder, ExecuteMsg, InstantiateMsg, QueryMsg, SudoMsg, TransferContext};
+use crate::state::{DENOM, ISSUANCE_MSG};
+use cosmwasm_std::entry_point;
+use cosmwasm_std::{
+    to_json_binary, Binary, Deps, DepsMut, Env, MessageInfo, Response, StdResult, Uint128,
+};
+use cw2::set_contract_version;
+use tx_wasm_sdk::types::cosmos::base::v1beta1::Coin;
+
+// version info for migration info
+const CONTRACT_NAME: &str = env!("CARGO_PKG_NAME");
+const CONTRACT_VERSION: &str = env!("CARGO_PKG_VERSION");
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn instantiate(
+    deps: DepsMut,
+    _env: Env,
+    info: MessageInfo,
+    msg: InstantiateMsg,
+) -> Result<Response, ContractError> {
+    set_contract_version(deps.storage, CONTRACT_NAME, CONTRACT_VERSION)?;
+
+    DENOM.save(deps.storage, &msg.denom)?;
+    ISSUANCE_MSG.save(deps.storage, &msg.issuance_msg)?;
+
+    Ok(Response::new()
+        .add_attribute("method", "instantiate")
+        .add_attribute("owner", info.sender))
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn execute(
+    _deps: DepsMut,
+    _env: Env,
+    _info: MessageInfo,
+    msg: ExecuteMsg,
+) -> Result<Response, ContractError> {
+    match msg {}
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn query(deps: Deps, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
+    match msg {
+        QueryMsg::QueryIssuanceMsg {} => to_json_binary(&ISSUANCE_MSG.load(deps.storage)?),
+    }
+}
+
+#[entry_point]
+pub fn sudo(deps: DepsMut, env: Env, msg: SudoMsg) -> Result<Response, ContractError> {
+    match msg {
+        SudoMsg::ExtensionTransfer {
+            sender,
+            recipient,
+            transfer_amount,
+            commission_amount,
+            burn_amount,
+            context,
+        } => sudo_extension_transfer(
+            deps,
+            env,
+            transfer_amount,
+            sender,
+            recipient,
+            commission_amount,
+            burn_amount,
+            context,
+        ),
+        SudoMsg::ExtensionPlaceOrder {
+            order,
+            spent,
+            received,
+        } => sudo_extension_place_order(order, spent, received),
+    }
+}
+
+// The extension rejects all the transfers except the ones sent to the extension itself.
+#[allow(clippy::too_many_arguments)]
+pub fn sudo_extension_transfer(
+    _deps: DepsMut,
+    env: Env,
+    amount: Uint128,
+    _sender: String,
+    recipient: String,
+    _commission_amount: Uint128,
+    _burn_amount: Uint128,
+    _context: TransferContext,
+) -> Result<Response, ContractError> {
+    if amount.is_zero() {
+        return Err(ContractError::InvalidAmountError {});
+    }
+
+    if recipient == env.contract.address.as_str() {
+        return Ok(Response::new()
+            .add_attribute("method", "execute_transfer")
+            .add_attribute("skip_checks", "self_recipient"));
+    }
+
+    Err(ContractError::TransferRejected {})
+}
+
+pub fn sudo_extension_place_order(
+    _order: DEXOrder,
+    _spent: Coin,
+    _received: Coin,
+) -> Result<Response, ContractError> {
+    Err(ContractError::DEXOrderRejected {})
+}
@@ -0,0 +1,17 @@
+use cosmwasm_std::StdError;
+use thiserror::Error;
+
+#[derive(Error, Debug)]
+pub enum ContractError {
+    #[error("{0}")]
+    Std(#[from] StdError),
+
+    #[error("Invalid amount.")]
+    InvalidAmountError {},
+
+    #[error("Transfers are rejected by the extension.")]
+    TransferRejected {},
+
+    #[error("DEX orders are rejected by the extension.")]
+    DEXOrderRejected {},
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+type namedTransferListener struct {
+	name     string
+	listener types.TransferListener
+}
+
+// transferListeners is shared by all the copies of the keeper, so the listeners registered after the keeper is
+// passed to the dependent keepers are still invoked.
+type transferListeners struct {
+	listeners []namedTransferListener
+}
+
+// RegisterTransferListener registers the listener observing the fungible token transfers, mints and burns.
+// The listeners are invoked in the registration order. It must be called during the app initialization only.
+func (k Keeper) RegisterTransferListener(name string, listener types.TransferListener) {
+	for _, l := range k.transferListeners.listeners {
+		if l.name == name {
+			panic(fmt.Sprintf("transfer listener %q is already registered", name))
+		}
+	}
+	k.transferListeners.listeners = append(k.transferListeners.listeners, namedTransferListener{
+		name:     name,
+		listener: listener,
+	})
+}
+
+func (k Keeper) notifyTransfer(
+	ctx sdk.Context,
+	def types.Definition,
+	sender, recipient sdk.AccAddress,
+	coin sdk.Coin,
+) {
+	k.notifyTransferListeners(ctx, "OnTransfer", func(ctx sdk.Context, listener types.TransferListener) error {
+		return listener.OnTransfer(ctx, sender, recipient, coin)
+	})
+	k.notifyObserver(ctx, def, sender, recipient, coin)
+}
+
+func (k Keeper) notifyMint(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) {
+	k.notifyTransferListeners(ctx, "OnMint", func(ctx sdk.Context, listener types.TransferListener) error {
+		return listener.OnMint(ctx, recipient, coin)
+	})
+}
+
+func (k Keeper) notifyBurn(ctx sdk.Context, account sdk.AccAddress, coin sdk.Coin) {
+	k.notifyTransferListeners(ctx, "OnBurn", func(ctx sdk.Context, listener types.TransferListener) error {
+		return listener.OnBurn(ctx, account, coin)
+	})
+}
+
+func (k Keeper) notifyTransferListeners(
+	ctx sdk.Context,
+	method string,
+	call func(ctx sdk.Context, listener types.TransferListener) error,
+) {
+	for _, l := range k.transferListeners.listeners {
+		if err := invokeTransferListener(ctx, l.listener, call); err != nil {
+			k.logger.Error(
+				"transfer listener failed",
+				"listener", l.name,
+				"method", method,
+				"error", err,
+			)
+		}
+	}
+}
+
+// invokeTransferListener calls the listener on the cached context, so its state changes are discarded if it fails.
+func invokeTransferListener(
+	ctx sdk.Context,
+	listener types.TransferListener,
+	call func(ctx sdk.Context, listener types.TransferListener) error,
+) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			// the out of gas panic must reach the ante handler to fail the tx
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				panic(r)
+			}
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+
+	if err := call(cacheCtx, listener); err != nil {
+		return err
+	}
+	writeCache()
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+type recordedCall struct {
+	listener  string
+	method    string
+	sender    string
+	recipient string
+	coin      sdk.Coin
+}
+
+type recordingTransferListener struct {
+	name  string
+	calls *[]recordedCall
+	fail  func(ctx sdk.Context) error
+}
+
+func (l recordingTransferListener) OnTransfer(
+	ctx sdk.Context, sender, recipient sdk.AccAddress, coin sdk.Coin,
+) error {
+	return l.record(ctx, recordedCall{
+		method: "OnTransfer", sender: sender.String(), recipient: recipient.String(), coin: coin,
+	})
+}
+
+func (l recordingTransferListener) OnMint(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) error {
+	return l.record(ctx, recordedCall{method: "OnMint", recipient: recipient.String(), coin: coin})
+}
+
+func (l recordingTransferListener) OnBurn(ctx sdk.Context, account sdk.AccAddress, coin sdk.Coin) error {
+	return l.record(ctx, recordedCall{method: "OnBurn", sender: account.String(), coin: coin})
+}
+
+func (l recordingTransferListener) record(ctx sdk.Context, call recordedCall) error {
+	call.listener = l.name
+	*l.calls = append(*l.calls, call)
+	if l.fail != nil {
+		return l.fail(ctx)
+	}
+
+	return nil
+}
+
+func TestKeeper_TransferListeners(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	marker := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	var calls []recordedCall
+	// the failing listeners write the state before failing to verify that it is discarded
+	ftKeeper.RegisterTransferListener("panicking", recordingTransferListener{
+		name:  "panicking",
+		calls: &calls,
+		fail: func(ctx sdk.Context) error {
+			requireT.NoError(testApp.FundAccount(ctx, marker, sdk.NewCoins(sdk.NewInt64Coin("marker", 1))))
+			panic("listener panic")
+		},
+	})
+	ftKeeper.RegisterTransferListener("failing", recordingTransferListener{
+		name:  "failing",
+		calls: &calls,
+		fail: func(ctx sdk.Context) error {
+			requireT.NoError(testApp.FundAccount(ctx, marker, sdk.NewCoins(sdk.NewInt64Coin("marker", 1))))
+			return errors.New("listener error")
+		},
+	})
+	ftKeeper.RegisterTransferListener("recording", recordingTransferListener{
+		name:  "recording",
+		calls: &calls,
+	})
+	requireT.Panics(func() {
+		ftKeeper.RegisterTransferListener("recording", recordingTransferListener{name: "recording", calls: &calls})
+	})
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(100),
+		Features:      []types.Feature{types.Feature_minting, types.Feature_burning},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))))
+	requireT.NoError(ftKeeper.Burn(ctx, issuer, sdk.NewInt64Coin(denom, 30)))
+
+	// the failing transfer is not notified
+	requireT.Error(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+	// the movements are executed and the listeners state changes are discarded
+	requireT.Equal(sdk.NewInt64Coin(denom, 50), bankKeeper.GetBalance(ctx, issuer, denom))
+	requireT.Equal(sdk.NewInt64Coin(denom, 30), bankKeeper.GetBalance(ctx, recipient, denom))
+	requireT.True(bankKeeper.GetBalance(ctx, marker, "marker").IsZero())
+
+	expectedCalls := make([]recordedCall, 0)
+	for _, call := range []recordedCall{
+		{method: "OnMint", recipient: issuer.String(), coin: sdk.NewInt64Coin(denom, 100)},
+		{method: "OnMint", recipient: recipient.String(), coin: sdk.NewInt64Coin(denom, 10)},
+		{
+			method: "OnTransfer", sender: issuer.String(), recipient: recipient.String(),
+			coin: sdk.NewInt64Coin(denom, 20),
+		},
+		{method: "OnBurn", sender: issuer.String(), coin: sdk.NewInt64Coin(denom, 30)},
+	} {
+		// the listeners are invoked in the registration order
+		for _, listener := range []string{"panicking", "failing", "recording"} {
+			call.listener = listener
+			expectedCalls = append(expectedCalls, call)
+		}
+	}
+	requireT.Equal(expectedCalls, calls)
+}
@@ -0,0 +1,52 @@
+package v6
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+// FTKeeper represents ft keeper.
+type FTKeeper interface {
+	GetParams(ctx sdk.Context) (types.Params, error)
+	SetParams(ctx sdk.Context, params types.Params) error
+}
+
+// StakingKeeper represents staking keeper.
+type StakingKeeper interface {
+	GetParams(ctx context.Context) (params stakingtypes.Params, err error)
+}
+
+// MigrateParams sets the symbol claim, referral, symbol reservation, send rate limit, feature update, extension
+// code pinning, buyback, observer, rate change and issuer recovery params introduced in this version.
+func MigrateParams(ctx sdk.Context, keeper FTKeeper, stakingKeeper StakingKeeper) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	stakingParams, err := stakingKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.SymbolClaimDeposit = sdk.NewInt64Coin(stakingParams.BondDenom, 0)
+	params.ReferralFeeRatio = sdkmath.LegacyZeroDec()
+	params.SymbolReservationDeposit = sdk.NewInt64Coin(stakingParams.BondDenom, 0)
+	params.SymbolReservationPeriod = types.DefaultSymbolReservationPeriod
+	params.SendRateLimitChangeDelay = types.DefaultSendRateLimitChangeDelay
+	params.FeatureUpdateDelay = types.DefaultFeatureUpdateDelay
+	params.MaxPinnedExtensionCodes = types.DefaultMaxPinnedExtensionCodes
+	params.CommissionBuybackRatio = sdkmath.LegacyZeroDec()
+	params.BuybackInterval = types.DefaultBuybackInterval
+	params.BuybackDestination = types.BUYBACK_DESTINATION_BURN
+	params.ObserverGasLimit = types.DefaultObserverGasLimit
+	params.MinRateChangeNotice = types.DefaultMinRateChangeNotice
+	params.MinIssuerRecoveryInactivityPeriod = types.DefaultMinIssuerRecoveryInactivityPeriod
+
+	return keeper.SetParams(ctx, params)
+}
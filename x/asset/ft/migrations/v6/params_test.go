@@ -0,0 +1,66 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	v6 "github.com/tokenize-x/tx-chain/v7/x/asset/ft/migrations/v6"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:    time.Now(),
+		AppHash: []byte("some-hash"),
+	})
+
+	keeper := testApp.AssetFTKeeper
+
+	// set params without the symbol claim deposit to simulate the state before the migration
+	params, err := keeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.SymbolClaimDeposit = sdk.Coin{}
+	params.ReferralFeeRatio = sdkmath.LegacyDec{}
+	params.SymbolReservationDeposit = sdk.Coin{}
+	params.SymbolReservationPeriod = 0
+	params.SendRateLimitChangeDelay = 0
+	params.FeatureUpdateDelay = 0
+	params.MaxPinnedExtensionCodes = 0
+	params.CommissionBuybackRatio = sdkmath.LegacyDec{}
+	params.BuybackInterval = 0
+	params.ObserverGasLimit = 0
+	params.MinRateChangeNotice = 0
+	params.MinIssuerRecoveryInactivityPeriod = 0
+	requireT.NoError(keeper.SetParams(ctx, params))
+
+	requireT.NoError(v6.MigrateParams(ctx, keeper, testApp.StakingKeeper))
+
+	stakingParams, err := testApp.StakingKeeper.GetParams(ctx)
+	requireT.NoError(err)
+
+	params, err = keeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(stakingParams.BondDenom, 0), params.SymbolClaimDeposit)
+	requireT.True(params.ReferralFeeRatio.IsZero())
+	requireT.Equal(sdk.NewInt64Coin(stakingParams.BondDenom, 0), params.SymbolReservationDeposit)
+	requireT.Equal(types.DefaultSymbolReservationPeriod, params.SymbolReservationPeriod)
+	requireT.Equal(types.DefaultSendRateLimitChangeDelay, params.SendRateLimitChangeDelay)
+	requireT.Equal(types.DefaultFeatureUpdateDelay, params.FeatureUpdateDelay)
+	requireT.Equal(uint32(types.DefaultMaxPinnedExtensionCodes), params.MaxPinnedExtensionCodes)
+	requireT.True(params.CommissionBuybackRatio.IsZero())
+	requireT.Equal(types.DefaultBuybackInterval, params.BuybackInterval)
+	requireT.Equal(types.BUYBACK_DESTINATION_BURN, params.BuybackDestination)
+	requireT.Equal(uint64(types.DefaultObserverGasLimit), params.ObserverGasLimit)
+	requireT.Equal(types.DefaultMinRateChangeNotice, params.MinRateChangeNotice)
+	requireT.Equal(types.DefaultMinIssuerRecoveryInactivityPeriod, params.MinIssuerRecoveryInactivityPeriod)
+	requireT.NoError(params.ValidateBasic())
+}
@@ -3,6 +3,7 @@ package ft
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"cosmossdk.io/core/appmodule"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -16,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/tokenize-x/tx-chain/v7/pkg/blocktiming"
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/client/cli"
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/keeper"
 	v4 "github.com/tokenize-x/tx-chain/v7/x/asset/ft/migrations/v4"
@@ -29,7 +31,8 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -106,10 +109,12 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper        keeper.Keeper
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
-	paramsKeeper  v4.ParamsKeeper
+	keeper         keeper.Keeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	paramsKeeper   v4.ParamsKeeper
+	transferKeeper keeper.TransferKeeper
+	channelKeeper  keeper.ChannelKeeper
 }
 
 // NewAppModule returns the new instance of the AppModule.
@@ -119,6 +124,8 @@ func NewAppModule(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	paramsKeeper v4.ParamsKeeper,
+	transferKeeper keeper.TransferKeeper,
+	channelKeeper keeper.ChannelKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
@@ -126,6 +133,8 @@ func NewAppModule(
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
 		paramsKeeper:   paramsKeeper,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
 	}
 }
 
@@ -144,7 +153,7 @@ func (am AppModule) IsOnePerModuleType() {}
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
-	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper, am.bankKeeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper, am.bankKeeper, am.transferKeeper, am.channelKeeper))
 
 	m := keeper.NewMigrator(am.keeper, am.paramsKeeper)
 	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
@@ -168,8 +177,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock processes the balance accumulated in the buyback account.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	defer blocktiming.MeasureSince(ctx, time.Now(), types.ModuleName, "buyback")
+	return am.keeper.ProcessBuyback(sdk.UnwrapSDKContext(ctx))
+}
+
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 5 }
+func (AppModule) ConsensusVersion() uint64 { return 6 }
 
 // AppModuleSimulation functions
 
@@ -184,6 +199,8 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	return simulation.NewOperationFactory(
 		simState.AppParams,
 		simState.Cdc,
+		simState.TxConfig,
+		am.keeper,
 		am.accountKeeper,
 		am.bankKeeper,
 	).WeightedOperations()
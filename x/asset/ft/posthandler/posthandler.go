@@ -0,0 +1,83 @@
+package posthandler
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	metatxtypes "github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+// Keeper interface exposes methods required by post handler decorator of fungible tokens.
+type Keeper interface {
+	RecordIssuerActivity(ctx sdk.Context, signer sdk.AccAddress) error
+}
+
+// IssuerActivityDecorator records the activity of the issuers on whose behalf the messages wrapped by the successfully
+// executed transaction are executed, like the granters of the authz MsgExec and the signers of the meta-transactions.
+// The signers of the transaction are recorded by the ante decorator. The wrapped messages can't be recorded there,
+// because their signers are verified only when the messages are executed.
+type IssuerActivityDecorator struct {
+	keeper Keeper
+	cdc    codec.Codec
+}
+
+// NewIssuerActivityDecorator creates post decorator recording the activity of the issuers.
+func NewIssuerActivityDecorator(keeper Keeper, cdc codec.Codec) IssuerActivityDecorator {
+	return IssuerActivityDecorator{
+		keeper: keeper,
+		cdc:    cdc,
+	}
+}
+
+// PostHandle handles transaction in post decorator.
+func (iad IssuerActivityDecorator) PostHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler,
+) (sdk.Context, error) {
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if err := iad.recordWrappedSigners(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+func (iad IssuerActivityDecorator) recordWrappedSigners(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var (
+			wrappedMsgs []sdk.Msg
+			err         error
+		)
+		switch typedMsg := msg.(type) {
+		case *authz.MsgExec:
+			wrappedMsgs, err = typedMsg.GetMessages()
+		case *metatxtypes.MsgExecuteMetaTx:
+			wrappedMsgs, err = typedMsg.GetMessages()
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, wrappedMsg := range wrappedMsgs {
+			signers, _, err := iad.cdc.GetMsgV1Signers(wrappedMsg)
+			if err != nil {
+				return err
+			}
+			for _, signer := range signers {
+				if err := iad.keeper.RecordIssuerActivity(ctx, signer); err != nil {
+					return err
+				}
+			}
+		}
+		if err := iad.recordWrappedSigners(ctx, wrappedMsgs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package posthandler_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/posthandler"
+	metatxtypes "github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+func TestIssuerActivityDecorator(t *testing.T) {
+	testApp := simapp.New()
+	startTime := time.Now().UTC()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).WithBlockTime(startTime)
+
+	ftKeeper := testApp.AssetFTKeeper
+	params, err := ftKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	successor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, relayerKey := testApp.GenAccount(ctx)
+	relayer := sdk.AccAddress(relayerKey.PubKey().Address())
+	require.NoError(t, ftKeeper.DesignateSuccessor(ctx, issuer, successor, params.MinIssuerRecoveryInactivityPeriod))
+
+	issuerMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   relayer.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))),
+	}
+	execMsg := authz.NewMsgExec(relayer, []sdk.Msg{issuerMsg})
+	issuerMsgAny, err := codectypes.NewAnyWithValue(issuerMsg)
+	require.NoError(t, err)
+	metaTxMsg := &metatxtypes.MsgExecuteMetaTx{
+		Relayer:  relayer.String(),
+		Signer:   issuer.String(),
+		Messages: []*codectypes.Any{issuerMsgAny},
+	}
+	nestedExecMsg := authz.NewMsgExec(relayer, []sdk.Msg{metaTxMsg})
+
+	tests := []struct {
+		name     string
+		msg      sdk.Msg
+		success  bool
+		recorded bool
+	}{
+		{
+			name:     "authz_exec",
+			msg:      &execMsg,
+			success:  true,
+			recorded: true,
+		},
+		{
+			name:     "metatx",
+			msg:      metaTxMsg,
+			success:  true,
+			recorded: true,
+		},
+		{
+			name:     "nested",
+			msg:      &nestedExecMsg,
+			success:  true,
+			recorded: true,
+		},
+		{
+			name:    "failed_tx",
+			msg:     &execMsg,
+			success: false,
+		},
+		{
+			// the signers of the transaction are recorded by the ante decorator
+			name:    "not_wrapped",
+			msg:     issuerMsg,
+			success: true,
+		},
+	}
+
+	decorator := posthandler.NewIssuerActivityDecorator(ftKeeper, testApp.AppCodec())
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+
+			txCtx, _ := ctx.WithBlockTime(startTime.Add(time.Duration(i+1) * time.Hour)).CacheContext()
+			tx, err := testApp.GenTx(txCtx, sdk.NewInt64Coin("stake", 0), 100_000, relayerKey, tt.msg)
+			requireT.NoError(err)
+
+			_, err = decorator.PostHandle(txCtx, tx, false, tt.success, nextPostHandler)
+			requireT.NoError(err)
+
+			recovery, err := ftKeeper.GetIssuerRecovery(txCtx, issuer)
+			requireT.NoError(err)
+			if tt.recorded {
+				requireT.Equal(txCtx.BlockTime(), recovery.LastActivityTime)
+			} else {
+				requireT.Equal(startTime, recovery.LastActivityTime)
+			}
+		})
+	}
+}
+
+func nextPostHandler(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
@@ -6,13 +6,13 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
-	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/samber/lo"
 
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
 )
@@ -20,18 +20,33 @@ import (
 // Message types.
 var (
 	TypeMsgIssue = sdk.MsgTypeURL(&types.MsgIssue{})
+	TypeMsgMint  = sdk.MsgTypeURL(&types.MsgMint{})
+	TypeMsgBurn  = sdk.MsgTypeURL(&types.MsgBurn{})
 )
 
 // Simulation operation weights constants.
 const (
 	OpWeightMsgIssue      = "op_weight_msg_issue"
 	DefaultWeightMsgIssue = 100
+	OpWeightMsgMint       = "op_weight_msg_mint"
+	DefaultWeightMsgMint  = 50
+	OpWeightMsgBurn       = "op_weight_msg_burn"
+	DefaultWeightMsgBurn  = 50
 )
 
+// Keeper defines the methods of the asset ft keeper used by the simulation.
+type Keeper interface {
+	GetDefinition(ctx sdk.Context, denom string) (types.Definition, error)
+	GetTokens(ctx sdk.Context, pagination *query.PageRequest) ([]types.Token, *query.PageResponse, error)
+	GetSpendableBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
+}
+
 // OperationFactory creates simulation messages.
 type OperationFactory struct {
 	appParams simtypes.AppParams
 	cdc       codec.JSONCodec
+	txConfig  client.TxConfig
+	keeper    Keeper
 	ak        types.AccountKeeper
 	bk        types.BankKeeper
 }
@@ -40,12 +55,16 @@ type OperationFactory struct {
 func NewOperationFactory(
 	appParams simtypes.AppParams,
 	cdc codec.JSONCodec,
+	txConfig client.TxConfig,
+	keeper Keeper,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 ) *OperationFactory {
 	return &OperationFactory{
 		appParams: appParams,
 		cdc:       cdc,
+		txConfig:  txConfig,
+		keeper:    keeper,
 		ak:        ak,
 		bk:        bk,
 	}
@@ -54,23 +73,41 @@ func NewOperationFactory(
 // WeightedOperations returns all the operations from the module with their respective weights.
 func (op *OperationFactory) WeightedOperations() simulation.WeightedOperations {
 	// make the weights updatable by the simulation
-	var weightMsgIssue int
+	var weightMsgIssue, weightMsgMint, weightMsgBurn int
 	op.appParams.GetOrGenerate(OpWeightMsgIssue, &weightMsgIssue, nil,
 		func(_ *rand.Rand) {
 			weightMsgIssue = DefaultWeightMsgIssue
 		},
 	)
+	op.appParams.GetOrGenerate(OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = DefaultWeightMsgMint
+		},
+	)
+	op.appParams.GetOrGenerate(OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = DefaultWeightMsgBurn
+		},
+	)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgIssue,
 			op.simulateMsgIssue,
 		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			op.simulateMsgMint,
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			op.simulateMsgBurn,
+		),
 	}
 }
 
 func (op *OperationFactory) simulateMsgIssue(
 	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
-	accs []simtypes.Account, chainID string,
+	accs []simtypes.Account, _ string,
 ) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 	senderAcc, _ := simtypes.RandomAcc(r, accs)
 	msg, skip := op.randomIssueMsg(ctx, r, senderAcc.Address)
@@ -78,12 +115,46 @@ func (op *OperationFactory) simulateMsgIssue(
 		return simtypes.NoOpMsg(types.ModuleName, TypeMsgIssue, "skip issue"), nil, nil
 	}
 
-	err := op.sendMsg(ctx, r, chainID, []cryptotypes.PrivKey{senderAcc.PrivKey}, app, senderAcc.Address, msg)
+	return op.deliver(r, app, ctx, senderAcc, msg)
+}
+
+func (op *OperationFactory) simulateMsgMint(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, _ string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msg, skip, err := op.randomMintMsg(ctx, r)
 	if err != nil {
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "invalid issuance"), nil, err
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "can't get tokens"), nil, err
+	}
+	if skip {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "skip mint"), nil, nil
+	}
+	// only the admin is allowed to mint
+	senderAcc, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(msg.Sender))
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "admin not found"), nil, nil
 	}
 
-	return simtypes.NewOperationMsg(msg, false, ""), nil, nil
+	return op.deliver(r, app, ctx, senderAcc, msg)
+}
+
+func (op *OperationFactory) simulateMsgBurn(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, _ string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msg, skip, err := op.randomBurnMsg(ctx, r)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "can't get balances"), nil, err
+	}
+	if skip {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "skip burn"), nil, nil
+	}
+	senderAcc, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(msg.Sender))
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "issuer not found"), nil, nil
+	}
+
+	return op.deliver(r, app, ctx, senderAcc, msg)
 }
 
 func (op *OperationFactory) randomIssueMsg(
@@ -104,7 +175,9 @@ func (op *OperationFactory) randomIssueMsg(
 		Precision:     uint32(simtypes.RandIntBetween(r, 1, 20)),
 		InitialAmount: simtypes.RandomAmount(r, sdkmath.NewIntWithDecimal(1, 30)),
 		Description:   simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxDescriptionLength)),
-		Features:      nil,
+		// The features restricting the transfers (freezing, whitelisting, etc.) are not used since the bank
+		// simulation sends the spendable balances and fails if the transfer is rejected by the asset ft.
+		Features: randomFeatures(r, types.Feature_minting, types.Feature_burning),
 		// TODO (v7): fix the simulation to work with the commissions since now it is failed
 		// in the distribution EndBlocker since tries to allocate all tokens for the fee_collector
 		// and the fee_collector has the asset_ft_tokens with the SendCommissionRate and BurnRate
@@ -120,35 +193,103 @@ func (op *OperationFactory) randomIssueMsg(
 	return msg, false
 }
 
-func (op *OperationFactory) sendMsg(
+func (op *OperationFactory) randomMintMsg(
 	ctx sdk.Context,
 	r *rand.Rand,
-	chainID string,
-	privKeys []cryptotypes.PrivKey,
-	app *baseapp.BaseApp,
-	sender sdk.AccAddress,
-	msg *types.MsgIssue,
-) error {
-	account := op.ak.GetAccount(ctx, sender)
-	txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
-	tx, err := simtestutil.GenSignedMockTx(
-		r,
-		txGen,
-		[]sdk.Msg{msg},
-		sdk.Coins{},
-		simtestutil.DefaultGenTxGas,
-		chainID,
-		[]uint64{account.GetAccountNumber()},
-		[]uint64{account.GetSequence()},
-		privKeys...,
-	)
+	// msg, skip, err
+) (*types.MsgMint, bool, error) {
+	tokens, _, err := op.keeper.GetTokens(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	tokens = lo.Filter(tokens, func(token types.Token, _ int) bool {
+		return token.Admin != "" && lo.Contains(token.Features, types.Feature_minting)
+	})
+	if len(tokens) == 0 {
+		return nil, true, nil
+	}
+
+	token := tokens[r.Intn(len(tokens))]
+	amount := simtypes.RandomAmount(r, sdkmath.NewIntWithDecimal(1, 20))
+	if !amount.IsPositive() {
+		return nil, true, nil
+	}
+	supply := op.bk.GetSupply(ctx, token.Denom).Amount
+	if supply.Add(amount).GT(types.MaxMintableAmount) {
+		return nil, true, nil
+	}
+
+	return &types.MsgMint{
+		Sender: token.Admin,
+		Coin:   sdk.NewCoin(token.Denom, amount),
+	}, false, nil
+}
+
+func (op *OperationFactory) randomBurnMsg(
+	ctx sdk.Context,
+	r *rand.Rand,
+	// msg, skip, err
+) (*types.MsgBurn, bool, error) {
+	tokens, _, err := op.keeper.GetTokens(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(tokens) == 0 {
+		return nil, true, nil
+	}
+
+	// the issuer holds the initial amount, so it burns the tokens
+	token := tokens[r.Intn(len(tokens))]
+	sender, err := sdk.AccAddressFromBech32(token.Issuer)
+	if err != nil {
+		return nil, false, err
+	}
+	def, err := op.keeper.GetDefinition(ctx, token.Denom)
+	if err != nil {
+		return nil, false, err
+	}
+	if !def.IsFeatureAllowed(sender, types.Feature_burning) {
+		return nil, true, nil
+	}
+	spendable, err := op.keeper.GetSpendableBalance(ctx, sender, token.Denom)
 	if err != nil {
-		return err
+		return nil, false, err
 	}
-	_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+	if !spendable.IsPositive() {
+		return nil, true, nil
+	}
+	amount, err := simtypes.RandPositiveInt(r, spendable.Amount)
 	if err != nil {
-		return err
+		return nil, false, err
 	}
 
-	return nil
+	return &types.MsgBurn{
+		Sender: token.Issuer,
+		Coin:   sdk.NewCoin(token.Denom, amount),
+	}, false, nil
+}
+
+func (op *OperationFactory) deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	senderAcc simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTx(simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         op.txConfig,
+		Msg:           msg,
+		Context:       ctx,
+		SimAccount:    senderAcc,
+		AccountKeeper: op.ak,
+		ModuleName:    types.ModuleName,
+	}, sdk.Coins{})
+}
+
+func randomFeatures(r *rand.Rand, features ...types.Feature) []types.Feature {
+	return lo.Filter(features, func(_ types.Feature, _ int) bool {
+		return r.Intn(2) == 0
+	})
 }
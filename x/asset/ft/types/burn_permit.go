@@ -0,0 +1,74 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewBurnPermit returns the burn permit the holder signs to let the burner burn its coins.
+func NewBurnPermit(
+	chainID string,
+	holder, burner sdk.AccAddress,
+	coin sdk.Coin,
+	nonce uint64,
+	expirationTime time.Time,
+) BurnPermit {
+	return BurnPermit{
+		ChainId:        chainID,
+		Holder:         holder.String(),
+		Burner:         burner.String(),
+		Coin:           coin,
+		Nonce:          nonce,
+		ExpirationTime: expirationTime,
+	}
+}
+
+// SignBytes returns the bytes of the permit signed by the holder.
+func (p BurnPermit) SignBytes() ([]byte, error) {
+	return p.Marshal()
+}
+
+// IsExpired returns true if the permit can't be used at the provided time anymore.
+func (p BurnPermit) IsExpired(now time.Time) bool {
+	return !now.Before(p.ExpirationTime)
+}
+
+// VerifySignature verifies that the permit is signed by the holder owning the public key.
+func (p BurnPermit) VerifySignature(pubKey, signature []byte) error {
+	if err := ValidateBurnPermitPubKey(pubKey); err != nil {
+		return err
+	}
+
+	key := &secp256k1.PubKey{Key: pubKey}
+	if sdk.AccAddress(key.Address()).String() != p.Holder {
+		return sdkerrors.Wrap(ErrInvalidBurnPermit, "public key doesn't belong to the holder")
+	}
+
+	signBytes, err := p.SignBytes()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBurnPermit, "failed to encode the permit: %s", err)
+	}
+	if !key.VerifySignature(signBytes, signature) {
+		return sdkerrors.Wrap(ErrInvalidBurnPermit, "invalid signature")
+	}
+
+	return nil
+}
+
+// ValidateBurnPermitPubKey checks that the public key is the compressed secp256k1 key.
+func ValidateBurnPermitPubKey(pubKey []byte) error {
+	if len(pubKey) != secp256k1.PubKeySize {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrInvalidPubKey,
+			"public key must be %d bytes long, got %d",
+			secp256k1.PubKeySize,
+			len(pubKey),
+		)
+	}
+
+	return nil
+}
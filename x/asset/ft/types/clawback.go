@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxClawbackBatchSize is the maximum number of entries clawed back by one message.
+const MaxClawbackBatchSize = 100
+
+// ValidateClawbackEntries validates the entries of the batch clawback. The account can be present in the batch once
+// per denom.
+func ValidateClawbackEntries(entries []ClawbackEntry) error {
+	if len(entries) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "at least one entry must be provided")
+	}
+
+	if len(entries) > MaxClawbackBatchSize {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "at most %d entries can be provided at once, got %d", MaxClawbackBatchSize, len(entries),
+		)
+	}
+
+	type accountDenom struct {
+		account string
+		denom   string
+	}
+	uniqueEntries := make(map[accountDenom]struct{}, len(entries))
+	for _, entry := range entries {
+		if _, err := sdk.AccAddressFromBech32(entry.Account); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid account address %s: %s", entry.Account, err)
+		}
+		if _, _, err := DeconstructDenom(entry.Coin.Denom); err != nil {
+			return err
+		}
+		if err := entry.Coin.Validate(); err != nil {
+			return err
+		}
+		if !entry.Coin.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "clawback amount of account %s must be positive", entry.Account)
+		}
+
+		key := accountDenom{account: entry.Account, denom: entry.Coin.Denom}
+		if _, ok := uniqueEntries[key]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated entry of account %s and denom %s", key.account, key.denom)
+		}
+		uniqueEntries[key] = struct{}{}
+	}
+
+	return nil
+}
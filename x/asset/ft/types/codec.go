@@ -26,6 +26,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	)
 	registry.RegisterImplementations((*proto.Message)(nil),
 		&DelayedTokenUpgradeV1{},
+		&DelayedSymbolReservationExpiration{},
+		&DelayedIssuanceEscrowExpiration{},
+		&DelayedSendRateLimitChange{},
+		&DelayedFeatureUpdate{},
+		&DelayedRateChange{},
+		&DelayedRedenominationFinalization{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// ToDisplayAmount converts the amount of subunits to the decimal amount in the display unit, e.g. 1500000 subunits of
+// the token with the precision of 6 are converted to "1.5". The trailing zeros of the fractional part are removed.
+func ToDisplayAmount(amount sdkmath.Int, precision uint32) string {
+	abs := amount.Abs().BigInt()
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	integer, fraction := new(big.Int).QuoRem(abs, divisor, new(big.Int))
+
+	var sign string
+	if amount.IsNegative() {
+		sign = "-"
+	}
+	if fraction.Sign() == 0 {
+		return sign + integer.String()
+	}
+
+	fractionStr := fraction.String()
+	fractionStr = strings.Repeat("0", int(precision)-len(fractionStr)) + fractionStr
+	return sign + integer.String() + "." + strings.TrimRight(fractionStr, "0")
+}
+
+// ToSubunitAmount converts the non-negative decimal amount in the display unit to the amount of subunits, e.g. "1.5"
+// of the token with the precision of 6 is converted to 1500000. The amount having more decimal places than the
+// precision is rejected, since it can't be represented in subunits.
+func ToSubunitAmount(amount string, precision uint32) (sdkmath.Int, error) {
+	integerStr, fractionStr, _ := strings.Cut(amount, ".")
+	if integerStr == "" || !isDigits(integerStr) || (fractionStr != "" && !isDigits(fractionStr)) ||
+		strings.HasSuffix(amount, ".") {
+		return sdkmath.Int{}, sdkerrors.Wrapf(ErrInvalidInput, "invalid display amount %q", amount)
+	}
+	if len(fractionStr) > int(precision) {
+		return sdkmath.Int{}, sdkerrors.Wrapf(
+			ErrInvalidInput, "display amount %s has more than %d decimal places", amount, precision,
+		)
+	}
+
+	subunits, ok := new(big.Int).SetString(
+		integerStr+fractionStr+strings.Repeat("0", int(precision)-len(fractionStr)), 10,
+	)
+	if !ok {
+		return sdkmath.Int{}, sdkerrors.Wrapf(ErrInvalidInput, "invalid display amount %q", amount)
+	}
+	if subunits.BitLen() > sdkmath.MaxBitLen {
+		return sdkmath.Int{}, sdkerrors.Wrapf(ErrInvalidInput, "display amount %s is too large", amount)
+	}
+
+	return sdkmath.NewIntFromBigInt(subunits), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
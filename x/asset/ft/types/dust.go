@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// MaxDustSweepBatchSize is the maximum number of accounts swept by one message.
+const MaxDustSweepBatchSize = 100
+
+// The reasons of the skipped dust sweeping.
+const (
+	DustSweepSkipReasonOptedOut       = "opted_out"
+	DustSweepSkipReasonIssuerOrAdmin  = "issuer_or_admin"
+	DustSweepSkipReasonModuleAccount  = "module_account"
+	DustSweepSkipReasonSmartContract  = "smart_contract"
+	DustSweepSkipReasonZeroBalance    = "zero_balance"
+	DustSweepSkipReasonAboveThreshold = "above_threshold"
+	DustSweepSkipReasonFrozen         = "frozen"
+	DustSweepSkipReasonLocked         = "locked"
+	DustSweepSkipReasonLegalHold      = "legal_hold"
+)
+
+// ValidateDustPolicyTerms validates the threshold and destination of the dust policy.
+func ValidateDustPolicyTerms(threshold sdkmath.Int, destination DustDestination) error {
+	if threshold.IsNil() || threshold.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "dust threshold must not be negative")
+	}
+
+	if threshold.GT(MaxMintableAmount) {
+		return sdkerrors.Wrap(ErrInvalidInput, "dust threshold is greater than maximum allowed")
+	}
+
+	if _, ok := DustDestination_name[int32(destination)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "unknown dust destination %d", destination)
+	}
+
+	return nil
+}
+
+// ValidateDustSweepAccounts validates the accounts of the dust sweeping.
+func ValidateDustSweepAccounts(accounts []string) error {
+	return validateAccountsBatch(accounts, MaxDustSweepBatchSize)
+}
+
+// ValidateBasic checks that the dust policy fields are valid.
+func (p DustPolicy) ValidateBasic() error {
+	if _, _, err := DeconstructDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateDustPolicyTerms(p.Threshold, p.Destination); err != nil {
+		return err
+	}
+
+	if !p.Threshold.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "dust threshold of the stored policy must be positive")
+	}
+
+	return nil
+}
@@ -29,4 +29,46 @@ var (
 	ErrDEXInsufficientSpendableBalance = sdkerrors.Register(
 		ModuleName, 11, "DEX insufficient spendable balance",
 	)
+	// ErrSymbolClaimNotFound error for a symbol claim not found in the store.
+	ErrSymbolClaimNotFound = sdkerrors.Register(ModuleName, 12, "symbol claim not found")
+	// ErrVerifiedSymbolNotFound error for a verified symbol not found in the store.
+	ErrVerifiedSymbolNotFound = sdkerrors.Register(ModuleName, 13, "verified symbol not found")
+	// ErrSymbolReservationNotFound error for a symbol reservation not found in the store.
+	ErrSymbolReservationNotFound = sdkerrors.Register(ModuleName, 14, "symbol reservation not found")
+	// ErrSymbolReserved error for an issuance of the symbol reserved by another issuer.
+	ErrSymbolReserved = sdkerrors.Register(ModuleName, 15, "symbol reserved")
+	// ErrMintAllowanceNotFound error for a mint allowance not found in the store.
+	ErrMintAllowanceNotFound = sdkerrors.Register(ModuleName, 16, "mint allowance not found")
+	// ErrMintAllowanceExceeded error for a mint exceeding the allowance of the grantee.
+	ErrMintAllowanceExceeded = sdkerrors.Register(ModuleName, 17, "mint allowance exceeded")
+	// ErrIssuePresetNotFound error for an issue preset not found in the store.
+	ErrIssuePresetNotFound = sdkerrors.Register(ModuleName, 18, "issue preset not found")
+	// ErrDustPolicyNotFound error for a dust policy not found in the store.
+	ErrDustPolicyNotFound = sdkerrors.Register(ModuleName, 19, "dust policy not found")
+	// ErrInvalidBurnPermit error for a burn permit which is expired, used or not signed by the holder.
+	ErrInvalidBurnPermit = sdkerrors.Register(ModuleName, 20, "invalid burn permit")
+	// ErrIssuanceEscrowNotFound error for an issuance escrow not found in the store.
+	ErrIssuanceEscrowNotFound = sdkerrors.Register(ModuleName, 21, "issuance escrow not found")
+	// ErrSendRateLimitNotFound error for a send rate limit not found in the store.
+	ErrSendRateLimitNotFound = sdkerrors.Register(ModuleName, 22, "send rate limit not found")
+	// ErrSendRateLimitExceeded error for a send exceeding the send rate limit of the sender.
+	ErrSendRateLimitExceeded = sdkerrors.Register(ModuleName, 23, "send rate limit exceeded")
+	// ErrFeatureUpdateNotFound error for a pending feature update not found in the store.
+	ErrFeatureUpdateNotFound = sdkerrors.Register(ModuleName, 24, "feature update not found")
+	// ErrLegalHoldNotFound error for a legal hold not found in the store.
+	ErrLegalHoldNotFound = sdkerrors.Register(ModuleName, 25, "legal hold not found")
+	// ErrLegalHold error for an action prohibited by the legal hold of the account.
+	ErrLegalHold = sdkerrors.Register(ModuleName, 26, "legal hold")
+	// ErrFreezeExemptionNotFound error for a freeze exemption not found in the store.
+	ErrFreezeExemptionNotFound = sdkerrors.Register(ModuleName, 27, "freeze exemption not found")
+	// ErrRoleNotFound error for a role not assigned to the account.
+	ErrRoleNotFound = sdkerrors.Register(ModuleName, 28, "role not found")
+	// ErrRateChangeNotFound error for a pending rate change not found in the store.
+	ErrRateChangeNotFound = sdkerrors.Register(ModuleName, 29, "rate change not found")
+	// ErrRedenominationNotFound error for a redenomination not found in the store.
+	ErrRedenominationNotFound = sdkerrors.Register(ModuleName, 30, "redenomination not found")
+	// ErrRedenominated error for an action prohibited on the token replaced by the redenomination.
+	ErrRedenominated = sdkerrors.Register(ModuleName, 31, "token redenominated")
+	// ErrIssuerRecoveryNotFound error for an issuer recovery not found in the store.
+	ErrIssuerRecoveryNotFound = sdkerrors.Register(ModuleName, 32, "issuer recovery not found")
 )
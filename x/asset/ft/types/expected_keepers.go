@@ -9,6 +9,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
+
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 )
 
 // AccountKeeper defines the expected account keeper interface.
@@ -37,13 +39,16 @@ type BankKeeper interface {
 		amt sdk.Coins,
 	) error
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	HasSupply(ctx context.Context, denom string) bool
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
 // DelayKeeper defines methods required from the delay keeper.
 type DelayKeeper interface {
 	DelayExecution(ctx sdk.Context, id string, data proto.Message, delay time.Duration) error
+	RemoveExecuteAfter(ctx sdk.Context, id string, time time.Time) error
 }
 
 // StakingKeeper defines the expected staking interface.
@@ -70,4 +75,16 @@ type WasmPermissionedKeeper interface {
 		wasmCode []byte,
 		instantiateAccess *wasmtypes.AccessConfig,
 	) (codeID uint64, checksum []byte, err error)
+	PinCode(ctx sdk.Context, codeID uint64) error
+}
+
+// CustomParamsKeeper defines methods required from the custom params keeper.
+type CustomParamsKeeper interface {
+	GetWasmParams(ctx sdk.Context) (customparamstypes.WasmParams, error)
+	GetBankParams(ctx sdk.Context) (customparamstypes.BankParams, error)
+}
+
+// DistributionKeeper defines methods required from the distribution keeper.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
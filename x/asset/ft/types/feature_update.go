@@ -0,0 +1,97 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/samber/lo"
+)
+
+// immutableFeatures can't be updated after the issuance. The extension is bound to the contract instantiated on
+// issuance, and the DEX records the expected to receive balances only for the tokens issued with the whitelisting.
+var immutableFeatures = []Feature{
+	Feature_extension,
+	Feature_whitelisting,
+}
+
+// adminEnableableFeatures are the features the admin may enable after the issuance. The features letting the admin
+// mint the new supply or seize the balances of the holders can be enabled by governance only.
+var adminEnableableFeatures = []Feature{
+	Feature_burning,
+	Feature_ibc,
+	Feature_freezing,
+	Feature_block_smart_contracts,
+	Feature_dex_block,
+	Feature_dex_whitelisted_denoms,
+	Feature_dex_order_cancellation,
+	Feature_dex_unified_ref_amount_change,
+}
+
+// holderProtectingFeatures are the features letting the holders burn and transfer the tokens to other chains, the
+// admin can't disable them.
+var holderProtectingFeatures = []Feature{
+	Feature_burning,
+	Feature_ibc,
+}
+
+// ValidateFeatureUpdate checks that the features enabled and disabled by the update are valid and don't overlap.
+func ValidateFeatureUpdate(enableFeatures, disableFeatures []Feature) error {
+	if len(enableFeatures) == 0 && len(disableFeatures) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "at least one feature must be enabled or disabled")
+	}
+	if err := ValidateFeatures(append(append([]Feature{}, enableFeatures...), disableFeatures...)); err != nil {
+		return err
+	}
+	for _, feature := range append(append([]Feature{}, enableFeatures...), disableFeatures...) {
+		if lo.Contains(immutableFeatures, feature) {
+			return sdkerrors.Wrapf(ErrInvalidInput, "feature %s can't be updated after the issuance", feature)
+		}
+	}
+	return nil
+}
+
+// ValidateAdminFeatureUpdate checks that the admin is allowed to make the update.
+func ValidateAdminFeatureUpdate(enableFeatures, disableFeatures []Feature) error {
+	for _, feature := range enableFeatures {
+		if !lo.Contains(adminEnableableFeatures, feature) {
+			return sdkerrors.Wrapf(ErrInvalidInput, "feature %s can be enabled by governance only", feature)
+		}
+	}
+	for _, feature := range disableFeatures {
+		if lo.Contains(holderProtectingFeatures, feature) {
+			return sdkerrors.Wrapf(ErrInvalidInput, "feature %s can be disabled by governance only", feature)
+		}
+	}
+	return nil
+}
+
+// ApplyFeatureUpdate returns the features resulting from the update. The enabled features are appended to the
+// current ones.
+func ApplyFeatureUpdate(features, enableFeatures, disableFeatures []Feature) ([]Feature, error) {
+	for _, feature := range enableFeatures {
+		if lo.Contains(features, feature) {
+			return nil, sdkerrors.Wrapf(ErrInvalidInput, "feature %s is already enabled", feature)
+		}
+	}
+	for _, feature := range disableFeatures {
+		if !lo.Contains(features, feature) {
+			return nil, sdkerrors.Wrapf(ErrInvalidInput, "feature %s is already disabled", feature)
+		}
+	}
+
+	updatedFeatures := append(lo.Without(features, disableFeatures...), enableFeatures...)
+	if err := ValidateFeatures(updatedFeatures); err != nil {
+		return nil, err
+	}
+
+	return updatedFeatures, nil
+}
+
+// ValidateBasic checks that the feature update fields are valid.
+func (u FeatureUpdate) ValidateBasic() error {
+	if _, _, err := DeconstructDenom(u.Denom); err != nil {
+		return err
+	}
+	if err := ValidateFeatureUpdate(u.EnableFeatures, u.DisableFeatures); err != nil {
+		return err
+	}
+	return ValidateAdminFeatureUpdate(u.EnableFeatures, u.DisableFeatures)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxFreezeRateBatchSize is the maximum number of accounts frozen by one message.
+const MaxFreezeRateBatchSize = 100
+
+// ValidateFreezeRate validates the rate of the balance frozen on the accounts.
+func ValidateFreezeRate(rate sdkmath.LegacyDec) error {
+	if rate.IsNil() || rate.IsNegative() || rate.GT(sdkmath.LegacyOneDec()) {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"freeze rate must be between 0 and 1, the frozen amount is replaced by the rate of the balance",
+		)
+	}
+
+	return nil
+}
+
+// ComputeRateFrozenAmount returns the amount frozen by the rate of the balance. The amount is rounded down, so the
+// frozen amount never exceeds the balance.
+func ComputeRateFrozenAmount(rate sdkmath.LegacyDec, balance sdkmath.Int) sdkmath.Int {
+	return rate.MulInt(balance).TruncateInt()
+}
+
+// validateAccountsBatch validates the accounts processed by one message. The accounts must be unique, since the
+// amounts of each account are computed once using its balance at the execution time.
+func validateAccountsBatch(accounts []string, maxBatchSize int) error {
+	if len(accounts) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "at least one account must be provided")
+	}
+
+	if len(accounts) > maxBatchSize {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "at most %d accounts can be provided at once, got %d", maxBatchSize, len(accounts),
+		)
+	}
+
+	uniqueAccounts := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid account address %s: %s", account, err)
+		}
+		if _, ok := uniqueAccounts[account]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated account %s", account)
+		}
+		uniqueAccounts[account] = struct{}{}
+	}
+
+	return nil
+}
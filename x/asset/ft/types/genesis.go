@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default Token genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -51,6 +56,224 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, verifiedSymbol := range gs.VerifiedSymbols {
+		if err := ValidateSymbol(verifiedSymbol.Symbol); err != nil {
+			return err
+		}
+		if _, _, err := DeconstructDenom(verifiedSymbol.Denom); err != nil {
+			return err
+		}
+	}
+
+	for _, claim := range gs.SymbolClaims {
+		if err := ValidateSymbol(claim.Symbol); err != nil {
+			return err
+		}
+		if _, _, err := DeconstructDenom(claim.Denom); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(claim.Claimer); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid claimer address: %s", err)
+		}
+		if !claim.Deposit.IsValid() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid symbol claim deposit: %s", claim.Deposit)
+		}
+	}
+
+	for _, stats := range gs.ReferrerStats {
+		if _, err := sdk.AccAddressFromBech32(stats.Referrer); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid referrer address: %s", err)
+		}
+		if err := stats.FeesEarned.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid referrer fees earned: %s", err)
+		}
+	}
+
+	for _, reservation := range gs.SymbolReservations {
+		if err := ValidateSymbol(reservation.Symbol); err != nil {
+			return err
+		}
+		if err := ValidateSubunit(reservation.Subunit); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(reservation.Issuer); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid issuer address: %s", err)
+		}
+		if !reservation.Deposit.IsValid() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid symbol reservation deposit: %s", reservation.Deposit)
+		}
+	}
+
+	for _, allowance := range gs.MintAllowances {
+		if err := allowance.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	presetNames := make(map[string]struct{}, len(gs.IssuePresets))
+	for _, preset := range gs.IssuePresets {
+		if err := preset.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := presetNames[preset.Name]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated issue preset %s", preset.Name)
+		}
+		presetNames[preset.Name] = struct{}{}
+	}
+
+	for _, policy := range gs.DustPolicies {
+		if err := policy.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, optOut := range gs.DustOptOuts {
+		if _, _, err := DeconstructDenom(optOut.Denom); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(optOut.Account); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid dust opt-out account address: %s", err)
+		}
+	}
+
+	usedBurnPermits := map[UsedBurnPermit]struct{}{}
+	for _, permit := range gs.UsedBurnPermits {
+		if _, err := sdk.AccAddressFromBech32(permit.Holder); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid used burn permit holder address: %s", err)
+		}
+		if _, exists := usedBurnPermits[permit]; exists {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicated used burn permit %s/%d", permit.Holder, permit.Nonce,
+			)
+		}
+		usedBurnPermits[permit] = struct{}{}
+	}
+
+	for _, escrow := range gs.IssuanceEscrows {
+		if _, _, err := DeconstructDenom(escrow.Denom); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(escrow.Issuer); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid issuance escrow issuer address: %s", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(escrow.Buyer); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid issuance escrow buyer address: %s", err)
+		}
+		if escrow.Amount.IsNil() || !escrow.Amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid issuance escrow amount: %s", escrow.Amount)
+		}
+		if !escrow.Payment.IsValid() || !escrow.Payment.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid issuance escrow payment: %s", escrow.Payment)
+		}
+	}
+
+	for _, limit := range gs.SendRateLimits {
+		if err := limit.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range gs.SendRateLimitUsages {
+		if err := usage.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, update := range gs.PendingFeatureUpdates {
+		if err := update.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range gs.PendingRateChanges {
+		if err := change.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, redenomination := range gs.Redenominations {
+		if err := redenomination.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	issuerRecoveryIssuers := make(map[string]struct{}, len(gs.IssuerRecoveries))
+	for _, recovery := range gs.IssuerRecoveries {
+		if err := recovery.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, exists := issuerRecoveryIssuers[recovery.Issuer]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate issuer recovery of %s", recovery.Issuer)
+		}
+		issuerRecoveryIssuers[recovery.Issuer] = struct{}{}
+	}
+
+	if err := gs.BuybackStats.Burnt.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid buyback burnt amount: %s", err)
+	}
+	if err := gs.BuybackStats.CommunityPool.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid buyback community pool amount: %s", err)
+	}
+
+	supplyBreakdownDenoms := make(map[string]struct{}, len(gs.SupplyBreakdowns))
+	for _, breakdown := range gs.SupplyBreakdowns {
+		if err := breakdown.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, exists := supplyBreakdownDenoms[breakdown.Denom]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate supply breakdown of %s", breakdown.Denom)
+		}
+		supplyBreakdownDenoms[breakdown.Denom] = struct{}{}
+	}
+
+	legalHoldKeys := make(map[string]struct{}, len(gs.LegalHolds))
+	for _, hold := range gs.LegalHolds {
+		if err := hold.ValidateBasic(); err != nil {
+			return err
+		}
+		key := hold.Account + "/" + hold.Amount.Denom
+		if _, exists := legalHoldKeys[key]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate legal hold of %s on %s", hold.Amount.Denom, hold.Account)
+		}
+		legalHoldKeys[key] = struct{}{}
+	}
+
+	freezeExemptionKeys := make(map[string]struct{}, len(gs.FreezeExemptions))
+	for _, exemption := range gs.FreezeExemptions {
+		if _, _, err := DeconstructDenom(exemption.Denom); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(exemption.Account); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid freeze exemption account address: %s", err)
+		}
+		if exemption.ExpirationTime.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "expiration time of the freeze exemption must be set")
+		}
+		key := exemption.Denom + "/" + exemption.Account
+		if _, exists := freezeExemptionKeys[key]; exists {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicate freeze exemption of %s for %s", exemption.Account, exemption.Denom,
+			)
+		}
+		freezeExemptionKeys[key] = struct{}{}
+	}
+
+	roleKeys := make(map[string]struct{}, len(gs.RoleAssignments))
+	for _, assignment := range gs.RoleAssignments {
+		if err := assignment.ValidateBasic(); err != nil {
+			return err
+		}
+		key := assignment.Denom + "/" + assignment.Account + "/" + assignment.Role.String()
+		if _, exists := roleKeys[key]; exists {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput,
+				"duplicate role %s of %s for %s",
+				assignment.Role, assignment.Account, assignment.Denom,
+			)
+		}
+		roleKeys[key] = struct{}{}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -77,5 +300,11 @@ func (token Token) Validate() error {
 		return err
 	}
 
+	if token.ObserverCWAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(token.ObserverCWAddress); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid observer contract address: %s", err)
+		}
+	}
+
 	return ValidateBurnRate(token.BurnRate)
 }
@@ -41,6 +41,48 @@ type GenesisState struct {
 	DEXLockedBalances            []Balance              `protobuf:"bytes,6,rep,name=dex_locked_balances,json=dexLockedBalances,proto3" json:"dex_locked_balances"`
 	DEXExpectedToReceiveBalances []Balance              `protobuf:"bytes,7,rep,name=dex_expected_to_receive_balances,json=dexExpectedToReceiveBalances,proto3" json:"dex_expected_to_receive_balances"`
 	DEXSettings                  []DEXSettingsWithDenom `protobuf:"bytes,8,rep,name=dex_settings,json=dexSettings,proto3" json:"dex_settings"`
+	// verified_symbols contains the symbols verified by the governance.
+	VerifiedSymbols []VerifiedSymbol `protobuf:"bytes,9,rep,name=verified_symbols,json=verifiedSymbols,proto3" json:"verified_symbols"`
+	// symbol_claims contains the pending symbol claims.
+	SymbolClaims []SymbolClaim `protobuf:"bytes,10,rep,name=symbol_claims,json=symbolClaims,proto3" json:"symbol_claims"`
+	// referrer_stats contains the cumulative statistics of the referrers.
+	ReferrerStats []ReferrerStats `protobuf:"bytes,11,rep,name=referrer_stats,json=referrerStats,proto3" json:"referrer_stats"`
+	// symbol_reservations contains the active symbol reservations.
+	SymbolReservations []SymbolReservation `protobuf:"bytes,12,rep,name=symbol_reservations,json=symbolReservations,proto3" json:"symbol_reservations"`
+	// mint_allowances contains the mint allowances granted by the token admins.
+	MintAllowances []MintAllowance `protobuf:"bytes,13,rep,name=mint_allowances,json=mintAllowances,proto3" json:"mint_allowances"`
+	// issue_presets contains the issue presets curated by the governance.
+	IssuePresets []IssuePreset `protobuf:"bytes,14,rep,name=issue_presets,json=issuePresets,proto3" json:"issue_presets"`
+	// dust_policies contains the dust policies of the tokens.
+	DustPolicies []DustPolicy `protobuf:"bytes,15,rep,name=dust_policies,json=dustPolicies,proto3" json:"dust_policies"`
+	// dust_opt_outs contains the accounts excluded from the dust sweeping.
+	DustOptOuts []DustOptOut `protobuf:"bytes,16,rep,name=dust_opt_outs,json=dustOptOuts,proto3" json:"dust_opt_outs"`
+	// used_burn_permits contains the nonces of the burn permits already used by the holders.
+	UsedBurnPermits []UsedBurnPermit `protobuf:"bytes,17,rep,name=used_burn_permits,json=usedBurnPermits,proto3" json:"used_burn_permits"`
+	// issuance_escrows contains the initial supplies waiting for the payment of the buyers.
+	IssuanceEscrows []IssuanceEscrow `protobuf:"bytes,18,rep,name=issuance_escrows,json=issuanceEscrows,proto3" json:"issuance_escrows"`
+	// send_rate_limits contains the send rate limits configured by the accounts.
+	SendRateLimits []SendRateLimit `protobuf:"bytes,19,rep,name=send_rate_limits,json=sendRateLimits,proto3" json:"send_rate_limits"`
+	// send_rate_limit_usages contains the amounts sent by the rate limited accounts within the current windows.
+	SendRateLimitUsages []SendRateLimitUsage `protobuf:"bytes,20,rep,name=send_rate_limit_usages,json=sendRateLimitUsages,proto3" json:"send_rate_limit_usages"`
+	// pending_feature_updates contains the feature updates announced by the admins.
+	PendingFeatureUpdates []FeatureUpdate `protobuf:"bytes,21,rep,name=pending_feature_updates,json=pendingFeatureUpdates,proto3" json:"pending_feature_updates"`
+	// buyback_stats contains the running totals of the buyback.
+	BuybackStats BuybackStats `protobuf:"bytes,22,opt,name=buyback_stats,json=buybackStats,proto3" json:"buyback_stats"`
+	// supply_breakdowns contains the cumulative amounts of the tokens minted and burnt.
+	SupplyBreakdowns []SupplyBreakdown `protobuf:"bytes,23,rep,name=supply_breakdowns,json=supplyBreakdowns,proto3" json:"supply_breakdowns"`
+	// legal_holds contains the active legal holds.
+	LegalHolds []LegalHold `protobuf:"bytes,24,rep,name=legal_holds,json=legalHolds,proto3" json:"legal_holds"`
+	// freeze_exemptions contains the accounts exempted from the global freeze of the tokens.
+	FreezeExemptions []FreezeExemption `protobuf:"bytes,25,rep,name=freeze_exemptions,json=freezeExemptions,proto3" json:"freeze_exemptions"`
+	// role_assignments contains the roles of the tokens assigned to the accounts.
+	RoleAssignments []RoleAssignment `protobuf:"bytes,26,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments"`
+	// pending_rate_changes contains the rate changes scheduled by the admins.
+	PendingRateChanges []RateChange `protobuf:"bytes,27,rep,name=pending_rate_changes,json=pendingRateChanges,proto3" json:"pending_rate_changes"`
+	// redenominations contains the redenominations of the tokens.
+	Redenominations []Redenomination `protobuf:"bytes,28,rep,name=redenominations,proto3" json:"redenominations"`
+	// issuer_recoveries contains the successors designated by the issuers.
+	IssuerRecoveries []IssuerRecovery `protobuf:"bytes,29,rep,name=issuer_recoveries,json=issuerRecoveries,proto3" json:"issuer_recoveries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +174,153 @@ func (m *GenesisState) GetDEXSettings() []DEXSettingsWithDenom {
 	return nil
 }
 
+func (m *GenesisState) GetVerifiedSymbols() []VerifiedSymbol {
+	if m != nil {
+		return m.VerifiedSymbols
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbolClaims() []SymbolClaim {
+	if m != nil {
+		return m.SymbolClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferrerStats() []ReferrerStats {
+	if m != nil {
+		return m.ReferrerStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbolReservations() []SymbolReservation {
+	if m != nil {
+		return m.SymbolReservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintAllowances() []MintAllowance {
+	if m != nil {
+		return m.MintAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIssuePresets() []IssuePreset {
+	if m != nil {
+		return m.IssuePresets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDustPolicies() []DustPolicy {
+	if m != nil {
+		return m.DustPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDustOptOuts() []DustOptOut {
+	if m != nil {
+		return m.DustOptOuts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsedBurnPermits() []UsedBurnPermit {
+	if m != nil {
+		return m.UsedBurnPermits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIssuanceEscrows() []IssuanceEscrow {
+	if m != nil {
+		return m.IssuanceEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSendRateLimits() []SendRateLimit {
+	if m != nil {
+		return m.SendRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSendRateLimitUsages() []SendRateLimitUsage {
+	if m != nil {
+		return m.SendRateLimitUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingFeatureUpdates() []FeatureUpdate {
+	if m != nil {
+		return m.PendingFeatureUpdates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBuybackStats() BuybackStats {
+	if m != nil {
+		return m.BuybackStats
+	}
+	return BuybackStats{}
+}
+
+func (m *GenesisState) GetSupplyBreakdowns() []SupplyBreakdown {
+	if m != nil {
+		return m.SupplyBreakdowns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLegalHolds() []LegalHold {
+	if m != nil {
+		return m.LegalHolds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFreezeExemptions() []FreezeExemption {
+	if m != nil {
+		return m.FreezeExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleAssignments() []RoleAssignment {
+	if m != nil {
+		return m.RoleAssignments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRateChanges() []RateChange {
+	if m != nil {
+		return m.PendingRateChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedenominations() []Redenomination {
+	if m != nil {
+		return m.Redenominations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIssuerRecoveries() []IssuerRecovery {
+	if m != nil {
+		return m.IssuerRecoveries
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used module genesis genesis state.
 type Balance struct {
 	// address is the address of the balance holder.
@@ -302,46 +491,83 @@ func init() {
 func init() { proto.RegisterFile("coreum/asset/ft/v1/genesis.proto", fileDescriptor_d281657d6c91cb92) }
 
 var fileDescriptor_d281657d6c91cb92 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8f, 0xdb, 0x26, 0xfd, 0xff, 0x37, 0x05, 0x54, 0x27, 0x42, 0x6e, 0xa9, 0x9c, 0x28, 0x42,
-	0x22, 0x97, 0x7a, 0x49, 0x39, 0x94, 0x73, 0x48, 0x84, 0x84, 0x7a, 0x40, 0x6e, 0x51, 0x2b, 0x2e,
-	0x61, 0x63, 0x4f, 0x9c, 0x55, 0x1b, 0xaf, 0xe5, 0xdd, 0x06, 0xd3, 0x3b, 0x48, 0xdc, 0x78, 0x0e,
-	0x9e, 0xa4, 0xc7, 0x1e, 0x39, 0x15, 0x94, 0xbe, 0x08, 0xda, 0x0f, 0x37, 0x81, 0xba, 0x0a, 0x27,
-	0x7b, 0x76, 0x7e, 0x5f, 0x1e, 0xed, 0x18, 0x35, 0x03, 0x96, 0xc2, 0xf9, 0x04, 0x13, 0xce, 0x41,
-	0xe0, 0x91, 0xc0, 0xd3, 0x0e, 0x8e, 0x20, 0x06, 0x4e, 0xb9, 0x97, 0xa4, 0x4c, 0x30, 0xdb, 0xd6,
-	0x08, 0x4f, 0x21, 0xbc, 0x91, 0xf0, 0xa6, 0x9d, 0xed, 0x46, 0x01, 0x2b, 0x21, 0x29, 0x99, 0x18,
-	0xd2, 0xb6, 0x5b, 0x00, 0x10, 0xec, 0x14, 0xe2, 0x79, 0x9f, 0x4f, 0x18, 0xc7, 0x43, 0xc2, 0x01,
-	0x4f, 0x3b, 0x43, 0x10, 0xa4, 0x83, 0x03, 0x46, 0xf3, 0x7e, 0x3d, 0x62, 0x11, 0x53, 0xaf, 0x58,
-	0xbe, 0xe9, 0xd3, 0xd6, 0x75, 0x19, 0x6d, 0xbc, 0xd6, 0xe1, 0x0e, 0x05, 0x11, 0x60, 0xbf, 0x44,
-	0x15, 0x6d, 0xeb, 0x58, 0x4d, 0xab, 0x5d, 0xdd, 0xdb, 0xf6, 0xee, 0x86, 0xf5, 0xde, 0x2a, 0x44,
-	0x77, 0xed, 0xf2, 0xba, 0x51, 0xf2, 0x0d, 0xde, 0xde, 0x47, 0x15, 0x95, 0x87, 0x3b, 0x2b, 0xcd,
-	0xd5, 0x76, 0x75, 0x6f, 0xab, 0x88, 0x79, 0x24, 0x11, 0x39, 0x51, 0xc3, 0xed, 0x37, 0xe8, 0xd1,
-	0x28, 0x65, 0x17, 0x10, 0x0f, 0x86, 0xe4, 0x8c, 0xc4, 0x01, 0x70, 0x67, 0x55, 0x29, 0x3c, 0x29,
-	0x52, 0xe8, 0x6a, 0x8c, 0xd1, 0x78, 0xa8, 0x99, 0xe6, 0x90, 0xdb, 0x47, 0xa8, 0xfe, 0x71, 0x4c,
-	0x05, 0x9c, 0x51, 0x2e, 0x20, 0x9c, 0x0b, 0xae, 0xfd, 0xab, 0x60, 0x6d, 0x81, 0x7e, 0xab, 0x1a,
-	0xa0, 0xc7, 0x09, 0xc4, 0x21, 0x8d, 0xa3, 0x81, 0xca, 0x3c, 0x38, 0x4f, 0xa2, 0x94, 0x84, 0xc0,
-	0x9d, 0xb2, 0xd2, 0x7d, 0x56, 0x38, 0x24, 0xcd, 0x50, 0x5f, 0xfc, 0x4e, 0xe3, 0x8d, 0x47, 0x3d,
-	0xb9, 0xdb, 0xe2, 0xf6, 0x08, 0xd5, 0x42, 0xc8, 0x06, 0x67, 0x2c, 0x38, 0x5d, 0x4c, 0x5e, 0x59,
-	0x9e, 0x7c, 0x4b, 0xaa, 0xce, 0xae, 0x1b, 0x9b, 0xbd, 0xfe, 0xc9, 0x81, 0xa2, 0xe7, 0xc9, 0xfd,
-	0xcd, 0x10, 0xb2, 0x3f, 0x8f, 0xec, 0xaf, 0x16, 0x6a, 0x4a, 0x23, 0xc8, 0x12, 0x08, 0xe4, 0x90,
-	0x04, 0x1b, 0xa4, 0x10, 0x00, 0x9d, 0xc2, 0xdc, 0x75, 0x7d, 0xb9, 0xeb, 0x53, 0xe3, 0xba, 0xd3,
-	0xeb, 0x9f, 0xf4, 0x8d, 0xd6, 0x11, 0xf3, 0xb5, 0xd2, 0x6d, 0x80, 0x9d, 0x10, 0xb2, 0x7b, 0xbb,
-	0xf6, 0x07, 0xb4, 0x21, 0xa3, 0x70, 0x10, 0x82, 0xc6, 0x11, 0x77, 0xfe, 0x53, 0xb6, 0xed, 0x22,
-	0xdb, 0x5e, 0xff, 0xe4, 0xd0, 0xc0, 0x8e, 0xa9, 0x18, 0xf7, 0x20, 0x66, 0x93, 0x6e, 0xcd, 0x64,
-	0xa8, 0x2e, 0x74, 0xfd, 0x6a, 0x08, 0x59, 0x5e, 0xb4, 0xbe, 0x58, 0x68, 0xdd, 0xd8, 0xd9, 0x0e,
-	0x5a, 0x27, 0x61, 0x98, 0x02, 0xd7, 0x97, 0xfb, 0x7f, 0x3f, 0x2f, 0x6d, 0x82, 0xca, 0x72, 0x55,
-	0x16, 0xaf, 0xae, 0x5c, 0x26, 0x4f, 0x2e, 0x93, 0x67, 0x96, 0xc9, 0x7b, 0xc5, 0x68, 0xdc, 0x7d,
-	0x2e, 0x1d, 0xbf, 0xff, 0x6c, 0xb4, 0x23, 0x2a, 0xc6, 0xe7, 0x43, 0x2f, 0x60, 0x13, 0x6c, 0x36,
-	0x4f, 0x3f, 0x76, 0x79, 0x78, 0x8a, 0xc5, 0xa7, 0x04, 0xb8, 0x22, 0x70, 0x5f, 0x2b, 0xb7, 0xfa,
-	0xa8, 0x56, 0x70, 0x23, 0xec, 0x3a, 0x2a, 0x87, 0xf2, 0x53, 0x4c, 0x22, 0x5d, 0xc8, 0xa4, 0x53,
-	0x48, 0x39, 0x65, 0xb1, 0xb3, 0xd2, 0xb4, 0xda, 0x0f, 0xfc, 0xbc, 0x6c, 0x7d, 0xb6, 0x50, 0xbd,
-	0x68, 0x14, 0xf7, 0x08, 0x1d, 0xff, 0x35, 0xe0, 0x15, 0xb5, 0xd4, 0x8d, 0x25, 0x03, 0x5e, 0x3e,
-	0xd7, 0xee, 0xc1, 0xe5, 0xcc, 0xb5, 0xae, 0x66, 0xae, 0xf5, 0x6b, 0xe6, 0x5a, 0xdf, 0x6e, 0xdc,
-	0xd2, 0xd5, 0x8d, 0x5b, 0xfa, 0x71, 0xe3, 0x96, 0xde, 0xef, 0x2d, 0x4c, 0x46, 0x6d, 0x0b, 0xbd,
-	0x80, 0xdd, 0x0c, 0x8b, 0x6c, 0x37, 0x18, 0x13, 0x1a, 0xe3, 0xe9, 0x3e, 0xce, 0xe6, 0x7f, 0x31,
-	0x35, 0xa9, 0x61, 0x45, 0xfd, 0x8d, 0x5e, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x18, 0x2f, 0xe9,
-	0x80, 0x3c, 0x05, 0x00, 0x00,
+	// 1210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4d, 0x73, 0x13, 0xc7,
+	0x16, 0xb5, 0xf8, 0x30, 0x8f, 0x96, 0x3f, 0x5b, 0x06, 0x06, 0x03, 0xb2, 0x9e, 0xdf, 0x4b, 0xe2,
+	0x0d, 0x9a, 0x98, 0x2c, 0xc8, 0x16, 0x61, 0x11, 0x48, 0x9c, 0xe0, 0x8c, 0x31, 0x50, 0xa9, 0x54,
+	0x4d, 0x5a, 0x33, 0x57, 0x72, 0x97, 0x47, 0xd3, 0x53, 0x7d, 0x7b, 0x64, 0x99, 0x7d, 0x52, 0x95,
+	0x5d, 0x7e, 0x47, 0xfe, 0x43, 0xf6, 0x2c, 0x59, 0x66, 0x45, 0x52, 0xe6, 0x8f, 0xa4, 0xba, 0xa7,
+	0xdb, 0x92, 0x60, 0x06, 0x67, 0x25, 0xf5, 0xe9, 0x73, 0xcf, 0x3d, 0xba, 0xba, 0xdd, 0x7d, 0x49,
+	0x2b, 0x12, 0x12, 0xf2, 0xa1, 0xcf, 0x10, 0x41, 0xf9, 0x7d, 0xe5, 0x8f, 0xb6, 0xfd, 0x01, 0xa4,
+	0x80, 0x1c, 0xdb, 0x99, 0x14, 0x4a, 0x50, 0x5a, 0x30, 0xda, 0x86, 0xd1, 0xee, 0xab, 0xf6, 0x68,
+	0x7b, 0x7d, 0xa3, 0x24, 0x2a, 0x63, 0x92, 0x0d, 0x6d, 0xd0, 0x7a, 0xb3, 0x84, 0xa0, 0xc4, 0x11,
+	0xa4, 0x93, 0x7d, 0x1c, 0x0a, 0xf4, 0x7b, 0x0c, 0xc1, 0x1f, 0x6d, 0xf7, 0x40, 0xb1, 0x6d, 0x3f,
+	0x12, 0xdc, 0xed, 0xaf, 0x0d, 0xc4, 0x40, 0x98, 0xaf, 0xbe, 0xfe, 0x56, 0xa0, 0x9b, 0x7f, 0x34,
+	0xc8, 0xc2, 0x57, 0x85, 0xb9, 0x7d, 0xc5, 0x14, 0xd0, 0x2f, 0xc9, 0x7c, 0x91, 0xd6, 0xab, 0xb5,
+	0x6a, 0x5b, 0xf5, 0x7b, 0xeb, 0xed, 0x0f, 0xcd, 0xb6, 0xf7, 0x0c, 0xa3, 0x73, 0xe9, 0xf5, 0xdb,
+	0x8d, 0xb9, 0xc0, 0xf2, 0xe9, 0x7d, 0x32, 0x6f, 0xfc, 0xa0, 0x77, 0xa1, 0x75, 0x71, 0xab, 0x7e,
+	0xef, 0x66, 0x59, 0xe4, 0x33, 0xcd, 0x70, 0x81, 0x05, 0x9d, 0x7e, 0x4d, 0x96, 0xfb, 0x52, 0xbc,
+	0x82, 0x34, 0xec, 0xb1, 0x84, 0xa5, 0x11, 0xa0, 0x77, 0xd1, 0x28, 0xdc, 0x2a, 0x53, 0xe8, 0x14,
+	0x1c, 0xab, 0xb1, 0x54, 0x44, 0x5a, 0x10, 0xe9, 0x33, 0xb2, 0x76, 0x7c, 0xc8, 0x15, 0x24, 0x1c,
+	0x15, 0xc4, 0x13, 0xc1, 0x4b, 0xff, 0x56, 0xb0, 0x31, 0x15, 0x7e, 0xa6, 0x1a, 0x91, 0xeb, 0x19,
+	0xa4, 0x31, 0x4f, 0x07, 0xa1, 0xf1, 0x1c, 0xe6, 0xd9, 0x40, 0xb2, 0x18, 0xd0, 0xbb, 0x6c, 0x74,
+	0x3f, 0x2b, 0x2d, 0x52, 0x11, 0x61, 0x7e, 0xf1, 0x41, 0xc1, 0xb7, 0x39, 0xd6, 0xb2, 0x0f, 0xb7,
+	0x90, 0xf6, 0x49, 0x23, 0x86, 0x71, 0x98, 0x88, 0xe8, 0x68, 0xda, 0xf9, 0xfc, 0xf9, 0xce, 0x6f,
+	0x6a, 0xd5, 0xd3, 0xb7, 0x1b, 0xab, 0x3b, 0xdd, 0x97, 0xbb, 0x26, 0xdc, 0x39, 0x0f, 0x56, 0x63,
+	0x18, 0xcf, 0x42, 0xf4, 0xd7, 0x1a, 0x69, 0xe9, 0x44, 0x30, 0xce, 0x20, 0xd2, 0x45, 0x52, 0x22,
+	0x94, 0x10, 0x01, 0x1f, 0xc1, 0x24, 0xeb, 0x95, 0xf3, 0xb3, 0xfe, 0xdf, 0x66, 0xbd, 0xbd, 0xd3,
+	0x7d, 0xd9, 0xb5, 0x5a, 0xcf, 0x44, 0x50, 0x28, 0x9d, 0x19, 0xb8, 0x1d, 0xc3, 0xb8, 0x72, 0x97,
+	0xfe, 0x44, 0x16, 0xb4, 0x15, 0x04, 0xa5, 0x78, 0x3a, 0x40, 0xef, 0x3f, 0x26, 0xed, 0x56, 0x59,
+	0xda, 0x9d, 0xee, 0xcb, 0x7d, 0x4b, 0x7b, 0xc1, 0xd5, 0xe1, 0x0e, 0xa4, 0x62, 0xd8, 0x69, 0x58,
+	0x0f, 0xf5, 0xa9, 0xdd, 0xa0, 0x1e, 0xc3, 0xd8, 0x2d, 0xe8, 0x3e, 0x59, 0x19, 0x81, 0xe4, 0x7d,
+	0x0e, 0x71, 0x88, 0x27, 0xc3, 0x9e, 0x48, 0xd0, 0xbb, 0x6a, 0xb2, 0x6c, 0x96, 0x65, 0x79, 0x6e,
+	0xb9, 0xfb, 0x86, 0x6a, 0xff, 0xaf, 0xe5, 0xd1, 0x0c, 0xaa, 0x3b, 0x76, 0xb1, 0xd0, 0x0a, 0xa3,
+	0x84, 0xf1, 0x21, 0x7a, 0xc4, 0x28, 0x6e, 0x94, 0x29, 0x16, 0x31, 0x0f, 0x35, 0xcf, 0xca, 0x2d,
+	0xe0, 0x04, 0x42, 0xfa, 0x1d, 0x59, 0x92, 0xd0, 0x07, 0x29, 0x41, 0x86, 0xa8, 0x98, 0x42, 0xaf,
+	0x6e, 0xc4, 0xfe, 0x5b, 0x26, 0x16, 0x58, 0xa6, 0x3e, 0xab, 0xee, 0xfc, 0x2d, 0xca, 0x69, 0x90,
+	0xfe, 0x48, 0x1a, 0xd6, 0x9b, 0x04, 0x04, 0x39, 0x62, 0x8a, 0x8b, 0x14, 0xbd, 0x05, 0x23, 0xfa,
+	0x49, 0xb5, 0xc3, 0x60, 0xc2, 0xb6, 0xc2, 0x14, 0xdf, 0xdf, 0x40, 0xba, 0x47, 0x96, 0x87, 0x3c,
+	0x55, 0x21, 0x4b, 0x12, 0x71, 0x5c, 0xb4, 0xca, 0x62, 0xb5, 0xdd, 0x6f, 0x79, 0xaa, 0x1e, 0x38,
+	0xa6, 0x3b, 0xb1, 0xc3, 0x69, 0xd0, 0xd4, 0x92, 0x23, 0xe6, 0x10, 0x66, 0xda, 0xaf, 0x42, 0x6f,
+	0xa9, 0xba, 0x96, 0x4f, 0x34, 0x71, 0xcf, 0xf0, 0x5c, 0x2d, 0xf9, 0x04, 0x42, 0xfa, 0x84, 0x2c,
+	0xc6, 0x39, 0xaa, 0x30, 0x13, 0x09, 0x8f, 0x38, 0xa0, 0xb7, 0x6c, 0xb4, 0x9a, 0xa5, 0xfd, 0x94,
+	0xa3, 0xda, 0xd3, 0xbc, 0x13, 0x27, 0x15, 0x3b, 0x84, 0x03, 0xd2, 0xc7, 0x56, 0x4a, 0x64, 0x2a,
+	0x14, 0xb9, 0x42, 0x6f, 0xe5, 0xe3, 0x52, 0x4f, 0x33, 0xf5, 0x34, 0x77, 0xae, 0xea, 0xf1, 0x19,
+	0xa2, 0xaf, 0xa4, 0xd5, 0x1c, 0xf5, 0x89, 0xce, 0x65, 0x1a, 0x66, 0x20, 0x87, 0x5c, 0xa1, 0xb7,
+	0x5a, 0xdd, 0x82, 0x07, 0x08, 0x71, 0x27, 0x97, 0xe9, 0x9e, 0xa1, 0xba, 0x16, 0xcc, 0x67, 0x50,
+	0xd3, 0xd7, 0xfa, 0xa7, 0xeb, 0x1a, 0x86, 0x80, 0x91, 0x14, 0xc7, 0xe8, 0xd1, 0x6a, 0xd1, 0x27,
+	0x96, 0xdb, 0x35, 0x54, 0x27, 0xca, 0x67, 0x50, 0xa4, 0xdf, 0x93, 0x15, 0x84, 0x34, 0x0e, 0x25,
+	0x53, 0x10, 0x26, 0xdc, 0x38, 0x6d, 0x54, 0xff, 0xbd, 0xfb, 0x90, 0xc6, 0x01, 0x53, 0xb0, 0xcb,
+	0x27, 0x46, 0x97, 0x70, 0x1a, 0x44, 0xca, 0xc8, 0xf5, 0xf7, 0x24, 0xc3, 0x1c, 0xd9, 0x00, 0xd0,
+	0x5b, 0x33, 0xc2, 0x9f, 0x9e, 0x2b, 0x7c, 0xa0, 0xe9, 0xee, 0x76, 0xc6, 0x0f, 0x76, 0x90, 0x86,
+	0xe4, 0x86, 0xbb, 0x9d, 0xfb, 0xc0, 0x54, 0x2e, 0x21, 0xcc, 0xb3, 0x98, 0x29, 0x40, 0xef, 0x5a,
+	0xb5, 0xf9, 0x47, 0x05, 0xf5, 0xc0, 0x30, 0xad, 0xfc, 0x35, 0xab, 0x33, 0xb3, 0x87, 0xf4, 0x1b,
+	0xb2, 0xd8, 0xcb, 0x4f, 0x7a, 0x2c, 0x3a, 0xb2, 0x27, 0xf4, 0xba, 0x79, 0x1a, 0x5b, 0xa5, 0xb7,
+	0x63, 0x41, 0x9c, 0x3e, 0xa0, 0x0b, 0xbd, 0x29, 0x8c, 0x3e, 0x27, 0xab, 0x98, 0x67, 0x59, 0x72,
+	0x12, 0xf6, 0x24, 0xb0, 0xa3, 0x58, 0x1c, 0xa7, 0xe8, 0xdd, 0x30, 0x3e, 0xff, 0x57, 0x5a, 0x0b,
+	0x43, 0xee, 0x38, 0xae, 0xd5, 0x5c, 0xc1, 0x59, 0x18, 0xe9, 0x0e, 0xa9, 0x27, 0x30, 0x60, 0x49,
+	0x78, 0x28, 0x92, 0x18, 0x3d, 0xcf, 0x28, 0xde, 0x29, 0x53, 0xdc, 0xd5, 0xb4, 0xc7, 0x22, 0x89,
+	0xad, 0x16, 0x49, 0x1c, 0x60, 0xdc, 0xf5, 0x25, 0xc0, 0x2b, 0x08, 0x61, 0x0c, 0xc3, 0xac, 0xb8,
+	0x3b, 0x6e, 0x56, 0xbb, 0x7b, 0x64, 0xc8, 0x5d, 0xc7, 0x75, 0xee, 0xfa, 0xb3, 0xb0, 0x69, 0x57,
+	0x29, 0x12, 0x08, 0x19, 0x22, 0x1f, 0xa4, 0x43, 0x48, 0x15, 0x7a, 0xeb, 0xd5, 0xed, 0x1a, 0x88,
+	0x04, 0x1e, 0x9c, 0x51, 0x5d, 0xbb, 0xca, 0x19, 0x54, 0x9b, 0x75, 0x2f, 0x69, 0xd1, 0x5e, 0xd1,
+	0x21, 0x4b, 0x75, 0x67, 0xdd, 0xaa, 0x3e, 0xaa, 0xba, 0x77, 0x1e, 0x1a, 0x9a, 0xbb, 0xe4, 0xac,
+	0xc2, 0x64, 0x03, 0x69, 0x40, 0x96, 0x25, 0xc4, 0xfa, 0x81, 0xe1, 0xa9, 0xbd, 0x3e, 0x6f, 0x7f,
+	0xc4, 0xeb, 0x0c, 0xf5, 0xcc, 0xeb, 0xac, 0x00, 0x3d, 0x20, 0xab, 0xe6, 0xaa, 0x92, 0xfa, 0xad,
+	0x15, 0xfa, 0x41, 0x01, 0xf4, 0xee, 0x7c, 0xfc, 0xc0, 0x82, 0x0c, 0x0a, 0xae, 0xbb, 0xa2, 0x56,
+	0xf8, 0x34, 0xca, 0x01, 0x37, 0x7f, 0xa9, 0x91, 0x2b, 0xf6, 0x35, 0xa5, 0x1e, 0xb9, 0xc2, 0xe2,
+	0x58, 0x02, 0x16, 0xb3, 0xdb, 0xd5, 0xc0, 0x2d, 0x29, 0x23, 0x97, 0xf5, 0x24, 0x38, 0x3d, 0x99,
+	0xe9, 0x59, 0xb1, 0xad, 0x67, 0xc5, 0xb6, 0x9d, 0x15, 0xdb, 0x0f, 0x05, 0x4f, 0x3b, 0x9f, 0xeb,
+	0x3c, 0xbf, 0xff, 0xb5, 0xb1, 0x35, 0xe0, 0xea, 0x30, 0xef, 0xb5, 0x23, 0x31, 0xf4, 0xed, 0x60,
+	0x59, 0x7c, 0xdc, 0xc5, 0xf8, 0xc8, 0x57, 0x27, 0x19, 0xa0, 0x09, 0xc0, 0xa0, 0x50, 0xde, 0xec,
+	0x92, 0x46, 0xc9, 0xc0, 0x43, 0xd7, 0xc8, 0x65, 0x53, 0x07, 0xeb, 0xa8, 0x58, 0x68, 0xa7, 0x23,
+	0x90, 0xc8, 0x45, 0xea, 0x5d, 0x68, 0xd5, 0xb6, 0x16, 0x03, 0xb7, 0xdc, 0xfc, 0xb9, 0x46, 0xd6,
+	0xca, 0x5e, 0xfa, 0x0a, 0xa1, 0x17, 0xef, 0xcd, 0x0f, 0x17, 0x5a, 0xb5, 0xaa, 0xb7, 0x63, 0x4a,
+	0xf5, 0xfc, 0xb1, 0xa1, 0xb3, 0xfb, 0xfa, 0xb4, 0x59, 0x7b, 0x73, 0xda, 0xac, 0xfd, 0x7d, 0xda,
+	0xac, 0xfd, 0xf6, 0xae, 0x39, 0xf7, 0xe6, 0x5d, 0x73, 0xee, 0xcf, 0x77, 0xcd, 0xb9, 0x1f, 0xee,
+	0x4d, 0x55, 0xc6, 0x0c, 0x83, 0xfc, 0x15, 0xdc, 0x1d, 0xfb, 0x6a, 0x7c, 0x37, 0x3a, 0x64, 0x3c,
+	0xf5, 0x47, 0xf7, 0xfd, 0xf1, 0x64, 0x48, 0x37, 0x95, 0xea, 0xcd, 0x9b, 0x61, 0xfb, 0x8b, 0x7f,
+	0x06, 0x00, 0xcb, 0x2e, 0x1d, 0xbe, 0x1b, 0x0c, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -364,10 +590,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.DEXSettings) > 0 {
-		for iNdEx := len(m.DEXSettings) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IssuerRecoveries) > 0 {
+		for iNdEx := len(m.IssuerRecoveries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DEXSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IssuerRecoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -375,13 +601,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.DEXExpectedToReceiveBalances) > 0 {
-		for iNdEx := len(m.DEXExpectedToReceiveBalances) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Redenominations) > 0 {
+		for iNdEx := len(m.Redenominations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DEXExpectedToReceiveBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Redenominations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -389,13 +617,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.DEXLockedBalances) > 0 {
-		for iNdEx := len(m.DEXLockedBalances) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingRateChanges) > 0 {
+		for iNdEx := len(m.PendingRateChanges) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DEXLockedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingRateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -403,13 +633,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.PendingTokenUpgrades) > 0 {
-		for iNdEx := len(m.PendingTokenUpgrades) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RoleAssignments) > 0 {
+		for iNdEx := len(m.RoleAssignments) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PendingTokenUpgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RoleAssignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -417,13 +649,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.WhitelistedBalances) > 0 {
-		for iNdEx := len(m.WhitelistedBalances) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FreezeExemptions) > 0 {
+		for iNdEx := len(m.FreezeExemptions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.WhitelistedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FreezeExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -431,13 +665,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.FrozenBalances) > 0 {
-		for iNdEx := len(m.FrozenBalances) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LegalHolds) > 0 {
+		for iNdEx := len(m.LegalHolds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FrozenBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LegalHolds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -445,13 +681,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SupplyBreakdowns) > 0 {
+		for iNdEx := len(m.SupplyBreakdowns) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SupplyBreakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -459,11 +697,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BuybackStats.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -471,34 +711,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Balance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if len(m.PendingFeatureUpdates) > 0 {
+		for iNdEx := len(m.PendingFeatureUpdates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingFeatureUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -506,209 +725,656 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.SendRateLimitUsages) > 0 {
+		for iNdEx := len(m.SendRateLimitUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendRateLimitUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PendingTokenUpgrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.SendRateLimits) > 0 {
+		for iNdEx := len(m.SendRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PendingTokenUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PendingTokenUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
+	if len(m.IssuanceEscrows) > 0 {
+		for iNdEx := len(m.IssuanceEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuanceEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.UsedBurnPermits) > 0 {
+		for iNdEx := len(m.UsedBurnPermits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedBurnPermits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DEXSettingsWithDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.DustOptOuts) > 0 {
+		for iNdEx := len(m.DustOptOuts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustOptOuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DEXSettingsWithDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DEXSettingsWithDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DEXSettings.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.DustPolicies) > 0 {
+		for iNdEx := len(m.DustPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.IssuePresets) > 0 {
+		for iNdEx := len(m.IssuePresets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuePresets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.MintAllowances) > 0 {
+		for iNdEx := len(m.MintAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SymbolReservations) > 0 {
+		for iNdEx := len(m.SymbolReservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbolReservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReferrerStats) > 0 {
+		for iNdEx := len(m.ReferrerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferrerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.FrozenBalances) > 0 {
-		for _, e := range m.FrozenBalances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SymbolClaims) > 0 {
+		for iNdEx := len(m.SymbolClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbolClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.WhitelistedBalances) > 0 {
-		for _, e := range m.WhitelistedBalances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VerifiedSymbols) > 0 {
+		for iNdEx := len(m.VerifiedSymbols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VerifiedSymbols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.PendingTokenUpgrades) > 0 {
-		for _, e := range m.PendingTokenUpgrades {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DEXSettings) > 0 {
+		for iNdEx := len(m.DEXSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DEXSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DEXExpectedToReceiveBalances) > 0 {
+		for iNdEx := len(m.DEXExpectedToReceiveBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DEXExpectedToReceiveBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
 	if len(m.DEXLockedBalances) > 0 {
-		for _, e := range m.DEXLockedBalances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		for iNdEx := len(m.DEXLockedBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DEXLockedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.DEXExpectedToReceiveBalances) > 0 {
-		for _, e := range m.DEXExpectedToReceiveBalances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingTokenUpgrades) > 0 {
+		for iNdEx := len(m.PendingTokenUpgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTokenUpgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.DEXSettings) > 0 {
-		for _, e := range m.DEXSettings {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WhitelistedBalances) > 0 {
+		for iNdEx := len(m.WhitelistedBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
-}
-
-func (m *Balance) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.FrozenBalances) > 0 {
+		for iNdEx := len(m.FrozenBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PendingTokenUpgrade) Size() (n int) {
+func (m *Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DEXSettingsWithDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DEXSettingsWithDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DEXSettingsWithDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DEXSettings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.Version != 0 {
-		n += 1 + sovGenesis(uint64(m.Version))
+	if len(m.FrozenBalances) > 0 {
+		for _, e := range m.FrozenBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WhitelistedBalances) > 0 {
+		for _, e := range m.WhitelistedBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTokenUpgrades) > 0 {
+		for _, e := range m.PendingTokenUpgrades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DEXLockedBalances) > 0 {
+		for _, e := range m.DEXLockedBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DEXExpectedToReceiveBalances) > 0 {
+		for _, e := range m.DEXExpectedToReceiveBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DEXSettings) > 0 {
+		for _, e := range m.DEXSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VerifiedSymbols) > 0 {
+		for _, e := range m.VerifiedSymbols {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SymbolClaims) > 0 {
+		for _, e := range m.SymbolClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferrerStats) > 0 {
+		for _, e := range m.ReferrerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SymbolReservations) > 0 {
+		for _, e := range m.SymbolReservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for _, e := range m.MintAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IssuePresets) > 0 {
+		for _, e := range m.IssuePresets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DustPolicies) > 0 {
+		for _, e := range m.DustPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DustOptOuts) > 0 {
+		for _, e := range m.DustOptOuts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedBurnPermits) > 0 {
+		for _, e := range m.UsedBurnPermits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IssuanceEscrows) > 0 {
+		for _, e := range m.IssuanceEscrows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendRateLimits) > 0 {
+		for _, e := range m.SendRateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendRateLimitUsages) > 0 {
+		for _, e := range m.SendRateLimitUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingFeatureUpdates) > 0 {
+		for _, e := range m.PendingFeatureUpdates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BuybackStats.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.SupplyBreakdowns) > 0 {
+		for _, e := range m.SupplyBreakdowns {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LegalHolds) > 0 {
+		for _, e := range m.LegalHolds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FreezeExemptions) > 0 {
+		for _, e := range m.FreezeExemptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoleAssignments) > 0 {
+		for _, e := range m.RoleAssignments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRateChanges) > 0 {
+		for _, e := range m.PendingRateChanges {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redenominations) > 0 {
+		for _, e := range m.Redenominations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IssuerRecoveries) > 0 {
+		for _, e := range m.IssuerRecoveries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *DEXSettingsWithDenom) Size() (n int) {
+func (m *Balance) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = m.DEXSettings.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingTokenUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovGenesis(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DEXSettingsWithDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.DEXSettings.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozGenesis(x uint64) (n int) {
@@ -724,28 +1390,537 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBalances = append(m.FrozenBalances, Balance{})
+			if err := m.FrozenBalances[len(m.FrozenBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedBalances = append(m.WhitelistedBalances, Balance{})
+			if err := m.WhitelistedBalances[len(m.WhitelistedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTokenUpgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTokenUpgrades = append(m.PendingTokenUpgrades, PendingTokenUpgrade{})
+			if err := m.PendingTokenUpgrades[len(m.PendingTokenUpgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DEXLockedBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DEXLockedBalances = append(m.DEXLockedBalances, Balance{})
+			if err := m.DEXLockedBalances[len(m.DEXLockedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DEXExpectedToReceiveBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DEXExpectedToReceiveBalances = append(m.DEXExpectedToReceiveBalances, Balance{})
+			if err := m.DEXExpectedToReceiveBalances[len(m.DEXExpectedToReceiveBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DEXSettings = append(m.DEXSettings, DEXSettingsWithDenom{})
+			if err := m.DEXSettings[len(m.DEXSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedSymbols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedSymbols = append(m.VerifiedSymbols, VerifiedSymbol{})
+			if err := m.VerifiedSymbols[len(m.VerifiedSymbols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolClaims = append(m.SymbolClaims, SymbolClaim{})
+			if err := m.SymbolClaims[len(m.SymbolClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferrerStats = append(m.ReferrerStats, ReferrerStats{})
+			if err := m.ReferrerStats[len(m.ReferrerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolReservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolReservations = append(m.SymbolReservations, SymbolReservation{})
+			if err := m.SymbolReservations[len(m.SymbolReservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAllowances = append(m.MintAllowances, MintAllowance{})
+			if err := m.MintAllowances[len(m.MintAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuePresets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuePresets = append(m.IssuePresets, IssuePreset{})
+			if err := m.IssuePresets[len(m.IssuePresets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DustPolicies = append(m.DustPolicies, DustPolicy{})
+			if err := m.DustPolicies[len(m.DustPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DustOptOuts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -772,13 +1947,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DustOptOuts = append(m.DustOptOuts, DustOptOut{})
+			if err := m.DustOptOuts[len(m.DustOptOuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBurnPermits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -805,14 +1981,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, Token{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UsedBurnPermits = append(m.UsedBurnPermits, UsedBurnPermit{})
+			if err := m.UsedBurnPermits[len(m.UsedBurnPermits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceEscrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -839,14 +2015,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrozenBalances = append(m.FrozenBalances, Balance{})
-			if err := m.FrozenBalances[len(m.FrozenBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IssuanceEscrows = append(m.IssuanceEscrows, IssuanceEscrow{})
+			if err := m.IssuanceEscrows[len(m.IssuanceEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendRateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -873,14 +2049,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedBalances = append(m.WhitelistedBalances, Balance{})
-			if err := m.WhitelistedBalances[len(m.WhitelistedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SendRateLimits = append(m.SendRateLimits, SendRateLimit{})
+			if err := m.SendRateLimits[len(m.SendRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingTokenUpgrades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendRateLimitUsages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -907,14 +2083,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingTokenUpgrades = append(m.PendingTokenUpgrades, PendingTokenUpgrade{})
-			if err := m.PendingTokenUpgrades[len(m.PendingTokenUpgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SendRateLimitUsages = append(m.SendRateLimitUsages, SendRateLimitUsage{})
+			if err := m.SendRateLimitUsages[len(m.SendRateLimitUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DEXLockedBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFeatureUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -941,14 +2117,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DEXLockedBalances = append(m.DEXLockedBalances, Balance{})
-			if err := m.DEXLockedBalances[len(m.DEXLockedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingFeatureUpdates = append(m.PendingFeatureUpdates, FeatureUpdate{})
+			if err := m.PendingFeatureUpdates[len(m.PendingFeatureUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DEXExpectedToReceiveBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -975,14 +2151,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DEXExpectedToReceiveBalances = append(m.DEXExpectedToReceiveBalances, Balance{})
-			if err := m.DEXExpectedToReceiveBalances[len(m.DEXExpectedToReceiveBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BuybackStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyBreakdowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1009,8 +2184,212 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DEXSettings = append(m.DEXSettings, DEXSettingsWithDenom{})
-			if err := m.DEXSettings[len(m.DEXSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SupplyBreakdowns = append(m.SupplyBreakdowns, SupplyBreakdown{})
+			if err := m.SupplyBreakdowns[len(m.SupplyBreakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalHolds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegalHolds = append(m.LegalHolds, LegalHold{})
+			if err := m.LegalHolds[len(m.LegalHolds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreezeExemptions = append(m.FreezeExemptions, FreezeExemption{})
+			if err := m.FreezeExemptions[len(m.FreezeExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleAssignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleAssignments = append(m.RoleAssignments, RoleAssignment{})
+			if err := m.RoleAssignments[len(m.RoleAssignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRateChanges = append(m.PendingRateChanges, RateChange{})
+			if err := m.PendingRateChanges[len(m.PendingRateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redenominations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redenominations = append(m.Redenominations, Redenomination{})
+			if err := m.Redenominations[len(m.Redenominations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerRecoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerRecoveries = append(m.IssuerRecoveries, IssuerRecovery{})
+			if err := m.IssuerRecoveries[len(m.IssuerRecoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
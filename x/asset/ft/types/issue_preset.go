@@ -0,0 +1,88 @@
+package types
+
+import (
+	"regexp"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/samber/lo"
+)
+
+var (
+	issuePresetNameRegexStr = `^[a-z][a-z0-9._-]{2,63}$`
+	issuePresetNameRegex    = regexp.MustCompile(issuePresetNameRegexStr)
+)
+
+// ValidateIssuePresetName checks the provided issue preset name is valid.
+func ValidateIssuePresetName(name string) error {
+	if !issuePresetNameRegex.MatchString(name) {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "issue preset name must match regex format '%s'", issuePresetNameRegexStr,
+		)
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that the issue preset fields are valid.
+func (p IssuePreset) ValidateBasic() error {
+	if err := ValidateIssuePresetName(p.Name); err != nil {
+		return err
+	}
+
+	if len(p.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"invalid description %q, the length must be less than %d",
+			p.Description,
+			MaxDescriptionLength,
+		)
+	}
+
+	if err := ValidateFeatures(p.Features); err != nil {
+		return err
+	}
+
+	duplicates := lo.FindDuplicates(p.Features)
+	if len(duplicates) != 0 {
+		return sdkerrors.Wrapf(ErrInvalidInput, "duplicated features in the features list, duplicates: %v", duplicates)
+	}
+
+	if err := ValidateBurnRate(p.BurnRate); err != nil {
+		return err
+	}
+
+	if err := ValidateSendCommissionRate(p.SendCommissionRate); err != nil {
+		return err
+	}
+
+	if p.DEXSettings != nil {
+		if err := ValidateDEXSettings(*p.DEXSettings); err != nil {
+			return err
+		}
+		// the settings are validated against the features of the token issued with the preset
+		if err := ValidateDEXSettingsAccess(*p.DEXSettings, Definition{Features: p.Features}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply returns the issue settings with the features, rates and DEX settings taken from the preset.
+func (p IssuePreset) Apply(settings IssueSettings) IssueSettings {
+	settings.Features = append([]Feature{}, p.Features...)
+	settings.BurnRate = p.BurnRate
+	settings.SendCommissionRate = p.SendCommissionRate
+	settings.DEXSettings = nil
+	if p.DEXSettings != nil {
+		dexSettings := *p.DEXSettings
+		settings.DEXSettings = &dexSettings
+	}
+
+	return settings
+}
+
+func isRateSet(rate sdkmath.LegacyDec) bool {
+	return !rate.IsNil() && !rate.IsZero()
+}
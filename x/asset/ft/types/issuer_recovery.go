@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateIssuerRecoveryInactivityPeriod checks that the inactivity period of the issuer recovery is positive. The
+// lower bound set by the module params is checked by the keeper.
+func ValidateIssuerRecoveryInactivityPeriod(period time.Duration) error {
+	if period <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "inactivity period must be positive")
+	}
+	return nil
+}
+
+// ClaimableTime returns the time after which the successor can claim the admin rights if the issuer stays inactive.
+func (r IssuerRecovery) ClaimableTime() time.Time {
+	return r.LastActivityTime.Add(r.InactivityPeriod)
+}
+
+// ValidateBasic checks that the issuer recovery fields are valid.
+func (r IssuerRecovery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Issuer); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid issuer %s", r.Issuer)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Successor); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid successor %s", r.Successor)
+	}
+	if r.Issuer == r.Successor {
+		return sdkerrors.Wrap(ErrInvalidInput, "issuer can't be its own successor")
+	}
+	if err := ValidateIssuerRecoveryInactivityPeriod(r.InactivityPeriod); err != nil {
+		return err
+	}
+	if r.LastActivityTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "last activity time must be set")
+	}
+	return nil
+}
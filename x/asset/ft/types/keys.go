@@ -15,6 +15,9 @@ const (
 
 	// StoreKey defines the primary module store key.
 	StoreKey = ModuleName
+
+	// BuybackAccountName defines the name of the module account accumulating the send commissions for the buyback.
+	BuybackAccountName = "assetft_buyback"
 )
 
 // Store key prefixes.
@@ -41,6 +44,50 @@ var (
 	DEXExpectedToReceiveBalancesKeyPrefix = []byte{0x10}
 	// DEXSettingsKeyPrefix defines the key prefix for the DEX settings.
 	DEXSettingsKeyPrefix = []byte{0x11}
+	// VerifiedSymbolKeyPrefix defines the key prefix for the symbols verified by the governance.
+	VerifiedSymbolKeyPrefix = []byte{0x12}
+	// SymbolClaimKeyPrefix defines the key prefix for the pending symbol claims.
+	SymbolClaimKeyPrefix = []byte{0x13}
+	// ReferrerStatsKeyPrefix defines the key prefix for the cumulative referrer statistics.
+	ReferrerStatsKeyPrefix = []byte{0x14}
+	// SymbolReservationKeyPrefix defines the key prefix for the symbol reservations.
+	SymbolReservationKeyPrefix = []byte{0x15}
+	// MintAllowanceKeyPrefix defines the key prefix for the mint allowances.
+	MintAllowanceKeyPrefix = []byte{0x16}
+	// IssuePresetKeyPrefix defines the key prefix for the issue presets.
+	IssuePresetKeyPrefix = []byte{0x17}
+	// DustPolicyKeyPrefix defines the key prefix for the dust policies.
+	DustPolicyKeyPrefix = []byte{0x18}
+	// DustOptOutKeyPrefix defines the key prefix for the accounts excluded from the dust sweeping.
+	DustOptOutKeyPrefix = []byte{0x19}
+	// BurnPermitNonceKeyPrefix defines the key prefix for the nonces of the used burn permits.
+	BurnPermitNonceKeyPrefix = []byte{0x1a}
+	// IssuanceEscrowKeyPrefix defines the key prefix for the issuance escrows.
+	IssuanceEscrowKeyPrefix = []byte{0x1b}
+	// SendRateLimitKeyPrefix defines the key prefix for the send rate limits of the accounts.
+	SendRateLimitKeyPrefix = []byte{0x1c}
+	// SendRateLimitUsageKeyPrefix defines the key prefix for the amounts sent within the current windows.
+	SendRateLimitUsageKeyPrefix = []byte{0x1d}
+	// PendingFeatureUpdateKeyPrefix defines the key prefix for the feature updates waiting for the announcement delay.
+	PendingFeatureUpdateKeyPrefix = []byte{0x1e}
+	// PinnedExtensionCodeKeyPrefix defines the key prefix for the extension codes pinned by the module.
+	PinnedExtensionCodeKeyPrefix = []byte{0x1f}
+	// BuybackStatsKey defines the key to store the running totals of the buyback.
+	BuybackStatsKey = []byte{0x20}
+	// SupplyBreakdownKeyPrefix defines the key prefix for the cumulative amounts of the tokens minted and burnt.
+	SupplyBreakdownKeyPrefix = []byte{0x21}
+	// LegalHoldKeyPrefix defines the key prefix for the legal holds of the accounts.
+	LegalHoldKeyPrefix = []byte{0x22}
+	// FreezeExemptionKeyPrefix defines the key prefix for the accounts exempted from the global freeze.
+	FreezeExemptionKeyPrefix = []byte{0x23}
+	// RoleKeyPrefix defines the key prefix for the roles of the tokens assigned to the accounts.
+	RoleKeyPrefix = []byte{0x24}
+	// PendingRateChangeKeyPrefix defines the key prefix for the rate changes scheduled by the admins.
+	PendingRateChangeKeyPrefix = []byte{0x25}
+	// RedenominationKeyPrefix defines the key prefix for the redenominations of the tokens.
+	RedenominationKeyPrefix = []byte{0x26}
+	// IssuerRecoveryKeyPrefix defines the key prefix for the successors designated by the issuers.
+	IssuerRecoveryKeyPrefix = []byte{0x27}
 )
 
 // StoreTrue keeps a value used by stores to indicate that key is present.
@@ -101,6 +148,80 @@ func CreateDEXSettingsKey(denom string) []byte {
 	return store.JoinKeys(DEXSettingsKeyPrefix, []byte(denom))
 }
 
+// CreateVerifiedSymbolKey creates the key for the verified symbol.
+func CreateVerifiedSymbolKey(symbol string) []byte {
+	return store.JoinKeys(VerifiedSymbolKeyPrefix, []byte(NormalizeSymbolForKey(symbol)))
+}
+
+// CreateSymbolClaimKey creates the key for the pending symbol claim.
+func CreateSymbolClaimKey(symbol string) []byte {
+	return store.JoinKeys(SymbolClaimKeyPrefix, []byte(NormalizeSymbolForKey(symbol)))
+}
+
+// CreateSymbolReservationKey creates the key for the symbol reservation.
+func CreateSymbolReservationKey(symbol string) []byte {
+	return store.JoinKeys(SymbolReservationKeyPrefix, []byte(NormalizeSymbolForKey(symbol)))
+}
+
+// CreateMintAllowanceKey creates the key for the mint allowance of the grantee.
+func CreateMintAllowanceKey(denom string, grantee sdk.AccAddress) []byte {
+	return store.JoinKeys(
+		store.JoinKeys(MintAllowanceKeyPrefix, address.MustLengthPrefix([]byte(denom))),
+		address.MustLengthPrefix(grantee),
+	)
+}
+
+// CreateIssuePresetKey creates the key for the issue preset.
+func CreateIssuePresetKey(name string) []byte {
+	return store.JoinKeys(IssuePresetKeyPrefix, []byte(name))
+}
+
+// CreateDustPolicyKey creates the key for the dust policy.
+func CreateDustPolicyKey(denom string) []byte {
+	return store.JoinKeys(DustPolicyKeyPrefix, []byte(denom))
+}
+
+// CreateDustOptOutKey creates the key for the dust opt-out of the account.
+func CreateDustOptOutKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(
+		store.JoinKeys(DustOptOutKeyPrefix, address.MustLengthPrefix([]byte(denom))),
+		address.MustLengthPrefix(addr),
+	)
+}
+
+// CreateBurnPermitNonceKey creates the key for the nonce of the burn permit signed by the holder.
+func CreateBurnPermitNonceKey(holder sdk.AccAddress, nonce uint64) []byte {
+	return store.JoinKeys(
+		store.JoinKeys(BurnPermitNonceKeyPrefix, address.MustLengthPrefix(holder)),
+		sdk.Uint64ToBigEndian(nonce),
+	)
+}
+
+// CreateSendRateLimitsPrefix creates the key prefix for the send rate limits of the account.
+func CreateSendRateLimitsPrefix(account sdk.AccAddress) []byte {
+	return store.JoinKeys(SendRateLimitKeyPrefix, address.MustLengthPrefix(account))
+}
+
+// CreateSendRateLimitKey creates the key for the send rate limit of the account. The empty denom is the key of the
+// limit of all the denoms.
+func CreateSendRateLimitKey(account sdk.AccAddress, denom string) []byte {
+	return store.JoinKeys(CreateSendRateLimitsPrefix(account), []byte(denom))
+}
+
+// CreateSendRateLimitUsageKey creates the key for the amount of the denom sent by the account within the current
+// window.
+func CreateSendRateLimitUsageKey(account sdk.AccAddress, denom string) []byte {
+	return store.JoinKeys(
+		store.JoinKeys(SendRateLimitUsageKeyPrefix, address.MustLengthPrefix(account)),
+		[]byte(denom),
+	)
+}
+
+// CreateReferrerStatsKey creates the key for the referrer statistics.
+func CreateReferrerStatsKey(referrer sdk.AccAddress) []byte {
+	return store.JoinKeys(ReferrerStatsKeyPrefix, address.MustLengthPrefix(referrer))
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -126,3 +247,73 @@ func DecodeDenomFromKey(key []byte) (string, error) {
 
 	return string(key), nil
 }
+
+// CreateIssuanceEscrowKey creates the key for the issuance escrow of the denom.
+func CreateIssuanceEscrowKey(denom string) []byte {
+	return store.JoinKeys(IssuanceEscrowKeyPrefix, []byte(denom))
+}
+
+// CreatePendingFeatureUpdateKey creates the key for the pending feature update of the denom.
+func CreatePendingFeatureUpdateKey(denom string) []byte {
+	return store.JoinKeys(PendingFeatureUpdateKeyPrefix, []byte(denom))
+}
+
+// CreatePendingRateChangeKey creates the key for the pending rate change of the denom.
+func CreatePendingRateChangeKey(denom string) []byte {
+	return store.JoinKeys(PendingRateChangeKeyPrefix, []byte(denom))
+}
+
+// CreateRedenominationKey creates the key for the redenomination of the denom.
+func CreateRedenominationKey(denom string) []byte {
+	return store.JoinKeys(RedenominationKeyPrefix, []byte(denom))
+}
+
+// CreateIssuerRecoveryKey creates the key for the successor designated by the issuer.
+func CreateIssuerRecoveryKey(issuer sdk.AccAddress) []byte {
+	return store.JoinKeys(IssuerRecoveryKeyPrefix, address.MustLengthPrefix(issuer))
+}
+
+// CreateSupplyBreakdownKey creates the key for the supply breakdown of the denom.
+func CreateSupplyBreakdownKey(denom string) []byte {
+	return store.JoinKeys(SupplyBreakdownKeyPrefix, []byte(denom))
+}
+
+// CreatePinnedExtensionCodeKey creates the key for the extension code pinned by the module.
+func CreatePinnedExtensionCodeKey(codeID uint64) []byte {
+	return store.JoinKeys(PinnedExtensionCodeKeyPrefix, sdk.Uint64ToBigEndian(codeID))
+}
+
+// CreateLegalHoldsPrefix creates the key prefix for the legal holds of the account.
+func CreateLegalHoldsPrefix(account sdk.AccAddress) []byte {
+	return store.JoinKeys(LegalHoldKeyPrefix, address.MustLengthPrefix(account))
+}
+
+// CreateLegalHoldKey creates the key for the legal hold of the denom placed on the account.
+func CreateLegalHoldKey(account sdk.AccAddress, denom string) []byte {
+	return store.JoinKeys(CreateLegalHoldsPrefix(account), []byte(denom))
+}
+
+// CreateFreezeExemptionsPrefix creates the key prefix for the freeze exemptions of the denom.
+func CreateFreezeExemptionsPrefix(denom string) []byte {
+	return store.JoinKeys(FreezeExemptionKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateFreezeExemptionKey creates the key for the freeze exemption of the account.
+func CreateFreezeExemptionKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateFreezeExemptionsPrefix(denom), address.MustLengthPrefix(addr))
+}
+
+// CreateRolesPrefix creates the key prefix for the roles of the denom.
+func CreateRolesPrefix(denom string) []byte {
+	return store.JoinKeys(RoleKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateAccountRolesPrefix creates the key prefix for the roles of the denom assigned to the account.
+func CreateAccountRolesPrefix(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateRolesPrefix(denom), address.MustLengthPrefix(addr))
+}
+
+// CreateRoleKey creates the key for the role of the denom assigned to the account.
+func CreateRoleKey(denom string, addr sdk.AccAddress, role Role) []byte {
+	return store.JoinKeys(CreateAccountRolesPrefix(denom, addr), []byte{byte(role)})
+}
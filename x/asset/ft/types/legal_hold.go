@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic checks that the legal hold fields are valid.
+func (h LegalHold) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(h.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid legal hold account address: %s", err)
+	}
+
+	if _, _, err := DeconstructDenom(h.Amount.Denom); err != nil {
+		return err
+	}
+
+	if !h.Amount.IsValid() || !h.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid legal hold amount: %s", h.Amount)
+	}
+
+	if h.AdminReleaseApproved && h.AuthorityReleaseApproved {
+		return sdkerrors.Wrap(ErrInvalidInput, "legal hold approved by both parties must be released")
+	}
+
+	return nil
+}
+
+// IsReleaseApproved returns true if both the admin of the token and the legal hold authority approved the release.
+func (h LegalHold) IsReleaseApproved() bool {
+	return h.AdminReleaseApproved && h.AuthorityReleaseApproved
+}
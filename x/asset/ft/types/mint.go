@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxMintRecipients is the maximum number of recipients the tokens are minted to by one message.
+const MaxMintRecipients = 100
+
+// ValidateMintRecipients validates the recipients the minted tokens are split among. Each account can be present once
+// and the amounts must sum up to the total minted amount.
+func ValidateMintRecipients(total sdkmath.Int, recipients []MintRecipient) error {
+	if len(recipients) > MaxMintRecipients {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "at most %d recipients can be provided at once, got %d", MaxMintRecipients, len(recipients),
+		)
+	}
+
+	sum := sdkmath.ZeroInt()
+	uniqueRecipients := make(map[string]struct{}, len(recipients))
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid recipient address %s: %s", recipient.Address, err)
+		}
+		if recipient.Amount.IsNil() || !recipient.Amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "amount minted to %s must be positive", recipient.Address)
+		}
+		if _, ok := uniqueRecipients[recipient.Address]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated recipient %s", recipient.Address)
+		}
+		uniqueRecipients[recipient.Address] = struct{}{}
+		sum = sum.Add(recipient.Amount)
+	}
+
+	if !sum.Equal(total) {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "amounts of the recipients sum up to %s, but %s is minted", sum, total,
+		)
+	}
+
+	return nil
+}
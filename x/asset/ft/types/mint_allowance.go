@@ -0,0 +1,79 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateMintAllowanceTerms validates the cap and period of the mint allowance.
+func ValidateMintAllowanceTerms(mintCap sdk.Coin, period time.Duration) error {
+	if _, _, err := DeconstructDenom(mintCap.Denom); err != nil {
+		return err
+	}
+
+	if err := mintCap.Validate(); err != nil {
+		return err
+	}
+
+	if !mintCap.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "mint allowance cap must be positive")
+	}
+
+	if mintCap.Amount.GT(MaxMintableAmount) {
+		return sdkerrors.Wrap(ErrInvalidInput, "mint allowance cap is greater than maximum allowed")
+	}
+
+	if period < 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "mint allowance period must not be negative")
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that the mint allowance fields are valid.
+func (a MintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Granter); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid granter address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(a.Grantee); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid grantee address: %s", err)
+	}
+
+	if err := ValidateMintAllowanceTerms(a.Cap, a.Period); err != nil {
+		return err
+	}
+
+	if a.PeriodMinted.IsNil() || a.PeriodMinted.IsNegative() || a.PeriodMinted.GT(a.Cap.Amount) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid period minted amount: %s", a.PeriodMinted)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the allowance can't be used at the provided time anymore.
+func (a MintAllowance) IsExpired(now time.Time) bool {
+	return !now.Before(a.ExpirationTime)
+}
+
+// WithPeriodReset returns the allowance with the minted amount reset if the current period has ended at the
+// provided time. The new period starts at the end of the last elapsed one, so the windows stay aligned to the grant.
+func (a MintAllowance) WithPeriodReset(now time.Time) MintAllowance {
+	if a.Period == 0 || now.Before(a.PeriodResetTime) {
+		return a
+	}
+
+	elapsedPeriods := now.Sub(a.PeriodResetTime)/a.Period + 1
+	a.PeriodResetTime = a.PeriodResetTime.Add(elapsedPeriods * a.Period)
+	a.PeriodMinted = sdkmath.ZeroInt()
+
+	return a
+}
+
+// Remaining returns the amount which still might be minted within the current period.
+func (a MintAllowance) Remaining() sdk.Coin {
+	return sdk.NewCoin(a.Cap.Denom, a.Cap.Amount.Sub(a.PeriodMinted))
+}
@@ -31,6 +31,7 @@ var (
 	_ extendedMsg = &MsgFreeze{}
 	_ extendedMsg = &MsgUnfreeze{}
 	_ extendedMsg = &MsgSetFrozen{}
+	_ extendedMsg = &MsgFreezeRate{}
 	_ extendedMsg = &MsgGloballyFreeze{}
 	_ extendedMsg = &MsgGloballyUnfreeze{}
 	_ extendedMsg = &MsgClawback{}
@@ -40,6 +41,35 @@ var (
 	_ extendedMsg = &MsgUpdateParams{}
 	_ extendedMsg = &MsgUpdateDEXUnifiedRefAmount{}
 	_ extendedMsg = &MsgUpdateDEXWhitelistedDenoms{}
+	_ extendedMsg = &MsgClaimSymbol{}
+	_ extendedMsg = &MsgResolveSymbolClaim{}
+	_ extendedMsg = &MsgReserveSymbol{}
+	_ extendedMsg = &MsgGrantMintAllowance{}
+	_ extendedMsg = &MsgRevokeMintAllowance{}
+	_ extendedMsg = &MsgSetIssuePreset{}
+	_ extendedMsg = &MsgRemoveIssuePreset{}
+	_ extendedMsg = &MsgSetDustPolicy{}
+	_ extendedMsg = &MsgSetDustOptOut{}
+	_ extendedMsg = &MsgSweepDust{}
+	_ extendedMsg = &MsgBurnFrom{}
+	_ extendedMsg = &MsgIssueEscrowed{}
+	_ extendedMsg = &MsgSettleEscrow{}
+	_ extendedMsg = &MsgSetSendRateLimit{}
+	_ extendedMsg = &MsgUpdateFeatures{}
+	_ extendedMsg = &MsgBatchClawback{}
+	_ extendedMsg = &MsgPlaceLegalHold{}
+	_ extendedMsg = &MsgApproveLegalHoldRelease{}
+	_ extendedMsg = &MsgSetObserverContract{}
+	_ extendedMsg = &MsgSetFreezeExemption{}
+	_ extendedMsg = &MsgRemoveFreezeExemption{}
+	_ extendedMsg = &MsgAssignRole{}
+	_ extendedMsg = &MsgRevokeRole{}
+	_ extendedMsg = &MsgScheduleRateChange{}
+	_ extendedMsg = &MsgStartRedenomination{}
+	_ extendedMsg = &MsgRedenominate{}
+	_ extendedMsg = &MsgSetIssuerRecovery{}
+	_ extendedMsg = &MsgCancelIssuerRecovery{}
+	_ extendedMsg = &MsgClaimIssuerRecovery{}
 )
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
@@ -50,6 +80,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgFreeze{}, ModuleName+"/MsgFreeze")
 	legacy.RegisterAminoMsg(cdc, &MsgUnfreeze{}, ModuleName+"/MsgUnfreeze")
 	legacy.RegisterAminoMsg(cdc, &MsgSetFrozen{}, ModuleName+"/MsgSetFrozen")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeRate{}, ModuleName+"/MsgFreezeRate")
 	legacy.RegisterAminoMsg(cdc, &MsgGloballyFreeze{}, ModuleName+"/MsgGloballyFreeze")
 	legacy.RegisterAminoMsg(cdc, &MsgGloballyUnfreeze{}, ModuleName+"/MsgGloballyUnfreeze")
 	legacy.RegisterAminoMsg(cdc, &MsgSetWhitelistedLimit{}, ModuleName+"/MsgSetWhitelistedLimit")
@@ -63,6 +94,35 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(
 		cdc, &MsgUpdateDEXWhitelistedDenoms{}, ModuleName+"/MsgUpdateDEXWhitelistedDenoms",
 	)
+	legacy.RegisterAminoMsg(cdc, &MsgClaimSymbol{}, ModuleName+"/MsgClaimSymbol")
+	legacy.RegisterAminoMsg(cdc, &MsgResolveSymbolClaim{}, ModuleName+"/MsgResolveSymbolClaim")
+	legacy.RegisterAminoMsg(cdc, &MsgReserveSymbol{}, ModuleName+"/MsgReserveSymbol")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantMintAllowance{}, ModuleName+"/MsgGrantMintAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeMintAllowance{}, ModuleName+"/MsgRevokeMintAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgSetIssuePreset{}, ModuleName+"/MsgSetIssuePreset")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveIssuePreset{}, ModuleName+"/MsgRemoveIssuePreset")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDustPolicy{}, ModuleName+"/MsgSetDustPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDustOptOut{}, ModuleName+"/MsgSetDustOptOut")
+	legacy.RegisterAminoMsg(cdc, &MsgSweepDust{}, ModuleName+"/MsgSweepDust")
+	legacy.RegisterAminoMsg(cdc, &MsgBurnFrom{}, ModuleName+"/MsgBurnFrom")
+	legacy.RegisterAminoMsg(cdc, &MsgIssueEscrowed{}, ModuleName+"/MsgIssueEscrowed")
+	legacy.RegisterAminoMsg(cdc, &MsgSettleEscrow{}, ModuleName+"/MsgSettleEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSendRateLimit{}, ModuleName+"/MsgSetSendRateLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeatures{}, ModuleName+"/MsgUpdateFeatures")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchClawback{}, ModuleName+"/MsgBatchClawback")
+	legacy.RegisterAminoMsg(cdc, &MsgPlaceLegalHold{}, ModuleName+"/MsgPlaceLegalHold")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveLegalHoldRelease{}, ModuleName+"/MsgApproveLegalHoldRelease")
+	legacy.RegisterAminoMsg(cdc, &MsgSetObserverContract{}, ModuleName+"/MsgSetObserverContract")
+	legacy.RegisterAminoMsg(cdc, &MsgSetFreezeExemption{}, ModuleName+"/MsgSetFreezeExemption")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveFreezeExemption{}, ModuleName+"/MsgRemoveFreezeExemption")
+	legacy.RegisterAminoMsg(cdc, &MsgAssignRole{}, ModuleName+"/MsgAssignRole")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeRole{}, ModuleName+"/MsgRevokeRole")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleRateChange{}, ModuleName+"/MsgScheduleRateChange")
+	legacy.RegisterAminoMsg(cdc, &MsgStartRedenomination{}, ModuleName+"/MsgStartRedenomination")
+	legacy.RegisterAminoMsg(cdc, &MsgRedenominate{}, ModuleName+"/MsgRedenominate")
+	legacy.RegisterAminoMsg(cdc, &MsgSetIssuerRecovery{}, ModuleName+"/MsgSetIssuerRecovery")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelIssuerRecovery{}, ModuleName+"/MsgCancelIssuerRecovery")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimIssuerRecovery{}, ModuleName+"/MsgClaimIssuerRecovery")
 }
 
 // ValidateBasic validates the message.
@@ -106,6 +166,15 @@ func (m MsgIssue) ValidateBasic() error {
 		}
 	}
 
+	if m.Referrer != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Referrer); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid referrer %s", m.Referrer)
+		}
+		if m.Referrer == m.Issuer {
+			return sdkerrors.Wrap(ErrInvalidInput, "issuer can't be the referrer")
+		}
+	}
+
 	if len(m.Description) > MaxDescriptionLength {
 		return sdkerrors.Wrapf(
 			ErrInvalidInput,
@@ -115,6 +184,18 @@ func (m MsgIssue) ValidateBasic() error {
 		)
 	}
 
+	if m.Preset != "" {
+		if err := ValidateIssuePresetName(m.Preset); err != nil {
+			return err
+		}
+		if len(m.Features) != 0 || isRateSet(m.BurnRate) || isRateSet(m.SendCommissionRate) || m.DEXSettings != nil {
+			return sdkerrors.Wrap(
+				ErrInvalidInput,
+				"features, burn rate, send commission rate and DEX settings must not be provided with the preset",
+			)
+		}
+	}
+
 	duplicates := lo.FindDuplicates(m.Features)
 	if len(duplicates) != 0 {
 		return sdkerrors.Wrapf(ErrInvalidInput, "duplicated features in the features list, duplicates: %v", duplicates)
@@ -151,7 +232,17 @@ func (m MsgMint) ValidateBasic() error {
 		return err
 	}
 
-	return m.Coin.Validate()
+	if err := m.Coin.Validate(); err != nil {
+		return err
+	}
+
+	if len(m.Recipients) == 0 {
+		return nil
+	}
+	if m.Recipient != "" {
+		return sdkerrors.Wrap(ErrInvalidInput, "recipient and recipients can't be provided together")
+	}
+	return ValidateMintRecipients(m.Coin.Amount, m.Recipients)
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -220,6 +311,23 @@ func (m MsgSetFrozen) ValidateBasic() error {
 	return m.Coin.Validate()
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgFreezeRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateFreezeRate(m.Rate); err != nil {
+		return err
+	}
+
+	return validateAccountsBatch(m.Accounts, MaxFreezeRateBatchSize)
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m MsgGloballyFreeze) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -256,6 +364,12 @@ func (m MsgClawback) ValidateBasic() error {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid recipient address")
+		}
+	}
+
 	_, _, err := DeconstructDenom(m.Coin.Denom)
 	if err != nil {
 		return err
@@ -264,6 +378,21 @@ func (m MsgClawback) ValidateBasic() error {
 	return m.Coin.Validate()
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgBatchClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid recipient address")
+		}
+	}
+
+	return ValidateClawbackEntries(m.Entries)
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m MsgSetWhitelistedLimit) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -279,7 +408,18 @@ func (m MsgSetWhitelistedLimit) ValidateBasic() error {
 		return err
 	}
 
-	return m.Coin.Validate()
+	if err := m.Coin.Validate(); err != nil {
+		return err
+	}
+
+	if m.Relative && m.Coin.Amount.GT(MaxMintableAmount) {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"relative whitelisted limit is greater than maximum allowed, it is added to the balance at the execution time",
+		)
+	}
+
+	return nil
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -340,3 +480,451 @@ func (m MsgUpdateDEXWhitelistedDenoms) ValidateBasic() error {
 
 	return ValidateWhitelistedDenoms(m.WhitelistedDenoms)
 }
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgClaimSymbol) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgResolveSymbolClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateSymbol(m.Symbol)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgReserveSymbol) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid issuer %s", m.Issuer)
+	}
+
+	if err := ValidateSubunit(m.Subunit); err != nil {
+		return err
+	}
+
+	return ValidateSymbol(m.Symbol)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgGrantMintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid grantee address")
+	}
+
+	if m.Sender == m.Grantee {
+		return sdkerrors.Wrap(ErrInvalidInput, "sender can't grant mint allowance to itself")
+	}
+
+	return ValidateMintAllowanceTerms(m.Cap, m.Period)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRevokeMintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid grantee address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetIssuePreset) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return m.Preset.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRemoveIssuePreset) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateIssuePresetName(m.Name)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetDustPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateDustPolicyTerms(m.Threshold, m.Destination)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetDustOptOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSweepDust) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateDustSweepAccounts(m.Accounts)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgBurnFrom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := m.Coin.Validate(); err != nil {
+		return err
+	}
+
+	if !m.Coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "burn amount must be positive")
+	}
+
+	if err := ValidateBurnPermitPubKey(m.PubKey); err != nil {
+		return err
+	}
+
+	if len(m.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBurnPermit, "signature must be provided")
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgIssueEscrowed) ValidateBasic() error {
+	if err := m.Issue.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !m.Issue.InitialAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "initial amount of the escrowed issuance must be positive")
+	}
+
+	if m.Issue.ExtensionSettings != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, "tokens with the extension can't be issued with the escrow")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid buyer %s", m.Buyer)
+	}
+
+	if m.Buyer == m.Issue.Issuer {
+		return sdkerrors.Wrap(ErrInvalidInput, "buyer must be different from the issuer")
+	}
+
+	if err := m.Payment.Validate(); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidCoins, "invalid payment: %s", err)
+	}
+
+	if !m.Payment.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "payment must be positive")
+	}
+
+	if m.SettlementPeriod <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "settlement period must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSettleEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid buyer address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetSendRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return ValidateSendRateLimitTerms(m.Denom, m.Amount, m.Window)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpdateFeatures) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateFeatureUpdate(m.EnableFeatures, m.DisableFeatures)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgPlaceLegalHold) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := m.Coin.Validate(); err != nil {
+		return err
+	}
+	if !m.Coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "legal hold amount should be positive")
+	}
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgApproveLegalHoldRelease) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetObserverContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if m.Contract != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid contract address")
+		}
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetFreezeExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if m.ExpirationTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "expiration time must be set")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRemoveFreezeExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgAssignRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ValidateRole(m.Role); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRevokeRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ValidateRole(m.Role); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgScheduleRateChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ValidateRateChange(m.BurnRate, m.SendCommissionRate); err != nil {
+		return err
+	}
+
+	if m.EffectiveTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "effective time must be set")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgStartRedenomination) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ValidateSubunit(m.NewSubunit); err != nil {
+		return err
+	}
+
+	if err := ValidateSymbol(m.NewSymbol); err != nil {
+		return err
+	}
+
+	if err := ValidateRedenominationRatio(m.Ratio); err != nil {
+		return err
+	}
+
+	if m.SwapDeadline.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "swap deadline must be set")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRedenominate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := m.Coin.Validate(); err != nil {
+		return err
+	}
+
+	if !m.Coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "redenominated amount must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetIssuerRecovery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Successor); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid successor address")
+	}
+
+	if m.Sender == m.Successor {
+		return sdkerrors.Wrap(ErrInvalidInput, "issuer can't be its own successor")
+	}
+
+	return ValidateIssuerRecoveryInactivityPeriod(m.InactivityPeriod)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgCancelIssuerRecovery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgClaimIssuerRecovery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid issuer address")
+	}
+
+	return nil
+}
@@ -1,13 +1,16 @@
 package types_test
 
 import (
+	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/samber/lo"
@@ -63,6 +66,55 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 			},
 			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
+		{
+			name: "valid_referrer",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Referrer = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+				return msg
+			},
+		},
+		{
+			name: "invalid_referrer_address",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Referrer = "invalid"
+				return msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid_referrer_is_issuer",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Referrer = msg.Issuer
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_preset",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Preset = "security-token-v1"
+				msg.DEXSettings = nil
+				return msg
+			},
+		},
+		{
+			name: "invalid_preset_with_dex_settings",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Preset = "security-token-v1"
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_preset_with_features",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Preset = "security-token-v1"
+				msg.DEXSettings = nil
+				msg.Features = []types.Feature{types.Feature_minting}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid_missing_symbol",
 			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
@@ -346,6 +398,7 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 	type M = types.MsgMint
 
 	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	defaultMsg := func() M {
 		return M{
 			Sender: acc.String(),
@@ -372,6 +425,80 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 			modifyMsg:   func(m M) M { m.Coin = sdk.Coin{}; return m },
 			expectError: true,
 		},
+		{
+			name: "recipients",
+			modifyMsg: func(m M) M {
+				m.Recipients = []types.MintRecipient{
+					{Address: acc.String(), Amount: sdkmath.NewInt(60)},
+					{Address: recipient.String(), Amount: sdkmath.NewInt(40)},
+				}
+				return m
+			},
+		},
+		{
+			name: "recipient and recipients",
+			modifyMsg: func(m M) M {
+				m.Recipient = recipient.String()
+				m.Recipients = []types.MintRecipient{{Address: acc.String(), Amount: sdkmath.NewInt(100)}}
+				return m
+			},
+			expectError: true,
+		},
+		{
+			name: "recipients amounts don't sum up to the minted amount",
+			modifyMsg: func(m M) M {
+				m.Recipients = []types.MintRecipient{
+					{Address: acc.String(), Amount: sdkmath.NewInt(60)},
+					{Address: recipient.String(), Amount: sdkmath.NewInt(41)},
+				}
+				return m
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicated recipient",
+			modifyMsg: func(m M) M {
+				m.Recipients = []types.MintRecipient{
+					{Address: acc.String(), Amount: sdkmath.NewInt(60)},
+					{Address: acc.String(), Amount: sdkmath.NewInt(40)},
+				}
+				return m
+			},
+			expectError: true,
+		},
+		{
+			name: "zero recipient amount",
+			modifyMsg: func(m M) M {
+				m.Recipients = []types.MintRecipient{
+					{Address: acc.String(), Amount: sdkmath.NewInt(100)},
+					{Address: recipient.String(), Amount: sdkmath.ZeroInt()},
+				}
+				return m
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid recipient address",
+			modifyMsg: func(m M) M {
+				m.Recipients = []types.MintRecipient{{Address: "invalid", Amount: sdkmath.NewInt(100)}}
+				return m
+			},
+			expectError: true,
+		},
+		{
+			name: "too many recipients",
+			modifyMsg: func(m M) M {
+				m.Coin.Amount = sdkmath.NewInt(types.MaxMintRecipients + 1)
+				for range types.MaxMintRecipients + 1 {
+					m.Recipients = append(m.Recipients, types.MintRecipient{
+						Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+						Amount:  sdkmath.OneInt(),
+					})
+				}
+				return m
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -473,6 +600,122 @@ func TestMsgClawback_ValidateBasic(t *testing.T) {
 			},
 			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
+		{
+			name: "valid msg with recipient",
+			message: types.MsgClawback{
+				Sender:    "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:   "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+				Recipient: "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdkmath.NewInt(100),
+				},
+			},
+		},
+		{
+			name: "invalid recipient",
+			message: types.MsgClawback{
+				Sender:    "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:   "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+				Recipient: "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h+",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdkmath.NewInt(100),
+				},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgBatchClawback_ValidateBasic(t *testing.T) {
+	const denom = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	validEntry := types.ClawbackEntry{
+		Account: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+		Coin:    sdk.NewInt64Coin(denom, 100),
+	}
+
+	testCases := []struct {
+		name          string
+		message       types.MsgBatchClawback
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgBatchClawback{
+				Sender:    "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Recipient: "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h",
+				Entries: []types.ClawbackEntry{
+					validEntry,
+					{Account: "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h", Coin: sdk.NewInt64Coin(denom, 1)},
+				},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgBatchClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Entries: []types.ClawbackEntry{validEntry},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid recipient",
+			message: types.MsgBatchClawback{
+				Sender:    "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Recipient: "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h+",
+				Entries:   []types.ClawbackEntry{validEntry},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "no entries",
+			message: types.MsgBatchClawback{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgBatchClawback{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Entries: []types.ClawbackEntry{
+					{Account: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s+", Coin: sdk.NewInt64Coin(denom, 100)},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero amount",
+			message: types.MsgBatchClawback{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Entries: []types.ClawbackEntry{
+					{Account: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s", Coin: sdk.NewInt64Coin(denom, 0)},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated entry",
+			message: types.MsgBatchClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Entries: []types.ClawbackEntry{validEntry, validEntry},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -543,6 +786,31 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 			},
 			expectedErrorString: "invalid denom",
 		},
+		{
+			name: "valid relative msg",
+			message: types.MsgSetWhitelistedLimit{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdkmath.NewInt(100),
+				},
+				Relative: true,
+			},
+		},
+		{
+			name: "relative amount is too big",
+			message: types.MsgSetWhitelistedLimit{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: types.MaxMintableAmount.AddRaw(1),
+				},
+				Relative: true,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -773,79 +1041,512 @@ func TestMsgUpdateDEXWhitelistedDenoms_ValidateBasic(t *testing.T) {
 }
 
 //nolint:lll // we don't care about test strings
-func TestAmino(t *testing.T) {
-	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
-	coin := sdk.NewInt64Coin("my-denom", 1)
-
-	tests := []struct {
-		name          string
-		msg           sdk.Msg
-		wantAminoJSON string
+func TestMsgGrantMintAllowance_ValidateBasic(t *testing.T) {
+	const denom = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	testCases := []struct {
+		name                string
+		message             types.MsgGrantMintAllowance
+		expectedError       error
+		expectedErrorString string
 	}{
 		{
-			name: sdk.MsgTypeURL(&types.MsgIssue{}),
-			msg: &types.MsgIssue{
-				Issuer: address,
-				Symbol: "ABC",
+			name: "valid msg",
+			message: types.MsgGrantMintAllowance{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Grantee: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+				Cap:     sdk.NewInt64Coin(denom, 100),
+				Period:  time.Hour,
 			},
-			wantAminoJSON: `{"type":"assetft/MsgIssue","value":{"issuer":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","symbol":"ABC","initial_amount":"0","burn_rate":"0","send_commission_rate":"0"}}`,
 		},
 		{
-			name: sdk.MsgTypeURL(&types.MsgMint{}),
-			msg: &types.MsgMint{
-				Sender: address,
-				Coin:   coin,
+			name: "invalid grantee address",
+			message: types.MsgGrantMintAllowance{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Grantee: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s+",
+				Cap:     sdk.NewInt64Coin(denom, 100),
 			},
-			wantAminoJSON: `{"type":"assetft/MsgMint","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
 		{
-			name: sdk.MsgTypeURL(&types.MsgBurn{}),
-			msg: &types.MsgBurn{
-				Sender: address,
-				Coin:   coin,
+			name: "grant to itself",
+			message: types.MsgGrantMintAllowance{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Grantee: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Cap:     sdk.NewInt64Coin(denom, 100),
 			},
-			wantAminoJSON: `{"type":"assetft/MsgBurn","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+			expectedError: types.ErrInvalidInput,
 		},
 		{
-			name: sdk.MsgTypeURL(&types.MsgFreeze{}),
-			msg: &types.MsgFreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "zero cap",
+			message: types.MsgGrantMintAllowance{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Grantee: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+				Cap:     sdk.NewInt64Coin(denom, 0),
 			},
-			wantAminoJSON: `{"type":"assetft/MsgFreeze","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+			expectedError: types.ErrInvalidInput,
 		},
 		{
-			name: sdk.MsgTypeURL(&types.MsgUnfreeze{}),
-			msg: &types.MsgUnfreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "negative period",
+			message: types.MsgGrantMintAllowance{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Grantee: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+				Cap:     sdk.NewInt64Coin(denom, 100),
+				Period:  -time.Hour,
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+			expectedError: types.ErrInvalidInput,
 		},
 		{
-			name: sdk.MsgTypeURL(&types.MsgSetFrozen{}),
-			msg: &types.MsgSetFrozen{
-				Sender:  address,
-				Account: address,
-				Coin:    coin,
+			name: "invalid denom",
+			message: types.MsgGrantMintAllowance{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Grantee: "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s",
+				Cap:     sdk.NewInt64Coin("abc", 100),
 			},
-			wantAminoJSON: `{"type":"assetft/MsgSetFrozen","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+			expectedErrorString: "invalid denom",
 		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			switch {
+			case tc.expectedError == nil && tc.expectedErrorString == "":
+				requireT.NoError(err)
+			case tc.expectedErrorString != "":
+				requireT.Contains(err.Error(), tc.expectedErrorString)
+			default:
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgFreezeRate_ValidateBasic(t *testing.T) {
+	const (
+		denom   = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		sender  = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		account = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+	)
+	tooManyAccounts := make([]string, types.MaxFreezeRateBatchSize+1)
+	for i := range tooManyAccounts {
+		tooManyAccounts[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i)}, 20)).String()
+	}
+
+	testCases := []struct {
+		name          string
+		message       types.MsgFreezeRate
+		expectedError error
+	}{
 		{
-			name: sdk.MsgTypeURL(&types.MsgGloballyFreeze{}),
-			msg: &types.MsgGloballyFreeze{
-				Sender: address,
-				Denom:  coin.Denom,
+			name: "valid msg",
+			message: types.MsgFreezeRate{
+				Sender:   sender,
+				Denom:    denom,
+				Rate:     sdkmath.LegacyMustNewDecFromStr("0.25"),
+				Accounts: []string{account},
 			},
-			wantAminoJSON: `{"type":"assetft/MsgGloballyFreeze","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom"}}`,
 		},
 		{
-			name: sdk.MsgTypeURL(&types.MsgGloballyUnfreeze{}),
-			msg: &types.MsgGloballyUnfreeze{
-				Sender: address,
-				Denom:  coin.Denom,
+			name: "invalid sender address",
+			message: types.MsgFreezeRate{
+				Sender:   sender + "+",
+				Denom:    denom,
+				Rate:     sdkmath.LegacyMustNewDecFromStr("0.25"),
+				Accounts: []string{account},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "negative rate",
+			message: types.MsgFreezeRate{
+				Sender:   sender,
+				Denom:    denom,
+				Rate:     sdkmath.LegacyMustNewDecFromStr("-0.25"),
+				Accounts: []string{account},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "rate greater than one",
+			message: types.MsgFreezeRate{
+				Sender:   sender,
+				Denom:    denom,
+				Rate:     sdkmath.LegacyMustNewDecFromStr("1.01"),
+				Accounts: []string{account},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "no accounts",
+			message: types.MsgFreezeRate{
+				Sender: sender,
+				Denom:  denom,
+				Rate:   sdkmath.LegacyOneDec(),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated account",
+			message: types.MsgFreezeRate{
+				Sender:   sender,
+				Denom:    denom,
+				Rate:     sdkmath.LegacyOneDec(),
+				Accounts: []string{account, account},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too many accounts",
+			message: types.MsgFreezeRate{
+				Sender:   sender,
+				Denom:    denom,
+				Rate:     sdkmath.LegacyOneDec(),
+				Accounts: tooManyAccounts,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgSweepDust_ValidateBasic(t *testing.T) {
+	const (
+		denom   = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		sender  = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		account = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+	)
+	tooManyAccounts := make([]string, types.MaxDustSweepBatchSize+1)
+	for i := range tooManyAccounts {
+		tooManyAccounts[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i)}, 20)).String()
+	}
+
+	testCases := []struct {
+		name          string
+		message       types.MsgSweepDust
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSweepDust{
+				Sender:   sender,
+				Denom:    denom,
+				Accounts: []string{sender, account},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSweepDust{
+				Sender:   sender + "+",
+				Denom:    denom,
+				Accounts: []string{account},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "no accounts",
+			message: types.MsgSweepDust{
+				Sender: sender,
+				Denom:  denom,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgSweepDust{
+				Sender:   sender,
+				Denom:    denom,
+				Accounts: []string{account + "+"},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated account",
+			message: types.MsgSweepDust{
+				Sender:   sender,
+				Denom:    denom,
+				Accounts: []string{account, account},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too many accounts",
+			message: types.MsgSweepDust{
+				Sender:   sender,
+				Denom:    denom,
+				Accounts: tooManyAccounts,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgSetDustPolicy_ValidateBasic(t *testing.T) {
+	const (
+		denom  = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		sender = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+	testCases := []struct {
+		name          string
+		message       types.MsgSetDustPolicy
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetDustPolicy{
+				Sender:      sender,
+				Denom:       denom,
+				Threshold:   sdkmath.NewInt(100),
+				Destination: types.DUST_DESTINATION_COMMUNITY_POOL,
+			},
+		},
+		{
+			name: "zero threshold removing the policy",
+			message: types.MsgSetDustPolicy{
+				Sender:    sender,
+				Denom:     denom,
+				Threshold: sdkmath.ZeroInt(),
+			},
+		},
+		{
+			name: "negative threshold",
+			message: types.MsgSetDustPolicy{
+				Sender:    sender,
+				Denom:     denom,
+				Threshold: sdkmath.NewInt(-1),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "unknown destination",
+			message: types.MsgSetDustPolicy{
+				Sender:      sender,
+				Denom:       denom,
+				Threshold:   sdkmath.NewInt(100),
+				Destination: 5,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgBurnFrom_ValidateBasic(t *testing.T) {
+	const (
+		sender  = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		account = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+	)
+	coin := sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100)
+	pubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	signature := bytes.Repeat([]byte{0x01}, 64)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgBurnFrom
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgBurnFrom{
+				Sender:    sender,
+				Account:   account,
+				Coin:      coin,
+				PubKey:    pubKey,
+				Signature: signature,
 			},
-			wantAminoJSON: `{"type":"assetft/MsgGloballyUnfreeze","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom"}}`,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgBurnFrom{
+				Sender:    sender,
+				Account:   "invalid",
+				Coin:      coin,
+				PubKey:    pubKey,
+				Signature: signature,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero amount",
+			message: types.MsgBurnFrom{
+				Sender:    sender,
+				Account:   account,
+				Coin:      sdk.NewInt64Coin(coin.Denom, 0),
+				PubKey:    pubKey,
+				Signature: signature,
+			},
+			expectedError: cosmoserrors.ErrInvalidCoins,
+		},
+		{
+			name: "invalid public key",
+			message: types.MsgBurnFrom{
+				Sender:    sender,
+				Account:   account,
+				Coin:      coin,
+				PubKey:    pubKey[1:],
+				Signature: signature,
+			},
+			expectedError: cosmoserrors.ErrInvalidPubKey,
+		},
+		{
+			name: "missing signature",
+			message: types.MsgBurnFrom{
+				Sender:  sender,
+				Account: account,
+				Coin:    coin,
+				PubKey:  pubKey,
+			},
+			expectedError: types.ErrInvalidBurnPermit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestAmino(t *testing.T) {
+	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	coin := sdk.NewInt64Coin("my-denom", 1)
+
+	tests := []struct {
+		name          string
+		msg           sdk.Msg
+		wantAminoJSON string
+	}{
+		{
+			name: sdk.MsgTypeURL(&types.MsgIssue{}),
+			msg: &types.MsgIssue{
+				Issuer: address,
+				Symbol: "ABC",
+			},
+			wantAminoJSON: `{"type":"assetft/MsgIssue","value":{"issuer":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","symbol":"ABC","initial_amount":"0","burn_rate":"0","send_commission_rate":"0"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgMint{}),
+			msg: &types.MsgMint{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgMint","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgBurn{}),
+			msg: &types.MsgBurn{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgBurn","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgFreeze{}),
+			msg: &types.MsgFreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgFreeze","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgUnfreeze{}),
+			msg: &types.MsgUnfreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSetFrozen{}),
+			msg: &types.MsgSetFrozen{
+				Sender:  address,
+				Account: address,
+				Coin:    coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgSetFrozen","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"}}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgFreezeRate{}),
+			msg: &types.MsgFreezeRate{
+				Sender:   address,
+				Denom:    "my-denom",
+				Rate:     sdkmath.LegacyMustNewDecFromStr("0.5"),
+				Accounts: []string{address},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgFreezeRate","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","rate":"0.500000000000000000","accounts":["devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"]}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgBurnFrom{}),
+			msg: &types.MsgBurnFrom{
+				Sender:    address,
+				Account:   address,
+				Coin:      coin,
+				Nonce:     1,
+				PubKey:    []byte{0x01},
+				Signature: []byte{0x02},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgBurnFrom","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"denom":"my-denom","amount":"1"},"nonce":"1","expiration_time":"0001-01-01T00:00:00Z","pub_key":"AQ==","signature":"Ag=="}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgGloballyFreeze{}),
+			msg: &types.MsgGloballyFreeze{
+				Sender: address,
+				Denom:  coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgGloballyFreeze","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgGloballyUnfreeze{}),
+			msg: &types.MsgGloballyUnfreeze{
+				Sender: address,
+				Denom:  coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgGloballyUnfreeze","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom"}}`,
 		},
 		{
 			name: sdk.MsgTypeURL(&types.MsgSetWhitelistedLimit{}),
@@ -885,3 +1586,947 @@ func TestAmino(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgIssueEscrowed_ValidateBasic(t *testing.T) {
+	const (
+		issuer = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		buyer  = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+	)
+	validMessage := types.MsgIssueEscrowed{
+		Issue: types.MsgIssue{
+			Issuer:        issuer,
+			Symbol:        "ABC",
+			Subunit:       "uabc",
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(100),
+		},
+		Buyer:            buyer,
+		Payment:          sdk.NewInt64Coin(constant.DenomDev, 1_000),
+		SettlementPeriod: time.Hour,
+	}
+
+	testCases := []struct {
+		name          string
+		message       func() types.MsgIssueEscrowed
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: func() types.MsgIssueEscrowed {
+				return validMessage
+			},
+		},
+		{
+			name: "invalid issue",
+			message: func() types.MsgIssueEscrowed {
+				msg := validMessage
+				msg.Issue.Subunit = ""
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero initial amount",
+			message: func() types.MsgIssueEscrowed {
+				msg := validMessage
+				msg.Issue.InitialAmount = sdkmath.ZeroInt()
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "extension",
+			message: func() types.MsgIssueEscrowed {
+				msg := validMessage
+				msg.Issue.ExtensionSettings = &types.ExtensionIssueSettings{CodeId: 1}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid buyer",
+			message: func() types.MsgIssueEscrowed {
+				msg := validMessage
+				msg.Buyer = "invalid"
+				return msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "buyer is issuer",
+			message: func() types.MsgIssueEscrowed {
+				msg := validMessage
+				msg.Buyer = issuer
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero payment",
+			message: func() types.MsgIssueEscrowed {
+				msg := validMessage
+				msg.Payment = sdk.NewInt64Coin(constant.DenomDev, 0)
+				return msg
+			},
+			expectedError: cosmoserrors.ErrInvalidCoins,
+		},
+		{
+			name: "zero settlement period",
+			message: func() types.MsgIssueEscrowed {
+				msg := validMessage
+				msg.SettlementPeriod = 0
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message().ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgSettleEscrow_ValidateBasic(t *testing.T) {
+	const buyer = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+
+	testCases := []struct {
+		name          string
+		message       types.MsgSettleEscrow
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSettleEscrow{
+				Buyer: buyer,
+				Denom: "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid buyer",
+			message: types.MsgSettleEscrow{
+				Buyer: "invalid",
+				Denom: "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSettleEscrow{
+				Buyer: buyer,
+				Denom: "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgSetSendRateLimit_ValidateBasic(t *testing.T) {
+	const sender = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+
+	testCases := []struct {
+		name          string
+		message       types.MsgSetSendRateLimit
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetSendRateLimit{
+				Sender: sender,
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount: sdkmath.NewInt(100),
+				Window: time.Hour,
+			},
+		},
+		{
+			name: "valid msg limiting all the denoms",
+			message: types.MsgSetSendRateLimit{
+				Sender: sender,
+				Amount: sdkmath.NewInt(100),
+				Window: time.Hour,
+			},
+		},
+		{
+			name: "zero amount removing the limit",
+			message: types.MsgSetSendRateLimit{
+				Sender: sender,
+				Amount: sdkmath.ZeroInt(),
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgSetSendRateLimit{
+				Sender: "invalid",
+				Amount: sdkmath.NewInt(100),
+				Window: time.Hour,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetSendRateLimit{
+				Sender: sender,
+				Denom:  "1",
+				Amount: sdkmath.NewInt(100),
+				Window: time.Hour,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "negative amount",
+			message: types.MsgSetSendRateLimit{
+				Sender: sender,
+				Amount: sdkmath.NewInt(-1),
+				Window: time.Hour,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero window",
+			message: types.MsgSetSendRateLimit{
+				Sender: sender,
+				Amount: sdkmath.NewInt(100),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateFeatures_ValidateBasic(t *testing.T) {
+	const (
+		sender = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		denom  = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgUpdateFeatures
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgUpdateFeatures{
+				Sender:          sender,
+				Denom:           denom,
+				EnableFeatures:  []types.Feature{types.Feature_freezing},
+				DisableFeatures: []types.Feature{types.Feature_minting},
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgUpdateFeatures{
+				Sender:         "invalid",
+				Denom:          denom,
+				EnableFeatures: []types.Feature{types.Feature_freezing},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgUpdateFeatures{
+				Sender:         sender,
+				Denom:          "abc",
+				EnableFeatures: []types.Feature{types.Feature_freezing},
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "empty update",
+			message: types.MsgUpdateFeatures{
+				Sender: sender,
+				Denom:  denom,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "feature both enabled and disabled",
+			message: types.MsgUpdateFeatures{
+				Sender:          sender,
+				Denom:           denom,
+				EnableFeatures:  []types.Feature{types.Feature_freezing},
+				DisableFeatures: []types.Feature{types.Feature_freezing},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "immutable feature",
+			message: types.MsgUpdateFeatures{
+				Sender:          sender,
+				Denom:           denom,
+				DisableFeatures: []types.Feature{types.Feature_whitelisting},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgPlaceLegalHold_ValidateBasic(t *testing.T) {
+	const (
+		sender  = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		account = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+		denom   = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgPlaceLegalHold
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgPlaceLegalHold{
+				Sender:  sender,
+				Account: account,
+				Coin:    sdk.NewInt64Coin(denom, 100),
+			},
+		},
+		{
+			name: "invalid account",
+			message: types.MsgPlaceLegalHold{
+				Sender:  sender,
+				Account: "invalid",
+				Coin:    sdk.NewInt64Coin(denom, 100),
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgPlaceLegalHold{
+				Sender:  sender,
+				Account: account,
+				Coin:    sdk.NewInt64Coin("abc", 100),
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "zero amount",
+			message: types.MsgPlaceLegalHold{
+				Sender:  sender,
+				Account: account,
+				Coin:    sdk.NewInt64Coin(denom, 0),
+			},
+			expectedError: cosmoserrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgSetObserverContract_ValidateBasic(t *testing.T) {
+	const (
+		sender   = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		contract = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+		denom    = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgSetObserverContract
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetObserverContract{
+				Sender:   sender,
+				Denom:    denom,
+				Contract: contract,
+			},
+		},
+		{
+			name: "valid msg removing the observer",
+			message: types.MsgSetObserverContract{
+				Sender: sender,
+				Denom:  denom,
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgSetObserverContract{
+				Sender:   "invalid",
+				Denom:    denom,
+				Contract: contract,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid contract",
+			message: types.MsgSetObserverContract{
+				Sender:   sender,
+				Denom:    denom,
+				Contract: "invalid",
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetObserverContract{
+				Sender:   sender,
+				Denom:    "abc",
+				Contract: contract,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgSetFreezeExemption_ValidateBasic(t *testing.T) {
+	const (
+		sender  = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		account = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+		denom   = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+	expirationTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgSetFreezeExemption
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetFreezeExemption{
+				Sender:         sender,
+				Account:        account,
+				Denom:          denom,
+				ExpirationTime: expirationTime,
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgSetFreezeExemption{
+				Sender:         "invalid",
+				Account:        account,
+				Denom:          denom,
+				ExpirationTime: expirationTime,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgSetFreezeExemption{
+				Sender:         sender,
+				Account:        "invalid",
+				Denom:          denom,
+				ExpirationTime: expirationTime,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "missing expiration time",
+			message: types.MsgSetFreezeExemption{
+				Sender:  sender,
+				Account: account,
+				Denom:   denom,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetFreezeExemption{
+				Sender:         sender,
+				Account:        account,
+				Denom:          "abc",
+				ExpirationTime: expirationTime,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgAssignRole_ValidateBasic(t *testing.T) {
+	const (
+		sender  = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		account = "devcore1szhvg2jzfpj5f4jtgzgjrul9292vh4st57td6s"
+		denom   = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgAssignRole
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgAssignRole{
+				Sender:  sender,
+				Account: account,
+				Denom:   denom,
+				Role:    types.ROLE_COMPLIANCE,
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgAssignRole{
+				Sender:  "invalid",
+				Account: account,
+				Denom:   denom,
+				Role:    types.ROLE_COMPLIANCE,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgAssignRole{
+				Sender:  sender,
+				Account: "invalid",
+				Denom:   denom,
+				Role:    types.ROLE_COMPLIANCE,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "unspecified role",
+			message: types.MsgAssignRole{
+				Sender:  sender,
+				Account: account,
+				Denom:   denom,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "unknown role",
+			message: types.MsgAssignRole{
+				Sender:  sender,
+				Account: account,
+				Denom:   denom,
+				Role:    types.Role(100),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgAssignRole{
+				Sender:  sender,
+				Account: account,
+				Denom:   "abc",
+				Role:    types.ROLE_COMPLIANCE,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgScheduleRateChange_ValidateBasic(t *testing.T) {
+	const (
+		sender = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		denom  = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+	effectiveTime := time.Unix(1_700_000_000, 0).UTC()
+
+	testCases := []struct {
+		name          string
+		message       types.MsgScheduleRateChange
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgScheduleRateChange{
+				Sender:             sender,
+				Denom:              denom,
+				BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.01"),
+				SendCommissionRate: sdkmath.LegacyZeroDec(),
+				EffectiveTime:      effectiveTime,
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgScheduleRateChange{
+				Sender:             "invalid",
+				Denom:              denom,
+				BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.01"),
+				SendCommissionRate: sdkmath.LegacyZeroDec(),
+				EffectiveTime:      effectiveTime,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgScheduleRateChange{
+				Sender:             sender,
+				Denom:              "abc",
+				BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.01"),
+				SendCommissionRate: sdkmath.LegacyZeroDec(),
+				EffectiveTime:      effectiveTime,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "rate not set",
+			message: types.MsgScheduleRateChange{
+				Sender:        sender,
+				Denom:         denom,
+				BurnRate:      sdkmath.LegacyMustNewDecFromStr("0.01"),
+				EffectiveTime: effectiveTime,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid rate",
+			message: types.MsgScheduleRateChange{
+				Sender:             sender,
+				Denom:              denom,
+				BurnRate:           sdkmath.LegacyMustNewDecFromStr("1.1"),
+				SendCommissionRate: sdkmath.LegacyZeroDec(),
+				EffectiveTime:      effectiveTime,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "effective time not set",
+			message: types.MsgScheduleRateChange{
+				Sender:             sender,
+				Denom:              denom,
+				BurnRate:           sdkmath.LegacyMustNewDecFromStr("0.01"),
+				SendCommissionRate: sdkmath.LegacyZeroDec(),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgStartRedenomination_ValidateBasic(t *testing.T) {
+	const (
+		sender = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		denom  = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+	swapDeadline := time.Unix(1_700_000_000, 0).UTC()
+
+	testCases := []struct {
+		name          string
+		message       types.MsgStartRedenomination
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgStartRedenomination{
+				Sender:       sender,
+				Denom:        denom,
+				NewSubunit:   "abcx",
+				NewSymbol:    "ABCX",
+				Ratio:        10,
+				SwapDeadline: swapDeadline,
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgStartRedenomination{
+				Sender:       "invalid",
+				Denom:        denom,
+				NewSubunit:   "abcx",
+				NewSymbol:    "ABCX",
+				Ratio:        10,
+				SwapDeadline: swapDeadline,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgStartRedenomination{
+				Sender:       sender,
+				Denom:        "abc",
+				NewSubunit:   "abcx",
+				NewSymbol:    "ABCX",
+				Ratio:        10,
+				SwapDeadline: swapDeadline,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "invalid new subunit",
+			message: types.MsgStartRedenomination{
+				Sender:       sender,
+				Denom:        denom,
+				NewSubunit:   "ABC X",
+				NewSymbol:    "ABCX",
+				Ratio:        10,
+				SwapDeadline: swapDeadline,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid new symbol",
+			message: types.MsgStartRedenomination{
+				Sender:       sender,
+				Denom:        denom,
+				NewSubunit:   "abcx",
+				NewSymbol:    "ABC X",
+				Ratio:        10,
+				SwapDeadline: swapDeadline,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero ratio",
+			message: types.MsgStartRedenomination{
+				Sender:       sender,
+				Denom:        denom,
+				NewSubunit:   "abcx",
+				NewSymbol:    "ABCX",
+				SwapDeadline: swapDeadline,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "swap deadline not set",
+			message: types.MsgStartRedenomination{
+				Sender:     sender,
+				Denom:      denom,
+				NewSubunit: "abcx",
+				NewSymbol:  "ABCX",
+				Ratio:      10,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgRedenominate_ValidateBasic(t *testing.T) {
+	const (
+		sender = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		denom  = "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgRedenominate
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgRedenominate{
+				Sender: sender,
+				Coin:   sdk.NewInt64Coin(denom, 100),
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgRedenominate{
+				Sender: "invalid",
+				Coin:   sdk.NewInt64Coin(denom, 100),
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgRedenominate{
+				Sender: sender,
+				Coin:   sdk.NewInt64Coin("abc", 100),
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "zero amount",
+			message: types.MsgRedenominate{
+				Sender: sender,
+				Coin:   sdk.NewInt64Coin(denom, 0),
+			},
+			expectedError: cosmoserrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgSetIssuerRecovery_ValidateBasic(t *testing.T) {
+	const (
+		sender    = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+		successor = "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h"
+	)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgSetIssuerRecovery
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetIssuerRecovery{
+				Sender:           sender,
+				Successor:        successor,
+				InactivityPeriod: time.Hour,
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgSetIssuerRecovery{
+				Sender:           "invalid",
+				Successor:        successor,
+				InactivityPeriod: time.Hour,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid successor",
+			message: types.MsgSetIssuerRecovery{
+				Sender:           sender,
+				Successor:        "invalid",
+				InactivityPeriod: time.Hour,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "sender is successor",
+			message: types.MsgSetIssuerRecovery{
+				Sender:           sender,
+				Successor:        sender,
+				InactivityPeriod: time.Hour,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero inactivity period",
+			message: types.MsgSetIssuerRecovery{
+				Sender:    sender,
+				Successor: successor,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -11,6 +12,31 @@ import (
 // DefaultTokenUpgradeGracePeriod is the period after which upgrade is effectively executed.
 const DefaultTokenUpgradeGracePeriod = time.Hour * 24 * 7
 
+// DefaultSymbolReservationPeriod is the period the symbol stays reserved for the issuer.
+const DefaultSymbolReservationPeriod = time.Hour
+
+// DefaultSendRateLimitChangeDelay is the delay after which the change loosening the send rate limit is applied.
+const DefaultSendRateLimitChangeDelay = time.Hour * 24
+
+// DefaultFeatureUpdateDelay is the announcement delay after which the update of the token features is applied.
+const DefaultFeatureUpdateDelay = time.Hour * 24 * 7
+
+// DefaultMaxPinnedExtensionCodes is the maximum number of extension codes pinned by the module in the wasm VM cache.
+const DefaultMaxPinnedExtensionCodes = 20
+
+// DefaultBuybackInterval is the interval after which the balance accumulated in the buyback account is processed.
+const DefaultBuybackInterval = time.Hour * 24
+
+// DefaultObserverGasLimit is the maximum gas the observer contract may use to process one transfer.
+const DefaultObserverGasLimit = 100_000
+
+// DefaultMinRateChangeNotice is the minimum period between scheduling the change of the token rates and applying it.
+const DefaultMinRateChangeNotice = time.Hour * 24 * 7
+
+// DefaultMinIssuerRecoveryInactivityPeriod is the minimum period the issuer must stay inactive before the successor can
+// claim the admin rights of the issuer's tokens.
+const DefaultMinIssuerRecoveryInactivityPeriod = time.Hour * 24 * 180
+
 // DefaultTokenUpgradeDecisionTimeout is the timeout for a decision to upgrade the token.
 var DefaultTokenUpgradeDecisionTimeout = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
 
@@ -23,14 +49,69 @@ var (
 
 	// KeyTokenUpgradeGracePeriod represents the token upgrade grace period param key.
 	KeyTokenUpgradeGracePeriod = []byte("TokenUpgradeGracePeriod")
+
+	// KeySymbolClaimDeposit represents the symbol claim deposit param key.
+	KeySymbolClaimDeposit = []byte("SymbolClaimDeposit")
+
+	// KeyReferralFeeRatio represents the referral fee ratio param key.
+	KeyReferralFeeRatio = []byte("ReferralFeeRatio")
+
+	// KeySymbolReservationDeposit represents the symbol reservation deposit param key.
+	KeySymbolReservationDeposit = []byte("SymbolReservationDeposit")
+
+	// KeySymbolReservationPeriod represents the symbol reservation period param key.
+	KeySymbolReservationPeriod = []byte("SymbolReservationPeriod")
+
+	// KeySendRateLimitChangeDelay represents the send rate limit change delay param key.
+	KeySendRateLimitChangeDelay = []byte("SendRateLimitChangeDelay")
+
+	// KeyFeatureUpdateDelay represents the feature update delay param key.
+	KeyFeatureUpdateDelay = []byte("FeatureUpdateDelay")
+
+	// KeyMaxPinnedExtensionCodes represents the max pinned extension codes param key.
+	KeyMaxPinnedExtensionCodes = []byte("MaxPinnedExtensionCodes")
+
+	// KeyCommissionBuybackRatio represents the commission buyback ratio param key.
+	KeyCommissionBuybackRatio = []byte("CommissionBuybackRatio")
+
+	// KeyBuybackInterval represents the buyback interval param key.
+	KeyBuybackInterval = []byte("BuybackInterval")
+
+	// KeyBuybackDestination represents the buyback destination param key.
+	KeyBuybackDestination = []byte("BuybackDestination")
+
+	// KeyLegalHoldAuthority represents the legal hold authority param key.
+	KeyLegalHoldAuthority = []byte("LegalHoldAuthority")
+
+	// KeyObserverGasLimit represents the observer gas limit param key.
+	KeyObserverGasLimit = []byte("ObserverGasLimit")
+
+	// KeyMinRateChangeNotice represents the min rate change notice param key.
+	KeyMinRateChangeNotice = []byte("MinRateChangeNotice")
+
+	// KeyMinIssuerRecoveryInactivityPeriod represents the min issuer recovery inactivity period param key.
+	KeyMinIssuerRecoveryInactivityPeriod = []byte("MinIssuerRecoveryInactivityPeriod")
 )
 
 // DefaultParams returns params with default values.
 func DefaultParams() Params {
 	return Params{
-		IssueFee:                    sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
-		TokenUpgradeDecisionTimeout: DefaultTokenUpgradeDecisionTimeout,
-		TokenUpgradeGracePeriod:     DefaultTokenUpgradeGracePeriod,
+		IssueFee:                          sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		TokenUpgradeDecisionTimeout:       DefaultTokenUpgradeDecisionTimeout,
+		TokenUpgradeGracePeriod:           DefaultTokenUpgradeGracePeriod,
+		SymbolClaimDeposit:                sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		ReferralFeeRatio:                  sdkmath.LegacyZeroDec(),
+		SymbolReservationDeposit:          sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		SymbolReservationPeriod:           DefaultSymbolReservationPeriod,
+		SendRateLimitChangeDelay:          DefaultSendRateLimitChangeDelay,
+		FeatureUpdateDelay:                DefaultFeatureUpdateDelay,
+		MaxPinnedExtensionCodes:           DefaultMaxPinnedExtensionCodes,
+		CommissionBuybackRatio:            sdkmath.LegacyZeroDec(),
+		BuybackInterval:                   DefaultBuybackInterval,
+		BuybackDestination:                BUYBACK_DESTINATION_BURN,
+		ObserverGasLimit:                  DefaultObserverGasLimit,
+		MinRateChangeNotice:               DefaultMinRateChangeNotice,
+		MinIssuerRecoveryInactivityPeriod: DefaultMinIssuerRecoveryInactivityPeriod,
 	}
 }
 
@@ -45,6 +126,36 @@ func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			validateTokenUpgradeDecisionTimeout,
 		),
 		paramtypes.NewParamSetPair(KeyTokenUpgradeGracePeriod, &m.TokenUpgradeGracePeriod, validateTokenUpgradeGracePeriod),
+		paramtypes.NewParamSetPair(KeySymbolClaimDeposit, &m.SymbolClaimDeposit, validateSymbolClaimDeposit),
+		paramtypes.NewParamSetPair(KeyReferralFeeRatio, &m.ReferralFeeRatio, validateReferralFeeRatio),
+		paramtypes.NewParamSetPair(
+			KeySymbolReservationDeposit,
+			&m.SymbolReservationDeposit,
+			validateSymbolReservationDeposit,
+		),
+		paramtypes.NewParamSetPair(KeySymbolReservationPeriod, &m.SymbolReservationPeriod, validateSymbolReservationPeriod),
+		paramtypes.NewParamSetPair(
+			KeySendRateLimitChangeDelay,
+			&m.SendRateLimitChangeDelay,
+			validateSendRateLimitChangeDelay,
+		),
+		paramtypes.NewParamSetPair(KeyFeatureUpdateDelay, &m.FeatureUpdateDelay, validateFeatureUpdateDelay),
+		paramtypes.NewParamSetPair(
+			KeyMaxPinnedExtensionCodes,
+			&m.MaxPinnedExtensionCodes,
+			validateMaxPinnedExtensionCodes,
+		),
+		paramtypes.NewParamSetPair(KeyCommissionBuybackRatio, &m.CommissionBuybackRatio, validateCommissionBuybackRatio),
+		paramtypes.NewParamSetPair(KeyBuybackInterval, &m.BuybackInterval, validateBuybackInterval),
+		paramtypes.NewParamSetPair(KeyBuybackDestination, &m.BuybackDestination, validateBuybackDestination),
+		paramtypes.NewParamSetPair(KeyLegalHoldAuthority, &m.LegalHoldAuthority, validateLegalHoldAuthority),
+		paramtypes.NewParamSetPair(KeyObserverGasLimit, &m.ObserverGasLimit, validateObserverGasLimit),
+		paramtypes.NewParamSetPair(KeyMinRateChangeNotice, &m.MinRateChangeNotice, validateMinRateChangeNotice),
+		paramtypes.NewParamSetPair(
+			KeyMinIssuerRecoveryInactivityPeriod,
+			&m.MinIssuerRecoveryInactivityPeriod,
+			validateMinIssuerRecoveryInactivityPeriod,
+		),
 	}
 }
 
@@ -56,7 +167,49 @@ func (m Params) ValidateBasic() error {
 	if err := validateTokenUpgradeDecisionTimeout(m.TokenUpgradeDecisionTimeout); err != nil {
 		return err
 	}
-	return validateTokenUpgradeGracePeriod(m.TokenUpgradeGracePeriod)
+	if err := validateTokenUpgradeGracePeriod(m.TokenUpgradeGracePeriod); err != nil {
+		return err
+	}
+	if err := validateSymbolClaimDeposit(m.SymbolClaimDeposit); err != nil {
+		return err
+	}
+	if err := validateReferralFeeRatio(m.ReferralFeeRatio); err != nil {
+		return err
+	}
+	if err := validateSymbolReservationDeposit(m.SymbolReservationDeposit); err != nil {
+		return err
+	}
+	if err := validateSymbolReservationPeriod(m.SymbolReservationPeriod); err != nil {
+		return err
+	}
+	if err := validateSendRateLimitChangeDelay(m.SendRateLimitChangeDelay); err != nil {
+		return err
+	}
+	if err := validateFeatureUpdateDelay(m.FeatureUpdateDelay); err != nil {
+		return err
+	}
+	if err := validateMaxPinnedExtensionCodes(m.MaxPinnedExtensionCodes); err != nil {
+		return err
+	}
+	if err := validateCommissionBuybackRatio(m.CommissionBuybackRatio); err != nil {
+		return err
+	}
+	if err := validateBuybackInterval(m.BuybackInterval); err != nil {
+		return err
+	}
+	if err := validateBuybackDestination(m.BuybackDestination); err != nil {
+		return err
+	}
+	if err := validateLegalHoldAuthority(m.LegalHoldAuthority); err != nil {
+		return err
+	}
+	if err := validateObserverGasLimit(m.ObserverGasLimit); err != nil {
+		return err
+	}
+	if err := validateMinRateChangeNotice(m.MinRateChangeNotice); err != nil {
+		return err
+	}
+	return validateMinIssuerRecoveryInactivityPeriod(m.MinIssuerRecoveryInactivityPeriod)
 }
 
 func validateIssueFee(i interface{}) error {
@@ -96,3 +249,152 @@ func validateTokenUpgradeGracePeriod(i interface{}) error {
 	}
 	return nil
 }
+
+func validateSymbolClaimDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Coin)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if deposit.IsNil() || !deposit.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidInput, "symbol claim deposit must be a non-negative value")
+	}
+	return nil
+}
+
+func validateReferralFeeRatio(i interface{}) error {
+	ratio, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if ratio.IsNil() || ratio.IsNegative() || ratio.GT(sdkmath.LegacyOneDec()) {
+		return sdkerrors.Wrap(ErrInvalidInput, "referral fee ratio must be between 0 and 1")
+	}
+	return nil
+}
+
+func validateSymbolReservationDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Coin)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if deposit.IsNil() || !deposit.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidInput, "symbol reservation deposit must be a non-negative value")
+	}
+	return nil
+}
+
+func validateSymbolReservationPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if period < 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "symbol reservation period must be a non-negative value")
+	}
+	return nil
+}
+
+func validateSendRateLimitChangeDelay(i interface{}) error {
+	delay, ok := i.(time.Duration)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if delay <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "send rate limit change delay must be greater than 0")
+	}
+	return nil
+}
+
+func validateFeatureUpdateDelay(i interface{}) error {
+	delay, ok := i.(time.Duration)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if delay <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "feature update delay must be greater than 0")
+	}
+	return nil
+}
+
+func validateMaxPinnedExtensionCodes(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateCommissionBuybackRatio(i interface{}) error {
+	ratio, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if ratio.IsNil() || ratio.IsNegative() || ratio.GT(sdkmath.LegacyOneDec()) {
+		return sdkerrors.Wrap(ErrInvalidInput, "commission buyback ratio must be between 0 and 1")
+	}
+	return nil
+}
+
+func validateBuybackInterval(i interface{}) error {
+	interval, ok := i.(time.Duration)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if interval <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "buyback interval must be greater than 0")
+	}
+	return nil
+}
+
+func validateBuybackDestination(i interface{}) error {
+	destination, ok := i.(BuybackDestination)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if _, ok := BuybackDestination_name[int32(destination)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "unknown buyback destination %d", destination)
+	}
+	return nil
+}
+
+func validateLegalHoldAuthority(i interface{}) error {
+	authority, ok := i.(string)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if authority == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid legal hold authority: %s", err)
+	}
+	return nil
+}
+
+func validateObserverGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinRateChangeNotice(i interface{}) error {
+	notice, ok := i.(time.Duration)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if notice <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "min rate change notice must be greater than 0")
+	}
+	return nil
+}
+
+func validateMinIssuerRecoveryInactivityPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if period <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "min issuer recovery inactivity period must be greater than 0")
+	}
+	return nil
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -29,6 +30,34 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BuybackDestination defines what happens with the balance accumulated in the buyback account.
+type BuybackDestination int32
+
+const (
+	// BUYBACK_DESTINATION_BURN means that the accumulated balance is burnt.
+	BUYBACK_DESTINATION_BURN BuybackDestination = 0
+	// BUYBACK_DESTINATION_COMMUNITY_POOL means that the accumulated balance is transferred to the community pool.
+	BUYBACK_DESTINATION_COMMUNITY_POOL BuybackDestination = 1
+)
+
+var BuybackDestination_name = map[int32]string{
+	0: "BUYBACK_DESTINATION_BURN",
+	1: "BUYBACK_DESTINATION_COMMUNITY_POOL",
+}
+
+var BuybackDestination_value = map[string]int32{
+	"BUYBACK_DESTINATION_BURN":           0,
+	"BUYBACK_DESTINATION_COMMUNITY_POOL": 1,
+}
+
+func (x BuybackDestination) String() string {
+	return proto.EnumName(BuybackDestination_name, int32(x))
+}
+
+func (BuybackDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b08ee2013666b045, []int{0}
+}
+
 // Params store gov manageable parameters.
 type Params struct {
 	// issue_fee is the fee burnt each time new token is issued.
@@ -37,6 +66,43 @@ type Params struct {
 	TokenUpgradeDecisionTimeout time.Time `protobuf:"bytes,2,opt,name=token_upgrade_decision_timeout,json=tokenUpgradeDecisionTimeout,proto3,stdtime" json:"token_upgrade_decision_timeout" yaml:"token_upgrade_decision_timeout"`
 	// token_upgrade_grace_period the period after which the token upgrade is executed effectively.
 	TokenUpgradeGracePeriod time.Duration `protobuf:"bytes,3,opt,name=token_upgrade_grace_period,json=tokenUpgradeGracePeriod,proto3,stdduration" json:"token_upgrade_grace_period" yaml:"token_upgrade_grace_period"`
+	// symbol_claim_deposit is the deposit locked when the symbol is claimed to be verified.
+	SymbolClaimDeposit types.Coin `protobuf:"bytes,4,opt,name=symbol_claim_deposit,json=symbolClaimDeposit,proto3" json:"symbol_claim_deposit" yaml:"symbol_claim_deposit"`
+	// referral_fee_ratio is a number between 0 and 1 which will be multiplied by the issue fee to determine the amount
+	// sent to the referrer of the issuer instead of being burnt.
+	ReferralFeeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=referral_fee_ratio,json=referralFeeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"referral_fee_ratio" yaml:"referral_fee_ratio"`
+	// symbol_reservation_deposit is the deposit locked when the symbol is reserved for the issuance.
+	SymbolReservationDeposit types.Coin `protobuf:"bytes,6,opt,name=symbol_reservation_deposit,json=symbolReservationDeposit,proto3" json:"symbol_reservation_deposit" yaml:"symbol_reservation_deposit"`
+	// symbol_reservation_period is the period the symbol stays reserved for the issuer. Zero value disables
+	// the reservations.
+	SymbolReservationPeriod time.Duration `protobuf:"bytes,7,opt,name=symbol_reservation_period,json=symbolReservationPeriod,proto3,stdduration" json:"symbol_reservation_period" yaml:"symbol_reservation_period"`
+	// send_rate_limit_change_delay is the delay after which the change loosening the send rate limit of the account
+	// is applied.
+	SendRateLimitChangeDelay time.Duration `protobuf:"bytes,8,opt,name=send_rate_limit_change_delay,json=sendRateLimitChangeDelay,proto3,stdduration" json:"send_rate_limit_change_delay" yaml:"send_rate_limit_change_delay"`
+	// feature_update_delay is the announcement delay after which the update of the token features made by the admin
+	// is applied.
+	FeatureUpdateDelay time.Duration `protobuf:"bytes,9,opt,name=feature_update_delay,json=featureUpdateDelay,proto3,stdduration" json:"feature_update_delay" yaml:"feature_update_delay"`
+	// max_pinned_extension_codes is the maximum number of extension codes pinned by the module in the wasm VM cache.
+	// Zero value disables the pinning.
+	MaxPinnedExtensionCodes uint32 `protobuf:"varint,10,opt,name=max_pinned_extension_codes,json=maxPinnedExtensionCodes,proto3" json:"max_pinned_extension_codes,omitempty" yaml:"max_pinned_extension_codes"`
+	// commission_buyback_ratio is a number between 0 and 1 which will be multiplied by the send commission to determine
+	// the amount routed to the buyback account instead of the admin of the token.
+	CommissionBuybackRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=commission_buyback_ratio,json=commissionBuybackRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_buyback_ratio" yaml:"commission_buyback_ratio"`
+	// buyback_interval is the interval after which the balance accumulated in the buyback account is processed.
+	BuybackInterval time.Duration `protobuf:"bytes,12,opt,name=buyback_interval,json=buybackInterval,proto3,stdduration" json:"buyback_interval" yaml:"buyback_interval"`
+	// buyback_destination defines what happens with the balance accumulated in the buyback account.
+	BuybackDestination BuybackDestination `protobuf:"varint,13,opt,name=buyback_destination,json=buybackDestination,proto3,enum=coreum.asset.ft.v1.BuybackDestination" json:"buyback_destination,omitempty" yaml:"buyback_destination"`
+	// legal_hold_authority is the second authority which must approve the release of each legal hold together with
+	// the admin of the token. The legal holds can't be placed while it is empty.
+	LegalHoldAuthority string `protobuf:"bytes,14,opt,name=legal_hold_authority,json=legalHoldAuthority,proto3" json:"legal_hold_authority,omitempty" yaml:"legal_hold_authority"`
+	// observer_gas_limit is the maximum gas the observer contract of the token may use to process one transfer. Zero
+	// value disables the notifications.
+	ObserverGasLimit uint64 `protobuf:"varint,15,opt,name=observer_gas_limit,json=observerGasLimit,proto3" json:"observer_gas_limit,omitempty" yaml:"observer_gas_limit"`
+	// min_rate_change_notice is the minimum period between scheduling the change of the token rates and applying it.
+	MinRateChangeNotice time.Duration `protobuf:"bytes,16,opt,name=min_rate_change_notice,json=minRateChangeNotice,proto3,stdduration" json:"min_rate_change_notice" yaml:"min_rate_change_notice"`
+	// min_issuer_recovery_inactivity_period is the minimum period the issuer must stay inactive before the successor
+	// designated by the issuer can claim the admin rights of the issuer's tokens.
+	MinIssuerRecoveryInactivityPeriod time.Duration `protobuf:"bytes,17,opt,name=min_issuer_recovery_inactivity_period,json=minIssuerRecoveryInactivityPeriod,proto3,stdduration" json:"min_issuer_recovery_inactivity_period" yaml:"min_issuer_recovery_inactivity_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -93,40 +159,164 @@ func (m *Params) GetTokenUpgradeGracePeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSymbolClaimDeposit() types.Coin {
+	if m != nil {
+		return m.SymbolClaimDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetSymbolReservationDeposit() types.Coin {
+	if m != nil {
+		return m.SymbolReservationDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetSymbolReservationPeriod() time.Duration {
+	if m != nil {
+		return m.SymbolReservationPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSendRateLimitChangeDelay() time.Duration {
+	if m != nil {
+		return m.SendRateLimitChangeDelay
+	}
+	return 0
+}
+
+func (m *Params) GetFeatureUpdateDelay() time.Duration {
+	if m != nil {
+		return m.FeatureUpdateDelay
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPinnedExtensionCodes() uint32 {
+	if m != nil {
+		return m.MaxPinnedExtensionCodes
+	}
+	return 0
+}
+
+func (m *Params) GetBuybackInterval() time.Duration {
+	if m != nil {
+		return m.BuybackInterval
+	}
+	return 0
+}
+
+func (m *Params) GetBuybackDestination() BuybackDestination {
+	if m != nil {
+		return m.BuybackDestination
+	}
+	return BUYBACK_DESTINATION_BURN
+}
+
+func (m *Params) GetLegalHoldAuthority() string {
+	if m != nil {
+		return m.LegalHoldAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetObserverGasLimit() uint64 {
+	if m != nil {
+		return m.ObserverGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMinRateChangeNotice() time.Duration {
+	if m != nil {
+		return m.MinRateChangeNotice
+	}
+	return 0
+}
+
+func (m *Params) GetMinIssuerRecoveryInactivityPeriod() time.Duration {
+	if m != nil {
+		return m.MinIssuerRecoveryInactivityPeriod
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("coreum.asset.ft.v1.BuybackDestination", BuybackDestination_name, BuybackDestination_value)
 	proto.RegisterType((*Params)(nil), "coreum.asset.ft.v1.Params")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/params.proto", fileDescriptor_b08ee2013666b045) }
 
 var fileDescriptor_b08ee2013666b045 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x8b, 0xd4, 0x40,
-	0x14, 0xc7, 0x33, 0x27, 0x1c, 0x1a, 0x1b, 0x09, 0x82, 0x31, 0xc2, 0x44, 0x03, 0x82, 0xcd, 0xce,
-	0x90, 0xb3, 0x10, 0x2c, 0xe3, 0xa1, 0x8d, 0xc5, 0x72, 0x9c, 0x8d, 0x4d, 0x98, 0x24, 0x2f, 0xb9,
-	0xc1, 0x4b, 0x5e, 0xc8, 0x4c, 0xc2, 0x9e, 0xbd, 0xfd, 0x62, 0xe5, 0x47, 0xda, 0x72, 0x4b, 0xab,
-	0x55, 0x76, 0xbf, 0x81, 0x8d, 0xad, 0x64, 0x26, 0xab, 0xeb, 0x22, 0x76, 0x2f, 0x79, 0xbf, 0xff,
-	0x7b, 0xbf, 0x19, 0xc6, 0x0d, 0x73, 0xec, 0xa0, 0xaf, 0xb9, 0x50, 0x0a, 0x34, 0x2f, 0x35, 0x1f,
-	0x62, 0xde, 0x8a, 0x4e, 0xd4, 0x8a, 0xb5, 0x1d, 0x6a, 0xf4, 0x3c, 0x0b, 0x30, 0x03, 0xb0, 0x52,
-	0xb3, 0x21, 0x0e, 0x68, 0x8e, 0xaa, 0x46, 0xc5, 0x33, 0xa1, 0x80, 0x0f, 0x71, 0x06, 0x5a, 0xc4,
-	0x3c, 0x47, 0xd9, 0xd8, 0x4c, 0x70, 0xbf, 0xc2, 0x0a, 0x4d, 0xc9, 0xc7, 0x6a, 0xfa, 0x4b, 0x2b,
-	0xc4, 0xea, 0x1a, 0xb8, 0xf9, 0xca, 0xfa, 0x92, 0x17, 0x7d, 0x27, 0xb4, 0xc4, 0x7d, 0x2a, 0x3c,
-	0xee, 0x6b, 0x59, 0x83, 0xd2, 0xa2, 0x6e, 0x2d, 0x10, 0xfd, 0x3c, 0x71, 0x4f, 0xe7, 0xc6, 0xcd,
-	0x9b, 0xbb, 0x77, 0xa4, 0x52, 0x3d, 0xa4, 0x25, 0x80, 0x4f, 0x1e, 0x93, 0x67, 0x77, 0xcf, 0x1e,
-	0x32, 0x6b, 0xc5, 0x46, 0x2b, 0x36, 0x59, 0xb1, 0x57, 0x28, 0x9b, 0xc4, 0x5f, 0x6d, 0x42, 0xe7,
-	0xc7, 0x26, 0xbc, 0x77, 0x23, 0xea, 0xeb, 0x97, 0xd1, 0xef, 0x64, 0x74, 0x71, 0xdb, 0xd4, 0xaf,
-	0x01, 0xbc, 0xcf, 0xc4, 0xa5, 0x1a, 0x3f, 0x40, 0x93, 0xf6, 0x6d, 0xd5, 0x89, 0x02, 0xd2, 0x02,
-	0x72, 0xa9, 0x24, 0x36, 0xe9, 0xe8, 0x81, 0xbd, 0xf6, 0x4f, 0xcc, 0x9e, 0x80, 0x59, 0x4f, 0xb6,
-	0xf7, 0x64, 0x97, 0x7b, 0xcf, 0x24, 0x9e, 0x16, 0x3d, 0xb5, 0x8b, 0xfe, 0x3f, 0x2f, 0x5a, 0x7e,
-	0x0b, 0xc9, 0xc5, 0x23, 0x03, 0xbd, 0xb3, 0xcc, 0xf9, 0x84, 0x5c, 0x5a, 0xc2, 0xfb, 0x44, 0xdc,
-	0xe0, 0xef, 0x21, 0x55, 0x27, 0x72, 0x48, 0x5b, 0xe8, 0x24, 0x16, 0xfe, 0xad, 0xe9, 0xe0, 0xc7,
-	0x42, 0xe7, 0xd3, 0xc5, 0x26, 0xb3, 0xc9, 0xe7, 0xc9, 0xbf, 0x7c, 0x0e, 0x47, 0x45, 0x5f, 0x46,
-	0x97, 0x07, 0x87, 0x2e, 0x6f, 0xc6, 0xf6, 0xdc, 0x74, 0x93, 0xb7, 0xab, 0x2d, 0x25, 0xeb, 0x2d,
-	0x25, 0xdf, 0xb7, 0x94, 0x2c, 0x77, 0xd4, 0x59, 0xef, 0xa8, 0xf3, 0x75, 0x47, 0x9d, 0xf7, 0x67,
-	0x95, 0xd4, 0x57, 0x7d, 0xc6, 0x72, 0xac, 0xb9, 0x49, 0xcb, 0x8f, 0x30, 0x5b, 0x70, 0xbd, 0x98,
-	0xe5, 0x57, 0x42, 0x36, 0x7c, 0x78, 0xc1, 0x17, 0x7f, 0x1e, 0x97, 0xbe, 0x69, 0x41, 0x65, 0xa7,
-	0x46, 0xf4, 0xf9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0x43, 0x14, 0x82, 0x7c, 0x02, 0x00,
-	0x00,
+	// 1038 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x41, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0x2d, 0x08, 0xa1, 0xd9, 0xd2, 0xc6, 0x6c, 0x33, 0x8d, 0xe2, 0xb4, 0xb2, 0xab, 0x4e,
+	0x4a, 0x60, 0x1a, 0x69, 0x12, 0x0e, 0x30, 0x9c, 0x88, 0xec, 0xa6, 0x78, 0x9a, 0x26, 0x41, 0x24,
+	0x87, 0x72, 0x11, 0x2b, 0x69, 0x2d, 0xef, 0x44, 0xd2, 0x7a, 0xb4, 0x2b, 0x63, 0x73, 0x62, 0x60,
+	0x98, 0x61, 0x38, 0x75, 0x38, 0x71, 0x67, 0xf8, 0x2e, 0x3d, 0xf6, 0xc8, 0x70, 0x08, 0x4c, 0xf2,
+	0x0d, 0x32, 0xc3, 0x9d, 0xd1, 0xee, 0x2a, 0x8e, 0x63, 0xa7, 0xe6, 0x26, 0xbf, 0xff, 0x7f, 0xdf,
+	0xfb, 0xed, 0xdb, 0xb7, 0x92, 0x41, 0x3d, 0xa0, 0x19, 0xce, 0x13, 0x1b, 0x31, 0x86, 0xb9, 0xdd,
+	0xe1, 0x76, 0x7f, 0xd3, 0xee, 0xa1, 0x0c, 0x25, 0xcc, 0xea, 0x65, 0x94, 0x53, 0x08, 0xa5, 0xc1,
+	0x12, 0x06, 0xab, 0xc3, 0xad, 0xfe, 0x66, 0xcd, 0x08, 0x28, 0x4b, 0x28, 0xb3, 0x7d, 0xc4, 0xb0,
+	0xdd, 0xdf, 0xf4, 0x31, 0x47, 0x9b, 0x76, 0x40, 0x49, 0x2a, 0xd7, 0xd4, 0x96, 0x22, 0x1a, 0x51,
+	0xf1, 0x68, 0x17, 0x4f, 0x2a, 0x6a, 0x44, 0x94, 0x46, 0x31, 0xb6, 0xc5, 0x2f, 0x3f, 0xef, 0xd8,
+	0x61, 0x9e, 0x21, 0x4e, 0x68, 0xb9, 0xaa, 0x7e, 0x55, 0xe7, 0x24, 0xc1, 0x8c, 0xa3, 0xa4, 0x27,
+	0x0d, 0xe6, 0xbf, 0x8b, 0x60, 0xfe, 0x40, 0xb0, 0xc1, 0x03, 0xb0, 0x40, 0x18, 0xcb, 0xb1, 0xd7,
+	0xc1, 0x58, 0xd7, 0x1a, 0xda, 0xfa, 0xcd, 0xad, 0x15, 0x4b, 0x52, 0x59, 0x05, 0x95, 0xa5, 0xa8,
+	0xac, 0x26, 0x25, 0xa9, 0xa3, 0xbf, 0x3a, 0xa9, 0x57, 0xce, 0x4f, 0xea, 0xd5, 0x21, 0x4a, 0xe2,
+	0xcf, 0xcc, 0x8b, 0x95, 0xa6, 0x7b, 0x43, 0x3c, 0xef, 0x60, 0x0c, 0x7f, 0xd5, 0x80, 0xc1, 0xe9,
+	0x31, 0x4e, 0xbd, 0xbc, 0x17, 0x65, 0x28, 0xc4, 0x5e, 0x88, 0x03, 0xc2, 0x08, 0x4d, 0xbd, 0x82,
+	0x83, 0xe6, 0x5c, 0x7f, 0x4b, 0xd4, 0xa9, 0x59, 0x92, 0xd3, 0x2a, 0x39, 0xad, 0xc3, 0x92, 0xd3,
+	0xd9, 0x54, 0x85, 0xd6, 0x64, 0xa1, 0x37, 0xe7, 0x33, 0x5f, 0xfe, 0x5d, 0xd7, 0xdc, 0x55, 0x61,
+	0x3a, 0x92, 0x9e, 0x96, 0xb2, 0x1c, 0x4a, 0x07, 0xfc, 0x49, 0x03, 0xb5, 0xf1, 0x24, 0x51, 0x86,
+	0x02, 0xec, 0xf5, 0x70, 0x46, 0x68, 0xa8, 0xbf, 0xad, 0x36, 0x7e, 0x15, 0xa8, 0xa5, 0x1a, 0xeb,
+	0x6c, 0x28, 0x9e, 0x07, 0xd3, 0x78, 0x2e, 0xa7, 0x32, 0x7f, 0x2b, 0x58, 0x96, 0x2f, 0xb3, 0x3c,
+	0x2d, 0xe4, 0x03, 0xa1, 0xc2, 0x1e, 0x58, 0x62, 0xc3, 0xc4, 0xa7, 0xb1, 0x17, 0xc4, 0x88, 0x24,
+	0x5e, 0x88, 0x7b, 0x94, 0x11, 0xae, 0xcf, 0xcd, 0xea, 0xfc, 0x43, 0x05, 0xb0, 0x2a, 0x01, 0xa6,
+	0x25, 0x31, 0x5d, 0x28, 0xc3, 0xcd, 0x22, 0xda, 0x92, 0x41, 0x98, 0x02, 0x98, 0xe1, 0x0e, 0xce,
+	0x32, 0x14, 0x17, 0x27, 0xe5, 0x89, 0x0d, 0xe9, 0xef, 0x34, 0xb4, 0xf5, 0x05, 0xe7, 0xf3, 0x22,
+	0xe9, 0x5f, 0x27, 0xf5, 0x55, 0x59, 0x96, 0x85, 0xc7, 0x16, 0xa1, 0x76, 0x82, 0x78, 0xd7, 0xda,
+	0xc5, 0x11, 0x0a, 0x86, 0x2d, 0x1c, 0x9c, 0x9f, 0xd4, 0x57, 0x64, 0xcd, 0xc9, 0x34, 0xa6, 0x5b,
+	0x2d, 0x83, 0x3b, 0x18, 0xbb, 0x45, 0x08, 0xfe, 0xa0, 0x81, 0x9a, 0xa2, 0xcb, 0x30, 0xc3, 0x59,
+	0x5f, 0x34, 0xf0, 0x62, 0xa3, 0xf3, 0xb3, 0x36, 0xfa, 0xe1, 0x78, 0xa7, 0xaf, 0x4f, 0x65, 0xba,
+	0xba, 0x14, 0xdd, 0x91, 0x56, 0x6e, 0xfa, 0x47, 0x0d, 0xac, 0x4c, 0x59, 0xa9, 0x4e, 0xfb, 0xdd,
+	0x59, 0xa7, 0xfd, 0x58, 0x31, 0x34, 0xae, 0x65, 0x18, 0x3b, 0xec, 0x09, 0x0c, 0x75, 0xd8, 0xbf,
+	0x68, 0xe0, 0x1e, 0xc3, 0x69, 0x58, 0x34, 0x0b, 0x7b, 0x31, 0x49, 0x08, 0xf7, 0x82, 0x2e, 0x4a,
+	0xa3, 0x62, 0x84, 0x63, 0x34, 0xd4, 0x6f, 0xcc, 0x02, 0xb1, 0x15, 0xc8, 0x43, 0x05, 0xf2, 0x86,
+	0x64, 0x92, 0x45, 0x2f, 0x2c, 0x2e, 0xe2, 0x78, 0xb7, 0x30, 0x34, 0x85, 0xde, 0x2a, 0x64, 0xc8,
+	0xc1, 0x52, 0x07, 0x23, 0x9e, 0x67, 0xd8, 0xcb, 0x7b, 0x21, 0xe2, 0x6a, 0x99, 0xbe, 0x30, 0x8b,
+	0xe1, 0x83, 0xf1, 0xc9, 0x9b, 0x96, 0x44, 0xd6, 0x86, 0x4a, 0x3a, 0x12, 0x8a, 0xac, 0xea, 0x83,
+	0x5a, 0x82, 0x06, 0x5e, 0x8f, 0xa4, 0x29, 0x0e, 0x3d, 0x3c, 0xe0, 0x38, 0x15, 0x37, 0x37, 0xa0,
+	0x21, 0x66, 0x3a, 0x68, 0x68, 0xeb, 0xb7, 0x9c, 0xb5, 0xd1, 0x69, 0x5f, 0xef, 0x35, 0xdd, 0xe5,
+	0x04, 0x0d, 0x0e, 0x84, 0xf6, 0xa4, 0x94, 0x9a, 0x85, 0x02, 0xbf, 0xd7, 0x80, 0x1e, 0xd0, 0x24,
+	0x21, 0x4c, 0xd8, 0xfd, 0x7c, 0xe8, 0xa3, 0xe0, 0x58, 0x0d, 0xfa, 0x4d, 0x31, 0xe8, 0x3b, 0xff,
+	0x6f, 0xd0, 0xeb, 0x92, 0xe2, 0xba, 0x64, 0xa6, 0x7b, 0x77, 0x24, 0x39, 0x52, 0x91, 0x43, 0x4f,
+	0x40, 0xb5, 0x74, 0x92, 0x94, 0x17, 0x63, 0x10, 0xeb, 0xef, 0xcd, 0x6a, 0x6c, 0x79, 0xa5, 0x97,
+	0x65, 0xd5, 0xab, 0x09, 0x64, 0x53, 0x17, 0x55, 0xb8, 0xad, 0xa2, 0xf0, 0x5b, 0x70, 0xa7, 0x74,
+	0x86, 0x98, 0x71, 0x92, 0x8a, 0x64, 0xfa, 0xad, 0x86, 0xb6, 0x7e, 0x7b, 0xeb, 0x91, 0x35, 0xf9,
+	0x91, 0xb1, 0x14, 0x69, 0x6b, 0xe4, 0x76, 0x8c, 0xf3, 0x93, 0x7a, 0x6d, 0xbc, 0xec, 0xa5, 0x64,
+	0xa6, 0x0b, 0xfd, 0x89, 0x35, 0xf0, 0x4b, 0xb0, 0x14, 0xe3, 0x08, 0xc5, 0x5e, 0x97, 0xc6, 0xa1,
+	0x87, 0x72, 0xde, 0xa5, 0x19, 0xe1, 0x43, 0xfd, 0xb6, 0xe8, 0x70, 0x7d, 0x34, 0x21, 0xd3, 0x5c,
+	0xa6, 0x0b, 0x45, 0xf8, 0x0b, 0x1a, 0x87, 0xdb, 0x65, 0x10, 0x3e, 0x03, 0x90, 0xfa, 0xc5, 0xad,
+	0xc1, 0x99, 0x17, 0x21, 0x26, 0xa7, 0x5a, 0x5f, 0x6c, 0x68, 0xeb, 0x73, 0xce, 0xfd, 0xd1, 0x8b,
+	0x67, 0xd2, 0x63, 0xba, 0xd5, 0x32, 0xf8, 0x14, 0x31, 0x31, 0xeb, 0x70, 0x08, 0xee, 0x26, 0x24,
+	0x95, 0xd7, 0x43, 0x5d, 0x8c, 0x94, 0x72, 0x12, 0x60, 0xbd, 0x3a, 0xeb, 0x24, 0xca, 0x77, 0xce,
+	0x7d, 0x35, 0x85, 0x53, 0xd3, 0xc8, 0xf3, 0xb8, 0x93, 0x90, 0xb4, 0xb8, 0x5f, 0xf2, 0x6a, 0xed,
+	0x09, 0x05, 0xfe, 0xa1, 0x81, 0xb5, 0x62, 0x91, 0xf8, 0x06, 0x66, 0x5e, 0x86, 0x03, 0xda, 0xc7,
+	0xd9, 0xd0, 0x23, 0x29, 0x0a, 0x38, 0xe9, 0x13, 0x3e, 0x2c, 0x5f, 0x3d, 0xef, 0xcf, 0x42, 0xf9,
+	0x54, 0xa1, 0x3c, 0x1e, 0xa1, 0xcc, 0xcc, 0x2a, 0xc9, 0x1e, 0x24, 0x24, 0x6d, 0x0b, 0xab, 0xab,
+	0x9c, 0xed, 0x0b, 0xa3, 0x7c, 0x21, 0x7d, 0xf4, 0x0d, 0x80, 0x93, 0xc3, 0x00, 0xef, 0x01, 0xdd,
+	0x39, 0x7a, 0xe1, 0x6c, 0x37, 0x9f, 0x79, 0xad, 0x27, 0x5f, 0x1d, 0xb6, 0xf7, 0xb6, 0x0f, 0xdb,
+	0xfb, 0x7b, 0x9e, 0x73, 0xe4, 0xee, 0x55, 0x2b, 0xf0, 0x11, 0x30, 0xa7, 0xa9, 0xcd, 0xfd, 0xe7,
+	0xcf, 0x8f, 0xf6, 0xda, 0x87, 0x2f, 0xbc, 0x83, 0xfd, 0xfd, 0xdd, 0xaa, 0x56, 0x9b, 0xfb, 0xf9,
+	0x77, 0xa3, 0xe2, 0xec, 0xbe, 0x3a, 0x35, 0xb4, 0xd7, 0xa7, 0x86, 0xf6, 0xcf, 0xa9, 0xa1, 0xbd,
+	0x3c, 0x33, 0x2a, 0xaf, 0xcf, 0x8c, 0xca, 0x9f, 0x67, 0x46, 0xe5, 0xeb, 0xad, 0x88, 0xf0, 0x6e,
+	0xee, 0x5b, 0x01, 0x4d, 0x6c, 0xf1, 0x75, 0x24, 0xdf, 0xe1, 0x8d, 0x81, 0xcd, 0x07, 0x1b, 0x41,
+	0x17, 0x91, 0xd4, 0xee, 0x7f, 0x62, 0x0f, 0x46, 0x7f, 0x9e, 0xf8, 0xb0, 0x87, 0x99, 0x3f, 0x2f,
+	0xfa, 0xf3, 0xf1, 0x7f, 0x03, 0x00, 0x0c, 0xf5, 0x83, 0x33, 0x5c, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -149,21 +339,135 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TokenUpgradeGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TokenUpgradeGracePeriod):])
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinIssuerRecoveryInactivityPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinIssuerRecoveryInactivityPeriod):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TokenUpgradeDecisionTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TokenUpgradeDecisionTimeout):])
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinRateChangeNotice, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinRateChangeNotice):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.ObserverGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ObserverGasLimit))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.LegalHoldAuthority) > 0 {
+		i -= len(m.LegalHoldAuthority)
+		copy(dAtA[i:], m.LegalHoldAuthority)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LegalHoldAuthority)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.BuybackDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BuybackDestination))
+		i--
+		dAtA[i] = 0x68
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.BuybackInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BuybackInterval):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.CommissionBuybackRatio.Size()
+		i -= size
+		if _, err := m.CommissionBuybackRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxPinnedExtensionCodes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPinnedExtensionCodes))
+		i--
+		dAtA[i] = 0x50
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FeatureUpdateDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeatureUpdateDelay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x4a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SendRateLimitChangeDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SendRateLimitChangeDelay):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SymbolReservationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SymbolReservationPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.SymbolReservationDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ReferralFeeRatio.Size()
+		i -= size
+		if _, err := m.ReferralFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.SymbolClaimDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TokenUpgradeGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TokenUpgradeGracePeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintParams(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TokenUpgradeDecisionTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TokenUpgradeDecisionTimeout):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintParams(dAtA, i, uint64(n10))
+	i--
 	dAtA[i] = 0x12
 	{
 		size, err := m.IssueFee.MarshalToSizedBuffer(dAtA[:i])
@@ -201,6 +505,39 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TokenUpgradeGracePeriod)
 	n += 1 + l + sovParams(uint64(l))
+	l = m.SymbolClaimDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ReferralFeeRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SymbolReservationDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SymbolReservationPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SendRateLimitChangeDelay)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeatureUpdateDelay)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxPinnedExtensionCodes != 0 {
+		n += 1 + sovParams(uint64(m.MaxPinnedExtensionCodes))
+	}
+	l = m.CommissionBuybackRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.BuybackInterval)
+	n += 1 + l + sovParams(uint64(l))
+	if m.BuybackDestination != 0 {
+		n += 1 + sovParams(uint64(m.BuybackDestination))
+	}
+	l = len(m.LegalHoldAuthority)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ObserverGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.ObserverGasLimit))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinRateChangeNotice)
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinIssuerRecoveryInactivityPeriod)
+	n += 2 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -338,6 +675,427 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolClaimDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SymbolClaimDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolReservationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SymbolReservationDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolReservationPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SymbolReservationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendRateLimitChangeDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SendRateLimitChangeDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureUpdateDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FeatureUpdateDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPinnedExtensionCodes", wireType)
+			}
+			m.MaxPinnedExtensionCodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPinnedExtensionCodes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionBuybackRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionBuybackRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.BuybackInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackDestination", wireType)
+			}
+			m.BuybackDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuybackDestination |= BuybackDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegalHoldAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegalHoldAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverGasLimit", wireType)
+			}
+			m.ObserverGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRateChangeNotice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinRateChangeNotice, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIssuerRecoveryInactivityPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinIssuerRecoveryInactivityPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -10,9 +10,21 @@ import (
 )
 
 var params = Params{
-	IssueFee:                    sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000),
-	TokenUpgradeGracePeriod:     time.Second,
-	TokenUpgradeDecisionTimeout: time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	IssueFee:                          sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000),
+	TokenUpgradeGracePeriod:           time.Second,
+	TokenUpgradeDecisionTimeout:       time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	SymbolClaimDeposit:                sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000),
+	SymbolReservationDeposit:          sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000),
+	SymbolReservationPeriod:           time.Hour,
+	SendRateLimitChangeDelay:          time.Hour,
+	FeatureUpdateDelay:                time.Hour,
+	MaxPinnedExtensionCodes:           5,
+	CommissionBuybackRatio:            sdkmath.LegacyMustNewDecFromStr("0.5"),
+	BuybackInterval:                   time.Hour,
+	BuybackDestination:                BUYBACK_DESTINATION_COMMUNITY_POOL,
+	ReferralFeeRatio:                  sdkmath.LegacyMustNewDecFromStr("0.1"),
+	MinRateChangeNotice:               time.Hour,
+	MinIssuerRecoveryInactivityPeriod: time.Hour,
 }
 
 func TestParamsValidation(t *testing.T) {
@@ -47,4 +59,84 @@ func TestParamsValidation(t *testing.T) {
 	testParams = params
 	testParams.TokenUpgradeGracePeriod = -1
 	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.SymbolClaimDeposit = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	requireT.NoError(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.SymbolClaimDeposit = sdk.Coin{}
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.SymbolClaimDeposit = sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-1)}
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.ReferralFeeRatio = sdkmath.LegacyOneDec()
+	requireT.NoError(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.ReferralFeeRatio = sdkmath.LegacyMustNewDecFromStr("1.01")
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.ReferralFeeRatio = sdkmath.LegacyMustNewDecFromStr("-0.1")
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.ReferralFeeRatio = sdkmath.LegacyDec{}
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.SymbolReservationDeposit = sdk.Coin{}
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.SymbolReservationPeriod = 0
+	requireT.NoError(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.SymbolReservationPeriod = -1
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.SendRateLimitChangeDelay = 0
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.FeatureUpdateDelay = 0
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.MinRateChangeNotice = 0
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.MinIssuerRecoveryInactivityPeriod = 0
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.CommissionBuybackRatio = sdkmath.LegacyMustNewDecFromStr("1.1")
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.CommissionBuybackRatio = sdkmath.LegacyDec{}
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.BuybackInterval = 0
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.BuybackDestination = 5
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.LegalHoldAuthority = "invalid"
+	requireT.Error(testParams.ValidateBasic())
+
+	testParams = params
+	testParams.LegalHoldAuthority = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	requireT.NoError(testParams.ValidateBasic())
 }
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// ValidateRateChange checks that both rates of the change are set and valid. Unlike on issuance, the rates must be
+// set explicitly, so the change never resets the rate by omission.
+func ValidateRateChange(burnRate, sendCommissionRate sdkmath.LegacyDec) error {
+	if burnRate.IsNil() {
+		return sdkerrors.Wrap(ErrInvalidInput, "burn rate must be set")
+	}
+	if sendCommissionRate.IsNil() {
+		return sdkerrors.Wrap(ErrInvalidInput, "send commission rate must be set")
+	}
+	if err := ValidateBurnRate(burnRate); err != nil {
+		return err
+	}
+	return ValidateSendCommissionRate(sendCommissionRate)
+}
+
+// ValidateBasic checks that the rate change fields are valid.
+func (c RateChange) ValidateBasic() error {
+	if _, _, err := DeconstructDenom(c.Denom); err != nil {
+		return err
+	}
+	if c.EffectiveTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "effective time must be set")
+	}
+	return ValidateRateChange(c.BurnRate, c.SendCommissionRate)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// ValidateRedenominationRatio checks that the ratio of the redenomination is set. The ratio is the amount of the new
+// token units received for one unit of the old token, so it can't be zero.
+func ValidateRedenominationRatio(ratio uint64) error {
+	if ratio == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "redenomination ratio must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic checks that the redenomination fields are valid.
+func (r Redenomination) ValidateBasic() error {
+	if _, _, err := DeconstructDenom(r.Denom); err != nil {
+		return err
+	}
+	if _, _, err := DeconstructDenom(r.NewDenom); err != nil {
+		return err
+	}
+	if r.Denom == r.NewDenom {
+		return sdkerrors.Wrap(ErrInvalidInput, "new denom must be different from the redenominated one")
+	}
+	if err := ValidateRedenominationRatio(r.Ratio); err != nil {
+		return err
+	}
+	if r.SwapDeadline.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "swap deadline must be set")
+	}
+	if r.SwappedAmount.IsNil() || r.SwappedAmount.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid swapped amount: %s", r.SwappedAmount)
+	}
+	return nil
+}
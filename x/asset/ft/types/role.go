@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateRole checks that the role is one of the defined roles.
+func ValidateRole(role Role) error {
+	if _, exists := Role_name[int32(role)]; !exists || role == ROLE_UNSPECIFIED {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid role: %s", role)
+	}
+	return nil
+}
+
+// ValidateBasic checks that the role assignment fields are valid.
+func (a RoleAssignment) ValidateBasic() error {
+	if _, _, err := DeconstructDenom(a.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid role account address: %s", err)
+	}
+	return ValidateRole(a.Role)
+}
+
+// RoleGrantsFeature returns true if the role lets the account use the feature the same way as the admin does.
+func RoleGrantsFeature(role Role, feature Feature) bool {
+	switch role {
+	case ROLE_ADMIN:
+		return RoleGrantsFeature(ROLE_MINTER, feature) || RoleGrantsFeature(ROLE_COMPLIANCE, feature)
+	case ROLE_MINTER:
+		return feature == Feature_minting || feature == Feature_burning
+	case ROLE_COMPLIANCE:
+		return feature == Feature_freezing || feature == Feature_whitelisting || feature == Feature_clawback
+	default:
+		return false
+	}
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateSendRateLimitTerms validates the denom, amount and window of the send rate limit. The empty denom limits
+// all the denoms and the zero amount removes the limit.
+func ValidateSendRateLimitTerms(denom string, amount sdkmath.Int, window time.Duration) error {
+	if denom != "" {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid denom: %s", err)
+		}
+	}
+
+	if amount.IsNil() || amount.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "send rate limit amount must not be negative")
+	}
+
+	if amount.IsPositive() && window <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "send rate limit window must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that the send rate limit fields are valid.
+func (l SendRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(l.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid account address: %s", err)
+	}
+
+	if err := ValidateSendRateLimitTerms(l.Denom, l.Amount, l.Window); err != nil {
+		return err
+	}
+
+	if !l.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "send rate limit amount must be positive")
+	}
+
+	if l.PendingChange != nil {
+		return ValidateSendRateLimitTerms(l.Denom, l.PendingChange.Amount, l.PendingChange.Window)
+	}
+
+	return nil
+}
+
+// IsLoosenedBy returns true if the change allows the account to send more within some window than the limit does.
+// The removal of the limit always loosens it.
+func (l SendRateLimit) IsLoosenedBy(amount sdkmath.Int, window time.Duration) bool {
+	return !amount.IsPositive() || amount.GT(l.Amount) || window < l.Window
+}
+
+// ValidateBasic checks that the send rate limit usage fields are valid.
+func (u SendRateLimitUsage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid account address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid denom: %s", err)
+	}
+
+	if u.WindowSent.IsNil() || u.WindowSent.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid window sent amount: %s", u.WindowSent)
+	}
+
+	return nil
+}
+
+// IsWindowInProgress returns true if the window of the usage hasn't ended at the provided time.
+func (u SendRateLimitUsage) IsWindowInProgress(now time.Time) bool {
+	return now.Before(u.WindowResetTime)
+}
+
+// Remaining returns the amount which still might be sent within the current window under the limit.
+func (u SendRateLimitUsage) Remaining(limit SendRateLimit, now time.Time) sdkmath.Int {
+	if !u.IsWindowInProgress(now) {
+		return limit.Amount
+	}
+	return sdkmath.MaxInt(limit.Amount.Sub(u.WindowSent), sdkmath.ZeroInt())
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewSupplyBreakdown returns the empty supply breakdown of the denom.
+func NewSupplyBreakdown(denom string) SupplyBreakdown {
+	return SupplyBreakdown{
+		Denom:           denom,
+		Minted:          sdkmath.ZeroInt(),
+		BurntByHolders:  sdkmath.ZeroInt(),
+		BurntByBurnRate: sdkmath.ZeroInt(),
+		BurntByAdmin:    sdkmath.ZeroInt(),
+		BurntByBuyback:  sdkmath.ZeroInt(),
+	}
+}
+
+// Burnt returns the total amount burnt by all the categories.
+func (b SupplyBreakdown) Burnt() sdkmath.Int {
+	return b.BurntByHolders.Add(b.BurntByBurnRate).Add(b.BurntByAdmin).Add(b.BurntByBuyback)
+}
+
+// Supply returns the supply expected from the minted and burnt amounts.
+func (b SupplyBreakdown) Supply() sdkmath.Int {
+	return b.Minted.Sub(b.Burnt())
+}
+
+// ValidateBasic checks that the supply breakdown is valid.
+func (b SupplyBreakdown) ValidateBasic() error {
+	if _, _, err := DeconstructDenom(b.Denom); err != nil {
+		return err
+	}
+	for _, amount := range []sdkmath.Int{
+		b.Minted, b.BurntByHolders, b.BurntByBurnRate, b.BurntByAdmin, b.BurntByBuyback,
+	} {
+		if amount.IsNil() || amount.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid supply breakdown amount of %s", b.Denom)
+		}
+	}
+	if b.Supply().IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "burnt amount of %s is greater than the minted one", b.Denom)
+	}
+
+	return nil
+}
@@ -36,6 +36,8 @@ const (
 	denomSeparator = "-"
 	// MaxPrecision used when issuing a token.
 	MaxPrecision = 20
+	// MaxTokensQueryLimit is the maximum number of tokens returned by a single tokens query.
+	MaxTokensQueryLimit = 100
 )
 
 // MaxMintableAmount is the maximum amount of a coin that can be minted at a time.
@@ -64,6 +66,9 @@ type IssueSettings struct {
 	SendCommissionRate sdkmath.LegacyDec
 	ExtensionSettings  *ExtensionIssueSettings
 	DEXSettings        *DEXSettings
+	Referrer           sdk.AccAddress
+	// Preset is the name of the issue preset overriding the features, rates and DEX settings.
+	Preset string
 }
 
 // BuildDenom builds the denom string from the symbol and issuer address.
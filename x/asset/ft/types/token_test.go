@@ -629,3 +629,38 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestDisplayAmountConversion(t *testing.T) {
+	testCases := []struct {
+		subunits  sdkmath.Int
+		precision uint32
+		display   string
+	}{
+		{subunits: sdkmath.NewInt(0), precision: 6, display: "0"},
+		{subunits: sdkmath.NewInt(1_500_000), precision: 6, display: "1.5"},
+		{subunits: sdkmath.NewInt(1), precision: 6, display: "0.000001"},
+		{subunits: sdkmath.NewInt(123), precision: 0, display: "123"},
+		{subunits: sdkmath.NewInt(100_000_000), precision: 8, display: "1"},
+		{subunits: sdkmath.NewInt(1), precision: types.MaxPrecision, display: "0.00000000000000000001"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.display, func(t *testing.T) {
+			requireT := require.New(t)
+			requireT.Equal(tc.display, types.ToDisplayAmount(tc.subunits, tc.precision))
+			subunits, err := types.ToSubunitAmount(tc.display, tc.precision)
+			requireT.NoError(err)
+			requireT.Equal(tc.subunits.String(), subunits.String())
+		})
+	}
+
+	// trailing zeros are accepted
+	subunits, err := types.ToSubunitAmount("1.500", 6)
+	require.NoError(t, err)
+	require.Equal(t, "1500000", subunits.String())
+
+	for _, invalid := range []string{"", ".", "1.", ".5", "-1", "1.2.3", "1e6", "abc", "1.0000001"} {
+		_, err := types.ToSubunitAmount(invalid, 6)
+		require.ErrorIs(t, err, types.ErrInvalidInput, invalid)
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferListener is the interface implemented by the modules observing the fungible token movements.
+// The listener is invoked after all the token restrictions are evaluated and the movement is executed. The state
+// changes of the listener are written only if it succeeds, the returned error or the panic doesn't revert the
+// movement, it is logged and the next listener is invoked.
+type TransferListener interface {
+	// OnTransfer is called after the coin is transferred from the sender to the recipient.
+	OnTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, coin sdk.Coin) error
+	// OnMint is called after the coin is minted to the recipient.
+	OnMint(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) error
+	// OnBurn is called after the coin is burnt from the account.
+	OnBurn(ctx sdk.Context, account sdk.AccAddress, coin sdk.Coin) error
+}
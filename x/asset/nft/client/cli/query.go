@@ -16,7 +16,8 @@ import (
 
 // Flags defined on queries.
 const (
-	IssuerFlag = "issuer"
+	IssuerFlag  = "issuer"
+	ExpiredFlag = "expired"
 )
 
 // GetQueryCmd returns the cli query commands for the module.
@@ -40,6 +41,8 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryWhitelistedAccounts(),
 		CmdQueryClassWhitelistedAccounts(),
 		CmdQueryBurnt(),
+		CmdQueryDataSchema(),
+		CmdQueryExpiration(),
 		CmdQueryParams(),
 	)
 
@@ -477,3 +480,102 @@ $ %s query %s burnt [class-id]
 
 	return cmd
 }
+
+// CmdQueryDataSchema return the QueryDataSchema cobra command.
+func CmdQueryDataSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "data-schema [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the JSON schema of the non-fungible token data",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the JSON schema of the non-fungible token data registered for the class.
+
+Example:
+$ %s query %s data-schema [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DataSchema(cmd.Context(), &types.QueryDataSchemaRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryExpiration return the QueryExpiration cobra command.
+func CmdQueryExpiration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiration [class-id] [id]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query for the expiration of the NFTs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the expiration of the NFTs in a class.
+
+Example:
+$ %s query %s expiration [class-id] [id]
+$ %s query %s expiration [class-id] --%s
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, ExpiredFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+
+			if len(args) == 2 {
+				res, err := queryClient.Expiration(cmd.Context(), &types.QueryExpirationRequest{
+					ClassId: classID,
+					Id:      args[1],
+				})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			expired, err := cmd.Flags().GetBool(ExpiredFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Expirations(cmd.Context(), &types.QueryExpirationsRequest{
+				Pagination: pageReq,
+				ClassId:    classID,
+				Expired:    expired,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiration")
+	cmd.Flags().Bool(ExpiredFlag, false, "Query only the NFTs expired at the current block time")
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -61,6 +62,9 @@ func GetTxCmd() *cobra.Command {
 		CmdTxUnwhitelist(),
 		CmdTxClassWhitelist(),
 		CmdTxClassUnwhitelist(),
+		CmdTxRegisterDataSchema(),
+		CmdTxExtendExpiration(),
+		CmdTxBurnExpired(),
 		CmdGrantAuthorization(),
 	)
 
@@ -217,14 +221,20 @@ $ %s tx %s mint abc-%s id1 --%s [sender] --%s [path]
 				return err
 			}
 
+			expirationTime, err := getExpireTime(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
 			msg := &types.MsgMint{
-				Sender:    sender.String(),
-				Recipient: recipient,
-				ClassID:   classID,
-				ID:        ID,
-				URI:       uri,
-				URIHash:   uriHash,
-				Data:      data,
+				Sender:         sender.String(),
+				Recipient:      recipient,
+				ClassID:        classID,
+				ID:             ID,
+				URI:            uri,
+				URIHash:        uriHash,
+				Data:           data,
+				ExpirationTime: expirationTime,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -242,6 +252,8 @@ $ %s tx %s mint abc-%s id1 --%s [sender] --%s [path]
 		DataTypeBytes,
 		fmt.Sprintf("type of data in the file %v.", []string{DataTypeBytes, DataTypeDynamic}),
 	)
+	//nolint:lll // breaking it down will make it look worse when printed to user screen
+	cmd.Flags().Int64(ExpirationFlag, 0, "Expiration time as Unix timestamp, the expired token can't be sent and can be burnt by anyone. Set zero (0) for no expiry.")
 
 	return cmd
 }
@@ -664,6 +676,126 @@ $ %s tx %s class-unfreeze abc-%[3]s %[3]s --from [sender]
 	return cmd
 }
 
+// CmdTxRegisterDataSchema returns RegisterDataSchema cobra command.
+func CmdTxRegisterDataSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-data-schema [class-id] [schema-file] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Register JSON schema of the non-fungible token data",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register JSON schema the data of the non-fungible tokens in the class must match.
+The schema can be registered once, while the class doesn't have non-fungible tokens.
+
+Example:
+$ %s tx %s register-data-schema abc-%s schema.json --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			schema, err := os.ReadFile(args[1])
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgRegisterDataSchema{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				Schema:  string(schema),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxExtendExpiration returns ExtendExpiration cobra command.
+func CmdTxExtendExpiration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-expiration [class-id] [id] [expiration] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Extend expiration of the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Extend expiration of the non-fungible token, the expiration is set as Unix timestamp.
+
+Example:
+$ %s tx %s extend-expiration abc-%s id1 1767225600 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			expiration, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid expiration %q", args[2])
+			}
+
+			msg := &types.MsgExtendExpiration{
+				Sender:         clientCtx.GetFromAddress().String(),
+				ClassID:        args[0],
+				ID:             args[1],
+				ExpirationTime: time.Unix(expiration, 0),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBurnExpired returns BurnExpired cobra command.
+func CmdTxBurnExpired() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-expired [class-id] [id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Burn expired non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn expired non-fungible token, it can be sent by any account.
+
+Example:
+$ %s tx %s burn-expired abc-%s id1 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgBurnExpired{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				ID:      args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func CmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
@@ -89,6 +89,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			}
 		}
 	}
+
+	for _, dataSchema := range genState.ClassDataSchemas {
+		if err := dataSchema.Validate(); err != nil {
+			panic(err)
+		}
+		if err := k.SetDataSchema(ctx, dataSchema); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, expiration := range genState.NFTExpirations {
+		if err := expiration.Validate(); err != nil {
+			panic(err)
+		}
+		if err := k.SetExpiration(ctx, expiration); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -123,6 +141,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	dataSchemas, _, err := k.GetDataSchemas(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	expirations, _, err := k.GetExpirations(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		panic(err)
@@ -136,5 +164,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		ClassWhitelistedAccounts: classWhitelisted,
 		ClassFrozenAccounts:      classFrozen,
 		BurntNFTs:                burnt,
+		ClassDataSchemas:         dataSchemas,
+		NFTExpirations:           expirations,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	rawnft "cosmossdk.io/x/nft"
@@ -147,6 +148,25 @@ func TestInitAndExportGenesis(t *testing.T) {
 		})
 	}
 
+	// data schemas
+	var dataSchemas []types.ClassDataSchema
+	for i := range 2 {
+		dataSchemas = append(dataSchemas, types.ClassDataSchema{
+			ClassID: fmt.Sprintf("classid%d-%s", i, issuer),
+			Schema:  fmt.Sprintf(`{"type":"object","properties":{"level":{"maximum":%d}}}`, i),
+		})
+	}
+
+	// expirations
+	var expirations []types.NFTExpiration
+	for i := range 2 {
+		expirations = append(expirations, types.NFTExpiration{
+			ClassID:        fmt.Sprintf("classid%d-%s", i, issuer),
+			ID:             fmt.Sprintf("nft-id-%d", i),
+			ExpirationTime: time.Unix(int64(1_800_000_000+i), 0).UTC(),
+		})
+	}
+
 	genState := types.GenesisState{
 		Params:                   types.DefaultParams(),
 		ClassDefinitions:         classDefinitions,
@@ -155,6 +175,8 @@ func TestInitAndExportGenesis(t *testing.T) {
 		ClassWhitelistedAccounts: classWhitelisted,
 		ClassFrozenAccounts:      classFrozen,
 		BurntNFTs:                burnt,
+		ClassDataSchemas:         dataSchemas,
+		NFTExpirations:           expirations,
 	}
 
 	// init the keeper
@@ -207,4 +229,6 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.ElementsMatch(genState.ClassWhitelistedAccounts, exportedGenState.ClassWhitelistedAccounts)
 	assertT.ElementsMatch(genState.ClassFrozenAccounts, exportedGenState.ClassFrozenAccounts)
 	assertT.ElementsMatch(genState.BurntNFTs, exportedGenState.BurntNFTs)
+	assertT.ElementsMatch(genState.ClassDataSchemas, exportedGenState.ClassDataSchemas)
+	assertT.ElementsMatch(genState.NFTExpirations, exportedGenState.NFTExpirations)
 }
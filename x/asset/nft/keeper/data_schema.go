@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+// RegisterDataSchema registers the JSON schema the data of the NFTs in the class must match. The schema can be
+// registered by the issuer once, while the class doesn't have NFTs, so the data of all the NFTs matches it.
+func (k Keeper) RegisterDataSchema(ctx sdk.Context, sender sdk.AccAddress, classID, schema string) error {
+	if _, err := types.ParseDataSchema(schema); err != nil {
+		return err
+	}
+
+	definition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+	if !definition.IsIssuer(sender) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized,
+			"address %q is unauthorized to register the data schema",
+			sender.String(),
+		)
+	}
+
+	_, err = k.GetDataSchema(ctx, classID)
+	if err == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "data schema is already registered for the class %s", classID)
+	}
+	if !sdkerrors.IsOf(err, types.ErrDataSchemaNotFound) {
+		return err
+	}
+
+	if supply := k.nftKeeper.GetTotalSupply(ctx, classID); supply > 0 {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"data schema can't be registered for the class %s having %d NFTs",
+			classID, supply,
+		)
+	}
+
+	if err := k.SetDataSchema(ctx, types.ClassDataSchema{ClassID: classID, Schema: schema}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDataSchemaRegistered{
+		ClassId: classID,
+		Schema:  schema,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event EventDataSchemaRegistered: %s", err)
+	}
+
+	return nil
+}
+
+// GetDataSchema returns the JSON schema of the NFT data registered for the class.
+func (k Keeper) GetDataSchema(ctx sdk.Context, classID string) (string, error) {
+	key, err := types.CreateDataSchemaKey(classID)
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := k.storeService.OpenKVStore(ctx).Get(key)
+	if err != nil {
+		return "", err
+	}
+	if bz == nil {
+		return "", sdkerrors.Wrapf(types.ErrDataSchemaNotFound, "classID: %s", classID)
+	}
+
+	var dataSchema types.ClassDataSchema
+	if err := k.cdc.Unmarshal(bz, &dataSchema); err != nil {
+		return "", err
+	}
+
+	return dataSchema.Schema, nil
+}
+
+// SetDataSchema stores the JSON schema of the NFT data of the class.
+func (k Keeper) SetDataSchema(ctx sdk.Context, dataSchema types.ClassDataSchema) error {
+	key, err := types.CreateDataSchemaKey(dataSchema.ClassID)
+	if err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(key, k.cdc.MustMarshal(&dataSchema))
+}
+
+// GetDataSchemas returns paginated JSON schemas of the NFT data registered for the classes.
+func (k Keeper) GetDataSchemas(
+	ctx sdk.Context, q *query.PageRequest,
+) ([]types.ClassDataSchema, *query.PageResponse, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	dataSchemas := make([]types.ClassDataSchema, 0)
+	pageRes, err := query.Paginate(
+		prefix.NewStore(runtime.KVStoreAdapter(store), types.NFTDataSchemaKeyPrefix),
+		q,
+		func(_, value []byte) error {
+			var dataSchema types.ClassDataSchema
+			if err := k.cdc.Unmarshal(value, &dataSchema); err != nil {
+				return err
+			}
+			dataSchemas = append(dataSchemas, dataSchema)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dataSchemas, pageRes, nil
+}
+
+// validateDataSchema checks that the NFT data matches the JSON schema registered for the class if any.
+func (k Keeper) validateDataSchema(ctx sdk.Context, classID string, data *codectypes.Any) error {
+	schema, err := k.GetDataSchema(ctx, classID)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrDataSchemaNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	dataSchema, err := types.ParseDataSchema(schema)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid stored data schema of the class %s: %s", classID, err)
+	}
+
+	return dataSchema.ValidateNFTData(data)
+}
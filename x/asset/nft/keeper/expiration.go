@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+// ExtendExpiration moves the expiration time of the NFT forward. Only the issuer can extend the expiration and only
+// of the NFT minted with the expiration time. The expired NFT can be extended as well until it is burnt.
+func (k Keeper) ExtendExpiration(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	classID, nftID string,
+	expirationTime time.Time,
+) error {
+	definition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+	if !definition.IsIssuer(sender) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized,
+			"address %q is unauthorized to extend the expiration",
+			sender.String(),
+		)
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+
+	expiration, err := k.GetExpiration(ctx, classID, nftID)
+	if err != nil {
+		return err
+	}
+	if !expirationTime.After(expiration.ExpirationTime) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"expiration time %s must be after the current expiration time %s",
+			expirationTime, expiration.ExpirationTime,
+		)
+	}
+
+	return k.setExpiration(ctx, classID, nftID, expirationTime)
+}
+
+// BurnExpired burns the expired NFT. Anyone can burn it, so the expired NFTs don't stay in the store forever. The
+// burning is allowed regardless of the burning feature and the freezing of the NFT, since the NFT is expired.
+func (k Keeper) BurnExpired(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error {
+	expiration, err := k.GetExpiration(ctx, classID, nftID)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockTime().Before(expiration.ExpirationTime) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"nft with classID:%s and ID:%s expires at %s",
+			classID, nftID, expiration.ExpirationTime,
+		)
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID)
+	}
+	owner := k.nftKeeper.GetOwner(ctx, classID, nftID)
+
+	if err := k.SetFrozen(ctx, classID, nftID, false); err != nil {
+		return err
+	}
+	if err := k.nftKeeper.Burn(ctx, classID, nftID); err != nil {
+		return err
+	}
+	if err := k.SetBurnt(ctx, classID, nftID); err != nil {
+		return err
+	}
+	if err := k.removeExpiration(ctx, classID, nftID); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventExpiredNFTBurnt{
+		ClassId:        classID,
+		Id:             nftID,
+		Owner:          owner.String(),
+		ExpirationTime: expiration.ExpirationTime,
+		Sender:         sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event EventExpiredNFTBurnt: %s", err)
+	}
+
+	return nil
+}
+
+// GetExpiration returns the expiration time of the NFT.
+func (k Keeper) GetExpiration(ctx sdk.Context, classID, nftID string) (types.NFTExpiration, error) {
+	key, err := types.CreateExpirationKey(classID, nftID)
+	if err != nil {
+		return types.NFTExpiration{}, err
+	}
+
+	bz, err := k.storeService.OpenKVStore(ctx).Get(key)
+	if err != nil {
+		return types.NFTExpiration{}, err
+	}
+	if bz == nil {
+		return types.NFTExpiration{}, sdkerrors.Wrapf(
+			types.ErrExpirationNotFound, "nft with classID:%s and ID:%s doesn't expire", classID, nftID,
+		)
+	}
+
+	var expiration types.NFTExpiration
+	if err := k.cdc.Unmarshal(bz, &expiration); err != nil {
+		return types.NFTExpiration{}, err
+	}
+
+	return expiration, nil
+}
+
+// SetExpiration stores the expiration time of the NFT, but does not make any checks
+// should not be used directly outside the module except for genesis.
+func (k Keeper) SetExpiration(ctx sdk.Context, expiration types.NFTExpiration) error {
+	key, err := types.CreateExpirationKey(expiration.ClassID, expiration.ID)
+	if err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(key, k.cdc.MustMarshal(&expiration))
+}
+
+// GetExpirations returns paginated expiration times of the NFTs.
+func (k Keeper) GetExpirations(
+	ctx sdk.Context, q *query.PageRequest,
+) ([]types.NFTExpiration, *query.PageResponse, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	expirations := make([]types.NFTExpiration, 0)
+	pageRes, err := query.Paginate(
+		prefix.NewStore(runtime.KVStoreAdapter(store), types.NFTExpirationKeyPrefix),
+		q,
+		func(_, value []byte) error {
+			var expiration types.NFTExpiration
+			if err := k.cdc.Unmarshal(value, &expiration); err != nil {
+				return err
+			}
+			expirations = append(expirations, expiration)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return expirations, pageRes, nil
+}
+
+// GetClassExpirations returns paginated expiration times of the NFTs in the class. If expired is set, only the NFTs
+// expired at the block time are returned.
+func (k Keeper) GetClassExpirations(
+	ctx sdk.Context, classID string, expired bool, q *query.PageRequest,
+) ([]types.NFTExpiration, *query.PageResponse, error) {
+	key, err := types.CreateClassExpirationPrefix(classID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	expirations := make([]types.NFTExpiration, 0)
+	pageRes, err := query.FilteredPaginate(
+		prefix.NewStore(runtime.KVStoreAdapter(store), key),
+		q,
+		func(_, value []byte, accumulate bool) (bool, error) {
+			var expiration types.NFTExpiration
+			if err := k.cdc.Unmarshal(value, &expiration); err != nil {
+				return false, err
+			}
+			if expired && ctx.BlockTime().Before(expiration.ExpirationTime) {
+				return false, nil
+			}
+			if accumulate {
+				expirations = append(expirations, expiration)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return expirations, pageRes, nil
+}
+
+// validateExpirationTime checks that the expiration time of the NFT is in the future.
+func validateExpirationTime(ctx sdk.Context, expirationTime time.Time) error {
+	if !expirationTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "expiration time %s must be after the block time %s", expirationTime, ctx.BlockTime(),
+		)
+	}
+
+	return nil
+}
+
+func (k Keeper) setExpiration(ctx sdk.Context, classID, nftID string, expirationTime time.Time) error {
+	if err := validateExpirationTime(ctx, expirationTime); err != nil {
+		return err
+	}
+
+	if err := k.SetExpiration(ctx, types.NFTExpiration{
+		ClassID:        classID,
+		ID:             nftID,
+		ExpirationTime: expirationTime,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventExpirationSet{
+		ClassId:        classID,
+		Id:             nftID,
+		ExpirationTime: expirationTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event EventExpirationSet: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) removeExpiration(ctx sdk.Context, classID, nftID string) error {
+	key, err := types.CreateExpirationKey(classID, nftID)
+	if err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(key)
+}
+
+func (k Keeper) validateNFTNotExpired(ctx sdk.Context, classID, nftID string) error {
+	expiration, err := k.GetExpiration(ctx, classID, nftID)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrExpirationNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !ctx.BlockTime().Before(expiration.ExpirationTime) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized,
+			"nft with classID:%s and ID:%s expired at %s",
+			classID, nftID, expiration.ExpirationTime,
+		)
+	}
+
+	return nil
+}
@@ -39,6 +39,14 @@ type QueryKeeper interface {
 	GetClassFrozenAccounts(ctx sdk.Context, classID string, q *query.PageRequest) ([]string, *query.PageResponse, error)
 	GetBurntByClass(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []string, error)
 	IsBurnt(ctx sdk.Context, classID, nftID string) (bool, error)
+	GetDataSchema(ctx sdk.Context, classID string) (string, error)
+	GetExpiration(ctx sdk.Context, classID, nftID string) (types.NFTExpiration, error)
+	GetClassExpirations(
+		ctx sdk.Context,
+		classID string,
+		expired bool,
+		q *query.PageRequest,
+	) ([]types.NFTExpiration, *query.PageResponse, error)
 }
 
 // QueryService serves grpc query requests for assetsnft module.
@@ -213,3 +221,51 @@ func (qs QueryService) BurntNFTsInClass(
 		NftIds:     list,
 	}, nil
 }
+
+// DataSchema returns the JSON schema of the NFT data registered for the class.
+func (qs QueryService) DataSchema(
+	ctx context.Context,
+	req *types.QueryDataSchemaRequest,
+) (*types.QueryDataSchemaResponse, error) {
+	schema, err := qs.keeper.GetDataSchema(sdk.UnwrapSDKContext(ctx), req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDataSchemaResponse{
+		Schema: schema,
+	}, nil
+}
+
+// Expiration returns the expiration time of the NFT.
+func (qs QueryService) Expiration(
+	ctx context.Context,
+	req *types.QueryExpirationRequest,
+) (*types.QueryExpirationResponse, error) {
+	expiration, err := qs.keeper.GetExpiration(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExpirationResponse{
+		Expiration: expiration,
+	}, nil
+}
+
+// Expirations returns the expiration times of the NFTs in the class.
+func (qs QueryService) Expirations(
+	ctx context.Context,
+	req *types.QueryExpirationsRequest,
+) (*types.QueryExpirationsResponse, error) {
+	expirations, pageRes, err := qs.keeper.GetClassExpirations(
+		sdk.UnwrapSDKContext(ctx), req.ClassId, req.Expired, req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExpirationsResponse{
+		Pagination:  pageRes,
+		Expirations: expirations,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+	invarianttypes "github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+)
+
+const (
+	// BurntNFTsInvariantRoute is the route of the burnt NFTs invariant.
+	BurntNFTsInvariantRoute = "burnt-nfts"
+	// FrozenNFTsInvariantRoute is the route of the frozen NFTs invariant.
+	FrozenNFTsInvariantRoute = "frozen-nfts"
+)
+
+// RegisterInvariants registers the asset nft module invariants.
+func RegisterInvariants(ir invarianttypes.Registry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, BurntNFTsInvariantRoute, BurntNFTsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, FrozenNFTsInvariantRoute, FrozenNFTsInvariant(k))
+}
+
+// BurntNFTsInvariant checks that the NFTs marked as burnt don't exist in the nft store, so the burnt ID can't be
+// reused.
+func BurntNFTsInvariant(k Keeper) invarianttypes.InvariantFunc {
+	return func(ctx sdk.Context) (string, bool) {
+		return k.nftRecordsInvariant(
+			ctx, BurntNFTsInvariantRoute, types.NFTBurningKeyPrefix, types.ParseBurningKey,
+			func(classID, nftID string) string {
+				if k.nftKeeper.HasNFT(ctx, classID, nftID) {
+					return "burnt nft exists"
+				}
+				return ""
+			},
+		)
+	}
+}
+
+// FrozenNFTsInvariant checks that the NFTs marked as frozen exist and belong to the classes with the freezing
+// feature enabled. The freezing record is removed once the NFT is burnt.
+func FrozenNFTsInvariant(k Keeper) invarianttypes.InvariantFunc {
+	return func(ctx sdk.Context) (string, bool) {
+		definitions := make(map[string]*types.ClassDefinition)
+		return k.nftRecordsInvariant(
+			ctx, FrozenNFTsInvariantRoute, types.NFTFreezingKeyPrefix, types.ParseFreezingKey,
+			func(classID, nftID string) string {
+				def, ok := definitions[classID]
+				if !ok {
+					if d, err := k.GetClassDefinition(ctx, classID); err == nil {
+						def = &d
+					}
+					definitions[classID] = def
+				}
+				switch {
+				case def == nil:
+					return "class is not issued"
+				case !def.IsFeatureEnabled(types.ClassFeature_freezing):
+					return "feature freezing is disabled"
+				case !k.nftKeeper.HasNFT(ctx, classID, nftID):
+					return "frozen nft doesn't exist"
+				default:
+					return ""
+				}
+			},
+		)
+	}
+}
+
+func (k Keeper) nftRecordsInvariant(
+	ctx sdk.Context,
+	route string,
+	keyPrefix []byte,
+	parseKey func(key []byte) (string, string, error),
+	check func(classID, nftID string) string,
+) (string, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var violations []string
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		classID, nftID, err := parseKey(iterator.Key())
+		if err != nil {
+			return invarianttypes.FormatInvariant(
+				types.ModuleName, route, fmt.Sprintf("failed to check: %s", err),
+			), true
+		}
+		if !bytes.Equal(iterator.Value(), types.StoreTrue) {
+			violations = append(violations, fmt.Sprintf(
+				"nft %s/%s: unexpected value %x", classID, nftID, iterator.Value(),
+			))
+			continue
+		}
+		if violation := check(classID, nftID); violation != "" {
+			violations = append(violations, fmt.Sprintf("nft %s/%s: %s", classID, nftID, violation))
+		}
+	}
+	if len(violations) > 0 {
+		return invarianttypes.FormatInvariant(types.ModuleName, route, fmt.Sprintf(
+			"%d of %d nfts are invalid:\n%s", len(violations), count, strings.Join(violations, "\n"),
+		)), true
+	}
+
+	return invarianttypes.FormatInvariant(types.ModuleName, route, fmt.Sprintf("%d nfts are valid", count)), false
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/nft"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+func TestInvariants(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:   issuer,
+		Symbol:   "symbol",
+		Features: []types.ClassFeature{types.ClassFeature_burning, types.ClassFeature_freezing},
+	})
+	requireT.NoError(err)
+	plainClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "plain",
+	})
+	requireT.NoError(err)
+
+	for _, id := range []string{"frozen", "burnt"} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:    issuer,
+			Recipient: issuer,
+			ClassID:   classID,
+			ID:        id,
+		}))
+	}
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, "frozen"))
+	// the burning of the frozen nft removes the freezing
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, "burnt"))
+	requireT.NoError(assetNFTKeeper.Burn(ctx, issuer, classID, "burnt"))
+
+	for _, invariant := range []func(ctx sdk.Context) (string, bool){
+		keeper.BurntNFTsInvariant(assetNFTKeeper),
+		keeper.FrozenNFTsInvariant(assetNFTKeeper),
+	} {
+		msg, broken := invariant(ctx)
+		requireT.False(broken, msg)
+	}
+
+	// the burnt nft minted again bypassing the asset nft
+	requireT.NoError(testApp.NFTKeeper.Mint(ctx, nft.NFT{ClassId: classID, Id: "burnt"}, issuer))
+	msg, broken := keeper.BurntNFTsInvariant(assetNFTKeeper)(ctx)
+	requireT.True(broken)
+	requireT.Contains(msg, classID+"/burnt")
+
+	// the frozen nft which doesn't exist
+	requireT.NoError(assetNFTKeeper.SetFrozen(ctx, classID, "missing", true))
+	msg, broken = keeper.FrozenNFTsInvariant(assetNFTKeeper)(ctx)
+	requireT.True(broken)
+	requireT.Contains(msg, classID+"/missing")
+
+	// the frozen nft of the class without the freezing feature
+	requireT.NoError(assetNFTKeeper.SetFrozen(ctx, plainClassID, "any", true))
+	msg, broken = keeper.FrozenNFTsInvariant(assetNFTKeeper)(ctx)
+	requireT.True(broken)
+	requireT.Contains(msg, "feature freezing is disabled")
+}
@@ -295,6 +295,12 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
 	}
 
+	if settings.ExpirationTime != nil {
+		if err := validateExpirationTime(ctx, *settings.ExpirationTime); err != nil {
+			return err
+		}
+	}
+
 	definition, err := k.GetClassDefinition(ctx, settings.ClassID)
 	if err != nil {
 		return err
@@ -330,6 +336,10 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 		return sdkerrors.Wrapf(types.ErrInvalidInput, "ID %q already defined for the class", settings.ID)
 	}
 
+	if err := k.validateDataSchema(ctx, settings.ClassID, settings.Data); err != nil {
+		return err
+	}
+
 	burnt, err := k.IsBurnt(ctx, settings.ClassID, settings.ID)
 	if err != nil {
 		return err
@@ -369,6 +379,10 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't save non-fungible token: %s", err)
 	}
 
+	if settings.ExpirationTime != nil {
+		return k.setExpiration(ctx, settings.ClassID, settings.ID, *settings.ExpirationTime)
+	}
+
 	return nil
 }
 
@@ -439,6 +453,9 @@ func (k Keeper) UpdateData(
 	if err := types.ValidateNFTData(storedNFT.Data); err != nil {
 		return err
 	}
+	if err := k.validateDataSchema(ctx, classID, storedNFT.Data); err != nil {
+		return err
+	}
 
 	return k.nftKeeper.Update(ctx, storedNFT)
 }
@@ -477,6 +494,10 @@ func (k Keeper) Burn(ctx sdk.Context, owner sdk.AccAddress, classID, id string)
 		return err
 	}
 
+	if err := k.removeExpiration(ctx, classID, id); err != nil {
+		return err
+	}
+
 	return k.SetBurnt(ctx, classID, id)
 }
 
@@ -4,6 +4,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -16,6 +17,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
@@ -1693,6 +1695,212 @@ func TestKeeper_Soulbound_Burning(t *testing.T) {
 	requireT.False(nftKeeper.HasNFT(ctx, classID, nftID))
 }
 
+func TestKeeper_DataSchema(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+
+	schema := `{"type":"object","required":["name"],"properties":{"name":{"type":"string","maxLength":5}}}`
+	dataBytes := func(data string) *codectypes.Any {
+		return marshalDataToAny(requireT, &types.DataBytes{Data: []byte(data)})
+	}
+	mint := func(id string, data *codectypes.Any) error {
+		return nftKeeper.Mint(ctx, types.MintSettings{
+			Sender:    issuer,
+			Recipient: issuer,
+			ClassID:   classID,
+			ID:        id,
+			Data:      data,
+		})
+	}
+
+	// the schema is not registered
+	_, err = nftKeeper.GetDataSchema(ctx, classID)
+	requireT.ErrorIs(err, types.ErrDataSchemaNotFound)
+
+	// only the issuer can register the schema
+	err = nftKeeper.RegisterDataSchema(ctx, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), classID, schema)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// invalid schema
+	err = nftKeeper.RegisterDataSchema(ctx, issuer, classID, `{"type":"object","pattern":"^a"}`)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	requireT.NoError(nftKeeper.RegisterDataSchema(ctx, issuer, classID, schema))
+	storedSchema, err := nftKeeper.GetDataSchema(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal(schema, storedSchema)
+
+	// the schema can't be registered twice
+	err = nftKeeper.RegisterDataSchema(ctx, issuer, classID, schema)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// minting with the data not matching the schema
+	requireT.ErrorIs(mint("id1", nil), types.ErrInvalidInput)
+	requireT.ErrorIs(mint("id1", dataBytes(`{"name":"too long"}`)), types.ErrInvalidInput)
+	requireT.ErrorIs(mint("id1", dataBytes(`{}`)), types.ErrInvalidInput)
+	requireT.ErrorIs(mint("id1", dataBytes(`not json`)), types.ErrInvalidInput)
+
+	// minting with the data matching the schema
+	requireT.NoError(mint("id1", dataBytes(`{"name":"nft"}`)))
+	requireT.NoError(mint("id2", marshalDataToAny(requireT, &types.DataDynamic{
+		Items: []types.DataDynamicItem{
+			{
+				Editors: []types.DataEditor{types.DataEditor_admin},
+				Data:    []byte(`{"name":"nft"}`),
+			},
+		},
+	})))
+
+	// updating the data
+	err = nftKeeper.UpdateData(ctx, issuer, classID, "id2", []types.DataDynamicIndexedItem{
+		{Index: 0, Data: []byte(`{"name":"too long"}`)},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	requireT.NoError(nftKeeper.UpdateData(ctx, issuer, classID, "id2", []types.DataDynamicIndexedItem{
+		{Index: 0, Data: []byte(`{"name":"new"}`)},
+	}))
+
+	// the schema can't be registered for the class having NFTs
+	classID2, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: issuer,
+		ClassID:   classID2,
+		ID:        "id1",
+	}))
+	err = nftKeeper.RegisterDataSchema(ctx, issuer, classID2, schema)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
+
+func TestKeeper_Expiration(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	blockTime := time.Now().UTC().Truncate(time.Second)
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Time: blockTime})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+		},
+	})
+	requireT.NoError(err)
+
+	mint := func(id string, expirationTime *time.Time) error {
+		return assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:         issuer,
+			Recipient:      holder,
+			ClassID:        classID,
+			ID:             id,
+			ExpirationTime: expirationTime,
+		})
+	}
+
+	// the expiration time must be in the future
+	requireT.ErrorIs(mint("id1", lo.ToPtr(blockTime)), types.ErrInvalidInput)
+
+	requireT.NoError(mint("id1", lo.ToPtr(blockTime.Add(time.Hour))))
+	requireT.NoError(mint("id2", nil))
+	requireT.NoError(mint("id3", lo.ToPtr(blockTime.Add(10*time.Hour))))
+
+	events, err := event.FindTypedEvents[*types.EventExpirationSet](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(events, 2)
+	requireT.Equal(&types.EventExpirationSet{
+		ClassId:        classID,
+		Id:             "id1",
+		ExpirationTime: blockTime.Add(time.Hour),
+	}, events[0])
+
+	_, err = assetNFTKeeper.GetExpiration(ctx, classID, "id2")
+	requireT.ErrorIs(err, types.ErrExpirationNotFound)
+
+	// the NFT can be sent before the expiration
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id1", recipient))
+
+	// the NFT can't be burnt before the expiration
+	err = assetNFTKeeper.BurnExpired(ctx, holder, classID, "id1")
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	err = assetNFTKeeper.BurnExpired(ctx, holder, classID, "id2")
+	requireT.ErrorIs(err, types.ErrExpirationNotFound)
+
+	// extending the expiration
+	err = assetNFTKeeper.ExtendExpiration(ctx, holder, classID, "id1", blockTime.Add(2*time.Hour))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	err = assetNFTKeeper.ExtendExpiration(ctx, issuer, classID, "id1", blockTime.Add(time.Hour))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	err = assetNFTKeeper.ExtendExpiration(ctx, issuer, classID, "id2", blockTime.Add(time.Hour))
+	requireT.ErrorIs(err, types.ErrExpirationNotFound)
+	requireT.NoError(assetNFTKeeper.ExtendExpiration(ctx, issuer, classID, "id1", blockTime.Add(2*time.Hour)))
+	expiration, err := assetNFTKeeper.GetExpiration(ctx, classID, "id1")
+	requireT.NoError(err)
+	requireT.Equal(blockTime.Add(2*time.Hour), expiration.ExpirationTime)
+
+	// the frozen NFT can be burnt once it is expired
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, "id1"))
+
+	ctx = ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+
+	expirations, _, err := assetNFTKeeper.GetClassExpirations(ctx, classID, false, nil)
+	requireT.NoError(err)
+	requireT.Len(expirations, 2)
+	expirations, _, err = assetNFTKeeper.GetClassExpirations(ctx, classID, true, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.NFTExpiration{expiration}, expirations)
+
+	// the expired NFT can't be sent by anyone
+	err = nftKeeper.Transfer(ctx, classID, "id1", holder)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// anyone can burn the expired NFT
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(assetNFTKeeper.BurnExpired(ctx, sender, classID, "id1"))
+	requireT.False(nftKeeper.HasNFT(ctx, classID, "id1"))
+	burnt, err := assetNFTKeeper.IsBurnt(ctx, classID, "id1")
+	requireT.NoError(err)
+	requireT.True(burnt)
+	_, err = assetNFTKeeper.GetExpiration(ctx, classID, "id1")
+	requireT.ErrorIs(err, types.ErrExpirationNotFound)
+
+	burntEvents, err := event.FindTypedEvents[*types.EventExpiredNFTBurnt](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventExpiredNFTBurnt{
+		{
+			ClassId:        classID,
+			Id:             "id1",
+			Owner:          recipient.String(),
+			ExpirationTime: blockTime.Add(2 * time.Hour),
+			Sender:         sender.String(),
+		},
+	}, burntEvents)
+
+	// the expiration is removed when the NFT is burnt by the issuer
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, "id3", issuer))
+	requireT.NoError(assetNFTKeeper.Burn(ctx, issuer, classID, "id3"))
+	expirations, _, err = assetNFTKeeper.GetClassExpirations(ctx, classID, false, nil)
+	requireT.NoError(err)
+	requireT.Empty(expirations)
+}
+
 func genNFTData(requireT *require.Assertions) *codectypes.Any {
 	dataString := "metadata"
 	dataValue, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte(dataString)})
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,6 +34,9 @@ type MsgKeeper interface {
 	AddToClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error
 	RemoveFromClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error
 	UpdateParams(ctx sdk.Context, authority string, params types.Params) error
+	RegisterDataSchema(ctx sdk.Context, sender sdk.AccAddress, classID, schema string) error
+	ExtendExpiration(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string, expirationTime time.Time) error
+	BurnExpired(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -92,13 +96,14 @@ func (ms MsgServer) Mint(ctx context.Context, req *types.MsgMint) (*types.EmptyR
 	if err := ms.keeper.Mint(
 		sdk.UnwrapSDKContext(ctx),
 		types.MintSettings{
-			Sender:    owner,
-			Recipient: recipient,
-			ClassID:   req.ClassID,
-			ID:        req.ID,
-			URI:       req.URI,
-			URIHash:   req.URIHash,
-			Data:      req.Data,
+			Sender:         owner,
+			Recipient:      recipient,
+			ClassID:        req.ClassID,
+			ID:             req.ID,
+			URI:            req.URI,
+			URIHash:        req.URIHash,
+			Data:           req.Data,
+			ExpirationTime: req.ExpirationTime,
 		},
 	); err != nil {
 		return nil, err
@@ -304,3 +309,53 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.EmptyResponse{}, nil
 }
+
+// RegisterDataSchema registers the JSON schema of the NFT data of the class.
+func (ms MsgServer) RegisterDataSchema(
+	ctx context.Context,
+	req *types.MsgRegisterDataSchema,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.RegisterDataSchema(sdk.UnwrapSDKContext(ctx), sender, req.ClassID, req.Schema); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ExtendExpiration moves the expiration time of the NFT forward.
+func (ms MsgServer) ExtendExpiration(
+	ctx context.Context,
+	req *types.MsgExtendExpiration,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.ExtendExpiration(
+		sdk.UnwrapSDKContext(ctx), sender, req.ClassID, req.ID, req.ExpirationTime,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BurnExpired burns the expired NFT.
+func (ms MsgServer) BurnExpired(ctx context.Context, req *types.MsgBurnExpired) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.BurnExpired(sdk.UnwrapSDKContext(ctx), sender, req.ClassID, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
@@ -14,6 +14,11 @@ func (k Keeper) Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.Ac
 }
 
 func (k Keeper) beforeTransfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	// the expired NFT can't be sent by anyone including the issuer, it can be only burnt
+	if err := k.validateNFTNotExpired(ctx, classID, nftID); err != nil {
+		return err
+	}
+
 	if err := k.validateSendableNFT(ctx, classID, nftID); err != nil {
 		return err
 	}
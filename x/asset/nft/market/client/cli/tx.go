@@ -0,0 +1,268 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      types.ModuleName + " transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdListNFT(),
+		CmdCancelListing(),
+		CmdPlaceBid(),
+		CmdCancelBid(),
+		CmdAcceptBid(),
+	)
+
+	return cmd
+}
+
+// CmdListNFT returns ListNFT cobra command.
+func CmdListNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [class_id] [nft_id] [price] [expiration] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "List the NFT for sale",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List the NFT for sale. The NFT stays with the owner until it is sold.
+The expiration is either the RFC3339 time or the duration from now.
+
+Example:
+$ %s tx %s list abc-%s nft1 100000%s 72h --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.DenomTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return errors.Wrap(err, "invalid price")
+			}
+			expiration, err := parseExpiration(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgListNFT{
+				Sender:     clientCtx.GetFromAddress().String(),
+				ClassID:    args[0],
+				NFTID:      args[1],
+				Price:      price,
+				Expiration: expiration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelListing returns CancelListing cobra command.
+func CmdCancelListing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-listing [listing_id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the listing of the NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the listing of the NFT.
+
+Example:
+$ %s tx %s cancel-listing 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid listing ID")
+			}
+
+			msg := &types.MsgCancelListing{
+				Sender:    clientCtx.GetFromAddress().String(),
+				ListingID: listingID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdPlaceBid returns PlaceBid cobra command.
+func CmdPlaceBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid [class_id] [nft_id] [price] [expiration] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Place the bid on the NFT",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place the bid on the NFT. The price is escrowed until the bid is accepted, cancelled or expired.
+If the NFT is listed for the price in the same denom not higher than the bid, it is bought immediately for the listed
+price. The expiration is either the RFC3339 time or the duration from now.
+
+Example:
+$ %s tx %s bid abc-%s nft1 100000%s 72h --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.DenomTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return errors.Wrap(err, "invalid price")
+			}
+			expiration, err := parseExpiration(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPlaceBid{
+				Sender:     clientCtx.GetFromAddress().String(),
+				ClassID:    args[0],
+				NFTID:      args[1],
+				Price:      price,
+				Expiration: expiration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelBid returns CancelBid cobra command.
+func CmdCancelBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-bid [bid_id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the bid and return the escrowed price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the bid and return the escrowed price.
+
+Example:
+$ %s tx %s cancel-bid 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			bidID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid bid ID")
+			}
+
+			msg := &types.MsgCancelBid{
+				Sender: clientCtx.GetFromAddress().String(),
+				BidID:  bidID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAcceptBid returns AcceptBid cobra command.
+func CmdAcceptBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-bid [bid_id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sell the owned NFT to the bidder",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sell the owned NFT to the bidder for the escrowed price.
+
+Example:
+$ %s tx %s accept-bid 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			bidID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid bid ID")
+			}
+
+			msg := &types.MsgAcceptBid{
+				Sender: clientCtx.GetFromAddress().String(),
+				BidID:  bidID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseExpiration(arg string) (time.Time, error) {
+	if duration, err := time.ParseDuration(arg); err == nil {
+		return time.Now().Add(duration).UTC(), nil
+	}
+	expiration, err := time.Parse(time.RFC3339, arg)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid expiration %q", arg)
+	}
+	return expiration, nil
+}
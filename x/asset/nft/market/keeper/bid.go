@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+// PlaceBid escrows the price and places the bid on the NFT. If the NFT is listed by its owner for the price in the
+// same denom not higher than the bid, the NFT is bought immediately for the listed price and the bid isn't stored,
+// in that case zero is returned as the bid ID.
+func (k Keeper) PlaceBid(
+	ctx sdk.Context,
+	bidder sdk.AccAddress,
+	classID, nftID string,
+	price sdk.Coin,
+	expiration time.Time,
+) (uint64, error) {
+	if err := validateExpiration(ctx, expiration); err != nil {
+		return 0, err
+	}
+	if _, err := k.assetNFTKeeper.GetClassDefinition(ctx, classID); err != nil {
+		return 0, err
+	}
+	owner := k.nftKeeper.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return 0, sdkerrors.Wrapf(
+			assetnfttypes.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, nftID,
+		)
+	}
+	if owner.Equals(bidder) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidInput, "%s is the owner of the nft", bidder)
+	}
+
+	listing, found, err := k.getListingByNFT(ctx, classID, nftID)
+	if err != nil {
+		return 0, err
+	}
+	if found && listing.Seller == owner.String() && !isExpired(ctx, listing.Expiration) &&
+		listing.Price.Denom == price.Denom && listing.Price.Amount.LTE(price.Amount) {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx, bidder, types.ModuleName, sdk.NewCoins(listing.Price),
+		); err != nil {
+			return 0, sdkerrors.Wrap(err, "failed to escrow the price")
+		}
+		return 0, k.sell(ctx, classID, nftID, owner, bidder, listing.Price)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(price)); err != nil {
+		return 0, sdkerrors.Wrap(err, "failed to escrow the price")
+	}
+
+	id, err := k.BidSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	bid := types.Bid{
+		ID:         id,
+		Bidder:     bidder.String(),
+		ClassID:    classID,
+		NFTID:      nftID,
+		Price:      price,
+		Expiration: expiration,
+	}
+	if err := k.SetBid(ctx, bid); err != nil {
+		return 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBidPlaced{
+		ID:      id,
+		Bidder:  bid.Bidder,
+		ClassID: classID,
+		NFTID:   nftID,
+		Price:   price,
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// CancelBid removes the bid of the bidder and returns the escrowed price.
+func (k Keeper) CancelBid(ctx sdk.Context, bidder sdk.AccAddress, id uint64) error {
+	bid, err := k.GetBid(ctx, id)
+	if err != nil {
+		return err
+	}
+	if bid.Bidder != bidder.String() {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "bid %d is not owned by %s", id, bidder)
+	}
+
+	return k.refundBid(ctx, bid, types.RemovalReasonCancelled)
+}
+
+// AcceptBid sells the NFT owned by the seller to the bidder for the escrowed price.
+func (k Keeper) AcceptBid(ctx sdk.Context, seller sdk.AccAddress, id uint64) error {
+	bid, err := k.GetBid(ctx, id)
+	if err != nil {
+		return err
+	}
+	if isExpired(ctx, bid.Expiration) {
+		return sdkerrors.Wrapf(types.ErrExpired, "bid %d is expired", id)
+	}
+	if !k.nftKeeper.GetOwner(ctx, bid.ClassID, bid.NFTID).Equals(seller) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized,
+			"%s is not the owner of nft with classID:%s and ID:%s", seller, bid.ClassID, bid.NFTID,
+		)
+	}
+	bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeBid(ctx, bid, types.RemovalReasonSold); err != nil {
+		return err
+	}
+
+	return k.sell(ctx, bid.ClassID, bid.NFTID, seller, bidder, bid.Price)
+}
+
+// GetBid returns the bid by its ID.
+func (k Keeper) GetBid(ctx sdk.Context, id uint64) (types.Bid, error) {
+	bid, err := k.Bids.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Bid{}, sdkerrors.Wrapf(types.ErrBidNotFound, "bid %d", id)
+		}
+		return types.Bid{}, err
+	}
+	return bid, nil
+}
+
+// SetBid stores the bid together with its indexes.
+func (k Keeper) SetBid(ctx sdk.Context, bid types.Bid) error {
+	if err := k.Bids.Set(ctx, bid.ID, bid); err != nil {
+		return err
+	}
+	if err := k.BidsByNFT.Set(ctx, collections.Join(types.NFTKey(bid.ClassID, bid.NFTID), bid.ID)); err != nil {
+		return err
+	}
+	return k.BidExpirations.Set(ctx, collections.Join(bid.Expiration, bid.ID))
+}
+
+func (k Keeper) refundBid(ctx sdk.Context, bid types.Bid, reason string) error {
+	bidder, err := sdk.AccAddressFromBech32(bid.Bidder)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(bid.Price)); err != nil {
+		return sdkerrors.Wrapf(err, "failed to return the price of bid %d", bid.ID)
+	}
+
+	return k.removeBid(ctx, bid, reason)
+}
+
+func (k Keeper) removeBid(ctx sdk.Context, bid types.Bid, reason string) error {
+	if err := k.Bids.Remove(ctx, bid.ID); err != nil {
+		return err
+	}
+	if err := k.BidsByNFT.Remove(ctx, collections.Join(types.NFTKey(bid.ClassID, bid.NFTID), bid.ID)); err != nil {
+		return err
+	}
+	if err := k.BidExpirations.Remove(ctx, collections.Join(bid.Expiration, bid.ID)); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBidRemoved{
+		ID:     bid.ID,
+		Reason: reason,
+	})
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+)
+
+// RemoveExpired removes the expired listings and returns the escrowed prices of the expired bids. At most
+// MaxExpirationsPerBlock listings and bids are processed, the rest is processed by the next calls. Should be called
+// from EndBlock.
+func (k Keeper) RemoveExpired(ctx sdk.Context) error {
+	listingKeys, err := collectExpired(ctx, k.ListingExpirations)
+	if err != nil {
+		return err
+	}
+	for _, key := range listingKeys {
+		listing, err := k.GetListing(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.removeListing(ctx, listing, types.RemovalReasonExpired); err != nil {
+			return err
+		}
+	}
+
+	bidKeys, err := collectExpired(ctx, k.BidExpirations)
+	if err != nil {
+		return err
+	}
+	for _, key := range bidKeys {
+		bid, err := k.GetBid(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		// the failure of the refund must not halt the chain, e.g. if the bidder can't receive the denom anymore,
+		// in that case the bid is kept, so the bidder can cancel it later
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.refundBid(cacheCtx, bid, types.RemovalReasonExpired); err != nil {
+			k.logger.Error("failed to return the price of the expired bid", "bidID", bid.ID, "error", err)
+			if err := k.BidExpirations.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+		writeCache()
+	}
+
+	return nil
+}
+
+func collectExpired(
+	ctx sdk.Context, expirations collections.KeySet[collections.Pair[time.Time, uint64]],
+) ([]collections.Pair[time.Time, uint64], error) {
+	keys := make([]collections.Pair[time.Time, uint64], 0)
+	err := expirations.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K1().After(ctx.BlockTime()) || len(keys) == types.MaxExpirationsPerBlock {
+			return true, nil
+		}
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+)
+
+// InitGenesis initializes the market module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) error {
+	for _, listing := range genState.Listings {
+		if err := k.SetListing(ctx, listing); err != nil {
+			return err
+		}
+	}
+
+	for _, bid := range genState.Bids {
+		if err := k.SetBid(ctx, bid); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ListingSequence.Set(ctx, genState.ListingSequence); err != nil {
+		return err
+	}
+	return k.BidSequence.Set(ctx, genState.BidSequence)
+}
+
+// ExportGenesis returns the market module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	genesis := types.DefaultGenesisState()
+
+	if err := k.Listings.Walk(ctx, nil, func(_ uint64, listing types.Listing) (bool, error) {
+		genesis.Listings = append(genesis.Listings, listing)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Bids.Walk(ctx, nil, func(_ uint64, bid types.Bid) (bool, error) {
+		genesis.Bids = append(genesis.Bids, bid)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var err error
+	genesis.ListingSequence, err = k.ListingSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.BidSequence, err = k.BidSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
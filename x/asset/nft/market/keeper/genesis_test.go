@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+)
+
+func TestGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	seller, _ := testApp.GenAccount(ctx)
+	bidder, _ := testApp.GenAccount(ctx)
+	classID := "symbol-" + seller.String()
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	genState := types.GenesisState{
+		Listings: []types.Listing{
+			{
+				ID:         2,
+				Seller:     seller.String(),
+				ClassID:    classID,
+				NFTID:      "nft1",
+				Price:      sdk.NewInt64Coin("denom1", 100),
+				Expiration: expiration,
+			},
+		},
+		Bids: []types.Bid{
+			{
+				ID:         1,
+				Bidder:     bidder.String(),
+				ClassID:    classID,
+				NFTID:      "nft1",
+				Price:      sdk.NewInt64Coin("denom1", 50),
+				Expiration: expiration,
+			},
+			{
+				ID:         3,
+				Bidder:     bidder.String(),
+				ClassID:    classID,
+				NFTID:      "nft2",
+				Price:      sdk.NewInt64Coin("denom2", 70),
+				Expiration: expiration,
+			},
+		},
+		ListingSequence: 3,
+		BidSequence:     4,
+	}
+	requireT.NoError(genState.Validate())
+
+	requireT.NoError(testApp.NFTMarketKeeper.InitGenesis(ctx, genState))
+	exported, err := testApp.NFTMarketKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState, *exported)
+
+	// the indexes are restored
+	res, err := keeper.NewQueryService(testApp.NFTMarketKeeper).Bids(ctx, &types.QueryBidsRequest{
+		ClassId: classID,
+		NftId:   "nft1",
+	})
+	requireT.NoError(err)
+	requireT.Equal(genState.Bids[:1], res.Bids)
+}
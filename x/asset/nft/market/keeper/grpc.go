@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryService serves grpc requests for the module.
+type QueryService struct {
+	keeper Keeper
+}
+
+// NewQueryService creates query service.
+func NewQueryService(keeper Keeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Listing returns the listing by its ID.
+func (qs QueryService) Listing(ctx context.Context, req *types.QueryListingRequest) (*types.QueryListingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	listing, err := qs.keeper.GetListing(sdk.UnwrapSDKContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListingResponse{
+		Listing: listing,
+	}, nil
+}
+
+// Listings returns all the active listings.
+func (qs QueryService) Listings(
+	ctx context.Context, req *types.QueryListingsRequest,
+) (*types.QueryListingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	listings, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.Listings,
+		req.Pagination,
+		func(_ uint64, listing types.Listing) (types.Listing, error) {
+			return listing, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListingsResponse{
+		Listings:   listings,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Bid returns the bid by its ID.
+func (qs QueryService) Bid(ctx context.Context, req *types.QueryBidRequest) (*types.QueryBidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bid, err := qs.keeper.GetBid(sdk.UnwrapSDKContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBidResponse{
+		Bid: bid,
+	}, nil
+}
+
+// Bids returns the active bids placed on the NFT.
+func (qs QueryService) Bids(ctx context.Context, req *types.QueryBidsRequest) (*types.QueryBidsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	bids, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.BidsByNFT,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Bid, error) {
+			return qs.keeper.GetBid(sdkCtx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](types.NFTKey(req.ClassId, req.NftId)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBidsResponse{
+		Bids:       bids,
+		Pagination: pageRes,
+	}, nil
+}
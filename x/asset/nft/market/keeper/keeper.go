@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkstore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	logger       log.Logger
+
+	// codec
+	cdc codec.BinaryCodec
+
+	// keepers
+	bankKeeper     types.BankKeeper
+	assetNFTKeeper types.AssetNFTKeeper
+	nftKeeper      types.NFTKeeper
+
+	// collections
+	Schema   collections.Schema
+	Listings collections.Map[uint64, types.Listing]
+	// Map: (class ID, NFT ID) -> ID of the listing of the NFT
+	ListingsByNFT      collections.Map[collections.Pair[string, string], uint64]
+	ListingExpirations collections.KeySet[collections.Pair[time.Time, uint64]]
+	ListingSequence    collections.Sequence
+	Bids               collections.Map[uint64, types.Bid]
+	// KeySet: (NFT key, bid ID)
+	BidsByNFT      collections.KeySet[collections.Pair[string, uint64]]
+	BidExpirations collections.KeySet[collections.Pair[time.Time, uint64]]
+	BidSequence    collections.Sequence
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.BinaryCodec,
+	bankKeeper types.BankKeeper,
+	assetNFTKeeper types.AssetNFTKeeper,
+	nftKeeper types.NFTKeeper,
+	logger log.Logger,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:   storeService,
+		logger:         logger,
+		cdc:            cdc,
+		bankKeeper:     bankKeeper,
+		assetNFTKeeper: assetNFTKeeper,
+		nftKeeper:      nftKeeper,
+
+		Listings: collections.NewMap(
+			sb,
+			types.ListingKey,
+			"listings",
+			collections.Uint64Key,
+			codec.CollValue[types.Listing](cdc),
+		),
+		ListingsByNFT: collections.NewMap(
+			sb,
+			types.ListingByNFTKey,
+			"listings_by_nft",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collections.Uint64Value,
+		),
+		ListingExpirations: collections.NewKeySet(
+			sb,
+			types.ListingExpirationKey,
+			"listing_expirations",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		ListingSequence: collections.NewSequence(
+			sb,
+			types.ListingSequenceKey,
+			"listing_sequence",
+		),
+		Bids: collections.NewMap(
+			sb,
+			types.BidKey,
+			"bids",
+			collections.Uint64Key,
+			codec.CollValue[types.Bid](cdc),
+		),
+		BidsByNFT: collections.NewKeySet(
+			sb,
+			types.BidByNFTKey,
+			"bids_by_nft",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		BidExpirations: collections.NewKeySet(
+			sb,
+			types.BidExpirationKey,
+			"bid_expirations",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		BidSequence: collections.NewSequence(
+			sb,
+			types.BidSequenceKey,
+			"bid_sequence",
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
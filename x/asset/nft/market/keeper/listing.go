@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+// ListNFT lists the NFT owned by the seller for sale. The NFT stays with the seller, the rules of the class are
+// enforced once it is sold.
+func (k Keeper) ListNFT(
+	ctx sdk.Context,
+	seller sdk.AccAddress,
+	classID, nftID string,
+	price sdk.Coin,
+	expiration time.Time,
+) (uint64, error) {
+	if err := validateExpiration(ctx, expiration); err != nil {
+		return 0, err
+	}
+	if _, err := k.assetNFTKeeper.GetClassDefinition(ctx, classID); err != nil {
+		return 0, err
+	}
+	if !k.nftKeeper.GetOwner(ctx, classID, nftID).Equals(seller) {
+		return 0, sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized, "%s is not the owner of nft with classID:%s and ID:%s", seller, classID, nftID,
+		)
+	}
+	if err := k.validateNFTNotFrozen(ctx, classID, nftID); err != nil {
+		return 0, err
+	}
+
+	existingListing, found, err := k.getListingByNFT(ctx, classID, nftID)
+	if err != nil {
+		return 0, err
+	}
+	if found {
+		// the listing of the previous owner is stale, so it is replaced
+		if existingListing.Seller == seller.String() {
+			return 0, sdkerrors.Wrapf(
+				types.ErrListingExists, "nft with classID:%s and ID:%s is listed by %d", classID, nftID, existingListing.ID,
+			)
+		}
+		if err := k.removeListing(ctx, existingListing, types.RemovalReasonCancelled); err != nil {
+			return 0, err
+		}
+	}
+
+	id, err := k.ListingSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	listing := types.Listing{
+		ID:         id,
+		Seller:     seller.String(),
+		ClassID:    classID,
+		NFTID:      nftID,
+		Price:      price,
+		Expiration: expiration,
+	}
+	if err := k.SetListing(ctx, listing); err != nil {
+		return 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventListingCreated{
+		ID:      id,
+		Seller:  listing.Seller,
+		ClassID: classID,
+		NFTID:   nftID,
+		Price:   price,
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// CancelListing removes the listing of the seller.
+func (k Keeper) CancelListing(ctx sdk.Context, seller sdk.AccAddress, id uint64) error {
+	listing, err := k.GetListing(ctx, id)
+	if err != nil {
+		return err
+	}
+	if listing.Seller != seller.String() {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "listing %d is not owned by %s", id, seller)
+	}
+
+	return k.removeListing(ctx, listing, types.RemovalReasonCancelled)
+}
+
+// GetListing returns the listing by its ID.
+func (k Keeper) GetListing(ctx sdk.Context, id uint64) (types.Listing, error) {
+	listing, err := k.Listings.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Listing{}, sdkerrors.Wrapf(types.ErrListingNotFound, "listing %d", id)
+		}
+		return types.Listing{}, err
+	}
+	return listing, nil
+}
+
+// SetListing stores the listing together with its indexes.
+func (k Keeper) SetListing(ctx sdk.Context, listing types.Listing) error {
+	if err := k.Listings.Set(ctx, listing.ID, listing); err != nil {
+		return err
+	}
+	if err := k.ListingsByNFT.Set(ctx, collections.Join(listing.ClassID, listing.NFTID), listing.ID); err != nil {
+		return err
+	}
+	return k.ListingExpirations.Set(ctx, collections.Join(listing.Expiration, listing.ID))
+}
+
+func (k Keeper) getListingByNFT(ctx sdk.Context, classID, nftID string) (types.Listing, bool, error) {
+	id, err := k.ListingsByNFT.Get(ctx, collections.Join(classID, nftID))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Listing{}, false, nil
+		}
+		return types.Listing{}, false, err
+	}
+	listing, err := k.GetListing(ctx, id)
+	if err != nil {
+		return types.Listing{}, false, err
+	}
+	return listing, true, nil
+}
+
+func (k Keeper) removeListing(ctx sdk.Context, listing types.Listing, reason string) error {
+	if err := k.Listings.Remove(ctx, listing.ID); err != nil {
+		return err
+	}
+	if err := k.ListingsByNFT.Remove(ctx, collections.Join(listing.ClassID, listing.NFTID)); err != nil {
+		return err
+	}
+	if err := k.ListingExpirations.Remove(ctx, collections.Join(listing.Expiration, listing.ID)); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventListingRemoved{
+		ID:     listing.ID,
+		Reason: reason,
+	})
+}
+
+func (k Keeper) validateNFTNotFrozen(ctx sdk.Context, classID, nftID string) error {
+	// the IsFrozen includes both class and NFT freezing check
+	frozen, err := k.assetNFTKeeper.IsFrozen(ctx, classID, nftID)
+	if err != nil {
+		if errors.Is(err, assetnfttypes.ErrFeatureDisabled) {
+			return nil
+		}
+		return err
+	}
+	if frozen {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "nft with classID:%s and ID:%s is frozen", classID, nftID)
+	}
+	return nil
+}
+
+func validateExpiration(ctx sdk.Context, expiration time.Time) error {
+	if !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrExpired, "expiration %s must be after the block time", expiration)
+	}
+	if expiration.After(ctx.BlockTime().Add(types.MaxOfferDuration)) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "expiration %s is later than %s after the block time", expiration, types.MaxOfferDuration,
+		)
+	}
+	return nil
+}
+
+func isExpired(ctx sdk.Context, expiration time.Time) bool {
+	return !expiration.After(ctx.BlockTime())
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+const nftID = "nft1"
+
+func setupMarket(
+	t *testing.T, features ...assetnfttypes.ClassFeature,
+) (*simapp.App, sdk.Context, sdk.AccAddress, sdk.AccAddress, string) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Time: time.Now().UTC()})
+
+	requireT.NoError(testApp.AssetNFTKeeper.SetParams(ctx, assetnfttypes.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}))
+
+	issuer, _ := testApp.GenAccount(ctx)
+	owner, _ := testApp.GenAccount(ctx)
+	classID, err := testApp.AssetNFTKeeper.IssueClass(ctx, assetnfttypes.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		Features:    features,
+		RoyaltyRate: sdkmath.LegacyMustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+	requireT.NoError(testApp.AssetNFTKeeper.Mint(ctx, assetnfttypes.MintSettings{
+		Sender:    issuer,
+		Recipient: owner,
+		ClassID:   classID,
+		ID:        nftID,
+	}))
+
+	return testApp, ctx, issuer, owner, classID
+}
+
+func TestKeeper_ListAndBuy(t *testing.T) {
+	requireT := require.New(t)
+	testApp, ctx, issuer, seller, classID := setupMarket(t)
+	marketKeeper := testApp.NFTMarketKeeper
+	bankKeeper := testApp.BankKeeper
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	price := sdk.NewInt64Coin(constant.DenomDev, 1_000)
+
+	// only the owner can list the nft
+	buyer, _ := testApp.GenAccount(ctx)
+	_, err := marketKeeper.ListNFT(ctx, buyer, classID, nftID, price, expiration)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the expiration must be in the allowed range
+	_, err = marketKeeper.ListNFT(ctx, seller, classID, nftID, price, ctx.BlockTime())
+	requireT.ErrorIs(err, types.ErrExpired)
+	_, err = marketKeeper.ListNFT(
+		ctx, seller, classID, nftID, price, ctx.BlockTime().Add(types.MaxOfferDuration+time.Second),
+	)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	listingID, err := marketKeeper.ListNFT(ctx, seller, classID, nftID, price, expiration)
+	requireT.NoError(err)
+	_, err = marketKeeper.ListNFT(ctx, seller, classID, nftID, price, expiration)
+	requireT.ErrorIs(err, types.ErrListingExists)
+
+	// the lower bid is stored and the price is escrowed
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 2_000))))
+	bidID, err := marketKeeper.PlaceBid(
+		ctx, buyer, classID, nftID, sdk.NewInt64Coin(constant.DenomDev, 500), expiration,
+	)
+	requireT.NoError(err)
+	requireT.NotZero(bidID)
+	requireT.Equal(sdkmath.NewInt(1_500), bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).Amount)
+
+	// the bid matching the listing buys the nft for the listed price
+	bidID, err = marketKeeper.PlaceBid(
+		ctx, buyer, classID, nftID, sdk.NewInt64Coin(constant.DenomDev, 1_200), expiration,
+	)
+	requireT.NoError(err)
+	requireT.Zero(bidID)
+	requireT.Equal(buyer, testApp.NFTKeeper.GetOwner(ctx, classID, nftID))
+	requireT.Equal(sdkmath.NewInt(500), bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).Amount)
+	requireT.Equal(sdkmath.NewInt(900), bankKeeper.GetBalance(ctx, seller, constant.DenomDev).Amount)
+	requireT.Equal(sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).Amount)
+
+	_, err = marketKeeper.GetListing(ctx, listingID)
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+
+	// the remaining bid is cancelled and the price is returned
+	bids, err := testApp.NFTMarketKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.Len(bids.Bids, 1)
+	requireT.ErrorIs(marketKeeper.CancelBid(ctx, seller, bids.Bids[0].ID), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(marketKeeper.CancelBid(ctx, buyer, bids.Bids[0].ID))
+	requireT.Equal(sdkmath.NewInt(1_000), bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).Amount)
+}
+
+func TestKeeper_AcceptBid(t *testing.T) {
+	requireT := require.New(t)
+	testApp, ctx, issuer, seller, classID := setupMarket(t, assetnfttypes.ClassFeature_freezing)
+	marketKeeper := testApp.NFTMarketKeeper
+	bankKeeper := testApp.BankKeeper
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	buyer, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1_000))))
+	bidID, err := marketKeeper.PlaceBid(
+		ctx, buyer, classID, nftID, sdk.NewInt64Coin(constant.DenomDev, 1_000), expiration,
+	)
+	requireT.NoError(err)
+
+	// only the owner can accept the bid
+	requireT.ErrorIs(marketKeeper.AcceptBid(ctx, buyer, bidID), cosmoserrors.ErrUnauthorized)
+
+	// the class freeze of the seller is honored
+	requireT.NoError(testApp.AssetNFTKeeper.ClassFreeze(ctx, issuer, seller, classID))
+	_, err = marketKeeper.ListNFT(ctx, seller, classID, nftID, sdk.NewInt64Coin(constant.DenomDev, 1), expiration)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	cacheCtx, _ := ctx.CacheContext()
+	requireT.ErrorIs(marketKeeper.AcceptBid(cacheCtx, seller, bidID), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(testApp.AssetNFTKeeper.ClassUnfreeze(ctx, issuer, seller, classID))
+
+	requireT.NoError(marketKeeper.AcceptBid(ctx, seller, bidID))
+	requireT.Equal(buyer, testApp.NFTKeeper.GetOwner(ctx, classID, nftID))
+	requireT.Equal(sdkmath.NewInt(900), bankKeeper.GetBalance(ctx, seller, constant.DenomDev).Amount)
+	requireT.Equal(sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).Amount)
+
+	_, err = marketKeeper.GetBid(ctx, bidID)
+	requireT.ErrorIs(err, types.ErrBidNotFound)
+}
+
+func TestKeeper_RemoveExpired(t *testing.T) {
+	requireT := require.New(t)
+	testApp, ctx, _, seller, classID := setupMarket(t)
+	marketKeeper := testApp.NFTMarketKeeper
+	bankKeeper := testApp.BankKeeper
+
+	price := sdk.NewInt64Coin(constant.DenomDev, 1_000)
+	listingID, err := marketKeeper.ListNFT(ctx, seller, classID, nftID, price, ctx.BlockTime().Add(time.Hour))
+	requireT.NoError(err)
+
+	buyer, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(price)))
+	bidID, err := marketKeeper.PlaceBid(
+		ctx, buyer, classID, nftID, sdk.NewInt64Coin(constant.DenomDev, 500), ctx.BlockTime().Add(2*time.Hour),
+	)
+	requireT.NoError(err)
+
+	// nothing is expired yet
+	requireT.NoError(marketKeeper.RemoveExpired(ctx))
+	_, err = marketKeeper.GetListing(ctx, listingID)
+	requireT.NoError(err)
+
+	// the listing is expired
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.NoError(marketKeeper.RemoveExpired(ctx))
+	_, err = marketKeeper.GetListing(ctx, listingID)
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+	_, err = marketKeeper.GetBid(ctx, bidID)
+	requireT.NoError(err)
+
+	// the bid is expired and can't be accepted
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.ErrorIs(marketKeeper.AcceptBid(ctx, seller, bidID), types.ErrExpired)
+
+	// the price of the expired bid is returned
+	requireT.NoError(marketKeeper.RemoveExpired(ctx))
+	_, err = marketKeeper.GetBid(ctx, bidID)
+	requireT.ErrorIs(err, types.ErrBidNotFound)
+	requireT.Equal(price.Amount, bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).Amount)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// ListNFT lists the NFT owned by the sender for sale.
+func (ms MsgServer) ListNFT(ctx context.Context, req *types.MsgListNFT) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ms.keeper.ListNFT(
+		sdk.UnwrapSDKContext(ctx), sender, req.ClassID, req.NFTID, req.Price, req.Expiration,
+	); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// CancelListing removes the listing of the sender.
+func (ms MsgServer) CancelListing(ctx context.Context, req *types.MsgCancelListing) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.CancelListing(sdk.UnwrapSDKContext(ctx), sender, req.ListingID); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// PlaceBid escrows the price and places the bid on the NFT.
+func (ms MsgServer) PlaceBid(ctx context.Context, req *types.MsgPlaceBid) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ms.keeper.PlaceBid(
+		sdk.UnwrapSDKContext(ctx), sender, req.ClassID, req.NFTID, req.Price, req.Expiration,
+	); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// CancelBid removes the bid of the sender and returns the escrowed price.
+func (ms MsgServer) CancelBid(ctx context.Context, req *types.MsgCancelBid) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.CancelBid(sdk.UnwrapSDKContext(ctx), sender, req.BidID); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// AcceptBid sells the NFT owned by the sender to the bidder.
+func (ms MsgServer) AcceptBid(ctx context.Context, req *types.MsgAcceptBid) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.AcceptBid(sdk.UnwrapSDKContext(ctx), sender, req.BidID); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
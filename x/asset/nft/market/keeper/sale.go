@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
+)
+
+// sell transfers the NFT from the seller to the buyer and pays the price escrowed by the module to the seller and the
+// royalty to the issuer of the class. The transfer is executed by the asset nft keeper, so the freezing,
+// whitelisting and other rules of the class are applied.
+func (k Keeper) sell(ctx sdk.Context, classID, nftID string, seller, buyer sdk.AccAddress, price sdk.Coin) error {
+	classDefinition, err := k.assetNFTKeeper.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.assetNFTKeeper.Transfer(ctx, classID, nftID, buyer); err != nil {
+		return sdkerrors.Wrap(err, "failed to transfer the nft")
+	}
+
+	royalty := sdk.NewCoin(price.Denom, sdkmath.ZeroInt())
+	if !classDefinition.IsIssuer(seller) && classDefinition.RoyaltyRate.IsPositive() {
+		royalty.Amount = classDefinition.RoyaltyRate.MulInt(price.Amount).TruncateInt()
+	}
+	if royalty.IsPositive() {
+		issuer, err := sdk.AccAddressFromBech32(classDefinition.Issuer)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, issuer, sdk.NewCoins(royalty),
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to pay the royalty")
+		}
+	}
+	if proceeds := price.Sub(royalty); proceeds.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, seller, sdk.NewCoins(proceeds),
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to pay the seller")
+		}
+	}
+
+	listing, found, err := k.getListingByNFT(ctx, classID, nftID)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := k.removeListing(ctx, listing, types.RemovalReasonSold); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventNFTSold{
+		ClassID: classID,
+		NFTID:   nftID,
+		Seller:  seller.String(),
+		Buyer:   buyer.String(),
+		Price:   price,
+		Royalty: royalty,
+	})
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the amino types and interfaces.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgListNFT{}, ModuleName+"/MsgListNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelListing{}, ModuleName+"/MsgCancelListing")
+	legacy.RegisterAminoMsg(cdc, &MsgPlaceBid{}, ModuleName+"/MsgPlaceBid")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelBid{}, ModuleName+"/MsgCancelBid")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptBid{}, ModuleName+"/MsgAcceptBid")
+}
+
+// RegisterInterfaces registers the module's tx interfaces.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
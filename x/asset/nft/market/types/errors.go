@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidInput is returned when input validation fails.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 2, "invalid input")
+
+	// ErrListingNotFound is returned when the listing doesn't exist.
+	ErrListingNotFound = sdkerrors.Register(ModuleName, 3, "listing not found")
+
+	// ErrListingExists is returned when the NFT is already listed.
+	ErrListingExists = sdkerrors.Register(ModuleName, 4, "listing already exists")
+
+	// ErrBidNotFound is returned when the bid doesn't exist.
+	ErrBidNotFound = sdkerrors.Register(ModuleName, 5, "bid not found")
+
+	// ErrExpired is returned when the listing or the bid is expired.
+	ErrExpired = sdkerrors.Register(ModuleName, 6, "expired")
+)
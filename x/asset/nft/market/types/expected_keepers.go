@@ -0,0 +1,31 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+// BankKeeper interface for escrowing the bids and paying the sales.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(
+		ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+	) error
+	SendCoinsFromModuleToAccount(
+		ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+	) error
+}
+
+// AssetNFTKeeper interface for resolving the classes and transferring the NFTs under the rules of the class.
+type AssetNFTKeeper interface {
+	GetClassDefinition(ctx sdk.Context, classID string) (assetnfttypes.ClassDefinition, error)
+	IsFrozen(ctx sdk.Context, classID, nftID string) (bool, error)
+	Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error
+}
+
+// NFTKeeper interface for resolving the owners of the NFTs.
+type NFTKeeper interface {
+	GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+}
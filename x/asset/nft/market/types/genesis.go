@@ -0,0 +1,61 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Listings:        []Listing{},
+		Bids:            []Bid{},
+		ListingSequence: 1,
+		BidSequence:     1,
+	}
+}
+
+// Validate validates genesis parameters.
+func (m GenesisState) Validate() error {
+	if m.ListingSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "listing sequence must be positive")
+	}
+	if m.BidSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "bid sequence must be positive")
+	}
+
+	listingIDs := make(map[uint64]struct{}, len(m.Listings))
+	listedNFTs := make(map[string]struct{}, len(m.Listings))
+	for _, listing := range m.Listings {
+		if err := listing.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid listing %d", listing.ID)
+		}
+		if listing.ID >= m.ListingSequence {
+			return errorsmod.Wrapf(ErrInvalidInput, "listing ID %d is not lower than the listing sequence", listing.ID)
+		}
+		if _, found := listingIDs[listing.ID]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate listing %d", listing.ID)
+		}
+		listingIDs[listing.ID] = struct{}{}
+		nftKey := NFTKey(listing.ClassID, listing.NFTID)
+		if _, found := listedNFTs[nftKey]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate listing of nft %s", nftKey)
+		}
+		listedNFTs[nftKey] = struct{}{}
+	}
+
+	bidIDs := make(map[uint64]struct{}, len(m.Bids))
+	for _, bid := range m.Bids {
+		if err := bid.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid bid %d", bid.ID)
+		}
+		if bid.ID >= m.BidSequence {
+			return errorsmod.Wrapf(ErrInvalidInput, "bid ID %d is not lower than the bid sequence", bid.ID)
+		}
+		if _, found := bidIDs[bid.ID]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate bid %d", bid.ID)
+		}
+		bidIDs[bid.ID] = struct{}{}
+	}
+
+	return nil
+}
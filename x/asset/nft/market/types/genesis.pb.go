@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/nft/market/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the module genesis state.
+type GenesisState struct {
+	// listings contains the active listings.
+	Listings []Listing `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings"`
+	// bids contains the active bids.
+	Bids []Bid `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	// listing_sequence is the ID assigned to the next listing.
+	ListingSequence uint64 `protobuf:"varint,3,opt,name=listing_sequence,json=listingSequence,proto3" json:"listing_sequence,omitempty"`
+	// bid_sequence is the ID assigned to the next bid.
+	BidSequence uint64 `protobuf:"varint,4,opt,name=bid_sequence,json=bidSequence,proto3" json:"bid_sequence,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0bd2dc37d42c5d17, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetListings() []Listing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBids() []Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetListingSequence() uint64 {
+	if m != nil {
+		return m.ListingSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBidSequence() uint64 {
+	if m != nil {
+		return m.BidSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "coreum.asset.nft.market.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("coreum/asset/nft/market/v1/genesis.proto", fileDescriptor_0bd2dc37d42c5d17)
+}
+
+var fileDescriptor_0bd2dc37d42c5d17 = []byte{
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x73, 0x36, 0x88, 0x5c, 0x0b, 0x4a, 0x70, 0x28, 0x1d, 0xae, 0x55, 0x07, 0xeb, 0xd0,
+	0x3b, 0xaa, 0x83, 0xe8, 0x58, 0x10, 0x17, 0xa7, 0xd6, 0xc9, 0x45, 0xf2, 0xe7, 0x6d, 0x7a, 0xd4,
+	0xde, 0xd5, 0xdc, 0x9b, 0x10, 0xfd, 0x14, 0x7e, 0xac, 0x8e, 0x1d, 0x9d, 0x8a, 0x24, 0x5f, 0x44,
+	0xbc, 0x44, 0x1d, 0xc4, 0x6e, 0x2f, 0x0f, 0xbf, 0xe7, 0xf7, 0xc2, 0x43, 0xfb, 0xa1, 0x4e, 0x20,
+	0x5d, 0x08, 0xdf, 0x18, 0x40, 0xa1, 0xa6, 0x28, 0x16, 0x7e, 0x32, 0x07, 0x14, 0xd9, 0x50, 0xc4,
+	0xa0, 0xc0, 0x48, 0xc3, 0x97, 0x89, 0x46, 0xed, 0x75, 0x2a, 0x92, 0x5b, 0x92, 0xab, 0x29, 0xf2,
+	0x8a, 0xe4, 0xd9, 0xb0, 0x73, 0xba, 0xc5, 0x52, 0x53, 0x56, 0xd2, 0x39, 0x8c, 0x75, 0xac, 0xed,
+	0x29, 0xbe, 0xae, 0x2a, 0x3d, 0xde, 0x10, 0xda, 0xba, 0xad, 0x9e, 0x4d, 0xd0, 0x47, 0xf0, 0x6e,
+	0xe8, 0xde, 0x93, 0x34, 0x28, 0x55, 0x6c, 0xda, 0xa4, 0xd7, 0xe8, 0x37, 0xcf, 0x4f, 0xf8, 0xff,
+	0xef, 0xf9, 0x5d, 0xc5, 0x8e, 0xdc, 0xd5, 0xa6, 0xeb, 0x8c, 0x7f, 0xaa, 0xde, 0x15, 0x75, 0x03,
+	0x19, 0x99, 0xf6, 0x8e, 0x55, 0x74, 0xb7, 0x29, 0x46, 0x32, 0xaa, 0xeb, 0xb6, 0xe2, 0x9d, 0xd1,
+	0x83, 0x5a, 0xf3, 0x68, 0xe0, 0x39, 0x05, 0x15, 0x42, 0xbb, 0xd1, 0x23, 0x7d, 0x77, 0xbc, 0x5f,
+	0xe7, 0x93, 0x3a, 0xf6, 0x8e, 0x68, 0x2b, 0x90, 0xd1, 0x2f, 0xe6, 0x5a, 0xac, 0x19, 0xc8, 0xe8,
+	0x1b, 0x19, 0xdd, 0xaf, 0x0a, 0x46, 0xd6, 0x05, 0x23, 0x1f, 0x05, 0x23, 0x6f, 0x25, 0x73, 0xd6,
+	0x25, 0x73, 0xde, 0x4b, 0xe6, 0x3c, 0x5c, 0xc7, 0x12, 0x67, 0x69, 0xc0, 0x43, 0xbd, 0x10, 0xa8,
+	0xe7, 0xa0, 0xe4, 0x2b, 0x0c, 0x72, 0x81, 0xf9, 0x20, 0x9c, 0xf9, 0x52, 0x89, 0xec, 0x52, 0xe4,
+	0x7f, 0x67, 0xc5, 0x97, 0x25, 0x98, 0x60, 0xd7, 0xae, 0x77, 0xf1, 0x39, 0x00, 0xe7, 0xf4, 0x81,
+	0xba, 0xc4, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BidSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ListingSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ListingSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ListingSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ListingSequence))
+	}
+	if m.BidSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.BidSequence))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingSequence", wireType)
+			}
+			m.ListingSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListingSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidSequence", wireType)
+			}
+			m.BidSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
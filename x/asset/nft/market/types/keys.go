@@ -0,0 +1,29 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "market"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// KVStore keys.
+var (
+	ListingKey           = collections.NewPrefix(0) // Map: listing ID -> Listing
+	ListingByNFTKey      = collections.NewPrefix(1) // Map: (class ID, NFT ID) -> listing ID
+	ListingExpirationKey = collections.NewPrefix(2) // KeySet: (expiration, listing ID)
+	ListingSequenceKey   = collections.NewPrefix(3)
+	BidKey               = collections.NewPrefix(4) // Map: bid ID -> Bid
+	BidByNFTKey          = collections.NewPrefix(5) // KeySet: (NFT key, bid ID)
+	BidExpirationKey     = collections.NewPrefix(6) // KeySet: (expiration, bid ID)
+	BidSequenceKey       = collections.NewPrefix(7)
+)
+
+// NFTKey returns the key identifying the NFT in the indexes of the module. The class ID can't contain the separator,
+// so the key is unique.
+func NFTKey(classID, nftID string) string {
+	return classID + "/" + nftID
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+const (
+	// MaxOfferDuration is the maximum period between the creation of the listing or the bid and its expiration.
+	MaxOfferDuration = 90 * 24 * time.Hour
+
+	// MaxExpirationsPerBlock is the maximum number of the expired listings and the expired bids removed in one block
+	// each. The rest is removed in the next blocks.
+	MaxExpirationsPerBlock = 100
+)
+
+// Reasons of the removal of the listings and the bids.
+const (
+	RemovalReasonCancelled = "cancelled"
+	RemovalReasonExpired   = "expired"
+	RemovalReasonSold      = "sold"
+)
+
+// ValidateBasic checks that the listing fields are valid.
+func (l Listing) ValidateBasic() error {
+	if l.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "listing ID must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(l.Seller); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid seller address %q: %s", l.Seller, err)
+	}
+	return validateOffer(l.ClassID, l.NFTID, l.Price, l.Expiration)
+}
+
+// ValidateBasic checks that the bid fields are valid.
+func (b Bid) ValidateBasic() error {
+	if b.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "bid ID must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(b.Bidder); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid bidder address %q: %s", b.Bidder, err)
+	}
+	return validateOffer(b.ClassID, b.NFTID, b.Price, b.Expiration)
+}
+
+func validateOffer(classID, nftID string, price sdk.Coin, expiration time.Time) error {
+	if _, _, err := assetnfttypes.DeconstructClassID(classID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+	if err := assetnfttypes.ValidateTokenID(nftID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+	if err := price.Validate(); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, err.Error())
+	}
+	if !price.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "price must be positive")
+	}
+	if expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "expiration must be set")
+	}
+	return nil
+}
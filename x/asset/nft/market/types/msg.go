@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type extendedMsg interface {
+	sdk.Msg
+	sdk.HasValidateBasic
+}
+
+var (
+	_ extendedMsg = &MsgListNFT{}
+	_ extendedMsg = &MsgCancelListing{}
+	_ extendedMsg = &MsgPlaceBid{}
+	_ extendedMsg = &MsgCancelBid{}
+	_ extendedMsg = &MsgAcceptBid{}
+)
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgListNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	return validateOffer(m.ClassID, m.NFTID, m.Price, m.Expiration)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgCancelListing) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if m.ListingID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "listing ID must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgPlaceBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	return validateOffer(m.ClassID, m.NFTID, m.Price, m.Expiration)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgCancelBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if m.BidID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "bid ID must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgAcceptBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if m.BidID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "bid ID must be positive")
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/client/cli"
 	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/simulation"
 	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
 )
 
@@ -102,10 +103,11 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper       keeper.Keeper
-	nftKeeper    types.NFTKeeper
-	wasmKeeper   types.WasmKeeper
-	paramsKeeper types.ParamsKeeper
+	keeper        keeper.Keeper
+	nftKeeper     types.NFTKeeper
+	wasmKeeper    types.WasmKeeper
+	paramsKeeper  types.ParamsKeeper
+	accountKeeper types.AccountKeeper
 }
 
 // NewAppModule returns the new instance of the AppModule.
@@ -115,6 +117,7 @@ func NewAppModule(
 	nftKeeper types.NFTKeeper,
 	wasmKeeper types.WasmKeeper,
 	paramsKeeper types.ParamsKeeper,
+	accountKeeper types.AccountKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
@@ -122,6 +125,7 @@ func NewAppModule(
 		nftKeeper:      nftKeeper,
 		wasmKeeper:     wasmKeeper,
 		paramsKeeper:   paramsKeeper,
+		accountKeeper:  accountKeeper,
 	}
 }
 
@@ -178,6 +182,12 @@ func (AppModule) GenerateGenesisState(_ *module.SimulationState) {}
 func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 
 // WeightedOperations returns the all the assetnft module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.NewOperationFactory(
+		simState.AppParams,
+		simState.TxConfig,
+		am.keeper,
+		am.nftKeeper,
+		am.accountKeeper,
+	).WeightedOperations()
 }
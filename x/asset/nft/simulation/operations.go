@@ -0,0 +1,326 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/samber/lo"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+// Message types.
+var (
+	TypeMsgIssueClass = sdk.MsgTypeURL(&types.MsgIssueClass{})
+	TypeMsgMint       = sdk.MsgTypeURL(&types.MsgMint{})
+	TypeMsgBurn       = sdk.MsgTypeURL(&types.MsgBurn{})
+	TypeMsgFreeze     = sdk.MsgTypeURL(&types.MsgFreeze{})
+	TypeMsgUnfreeze   = sdk.MsgTypeURL(&types.MsgUnfreeze{})
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgIssueClass      = "op_weight_msg_issue_class"
+	DefaultWeightMsgIssueClass = 50
+	OpWeightMsgMint            = "op_weight_msg_mint"
+	DefaultWeightMsgMint       = 100
+	OpWeightMsgBurn            = "op_weight_msg_burn"
+	DefaultWeightMsgBurn       = 30
+	OpWeightMsgFreeze          = "op_weight_msg_freeze"
+	DefaultWeightMsgFreeze     = 30
+	OpWeightMsgUnfreeze        = "op_weight_msg_unfreeze"
+	DefaultWeightMsgUnfreeze   = 30
+)
+
+// Keeper defines the methods of the asset nft keeper used by the simulation.
+type Keeper interface {
+	GetClassDefinitions(
+		ctx sdk.Context, issuer *sdk.AccAddress, pagination *query.PageRequest,
+	) ([]types.ClassDefinition, *query.PageResponse, error)
+	IsBurnt(ctx sdk.Context, classID, nftID string) (bool, error)
+	IsFrozen(ctx sdk.Context, classID, nftID string) (bool, error)
+}
+
+// OperationFactory creates simulation messages.
+type OperationFactory struct {
+	appParams simtypes.AppParams
+	txConfig  client.TxConfig
+	keeper    Keeper
+	nftKeeper types.NFTKeeper
+	ak        types.AccountKeeper
+}
+
+// NewOperationFactory returns new instance of the OperationFactory.
+func NewOperationFactory(
+	appParams simtypes.AppParams,
+	txConfig client.TxConfig,
+	keeper Keeper,
+	nftKeeper types.NFTKeeper,
+	ak types.AccountKeeper,
+) *OperationFactory {
+	return &OperationFactory{
+		appParams: appParams,
+		txConfig:  txConfig,
+		keeper:    keeper,
+		nftKeeper: nftKeeper,
+		ak:        ak,
+	}
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func (op *OperationFactory) WeightedOperations() simulation.WeightedOperations {
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgIssueClass, DefaultWeightMsgIssueClass),
+			op.simulateMsgIssueClass,
+		),
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgMint, DefaultWeightMsgMint),
+			op.simulateMsgMint,
+		),
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgBurn, DefaultWeightMsgBurn),
+			op.simulateMsgBurn,
+		),
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgFreeze, DefaultWeightMsgFreeze),
+			op.simulateMsgFreeze(true),
+		),
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgUnfreeze, DefaultWeightMsgUnfreeze),
+			op.simulateMsgFreeze(false),
+		),
+	}
+}
+
+// weight returns the weight of the operation, making it updatable by the simulation.
+func (op *OperationFactory) weight(key string, defaultWeight int) int {
+	var weight int
+	op.appParams.GetOrGenerate(key, &weight, nil,
+		func(_ *rand.Rand) {
+			weight = defaultWeight
+		},
+	)
+	return weight
+}
+
+func (op *OperationFactory) simulateMsgIssueClass(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, _ string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	senderAcc, _ := simtypes.RandomAcc(r, accs)
+	if op.ak.GetAccount(ctx, senderAcc.Address) == nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgIssueClass, "account not found"), nil, nil
+	}
+
+	msg := &types.MsgIssueClass{
+		Issuer:      senderAcc.Address.String(),
+		Symbol:      randomID(r, 1, 31),
+		Name:        simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.ClassMaxNameLength)),
+		Description: simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.ClassMaxDescriptionLength)),
+		URI:         simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxURILength)),
+		URIHash:     simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxURIHashLength)),
+		// The whitelisting, disable_sending and soulbound features are not used since they restrict only the
+		// transfers, which aren't simulated.
+		Features: lo.Filter(
+			[]types.ClassFeature{types.ClassFeature_burning, types.ClassFeature_freezing},
+			func(_ types.ClassFeature, _ int) bool { return r.Intn(2) == 0 },
+		),
+		RoyaltyRate: sdkmath.LegacyNewDec(int64(r.Intn(100))).QuoInt64(100),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgIssueClass, "invalid class"), nil, nil
+	}
+	classID := types.BuildClassID(msg.Symbol, senderAcc.Address)
+	if op.nftKeeper.HasClass(ctx, classID) {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgIssueClass, "class exists"), nil, nil
+	}
+
+	return op.deliver(r, app, ctx, senderAcc, msg)
+}
+
+func (op *OperationFactory) simulateMsgMint(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, _ string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	definitions, _, err := op.keeper.GetClassDefinitions(ctx, nil, nil)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "can't get classes"), nil, err
+	}
+	if len(definitions) == 0 {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "no classes"), nil, nil
+	}
+
+	// only the issuer is allowed to mint
+	definition := definitions[r.Intn(len(definitions))]
+	issuer, err := sdk.AccAddressFromBech32(definition.Issuer)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "invalid issuer"), nil, err
+	}
+	senderAcc, found := simtypes.FindAccount(accs, issuer)
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "issuer not found"), nil, nil
+	}
+	recipientAcc, _ := simtypes.RandomAcc(r, accs)
+	msg := &types.MsgMint{
+		Sender:    senderAcc.Address.String(),
+		ClassID:   definition.ID,
+		ID:        randomID(r, 3, 101),
+		URI:       simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxURILength)),
+		URIHash:   simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxURIHashLength)),
+		Recipient: recipientAcc.Address.String(),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "invalid nft"), nil, nil
+	}
+	if op.nftKeeper.HasNFT(ctx, msg.ClassID, msg.ID) {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "nft exists"), nil, nil
+	}
+	burnt, err := op.keeper.IsBurnt(ctx, msg.ClassID, msg.ID)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "can't check burnt nft"), nil, err
+	}
+	if burnt {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgMint, "nft burnt"), nil, nil
+	}
+
+	return op.deliver(r, app, ctx, senderAcc, msg)
+}
+
+func (op *OperationFactory) simulateMsgBurn(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, _ string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	definition, nftID, found, err := op.randomNFT(ctx, r)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "can't get nft"), nil, err
+	}
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "no nfts"), nil, nil
+	}
+
+	owner := op.nftKeeper.GetOwner(ctx, definition.ID, nftID)
+	ownerAcc, found := simtypes.FindAccount(accs, owner)
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "owner not found"), nil, nil
+	}
+	if definition.CheckFeatureAllowed(owner, types.ClassFeature_burning) != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "burning not allowed"), nil, nil
+	}
+	frozen, err := op.keeper.IsFrozen(ctx, definition.ID, nftID)
+	if err != nil && !errors.Is(err, types.ErrFeatureDisabled) {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "can't check frozen nft"), nil, err
+	}
+	if frozen && !definition.IsIssuer(owner) {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurn, "nft frozen"), nil, nil
+	}
+
+	return op.deliver(r, app, ctx, ownerAcc, &types.MsgBurn{
+		Sender:  owner.String(),
+		ClassID: definition.ID,
+		ID:      nftID,
+	})
+}
+
+func (op *OperationFactory) simulateMsgFreeze(freeze bool) simtypes.Operation {
+	typeMsg := TypeMsgUnfreeze
+	if freeze {
+		typeMsg = TypeMsgFreeze
+	}
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		definition, nftID, found, err := op.randomNFT(ctx, r)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsg, "can't get nft"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsg, "no nfts"), nil, nil
+		}
+		if !definition.IsFeatureEnabled(types.ClassFeature_freezing) {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsg, "freezing disabled"), nil, nil
+		}
+
+		issuer, err := sdk.AccAddressFromBech32(definition.Issuer)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsg, "invalid issuer"), nil, err
+		}
+		issuerAcc, found := simtypes.FindAccount(accs, issuer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsg, "issuer not found"), nil, nil
+		}
+
+		var msg sdk.Msg = &types.MsgUnfreeze{
+			Sender:  definition.Issuer,
+			ClassID: definition.ID,
+			ID:      nftID,
+		}
+		if freeze {
+			msg = &types.MsgFreeze{
+				Sender:  definition.Issuer,
+				ClassID: definition.ID,
+				ID:      nftID,
+			}
+		}
+
+		return op.deliver(r, app, ctx, issuerAcc, msg)
+	}
+}
+
+// randomNFT returns the random nft of the random class.
+func (op *OperationFactory) randomNFT(
+	ctx sdk.Context,
+	r *rand.Rand,
+	// definition, nftID, found, err
+) (types.ClassDefinition, string, bool, error) {
+	definitions, _, err := op.keeper.GetClassDefinitions(ctx, nil, nil)
+	if err != nil {
+		return types.ClassDefinition{}, "", false, err
+	}
+	if len(definitions) == 0 {
+		return types.ClassDefinition{}, "", false, nil
+	}
+
+	definition := definitions[r.Intn(len(definitions))]
+	nfts := op.nftKeeper.GetNFTsOfClass(ctx, definition.ID)
+	if len(nfts) == 0 {
+		return types.ClassDefinition{}, "", false, nil
+	}
+
+	return definition, nfts[r.Intn(len(nfts))].Id, true, nil
+}
+
+func (op *OperationFactory) deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	senderAcc simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTx(simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         op.txConfig,
+		Msg:           msg,
+		Context:       ctx,
+		SimAccount:    senderAcc,
+		AccountKeeper: op.ak,
+		ModuleName:    types.ModuleName,
+	}, sdk.Coins{})
+}
+
+// randomID returns the random string starting with a letter, so it can be used as the class symbol or the nft ID.
+func randomID(r *rand.Rand, minLength, maxLength int) string {
+	return simtypes.RandStringOfLength(r, 1) + simtypes.RandStringOfLength(
+		r, simtypes.RandIntBetween(r, minLength, maxLength)-1,
+	)
+}
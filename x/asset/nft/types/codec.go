@@ -24,6 +24,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRemoveFromClassWhitelist{},
 		&MsgClassFreeze{},
 		&MsgClassUnfreeze{},
+		&MsgRegisterDataSchema{},
+		&MsgExtendExpiration{},
+		&MsgBurnExpired{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -0,0 +1,433 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	sdkerrors "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+const (
+	// MaxDataSchemaLength is the max length of the JSON schema of the NFT data.
+	MaxDataSchemaLength = 5 * 1024
+	// maxDataSchemaDepth is the max nesting depth of the JSON schema of the NFT data.
+	maxDataSchemaDepth = 16
+)
+
+// Supported JSON types.
+const (
+	jsonTypeNull    = "null"
+	jsonTypeBoolean = "boolean"
+	jsonTypeObject  = "object"
+	jsonTypeArray   = "array"
+	jsonTypeNumber  = "number"
+	jsonTypeInteger = "integer"
+	jsonTypeString  = "string"
+)
+
+// DataSchema is the parsed JSON schema of the NFT data. To keep the validation deterministic and cheap only the
+// subset of the JSON schema keywords is supported, the schema containing any other keyword is rejected.
+type DataSchema struct {
+	types                []string
+	enum                 []any
+	properties           map[string]*DataSchema
+	required             []string
+	additionalProperties *bool
+	items                *DataSchema
+	minLength            *uint64
+	maxLength            *uint64
+	minItems             *uint64
+	maxItems             *uint64
+	minimum              *big.Rat
+	maximum              *big.Rat
+}
+
+// ParseDataSchema parses and validates the JSON schema of the NFT data.
+func ParseDataSchema(schema string) (*DataSchema, error) {
+	if len(schema) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "data schema must not be empty")
+	}
+	if len(schema) > MaxDataSchemaLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "data schema length must not exceed %d", MaxDataSchemaLength)
+	}
+
+	value, err := decodeJSON([]byte(schema))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "invalid data schema JSON: %s", err)
+	}
+	dataSchema, err := parseDataSchema(value, "#", 0)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "invalid data schema: %s", err)
+	}
+
+	return dataSchema, nil
+}
+
+// ValidateNFTData checks that the NFT data matches the schema. The content of the DataBytes and each item of
+// DataDynamic must be the JSON value matching the schema.
+func (s *DataSchema) ValidateNFTData(data *codectypes.Any) error {
+	if data == nil {
+		return sdkerrors.Wrap(ErrInvalidInput, "data is required by the data schema of the class")
+	}
+
+	switch data.TypeUrl {
+	case "/" + proto.MessageName((*DataBytes)(nil)):
+		var dataBytes DataBytes
+		if err := dataBytes.Unmarshal(data.Value); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, "failed to unmarshal data to DataBytes")
+		}
+		return s.validateJSON(dataBytes.Data, "data")
+	case "/" + proto.MessageName((*DataDynamic)(nil)):
+		var dataDynamic DataDynamic
+		if err := dataDynamic.Unmarshal(data.Value); err != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, "failed to unmarshal data to DataDynamic")
+		}
+		for i, item := range dataDynamic.Items {
+			if err := s.validateJSON(item.Data, "item "+strconv.Itoa(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalidInput, "data type %s is not supported by the data schema", data.TypeUrl)
+	}
+}
+
+func (s *DataSchema) validateJSON(data []byte, name string) error {
+	value, err := decodeJSON(data)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "%s is not valid JSON: %s", name, err)
+	}
+	if err := s.validate(value, "#"); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "%s doesn't match the data schema: %s", name, err)
+	}
+	return nil
+}
+
+//nolint:gocyclo,funlen // the function validates all the supported keywords
+func (s *DataSchema) validate(value any, path string) error {
+	if len(s.types) > 0 && !matchesAnyType(value, s.types) {
+		return errors.Errorf("%s: value must be of type %v", path, s.types)
+	}
+	if s.enum != nil {
+		found := false
+		for _, allowed := range s.enum {
+			if jsonValuesEqual(value, allowed) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := uint64(utf8.RuneCountInString(v))
+		if s.minLength != nil && length < *s.minLength {
+			return errors.Errorf("%s: string length must be at least %d", path, *s.minLength)
+		}
+		if s.maxLength != nil && length > *s.maxLength {
+			return errors.Errorf("%s: string length must be at most %d", path, *s.maxLength)
+		}
+	case json.Number:
+		number, ok := new(big.Rat).SetString(v.String())
+		if !ok {
+			return errors.Errorf("%s: invalid number %s", path, v)
+		}
+		if s.minimum != nil && number.Cmp(s.minimum) < 0 {
+			return errors.Errorf("%s: number must be at least %s", path, s.minimum.RatString())
+		}
+		if s.maximum != nil && number.Cmp(s.maximum) > 0 {
+			return errors.Errorf("%s: number must be at most %s", path, s.maximum.RatString())
+		}
+	case []any:
+		length := uint64(len(v))
+		if s.minItems != nil && length < *s.minItems {
+			return errors.Errorf("%s: array must contain at least %d items", path, *s.minItems)
+		}
+		if s.maxItems != nil && length > *s.maxItems {
+			return errors.Errorf("%s: array must contain at most %d items", path, *s.maxItems)
+		}
+		if s.items != nil {
+			for i, item := range v {
+				if err := s.items.validate(item, path+"/"+strconv.Itoa(i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]any:
+		for _, name := range s.required {
+			if _, ok := v[name]; !ok {
+				return errors.Errorf("%s: property %q is required", path, name)
+			}
+		}
+		// properties are validated in the sorted order to return the same error on all the nodes
+		for _, name := range sortedKeys(v) {
+			propertySchema, ok := s.properties[name]
+			if !ok {
+				if s.additionalProperties != nil && !*s.additionalProperties {
+					return errors.Errorf("%s: property %q is not allowed", path, name)
+				}
+				continue
+			}
+			if err := propertySchema.validate(v[name], path+"/"+name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+//nolint:gocyclo,funlen // the function parses all the supported keywords
+func parseDataSchema(value any, path string, depth int) (*DataSchema, error) {
+	if depth > maxDataSchemaDepth {
+		return nil, errors.Errorf("%s: schema nesting depth must not exceed %d", path, maxDataSchemaDepth)
+	}
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, errors.Errorf("%s: schema must be an object", path)
+	}
+
+	s := &DataSchema{}
+	var err error
+	for _, keyword := range sortedKeys(object) {
+		keywordValue := object[keyword]
+		keywordPath := path + "/" + keyword
+		switch keyword {
+		case "$schema", "$id", "title", "description", "$comment":
+			// annotations don't affect the validation
+		case "type":
+			s.types, err = parseTypes(keywordValue, keywordPath)
+		case "enum":
+			enum, ok := keywordValue.([]any)
+			if !ok || len(enum) == 0 {
+				return nil, errors.Errorf("%s: must be a non-empty array", keywordPath)
+			}
+			s.enum = enum
+		case "properties":
+			s.properties, err = parseProperties(keywordValue, keywordPath, depth)
+		case "required":
+			s.required, err = parseRequired(keywordValue, keywordPath)
+		case "additionalProperties":
+			additionalProperties, ok := keywordValue.(bool)
+			if !ok {
+				return nil, errors.Errorf("%s: must be a boolean", keywordPath)
+			}
+			s.additionalProperties = &additionalProperties
+		case "items":
+			s.items, err = parseDataSchema(keywordValue, keywordPath, depth+1)
+		case "minLength":
+			s.minLength, err = parseNonNegativeInteger(keywordValue, keywordPath)
+		case "maxLength":
+			s.maxLength, err = parseNonNegativeInteger(keywordValue, keywordPath)
+		case "minItems":
+			s.minItems, err = parseNonNegativeInteger(keywordValue, keywordPath)
+		case "maxItems":
+			s.maxItems, err = parseNonNegativeInteger(keywordValue, keywordPath)
+		case "minimum":
+			s.minimum, err = parseRat(keywordValue, keywordPath)
+		case "maximum":
+			s.maximum, err = parseRat(keywordValue, keywordPath)
+		default:
+			return nil, errors.Errorf("%s: keyword is not supported", keywordPath)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func parseTypes(value any, path string) ([]string, error) {
+	var types []string
+	switch v := value.(type) {
+	case string:
+		types = []string{v}
+	case []any:
+		for _, t := range v {
+			typ, ok := t.(string)
+			if !ok {
+				return nil, errors.Errorf("%s: must contain strings", path)
+			}
+			types = append(types, typ)
+		}
+	default:
+		return nil, errors.Errorf("%s: must be a string or an array of strings", path)
+	}
+	if len(types) == 0 {
+		return nil, errors.Errorf("%s: must not be empty", path)
+	}
+
+	for _, typ := range types {
+		switch typ {
+		case jsonTypeNull, jsonTypeBoolean, jsonTypeObject, jsonTypeArray, jsonTypeNumber, jsonTypeInteger,
+			jsonTypeString:
+		default:
+			return nil, errors.Errorf("%s: unknown type %q", path, typ)
+		}
+	}
+
+	return types, nil
+}
+
+func parseProperties(value any, path string, depth int) (map[string]*DataSchema, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, errors.Errorf("%s: must be an object", path)
+	}
+	properties := make(map[string]*DataSchema, len(object))
+	for _, name := range sortedKeys(object) {
+		propertySchema, err := parseDataSchema(object[name], path+"/"+name, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		properties[name] = propertySchema
+	}
+	return properties, nil
+}
+
+func parseRequired(value any, path string) ([]string, error) {
+	items, ok := value.([]any)
+	if !ok {
+		return nil, errors.Errorf("%s: must be an array of strings", path)
+	}
+	required := make([]string, 0, len(items))
+	for _, item := range items {
+		name, ok := item.(string)
+		if !ok {
+			return nil, errors.Errorf("%s: must be an array of strings", path)
+		}
+		required = append(required, name)
+	}
+	return required, nil
+}
+
+func parseNonNegativeInteger(value any, path string) (*uint64, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return nil, errors.Errorf("%s: must be a non-negative integer", path)
+	}
+	rat, ok := new(big.Rat).SetString(number.String())
+	if !ok || !rat.IsInt() || rat.Sign() < 0 || !rat.Num().IsUint64() {
+		return nil, errors.Errorf("%s: must be a non-negative integer", path)
+	}
+	result := rat.Num().Uint64()
+	return &result, nil
+}
+
+func parseRat(value any, path string) (*big.Rat, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return nil, errors.Errorf("%s: must be a number", path)
+	}
+	rat, ok := new(big.Rat).SetString(number.String())
+	if !ok {
+		return nil, errors.Errorf("%s: must be a number", path)
+	}
+	return rat, nil
+}
+
+func matchesAnyType(value any, types []string) bool {
+	for _, typ := range types {
+		if matchesType(value, typ) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesType(value any, typ string) bool {
+	switch v := value.(type) {
+	case nil:
+		return typ == jsonTypeNull
+	case bool:
+		return typ == jsonTypeBoolean
+	case string:
+		return typ == jsonTypeString
+	case []any:
+		return typ == jsonTypeArray
+	case map[string]any:
+		return typ == jsonTypeObject
+	case json.Number:
+		if typ == jsonTypeNumber {
+			return true
+		}
+		if typ != jsonTypeInteger {
+			return false
+		}
+		rat, ok := new(big.Rat).SetString(v.String())
+		return ok && rat.IsInt()
+	default:
+		return false
+	}
+}
+
+func jsonValuesEqual(a, b any) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		aRat, aOK := new(big.Rat).SetString(av.String())
+		bRat, bOK := new(big.Rat).SetString(bv.String())
+		return aOK && bOK && aRat.Cmp(bRat) == 0
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonValuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for key, value := range av {
+			other, ok := bv[key]
+			if !ok || !jsonValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+func sortedKeys(object map[string]any) []string {
+	keys := lo.Keys(object)
+	sort.Strings(keys)
+	return keys
+}
+
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return value, nil
+}
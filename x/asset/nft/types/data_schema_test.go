@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+)
+
+func TestParseDataSchema(t *testing.T) {
+	testCases := []struct {
+		name   string
+		schema string
+		valid  bool
+	}{
+		{
+			name: "valid",
+			schema: `{
+				"$schema": "https://json-schema.org/draft/2020-12/schema",
+				"title": "nft",
+				"type": "object",
+				"required": ["name"],
+				"additionalProperties": false,
+				"properties": {
+					"name": {"type": "string", "minLength": 1, "maxLength": 32},
+					"level": {"type": "integer", "minimum": 1, "maximum": 100},
+					"rarity": {"enum": ["common", "rare"]},
+					"tags": {"type": "array", "maxItems": 3, "items": {"type": "string"}}
+				}
+			}`,
+			valid: true,
+		},
+		{
+			name:   "empty object",
+			schema: `{}`,
+			valid:  true,
+		},
+		{
+			name:   "empty",
+			schema: ``,
+		},
+		{
+			name:   "not json",
+			schema: `{`,
+		},
+		{
+			name:   "trailing data",
+			schema: `{}{}`,
+		},
+		{
+			name:   "not object",
+			schema: `[]`,
+		},
+		{
+			name:   "unsupported keyword",
+			schema: `{"type": "string", "pattern": "^a"}`,
+		},
+		{
+			name:   "unknown type",
+			schema: `{"type": "text"}`,
+		},
+		{
+			name:   "negative length",
+			schema: `{"type": "string", "maxLength": -1}`,
+		},
+		{
+			name:   "invalid nested schema",
+			schema: `{"properties": {"name": {"format": "email"}}}`,
+		},
+		{
+			name:   "empty enum",
+			schema: `{"enum": []}`,
+		},
+		{
+			name:   "too deep",
+			schema: strings.Repeat(`{"items":`, 20) + `{}` + strings.Repeat(`}`, 20),
+		},
+		{
+			name:   "too long",
+			schema: `{"description": "` + strings.Repeat("a", types.MaxDataSchemaLength) + `"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.ParseDataSchema(tc.schema)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidInput)
+			}
+		})
+	}
+}
+
+func TestDataSchema_ValidateNFTData(t *testing.T) {
+	requireT := require.New(t)
+
+	schema, err := types.ParseDataSchema(`{
+		"type": "object",
+		"required": ["name"],
+		"additionalProperties": false,
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "maxLength": 5},
+			"level": {"type": "integer", "minimum": 1, "maximum": 100},
+			"price": {"type": ["number", "null"], "minimum": 0.5},
+			"rarity": {"enum": ["common", "rare", 1]},
+			"tags": {"type": "array", "maxItems": 2, "items": {"type": "string"}}
+		}
+	}`)
+	requireT.NoError(err)
+
+	testCases := []struct {
+		name  string
+		data  string
+		valid bool
+	}{
+		{name: "minimal", data: `{"name": "nft"}`, valid: true},
+		{
+			name:  "full",
+			data:  `{"name": "nft", "level": 1e1, "price": 0.5, "rarity": 1.0, "tags": ["a", "b"]}`,
+			valid: true,
+		},
+		{name: "null price", data: `{"name": "nft", "price": null}`, valid: true},
+		{name: "unicode name", data: `{"name": "ñññññ"}`, valid: true},
+		{name: "not json", data: `nft`},
+		{name: "trailing data", data: `{"name": "nft"} {}`},
+		{name: "not object", data: `"nft"`},
+		{name: "missing required", data: `{"level": 1}`},
+		{name: "additional property", data: `{"name": "nft", "owner": "me"}`},
+		{name: "too short", data: `{"name": ""}`},
+		{name: "too long", data: `{"name": "nft123"}`},
+		{name: "not integer", data: `{"name": "nft", "level": 1.5}`},
+		{name: "below minimum", data: `{"name": "nft", "price": 0.4}`},
+		{name: "above maximum", data: `{"name": "nft", "level": 101}`},
+		{name: "not in enum", data: `{"name": "nft", "rarity": "epic"}`},
+		{name: "too many items", data: `{"name": "nft", "tags": ["a", "b", "c"]}`},
+		{name: "invalid item", data: `{"name": "nft", "tags": [1]}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte(tc.data)})
+			require.NoError(t, err)
+			err = schema.ValidateNFTData(data)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidInput)
+			}
+		})
+	}
+
+	// all the items of the dynamic data must match the schema
+	data, err := codectypes.NewAnyWithValue(&types.DataDynamic{
+		Items: []types.DataDynamicItem{
+			{Data: []byte(`{"name": "nft"}`)},
+			{Data: []byte(`{"name": "nft123"}`)},
+		},
+	})
+	requireT.NoError(err)
+	requireT.ErrorIs(schema.ValidateNFTData(data), types.ErrInvalidInput)
+
+	// the data is required
+	requireT.ErrorIs(schema.ValidateNFTData(nil), types.ErrInvalidInput)
+}
@@ -19,4 +19,8 @@ var (
 	ErrInvalidKey = sdkerrors.Register(ModuleName, 6, "invalid key")
 	// ErrInvalidState is returned when state of the module is invalid.
 	ErrInvalidState = sdkerrors.Register(ModuleName, 7, "invalid state")
+	// ErrDataSchemaNotFound is returned when the data schema of the class is not found in the store.
+	ErrDataSchemaNotFound = sdkerrors.Register(ModuleName, 8, "data schema not found")
+	// ErrExpirationNotFound is returned when the expiration time of the non-fungible token is not found in the store.
+	ErrExpirationNotFound = sdkerrors.Register(ModuleName, 9, "expiration not found")
 )
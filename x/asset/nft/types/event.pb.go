@@ -8,15 +8,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -574,6 +578,197 @@ func (m *EventRemovedFromClassWhitelist) GetAccount() string {
 	return ""
 }
 
+// EventDataSchemaRegistered is emitted on MsgRegisterDataSchema.
+type EventDataSchemaRegistered struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Schema  string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *EventDataSchemaRegistered) Reset()         { *m = EventDataSchemaRegistered{} }
+func (m *EventDataSchemaRegistered) String() string { return proto.CompactTextString(m) }
+func (*EventDataSchemaRegistered) ProtoMessage()    {}
+func (*EventDataSchemaRegistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef75aa7da633196, []int{9}
+}
+func (m *EventDataSchemaRegistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDataSchemaRegistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDataSchemaRegistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDataSchemaRegistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDataSchemaRegistered.Merge(m, src)
+}
+func (m *EventDataSchemaRegistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDataSchemaRegistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDataSchemaRegistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDataSchemaRegistered proto.InternalMessageInfo
+
+func (m *EventDataSchemaRegistered) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventDataSchemaRegistered) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+// EventExpirationSet is emitted on MsgMint with the expiration time and on MsgExtendExpiration.
+type EventExpirationSet struct {
+	ClassId        string    `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id             string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ExpirationTime time.Time `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+}
+
+func (m *EventExpirationSet) Reset()         { *m = EventExpirationSet{} }
+func (m *EventExpirationSet) String() string { return proto.CompactTextString(m) }
+func (*EventExpirationSet) ProtoMessage()    {}
+func (*EventExpirationSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef75aa7da633196, []int{10}
+}
+func (m *EventExpirationSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpirationSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpirationSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExpirationSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpirationSet.Merge(m, src)
+}
+func (m *EventExpirationSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpirationSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpirationSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExpirationSet proto.InternalMessageInfo
+
+func (m *EventExpirationSet) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventExpirationSet) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventExpirationSet) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
+}
+
+// EventExpiredNFTBurnt is emitted on MsgBurnExpired.
+type EventExpiredNFTBurnt struct {
+	ClassId        string    `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id             string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Owner          string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	ExpirationTime time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+	Sender         string    `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *EventExpiredNFTBurnt) Reset()         { *m = EventExpiredNFTBurnt{} }
+func (m *EventExpiredNFTBurnt) String() string { return proto.CompactTextString(m) }
+func (*EventExpiredNFTBurnt) ProtoMessage()    {}
+func (*EventExpiredNFTBurnt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fef75aa7da633196, []int{11}
+}
+func (m *EventExpiredNFTBurnt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpiredNFTBurnt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpiredNFTBurnt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExpiredNFTBurnt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpiredNFTBurnt.Merge(m, src)
+}
+func (m *EventExpiredNFTBurnt) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpiredNFTBurnt) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpiredNFTBurnt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExpiredNFTBurnt proto.InternalMessageInfo
+
+func (m *EventExpiredNFTBurnt) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventExpiredNFTBurnt) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventExpiredNFTBurnt) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventExpiredNFTBurnt) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
+}
+
+func (m *EventExpiredNFTBurnt) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventClassIssued)(nil), "coreum.asset.nft.v1.EventClassIssued")
 	proto.RegisterType((*EventFrozen)(nil), "coreum.asset.nft.v1.EventFrozen")
@@ -584,47 +779,58 @@ func init() {
 	proto.RegisterType((*EventRemovedFromWhitelist)(nil), "coreum.asset.nft.v1.EventRemovedFromWhitelist")
 	proto.RegisterType((*EventAddedToClassWhitelist)(nil), "coreum.asset.nft.v1.EventAddedToClassWhitelist")
 	proto.RegisterType((*EventRemovedFromClassWhitelist)(nil), "coreum.asset.nft.v1.EventRemovedFromClassWhitelist")
+	proto.RegisterType((*EventDataSchemaRegistered)(nil), "coreum.asset.nft.v1.EventDataSchemaRegistered")
+	proto.RegisterType((*EventExpirationSet)(nil), "coreum.asset.nft.v1.EventExpirationSet")
+	proto.RegisterType((*EventExpiredNFTBurnt)(nil), "coreum.asset.nft.v1.EventExpiredNFTBurnt")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/event.proto", fileDescriptor_fef75aa7da633196) }
 
 var fileDescriptor_fef75aa7da633196 = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x13, 0xa7, 0x8d, 0xd3, 0x0d, 0x54, 0x68, 0x29, 0xc8, 0x2d, 0xc2, 0x2e, 0x41, 0x42,
-	0xbd, 0xd4, 0x56, 0xe9, 0x81, 0x13, 0x07, 0x4a, 0x09, 0x58, 0x02, 0x04, 0x2b, 0x22, 0x24, 0x84,
-	0x54, 0x36, 0xf6, 0x24, 0x5e, 0x35, 0xf6, 0x46, 0xbb, 0xeb, 0x90, 0xf4, 0x29, 0x78, 0xac, 0x1e,
-	0x7b, 0x44, 0x3d, 0x44, 0xc8, 0x79, 0x11, 0xb4, 0xeb, 0x14, 0x02, 0x6a, 0x45, 0x91, 0x72, 0x9b,
-	0xf9, 0x67, 0xe6, 0x9b, 0xd9, 0xdf, 0x92, 0x91, 0x17, 0x71, 0x01, 0x79, 0x1a, 0x50, 0x29, 0x41,
-	0x05, 0x59, 0x4f, 0x05, 0xa3, 0xbd, 0x00, 0x46, 0x90, 0x29, 0x7f, 0x28, 0xb8, 0xe2, 0xf8, 0x76,
-	0xd9, 0xe0, 0x9b, 0x06, 0x3f, 0xeb, 0x29, 0x7f, 0xb4, 0xb7, 0x75, 0xff, 0xb2, 0x29, 0x5d, 0x33,
-	0x33, 0x5b, 0x1b, 0x7d, 0xde, 0xe7, 0x26, 0x0c, 0x74, 0x54, 0xaa, 0xad, 0x73, 0x0b, 0xdd, 0x7a,
-	0xa1, 0xc9, 0xcf, 0x07, 0x54, 0xca, 0x50, 0xca, 0x1c, 0x62, 0x7c, 0x17, 0x59, 0x2c, 0x76, 0xaa,
-	0xdb, 0xd5, 0x9d, 0xb5, 0x83, 0x7a, 0x31, 0xf5, 0xac, 0xf0, 0x90, 0x58, 0x4c, 0xeb, 0x75, 0xa6,
-	0x3b, 0x84, 0x63, 0xe9, 0x1a, 0x99, 0x67, 0x5a, 0x97, 0x93, 0xb4, 0xcb, 0x07, 0x4e, 0xad, 0xd4,
-	0xcb, 0x0c, 0x63, 0xb4, 0x92, 0xd1, 0x14, 0x9c, 0x15, 0xa3, 0x9a, 0x18, 0x6f, 0xa3, 0x66, 0x0c,
-	0x32, 0x12, 0x6c, 0xa8, 0x18, 0xcf, 0x9c, 0x55, 0x53, 0x5a, 0x94, 0xf0, 0x26, 0xaa, 0xe5, 0x82,
-	0x39, 0x75, 0xb3, 0xde, 0x2e, 0xa6, 0x5e, 0xad, 0x43, 0x42, 0xa2, 0x35, 0xfc, 0x08, 0x35, 0x72,
-	0xc1, 0x8e, 0x12, 0x2a, 0x13, 0xc7, 0x36, 0xf5, 0x66, 0x31, 0xf5, 0xec, 0x0e, 0x09, 0x5f, 0x51,
-	0x99, 0x10, 0x3b, 0x17, 0x4c, 0x07, 0xf8, 0x29, 0x6a, 0xf4, 0x80, 0xaa, 0x5c, 0x80, 0x74, 0x1a,
-	0xdb, 0xb5, 0x9d, 0xf5, 0xc7, 0x0f, 0xfc, 0x4b, 0x2c, 0xf3, 0xcd, 0xa3, 0xdb, 0x65, 0x27, 0xf9,
-	0x35, 0x82, 0xdb, 0xe8, 0x86, 0xe0, 0x13, 0x3a, 0x50, 0x93, 0x23, 0x41, 0x15, 0x38, 0x6b, 0x66,
-	0xd5, 0xc3, 0xd3, 0xa9, 0x57, 0x39, 0x9f, 0x7a, 0xf7, 0x22, 0x2e, 0x53, 0x2e, 0x65, 0x7c, 0xec,
-	0x33, 0x1e, 0xa4, 0x54, 0x25, 0xfe, 0x6b, 0xe8, 0xd3, 0x68, 0x72, 0x08, 0x11, 0x69, 0xce, 0x07,
-	0x09, 0x55, 0xd0, 0x7a, 0x8b, 0x9a, 0xc6, 0xdb, 0xb6, 0xe0, 0x27, 0xa0, 0x1f, 0xd6, 0x88, 0xf4,
-	0xc2, 0xa3, 0x0b, 0x73, 0x89, 0x6d, 0xf2, 0x30, 0xc6, 0xeb, 0xc6, 0xf1, 0xd2, 0x55, 0xed, 0xf4,
-	0x06, 0x5a, 0xe5, 0x5f, 0x33, 0x10, 0x73, 0x43, 0xcb, 0xa4, 0xf5, 0x0e, 0xdd, 0x34, 0xbc, 0x4e,
-	0xd6, 0x5b, 0x12, 0xf1, 0xe5, 0xe2, 0xd7, 0xff, 0xf7, 0x99, 0x0e, 0xb2, 0x69, 0x14, 0xf1, 0x3c,
-	0x53, 0x73, 0xcc, 0x45, 0xda, 0x0a, 0x11, 0xfe, 0x0d, 0xba, 0xce, 0x7d, 0x57, 0xa3, 0x3e, 0xa3,
-	0x3b, 0x06, 0xf5, 0x2c, 0x8e, 0x21, 0xfe, 0xc0, 0x3f, 0x26, 0x4c, 0xc1, 0x80, 0x49, 0xf5, 0x3f,
-	0xaf, 0xbd, 0x9a, 0xfe, 0x05, 0x6d, 0x1a, 0x3a, 0x81, 0x94, 0x8f, 0x20, 0x6e, 0x0b, 0x9e, 0x2e,
-	0x79, 0xc3, 0x7b, 0xb4, 0xb5, 0x78, 0xbf, 0x71, 0xe4, 0x5a, 0x2b, 0x16, 0x90, 0xd6, 0x9f, 0xc8,
-	0x0e, 0x72, 0xff, 0x3e, 0x7a, 0x09, 0xd8, 0x83, 0x37, 0xa7, 0x85, 0x5b, 0x3d, 0x2b, 0xdc, 0xea,
-	0x8f, 0xc2, 0xad, 0x7e, 0x9b, 0xb9, 0x95, 0xb3, 0x99, 0x5b, 0xf9, 0x3e, 0x73, 0x2b, 0x9f, 0xf6,
-	0xfb, 0x4c, 0x25, 0x79, 0xd7, 0x8f, 0x78, 0x1a, 0x28, 0x7e, 0x0c, 0x19, 0x3b, 0x81, 0xdd, 0x71,
-	0xa0, 0xc6, 0xbb, 0x51, 0x42, 0x59, 0x16, 0x8c, 0x9e, 0x04, 0xe3, 0x85, 0x1f, 0x8d, 0x9a, 0x0c,
-	0x41, 0x76, 0xeb, 0xe6, 0x97, 0xb2, 0xff, 0x33, 0x00, 0x00, 0xff, 0xff, 0x50, 0x6d, 0x07, 0xcc,
-	0xbf, 0x04, 0x00, 0x00,
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0xd3, 0x4a,
+	0x10, 0x8e, 0x93, 0x36, 0x49, 0x37, 0xef, 0xf5, 0x3d, 0x99, 0x82, 0xdc, 0x20, 0xec, 0x12, 0x24,
+	0xd4, 0x4b, 0x6d, 0xb5, 0x3d, 0x70, 0xe2, 0x40, 0x68, 0x03, 0x91, 0x68, 0x05, 0x6e, 0x23, 0x24,
+	0x84, 0x14, 0x36, 0xf6, 0xc4, 0x5e, 0x35, 0xf6, 0x46, 0xbb, 0xeb, 0x90, 0xf4, 0x47, 0xa0, 0xfe,
+	0x22, 0xce, 0x3d, 0xf6, 0x88, 0x7a, 0x08, 0x28, 0xfd, 0x23, 0x68, 0xd7, 0x4e, 0x1b, 0x50, 0x0b,
+	0x2d, 0xf4, 0xb6, 0xdf, 0xec, 0xcc, 0x37, 0xdf, 0x7e, 0xc9, 0x8c, 0x91, 0xe5, 0x51, 0x06, 0x49,
+	0xe4, 0x60, 0xce, 0x41, 0x38, 0x71, 0x57, 0x38, 0x83, 0x75, 0x07, 0x06, 0x10, 0x0b, 0xbb, 0xcf,
+	0xa8, 0xa0, 0xfa, 0x9d, 0x34, 0xc1, 0x56, 0x09, 0x76, 0xdc, 0x15, 0xf6, 0x60, 0xbd, 0xfa, 0xe0,
+	0xb2, 0xaa, 0xb8, 0x9b, 0xd5, 0x54, 0x97, 0x02, 0x1a, 0x50, 0x75, 0x74, 0xe4, 0x29, 0x8b, 0x5a,
+	0x01, 0xa5, 0x41, 0x0f, 0x1c, 0x85, 0x3a, 0x49, 0xd7, 0x11, 0x24, 0x02, 0x2e, 0x70, 0xd4, 0x4f,
+	0x13, 0x6a, 0xa7, 0x79, 0xf4, 0xff, 0xb6, 0x6c, 0xfd, 0xbc, 0x87, 0x39, 0x6f, 0x72, 0x9e, 0x80,
+	0xaf, 0xdf, 0x43, 0x79, 0xe2, 0x1b, 0xda, 0x8a, 0xb6, 0xba, 0x50, 0x2f, 0x4e, 0xc6, 0x56, 0xbe,
+	0xb9, 0xe5, 0xe6, 0x89, 0x8c, 0x17, 0x89, 0xcc, 0x60, 0x46, 0x5e, 0xde, 0xb9, 0x19, 0x92, 0x71,
+	0x3e, 0x8a, 0x3a, 0xb4, 0x67, 0x14, 0xd2, 0x78, 0x8a, 0x74, 0x1d, 0xcd, 0xc5, 0x38, 0x02, 0x63,
+	0x4e, 0x45, 0xd5, 0x59, 0x5f, 0x41, 0x15, 0x1f, 0xb8, 0xc7, 0x48, 0x5f, 0x10, 0x1a, 0x1b, 0xf3,
+	0xea, 0x6a, 0x36, 0xa4, 0x2f, 0xa3, 0x42, 0xc2, 0x88, 0x51, 0x54, 0xed, 0x4b, 0x93, 0xb1, 0x55,
+	0x68, 0xb9, 0x4d, 0x57, 0xc6, 0xf4, 0xc7, 0xa8, 0x9c, 0x30, 0xd2, 0x0e, 0x31, 0x0f, 0x8d, 0x92,
+	0xba, 0xaf, 0x4c, 0xc6, 0x56, 0xa9, 0xe5, 0x36, 0x5f, 0x62, 0x1e, 0xba, 0xa5, 0x84, 0x11, 0x79,
+	0xd0, 0x9f, 0xa2, 0x72, 0x17, 0xb0, 0x48, 0x18, 0x70, 0xa3, 0xbc, 0x52, 0x58, 0x5d, 0xdc, 0x78,
+	0x68, 0x5f, 0xe2, 0xa9, 0xad, 0x1e, 0xdd, 0x48, 0x33, 0xdd, 0xf3, 0x12, 0xbd, 0x81, 0xfe, 0x61,
+	0x74, 0x84, 0x7b, 0x62, 0xd4, 0x66, 0x58, 0x80, 0xb1, 0xa0, 0x5a, 0x3d, 0x3a, 0x1e, 0x5b, 0xb9,
+	0xd3, 0xb1, 0x75, 0xdf, 0xa3, 0x3c, 0xa2, 0x9c, 0xfb, 0x07, 0x36, 0xa1, 0x4e, 0x84, 0x45, 0x68,
+	0xbf, 0x82, 0x00, 0x7b, 0xa3, 0x2d, 0xf0, 0xdc, 0x4a, 0x56, 0xe8, 0x62, 0x01, 0xb5, 0x5d, 0x54,
+	0x51, 0xde, 0x36, 0x18, 0x3d, 0x04, 0xf9, 0xb0, 0xb2, 0x27, 0x1b, 0xb6, 0xa7, 0xe6, 0xba, 0x25,
+	0x85, 0x9b, 0xbe, 0xbe, 0xa8, 0x1c, 0x4f, 0x5d, 0x95, 0x4e, 0x2f, 0xa1, 0x79, 0xfa, 0x31, 0x06,
+	0x96, 0x19, 0x9a, 0x82, 0xda, 0x6b, 0xf4, 0xaf, 0xe2, 0x6b, 0xc5, 0xdd, 0x5b, 0x62, 0x7c, 0x31,
+	0xfb, 0xeb, 0xff, 0x5e, 0xa6, 0x81, 0x4a, 0xd8, 0xf3, 0x68, 0x12, 0x8b, 0x8c, 0x66, 0x0a, 0x6b,
+	0x4d, 0xa4, 0x5f, 0x10, 0x5d, 0x47, 0xdf, 0xd5, 0x54, 0xef, 0xd1, 0x5d, 0x45, 0xf5, 0xcc, 0xf7,
+	0xc1, 0xdf, 0xa7, 0x6f, 0x43, 0x22, 0xa0, 0x47, 0xb8, 0xb8, 0xc9, 0x6b, 0xaf, 0x66, 0xff, 0x80,
+	0x96, 0x15, 0xbb, 0x0b, 0x11, 0x1d, 0x80, 0xdf, 0x60, 0x34, 0xba, 0xe5, 0x0e, 0x6f, 0x50, 0x75,
+	0x56, 0xbf, 0x72, 0xe4, 0x5a, 0x2d, 0x66, 0x28, 0xf3, 0x3f, 0x52, 0xb6, 0x90, 0xf9, 0xb3, 0xe8,
+	0xdb, 0xa0, 0xdd, 0xcd, 0xbc, 0xd8, 0xc2, 0x02, 0xef, 0x79, 0x21, 0x44, 0xd8, 0x85, 0x80, 0x70,
+	0x01, 0x0c, 0xfc, 0x5f, 0x31, 0xca, 0x79, 0x57, 0xe9, 0xd3, 0x3d, 0x90, 0xa2, 0xda, 0x27, 0x2d,
+	0xfb, 0x17, 0x6c, 0x0f, 0xfb, 0x84, 0x61, 0x39, 0xcd, 0x7b, 0x70, 0x23, 0x57, 0x77, 0xd0, 0x7f,
+	0x70, 0x5e, 0xdb, 0x96, 0xcb, 0x4a, 0xb9, 0x5b, 0xd9, 0xa8, 0xda, 0xe9, 0x26, 0xb3, 0xa7, 0x9b,
+	0xcc, 0xde, 0x9f, 0x6e, 0xb2, 0x7a, 0x59, 0x0e, 0xe6, 0xd1, 0x57, 0x4b, 0x73, 0x17, 0x2f, 0x8a,
+	0xe5, 0x75, 0xed, 0xb3, 0x86, 0x96, 0x2e, 0x04, 0x81, 0xbf, 0xdb, 0xd8, 0xaf, 0x27, 0x2c, 0x16,
+	0x7f, 0x3d, 0x38, 0x97, 0x09, 0x9d, 0xfb, 0x73, 0xa1, 0xca, 0x51, 0x88, 0x7d, 0x60, 0xd9, 0x42,
+	0xcc, 0x50, 0x7d, 0xe7, 0x78, 0x62, 0x6a, 0x27, 0x13, 0x53, 0xfb, 0x36, 0x31, 0xb5, 0xa3, 0x33,
+	0x33, 0x77, 0x72, 0x66, 0xe6, 0xbe, 0x9c, 0x99, 0xb9, 0x77, 0x9b, 0x01, 0x11, 0x61, 0xd2, 0xb1,
+	0x3d, 0x1a, 0x39, 0x82, 0x1e, 0x40, 0x4c, 0x0e, 0x61, 0x6d, 0xe8, 0x88, 0xe1, 0x9a, 0x17, 0x62,
+	0x12, 0x3b, 0x83, 0x27, 0xce, 0x70, 0xe6, 0x5b, 0x21, 0x46, 0x7d, 0xe0, 0x9d, 0xa2, 0x12, 0xb5,
+	0xf9, 0x7d, 0x00, 0x25, 0x37, 0x8e, 0x7b, 0x82, 0x06, 0x00, 0x00,
 }
 
 func (m *EventClassIssued) Marshal() (dAtA []byte, err error) {
@@ -1051,6 +1257,147 @@ func (m *EventRemovedFromClassWhitelist) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDataSchemaRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDataSchemaRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDataSchemaRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExpirationSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpirationSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpirationSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvent(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExpiredNFTBurnt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpiredNFTBurnt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpiredNFTBurnt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintEvent(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -1260,20 +1607,83 @@ func (m *EventRemovedFromClassWhitelist) Size() (n int) {
 	return n
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventDataSchemaRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
 }
-func (m *EventClassIssued) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *EventExpirationSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventExpiredNFTBurnt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventClassIssued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowEvent
 			}
 			if iNdEx >= l {
@@ -1517,80 +1927,602 @@ func (m *EventClassIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v ClassFeature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClassFeature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]ClassFeature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClassFeature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClassFeature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFrozen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFrozen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnfrozen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnfrozen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnfrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClassFrozen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClassFrozen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClassFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClassUnfrozen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClassUnfrozen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClassUnfrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType == 0 {
-				var v ClassFeature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= ClassFeature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvent
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]ClassFeature, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v ClassFeature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= ClassFeature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
-			}
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1618,9 +2550,7 @@ func (m *EventClassIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1643,7 +2573,7 @@ func (m *EventClassIssued) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFrozen) Unmarshal(dAtA []byte) error {
+func (m *EventAddedToWhitelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,10 +2596,10 @@ func (m *EventFrozen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFrozen: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAddedToWhitelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAddedToWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1738,7 +2668,7 @@ func (m *EventFrozen) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1766,7 +2696,7 @@ func (m *EventFrozen) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1789,7 +2719,7 @@ func (m *EventFrozen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUnfrozen) Unmarshal(dAtA []byte) error {
+func (m *EventRemovedFromWhitelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1812,10 +2742,10 @@ func (m *EventUnfrozen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUnfrozen: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRemovedFromWhitelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUnfrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRemovedFromWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1884,7 +2814,7 @@ func (m *EventUnfrozen) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1912,7 +2842,7 @@ func (m *EventUnfrozen) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1935,7 +2865,7 @@ func (m *EventUnfrozen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClassFrozen) Unmarshal(dAtA []byte) error {
+func (m *EventAddedToClassWhitelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1958,10 +2888,10 @@ func (m *EventClassFrozen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClassFrozen: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAddedToClassWhitelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClassFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAddedToClassWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1996,7 +2926,7 @@ func (m *EventClassFrozen) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2049,7 +2979,7 @@ func (m *EventClassFrozen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClassUnfrozen) Unmarshal(dAtA []byte) error {
+func (m *EventRemovedFromClassWhitelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2072,10 +3002,10 @@ func (m *EventClassUnfrozen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClassUnfrozen: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRemovedFromClassWhitelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClassUnfrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRemovedFromClassWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2110,7 +3040,7 @@ func (m *EventClassUnfrozen) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2163,7 +3093,7 @@ func (m *EventClassUnfrozen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAddedToWhitelist) Unmarshal(dAtA []byte) error {
+func (m *EventDataSchemaRegistered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2186,10 +3116,10 @@ func (m *EventAddedToWhitelist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAddedToWhitelist: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDataSchemaRegistered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAddedToWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDataSchemaRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2226,39 +3156,7 @@ func (m *EventAddedToWhitelist) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2286,7 +3184,7 @@ func (m *EventAddedToWhitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2309,7 +3207,7 @@ func (m *EventAddedToWhitelist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRemovedFromWhitelist) Unmarshal(dAtA []byte) error {
+func (m *EventExpirationSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2332,10 +3230,10 @@ func (m *EventRemovedFromWhitelist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRemovedFromWhitelist: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExpirationSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRemovedFromWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExpirationSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2404,9 +3302,9 @@ func (m *EventRemovedFromWhitelist) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2416,23 +3314,24 @@ func (m *EventRemovedFromWhitelist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2455,7 +3354,7 @@ func (m *EventRemovedFromWhitelist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAddedToClassWhitelist) Unmarshal(dAtA []byte) error {
+func (m *EventExpiredNFTBurnt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2478,10 +3377,10 @@ func (m *EventAddedToClassWhitelist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAddedToClassWhitelist: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExpiredNFTBurnt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAddedToClassWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExpiredNFTBurnt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2518,7 +3417,7 @@ func (m *EventAddedToClassWhitelist) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2546,63 +3445,45 @@ func (m *EventAddedToClassWhitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventRemovedFromClassWhitelist) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventRemovedFromClassWhitelist: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRemovedFromClassWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2612,27 +3493,28 @@ func (m *EventRemovedFromClassWhitelist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2660,7 +3542,7 @@ func (m *EventRemovedFromClassWhitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8,6 +8,12 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// AccountKeeper defines the expected account keeper interface.
+type AccountKeeper interface {
+	//nolint:inamedparam // the sdk interface
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
+}
+
 // NFTKeeper defines the expected NFT interface.
 //
 //nolint:interfacebloat
@@ -16,6 +22,7 @@ type NFTKeeper interface {
 	GetClass(ctx context.Context, classID string) (nft.Class, bool)
 	UpdateClass(ctx context.Context, class nft.Class) error
 	GetNFTsOfClass(ctx context.Context, classID string) []nft.NFT
+	GetTotalSupply(ctx context.Context, classID string) uint64
 	HasClass(ctx context.Context, classID string) bool
 	GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool)
 	HasNFT(ctx context.Context, classID, id string) bool
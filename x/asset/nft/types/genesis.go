@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,6 +38,18 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, dataSchema := range gs.ClassDataSchemas {
+		if err := dataSchema.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, expiration := range gs.NFTExpirations {
+		if err := expiration.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -124,3 +137,30 @@ func (b BurntNFT) Validate() error {
 
 	return nil
 }
+
+// Validate performs basic validation on the fields of ClassDataSchema.
+func (s ClassDataSchema) Validate() error {
+	if _, _, err := DeconstructClassID(s.ClassID); err != nil {
+		return err
+	}
+
+	_, err := ParseDataSchema(s.Schema)
+	return err
+}
+
+// Validate performs basic validation on the fields of NFTExpiration.
+func (e NFTExpiration) Validate() error {
+	if _, _, err := DeconstructClassID(e.ClassID); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenID(e.ID); err != nil {
+		return err
+	}
+
+	if e.ExpirationTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "expiration time must be set")
+	}
+
+	return nil
+}
@@ -34,6 +34,10 @@ type GenesisState struct {
 	BurntNFTs                []BurntNFT                 `protobuf:"bytes,5,rep,name=burnt_nfts,json=burntNfts,proto3" json:"burnt_nfts"`
 	ClassWhitelistedAccounts []ClassWhitelistedAccounts `protobuf:"bytes,6,rep,name=class_whitelisted_accounts,json=classWhitelistedAccounts,proto3" json:"class_whitelisted_accounts"`
 	ClassFrozenAccounts      []ClassFrozenAccounts      `protobuf:"bytes,7,rep,name=class_frozen_accounts,json=classFrozenAccounts,proto3" json:"class_frozen_accounts"`
+	// class_data_schemas keep the JSON schemas of the NFT data registered for the classes.
+	ClassDataSchemas []ClassDataSchema `protobuf:"bytes,8,rep,name=class_data_schemas,json=classDataSchemas,proto3" json:"class_data_schemas"`
+	// nft_expirations keep the expiration times of the NFTs.
+	NFTExpirations []NFTExpiration `protobuf:"bytes,9,rep,name=nft_expirations,json=nftExpirations,proto3" json:"nft_expirations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,6 +122,20 @@ func (m *GenesisState) GetClassFrozenAccounts() []ClassFrozenAccounts {
 	return nil
 }
 
+func (m *GenesisState) GetClassDataSchemas() []ClassDataSchema {
+	if m != nil {
+		return m.ClassDataSchemas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNFTExpirations() []NFTExpiration {
+	if m != nil {
+		return m.NFTExpirations
+	}
+	return nil
+}
+
 type FrozenNFT struct {
 	ClassID string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
 	NftIDs  []string `protobuf:"bytes,2,rep,name=nftIDs,proto3" json:"nftIDs,omitempty"`
@@ -398,41 +416,45 @@ func init() {
 func init() { proto.RegisterFile("coreum/asset/nft/v1/genesis.proto", fileDescriptor_3abcf08d60f6fbfd) }
 
 var fileDescriptor_3abcf08d60f6fbfd = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0xb4, 0x49, 0xeb, 0x09, 0x07, 0xba, 0x29, 0x91, 0x15, 0x54, 0x37, 0x44, 0x1c,
-	0x22, 0xa1, 0xd8, 0x6a, 0x7b, 0x40, 0x48, 0x70, 0x20, 0x44, 0x41, 0x15, 0x22, 0x54, 0x6e, 0xa5,
-	0x4a, 0x5c, 0x22, 0xc7, 0x59, 0x27, 0x16, 0xcd, 0x6e, 0xc8, 0x4e, 0x42, 0xe8, 0x9d, 0x3b, 0x6f,
-	0xc1, 0xab, 0xf4, 0xd8, 0x23, 0xa7, 0x0a, 0x25, 0x2f, 0x82, 0xbc, 0xeb, 0x18, 0xb7, 0xd8, 0x95,
-	0xe0, 0xe6, 0x9d, 0xf9, 0xe7, 0xff, 0x3c, 0x3b, 0xa3, 0x85, 0x27, 0x1e, 0x9f, 0xd2, 0xd9, 0xd8,
-	0x76, 0x85, 0xa0, 0x68, 0x33, 0x1f, 0xed, 0xf9, 0x81, 0x3d, 0xa4, 0x8c, 0x8a, 0x40, 0x58, 0x93,
-	0x29, 0x47, 0x4e, 0xca, 0x4a, 0x62, 0x49, 0x89, 0xc5, 0x7c, 0xb4, 0xe6, 0x07, 0xd5, 0xbd, 0xb4,
-	0xba, 0x30, 0x27, 0x6b, 0xaa, 0xb5, 0xb4, 0xf4, 0xc4, 0x9d, 0xba, 0xe3, 0xc8, 0xb5, 0xba, 0x3b,
-	0xe4, 0x43, 0x2e, 0x3f, 0xed, 0xf0, 0x4b, 0x45, 0xeb, 0x3f, 0x0a, 0xf0, 0xe0, 0xad, 0xa2, 0x9f,
-	0xa2, 0x8b, 0x94, 0xbc, 0x80, 0xa2, 0x2a, 0x33, 0xb4, 0x9a, 0xd6, 0x28, 0x1d, 0x3e, 0xb6, 0x52,
-	0xfe, 0xc6, 0x3a, 0x91, 0x92, 0xd6, 0xe6, 0xd5, 0xcd, 0x7e, 0xce, 0x89, 0x0a, 0xc8, 0x39, 0xec,
-	0x78, 0x17, 0xae, 0x10, 0xbd, 0x01, 0xf5, 0x03, 0x16, 0x60, 0xc0, 0x99, 0x30, 0xf2, 0xb5, 0x8d,
-	0x46, 0xe9, 0xf0, 0x69, 0xaa, 0xcb, 0x9b, 0x50, 0xdd, 0x8e, 0xc5, 0x91, 0xdd, 0x43, 0xef, 0x76,
-	0x58, 0x90, 0x53, 0x28, 0xf9, 0x53, 0x7e, 0x49, 0x59, 0x8f, 0xf9, 0x28, 0x8c, 0x0d, 0x69, 0x69,
-	0xa6, 0x5a, 0x76, 0xa4, 0xae, 0xdb, 0x39, 0x6b, 0x91, 0xd0, 0x6c, 0x79, 0xb3, 0x0f, 0x71, 0x48,
-	0x38, 0xa0, 0x6c, 0xba, 0x3e, 0x0a, 0xf2, 0x4d, 0x03, 0xe3, 0xcb, 0x28, 0x40, 0x7a, 0x11, 0x08,
-	0xa4, 0x83, 0xd0, 0xba, 0xe7, 0x7a, 0x1e, 0x9f, 0x31, 0x14, 0xc6, 0xa6, 0x44, 0x3c, 0x4b, 0x45,
-	0x9c, 0xff, 0x29, 0xea, 0x76, 0xce, 0x5e, 0x47, 0x25, 0x2d, 0x33, 0xe2, 0x55, 0xd2, 0xf3, 0x4e,
-	0x25, 0x01, 0xeb, 0xfa, 0xb8, 0x8e, 0x93, 0x0f, 0x00, 0xfd, 0xd9, 0x94, 0xa1, 0xea, 0xad, 0x20,
-	0xc1, 0x7b, 0xa9, 0xe0, 0x56, 0x28, 0x0b, 0x5b, 0xdb, 0x89, 0x50, 0xfa, 0x3a, 0x22, 0x1c, 0x5d,
-	0x7a, 0xc8, 0xc6, 0x3e, 0x43, 0x55, 0x8d, 0x21, 0xd9, 0x5d, 0xdc, 0x59, 0x51, 0x02, 0x9a, 0xd9,
-	0xf3, 0x48, 0xfc, 0x7e, 0xdc, 0x9b, 0x1a, 0x8c, 0xe1, 0x65, 0xe4, 0x49, 0x1f, 0x1e, 0x29, 0x64,
-	0x34, 0xa6, 0x98, 0xb6, 0x25, 0x69, 0x8d, 0x6c, 0x9a, 0x1a, 0xce, 0x1d, 0x50, 0xd9, 0xfb, 0x3b,
-	0x55, 0x7f, 0x05, 0x7a, 0x3c, 0x49, 0x62, 0xc0, 0x96, 0xd4, 0x1c, 0xb7, 0xe5, 0x9a, 0xea, 0xce,
-	0xfa, 0x48, 0x2a, 0x50, 0x64, 0x3e, 0x1e, 0xb7, 0xd5, 0xe6, 0xe9, 0x4e, 0x74, 0xaa, 0x0f, 0x20,
-	0x63, 0x30, 0xf7, 0x78, 0xed, 0x42, 0x41, 0x56, 0x1b, 0x79, 0x19, 0x57, 0x07, 0x52, 0x85, 0xed,
-	0x5b, 0x7b, 0xa2, 0x3b, 0xf1, 0xb9, 0x7e, 0x02, 0x46, 0xd6, 0x25, 0xde, 0xc3, 0x49, 0x3a, 0xe6,
-	0xef, 0x38, 0xbe, 0x83, 0x72, 0xca, 0x45, 0xfd, 0xa7, 0xd9, 0x4b, 0xd8, 0x5e, 0xaf, 0xcc, 0xbf,
-	0x5f, 0x61, 0xeb, 0xfd, 0xd5, 0xd2, 0xd4, 0xae, 0x97, 0xa6, 0xf6, 0x6b, 0x69, 0x6a, 0xdf, 0x57,
-	0x66, 0xee, 0x7a, 0x65, 0xe6, 0x7e, 0xae, 0xcc, 0xdc, 0xc7, 0xa3, 0x61, 0x80, 0xa3, 0x59, 0xdf,
-	0xf2, 0xf8, 0xd8, 0x46, 0xfe, 0x89, 0xb2, 0xe0, 0x92, 0x36, 0x17, 0x36, 0x2e, 0x9a, 0xde, 0xc8,
-	0x0d, 0x98, 0x3d, 0x7f, 0x6e, 0x2f, 0x12, 0x4f, 0x13, 0x7e, 0x9d, 0x50, 0xd1, 0x2f, 0xca, 0x17,
-	0xe8, 0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x97, 0x18, 0x65, 0x41, 0x12, 0x05, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0xd3, 0x36, 0x8d, 0x27, 0xa8, 0xd0, 0x4d, 0x89, 0xac, 0xa0, 0xba, 0x21, 0xe2, 0x10,
+	0x09, 0xc5, 0x56, 0xdb, 0x03, 0x42, 0x82, 0x03, 0x26, 0x04, 0x55, 0x88, 0x50, 0x39, 0x91, 0x8a,
+	0xb8, 0x44, 0x1b, 0x67, 0x9d, 0x58, 0x34, 0xeb, 0xe0, 0xdd, 0x84, 0xd0, 0x3b, 0x77, 0x3e, 0xab,
+	0xc7, 0x1e, 0x39, 0x55, 0x28, 0x91, 0xf8, 0x0e, 0xe4, 0x5d, 0xc7, 0x38, 0xc5, 0x29, 0x82, 0x9b,
+	0x67, 0xe6, 0xcd, 0x7b, 0x3b, 0x9e, 0xb7, 0x0b, 0x0f, 0x1d, 0x3f, 0x20, 0x93, 0x91, 0x89, 0x19,
+	0x23, 0xdc, 0xa4, 0x2e, 0x37, 0xa7, 0x87, 0xe6, 0x80, 0x50, 0xc2, 0x3c, 0x66, 0x8c, 0x03, 0x9f,
+	0xfb, 0xa8, 0x28, 0x21, 0x86, 0x80, 0x18, 0xd4, 0xe5, 0xc6, 0xf4, 0xb0, 0xbc, 0x9f, 0xd6, 0x17,
+	0xd6, 0x44, 0x4f, 0xb9, 0x92, 0x56, 0x1e, 0xe3, 0x00, 0x8f, 0x22, 0xd6, 0xf2, 0xde, 0xc0, 0x1f,
+	0xf8, 0xe2, 0xd3, 0x0c, 0xbf, 0x64, 0xb6, 0xfa, 0x33, 0x07, 0x77, 0x5e, 0x4b, 0xf5, 0x36, 0xc7,
+	0x9c, 0xa0, 0xa7, 0x90, 0x93, 0x6d, 0x9a, 0x52, 0x51, 0x6a, 0x85, 0xa3, 0x07, 0x46, 0xca, 0x69,
+	0x8c, 0x53, 0x01, 0xb1, 0x36, 0x2f, 0xaf, 0x0f, 0x32, 0x76, 0xd4, 0x80, 0xce, 0x60, 0xd7, 0x39,
+	0xc7, 0x8c, 0x75, 0xfb, 0xc4, 0xf5, 0xa8, 0xc7, 0x3d, 0x9f, 0x32, 0x2d, 0x5b, 0xd9, 0xa8, 0x15,
+	0x8e, 0x1e, 0xa5, 0xb2, 0xbc, 0x0c, 0xd1, 0x8d, 0x18, 0x1c, 0xd1, 0xdd, 0x73, 0x56, 0xd3, 0x0c,
+	0xb5, 0xa1, 0xe0, 0x06, 0xfe, 0x05, 0xa1, 0x5d, 0xea, 0x72, 0xa6, 0x6d, 0x08, 0x4a, 0x3d, 0x95,
+	0xb2, 0x29, 0x70, 0xad, 0x66, 0xc7, 0x42, 0x21, 0xd9, 0xfc, 0xfa, 0x00, 0xe2, 0x14, 0xb3, 0x41,
+	0xd2, 0xb4, 0x5c, 0xce, 0xd0, 0x57, 0x05, 0xb4, 0xcf, 0x43, 0x8f, 0x93, 0x73, 0x8f, 0x71, 0xd2,
+	0x0f, 0xa9, 0xbb, 0xd8, 0x71, 0xfc, 0x09, 0xe5, 0x4c, 0xdb, 0x14, 0x12, 0x8f, 0x53, 0x25, 0xce,
+	0x7e, 0x37, 0xb5, 0x9a, 0x9d, 0x17, 0x51, 0x8b, 0xa5, 0x47, 0x7a, 0xa5, 0xf4, 0xba, 0x5d, 0x4a,
+	0x88, 0xb5, 0x5c, 0xbe, 0xcc, 0xa3, 0x77, 0x00, 0xbd, 0x49, 0x40, 0xb9, 0x9c, 0x6d, 0x4b, 0x08,
+	0xef, 0xa7, 0x0a, 0x5b, 0x21, 0x2c, 0x1c, 0x6d, 0x37, 0x92, 0x52, 0x97, 0x19, 0x66, 0xab, 0x82,
+	0x43, 0x0c, 0xf6, 0x09, 0xca, 0x72, 0x0d, 0xc9, 0xe9, 0xe2, 0xc9, 0x72, 0x42, 0xa0, 0xbe, 0x7e,
+	0x1f, 0x89, 0xe3, 0xc7, 0xb3, 0xc9, 0xc5, 0x68, 0xce, 0x9a, 0x3a, 0xea, 0xc1, 0x7d, 0x29, 0x19,
+	0xad, 0x29, 0x56, 0xdb, 0x16, 0x6a, 0xb5, 0xf5, 0x6a, 0x72, 0x39, 0x37, 0x84, 0x8a, 0xce, 0x9f,
+	0x25, 0xf4, 0x1e, 0x50, 0xe4, 0x2e, 0xcc, 0x71, 0x97, 0x39, 0x43, 0x32, 0xc2, 0x4c, 0xcb, 0xff,
+	0xd5, 0x5e, 0x98, 0xe3, 0xb6, 0x00, 0xaf, 0xda, 0x2b, 0x4e, 0x33, 0xe4, 0xc0, 0xdd, 0x70, 0xf9,
+	0x64, 0x36, 0xf6, 0x02, 0x2c, 0x5d, 0xab, 0x0a, 0xda, 0x6a, 0x2a, 0x6d, 0xab, 0xd9, 0x79, 0x15,
+	0x43, 0xad, 0x52, 0xb4, 0x8b, 0x9d, 0x95, 0x34, 0xb3, 0x77, 0xa8, 0xcb, 0x13, 0x71, 0xf5, 0x39,
+	0xa8, 0xb1, 0x11, 0x91, 0x06, 0xdb, 0xe2, 0x14, 0x27, 0x0d, 0x71, 0xcb, 0x54, 0x7b, 0x19, 0xa2,
+	0x12, 0xe4, 0xa8, 0xcb, 0x4f, 0x1a, 0xf2, 0xe2, 0xa8, 0x76, 0x14, 0x55, 0xfb, 0xb0, 0xc6, 0x57,
+	0xb7, 0x70, 0xed, 0xc1, 0x96, 0xe8, 0xd6, 0xb2, 0x22, 0x2f, 0x03, 0x54, 0x86, 0xfc, 0x8a, 0xcd,
+	0x55, 0x3b, 0x8e, 0xab, 0xa7, 0xa0, 0xad, 0xf3, 0xc0, 0x2d, 0x3a, 0x49, 0xc6, 0xec, 0x0d, 0xc6,
+	0x37, 0x50, 0x4c, 0xd9, 0xf3, 0x7f, 0x92, 0x3d, 0x83, 0xfc, 0xd2, 0xf1, 0xff, 0xfe, 0x0b, 0xad,
+	0xb7, 0x97, 0x73, 0x5d, 0xb9, 0x9a, 0xeb, 0xca, 0x8f, 0xb9, 0xae, 0x7c, 0x5b, 0xe8, 0x99, 0xab,
+	0x85, 0x9e, 0xf9, 0xbe, 0xd0, 0x33, 0x1f, 0x8e, 0x07, 0x1e, 0x1f, 0x4e, 0x7a, 0x86, 0xe3, 0x8f,
+	0x4c, 0xee, 0x7f, 0x24, 0xd4, 0xbb, 0x20, 0xf5, 0x99, 0xc9, 0x67, 0x75, 0x67, 0x88, 0x3d, 0x6a,
+	0x4e, 0x9f, 0x98, 0xb3, 0xc4, 0xcb, 0xca, 0xbf, 0x8c, 0x09, 0xeb, 0xe5, 0xc4, 0x03, 0x7a, 0xfc,
+	0x6b, 0x00, 0xa9, 0xfa, 0xf1, 0x9b, 0xd1, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -455,6 +477,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NFTExpirations) > 0 {
+		for iNdEx := len(m.NFTExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ClassDataSchemas) > 0 {
+		for iNdEx := len(m.ClassDataSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassDataSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.ClassFrozenAccounts) > 0 {
 		for iNdEx := len(m.ClassFrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -809,6 +859,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClassDataSchemas) > 0 {
+		for _, e := range m.ClassDataSchemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NFTExpirations) > 0 {
+		for _, e := range m.NFTExpirations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1183,6 +1245,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassDataSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassDataSchemas = append(m.ClassDataSchemas, ClassDataSchema{})
+			if err := m.ClassDataSchemas[len(m.ClassDataSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTExpirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTExpirations = append(m.NFTExpirations, NFTExpiration{})
+			if err := m.NFTExpirations[len(m.NFTExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -37,6 +37,10 @@ var (
 	NFTClassWhitelistingKeyPrefix = []byte{0x06}
 	// NFTClassFreezingKeyPrefix defines the key prefix to track frozen account for NFT class.
 	NFTClassFreezingKeyPrefix = []byte{0x07}
+	// NFTDataSchemaKeyPrefix defines the key prefix to store the JSON schemas of the NFT data.
+	NFTDataSchemaKeyPrefix = []byte{0x08}
+	// NFTExpirationKeyPrefix defines the key prefix to store the expiration times of the NFTs.
+	NFTExpirationKeyPrefix = []byte{0x09}
 )
 
 // StoreTrue keeps a value used by stores to indicate that key is present.
@@ -63,6 +67,36 @@ func CreateClassKey(classID string) ([]byte, error) {
 	return store.JoinKeys(NFTClassKeyPrefix, classKey), nil
 }
 
+// CreateDataSchemaKey constructs the key for the JSON schema of the NFT data of the class.
+func CreateDataSchemaKey(classID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a data schema key, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTDataSchemaKeyPrefix, compositeKey), nil
+}
+
+// CreateExpirationKey constructs the key for the expiration time of the non-fungible token.
+func CreateExpirationKey(classID, nftID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create an expiration key, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTExpirationKeyPrefix, compositeKey), nil
+}
+
+// CreateClassExpirationPrefix constructs the key prefix for the expiration times of the non-fungible tokens in class.
+func CreateClassExpirationPrefix(classID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a class expiration prefix, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTExpirationKeyPrefix, compositeKey), nil
+}
+
 // CreateIssuerClassPrefix constructs the key for the non-fungible token class for the specific issuer.
 func CreateIssuerClassPrefix(issuer sdk.AccAddress) ([]byte, error) {
 	issuerKey, err := store.JoinKeysWithLength(issuer)
@@ -28,6 +28,9 @@ var (
 	_ extendedMsg = &MsgClassFreeze{}
 	_ extendedMsg = &MsgClassUnfreeze{}
 	_ extendedMsg = &MsgUpdateParams{}
+	_ extendedMsg = &MsgRegisterDataSchema{}
+	_ extendedMsg = &MsgExtendExpiration{}
+	_ extendedMsg = &MsgBurnExpired{}
 )
 
 // Constraints.
@@ -53,6 +56,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgClassFreeze{}, ModuleName+"/MsgClassFreeze")
 	legacy.RegisterAminoMsg(cdc, &MsgClassUnfreeze{}, ModuleName+"/MsgClassUnfreeze")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, ModuleName+"/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterDataSchema{}, ModuleName+"/MsgRegisterDataSchema")
+	legacy.RegisterAminoMsg(cdc, &MsgExtendExpiration{}, ModuleName+"/MsgExtendExpiration")
+	legacy.RegisterAminoMsg(cdc, &MsgBurnExpired{}, ModuleName+"/MsgBurnExpired")
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -149,6 +155,10 @@ func (m *MsgMint) ValidateBasic() error {
 		)
 	}
 
+	if m.ExpirationTime != nil && m.ExpirationTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "expiration time must be set")
+	}
+
 	return nil
 }
 
@@ -350,3 +360,55 @@ func (m *MsgRemoveFromClassWhitelist) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgRegisterDataSchema) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	_, err := ParseDataSchema(m.Schema)
+	return err
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgExtendExpiration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if err := ValidateTokenID(m.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if m.ExpirationTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "expiration time must be set")
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgBurnExpired) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if err := ValidateTokenID(m.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
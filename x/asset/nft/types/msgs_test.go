@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -556,6 +557,138 @@ func TestMsgBurn_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgRegisterDataSchema_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgRegisterDataSchema{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Schema:  `{"type":"object"}`,
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgRegisterDataSchema
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgRegisterDataSchema {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgRegisterDataSchema {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgRegisterDataSchema {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid schema",
+			messageFunc: func() *types.MsgRegisterDataSchema {
+				msg := validMessage
+				msg.Schema = `{"type":"object","format":"date"}`
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgExtendExpiration_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgExtendExpiration{
+		Sender:         "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID:        "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:             "my-id",
+		ExpirationTime: time.Unix(1_800_000_000, 0),
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgExtendExpiration
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgExtendExpiration {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgExtendExpiration {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgExtendExpiration {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgExtendExpiration {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero expiration time",
+			messageFunc: func() *types.MsgExtendExpiration {
+				msg := validMessage
+				msg.ExpirationTime = time.Time{}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
 func TestMsgFreeze_ValidateBasic(t *testing.T) {
 	validMessage := types.MsgFreeze{
 		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
@@ -890,6 +1023,34 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"assetnft/MsgRemoveFromWhitelist","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","class_id":"classID","id":"nftID"}}`,
 		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgRegisterDataSchema{}),
+			msg: &types.MsgRegisterDataSchema{
+				Sender:  address,
+				ClassID: "classID",
+				Schema:  "{}",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgRegisterDataSchema","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","class_id":"classID","schema":"{}"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgExtendExpiration{}),
+			msg: &types.MsgExtendExpiration{
+				Sender:         address,
+				ClassID:        "classID",
+				ID:             "nftID",
+				ExpirationTime: time.Unix(1_800_000_000, 0).UTC(),
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgExtendExpiration","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","class_id":"classID","id":"nftID","expiration_time":"2027-01-15T08:00:00Z"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgBurnExpired{}),
+			msg: &types.MsgBurnExpired{
+				Sender:  address,
+				ClassID: "classID",
+				ID:      "nftID",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgBurnExpired","value":{"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","class_id":"classID","id":"nftID"}}`,
+		},
 	}
 
 	legacyAmino := codec.NewLegacyAmino()
@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -238,49 +242,172 @@ func (m *Class) GetFeatures() []ClassFeature {
 	return nil
 }
 
+// ClassDataSchema is the JSON schema the data of the NFTs in the class must match.
+type ClassDataSchema struct {
+	ClassID string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Schema  string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *ClassDataSchema) Reset()         { *m = ClassDataSchema{} }
+func (m *ClassDataSchema) String() string { return proto.CompactTextString(m) }
+func (*ClassDataSchema) ProtoMessage()    {}
+func (*ClassDataSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{2}
+}
+func (m *ClassDataSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassDataSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassDataSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassDataSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassDataSchema.Merge(m, src)
+}
+func (m *ClassDataSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassDataSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassDataSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassDataSchema proto.InternalMessageInfo
+
+func (m *ClassDataSchema) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *ClassDataSchema) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+// NFTExpiration is the time after which the NFT can't be sent anymore and can be burnt by anyone.
+type NFTExpiration struct {
+	ClassID        string    `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ID             string    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ExpirationTime time.Time `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+}
+
+func (m *NFTExpiration) Reset()         { *m = NFTExpiration{} }
+func (m *NFTExpiration) String() string { return proto.CompactTextString(m) }
+func (*NFTExpiration) ProtoMessage()    {}
+func (*NFTExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{3}
+}
+func (m *NFTExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTExpiration.Merge(m, src)
+}
+func (m *NFTExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTExpiration proto.InternalMessageInfo
+
+func (m *NFTExpiration) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *NFTExpiration) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *NFTExpiration) GetExpirationTime() time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("coreum.asset.nft.v1.ClassFeature", ClassFeature_name, ClassFeature_value)
 	proto.RegisterType((*ClassDefinition)(nil), "coreum.asset.nft.v1.ClassDefinition")
 	proto.RegisterType((*Class)(nil), "coreum.asset.nft.v1.Class")
+	proto.RegisterType((*ClassDataSchema)(nil), "coreum.asset.nft.v1.ClassDataSchema")
+	proto.RegisterType((*NFTExpiration)(nil), "coreum.asset.nft.v1.NFTExpiration")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/nft.proto", fileDescriptor_5b9231d6a69d6d06) }
 
 var fileDescriptor_5b9231d6a69d6d06 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
-	0x1c, 0x6d, 0x92, 0xae, 0xed, 0xdc, 0xb2, 0x4d, 0xde, 0x34, 0x65, 0x43, 0xa4, 0x65, 0x48, 0xa8,
-	0x42, 0x5a, 0xa2, 0x6d, 0x07, 0x4e, 0x1c, 0x18, 0xd5, 0x44, 0x25, 0xb8, 0x44, 0xda, 0x85, 0x4b,
-	0xe5, 0x24, 0x6e, 0x62, 0x2d, 0xb1, 0x2b, 0xff, 0x29, 0xcd, 0x3e, 0x05, 0x1f, 0xab, 0xc7, 0x1d,
-	0x11, 0x87, 0x0a, 0xa5, 0x9f, 0x80, 0x6f, 0x80, 0xec, 0x14, 0x28, 0x12, 0xe2, 0x00, 0xa7, 0xfc,
-	0x7e, 0xef, 0x3d, 0xeb, 0xbd, 0x3c, 0xcb, 0xe0, 0x49, 0xcc, 0x38, 0x56, 0x45, 0x80, 0x84, 0xc0,
-	0x32, 0xa0, 0x53, 0x19, 0xcc, 0x2f, 0xf4, 0xc7, 0x9f, 0x71, 0x26, 0x19, 0x3c, 0xac, 0x69, 0xdf,
-	0xd0, 0xbe, 0xc6, 0xe7, 0x17, 0xa7, 0x47, 0x29, 0x4b, 0x99, 0xe1, 0x03, 0x3d, 0xd5, 0xd2, 0xd3,
-	0x93, 0x94, 0xb1, 0x34, 0xc7, 0x81, 0xd9, 0x22, 0x35, 0x0d, 0x10, 0x2d, 0x6b, 0xea, 0x6c, 0x69,
-	0x81, 0xfd, 0x37, 0x39, 0x12, 0x62, 0x84, 0xa7, 0x84, 0x12, 0x49, 0x18, 0x85, 0xc7, 0xc0, 0x26,
-	0x89, 0x6b, 0x0d, 0xac, 0xe1, 0xee, 0x75, 0xab, 0x5a, 0xf5, 0xed, 0xf1, 0x28, 0xb4, 0x49, 0x02,
-	0x8f, 0x41, 0x8b, 0x08, 0xa1, 0x30, 0x77, 0x6d, 0xcd, 0x85, 0x9b, 0x0d, 0xbe, 0x02, 0x9d, 0x29,
-	0x46, 0x52, 0x71, 0x2c, 0x5c, 0x67, 0xe0, 0x0c, 0xf7, 0x2e, 0x9f, 0xfa, 0x7f, 0x08, 0xe7, 0x1b,
-	0x9f, 0x9b, 0x5a, 0x19, 0xfe, 0x3c, 0x02, 0x6f, 0x40, 0x8f, 0xb3, 0x12, 0xe5, 0xb2, 0x9c, 0x70,
-	0x24, 0xb1, 0xdb, 0x34, 0xc6, 0xcf, 0x96, 0xab, 0x7e, 0xe3, 0xcb, 0xaa, 0xff, 0x38, 0x66, 0xa2,
-	0x60, 0x42, 0x24, 0x77, 0x3e, 0x61, 0x41, 0x81, 0x64, 0xe6, 0xbf, 0xc3, 0x29, 0x8a, 0xcb, 0x11,
-	0x8e, 0xc3, 0xee, 0xe6, 0x60, 0x88, 0x24, 0x3e, 0xfb, 0x66, 0x83, 0x1d, 0x63, 0x01, 0xf7, 0x7e,
-	0xfd, 0xc0, 0x5f, 0x83, 0x43, 0xd0, 0xa4, 0xa8, 0xc0, 0xae, 0x63, 0x50, 0x33, 0x6b, 0xad, 0x28,
-	0x8b, 0x88, 0xe5, 0x75, 0x8e, 0x70, 0xb3, 0xc1, 0x01, 0xe8, 0x26, 0x58, 0xc4, 0x9c, 0xcc, 0x74,
-	0x47, 0xee, 0x8e, 0x21, 0xb7, 0x21, 0x78, 0x02, 0x1c, 0xc5, 0x89, 0xdb, 0x32, 0xf1, 0xdb, 0xd5,
-	0xaa, 0xef, 0xdc, 0x86, 0xe3, 0x50, 0x63, 0xf0, 0x39, 0xe8, 0x28, 0x4e, 0x26, 0x19, 0x12, 0x99,
-	0xdb, 0x36, 0x7c, 0xb7, 0x5a, 0xf5, 0xdb, 0xb7, 0xe1, 0xf8, 0x2d, 0x12, 0x59, 0xd8, 0x56, 0x9c,
-	0xe8, 0x01, 0x0e, 0x41, 0x33, 0x41, 0x12, 0xb9, 0x9d, 0x81, 0x35, 0xec, 0x5e, 0x1e, 0xf9, 0xf5,
-	0xbd, 0xf9, 0x3f, 0xee, 0xcd, 0x7f, 0x4d, 0xcb, 0xd0, 0x28, 0x7e, 0xeb, 0x7c, 0xf7, 0xff, 0x3b,
-	0x07, 0xff, 0xd6, 0xf9, 0x8b, 0x09, 0xe8, 0x6d, 0x3b, 0xc0, 0x2e, 0x68, 0x47, 0x8a, 0x53, 0x42,
-	0xd3, 0x83, 0x06, 0xec, 0x81, 0xce, 0x94, 0x63, 0x7c, 0xaf, 0x37, 0x0b, 0x1e, 0x80, 0xde, 0xc7,
-	0x8c, 0x48, 0x9c, 0x13, 0x21, 0x35, 0x62, 0xc3, 0x43, 0xb0, 0x9f, 0x10, 0x81, 0xa2, 0x1c, 0x4f,
-	0x04, 0xa6, 0x89, 0x06, 0x1d, 0xf8, 0x08, 0xec, 0x0a, 0xa6, 0xf2, 0x88, 0x29, 0x9a, 0x1c, 0x34,
-	0xaf, 0xdf, 0x2f, 0x2b, 0xcf, 0x7a, 0xa8, 0x3c, 0xeb, 0x6b, 0xe5, 0x59, 0x9f, 0xd6, 0x5e, 0xe3,
-	0x61, 0xed, 0x35, 0x3e, 0xaf, 0xbd, 0xc6, 0x87, 0xab, 0x94, 0xc8, 0x4c, 0x45, 0x7e, 0xcc, 0x8a,
-	0x40, 0xb2, 0x3b, 0x4c, 0xc9, 0x3d, 0x3e, 0x5f, 0x04, 0x72, 0x71, 0x1e, 0x67, 0x88, 0xd0, 0x60,
-	0xfe, 0x32, 0x58, 0x6c, 0xbd, 0x1d, 0x59, 0xce, 0xb0, 0x88, 0x5a, 0xa6, 0xca, 0xab, 0xef, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xdc, 0x42, 0x31, 0x52, 0x5c, 0x03, 0x00, 0x00,
+	// 628 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x6e, 0xd2, 0xae, 0xed, 0xdc, 0xee, 0x47, 0xde, 0x34, 0x65, 0x43, 0x34, 0x65, 0x48, 0xa8,
+	0x42, 0x5a, 0xa2, 0x6d, 0x17, 0x5c, 0x71, 0xc1, 0x28, 0x13, 0x95, 0x18, 0x12, 0x66, 0xbb, 0xe1,
+	0xa6, 0x72, 0x12, 0x37, 0xb1, 0x96, 0xc4, 0x95, 0xed, 0x8c, 0x66, 0x4f, 0xb1, 0x27, 0xe0, 0x79,
+	0x76, 0xb9, 0x4b, 0xc4, 0x45, 0x41, 0xd9, 0x13, 0xf0, 0x06, 0xc8, 0x4e, 0xbb, 0x0d, 0x86, 0x10,
+	0x82, 0xab, 0xfa, 0x7c, 0xdf, 0x39, 0xf6, 0x39, 0xdf, 0x77, 0x1a, 0xf0, 0xd0, 0x67, 0x9c, 0x64,
+	0x89, 0x8b, 0x85, 0x20, 0xd2, 0x4d, 0x47, 0xd2, 0x3d, 0xdb, 0x55, 0x3f, 0xce, 0x98, 0x33, 0xc9,
+	0xe0, 0x5a, 0x49, 0x3b, 0x9a, 0x76, 0x14, 0x7e, 0xb6, 0xbb, 0xb5, 0x1e, 0xb2, 0x90, 0x69, 0xde,
+	0x55, 0xa7, 0x32, 0x75, 0x6b, 0x33, 0x64, 0x2c, 0x8c, 0x89, 0xab, 0x23, 0x2f, 0x1b, 0xb9, 0x38,
+	0xcd, 0x67, 0x94, 0xfd, 0x2b, 0x25, 0x69, 0x42, 0x84, 0xc4, 0xc9, 0xb8, 0x4c, 0xd8, 0xbe, 0x34,
+	0xc0, 0xca, 0xcb, 0x18, 0x0b, 0xd1, 0x27, 0x23, 0x9a, 0x52, 0x49, 0x59, 0x0a, 0x37, 0x80, 0x49,
+	0x03, 0xcb, 0xe8, 0x1a, 0xbd, 0xc5, 0x83, 0x7a, 0x31, 0xb5, 0xcd, 0x41, 0x1f, 0x99, 0x34, 0x80,
+	0x1b, 0xa0, 0x4e, 0x85, 0xc8, 0x08, 0xb7, 0x4c, 0xc5, 0xa1, 0x59, 0x04, 0x9f, 0x83, 0xe6, 0x88,
+	0x60, 0x99, 0x71, 0x22, 0xac, 0x6a, 0xb7, 0xda, 0x5b, 0xde, 0x7b, 0xe4, 0xfc, 0xa6, 0x7b, 0x47,
+	0xbf, 0x73, 0x58, 0x66, 0xa2, 0x9b, 0x12, 0x78, 0x08, 0xda, 0x9c, 0xe5, 0x38, 0x96, 0xf9, 0x90,
+	0x63, 0x49, 0xac, 0x9a, 0x7e, 0xf8, 0xf1, 0xe5, 0xd4, 0xae, 0x7c, 0x99, 0xda, 0x0f, 0x7c, 0x26,
+	0x12, 0x26, 0x44, 0x70, 0xea, 0x50, 0xe6, 0x26, 0x58, 0x46, 0xce, 0x1b, 0x12, 0x62, 0x3f, 0xef,
+	0x13, 0x1f, 0xb5, 0x66, 0x85, 0x08, 0x4b, 0xb2, 0xfd, 0xdd, 0x04, 0x0b, 0xfa, 0x09, 0xb8, 0x7c,
+	0x3b, 0xc0, 0x1f, 0x1b, 0x87, 0xa0, 0x96, 0xe2, 0x84, 0x58, 0x55, 0x8d, 0xea, 0xb3, 0xca, 0x15,
+	0x79, 0xe2, 0xb1, 0xb8, 0xec, 0x03, 0xcd, 0x22, 0xd8, 0x05, 0xad, 0x80, 0x08, 0x9f, 0xd3, 0xb1,
+	0xd2, 0xc8, 0x5a, 0xd0, 0xe4, 0x5d, 0x08, 0x6e, 0x82, 0x6a, 0xc6, 0xa9, 0x55, 0xd7, 0xed, 0x37,
+	0x8a, 0xa9, 0x5d, 0x3d, 0x41, 0x03, 0xa4, 0x30, 0xf8, 0x04, 0x34, 0x33, 0x4e, 0x87, 0x11, 0x16,
+	0x91, 0xd5, 0xd0, 0x7c, 0xab, 0x98, 0xda, 0x8d, 0x13, 0x34, 0x78, 0x8d, 0x45, 0x84, 0x1a, 0x19,
+	0xa7, 0xea, 0x00, 0x7b, 0xa0, 0x16, 0x60, 0x89, 0xad, 0x66, 0xd7, 0xe8, 0xb5, 0xf6, 0xd6, 0x9d,
+	0xd2, 0x3d, 0x67, 0xee, 0x9e, 0xf3, 0x22, 0xcd, 0x91, 0xce, 0xf8, 0x49, 0xf3, 0xc5, 0xff, 0xd7,
+	0x1c, 0xfc, 0xa3, 0xe6, 0xef, 0xe6, 0xdb, 0x83, 0x25, 0x7e, 0xef, 0x47, 0x24, 0xc1, 0x6a, 0x56,
+	0x5f, 0x41, 0xc3, 0x9b, 0x1d, 0xd2, 0xb3, 0xea, 0xb4, 0x41, 0x1f, 0x35, 0x34, 0x39, 0xd0, 0xa6,
+	0x08, 0x5d, 0x31, 0x37, 0xa5, 0x8c, 0xb6, 0x3f, 0x19, 0x60, 0xe9, 0xed, 0xe1, 0xf1, 0xab, 0xc9,
+	0x98, 0x72, 0xac, 0x85, 0xfd, 0xfb, 0x1b, 0x95, 0xed, 0xe6, 0xbd, 0xbd, 0x3d, 0x02, 0x2b, 0xe4,
+	0xe6, 0xb6, 0xa1, 0xa4, 0x33, 0xc7, 0x5b, 0x7b, 0x5b, 0xf7, 0x04, 0x3e, 0x9e, 0xff, 0x3d, 0x0e,
+	0x9a, 0x4a, 0x8b, 0x8b, 0xaf, 0xb6, 0x81, 0x96, 0x6f, 0x8b, 0x15, 0xfd, 0x74, 0x08, 0xda, 0x77,
+	0x55, 0x85, 0x2d, 0xd0, 0xf0, 0x32, 0x9e, 0xd2, 0x34, 0x5c, 0xad, 0xc0, 0x36, 0x68, 0x8e, 0x38,
+	0x21, 0xe7, 0x2a, 0x32, 0xe0, 0x2a, 0x68, 0x7f, 0x8c, 0xa8, 0x24, 0x31, 0x15, 0x52, 0x21, 0x26,
+	0x5c, 0x03, 0x2b, 0x01, 0x15, 0xd8, 0x8b, 0xc9, 0x50, 0x90, 0x34, 0x50, 0x60, 0x15, 0x2e, 0x81,
+	0x45, 0xc1, 0xb2, 0xd8, 0x63, 0x59, 0x1a, 0xac, 0xd6, 0x0e, 0x8e, 0x2e, 0x8b, 0x8e, 0x71, 0x55,
+	0x74, 0x8c, 0x6f, 0x45, 0xc7, 0xb8, 0xb8, 0xee, 0x54, 0xae, 0xae, 0x3b, 0x95, 0xcf, 0xd7, 0x9d,
+	0xca, 0x87, 0xfd, 0x90, 0xca, 0x28, 0xf3, 0x1c, 0x9f, 0x25, 0xae, 0x64, 0xa7, 0x24, 0xa5, 0xe7,
+	0x64, 0x67, 0xe2, 0xca, 0xc9, 0x8e, 0x1f, 0x61, 0x9a, 0xba, 0x67, 0xcf, 0xdc, 0xc9, 0x9d, 0x0f,
+	0x8a, 0xcc, 0xc7, 0x44, 0x78, 0x75, 0x3d, 0xdd, 0xfe, 0x8f, 0x01, 0x00, 0xec, 0x76, 0x99, 0x23,
+	0x71, 0x04, 0x00, 0x00,
 }
 
 func (m *ClassDefinition) Marshal() (dAtA []byte, err error) {
@@ -460,6 +587,88 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassDataSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassDataSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassDataSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintNft(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -547,6 +756,42 @@ func (m *Class) Size() (n int) {
 	return n
 }
 
+func (m *ClassDataSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *NFTExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovNft(uint64(l))
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1183,6 +1428,267 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassDataSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassDataSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassDataSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTExpiration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTExpiration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
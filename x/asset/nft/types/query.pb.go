@@ -1134,6 +1134,305 @@ func (m *QueryBurntNFTsInClassResponse) GetNftIds() []string {
 	return nil
 }
 
+type QueryDataSchemaRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryDataSchemaRequest) Reset()         { *m = QueryDataSchemaRequest{} }
+func (m *QueryDataSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataSchemaRequest) ProtoMessage()    {}
+func (*QueryDataSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{22}
+}
+func (m *QueryDataSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataSchemaRequest.Merge(m, src)
+}
+func (m *QueryDataSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryDataSchemaRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+type QueryDataSchemaResponse struct {
+	Schema string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *QueryDataSchemaResponse) Reset()         { *m = QueryDataSchemaResponse{} }
+func (m *QueryDataSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataSchemaResponse) ProtoMessage()    {}
+func (*QueryDataSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{23}
+}
+func (m *QueryDataSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataSchemaResponse.Merge(m, src)
+}
+func (m *QueryDataSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryDataSchemaResponse) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+type QueryExpirationRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryExpirationRequest) Reset()         { *m = QueryExpirationRequest{} }
+func (m *QueryExpirationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpirationRequest) ProtoMessage()    {}
+func (*QueryExpirationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{24}
+}
+func (m *QueryExpirationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpirationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpirationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpirationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpirationRequest.Merge(m, src)
+}
+func (m *QueryExpirationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpirationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpirationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpirationRequest proto.InternalMessageInfo
+
+func (m *QueryExpirationRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryExpirationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QueryExpirationResponse struct {
+	Expiration NFTExpiration `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration"`
+}
+
+func (m *QueryExpirationResponse) Reset()         { *m = QueryExpirationResponse{} }
+func (m *QueryExpirationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpirationResponse) ProtoMessage()    {}
+func (*QueryExpirationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{25}
+}
+func (m *QueryExpirationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpirationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpirationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpirationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpirationResponse.Merge(m, src)
+}
+func (m *QueryExpirationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpirationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpirationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpirationResponse proto.InternalMessageInfo
+
+func (m *QueryExpirationResponse) GetExpiration() NFTExpiration {
+	if m != nil {
+		return m.Expiration
+	}
+	return NFTExpiration{}
+}
+
+type QueryExpirationsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ClassId    string             `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// expired filters the NFTs expired at the current block time, so they can be burnt.
+	Expired bool `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (m *QueryExpirationsRequest) Reset()         { *m = QueryExpirationsRequest{} }
+func (m *QueryExpirationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpirationsRequest) ProtoMessage()    {}
+func (*QueryExpirationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{26}
+}
+func (m *QueryExpirationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpirationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpirationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpirationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpirationsRequest.Merge(m, src)
+}
+func (m *QueryExpirationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpirationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpirationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpirationsRequest proto.InternalMessageInfo
+
+func (m *QueryExpirationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryExpirationsRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryExpirationsRequest) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+type QueryExpirationsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination  *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Expirations []NFTExpiration     `protobuf:"bytes,2,rep,name=expirations,proto3" json:"expirations"`
+}
+
+func (m *QueryExpirationsResponse) Reset()         { *m = QueryExpirationsResponse{} }
+func (m *QueryExpirationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpirationsResponse) ProtoMessage()    {}
+func (*QueryExpirationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{27}
+}
+func (m *QueryExpirationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpirationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpirationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpirationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpirationsResponse.Merge(m, src)
+}
+func (m *QueryExpirationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpirationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpirationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpirationsResponse proto.InternalMessageInfo
+
+func (m *QueryExpirationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryExpirationsResponse) GetExpirations() []NFTExpiration {
+	if m != nil {
+		return m.Expirations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.asset.nft.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.asset.nft.v1.QueryParamsResponse")
@@ -1157,80 +1456,97 @@ func init() {
 	proto.RegisterType((*QueryBurntNFTResponse)(nil), "coreum.asset.nft.v1.QueryBurntNFTResponse")
 	proto.RegisterType((*QueryBurntNFTsInClassRequest)(nil), "coreum.asset.nft.v1.QueryBurntNFTsInClassRequest")
 	proto.RegisterType((*QueryBurntNFTsInClassResponse)(nil), "coreum.asset.nft.v1.QueryBurntNFTsInClassResponse")
+	proto.RegisterType((*QueryDataSchemaRequest)(nil), "coreum.asset.nft.v1.QueryDataSchemaRequest")
+	proto.RegisterType((*QueryDataSchemaResponse)(nil), "coreum.asset.nft.v1.QueryDataSchemaResponse")
+	proto.RegisterType((*QueryExpirationRequest)(nil), "coreum.asset.nft.v1.QueryExpirationRequest")
+	proto.RegisterType((*QueryExpirationResponse)(nil), "coreum.asset.nft.v1.QueryExpirationResponse")
+	proto.RegisterType((*QueryExpirationsRequest)(nil), "coreum.asset.nft.v1.QueryExpirationsRequest")
+	proto.RegisterType((*QueryExpirationsResponse)(nil), "coreum.asset.nft.v1.QueryExpirationsResponse")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/query.proto", fileDescriptor_97b36b7d05006cb3) }
 
 var fileDescriptor_97b36b7d05006cb3 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x38, 0xd8, 0x49, 0x5f, 0xa4, 0x0a, 0x26, 0x69, 0xeb, 0x6e, 0x12, 0xc7, 0x6c, 0x20,
-	0x49, 0x23, 0xbc, 0x43, 0x12, 0x12, 0xda, 0x14, 0x28, 0x04, 0x91, 0x12, 0x09, 0x4a, 0xb0, 0x90,
-	0x90, 0x38, 0x80, 0xd6, 0xf6, 0xd8, 0x59, 0x91, 0xec, 0xba, 0xde, 0x71, 0x48, 0x1b, 0x45, 0xa2,
-	0x08, 0x89, 0x56, 0x02, 0x09, 0x89, 0x1b, 0x88, 0x03, 0x77, 0x0e, 0x3d, 0xc3, 0x3f, 0xd0, 0x13,
-	0xaa, 0xc4, 0x05, 0x09, 0x09, 0xa1, 0x04, 0x89, 0x33, 0xff, 0x01, 0xf2, 0xcc, 0xdb, 0x78, 0xd7,
-	0xd9, 0x1f, 0x76, 0xb0, 0xc2, 0xcd, 0x33, 0xfb, 0x7e, 0x7c, 0xdf, 0xf7, 0x66, 0xe6, 0x3d, 0x19,
-	0xa6, 0xca, 0x4e, 0x83, 0x37, 0x77, 0x98, 0xe9, 0xba, 0x5c, 0x30, 0xbb, 0x2a, 0xd8, 0xee, 0x02,
-	0xbb, 0xdd, 0xe4, 0x8d, 0x3b, 0x46, 0xbd, 0xe1, 0x08, 0x87, 0x8e, 0x2a, 0x03, 0x43, 0x1a, 0x18,
-	0x76, 0x55, 0x18, 0xbb, 0x0b, 0xda, 0x64, 0x98, 0x57, 0xeb, 0x9b, 0xf4, 0xd1, 0xf2, 0x61, 0x9f,
-	0xeb, 0x66, 0xc3, 0xdc, 0x71, 0xd1, 0x62, 0xbe, 0xec, 0xb8, 0x3b, 0x8e, 0xcb, 0x4a, 0xa6, 0xcb,
-	0x55, 0x3a, 0xb6, 0xbb, 0x50, 0xe2, 0xc2, 0x6c, 0xd9, 0xd5, 0x2c, 0xdb, 0x14, 0x96, 0x63, 0xa3,
-	0xed, 0x38, 0xda, 0x7a, 0x66, 0x7e, 0x78, 0xda, 0x58, 0xcd, 0xa9, 0x39, 0xf2, 0x27, 0x6b, 0xfd,
-	0xc2, 0xdd, 0x89, 0x9a, 0xe3, 0xd4, 0xb6, 0x39, 0x33, 0xeb, 0x16, 0x33, 0x6d, 0xdb, 0x11, 0x32,
-	0x1e, 0x26, 0xd7, 0xc7, 0x80, 0xbe, 0xdb, 0x0a, 0xb1, 0x29, 0x11, 0x15, 0xf9, 0xed, 0x26, 0x77,
-	0x85, 0xbe, 0x09, 0xa3, 0x81, 0x5d, 0xb7, 0xee, 0xd8, 0x2e, 0xa7, 0xd7, 0x20, 0xa3, 0x90, 0x67,
-	0x49, 0x9e, 0xcc, 0x8d, 0x2c, 0x8e, 0x1b, 0x21, 0x82, 0x18, 0xca, 0x69, 0xed, 0x89, 0x47, 0x7f,
-	0x4c, 0x0d, 0x14, 0xd1, 0x41, 0x9f, 0x86, 0xa7, 0x64, 0xc4, 0xd7, 0xb7, 0x4d, 0xd7, 0x4b, 0x43,
-	0xcf, 0x43, 0xca, 0xaa, 0xc8, 0x58, 0xe7, 0x8a, 0x29, 0xab, 0xa2, 0xbf, 0x85, 0x60, 0xd0, 0x08,
-	0xb3, 0xae, 0x40, 0xba, 0xdc, 0xda, 0xc0, 0xa4, 0x5a, 0x68, 0x52, 0xe9, 0x82, 0x39, 0x95, 0xb9,
-	0xde, 0x44, 0x12, 0xf2, 0x13, 0x3f, 0x4e, 0xba, 0x0e, 0xd0, 0x96, 0x15, 0x63, 0xce, 0x18, 0x4a,
-	0x57, 0xa3, 0x55, 0x03, 0x43, 0x69, 0x8a, 0x35, 0x30, 0x36, 0xcd, 0x1a, 0x47, 0xdf, 0xa2, 0xcf,
-	0x93, 0x5e, 0x84, 0x8c, 0xe5, 0xba, 0x4d, 0xde, 0xc8, 0xa6, 0x24, 0x01, 0x5c, 0xe9, 0xdf, 0x11,
-	0x18, 0x0b, 0xe6, 0x45, 0x1e, 0x37, 0x43, 0x12, 0xcf, 0x26, 0x26, 0x56, 0xce, 0x81, 0xcc, 0xab,
-	0x30, 0x54, 0x56, 0xb1, 0xb3, 0xa9, 0xfc, 0x60, 0x57, 0x92, 0x78, 0x0e, 0xfa, 0x0d, 0x94, 0x78,
-	0xbd, 0xe1, 0xdc, 0xe5, 0x76, 0x44, 0x21, 0xe8, 0x65, 0x18, 0x96, 0x0e, 0x1f, 0x59, 0x15, 0x64,
-	0xa7, 0x02, 0x6c, 0x54, 0xf4, 0x02, 0xaa, 0xea, 0x05, 0x40, 0x72, 0x17, 0x21, 0x53, 0x95, 0x3b,
-	0x32, 0xca, 0x70, 0x11, 0x57, 0xfa, 0x2d, 0xb8, 0xd4, 0x16, 0x23, 0x98, 0xd4, 0x9f, 0x84, 0x04,
-	0x92, 0xd0, 0x2c, 0x0c, 0x99, 0xe5, 0xb2, 0xd3, 0xb4, 0x85, 0x97, 0x1e, 0x97, 0xfa, 0x22, 0x64,
-	0x4f, 0xc6, 0x4b, 0xc0, 0xf0, 0x21, 0x62, 0x78, 0x7f, 0xcb, 0x12, 0x7c, 0xdb, 0x72, 0x05, 0xaf,
-	0xf4, 0x4e, 0xdc, 0x8f, 0x69, 0x30, 0x88, 0xe9, 0x25, 0xc4, 0x14, 0x88, 0x8f, 0x98, 0xf2, 0x30,
-	0xf2, 0x49, 0x7b, 0x1b, 0x81, 0xf9, 0xb7, 0xf4, 0x6f, 0x09, 0x3c, 0xdb, 0xe9, 0xfe, 0x9a, 0x8a,
-	0xec, 0xae, 0x3b, 0x8d, 0x5b, 0xeb, 0xef, 0xf5, 0xfb, 0xe4, 0x2a, 0xd2, 0xa9, 0x50, 0xd2, 0x83,
-	0xc1, 0x6a, 0x7f, 0x45, 0x60, 0x26, 0x09, 0x5c, 0xbf, 0x8f, 0xb7, 0x06, 0xc3, 0xa8, 0xac, 0x3a,
-	0xdf, 0xe7, 0x8a, 0xc7, 0x6b, 0xfd, 0x01, 0x81, 0x67, 0xda, 0xf5, 0x0f, 0x01, 0xd5, 0x6f, 0xad,
-	0x62, 0x6e, 0xc2, 0x97, 0x5e, 0xe1, 0xa2, 0xb1, 0x9c, 0xa5, 0x34, 0x9f, 0x13, 0x98, 0xea, 0xbc,
-	0x1a, 0xff, 0x83, 0x2a, 0x5f, 0x10, 0xc8, 0x47, 0xc3, 0x38, 0x4b, 0x41, 0xde, 0xc4, 0x77, 0x78,
-	0xad, 0xd9, 0xb0, 0x85, 0xef, 0x1a, 0xc5, 0xbc, 0x3b, 0x17, 0x20, 0x63, 0x57, 0x45, 0x9b, 0x55,
-	0xda, 0xae, 0x0a, 0xf9, 0xe6, 0x5d, 0xe8, 0x88, 0x84, 0x3c, 0xc6, 0x20, 0x5d, 0x6a, 0xed, 0xe1,
-	0xbd, 0x56, 0x0b, 0xfd, 0x1e, 0x81, 0x89, 0x80, 0xbd, 0xbb, 0x61, 0x07, 0xfa, 0xde, 0x19, 0x94,
-	0xe1, 0x1e, 0x81, 0xc9, 0x08, 0x0c, 0xfd, 0xae, 0xc1, 0x25, 0x18, 0x52, 0xa2, 0x79, 0x25, 0xc8,
-	0x48, 0xd5, 0xdc, 0xc5, 0x7f, 0xce, 0x43, 0x5a, 0x62, 0xa0, 0x9f, 0x12, 0xc8, 0xa8, 0xb1, 0x80,
-	0xce, 0x86, 0xf6, 0xaa, 0x93, 0x33, 0x88, 0x36, 0x97, 0x6c, 0xa8, 0xc0, 0xe8, 0xd3, 0x9f, 0xfd,
-	0xfa, 0xd7, 0x37, 0xa9, 0x49, 0x3a, 0xce, 0xa2, 0x67, 0x2d, 0x7a, 0x9f, 0x40, 0x5a, 0x0a, 0x40,
-	0x67, 0xa2, 0x03, 0xfb, 0xab, 0xa4, 0xcd, 0x26, 0xda, 0x61, 0x7e, 0xe3, 0xfe, 0xdf, 0x0f, 0xe7,
-	0x89, 0x04, 0x31, 0x4d, 0x9f, 0x0e, 0x05, 0x81, 0xed, 0x97, 0xed, 0x5b, 0x95, 0x03, 0xfa, 0x80,
-	0xc0, 0x10, 0x0e, 0x07, 0x74, 0x2e, 0x21, 0xc9, 0xf1, 0xdc, 0xa2, 0x5d, 0xe9, 0xc2, 0x12, 0x01,
-	0x5d, 0x69, 0x03, 0xca, 0xd1, 0x89, 0x38, 0x40, 0xf4, 0x7b, 0x02, 0x19, 0x75, 0x49, 0xe3, 0x2a,
-	0x13, 0x68, 0xdc, 0x71, 0x95, 0x09, 0x76, 0x64, 0xfd, 0x55, 0x89, 0x61, 0x95, 0x5e, 0x8d, 0x17,
-	0xc5, 0x3b, 0xc4, 0x07, 0xad, 0x2f, 0x4a, 0x24, 0xa6, 0x7a, 0x37, 0xfd, 0x91, 0xc0, 0x88, 0xef,
-	0x25, 0xa1, 0xcf, 0x25, 0xa8, 0x10, 0x44, 0x5a, 0xe8, 0xd2, 0xfa, 0xb4, 0x70, 0x15, 0x48, 0xb6,
-	0x8f, 0x6f, 0xce, 0x01, 0xfd, 0x89, 0xc0, 0x68, 0xc8, 0xc3, 0x47, 0x5f, 0xe8, 0x0a, 0x48, 0xc7,
-	0x73, 0xad, 0x2d, 0xf7, 0xe8, 0x85, 0x34, 0x56, 0x24, 0x8d, 0xe7, 0xa9, 0xd1, 0x1b, 0x0d, 0xfa,
-	0x33, 0x81, 0x11, 0x5f, 0x1b, 0x8b, 0xd3, 0xfa, 0xe4, 0x28, 0x15, 0xa7, 0x75, 0xc8, 0x60, 0xa4,
-	0xbf, 0x23, 0x41, 0x6e, 0xd0, 0x9b, 0xbd, 0x1f, 0x0d, 0xdf, 0xf4, 0xe4, 0x93, 0xfe, 0x77, 0x02,
-	0x97, 0x23, 0xa7, 0x14, 0xba, 0xda, 0x15, 0xba, 0xd0, 0xb9, 0x4b, 0xbb, 0x7e, 0x2a, 0x5f, 0xe4,
-	0xf9, 0x86, 0xe4, 0x79, 0x83, 0xbe, 0xfc, 0x9f, 0x78, 0xd2, 0x5f, 0x08, 0x64, 0xa3, 0xe6, 0x0c,
-	0x7a, 0x2d, 0xe1, 0x9c, 0x44, 0xcf, 0x49, 0xda, 0xea, 0x69, 0x5c, 0x91, 0xda, 0x75, 0x49, 0x6d,
-	0x99, 0x2e, 0x75, 0x4b, 0xcd, 0x4f, 0xe8, 0x07, 0x02, 0xc3, 0x5e, 0x6f, 0xa2, 0x31, 0x6f, 0x5b,
-	0x47, 0xf7, 0xd6, 0xe6, 0xbb, 0x31, 0x45, 0x80, 0xaf, 0x48, 0x80, 0x57, 0xe9, 0x4a, 0xb7, 0x00,
-	0x65, 0xff, 0x66, 0xfb, 0xaa, 0x9d, 0x1d, 0xd0, 0x87, 0x04, 0x9e, 0xec, 0xec, 0x9f, 0x74, 0x21,
-	0x19, 0x40, 0x47, 0xbf, 0xd7, 0x16, 0x7b, 0x71, 0x41, 0xec, 0xcb, 0x12, 0x3b, 0xa3, 0x85, 0x9e,
-	0xb0, 0xaf, 0xbd, 0xfd, 0xe8, 0x30, 0x47, 0x1e, 0x1f, 0xe6, 0xc8, 0x9f, 0x87, 0x39, 0xf2, 0xf5,
-	0x51, 0x6e, 0xe0, 0xf1, 0x51, 0x6e, 0xe0, 0xb7, 0xa3, 0xdc, 0xc0, 0x07, 0x4b, 0x35, 0x4b, 0x6c,
-	0x35, 0x4b, 0x46, 0xd9, 0xd9, 0x61, 0xc2, 0xf9, 0x98, 0xdb, 0xd6, 0x5d, 0x5e, 0xd8, 0x63, 0x62,
-	0xaf, 0x50, 0xde, 0x32, 0x2d, 0x9b, 0xed, 0xbe, 0xc8, 0xf6, 0x7c, 0x49, 0xc4, 0x9d, 0x3a, 0x77,
-	0x4b, 0x19, 0xf9, 0x2f, 0xc1, 0xd2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x85, 0x1c, 0xbc,
-	0x1b, 0x11, 0x00, 0x00,
+	// 1259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0xdf, 0x4f, 0x1c, 0x55,
+	0x14, 0xc7, 0xb9, 0x5b, 0x59, 0xe8, 0xd9, 0xc4, 0xe8, 0x81, 0x96, 0xed, 0x00, 0x0b, 0x0e, 0x0a,
+	0x14, 0xd9, 0x19, 0x01, 0xc1, 0x96, 0xd6, 0x56, 0x69, 0x4b, 0x8b, 0x51, 0xc4, 0xb5, 0x89, 0x89,
+	0x0f, 0x9a, 0x61, 0x77, 0x58, 0x26, 0xc2, 0xcc, 0x76, 0xef, 0x2c, 0xd2, 0x12, 0x12, 0x6b, 0x4c,
+	0x6c, 0x13, 0x4d, 0x4c, 0x4c, 0x7c, 0xd0, 0xf8, 0x60, 0x62, 0xe2, 0x8b, 0xc6, 0x3e, 0xeb, 0x3f,
+	0xd0, 0x27, 0xd3, 0xc4, 0x17, 0x13, 0x13, 0x63, 0xc0, 0xc4, 0x77, 0xff, 0x02, 0xb3, 0xf7, 0x9e,
+	0x65, 0x66, 0x76, 0x67, 0x77, 0x66, 0x91, 0xe0, 0xdb, 0xde, 0x3b, 0xe7, 0xc7, 0xe7, 0x9c, 0xfb,
+	0xeb, 0x9b, 0x85, 0xa1, 0xbc, 0x53, 0x36, 0x2b, 0x9b, 0xba, 0xc1, 0xb9, 0xe9, 0xea, 0xf6, 0x9a,
+	0xab, 0x6f, 0x4d, 0xe9, 0xb7, 0x2a, 0x66, 0xf9, 0xb6, 0x56, 0x2a, 0x3b, 0xae, 0x83, 0x3d, 0xd2,
+	0x40, 0x13, 0x06, 0x9a, 0xbd, 0xe6, 0x6a, 0x5b, 0x53, 0xca, 0x60, 0x98, 0x57, 0xf5, 0x9b, 0xf0,
+	0x51, 0x86, 0xc3, 0x3e, 0x97, 0x8c, 0xb2, 0xb1, 0xc9, 0xc9, 0x62, 0x22, 0xef, 0xf0, 0x4d, 0x87,
+	0xeb, 0xab, 0x06, 0x37, 0x65, 0x3a, 0x7d, 0x6b, 0x6a, 0xd5, 0x74, 0x8d, 0xaa, 0x5d, 0xd1, 0xb2,
+	0x0d, 0xd7, 0x72, 0x6c, 0xb2, 0xed, 0x27, 0xdb, 0x9a, 0x99, 0x1f, 0x4f, 0xe9, 0x2d, 0x3a, 0x45,
+	0x47, 0xfc, 0xd4, 0xab, 0xbf, 0x68, 0x76, 0xa0, 0xe8, 0x38, 0xc5, 0x0d, 0x53, 0x37, 0x4a, 0x96,
+	0x6e, 0xd8, 0xb6, 0xe3, 0x8a, 0x78, 0x94, 0x5c, 0xed, 0x05, 0x7c, 0xa3, 0x1a, 0x62, 0x45, 0x10,
+	0xe5, 0xcc, 0x5b, 0x15, 0x93, 0xbb, 0xea, 0x0a, 0xf4, 0x04, 0x66, 0x79, 0xc9, 0xb1, 0xb9, 0x89,
+	0xe7, 0x21, 0x29, 0xc9, 0xd3, 0x6c, 0x98, 0x8d, 0xa7, 0xa6, 0xfb, 0xb5, 0x90, 0x86, 0x68, 0xd2,
+	0x69, 0xe1, 0xb1, 0x87, 0x7f, 0x0c, 0x75, 0xe4, 0xc8, 0x41, 0x1d, 0x81, 0x27, 0x45, 0xc4, 0x2b,
+	0x1b, 0x06, 0xaf, 0xa5, 0xc1, 0xc7, 0x21, 0x61, 0x15, 0x44, 0xac, 0x93, 0xb9, 0x84, 0x55, 0x50,
+	0x5f, 0x05, 0xf4, 0x1b, 0x51, 0xd6, 0x39, 0xe8, 0xcc, 0x57, 0x27, 0x28, 0xa9, 0x12, 0x9a, 0x54,
+	0xb8, 0x50, 0x4e, 0x69, 0xae, 0x56, 0xa8, 0x08, 0xf1, 0xc9, 0x3c, 0x48, 0xba, 0x08, 0xe0, 0xb5,
+	0x95, 0x62, 0x8e, 0x6a, 0xb2, 0xaf, 0x5a, 0x75, 0x0d, 0x34, 0xd9, 0x53, 0x5a, 0x03, 0x6d, 0xc5,
+	0x28, 0x9a, 0xe4, 0x9b, 0xf3, 0x79, 0xe2, 0x69, 0x48, 0x5a, 0x9c, 0x57, 0xcc, 0x72, 0x3a, 0x21,
+	0x0a, 0xa0, 0x91, 0xfa, 0x15, 0x83, 0xde, 0x60, 0x5e, 0xaa, 0xe3, 0x7a, 0x48, 0xe2, 0xb1, 0xc8,
+	0xc4, 0xd2, 0x39, 0x90, 0x79, 0x1e, 0xba, 0xf2, 0x32, 0x76, 0x3a, 0x31, 0x7c, 0x22, 0x56, 0x4b,
+	0x6a, 0x0e, 0xea, 0x65, 0x6a, 0xf1, 0x62, 0xd9, 0xb9, 0x63, 0xda, 0x4d, 0x16, 0x02, 0xcf, 0x40,
+	0xb7, 0x70, 0x78, 0xd7, 0x2a, 0x50, 0x75, 0x32, 0xc0, 0x52, 0x41, 0xcd, 0x42, 0x4f, 0x20, 0x00,
+	0x15, 0x77, 0x1a, 0x92, 0x6b, 0x62, 0x46, 0x44, 0xe9, 0xce, 0xd1, 0x48, 0x5d, 0x86, 0x3e, 0xaf,
+	0x19, 0xc1, 0xa4, 0xfe, 0x24, 0x2c, 0x90, 0x04, 0xd3, 0xd0, 0x65, 0xe4, 0xf3, 0x4e, 0xc5, 0x76,
+	0x6b, 0xe9, 0x69, 0xa8, 0x4e, 0x43, 0xba, 0x31, 0x5e, 0x04, 0xc3, 0x3b, 0xc4, 0xf0, 0xd6, 0xba,
+	0xe5, 0x9a, 0x1b, 0x16, 0x77, 0xcd, 0x42, 0xfb, 0x85, 0xfb, 0x99, 0x4e, 0x04, 0x99, 0x2e, 0x42,
+	0xba, 0x31, 0x3e, 0x31, 0x0d, 0x43, 0xea, 0x7d, 0x6f, 0x9a, 0xc0, 0xfc, 0x53, 0xea, 0x97, 0x0c,
+	0x9e, 0xa9, 0x77, 0x7f, 0x59, 0x46, 0xe6, 0x8b, 0x4e, 0x79, 0x79, 0xf1, 0xe6, 0x51, 0xef, 0x5c,
+	0x59, 0x74, 0x22, 0xb4, 0xe8, 0x13, 0xc1, 0xd5, 0xfe, 0x94, 0xc1, 0x68, 0x14, 0xdc, 0x51, 0x6f,
+	0x6f, 0x05, 0xba, 0xa9, 0xb3, 0x72, 0x7f, 0x9f, 0xcc, 0x1d, 0x8c, 0xd5, 0xfb, 0x0c, 0x9e, 0xf6,
+	0xd6, 0x3f, 0x04, 0xea, 0xa8, 0x7b, 0xd5, 0xe2, 0x24, 0x7c, 0x52, 0x5b, 0xb8, 0xe6, 0x2c, 0xc7,
+	0xd9, 0x9a, 0x8f, 0x18, 0x0c, 0xd5, 0x1f, 0x8d, 0xff, 0xa1, 0x2b, 0x1f, 0x33, 0x18, 0x6e, 0x8e,
+	0x71, 0x9c, 0x0d, 0xb9, 0x41, 0xf7, 0xf0, 0x42, 0xa5, 0x6c, 0xbb, 0xbe, 0x63, 0xd4, 0xe2, 0xde,
+	0x39, 0x05, 0x49, 0x7b, 0xcd, 0xf5, 0xaa, 0xea, 0xb4, 0xd7, 0x5c, 0x71, 0xe7, 0x9d, 0xaa, 0x8b,
+	0x44, 0x75, 0xf4, 0x42, 0xe7, 0x6a, 0x75, 0x8e, 0xce, 0xb5, 0x1c, 0xa8, 0x77, 0x19, 0x0c, 0x04,
+	0xec, 0xf9, 0x92, 0x1d, 0x78, 0xf7, 0x8e, 0x61, 0x19, 0xee, 0x32, 0x18, 0x6c, 0xc2, 0x70, 0xd4,
+	0x6b, 0xd0, 0x07, 0x5d, 0xb2, 0x69, 0xb5, 0x25, 0x48, 0x8a, 0xae, 0x71, 0x75, 0x06, 0x4e, 0x0b,
+	0x84, 0xab, 0x86, 0x6b, 0xbc, 0x99, 0x5f, 0x37, 0x37, 0x8d, 0xe8, 0x25, 0x50, 0xa7, 0xa0, 0xaf,
+	0xc1, 0xc9, 0xbb, 0xdf, 0xb9, 0x98, 0x21, 0x1f, 0x1a, 0xa9, 0x57, 0x28, 0xcf, 0xb5, 0xed, 0x92,
+	0x55, 0x16, 0x4c, 0x31, 0x96, 0xba, 0xee, 0x12, 0x54, 0xf3, 0xd0, 0xd7, 0x10, 0x84, 0xf2, 0xde,
+	0x00, 0x30, 0x0f, 0x66, 0xa9, 0x53, 0x6a, 0xe8, 0x93, 0xbb, 0xbc, 0x78, 0xd3, 0xf3, 0xa7, 0xa7,
+	0xd7, 0xe7, 0xab, 0x7e, 0xc1, 0x1a, 0xb2, 0x1c, 0xe3, 0xa6, 0xa8, 0x3e, 0x61, 0x02, 0xc6, 0x94,
+	0xf7, 0x7c, 0x77, 0xae, 0x36, 0x54, 0x7f, 0x64, 0x90, 0x6e, 0x04, 0x3b, 0xea, 0x9d, 0xf2, 0x0a,
+	0xa4, 0xbc, 0x66, 0xd4, 0xc4, 0x4b, 0xfc, 0x4e, 0xfa, 0x9d, 0xa7, 0xff, 0x41, 0xe8, 0x14, 0xc4,
+	0xf8, 0x01, 0x83, 0xa4, 0xd4, 0x9c, 0x38, 0x16, 0x1a, 0xab, 0x51, 0xe0, 0x2a, 0xe3, 0xd1, 0x86,
+	0x92, 0x5f, 0x1d, 0xf9, 0xf0, 0xd7, 0xbf, 0x3e, 0x4f, 0x0c, 0x62, 0xbf, 0xde, 0x5c, 0xc8, 0xe3,
+	0x3d, 0x06, 0x9d, 0xe2, 0x74, 0xe1, 0x68, 0xf3, 0xc0, 0xfe, 0x2b, 0x40, 0x19, 0x8b, 0xb4, 0xa3,
+	0xfc, 0xda, 0xbd, 0xbf, 0x1f, 0x4c, 0x30, 0x01, 0x31, 0x82, 0x4f, 0x85, 0x42, 0x90, 0xb6, 0xd3,
+	0x77, 0xac, 0xc2, 0x2e, 0xde, 0x67, 0xd0, 0x45, 0xca, 0x13, 0xc7, 0x23, 0x92, 0x1c, 0x88, 0x62,
+	0xe5, 0x6c, 0x0c, 0x4b, 0x02, 0x3a, 0xeb, 0x01, 0x65, 0x70, 0xa0, 0x15, 0x10, 0x7e, 0xcd, 0x20,
+	0x29, 0x5f, 0x80, 0x56, 0x2b, 0x13, 0x50, 0x85, 0xca, 0x78, 0xb4, 0x21, 0x81, 0xbc, 0x24, 0x18,
+	0xe6, 0xf1, 0x5c, 0xeb, 0xa6, 0xd4, 0x0e, 0xc3, 0x6e, 0xf5, 0x8b, 0x6c, 0x92, 0x2e, 0x85, 0x21,
+	0x7e, 0xcf, 0x20, 0xe5, 0x7b, 0xa6, 0x70, 0x32, 0xa2, 0x0b, 0x41, 0xd2, 0x6c, 0x4c, 0xeb, 0xc3,
+	0xe2, 0x4a, 0x48, 0x7d, 0x87, 0x1e, 0xb4, 0x5d, 0xfc, 0x89, 0x41, 0x4f, 0xc8, 0xab, 0x8a, 0xcf,
+	0xc7, 0x02, 0xa9, 0xd3, 0x02, 0xca, 0x6c, 0x9b, 0x5e, 0x54, 0xc6, 0x9c, 0x28, 0xe3, 0x39, 0xd4,
+	0xda, 0x2b, 0x03, 0x7f, 0x66, 0x90, 0xf2, 0x69, 0xa4, 0x56, 0xbd, 0x6e, 0xd4, 0xe9, 0x4a, 0x36,
+	0xa6, 0x35, 0x41, 0xbe, 0x2e, 0x20, 0x97, 0xf0, 0x7a, 0xfb, 0x5b, 0xc3, 0x27, 0xcd, 0x7d, 0xad,
+	0xff, 0x9d, 0xc1, 0x99, 0xa6, 0x12, 0x18, 0xe7, 0x63, 0xd1, 0x85, 0x8a, 0x7a, 0xe5, 0xc2, 0xa1,
+	0x7c, 0xa9, 0xce, 0x6b, 0xa2, 0xce, 0xcb, 0xf8, 0xe2, 0x7f, 0xaa, 0x13, 0x7f, 0x61, 0x90, 0x6e,
+	0x26, 0x62, 0xf1, 0x7c, 0xc4, 0x3e, 0x69, 0x2e, 0xc2, 0x95, 0xf9, 0xc3, 0xb8, 0x52, 0x69, 0x17,
+	0x44, 0x69, 0xb3, 0x38, 0x13, 0xb7, 0x34, 0x7f, 0x41, 0xdf, 0x30, 0xe8, 0xae, 0x09, 0x1f, 0x6c,
+	0x71, 0xb7, 0xd5, 0x49, 0x43, 0x65, 0x22, 0x8e, 0x29, 0x01, 0x5e, 0x12, 0x80, 0xe7, 0x70, 0x2e,
+	0x2e, 0xa0, 0x10, 0x87, 0xfa, 0x8e, 0xd4, 0x4a, 0xbb, 0xf8, 0x80, 0xc1, 0x13, 0xf5, 0xe2, 0x0c,
+	0xa7, 0xa2, 0x01, 0xea, 0xc4, 0xa4, 0x32, 0xdd, 0x8e, 0x0b, 0xb1, 0xcf, 0x0a, 0x76, 0x1d, 0xb3,
+	0x6d, 0xb1, 0xe3, 0xb7, 0x0c, 0xc0, 0xd3, 0x65, 0xf8, 0x6c, 0xf3, 0xcc, 0x0d, 0x92, 0x4f, 0x99,
+	0x8c, 0x67, 0x7c, 0xd8, 0xd5, 0x2f, 0x18, 0xae, 0x91, 0x95, 0x7a, 0x10, 0x7f, 0x60, 0x00, 0x9e,
+	0x78, 0x68, 0x85, 0xd9, 0xa0, 0x18, 0x95, 0xc9, 0x78, 0xc6, 0x84, 0x79, 0x55, 0x60, 0x5e, 0xc2,
+	0x8b, 0xed, 0x9f, 0x3f, 0x4f, 0xcb, 0xe0, 0x77, 0x0c, 0x52, 0x5e, 0x70, 0x8e, 0xb1, 0x18, 0x78,
+	0x8c, 0xab, 0x31, 0x44, 0xcc, 0xb5, 0xdf, 0x59, 0x0f, 0x94, 0x2f, 0xbc, 0xf6, 0x70, 0x2f, 0xc3,
+	0x1e, 0xed, 0x65, 0xd8, 0x9f, 0x7b, 0x19, 0xf6, 0xd9, 0x7e, 0xa6, 0xe3, 0xd1, 0x7e, 0xa6, 0xe3,
+	0xb7, 0xfd, 0x4c, 0xc7, 0xdb, 0x33, 0x45, 0xcb, 0x5d, 0xaf, 0xac, 0x6a, 0x79, 0x67, 0x53, 0x77,
+	0x9d, 0xf7, 0x4c, 0xdb, 0xba, 0x63, 0x66, 0xb7, 0x75, 0x77, 0x3b, 0x9b, 0x5f, 0x37, 0x2c, 0x5b,
+	0xdf, 0x7a, 0x41, 0xdf, 0xf6, 0xa5, 0x72, 0x6f, 0x97, 0x4c, 0xbe, 0x9a, 0x14, 0xff, 0x41, 0xce,
+	0xfc, 0x3b, 0x00, 0x44, 0x72, 0x87, 0x18, 0x79, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1267,6 +1583,12 @@ type QueryClient interface {
 	BurntNFT(ctx context.Context, in *QueryBurntNFTRequest, opts ...grpc.CallOption) (*QueryBurntNFTResponse, error)
 	// BurntNFTsInClass returns the list of burnt nfts in a class.
 	BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsInClassRequest, opts ...grpc.CallOption) (*QueryBurntNFTsInClassResponse, error)
+	// DataSchema returns the JSON schema of the NFT data registered for the class.
+	DataSchema(ctx context.Context, in *QueryDataSchemaRequest, opts ...grpc.CallOption) (*QueryDataSchemaResponse, error)
+	// Expiration returns the expiration time of the NFT.
+	Expiration(ctx context.Context, in *QueryExpirationRequest, opts ...grpc.CallOption) (*QueryExpirationResponse, error)
+	// Expirations returns the expiration times of the NFTs in the class.
+	Expirations(ctx context.Context, in *QueryExpirationsRequest, opts ...grpc.CallOption) (*QueryExpirationsResponse, error)
 }
 
 type queryClient struct {
@@ -1376,6 +1698,33 @@ func (c *queryClient) BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsIn
 	return out, nil
 }
 
+func (c *queryClient) DataSchema(ctx context.Context, in *QueryDataSchemaRequest, opts ...grpc.CallOption) (*QueryDataSchemaResponse, error) {
+	out := new(QueryDataSchemaResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/DataSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Expiration(ctx context.Context, in *QueryExpirationRequest, opts ...grpc.CallOption) (*QueryExpirationResponse, error) {
+	out := new(QueryExpirationResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Expiration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Expirations(ctx context.Context, in *QueryExpirationsRequest, opts ...grpc.CallOption) (*QueryExpirationsResponse, error) {
+	out := new(QueryExpirationsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Expirations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/asset/nft module.
@@ -1400,6 +1749,12 @@ type QueryServer interface {
 	BurntNFT(context.Context, *QueryBurntNFTRequest) (*QueryBurntNFTResponse, error)
 	// BurntNFTsInClass returns the list of burnt nfts in a class.
 	BurntNFTsInClass(context.Context, *QueryBurntNFTsInClassRequest) (*QueryBurntNFTsInClassResponse, error)
+	// DataSchema returns the JSON schema of the NFT data registered for the class.
+	DataSchema(context.Context, *QueryDataSchemaRequest) (*QueryDataSchemaResponse, error)
+	// Expiration returns the expiration time of the NFT.
+	Expiration(context.Context, *QueryExpirationRequest) (*QueryExpirationResponse, error)
+	// Expirations returns the expiration times of the NFTs in the class.
+	Expirations(context.Context, *QueryExpirationsRequest) (*QueryExpirationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1439,6 +1794,15 @@ func (*UnimplementedQueryServer) BurntNFT(ctx context.Context, req *QueryBurntNF
 func (*UnimplementedQueryServer) BurntNFTsInClass(ctx context.Context, req *QueryBurntNFTsInClassRequest) (*QueryBurntNFTsInClassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurntNFTsInClass not implemented")
 }
+func (*UnimplementedQueryServer) DataSchema(ctx context.Context, req *QueryDataSchemaRequest) (*QueryDataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSchema not implemented")
+}
+func (*UnimplementedQueryServer) Expiration(ctx context.Context, req *QueryExpirationRequest) (*QueryExpirationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expiration not implemented")
+}
+func (*UnimplementedQueryServer) Expirations(ctx context.Context, req *QueryExpirationsRequest) (*QueryExpirationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expirations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1642,6 +2006,60 @@ func _Query_BurntNFTsInClass_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/DataSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSchema(ctx, req.(*QueryDataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Expiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpirationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Expiration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/Expiration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Expiration(ctx, req.(*QueryExpirationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Expirations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpirationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Expirations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/Expirations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Expirations(ctx, req.(*QueryExpirationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.asset.nft.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1690,6 +2108,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BurntNFTsInClass",
 			Handler:    _Query_BurntNFTsInClass_Handler,
 		},
+		{
+			MethodName: "DataSchema",
+			Handler:    _Query_DataSchema_Handler,
+		},
+		{
+			MethodName: "Expiration",
+			Handler:    _Query_Expiration_Handler,
+		},
+		{
+			MethodName: "Expirations",
+			Handler:    _Query_Expirations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/asset/nft/v1/query.proto",
@@ -2543,9 +2973,240 @@ func (m *QueryBurntNFTsInClassResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
+func (m *QueryDataSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpirationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpirationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpirationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpirationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpirationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpirationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpirationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpirationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpirationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpirationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpirationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpirationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expirations) > 0 {
+		for iNdEx := len(m.Expirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -2902,39 +3563,132 @@ func (m *QueryBurntNFTsInClassResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryDataSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryDataSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+
+func (m *QueryExpirationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpirationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Expiration.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExpirationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryExpirationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Expirations) > 0 {
+		for _, e := range m.Expirations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3628,7 +4382,557 @@ func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassFrozenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassFrozenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassFrozenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassFrozenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassFrozenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Whitelisted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3651,17 +4955,17 @@ func (m *QueryClassFrozenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassFrozenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3671,27 +4975,31 @@ func (m *QueryClassFrozenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3719,7 +5027,7 @@ func (m *QueryClassFrozenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3742,7 +5050,7 @@ func (m *QueryClassFrozenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassFrozenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3765,17 +5073,17 @@ func (m *QueryClassFrozenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassFrozenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3785,12 +5093,60 @@ func (m *QueryClassFrozenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Frozen = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3812,7 +5168,7 @@ func (m *QueryClassFrozenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3835,17 +5191,17 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3855,59 +5211,31 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3935,7 +5263,7 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3958,7 +5286,7 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3981,17 +5309,17 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassFrozenAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassFrozenAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4001,12 +5329,60 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Whitelisted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4028,7 +5404,7 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4051,10 +5427,10 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassFrozenAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassFrozenAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4087,7 +5463,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4095,39 +5471,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4155,7 +5499,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4178,7 +5522,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4201,17 +5545,17 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurntNFTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurntNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4221,31 +5565,27 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4273,7 +5613,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4296,7 +5636,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4319,53 +5659,17 @@ func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurntNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurntNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4375,24 +5679,12 @@ func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Burnt = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4414,7 +5706,7 @@ func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4437,10 +5729,10 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurntNFTsInClassRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurntNFTsInClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4473,7 +5765,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4481,7 +5773,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4509,7 +5801,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4532,7 +5824,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4555,10 +5847,10 @@ func (m *QueryClassFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassFrozenAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurntNFTsInClassResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassFrozenAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurntNFTsInClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4591,7 +5883,7 @@ func (m *QueryClassFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4599,7 +5891,7 @@ func (m *QueryClassFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4627,7 +5919,7 @@ func (m *QueryClassFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.NftIds = append(m.NftIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4650,7 +5942,7 @@ func (m *QueryClassFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4673,17 +5965,17 @@ func (m *QueryClassFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassFrozenAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassFrozenAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4693,31 +5985,77 @@ func (m *QueryClassFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4745,7 +6083,7 @@ func (m *QueryClassFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4768,7 +6106,7 @@ func (m *QueryClassFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExpirationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4791,10 +6129,10 @@ func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurntNFTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpirationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurntNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpirationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4831,7 +6169,7 @@ func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4859,7 +6197,7 @@ func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NftId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4882,7 +6220,7 @@ func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExpirationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4905,17 +6243,17 @@ func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurntNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpirationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurntNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4925,12 +6263,25 @@ func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Burnt = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4952,7 +6303,7 @@ func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExpirationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4975,10 +6326,10 @@ func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurntNFTsInClassRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpirationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurntNFTsInClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpirationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5049,6 +6400,26 @@ func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5070,7 +6441,7 @@ func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExpirationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5093,10 +6464,10 @@ func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurntNFTsInClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpirationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurntNFTsInClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpirationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5137,9 +6508,9 @@ func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expirations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5149,23 +6520,25 @@ func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NftIds = append(m.NftIds, string(dAtA[iNdEx:postIndex]))
+			m.Expirations = append(m.Expirations, NFTExpiration{})
+			if err := m.Expirations[len(m.Expirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,6 +3,7 @@ package types
 import (
 	"regexp"
 	"strings"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -49,6 +50,8 @@ type MintSettings struct {
 	URI       string
 	URIHash   string
 	Data      *codectypes.Any
+	// ExpirationTime is the optional time after which the NFT can't be sent anymore and can be burnt by anyone.
+	ExpirationTime *time.Time
 }
 
 // BuildClassID builds the non-fungible token id string from the symbol and issuer address.
@@ -14,18 +14,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -89,6 +93,8 @@ type MsgMint struct {
 	// Data can be DataBytes or DataDynamic.
 	Data      *types.Any `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 	Recipient string     `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// expiration_time is the optional time after which the NFT can't be sent anymore and can be burnt by anyone.
+	ExpirationTime *time.Time `protobuf:"bytes,8,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
 }
 
 func (m *MsgMint) Reset()         { *m = MsgMint{} }
@@ -557,6 +563,129 @@ func (m *MsgUpdateParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
 
+// MsgRegisterDataSchema defines message for the RegisterDataSchema method.
+type MsgRegisterDataSchema struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ClassID string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// schema is the JSON schema of the NFT data.
+	Schema string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *MsgRegisterDataSchema) Reset()         { *m = MsgRegisterDataSchema{} }
+func (m *MsgRegisterDataSchema) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDataSchema) ProtoMessage()    {}
+func (*MsgRegisterDataSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e850acc149a7cfa7, []int{13}
+}
+func (m *MsgRegisterDataSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDataSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDataSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDataSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDataSchema.Merge(m, src)
+}
+func (m *MsgRegisterDataSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDataSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDataSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDataSchema proto.InternalMessageInfo
+
+// MsgExtendExpiration defines message for the ExtendExpiration method.
+type MsgExtendExpiration struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ClassID string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ID      string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// expiration_time is the new expiration time, it must be after the current one.
+	ExpirationTime time.Time `protobuf:"bytes,4,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time"`
+}
+
+func (m *MsgExtendExpiration) Reset()         { *m = MsgExtendExpiration{} }
+func (m *MsgExtendExpiration) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendExpiration) ProtoMessage()    {}
+func (*MsgExtendExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e850acc149a7cfa7, []int{14}
+}
+func (m *MsgExtendExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendExpiration.Merge(m, src)
+}
+func (m *MsgExtendExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendExpiration proto.InternalMessageInfo
+
+// MsgBurnExpired defines message for the BurnExpired method.
+type MsgBurnExpired struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ClassID string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ID      string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgBurnExpired) Reset()         { *m = MsgBurnExpired{} }
+func (m *MsgBurnExpired) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnExpired) ProtoMessage()    {}
+func (*MsgBurnExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e850acc149a7cfa7, []int{15}
+}
+func (m *MsgBurnExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnExpired.Merge(m, src)
+}
+func (m *MsgBurnExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnExpired proto.InternalMessageInfo
+
 type EmptyResponse struct {
 }
 
@@ -564,7 +693,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e850acc149a7cfa7, []int{13}
+	return fileDescriptor_e850acc149a7cfa7, []int{16}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,86 +736,101 @@ func init() {
 	proto.RegisterType((*MsgAddToClassWhitelist)(nil), "coreum.asset.nft.v1.MsgAddToClassWhitelist")
 	proto.RegisterType((*MsgRemoveFromClassWhitelist)(nil), "coreum.asset.nft.v1.MsgRemoveFromClassWhitelist")
 	proto.RegisterType((*MsgUpdateParams)(nil), "coreum.asset.nft.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgRegisterDataSchema)(nil), "coreum.asset.nft.v1.MsgRegisterDataSchema")
+	proto.RegisterType((*MsgExtendExpiration)(nil), "coreum.asset.nft.v1.MsgExtendExpiration")
+	proto.RegisterType((*MsgBurnExpired)(nil), "coreum.asset.nft.v1.MsgBurnExpired")
 	proto.RegisterType((*EmptyResponse)(nil), "coreum.asset.nft.v1.EmptyResponse")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/tx.proto", fileDescriptor_e850acc149a7cfa7) }
 
 var fileDescriptor_e850acc149a7cfa7 = []byte{
-	// 1168 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0x34, 0x69, 0x26, 0xfb, 0xd3, 0x5b, 0x75, 0xdd, 0x1f, 0x24, 0xc1, 0x5b, 0x4a,
-	0x69, 0x55, 0x9b, 0xcd, 0x22, 0x10, 0x95, 0x38, 0xb4, 0x94, 0xb2, 0x91, 0x36, 0xd2, 0x62, 0x5a,
-	0x40, 0x2b, 0xa4, 0x6a, 0x6a, 0x4f, 0x9c, 0xd1, 0xd6, 0x9e, 0xc8, 0x33, 0xa9, 0x9a, 0x3d, 0x21,
-	0x8e, 0x9c, 0x38, 0x23, 0x71, 0xe0, 0x80, 0x84, 0xc4, 0xa5, 0x48, 0x5c, 0x39, 0x53, 0x69, 0x2f,
-	0x2b, 0x24, 0x24, 0xc4, 0xa1, 0x82, 0xf4, 0xd0, 0x3b, 0x7f, 0x01, 0x9a, 0x19, 0xa7, 0xb1, 0xb3,
-	0x4e, 0x6b, 0x56, 0xa2, 0xdd, 0x4b, 0x95, 0x99, 0xf7, 0xe6, 0x7b, 0xdf, 0xf7, 0xde, 0xcb, 0xcc,
-	0x6b, 0xc0, 0xac, 0x4d, 0x02, 0xd4, 0xf6, 0x4c, 0x48, 0x29, 0x62, 0xa6, 0xdf, 0x60, 0xe6, 0xde,
-	0x5d, 0x93, 0xed, 0x1b, 0xad, 0x80, 0x30, 0xa2, 0xde, 0x92, 0x56, 0x43, 0x58, 0x0d, 0xbf, 0xc1,
-	0x8c, 0xbd, 0xbb, 0xd3, 0x37, 0xa1, 0x87, 0x7d, 0x62, 0x8a, 0xbf, 0xd2, 0x6f, 0xfa, 0x95, 0x24,
-	0x14, 0xee, 0x2e, 0xcd, 0x95, 0x24, 0x73, 0x0b, 0x06, 0xd0, 0xa3, 0xa1, 0x47, 0x39, 0x91, 0x46,
-	0xa7, 0x85, 0x7a, 0x0e, 0xb7, 0x6d, 0x42, 0x3d, 0x42, 0x4d, 0x8f, 0xba, 0xdc, 0xe4, 0x51, 0x37,
-	0x34, 0x4c, 0x49, 0xc3, 0xb6, 0x58, 0x99, 0x72, 0x11, 0x9a, 0x26, 0x5c, 0xe2, 0x12, 0xb9, 0xcf,
-	0x3f, 0xf5, 0x0e, 0xb8, 0x84, 0xb8, 0xbb, 0xc8, 0x14, 0xab, 0x9d, 0x76, 0xc3, 0x84, 0x7e, 0x47,
-	0x9a, 0xf4, 0x1f, 0x33, 0xe0, 0x6a, 0x9d, 0xba, 0x35, 0x4a, 0xdb, 0xe8, 0xfd, 0x5d, 0x48, 0xa9,
-	0xfa, 0x26, 0xc8, 0x61, 0xbe, 0x0a, 0x34, 0xa5, 0xa2, 0x2c, 0x14, 0xd6, 0xb4, 0xdf, 0x7e, 0x5e,
-	0x9e, 0x08, 0x83, 0xac, 0x3a, 0x4e, 0x80, 0x28, 0xfd, 0x98, 0x05, 0xd8, 0x77, 0xad, 0xd0, 0x4f,
-	0x9d, 0x04, 0x39, 0xda, 0xf1, 0x76, 0xc8, 0xae, 0x36, 0xca, 0x4f, 0x58, 0xe1, 0x4a, 0x55, 0x41,
-	0xd6, 0x87, 0x1e, 0xd2, 0x32, 0x62, 0x57, 0x7c, 0x56, 0x2b, 0xa0, 0xe8, 0x20, 0x6a, 0x07, 0xb8,
-	0xc5, 0x30, 0xf1, 0xb5, 0xac, 0x30, 0x45, 0xb7, 0xd4, 0x29, 0x90, 0x69, 0x07, 0x58, 0x1b, 0x13,
-	0xc1, 0xf3, 0xdd, 0xa3, 0x72, 0x66, 0xcb, 0xaa, 0x59, 0x7c, 0x4f, 0x9d, 0x07, 0xe3, 0xed, 0x00,
-	0x6f, 0x37, 0x21, 0x6d, 0x6a, 0x39, 0x61, 0x2f, 0x76, 0x8f, 0xca, 0xf9, 0x2d, 0xab, 0x76, 0x1f,
-	0xd2, 0xa6, 0x95, 0x6f, 0x07, 0x98, 0x7f, 0x50, 0x17, 0x40, 0xd6, 0x81, 0x0c, 0x6a, 0xf9, 0x8a,
-	0xb2, 0x50, 0xac, 0x4e, 0x18, 0x52, 0xbe, 0xd1, 0x93, 0x6f, 0xac, 0xfa, 0x1d, 0x4b, 0x78, 0xa8,
-	0xef, 0x81, 0xf1, 0x06, 0x82, 0xac, 0x1d, 0x20, 0xaa, 0x8d, 0x57, 0x32, 0x0b, 0xd7, 0xaa, 0xaf,
-	0x1a, 0x09, 0x0d, 0x60, 0x88, 0xd4, 0x6c, 0x48, 0x4f, 0xeb, 0xf4, 0x88, 0xba, 0x01, 0xae, 0x04,
-	0xa4, 0x03, 0x77, 0x59, 0x67, 0x3b, 0x80, 0x0c, 0x69, 0x05, 0x41, 0xea, 0xce, 0xe1, 0x51, 0x79,
-	0xe4, 0xcf, 0xa3, 0xf2, 0x8c, 0xcc, 0x1a, 0x75, 0x1e, 0x1b, 0x98, 0x98, 0x1e, 0x64, 0x4d, 0xe3,
-	0x01, 0x72, 0xa1, 0xdd, 0x59, 0x47, 0xb6, 0x55, 0x0c, 0x0f, 0x5a, 0x90, 0xa1, 0x95, 0xf9, 0x2f,
-	0x4f, 0x0e, 0x16, 0xc3, 0x74, 0x7e, 0x75, 0x72, 0xb0, 0x38, 0x29, 0x82, 0xf3, 0x9e, 0x88, 0xd5,
-	0x46, 0xff, 0x61, 0x14, 0xe4, 0xeb, 0xd4, 0xad, 0x63, 0x9f, 0xf1, 0x3a, 0x51, 0xe4, 0x3b, 0x69,
-	0xea, 0x24, 0xfd, 0x78, 0xfa, 0x6c, 0x0e, 0xb3, 0x8d, 0x1d, 0x59, 0x29, 0x99, 0x3e, 0x01, 0x5d,
-	0x5b, 0xb7, 0xf2, 0xc2, 0x58, 0x73, 0xd4, 0x49, 0x30, 0x8a, 0x1d, 0x59, 0xb5, 0xb5, 0x5c, 0xf7,
-	0xa8, 0x3c, 0x5a, 0x5b, 0xb7, 0x46, 0xb1, 0xd3, 0xab, 0x4c, 0xf6, 0x9c, 0xca, 0x8c, 0xa5, 0xa8,
-	0x4c, 0xee, 0xdc, 0xca, 0xcc, 0x82, 0x42, 0x80, 0x6c, 0xdc, 0xc2, 0xc8, 0x67, 0xa2, 0x90, 0x05,
-	0xab, 0xbf, 0xb1, 0x52, 0x11, 0x09, 0x93, 0xba, 0x78, 0xc2, 0x6e, 0x44, 0x13, 0xc6, 0xd3, 0xa3,
-	0xff, 0xa3, 0x88, 0xc6, 0xde, 0x6a, 0x39, 0x90, 0xa1, 0x75, 0x8e, 0x78, 0xf1, 0x09, 0xfb, 0x10,
-	0x8c, 0x61, 0x86, 0x3c, 0xaa, 0x65, 0x2b, 0x99, 0x85, 0x62, 0x75, 0x29, 0xb1, 0xb5, 0x38, 0xb7,
-	0xf5, 0x8e, 0x0f, 0x3d, 0x6c, 0xd7, 0x7c, 0x07, 0xed, 0x23, 0xa7, 0xc6, 0x90, 0xb7, 0x96, 0xe5,
-	0x4d, 0x64, 0xc9, 0xf3, 0x61, 0x7f, 0xf4, 0xe5, 0xc6, 0xfa, 0xa3, 0x2f, 0x51, 0xff, 0x56, 0x11,
-	0xfd, 0xb1, 0xd6, 0x0e, 0xfc, 0x8b, 0x97, 0x7b, 0x76, 0x51, 0x38, 0x27, 0xfd, 0x3b, 0x05, 0x14,
-	0xea, 0xd4, 0xdd, 0x08, 0x10, 0x7a, 0x82, 0x2e, 0x81, 0xa1, 0x3e, 0xc0, 0x50, 0x8d, 0x32, 0x94,
-	0xac, 0xf4, 0xef, 0x15, 0x50, 0xe4, 0x59, 0xf5, 0x1b, 0x97, 0xc5, 0x72, 0x6e, 0x80, 0xe5, 0x44,
-	0xac, 0xda, 0x21, 0x2f, 0xfd, 0x57, 0x05, 0x5c, 0xab, 0x53, 0x57, 0xde, 0x4c, 0xff, 0x37, 0xd5,
-	0x2a, 0xc8, 0x43, 0xdb, 0x26, 0x6d, 0x9f, 0x85, 0x7c, 0x87, 0x43, 0xf7, 0x1c, 0x57, 0x5e, 0x1f,
-	0x90, 0x71, 0x3b, 0x2a, 0x23, 0x42, 0x5b, 0x7f, 0xaa, 0x80, 0x1b, 0xbd, 0xad, 0x0b, 0x48, 0xfb,
-	0x8b, 0x68, 0x79, 0x63, 0x40, 0xcb, 0xd4, 0x73, 0x5a, 0x4e, 0xeb, 0xf2, 0x54, 0x01, 0x37, 0xeb,
-	0xd4, 0x5d, 0x75, 0x9c, 0x4d, 0xf2, 0x69, 0x13, 0x33, 0xb4, 0x8b, 0xe9, 0x65, 0xdc, 0xd6, 0x5a,
-	0x5f, 0xa6, 0x7c, 0x65, 0x4f, 0xc5, 0x2c, 0x0e, 0x88, 0x99, 0x8e, 0x8a, 0x89, 0xf3, 0xd6, 0x7f,
-	0x57, 0xc0, 0x64, 0x9d, 0xba, 0x16, 0xf2, 0xc8, 0x1e, 0xda, 0x08, 0x88, 0xf7, 0x72, 0x4a, 0x32,
-	0x07, 0x24, 0x95, 0xa3, 0x92, 0x12, 0xc8, 0xeb, 0xbf, 0x48, 0x5d, 0x42, 0xad, 0x88, 0x7f, 0x11,
-	0xba, 0xb4, 0x81, 0xce, 0x4b, 0xc9, 0x3f, 0x81, 0x24, 0xff, 0xf6, 0xcf, 0xc4, 0xa4, 0xbd, 0x04,
-	0x22, 0xde, 0x1a, 0x10, 0x31, 0x97, 0x5c, 0x84, 0x01, 0x25, 0x3f, 0x29, 0xe0, 0xfa, 0xe9, 0x2b,
-	0xf6, 0x50, 0x4c, 0xc8, 0xea, 0xdb, 0xa0, 0x00, 0xdb, 0xac, 0x49, 0x02, 0xcc, 0x3a, 0xe7, 0x0a,
-	0xe8, 0xbb, 0xaa, 0xef, 0x82, 0x9c, 0x9c, 0xb1, 0x85, 0x82, 0x62, 0x75, 0x26, 0xf1, 0xc5, 0x95,
-	0x41, 0xc2, 0x17, 0x36, 0x3c, 0xb0, 0xb2, 0xc4, 0xc9, 0xf7, 0xa1, 0x38, 0x7f, 0xed, 0xf9, 0x57,
-	0x56, 0x1e, 0xd5, 0xaf, 0x83, 0xab, 0x1f, 0x78, 0x2d, 0xd6, 0xb1, 0x10, 0x6d, 0x11, 0x9f, 0xa2,
-	0xea, 0x37, 0x05, 0x90, 0xa9, 0x53, 0x57, 0xdd, 0x04, 0x20, 0x32, 0x4a, 0xeb, 0x89, 0xe1, 0x63,
-	0x23, 0xdd, 0x74, 0xb2, 0x4f, 0x0c, 0x5d, 0xbd, 0x0f, 0xb2, 0x62, 0xe4, 0x9b, 0x1d, 0x86, 0xc7,
-	0xad, 0xa9, 0x90, 0x36, 0x01, 0x88, 0x4c, 0x44, 0x43, 0xf9, 0xf5, 0x7d, 0xd2, 0xf2, 0x13, 0x23,
-	0xc7, 0x50, 0x7e, 0xdc, 0x9a, 0x0a, 0xe9, 0x01, 0xc8, 0x85, 0x6f, 0x59, 0x69, 0x18, 0x96, 0xb4,
-	0xa7, 0x42, 0x7b, 0x08, 0xc6, 0x4f, 0xdf, 0x93, 0xca, 0x50, 0xad, 0xa1, 0x47, 0x2a, 0xc4, 0xcf,
-	0xc1, 0xb5, 0x81, 0x8b, 0x7d, 0x7e, 0x18, 0x6e, 0xdc, 0x2f, 0x15, 0x7a, 0x03, 0xdc, 0x4a, 0xba,
-	0x68, 0x97, 0x86, 0x85, 0x48, 0x70, 0x4e, 0x1b, 0x27, 0xe9, 0xe2, 0x5b, 0x3a, 0x53, 0x4a, 0xdc,
-	0x39, 0x55, 0x9c, 0x16, 0xd0, 0x86, 0x5f, 0x50, 0xe7, 0x8b, 0x7a, 0x81, 0x88, 0x9f, 0x80, 0x62,
-	0x74, 0x20, 0xba, 0x33, 0x2c, 0x48, 0xc4, 0x29, 0x15, 0xee, 0x23, 0x70, 0x35, 0x3e, 0x9e, 0xbc,
-	0x76, 0x26, 0xf2, 0x7f, 0xea, 0xa9, 0xcf, 0xc0, 0x95, 0xd8, 0xe5, 0x37, 0x77, 0xf6, 0xb7, 0x52,
-	0x7a, 0xa5, 0x41, 0x9e, 0x1e, 0xfb, 0xe2, 0xe4, 0x60, 0x51, 0x59, 0xfb, 0xe8, 0xf0, 0xef, 0xd2,
-	0xc8, 0x61, 0xb7, 0xa4, 0x3c, 0xeb, 0x96, 0x94, 0xbf, 0xba, 0x25, 0xe5, 0xeb, 0xe3, 0xd2, 0xc8,
-	0xb3, 0xe3, 0xd2, 0xc8, 0x1f, 0xc7, 0xa5, 0x91, 0x47, 0xf7, 0x5c, 0xcc, 0x9a, 0xed, 0x1d, 0xc3,
-	0x26, 0x9e, 0xc9, 0xc8, 0x63, 0xe4, 0xe3, 0x27, 0x68, 0x79, 0xdf, 0x64, 0xfb, 0xcb, 0x76, 0x13,
-	0x62, 0xdf, 0xdc, 0x7b, 0xc7, 0xdc, 0x8f, 0xfc, 0x48, 0x21, 0x7e, 0xa1, 0xd8, 0xc9, 0x89, 0xff,
-	0xd8, 0xee, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x04, 0xc8, 0x76, 0x13, 0x4c, 0x11, 0x00, 0x00,
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x41, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x8e, 0x9d, 0x8c, 0xdb, 0xb4, 0xdd, 0xe6, 0x9f, 0x6e, 0xd3, 0xfc, 0x6d, 0xb3,
+	0x2d, 0x25, 0x24, 0x74, 0x97, 0xa6, 0x08, 0x44, 0x24, 0x0e, 0x35, 0x69, 0xa8, 0xa5, 0x5a, 0x2a,
+	0xdb, 0x14, 0x50, 0x85, 0x14, 0x26, 0xbb, 0xe3, 0xf5, 0xa8, 0xd9, 0x5d, 0x6b, 0x67, 0x1c, 0xd9,
+	0x3d, 0x21, 0xc4, 0x89, 0x53, 0xbf, 0x00, 0x07, 0x0e, 0x9c, 0x7a, 0x29, 0x12, 0x17, 0x90, 0x38,
+	0x53, 0xa9, 0x97, 0x0a, 0x09, 0x09, 0x71, 0x08, 0xe0, 0x1e, 0x7a, 0x87, 0x2f, 0x80, 0x66, 0x66,
+	0x6d, 0xef, 0x6e, 0xd6, 0xf6, 0x52, 0xa9, 0x49, 0x2f, 0xd6, 0xce, 0xbc, 0x37, 0xef, 0xfd, 0x7e,
+	0xef, 0xbd, 0xd9, 0xf7, 0xd6, 0x60, 0xc9, 0xf4, 0x7c, 0xd4, 0x76, 0x74, 0x48, 0x08, 0xa2, 0xba,
+	0xdb, 0xa0, 0xfa, 0xde, 0x65, 0x9d, 0x76, 0xb4, 0x96, 0xef, 0x51, 0x4f, 0x3e, 0x2d, 0xa4, 0x1a,
+	0x97, 0x6a, 0x6e, 0x83, 0x6a, 0x7b, 0x97, 0x17, 0x4f, 0x41, 0x07, 0xbb, 0x9e, 0xce, 0x7f, 0x85,
+	0xde, 0xe2, 0xff, 0x93, 0xac, 0x30, 0x75, 0x21, 0xae, 0x24, 0x89, 0x5b, 0xd0, 0x87, 0x0e, 0x09,
+	0x34, 0xca, 0x89, 0x30, 0xba, 0x2d, 0xd4, 0x57, 0x38, 0x63, 0x7a, 0xc4, 0xf1, 0x88, 0xee, 0x10,
+	0x9b, 0x89, 0x1c, 0x62, 0x07, 0x82, 0xb3, 0x42, 0xb0, 0xcd, 0x57, 0xba, 0x58, 0x04, 0xa2, 0x79,
+	0xdb, 0xb3, 0x3d, 0xb1, 0xcf, 0x9e, 0xfa, 0x07, 0x6c, 0xcf, 0xb3, 0x77, 0x91, 0xce, 0x57, 0x3b,
+	0xed, 0x86, 0x0e, 0xdd, 0x6e, 0x1f, 0x45, 0x5c, 0x44, 0xb1, 0x83, 0x08, 0x85, 0x4e, 0x4b, 0x28,
+	0xa8, 0x0f, 0xb2, 0xe0, 0x78, 0x9d, 0xd8, 0x35, 0x42, 0xda, 0xe8, 0xfd, 0x5d, 0x48, 0x88, 0xfc,
+	0x26, 0xc8, 0x63, 0xb6, 0xf2, 0x15, 0xa9, 0x22, 0x2d, 0xcf, 0x56, 0x95, 0x5f, 0xbe, 0xbf, 0x34,
+	0x1f, 0xa0, 0xb8, 0x6a, 0x59, 0x3e, 0x22, 0xe4, 0x16, 0xf5, 0xb1, 0x6b, 0x1b, 0x81, 0x9e, 0xbc,
+	0x00, 0xf2, 0xa4, 0xeb, 0xec, 0x78, 0xbb, 0x4a, 0x86, 0x9d, 0x30, 0x82, 0x95, 0x2c, 0x83, 0x9c,
+	0x0b, 0x1d, 0xa4, 0x64, 0xf9, 0x2e, 0x7f, 0x96, 0x2b, 0xa0, 0x68, 0x21, 0x62, 0xfa, 0xb8, 0x45,
+	0xb1, 0xe7, 0x2a, 0x39, 0x2e, 0x0a, 0x6f, 0xc9, 0x67, 0x41, 0xb6, 0xed, 0x63, 0x65, 0x9a, 0x3b,
+	0x2f, 0xf4, 0xf6, 0xcb, 0xd9, 0xdb, 0x46, 0xcd, 0x60, 0x7b, 0xf2, 0x45, 0x30, 0xd3, 0xf6, 0xf1,
+	0x76, 0x13, 0x92, 0xa6, 0x92, 0xe7, 0xf2, 0x62, 0x6f, 0xbf, 0x5c, 0xb8, 0x6d, 0xd4, 0xae, 0x43,
+	0xd2, 0x34, 0x0a, 0x6d, 0x1f, 0xb3, 0x07, 0x79, 0x19, 0xe4, 0x2c, 0x48, 0xa1, 0x52, 0xa8, 0x48,
+	0xcb, 0xc5, 0xb5, 0x79, 0x4d, 0x04, 0x41, 0xeb, 0x07, 0x41, 0xbb, 0xea, 0x76, 0x0d, 0xae, 0x21,
+	0xbf, 0x07, 0x66, 0x1a, 0x08, 0xd2, 0xb6, 0x8f, 0x88, 0x32, 0x53, 0xc9, 0x2e, 0xcf, 0xad, 0xbd,
+	0xa2, 0x25, 0x54, 0x88, 0xc6, 0x43, 0xb3, 0x29, 0x34, 0x8d, 0xc1, 0x11, 0x79, 0x13, 0x1c, 0xf3,
+	0xbd, 0x2e, 0xdc, 0xa5, 0xdd, 0x6d, 0x1f, 0x52, 0xa4, 0xcc, 0x72, 0x50, 0xe7, 0x1f, 0xed, 0x97,
+	0xa7, 0x7e, 0xdf, 0x2f, 0x9f, 0x13, 0x51, 0x23, 0xd6, 0x5d, 0x0d, 0x7b, 0xba, 0x03, 0x69, 0x53,
+	0xbb, 0x81, 0x6c, 0x68, 0x76, 0x37, 0x90, 0x69, 0x14, 0x83, 0x83, 0x06, 0xa4, 0x68, 0xfd, 0xe2,
+	0x17, 0xcf, 0x1e, 0xae, 0x04, 0xe1, 0xfc, 0xea, 0xd9, 0xc3, 0x95, 0x05, 0xee, 0x9c, 0x15, 0x4d,
+	0x24, 0x37, 0xea, 0x3f, 0x19, 0x50, 0xa8, 0x13, 0xbb, 0x8e, 0x5d, 0xca, 0xf2, 0x44, 0x90, 0x6b,
+	0xa5, 0xc9, 0x93, 0xd0, 0x63, 0xe1, 0x33, 0x99, 0x99, 0x6d, 0x6c, 0x29, 0x99, 0x61, 0xf8, 0xb8,
+	0xe9, 0xda, 0x86, 0x51, 0xe0, 0xc2, 0x9a, 0x25, 0x2f, 0x80, 0x0c, 0xb6, 0x44, 0xd6, 0xaa, 0xf9,
+	0xde, 0x7e, 0x39, 0x53, 0xdb, 0x30, 0x32, 0xd8, 0xea, 0x67, 0x26, 0x37, 0x21, 0x33, 0xd3, 0x29,
+	0x32, 0x93, 0x9f, 0x98, 0x99, 0x25, 0x30, 0xeb, 0x23, 0x13, 0xb7, 0x30, 0x72, 0x29, 0x4f, 0xe4,
+	0xac, 0x31, 0xdc, 0x90, 0x6b, 0xe0, 0x04, 0xea, 0xb4, 0xb0, 0x0f, 0x59, 0xc9, 0x6c, 0xb3, 0xa2,
+	0x56, 0x66, 0xb8, 0xc9, 0xc5, 0x03, 0x26, 0xb7, 0xfa, 0x15, 0x5f, 0xcd, 0xdd, 0xff, 0xa3, 0x2c,
+	0x19, 0x73, 0xc3, 0x83, 0x4c, 0xb4, 0x5e, 0xe1, 0xb1, 0x17, 0x21, 0x62, 0xb1, 0x3f, 0x19, 0x8e,
+	0x3d, 0x8b, 0xb4, 0xfa, 0xb7, 0xc4, 0xef, 0xc8, 0xed, 0x96, 0x05, 0x29, 0xda, 0x60, 0xe0, 0x0e,
+	0x3f, 0xf6, 0x1f, 0x80, 0x69, 0x4c, 0x91, 0x43, 0x94, 0x5c, 0x25, 0xbb, 0x5c, 0x5c, 0x5b, 0x4d,
+	0xac, 0x52, 0x86, 0x6d, 0xa3, 0xeb, 0x42, 0x07, 0x9b, 0x35, 0xd7, 0x42, 0x1d, 0x64, 0xd5, 0x28,
+	0x72, 0xaa, 0x39, 0x56, 0x8f, 0x86, 0x38, 0x1f, 0x94, 0xda, 0x90, 0x6e, 0xa4, 0xd4, 0x86, 0x14,
+	0xd5, 0xaf, 0x25, 0x5e, 0x6a, 0xd5, 0xb6, 0xef, 0x1e, 0x3e, 0xdd, 0xf1, 0x49, 0x61, 0x98, 0xd4,
+	0x6f, 0x24, 0x30, 0x5b, 0x27, 0xf6, 0xa6, 0x8f, 0xd0, 0x3d, 0x74, 0x04, 0x08, 0xd5, 0x18, 0x42,
+	0x39, 0x8c, 0x50, 0xa0, 0x52, 0xbf, 0x95, 0x40, 0x91, 0x45, 0xd5, 0x6d, 0x1c, 0x15, 0xca, 0x0b,
+	0x31, 0x94, 0xf3, 0x91, 0x6c, 0x07, 0xb8, 0xd4, 0x9f, 0x25, 0x30, 0x57, 0x27, 0xb6, 0x78, 0xc9,
+	0xbd, 0x68, 0xa8, 0x6b, 0xa0, 0x00, 0x4d, 0xd3, 0x6b, 0xbb, 0x54, 0xc9, 0x4e, 0x30, 0xdd, 0x57,
+	0x5c, 0x7f, 0x2d, 0x46, 0xe3, 0x4c, 0x98, 0x46, 0x08, 0xb6, 0xfa, 0x58, 0x02, 0x27, 0xfb, 0x5b,
+	0x87, 0x10, 0xf6, 0xe7, 0xe1, 0xf2, 0x7a, 0x8c, 0xcb, 0xd9, 0x03, 0x5c, 0x06, 0x79, 0x79, 0x2c,
+	0x81, 0x53, 0x75, 0x62, 0x5f, 0xb5, 0xac, 0x2d, 0xef, 0xe3, 0x26, 0xa6, 0x68, 0x17, 0x93, 0xa3,
+	0x78, 0xf1, 0x2b, 0x43, 0x9a, 0xa2, 0x61, 0x0f, 0xc8, 0xac, 0xc4, 0xc8, 0x2c, 0x86, 0xc9, 0x44,
+	0x71, 0xab, 0xbf, 0x4a, 0x60, 0xa1, 0x4e, 0x6c, 0x03, 0x39, 0xde, 0x1e, 0xda, 0xf4, 0x3d, 0xe7,
+	0xe5, 0xa4, 0xa4, 0xc7, 0x28, 0x95, 0xc3, 0x94, 0x12, 0xc0, 0xab, 0x3f, 0x09, 0x5e, 0x9c, 0x2d,
+	0xf7, 0x7f, 0x18, 0xbc, 0x94, 0x58, 0xe5, 0xa5, 0xc4, 0x9f, 0x00, 0x92, 0xdd, 0xfe, 0x73, 0x11,
+	0x6a, 0x2f, 0x01, 0x89, 0xb7, 0x62, 0x24, 0x2e, 0x24, 0x27, 0x21, 0xc6, 0xe4, 0x3b, 0x09, 0x9c,
+	0x18, 0x74, 0xb1, 0x9b, 0x7c, 0x1a, 0x97, 0xdf, 0x06, 0xb3, 0xb0, 0x4d, 0x9b, 0x9e, 0x8f, 0x69,
+	0x77, 0x22, 0x81, 0xa1, 0xaa, 0xfc, 0x2e, 0xc8, 0x8b, 0x79, 0x9e, 0x33, 0x28, 0xae, 0x9d, 0x4b,
+	0xec, 0xb8, 0xc2, 0x49, 0xd0, 0x61, 0x83, 0x03, 0xeb, 0xab, 0x0c, 0xfc, 0xd0, 0x14, 0xc3, 0xaf,
+	0x1c, 0xec, 0xb2, 0xe2, 0xa8, 0xfa, 0x83, 0x04, 0xfe, 0xc7, 0x39, 0xd9, 0x98, 0x50, 0xe4, 0xb3,
+	0xde, 0x7b, 0xcb, 0x6c, 0x22, 0xe7, 0xc5, 0x0e, 0x19, 0x79, 0xc2, 0x7d, 0x04, 0x61, 0x0f, 0x56,
+	0xeb, 0x5a, 0x2c, 0xea, 0xa5, 0x68, 0xd4, 0xe3, 0x08, 0xd5, 0x2f, 0x33, 0xe0, 0x74, 0x9d, 0xd8,
+	0xd7, 0x3a, 0x14, 0xb9, 0xd6, 0xb5, 0xc1, 0x58, 0x75, 0x04, 0xd7, 0xb9, 0x7e, 0x70, 0x1e, 0xcc,
+	0x4d, 0x9c, 0x07, 0x67, 0x58, 0xd6, 0x12, 0x67, 0xc2, 0x37, 0x62, 0x81, 0x58, 0x0a, 0x07, 0x22,
+	0x4e, 0x57, 0x7d, 0x20, 0xda, 0x27, 0x1b, 0x4b, 0xf8, 0x2e, 0xb2, 0x8e, 0xa0, 0xd3, 0x8f, 0x6d,
+	0x91, 0x21, 0x68, 0xea, 0x09, 0x70, 0xfc, 0x9a, 0xd3, 0xa2, 0x5d, 0x03, 0x91, 0x96, 0xe7, 0x12,
+	0xb4, 0xf6, 0x63, 0x11, 0x64, 0xeb, 0xc4, 0x96, 0xb7, 0x00, 0x08, 0x7d, 0x06, 0xaa, 0x89, 0xf5,
+	0x1e, 0xf9, 0x1c, 0x59, 0x4c, 0xd6, 0x89, 0x58, 0x97, 0xaf, 0x83, 0x1c, 0xff, 0x5c, 0x59, 0x1a,
+	0x65, 0x8f, 0x49, 0x53, 0x59, 0xda, 0x02, 0x20, 0x34, 0x82, 0x8f, 0xc4, 0x37, 0xd4, 0x49, 0x8b,
+	0x8f, 0xcf, 0xb8, 0x23, 0xf1, 0x31, 0x69, 0x2a, 0x4b, 0x37, 0x40, 0x3e, 0x18, 0x9e, 0x4a, 0xa3,
+	0x6c, 0x09, 0x79, 0x2a, 0x6b, 0x37, 0xc1, 0xcc, 0x60, 0x80, 0xa9, 0x8c, 0xe4, 0xea, 0x36, 0xd2,
+	0x5b, 0xfc, 0x14, 0xcc, 0xc5, 0x26, 0x89, 0x8b, 0xa3, 0xec, 0x46, 0xf5, 0x52, 0x59, 0x6f, 0x80,
+	0xd3, 0x49, 0x9d, 0x7d, 0x75, 0x94, 0x8b, 0x04, 0xe5, 0xb4, 0x7e, 0x92, 0x3a, 0xed, 0xea, 0x58,
+	0x2a, 0x51, 0xe5, 0x54, 0x7e, 0x5a, 0x40, 0x19, 0xdd, 0x11, 0x27, 0x93, 0x7a, 0x0e, 0x8f, 0x1f,
+	0x81, 0x62, 0x78, 0x02, 0x3f, 0x3f, 0xca, 0x49, 0x48, 0x29, 0x95, 0xdd, 0x3b, 0xe0, 0x78, 0x74,
+	0x1e, 0x7e, 0x75, 0xac, 0xe5, 0xff, 0x54, 0x53, 0x9f, 0x80, 0x63, 0x91, 0x6e, 0x7b, 0x61, 0xfc,
+	0xad, 0x14, 0x5a, 0xa9, 0x2c, 0x5b, 0x40, 0x4e, 0xe8, 0x89, 0x2b, 0xa3, 0x23, 0x1f, 0xd7, 0x4d,
+	0xe5, 0xe5, 0x33, 0x70, 0xf2, 0x40, 0xf7, 0x5a, 0x1e, 0xe5, 0x23, 0xae, 0x99, 0x36, 0xab, 0xe1,
+	0xc6, 0x70, 0x7e, 0xdc, 0x6b, 0x26, 0x50, 0x4a, 0x63, 0x77, 0x71, 0xfa, 0xf3, 0x67, 0x0f, 0x57,
+	0xa4, 0xea, 0x87, 0x8f, 0xfe, 0x2a, 0x4d, 0x3d, 0xea, 0x95, 0xa4, 0x27, 0xbd, 0x92, 0xf4, 0x67,
+	0xaf, 0x24, 0xdd, 0x7f, 0x5a, 0x9a, 0x7a, 0xf2, 0xb4, 0x34, 0xf5, 0xdb, 0xd3, 0xd2, 0xd4, 0x9d,
+	0x2b, 0x36, 0xa6, 0xcd, 0xf6, 0x8e, 0x66, 0x7a, 0x8e, 0x4e, 0xbd, 0xbb, 0xc8, 0xc5, 0xf7, 0xd0,
+	0xa5, 0x8e, 0x4e, 0x3b, 0x97, 0xcc, 0x26, 0xc4, 0xae, 0xbe, 0xf7, 0x8e, 0xde, 0x09, 0xfd, 0x43,
+	0xc9, 0xff, 0x9e, 0xdc, 0xc9, 0xf3, 0x56, 0x79, 0xe5, 0xdf, 0x01, 0x00, 0x72, 0x37, 0x37, 0x37,
+	0x49, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -735,6 +879,13 @@ type MsgClient interface {
 	// UpdateParams is a governance operation that sets the parameters of the module.
 	// NOTE: all parameters must be provided.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// RegisterDataSchema registers the JSON schema the data of the NFTs in the class must match.
+	// NOTE: the schema can be registered once, while the class doesn't have NFTs.
+	RegisterDataSchema(ctx context.Context, in *MsgRegisterDataSchema, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ExtendExpiration moves the expiration time of the NFT forward.
+	ExtendExpiration(ctx context.Context, in *MsgExtendExpiration, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// BurnExpired burns the expired NFT, it can be sent by any account.
+	BurnExpired(ctx context.Context, in *MsgBurnExpired, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type msgClient struct {
@@ -862,6 +1013,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterDataSchema(ctx context.Context, in *MsgRegisterDataSchema, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Msg/RegisterDataSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendExpiration(ctx context.Context, in *MsgExtendExpiration, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Msg/ExtendExpiration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnExpired(ctx context.Context, in *MsgBurnExpired, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Msg/BurnExpired", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// IssueClass creates new non-fungible token class.
@@ -898,6 +1076,13 @@ type MsgServer interface {
 	// UpdateParams is a governance operation that sets the parameters of the module.
 	// NOTE: all parameters must be provided.
 	UpdateParams(context.Context, *MsgUpdateParams) (*EmptyResponse, error)
+	// RegisterDataSchema registers the JSON schema the data of the NFTs in the class must match.
+	// NOTE: the schema can be registered once, while the class doesn't have NFTs.
+	RegisterDataSchema(context.Context, *MsgRegisterDataSchema) (*EmptyResponse, error)
+	// ExtendExpiration moves the expiration time of the NFT forward.
+	ExtendExpiration(context.Context, *MsgExtendExpiration) (*EmptyResponse, error)
+	// BurnExpired burns the expired NFT, it can be sent by any account.
+	BurnExpired(context.Context, *MsgBurnExpired) (*EmptyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -943,6 +1128,15 @@ func (*UnimplementedMsgServer) ClassUnfreeze(ctx context.Context, req *MsgClassU
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterDataSchema(ctx context.Context, req *MsgRegisterDataSchema) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDataSchema not implemented")
+}
+func (*UnimplementedMsgServer) ExtendExpiration(ctx context.Context, req *MsgExtendExpiration) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendExpiration not implemented")
+}
+func (*UnimplementedMsgServer) BurnExpired(ctx context.Context, req *MsgBurnExpired) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnExpired not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1182,6 +1376,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterDataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDataSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Msg/RegisterDataSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDataSchema(ctx, req.(*MsgRegisterDataSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendExpiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendExpiration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendExpiration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Msg/ExtendExpiration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendExpiration(ctx, req.(*MsgExtendExpiration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnExpired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnExpired)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnExpired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Msg/BurnExpired",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnExpired(ctx, req.(*MsgBurnExpired))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.asset.nft.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1238,6 +1486,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterDataSchema",
+			Handler:    _Msg_RegisterDataSchema_Handler,
+		},
+		{
+			MethodName: "ExtendExpiration",
+			Handler:    _Msg_ExtendExpiration_Handler,
+		},
+		{
+			MethodName: "BurnExpired",
+			Handler:    _Msg_BurnExpired_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/asset/nft/v1/tx.proto",
@@ -1368,6 +1628,16 @@ func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Recipient) > 0 {
 		i -= len(m.Recipient)
 		copy(dAtA[i:], m.Recipient)
@@ -1933,7 +2203,7 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterDataSchema) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1943,82 +2213,222 @@ func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterDataSchema) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterDataSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgExtendExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgIssueClass) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgExtendExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	l = len(m.URIHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.RoyaltyRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBurnExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgIssueClass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.RoyaltyRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -2047,6 +2457,10 @@ func (m *MsgMint) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2289,6 +2703,71 @@ func (m *MsgUpdateParams) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterDataSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExtendExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *EmptyResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2942,6 +3421,42 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			}
 			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4636,6 +5151,477 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterDataSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDataSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDataSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendExpiration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendExpiration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,35 @@
+package ante
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+// BidDecorator marks the context of the transaction containing the bid as its only message, so the bid might be paid,
+// and refuses the transactions containing the bid together with other messages.
+type BidDecorator struct{}
+
+// NewBidDecorator creates ante decorator marking the bid transactions.
+func NewBidDecorator() BidDecorator {
+	return BidDecorator{}
+}
+
+// AnteHandle handles transaction in ante decorator.
+func (bd BidDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		if _, ok := msg.(*types.MsgAuctionBid); !ok {
+			continue
+		}
+		if len(msgs) != 1 {
+			return ctx, sdkerrors.Wrap(types.ErrInvalidBid, "bid must be the only message of the transaction")
+		}
+		return next(types.WithBidTx(ctx), tx, simulate)
+	}
+
+	return next(ctx, tx, simulate)
+}
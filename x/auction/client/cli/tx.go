@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      types.ModuleName + " transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdCommitBid(),
+		CmdAuctionBid(),
+	)
+
+	return cmd
+}
+
+// CmdCommitBid returns CommitBid cobra command.
+func CmdCommitBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-bid [bid] [salt] [signed_tx_file]... --from [bidder]",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "Commit the sealed bid for the top of the next block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit the sealed bid for the top of the next block.
+Only the hash of the bid, the bundle and the hex encoded salt is published. The bid must be revealed by the "bid"
+command with the same arguments in the next block, so the salt must be random and kept secret until then.
+
+Example:
+$ %s tx %s commit-bid 10000000%s $(openssl rand -hex 32) signed-tx1.json signed-tx2.json --from [bidder]
+`,
+				version.AppName, types.ModuleName, constant.DenomTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			bid, salt, transactions, err := parseBidArgs(clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+			msg := &types.MsgCommitBid{
+				Bidder:     bidder.String(),
+				Commitment: types.BidCommitment(bidder, bid, transactions, salt),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAuctionBid returns AuctionBid cobra command.
+func CmdAuctionBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid [bid] [salt] [signed_tx_file]... --from [bidder]",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "Reveal the bid for the top of the block with the bundle of signed transactions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal the bid for the top of the block with the bundle of signed transactions.
+The bid must be committed by the "commit-bid" command with the same arguments in the previous block.
+The transactions are read from the JSON files produced by the "tx sign" command and executed in the given order
+right after the bid transaction. The bid is paid only if it wins the auction.
+
+Example:
+$ %s tx %s bid 10000000%s [salt] signed-tx1.json signed-tx2.json --from [bidder]
+`,
+				version.AppName, types.ModuleName, constant.DenomTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			bid, salt, transactions, err := parseBidArgs(clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAuctionBid{
+				Bidder:       clientCtx.GetFromAddress().String(),
+				Bid:          bid,
+				Transactions: transactions,
+				Salt:         salt,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseBidArgs parses the bid, the hex encoded salt and the bundle of the transactions read from the files.
+func parseBidArgs(clientCtx client.Context, args []string) (sdk.Coin, []byte, [][]byte, error) {
+	bid, err := sdk.ParseCoinNormalized(args[0])
+	if err != nil {
+		return sdk.Coin{}, nil, nil, errors.Wrap(err, "invalid bid")
+	}
+
+	salt, err := hex.DecodeString(args[1])
+	if err != nil {
+		return sdk.Coin{}, nil, nil, errors.Wrap(err, "invalid salt")
+	}
+
+	transactions := make([][]byte, 0, len(args)-2)
+	for _, file := range args[2:] {
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return sdk.Coin{}, nil, nil, errors.Wrapf(err, "failed to read transaction file %s", file)
+		}
+		signedTx, err := clientCtx.TxConfig.TxJSONDecoder()(bz)
+		if err != nil {
+			return sdk.Coin{}, nil, nil, errors.Wrapf(err, "failed to decode transaction file %s", file)
+		}
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(signedTx)
+		if err != nil {
+			return sdk.Coin{}, nil, nil, errors.Wrapf(err, "failed to encode transaction from file %s", file)
+		}
+		transactions = append(transactions, txBytes)
+	}
+
+	return bid, salt, transactions, nil
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+// PayBid pays the winning bid. The proposer fee is sent to the operator of the validator which proposed the block,
+// the rest of the bid is sent to the community pool. If the proposer is unknown, the whole bid is sent to the
+// community pool.
+func (k Keeper) PayBid(ctx context.Context, bidder sdk.AccAddress, bid sdk.Coin, bundleSize int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := params.ValidateBid(bid, bundleSize); err != nil {
+		return err
+	}
+
+	proposer, err := k.getProposer(ctx)
+	if err != nil {
+		return err
+	}
+
+	proposerReward := sdk.NewCoin(bid.Denom, sdkmath.ZeroInt())
+	if proposer != nil {
+		proposerReward.Amount = params.ProposerFee.MulInt(bid.Amount).TruncateInt()
+	}
+	if proposerReward.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, bidder, sdk.AccAddress(proposer), sdk.NewCoins(proposerReward)); err != nil {
+			return errorsmod.Wrap(err, "failed to pay the proposer reward")
+		}
+	}
+
+	communityPoolAmount := bid.Sub(proposerReward)
+	if communityPoolAmount.IsPositive() {
+		if err := k.distributionKeeper.FundCommunityPool(
+			ctx, sdk.NewCoins(communityPoolAmount), bidder,
+		); err != nil {
+			return errorsmod.Wrap(err, "failed to fund the community pool")
+		}
+	}
+
+	var proposerAddress string
+	if proposer != nil {
+		proposerAddress = proposer.String()
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAuctionWon{
+		Bidder:              bidder.String(),
+		Bid:                 bid,
+		BundleSize:          uint32(bundleSize),
+		Proposer:            proposerAddress,
+		ProposerReward:      proposerReward,
+		CommunityPoolAmount: communityPoolAmount,
+	})
+}
+
+// ValidateBidderFunds checks that the bidder is able to pay the bid and the fee of the bid transaction.
+func (k Keeper) ValidateBidderFunds(ctx context.Context, bidder sdk.AccAddress, required sdk.Coins) error {
+	spendable := k.bankKeeper.SpendableCoins(ctx, bidder)
+	if !spendable.IsAllGTE(required) {
+		return errorsmod.Wrapf(types.ErrInvalidBid, "insufficient funds to pay %s, spendable %s", required, spendable)
+	}
+	return nil
+}
+
+func (k Keeper) getProposer(ctx context.Context) (sdk.ValAddress, error) {
+	consAddr := sdk.ConsAddress(sdk.UnwrapSDKContext(ctx).BlockHeader().ProposerAddress)
+	if consAddr.Empty() {
+		return nil, nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return sdk.ValAddressFromBech32(validator.GetOperator())
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/auction/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+func TestAuctionBid(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockHeight(10)
+	auctionKeeper := testApp.AuctionKeeper
+	msgServer := keeper.NewMsgServer(auctionKeeper)
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	operator, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, operator, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000_000))))
+	validator, err := testApp.AddValidator(ctx, operator, sdk.NewInt64Coin(bondDenom, 10_000_000), nil)
+	requireT.NoError(err)
+	consAddr, err := validator.GetConsAddr()
+	requireT.NoError(err)
+
+	bidder, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, bidder, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000))))
+
+	params := types.DefaultParams()
+	params.ReservePrice = sdk.NewInt64Coin(bondDenom, 100)
+	params.ProposerFee = sdkmath.LegacyNewDecWithPrec(25, 2)
+	params.MaxBundleSize = 1
+	requireT.NoError(auctionKeeper.SetParams(ctx, params))
+
+	salt := bytes.Repeat([]byte{0x01}, types.MinBidSaltLength)
+	bid := func(ctx sdk.Context, amount sdk.Coin, bundleSize int) error {
+		transactions := make([][]byte, bundleSize)
+		requireT.NoError(auctionKeeper.BidCommitments.Set(
+			ctx,
+			collections.Join(ctx.BlockHeight()-1, bidder),
+			types.BidCommitment(bidder, amount, transactions, salt),
+		))
+		_, err := msgServer.AuctionBid(types.WithBidTx(ctx), &types.MsgAuctionBid{
+			Bidder:       bidder.String(),
+			Bid:          amount,
+			Transactions: transactions,
+			Salt:         salt,
+		})
+		return err
+	}
+
+	// the auction is disabled
+	requireT.ErrorIs(bid(ctx, sdk.NewInt64Coin(bondDenom, 100), 1), types.ErrAuctionDisabled)
+
+	params.Enabled = true
+	requireT.NoError(auctionKeeper.SetParams(ctx, params))
+
+	// invalid bids
+	requireT.ErrorIs(bid(ctx, sdk.NewInt64Coin(bondDenom, 99), 1), types.ErrInvalidBid)
+	requireT.ErrorIs(bid(ctx, sdk.NewInt64Coin("otherdenom", 100), 1), types.ErrInvalidBid)
+	requireT.ErrorIs(bid(ctx, sdk.NewInt64Coin(bondDenom, 100), 2), types.ErrInvalidBundle)
+
+	distrAddress := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	operatorBalance := testApp.BankKeeper.GetBalance(ctx, operator, bondDenom)
+	distrBalance := testApp.BankKeeper.GetBalance(ctx, distrAddress, bondDenom)
+
+	// the bid is split between the proposer and the community pool
+	proposerCtx := ctx.WithBlockHeader(tmproto.Header{Height: ctx.BlockHeight(), ProposerAddress: consAddr})
+	requireT.NoError(bid(proposerCtx, sdk.NewInt64Coin(bondDenom, 401), 1))
+	requireT.Equal(
+		operatorBalance.AddAmount(sdkmath.NewInt(100)).String(),
+		testApp.BankKeeper.GetBalance(ctx, operator, bondDenom).String(),
+	)
+	requireT.Equal(
+		distrBalance.AddAmount(sdkmath.NewInt(301)).String(),
+		testApp.BankKeeper.GetBalance(ctx, distrAddress, bondDenom).String(),
+	)
+
+	// the whole bid is sent to the community pool if the proposer is unknown
+	requireT.NoError(bid(ctx, sdk.NewInt64Coin(bondDenom, 200), 1))
+	requireT.Equal(
+		operatorBalance.AddAmount(sdkmath.NewInt(100)).String(),
+		testApp.BankKeeper.GetBalance(ctx, operator, bondDenom).String(),
+	)
+	requireT.Equal(
+		distrBalance.AddAmount(sdkmath.NewInt(501)).String(),
+		testApp.BankKeeper.GetBalance(ctx, distrAddress, bondDenom).String(),
+	)
+	requireT.Equal(
+		sdk.NewInt64Coin(bondDenom, 399).String(),
+		testApp.BankKeeper.GetBalance(ctx, bidder, bondDenom).String(),
+	)
+
+	// the bidder can't pay the bid
+	requireT.Error(bid(proposerCtx, sdk.NewInt64Coin(bondDenom, 400), 1))
+
+	// the bid wrapped by another message is rejected, because it bypasses the top-of-block placement
+	bidderBalance := testApp.BankKeeper.GetBalance(ctx, bidder, bondDenom)
+	bidMsg := &types.MsgAuctionBid{
+		Bidder:       bidder.String(),
+		Bid:          sdk.NewInt64Coin(bondDenom, 100),
+		Transactions: [][]byte{{0x01}},
+	}
+	_, err = msgServer.AuctionBid(proposerCtx, bidMsg)
+	requireT.ErrorIs(err, types.ErrInvalidBid)
+	execMsg := authz.NewMsgExec(bidder, []sdk.Msg{bidMsg})
+	_, err = testApp.MsgServiceRouter().Handler(&execMsg)(proposerCtx, &execMsg)
+	requireT.ErrorIs(err, types.ErrInvalidBid)
+	requireT.Equal(bidderBalance.String(), testApp.BankKeeper.GetBalance(ctx, bidder, bondDenom).String())
+}
+
+func TestCommitBid(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	commitCtx := testApp.NewContext(false).WithBlockHeight(10)
+	revealCtx := commitCtx.WithBlockHeight(11)
+	auctionKeeper := testApp.AuctionKeeper
+	msgServer := keeper.NewMsgServer(auctionKeeper)
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(commitCtx)
+	requireT.NoError(err)
+
+	bidder, _ := testApp.GenAccount(commitCtx)
+	requireT.NoError(testApp.FundAccount(commitCtx, bidder, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000))))
+
+	params := types.DefaultParams()
+	params.ReservePrice = sdk.NewInt64Coin(bondDenom, 100)
+	requireT.NoError(auctionKeeper.SetParams(commitCtx, params))
+
+	salt := bytes.Repeat([]byte{0x01}, types.MinBidSaltLength)
+	bidMsg := &types.MsgAuctionBid{
+		Bidder:       bidder.String(),
+		Bid:          sdk.NewInt64Coin(bondDenom, 100),
+		Transactions: [][]byte{{0x01}},
+		Salt:         salt,
+	}
+	commitMsg := &types.MsgCommitBid{
+		Bidder:     bidder.String(),
+		Commitment: types.BidCommitment(bidder, bidMsg.Bid, bidMsg.Transactions, salt),
+	}
+
+	// the auction is disabled
+	_, err = msgServer.CommitBid(commitCtx, commitMsg)
+	requireT.ErrorIs(err, types.ErrAuctionDisabled)
+
+	params.Enabled = true
+	requireT.NoError(auctionKeeper.SetParams(commitCtx, params))
+	_, err = msgServer.CommitBid(commitCtx, commitMsg)
+	requireT.NoError(err)
+
+	// the bid is revealed only in the next block
+	requireT.ErrorIs(auctionKeeper.ValidateBidCommitment(commitCtx, bidder, bidMsg), types.ErrCommitmentNotFound)
+	requireT.NoError(auctionKeeper.ValidateBidCommitment(revealCtx, bidder, bidMsg))
+
+	// the revealed bid must match the commitment
+	changedBidMsg := *bidMsg
+	changedBidMsg.Bid = sdk.NewInt64Coin(bondDenom, 101)
+	requireT.ErrorIs(auctionKeeper.ValidateBidCommitment(revealCtx, bidder, &changedBidMsg), types.ErrCommitmentNotFound)
+	changedBidMsg = *bidMsg
+	changedBidMsg.Salt = bytes.Repeat([]byte{0x02}, types.MinBidSaltLength)
+	requireT.ErrorIs(auctionKeeper.ValidateBidCommitment(revealCtx, bidder, &changedBidMsg), types.ErrCommitmentNotFound)
+
+	// the commitment is kept until the end of the block it might be revealed in
+	requireT.NoError(auctionKeeper.PruneBidCommitments(commitCtx))
+	requireT.NoError(auctionKeeper.ValidateBidCommitment(revealCtx, bidder, bidMsg))
+	requireT.NoError(auctionKeeper.PruneBidCommitments(revealCtx))
+	requireT.ErrorIs(auctionKeeper.ValidateBidCommitment(revealCtx, bidder, bidMsg), types.ErrCommitmentNotFound)
+}
+
+func TestUpdateParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	auctionKeeper := testApp.AuctionKeeper
+
+	params, err := auctionKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.False(params.Enabled)
+
+	params.Enabled = true
+	requireT.ErrorIs(auctionKeeper.UpdateParams(ctx, "invalid", params), types.ErrInvalidAuthority)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	requireT.NoError(auctionKeeper.UpdateParams(ctx, authority, params))
+	params, err = auctionKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.True(params.Enabled)
+
+	params.MaxBundleSize = 0
+	requireT.ErrorIs(auctionKeeper.UpdateParams(ctx, authority, params), types.ErrInvalidInput)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+// CommitBid stores the commitment sealing the bid for the top of the next block. The commitment stored by the bidder
+// in the same block before is replaced.
+func (k Keeper) CommitBid(ctx context.Context, bidder sdk.AccAddress, commitment []byte) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled {
+		return types.ErrAuctionDisabled
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.BidCommitments.Set(ctx, collections.Join(height, bidder), commitment)
+}
+
+// ValidateBidCommitment checks that the revealed bid matches the commitment stored by the bidder in the previous
+// block.
+func (k Keeper) ValidateBidCommitment(ctx context.Context, bidder sdk.AccAddress, msg *types.MsgAuctionBid) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	commitment, err := k.BidCommitments.Get(ctx, collections.Join(height-1, bidder))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(
+				types.ErrCommitmentNotFound, "bidder %s hasn't committed the bid in the previous block", bidder,
+			)
+		}
+		return err
+	}
+	if !bytes.Equal(commitment, types.BidCommitment(bidder, msg.Bid, msg.Transactions, msg.Salt)) {
+		return errorsmod.Wrap(types.ErrCommitmentNotFound, "bid doesn't match the commitment")
+	}
+
+	return nil
+}
+
+// PruneBidCommitments removes the commitments which can't be revealed anymore. The commitment is revealed in the block
+// following the one it is stored in, so at the end of the block only the commitments stored in it are kept.
+func (k Keeper) PruneBidCommitments(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return k.BidCommitments.Clear(ctx, collections.NewPrefixUntilPairRange[int64, sdk.AccAddress](height-1))
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+// InitGenesis initializes the auction module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	return k.SetParams(ctx, genState.Params)
+}
+
+// ExportGenesis returns the auction module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params: params,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdkstore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	authority    string
+
+	// codec
+	cdc codec.BinaryCodec
+
+	// keepers
+	bankKeeper         types.BankKeeper
+	distributionKeeper types.DistributionKeeper
+	stakingKeeper      types.StakingKeeper
+
+	// collections
+	Schema         collections.Schema
+	Params         collections.Item[types.Params]
+	BidCommitments collections.Map[collections.Pair[int64, sdk.AccAddress], []byte]
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.BinaryCodec,
+	authority string,
+	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
+	stakingKeeper types.StakingKeeper,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:       storeService,
+		cdc:                cdc,
+		authority:          authority,
+		bankKeeper:         bankKeeper,
+		distributionKeeper: distributionKeeper,
+		stakingKeeper:      stakingKeeper,
+
+		Params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			codec.CollValue[types.Params](cdc),
+		),
+		BidCommitments: collections.NewMap(
+			sb,
+			types.BidCommitmentsKey,
+			"bid_commitments",
+			collections.PairKeyCodec(collections.Int64Key, sdk.AccAddressKey),
+			collections.BytesValue,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
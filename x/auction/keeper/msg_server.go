@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// UpdateParams is a governance operation that sets parameters of the module.
+func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateParams(ctx, req.Authority, req.Params); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// CommitBid stores the commitment sealing the bid for the top of the next block.
+func (ms MsgServer) CommitBid(ctx context.Context, req *types.MsgCommitBid) (*types.EmptyResponse, error) {
+	bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.CommitBid(ctx, bidder, req.Commitment); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// AuctionBid pays the bid placed on top of the block. The placement of the bid and its bundle is enforced by the
+// proposal handler, which checks only the messages of the transaction, so the wrapped bids are rejected.
+func (ms MsgServer) AuctionBid(ctx context.Context, req *types.MsgAuctionBid) (*types.EmptyResponse, error) {
+	if !types.IsBidTx(sdk.UnwrapSDKContext(ctx)) {
+		return nil, errorsmod.Wrap(types.ErrInvalidBid, "bid must be the only message of the transaction")
+	}
+	bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.ValidateBidCommitment(ctx, bidder, req); err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.PayBid(ctx, bidder, req.Bid, len(req.Transactions)); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
@@ -0,0 +1,268 @@
+package auction
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/auction/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+// ProposalHandler places the winning bid of the top-of-block auction followed by its bundle on top of the block.
+// The bids are sealed: only the hashes of the bids are committed in the previous block, so the bids revealed in the
+// proposed block can't be adjusted to the bids of the others. The proposer picks the highest revealed bid matching its
+// commitment, and only the winning bid is included into the block and paid.
+type ProposalHandler struct {
+	keeper          keeper.Keeper
+	txDecoder       sdk.TxDecoder
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a new instance of the ProposalHandler wrapping the provided handlers, which are called
+// for the reordered transactions.
+func NewProposalHandler(
+	keeper keeper.Keeper,
+	txDecoder sdk.TxDecoder,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) ProposalHandler {
+	return ProposalHandler{
+		keeper:          keeper,
+		txDecoder:       txDecoder,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+type bidBundle struct {
+	bid sdk.Coin
+	// txs contains the bid transaction followed by the bundled transactions.
+	txs [][]byte
+}
+
+// PrepareProposalHandler returns the handler selecting the highest valid bid and placing it together with its bundle
+// on top of the block. The losing and invalid bids are removed from the proposal.
+func (h ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		params, err := h.keeper.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var winner *bidBundle
+		txs := make([][]byte, 0, len(req.Txs))
+		for _, txBytes := range req.Txs {
+			tx, err := h.txDecoder(txBytes)
+			if err != nil {
+				// the transaction is handled by the wrapped handler
+				txs = append(txs, txBytes)
+				continue
+			}
+			msg, isBid, err := bidMsg(tx)
+			if !isBid {
+				txs = append(txs, txBytes)
+				continue
+			}
+			if err != nil {
+				ctx.Logger().Debug("Removing invalid bid transaction from the proposal", "err", err)
+				continue
+			}
+
+			bundle, err := h.validateBid(ctx, params, tx, txBytes, msg)
+			if err != nil {
+				ctx.Logger().Debug("Removing invalid bid transaction from the proposal", "err", err)
+				continue
+			}
+			if winner == nil || bundle.bid.Amount.GT(winner.bid.Amount) {
+				winner = &bundle
+			}
+		}
+
+		if winner != nil {
+			txs = append(winner.txs, removeTxs(txs, winner.txs)...)
+		}
+
+		reorderedReq := *req
+		reorderedReq.Txs = txs
+		return h.prepareProposal(ctx, &reorderedReq)
+	}
+}
+
+// ProcessProposalHandler returns the handler rejecting the proposal if it contains the bid which isn't the first
+// transaction of the block, the bid is invalid or doesn't match its commitment, or it isn't followed by its bundle.
+func (h ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		params, err := h.keeper.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, txBytes := range req.Txs {
+			tx, err := h.txDecoder(txBytes)
+			if err != nil {
+				continue
+			}
+			msg, isBid, err := bidMsg(tx)
+			if !isBid {
+				continue
+			}
+			if i != 0 {
+				ctx.Logger().Error("Rejecting proposal, bid transaction is not on top of the block", "index", i)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			if err != nil {
+				ctx.Logger().Error("Rejecting proposal, invalid bid transaction", "err", err)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+
+			bundle, err := h.validateBid(ctx, params, tx, txBytes, msg)
+			if err != nil {
+				ctx.Logger().Error("Rejecting proposal, invalid bid transaction", "err", err)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			if !isPrefix(req.Txs, bundle.txs) {
+				ctx.Logger().Error("Rejecting proposal, bid transaction is not followed by its bundle")
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+		}
+
+		return h.processProposal(ctx, req)
+	}
+}
+
+// validateBid checks that the bid is accepted by the auction, the bidder is able to pay it, the bid matches the
+// commitment stored in the previous block, and the bid transaction together with its bundle fits into the block space
+// reserved for the auction.
+func (h ProposalHandler) validateBid(
+	ctx sdk.Context,
+	params types.Params,
+	tx sdk.Tx,
+	txBytes []byte,
+	msg *types.MsgAuctionBid,
+) (bidBundle, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return bidBundle{}, err
+	}
+	if err := params.ValidateBid(msg.Bid, len(msg.Transactions)); err != nil {
+		return bidBundle{}, err
+	}
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return bidBundle{}, err
+	}
+	required := sdk.NewCoins(msg.Bid)
+	if feeTx, ok := tx.(sdk.FeeTx); ok && bytes.Equal(feeTx.FeePayer(), bidder) {
+		required = required.Add(feeTx.GetFee()...)
+	}
+	if err := h.keeper.ValidateBidderFunds(ctx, bidder, required); err != nil {
+		return bidBundle{}, err
+	}
+	if err := h.keeper.ValidateBidCommitment(ctx, bidder, msg); err != nil {
+		return bidBundle{}, err
+	}
+
+	txs := make([][]byte, 0, len(msg.Transactions)+1)
+	txs = append(txs, txBytes)
+	gas := txGas(tx)
+	for i, bundledTxBytes := range msg.Transactions {
+		bundledTx, err := h.txDecoder(bundledTxBytes)
+		if err != nil {
+			return bidBundle{}, errorsmod.Wrapf(types.ErrInvalidBundle, "failed to decode transaction %d: %s", i, err)
+		}
+		if _, isBid, _ := bidMsg(bundledTx); isBid {
+			return bidBundle{}, errorsmod.Wrapf(types.ErrInvalidBundle, "transaction %d is a bid", i)
+		}
+		gas += txGas(bundledTx)
+		txs = append(txs, bundledTxBytes)
+	}
+
+	maxGas, maxBytes := auctionLimits(ctx, params)
+	if maxGas > 0 && gas > maxGas {
+		return bidBundle{}, errorsmod.Wrapf(
+			types.ErrInvalidBundle, "bundle gas %d exceeds the auction limit %d", gas, maxGas,
+		)
+	}
+	cmtTxs := make([]cmttypes.Tx, 0, len(txs))
+	for _, txBytes := range txs {
+		cmtTxs = append(cmtTxs, txBytes)
+	}
+	size := uint64(cmttypes.ComputeProtoSizeForTxs(cmtTxs))
+	if maxBytes > 0 && size > maxBytes {
+		return bidBundle{}, errorsmod.Wrapf(
+			types.ErrInvalidBundle, "bundle size %d exceeds the auction limit %d", size, maxBytes,
+		)
+	}
+
+	return bidBundle{
+		bid: msg.Bid,
+		txs: txs,
+	}, nil
+}
+
+// auctionLimits returns the gas and bytes limits of the block space reserved for the auction. Both limits are derived
+// from the consensus params, so the proposer and the validators processing the proposal apply the same bounds. Zero
+// limit means the limit is not set.
+func auctionLimits(ctx sdk.Context, params types.Params) (uint64, uint64) {
+	b := ctx.ConsensusParams().Block
+	if b == nil {
+		return 0, 0
+	}
+	return params.MaxBlockSpaceLimit(b.MaxGas), params.MaxBlockSpaceLimit(b.MaxBytes)
+}
+
+// bidMsg returns the bid message if the transaction contains one. The bid transaction is valid only if the bid is
+// its only message.
+func bidMsg(tx sdk.Tx) (*types.MsgAuctionBid, bool, error) {
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		bid, ok := msg.(*types.MsgAuctionBid)
+		if !ok {
+			continue
+		}
+		if len(msgs) != 1 {
+			return nil, true, errorsmod.Wrap(types.ErrInvalidBid, "bid must be the only message of the transaction")
+		}
+		return bid, true, nil
+	}
+	return nil, false, nil
+}
+
+func txGas(tx sdk.Tx) uint64 {
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		return feeTx.GetGas()
+	}
+	return 0
+}
+
+func removeTxs(txs, toRemove [][]byte) [][]byte {
+	removed := make(map[string]struct{}, len(toRemove))
+	for _, tx := range toRemove {
+		removed[string(tx)] = struct{}{}
+	}
+
+	result := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		if _, found := removed[string(tx)]; !found {
+			result = append(result, tx)
+		}
+	}
+	return result
+}
+
+func isPrefix(txs, prefix [][]byte) bool {
+	if len(txs) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if !bytes.Equal(txs[i], prefix[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,179 @@
+package auction_test
+
+import (
+	"bytes"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/auction"
+	"github.com/tokenize-x/tx-chain/v7/x/auction/types"
+)
+
+func TestProposalHandler(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockHeight(10)
+	commitCtx := ctx.WithBlockHeight(9)
+	auctionKeeper := testApp.AuctionKeeper
+	txEncoder := testApp.TxConfig().TxEncoder()
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+	fee := sdk.NewInt64Coin(bondDenom, 1_000)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.MaxBundleSize = 2
+	params.ReservePrice = sdk.NewInt64Coin(bondDenom, 100)
+	requireT.NoError(auctionKeeper.SetParams(ctx, params))
+
+	genTx := func(priv *secp256k1.PrivKey, msgs ...sdk.Msg) []byte {
+		tx, err := testApp.GenTx(ctx, fee, 200_000, priv, msgs...)
+		requireT.NoError(err)
+		txBytes, err := txEncoder(tx)
+		requireT.NoError(err)
+		return txBytes
+	}
+	genAccount := func() (sdk.AccAddress, *secp256k1.PrivKey) {
+		address, priv := testApp.GenAccount(ctx)
+		requireT.NoError(testApp.FundAccount(ctx, address, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+		return address, priv
+	}
+	sendTx := func() []byte {
+		address, priv := genAccount()
+		return genTx(priv, &banktypes.MsgSend{
+			FromAddress: address.String(),
+			ToAddress:   address.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1)),
+		})
+	}
+	salt := bytes.Repeat([]byte{0x01}, types.MinBidSaltLength)
+	// bidTx commits the bid in the previous block and returns the transaction revealing it
+	bidTx := func(amount int64, bundle ...[]byte) []byte {
+		bidder, priv := genAccount()
+		bid := sdk.NewInt64Coin(bondDenom, amount)
+		requireT.NoError(auctionKeeper.CommitBid(commitCtx, bidder, types.BidCommitment(bidder, bid, bundle, salt)))
+		return genTx(priv, &types.MsgAuctionBid{
+			Bidder:       bidder.String(),
+			Bid:          bid,
+			Transactions: bundle,
+			Salt:         salt,
+		})
+	}
+	uncommittedBidTx := func(amount int64, bundle ...[]byte) []byte {
+		bidder, priv := genAccount()
+		return genTx(priv, &types.MsgAuctionBid{
+			Bidder:       bidder.String(),
+			Bid:          sdk.NewInt64Coin(bondDenom, amount),
+			Transactions: bundle,
+			Salt:         salt,
+		})
+	}
+	raisedBidTx := func(amount int64, bundle ...[]byte) []byte {
+		bidder, priv := genAccount()
+		requireT.NoError(auctionKeeper.CommitBid(
+			commitCtx, bidder, types.BidCommitment(bidder, sdk.NewInt64Coin(bondDenom, amount), bundle, salt),
+		))
+		return genTx(priv, &types.MsgAuctionBid{
+			Bidder:       bidder.String(),
+			Bid:          sdk.NewInt64Coin(bondDenom, amount+1),
+			Transactions: bundle,
+			Salt:         salt,
+		})
+	}
+
+	tx1 := sendTx()
+	tx2 := sendTx()
+	tx3 := sendTx()
+	lowBid := bidTx(200, tx1)
+	highBid := bidTx(300, tx2, tx3)
+	belowReserveBid := bidTx(99, tx1)
+	tooLargeBundleBid := bidTx(1_000, tx1, tx2, tx3)
+	nestedBid := bidTx(1_000, lowBid)
+	uncommittedBid := uncommittedBidTx(1_000, tx1)
+	raisedBid := raisedBidTx(1_000, tx1)
+
+	handler := auction.NewProposalHandler(
+		auctionKeeper,
+		testApp.TxConfig().TxDecoder(),
+		func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+			return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+		},
+		func(_ sdk.Context, _ *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		},
+	)
+	prepare := handler.PrepareProposalHandler()
+	process := handler.ProcessProposalHandler()
+
+	processStatus := func(ctx sdk.Context, txs ...[]byte) abci.ResponseProcessProposal_ProposalStatus {
+		res, err := process(ctx, &abci.RequestProcessProposal{Txs: txs})
+		requireT.NoError(err)
+		return res.Status
+	}
+
+	// the highest valid bid is placed on top of the block followed by its bundle, other bids are removed
+	res, err := prepare(ctx, &abci.RequestPrepareProposal{
+		Txs: [][]byte{
+			tx1, lowBid, tx3, belowReserveBid, highBid, tooLargeBundleBid, nestedBid, uncommittedBid, raisedBid,
+		},
+		MaxTxBytes: 1_000_000,
+	})
+	requireT.NoError(err)
+	requireT.Equal([][]byte{highBid, tx2, tx3, tx1}, res.Txs)
+	requireT.Equal(abci.ResponseProcessProposal_ACCEPT, processStatus(ctx, res.Txs...))
+
+	// the bundle doesn't fit into the block space reserved for the auction, the same limit is applied by the proposer
+	// and the validators processing the proposal
+	limitedCtx := ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{
+			MaxBytes: int64(len(highBid)),
+			MaxGas:   -1,
+		},
+	})
+	res, err = prepare(limitedCtx, &abci.RequestPrepareProposal{
+		Txs:        [][]byte{tx1, highBid},
+		MaxTxBytes: 1_000_000,
+	})
+	requireT.NoError(err)
+	requireT.Equal([][]byte{tx1}, res.Txs)
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(limitedCtx, highBid, tx2, tx3))
+
+	// proposals without bids are accepted
+	requireT.Equal(abci.ResponseProcessProposal_ACCEPT, processStatus(ctx, tx1, tx2))
+
+	// the bid is not on top of the block
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx, tx1, highBid, tx2, tx3))
+
+	// the bid is not followed by its bundle
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx, highBid, tx3, tx2))
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx, highBid, tx2))
+
+	// invalid bids
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx, belowReserveBid, tx1))
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx, nestedBid, lowBid))
+
+	// the bids not matching the commitment of the previous block are rejected
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx, uncommittedBid, tx1))
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx, raisedBid, tx1))
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx.WithBlockHeight(11), highBid, tx2, tx3))
+
+	// bids are rejected and removed when the auction is disabled
+	params.Enabled = false
+	requireT.NoError(auctionKeeper.SetParams(ctx, params))
+	requireT.Equal(abci.ResponseProcessProposal_REJECT, processStatus(ctx, highBid, tx2, tx3))
+	res, err = prepare(ctx, &abci.RequestPrepareProposal{
+		Txs:        [][]byte{tx1, highBid},
+		MaxTxBytes: 1_000_000,
+	})
+	requireT.NoError(err)
+	requireT.Equal([][]byte{tx1}, res.Txs)
+}
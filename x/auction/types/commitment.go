@@ -0,0 +1,35 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MinBidSaltLength is the minimum length of the salt of the bid, so the committed bid can't be guessed by hashing the
+// possible bids.
+const MinBidSaltLength = 16
+
+// BidCommitment returns the commitment sealing the bid. It is the SHA-256 hash of the bidder, the bid, the bundled
+// transactions and the salt, each of them prefixed with its length.
+func BidCommitment(bidder sdk.AccAddress, bid sdk.Coin, transactions [][]byte, salt []byte) []byte {
+	h := sha256.New()
+	writeLen := func(n int) {
+		_, _ = h.Write(binary.BigEndian.AppendUint64(nil, uint64(n)))
+	}
+	write := func(bz []byte) {
+		writeLen(len(bz))
+		_, _ = h.Write(bz)
+	}
+
+	write(bidder)
+	write([]byte(bid.String()))
+	writeLen(len(transactions))
+	for _, tx := range transactions {
+		write(tx)
+	}
+	write(salt)
+
+	return h.Sum(nil)
+}
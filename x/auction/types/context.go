@@ -0,0 +1,18 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+type bidTxKey struct{}
+
+// WithBidTx marks the context of the transaction containing the bid as its only message. The bid is paid only when
+// executed with the marked context, so the bid wrapped by another message, like the authz MsgExec, the meta-transaction
+// or the message dispatched by the wasm contract, is rejected, because it bypasses the top-of-block placement.
+func WithBidTx(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bidTxKey{}, true)
+}
+
+// IsBidTx returns true if the context is marked by WithBidTx.
+func IsBidTx(ctx sdk.Context) bool {
+	isBidTx, ok := ctx.Value(bidTxKey{}).(bool)
+	return ok && isBidTx
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidAuthority is returned when the authority is invalid.
+	ErrInvalidAuthority = sdkerrors.Register(ModuleName, 2, "invalid authority")
+
+	// ErrInvalidInput is returned when input validation fails.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 3, "invalid input")
+
+	// ErrAuctionDisabled is returned when the bid is placed while the auction is disabled.
+	ErrAuctionDisabled = sdkerrors.Register(ModuleName, 4, "auction is disabled")
+
+	// ErrInvalidBid is returned when the bid doesn't satisfy the auction params.
+	ErrInvalidBid = sdkerrors.Register(ModuleName, 5, "invalid bid")
+
+	// ErrInvalidBundle is returned when the bundle of the bid can't be placed on top of the block.
+	ErrInvalidBundle = sdkerrors.Register(ModuleName, 6, "invalid bundle")
+
+	// ErrCommitmentNotFound is returned when the revealed bid doesn't match the bid committed in the previous block.
+	ErrCommitmentNotFound = sdkerrors.Register(ModuleName, 7, "bid commitment not found")
+)
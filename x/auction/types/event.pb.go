@@ -0,0 +1,575 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/auction/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventAuctionWon is emitted when the winning bid placed on top of the block is paid.
+type EventAuctionWon struct {
+	Bidder     string     `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Bid        types.Coin `protobuf:"bytes,2,opt,name=bid,proto3" json:"bid"`
+	BundleSize uint32     `protobuf:"varint,3,opt,name=bundle_size,json=bundleSize,proto3" json:"bundle_size,omitempty"`
+	// proposer is the operator address of the validator which proposed the block.
+	Proposer            string     `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	ProposerReward      types.Coin `protobuf:"bytes,5,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward"`
+	CommunityPoolAmount types.Coin `protobuf:"bytes,6,opt,name=community_pool_amount,json=communityPoolAmount,proto3" json:"community_pool_amount"`
+}
+
+func (m *EventAuctionWon) Reset()         { *m = EventAuctionWon{} }
+func (m *EventAuctionWon) String() string { return proto.CompactTextString(m) }
+func (*EventAuctionWon) ProtoMessage()    {}
+func (*EventAuctionWon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c0672e343059180, []int{0}
+}
+func (m *EventAuctionWon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAuctionWon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAuctionWon.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAuctionWon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAuctionWon.Merge(m, src)
+}
+func (m *EventAuctionWon) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAuctionWon) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAuctionWon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAuctionWon proto.InternalMessageInfo
+
+func (m *EventAuctionWon) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *EventAuctionWon) GetBid() types.Coin {
+	if m != nil {
+		return m.Bid
+	}
+	return types.Coin{}
+}
+
+func (m *EventAuctionWon) GetBundleSize() uint32 {
+	if m != nil {
+		return m.BundleSize
+	}
+	return 0
+}
+
+func (m *EventAuctionWon) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *EventAuctionWon) GetProposerReward() types.Coin {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return types.Coin{}
+}
+
+func (m *EventAuctionWon) GetCommunityPoolAmount() types.Coin {
+	if m != nil {
+		return m.CommunityPoolAmount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*EventAuctionWon)(nil), "coreum.auction.v1.EventAuctionWon")
+}
+
+func init() { proto.RegisterFile("coreum/auction/v1/event.proto", fileDescriptor_6c0672e343059180) }
+
+var fileDescriptor_6c0672e343059180 = []byte{
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x6e, 0xe2, 0x40,
+	0x10, 0xc6, 0x6d, 0xe0, 0xd0, 0xdd, 0xa2, 0x3b, 0x74, 0xce, 0x1f, 0x39, 0x48, 0x31, 0x28, 0x15,
+	0x0d, 0xbb, 0x72, 0x52, 0xa4, 0x86, 0x28, 0x52, 0xa4, 0x34, 0x91, 0x29, 0x22, 0xa5, 0xb1, 0xec,
+	0xf5, 0x08, 0x56, 0xc1, 0x3b, 0xd6, 0x7a, 0xed, 0x00, 0x4f, 0x91, 0xc7, 0xc9, 0x23, 0x50, 0x52,
+	0xa6, 0x8a, 0x22, 0x78, 0x91, 0xc8, 0x36, 0x50, 0xd3, 0x7d, 0x3b, 0xb3, 0xbf, 0xef, 0x57, 0x0c,
+	0xb9, 0xe4, 0xa8, 0x20, 0x8b, 0x59, 0x90, 0x71, 0x2d, 0x50, 0xb2, 0xdc, 0x65, 0x90, 0x83, 0xd4,
+	0x34, 0x51, 0xa8, 0xd1, 0xfa, 0x5f, 0xad, 0xe9, 0x6e, 0x4d, 0x73, 0xb7, 0xe3, 0x70, 0x4c, 0x63,
+	0x4c, 0x59, 0x18, 0xa4, 0xc0, 0x72, 0x37, 0x04, 0x1d, 0xb8, 0x8c, 0xa3, 0x90, 0x15, 0xd2, 0x39,
+	0x9d, 0xe0, 0x04, 0xcb, 0xc8, 0x8a, 0x54, 0x4d, 0xaf, 0x3e, 0x6a, 0xa4, 0x7d, 0x5f, 0x14, 0x0f,
+	0xab, 0xa6, 0x67, 0x94, 0xd6, 0x39, 0x69, 0x86, 0x22, 0x8a, 0x40, 0xd9, 0x66, 0xcf, 0xec, 0xff,
+	0xf1, 0x76, 0x2f, 0xcb, 0x25, 0xf5, 0x50, 0x44, 0x76, 0xad, 0x67, 0xf6, 0x5b, 0xd7, 0x17, 0xb4,
+	0xf2, 0xd1, 0xc2, 0x47, 0x77, 0x3e, 0x7a, 0x87, 0x42, 0x8e, 0x1a, 0xab, 0xaf, 0xae, 0xe1, 0x15,
+	0x7f, 0xad, 0x2e, 0x69, 0x85, 0x99, 0x8c, 0x66, 0xe0, 0xa7, 0x62, 0x09, 0x76, 0xbd, 0x67, 0xf6,
+	0xff, 0x7a, 0xa4, 0x1a, 0x8d, 0xc5, 0x12, 0xac, 0x0e, 0xf9, 0x9d, 0x28, 0x4c, 0x30, 0x05, 0x65,
+	0x37, 0x4a, 0xdb, 0xe1, 0x6d, 0x3d, 0x90, 0xf6, 0x3e, 0xfb, 0x0a, 0xde, 0x02, 0x15, 0xd9, 0xbf,
+	0x8e, 0x73, 0xff, 0xdb, 0x73, 0x5e, 0x89, 0x59, 0x63, 0x72, 0xc6, 0x31, 0x8e, 0x33, 0x29, 0xf4,
+	0xc2, 0x4f, 0x10, 0x67, 0x7e, 0x10, 0x63, 0x26, 0xb5, 0xdd, 0x3c, 0xae, 0xef, 0xe4, 0x40, 0x3f,
+	0x21, 0xce, 0x86, 0x25, 0x3b, 0x7a, 0x5c, 0x6d, 0x1c, 0x73, 0xbd, 0x71, 0xcc, 0xef, 0x8d, 0x63,
+	0xbe, 0x6f, 0x1d, 0x63, 0xbd, 0x75, 0x8c, 0xcf, 0xad, 0x63, 0xbc, 0xb8, 0x13, 0xa1, 0xa7, 0x59,
+	0x48, 0x39, 0xc6, 0x4c, 0xe3, 0x2b, 0x48, 0xb1, 0x84, 0xc1, 0x9c, 0xe9, 0xf9, 0x80, 0x4f, 0x03,
+	0x21, 0x59, 0x7e, 0xcb, 0xe6, 0x87, 0xcb, 0xea, 0x45, 0x02, 0x69, 0xd8, 0x2c, 0xcf, 0x71, 0xf3,
+	0x33, 0x00, 0x2a, 0xd5, 0x45, 0xeb, 0xf8, 0x01, 0x00, 0x00,
+}
+
+func (m *EventAuctionWon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAuctionWon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAuctionWon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CommunityPoolAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.ProposerReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BundleSize != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BundleSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventAuctionWon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BundleSize != 0 {
+		n += 1 + sovEvent(uint64(m.BundleSize))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ProposerReward.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CommunityPoolAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventAuctionWon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAuctionWon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAuctionWon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleSize", wireType)
+			}
+			m.BundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type extendedMsg interface {
+	sdk.Msg
+	sdk.HasValidateBasic
+}
+
+var (
+	_ extendedMsg = &MsgUpdateParams{}
+	_ extendedMsg = &MsgCommitBid{}
+	_ extendedMsg = &MsgAuctionBid{}
+)
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return m.Params.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgCommitBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid bidder address: %s", err)
+	}
+	if len(m.Commitment) != sha256.Size {
+		return ErrInvalidBid.Wrapf("commitment must be %d bytes long, got %d", sha256.Size, len(m.Commitment))
+	}
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgAuctionBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid bidder address: %s", err)
+	}
+	if err := m.Bid.Validate(); err != nil {
+		return cosmoserrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	if !m.Bid.IsPositive() {
+		return cosmoserrors.ErrInvalidCoins.Wrap("bid must be positive")
+	}
+	if len(m.Transactions) == 0 {
+		return ErrInvalidBundle.Wrap("bundle must not be empty")
+	}
+	for i, tx := range m.Transactions {
+		if len(tx) == 0 {
+			return ErrInvalidBundle.Wrapf("transaction %d of the bundle is empty", i)
+		}
+	}
+	if len(m.Salt) < MinBidSaltLength {
+		return ErrInvalidBid.Wrapf("salt must be at least %d bytes long", MinBidSaltLength)
+	}
+	return nil
+}
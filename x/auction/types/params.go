@@ -0,0 +1,65 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns params with default values. The auction is disabled by default.
+func DefaultParams() Params {
+	return Params{
+		Enabled:       false,
+		MaxBlockSpace: sdkmath.LegacyNewDecWithPrec(1, 1),
+		MaxBundleSize: 4,
+		ReservePrice:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000),
+		ProposerFee:   sdkmath.LegacyNewDecWithPrec(5, 1),
+	}
+}
+
+// ValidateBasic validates the params.
+func (p Params) ValidateBasic() error {
+	if p.MaxBlockSpace.IsNil() || !p.MaxBlockSpace.IsPositive() || p.MaxBlockSpace.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrap(ErrInvalidInput, "max block space must be in the range (0, 1]")
+	}
+	if p.MaxBundleSize == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "max bundle size must be positive")
+	}
+	if err := p.ReservePrice.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid reserve price: %s", err)
+	}
+	if p.ProposerFee.IsNil() || p.ProposerFee.IsNegative() || p.ProposerFee.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrap(ErrInvalidInput, "proposer fee must be in the range [0, 1]")
+	}
+
+	return nil
+}
+
+// MaxBlockSpaceLimit returns the part of the block limit which might be used by the winning bid. Zero is returned if
+// the block limit is not set.
+func (p Params) MaxBlockSpaceLimit(blockLimit int64) uint64 {
+	if blockLimit <= 0 {
+		return 0
+	}
+	return p.MaxBlockSpace.MulInt64(blockLimit).TruncateInt().Uint64()
+}
+
+// ValidateBid checks that the bid might be accepted by the auction.
+func (p Params) ValidateBid(bid sdk.Coin, bundleSize int) error {
+	if !p.Enabled {
+		return ErrAuctionDisabled
+	}
+	if bid.Denom != p.ReservePrice.Denom {
+		return errorsmod.Wrapf(ErrInvalidBid, "bid denom must be %s, got %s", p.ReservePrice.Denom, bid.Denom)
+	}
+	if bid.Amount.LT(p.ReservePrice.Amount) {
+		return errorsmod.Wrapf(ErrInvalidBid, "bid %s is lower than the reserve price %s", bid, p.ReservePrice)
+	}
+	if bundleSize > int(p.MaxBundleSize) {
+		return errorsmod.Wrapf(
+			ErrInvalidBundle, "bundle size %d exceeds the maximum %d", bundleSize, p.MaxBundleSize,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,518 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/auction/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params keeps gov manageable parameters.
+type Params struct {
+	// enabled defines whether the top-of-block auction is running. Bids are rejected when it is disabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// max_block_space is the fraction of the block gas and bytes limits which might be used by the winning bid
+	// transaction together with its bundle.
+	MaxBlockSpace cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_block_space,json=maxBlockSpace,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_space" yaml:"max_block_space"`
+	// max_bundle_size is the maximum number of transactions in the bundle.
+	MaxBundleSize uint32 `protobuf:"varint,3,opt,name=max_bundle_size,json=maxBundleSize,proto3" json:"max_bundle_size,omitempty" yaml:"max_bundle_size"`
+	// reserve_price is the minimum bid accepted by the auction.
+	ReservePrice types.Coin `protobuf:"bytes,4,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price" yaml:"reserve_price"`
+	// proposer_fee is the fraction of the winning bid sent to the block proposer. The rest is sent to the community
+	// pool.
+	ProposerFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=proposer_fee,json=proposerFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proposer_fee" yaml:"proposer_fee"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_452189b88a8a27c5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxBundleSize() uint32 {
+	if m != nil {
+		return m.MaxBundleSize
+	}
+	return 0
+}
+
+func (m *Params) GetReservePrice() types.Coin {
+	if m != nil {
+		return m.ReservePrice
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "coreum.auction.v1.Params")
+}
+
+func init() { proto.RegisterFile("coreum/auction/v1/params.proto", fileDescriptor_452189b88a8a27c5) }
+
+var fileDescriptor_452189b88a8a27c5 = []byte{
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x63, 0x0a, 0x05, 0xdc, 0x06, 0x54, 0x53, 0x21, 0x37, 0x48, 0x76, 0xf0, 0x14, 0x21,
+	0x72, 0x27, 0xc3, 0x80, 0xc4, 0x68, 0x2a, 0x84, 0x54, 0x86, 0x2a, 0xdd, 0x58, 0xac, 0xf3, 0xe5,
+	0x25, 0x39, 0x25, 0xe7, 0xd7, 0xf2, 0x39, 0x96, 0x93, 0x4f, 0xc1, 0xc7, 0x60, 0x64, 0x60, 0x67,
+	0xed, 0x58, 0x31, 0x21, 0x06, 0x0b, 0x25, 0x03, 0x7b, 0x3e, 0x01, 0xb2, 0xef, 0xca, 0x9f, 0x4c,
+	0x2c, 0x96, 0xef, 0xf9, 0xbd, 0x7e, 0x9e, 0xf3, 0xa3, 0xd7, 0xf6, 0x38, 0xe6, 0xb0, 0x90, 0x94,
+	0x2d, 0x78, 0x21, 0x30, 0xa5, 0x65, 0x48, 0x33, 0x96, 0x33, 0xa9, 0x48, 0x96, 0x63, 0x81, 0xce,
+	0x91, 0xe6, 0xc4, 0x70, 0x52, 0x86, 0xbd, 0x23, 0x26, 0x45, 0x8a, 0xb4, 0x7d, 0xea, 0xa9, 0x9e,
+	0xc7, 0x51, 0x49, 0x54, 0x34, 0x61, 0x0a, 0x68, 0x19, 0x26, 0x50, 0xb0, 0x90, 0x72, 0x14, 0xa9,
+	0xe1, 0x27, 0x9a, 0xc7, 0xed, 0x89, 0xea, 0x83, 0x41, 0xc7, 0x13, 0x9c, 0xa0, 0xd6, 0x9b, 0x37,
+	0xad, 0x06, 0x5f, 0xf6, 0xec, 0xfd, 0xf3, 0xf6, 0x1e, 0xce, 0x53, 0xfb, 0x36, 0xa4, 0x2c, 0x99,
+	0xc3, 0xd8, 0xb5, 0xfa, 0xd6, 0xe0, 0x4e, 0xe4, 0x6c, 0x6b, 0xff, 0xde, 0x92, 0xc9, 0xf9, 0xcb,
+	0xc0, 0x80, 0x60, 0x74, 0x3d, 0xe2, 0x28, 0xfb, 0xbe, 0x64, 0x55, 0x9c, 0xcc, 0x91, 0xcf, 0x62,
+	0x95, 0x31, 0x0e, 0xee, 0x8d, 0xbe, 0x35, 0xb8, 0x1b, 0x9d, 0x5d, 0xd6, 0x7e, 0xe7, 0x7b, 0xed,
+	0x3f, 0xd2, 0xe9, 0x6a, 0x3c, 0x23, 0x02, 0xa9, 0x64, 0xc5, 0x94, 0xbc, 0x85, 0x09, 0xe3, 0xcb,
+	0x53, 0xe0, 0xdb, 0xda, 0x7f, 0xa8, 0x8d, 0x77, 0x3c, 0x82, 0xaf, 0x9f, 0x87, 0xb6, 0xb9, 0xf6,
+	0x29, 0xf0, 0x51, 0x57, 0xb2, 0x2a, 0x6a, 0xf0, 0x45, 0x43, 0x9d, 0xc8, 0x84, 0x2e, 0xd2, 0xf1,
+	0x1c, 0x62, 0x25, 0x56, 0xe0, 0xee, 0xf5, 0xad, 0x41, 0x37, 0xea, 0xed, 0x38, 0xfe, 0x19, 0x08,
+	0xb4, 0x47, 0x2b, 0x5c, 0x88, 0x15, 0x38, 0xcc, 0xee, 0xe6, 0xa0, 0x20, 0x2f, 0x21, 0xce, 0x72,
+	0xc1, 0xc1, 0xbd, 0xd9, 0xb7, 0x06, 0x07, 0xcf, 0x4e, 0x88, 0x89, 0x6d, 0xaa, 0x25, 0xa6, 0x5a,
+	0xf2, 0x0a, 0x45, 0x1a, 0x3d, 0x6e, 0xfe, 0x68, 0x5b, 0xfb, 0xc7, 0x3a, 0xe0, 0x9f, 0xaf, 0x83,
+	0x8f, 0x3f, 0x3f, 0x3d, 0xb1, 0x46, 0x87, 0x46, 0x3c, 0x6f, 0x34, 0x67, 0x66, 0x1f, 0x66, 0x39,
+	0x66, 0xa8, 0x20, 0x8f, 0xdf, 0x03, 0xb8, 0xb7, 0xda, 0x62, 0xde, 0xfc, 0x5f, 0x31, 0x0f, 0x74,
+	0xca, 0xdf, 0x06, 0xbb, 0xad, 0x1c, 0x5c, 0xc3, 0xd7, 0x00, 0xd1, 0xd9, 0xe5, 0xda, 0xb3, 0xae,
+	0xd6, 0x9e, 0xf5, 0x63, 0xed, 0x59, 0x1f, 0x36, 0x5e, 0xe7, 0x6a, 0xe3, 0x75, 0xbe, 0x6d, 0xbc,
+	0xce, 0xbb, 0x70, 0x22, 0x8a, 0xe9, 0x22, 0x21, 0x1c, 0x25, 0x2d, 0x70, 0x06, 0xa9, 0x58, 0xc1,
+	0xb0, 0xa2, 0x45, 0x35, 0xe4, 0x53, 0x26, 0x52, 0x5a, 0xbe, 0xa0, 0xd5, 0xef, 0x7d, 0x2c, 0x96,
+	0x19, 0xa8, 0x64, 0xbf, 0xdd, 0x8a, 0xe7, 0xbf, 0x06, 0x00, 0x02, 0xac, 0x1a, 0x59, 0xae, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProposerFee.Size()
+		i -= size
+		if _, err := m.ProposerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxBlockSpace.Size()
+		i -= size
+		if _, err := m.MaxBlockSpace.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MaxBlockSpace.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBundleSize))
+	}
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ProposerFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockSpace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockSpace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
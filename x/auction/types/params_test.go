@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	requireT := require.New(t)
+
+	params := DefaultParams()
+	requireT.False(params.Enabled)
+	requireT.NoError(params.ValidateBasic())
+	requireT.ErrorIs(params.ValidateBid(params.ReservePrice, 1), ErrAuctionDisabled)
+}
+
+func TestParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		modify    func(p *Params)
+		expectErr bool
+	}{
+		{
+			name:   "valid",
+			modify: func(p *Params) {},
+		},
+		{
+			name: "full_block_space",
+			modify: func(p *Params) {
+				p.MaxBlockSpace = sdkmath.LegacyOneDec()
+			},
+		},
+		{
+			name: "zero_block_space",
+			modify: func(p *Params) {
+				p.MaxBlockSpace = sdkmath.LegacyZeroDec()
+			},
+			expectErr: true,
+		},
+		{
+			name: "block_space_above_one",
+			modify: func(p *Params) {
+				p.MaxBlockSpace = sdkmath.LegacyNewDecWithPrec(11, 1)
+			},
+			expectErr: true,
+		},
+		{
+			name: "zero_bundle_size",
+			modify: func(p *Params) {
+				p.MaxBundleSize = 0
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid_reserve_price",
+			modify: func(p *Params) {
+				p.ReservePrice = sdk.Coin{Denom: "1invalid", Amount: sdkmath.OneInt()}
+			},
+			expectErr: true,
+		},
+		{
+			name: "negative_proposer_fee",
+			modify: func(p *Params) {
+				p.ProposerFee = sdkmath.LegacyNewDec(-1)
+			},
+			expectErr: true,
+		},
+		{
+			name: "proposer_fee_above_one",
+			modify: func(p *Params) {
+				p.ProposerFee = sdkmath.LegacyNewDecWithPrec(11, 1)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.modify(&params)
+			err := params.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParams_ValidateBid(t *testing.T) {
+	requireT := require.New(t)
+
+	params := DefaultParams()
+	params.Enabled = true
+	params.MaxBundleSize = 2
+
+	requireT.NoError(params.ValidateBid(params.ReservePrice, 2))
+	requireT.ErrorIs(params.ValidateBid(params.ReservePrice, 3), ErrInvalidBundle)
+	requireT.ErrorIs(params.ValidateBid(params.ReservePrice.SubAmount(sdkmath.OneInt()), 1), ErrInvalidBid)
+	requireT.ErrorIs(params.ValidateBid(sdk.NewCoin("otherdenom", params.ReservePrice.Amount), 1), ErrInvalidBid)
+}
+
+func TestParams_MaxBlockSpaceLimit(t *testing.T) {
+	requireT := require.New(t)
+
+	params := DefaultParams()
+	params.MaxBlockSpace = sdkmath.LegacyNewDecWithPrec(25, 2)
+
+	requireT.EqualValues(250, params.MaxBlockSpaceLimit(1_000))
+	requireT.EqualValues(0, params.MaxBlockSpaceLimit(0))
+	requireT.EqualValues(0, params.MaxBlockSpaceLimit(-1))
+}
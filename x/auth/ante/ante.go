@@ -16,10 +16,14 @@ import (
 	ibcante "github.com/cosmos/ibc-go/v10/modules/core/ante"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
+	"github.com/tokenize-x/tx-chain/v7/pkg/sigverify"
+	assetftante "github.com/tokenize-x/tx-chain/v7/x/asset/ft/ante"
+	auctionante "github.com/tokenize-x/tx-chain/v7/x/auction/ante"
 	authkeeper "github.com/tokenize-x/tx-chain/v7/x/auth/keeper"
 	"github.com/tokenize-x/tx-chain/v7/x/deterministicgas"
 	deterministicgasante "github.com/tokenize-x/tx-chain/v7/x/deterministicgas/ante"
 	feemodelante "github.com/tokenize-x/tx-chain/v7/x/feemodel/ante"
+	feereferralante "github.com/tokenize-x/tx-chain/v7/x/feereferral/ante"
 )
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
@@ -27,16 +31,30 @@ type HandlerOptions struct {
 	authante.HandlerOptions
 	DeterministicGasConfig deterministicgas.Config
 	FeeModelKeeper         feemodelante.Keeper
+	AssetFTKeeper          assetftante.Keeper
+	FeeReferralKeeper      feereferralante.Keeper
 	WasmConfig             wasmtypes.NodeConfig
 	IBCKeeper              *ibckeeper.Keeper
 	GovKeeper              *govkeeper.Keeper
 	WasmTXCounterStoreKey  store.KVStoreService
+	// SigVerifier verifies the signatures in parallel, nil if the parallel verification is disabled.
+	SigVerifier *sigverify.Verifier
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
 // signer.
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	anteDecorators, err := NewAnteDecorators(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+// NewAnteDecorators returns the decorators of the AnteHandler in the order they are executed.
+func NewAnteDecorators(options HandlerOptions) ([]sdk.AnteDecorator, error) {
 	if options.AccountKeeper == nil {
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrLogic, "account keeper is required for ante builder")
 	}
@@ -49,6 +67,14 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrLogic, "fee model keeper is required for ante builder")
 	}
 
+	if options.AssetFTKeeper == nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrLogic, "asset ft keeper is required for ante builder")
+	}
+
+	if options.FeeReferralKeeper == nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrLogic, "fee referral keeper is required for ante builder")
+	}
+
 	if options.IBCKeeper == nil {
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrLogic, "IBC keeper is required for ante builder")
 	}
@@ -109,6 +135,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		authante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		authante.NewValidateBasicDecorator(),
 		authante.NewTxTimeoutHeightDecorator(),
+		assetftante.NewIssueDecorator(options.AssetFTKeeper),
+		feereferralante.NewReferralDecorator(options.FeeReferralKeeper),
+		auctionante.NewBidDecorator(),
 		// after setup context to enforce limits early
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit),
 		wasmkeeper.NewCountTXDecorator(options.WasmTXCounterStoreKey),
@@ -120,8 +149,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		authante.NewSetPubKeyDecorator(options.AccountKeeper),
 		authante.NewValidateSigCountDecorator(options.AccountKeeper),
+		// ParallelSigVerificationDecorator must be called right before the SDK signature verification decorator
+		NewParallelSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigVerifier),
 		authante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigVerifyOptions...),
 		authante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		assetftante.NewIssuerActivityDecorator(options.AssetFTKeeper),
 		deterministicgasante.NewAddBaseGasDecorator(infiniteAccountKeeper, options.DeterministicGasConfig),
 		authante.NewConsumeGasForTxSizeDecorator(infiniteAccountKeeper),
 		authante.NewSigGasConsumeDecorator(infiniteAccountKeeper, options.SigGasConsumer),
@@ -129,5 +161,5 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
 	}
 
-	return sdk.ChainAnteDecorators(anteDecorators...), nil
+	return anteDecorators, nil
 }
@@ -0,0 +1,188 @@
+package ante
+
+import (
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	storetypes "cosmossdk.io/store/types"
+	txsigning "cosmossdk.io/x/tx/signing"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/sigverify"
+)
+
+// ParallelSigVerificationDecorator verifies the secp256k1 signatures of the transaction using the parallel verifier
+// before the SDK signature verification decorator. If all the signatures are valid, the verification in the SDK
+// decorator is skipped, otherwise the SDK decorator verifies them again and returns the error, so the outcome of the
+// transaction is the same as without this decorator.
+type ParallelSigVerificationDecorator struct {
+	ak              authante.AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+	verifier        *sigverify.Verifier
+}
+
+// NewParallelSigVerificationDecorator creates new ParallelSigVerificationDecorator. The decorator does nothing if
+// the verifier is nil.
+func NewParallelSigVerificationDecorator(
+	ak authante.AccountKeeper,
+	signModeHandler *txsigning.HandlerMap,
+	verifier *sigverify.Verifier,
+) ParallelSigVerificationDecorator {
+	return ParallelSigVerificationDecorator{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		verifier:        verifier,
+	}
+}
+
+// AnteHandle verifies the signatures of the transaction.
+func (psvd ParallelSigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	if psvd.verifier == nil || simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigs, ok := collectSignatures(ctx, psvd.ak, psvd.signModeHandler, tx, false)
+	if !ok || !psvd.verifier.VerifyAll(sigs...) {
+		return next(ctx, tx, simulate)
+	}
+
+	newCtx, err := next(ctx.WithIsSigverifyTx(false), tx, simulate)
+	return newCtx.WithIsSigverifyTx(true), err
+}
+
+// PrefetchSignatures schedules the verification of the secp256k1 signatures of the transactions using the account
+// state of the context, so the results are ready once the transactions are executed. The prefetched signatures not
+// matching the state at the time of the execution are never used.
+func PrefetchSignatures(
+	ctx sdk.Context,
+	ak authante.AccountKeeper,
+	signModeHandler *txsigning.HandlerMap,
+	verifier *sigverify.Verifier,
+	txs []sdk.Tx,
+) {
+	for _, tx := range txs {
+		if sigs, ok := collectSignatures(ctx, ak, signModeHandler, tx, true); ok {
+			verifier.Prefetch(sigs...)
+		}
+	}
+}
+
+// collectSignatures returns the signatures of the transaction together with the sign bytes built the same way as in
+// the SDK signature verification decorator. It returns false if any signature can't be verified by the verifier.
+func collectSignatures(
+	ctx sdk.Context,
+	ak authante.AccountKeeper,
+	signModeHandler *txsigning.HandlerMap,
+	tx sdk.Tx,
+	prefetch bool,
+) ([]sigverify.Signature, bool) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, false
+	}
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil, false
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, false
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil || len(sigs) != len(signers) {
+		return nil, false
+	}
+
+	// the gas consumed here must not affect the gas of the transaction
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	txData := adaptableTx.GetSigningTxData()
+	res := make([]sigverify.Signature, 0, len(sigs))
+	for i, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return nil, false
+		}
+		signMode, ok := supportedSignMode(data.SignMode)
+		if !ok {
+			return nil, false
+		}
+
+		acc := ak.GetAccount(ctx, signers[i])
+		if acc == nil {
+			return nil, false
+		}
+		pubKey := acc.GetPubKey()
+		// the public key is set from the transaction before the verification if it is not set yet
+		if pubKey == nil && prefetch {
+			pubKey = sig.PubKey
+		}
+		if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+			return nil, false
+		}
+
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+		bz, err := signBytes(
+			ctx, signModeHandler, signMode, acc.GetAddress().String(), accNum, sig.Sequence, pubKey, txData,
+		)
+		if err != nil {
+			return nil, false
+		}
+
+		res = append(res, sigverify.Signature{
+			PubKey:    pubKey,
+			SignBytes: bz,
+			Signature: data.Signature,
+		})
+	}
+
+	return res, true
+}
+
+// supportedSignMode returns the sign mode if it is supported. The sign modes reading the state, like textual, are not
+// supported because skipping their verification in the SDK decorator would change the state reads of the transaction.
+func supportedSignMode(mode signing.SignMode) (signingv1beta1.SignMode, bool) {
+	switch mode {
+	case signing.SignMode_SIGN_MODE_DIRECT:
+		return signingv1beta1.SignMode_SIGN_MODE_DIRECT, true
+	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		return signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, true
+	default:
+		return signingv1beta1.SignMode_SIGN_MODE_UNSPECIFIED, false
+	}
+}
+
+func signBytes(
+	ctx sdk.Context,
+	signModeHandler *txsigning.HandlerMap,
+	signMode signingv1beta1.SignMode,
+	address string,
+	accNum, sequence uint64,
+	pubKey cryptotypes.PubKey,
+	txData txsigning.TxData,
+) ([]byte, error) {
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return signModeHandler.GetSignBytes(ctx, signMode, txsigning.SignerData{
+		Address:       address,
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      sequence,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}, txData)
+}
@@ -0,0 +1,329 @@
+package ante_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/sigverify"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/auth/ante"
+)
+
+func TestParallelSigVerificationDecorator(t *testing.T) {
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Height: 1, ChainID: testApp.ChainID()})
+
+	verifier := sigverify.NewVerifier(2, sigverify.DefaultMaxPending)
+	t.Cleanup(verifier.Close)
+
+	secpKey := secp256k1.GenPrivKey()
+	secpAcc := setAccount(ctx, testApp, secpKey.PubKey(), true)
+	noPubKey := secp256k1.GenPrivKey()
+	noPubKeyAcc := setAccount(ctx, testApp, noPubKey.PubKey(), false)
+	edKey := ed25519.GenPrivKey()
+	edAcc := setAccount(ctx, testApp, edKey.PubKey(), true)
+	multisigKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	multisigPubKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{
+		multisigKeys[0].PubKey(), multisigKeys[1].PubKey(),
+	})
+	multisigAcc := setAccount(ctx, testApp, multisigPubKey, true)
+
+	genTx := func(priv cryptotypes.PrivKey, accNum, seq uint64) sdk.Tx {
+		tx, err := simtestutil.GenSignedMockTx(
+			rand.New(rand.NewSource(0)),
+			testApp.TxConfig(),
+			[]sdk.Msg{sendMsg(priv.PubKey())},
+			sdk.NewCoins(),
+			100_000,
+			testApp.ChainID(),
+			[]uint64{accNum},
+			[]uint64{seq},
+			priv,
+		)
+		require.NoError(t, err)
+		return tx
+	}
+
+	tests := []struct {
+		name string
+		tx   func() sdk.Tx
+		// parallel is true if the signatures are verified by the parallel verifier and skipped by the SDK decorator
+		parallel    bool
+		expectedErr error
+	}{
+		{
+			name:     "valid",
+			tx:       func() sdk.Tx { return genTx(secpKey, secpAcc.GetAccountNumber(), secpAcc.GetSequence()) },
+			parallel: true,
+		},
+		{
+			name: "invalid_signature",
+			tx: func() sdk.Tx {
+				return corruptSignature(t, testApp, genTx(secpKey, secpAcc.GetAccountNumber(), secpAcc.GetSequence()))
+			},
+			expectedErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "wrong_sequence",
+			tx: func() sdk.Tx {
+				return genTx(secpKey, secpAcc.GetAccountNumber(), secpAcc.GetSequence()+1)
+			},
+			parallel:    true,
+			expectedErr: cosmoserrors.ErrWrongSequence,
+		},
+		{
+			name: "wrong_account_number",
+			tx: func() sdk.Tx {
+				return genTx(secpKey, secpAcc.GetAccountNumber()+1, secpAcc.GetSequence())
+			},
+			expectedErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "no_pub_key",
+			tx: func() sdk.Tx {
+				return genTx(noPubKey, noPubKeyAcc.GetAccountNumber(), noPubKeyAcc.GetSequence())
+			},
+			expectedErr: cosmoserrors.ErrInvalidPubKey,
+		},
+		{
+			name: "ed25519",
+			tx:   func() sdk.Tx { return genTx(edKey, edAcc.GetAccountNumber(), edAcc.GetSequence()) },
+		},
+		{
+			name: "ed25519_invalid_signature",
+			tx: func() sdk.Tx {
+				return corruptSignature(t, testApp, genTx(edKey, edAcc.GetAccountNumber(), edAcc.GetSequence()))
+			},
+			expectedErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "multisig",
+			tx: func() sdk.Tx {
+				return genMultisigTx(ctx, t, testApp, multisigPubKey, multisigKeys, multisigAcc, multisigKeys[0])
+			},
+		},
+		{
+			name: "multisig_invalid_signature",
+			tx: func() sdk.Tx {
+				return genMultisigTx(ctx, t, testApp, multisigPubKey, multisigKeys, multisigAcc, secp256k1.GenPrivKey())
+			},
+			expectedErr: cosmoserrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			tx := tt.tx()
+
+			sdkErr := runSDKSigVerification(ctx, testApp, tx)
+			parallel, err := runParallelSigVerification(ctx, testApp, verifier, tx)
+			requireT.Equal(tt.parallel, parallel)
+			if tt.expectedErr == nil {
+				requireT.NoError(sdkErr)
+				requireT.NoError(err)
+				return
+			}
+			requireT.ErrorIs(sdkErr, tt.expectedErr)
+			requireT.ErrorIs(err, tt.expectedErr)
+			requireT.Equal(sdkErr.Error(), err.Error())
+		})
+	}
+}
+
+func TestPrefetchSignatures_StaleSequence(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Height: 1, ChainID: testApp.ChainID()})
+
+	verifier := sigverify.NewVerifier(2, sigverify.DefaultMaxPending)
+	t.Cleanup(verifier.Close)
+
+	privKey := secp256k1.GenPrivKey()
+	acc := setAccount(ctx, testApp, privKey.PubKey(), true)
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(0)),
+		testApp.TxConfig(),
+		[]sdk.Msg{sendMsg(privKey.PubKey())},
+		sdk.NewCoins(),
+		100_000,
+		testApp.ChainID(),
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		privKey,
+	)
+	requireT.NoError(err)
+
+	ante.PrefetchSignatures(ctx, testApp.AccountKeeper, testApp.TxConfig().SignModeHandler(), verifier, []sdk.Tx{tx})
+
+	// the sequence is incremented by another transaction executed before
+	requireT.NoError(acc.SetSequence(acc.GetSequence() + 1))
+	testApp.AccountKeeper.SetAccount(ctx, acc)
+
+	// the prefetched signature doesn't verify the transaction claiming the current sequence
+	txBytes, err := testApp.TxConfig().TxEncoder()(tx)
+	requireT.NoError(err)
+	bumpedTx, err := testApp.TxConfig().TxDecoder()(txBytes)
+	requireT.NoError(err)
+	txBuilder, err := testApp.TxConfig().WrapTxBuilder(bumpedTx)
+	requireT.NoError(err)
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	requireT.NoError(err)
+	sigs[0].Sequence = acc.GetSequence()
+	requireT.NoError(txBuilder.SetSignatures(sigs...))
+
+	parallel, err := runParallelSigVerification(ctx, testApp, verifier, txBuilder.GetTx())
+	requireT.False(parallel)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the prefetched transaction is rejected
+	_, err = runParallelSigVerification(ctx, testApp, verifier, tx)
+	requireT.ErrorIs(err, cosmoserrors.ErrWrongSequence)
+}
+
+func setAccount(
+	ctx sdk.Context, testApp *simapp.App, pubKey cryptotypes.PubKey, withPubKey bool,
+) sdk.AccountI {
+	acc := testApp.AccountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(pubKey.Address()))
+	if withPubKey {
+		if err := acc.SetPubKey(pubKey); err != nil {
+			panic(err)
+		}
+	}
+	testApp.AccountKeeper.SetAccount(ctx, acc)
+	return acc
+}
+
+func sendMsg(from cryptotypes.PubKey) sdk.Msg {
+	return &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(from.Address()).String(),
+		ToAddress:   sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))),
+	}
+}
+
+func corruptSignature(t *testing.T, testApp *simapp.App, tx sdk.Tx) sdk.Tx {
+	txBuilder, err := testApp.TxConfig().WrapTxBuilder(tx)
+	require.NoError(t, err)
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	data, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	require.True(t, ok)
+	data.Signature[0] ^= 0xff
+	require.NoError(t, txBuilder.SetSignatures(sigs...))
+	return txBuilder.GetTx()
+}
+
+// genMultisigTx returns the transaction of the 2-of-2 multisig signed by the signer in place of the first key.
+func genMultisigTx(
+	ctx sdk.Context,
+	t *testing.T,
+	testApp *simapp.App,
+	pubKey *kmultisig.LegacyAminoPubKey,
+	keys []cryptotypes.PrivKey,
+	acc sdk.AccountI,
+	signer cryptotypes.PrivKey,
+) sdk.Tx {
+	requireT := require.New(t)
+
+	txBuilder := testApp.TxConfig().NewTxBuilder()
+	requireT.NoError(txBuilder.SetMsgs(sendMsg(pubKey)))
+	txBuilder.SetGasLimit(100_000)
+	requireT.NoError(txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     multisig.NewMultisig(len(keys)),
+		Sequence: acc.GetSequence(),
+	}))
+
+	signBytes, err := authsigning.GetSignBytesAdapter(
+		context.Background(),
+		testApp.TxConfig().SignModeHandler(),
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		authsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        pubKey,
+		},
+		txBuilder.GetTx(),
+	)
+	requireT.NoError(err)
+
+	sigData := multisig.NewMultisig(len(keys))
+	for i, key := range []cryptotypes.PrivKey{signer, keys[1]} {
+		sig, err := key.Sign(signBytes)
+		requireT.NoError(err)
+		requireT.NoError(multisig.AddSignatureV2(sigData, signing.SignatureV2{
+			PubKey: keys[i].PubKey(),
+			Data: &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+				Signature: sig,
+			},
+		}, pubKey.GetPubKeys()))
+	}
+	requireT.NoError(txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     sigData,
+		Sequence: acc.GetSequence(),
+	}))
+
+	return txBuilder.GetTx()
+}
+
+func runSDKSigVerification(ctx sdk.Context, testApp *simapp.App, tx sdk.Tx) error {
+	ctx, _ = ctx.CacheContext()
+	anteHandler := sdk.ChainAnteDecorators(
+		authante.NewSigVerificationDecorator(testApp.AccountKeeper, testApp.TxConfig().SignModeHandler()),
+	)
+	_, err := anteHandler(ctx, tx, false)
+	return err
+}
+
+// runParallelSigVerification runs the parallel decorator followed by the SDK one and returns true if the SDK
+// decorator is run with the signature verification skipped.
+func runParallelSigVerification(
+	ctx sdk.Context, testApp *simapp.App, verifier *sigverify.Verifier, tx sdk.Tx,
+) (bool, error) {
+	ctx, _ = ctx.CacheContext()
+	var parallel bool
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewParallelSigVerificationDecorator(
+			testApp.AccountKeeper, testApp.TxConfig().SignModeHandler(), verifier,
+		),
+		sigVerifyRecorder{parallel: &parallel},
+		authante.NewSigVerificationDecorator(testApp.AccountKeeper, testApp.TxConfig().SignModeHandler()),
+	)
+	_, err := anteHandler(ctx, tx, false)
+	return parallel, err
+}
+
+type sigVerifyRecorder struct {
+	parallel *bool
+}
+
+func (r sigVerifyRecorder) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	*r.parallel = !ctx.IsSigverifyTx()
+	return next(ctx, tx, simulate)
+}
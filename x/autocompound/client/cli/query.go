@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+)
+
+// GetQueryCmd returns the parent command for all CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the autocompound module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryAutoCompound(),
+		CmdQueryAutoCompounds(),
+	)
+
+	return cmd
+}
+
+// CmdQueryParams implements a command to fetch autocompound parameters.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: fmt.Sprintf("Query the current %s parameters", types.ModuleName),
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query parameters for the %s module:
+
+Example:
+$ %s query %s params
+`,
+				types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryAutoCompound implements a command to fetch the opt-in of the delegator.
+func CmdQueryAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator]",
+		Short: "Query the opt-in of the delegator to the compounding of the staking rewards",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the opt-in of the delegator to the compounding of the staking rewards.
+
+Example:
+$ %s query %s auto-compound [delegator]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AutoCompound(cmd.Context(), &types.QueryAutoCompoundRequest{
+				Delegator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryAutoCompounds implements a command to fetch the opt-ins of all the delegators.
+func CmdQueryAutoCompounds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-compounds",
+		Short: "Query the opt-ins of all the delegators to the compounding of the staking rewards",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the opt-ins of all the delegators to the compounding of the staking rewards.
+
+Example:
+$ %s query %s auto-compounds
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AutoCompounds(cmd.Context(), &types.QueryAutoCompoundsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auto-compounds")
+
+	return cmd
+}
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      types.ModuleName + " transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdSetAutoCompound(),
+		CmdDisableAutoCompound(),
+	)
+
+	return cmd
+}
+
+// CmdSetAutoCompound returns SetAutoCompound cobra command.
+func CmdSetAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set [threshold] --from [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Opt in to the compounding of the staking rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in to the compounding of the staking rewards or update the threshold. At the epoch
+boundary the rewards are withdrawn and delegated back to the validators if they reach the threshold, in the base
+units of the bond denom.
+
+Example:
+$ %s tx %s set 1000000 --from [delegator]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			threshold, ok := sdkmath.NewIntFromString(args[0])
+			if !ok {
+				return errors.Errorf("invalid threshold %q", args[0])
+			}
+			msg := &types.MsgSetAutoCompound{
+				Delegator: clientCtx.GetFromAddress().String(),
+				Threshold: threshold,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdDisableAutoCompound returns DisableAutoCompound cobra command.
+func CmdDisableAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable --from [delegator]",
+		Args:  cobra.NoArgs,
+		Short: "Opt out of the compounding of the staking rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt out of the compounding of the staking rewards.
+
+Example:
+$ %s tx %s disable --from [delegator]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgDisableAutoCompound{
+				Delegator: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+)
+
+// SetAutoCompound opts the delegator in to the compounding of the staking rewards or updates the threshold.
+func (k Keeper) SetAutoCompound(ctx context.Context, delegator sdk.AccAddress, threshold sdkmath.Int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if threshold.LT(params.MinThreshold) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidInput, "threshold %s is lower than the min threshold %s", threshold, params.MinThreshold,
+		)
+	}
+
+	return k.AutoCompounds.Set(ctx, delegator, types.AutoCompound{
+		Delegator: delegator.String(),
+		Threshold: threshold,
+	})
+}
+
+// DisableAutoCompound opts the delegator out of the compounding of the staking rewards.
+func (k Keeper) DisableAutoCompound(ctx context.Context, delegator sdk.AccAddress) error {
+	if _, err := k.GetAutoCompound(ctx, delegator); err != nil {
+		return err
+	}
+
+	return k.AutoCompounds.Remove(ctx, delegator)
+}
+
+// GetAutoCompound returns the opt-in of the delegator.
+func (k Keeper) GetAutoCompound(ctx context.Context, delegator sdk.AccAddress) (types.AutoCompound, error) {
+	autoCompound, err := k.AutoCompounds.Get(ctx, delegator)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.AutoCompound{}, errorsmod.Wrapf(types.ErrNotFound, "delegator %s", delegator)
+	}
+
+	return autoCompound, err
+}
+
+// StartCompounding starts the compounding of the rewards of the delegators who opted in, unless the compounding
+// started at the end of the previous epoch is still in progress. The delegators are processed by ProcessCompounding.
+// Called at the end of each compounding epoch.
+func (k Keeper) StartCompounding(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled {
+		return nil
+	}
+
+	inProgress, err := k.NextDelegator.Has(ctx)
+	if err != nil {
+		return err
+	}
+	if inProgress {
+		return nil
+	}
+
+	iter, err := k.AutoCompounds.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	firstDelegator, err := iter.Key()
+	if err != nil {
+		return err
+	}
+
+	return k.NextDelegator.Set(ctx, firstDelegator)
+}
+
+// ProcessCompounding compounds the rewards of the delegators if the compounding is in progress. At most max compounds
+// per block delegators are processed in one block, so the compounding continues in the next blocks if more delegators
+// opted in. Should be called from EndBlock.
+func (k Keeper) ProcessCompounding(ctx context.Context) error {
+	nextDelegator, err := k.NextDelegator.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled {
+		return nil
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	iter, err := k.AutoCompounds.Iterate(ctx, new(collections.Range[sdk.AccAddress]).StartInclusive(nextDelegator))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var processed uint32
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		if processed == params.MaxCompoundsPerBlock {
+			return k.NextDelegator.Set(ctx, kv.Key)
+		}
+		processed++
+
+		if err := k.compound(ctx, bondDenom, kv.Key, kv.Value.Threshold); err != nil {
+			return err
+		}
+	}
+
+	return k.NextDelegator.Remove(ctx)
+}
+
+type compounding struct {
+	valAddr sdk.ValAddress
+	amount  sdkmath.Int
+}
+
+// compound withdraws the rewards of the delegator and delegates them back to the validators they are paid by, if
+// the rewards in the bond denom reach the threshold. The rewards are delegated using the staking keeper, so the
+// hooks of the other modules are executed the same way as for the delegations sent by the delegator. In particular
+// the PSE score accrued by the delegation so far is finalized and the compounded tokens accrue the score from now
+// on, while the delegation stays continuous, since the rewards are added to the existing delegations only. The
+// failed compounding is skipped, so it never halts the chain.
+func (k Keeper) compound(ctx context.Context, bondDenom string, delegator sdk.AccAddress, threshold sdkmath.Int) error {
+	withdrawAddr, err := k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if !withdrawAddr.Equals(delegator) {
+		return emitCompoundSkipped(ctx, delegator, types.CompoundSkipReasonWithdrawAddress)
+	}
+
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+	compoundings, total, err := k.withdrawRewards(cacheCtx, bondDenom, delegator)
+	if err != nil {
+		return emitCompoundSkipped(ctx, delegator, err.Error())
+	}
+	if !total.IsPositive() || total.LT(threshold) {
+		return nil
+	}
+
+	for _, c := range compoundings {
+		validator, err := k.stakingKeeper.GetValidator(cacheCtx, c.valAddr)
+		if err != nil {
+			return emitCompoundSkipped(ctx, delegator, err.Error())
+		}
+		if _, err := k.stakingKeeper.Delegate(
+			cacheCtx, delegator, c.amount, stakingtypes.Unbonded, validator, true,
+		); err != nil {
+			return emitCompoundSkipped(ctx, delegator, err.Error())
+		}
+		if err := cacheCtx.EventManager().EmitTypedEvent(&types.EventRewardsCompounded{
+			Delegator: delegator.String(),
+			Validator: validator.OperatorAddress,
+			Amount:    sdk.NewCoin(bondDenom, c.amount),
+		}); err != nil {
+			return err
+		}
+	}
+	writeCache()
+
+	return nil
+}
+
+// withdrawRewards withdraws the rewards of the delegator from the validators which are not jailed, the rewards of
+// the jailed validators keep accumulating until they are unjailed.
+func (k Keeper) withdrawRewards(
+	ctx context.Context,
+	bondDenom string,
+	delegator sdk.AccAddress,
+) ([]compounding, sdkmath.Int, error) {
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delegator)
+	if err != nil {
+		return nil, sdkmath.Int{}, err
+	}
+
+	compoundings := make([]compounding, 0, len(delegations))
+	total := sdkmath.ZeroInt()
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, sdkmath.Int{}, err
+		}
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, sdkmath.Int{}, err
+		}
+		if validator.IsJailed() {
+			continue
+		}
+
+		rewards, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, delegator, valAddr)
+		if err != nil {
+			return nil, sdkmath.Int{}, err
+		}
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+		compoundings = append(compoundings, compounding{
+			valAddr: valAddr,
+			amount:  amount,
+		})
+		total = total.Add(amount)
+	}
+
+	return compoundings, total, nil
+}
+
+func emitCompoundSkipped(ctx context.Context, delegator sdk.AccAddress, reason string) error {
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCompoundSkipped{
+		Delegator: delegator.String(),
+		Reason:    reason,
+	})
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+	epochstypes "github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+)
+
+func TestKeeper_SetAutoCompound(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	autoCompoundKeeper := testApp.AutoCompoundKeeper
+
+	delegator, _ := testApp.GenAccount(ctx)
+
+	params := types.DefaultParams()
+	params.MinThreshold = sdkmath.NewInt(100)
+	requireT.NoError(autoCompoundKeeper.SetParams(ctx, params))
+
+	requireT.ErrorIs(autoCompoundKeeper.SetAutoCompound(ctx, delegator, sdkmath.NewInt(99)), types.ErrInvalidInput)
+	requireT.ErrorIs(autoCompoundKeeper.DisableAutoCompound(ctx, delegator), types.ErrNotFound)
+
+	requireT.NoError(autoCompoundKeeper.SetAutoCompound(ctx, delegator, sdkmath.NewInt(100)))
+	requireT.NoError(autoCompoundKeeper.SetAutoCompound(ctx, delegator, sdkmath.NewInt(200)))
+	autoCompound, err := autoCompoundKeeper.GetAutoCompound(ctx, delegator)
+	requireT.NoError(err)
+	requireT.Equal(types.AutoCompound{
+		Delegator: delegator.String(),
+		Threshold: sdkmath.NewInt(200),
+	}, autoCompound)
+
+	requireT.NoError(autoCompoundKeeper.DisableAutoCompound(ctx, delegator))
+	_, err = autoCompoundKeeper.GetAutoCompound(ctx, delegator)
+	requireT.ErrorIs(err, types.ErrNotFound)
+}
+
+func TestKeeper_ProcessCompounding(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	autoCompoundKeeper := testApp.AutoCompoundKeeper
+	stakingKeeper := testApp.StakingKeeper
+	distrKeeper := testApp.DistrKeeper
+
+	bondDenom, err := stakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.MaxCompoundsPerBlock = 2
+	params.MinThreshold = sdkmath.NewInt(1)
+	requireT.NoError(autoCompoundKeeper.SetParams(ctx, params))
+
+	operator, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, operator, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+	validator, err := testApp.AddValidator(ctx, operator, sdk.NewInt64Coin(bondDenom, 1_000_000), nil)
+	requireT.NoError(err)
+	valAddr := sdk.MustValAddressFromBech32(validator.GetOperator())
+
+	// compounded, below the threshold and withdrawing the rewards to the other address
+	delegators := make([]sdk.AccAddress, 3)
+	for i := range delegators {
+		delegators[i], _ = testApp.GenAccount(ctx)
+		requireT.NoError(testApp.FundAccount(ctx, delegators[i], sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+		validator, err = stakingKeeper.GetValidator(ctx, valAddr)
+		requireT.NoError(err)
+		_, err = stakingKeeper.Delegate(ctx, delegators[i], sdkmath.NewInt(1_000_000), stakingtypes.Unbonded, validator, true)
+		requireT.NoError(err)
+	}
+	requireT.NoError(autoCompoundKeeper.SetAutoCompound(ctx, delegators[0], sdkmath.NewInt(1)))
+	requireT.NoError(autoCompoundKeeper.SetAutoCompound(ctx, delegators[1], sdkmath.NewInt(1_000_000)))
+	requireT.NoError(autoCompoundKeeper.SetAutoCompound(ctx, delegators[2], sdkmath.NewInt(1)))
+	withdrawAddr, _ := testApp.GenAccount(ctx)
+	requireT.NoError(distrKeeper.SetWithdrawAddr(ctx, delegators[2], withdrawAddr))
+
+	// the rewards are allocated to the validator
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 40_000))
+	requireT.NoError(testApp.FundAccount(ctx, operator, rewards))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromAccountToModule(ctx, operator, distrtypes.ModuleName, rewards))
+	validator, err = stakingKeeper.GetValidator(ctx, valAddr)
+	requireT.NoError(err)
+	requireT.NoError(distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	delegationBefore, err := stakingKeeper.GetDelegation(ctx, delegators[0], valAddr)
+	requireT.NoError(err)
+	pseEntryBefore, err := testApp.PSEKeeper.GetDelegationTimeEntry(ctx, valAddr, delegators[0])
+	requireT.NoError(err)
+
+	// nothing is processed until the compounding epoch ends
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(autoCompoundKeeper.ProcessCompounding(ctx))
+	requireT.NoError(autoCompoundKeeper.EpochHooks().AfterEpochEnd(ctx, epochstypes.HourEpochID, 1))
+	requireT.NoError(autoCompoundKeeper.ProcessCompounding(ctx))
+	requireT.Empty(ctx.EventManager().ABCIEvents())
+
+	// the compounding is processed in two blocks
+	requireT.NoError(autoCompoundKeeper.EpochHooks().AfterEpochEnd(ctx, keeper.CompoundingEpochIdentifier, 1))
+	requireT.NoError(autoCompoundKeeper.ProcessCompounding(ctx))
+	nextDelegator, err := autoCompoundKeeper.NextDelegator.Get(ctx)
+	requireT.NoError(err)
+	requireT.NotEmpty(nextDelegator)
+	// the compounding in progress isn't restarted
+	requireT.NoError(autoCompoundKeeper.StartCompounding(ctx))
+	requireT.NoError(autoCompoundKeeper.ProcessCompounding(ctx))
+
+	compoundedEvents, err := event.FindTypedEvents[*types.EventRewardsCompounded](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(compoundedEvents, 1)
+	requireT.Equal(delegators[0].String(), compoundedEvents[0].Delegator)
+	requireT.Equal(valAddr.String(), compoundedEvents[0].Validator)
+	requireT.True(compoundedEvents[0].Amount.Amount.IsPositive())
+
+	skippedEvents, err := event.FindTypedEvents[*types.EventCompoundSkipped](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventCompoundSkipped{{
+		Delegator: delegators[2].String(),
+		Reason:    types.CompoundSkipReasonWithdrawAddress,
+	}}, skippedEvents)
+
+	hasNextDelegator, err := autoCompoundKeeper.NextDelegator.Has(ctx)
+	requireT.NoError(err)
+	requireT.False(hasNextDelegator)
+
+	// the rewards are delegated
+	delegationAfter, err := stakingKeeper.GetDelegation(ctx, delegators[0], valAddr)
+	requireT.NoError(err)
+	requireT.True(delegationAfter.Shares.GT(delegationBefore.Shares))
+
+	// the delegation stays continuous for the PSE score
+	pseEntryAfter, err := testApp.PSEKeeper.GetDelegationTimeEntry(ctx, valAddr, delegators[0])
+	requireT.NoError(err)
+	requireT.Equal(pseEntryBefore.DelegatedSinceUnixSec, pseEntryAfter.DelegatedSinceUnixSec)
+	requireT.Equal(ctx.BlockTime().Unix(), pseEntryAfter.LastChangedUnixSec)
+	requireT.Equal(delegationAfter.Shares, pseEntryAfter.Shares)
+
+	// the rewards below the threshold are not withdrawn
+	delegation, err := stakingKeeper.GetDelegation(ctx, delegators[1], valAddr)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.LegacyNewDec(1_000_000), delegation.Shares)
+
+	// nothing is processed until the next epoch
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	requireT.NoError(autoCompoundKeeper.ProcessCompounding(ctx))
+	requireT.Empty(ctx.EventManager().ABCIEvents())
+}
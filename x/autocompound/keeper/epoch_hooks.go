@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+)
+
+// CompoundingEpochIdentifier is the identifier of the epoch at the end of which the compounding of the rewards starts.
+const CompoundingEpochIdentifier = epochstypes.DayEpochID
+
+// EpochHooks implements the epoch hooks interface.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks creates new epoch hooks.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd implements the epoch hooks interface. It starts the compounding of the rewards.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	if epochIdentifier != CompoundingEpochIdentifier {
+		return nil
+	}
+	return h.k.StartCompounding(ctx)
+}
+
+// BeforeEpochStart implements the epoch hooks interface.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// EpochIdentifiers implements the epoch hooks interface.
+func (h EpochHooks) EpochIdentifiers() []string {
+	return []string{CompoundingEpochIdentifier}
+}
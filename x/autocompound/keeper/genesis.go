@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+)
+
+// InitGenesis initializes the autocompound module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, autoCompound := range genState.AutoCompounds {
+		delegator, err := sdk.AccAddressFromBech32(autoCompound.Delegator)
+		if err != nil {
+			return err
+		}
+		if err := k.AutoCompounds.Set(ctx, delegator, autoCompound); err != nil {
+			return err
+		}
+	}
+
+	if genState.NextDelegator == "" {
+		return nil
+	}
+	nextDelegator, err := sdk.AccAddressFromBech32(genState.NextDelegator)
+	if err != nil {
+		return err
+	}
+	return k.NextDelegator.Set(ctx, nextDelegator)
+}
+
+// ExportGenesis returns the autocompound module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis := &types.GenesisState{
+		Params:        params,
+		AutoCompounds: []types.AutoCompound{},
+	}
+	nextDelegator, err := k.NextDelegator.Get(ctx)
+	switch {
+	case err == nil:
+		genesis.NextDelegator = nextDelegator.String()
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+	if err := k.AutoCompounds.Walk(ctx, nil, func(_ sdk.AccAddress, autoCompound types.AutoCompound) (bool, error) {
+		genesis.AutoCompounds = append(genesis.AutoCompounds, autoCompound)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+)
+
+func TestGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	delegator1, _ := testApp.GenAccount(ctx)
+	delegator2, _ := testApp.GenAccount(ctx)
+	params := types.DefaultParams()
+	params.Enabled = true
+	genState := types.GenesisState{
+		Params: params,
+		AutoCompounds: []types.AutoCompound{
+			{Delegator: delegator1.String(), Threshold: sdkmath.NewInt(1_000_000)},
+			{Delegator: delegator2.String(), Threshold: sdkmath.NewInt(5_000_000)},
+		},
+		NextDelegator: delegator2.String(),
+	}
+	requireT.NoError(genState.Validate())
+
+	requireT.NoError(testApp.AutoCompoundKeeper.InitGenesis(ctx, genState))
+	exported, err := testApp.AutoCompoundKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState.Params, exported.Params)
+	requireT.Equal(genState.NextDelegator, exported.NextDelegator)
+	requireT.ElementsMatch(genState.AutoCompounds, exported.AutoCompounds)
+
+	// the duplicated auto-compounds are rejected
+	genState.AutoCompounds = append(genState.AutoCompounds, types.AutoCompound{
+		Delegator: delegator1.String(),
+		Threshold: sdkmath.NewInt(2_000_000),
+	})
+	requireT.ErrorIs(genState.Validate(), types.ErrInvalidInput)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryService serves grpc requests for the module.
+type QueryService struct {
+	keeper Keeper
+}
+
+// NewQueryService creates query service.
+func NewQueryService(keeper Keeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params returns the parameters of the module.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// AutoCompound returns the opt-in of the delegator.
+func (qs QueryService) AutoCompound(
+	ctx context.Context, req *types.QueryAutoCompoundRequest,
+) (*types.QueryAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+	autoCompound, err := qs.keeper.GetAutoCompound(ctx, delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoCompoundResponse{
+		AutoCompound: autoCompound,
+	}, nil
+}
+
+// AutoCompounds returns the opt-ins of all the delegators.
+func (qs QueryService) AutoCompounds(
+	ctx context.Context, req *types.QueryAutoCompoundsRequest,
+) (*types.QueryAutoCompoundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	autoCompounds, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.AutoCompounds,
+		req.Pagination,
+		func(_ sdk.AccAddress, autoCompound types.AutoCompound) (types.AutoCompound, error) {
+			return autoCompound, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoCompoundsResponse{
+		AutoCompounds: autoCompounds,
+		Pagination:    pageRes,
+	}, nil
+}
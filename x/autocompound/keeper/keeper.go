@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	sdkstore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	authority    string
+
+	// codec
+	cdc codec.Codec
+
+	// keepers
+	stakingKeeper      types.StakingKeeper
+	distributionKeeper types.DistributionKeeper
+
+	// collections
+	Schema        collections.Schema
+	Params        collections.Item[types.Params]
+	AutoCompounds collections.Map[sdk.AccAddress, types.AutoCompound]
+	NextDelegator collections.Item[sdk.AccAddress]
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.Codec,
+	authority string,
+	stakingKeeper types.StakingKeeper,
+	distributionKeeper types.DistributionKeeper,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:       storeService,
+		cdc:                cdc,
+		authority:          authority,
+		stakingKeeper:      stakingKeeper,
+		distributionKeeper: distributionKeeper,
+
+		Params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			codec.CollValue[types.Params](cdc),
+		),
+		AutoCompounds: collections.NewMap(
+			sb,
+			types.AutoCompoundsKey,
+			"auto_compounds",
+			sdk.AccAddressKey,
+			codec.CollValue[types.AutoCompound](cdc),
+		),
+		NextDelegator: collections.NewItem(
+			sb,
+			types.NextDelegatorKey,
+			"next_delegator",
+			collcodec.KeyToValueCodec(sdk.AccAddressKey),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// UpdateParams is a governance operation that sets parameters of the module.
+func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateParams(ctx, req.Authority, req.Params); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// SetAutoCompound opts the delegator in to the compounding of the staking rewards.
+func (ms MsgServer) SetAutoCompound(ctx context.Context, req *types.MsgSetAutoCompound) (*types.EmptyResponse, error) {
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.SetAutoCompound(ctx, delegator, req.Threshold); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// DisableAutoCompound opts the delegator out of the compounding of the staking rewards.
+func (ms MsgServer) DisableAutoCompound(
+	ctx context.Context,
+	req *types.MsgDisableAutoCompound,
+) (*types.EmptyResponse, error) {
+	delegator, err := sdk.AccAddressFromBech32(req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.DisableAutoCompound(ctx, delegator); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons of the skipped compounding which are not errors.
+const (
+	// CompoundSkipReasonWithdrawAddress is the reason used when the rewards of the delegator are withdrawn to the
+	// different address, so they can't be delegated by the delegator.
+	CompoundSkipReasonWithdrawAddress = "withdraw_address"
+)
+
+// ValidateBasic validates the auto-compound.
+func (a AutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Delegator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid delegator address %q: %s", a.Delegator, err)
+	}
+	if a.Threshold.IsNil() || !a.Threshold.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidInput, "threshold must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper interface for the staking operations. It must be the keeper with the hooks set, so the compounded
+// delegations are tracked by the other modules, e.g. by the PSE scores.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	ValidatorAddressCodec() address.Codec
+	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	Delegate(
+		ctx context.Context, delAddr sdk.AccAddress, bondAmt sdkmath.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool,
+	) (sdkmath.LegacyDec, error)
+}
+
+// DistributionKeeper interface for the distribution operations.
+type DistributionKeeper interface {
+	GetDelegatorWithdrawAddr(ctx context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, error)
+	WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+}
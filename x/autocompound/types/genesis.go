@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:        DefaultParams(),
+		AutoCompounds: []AutoCompound{},
+	}
+}
+
+// Validate validates genesis parameters.
+func (m GenesisState) Validate() error {
+	if err := m.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	delegators := make(map[string]struct{}, len(m.AutoCompounds))
+	for _, autoCompound := range m.AutoCompounds {
+		if err := autoCompound.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, found := delegators[autoCompound.Delegator]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate auto-compound of delegator %s", autoCompound.Delegator)
+		}
+		delegators[autoCompound.Delegator] = struct{}{}
+	}
+
+	if m.NextDelegator != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NextDelegator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid next delegator %q: %s", m.NextDelegator, err)
+		}
+	}
+
+	return nil
+}
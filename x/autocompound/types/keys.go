@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "autocompound"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// KVStore keys.
+var (
+	ParamsKey        = collections.NewPrefix(0)
+	AutoCompoundsKey = collections.NewPrefix(1) // Map: delegator -> auto-compound
+	NextDelegatorKey = collections.NewPrefix(2)
+)
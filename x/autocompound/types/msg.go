@@ -0,0 +1,45 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type extendedMsg interface {
+	sdk.Msg
+	sdk.HasValidateBasic
+}
+
+var (
+	_ extendedMsg = &MsgUpdateParams{}
+	_ extendedMsg = &MsgSetAutoCompound{}
+	_ extendedMsg = &MsgDisableAutoCompound{}
+)
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return m.Params.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if m.Threshold.IsNil() || !m.Threshold.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidInput, "threshold must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgDisableAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Delegator); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultParams returns params with default values. The compounding is disabled by default.
+func DefaultParams() Params {
+	return Params{
+		Enabled:              false,
+		MaxCompoundsPerBlock: 100,
+		MinThreshold:         sdkmath.NewInt(1_000_000),
+	}
+}
+
+// ValidateBasic validates the params.
+func (p Params) ValidateBasic() error {
+	if p.MaxCompoundsPerBlock == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "max compounds per block must be positive")
+	}
+	if p.MinThreshold.IsNil() || !p.MinThreshold.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidInput, "min threshold must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/autocompound/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params keeps gov manageable parameters.
+type Params struct {
+	// enabled defines whether the rewards are compounded at the end of each day epoch.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// max_compounds_per_block is the maximum number of the delegators processed in one block. If more delegators opted
+	// in, the processing of the epoch continues in the next blocks.
+	MaxCompoundsPerBlock uint32 `protobuf:"varint,3,opt,name=max_compounds_per_block,json=maxCompoundsPerBlock,proto3" json:"max_compounds_per_block,omitempty" yaml:"max_compounds_per_block"`
+	// min_threshold is the minimum threshold of the rewards the delegators might set, in the bond denom.
+	MinThreshold cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_threshold,json=minThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"min_threshold" yaml:"min_threshold"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb52faa82a7347ff, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxCompoundsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxCompoundsPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "coreum.autocompound.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("coreum/autocompound/v1/params.proto", fileDescriptor_bb52faa82a7347ff)
+}
+
+var fileDescriptor_bb52faa82a7347ff = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x6a, 0xea, 0x40,
+	0x14, 0xc6, 0x13, 0x15, 0xaf, 0x37, 0x5c, 0xe5, 0x12, 0xbc, 0xb7, 0xd2, 0x45, 0x22, 0xe9, 0xc6,
+	0x45, 0xcd, 0x20, 0xa5, 0x2d, 0x74, 0x99, 0xae, 0xda, 0x95, 0x84, 0x6e, 0xea, 0x26, 0x4c, 0x92,
+	0xc1, 0x0c, 0x66, 0xe6, 0x84, 0x99, 0x89, 0xc4, 0x6e, 0xfb, 0x02, 0x7d, 0x2c, 0x97, 0x2e, 0x4b,
+	0x17, 0xa1, 0xe8, 0x1b, 0xf8, 0x04, 0xc5, 0x3f, 0x01, 0xbb, 0xe8, 0x6e, 0xe6, 0x7c, 0xbf, 0x1f,
+	0x1f, 0x9c, 0x63, 0x5c, 0x44, 0x20, 0x48, 0xce, 0x10, 0xce, 0x15, 0x44, 0xc0, 0x32, 0xc8, 0x79,
+	0x8c, 0xe6, 0x23, 0x94, 0x61, 0x81, 0x99, 0x74, 0x33, 0x01, 0x0a, 0xcc, 0xff, 0x07, 0xc8, 0x3d,
+	0x85, 0xdc, 0xf9, 0xe8, 0xbc, 0x3b, 0x85, 0x29, 0xec, 0x11, 0xb4, 0x7b, 0x1d, 0x68, 0xe7, 0xb5,
+	0x66, 0x34, 0xc7, 0x7b, 0xdd, 0xbc, 0x34, 0x7e, 0x11, 0x8e, 0xc3, 0x94, 0xc4, 0x3d, 0xbd, 0xaf,
+	0x0f, 0x5a, 0x9e, 0xb9, 0x2d, 0xed, 0xce, 0x02, 0xb3, 0xf4, 0xce, 0x39, 0x06, 0x8e, 0x5f, 0x21,
+	0xe6, 0xb3, 0x71, 0xc6, 0x70, 0x11, 0x54, 0x0d, 0x32, 0xc8, 0x88, 0x08, 0xc2, 0x14, 0xa2, 0x59,
+	0xaf, 0xde, 0xd7, 0x07, 0x6d, 0xcf, 0xd9, 0x96, 0xb6, 0x75, 0xb0, 0x7f, 0x00, 0x1d, 0xbf, 0xcb,
+	0x70, 0x71, 0x5f, 0x05, 0x63, 0x22, 0xbc, 0xdd, 0xd8, 0x9c, 0x18, 0x6d, 0x46, 0x79, 0xa0, 0x12,
+	0x41, 0x64, 0x02, 0x69, 0xdc, 0x6b, 0xf4, 0xf5, 0xc1, 0x6f, 0xef, 0x7a, 0x59, 0xda, 0xda, 0x47,
+	0x69, 0xff, 0x8b, 0x40, 0x32, 0x90, 0x32, 0x9e, 0xb9, 0x14, 0x10, 0xc3, 0x2a, 0x71, 0x1f, 0xb8,
+	0xda, 0x96, 0x76, 0xf7, 0xd8, 0x76, 0xea, 0x3a, 0xfe, 0x1f, 0x46, 0xf9, 0x53, 0xf5, 0x7d, 0x6c,
+	0xb4, 0x6a, 0x7f, 0xeb, 0x7e, 0x87, 0x64, 0x10, 0x25, 0x41, 0x9c, 0x0b, 0xac, 0x28, 0x70, 0x6f,
+	0xbc, 0x5c, 0x5b, 0xfa, 0x6a, 0x6d, 0xe9, 0x9f, 0x6b, 0x4b, 0x7f, 0xdb, 0x58, 0xda, 0x6a, 0x63,
+	0x69, 0xef, 0x1b, 0x4b, 0x9b, 0xdc, 0x4c, 0xa9, 0x4a, 0xf2, 0xd0, 0x8d, 0x80, 0x21, 0x05, 0x33,
+	0xc2, 0xe9, 0x0b, 0x19, 0x16, 0x48, 0x15, 0xc3, 0x28, 0xc1, 0x94, 0xa3, 0xf9, 0x2d, 0x2a, 0xbe,
+	0xdf, 0x43, 0x2d, 0x32, 0x22, 0xc3, 0xe6, 0x7e, 0xbd, 0x57, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x8b, 0xe2, 0xe4, 0xf8, 0xb3, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinThreshold.Size()
+		i -= size
+		if _, err := m.MinThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxCompoundsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxCompoundsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxCompoundsPerBlock))
+	}
+	l = m.MinThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCompoundsPerBlock", wireType)
+			}
+			m.MaxCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCompoundsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      types.ModuleName + " transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdBridgeOut(),
+		CmdAttestInbound(),
+	)
+
+	return cmd
+}
+
+// CmdBridgeOut returns BridgeOut cobra command.
+func CmdBridgeOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-out [evm_recipient] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Bridge coins out to the EVM chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Bridge coins out to the EVM chain.
+The coins are locked or burnt depending on the bridge mode of the denom and released on the EVM chain by the operators.
+
+Example:
+$ %s tx %s bridge-out 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed 100000000%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.DenomTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgBridgeOut{
+				Sender:       clientCtx.GetFromAddress().String(),
+				EVMRecipient: args[0],
+				Amount:       amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAttestInbound returns AttestInbound cobra command.
+func CmdAttestInbound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-inbound [nonce] [evm_tx_hash] [evm_sender] [recipient] [amount] --from [operator]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Attest the transfer observed on the EVM chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Attest the transfer observed on the EVM chain.
+The transfer is executed once the required number of operators attest the same transfer details.
+
+Example:
+$ %s tx %s attest-inbound 1 0x[tx-hash] 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed [recipient] 100000000%s --from [operator]
+`,
+				version.AppName, types.ModuleName, constant.DenomTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid nonce")
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return errors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgAttestInbound{
+				Operator: clientCtx.GetFromAddress().String(),
+				Transfer: types.InboundTransfer{
+					Nonce:     nonce,
+					EVMTxHash: args[1],
+					EVMSender: args[2],
+					Recipient: args[3],
+					Amount:    amount,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
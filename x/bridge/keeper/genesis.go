@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+// InitGenesis initializes the bridge module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, config := range genState.DenomConfigs {
+		if err := k.DenomConfigs.Set(ctx, config.Denom, config); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range genState.RateLimitUsages {
+		if err := k.RateLimitUsages.Set(ctx, usage.Denom, usage); err != nil {
+			return err
+		}
+	}
+
+	for _, attestation := range genState.Attestations {
+		hash, err := attestation.Transfer.Hash()
+		if err != nil {
+			return err
+		}
+		if err := k.Attestations.Set(ctx, collections.Join(attestation.Transfer.Nonce, hash), attestation); err != nil {
+			return err
+		}
+	}
+
+	for _, nonce := range genState.ProcessedInboundNonces {
+		if err := k.ProcessedInboundNonces.Set(ctx, nonce); err != nil {
+			return err
+		}
+	}
+
+	return k.OutboundSequence.Set(ctx, genState.OutboundSequence)
+}
+
+// ExportGenesis returns the bridge module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params = params
+
+	if err := k.DenomConfigs.Walk(ctx, nil, func(_ string, config types.DenomConfig) (bool, error) {
+		genesis.DenomConfigs = append(genesis.DenomConfigs, config)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.RateLimitUsages.Walk(ctx, nil, func(_ string, usage types.RateLimitUsage) (bool, error) {
+		genesis.RateLimitUsages = append(genesis.RateLimitUsages, usage)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Attestations.Walk(
+		ctx,
+		nil,
+		func(_ collections.Pair[uint64, []byte], attestation types.Attestation) (bool, error) {
+			genesis.Attestations = append(genesis.Attestations, attestation)
+			return false, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	if err := k.ProcessedInboundNonces.Walk(ctx, nil, func(nonce uint64) (bool, error) {
+		genesis.ProcessedInboundNonces = append(genesis.ProcessedInboundNonces, nonce)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.OutboundSequence, err = k.OutboundSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+func TestGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	operator, _ := testApp.GenAccount(ctx)
+	recipient, _ := testApp.GenAccount(ctx)
+	genState := types.GenesisState{
+		Params: types.Params{
+			Operators:            []string{operator.String()},
+			AttestationThreshold: sdkmath.LegacyOneDec(),
+		},
+		DenomConfigs: []types.DenomConfig{
+			newDenomConfig("denom1", types.BRIDGE_MODE_LOCK),
+			newDenomConfig("denom2", types.BRIDGE_MODE_MINT),
+		},
+		RateLimitUsages: []types.RateLimitUsage{
+			{
+				Denom:       "denom1",
+				WindowStart: 100,
+				Outbound:    sdkmath.NewInt(10),
+				Inbound:     sdkmath.NewInt(20),
+			},
+		},
+		Attestations: []types.Attestation{
+			{
+				Transfer: types.InboundTransfer{
+					Nonce:     3,
+					EVMTxHash: evmTxHash,
+					EVMSender: evmTokenAddress,
+					Recipient: recipient.String(),
+					Amount:    sdk.NewInt64Coin("denom1", 100),
+				},
+				Operators: []string{operator.String()},
+			},
+		},
+		ProcessedInboundNonces: []uint64{1, 2},
+		OutboundSequence:       5,
+	}
+	requireT.NoError(genState.Validate())
+
+	requireT.NoError(testApp.BridgeKeeper.InitGenesis(ctx, genState))
+	exported, err := testApp.BridgeKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState, *exported)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryService serves grpc requests for the module.
+type QueryService struct {
+	keeper Keeper
+}
+
+// NewQueryService creates query service.
+func NewQueryService(keeper Keeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params returns params of the module.
+func (qs QueryService) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := qs.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// DenomConfig returns the bridge settings and the rate limit usage of the denom.
+func (qs QueryService) DenomConfig(
+	ctx context.Context, req *types.QueryDenomConfigRequest,
+) (*types.QueryDenomConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	config, err := qs.keeper.GetDenomConfig(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := qs.keeper.GetRateLimitUsage(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomConfigResponse{
+		Config:         config,
+		RateLimitUsage: usage,
+	}, nil
+}
+
+// DenomConfigs returns the bridge settings of all the denoms.
+func (qs QueryService) DenomConfigs(
+	ctx context.Context, req *types.QueryDenomConfigsRequest,
+) (*types.QueryDenomConfigsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	configs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.DenomConfigs,
+		req.Pagination,
+		func(_ string, config types.DenomConfig) (types.DenomConfig, error) {
+			return config, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomConfigsResponse{
+		Configs:    configs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// InboundTransfer returns the attestations of the inbound transfer.
+func (qs QueryService) InboundTransfer(
+	ctx context.Context, req *types.QueryInboundTransferRequest,
+) (*types.QueryInboundTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	processed, err := qs.keeper.ProcessedInboundNonces.Has(ctx, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	attestations := []types.Attestation{}
+	if err := qs.keeper.Attestations.Walk(
+		ctx,
+		collections.NewPrefixedPairRange[uint64, []byte](req.Nonce),
+		func(_ collections.Pair[uint64, []byte], attestation types.Attestation) (bool, error) {
+			attestations = append(attestations, attestation)
+			return false, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInboundTransferResponse{
+		Processed:    processed,
+		Attestations: attestations,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	addresscodec "cosmossdk.io/core/address"
+	sdkstore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	authority    string
+
+	// codec
+	cdc          codec.BinaryCodec
+	addressCodec addresscodec.Codec
+
+	// keepers
+	bankKeeper    types.BankKeeper
+	assetFTKeeper types.AssetFTKeeper
+
+	// collections
+	Schema          collections.Schema
+	Params          collections.Item[types.Params]
+	DenomConfigs    collections.Map[string, types.DenomConfig]
+	RateLimitUsages collections.Map[string, types.RateLimitUsage]
+	// Map: (nonce, transfer hash) -> attestation of the inbound transfer
+	Attestations           collections.Map[collections.Pair[uint64, []byte], types.Attestation]
+	ProcessedInboundNonces collections.KeySet[uint64]
+	OutboundSequence       collections.Sequence
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.BinaryCodec,
+	authority string,
+	bankKeeper types.BankKeeper,
+	assetFTKeeper types.AssetFTKeeper,
+	addressCodec addresscodec.Codec,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:  storeService,
+		cdc:           cdc,
+		addressCodec:  addressCodec,
+		authority:     authority,
+		bankKeeper:    bankKeeper,
+		assetFTKeeper: assetFTKeeper,
+
+		Params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			codec.CollValue[types.Params](cdc),
+		),
+		DenomConfigs: collections.NewMap(
+			sb,
+			types.DenomConfigKey,
+			"denom_configs",
+			collections.StringKey,
+			codec.CollValue[types.DenomConfig](cdc),
+		),
+		RateLimitUsages: collections.NewMap(
+			sb,
+			types.RateLimitUsageKey,
+			"rate_limit_usages",
+			collections.StringKey,
+			codec.CollValue[types.RateLimitUsage](cdc),
+		),
+		Attestations: collections.NewMap(
+			sb,
+			types.AttestationKey,
+			"attestations",
+			collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey),
+			codec.CollValue[types.Attestation](cdc),
+		),
+		ProcessedInboundNonces: collections.NewKeySet(
+			sb,
+			types.ProcessedInboundNonceKey,
+			"processed_inbound_nonces",
+			collections.Uint64Key,
+		),
+		OutboundSequence: collections.NewSequence(
+			sb,
+			types.OutboundSequenceKey,
+			"outbound_sequence",
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// UpdateParams is a governance operation that sets parameters of the module.
+func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateParams(ctx, req.Authority, req.Params); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateDenomConfig is a governance operation that sets the bridge settings of the denom.
+func (ms MsgServer) UpdateDenomConfig(
+	ctx context.Context,
+	req *types.MsgUpdateDenomConfig,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateDenomConfig(ctx, req.Authority, req.Config); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// BridgeOut bridges the coins out to the EVM chain.
+func (ms MsgServer) BridgeOut(ctx context.Context, req *types.MsgBridgeOut) (*types.MsgBridgeOutResponse, error) {
+	sender, err := ms.keeper.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := ms.keeper.BridgeOut(ctx, sender, req.EVMRecipient, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgBridgeOutResponse{Nonce: nonce}, nil
+}
+
+// AttestInbound attests the transfer observed on the EVM chain.
+func (ms MsgServer) AttestInbound(ctx context.Context, req *types.MsgAttestInbound) (*types.EmptyResponse, error) {
+	operator, err := ms.keeper.addressCodec.StringToBytes(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.AttestInbound(ctx, operator, req.Transfer); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
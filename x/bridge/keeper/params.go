@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+// GetParams returns the current bridge module parameters.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
+
+// SetParams sets the bridge module parameters.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.Params.Set(ctx, params)
+}
+
+// UpdateParams is a governance operation that sets parameters of the module, including the operator set.
+func (k Keeper) UpdateParams(ctx context.Context, authority string, params types.Params) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	return k.SetParams(ctx, params)
+}
+
+// GetDenomConfig returns the bridge settings of the denom.
+func (k Keeper) GetDenomConfig(ctx context.Context, denom string) (types.DenomConfig, error) {
+	config, err := k.DenomConfigs.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DenomConfig{}, errorsmod.Wrapf(types.ErrDenomNotEnabled, "denom: %s", denom)
+		}
+		return types.DenomConfig{}, err
+	}
+	return config, nil
+}
+
+// UpdateDenomConfig is a governance operation that sets the bridge settings of the denom.
+func (k Keeper) UpdateDenomConfig(ctx context.Context, authority string, config types.DenomConfig) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	return k.SetDenomConfig(ctx, config)
+}
+
+// SetDenomConfig sets the bridge settings of the denom.
+func (k Keeper) SetDenomConfig(ctx context.Context, config types.DenomConfig) error {
+	if err := config.ValidateBasic(); err != nil {
+		return err
+	}
+	if config.Enabled {
+		if err := k.validateAssetFTDenom(ctx, config); err != nil {
+			return err
+		}
+	}
+
+	return k.DenomConfigs.Set(ctx, config.Denom, config)
+}
+
+// validateAssetFTDenom checks that the token issued by the asset ft module might be bridged. Such tokens are
+// bridged only if the ibc feature, which controls the transfers to other chains, is enabled, and they can't be
+// minted by the bridge, since the supply is controlled by the token admin.
+func (k Keeper) validateAssetFTDenom(ctx context.Context, config types.DenomConfig) error {
+	def, err := k.assetFTKeeper.GetDefinition(sdk.UnwrapSDKContext(ctx), config.Denom)
+	if err != nil {
+		if errors.Is(err, assetfttypes.ErrInvalidDenom) || errors.Is(err, assetfttypes.ErrTokenNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if config.Mode != types.BRIDGE_MODE_LOCK {
+		return errorsmod.Wrapf(
+			types.ErrInvalidInput, "asset ft token %s might be bridged only in the lock mode", config.Denom,
+		)
+	}
+	if !def.IsFeatureEnabled(assetfttypes.Feature_ibc) {
+		return errorsmod.Wrapf(
+			types.ErrDenomNotEnabled, "feature %s is disabled for the token %s", assetfttypes.Feature_ibc, config.Denom,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+const evmTokenAddress = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+func newDenomConfig(denom string, mode types.BridgeMode) types.DenomConfig {
+	return types.DenomConfig{
+		Denom:           denom,
+		Enabled:         true,
+		Mode:            mode,
+		EVMTokenAddress: evmTokenAddress,
+		RateLimit: types.RateLimit{
+			MaxOutbound: sdkmath.ZeroInt(),
+			MaxInbound:  sdkmath.ZeroInt(),
+		},
+	}
+}
+
+func TestUpdateParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	msgServer := keeper.NewMsgServer(testApp.BridgeKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	operator, _ := testApp.GenAccount(ctx)
+	params := types.Params{
+		Operators:            []string{operator.String()},
+		AttestationThreshold: sdkmath.LegacyOneDec(),
+	}
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: operator.String(),
+		Params:    params,
+	})
+	requireT.ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	})
+	requireT.NoError(err)
+
+	storedParams, err := testApp.BridgeKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(params, storedParams)
+}
+
+func TestUpdateDenomConfig_AssetFT(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	msgServer := keeper.NewMsgServer(testApp.BridgeKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	issuer, _ := testApp.GenAccount(ctx)
+	issue := func(subunit string, features []assetfttypes.Feature) string {
+		denom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(1_000),
+			Features:      features,
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	denomWithIBC := issue("withibc", []assetfttypes.Feature{assetfttypes.Feature_ibc})
+	denomWithoutIBC := issue("noibc", nil)
+
+	updateDenomConfig := func(config types.DenomConfig) error {
+		_, err := msgServer.UpdateDenomConfig(ctx, &types.MsgUpdateDenomConfig{
+			Authority: authority,
+			Config:    config,
+		})
+		return err
+	}
+
+	// the token without the ibc feature can't be enabled
+	requireT.ErrorIs(updateDenomConfig(newDenomConfig(denomWithoutIBC, types.BRIDGE_MODE_LOCK)), types.ErrDenomNotEnabled)
+	// but might be configured as disabled
+	disabledConfig := newDenomConfig(denomWithoutIBC, types.BRIDGE_MODE_LOCK)
+	disabledConfig.Enabled = false
+	requireT.NoError(updateDenomConfig(disabledConfig))
+
+	// the asset ft token can't be minted by the bridge
+	requireT.ErrorIs(updateDenomConfig(newDenomConfig(denomWithIBC, types.BRIDGE_MODE_MINT)), types.ErrInvalidInput)
+	requireT.NoError(updateDenomConfig(newDenomConfig(denomWithIBC, types.BRIDGE_MODE_LOCK)))
+
+	// not asset ft denoms are accepted in both modes
+	requireT.NoError(updateDenomConfig(newDenomConfig(sdk.DefaultBondDenom, types.BRIDGE_MODE_LOCK)))
+	requireT.NoError(updateDenomConfig(newDenomConfig("weth", types.BRIDGE_MODE_MINT)))
+
+	config, err := testApp.BridgeKeeper.GetDenomConfig(ctx, denomWithIBC)
+	requireT.NoError(err)
+	requireT.Equal(newDenomConfig(denomWithIBC, types.BRIDGE_MODE_LOCK), config)
+}
@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+// BridgeOut locks or burns the coins of the sender and emits the event observed by the operators, who release the
+// tokens on the EVM chain. It returns the nonce assigned to the transfer.
+func (k Keeper) BridgeOut(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	evmRecipient string,
+	amount sdk.Coin,
+) (uint64, error) {
+	config, err := k.getEnabledDenomConfig(ctx, amount.Denom)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.consumeRateLimit(ctx, config, amount.Amount, true); err != nil {
+		return 0, err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	if config.Mode == types.BRIDGE_MODE_MINT {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return 0, err
+		}
+	}
+
+	nonce, err := k.OutboundSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	senderStr, err := k.addressCodec.BytesToString(sender)
+	if err != nil {
+		return 0, err
+	}
+
+	return nonce, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBridgeOut{
+		Nonce:           nonce,
+		Sender:          senderStr,
+		EVMRecipient:    evmRecipient,
+		EVMTokenAddress: config.EVMTokenAddress,
+		Amount:          amount,
+	})
+}
+
+// AttestInbound records the attestation of the inbound transfer by the operator. The transfer is executed once the
+// required number of the current operators attest the same transfer details.
+func (k Keeper) AttestInbound(ctx context.Context, operator sdk.AccAddress, transfer types.InboundTransfer) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	operatorStr, err := k.addressCodec.BytesToString(operator)
+	if err != nil {
+		return err
+	}
+	if !lo.Contains(params.Operators, operatorStr) {
+		return errorsmod.Wrapf(types.ErrNotOperator, "address: %s", operatorStr)
+	}
+
+	processed, err := k.ProcessedInboundNonces.Has(ctx, transfer.Nonce)
+	if err != nil {
+		return err
+	}
+	if processed {
+		return errorsmod.Wrapf(types.ErrAlreadyProcessed, "nonce: %d", transfer.Nonce)
+	}
+
+	hash, err := transfer.Hash()
+	if err != nil {
+		return err
+	}
+	key := collections.Join(transfer.Nonce, hash)
+	attestation, err := k.Attestations.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		attestation = types.Attestation{Transfer: transfer}
+	}
+	if lo.Contains(attestation.Operators, operatorStr) {
+		return errorsmod.Wrapf(types.ErrAlreadyAttested, "nonce: %d, operator: %s", transfer.Nonce, operatorStr)
+	}
+	attestation.Operators = append(attestation.Operators, operatorStr)
+
+	// attestations of the operators removed from the operator set are not counted
+	attestations := uint32(len(lo.Intersect(attestation.Operators, params.Operators)))
+	requiredAttestations := params.RequiredAttestations()
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventInboundAttested{
+		Nonce:                transfer.Nonce,
+		Operator:             operatorStr,
+		Attestations:         attestations,
+		RequiredAttestations: requiredAttestations,
+	}); err != nil {
+		return err
+	}
+
+	if attestations < requiredAttestations {
+		return k.Attestations.Set(ctx, key, attestation)
+	}
+
+	return k.executeInbound(ctx, transfer)
+}
+
+// executeInbound unlocks or mints the coins of the attested inbound transfer and removes all its attestations.
+func (k Keeper) executeInbound(ctx context.Context, transfer types.InboundTransfer) error {
+	config, err := k.getEnabledDenomConfig(ctx, transfer.Amount.Denom)
+	if err != nil {
+		return err
+	}
+	if err := k.consumeRateLimit(ctx, config, transfer.Amount.Amount, false); err != nil {
+		return err
+	}
+
+	if err := k.ProcessedInboundNonces.Set(ctx, transfer.Nonce); err != nil {
+		return err
+	}
+	if err := k.Attestations.Clear(
+		ctx, collections.NewPrefixedPairRange[uint64, []byte](transfer.Nonce),
+	); err != nil {
+		return err
+	}
+
+	recipient, err := k.addressCodec.StringToBytes(transfer.Recipient)
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(transfer.Amount)
+	if config.Mode == types.BRIDGE_MODE_MINT {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBridgeIn{
+		Nonce:     transfer.Nonce,
+		EVMTxHash: transfer.EVMTxHash,
+		Recipient: transfer.Recipient,
+		Amount:    transfer.Amount,
+	})
+}
+
+// GetRateLimitUsage returns the amounts bridged within the current window of the denom.
+func (k Keeper) GetRateLimitUsage(ctx context.Context, denom string) (types.RateLimitUsage, error) {
+	usage, err := k.RateLimitUsages.Get(ctx, denom)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return types.RateLimitUsage{}, err
+		}
+		return types.RateLimitUsage{
+			Denom:    denom,
+			Outbound: sdkmath.ZeroInt(),
+			Inbound:  sdkmath.ZeroInt(),
+		}, nil
+	}
+	return usage, nil
+}
+
+func (k Keeper) getEnabledDenomConfig(ctx context.Context, denom string) (types.DenomConfig, error) {
+	config, err := k.GetDenomConfig(ctx, denom)
+	if err != nil {
+		return types.DenomConfig{}, err
+	}
+	if !config.Enabled {
+		return types.DenomConfig{}, errorsmod.Wrapf(types.ErrDenomNotEnabled, "denom: %s", denom)
+	}
+	// the features of the asset ft token might be changed after the denom is enabled
+	if err := k.validateAssetFTDenom(ctx, config); err != nil {
+		return types.DenomConfig{}, err
+	}
+	return config, nil
+}
+
+// consumeRateLimit adds the amount to the usage of the current window, and returns an error if the limit is
+// exceeded. The window is restarted once its duration passes.
+func (k Keeper) consumeRateLimit(ctx context.Context, config types.DenomConfig, amount sdkmath.Int, outbound bool) error {
+	if !config.RateLimit.IsEnabled() {
+		return nil
+	}
+
+	usage, err := k.GetRateLimitUsage(ctx, config.Denom)
+	if err != nil {
+		return err
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if now >= usage.WindowStart+int64(config.RateLimit.WindowSeconds) {
+		usage = types.RateLimitUsage{
+			Denom:       config.Denom,
+			WindowStart: now,
+			Outbound:    sdkmath.ZeroInt(),
+			Inbound:     sdkmath.ZeroInt(),
+		}
+	}
+
+	used, limit, direction := &usage.Inbound, config.RateLimit.MaxInbound, "inbound"
+	if outbound {
+		used, limit, direction = &usage.Outbound, config.RateLimit.MaxOutbound, "outbound"
+	}
+	*used = used.Add(amount)
+	if limit.IsPositive() && used.GT(limit) {
+		return errorsmod.Wrapf(
+			types.ErrRateLimitExceeded,
+			"%s limit: %s%s, used within the window: %s%s",
+			direction, limit, config.Denom, used, config.Denom,
+		)
+	}
+
+	return k.RateLimitUsages.Set(ctx, config.Denom, usage)
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/bridge/types"
+)
+
+const evmTxHash = "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"
+
+func TestBridgeOut(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockTime(time.Unix(1_000, 0))
+	bridgeKeeper := testApp.BridgeKeeper
+	msgServer := keeper.NewMsgServer(bridgeKeeper)
+
+	lockConfig := newDenomConfig("lockdenom", types.BRIDGE_MODE_LOCK)
+	lockConfig.RateLimit = types.RateLimit{
+		MaxOutbound:   sdkmath.NewInt(150),
+		MaxInbound:    sdkmath.ZeroInt(),
+		WindowSeconds: 60,
+	}
+	requireT.NoError(bridgeKeeper.SetDenomConfig(ctx, lockConfig))
+	requireT.NoError(bridgeKeeper.SetDenomConfig(ctx, newDenomConfig("mintdenom", types.BRIDGE_MODE_MINT)))
+	disabledConfig := newDenomConfig("disableddenom", types.BRIDGE_MODE_LOCK)
+	disabledConfig.Enabled = false
+	requireT.NoError(bridgeKeeper.SetDenomConfig(ctx, disabledConfig))
+
+	sender, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, sender, sdk.NewCoins(
+		sdk.NewInt64Coin("lockdenom", 1_000),
+		sdk.NewInt64Coin("mintdenom", 1_000),
+		sdk.NewInt64Coin("disableddenom", 1_000),
+		sdk.NewInt64Coin("otherdenom", 1_000),
+	)))
+
+	bridgeOut := func(amount sdk.Coin) (uint64, error) {
+		res, err := msgServer.BridgeOut(ctx, &types.MsgBridgeOut{
+			Sender:       sender.String(),
+			EVMRecipient: evmTokenAddress,
+			Amount:       amount,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.Nonce, nil
+	}
+
+	// not configured and disabled denoms are rejected
+	_, err := bridgeOut(sdk.NewInt64Coin("otherdenom", 100))
+	requireT.ErrorIs(err, types.ErrDenomNotEnabled)
+	_, err = bridgeOut(sdk.NewInt64Coin("disableddenom", 100))
+	requireT.ErrorIs(err, types.ErrDenomNotEnabled)
+
+	// the locked coins are kept by the module
+	nonce, err := bridgeOut(sdk.NewInt64Coin("lockdenom", 100))
+	requireT.NoError(err)
+	requireT.EqualValues(1, nonce)
+	moduleAddr := testApp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	requireT.Equal("100", testApp.BankKeeper.GetBalance(ctx, moduleAddr, "lockdenom").Amount.String())
+
+	// the minted coins are burnt
+	supplyBefore := testApp.BankKeeper.GetSupply(ctx, "mintdenom").Amount
+	nonce, err = bridgeOut(sdk.NewInt64Coin("mintdenom", 100))
+	requireT.NoError(err)
+	requireT.EqualValues(2, nonce)
+	requireT.True(testApp.BankKeeper.GetBalance(ctx, moduleAddr, "mintdenom").Amount.IsZero())
+	requireT.Equal(
+		supplyBefore.SubRaw(100).String(), testApp.BankKeeper.GetSupply(ctx, "mintdenom").Amount.String(),
+	)
+
+	// the rate limit is exceeded within the window
+	_, err = bridgeOut(sdk.NewInt64Coin("lockdenom", 51))
+	requireT.ErrorIs(err, types.ErrRateLimitExceeded)
+	_, err = bridgeOut(sdk.NewInt64Coin("lockdenom", 50))
+	requireT.NoError(err)
+
+	// the usage is reset once the window passes
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	_, err = bridgeOut(sdk.NewInt64Coin("lockdenom", 150))
+	requireT.NoError(err)
+	usage, err := bridgeKeeper.GetRateLimitUsage(ctx, "lockdenom")
+	requireT.NoError(err)
+	requireT.Equal(ctx.BlockTime().Unix(), usage.WindowStart)
+	requireT.Equal("150", usage.Outbound.String())
+}
+
+//nolint:funlen // the test covers the whole attestation lifecycle
+func TestAttestInbound(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	bridgeKeeper := testApp.BridgeKeeper
+	msgServer := keeper.NewMsgServer(bridgeKeeper)
+
+	operator1, _ := testApp.GenAccount(ctx)
+	operator2, _ := testApp.GenAccount(ctx)
+	operator3, _ := testApp.GenAccount(ctx)
+	notOperator, _ := testApp.GenAccount(ctx)
+	recipient, _ := testApp.GenAccount(ctx)
+	requireT.NoError(bridgeKeeper.SetParams(ctx, types.Params{
+		Operators:            []string{operator1.String(), operator2.String(), operator3.String()},
+		AttestationThreshold: sdkmath.LegacyNewDecWithPrec(5, 1),
+	}))
+	requireT.NoError(bridgeKeeper.SetDenomConfig(ctx, newDenomConfig("mintdenom", types.BRIDGE_MODE_MINT)))
+
+	transfer := types.InboundTransfer{
+		Nonce:     1,
+		EVMTxHash: evmTxHash,
+		EVMSender: evmTokenAddress,
+		Recipient: recipient.String(),
+		Amount:    sdk.NewInt64Coin("mintdenom", 100),
+	}
+	attest := func(operator sdk.AccAddress, transfer types.InboundTransfer) error {
+		_, err := msgServer.AttestInbound(ctx, &types.MsgAttestInbound{
+			Operator: operator.String(),
+			Transfer: transfer,
+		})
+		return err
+	}
+	recipientBalance := func() string {
+		return testApp.BankKeeper.GetBalance(ctx, recipient, "mintdenom").Amount.String()
+	}
+
+	requireT.ErrorIs(attest(notOperator, transfer), types.ErrNotOperator)
+
+	// the operators attest different details of the same transfer
+	requireT.NoError(attest(operator1, transfer))
+	requireT.ErrorIs(attest(operator1, transfer), types.ErrAlreadyAttested)
+	conflictingTransfer := transfer
+	conflictingTransfer.Amount = sdk.NewInt64Coin("mintdenom", 1_000)
+	requireT.NoError(attest(operator2, conflictingTransfer))
+	requireT.Equal("0", recipientBalance())
+
+	res, err := keeper.NewQueryService(bridgeKeeper).InboundTransfer(ctx, &types.QueryInboundTransferRequest{Nonce: 1})
+	requireT.NoError(err)
+	requireT.False(res.Processed)
+	requireT.Len(res.Attestations, 2)
+
+	// the attestation of the removed operator isn't counted
+	requireT.NoError(bridgeKeeper.SetParams(ctx, types.Params{
+		Operators:            []string{operator2.String(), operator3.String()},
+		AttestationThreshold: sdkmath.LegacyOneDec(),
+	}))
+	requireT.ErrorIs(attest(operator1, transfer), types.ErrNotOperator)
+	requireT.NoError(bridgeKeeper.SetParams(ctx, types.Params{
+		Operators:            []string{operator1.String(), operator2.String(), operator3.String()},
+		AttestationThreshold: sdkmath.LegacyNewDecWithPrec(5, 1),
+	}))
+
+	// the second attestation of the same details executes the transfer
+	requireT.NoError(attest(operator3, transfer))
+	requireT.Equal("100", recipientBalance())
+
+	res, err = keeper.NewQueryService(bridgeKeeper).InboundTransfer(ctx, &types.QueryInboundTransferRequest{Nonce: 1})
+	requireT.NoError(err)
+	requireT.True(res.Processed)
+	requireT.Empty(res.Attestations)
+
+	// the processed transfer can't be executed again
+	requireT.ErrorIs(attest(operator2, transfer), types.ErrAlreadyProcessed)
+
+	// the locked coins are unlocked
+	requireT.NoError(bridgeKeeper.SetDenomConfig(ctx, newDenomConfig("lockdenom", types.BRIDGE_MODE_LOCK)))
+	requireT.NoError(testApp.FundAccount(ctx, notOperator, sdk.NewCoins(sdk.NewInt64Coin("lockdenom", 100))))
+	_, err = msgServer.BridgeOut(ctx, &types.MsgBridgeOut{
+		Sender:       notOperator.String(),
+		EVMRecipient: evmTokenAddress,
+		Amount:       sdk.NewInt64Coin("lockdenom", 100),
+	})
+	requireT.NoError(err)
+	lockTransfer := transfer
+	lockTransfer.Nonce = 2
+	lockTransfer.Amount = sdk.NewInt64Coin("lockdenom", 40)
+	requireT.NoError(attest(operator1, lockTransfer))
+	requireT.NoError(attest(operator2, lockTransfer))
+	requireT.Equal("40", testApp.BankKeeper.GetBalance(ctx, recipient, "lockdenom").Amount.String())
+	moduleAddr := testApp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	requireT.Equal("60", testApp.BankKeeper.GetBalance(ctx, moduleAddr, "lockdenom").Amount.String())
+}
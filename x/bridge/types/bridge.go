@@ -0,0 +1,86 @@
+package types
+
+import (
+	"crypto/sha256"
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	evmAddressRegex = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+	evmTxHashRegex  = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+)
+
+// ValidateEVMAddress checks that the address is the hex encoded EVM address.
+func ValidateEVMAddress(address string) error {
+	if !evmAddressRegex.MatchString(address) {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid EVM address %q", address)
+	}
+	return nil
+}
+
+// ValidateBasic checks that the denom config is valid.
+func (c DenomConfig) ValidateBasic() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid denom: %s", err)
+	}
+	if c.Mode != BRIDGE_MODE_LOCK && c.Mode != BRIDGE_MODE_MINT {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid bridge mode %s", c.Mode)
+	}
+	if err := ValidateEVMAddress(c.EVMTokenAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid EVM token address")
+	}
+	return c.RateLimit.ValidateBasic()
+}
+
+// ValidateBasic checks that the rate limit is valid.
+func (r RateLimit) ValidateBasic() error {
+	if r.MaxOutbound.IsNil() || r.MaxOutbound.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidInput, "max outbound amount must not be negative")
+	}
+	if r.MaxInbound.IsNil() || r.MaxInbound.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidInput, "max inbound amount must not be negative")
+	}
+	return nil
+}
+
+// IsEnabled returns true if the rate limit is applied.
+func (r RateLimit) IsEnabled() bool {
+	return r.WindowSeconds > 0
+}
+
+// ValidateBasic checks that the inbound transfer is valid.
+func (t InboundTransfer) ValidateBasic() error {
+	if t.Nonce == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "nonce must be positive")
+	}
+	if !evmTxHashRegex.MatchString(t.EVMTxHash) {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid EVM tx hash %q", t.EVMTxHash)
+	}
+	if err := ValidateEVMAddress(t.EVMSender); err != nil {
+		return errorsmod.Wrap(err, "invalid EVM sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid recipient: %s", err)
+	}
+	if err := t.Amount.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid amount: %s", err)
+	}
+	if !t.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidInput, "amount must be positive")
+	}
+	return nil
+}
+
+// Hash returns the hash of the transfer details. Operators attesting different details of the same nonce vote
+// for different transfers.
+func (t InboundTransfer) Hash() ([]byte, error) {
+	bz, err := t.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
@@ -0,0 +1,1727 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/bridge/v1/bridge.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BridgeMode defines how the denom is moved between the chains.
+type BridgeMode int32
+
+const (
+	// BRIDGE_MODE_UNSPECIFIED reserves the default value, to protect against unexpected settings.
+	BRIDGE_MODE_UNSPECIFIED BridgeMode = 0
+	// BRIDGE_MODE_LOCK means that the denom is native to this chain, the coins are locked in the module account
+	// when bridged out and unlocked when bridged back.
+	BRIDGE_MODE_LOCK BridgeMode = 1
+	// BRIDGE_MODE_MINT means that the denom represents the token native to the EVM chain, the coins are burnt
+	// when bridged out and minted when bridged in.
+	BRIDGE_MODE_MINT BridgeMode = 2
+)
+
+var BridgeMode_name = map[int32]string{
+	0: "BRIDGE_MODE_UNSPECIFIED",
+	1: "BRIDGE_MODE_LOCK",
+	2: "BRIDGE_MODE_MINT",
+}
+
+var BridgeMode_value = map[string]int32{
+	"BRIDGE_MODE_UNSPECIFIED": 0,
+	"BRIDGE_MODE_LOCK":        1,
+	"BRIDGE_MODE_MINT":        2,
+}
+
+func (x BridgeMode) String() string {
+	return proto.EnumName(BridgeMode_name, int32(x))
+}
+
+func (BridgeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ac2ab2e44e9f4a63, []int{0}
+}
+
+// RateLimit defines the max amounts which might be bridged in each direction within the window.
+type RateLimit struct {
+	// max_outbound is the max amount bridged out within the window, zero means unlimited.
+	MaxOutbound cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_outbound,json=maxOutbound,proto3,customtype=cosmossdk.io/math.Int" json:"max_outbound"`
+	// max_inbound is the max amount bridged in within the window, zero means unlimited.
+	MaxInbound cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_inbound,json=maxInbound,proto3,customtype=cosmossdk.io/math.Int" json:"max_inbound"`
+	// window_seconds is the duration of the window, zero disables the rate limit.
+	WindowSeconds uint64 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac2ab2e44e9f4a63, []int{0}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+// DenomConfig defines the bridge settings of the denom.
+type DenomConfig struct {
+	// denom is the denom of the coins on this chain.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// enabled defines whether the transfers of the denom are allowed.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// mode defines how the denom is moved between the chains.
+	Mode BridgeMode `protobuf:"varint,3,opt,name=mode,proto3,enum=coreum.bridge.v1.BridgeMode" json:"mode,omitempty"`
+	// evm_token_address is the address of the ERC20 contract representing the denom on the EVM chain.
+	EVMTokenAddress string `protobuf:"bytes,4,opt,name=evm_token_address,json=evmTokenAddress,proto3" json:"evm_token_address,omitempty"`
+	// rate_limit limits the amounts bridged within the window.
+	RateLimit RateLimit `protobuf:"bytes,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *DenomConfig) Reset()         { *m = DenomConfig{} }
+func (m *DenomConfig) String() string { return proto.CompactTextString(m) }
+func (*DenomConfig) ProtoMessage()    {}
+func (*DenomConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac2ab2e44e9f4a63, []int{1}
+}
+func (m *DenomConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomConfig.Merge(m, src)
+}
+func (m *DenomConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomConfig proto.InternalMessageInfo
+
+func (m *DenomConfig) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DenomConfig) GetMode() BridgeMode {
+	if m != nil {
+		return m.Mode
+	}
+	return BRIDGE_MODE_UNSPECIFIED
+}
+
+func (m *DenomConfig) GetEVMTokenAddress() string {
+	if m != nil {
+		return m.EVMTokenAddress
+	}
+	return ""
+}
+
+func (m *DenomConfig) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+// RateLimitUsage tracks the amounts bridged within the current window of the denom.
+type RateLimitUsage struct {
+	// denom is the denom the usage belongs to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window_start is the unix time (in seconds) the current window started at.
+	WindowStart int64 `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// outbound is the amount bridged out within the current window.
+	Outbound cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outbound,proto3,customtype=cosmossdk.io/math.Int" json:"outbound"`
+	// inbound is the amount bridged in within the current window.
+	Inbound cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=inbound,proto3,customtype=cosmossdk.io/math.Int" json:"inbound"`
+}
+
+func (m *RateLimitUsage) Reset()         { *m = RateLimitUsage{} }
+func (m *RateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUsage) ProtoMessage()    {}
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac2ab2e44e9f4a63, []int{2}
+}
+func (m *RateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUsage.Merge(m, src)
+}
+func (m *RateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitUsage proto.InternalMessageInfo
+
+func (m *RateLimitUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimitUsage) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+// InboundTransfer is the transfer observed on the EVM chain attested by the operators.
+type InboundTransfer struct {
+	// nonce is the unique nonce of the transfer assigned by the bridge contract on the EVM chain.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// evm_tx_hash is the hash of the EVM transaction locking or burning the tokens.
+	EVMTxHash string `protobuf:"bytes,2,opt,name=evm_tx_hash,json=evmTxHash,proto3" json:"evm_tx_hash,omitempty"`
+	// evm_sender is the address of the sender on the EVM chain.
+	EVMSender string `protobuf:"bytes,3,opt,name=evm_sender,json=evmSender,proto3" json:"evm_sender,omitempty"`
+	// recipient is the address receiving the coins on this chain.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount received on this chain.
+	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *InboundTransfer) Reset()         { *m = InboundTransfer{} }
+func (m *InboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*InboundTransfer) ProtoMessage()    {}
+func (*InboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac2ab2e44e9f4a63, []int{3}
+}
+func (m *InboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InboundTransfer.Merge(m, src)
+}
+func (m *InboundTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *InboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_InboundTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InboundTransfer proto.InternalMessageInfo
+
+func (m *InboundTransfer) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *InboundTransfer) GetEVMTxHash() string {
+	if m != nil {
+		return m.EVMTxHash
+	}
+	return ""
+}
+
+func (m *InboundTransfer) GetEVMSender() string {
+	if m != nil {
+		return m.EVMSender
+	}
+	return ""
+}
+
+func (m *InboundTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *InboundTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// Attestation keeps the operators who attested the inbound transfer.
+type Attestation struct {
+	// transfer is the attested transfer.
+	Transfer InboundTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
+	// operators is the list of operators who attested the transfer.
+	Operators []string `protobuf:"bytes,2,rep,name=operators,proto3" json:"operators,omitempty"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac2ab2e44e9f4a63, []int{4}
+}
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetTransfer() InboundTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return InboundTransfer{}
+}
+
+func (m *Attestation) GetOperators() []string {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("coreum.bridge.v1.BridgeMode", BridgeMode_name, BridgeMode_value)
+	proto.RegisterType((*RateLimit)(nil), "coreum.bridge.v1.RateLimit")
+	proto.RegisterType((*DenomConfig)(nil), "coreum.bridge.v1.DenomConfig")
+	proto.RegisterType((*RateLimitUsage)(nil), "coreum.bridge.v1.RateLimitUsage")
+	proto.RegisterType((*InboundTransfer)(nil), "coreum.bridge.v1.InboundTransfer")
+	proto.RegisterType((*Attestation)(nil), "coreum.bridge.v1.Attestation")
+}
+
+func init() { proto.RegisterFile("coreum/bridge/v1/bridge.proto", fileDescriptor_ac2ab2e44e9f4a63) }
+
+var fileDescriptor_ac2ab2e44e9f4a63 = []byte{
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0x1b, 0x3b,
+	0x14, 0xce, 0x90, 0xf0, 0x13, 0x87, 0x9f, 0x5c, 0xdf, 0x5c, 0xdd, 0x01, 0xee, 0x9d, 0x84, 0x48,
+	0x57, 0x42, 0xb7, 0xcd, 0x0c, 0x50, 0xa9, 0x2c, 0x5b, 0xf2, 0x53, 0x9a, 0x96, 0x40, 0x35, 0x81,
+	0x2e, 0xda, 0xc5, 0xc8, 0x99, 0x31, 0x89, 0x05, 0x63, 0x47, 0x63, 0x27, 0x4c, 0xfb, 0x04, 0x55,
+	0x57, 0x7d, 0x87, 0xbe, 0x02, 0x0f, 0xc1, 0x12, 0xb1, 0x6a, 0xbb, 0x88, 0xaa, 0xf0, 0x06, 0x95,
+	0xba, 0xaf, 0x66, 0xec, 0x04, 0x0a, 0x6a, 0x25, 0x76, 0xe7, 0x7c, 0xe7, 0xc7, 0xfe, 0xce, 0x77,
+	0x6c, 0xf0, 0xaf, 0xcb, 0x02, 0xdc, 0xf3, 0xad, 0x56, 0x40, 0xbc, 0x36, 0xb6, 0xfa, 0xeb, 0xca,
+	0x32, 0xbb, 0x01, 0x13, 0x0c, 0x66, 0x65, 0xd8, 0x54, 0x60, 0x7f, 0x7d, 0xc9, 0x70, 0x19, 0xf7,
+	0x19, 0xb7, 0x5a, 0x88, 0x47, 0xe9, 0x2d, 0x2c, 0xd0, 0xba, 0xe5, 0x32, 0x42, 0x65, 0xc5, 0xd2,
+	0xa2, 0x8c, 0x3b, 0xb1, 0x67, 0x49, 0x47, 0x85, 0x72, 0x6d, 0xd6, 0x66, 0x12, 0x8f, 0x2c, 0x89,
+	0x16, 0xcf, 0x35, 0x90, 0xb6, 0x91, 0xc0, 0x3b, 0xc4, 0x27, 0x02, 0xee, 0x82, 0x59, 0x1f, 0x85,
+	0x0e, 0xeb, 0x89, 0x16, 0xeb, 0x51, 0x4f, 0xd7, 0x0a, 0xda, 0x6a, 0xba, 0x7c, 0xef, 0x6c, 0x90,
+	0x4f, 0x7c, 0x19, 0xe4, 0xff, 0x92, 0xfd, 0xb8, 0x77, 0x64, 0x12, 0x66, 0xf9, 0x48, 0x74, 0xcc,
+	0x3a, 0x15, 0x17, 0xa7, 0x25, 0xa0, 0x0e, 0xaa, 0x53, 0x61, 0x67, 0x7c, 0x14, 0xee, 0xa9, 0x7a,
+	0xb8, 0x03, 0x22, 0xd7, 0x21, 0x54, 0xb6, 0x9b, 0xb8, 0x7b, 0x3b, 0xe0, 0xa3, 0xb0, 0x2e, 0xcb,
+	0xe1, 0x7f, 0x60, 0xfe, 0x84, 0x50, 0x8f, 0x9d, 0x38, 0x1c, 0xbb, 0x8c, 0x7a, 0x5c, 0x4f, 0x16,
+	0xb4, 0xd5, 0x94, 0x3d, 0x27, 0xd1, 0xa6, 0x04, 0x8b, 0xdf, 0x34, 0x90, 0xa9, 0x62, 0xca, 0xfc,
+	0x0a, 0xa3, 0x87, 0xa4, 0x0d, 0x73, 0x60, 0xd2, 0x8b, 0x5c, 0xc9, 0xc6, 0x96, 0x0e, 0xd4, 0xc1,
+	0x34, 0xa6, 0xa8, 0x75, 0x8c, 0xe5, 0xb5, 0x66, 0xec, 0x91, 0x0b, 0xd7, 0x40, 0xca, 0x67, 0x1e,
+	0x8e, 0x9b, 0xcf, 0x6f, 0xfc, 0x63, 0xde, 0x14, 0xc1, 0x2c, 0xc7, 0x56, 0x83, 0x79, 0xd8, 0x8e,
+	0x33, 0xe1, 0x23, 0xf0, 0x07, 0xee, 0xfb, 0x8e, 0x60, 0x47, 0x98, 0x3a, 0xc8, 0xf3, 0x02, 0xcc,
+	0xb9, 0x9e, 0x8a, 0xc9, 0xfe, 0x39, 0x1c, 0xe4, 0x17, 0x6a, 0x2f, 0x1b, 0xfb, 0x51, 0x6c, 0x4b,
+	0x86, 0xec, 0x05, 0xdc, 0xf7, 0xaf, 0x03, 0xf0, 0x31, 0x00, 0x01, 0x12, 0xd8, 0x39, 0x8e, 0x54,
+	0xd0, 0x27, 0x0b, 0xda, 0x6a, 0x66, 0x63, 0xf9, 0xf6, 0xc1, 0x63, 0xa1, 0xca, 0xa9, 0x68, 0x86,
+	0x76, 0x3a, 0x18, 0x01, 0xc5, 0xcf, 0x1a, 0x98, 0x1f, 0x87, 0x0f, 0x38, 0x6a, 0xe3, 0x5f, 0xf0,
+	0x5e, 0x01, 0xb3, 0xa3, 0x21, 0x0a, 0x14, 0x88, 0x98, 0x7c, 0xd2, 0xce, 0xa8, 0x11, 0x46, 0x10,
+	0xdc, 0x06, 0x33, 0xe3, 0x0d, 0x48, 0xde, 0x5d, 0xb2, 0x71, 0x31, 0xac, 0x81, 0xe9, 0x91, 0xf4,
+	0xa9, 0xbb, 0xf7, 0x19, 0xd5, 0x16, 0xbf, 0x6b, 0x60, 0x41, 0xed, 0xc0, 0x7e, 0x80, 0x28, 0x3f,
+	0xc4, 0x41, 0x44, 0x8e, 0x32, 0xea, 0xe2, 0x98, 0x5c, 0xca, 0x96, 0x0e, 0x2c, 0x81, 0x4c, 0x2c,
+	0x44, 0xe8, 0x74, 0x10, 0xef, 0xa8, 0x7d, 0x9b, 0x1b, 0x0e, 0xf2, 0xe9, 0x48, 0x82, 0xf0, 0x29,
+	0xe2, 0x1d, 0x3b, 0x1d, 0x0d, 0x3f, 0x36, 0xe1, 0x7d, 0x00, 0xa2, 0x74, 0x8e, 0xa9, 0x87, 0x03,
+	0x3d, 0xf9, 0x53, 0x76, 0x33, 0x06, 0xe3, 0x6c, 0x69, 0xc2, 0x87, 0x20, 0x1d, 0x60, 0x97, 0x74,
+	0x09, 0xa6, 0x42, 0xf1, 0xd1, 0x2f, 0x4e, 0x4b, 0x39, 0x75, 0x65, 0xa5, 0x65, 0x53, 0x04, 0x84,
+	0xb6, 0xed, 0xab, 0x54, 0xb8, 0x09, 0xa6, 0x90, 0xcf, 0x7a, 0x74, 0x24, 0xec, 0xa2, 0xa9, 0x2a,
+	0xa2, 0x47, 0x6c, 0xaa, 0x47, 0x6c, 0x56, 0x18, 0xa1, 0x4a, 0x56, 0x95, 0x5e, 0x7c, 0xaf, 0x81,
+	0xcc, 0x96, 0x10, 0x98, 0x0b, 0x24, 0x08, 0xa3, 0xb0, 0x02, 0x66, 0x84, 0xe2, 0x1f, 0xd3, 0xce,
+	0x6c, 0xac, 0xdc, 0xde, 0x91, 0x1b, 0x83, 0x52, 0x2d, 0xc7, 0x85, 0x11, 0x0b, 0xd6, 0xc5, 0x01,
+	0x12, 0x2c, 0xe0, 0xfa, 0x44, 0x21, 0xf9, 0x7b, 0x16, 0xe3, 0xd4, 0xff, 0x5f, 0x03, 0x70, 0xb5,
+	0xf7, 0x70, 0x19, 0xfc, 0x5d, 0xb6, 0xeb, 0xd5, 0xed, 0x9a, 0xd3, 0xd8, 0xab, 0xd6, 0x9c, 0x83,
+	0xdd, 0xe6, 0x8b, 0x5a, 0xa5, 0xfe, 0xa4, 0x5e, 0xab, 0x66, 0x13, 0x30, 0x07, 0xb2, 0xd7, 0x83,
+	0x3b, 0x7b, 0x95, 0xe7, 0x59, 0xed, 0x26, 0xda, 0xa8, 0xef, 0xee, 0x67, 0x27, 0x96, 0x52, 0xef,
+	0x3e, 0x1a, 0x89, 0xf2, 0xb3, 0xb3, 0xa1, 0xa1, 0x9d, 0x0f, 0x0d, 0xed, 0xeb, 0xd0, 0xd0, 0x3e,
+	0x5c, 0x1a, 0x89, 0xf3, 0x4b, 0x23, 0xf1, 0xe9, 0xd2, 0x48, 0xbc, 0x5a, 0x6b, 0x13, 0xd1, 0xe9,
+	0xb5, 0x4c, 0x97, 0xf9, 0x56, 0xfc, 0xbe, 0xc8, 0x5b, 0x5c, 0x0a, 0x2d, 0x11, 0x96, 0xdc, 0x0e,
+	0x22, 0xd4, 0xea, 0x6f, 0x5a, 0xe1, 0xe8, 0xfb, 0x14, 0x6f, 0xba, 0x98, 0xb7, 0xa6, 0xe2, 0x8f,
+	0xed, 0xc1, 0x8f, 0x01, 0x00, 0x9b, 0x0a, 0xdd, 0xbc, 0x5c, 0x05, 0x00, 0x00,
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxInbound.Size()
+		i -= size
+		if _, err := m.MaxInbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxOutbound.Size()
+		i -= size
+		if _, err := m.MaxOutbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EVMTokenAddress) > 0 {
+		i -= len(m.EVMTokenAddress)
+		copy(dAtA[i:], m.EVMTokenAddress)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.EVMTokenAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Mode != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inbound.Size()
+		i -= size
+		if _, err := m.Inbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Outbound.Size()
+		i -= size
+		if _, err := m.Outbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowStart != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EVMSender) > 0 {
+		i -= len(m.EVMSender)
+		copy(dAtA[i:], m.EVMSender)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.EVMSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EVMTxHash) > 0 {
+		i -= len(m.EVMTxHash)
+		copy(dAtA[i:], m.EVMTxHash)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.EVMTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Operators[iNdEx])
+			copy(dAtA[i:], m.Operators[iNdEx])
+			i = encodeVarintBridge(dAtA, i, uint64(len(m.Operators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxOutbound.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.MaxInbound.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.WindowSeconds != 0 {
+		n += 1 + sovBridge(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *DenomConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.Mode != 0 {
+		n += 1 + sovBridge(uint64(m.Mode))
+	}
+	l = len(m.EVMTokenAddress)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *RateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovBridge(uint64(m.WindowStart))
+	}
+	l = m.Outbound.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = m.Inbound.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *InboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovBridge(uint64(m.Nonce))
+	}
+	l = len(m.EVMTxHash)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.EVMSender)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *Attestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if len(m.Operators) > 0 {
+		for _, s := range m.Operators {
+			l = len(s)
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBridge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridge(x uint64) (n int) {
+	return sovBridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BridgeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMTokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMTokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBridge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBridge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBridge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBridge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBridge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBridge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBridge = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the amino types and interfaces.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, ModuleName+"/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDenomConfig{}, ModuleName+"/MsgUpdateDenomConfig")
+	legacy.RegisterAminoMsg(cdc, &MsgBridgeOut{}, ModuleName+"/MsgBridgeOut")
+	legacy.RegisterAminoMsg(cdc, &MsgAttestInbound{}, ModuleName+"/MsgAttestInbound")
+}
+
+// RegisterInterfaces registers the module's tx interfaces.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidAuthority is returned when the authority is invalid.
+	ErrInvalidAuthority = sdkerrors.Register(ModuleName, 2, "invalid authority")
+
+	// ErrInvalidInput is returned when input validation fails.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 3, "invalid input")
+
+	// ErrDenomNotEnabled is returned when the denom is not enabled for bridging.
+	ErrDenomNotEnabled = sdkerrors.Register(ModuleName, 4, "denom is not enabled for bridging")
+
+	// ErrRateLimitExceeded is returned when the transfer exceeds the rate limit of the denom.
+	ErrRateLimitExceeded = sdkerrors.Register(ModuleName, 5, "rate limit exceeded")
+
+	// ErrNotOperator is returned when the signer is not in the operator set.
+	ErrNotOperator = sdkerrors.Register(ModuleName, 6, "not an operator")
+
+	// ErrAlreadyAttested is returned when the operator has already attested the transfer.
+	ErrAlreadyAttested = sdkerrors.Register(ModuleName, 7, "transfer already attested")
+
+	// ErrAlreadyProcessed is returned when the inbound transfer has already been executed.
+	ErrAlreadyProcessed = sdkerrors.Register(ModuleName, 8, "transfer already processed")
+)
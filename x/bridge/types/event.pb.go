@@ -0,0 +1,1113 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/bridge/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventBridgeOut is emitted when the coins are bridged out to the EVM chain. The event is observed by the operators.
+type EventBridgeOut struct {
+	Nonce           uint64     `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Sender          string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	EVMRecipient    string     `protobuf:"bytes,3,opt,name=evm_recipient,json=evmRecipient,proto3" json:"evm_recipient,omitempty"`
+	EVMTokenAddress string     `protobuf:"bytes,4,opt,name=evm_token_address,json=evmTokenAddress,proto3" json:"evm_token_address,omitempty"`
+	Amount          types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventBridgeOut) Reset()         { *m = EventBridgeOut{} }
+func (m *EventBridgeOut) String() string { return proto.CompactTextString(m) }
+func (*EventBridgeOut) ProtoMessage()    {}
+func (*EventBridgeOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0404284f46da2261, []int{0}
+}
+func (m *EventBridgeOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgeOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgeOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgeOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgeOut.Merge(m, src)
+}
+func (m *EventBridgeOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgeOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgeOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgeOut proto.InternalMessageInfo
+
+func (m *EventBridgeOut) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EventBridgeOut) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventBridgeOut) GetEVMRecipient() string {
+	if m != nil {
+		return m.EVMRecipient
+	}
+	return ""
+}
+
+func (m *EventBridgeOut) GetEVMTokenAddress() string {
+	if m != nil {
+		return m.EVMTokenAddress
+	}
+	return ""
+}
+
+func (m *EventBridgeOut) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// EventInboundAttested is emitted when the operator attests the inbound transfer.
+type EventInboundAttested struct {
+	Nonce                uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Operator             string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Attestations         uint32 `protobuf:"varint,3,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	RequiredAttestations uint32 `protobuf:"varint,4,opt,name=required_attestations,json=requiredAttestations,proto3" json:"required_attestations,omitempty"`
+}
+
+func (m *EventInboundAttested) Reset()         { *m = EventInboundAttested{} }
+func (m *EventInboundAttested) String() string { return proto.CompactTextString(m) }
+func (*EventInboundAttested) ProtoMessage()    {}
+func (*EventInboundAttested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0404284f46da2261, []int{1}
+}
+func (m *EventInboundAttested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInboundAttested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInboundAttested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventInboundAttested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInboundAttested.Merge(m, src)
+}
+func (m *EventInboundAttested) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInboundAttested) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInboundAttested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInboundAttested proto.InternalMessageInfo
+
+func (m *EventInboundAttested) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EventInboundAttested) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventInboundAttested) GetAttestations() uint32 {
+	if m != nil {
+		return m.Attestations
+	}
+	return 0
+}
+
+func (m *EventInboundAttested) GetRequiredAttestations() uint32 {
+	if m != nil {
+		return m.RequiredAttestations
+	}
+	return 0
+}
+
+// EventBridgeIn is emitted when the attested inbound transfer is executed.
+type EventBridgeIn struct {
+	Nonce     uint64     `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	EVMTxHash string     `protobuf:"bytes,2,opt,name=evm_tx_hash,json=evmTxHash,proto3" json:"evm_tx_hash,omitempty"`
+	Recipient string     `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventBridgeIn) Reset()         { *m = EventBridgeIn{} }
+func (m *EventBridgeIn) String() string { return proto.CompactTextString(m) }
+func (*EventBridgeIn) ProtoMessage()    {}
+func (*EventBridgeIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0404284f46da2261, []int{2}
+}
+func (m *EventBridgeIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgeIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgeIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgeIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgeIn.Merge(m, src)
+}
+func (m *EventBridgeIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgeIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgeIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgeIn proto.InternalMessageInfo
+
+func (m *EventBridgeIn) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EventBridgeIn) GetEVMTxHash() string {
+	if m != nil {
+		return m.EVMTxHash
+	}
+	return ""
+}
+
+func (m *EventBridgeIn) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventBridgeIn) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*EventBridgeOut)(nil), "coreum.bridge.v1.EventBridgeOut")
+	proto.RegisterType((*EventInboundAttested)(nil), "coreum.bridge.v1.EventInboundAttested")
+	proto.RegisterType((*EventBridgeIn)(nil), "coreum.bridge.v1.EventBridgeIn")
+}
+
+func init() { proto.RegisterFile("coreum/bridge/v1/event.proto", fileDescriptor_0404284f46da2261) }
+
+var fileDescriptor_0404284f46da2261 = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0x42, 0x1a, 0x91, 0x6d, 0x42, 0x8b, 0x09, 0xc8, 0x44, 0x95, 0x13, 0xe5, 0x94, 0x4b,
+	0xbc, 0x84, 0x0a, 0xf5, 0x88, 0x62, 0x14, 0x89, 0x22, 0x55, 0x48, 0x16, 0xea, 0x81, 0x4b, 0xb4,
+	0xb6, 0x47, 0xf1, 0x0a, 0x79, 0x37, 0xec, 0xae, 0x2d, 0xc3, 0x57, 0xf0, 0x0b, 0x9c, 0xf9, 0x91,
+	0x1e, 0x7b, 0xe4, 0x14, 0x21, 0xe7, 0x03, 0xf8, 0x05, 0xb4, 0xeb, 0xa4, 0xa4, 0x48, 0x3d, 0xf4,
+	0x36, 0xf3, 0xde, 0xbc, 0xd5, 0xbe, 0x37, 0x83, 0x4f, 0x62, 0x21, 0x21, 0xcf, 0x48, 0x24, 0x59,
+	0xb2, 0x04, 0x52, 0x4c, 0x09, 0x14, 0xc0, 0xb5, 0xbf, 0x92, 0x42, 0x0b, 0xe7, 0xb8, 0x66, 0xfd,
+	0x9a, 0xf5, 0x8b, 0x69, 0xdf, 0x8b, 0x85, 0xca, 0x84, 0x22, 0x11, 0x55, 0x66, 0x3a, 0x02, 0x4d,
+	0xa7, 0x24, 0x16, 0x8c, 0xd7, 0x8a, 0x7e, 0x6f, 0x29, 0x96, 0xc2, 0x96, 0xc4, 0x54, 0x35, 0x3a,
+	0xfa, 0x83, 0xf0, 0xe3, 0xb9, 0x79, 0x37, 0xb0, 0x0f, 0x7d, 0xc8, 0xb5, 0xd3, 0xc3, 0x07, 0x5c,
+	0xf0, 0x18, 0x5c, 0x34, 0x44, 0xe3, 0x66, 0x58, 0x37, 0xce, 0x73, 0xdc, 0x52, 0xc0, 0x13, 0x90,
+	0xee, 0x83, 0x21, 0x1a, 0xb7, 0xc3, 0x6d, 0xe7, 0xbc, 0xc6, 0x5d, 0x28, 0xb2, 0x85, 0x84, 0x98,
+	0xad, 0x18, 0x70, 0xed, 0x3e, 0x34, 0x74, 0x70, 0x5c, 0xad, 0x07, 0x9d, 0xf9, 0xe5, 0x45, 0xb8,
+	0xc3, 0xc3, 0x0e, 0x14, 0xd9, 0x4d, 0xe7, 0xbc, 0xc1, 0x4f, 0x8c, 0x4c, 0x8b, 0xcf, 0xc0, 0x17,
+	0x34, 0x49, 0x24, 0x28, 0xe5, 0x36, 0xad, 0xf4, 0x69, 0xb5, 0x1e, 0x1c, 0xcd, 0x2f, 0x2f, 0x3e,
+	0x1a, 0x6e, 0x56, 0x53, 0xe1, 0x11, 0x14, 0xd9, 0x3e, 0xe0, 0x9c, 0xe1, 0x16, 0xcd, 0x44, 0xce,
+	0xb5, 0x7b, 0x30, 0x44, 0xe3, 0xc3, 0x57, 0x2f, 0xfc, 0xda, 0xbf, 0x6f, 0xfc, 0xfb, 0x5b, 0xff,
+	0xfe, 0x5b, 0xc1, 0x78, 0xd0, 0xbc, 0x5a, 0x0f, 0x1a, 0xe1, 0x76, 0x7c, 0xf4, 0x03, 0xe1, 0x9e,
+	0x75, 0x7c, 0xce, 0x23, 0x91, 0xf3, 0x64, 0xa6, 0x35, 0x28, 0x0d, 0xc9, 0x1d, 0xbe, 0xfb, 0xf8,
+	0x91, 0x58, 0x81, 0xa4, 0x5a, 0xec, 0x9c, 0xdf, 0xf4, 0xce, 0x08, 0x77, 0xa8, 0x55, 0x53, 0xcd,
+	0x04, 0x57, 0xd6, 0x7a, 0x37, 0xbc, 0x85, 0x39, 0xa7, 0xf8, 0x99, 0x84, 0x2f, 0x39, 0x93, 0x90,
+	0x2c, 0x6e, 0x0d, 0x37, 0xed, 0x70, 0x6f, 0x47, 0xce, 0xf6, 0xb8, 0xd1, 0x4f, 0x84, 0xbb, 0x7b,
+	0x5b, 0x39, 0xe7, 0x77, 0x7c, 0x6e, 0x82, 0x0f, 0x6d, 0x8a, 0xe5, 0x22, 0xa5, 0x2a, 0xad, 0xff,
+	0x17, 0x74, 0xab, 0xf5, 0xa0, 0x6d, 0xf2, 0x2b, 0xdf, 0x51, 0x95, 0x86, 0x6d, 0x93, 0x9c, 0x2d,
+	0x9d, 0x13, 0xdc, 0xfe, 0x6f, 0x4f, 0xe1, 0x3f, 0x60, 0x2f, 0xd1, 0xe6, 0xbd, 0x12, 0x0d, 0xde,
+	0x5f, 0x55, 0x1e, 0xba, 0xae, 0x3c, 0xf4, 0xbb, 0xf2, 0xd0, 0xf7, 0x8d, 0xd7, 0xb8, 0xde, 0x78,
+	0x8d, 0x5f, 0x1b, 0xaf, 0xf1, 0xe9, 0xe5, 0x92, 0xe9, 0x34, 0x8f, 0xfc, 0x58, 0x64, 0xc4, 0xae,
+	0x9a, 0x7d, 0x83, 0x49, 0x49, 0x74, 0x39, 0x89, 0x53, 0xca, 0x38, 0x29, 0xce, 0x48, 0xb9, 0x3b,
+	0x70, 0xfd, 0x75, 0x05, 0x2a, 0x6a, 0xd9, 0xb3, 0x3c, 0xfd, 0x3b, 0x00, 0x87, 0x1c, 0xcd, 0x5e,
+	0xfe, 0x02, 0x00, 0x00,
+}
+
+func (m *EventBridgeOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgeOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgeOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EVMTokenAddress) > 0 {
+		i -= len(m.EVMTokenAddress)
+		copy(dAtA[i:], m.EVMTokenAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.EVMTokenAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EVMRecipient) > 0 {
+		i -= len(m.EVMRecipient)
+		copy(dAtA[i:], m.EVMRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.EVMRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventInboundAttested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInboundAttested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInboundAttested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiredAttestations != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.RequiredAttestations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Attestations != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Attestations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBridgeIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgeIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgeIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EVMTxHash) > 0 {
+		i -= len(m.EVMTxHash)
+		copy(dAtA[i:], m.EVMTxHash)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.EVMTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBridgeOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovEvent(uint64(m.Nonce))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.EVMRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.EVMTokenAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventInboundAttested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovEvent(uint64(m.Nonce))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Attestations != 0 {
+		n += 1 + sovEvent(uint64(m.Attestations))
+	}
+	if m.RequiredAttestations != 0 {
+		n += 1 + sovEvent(uint64(m.RequiredAttestations))
+	}
+	return n
+}
+
+func (m *EventBridgeIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovEvent(uint64(m.Nonce))
+	}
+	l = len(m.EVMTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBridgeOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridgeOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridgeOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMTokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMTokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventInboundAttested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInboundAttested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInboundAttested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			m.Attestations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attestations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttestations", wireType)
+			}
+			m.RequiredAttestations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredAttestations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBridgeIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridgeIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridgeIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EVMTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,92 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:                 DefaultParams(),
+		DenomConfigs:           []DenomConfig{},
+		RateLimitUsages:        []RateLimitUsage{},
+		Attestations:           []Attestation{},
+		ProcessedInboundNonces: []uint64{},
+		OutboundSequence:       1,
+	}
+}
+
+// Validate validates genesis parameters.
+func (m GenesisState) Validate() error {
+	if err := m.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]struct{}, len(m.DenomConfigs))
+	for _, config := range m.DenomConfigs {
+		if err := config.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid config of denom %s", config.Denom)
+		}
+		if _, found := denoms[config.Denom]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate config of denom %s", config.Denom)
+		}
+		denoms[config.Denom] = struct{}{}
+	}
+
+	usageDenoms := make(map[string]struct{}, len(m.RateLimitUsages))
+	for _, usage := range m.RateLimitUsages {
+		if _, found := denoms[usage.Denom]; !found {
+			return errorsmod.Wrapf(ErrInvalidInput, "rate limit usage of not configured denom %s", usage.Denom)
+		}
+		if _, found := usageDenoms[usage.Denom]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate rate limit usage of denom %s", usage.Denom)
+		}
+		usageDenoms[usage.Denom] = struct{}{}
+		if usage.Outbound.IsNil() || usage.Outbound.IsNegative() ||
+			usage.Inbound.IsNil() || usage.Inbound.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid rate limit usage of denom %s", usage.Denom)
+		}
+	}
+
+	processed := make(map[uint64]struct{}, len(m.ProcessedInboundNonces))
+	for _, nonce := range m.ProcessedInboundNonces {
+		if _, found := processed[nonce]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate processed inbound nonce %d", nonce)
+		}
+		processed[nonce] = struct{}{}
+	}
+
+	attestations := make(map[string]struct{}, len(m.Attestations))
+	for _, attestation := range m.Attestations {
+		if err := attestation.Transfer.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid attested transfer %d", attestation.Transfer.Nonce)
+		}
+		if _, found := processed[attestation.Transfer.Nonce]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "attestation of processed transfer %d", attestation.Transfer.Nonce)
+		}
+		hash, err := attestation.Transfer.Hash()
+		if err != nil {
+			return err
+		}
+		if _, found := attestations[string(hash)]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate attestation of transfer %d", attestation.Transfer.Nonce)
+		}
+		attestations[string(hash)] = struct{}{}
+		if len(attestation.Operators) == 0 {
+			return errorsmod.Wrapf(ErrInvalidInput, "attestation of transfer %d has no operators",
+				attestation.Transfer.Nonce)
+		}
+		for _, operator := range attestation.Operators {
+			if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+				return errorsmod.Wrapf(ErrInvalidInput, "invalid operator address %q: %s", operator, err)
+			}
+		}
+	}
+
+	if m.OutboundSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "outbound sequence must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/bridge/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the module genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// denom_configs contains the bridge settings of the denoms.
+	DenomConfigs []DenomConfig `protobuf:"bytes,2,rep,name=denom_configs,json=denomConfigs,proto3" json:"denom_configs"`
+	// rate_limit_usages contains the amounts bridged within the current windows.
+	RateLimitUsages []RateLimitUsage `protobuf:"bytes,3,rep,name=rate_limit_usages,json=rateLimitUsages,proto3" json:"rate_limit_usages"`
+	// attestations contains the pending attestations of the inbound transfers.
+	Attestations []Attestation `protobuf:"bytes,4,rep,name=attestations,proto3" json:"attestations"`
+	// processed_inbound_nonces contains the nonces of the executed inbound transfers.
+	ProcessedInboundNonces []uint64 `protobuf:"varint,5,rep,packed,name=processed_inbound_nonces,json=processedInboundNonces,proto3" json:"processed_inbound_nonces,omitempty"`
+	// outbound_sequence is the nonce assigned to the next outbound transfer.
+	OutboundSequence uint64 `protobuf:"varint,6,opt,name=outbound_sequence,json=outboundSequence,proto3" json:"outbound_sequence,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a35f7c3bbee8401, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDenomConfigs() []DenomConfig {
+	if m != nil {
+		return m.DenomConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitUsages() []RateLimitUsage {
+	if m != nil {
+		return m.RateLimitUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProcessedInboundNonces() []uint64 {
+	if m != nil {
+		return m.ProcessedInboundNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutboundSequence() uint64 {
+	if m != nil {
+		return m.OutboundSequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "coreum.bridge.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("coreum/bridge/v1/genesis.proto", fileDescriptor_5a35f7c3bbee8401) }
+
+var fileDescriptor_5a35f7c3bbee8401 = []byte{
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6a, 0xdb, 0x40,
+	0x18, 0xc4, 0xa5, 0x4a, 0xf5, 0x61, 0xed, 0x52, 0x5b, 0x94, 0xb2, 0x18, 0xac, 0x8a, 0x9e, 0x04,
+	0xc5, 0xda, 0xda, 0x85, 0xb6, 0xd7, 0xba, 0x05, 0xb7, 0xa5, 0x94, 0x22, 0xd3, 0x4b, 0x2f, 0x62,
+	0x25, 0x7d, 0x95, 0x97, 0x44, 0xbb, 0x8a, 0x76, 0x65, 0x9c, 0x3c, 0x45, 0x0e, 0x79, 0x28, 0x1f,
+	0x7d, 0xcc, 0x29, 0x04, 0xfb, 0x45, 0x82, 0xfe, 0x38, 0x4e, 0xe2, 0xe4, 0xf6, 0x31, 0xbf, 0x99,
+	0xd1, 0x80, 0x16, 0xd9, 0x91, 0xc8, 0xa1, 0x48, 0x49, 0x98, 0xb3, 0x38, 0x01, 0xb2, 0x18, 0x91,
+	0x04, 0x38, 0x48, 0x26, 0xbd, 0x2c, 0x17, 0x4a, 0x58, 0xdd, 0x9a, 0x7b, 0x35, 0xf7, 0x16, 0xa3,
+	0xfe, 0xe0, 0x20, 0xd1, 0xb0, 0x2a, 0xf0, 0x08, 0xce, 0x68, 0x4e, 0xd3, 0xa6, 0xaf, 0xff, 0x2a,
+	0x11, 0x89, 0xa8, 0x4e, 0x52, 0x5e, 0xb5, 0xfa, 0xf6, 0xc2, 0x40, 0x9d, 0x69, 0xfd, 0xdd, 0x99,
+	0xa2, 0x0a, 0xac, 0x8f, 0xa8, 0x55, 0xc7, 0xb0, 0xee, 0xe8, 0x6e, 0x7b, 0x8c, 0xbd, 0x87, 0x3b,
+	0xbc, 0x3f, 0x15, 0x9f, 0x98, 0xab, 0xab, 0x37, 0x9a, 0xdf, 0xb8, 0xad, 0xef, 0xe8, 0x45, 0x0c,
+	0x5c, 0xa4, 0x41, 0x24, 0xf8, 0x7f, 0x96, 0x48, 0xfc, 0xcc, 0x31, 0xdc, 0xf6, 0x78, 0x70, 0x18,
+	0xff, 0x56, 0xda, 0xbe, 0x56, 0xae, 0xa6, 0xa3, 0x13, 0xef, 0x25, 0x69, 0xf9, 0xa8, 0x97, 0x53,
+	0x05, 0xc1, 0x31, 0x4b, 0x99, 0x0a, 0x0a, 0x49, 0x13, 0x90, 0xd8, 0xa8, 0xda, 0x9c, 0xc3, 0x36,
+	0x9f, 0x2a, 0xf8, 0x55, 0x3a, 0xff, 0x96, 0xc6, 0xa6, 0xf0, 0x65, 0x7e, 0x4f, 0x95, 0xd6, 0x14,
+	0x75, 0xa8, 0x52, 0x20, 0x15, 0x55, 0x4c, 0x70, 0x89, 0xcd, 0xa7, 0xc6, 0x7d, 0xd9, 0xbb, 0x76,
+	0xe3, 0xee, 0x06, 0xad, 0xcf, 0x08, 0x67, 0xb9, 0x88, 0x40, 0x4a, 0x88, 0x03, 0xc6, 0x43, 0x51,
+	0xf0, 0x38, 0xe0, 0x82, 0x47, 0x20, 0xf1, 0x73, 0xc7, 0x70, 0x4d, 0xff, 0xf5, 0x2d, 0xff, 0x51,
+	0xe3, 0xdf, 0x15, 0xb5, 0xde, 0xa1, 0x9e, 0x28, 0x54, 0x1d, 0x90, 0x70, 0x52, 0x00, 0x8f, 0x00,
+	0xb7, 0x1c, 0xdd, 0x35, 0xfd, 0xee, 0x0e, 0xcc, 0x1a, 0x7d, 0xf2, 0x73, 0xb5, 0xb1, 0xf5, 0xf5,
+	0xc6, 0xd6, 0xaf, 0x37, 0xb6, 0x7e, 0xbe, 0xb5, 0xb5, 0xf5, 0xd6, 0xd6, 0x2e, 0xb7, 0xb6, 0xf6,
+	0xef, 0x7d, 0xc2, 0xd4, 0xbc, 0x08, 0xbd, 0x48, 0xa4, 0x44, 0x89, 0x23, 0xe0, 0xec, 0x0c, 0x86,
+	0x4b, 0xa2, 0x96, 0xc3, 0x68, 0x4e, 0x19, 0x27, 0x8b, 0x4f, 0x64, 0xb9, 0x7b, 0x02, 0xea, 0x34,
+	0x03, 0x19, 0xb6, 0xaa, 0x3f, 0xfd, 0xe1, 0x66, 0x00, 0xc3, 0x9e, 0x94, 0x78, 0x71, 0x02, 0x00,
+	0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutboundSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OutboundSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ProcessedInboundNonces) > 0 {
+		dAtA2 := make([]byte, len(m.ProcessedInboundNonces)*10)
+		var j1 int
+		for _, num := range m.ProcessedInboundNonces {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for iNdEx := len(m.RateLimitUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DenomConfigs) > 0 {
+		for iNdEx := len(m.DenomConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomConfigs) > 0 {
+		for _, e := range m.DenomConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for _, e := range m.RateLimitUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProcessedInboundNonces) > 0 {
+		l = 0
+		for _, e := range m.ProcessedInboundNonces {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if m.OutboundSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.OutboundSequence))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomConfigs = append(m.DenomConfigs, DenomConfig{})
+			if err := m.DenomConfigs[len(m.DenomConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitUsages = append(m.RateLimitUsages, RateLimitUsage{})
+			if err := m.RateLimitUsages[len(m.RateLimitUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProcessedInboundNonces = append(m.ProcessedInboundNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProcessedInboundNonces) == 0 {
+					m.ProcessedInboundNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProcessedInboundNonces = append(m.ProcessedInboundNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedInboundNonces", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundSequence", wireType)
+			}
+			m.OutboundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "bridge"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// KVStore keys.
+var (
+	ParamsKey                = collections.NewPrefix(0)
+	DenomConfigKey           = collections.NewPrefix(1) // Map: denom -> DenomConfig
+	RateLimitUsageKey        = collections.NewPrefix(2) // Map: denom -> RateLimitUsage
+	AttestationKey           = collections.NewPrefix(3) // Map: (nonce, transfer hash) -> Attestation
+	ProcessedInboundNonceKey = collections.NewPrefix(4) // KeySet: nonce
+	OutboundSequenceKey      = collections.NewPrefix(5)
+)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type extendedMsg interface {
+	sdk.Msg
+	sdk.HasValidateBasic
+}
+
+var (
+	_ extendedMsg = &MsgUpdateParams{}
+	_ extendedMsg = &MsgUpdateDenomConfig{}
+	_ extendedMsg = &MsgBridgeOut{}
+	_ extendedMsg = &MsgAttestInbound{}
+)
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return m.Params.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateDenomConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return m.Config.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgBridgeOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if err := ValidateEVMAddress(m.EVMRecipient); err != nil {
+		return err
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return cosmoserrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	if !m.Amount.IsPositive() {
+		return cosmoserrors.ErrInvalidCoins.Wrap("amount must be positive")
+	}
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgAttestInbound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid operator address: %s", err)
+	}
+	return m.Transfer.ValidateBasic()
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns params with default values.
+func DefaultParams() Params {
+	return Params{
+		Operators:            []string{},
+		AttestationThreshold: sdkmath.LegacyNewDecWithPrec(67, 2),
+	}
+}
+
+// ValidateBasic validates the params.
+func (p Params) ValidateBasic() error {
+	seen := make(map[string]struct{}, len(p.Operators))
+	for _, operator := range p.Operators {
+		if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid operator address %q: %s", operator, err)
+		}
+		if _, found := seen[operator]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate operator %s", operator)
+		}
+		seen[operator] = struct{}{}
+	}
+
+	if p.AttestationThreshold.IsNil() ||
+		!p.AttestationThreshold.IsPositive() ||
+		p.AttestationThreshold.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrap(ErrInvalidInput, "attestation threshold must be in the range (0, 1]")
+	}
+
+	return nil
+}
+
+// RequiredAttestations returns the number of operator attestations required to execute the inbound transfer.
+func (p Params) RequiredAttestations() uint32 {
+	return uint32(p.AttestationThreshold.MulInt64(int64(len(p.Operators))).Ceil().TruncateInt64())
+}
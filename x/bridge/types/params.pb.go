@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/bridge/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params keeps gov manageable parameters.
+type Params struct {
+	// operators is the set of addresses allowed to attest the transfers received from the EVM chain.
+	Operators []string `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators,omitempty" yaml:"operators"`
+	// attestation_threshold is the fraction of operators required to attest the same inbound transfer
+	// before it is executed. The required number of attestations is rounded up.
+	AttestationThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=attestation_threshold,json=attestationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"attestation_threshold" yaml:"attestation_threshold"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d37b83fef1969c48, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetOperators() []string {
+	if m != nil {
+		return m.Operators
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "coreum.bridge.v1.Params")
+}
+
+func init() { proto.RegisterFile("coreum/bridge/v1/params.proto", fileDescriptor_d37b83fef1969c48) }
+
+var fileDescriptor_d37b83fef1969c48 = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0x2a, 0x41,
+	0x14, 0x86, 0x77, 0xee, 0x4d, 0x48, 0xd8, 0x8a, 0x10, 0x4c, 0x10, 0x75, 0x97, 0x50, 0x51, 0xc8,
+	0x8e, 0xc4, 0xc2, 0xc4, 0x4e, 0x42, 0x45, 0x2c, 0x14, 0xad, 0x6c, 0xc8, 0x30, 0x3b, 0xd9, 0x9d,
+	0xc0, 0xec, 0xd9, 0xcc, 0x1c, 0x08, 0xf8, 0x00, 0xd6, 0x3e, 0x8c, 0x0f, 0x41, 0x49, 0xac, 0x8c,
+	0x89, 0x1b, 0x03, 0x6f, 0xc0, 0x13, 0x18, 0x76, 0x40, 0x2d, 0xec, 0x66, 0xce, 0xf7, 0xcf, 0x37,
+	0x27, 0xbf, 0x7b, 0xc2, 0x41, 0x8b, 0x89, 0xa2, 0x43, 0x2d, 0xc3, 0x48, 0xd0, 0x69, 0x9b, 0xa6,
+	0x4c, 0x33, 0x65, 0x82, 0x54, 0x03, 0x42, 0xb9, 0x64, 0x71, 0x60, 0x71, 0x30, 0x6d, 0xd7, 0x0e,
+	0x39, 0x18, 0x05, 0x66, 0x90, 0x73, 0x6a, 0x2f, 0x36, 0x5c, 0xab, 0x44, 0x10, 0x81, 0x9d, 0x6f,
+	0x4f, 0x76, 0xda, 0xf8, 0x20, 0x6e, 0xe1, 0x26, 0x77, 0x96, 0x7b, 0x6e, 0x11, 0x52, 0xa1, 0x19,
+	0x82, 0x36, 0x55, 0x52, 0xff, 0xdf, 0x2c, 0x76, 0x4e, 0x37, 0x99, 0x5f, 0x9a, 0x33, 0x35, 0xbe,
+	0x6c, 0x7c, 0xa3, 0xc6, 0xeb, 0x4b, 0xab, 0xb2, 0x33, 0x5f, 0x85, 0xa1, 0x16, 0xc6, 0xdc, 0xa1,
+	0x96, 0x49, 0xd4, 0xff, 0x79, 0x5e, 0x7e, 0x22, 0xee, 0x01, 0x43, 0x14, 0x06, 0x19, 0x4a, 0x48,
+	0x06, 0x18, 0x6b, 0x61, 0x62, 0x18, 0x87, 0xd5, 0x7f, 0x75, 0xd2, 0x2c, 0x76, 0x6e, 0x17, 0x99,
+	0xef, 0xbc, 0x67, 0xfe, 0x91, 0x15, 0x99, 0x70, 0x14, 0x48, 0xa0, 0x8a, 0x61, 0x1c, 0x5c, 0x8b,
+	0x88, 0xf1, 0x79, 0x57, 0xf0, 0x4d, 0xe6, 0x1f, 0xdb, 0xbf, 0xff, 0x34, 0x6d, 0xf7, 0x70, 0x77,
+	0x7b, 0x74, 0x05, 0xef, 0x57, 0x7e, 0xa5, 0xee, 0xf7, 0xa1, 0x4e, 0x6f, 0xb1, 0xf2, 0xc8, 0x72,
+	0xe5, 0x91, 0xcf, 0x95, 0x47, 0x9e, 0xd7, 0x9e, 0xb3, 0x5c, 0x7b, 0xce, 0xdb, 0xda, 0x73, 0x1e,
+	0xce, 0x22, 0x89, 0xf1, 0x64, 0x18, 0x70, 0x50, 0x14, 0x61, 0x24, 0x12, 0xf9, 0x28, 0x5a, 0x33,
+	0x8a, 0xb3, 0x16, 0x8f, 0x99, 0x4c, 0xe8, 0xf4, 0x82, 0xce, 0xf6, 0xc5, 0xe3, 0x3c, 0x15, 0x66,
+	0x58, 0xc8, 0x2b, 0x3b, 0xff, 0x1a, 0x00, 0x8b, 0x98, 0x33, 0x5f, 0x96, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AttestationThreshold.Size()
+		i -= size
+		if _, err := m.AttestationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Operators[iNdEx])
+			copy(dAtA[i:], m.Operators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Operators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operators) > 0 {
+		for _, s := range m.Operators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.AttestationThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	requireT := require.New(t)
+
+	params := DefaultParams()
+	requireT.Empty(params.Operators)
+	requireT.NoError(params.ValidateBasic())
+}
+
+func TestParamsValidation(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	testCases := []struct {
+		name      string
+		params    Params
+		expectErr bool
+	}{
+		{
+			name: "valid",
+			params: Params{
+				Operators:            []string{addr1, addr2},
+				AttestationThreshold: sdkmath.LegacyNewDecWithPrec(67, 2),
+			},
+		},
+		{
+			name: "invalid_operator",
+			params: Params{
+				Operators:            []string{"invalid"},
+				AttestationThreshold: sdkmath.LegacyOneDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate_operator",
+			params: Params{
+				Operators:            []string{addr1, addr1},
+				AttestationThreshold: sdkmath.LegacyOneDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "zero_threshold",
+			params: Params{
+				Operators:            []string{addr1},
+				AttestationThreshold: sdkmath.LegacyZeroDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "threshold_greater_than_one",
+			params: Params{
+				Operators:            []string{addr1},
+				AttestationThreshold: sdkmath.LegacyNewDecWithPrec(11, 1),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.ValidateBasic()
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrInvalidInput)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequiredAttestations(t *testing.T) {
+	operators := make([]string, 0, 4)
+	for range 4 {
+		operators = append(operators, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String())
+	}
+
+	testCases := []struct {
+		threshold sdkmath.LegacyDec
+		operators []string
+		expected  uint32
+	}{
+		{threshold: sdkmath.LegacyOneDec(), operators: operators, expected: 4},
+		{threshold: sdkmath.LegacyNewDecWithPrec(5, 1), operators: operators, expected: 2},
+		{threshold: sdkmath.LegacyNewDecWithPrec(67, 2), operators: operators, expected: 3},
+		{threshold: sdkmath.LegacyNewDecWithPrec(67, 2), operators: operators[:3], expected: 3},
+		{threshold: sdkmath.LegacyNewDecWithPrec(1, 2), operators: operators[:1], expected: 1},
+		{threshold: sdkmath.LegacyOneDec(), operators: nil, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		params := Params{Operators: tc.operators, AttestationThreshold: tc.threshold}
+		require.Equal(t, tc.expected, params.RequiredAttestations())
+	}
+}
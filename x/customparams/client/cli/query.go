@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+)
+
+// GetQueryCmd returns the parent command for all x/customparams CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the customparams module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryEffectiveStakingConfig(),
+	)
+
+	return cmd
+}
+
+// CmdQueryEffectiveStakingConfig returns the command to query the effective staking configuration.
+func CmdQueryEffectiveStakingConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "effective-staking-config",
+		Short: "Query the staking configuration combined from the staking, slashing and customparams params",
+		Long: `Query the staking configuration combined from the staking, slashing and customparams params.
+The min self delegation is the global one defined by the customparams. The min commission rate is the greater of
+the staking and customparams ones, the customparams one is taken into account only once its activation height is
+reached. The max missed blocks is the number of blocks the validator can miss in the signed blocks window without
+being jailed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EffectiveStakingConfig(cmd.Context(), &types.QueryEffectiveStakingConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
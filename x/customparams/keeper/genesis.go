@@ -11,6 +11,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if err := k.SetStakingParams(ctx, genState.StakingParams); err != nil {
 		panic(err)
 	}
+	if err := k.SetBankParams(ctx, genState.BankParams); err != nil {
+		panic(err)
+	}
+	if err := k.SetWasmParams(ctx, genState.WasmParams); err != nil {
+		panic(err)
+	}
+	if err := k.SetCommissionParams(ctx, genState.CommissionParams); err != nil {
+		panic(err)
+	}
+	if err := k.SetIBCParams(ctx, genState.IBCParams); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the customparams module's exported genesis state.
@@ -19,5 +31,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
-	return &types.GenesisState{StakingParams: params}
+	bankParams, err := k.GetBankParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	wasmParams, err := k.GetWasmParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	commissionParams, err := k.GetCommissionParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	ibcParams, err := k.GetIBCParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		StakingParams:    params,
+		BankParams:       bankParams,
+		WasmParams:       wasmParams,
+		CommissionParams: commissionParams,
+		IBCParams:        ibcParams,
+	}
 }
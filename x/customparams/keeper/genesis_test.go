@@ -5,6 +5,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
@@ -15,11 +17,27 @@ func TestKeeper_InitAndExportGenesis(t *testing.T) {
 	testApp := simapp.New()
 	keeper := testApp.CustomParamsKeeper
 	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+	blockedAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
 	genState := types.GenesisState{
 		StakingParams: types.StakingParams{
 			MinSelfDelegation: sdkmath.OneInt(),
 		},
+		BankParams: types.BankParams{
+			BlockedAddresses: []string{blockedAddress.String()},
+		},
+		WasmParams: types.WasmParams{
+			Paused:                  true,
+			PlainExtensionTransfers: true,
+		},
+		CommissionParams: types.CommissionParams{
+			MinCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.05"),
+			ActivationHeight:  100,
+		},
+		IBCParams: types.IBCParams{
+			MaxMemoLength:      256,
+			IncomingMemoPolicy: types.MEMO_POLICY_TRUNCATE,
+		},
 	}
 	keeper.InitGenesis(ctx, genState)
 
@@ -27,6 +45,19 @@ func TestKeeper_InitAndExportGenesis(t *testing.T) {
 	params, err := keeper.GetStakingParams(ctx)
 	requireT.NoError(err)
 	requireT.Equal(sdkmath.OneInt().String(), params.MinSelfDelegation.String())
+	bankParams, err := keeper.GetBankParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState.BankParams, bankParams)
+	wasmParams, err := keeper.GetWasmParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState.WasmParams, wasmParams)
+	commissionParams, err := keeper.GetCommissionParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState.CommissionParams.MinCommissionRate.String(), commissionParams.MinCommissionRate.String())
+	requireT.Equal(genState.CommissionParams.ActivationHeight, commissionParams.ActivationHeight)
+	ibcParams, err := keeper.GetIBCParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState.IBCParams, ibcParams)
 
 	exportedGetState := keeper.ExportGenesis(ctx)
 	requireT.Equal(genState, *exportedGetState)
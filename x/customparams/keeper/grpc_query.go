@@ -13,17 +13,29 @@ import (
 // QueryKeeper defines subscope of keeper methods required by query service.
 type QueryKeeper interface {
 	GetStakingParams(ctx sdk.Context) (types.StakingParams, error)
+	GetBankParams(ctx sdk.Context) (types.BankParams, error)
+	GetWasmParams(ctx sdk.Context) (types.WasmParams, error)
+	GetCommissionParams(ctx sdk.Context) (types.CommissionParams, error)
+	GetIBCParams(ctx sdk.Context) (types.IBCParams, error)
 }
 
 // QueryService serves grpc requests for the model.
 type QueryService struct {
-	keeper QueryKeeper
+	keeper         QueryKeeper
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
 }
 
 // NewQueryService creates query service.
-func NewQueryService(keeper QueryKeeper) QueryService {
+func NewQueryService(
+	keeper QueryKeeper,
+	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+) QueryService {
 	return QueryService{
-		keeper: keeper,
+		keeper:         keeper,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
 	}
 }
 
@@ -42,3 +54,119 @@ func (qs QueryService) StakingParams(
 	}
 	return &types.QueryStakingParamsResponse{Params: params}, nil
 }
+
+// BankParams returns bank params of the model.
+func (qs QueryService) BankParams(
+	ctx context.Context,
+	req *types.QueryBankParamsRequest,
+) (*types.QueryBankParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.keeper.GetBankParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBankParamsResponse{Params: params}, nil
+}
+
+// WasmParams returns wasm params of the model.
+func (qs QueryService) WasmParams(
+	ctx context.Context,
+	req *types.QueryWasmParamsRequest,
+) (*types.QueryWasmParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.keeper.GetWasmParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryWasmParamsResponse{Params: params}, nil
+}
+
+// CommissionParams returns validator commission params of the model.
+func (qs QueryService) CommissionParams(
+	ctx context.Context,
+	req *types.QueryCommissionParamsRequest,
+) (*types.QueryCommissionParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.keeper.GetCommissionParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCommissionParamsResponse{Params: params}, nil
+}
+
+// IBCParams returns IBC memo params of the model.
+func (qs QueryService) IBCParams(
+	ctx context.Context,
+	req *types.QueryIBCParamsRequest,
+) (*types.QueryIBCParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.keeper.GetIBCParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryIBCParamsResponse{Params: params}, nil
+}
+
+// EffectiveStakingConfig returns the staking configuration combined from the staking, slashing and customparams
+// params. The global min self delegation is taken from the customparams, since the staking module doesn't define it.
+// The min commission rate is the greater of the staking one and the customparams one, the latter is applied only
+// once its activation height is reached.
+func (qs QueryService) EffectiveStakingConfig(
+	ctx context.Context,
+	req *types.QueryEffectiveStakingConfigRequest,
+) (*types.QueryEffectiveStakingConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	stakingParams, err := qs.keeper.GetStakingParams(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	commissionParams, err := qs.keeper.GetCommissionParams(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	originalStakingParams, err := qs.stakingKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slashingParams, err := qs.slashingKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	minCommissionRate := originalStakingParams.MinCommissionRate
+	if commissionParams.IsMinCommissionRateActive(sdkCtx.BlockHeight()) &&
+		commissionParams.MinCommissionRate.GT(minCommissionRate) {
+		minCommissionRate = commissionParams.MinCommissionRate
+	}
+	// the rounding matches the one used by the slashing module to jail the validators
+	minSignedPerWindow := slashingParams.MinSignedPerWindow.MulInt64(slashingParams.SignedBlocksWindow).RoundInt64()
+
+	return &types.QueryEffectiveStakingConfigResponse{
+		MinSelfDelegation:       stakingParams.MinSelfDelegation,
+		MinCommissionRate:       minCommissionRate,
+		MaxValidators:           originalStakingParams.MaxValidators,
+		BondDenom:               originalStakingParams.BondDenom,
+		UnbondingTime:           originalStakingParams.UnbondingTime,
+		SignedBlocksWindow:      slashingParams.SignedBlocksWindow,
+		MaxMissedBlocks:         slashingParams.SignedBlocksWindow - minSignedPerWindow,
+		DowntimeJailDuration:    slashingParams.DowntimeJailDuration,
+		SlashFractionDowntime:   slashingParams.SlashFractionDowntime,
+		SlashFractionDoubleSign: slashingParams.SlashFractionDoubleSign,
+	}, nil
+}
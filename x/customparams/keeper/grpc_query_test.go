@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/customparams/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+)
+
+func TestQueryService_EffectiveStakingConfig(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockHeight(10)
+	queryService := keeper.NewQueryService(testApp.CustomParamsKeeper, testApp.StakingKeeper, testApp.SlashingKeeper)
+
+	stakingParams, err := testApp.StakingKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	stakingParams.MinCommissionRate = sdkmath.LegacyMustNewDecFromStr("0.02")
+	requireT.NoError(testApp.StakingKeeper.SetParams(ctx, stakingParams))
+
+	slashingParams, err := testApp.SlashingKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	slashingParams.SignedBlocksWindow = 100
+	slashingParams.MinSignedPerWindow = sdkmath.LegacyMustNewDecFromStr("0.8")
+	requireT.NoError(testApp.SlashingKeeper.SetParams(ctx, slashingParams))
+
+	requireT.NoError(testApp.CustomParamsKeeper.SetStakingParams(ctx, types.StakingParams{
+		MinSelfDelegation: sdkmath.NewInt(50),
+	}))
+	requireT.NoError(testApp.CustomParamsKeeper.SetCommissionParams(ctx, types.CommissionParams{
+		MinCommissionRate: sdkmath.LegacyMustNewDecFromStr("0.05"),
+		ActivationHeight:  11,
+	}))
+
+	res, err := queryService.EffectiveStakingConfig(ctx, &types.QueryEffectiveStakingConfigRequest{})
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(50).String(), res.MinSelfDelegation.String())
+	// the customparams rate isn't active yet
+	requireT.Equal(stakingParams.MinCommissionRate.String(), res.MinCommissionRate.String())
+	requireT.Equal(stakingParams.MaxValidators, res.MaxValidators)
+	requireT.Equal(stakingParams.BondDenom, res.BondDenom)
+	requireT.Equal(stakingParams.UnbondingTime, res.UnbondingTime)
+	requireT.EqualValues(100, res.SignedBlocksWindow)
+	requireT.EqualValues(20, res.MaxMissedBlocks)
+	requireT.Equal(slashingParams.DowntimeJailDuration, res.DowntimeJailDuration)
+	requireT.Equal(slashingParams.SlashFractionDowntime.String(), res.SlashFractionDowntime.String())
+	requireT.Equal(slashingParams.SlashFractionDoubleSign.String(), res.SlashFractionDoubleSign.String())
+
+	// the customparams rate takes precedence once it is active and greater
+	res, err = queryService.EffectiveStakingConfig(ctx.WithBlockHeight(11), &types.QueryEffectiveStakingConfigRequest{})
+	requireT.NoError(err)
+	requireT.Equal("0.050000000000000000", res.MinCommissionRate.String())
+
+	// the staking rate takes precedence if it is greater
+	stakingParams.MinCommissionRate = sdkmath.LegacyMustNewDecFromStr("0.1")
+	requireT.NoError(testApp.StakingKeeper.SetParams(ctx, stakingParams))
+	res, err = queryService.EffectiveStakingConfig(ctx.WithBlockHeight(11), &types.QueryEffectiveStakingConfigRequest{})
+	requireT.NoError(err)
+	requireT.Equal(stakingParams.MinCommissionRate.String(), res.MinCommissionRate.String())
+}
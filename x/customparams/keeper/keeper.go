@@ -58,3 +58,140 @@ func (k Keeper) UpdateStakingParams(ctx sdk.Context, authority string, params ty
 
 	return k.SetStakingParams(ctx, params)
 }
+
+// GetBankParams returns the set of bank parameters.
+func (k Keeper) GetBankParams(ctx sdk.Context) (types.BankParams, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.BankParamsKey)
+	if err != nil {
+		return types.BankParams{}, err
+	}
+	if bz == nil {
+		return types.DefaultBankParams(), nil
+	}
+	var params types.BankParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, nil
+}
+
+// SetBankParams sets the module bank parameters.
+func (k Keeper) SetBankParams(ctx sdk.Context, params types.BankParams) error {
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.BankParamsKey, bz)
+}
+
+// UpdateBankParams is a governance operation that sets the bank parameters of the module.
+func (k Keeper) UpdateBankParams(ctx sdk.Context, authority string, params types.BankParams) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return k.SetBankParams(ctx, params)
+}
+
+// GetWasmParams returns the set of wasm parameters.
+func (k Keeper) GetWasmParams(ctx sdk.Context) (types.WasmParams, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.WasmParamsKey)
+	if err != nil {
+		return types.WasmParams{}, err
+	}
+	if bz == nil {
+		return types.DefaultWasmParams(), nil
+	}
+	var params types.WasmParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, nil
+}
+
+// SetWasmParams sets the module wasm parameters.
+func (k Keeper) SetWasmParams(ctx sdk.Context, params types.WasmParams) error {
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.WasmParamsKey, bz)
+}
+
+// UpdateWasmParams is a governance operation that sets the wasm parameters of the module.
+func (k Keeper) UpdateWasmParams(ctx sdk.Context, authority string, params types.WasmParams) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return k.SetWasmParams(ctx, params)
+}
+
+// GetCommissionParams returns the set of validator commission parameters.
+func (k Keeper) GetCommissionParams(ctx sdk.Context) (types.CommissionParams, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CommissionParamsKey)
+	if err != nil {
+		return types.CommissionParams{}, err
+	}
+	if bz == nil {
+		return types.DefaultCommissionParams(), nil
+	}
+	var params types.CommissionParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, nil
+}
+
+// SetCommissionParams sets the module validator commission parameters.
+func (k Keeper) SetCommissionParams(ctx sdk.Context, params types.CommissionParams) error {
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.CommissionParamsKey, bz)
+}
+
+// UpdateCommissionParams is a governance operation that sets the validator commission parameters of the module.
+// The positive min commission rate must be scheduled for the future height, so the commission rate of the existing
+// validators is raised when the height is reached.
+func (k Keeper) UpdateCommissionParams(ctx sdk.Context, authority string, params types.CommissionParams) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if params.MinCommissionRate.IsPositive() && params.ActivationHeight <= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidState,
+			"activation height must be greater than the current height %d, got %d",
+			ctx.BlockHeight(), params.ActivationHeight,
+		)
+	}
+
+	return k.SetCommissionParams(ctx, params)
+}
+
+// GetIBCParams returns the set of IBC memo parameters.
+func (k Keeper) GetIBCParams(ctx sdk.Context) (types.IBCParams, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.IBCParamsKey)
+	if err != nil {
+		return types.IBCParams{}, err
+	}
+	if bz == nil {
+		return types.DefaultIBCParams(), nil
+	}
+	var params types.IBCParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, nil
+}
+
+// SetIBCParams sets the module IBC memo parameters.
+func (k Keeper) SetIBCParams(ctx sdk.Context, params types.IBCParams) error {
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.IBCParamsKey, bz)
+}
+
+// UpdateIBCParams is a governance operation that sets the IBC memo parameters of the module.
+func (k Keeper) UpdateIBCParams(ctx sdk.Context, authority string, params types.IBCParams) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return k.SetIBCParams(ctx, params)
+}
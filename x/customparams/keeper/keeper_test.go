@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+)
+
+func TestKeeper_UpdateCommissionParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	keeper := testApp.CustomParamsKeeper
+	ctx := testApp.NewContext(false).WithBlockHeight(10)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params, err := keeper.GetCommissionParams(ctx)
+	requireT.NoError(err)
+	requireT.True(params.MinCommissionRate.IsZero())
+
+	minRate := sdkmath.LegacyMustNewDecFromStr("0.05")
+
+	// the floor must be scheduled for the future height
+	requireT.ErrorIs(keeper.UpdateCommissionParams(ctx, authority, types.CommissionParams{
+		MinCommissionRate: minRate,
+		ActivationHeight:  10,
+	}), types.ErrInvalidState)
+
+	requireT.NoError(keeper.UpdateCommissionParams(ctx, authority, types.CommissionParams{
+		MinCommissionRate: minRate,
+		ActivationHeight:  11,
+	}))
+	params, err = keeper.GetCommissionParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(minRate.String(), params.MinCommissionRate.String())
+	requireT.EqualValues(11, params.ActivationHeight)
+	requireT.False(params.IsMinCommissionRateActive(10))
+	requireT.True(params.IsMinCommissionRateActive(11))
+
+	// the floor can be disabled at any time
+	requireT.NoError(keeper.UpdateCommissionParams(ctx, authority, types.DefaultCommissionParams()))
+
+	// only the authority can update the params
+	requireT.Error(keeper.UpdateCommissionParams(ctx, "invalid", types.DefaultCommissionParams()))
+}
+
+func TestKeeper_UpdateIBCParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	keeper := testApp.CustomParamsKeeper
+	ctx := testApp.NewContext(false)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	params, err := keeper.GetIBCParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(types.DefaultIBCParams(), params)
+	requireT.False(params.IsMemoTooLong(string(make([]byte, 100_000))))
+
+	newParams := types.IBCParams{
+		MaxMemoLength:      10,
+		IncomingMemoPolicy: types.MEMO_POLICY_TRUNCATE,
+	}
+	requireT.NoError(keeper.UpdateIBCParams(ctx, authority, newParams))
+	params, err = keeper.GetIBCParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(newParams, params)
+	requireT.False(params.IsMemoTooLong("0123456789"))
+	requireT.True(params.IsMemoTooLong("0123456789a"))
+
+	// only the authority can update the params
+	requireT.ErrorIs(keeper.UpdateIBCParams(ctx, "invalid", types.DefaultIBCParams()), govtypes.ErrInvalidSigner)
+}
@@ -13,6 +13,10 @@ var _ types.MsgServer = MsgServer{}
 // MsgKeeper defines an interface of keeper required by fee module.
 type MsgKeeper interface {
 	UpdateStakingParams(ctx sdk.Context, authority string, params types.StakingParams) error
+	UpdateBankParams(ctx sdk.Context, authority string, params types.BankParams) error
+	UpdateWasmParams(ctx sdk.Context, authority string, params types.WasmParams) error
+	UpdateCommissionParams(ctx sdk.Context, authority string, params types.CommissionParams) error
+	UpdateIBCParams(ctx sdk.Context, authority string, params types.IBCParams) error
 }
 
 // MsgServer serves grpc tx requests for the module.
@@ -38,3 +42,53 @@ func (m MsgServer) UpdateStakingParams(
 
 	return &types.EmptyResponse{}, nil
 }
+
+// UpdateBankParams is a governance operation that sets bank parameters.
+func (m MsgServer) UpdateBankParams(
+	ctx context.Context,
+	req *types.MsgUpdateBankParams,
+) (*types.EmptyResponse, error) {
+	if err := m.keeper.UpdateBankParams(sdk.UnwrapSDKContext(ctx), req.Authority, req.BankParams); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateWasmParams is a governance operation that sets wasm parameters.
+func (m MsgServer) UpdateWasmParams(
+	ctx context.Context,
+	req *types.MsgUpdateWasmParams,
+) (*types.EmptyResponse, error) {
+	if err := m.keeper.UpdateWasmParams(sdk.UnwrapSDKContext(ctx), req.Authority, req.WasmParams); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateCommissionParams is a governance operation that sets validator commission parameters.
+func (m MsgServer) UpdateCommissionParams(
+	ctx context.Context,
+	req *types.MsgUpdateCommissionParams,
+) (*types.EmptyResponse, error) {
+	if err := m.keeper.UpdateCommissionParams(
+		sdk.UnwrapSDKContext(ctx), req.Authority, req.CommissionParams,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateIBCParams is a governance operation that sets IBC memo parameters.
+func (m MsgServer) UpdateIBCParams(
+	ctx context.Context,
+	req *types.MsgUpdateIBCParams,
+) (*types.EmptyResponse, error) {
+	if err := m.keeper.UpdateIBCParams(sdk.UnwrapSDKContext(ctx), req.Authority, req.IBCParams); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
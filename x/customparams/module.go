@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/tokenize-x/tx-chain/v7/x/customparams/client/cli"
 	"github.com/tokenize-x/tx-chain/v7/x/customparams/keeper"
 	"github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 )
@@ -70,9 +71,9 @@ func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	return nil
 }
 
-// GetQueryCmd returns no root query command for the customparams module.
+// GetQueryCmd returns the root query command for the customparams module.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
+	return cli.GetQueryCmd()
 }
 
 // RegisterInterfaces registers interfaces and implementations of the customparams module.
@@ -84,22 +85,34 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 type AppModule struct {
 	AppModuleBasic
 
-	keeper       keeper.Keeper
-	paramsKeeper types.ParamsKeeper
+	keeper         keeper.Keeper
+	paramsKeeper   types.ParamsKeeper
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
 }
 
 // NewAppModule creates a new AppModule object.
-func NewAppModule(keeper keeper.Keeper, paramsKeeper types.ParamsKeeper) AppModule {
+func NewAppModule(
+	keeper keeper.Keeper,
+	paramsKeeper types.ParamsKeeper,
+	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+) AppModule {
 	return AppModule{
-		keeper:       keeper,
-		paramsKeeper: paramsKeeper,
+		keeper:         keeper,
+		paramsKeeper:   paramsKeeper,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
 	}
 }
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
-	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper))
+	types.RegisterQueryServer(
+		cfg.QueryServer(),
+		keeper.NewQueryService(am.keeper, am.stakingKeeper, am.slashingKeeper),
+	)
 
 	m := keeper.NewMigrator(am.keeper, am.paramsKeeper)
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
@@ -10,6 +10,10 @@ import (
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateStakingParams{},
+		&MsgUpdateBankParams{},
+		&MsgUpdateWasmParams{},
+		&MsgUpdateCommissionParams{},
+		&MsgUpdateIBCParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -4,5 +4,9 @@ import (
 	sdkerrors "cosmossdk.io/errors"
 )
 
-// ErrInvalidState is returned when state of the module is invalid.
-var ErrInvalidState = sdkerrors.Register(ModuleName, 1, "invalid state")
+var (
+	// ErrInvalidState is returned when state of the module is invalid.
+	ErrInvalidState = sdkerrors.Register(ModuleName, 1, "invalid state")
+	// ErrWasmPaused is returned when the smart contract execution is requested while it is paused by the governance.
+	ErrWasmPaused = sdkerrors.Register(ModuleName, 2, "wasm execution is paused")
+)
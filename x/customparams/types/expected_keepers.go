@@ -1,10 +1,24 @@
 package types
 
 import (
+	"context"
+
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // ParamsKeeper specifies expected methods of params keeper.
 type ParamsKeeper interface {
 	GetSubspace(s string) (paramstypes.Subspace, bool)
 }
+
+// StakingKeeper specifies expected methods of staking keeper.
+type StakingKeeper interface {
+	GetParams(ctx context.Context) (stakingtypes.Params, error)
+}
+
+// SlashingKeeper specifies expected methods of slashing keeper.
+type SlashingKeeper interface {
+	GetParams(ctx context.Context) (slashingtypes.Params, error)
+}
@@ -3,11 +3,24 @@ package types
 // DefaultGenesisState returns genesis state with default values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		StakingParams: DefaultStakingParams(),
+		StakingParams:    DefaultStakingParams(),
+		BankParams:       DefaultBankParams(),
+		WasmParams:       DefaultWasmParams(),
+		CommissionParams: DefaultCommissionParams(),
+		IBCParams:        DefaultIBCParams(),
 	}
 }
 
 // Validate validates genesis parameters.
 func (m *GenesisState) Validate() error {
-	return m.StakingParams.ValidateBasic()
+	if err := m.StakingParams.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := m.BankParams.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := m.CommissionParams.ValidateBasic(); err != nil {
+		return err
+	}
+	return m.IBCParams.ValidateBasic()
 }
@@ -27,6 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// staking_params defines staking parameters of the module.
 	StakingParams StakingParams `protobuf:"bytes,1,opt,name=staking_params,json=stakingParams,proto3" json:"staking_params"`
+	// bank_params defines bank parameters of the module.
+	BankParams BankParams `protobuf:"bytes,2,opt,name=bank_params,json=bankParams,proto3" json:"bank_params"`
+	// wasm_params defines wasm parameters of the module.
+	WasmParams WasmParams `protobuf:"bytes,3,opt,name=wasm_params,json=wasmParams,proto3" json:"wasm_params"`
+	// commission_params defines validator commission parameters of the module.
+	CommissionParams CommissionParams `protobuf:"bytes,4,opt,name=commission_params,json=commissionParams,proto3" json:"commission_params"`
+	// ibc_params defines IBC parameters of the module.
+	IBCParams IBCParams `protobuf:"bytes,5,opt,name=ibc_params,json=ibcParams,proto3" json:"ibc_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +77,34 @@ func (m *GenesisState) GetStakingParams() StakingParams {
 	return StakingParams{}
 }
 
+func (m *GenesisState) GetBankParams() BankParams {
+	if m != nil {
+		return m.BankParams
+	}
+	return BankParams{}
+}
+
+func (m *GenesisState) GetWasmParams() WasmParams {
+	if m != nil {
+		return m.WasmParams
+	}
+	return WasmParams{}
+}
+
+func (m *GenesisState) GetCommissionParams() CommissionParams {
+	if m != nil {
+		return m.CommissionParams
+	}
+	return CommissionParams{}
+}
+
+func (m *GenesisState) GetIBCParams() IBCParams {
+	if m != nil {
+		return m.IBCParams
+	}
+	return IBCParams{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.customparams.v1.GenesisState")
 }
@@ -78,22 +114,28 @@ func init() {
 }
 
 var fileDescriptor_fe3d5fb69a1f14ca = []byte{
-	// 225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0x4a,
-	0x2d, 0xcd, 0xd5, 0x4f, 0x2e, 0x2d, 0x2e, 0xc9, 0xcf, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xa8, 0xd2, 0x43, 0x56, 0xa5, 0x57, 0x66, 0x28, 0xa5, 0x8c, 0x43, 0x37, 0x54,
-	0x05, 0x58, 0xb3, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95,
-	0x92, 0xb8, 0x78, 0xdc, 0x21, 0x76, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x05, 0x71, 0xf1, 0x15,
-	0x97, 0x24, 0x66, 0x67, 0xe6, 0xa5, 0xc7, 0x43, 0x74, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b,
-	0xa9, 0xea, 0x61, 0xb7, 0x5b, 0x2f, 0x18, 0xa2, 0x3a, 0x00, 0x2c, 0xe0, 0xc4, 0x72, 0xe2, 0x9e,
-	0x3c, 0x43, 0x10, 0x6f, 0x31, 0x8a, 0x60, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x97, 0xe4,
-	0x67, 0xa7, 0xe6, 0x65, 0x56, 0xa5, 0xea, 0x56, 0xe8, 0x97, 0x54, 0xe8, 0x26, 0x67, 0x24, 0x66,
-	0xe6, 0xe9, 0x97, 0x99, 0xeb, 0x57, 0xa0, 0xfa, 0xaa, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0xec, 0x78, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x8f, 0x4c, 0x9e, 0x37, 0x01, 0x00,
-	0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd2, 0xbf, 0x4e, 0x02, 0x31,
+	0x00, 0x06, 0xf0, 0x3b, 0x41, 0x13, 0x8a, 0x1a, 0xb9, 0x18, 0x63, 0x18, 0x0e, 0x45, 0x4d, 0x58,
+	0x68, 0x83, 0x26, 0xba, 0xc3, 0x60, 0xd8, 0x08, 0x0c, 0x26, 0x3a, 0x98, 0xb6, 0x69, 0x8e, 0xe6,
+	0xd2, 0x96, 0xd0, 0xf2, 0x47, 0x9f, 0xc2, 0xe7, 0xf0, 0x49, 0x18, 0x19, 0x9d, 0x88, 0x39, 0x5e,
+	0xc4, 0xd0, 0xe3, 0x2e, 0x9c, 0xf1, 0xdc, 0x9a, 0xaf, 0xdf, 0xf7, 0xeb, 0x52, 0x70, 0x4d, 0xd5,
+	0x98, 0x4d, 0x04, 0xa2, 0x13, 0x6d, 0x94, 0x18, 0xe1, 0x31, 0x16, 0x1a, 0x4d, 0x5b, 0x28, 0x60,
+	0x92, 0x69, 0xae, 0xe1, 0x68, 0xac, 0x8c, 0xf2, 0xce, 0xe2, 0x16, 0xdc, 0x6d, 0xc1, 0x69, 0xab,
+	0x7a, 0x95, 0xb3, 0xde, 0x36, 0xec, 0xb8, 0x7a, 0x1a, 0xa8, 0x40, 0xd9, 0x23, 0xda, 0x9c, 0xe2,
+	0xb4, 0xfe, 0x59, 0x00, 0x87, 0x8f, 0xf1, 0x23, 0x03, 0x83, 0x0d, 0xf3, 0xfa, 0xe0, 0x58, 0x1b,
+	0x1c, 0x72, 0x19, 0xbc, 0xc6, 0xf3, 0x73, 0xf7, 0xc2, 0x6d, 0x94, 0x6f, 0x6f, 0xe0, 0xdf, 0x8f,
+	0xc3, 0x41, 0xdc, 0xee, 0xd9, 0xa0, 0x5d, 0x5c, 0xac, 0x6a, 0x4e, 0xff, 0x48, 0xef, 0x86, 0x5e,
+	0x17, 0x94, 0x09, 0x96, 0x61, 0x02, 0xee, 0x59, 0xb0, 0x9e, 0x07, 0xb6, 0xb1, 0x0c, 0x33, 0x1a,
+	0x20, 0x69, 0xb2, 0xa1, 0x66, 0x58, 0x8b, 0x84, 0x2a, 0xfc, 0x4f, 0x3d, 0x61, 0x2d, 0xb2, 0xd4,
+	0x2c, 0x4d, 0xbc, 0x17, 0x50, 0xa1, 0x4a, 0x08, 0xae, 0x35, 0x57, 0x32, 0x01, 0x8b, 0x16, 0x6c,
+	0xe4, 0x81, 0x9d, 0x74, 0x90, 0x61, 0x4f, 0xe8, 0xaf, 0xdc, 0x1b, 0x00, 0xc0, 0x09, 0x4d, 0xd4,
+	0x7d, 0xab, 0x5e, 0xe6, 0xa9, 0xdd, 0x76, 0x67, 0xcb, 0x55, 0x36, 0x5c, 0xb4, 0xaa, 0x95, 0xd2,
+	0xa8, 0x5f, 0xe2, 0x84, 0x6e, 0x6f, 0x7b, 0x8b, 0xc8, 0x77, 0x97, 0x91, 0xef, 0x7e, 0x47, 0xbe,
+	0xfb, 0xb1, 0xf6, 0x9d, 0xe5, 0xda, 0x77, 0xbe, 0xd6, 0xbe, 0xf3, 0x7c, 0x1f, 0x70, 0x33, 0x9c,
+	0x10, 0x48, 0x95, 0x40, 0x46, 0x85, 0x4c, 0xf2, 0x77, 0xd6, 0x9c, 0x23, 0x33, 0x6f, 0xd2, 0x21,
+	0xe6, 0x12, 0x4d, 0x1f, 0xd0, 0x3c, 0xfb, 0x3d, 0xcc, 0xdb, 0x88, 0x69, 0x72, 0x60, 0x7f, 0xc1,
+	0xdd, 0xcf, 0x00, 0x10, 0xcf, 0xa0, 0xb8, 0x80, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +158,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.IBCParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.CommissionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.WasmParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BankParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.StakingParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -148,6 +230,14 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.StakingParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BankParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.WasmParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CommissionParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.IBCParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -219,6 +309,138 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WasmParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IBCParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
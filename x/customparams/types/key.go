@@ -18,4 +18,12 @@ const (
 var (
 	// StakingParamsKey defines the key to store parameters of the module, set via governance.
 	StakingParamsKey = []byte{0x01}
+	// BankParamsKey defines the key to store bank parameters of the module, set via governance.
+	BankParamsKey = []byte{0x02}
+	// WasmParamsKey defines the key to store wasm parameters of the module, set via governance.
+	WasmParamsKey = []byte{0x03}
+	// CommissionParamsKey defines the key to store validator commission parameters of the module, set via governance.
+	CommissionParamsKey = []byte{0x04}
+	// IBCParamsKey defines the key to store IBC memo parameters of the module, set via governance.
+	IBCParamsKey = []byte{0x05}
 )
@@ -9,7 +9,11 @@ import (
 
 // Type of messages for amino.
 const (
-	TypeMsgUpdateStakingParams = "update-staking-params"
+	TypeMsgUpdateStakingParams    = "update-staking-params"
+	TypeMsgUpdateBankParams       = "update-bank-params"
+	TypeMsgUpdateWasmParams       = "update-wasm-params"
+	TypeMsgUpdateCommissionParams = "update-commission-params"
+	TypeMsgUpdateIBCParams        = "update-ibc-params"
 )
 
 type extendedMsg interface {
@@ -17,11 +21,21 @@ type extendedMsg interface {
 	sdk.HasValidateBasic
 }
 
-var _ extendedMsg = &MsgUpdateStakingParams{}
+var (
+	_ extendedMsg = &MsgUpdateStakingParams{}
+	_ extendedMsg = &MsgUpdateBankParams{}
+	_ extendedMsg = &MsgUpdateWasmParams{}
+	_ extendedMsg = &MsgUpdateCommissionParams{}
+	_ extendedMsg = &MsgUpdateIBCParams{}
+)
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateStakingParams{}, ModuleName+"/MsgUpdateStakingParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateBankParams{}, ModuleName+"/MsgUpdateBankParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateWasmParams{}, ModuleName+"/MsgUpdateWasmParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateCommissionParams{}, ModuleName+"/MsgUpdateCommissionParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateIBCParams{}, ModuleName+"/MsgUpdateIBCParams")
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -36,3 +50,47 @@ func (m *MsgUpdateStakingParams) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateBankParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := m.BankParams.ValidateBasic(); err != nil {
+		return cosmoserrors.ErrInvalidRequest.Wrapf("invalid params, err: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateWasmParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateCommissionParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return m.CommissionParams.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateIBCParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := m.IBCParams.ValidateBasic(); err != nil {
+		return cosmoserrors.ErrInvalidRequest.Wrapf("invalid params, err: %s", err)
+	}
+
+	return nil
+}
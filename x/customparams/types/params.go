@@ -2,8 +2,10 @@ package types
 
 import (
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 )
 
 // ParamStoreKeyMinSelfDelegation defines the param key for the min_self_delegation param.
@@ -48,3 +50,89 @@ func validateMinSelfDelegation(i interface{}) error {
 
 	return nil
 }
+
+// DefaultBankParams returns default bank parameters.
+func DefaultBankParams() BankParams {
+	return BankParams{
+		BlockedAddresses: []string{},
+	}
+}
+
+// ValidateBasic performs basic validation on bank parameters.
+func (p BankParams) ValidateBasic() error {
+	addresses := make(map[string]struct{}, len(p.BlockedAddresses))
+	for _, address := range p.BlockedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errors.Wrapf(err, "invalid blocked address %s", address)
+		}
+		if _, exists := addresses[address]; exists {
+			return errors.Errorf("duplicated blocked address %s", address)
+		}
+		addresses[address] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsBlockedAddress returns true if the address is added to the blocked addresses.
+func (p BankParams) IsBlockedAddress(addr sdk.AccAddress) bool {
+	return lo.Contains(p.BlockedAddresses, addr.String())
+}
+
+// DefaultWasmParams returns default wasm parameters.
+func DefaultWasmParams() WasmParams {
+	return WasmParams{
+		Paused:                  false,
+		PlainExtensionTransfers: false,
+	}
+}
+
+// DefaultCommissionParams returns default validator commission parameters.
+func DefaultCommissionParams() CommissionParams {
+	return CommissionParams{
+		MinCommissionRate: sdkmath.LegacyZeroDec(),
+		ActivationHeight:  0,
+	}
+}
+
+// ValidateBasic performs basic validation on validator commission parameters.
+func (p CommissionParams) ValidateBasic() error {
+	if p.MinCommissionRate.IsNil() {
+		return errors.New("param min_commission_rate must be not nil")
+	}
+	if p.MinCommissionRate.IsNegative() || p.MinCommissionRate.GT(sdkmath.LegacyOneDec()) {
+		return errors.Errorf("param min_commission_rate must be between 0 and 1: %s", p.MinCommissionRate)
+	}
+	if p.ActivationHeight < 0 {
+		return errors.Errorf("param activation_height must not be negative: %d", p.ActivationHeight)
+	}
+
+	return nil
+}
+
+// IsMinCommissionRateActive returns true if the min commission rate is enforced at the height.
+func (p CommissionParams) IsMinCommissionRateActive(height int64) bool {
+	return p.MinCommissionRate.IsPositive() && height >= p.ActivationHeight
+}
+
+// DefaultIBCParams returns default IBC parameters. The memo length limit is disabled by default.
+func DefaultIBCParams() IBCParams {
+	return IBCParams{
+		MaxMemoLength:      0,
+		IncomingMemoPolicy: MEMO_POLICY_REJECT,
+	}
+}
+
+// ValidateBasic performs basic validation on IBC parameters.
+func (p IBCParams) ValidateBasic() error {
+	if _, ok := MemoPolicy_name[int32(p.IncomingMemoPolicy)]; !ok {
+		return errors.Errorf("param incoming_memo_policy is unknown: %d", p.IncomingMemoPolicy)
+	}
+
+	return nil
+}
+
+// IsMemoTooLong returns true if the memo length limit is enabled and the memo exceeds it.
+func (p IBCParams) IsMemoTooLong(memo string) bool {
+	return p.MaxMemoLength > 0 && uint64(len(memo)) > uint64(p.MaxMemoLength)
+}
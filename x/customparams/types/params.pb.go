@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,6 +25,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MemoPolicy defines how the incoming IBC transfer packets with the memo longer than the maximum are handled.
+type MemoPolicy int32
+
+const (
+	// MEMO_POLICY_REJECT means that the packet is rejected with the error acknowledgement, so the funds are refunded.
+	MEMO_POLICY_REJECT MemoPolicy = 0
+	// MEMO_POLICY_TRUNCATE means that the memo is truncated to the maximum length and the packet is processed.
+	MEMO_POLICY_TRUNCATE MemoPolicy = 1
+)
+
+var MemoPolicy_name = map[int32]string{
+	0: "MEMO_POLICY_REJECT",
+	1: "MEMO_POLICY_TRUNCATE",
+}
+
+var MemoPolicy_value = map[string]int32{
+	"MEMO_POLICY_REJECT":   0,
+	"MEMO_POLICY_TRUNCATE": 1,
+}
+
+func (x MemoPolicy) String() string {
+	return proto.EnumName(MemoPolicy_name, int32(x))
+}
+
+func (MemoPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_957be068a77b113f, []int{0}
+}
+
 // StakingParams defines the set of additional staking params for the staking module wrapper.
 type StakingParams struct {
 	// min_self_delegation is the validators global self declared minimum for delegation.
@@ -63,8 +92,231 @@ func (m *StakingParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StakingParams proto.InternalMessageInfo
 
+// BankParams defines the set of additional bank params for the bank module wrapper.
+type BankParams struct {
+	// blocked_addresses is the list of addresses not allowed to receive funds, in addition to the module accounts.
+	BlockedAddresses []string `protobuf:"bytes,1,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty" yaml:"blocked_addresses"`
+}
+
+func (m *BankParams) Reset()         { *m = BankParams{} }
+func (m *BankParams) String() string { return proto.CompactTextString(m) }
+func (*BankParams) ProtoMessage()    {}
+func (*BankParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957be068a77b113f, []int{1}
+}
+func (m *BankParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BankParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BankParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BankParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BankParams.Merge(m, src)
+}
+func (m *BankParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BankParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BankParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BankParams proto.InternalMessageInfo
+
+func (m *BankParams) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+// WasmParams defines the set of params controlling the smart contracts execution.
+type WasmParams struct {
+	// paused defines whether the smart contracts execution and instantiation is paused.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// plain_extension_transfers defines whether the transfers of the asset ft extension tokens are executed as the plain
+	// transfers, bypassing the extension, while the execution is paused. If false, the transfers are rejected.
+	PlainExtensionTransfers bool `protobuf:"varint,2,opt,name=plain_extension_transfers,json=plainExtensionTransfers,proto3" json:"plain_extension_transfers,omitempty" yaml:"plain_extension_transfers"`
+}
+
+func (m *WasmParams) Reset()         { *m = WasmParams{} }
+func (m *WasmParams) String() string { return proto.CompactTextString(m) }
+func (*WasmParams) ProtoMessage()    {}
+func (*WasmParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957be068a77b113f, []int{2}
+}
+func (m *WasmParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WasmParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WasmParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmParams.Merge(m, src)
+}
+func (m *WasmParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *WasmParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmParams proto.InternalMessageInfo
+
+func (m *WasmParams) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *WasmParams) GetPlainExtensionTransfers() bool {
+	if m != nil {
+		return m.PlainExtensionTransfers
+	}
+	return false
+}
+
+// CommissionParams defines the set of params controlling the minimum commission rate of the validators.
+type CommissionParams struct {
+	// min_commission_rate is the minimum commission rate of the validators. Zero disables the floor.
+	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// activation_height is the height the min_commission_rate is enforced from. At this height the commission rate of
+	// the existing validators below the floor is raised to it.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty" yaml:"activation_height"`
+}
+
+func (m *CommissionParams) Reset()         { *m = CommissionParams{} }
+func (m *CommissionParams) String() string { return proto.CompactTextString(m) }
+func (*CommissionParams) ProtoMessage()    {}
+func (*CommissionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957be068a77b113f, []int{3}
+}
+func (m *CommissionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionParams.Merge(m, src)
+}
+func (m *CommissionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionParams proto.InternalMessageInfo
+
+func (m *CommissionParams) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// IBCParams defines the set of params controlling the memo of the IBC transfers.
+type IBCParams struct {
+	// max_memo_length is the maximum length of the memo in bytes of the outgoing and incoming IBC transfers. Zero
+	// disables the limit.
+	MaxMemoLength uint32 `protobuf:"varint,1,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty" yaml:"max_memo_length"`
+	// incoming_memo_policy defines how the incoming packets with the memo longer than the maximum are handled. The
+	// outgoing transfers with such memo are always rejected.
+	IncomingMemoPolicy MemoPolicy `protobuf:"varint,2,opt,name=incoming_memo_policy,json=incomingMemoPolicy,proto3,enum=coreum.customparams.v1.MemoPolicy" json:"incoming_memo_policy,omitempty" yaml:"incoming_memo_policy"`
+	// max_callback_gas is the max gas the IBC callbacks of the smart contracts can consume. It is applied on top of the
+	// limit of the callbacks middleware. Zero means that only the limit of the middleware is applied.
+	MaxCallbackGas uint64 `protobuf:"varint,3,opt,name=max_callback_gas,json=maxCallbackGas,proto3" json:"max_callback_gas,omitempty" yaml:"max_callback_gas"`
+}
+
+func (m *IBCParams) Reset()         { *m = IBCParams{} }
+func (m *IBCParams) String() string { return proto.CompactTextString(m) }
+func (*IBCParams) ProtoMessage()    {}
+func (*IBCParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957be068a77b113f, []int{4}
+}
+func (m *IBCParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCParams.Merge(m, src)
+}
+func (m *IBCParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCParams proto.InternalMessageInfo
+
+func (m *IBCParams) GetMaxMemoLength() uint32 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
+}
+
+func (m *IBCParams) GetIncomingMemoPolicy() MemoPolicy {
+	if m != nil {
+		return m.IncomingMemoPolicy
+	}
+	return MEMO_POLICY_REJECT
+}
+
+func (m *IBCParams) GetMaxCallbackGas() uint64 {
+	if m != nil {
+		return m.MaxCallbackGas
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("coreum.customparams.v1.MemoPolicy", MemoPolicy_name, MemoPolicy_value)
 	proto.RegisterType((*StakingParams)(nil), "coreum.customparams.v1.StakingParams")
+	proto.RegisterType((*BankParams)(nil), "coreum.customparams.v1.BankParams")
+	proto.RegisterType((*WasmParams)(nil), "coreum.customparams.v1.WasmParams")
+	proto.RegisterType((*CommissionParams)(nil), "coreum.customparams.v1.CommissionParams")
+	proto.RegisterType((*IBCParams)(nil), "coreum.customparams.v1.IBCParams")
 }
 
 func init() {
@@ -72,24 +324,50 @@ func init() {
 }
 
 var fileDescriptor_957be068a77b113f = []byte{
-	// 257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0x4a,
-	0x2d, 0xcd, 0xd5, 0x4f, 0x2e, 0x2d, 0x2e, 0xc9, 0xcf, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2f, 0x33, 0xd4, 0x87, 0xb0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0x20, 0x8a, 0xf4,
-	0x90, 0x15, 0xe9, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x95, 0xe8, 0x83, 0x58,
-	0x10, 0xd5, 0x4a, 0x35, 0x5c, 0xbc, 0xc1, 0x25, 0x89, 0xd9, 0x99, 0x79, 0xe9, 0x01, 0x60, 0x95,
-	0x42, 0xd9, 0x5c, 0xc2, 0xb9, 0x99, 0x79, 0xf1, 0xc5, 0xa9, 0x39, 0x69, 0xf1, 0x29, 0xa9, 0x39,
-	0xa9, 0xe9, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0xd6, 0x27,
-	0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0x2f, 0x9a, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x5c, 0x9c, 0x92,
-	0xad, 0x97, 0x99, 0xaf, 0x9f, 0x9b, 0x58, 0x92, 0xa1, 0xe7, 0x99, 0x57, 0xf2, 0xe9, 0x9e, 0xbc,
-	0x54, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x16, 0x13, 0x94, 0x82, 0x04, 0x73, 0x33, 0xf3, 0x82,
-	0x53, 0x73, 0xd2, 0x5c, 0xe0, 0x62, 0x4e, 0x01, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x5f, 0x92,
-	0x9f, 0x9d, 0x9a, 0x97, 0x59, 0x95, 0xaa, 0x5b, 0xa1, 0x5f, 0x52, 0xa1, 0x9b, 0x9c, 0x91, 0x98,
-	0x99, 0xa7, 0x5f, 0x66, 0xae, 0x5f, 0x81, 0x1a, 0x0e, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c,
-	0x60, 0x6f, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x94, 0xba, 0xa7, 0x14, 0x2b, 0x01, 0x00,
-	0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xe3, 0xb6, 0xaa, 0xfe, 0x8e, 0x94, 0xfe, 0x89, 0x09, 0x6d, 0x9a, 0xa2, 0x38, 0x32,
+	0x2c, 0x0a, 0x52, 0x6d, 0x15, 0x10, 0x48, 0xb0, 0xaa, 0x93, 0x08, 0x82, 0x5a, 0x1a, 0xb9, 0x41,
+	0x08, 0x36, 0x66, 0x32, 0x99, 0x3a, 0xa3, 0x78, 0x66, 0x22, 0xcf, 0x24, 0x72, 0x10, 0x12, 0x5b,
+	0x36, 0x48, 0x6c, 0x59, 0xf3, 0x0a, 0x3c, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xc2, 0x42, 0xed, 0x1b,
+	0xf8, 0x09, 0x50, 0x3c, 0x6e, 0x93, 0xd2, 0xb2, 0xb3, 0xcf, 0x7c, 0xf7, 0xdc, 0x7b, 0x7d, 0xe4,
+	0x01, 0xb7, 0x11, 0x0f, 0xf1, 0x88, 0xda, 0x68, 0x24, 0x24, 0xa7, 0x43, 0x18, 0x42, 0x2a, 0xec,
+	0xf1, 0x8e, 0xad, 0x9e, 0xac, 0x61, 0xc8, 0x25, 0xd7, 0xd7, 0x14, 0x64, 0xcd, 0x43, 0xd6, 0x78,
+	0xa7, 0xb2, 0x81, 0xb8, 0xa0, 0x5c, 0x78, 0x29, 0x65, 0xab, 0x17, 0x55, 0x52, 0x29, 0xf9, 0xdc,
+	0xe7, 0x4a, 0x9f, 0x3e, 0x29, 0xd5, 0xfc, 0x00, 0xf2, 0x87, 0x12, 0x0e, 0x08, 0xf3, 0xdb, 0xa9,
+	0x89, 0x3e, 0x00, 0x37, 0x28, 0x61, 0x9e, 0xc0, 0xc1, 0x91, 0xd7, 0xc3, 0x01, 0xf6, 0xa1, 0x24,
+	0x9c, 0x95, 0xb5, 0x9a, 0xb6, 0xb5, 0xe2, 0x3c, 0x3d, 0x8e, 0x8d, 0xdc, 0xaf, 0xd8, 0xb8, 0xa9,
+	0x9c, 0x45, 0x6f, 0x60, 0x11, 0x6e, 0x53, 0x28, 0xfb, 0x56, 0x8b, 0xc9, 0x24, 0x36, 0x2a, 0x13,
+	0x48, 0x83, 0x27, 0xe6, 0x35, 0x0e, 0xa6, 0x5b, 0xa4, 0x84, 0x1d, 0xe2, 0xe0, 0xa8, 0x31, 0xd3,
+	0x38, 0x00, 0x0e, 0x64, 0x83, 0xac, 0x35, 0x04, 0xc5, 0x6e, 0xc0, 0xd1, 0x00, 0xf7, 0x3c, 0xd8,
+	0xeb, 0x85, 0x58, 0x08, 0x2c, 0xca, 0x5a, 0x6d, 0x71, 0x6b, 0xc5, 0x79, 0x98, 0xc4, 0x46, 0x59,
+	0x79, 0x5f, 0x41, 0xcc, 0x1f, 0xdf, 0xb7, 0x4b, 0xd9, 0xaa, 0xbb, 0x4a, 0x3c, 0x94, 0x21, 0x61,
+	0xbe, 0x5b, 0xc8, 0xd8, 0xdd, 0x0b, 0xf4, 0xab, 0x06, 0xc0, 0x6b, 0x28, 0x68, 0xd6, 0xf1, 0x2e,
+	0x58, 0x1e, 0xc2, 0x91, 0xc0, 0xbd, 0x74, 0xbf, 0xff, 0x9c, 0x62, 0x12, 0x1b, 0x79, 0xd5, 0x46,
+	0xe9, 0xa6, 0x9b, 0x01, 0xfa, 0x3b, 0xb0, 0x31, 0x0c, 0x20, 0x61, 0x1e, 0x8e, 0x24, 0x66, 0x82,
+	0x70, 0xe6, 0xc9, 0x10, 0x32, 0x71, 0x84, 0x43, 0x51, 0x5e, 0x48, 0xab, 0xef, 0x24, 0xb1, 0x51,
+	0xcb, 0xaa, 0xff, 0x85, 0x9a, 0xee, 0x7a, 0x7a, 0xd6, 0x3c, 0x3f, 0xea, 0x5c, 0x9c, 0xc4, 0x1a,
+	0x28, 0xd4, 0x39, 0xa5, 0x44, 0x4c, 0xf5, 0x6c, 0xc2, 0x8f, 0x2a, 0x0e, 0x74, 0xa1, 0x7b, 0x21,
+	0x94, 0x38, 0x8b, 0xe3, 0x20, 0x8b, 0x63, 0xf3, 0x6a, 0x1c, 0x7b, 0xd8, 0x87, 0x68, 0xd2, 0xc0,
+	0xe8, 0x72, 0x28, 0x7f, 0xf9, 0x4c, 0x3f, 0x1d, 0xc8, 0x3e, 0x5d, 0x03, 0xa3, 0x34, 0xa2, 0xd9,
+	0x08, 0x2e, 0x94, 0x58, 0x6f, 0x81, 0x22, 0x44, 0x92, 0x8c, 0xd3, 0xc0, 0xbc, 0x3e, 0x26, 0x7e,
+	0x5f, 0xa6, 0xfb, 0x2e, 0x3a, 0xb7, 0x66, 0xa1, 0x5c, 0x41, 0x4c, 0xb7, 0x30, 0xd3, 0x9e, 0x2b,
+	0xe9, 0xf3, 0x02, 0x58, 0x69, 0x39, 0xf5, 0x6c, 0x33, 0x07, 0xfc, 0x4f, 0x61, 0xe4, 0x51, 0x4c,
+	0xb9, 0x17, 0x60, 0xe6, 0xcb, 0x7e, 0xba, 0x55, 0xde, 0xa9, 0x24, 0xb1, 0xb1, 0x96, 0x8d, 0x7c,
+	0x19, 0x30, 0xdd, 0x3c, 0x85, 0xd1, 0x3e, 0xa6, 0x7c, 0x2f, 0x7d, 0xd7, 0x47, 0xa0, 0x44, 0x18,
+	0xe2, 0x94, 0x30, 0x5f, 0x71, 0x43, 0x1e, 0x10, 0x34, 0x49, 0xe7, 0x5b, 0xbd, 0x6f, 0x5a, 0xd7,
+	0xff, 0x25, 0xd6, 0xd4, 0xa1, 0x9d, 0x92, 0x8e, 0x91, 0xc4, 0xc6, 0xa6, 0x6a, 0x76, 0x9d, 0x93,
+	0xe9, 0xea, 0xe7, 0xf2, 0xac, 0x48, 0x6f, 0x82, 0xc2, 0x74, 0x32, 0x04, 0x83, 0xa0, 0x0b, 0xd1,
+	0xc0, 0xf3, 0xa1, 0x28, 0x2f, 0xd6, 0xb4, 0xad, 0x25, 0x67, 0x33, 0x89, 0x8d, 0xf5, 0xd9, 0xec,
+	0xf3, 0x84, 0xe9, 0xae, 0x52, 0x18, 0xd5, 0x33, 0xe5, 0x19, 0x14, 0xf7, 0x1a, 0x00, 0xcc, 0x99,
+	0xae, 0x01, 0x7d, 0xbf, 0xb9, 0x7f, 0xe0, 0xb5, 0x0f, 0xf6, 0x5a, 0xf5, 0x37, 0x9e, 0xdb, 0x7c,
+	0xd1, 0xac, 0x77, 0x0a, 0x39, 0xbd, 0x0c, 0x4a, 0xf3, 0x7a, 0xc7, 0x7d, 0xf5, 0xb2, 0xbe, 0xdb,
+	0x69, 0x16, 0xb4, 0xca, 0xd2, 0xa7, 0x6f, 0xd5, 0x9c, 0xd3, 0x3e, 0x3e, 0xad, 0x6a, 0x27, 0xa7,
+	0x55, 0xed, 0xf7, 0x69, 0x55, 0xfb, 0x72, 0x56, 0xcd, 0x9d, 0x9c, 0x55, 0x73, 0x3f, 0xcf, 0xaa,
+	0xb9, 0xb7, 0x8f, 0x7c, 0x22, 0xfb, 0xa3, 0xae, 0x85, 0x38, 0xb5, 0x25, 0x1f, 0x60, 0x46, 0xde,
+	0xe3, 0xed, 0xc8, 0x96, 0xd1, 0x36, 0xea, 0x43, 0xc2, 0xec, 0xf1, 0x63, 0x3b, 0xba, 0x7c, 0xcd,
+	0xc8, 0xc9, 0x10, 0x8b, 0xee, 0x72, 0x7a, 0x35, 0x3c, 0xf8, 0x33, 0x00, 0x47, 0xdb, 0x57, 0x0a,
+	0x8a, 0x04, 0x00, 0x00,
 }
 
 func (m *StakingParams) Marshal() (dAtA []byte, err error) {
@@ -125,6 +403,157 @@ func (m *StakingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BankParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BankParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BankParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WasmParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlainExtensionTransfers {
+		i--
+		if m.PlainExtensionTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCallbackGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCallbackGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IncomingMemoPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IncomingMemoPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -147,31 +576,93 @@ func (m *StakingParams) Size() (n int) {
 	return n
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BankParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
 }
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *WasmParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if m.PlainExtensionTransfers {
+		n += 2
+	}
+	return n
 }
-func (m *StakingParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *CommissionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *IBCParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxMemoLength))
+	}
+	if m.IncomingMemoPolicy != 0 {
+		n += 1 + sovParams(uint64(m.IncomingMemoPolicy))
+	}
+	if m.MaxCallbackGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxCallbackGas))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StakingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
@@ -237,6 +728,388 @@ func (m *StakingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BankParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BankParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BankParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainExtensionTransfers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PlainExtensionTransfers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncomingMemoPolicy", wireType)
+			}
+			m.IncomingMemoPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncomingMemoPolicy |= MemoPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGas", wireType)
+			}
+			m.MaxCallbackGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
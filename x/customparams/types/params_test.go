@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,3 +16,30 @@ func TestStakingParams_ValidateBasic(t *testing.T) {
 	p.MinSelfDelegation = sdkmath.NewInt(-1)
 	require.Error(t, p.ValidateBasic())
 }
+
+func TestBankParams_ValidateBasic(t *testing.T) {
+	p := DefaultBankParams()
+	require.NoError(t, p.ValidateBasic())
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	p.BlockedAddresses = []string{address}
+	require.NoError(t, p.ValidateBasic())
+
+	p.BlockedAddresses = []string{address, address}
+	require.Error(t, p.ValidateBasic())
+
+	p.BlockedAddresses = []string{"invalid"}
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestIBCParams_ValidateBasic(t *testing.T) {
+	p := DefaultIBCParams()
+	require.NoError(t, p.ValidateBasic())
+
+	p.MaxMemoLength = 100
+	p.IncomingMemoPolicy = MEMO_POLICY_TRUNCATE
+	require.NoError(t, p.ValidateBasic())
+
+	p.IncomingMemoPolicy = MemoPolicy(2)
+	require.Error(t, p.ValidateBasic())
+}
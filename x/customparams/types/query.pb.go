@@ -5,23 +5,29 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -111,9 +117,487 @@ func (m *QueryStakingParamsResponse) GetParams() StakingParams {
 	return StakingParams{}
 }
 
+// QueryBankParamsRequest defines the request type for querying x/customparams bank parameters.
+type QueryBankParamsRequest struct {
+}
+
+func (m *QueryBankParamsRequest) Reset()         { *m = QueryBankParamsRequest{} }
+func (m *QueryBankParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBankParamsRequest) ProtoMessage()    {}
+func (*QueryBankParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{2}
+}
+func (m *QueryBankParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBankParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBankParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBankParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBankParamsRequest.Merge(m, src)
+}
+func (m *QueryBankParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBankParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBankParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBankParamsRequest proto.InternalMessageInfo
+
+// QueryBankParamsResponse defines the response type for querying x/customparams bank parameters.
+type QueryBankParamsResponse struct {
+	Params BankParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryBankParamsResponse) Reset()         { *m = QueryBankParamsResponse{} }
+func (m *QueryBankParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBankParamsResponse) ProtoMessage()    {}
+func (*QueryBankParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{3}
+}
+func (m *QueryBankParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBankParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBankParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBankParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBankParamsResponse.Merge(m, src)
+}
+func (m *QueryBankParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBankParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBankParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBankParamsResponse proto.InternalMessageInfo
+
+func (m *QueryBankParamsResponse) GetParams() BankParams {
+	if m != nil {
+		return m.Params
+	}
+	return BankParams{}
+}
+
+// QueryWasmParamsRequest defines the request type for querying x/customparams wasm parameters.
+type QueryWasmParamsRequest struct {
+}
+
+func (m *QueryWasmParamsRequest) Reset()         { *m = QueryWasmParamsRequest{} }
+func (m *QueryWasmParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWasmParamsRequest) ProtoMessage()    {}
+func (*QueryWasmParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{4}
+}
+func (m *QueryWasmParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWasmParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWasmParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWasmParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWasmParamsRequest.Merge(m, src)
+}
+func (m *QueryWasmParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWasmParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWasmParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWasmParamsRequest proto.InternalMessageInfo
+
+// QueryWasmParamsResponse defines the response type for querying x/customparams wasm parameters.
+type QueryWasmParamsResponse struct {
+	Params WasmParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryWasmParamsResponse) Reset()         { *m = QueryWasmParamsResponse{} }
+func (m *QueryWasmParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWasmParamsResponse) ProtoMessage()    {}
+func (*QueryWasmParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{5}
+}
+func (m *QueryWasmParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWasmParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWasmParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWasmParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWasmParamsResponse.Merge(m, src)
+}
+func (m *QueryWasmParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWasmParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWasmParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWasmParamsResponse proto.InternalMessageInfo
+
+func (m *QueryWasmParamsResponse) GetParams() WasmParams {
+	if m != nil {
+		return m.Params
+	}
+	return WasmParams{}
+}
+
+// QueryCommissionParamsRequest defines the request type for querying x/customparams commission parameters.
+type QueryCommissionParamsRequest struct {
+}
+
+func (m *QueryCommissionParamsRequest) Reset()         { *m = QueryCommissionParamsRequest{} }
+func (m *QueryCommissionParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionParamsRequest) ProtoMessage()    {}
+func (*QueryCommissionParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{6}
+}
+func (m *QueryCommissionParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommissionParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionParamsRequest.Merge(m, src)
+}
+func (m *QueryCommissionParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommissionParamsRequest proto.InternalMessageInfo
+
+// QueryCommissionParamsResponse defines the response type for querying x/customparams commission parameters.
+type QueryCommissionParamsResponse struct {
+	Params CommissionParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryCommissionParamsResponse) Reset()         { *m = QueryCommissionParamsResponse{} }
+func (m *QueryCommissionParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionParamsResponse) ProtoMessage()    {}
+func (*QueryCommissionParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{7}
+}
+func (m *QueryCommissionParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommissionParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionParamsResponse.Merge(m, src)
+}
+func (m *QueryCommissionParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommissionParamsResponse proto.InternalMessageInfo
+
+func (m *QueryCommissionParamsResponse) GetParams() CommissionParams {
+	if m != nil {
+		return m.Params
+	}
+	return CommissionParams{}
+}
+
+// QueryIBCParamsRequest defines the request type for querying x/customparams IBC parameters.
+type QueryIBCParamsRequest struct {
+}
+
+func (m *QueryIBCParamsRequest) Reset()         { *m = QueryIBCParamsRequest{} }
+func (m *QueryIBCParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCParamsRequest) ProtoMessage()    {}
+func (*QueryIBCParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{8}
+}
+func (m *QueryIBCParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCParamsRequest.Merge(m, src)
+}
+func (m *QueryIBCParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCParamsRequest proto.InternalMessageInfo
+
+// QueryIBCParamsResponse defines the response type for querying x/customparams IBC parameters.
+type QueryIBCParamsResponse struct {
+	Params IBCParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryIBCParamsResponse) Reset()         { *m = QueryIBCParamsResponse{} }
+func (m *QueryIBCParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIBCParamsResponse) ProtoMessage()    {}
+func (*QueryIBCParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{9}
+}
+func (m *QueryIBCParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIBCParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIBCParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIBCParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIBCParamsResponse.Merge(m, src)
+}
+func (m *QueryIBCParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIBCParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIBCParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIBCParamsResponse proto.InternalMessageInfo
+
+func (m *QueryIBCParamsResponse) GetParams() IBCParams {
+	if m != nil {
+		return m.Params
+	}
+	return IBCParams{}
+}
+
+// QueryEffectiveStakingConfigRequest defines the request type for querying the effective staking configuration.
+type QueryEffectiveStakingConfigRequest struct {
+}
+
+func (m *QueryEffectiveStakingConfigRequest) Reset()         { *m = QueryEffectiveStakingConfigRequest{} }
+func (m *QueryEffectiveStakingConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveStakingConfigRequest) ProtoMessage()    {}
+func (*QueryEffectiveStakingConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{10}
+}
+func (m *QueryEffectiveStakingConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveStakingConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveStakingConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveStakingConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveStakingConfigRequest.Merge(m, src)
+}
+func (m *QueryEffectiveStakingConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveStakingConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveStakingConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveStakingConfigRequest proto.InternalMessageInfo
+
+// QueryEffectiveStakingConfigResponse defines the response type for querying the effective staking configuration.
+type QueryEffectiveStakingConfigResponse struct {
+	// min_self_delegation is the global min self delegation of x/customparams. The min self delegation declared by the
+	// validator must be greater than or equal to it.
+	MinSelfDelegation cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=cosmossdk.io/math.Int" json:"min_self_delegation"`
+	// min_commission_rate is the greater of the staking min commission rate and the x/customparams min commission rate,
+	// the latter is taken into account only once its activation height is reached.
+	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate"`
+	// max_validators is the max number of the bonded validators of the staking module.
+	MaxValidators uint32 `protobuf:"varint,3,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// bond_denom is the bondable coin denomination of the staking module.
+	BondDenom string `protobuf:"bytes,4,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// unbonding_time is the unbonding time of the staking module.
+	UnbondingTime time.Duration `protobuf:"bytes,5,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time"`
+	// signed_blocks_window is the number of the blocks the liveness of the validator is tracked in by the slashing
+	// module.
+	SignedBlocksWindow int64 `protobuf:"varint,6,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// max_missed_blocks is the max number of the blocks the validator can miss in the signed blocks window without
+	// being jailed for the downtime.
+	MaxMissedBlocks int64 `protobuf:"varint,7,opt,name=max_missed_blocks,json=maxMissedBlocks,proto3" json:"max_missed_blocks,omitempty"`
+	// downtime_jail_duration is the duration the validator is jailed for the downtime.
+	DowntimeJailDuration time.Duration `protobuf:"bytes,8,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
+	// slash_fraction_downtime is the fraction of the stake slashed for the downtime.
+	SlashFractionDowntime cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// slash_fraction_double_sign is the fraction of the stake slashed for the double signing.
+	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
+}
+
+func (m *QueryEffectiveStakingConfigResponse) Reset()         { *m = QueryEffectiveStakingConfigResponse{} }
+func (m *QueryEffectiveStakingConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveStakingConfigResponse) ProtoMessage()    {}
+func (*QueryEffectiveStakingConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{11}
+}
+func (m *QueryEffectiveStakingConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveStakingConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveStakingConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveStakingConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveStakingConfigResponse.Merge(m, src)
+}
+func (m *QueryEffectiveStakingConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveStakingConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveStakingConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveStakingConfigResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveStakingConfigResponse) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *QueryEffectiveStakingConfigResponse) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+func (m *QueryEffectiveStakingConfigResponse) GetUnbondingTime() time.Duration {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *QueryEffectiveStakingConfigResponse) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *QueryEffectiveStakingConfigResponse) GetMaxMissedBlocks() int64 {
+	if m != nil {
+		return m.MaxMissedBlocks
+	}
+	return 0
+}
+
+func (m *QueryEffectiveStakingConfigResponse) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryStakingParamsRequest)(nil), "coreum.customparams.v1.QueryStakingParamsRequest")
 	proto.RegisterType((*QueryStakingParamsResponse)(nil), "coreum.customparams.v1.QueryStakingParamsResponse")
+	proto.RegisterType((*QueryBankParamsRequest)(nil), "coreum.customparams.v1.QueryBankParamsRequest")
+	proto.RegisterType((*QueryBankParamsResponse)(nil), "coreum.customparams.v1.QueryBankParamsResponse")
+	proto.RegisterType((*QueryWasmParamsRequest)(nil), "coreum.customparams.v1.QueryWasmParamsRequest")
+	proto.RegisterType((*QueryWasmParamsResponse)(nil), "coreum.customparams.v1.QueryWasmParamsResponse")
+	proto.RegisterType((*QueryCommissionParamsRequest)(nil), "coreum.customparams.v1.QueryCommissionParamsRequest")
+	proto.RegisterType((*QueryCommissionParamsResponse)(nil), "coreum.customparams.v1.QueryCommissionParamsResponse")
+	proto.RegisterType((*QueryIBCParamsRequest)(nil), "coreum.customparams.v1.QueryIBCParamsRequest")
+	proto.RegisterType((*QueryIBCParamsResponse)(nil), "coreum.customparams.v1.QueryIBCParamsResponse")
+	proto.RegisterType((*QueryEffectiveStakingConfigRequest)(nil), "coreum.customparams.v1.QueryEffectiveStakingConfigRequest")
+	proto.RegisterType((*QueryEffectiveStakingConfigResponse)(nil), "coreum.customparams.v1.QueryEffectiveStakingConfigResponse")
 }
 
 func init() {
@@ -121,26 +605,66 @@ func init() {
 }
 
 var fileDescriptor_da080998585ae5b1 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0x4a,
-	0x2d, 0xcd, 0xd5, 0x4f, 0x2e, 0x2d, 0x2e, 0xc9, 0xcf, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x83, 0xa8, 0xd1, 0x43, 0x56, 0xa3, 0x57, 0x66, 0x28, 0xa5, 0x8c, 0x43, 0x2f, 0x54, 0x05, 0x58,
-	0xb3, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0xd2, 0xf3,
-	0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b, 0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b,
-	0x32, 0xf3, 0xf3, 0xa0, 0x7a, 0x94, 0xa4, 0xb9, 0x24, 0x03, 0x41, 0xf6, 0x07, 0x97, 0x24, 0x66,
-	0x67, 0xe6, 0xa5, 0x07, 0x80, 0xcd, 0x0b, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x4a, 0xe4,
-	0x92, 0xc2, 0x26, 0x59, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x2a, 0xe4, 0xcc, 0xc5, 0x06, 0xb1, 0x5e,
-	0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x55, 0x0f, 0xbb, 0xe3, 0xf5, 0x50, 0xb4, 0x3b, 0xb1,
-	0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0xd5, 0x6a, 0xb4, 0x9e, 0x91, 0x8b, 0x15, 0x6c, 0x87, 0xd0,
-	0x52, 0x46, 0x2e, 0x5e, 0x14, 0x95, 0x42, 0x86, 0xb8, 0x0c, 0xc4, 0xe9, 0x62, 0x29, 0x23, 0x52,
-	0xb4, 0x40, 0xfc, 0xa1, 0xa4, 0xdb, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x75, 0x21, 0x55, 0x7d, 0x1c,
-	0x81, 0x5c, 0x0c, 0xd1, 0x06, 0x11, 0x70, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xb3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x92,
-	0xfc, 0xec, 0xd4, 0xbc, 0xcc, 0xaa, 0x54, 0xdd, 0x0a, 0xfd, 0x92, 0x0a, 0xdd, 0xe4, 0x8c, 0xc4,
-	0xcc, 0x3c, 0xfd, 0x32, 0x73, 0xfd, 0x0a, 0x54, 0xc3, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x51, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x41, 0xf2, 0xd7, 0x4e, 0x21, 0x02, 0x00,
+	// 945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x6e, 0x1b, 0x45,
+	0x14, 0xc6, 0xb3, 0x6d, 0x13, 0x92, 0x41, 0x29, 0x74, 0x48, 0x9c, 0xcd, 0xb6, 0x71, 0x52, 0xa7,
+	0x11, 0x26, 0x92, 0x77, 0x93, 0xf0, 0x57, 0x70, 0x01, 0x72, 0x4c, 0xa5, 0x56, 0x54, 0x2a, 0x0e,
+	0xa2, 0x2a, 0x5c, 0xac, 0xc6, 0xbb, 0xe3, 0xcd, 0xe0, 0x9d, 0x19, 0x77, 0x67, 0xd6, 0x71, 0xb8,
+	0x83, 0x27, 0x40, 0x42, 0x48, 0xf0, 0x00, 0x3c, 0x00, 0x12, 0x0f, 0x51, 0x89, 0x9b, 0x0a, 0x6e,
+	0x10, 0x17, 0x05, 0x25, 0xbc, 0x06, 0x12, 0xda, 0xd9, 0x59, 0x3b, 0x5e, 0x67, 0x5d, 0x27, 0x77,
+	0x9e, 0x73, 0xce, 0x77, 0xbe, 0xdf, 0x7a, 0xf7, 0x9c, 0x01, 0x15, 0x8f, 0x47, 0x38, 0xa6, 0x8e,
+	0x17, 0x0b, 0xc9, 0x69, 0x17, 0x45, 0x88, 0x0a, 0xa7, 0xb7, 0xeb, 0x3c, 0x89, 0x71, 0x74, 0x6c,
+	0x77, 0x23, 0x2e, 0x39, 0x2c, 0xa5, 0x35, 0xf6, 0xd9, 0x1a, 0xbb, 0xb7, 0x6b, 0x6d, 0x16, 0x68,
+	0x75, 0x85, 0x12, 0x5b, 0xab, 0x1e, 0x17, 0x94, 0x0b, 0x57, 0x9d, 0x9c, 0xf4, 0xa0, 0x53, 0x4b,
+	0x01, 0x0f, 0x78, 0x1a, 0x4f, 0x7e, 0xe9, 0xe8, 0xad, 0x80, 0xf3, 0x20, 0xc4, 0x0e, 0xea, 0x12,
+	0x07, 0x31, 0xc6, 0x25, 0x92, 0x84, 0xb3, 0x4c, 0x53, 0xd6, 0x59, 0x75, 0x6a, 0xc5, 0x6d, 0xc7,
+	0x8f, 0x23, 0x55, 0x90, 0xe6, 0x2b, 0x37, 0xc1, 0xea, 0xa7, 0x09, 0xfa, 0x81, 0x44, 0x1d, 0xc2,
+	0x82, 0x87, 0x0a, 0xa5, 0x89, 0x9f, 0xc4, 0x58, 0xc8, 0x0a, 0x02, 0xd6, 0x79, 0x49, 0xd1, 0xe5,
+	0x4c, 0x60, 0xb8, 0x0f, 0xe6, 0x52, 0x72, 0xd3, 0xd8, 0x30, 0xaa, 0x2f, 0xef, 0x6d, 0xd9, 0xe7,
+	0x3f, 0xb7, 0x3d, 0x22, 0xaf, 0x5f, 0x7b, 0xfa, 0x7c, 0x7d, 0xa6, 0xa9, 0xa5, 0x15, 0x13, 0x94,
+	0x94, 0x45, 0x1d, 0xb1, 0xce, 0xa8, 0xf9, 0x97, 0x60, 0x65, 0x2c, 0xa3, 0x9d, 0x3f, 0xca, 0x39,
+	0x57, 0x8a, 0x9c, 0x87, 0xda, 0x02, 0xdb, 0x47, 0x48, 0xd0, 0xf3, 0x6d, 0xcf, 0x66, 0x2e, 0x6a,
+	0x3b, 0xd4, 0xe6, 0x6c, 0xcb, 0xe0, 0x96, 0x6a, 0xbe, 0xcf, 0x29, 0x25, 0x42, 0x10, 0xce, 0x46,
+	0xcd, 0x03, 0xb0, 0x56, 0x90, 0xd7, 0x08, 0x77, 0x73, 0x08, 0xd5, 0x22, 0x84, 0x7c, 0x87, 0x1c,
+	0xc8, 0x0a, 0x58, 0x56, 0x46, 0xf7, 0xea, 0xfb, 0xa3, 0x04, 0x8f, 0x41, 0x29, 0x9f, 0xd0, 0xd6,
+	0x1f, 0xe6, 0xac, 0x6f, 0x17, 0x59, 0x0f, 0xa4, 0x39, 0xcf, 0x3b, 0xa0, 0xa2, 0x5a, 0x7f, 0xdc,
+	0x6e, 0x63, 0x4f, 0x92, 0x1e, 0xd6, 0xdf, 0xc5, 0x3e, 0x67, 0x6d, 0x12, 0x64, 0x00, 0xff, 0xcd,
+	0x82, 0xcd, 0x89, 0x65, 0x1a, 0xe7, 0x01, 0x78, 0x8d, 0x12, 0xe6, 0x0a, 0x1c, 0xb6, 0x5d, 0x1f,
+	0x87, 0x38, 0x50, 0x5f, 0xb5, 0x62, 0x5b, 0xa8, 0xaf, 0x25, 0xc6, 0x7f, 0x3d, 0x5f, 0x5f, 0x4e,
+	0xe7, 0x47, 0xf8, 0x1d, 0x9b, 0x70, 0x87, 0x22, 0x79, 0x68, 0xdf, 0x63, 0xb2, 0x79, 0x83, 0x12,
+	0x76, 0x80, 0xc3, 0x76, 0x63, 0xa0, 0x83, 0x28, 0x6d, 0xe7, 0x0d, 0xfe, 0x36, 0x37, 0x42, 0x12,
+	0x9b, 0x57, 0x54, 0xbb, 0x5d, 0xdd, 0xee, 0xe6, 0x78, 0xbb, 0x4f, 0x70, 0x80, 0xbc, 0xe3, 0x06,
+	0xf6, 0x7e, 0xff, 0xb5, 0x06, 0xd2, 0xb4, 0xdd, 0xc0, 0x9e, 0xb2, 0x18, 0xbe, 0x83, 0x26, 0x92,
+	0x18, 0x6e, 0x81, 0xeb, 0x14, 0xf5, 0xdd, 0x1e, 0x0a, 0x89, 0x8f, 0x24, 0x8f, 0x84, 0x79, 0x75,
+	0xc3, 0xa8, 0x2e, 0x36, 0x17, 0x29, 0xea, 0x7f, 0x3e, 0x08, 0xc2, 0x35, 0x00, 0x5a, 0x9c, 0xf9,
+	0xae, 0x8f, 0x19, 0xa7, 0xe6, 0xb5, 0x04, 0xa0, 0xb9, 0x90, 0x44, 0x1a, 0x49, 0x00, 0xde, 0x07,
+	0xd7, 0x63, 0x96, 0x1c, 0x09, 0x0b, 0x5c, 0x49, 0x28, 0x36, 0x67, 0xd5, 0xeb, 0x58, 0xb5, 0xd3,
+	0x49, 0xb7, 0xb3, 0x49, 0xb7, 0x1b, 0x7a, 0xd2, 0xeb, 0xf3, 0x09, 0xfe, 0x8f, 0x7f, 0xaf, 0x1b,
+	0xcd, 0xc5, 0x81, 0xf4, 0x33, 0x42, 0x31, 0xdc, 0x01, 0x4b, 0x82, 0x04, 0x0c, 0xfb, 0x6e, 0x2b,
+	0xe4, 0x5e, 0x47, 0xb8, 0x47, 0x84, 0xf9, 0xfc, 0xc8, 0x9c, 0xdb, 0x30, 0xaa, 0x57, 0x9b, 0x30,
+	0xcd, 0xd5, 0x55, 0xea, 0x91, 0xca, 0xc0, 0x6d, 0x70, 0x23, 0x79, 0x86, 0xe4, 0xb1, 0x06, 0x2a,
+	0xf3, 0x25, 0x55, 0xfe, 0x0a, 0x45, 0xfd, 0x07, 0x2a, 0x9e, 0x2a, 0xe0, 0x63, 0x50, 0xf2, 0xf9,
+	0x11, 0x4b, 0x18, 0xdd, 0xaf, 0x10, 0x09, 0xdd, 0x6c, 0xf5, 0x98, 0xf3, 0xd3, 0x13, 0x2f, 0x65,
+	0x2d, 0xee, 0x23, 0x12, 0x66, 0x79, 0x48, 0xc0, 0x8a, 0x08, 0x91, 0x38, 0x74, 0xdb, 0x11, 0xf2,
+	0x92, 0x88, 0x9b, 0x95, 0x99, 0x0b, 0x97, 0x7d, 0x63, 0xcb, 0xaa, 0xe3, 0x5d, 0xdd, 0xb0, 0xa1,
+	0xfb, 0x41, 0x06, 0xac, 0x31, 0xab, 0xb8, 0x15, 0x62, 0x37, 0xf9, 0x77, 0x4c, 0x70, 0x59, 0xb7,
+	0x95, 0x9c, 0x5b, 0xd2, 0xf2, 0x80, 0x04, 0x6c, 0xef, 0x9b, 0x79, 0x30, 0xab, 0xbe, 0x7f, 0xf8,
+	0xb3, 0x01, 0x16, 0x47, 0x56, 0x27, 0xdc, 0x2d, 0x1a, 0xb9, 0xc2, 0x15, 0x6e, 0xed, 0x5d, 0x44,
+	0x92, 0x8e, 0x56, 0xa5, 0xf6, 0xed, 0x1f, 0xff, 0x7e, 0x7f, 0xe5, 0x75, 0xb8, 0xe5, 0x14, 0x5c,
+	0x58, 0x22, 0x95, 0xa5, 0x01, 0xf8, 0x93, 0x01, 0xc0, 0x70, 0xd1, 0x42, 0x7b, 0xa2, 0xe3, 0xd8,
+	0x9e, 0xb7, 0x9c, 0xa9, 0xeb, 0x35, 0xde, 0xb6, 0xc2, 0xbb, 0x03, 0x2b, 0x45, 0x78, 0x2d, 0xc4,
+	0x3a, 0x67, 0xd8, 0x86, 0xdb, 0xf8, 0x05, 0x6c, 0x63, 0x97, 0x81, 0xe5, 0x4c, 0x5d, 0x3f, 0x2d,
+	0xdb, 0x11, 0x12, 0xfa, 0x04, 0x7f, 0x31, 0xc0, 0xab, 0xf9, 0x35, 0x0d, 0xdf, 0x9a, 0xe8, 0x58,
+	0x70, 0x6f, 0x58, 0x6f, 0x5f, 0x50, 0xa5, 0x69, 0x77, 0x14, 0xed, 0x36, 0xac, 0x16, 0xd1, 0x0e,
+	0xd7, 0xa1, 0x66, 0xfe, 0xc1, 0x00, 0x0b, 0x83, 0xfd, 0x0e, 0x6b, 0x13, 0x6d, 0xf3, 0x77, 0x8b,
+	0x65, 0x4f, 0x5b, 0xae, 0xf1, 0xde, 0x50, 0x78, 0x9b, 0xf0, 0x76, 0x11, 0x1e, 0x69, 0x79, 0x9a,
+	0xeb, 0x37, 0x03, 0x94, 0xce, 0xbf, 0x30, 0xe0, 0xfb, 0x13, 0x5d, 0x27, 0x5e, 0x46, 0xd6, 0x07,
+	0x97, 0xd2, 0x6a, 0xfc, 0xf7, 0x14, 0xfe, 0x1e, 0xdc, 0x29, 0xc2, 0xc7, 0x99, 0xbe, 0xa6, 0x07,
+	0xaa, 0xe6, 0xa9, 0x0e, 0xf5, 0x87, 0x4f, 0x4f, 0xca, 0xc6, 0xb3, 0x93, 0xb2, 0xf1, 0xcf, 0x49,
+	0xd9, 0xf8, 0xee, 0xb4, 0x3c, 0xf3, 0xec, 0xb4, 0x3c, 0xf3, 0xe7, 0x69, 0x79, 0xe6, 0x8b, 0x77,
+	0x02, 0x22, 0x0f, 0xe3, 0x96, 0xed, 0x71, 0xea, 0x48, 0xde, 0xc1, 0x8c, 0x7c, 0x8d, 0x6b, 0x7d,
+	0x47, 0xf6, 0x6b, 0xde, 0x21, 0x22, 0xcc, 0xe9, 0xbd, 0xeb, 0xf4, 0x47, 0x7d, 0xe4, 0x71, 0x17,
+	0x8b, 0xd6, 0x9c, 0xda, 0xb1, 0x6f, 0xfe, 0x3f, 0x00, 0xff, 0x19, 0xf4, 0xaa, 0xbf, 0x0a, 0x00,
 	0x00,
 }
 
@@ -158,6 +682,17 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// StakingParams queries the staking parameters of the module.
 	StakingParams(ctx context.Context, in *QueryStakingParamsRequest, opts ...grpc.CallOption) (*QueryStakingParamsResponse, error)
+	// BankParams queries the bank parameters of the module.
+	BankParams(ctx context.Context, in *QueryBankParamsRequest, opts ...grpc.CallOption) (*QueryBankParamsResponse, error)
+	// WasmParams queries the wasm parameters of the module.
+	WasmParams(ctx context.Context, in *QueryWasmParamsRequest, opts ...grpc.CallOption) (*QueryWasmParamsResponse, error)
+	// CommissionParams queries the validator commission parameters of the module.
+	CommissionParams(ctx context.Context, in *QueryCommissionParamsRequest, opts ...grpc.CallOption) (*QueryCommissionParamsResponse, error)
+	// IBCParams queries the IBC parameters of the module.
+	IBCParams(ctx context.Context, in *QueryIBCParamsRequest, opts ...grpc.CallOption) (*QueryIBCParamsResponse, error)
+	// EffectiveStakingConfig queries the staking configuration combined from the staking, slashing and x/customparams
+	// parameters.
+	EffectiveStakingConfig(ctx context.Context, in *QueryEffectiveStakingConfigRequest, opts ...grpc.CallOption) (*QueryEffectiveStakingConfigResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +712,66 @@ func (c *queryClient) StakingParams(ctx context.Context, in *QueryStakingParamsR
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
+func (c *queryClient) BankParams(ctx context.Context, in *QueryBankParamsRequest, opts ...grpc.CallOption) (*QueryBankParamsResponse, error) {
+	out := new(QueryBankParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Query/BankParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WasmParams(ctx context.Context, in *QueryWasmParamsRequest, opts ...grpc.CallOption) (*QueryWasmParamsResponse, error) {
+	out := new(QueryWasmParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Query/WasmParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommissionParams(ctx context.Context, in *QueryCommissionParamsRequest, opts ...grpc.CallOption) (*QueryCommissionParamsResponse, error) {
+	out := new(QueryCommissionParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Query/CommissionParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IBCParams(ctx context.Context, in *QueryIBCParamsRequest, opts ...grpc.CallOption) (*QueryIBCParamsResponse, error) {
+	out := new(QueryIBCParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Query/IBCParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveStakingConfig(ctx context.Context, in *QueryEffectiveStakingConfigRequest, opts ...grpc.CallOption) (*QueryEffectiveStakingConfigResponse, error) {
+	out := new(QueryEffectiveStakingConfigResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Query/EffectiveStakingConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
 	// StakingParams queries the staking parameters of the module.
 	StakingParams(context.Context, *QueryStakingParamsRequest) (*QueryStakingParamsResponse, error)
+	// BankParams queries the bank parameters of the module.
+	BankParams(context.Context, *QueryBankParamsRequest) (*QueryBankParamsResponse, error)
+	// WasmParams queries the wasm parameters of the module.
+	WasmParams(context.Context, *QueryWasmParamsRequest) (*QueryWasmParamsResponse, error)
+	// CommissionParams queries the validator commission parameters of the module.
+	CommissionParams(context.Context, *QueryCommissionParamsRequest) (*QueryCommissionParamsResponse, error)
+	// IBCParams queries the IBC parameters of the module.
+	IBCParams(context.Context, *QueryIBCParamsRequest) (*QueryIBCParamsResponse, error)
+	// EffectiveStakingConfig queries the staking configuration combined from the staking, slashing and x/customparams
+	// parameters.
+	EffectiveStakingConfig(context.Context, *QueryEffectiveStakingConfigRequest) (*QueryEffectiveStakingConfigResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +781,21 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) StakingParams(ctx context.Context, req *QueryStakingParamsRequest) (*QueryStakingParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StakingParams not implemented")
 }
+func (*UnimplementedQueryServer) BankParams(ctx context.Context, req *QueryBankParamsRequest) (*QueryBankParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BankParams not implemented")
+}
+func (*UnimplementedQueryServer) WasmParams(ctx context.Context, req *QueryWasmParamsRequest) (*QueryWasmParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WasmParams not implemented")
+}
+func (*UnimplementedQueryServer) CommissionParams(ctx context.Context, req *QueryCommissionParamsRequest) (*QueryCommissionParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionParams not implemented")
+}
+func (*UnimplementedQueryServer) IBCParams(ctx context.Context, req *QueryIBCParamsRequest) (*QueryIBCParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCParams not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveStakingConfig(ctx context.Context, req *QueryEffectiveStakingConfigRequest) (*QueryEffectiveStakingConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveStakingConfig not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +819,96 @@ func _Query_StakingParams_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BankParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBankParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BankParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Query/BankParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BankParams(ctx, req.(*QueryBankParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WasmParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWasmParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WasmParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Query/WasmParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WasmParams(ctx, req.(*QueryWasmParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommissionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommissionParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommissionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Query/CommissionParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommissionParams(ctx, req.(*QueryCommissionParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Query/IBCParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCParams(ctx, req.(*QueryIBCParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveStakingConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveStakingConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveStakingConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Query/EffectiveStakingConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveStakingConfig(ctx, req.(*QueryEffectiveStakingConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.customparams.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +917,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StakingParams",
 			Handler:    _Query_StakingParams_Handler,
 		},
+		{
+			MethodName: "BankParams",
+			Handler:    _Query_BankParams_Handler,
+		},
+		{
+			MethodName: "WasmParams",
+			Handler:    _Query_WasmParams_Handler,
+		},
+		{
+			MethodName: "CommissionParams",
+			Handler:    _Query_CommissionParams_Handler,
+		},
+		{
+			MethodName: "IBCParams",
+			Handler:    _Query_IBCParams_Handler,
+		},
+		{
+			MethodName: "EffectiveStakingConfig",
+			Handler:    _Query_EffectiveStakingConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/customparams/v1/query.proto",
@@ -274,52 +990,1188 @@ func (m *QueryStakingParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryStakingParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBankParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBankParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBankParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBankParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBankParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBankParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWasmParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWasmParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWasmParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWasmParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWasmParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWasmParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveStakingConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveStakingConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveStakingConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveStakingConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveStakingConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveStakingConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFractionDoubleSign.Size()
+		i -= size
+		if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	if m.MaxMissedBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMissedBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinSelfDelegation.Size()
+		i -= size
+		if _, err := m.MinSelfDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStakingParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStakingParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBankParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBankParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWasmParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWasmParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommissionParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommissionParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIBCParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIBCParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEffectiveStakingConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEffectiveStakingConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovQuery(uint64(m.MaxValidators))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SignedBlocksWindow))
+	}
+	if m.MaxMissedBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MaxMissedBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStakingParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBankParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBankParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBankParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBankParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBankParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBankParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWasmParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWasmParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWasmParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWasmParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWasmParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWasmParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommissionParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommissionParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommissionParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommissionParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommissionParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommissionParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryStakingParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryStakingParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEffectiveStakingConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -342,10 +2194,10 @@ func (m *QueryStakingParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEffectiveStakingConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEffectiveStakingConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -369,7 +2221,7 @@ func (m *QueryStakingParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEffectiveStakingConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -392,15 +2244,134 @@ func (m *QueryStakingParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEffectiveStakingConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEffectiveStakingConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -427,7 +2398,146 @@ func (m *QueryStakingParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedBlocks", wireType)
+			}
+			m.MaxMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
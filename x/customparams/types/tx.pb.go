@@ -70,6 +70,162 @@ func (m *MsgUpdateStakingParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateStakingParams proto.InternalMessageInfo
 
+type MsgUpdateBankParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// bank_params holds the parameters related to the bank module.
+	BankParams BankParams `protobuf:"bytes,2,opt,name=bank_params,json=bankParams,proto3" json:"bank_params"`
+}
+
+func (m *MsgUpdateBankParams) Reset()         { *m = MsgUpdateBankParams{} }
+func (m *MsgUpdateBankParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBankParams) ProtoMessage()    {}
+func (*MsgUpdateBankParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f2c8294c3378c0, []int{1}
+}
+func (m *MsgUpdateBankParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBankParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBankParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBankParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBankParams.Merge(m, src)
+}
+func (m *MsgUpdateBankParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBankParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBankParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBankParams proto.InternalMessageInfo
+
+type MsgUpdateWasmParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// wasm_params holds the parameters related to the smart contracts execution.
+	WasmParams WasmParams `protobuf:"bytes,2,opt,name=wasm_params,json=wasmParams,proto3" json:"wasm_params"`
+}
+
+func (m *MsgUpdateWasmParams) Reset()         { *m = MsgUpdateWasmParams{} }
+func (m *MsgUpdateWasmParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWasmParams) ProtoMessage()    {}
+func (*MsgUpdateWasmParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f2c8294c3378c0, []int{2}
+}
+func (m *MsgUpdateWasmParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWasmParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWasmParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWasmParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWasmParams.Merge(m, src)
+}
+func (m *MsgUpdateWasmParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWasmParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWasmParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWasmParams proto.InternalMessageInfo
+
+type MsgUpdateCommissionParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// commission_params holds the parameters related to the validator commission.
+	CommissionParams CommissionParams `protobuf:"bytes,2,opt,name=commission_params,json=commissionParams,proto3" json:"commission_params"`
+}
+
+func (m *MsgUpdateCommissionParams) Reset()         { *m = MsgUpdateCommissionParams{} }
+func (m *MsgUpdateCommissionParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCommissionParams) ProtoMessage()    {}
+func (*MsgUpdateCommissionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f2c8294c3378c0, []int{3}
+}
+func (m *MsgUpdateCommissionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCommissionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCommissionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCommissionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCommissionParams.Merge(m, src)
+}
+func (m *MsgUpdateCommissionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCommissionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCommissionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCommissionParams proto.InternalMessageInfo
+
+type MsgUpdateIBCParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ibc_params holds the parameters related to the IBC transfers.
+	IBCParams IBCParams `protobuf:"bytes,2,opt,name=ibc_params,json=ibcParams,proto3" json:"ibc_params"`
+}
+
+func (m *MsgUpdateIBCParams) Reset()         { *m = MsgUpdateIBCParams{} }
+func (m *MsgUpdateIBCParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateIBCParams) ProtoMessage()    {}
+func (*MsgUpdateIBCParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9f2c8294c3378c0, []int{4}
+}
+func (m *MsgUpdateIBCParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateIBCParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateIBCParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateIBCParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateIBCParams.Merge(m, src)
+}
+func (m *MsgUpdateIBCParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateIBCParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateIBCParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateIBCParams proto.InternalMessageInfo
+
 type EmptyResponse struct {
 }
 
@@ -77,7 +233,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9f2c8294c3378c0, []int{1}
+	return fileDescriptor_c9f2c8294c3378c0, []int{5}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,37 +264,54 @@ var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*MsgUpdateStakingParams)(nil), "coreum.customparams.v1.MsgUpdateStakingParams")
+	proto.RegisterType((*MsgUpdateBankParams)(nil), "coreum.customparams.v1.MsgUpdateBankParams")
+	proto.RegisterType((*MsgUpdateWasmParams)(nil), "coreum.customparams.v1.MsgUpdateWasmParams")
+	proto.RegisterType((*MsgUpdateCommissionParams)(nil), "coreum.customparams.v1.MsgUpdateCommissionParams")
+	proto.RegisterType((*MsgUpdateIBCParams)(nil), "coreum.customparams.v1.MsgUpdateIBCParams")
 	proto.RegisterType((*EmptyResponse)(nil), "coreum.customparams.v1.EmptyResponse")
 }
 
 func init() { proto.RegisterFile("coreum/customparams/v1/tx.proto", fileDescriptor_c9f2c8294c3378c0) }
 
 var fileDescriptor_c9f2c8294c3378c0 = []byte{
-	// 380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0x4a,
-	0x2d, 0xcd, 0xd5, 0x4f, 0x2e, 0x2d, 0x2e, 0xc9, 0xcf, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x43, 0x56, 0xa0, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21,
-	0x4a, 0xa5, 0x94, 0x71, 0x98, 0x05, 0xd5, 0x04, 0x51, 0x24, 0x9e, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f,
-	0xac, 0x9f, 0x5b, 0x9c, 0x0e, 0x92, 0xcb, 0x2d, 0x4e, 0x87, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0xc1,
-	0x3c, 0x7d, 0x08, 0x07, 0x2a, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11, 0x07, 0xb1, 0x20, 0xa2,
-	0x4a, 0x77, 0x18, 0xb9, 0xc4, 0x7c, 0x8b, 0xd3, 0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x83, 0x4b,
-	0x12, 0xb3, 0x33, 0xf3, 0xd2, 0x03, 0xc0, 0x56, 0x09, 0x99, 0x71, 0x71, 0x26, 0x96, 0x96, 0x64,
-	0xe4, 0x17, 0x65, 0x96, 0x54, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2,
-	0x2b, 0x02, 0x35, 0xd5, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f,
-	0x3d, 0x08, 0xa1, 0x54, 0x28, 0x88, 0x8b, 0xaf, 0x18, 0x62, 0x50, 0x3c, 0xc4, 0xd1, 0x12, 0x4c,
-	0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xaa, 0x7a, 0xd8, 0x43, 0x41, 0x0f, 0xc5, 0x5a, 0x27, 0x96, 0x13,
-	0xf7, 0xe4, 0x19, 0x82, 0x78, 0x8b, 0x91, 0x05, 0xad, 0xcc, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xd8,
-	0xd1, 0xf5, 0x7c, 0x83, 0x96, 0x32, 0x4a, 0x08, 0x61, 0xf7, 0x83, 0x12, 0x3f, 0x17, 0xaf, 0x6b,
-	0x6e, 0x41, 0x49, 0x65, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x51, 0x15, 0x17, 0xb3,
-	0x6f, 0x71, 0xba, 0x50, 0x0e, 0x97, 0x30, 0x36, 0x2f, 0xeb, 0xe1, 0x72, 0x22, 0x76, 0xe3, 0xa5,
-	0x70, 0x7a, 0x09, 0xc5, 0x52, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4, 0x18, 0x9d, 0x42, 0x4e, 0x3c,
-	0x94, 0x63, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x92, 0xfc, 0xec, 0xd4, 0xbc, 0xcc, 0xaa,
-	0x54, 0xdd, 0x0a, 0xfd, 0x92, 0x0a, 0xdd, 0xe4, 0x8c, 0xc4, 0xcc, 0x3c, 0xfd, 0x32, 0x73, 0xfd,
-	0x0a, 0xd4, 0x54, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x48, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf1, 0xed, 0xb1, 0x70, 0x85, 0x02, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xc1, 0x8e, 0xd2, 0x40,
+	0x18, 0xc7, 0x19, 0x5d, 0x4d, 0x98, 0xcd, 0xba, 0x4b, 0x77, 0x83, 0x2c, 0x87, 0x82, 0xdd, 0x68,
+	0x08, 0x86, 0x36, 0xb0, 0x09, 0x46, 0x6e, 0x76, 0xe3, 0x61, 0x0f, 0x9b, 0x18, 0xd0, 0x98, 0xe8,
+	0x61, 0x33, 0x2d, 0xb5, 0x4c, 0x70, 0x3a, 0x4d, 0x67, 0x60, 0xc1, 0x93, 0xf1, 0xe8, 0xc9, 0x87,
+	0xf0, 0x01, 0x38, 0xf8, 0x10, 0x5c, 0x4c, 0x36, 0x26, 0x26, 0x1e, 0xcc, 0x46, 0xe1, 0xc0, 0xc1,
+	0x97, 0x30, 0xd0, 0x6e, 0x4b, 0xa1, 0x0d, 0x6c, 0xb8, 0x10, 0xfa, 0x7d, 0xff, 0xf9, 0x7e, 0xfd,
+	0x35, 0x9d, 0x0e, 0xcc, 0xe9, 0xd4, 0x31, 0x3a, 0x44, 0xd1, 0x3b, 0x8c, 0x53, 0x62, 0x23, 0x07,
+	0x11, 0xa6, 0x74, 0xcb, 0x0a, 0xef, 0xc9, 0xb6, 0x43, 0x39, 0x15, 0xd2, 0x6e, 0x40, 0x9e, 0x0f,
+	0xc8, 0xdd, 0x72, 0x36, 0x85, 0x08, 0xb6, 0xa8, 0x32, 0xfb, 0x75, 0xa3, 0xd9, 0xa3, 0x98, 0x59,
+	0xde, 0x22, 0x37, 0x74, 0x5f, 0xa7, 0x8c, 0x50, 0xa6, 0x10, 0x66, 0x4e, 0x7b, 0x84, 0x99, 0x5e,
+	0xe3, 0xd0, 0x6d, 0x9c, 0xcf, 0xae, 0x14, 0xf7, 0xc2, 0x6b, 0x1d, 0x98, 0xd4, 0xa4, 0x6e, 0x7d,
+	0xfa, 0xcf, 0xad, 0x4a, 0xbf, 0x01, 0x4c, 0x9f, 0x31, 0xf3, 0x95, 0xdd, 0x44, 0xdc, 0x68, 0x70,
+	0xd4, 0xc6, 0x96, 0xf9, 0x62, 0x86, 0x12, 0xaa, 0x30, 0x89, 0x3a, 0xbc, 0x45, 0x1d, 0xcc, 0xfb,
+	0x19, 0x90, 0x07, 0x85, 0xa4, 0x9a, 0xf9, 0xf1, 0xad, 0x74, 0xe0, 0x4d, 0x7d, 0xd6, 0x6c, 0x3a,
+	0x06, 0x63, 0x0d, 0xee, 0x60, 0xcb, 0xac, 0x07, 0x51, 0xa1, 0x0e, 0xef, 0x31, 0x77, 0xd0, 0xb9,
+	0x7b, 0xd3, 0x99, 0x5b, 0x79, 0x50, 0xd8, 0xae, 0x3c, 0x94, 0xa3, 0x9f, 0x82, 0x1c, 0xc2, 0xaa,
+	0x5b, 0xc3, 0xab, 0x5c, 0xa2, 0xbe, 0xc3, 0xe6, 0x8b, 0xb5, 0xea, 0xa7, 0xc9, 0xa0, 0x18, 0x30,
+	0x3e, 0x4f, 0x06, 0xc5, 0xa3, 0xd0, 0x13, 0x8a, 0x76, 0x90, 0xbe, 0x03, 0xb8, 0xef, 0xb7, 0x54,
+	0x64, 0xb5, 0x37, 0x74, 0x3b, 0x85, 0xdb, 0x1a, 0xb2, 0xda, 0x61, 0x31, 0x29, 0x4e, 0x2c, 0x00,
+	0x7a, 0x56, 0x50, 0xf3, 0x2b, 0xb5, 0xe3, 0x65, 0xa5, 0x7c, 0xb4, 0x52, 0x30, 0x26, 0xec, 0xf3,
+	0x1a, 0x31, 0xb2, 0xb9, 0xcf, 0x05, 0x62, 0x64, 0x4d, 0x9f, 0x00, 0x78, 0xed, 0x73, 0xe1, 0x57,
+	0x6e, 0xe0, 0x13, 0x8c, 0x91, 0xfe, 0x01, 0x78, 0xe8, 0xd7, 0x4f, 0x28, 0x21, 0x98, 0x31, 0x4c,
+	0xad, 0x0d, 0xad, 0xde, 0xc2, 0x94, 0xee, 0xcf, 0x0a, 0xbb, 0x15, 0xe2, 0xdc, 0x16, 0xe1, 0x9e,
+	0xe1, 0x9e, 0xbe, 0x50, 0xaf, 0x3d, 0x5d, 0xf6, 0x7c, 0x14, 0xed, 0xb9, 0x38, 0x52, 0xfa, 0x09,
+	0xa0, 0xe0, 0x77, 0x4f, 0xd5, 0x93, 0x0d, 0x35, 0x1b, 0x10, 0x62, 0x4d, 0x0f, 0xfb, 0x3d, 0x88,
+	0xf3, 0xf3, 0x71, 0x6a, 0x6a, 0x2a, 0x36, 0xba, 0xca, 0x25, 0xfd, 0x52, 0x3d, 0x89, 0x35, 0xdd,
+	0xd3, 0xab, 0x2c, 0xeb, 0xe5, 0xa2, 0xf5, 0xfc, 0xe5, 0xd2, 0x2e, 0xdc, 0x79, 0x4e, 0x6c, 0xde,
+	0xaf, 0x1b, 0xcc, 0xa6, 0x16, 0x33, 0x2a, 0x5f, 0xb7, 0xe0, 0xed, 0x33, 0x66, 0x0a, 0xef, 0xe1,
+	0x7e, 0xd4, 0x97, 0x45, 0x8e, 0xbb, 0xc9, 0xe8, 0x5d, 0x9c, 0x8d, 0xfd, 0x72, 0x84, 0xa8, 0xc2,
+	0x3b, 0xb8, 0xb7, 0xb4, 0xd1, 0x1f, 0xaf, 0x44, 0x05, 0xe1, 0x1b, 0x73, 0xe6, 0x36, 0xe0, 0x6a,
+	0x4e, 0x10, 0x5e, 0x97, 0xe3, 0xc0, 0x74, 0xcc, 0xc6, 0x28, 0xaf, 0xa4, 0x2d, 0x2e, 0x59, 0x97,
+	0xd9, 0x84, 0xbb, 0x8b, 0xaf, 0x67, 0x71, 0x25, 0xcc, 0xcf, 0xae, 0x49, 0xc9, 0xde, 0xf9, 0x38,
+	0x19, 0x14, 0x81, 0xfa, 0x72, 0xf8, 0x57, 0x4c, 0x0c, 0x47, 0x22, 0xb8, 0x1c, 0x89, 0xe0, 0xcf,
+	0x48, 0x04, 0x5f, 0xc6, 0x62, 0xe2, 0x72, 0x2c, 0x26, 0x7e, 0x8d, 0xc5, 0xc4, 0x9b, 0xaa, 0x89,
+	0x79, 0xab, 0xa3, 0xc9, 0x3a, 0x25, 0x0a, 0xa7, 0x6d, 0xc3, 0xc2, 0x1f, 0x8c, 0x52, 0x4f, 0xe1,
+	0xbd, 0x92, 0xde, 0x42, 0xd8, 0x52, 0xba, 0x4f, 0x94, 0x5e, 0xf8, 0x98, 0xe4, 0x7d, 0xdb, 0x60,
+	0xda, 0xdd, 0xd9, 0xc9, 0x76, 0xfc, 0x7f, 0x00, 0x1f, 0xfb, 0x86, 0xc5, 0x96, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +329,18 @@ type MsgClient interface {
 	// UpdateStakingParams is a governance operation that sets the staking parameter.
 	// NOTE: all parameters must be provided.
 	UpdateStakingParams(ctx context.Context, in *MsgUpdateStakingParams, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateBankParams is a governance operation that sets the bank parameter.
+	// NOTE: all parameters must be provided.
+	UpdateBankParams(ctx context.Context, in *MsgUpdateBankParams, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateWasmParams is a governance operation that sets the wasm parameter.
+	// NOTE: all parameters must be provided.
+	UpdateWasmParams(ctx context.Context, in *MsgUpdateWasmParams, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateCommissionParams is a governance operation that sets the validator commission parameter.
+	// NOTE: all parameters must be provided.
+	UpdateCommissionParams(ctx context.Context, in *MsgUpdateCommissionParams, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateIBCParams is a governance operation that sets the IBC parameter.
+	// NOTE: all parameters must be provided.
+	UpdateIBCParams(ctx context.Context, in *MsgUpdateIBCParams, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type msgClient struct {
@@ -175,11 +360,59 @@ func (c *msgClient) UpdateStakingParams(ctx context.Context, in *MsgUpdateStakin
 	return out, nil
 }
 
+func (c *msgClient) UpdateBankParams(ctx context.Context, in *MsgUpdateBankParams, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Msg/UpdateBankParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWasmParams(ctx context.Context, in *MsgUpdateWasmParams, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Msg/UpdateWasmParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCommissionParams(ctx context.Context, in *MsgUpdateCommissionParams, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Msg/UpdateCommissionParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateIBCParams(ctx context.Context, in *MsgUpdateIBCParams, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Msg/UpdateIBCParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateStakingParams is a governance operation that sets the staking parameter.
 	// NOTE: all parameters must be provided.
 	UpdateStakingParams(context.Context, *MsgUpdateStakingParams) (*EmptyResponse, error)
+	// UpdateBankParams is a governance operation that sets the bank parameter.
+	// NOTE: all parameters must be provided.
+	UpdateBankParams(context.Context, *MsgUpdateBankParams) (*EmptyResponse, error)
+	// UpdateWasmParams is a governance operation that sets the wasm parameter.
+	// NOTE: all parameters must be provided.
+	UpdateWasmParams(context.Context, *MsgUpdateWasmParams) (*EmptyResponse, error)
+	// UpdateCommissionParams is a governance operation that sets the validator commission parameter.
+	// NOTE: all parameters must be provided.
+	UpdateCommissionParams(context.Context, *MsgUpdateCommissionParams) (*EmptyResponse, error)
+	// UpdateIBCParams is a governance operation that sets the IBC parameter.
+	// NOTE: all parameters must be provided.
+	UpdateIBCParams(context.Context, *MsgUpdateIBCParams) (*EmptyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -189,6 +422,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateStakingParams(ctx context.Context, req *MsgUpdateStakingParams) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakingParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateBankParams(ctx context.Context, req *MsgUpdateBankParams) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWasmParams(ctx context.Context, req *MsgUpdateWasmParams) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWasmParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCommissionParams(ctx context.Context, req *MsgUpdateCommissionParams) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommissionParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateIBCParams(ctx context.Context, req *MsgUpdateIBCParams) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIBCParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -212,6 +457,78 @@ func _Msg_UpdateStakingParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateBankParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBankParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBankParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Msg/UpdateBankParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBankParams(ctx, req.(*MsgUpdateBankParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWasmParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWasmParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWasmParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Msg/UpdateWasmParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWasmParams(ctx, req.(*MsgUpdateWasmParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCommissionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCommissionParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCommissionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Msg/UpdateCommissionParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCommissionParams(ctx, req.(*MsgUpdateCommissionParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateIBCParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateIBCParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateIBCParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Msg/UpdateIBCParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateIBCParams(ctx, req.(*MsgUpdateIBCParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.customparams.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -220,6 +537,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStakingParams",
 			Handler:    _Msg_UpdateStakingParams_Handler,
 		},
+		{
+			MethodName: "UpdateBankParams",
+			Handler:    _Msg_UpdateBankParams_Handler,
+		},
+		{
+			MethodName: "UpdateWasmParams",
+			Handler:    _Msg_UpdateWasmParams_Handler,
+		},
+		{
+			MethodName: "UpdateCommissionParams",
+			Handler:    _Msg_UpdateCommissionParams_Handler,
+		},
+		{
+			MethodName: "UpdateIBCParams",
+			Handler:    _Msg_UpdateIBCParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/customparams/v1/tx.proto",
@@ -265,7 +598,7 @@ func (m *MsgUpdateStakingParams) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateBankParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -275,61 +608,281 @@ func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateBankParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateBankParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BankParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateWasmParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateStakingParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.StakingParams.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *MsgUpdateWasmParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmptyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateWasmParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgUpdateStakingParams) Unmarshal(dAtA []byte) error {
+	{
+		size, err := m.WasmParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommissionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommissionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommissionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CommissionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIBCParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIBCParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIBCParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.IBCParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateStakingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StakingParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateBankParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BankParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateWasmParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WasmParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCommissionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CommissionParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateIBCParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.IBCParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateStakingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -444,6 +997,466 @@ func (m *MsgUpdateStakingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateBankParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBankParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBankParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BankParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWasmParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWasmParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWasmParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WasmParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCommissionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCommissionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCommissionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIBCParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIBCParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIBCParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IBCParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -36,11 +36,19 @@ import (
 	"github.com/samber/lo"
 
 	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	assetnftmarkettypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/market/types"
 	assetnfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/nft/types"
+	auctiontypes "github.com/tokenize-x/tx-chain/v7/x/auction/types"
+	autocompoundtypes "github.com/tokenize-x/tx-chain/v7/x/autocompound/types"
+	bridgetypes "github.com/tokenize-x/tx-chain/v7/x/bridge/types"
 	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 	dextypes "github.com/tokenize-x/tx-chain/v7/x/dex/types"
 	feemodeltypes "github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+	feereferraltypes "github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+	labeltypes "github.com/tokenize-x/tx-chain/v7/x/label/types"
+	metatxtypes "github.com/tokenize-x/tx-chain/v7/x/metatx/types"
 	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+	treasurytypes "github.com/tokenize-x/tx-chain/v7/x/treasury/types"
 )
 
 // These constants define gas for messages which have custom calculation logic.
@@ -50,9 +58,12 @@ const (
 	NFTIssueClassBaseGas             = 16_000
 	NFTMintBaseGas                   = 39_000
 	NFTUpdateBaseGas                 = 40_000
+	NFTRegisterDataSchemaBaseGas     = 15_000
 	GrantBaseGas                     = 25000
 	DEXUpdateWhitelistedDenomBaseGas = 10_000
 	DEXWhitelistedPerDenomGas        = 10_000
+	FTMintBaseGas                    = 31_000
+	FTMintPerRecipientGas            = 25_000
 )
 
 type (
@@ -85,7 +96,7 @@ func DefaultConfig() Config {
 	cfg.gasByMsg = map[MsgURL]gasByMsgFunc{
 		// asset/ft
 		MsgToMsgURL(&assetfttypes.MsgIssue{}):                     constantGasFunc(70_000),
-		MsgToMsgURL(&assetfttypes.MsgMint{}):                      constantGasFunc(31_000),
+		MsgToMsgURL(&assetfttypes.MsgMint{}):                      assetFTMintGasFunc(FTMintBaseGas, FTMintPerRecipientGas),
 		MsgToMsgURL(&assetfttypes.MsgBurn{}):                      constantGasFunc(35_000),
 		MsgToMsgURL(&assetfttypes.MsgFreeze{}):                    constantGasFunc(8_500),
 		MsgToMsgURL(&assetfttypes.MsgUnfreeze{}):                  constantGasFunc(8_500),
@@ -100,12 +111,38 @@ func DefaultConfig() Config {
 		MsgToMsgURL(&assetfttypes.MsgUpdateDEXWhitelistedDenoms{}): updateDEXWhitelistedDenomsGasFunc(
 			DEXUpdateWhitelistedDenomBaseGas, DEXWhitelistedPerDenomGas,
 		),
+		MsgToMsgURL(&assetfttypes.MsgClaimSymbol{}):             constantGasFunc(20_000),
+		MsgToMsgURL(&assetfttypes.MsgReserveSymbol{}):           constantGasFunc(20_000),
+		MsgToMsgURL(&assetfttypes.MsgGrantMintAllowance{}):      constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgRevokeMintAllowance{}):     constantGasFunc(8_500),
+		MsgToMsgURL(&assetfttypes.MsgSetDustPolicy{}):           constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgSetDustOptOut{}):           constantGasFunc(8_500),
+		MsgToMsgURL(&assetfttypes.MsgBurnFrom{}):                constantGasFunc(40_000),
+		MsgToMsgURL(&assetfttypes.MsgIssueEscrowed{}):           constantGasFunc(90_000),
+		MsgToMsgURL(&assetfttypes.MsgSettleEscrow{}):            constantGasFunc(30_000),
+		MsgToMsgURL(&assetfttypes.MsgSetSendRateLimit{}):        constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgUpdateFeatures{}):          constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgPlaceLegalHold{}):          constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgApproveLegalHoldRelease{}): constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgSetObserverContract{}):     constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgSetFreezeExemption{}):      constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgRemoveFreezeExemption{}):   constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgAssignRole{}):              constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgRevokeRole{}):              constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgScheduleRateChange{}):      constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgStartRedenomination{}):     constantGasFunc(50_000),
+		MsgToMsgURL(&assetfttypes.MsgRedenominate{}):            constantGasFunc(30_000),
+		MsgToMsgURL(&assetfttypes.MsgSetIssuerRecovery{}):       constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgCancelIssuerRecovery{}):    constantGasFunc(8_500),
 
 		// asset/nft
 		MsgToMsgURL(&assetnfttypes.MsgBurn{}):                     constantGasFunc(26_000),
+		MsgToMsgURL(&assetnfttypes.MsgBurnExpired{}):              constantGasFunc(28_000),
+		MsgToMsgURL(&assetnfttypes.MsgExtendExpiration{}):         constantGasFunc(8_000),
 		MsgToMsgURL(&assetnfttypes.MsgIssueClass{}):               dataGasFunc(NFTIssueClassBaseGas),
 		MsgToMsgURL(&assetnfttypes.MsgMint{}):                     dataGasFunc(NFTMintBaseGas),
 		MsgToMsgURL(&assetnfttypes.MsgUpdateData{}):               dataGasFunc(NFTUpdateBaseGas),
+		MsgToMsgURL(&assetnfttypes.MsgRegisterDataSchema{}):       dataGasFunc(NFTRegisterDataSchemaBaseGas),
 		MsgToMsgURL(&assetnfttypes.MsgFreeze{}):                   constantGasFunc(8_000),
 		MsgToMsgURL(&assetnfttypes.MsgUnfreeze{}):                 constantGasFunc(5_000),
 		MsgToMsgURL(&assetnfttypes.MsgClassFreeze{}):              constantGasFunc(8_000),
@@ -115,9 +152,24 @@ func DefaultConfig() Config {
 		MsgToMsgURL(&assetnfttypes.MsgAddToClassWhitelist{}):      constantGasFunc(7_000),
 		MsgToMsgURL(&assetnfttypes.MsgRemoveFromClassWhitelist{}): constantGasFunc(3_500),
 
+		// asset/nft/market
+		MsgToMsgURL(&assetnftmarkettypes.MsgListNFT{}):       constantGasFunc(15_000),
+		MsgToMsgURL(&assetnftmarkettypes.MsgCancelListing{}): constantGasFunc(8_000),
+		MsgToMsgURL(&assetnftmarkettypes.MsgPlaceBid{}):      constantGasFunc(55_000),
+		MsgToMsgURL(&assetnftmarkettypes.MsgCancelBid{}):     constantGasFunc(25_000),
+		MsgToMsgURL(&assetnftmarkettypes.MsgAcceptBid{}):     constantGasFunc(60_000),
+
 		// dex
 		MsgToMsgURL(&dextypes.MsgCancelOrder{}): constantGasFunc(35_000),
 
+		// auction
+		MsgToMsgURL(&auctiontypes.MsgCommitBid{}):  constantGasFunc(10_000),
+		MsgToMsgURL(&auctiontypes.MsgAuctionBid{}): constantGasFunc(50_000),
+
+		// autocompound
+		MsgToMsgURL(&autocompoundtypes.MsgSetAutoCompound{}):     constantGasFunc(8_000),
+		MsgToMsgURL(&autocompoundtypes.MsgDisableAutoCompound{}): constantGasFunc(8_000),
+
 		// authz
 		MsgToMsgURL(&authz.MsgGrant{}):  authzMsgGrantGasFunc(GrantBaseGas, storeConfig.WriteCostPerByte),
 		MsgToMsgURL(&authz.MsgRevoke{}): constantGasFunc(8_000),
@@ -126,6 +178,9 @@ func DefaultConfig() Config {
 		MsgToMsgURL(&banktypes.MsgSend{}):      bankSendMsgGasFunc(BankSendPerCoinGas),
 		MsgToMsgURL(&banktypes.MsgMultiSend{}): bankMultiSendMsgGasFunc(BankMultiSendPerOperationsGas),
 
+		// bridge
+		MsgToMsgURL(&bridgetypes.MsgBridgeOut{}): constantGasFunc(40_000),
+
 		// distribution
 		MsgToMsgURL(&distributiontypes.MsgFundCommunityPool{}):           constantGasFunc(17_000),
 		MsgToMsgURL(&distributiontypes.MsgSetWithdrawAddress{}):          constantGasFunc(5_000),
@@ -136,6 +191,10 @@ func DefaultConfig() Config {
 		// feegrant
 		MsgToMsgURL(&feegranttypes.MsgRevokeAllowance{}): constantGasFunc(2_500),
 
+		// feereferral
+		MsgToMsgURL(&feereferraltypes.MsgRegisterReferrer{}):   constantGasFunc(8_000),
+		MsgToMsgURL(&feereferraltypes.MsgUnregisterReferrer{}): constantGasFunc(6_000),
+
 		// gov
 		MsgToMsgURL(&govtypesv1beta1.MsgVote{}):         constantGasFunc(6_000),
 		MsgToMsgURL(&govtypesv1beta1.MsgVoteWeighted{}): constantGasFunc(9_000),
@@ -162,6 +221,12 @@ func DefaultConfig() Config {
 		// nft
 		MsgToMsgURL(&nfttypes.MsgSend{}): constantGasFunc(25_000),
 
+		// pse
+		MsgToMsgURL(&psetypes.MsgFundDistribution{}):          constantGasFunc(25_000),
+		MsgToMsgURL(&psetypes.MsgSetDistributionPreference{}): constantGasFunc(10_000),
+		MsgToMsgURL(&psetypes.MsgOptOutDistributions{}):       constantGasFunc(10_000),
+		MsgToMsgURL(&psetypes.MsgOptInDistributions{}):        constantGasFunc(10_000),
+
 		// slashing
 		// Unjail message is not used in any integration test because it's too much hassle. Instead, unjailing is estimated
 		// manually by following this procedure:
@@ -201,7 +266,14 @@ func DefaultConfig() Config {
 		&cfg,
 		[]sdk.Msg{
 			// asset/ft
-			&assetfttypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&assetfttypes.MsgUpdateParams{},        // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&assetfttypes.MsgResolveSymbolClaim{},  // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&assetfttypes.MsgSetIssuePreset{},      // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&assetfttypes.MsgRemoveIssuePreset{},   // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&assetfttypes.MsgSweepDust{},           // This is non-deterministic because the cost depends on the number of swept accounts and the destination
+			&assetfttypes.MsgFreezeRate{},          // This is non-deterministic because the cost depends on the number of frozen accounts
+			&assetfttypes.MsgBatchClawback{},       // This is non-deterministic because the cost depends on the number of entries
+			&assetfttypes.MsgClaimIssuerRecovery{}, // This is non-deterministic because the cost depends on the number of tokens of the issuer
 
 			// asset/nft
 			&assetnfttypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
@@ -209,6 +281,15 @@ func DefaultConfig() Config {
 			// feemodel
 			&feemodeltypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
 
+			// feereferral
+			&feereferraltypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
+
+			// auction
+			&auctiontypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
+
+			// autocompound
+			&autocompoundtypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
+
 			// auth
 			&authtypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
 
@@ -218,6 +299,12 @@ func DefaultConfig() Config {
 			// bank
 			&banktypes.MsgSetSendEnabled{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
 			&banktypes.MsgUpdateParams{},   // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&customparamstypes.MsgUpdateBankParams{},
+
+			// bridge
+			&bridgetypes.MsgUpdateParams{},      // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&bridgetypes.MsgUpdateDenomConfig{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&bridgetypes.MsgAttestInbound{},     // This is non-deterministic because the execution of the attested transfer depends on the operator set
 
 			// consensus
 			&consensustypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
@@ -239,6 +326,12 @@ func DefaultConfig() Config {
 			&psetypes.MsgUpdateClearingAccountMappings{},
 			&psetypes.MsgUpdateDistributionSchedule{},
 			&psetypes.MsgDisableDistributions{},
+			&psetypes.MsgUpdateMinDelegationAmount{},
+			&psetypes.MsgUpdateSlashingPenalty{},
+			&psetypes.MsgUpdateMinDelegationDuration{},
+			&psetypes.MsgSetNamedSchedule{},
+			&psetypes.MsgRemoveNamedSchedule{},
+			&psetypes.MsgReallocateClearingFunds{},
 
 			// distribution
 			&distributiontypes.MsgUpdateParams{},       // This is non-deterministic because all the gov proposals are non-deterministic anyway
@@ -279,6 +372,14 @@ func DefaultConfig() Config {
 			// ValidateBasic step.
 			&evidencetypes.MsgSubmitEvidence{},
 
+			// label
+			&labeltypes.MsgSetAddressLabels{},    // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&labeltypes.MsgRemoveAddressLabels{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
+
+			// metatx
+			&metatxtypes.MsgUpdateParams{},  // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&metatxtypes.MsgExecuteMetaTx{}, // This is non-deterministic because the gas depends on the executed messages
+
 			// mint
 			&minttypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
 
@@ -286,6 +387,8 @@ func DefaultConfig() Config {
 			&stakingtypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
 			&stakingtypes.MsgBeginRedelegate{},
 			&customparamstypes.MsgUpdateStakingParams{},
+			&customparamstypes.MsgUpdateCommissionParams{},
+			&customparamstypes.MsgUpdateIBCParams{},
 
 			// slashing
 			&slashingtypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
@@ -293,6 +396,11 @@ func DefaultConfig() Config {
 			// slashing
 			&slashingtypes.MsgUpdateParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
 
+			// treasury
+			&treasurytypes.MsgSpend{},        // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&treasurytypes.MsgCreateStream{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
+			&treasurytypes.MsgCancelStream{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
+
 			// upgrade
 			&upgradetypes.MsgCancelUpgrade{},   // This is non-deterministic because all the gov proposals are non-deterministic anyway
 			&upgradetypes.MsgSoftwareUpgrade{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
@@ -315,6 +423,7 @@ func DefaultConfig() Config {
 			&wasmtypes.MsgUpdateContractLabel{},
 			&wasmtypes.MsgRemoveCodeUploadParamsAddresses{},
 			&wasmtypes.MsgAddCodeUploadParamsAddresses{},
+			&customparamstypes.MsgUpdateWasmParams{}, // This is non-deterministic because all the gov proposals are non-deterministic anyway
 
 			// ibc/lightclients
 			&ibclightclienttypes.MsgStoreCode{},
@@ -445,6 +554,8 @@ func dataGasFunc(constGas uint64) gasByMsgFunc {
 			dataLen = lo.Reduce(m.Items, func(agg int, item assetnfttypes.DataDynamicIndexedItem, _ int) int {
 				return agg + len(item.Data)
 			}, 0)
+		case *assetnfttypes.MsgRegisterDataSchema:
+			dataLen = len(m.Schema)
 		default:
 			return 0, false
 		}
@@ -516,6 +627,18 @@ func updateDEXWhitelistedDenomsGasFunc(
 	}
 }
 
+func assetFTMintGasFunc(ftMintBaseGas, ftMintPerRecipientGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*assetfttypes.MsgMint)
+		if !ok {
+			return 0, false
+		}
+
+		// The base gas covers minting to the single recipient.
+		return ftMintBaseGas + ftMintPerRecipientGas*uint64(lo.Max([]int{len(m.Recipients) - 1, 0})), true
+	}
+}
+
 func reportUnknownMessageMetric(msgURL MsgURL) {
 	metrics.IncrCounterWithLabels([]string{"deterministic_gas_unknown_message"}, 1, []metrics.Label{
 		{Name: "msg_name", Value: string(msgURL)},
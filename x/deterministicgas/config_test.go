@@ -97,10 +97,10 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 	// To make sure we do not increase/decrease deterministic and extension types accidentally,
 	// we assert length to be equal to exact number, so each change requires
 	// explicit adjustment of tests.
-	assert.Equal(t, 94, nondeterministicMsgCount)
-	assert.Equal(t, 68, deterministicMsgCount)
+	assert.Equal(t, 124, nondeterministicMsgCount)
+	assert.Equal(t, 110, deterministicMsgCount)
 	assert.Equal(t, 12, extensionMsgCount)
-	assert.Equal(t, 150, nonExtensionMsgCount)
+	assert.Equal(t, 222, nonExtensionMsgCount)
 }
 
 func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
@@ -155,6 +155,20 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 			expectedGas:             6 * bankSendPerCoinGas,
 			expectedIsDeterministic: true,
 		},
+		{
+			name:                    "assetft.MsgMint: no recipients",
+			msg:                     &assetfttypes.MsgMint{},
+			expectedGas:             deterministicgas.FTMintBaseGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetft.MsgMint: 3 recipients",
+			msg: &assetfttypes.MsgMint{
+				Recipients: make([]assetfttypes.MintRecipient, 3),
+			},
+			expectedGas:             deterministicgas.FTMintBaseGas + 2*deterministicgas.FTMintPerRecipientGas,
+			expectedIsDeterministic: true,
+		},
 		{
 			name:                    "bank.MsgMultiSend 0 input & 0 output",
 			msg:                     &banktypes.MsgMultiSend{},
@@ -93,8 +93,11 @@ func main() {
 		GrantBaseGas                     uint64
 		NFTMsgIssueClassCost             uint64
 		NFTMsgMintCost                   uint64
+		NFTMsgRegisterDataSchemaCost     uint64
 		DEXUpdateWhitelistedDenomBaseGas uint64
 		DEXWhitelistedPerDenomGas        uint64
+		FTMintBaseGas                    uint64
+		FTMintPerRecipientGas            uint64
 
 		DetermMsgsSpecialCases []deterministicgas.MsgURL
 		DetermMsgs             []determMsg
@@ -118,8 +121,11 @@ func main() {
 		GrantBaseGas:                     deterministicgas.GrantBaseGas,
 		NFTMsgIssueClassCost:             deterministicgas.NFTIssueClassBaseGas,
 		NFTMsgMintCost:                   deterministicgas.NFTMintBaseGas,
+		NFTMsgRegisterDataSchemaCost:     deterministicgas.NFTRegisterDataSchemaBaseGas,
 		DEXWhitelistedPerDenomGas:        deterministicgas.DEXWhitelistedPerDenomGas,
 		DEXUpdateWhitelistedDenomBaseGas: deterministicgas.DEXUpdateWhitelistedDenomBaseGas,
+		FTMintBaseGas:                    deterministicgas.FTMintBaseGas,
+		FTMintPerRecipientGas:            deterministicgas.FTMintPerRecipientGas,
 
 		DetermMsgsSpecialCases: determSpeicialCaseMsgURLs,
 		DetermMsgs:             determMsgs,
@@ -247,7 +247,8 @@ func hasExtensionCall(ctx sdk.Context, msg sdk.Msg, assetFTKeeper AssetFTKeeper)
 		} else if err != nil {
 			return false, err
 		}
-		if def.IsFeatureEnabled(assetfttypes.Feature_extension) {
+		// the gas used by the observer contract is charged to the transfer too
+		if def.IsFeatureEnabled(assetfttypes.Feature_extension) || def.ObserverCWAddress != "" {
 			return true, nil
 		}
 	}
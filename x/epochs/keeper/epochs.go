@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+)
+
+// GetEpoch returns the state of the epoch.
+func (k Keeper) GetEpoch(ctx context.Context, identifier string) (types.EpochInfo, error) {
+	epoch, err := k.Epochs.Get(ctx, identifier)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EpochInfo{}, errorsmod.Wrapf(types.ErrNotFound, "epoch %s", identifier)
+	}
+	return epoch, err
+}
+
+// GetEpochs returns the state of all the epochs.
+func (k Keeper) GetEpochs(ctx context.Context) ([]types.EpochInfo, error) {
+	var epochs []types.EpochInfo
+	if err := k.Epochs.Walk(ctx, nil, func(_ string, epoch types.EpochInfo) (bool, error) {
+		epochs = append(epochs, epoch)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return epochs, nil
+}
+
+// AddEpoch adds the new epoch.
+func (k Keeper) AddEpoch(ctx context.Context, epoch types.EpochInfo) error {
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+	found, err := k.Epochs.Has(ctx, epoch.Identifier)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errorsmod.Wrapf(types.ErrInvalidInput, "epoch %s already exists", epoch.Identifier)
+	}
+
+	return k.Epochs.Set(ctx, epoch.Identifier, epoch)
+}
+
+// ProcessEpochs starts the epochs which haven't started yet and moves to the next epoch each epoch which end time is
+// reached. The epoch moves by one epoch per block at most, so the epochs missed while the chain was halted are caught
+// up in the next blocks. The hook errors are returned and halt the chain, so the hooks handle the failures of their
+// business logic themselves and return only the errors which can't be recovered from, e.g. the store errors.
+// Should be called from BeginBlock.
+func (k Keeper) ProcessEpochs(ctx context.Context) error {
+	epochs, err := k.GetEpochs(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	for _, epoch := range epochs {
+		if epoch.Started() {
+			if blockTime.Before(epoch.EndTime()) {
+				continue
+			}
+
+			if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventEpochEnd{
+				Identifier:  epoch.Identifier,
+				EpochNumber: epoch.CurrentEpoch,
+			}); err != nil {
+				return err
+			}
+			if err := k.hooks.AfterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch); err != nil {
+				return errorsmod.Wrapf(err, "after epoch end hook of %s failed", epoch.Identifier)
+			}
+			epoch.CurrentEpochStartTime = epoch.EndTime()
+		} else {
+			epoch.CurrentEpochStartTime = blockTime
+		}
+		epoch.CurrentEpoch++
+		epoch.CurrentEpochStartHeight = sdkCtx.BlockHeight()
+
+		if err := k.Epochs.Set(ctx, epoch.Identifier, epoch); err != nil {
+			return err
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventEpochStart{
+			Identifier:  epoch.Identifier,
+			EpochNumber: epoch.CurrentEpoch,
+			StartTime:   epoch.CurrentEpochStartTime,
+		}); err != nil {
+			return err
+		}
+		if err := k.hooks.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch); err != nil {
+			return errorsmod.Wrapf(err, "before epoch start hook of %s failed", epoch.Identifier)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/epochs/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+)
+
+type hookCall struct {
+	hook        string
+	identifier  string
+	epochNumber int64
+}
+
+type recordingHooks struct {
+	calls *[]hookCall
+	fail  bool
+}
+
+func (h recordingHooks) AfterEpochEnd(_ context.Context, epochIdentifier string, epochNumber int64) error {
+	*h.calls = append(*h.calls, hookCall{hook: "end", identifier: epochIdentifier, epochNumber: epochNumber})
+	if h.fail {
+		return errors.New("hook failed")
+	}
+	return nil
+}
+
+func (h recordingHooks) BeforeEpochStart(_ context.Context, epochIdentifier string, epochNumber int64) error {
+	*h.calls = append(*h.calls, hookCall{hook: "start", identifier: epochIdentifier, epochNumber: epochNumber})
+	if h.fail {
+		return errors.New("hook failed")
+	}
+	return nil
+}
+
+func (h recordingHooks) EpochIdentifiers() []string {
+	return nil
+}
+
+func TestKeeper_ProcessEpochs(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	ctx := testApp.NewContext(false).WithBlockTime(startTime).WithBlockHeight(10)
+
+	var calls []hookCall
+	epochsKeeper := keeper.NewKeeper(
+		runtime.NewKVStoreService(testApp.GetKey(types.StoreKey)),
+		testApp.AppCodec(),
+		testApp.Logger(),
+	)
+	epochsKeeper.SetHooks(recordingHooks{calls: &calls})
+
+	requireT.NoError(epochsKeeper.Epochs.Clear(ctx, nil))
+	requireT.NoError(epochsKeeper.AddEpoch(ctx, types.NewEpochInfo(types.MinuteEpochID, time.Minute)))
+	requireT.NoError(epochsKeeper.AddEpoch(ctx, types.NewEpochInfo(types.HourEpochID, time.Hour)))
+	requireT.ErrorIs(
+		epochsKeeper.AddEpoch(ctx, types.NewEpochInfo(types.HourEpochID, time.Hour)),
+		types.ErrInvalidInput,
+	)
+
+	// the epochs start in the first block
+	requireT.NoError(epochsKeeper.ProcessEpochs(ctx))
+	requireT.Equal([]hookCall{
+		{hook: "start", identifier: types.HourEpochID, epochNumber: 1},
+		{hook: "start", identifier: types.MinuteEpochID, epochNumber: 1},
+	}, calls)
+	epoch, err := epochsKeeper.GetEpoch(ctx, types.MinuteEpochID)
+	requireT.NoError(err)
+	requireT.Equal(types.EpochInfo{
+		Identifier:              types.MinuteEpochID,
+		Duration:                time.Minute,
+		CurrentEpoch:            1,
+		CurrentEpochStartTime:   startTime,
+		CurrentEpochStartHeight: 10,
+	}, epoch)
+
+	// nothing happens until the end of the epoch
+	calls = nil
+	ctx = ctx.WithBlockTime(startTime.Add(59 * time.Second)).WithBlockHeight(11)
+	requireT.NoError(epochsKeeper.ProcessEpochs(ctx))
+	requireT.Empty(calls)
+
+	// the missed epochs are caught up one per block, starting at the end of the previous ones
+	ctx = ctx.WithBlockTime(startTime.Add(3 * time.Minute)).WithBlockHeight(12)
+	requireT.NoError(epochsKeeper.ProcessEpochs(ctx))
+	ctx = ctx.WithBlockHeight(13)
+	requireT.NoError(epochsKeeper.ProcessEpochs(ctx))
+	requireT.Equal([]hookCall{
+		{hook: "end", identifier: types.MinuteEpochID, epochNumber: 1},
+		{hook: "start", identifier: types.MinuteEpochID, epochNumber: 2},
+		{hook: "end", identifier: types.MinuteEpochID, epochNumber: 2},
+		{hook: "start", identifier: types.MinuteEpochID, epochNumber: 3},
+	}, calls)
+	epoch, err = epochsKeeper.GetEpoch(ctx, types.MinuteEpochID)
+	requireT.NoError(err)
+	requireT.Equal(int64(3), epoch.CurrentEpoch)
+	requireT.Equal(startTime.Add(2*time.Minute), epoch.CurrentEpochStartTime)
+	requireT.Equal(int64(13), epoch.CurrentEpochStartHeight)
+
+	_, err = epochsKeeper.GetEpoch(ctx, types.DayEpochID)
+	requireT.ErrorIs(err, types.ErrNotFound)
+}
+
+func TestKeeper_ProcessEpochs_HookError(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	var calls []hookCall
+	epochsKeeper := keeper.NewKeeper(
+		runtime.NewKVStoreService(testApp.GetKey(types.StoreKey)),
+		testApp.AppCodec(),
+		testApp.Logger(),
+	)
+	epochsKeeper.SetHooks(recordingHooks{calls: &calls, fail: true})
+
+	requireT.NoError(epochsKeeper.Epochs.Clear(ctx, nil))
+	requireT.NoError(epochsKeeper.AddEpoch(ctx, types.NewEpochInfo(types.MinuteEpochID, time.Minute)))
+
+	// the hook error halts the chain instead of being swallowed
+	requireT.ErrorContains(epochsKeeper.ProcessEpochs(ctx), "hook failed")
+	requireT.Equal([]hookCall{{hook: "start", identifier: types.MinuteEpochID, epochNumber: 1}}, calls)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+)
+
+// InitGenesis initializes the epochs module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, epoch := range genState.Epochs {
+		if err := k.AddEpoch(ctx, epoch); err != nil {
+			return err
+		}
+	}
+
+	// The hooks are silently never called if their epochs are missing, so the genesis is rejected instead.
+	for _, identifier := range k.hooks.EpochIdentifiers() {
+		found, err := k.Epochs.Has(ctx, identifier)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidInput, "epoch %s required by the hooks is missing", identifier)
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the epochs module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	epochs, err := k.GetEpochs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Epochs: epochs,
+	}, nil
+}
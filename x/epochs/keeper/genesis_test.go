@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+)
+
+func TestGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	// the default epochs are initialized by the app genesis
+	exported, err := testApp.EpochsKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.ElementsMatch(types.DefaultEpochs(), exported.Epochs)
+
+	requireT.NoError(testApp.EpochsKeeper.Epochs.Clear(ctx, nil))
+	genState := types.GenesisState{
+		Epochs: []types.EpochInfo{
+			types.NewEpochInfo(types.MinuteEpochID, time.Minute),
+			types.NewEpochInfo(types.HourEpochID, time.Hour),
+			{
+				Identifier:              types.DayEpochID,
+				Duration:                24 * time.Hour,
+				CurrentEpoch:            5,
+				CurrentEpochStartTime:   time.Unix(1_700_000_000, 0).UTC(),
+				CurrentEpochStartHeight: 100,
+			},
+		},
+	}
+	requireT.NoError(genState.Validate())
+
+	requireT.NoError(testApp.EpochsKeeper.InitGenesis(ctx, genState))
+	exported, err = testApp.EpochsKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.ElementsMatch(genState.Epochs, exported.Epochs)
+
+	// the epochs required by the hooks must exist
+	requireT.NoError(testApp.EpochsKeeper.Epochs.Clear(ctx, nil))
+	requireT.ErrorIs(testApp.EpochsKeeper.InitGenesis(ctx, types.GenesisState{
+		Epochs: []types.EpochInfo{
+			types.NewEpochInfo(types.HourEpochID, time.Hour),
+			types.NewEpochInfo(types.DayEpochID, 24*time.Hour),
+		},
+	}), types.ErrInvalidInput)
+
+	// the duplicated epochs are rejected
+	genState.Epochs = append(genState.Epochs, types.NewEpochInfo(types.HourEpochID, 2*time.Hour))
+	requireT.ErrorIs(genState.Validate(), types.ErrInvalidInput)
+
+	// the epochs with non-positive duration are rejected
+	genState.Epochs = []types.EpochInfo{types.NewEpochInfo(types.WeekEpochID, 0)}
+	requireT.ErrorIs(genState.Validate(), types.ErrInvalidInput)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryService serves grpc requests for the module.
+type QueryService struct {
+	keeper Keeper
+}
+
+// NewQueryService creates query service.
+func NewQueryService(keeper Keeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Epochs returns the state of all the epochs.
+func (qs QueryService) Epochs(ctx context.Context, req *types.QueryEpochsRequest) (*types.QueryEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	epochs, err := qs.keeper.GetEpochs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochsResponse{
+		Epochs: epochs,
+	}, nil
+}
+
+// Epoch returns the state of the epoch.
+func (qs QueryService) Epoch(ctx context.Context, req *types.QueryEpochRequest) (*types.QueryEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	epoch, err := qs.keeper.GetEpoch(ctx, req.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEpochResponse{
+		Epoch: epoch,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdkstore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	logger       log.Logger
+
+	// codec
+	cdc codec.BinaryCodec
+
+	hooks types.MultiEpochHooks
+
+	// collections
+	Schema collections.Schema
+	Epochs collections.Map[string, types.EpochInfo]
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.BinaryCodec,
+	logger log.Logger,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService: storeService,
+		logger:       logger,
+		cdc:          cdc,
+
+		Epochs: collections.NewMap(
+			sb,
+			types.EpochsKey,
+			"epochs",
+			collections.StringKey,
+			codec.CollValue[types.EpochInfo](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// SetHooks sets the hooks called at the epoch boundaries. It must be called before the keeper is passed to the
+// module, and only once.
+func (k *Keeper) SetHooks(hooks ...types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epoch hooks twice")
+	}
+	k.hooks = types.NewMultiEpochHooks(hooks...)
+
+	return k
+}
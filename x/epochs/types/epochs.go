@@ -0,0 +1,63 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Identifiers of the default epochs.
+const (
+	MinuteEpochID = "minute"
+	HourEpochID   = "hour"
+	DayEpochID    = "day"
+	WeekEpochID   = "week"
+	MonthEpochID  = "month"
+)
+
+// DefaultEpochs returns the default epochs, none of them started yet. The month epoch is 30 days long.
+func DefaultEpochs() []EpochInfo {
+	return []EpochInfo{
+		NewEpochInfo(MinuteEpochID, time.Minute),
+		NewEpochInfo(HourEpochID, time.Hour),
+		NewEpochInfo(DayEpochID, 24*time.Hour),
+		NewEpochInfo(WeekEpochID, 7*24*time.Hour),
+		NewEpochInfo(MonthEpochID, 30*24*time.Hour),
+	}
+}
+
+// NewEpochInfo returns the epoch which starts in the first block processed by the module.
+func NewEpochInfo(identifier string, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+// Validate validates the epoch.
+func (e EpochInfo) Validate() error {
+	if e.Identifier == "" {
+		return errorsmod.Wrap(ErrInvalidInput, "epoch identifier must not be empty")
+	}
+	if e.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidInput, "duration of epoch %s must be positive", e.Identifier)
+	}
+	if e.CurrentEpoch < 0 {
+		return errorsmod.Wrapf(ErrInvalidInput, "current epoch of %s must not be negative", e.Identifier)
+	}
+	if e.CurrentEpochStartHeight < 0 {
+		return errorsmod.Wrapf(ErrInvalidInput, "current epoch start height of %s must not be negative", e.Identifier)
+	}
+
+	return nil
+}
+
+// Started returns true if the first epoch has already started.
+func (e EpochInfo) Started() bool {
+	return e.CurrentEpoch > 0
+}
+
+// EndTime returns the time the current epoch ends at.
+func (e EpochInfo) EndTime() time.Time {
+	return e.CurrentEpochStartTime.Add(e.Duration)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidInput is returned when input validation fails.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 2, "invalid input")
+
+	// ErrNotFound is returned when the epoch doesn't exist.
+	ErrNotFound = sdkerrors.Register(ModuleName, 3, "not found")
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Epochs: DefaultEpochs(),
+	}
+}
+
+// Validate validates genesis parameters.
+func (m GenesisState) Validate() error {
+	identifiers := make(map[string]struct{}, len(m.Epochs))
+	for _, epoch := range m.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if _, found := identifiers[epoch.Identifier]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate epoch %s", epoch.Identifier)
+		}
+		identifiers[epoch.Identifier] = struct{}{}
+	}
+
+	return nil
+}
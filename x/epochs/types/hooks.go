@@ -0,0 +1,54 @@
+package types
+
+import (
+	"context"
+)
+
+// EpochHooks is the interface implemented by the modules which execute the logic at the epoch boundaries.
+type EpochHooks interface {
+	// AfterEpochEnd is called when the epoch ends, before the next one starts.
+	AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error
+	// BeforeEpochStart is called when the epoch starts, including the first one.
+	BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error
+	// EpochIdentifiers returns the identifiers of the epochs the hooks handle. The epochs must exist in genesis.
+	EpochIdentifiers() []string
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// MultiEpochHooks combines the hooks of multiple modules, they are called in the order they are provided.
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks returns the hooks calling the provided ones.
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// AfterEpochEnd calls AfterEpochEnd of all the hooks.
+func (h MultiEpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	for _, hook := range h {
+		if err := hook.AfterEpochEnd(ctx, epochIdentifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeforeEpochStart calls BeforeEpochStart of all the hooks.
+func (h MultiEpochHooks) BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	for _, hook := range h {
+		if err := hook.BeforeEpochStart(ctx, epochIdentifier, epochNumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EpochIdentifiers returns the identifiers of the epochs handled by all the hooks.
+func (h MultiEpochHooks) EpochIdentifiers() []string {
+	var identifiers []string
+	for _, hook := range h {
+		identifiers = append(identifiers, hook.EpochIdentifiers()...)
+	}
+	return identifiers
+}
@@ -10,7 +10,10 @@ import (
 	"github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
 )
 
-const afterFlag = "after"
+const (
+	afterFlag          = "after"
+	forecastBlocksFlag = "forecast-blocks"
+)
 
 // GetQueryCmd returns the parent command for all x/feemodel CLI query commands. The
 // provided clientCtx should have, at a minimum, a verifier, Tendermint RPC client,
@@ -27,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetMinGasPriceCmd(),
 		GetRecommendedGasPriceCmd(),
+		GetGasUsageCmd(),
 	)
 
 	return cmd
@@ -115,3 +119,39 @@ func GetRecommendedGasPriceCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetGasUsageCmd returns command for getting gas utilization of the recent blocks and its forecast.
+func GetGasUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "gas-usage",
+		Short: fmt.Sprintf(
+			"Query for gas utilization of the recent blocks and its forecast for `%s` blocks in future",
+			forecastBlocksFlag,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			forecastBlocks, err := cmd.Flags().GetUint32(forecastBlocksFlag)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GasUsage(cmd.Context(), &types.QueryGasUsageRequest{
+				ForecastBlocks: forecastBlocks,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(forecastBlocksFlag, 5, "how many blocks in future to forecast gas utilization for.")
+
+	return cmd
+}
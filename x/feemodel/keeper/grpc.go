@@ -15,6 +15,7 @@ type QueryKeeper interface {
 	GetParams(ctx sdk.Context) (types.Params, error)
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
 	CalculateEdgeGasPriceAfterBlocks(ctx sdk.Context, after uint32) (sdk.DecCoin, sdk.DecCoin, error)
+	CalculateGasUsage(ctx sdk.Context, forecastBlocks uint32) (*types.QueryGasUsageResponse, error)
 }
 
 // QueryService serves grpc requests for fee model.
@@ -63,6 +64,17 @@ func (qs QueryService) RecommendedGasPrice(
 	}, nil
 }
 
+// GasUsage returns the gas utilization of the recent blocks and its short-horizon forecast.
+func (qs QueryService) GasUsage(
+	ctx context.Context, req *types.QueryGasUsageRequest,
+) (*types.QueryGasUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return qs.keeper.CalculateGasUsage(sdk.UnwrapSDKContext(ctx), req.ForecastBlocks)
+}
+
 // Params returns params of fee model.
 func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+)
+
+const (
+	// BlockGasWindow is the number of the recent blocks the gas usage is stored for.
+	BlockGasWindow = 100
+	// DefaultGasUsageForecastBlocks is the number of blocks the gas usage is forecast for if not provided.
+	DefaultGasUsageForecastBlocks = 5
+)
+
+// SetBlockGas stores the gas used by the block and removes the one of the block leaving the window of the recent
+// blocks.
+func (k Keeper) SetBlockGas(ctx sdk.Context, height, gas int64) error {
+	bz, err := sdkmath.NewInt(gas).Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	kvStore := k.storeService.OpenKVStore(ctx)
+	if err := kvStore.Set(blockGasKey(height), bz); err != nil {
+		return err
+	}
+	if height > BlockGasWindow {
+		return kvStore.Delete(blockGasKey(height - BlockGasWindow))
+	}
+	return nil
+}
+
+// GetRecentBlockGas returns the gas used by the recent blocks, from the oldest to the latest one.
+func (k Keeper) GetRecentBlockGas(ctx sdk.Context) ([]types.BlockGasUsage, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), blockGasKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	blocks := make([]types.BlockGasUsage, 0, BlockGasWindow)
+	for ; iterator.Valid(); iterator.Next() {
+		gas := sdkmath.NewInt(0)
+		if err := gas.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, types.BlockGasUsage{
+			Height:             int64(binary.BigEndian.Uint64(iterator.Key())),
+			Gas:                gas.Int64(),
+			UtilizationPercent: utilizationPercent(gas.Int64(), params.Model.MaxBlockGas),
+		})
+	}
+
+	return blocks, nil
+}
+
+// CalculateGasUsage returns the gas utilization of the recent blocks together with the forecast of the short EMA of
+// block gas and the min gas price for the next blocks, assuming the next blocks use the average gas of the recent
+// ones.
+func (k Keeper) CalculateGasUsage(ctx sdk.Context, forecastBlocks uint32) (*types.QueryGasUsageResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if forecastBlocks > params.Model.ShortEmaBlockLength {
+		return nil, sdkerrors.Wrapf(
+			cosmoserrors.ErrInvalidRequest,
+			"forecast blocks must be lower than or equal to %d",
+			params.Model.ShortEmaBlockLength,
+		)
+	}
+	if forecastBlocks == 0 {
+		forecastBlocks = min(DefaultGasUsageForecastBlocks, params.Model.ShortEmaBlockLength)
+	}
+
+	blocks, err := k.GetRecentBlockGas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	averageGas := int64(0)
+	if len(blocks) > 0 {
+		totalGas := sdkmath.ZeroInt()
+		for _, block := range blocks {
+			totalGas = totalGas.AddRaw(block.Gas)
+		}
+		averageGas = totalGas.QuoRaw(int64(len(blocks))).Int64()
+	}
+
+	model := types.NewModel(params.Model)
+	denom := k.GetMinGasPrice(ctx).Denom
+	shortEMA := k.GetShortEMAGas(ctx)
+	longEMA := k.GetLongEMAGas(ctx)
+
+	res := &types.QueryGasUsageResponse{
+		Blocks:                     blocks,
+		AverageUtilizationPercent:  utilizationPercent(averageGas, params.Model.MaxBlockGas),
+		ShortEmaUtilizationPercent: utilizationPercent(shortEMA, params.Model.MaxBlockGas),
+		LongEmaUtilizationPercent:  utilizationPercent(longEMA, params.Model.MaxBlockGas),
+		Congested:                  shortEMA > model.CalculateEscalationStartBlockGas(),
+		Forecasts:                  make([]types.GasUsageForecast, 0, forecastBlocks),
+	}
+	for blocksAhead := range forecastBlocks {
+		shortEMA = types.CalculateEMA(shortEMA, averageGas, params.Model.ShortEmaBlockLength)
+		longEMA = types.CalculateEMA(longEMA, averageGas, params.Model.LongEmaBlockLength)
+		res.Forecasts = append(res.Forecasts, types.GasUsageForecast{
+			BlocksAhead:                blocksAhead + 1,
+			ShortEmaUtilizationPercent: utilizationPercent(shortEMA, params.Model.MaxBlockGas),
+			MinGasPrice:                sdk.NewDecCoinFromDec(denom, model.CalculateNextGasPrice(shortEMA, longEMA)),
+		})
+	}
+
+	return res, nil
+}
+
+func utilizationPercent(gas, maxBlockGas int64) sdkmath.LegacyDec {
+	if maxBlockGas <= 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+	return sdkmath.LegacyNewDec(gas).MulInt64(100).QuoInt64(maxBlockGas)
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feemodel/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/feemodel/types"
+)
+
+func TestRecentBlockGas(t *testing.T) {
+	ctx, feeModelKeeper := setup()
+	params := types.DefaultParams()
+	params.Model.MaxBlockGas = 1_000
+	require.NoError(t, feeModelKeeper.SetParams(ctx, params))
+
+	for height := int64(1); height <= keeper.BlockGasWindow+10; height++ {
+		require.NoError(t, feeModelKeeper.SetBlockGas(ctx, height, height))
+	}
+
+	blocks, err := feeModelKeeper.GetRecentBlockGas(ctx)
+	require.NoError(t, err)
+	require.Len(t, blocks, keeper.BlockGasWindow)
+	assert.Equal(t, types.BlockGasUsage{
+		Height:             11,
+		Gas:                11,
+		UtilizationPercent: sdkmath.LegacyMustNewDecFromStr("1.1"),
+	}, blocks[0])
+	assert.Equal(t, types.BlockGasUsage{
+		Height:             keeper.BlockGasWindow + 10,
+		Gas:                keeper.BlockGasWindow + 10,
+		UtilizationPercent: sdkmath.LegacyMustNewDecFromStr("11"),
+	}, blocks[len(blocks)-1])
+}
+
+func TestCalculateGasUsage(t *testing.T) {
+	ctx, feeModelKeeper := setup()
+	params := types.DefaultParams()
+	params.Model.MaxBlockGas = 1_000
+	params.Model.ShortEmaBlockLength = 10
+	params.Model.LongEmaBlockLength = 100
+	require.NoError(t, feeModelKeeper.SetParams(ctx, params))
+	require.NoError(t, feeModelKeeper.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec("coin", params.Model.InitialGasPrice)))
+	model := types.NewModel(params.Model)
+
+	// no blocks recorded yet
+	res, err := feeModelKeeper.CalculateGasUsage(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, res.Blocks)
+	assert.False(t, res.Congested)
+	assert.Len(t, res.Forecasts, keeper.DefaultGasUsageForecastBlocks)
+
+	_, err = feeModelKeeper.CalculateGasUsage(ctx, params.Model.ShortEmaBlockLength+1)
+	require.ErrorIs(t, err, cosmoserrors.ErrInvalidRequest)
+
+	// the blocks are full, so the forecast short EMA and min gas price grow
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, feeModelKeeper.SetBlockGas(ctx, height, params.Model.MaxBlockGas))
+	}
+	require.NoError(t, feeModelKeeper.SetShortEMAGas(ctx, 900))
+	require.NoError(t, feeModelKeeper.SetLongEMAGas(ctx, 100))
+
+	res, err = feeModelKeeper.CalculateGasUsage(ctx, 3)
+	require.NoError(t, err)
+	assert.Len(t, res.Blocks, 3)
+	assert.Equal(t, "100.000000000000000000", res.AverageUtilizationPercent.String())
+	assert.Equal(t, "90.000000000000000000", res.ShortEmaUtilizationPercent.String())
+	assert.Equal(t, "10.000000000000000000", res.LongEmaUtilizationPercent.String())
+	assert.True(t, res.Congested)
+	require.Len(t, res.Forecasts, 3)
+
+	previousMinGasPrice := model.CalculateNextGasPrice(900, 100)
+	for i, forecast := range res.Forecasts {
+		assert.EqualValues(t, i+1, forecast.BlocksAhead)
+		assert.Equal(t, "coin", forecast.MinGasPrice.Denom)
+		assert.True(t, forecast.MinGasPrice.Amount.GT(previousMinGasPrice))
+		previousMinGasPrice = forecast.MinGasPrice.Amount
+	}
+	assert.Equal(t, "91.000000000000000000", res.Forecasts[0].ShortEmaUtilizationPercent.String())
+}
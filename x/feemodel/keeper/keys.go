@@ -1,9 +1,18 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 var (
-	gasTrackingKey = []byte{0x00}
-	gasPriceKey    = []byte{0x01}
-	shortEMAGasKey = []byte{0x02}
-	longEMAGasKey  = []byte{0x03}
-	paramsKey      = []byte{0x04}
+	gasTrackingKey    = []byte{0x00}
+	gasPriceKey       = []byte{0x01}
+	shortEMAGasKey    = []byte{0x02}
+	longEMAGasKey     = []byte{0x03}
+	paramsKey         = []byte{0x04}
+	blockGasKeyPrefix = []byte{0x05}
 )
+
+func blockGasKey(height int64) []byte {
+	return append(append([]byte{}, blockGasKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -45,7 +45,9 @@ type Keeper interface {
 	SetLongEMAGas(ctx sdk.Context, emaGas int64) error
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
 	SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) error
+	SetBlockGas(ctx sdk.Context, height, gas int64) error
 	CalculateEdgeGasPriceAfterBlocks(ctx sdk.Context, after uint32) (sdk.DecCoin, sdk.DecCoin, error)
+	CalculateGasUsage(ctx sdk.Context, forecastBlocks uint32) (*types.QueryGasUsageResponse, error)
 	UpdateParams(ctx sdk.Context, authority string, params types.Params) error
 }
 
@@ -197,6 +199,9 @@ func (am AppModule) EndBlock(c context.Context) error {
 	if err := am.keeper.SetShortEMAGas(ctx, newShortEMA); err != nil {
 		return err
 	}
+	if err := am.keeper.SetBlockGas(ctx, ctx.BlockHeight(), currentGasUsage); err != nil {
+		return err
+	}
 	if err := am.keeper.SetLongEMAGas(ctx, newLongEMA); err != nil {
 		return err
 	}
@@ -62,6 +62,14 @@ func (k *keeperMock) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) er
 	return nil
 }
 
+func (k *keeperMock) SetBlockGas(ctx sdk.Context, height, gas int64) error {
+	return nil
+}
+
+func (k *keeperMock) CalculateGasUsage(ctx sdk.Context, forecastBlocks uint32) (*types.QueryGasUsageResponse, error) {
+	return &types.QueryGasUsageResponse{}, nil
+}
+
 func (k *keeperMock) CalculateEdgeGasPriceAfterBlocks(ctx sdk.Context, after uint32) (sdk.DecCoin, sdk.DecCoin, error) {
 	return sdk.NewDecCoin("", sdkmath.ZeroInt()), sdk.NewDecCoin("", sdkmath.ZeroInt()), nil
 }
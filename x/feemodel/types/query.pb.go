@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -217,6 +218,235 @@ func (m *QueryRecommendedGasPriceResponse) GetHigh() types.DecCoin {
 	return types.DecCoin{}
 }
 
+// QueryGasUsageRequest is the request type for the Query/GasUsage RPC method.
+type QueryGasUsageRequest struct {
+	// forecast_blocks is the number of future blocks to forecast the gas utilization and the min gas price for.
+	ForecastBlocks uint32 `protobuf:"varint,1,opt,name=forecast_blocks,json=forecastBlocks,proto3" json:"forecast_blocks,omitempty"`
+}
+
+func (m *QueryGasUsageRequest) Reset()         { *m = QueryGasUsageRequest{} }
+func (m *QueryGasUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasUsageRequest) ProtoMessage()    {}
+func (*QueryGasUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{4}
+}
+func (m *QueryGasUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasUsageRequest.Merge(m, src)
+}
+func (m *QueryGasUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasUsageRequest proto.InternalMessageInfo
+
+func (m *QueryGasUsageRequest) GetForecastBlocks() uint32 {
+	if m != nil {
+		return m.ForecastBlocks
+	}
+	return 0
+}
+
+// BlockGasUsage is the gas utilization of the block.
+type BlockGasUsage struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas is the gas declared by the transactions of the block.
+	Gas int64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	// utilization_percent is the gas of the block as the percentage of the max block gas.
+	UtilizationPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=utilization_percent,json=utilizationPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"utilization_percent"`
+}
+
+func (m *BlockGasUsage) Reset()         { *m = BlockGasUsage{} }
+func (m *BlockGasUsage) String() string { return proto.CompactTextString(m) }
+func (*BlockGasUsage) ProtoMessage()    {}
+func (*BlockGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{5}
+}
+func (m *BlockGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasUsage.Merge(m, src)
+}
+func (m *BlockGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockGasUsage proto.InternalMessageInfo
+
+func (m *BlockGasUsage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetGas() int64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// GasUsageForecast is the forecast of the gas utilization and the min gas price of the future block.
+type GasUsageForecast struct {
+	// blocks_ahead is the number of blocks after the current one.
+	BlocksAhead uint32 `protobuf:"varint,1,opt,name=blocks_ahead,json=blocksAhead,proto3" json:"blocks_ahead,omitempty"`
+	// short_ema_utilization_percent is the forecast short EMA of block gas as the percentage of the max block gas.
+	ShortEmaUtilizationPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=short_ema_utilization_percent,json=shortEmaUtilizationPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"short_ema_utilization_percent"`
+	// min_gas_price is the forecast min gas price.
+	MinGasPrice types.DecCoin `protobuf:"bytes,3,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+}
+
+func (m *GasUsageForecast) Reset()         { *m = GasUsageForecast{} }
+func (m *GasUsageForecast) String() string { return proto.CompactTextString(m) }
+func (*GasUsageForecast) ProtoMessage()    {}
+func (*GasUsageForecast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{6}
+}
+func (m *GasUsageForecast) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasUsageForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasUsageForecast.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasUsageForecast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasUsageForecast.Merge(m, src)
+}
+func (m *GasUsageForecast) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasUsageForecast) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasUsageForecast.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasUsageForecast proto.InternalMessageInfo
+
+func (m *GasUsageForecast) GetBlocksAhead() uint32 {
+	if m != nil {
+		return m.BlocksAhead
+	}
+	return 0
+}
+
+func (m *GasUsageForecast) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+// QueryGasUsageResponse is the response type for the Query/GasUsage RPC method.
+type QueryGasUsageResponse struct {
+	// blocks is the gas utilization of the recent blocks, from the oldest to the latest one.
+	Blocks []BlockGasUsage `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+	// average_utilization_percent is the average gas utilization of the recent blocks.
+	AverageUtilizationPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=average_utilization_percent,json=averageUtilizationPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"average_utilization_percent"`
+	// short_ema_utilization_percent is the current short EMA of block gas as the percentage of the max block gas.
+	ShortEmaUtilizationPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=short_ema_utilization_percent,json=shortEmaUtilizationPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"short_ema_utilization_percent"`
+	// long_ema_utilization_percent is the current long EMA of block gas as the percentage of the max block gas.
+	LongEmaUtilizationPercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=long_ema_utilization_percent,json=longEmaUtilizationPercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"long_ema_utilization_percent"`
+	// congested is true if the short EMA of block gas is above the escalation start, so the min gas price escalates.
+	Congested bool `protobuf:"varint,5,opt,name=congested,proto3" json:"congested,omitempty"`
+	// forecasts are the forecasts of the next blocks assuming the average gas utilization of the recent blocks.
+	Forecasts []GasUsageForecast `protobuf:"bytes,6,rep,name=forecasts,proto3" json:"forecasts"`
+}
+
+func (m *QueryGasUsageResponse) Reset()         { *m = QueryGasUsageResponse{} }
+func (m *QueryGasUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasUsageResponse) ProtoMessage()    {}
+func (*QueryGasUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{7}
+}
+func (m *QueryGasUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasUsageResponse.Merge(m, src)
+}
+func (m *QueryGasUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasUsageResponse proto.InternalMessageInfo
+
+func (m *QueryGasUsageResponse) GetBlocks() []BlockGasUsage {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *QueryGasUsageResponse) GetCongested() bool {
+	if m != nil {
+		return m.Congested
+	}
+	return false
+}
+
+func (m *QueryGasUsageResponse) GetForecasts() []GasUsageForecast {
+	if m != nil {
+		return m.Forecasts
+	}
+	return nil
+}
+
 // QueryParamsRequest defines the request type for querying x/feemodel parameters.
 type QueryParamsRequest struct {
 }
@@ -225,7 +455,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2036651e57006ae, []int{4}
+	return fileDescriptor_d2036651e57006ae, []int{8}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +493,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2036651e57006ae, []int{5}
+	return fileDescriptor_d2036651e57006ae, []int{9}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,6 +534,10 @@ func init() {
 	proto.RegisterType((*QueryMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryMinGasPriceResponse")
 	proto.RegisterType((*QueryRecommendedGasPriceRequest)(nil), "coreum.feemodel.v1.QueryRecommendedGasPriceRequest")
 	proto.RegisterType((*QueryRecommendedGasPriceResponse)(nil), "coreum.feemodel.v1.QueryRecommendedGasPriceResponse")
+	proto.RegisterType((*QueryGasUsageRequest)(nil), "coreum.feemodel.v1.QueryGasUsageRequest")
+	proto.RegisterType((*BlockGasUsage)(nil), "coreum.feemodel.v1.BlockGasUsage")
+	proto.RegisterType((*GasUsageForecast)(nil), "coreum.feemodel.v1.GasUsageForecast")
+	proto.RegisterType((*QueryGasUsageResponse)(nil), "coreum.feemodel.v1.QueryGasUsageResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.feemodel.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.feemodel.v1.QueryParamsResponse")
 }
@@ -311,40 +545,60 @@ func init() {
 func init() { proto.RegisterFile("coreum/feemodel/v1/query.proto", fileDescriptor_d2036651e57006ae) }
 
 var fileDescriptor_d2036651e57006ae = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0xb6, 0xe4, 0xb0, 0xa1, 0x97, 0x6d, 0x25, 0x82, 0x15, 0x39, 0xad, 0x91, 0x80,
-	0xaa, 0xd4, 0xab, 0xa4, 0x15, 0x70, 0x0e, 0x15, 0x5c, 0x40, 0x94, 0x1c, 0xb9, 0x44, 0x6b, 0x67,
-	0xea, 0xac, 0x9a, 0xdd, 0x75, 0xbd, 0x9b, 0x90, 0x22, 0x71, 0xe1, 0x09, 0x90, 0xfa, 0x28, 0xbc,
-	0x03, 0xea, 0xb1, 0x82, 0x0b, 0x27, 0x84, 0x12, 0x1e, 0x04, 0x79, 0xbd, 0x55, 0x1a, 0x6a, 0x8b,
-	0xf4, 0x66, 0xcd, 0xcc, 0x3f, 0xf3, 0x8d, 0xe7, 0xd7, 0x22, 0x2f, 0x92, 0x29, 0x8c, 0x38, 0x39,
-	0x06, 0xe0, 0xb2, 0x0f, 0x43, 0x32, 0x6e, 0x91, 0xd3, 0x11, 0xa4, 0x67, 0x41, 0x92, 0x4a, 0x2d,
-	0x31, 0xce, 0xf3, 0xc1, 0x55, 0x3e, 0x18, 0xb7, 0xdc, 0x66, 0x81, 0x26, 0xa1, 0x29, 0xe5, 0x2a,
-	0x17, 0xb9, 0x5e, 0x24, 0x15, 0x97, 0x8a, 0x84, 0x54, 0x01, 0x19, 0xb7, 0x42, 0xd0, 0xb4, 0x45,
-	0x22, 0xc9, 0x84, 0xcd, 0x6f, 0xc6, 0x32, 0x96, 0xe6, 0x93, 0x64, 0x5f, 0x36, 0xda, 0x88, 0xa5,
-	0x8c, 0x87, 0x40, 0x68, 0xc2, 0x08, 0x15, 0x42, 0x6a, 0xaa, 0x99, 0x14, 0xb6, 0xa7, 0x7f, 0x1f,
-	0xdd, 0x7b, 0x97, 0x71, 0xbd, 0x61, 0xe2, 0x15, 0x55, 0x47, 0x29, 0x8b, 0xa0, 0x0b, 0xa7, 0x23,
-	0x50, 0xda, 0x0f, 0x51, 0xfd, 0x66, 0x4a, 0x25, 0x52, 0x28, 0xc0, 0x2f, 0xd1, 0x3a, 0x67, 0xa2,
-	0x17, 0x53, 0xd5, 0x4b, 0xb2, 0x44, 0xdd, 0xd9, 0x72, 0x1e, 0xd7, 0xda, 0x8d, 0x20, 0x47, 0x0c,
-	0x32, 0xc4, 0xc0, 0x22, 0x06, 0x87, 0x10, 0xbd, 0x90, 0x4c, 0x74, 0xd6, 0x2e, 0x7e, 0x35, 0x2b,
-	0xdd, 0x1a, 0x9f, 0xf7, 0xf3, 0x0f, 0x51, 0xd3, 0xcc, 0xe8, 0x42, 0x24, 0x39, 0x07, 0xd1, 0x87,
-	0xfe, 0x3f, 0x18, 0x78, 0x1b, 0xdd, 0xa5, 0xc7, 0x1a, 0xd2, 0x5e, 0x38, 0x94, 0xd1, 0x89, 0x32,
-	0x93, 0xd6, 0xbb, 0x35, 0x13, 0xeb, 0x98, 0x90, 0xff, 0xcd, 0x41, 0x5b, 0xe5, 0x6d, 0x2c, 0xf2,
-	0x01, 0x5a, 0x1d, 0xca, 0x0f, 0xb7, 0x00, 0xcd, 0xca, 0x33, 0x15, 0x87, 0x7e, 0x7d, 0x65, 0x79,
-	0x15, 0x87, 0x3e, 0x7e, 0x8a, 0xd6, 0x06, 0x2c, 0x1e, 0xd4, 0x57, 0x97, 0x96, 0x99, 0x7a, 0x7f,
-	0x13, 0x61, 0xb3, 0xc7, 0x91, 0x39, 0xfb, 0xd5, 0x21, 0xde, 0xa2, 0x8d, 0x85, 0xa8, 0x5d, 0xe8,
-	0x39, 0xaa, 0xe6, 0xf6, 0xb0, 0x3b, 0xb9, 0xc1, 0x4d, 0x53, 0x05, 0xb9, 0xc6, 0x0e, 0xb1, 0xf5,
-	0xed, 0xef, 0xab, 0xe8, 0x8e, 0xe9, 0x88, 0xcf, 0x1d, 0x54, 0xbb, 0x76, 0x5f, 0xbc, 0x5b, 0xd4,
-	0xa3, 0xc4, 0x20, 0xee, 0x93, 0xe5, 0x8a, 0x73, 0x5c, 0x7f, 0xe7, 0xf3, 0x8f, 0x3f, 0xe7, 0x2b,
-	0x0f, 0xf0, 0x36, 0x29, 0xf0, 0xf9, 0x82, 0x99, 0xf0, 0x57, 0x07, 0x6d, 0x14, 0x9c, 0x12, 0xef,
-	0x97, 0x0e, 0x2c, 0xf7, 0x8f, 0x7b, 0x70, 0x3b, 0x91, 0xa5, 0x6d, 0x19, 0xda, 0x5d, 0xbc, 0x53,
-	0x44, 0x9b, 0xce, 0x85, 0xd7, 0xa8, 0x3f, 0xa1, 0x6a, 0xfe, 0xb7, 0xf1, 0xc3, 0xd2, 0x91, 0x0b,
-	0x87, 0x75, 0x1f, 0xfd, 0xb7, 0xce, 0xd2, 0xf8, 0x86, 0xa6, 0x81, 0x5d, 0x52, 0xfa, 0x46, 0x74,
-	0x5e, 0x5f, 0x4c, 0x3d, 0xe7, 0x72, 0xea, 0x39, 0xbf, 0xa7, 0x9e, 0xf3, 0x65, 0xe6, 0x55, 0x2e,
-	0x67, 0x5e, 0xe5, 0xe7, 0xcc, 0xab, 0xbc, 0x6f, 0xc7, 0x4c, 0x0f, 0x46, 0x61, 0x10, 0x49, 0x4e,
-	0xb4, 0x3c, 0x01, 0xc1, 0x3e, 0xc2, 0xde, 0x84, 0xe8, 0xc9, 0x5e, 0x34, 0xa0, 0x4c, 0x90, 0xf1,
-	0x33, 0x32, 0x99, 0x77, 0xd4, 0x67, 0x09, 0xa8, 0xb0, 0x6a, 0x9e, 0x87, 0xfd, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x4a, 0x34, 0xf5, 0x52, 0xc9, 0x04, 0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x59, 0xd7, 0x6a, 0xc6, 0x04, 0xaa, 0x49, 0x00, 0x77, 0xeb, 0xae, 0x93, 0x2d,
+	0x50, 0x47, 0xa5, 0x3b, 0xb2, 0x5b, 0x01, 0xb7, 0x0a, 0x13, 0x5a, 0x0e, 0x45, 0x84, 0x15, 0xbd,
+	0x70, 0xb1, 0xc6, 0xbb, 0xcf, 0xbb, 0xab, 0x78, 0x77, 0xdc, 0x9d, 0xb1, 0x49, 0x2a, 0x71, 0xe1,
+	0xc0, 0x15, 0xa4, 0x7e, 0x14, 0xbe, 0x03, 0xea, 0x31, 0x12, 0x17, 0x04, 0x52, 0x84, 0x12, 0xf8,
+	0x04, 0x7c, 0x01, 0xb4, 0xb3, 0xb3, 0x71, 0x6c, 0xef, 0x2a, 0x0e, 0x70, 0x1b, 0xbf, 0x99, 0xff,
+	0x7b, 0xbf, 0x79, 0xef, 0xcd, 0xf3, 0x22, 0xd3, 0x65, 0x09, 0x4c, 0x22, 0x32, 0x04, 0x88, 0x98,
+	0x07, 0x23, 0x32, 0xed, 0x90, 0xe7, 0x13, 0x48, 0x8e, 0xec, 0x71, 0xc2, 0x04, 0xc3, 0x38, 0xdb,
+	0xb7, 0xf3, 0x7d, 0x7b, 0xda, 0x31, 0x5a, 0x05, 0x9a, 0x31, 0x4d, 0x68, 0xc4, 0x33, 0x91, 0x61,
+	0xba, 0x8c, 0x47, 0x8c, 0x93, 0x01, 0xe5, 0x40, 0xa6, 0x9d, 0x01, 0x08, 0xda, 0x21, 0x2e, 0x0b,
+	0x63, 0xb5, 0xbf, 0xe5, 0x33, 0x9f, 0xc9, 0x25, 0x49, 0x57, 0xca, 0xda, 0xf4, 0x19, 0xf3, 0x47,
+	0x40, 0xe8, 0x38, 0x24, 0x34, 0x8e, 0x99, 0xa0, 0x22, 0x64, 0xb1, 0xf2, 0x69, 0xdd, 0x44, 0x6f,
+	0x7f, 0x99, 0x72, 0x7d, 0x1e, 0xc6, 0x4f, 0x28, 0xdf, 0x4f, 0x42, 0x17, 0x1c, 0x78, 0x3e, 0x01,
+	0x2e, 0xac, 0x01, 0x6a, 0x2c, 0x6f, 0xf1, 0x31, 0x8b, 0x39, 0xe0, 0xc7, 0x68, 0x23, 0x0a, 0xe3,
+	0xbe, 0x4f, 0x79, 0x7f, 0x9c, 0x6e, 0x34, 0xb4, 0x6d, 0xad, 0x5d, 0xef, 0x36, 0xed, 0x0c, 0xd1,
+	0x4e, 0x11, 0x6d, 0x85, 0x68, 0xef, 0x81, 0xfb, 0x09, 0x0b, 0xe3, 0x5e, 0xf5, 0xd5, 0x49, 0xab,
+	0xe2, 0xd4, 0xa3, 0x99, 0x3f, 0x6b, 0x0f, 0xb5, 0x64, 0x0c, 0x07, 0x5c, 0x16, 0x45, 0x10, 0x7b,
+	0xe0, 0x2d, 0x60, 0xe0, 0x1d, 0xf4, 0x1a, 0x1d, 0x0a, 0x48, 0xfa, 0x83, 0x11, 0x73, 0x0f, 0xb8,
+	0x8c, 0xb4, 0xe1, 0xd4, 0xa5, 0xad, 0x27, 0x4d, 0xd6, 0xcf, 0x1a, 0xda, 0x2e, 0x77, 0xa3, 0x90,
+	0x1f, 0x22, 0x7d, 0xc4, 0xbe, 0xb9, 0x02, 0x68, 0x7a, 0x3c, 0x55, 0x45, 0xe0, 0x35, 0xd6, 0x56,
+	0x57, 0x45, 0xe0, 0xe1, 0x0f, 0x50, 0x35, 0x08, 0xfd, 0xa0, 0xa1, 0xaf, 0x2c, 0x93, 0xe7, 0xad,
+	0x47, 0x68, 0x4b, 0xde, 0xe3, 0x09, 0xe5, 0xcf, 0x38, 0xf5, 0xcf, 0x73, 0x70, 0x17, 0xbd, 0x31,
+	0x64, 0x09, 0xb8, 0x94, 0x8b, 0xf9, 0x34, 0xbc, 0x9e, 0x9b, 0x55, 0x26, 0x7e, 0xd0, 0xd0, 0x86,
+	0x5c, 0xe6, 0x1e, 0xf0, 0x5b, 0xa8, 0x16, 0x40, 0xe8, 0x07, 0x42, 0x2a, 0x74, 0x47, 0xfd, 0xc2,
+	0x37, 0x90, 0xee, 0x53, 0x2e, 0x2f, 0xa6, 0x3b, 0xe9, 0x12, 0x7f, 0x85, 0x36, 0x27, 0x22, 0x1c,
+	0x85, 0x2f, 0x64, 0x83, 0xf4, 0xc7, 0x90, 0xb8, 0x10, 0x0b, 0x79, 0x87, 0xf5, 0xde, 0x9d, 0x94,
+	0xf2, 0xb7, 0x93, 0xd6, 0xad, 0xec, 0x2a, 0xdc, 0x3b, 0xb0, 0x43, 0x46, 0x22, 0x2a, 0x02, 0xfb,
+	0x29, 0xf8, 0xd4, 0x3d, 0xda, 0x03, 0xd7, 0xc1, 0x17, 0xf4, 0xfb, 0x99, 0xdc, 0xfa, 0x4b, 0x43,
+	0x37, 0x72, 0x98, 0xc7, 0x0a, 0x36, 0xad, 0x69, 0x76, 0x8d, 0x3e, 0x0d, 0x80, 0x7a, 0x79, 0x4d,
+	0x33, 0xdb, 0xc7, 0xa9, 0x09, 0x0f, 0xd1, 0x6d, 0x1e, 0xb0, 0x44, 0xf4, 0x21, 0xa2, 0xfd, 0x22,
+	0xae, 0xb5, 0xd5, 0xb9, 0x0c, 0xe9, 0xe9, 0xd3, 0x88, 0x3e, 0x5b, 0xe2, 0x5b, 0xee, 0x64, 0xfd,
+	0xdf, 0x75, 0xf2, 0xdf, 0x3a, 0x7a, 0x73, 0xa1, 0x76, 0xaa, 0xf1, 0x1e, 0xa1, 0xda, 0x79, 0xcd,
+	0xf4, 0x76, 0xbd, 0xbb, 0x63, 0x2f, 0x3f, 0x7e, 0x7b, 0xae, 0x68, 0xca, 0xbf, 0x92, 0x61, 0x17,
+	0xdd, 0xa2, 0x53, 0x48, 0xa8, 0x0f, 0xff, 0x35, 0x11, 0x37, 0x95, 0x9f, 0x82, 0x3c, 0x5c, 0x9a,
+	0x6f, 0xfd, 0xff, 0xc9, 0xb7, 0x87, 0x9a, 0x23, 0x16, 0xfb, 0xa5, 0x61, 0xaa, 0x57, 0xb8, 0x4d,
+	0xea, 0xa8, 0x38, 0x4a, 0x13, 0xad, 0xbb, 0x2c, 0xf6, 0x81, 0x0b, 0xf0, 0x1a, 0xd7, 0xb6, 0xb5,
+	0xf6, 0x75, 0x67, 0x66, 0xc0, 0x9f, 0xa1, 0xf5, 0xfc, 0xdd, 0xf0, 0x46, 0x4d, 0x16, 0xe5, 0x9d,
+	0xa2, 0xa2, 0x2c, 0xf6, 0xad, 0xaa, 0xcb, 0x4c, 0x6c, 0x6d, 0x21, 0x2c, 0x8b, 0xbe, 0x2f, 0xe7,
+	0x74, 0x3e, 0x39, 0xbf, 0x40, 0x9b, 0x73, 0x56, 0xd5, 0x08, 0x1f, 0xa1, 0x5a, 0x36, 0xcf, 0xd5,
+	0x10, 0x32, 0x8a, 0x62, 0x66, 0x9a, 0xbc, 0x03, 0xb2, 0xf3, 0xdd, 0xdf, 0xab, 0xe8, 0x9a, 0xf4,
+	0x88, 0x5f, 0x6a, 0xa8, 0x7e, 0x61, 0x20, 0xe3, 0x7b, 0x45, 0x3e, 0x4a, 0x26, 0xba, 0xf1, 0xfe,
+	0x6a, 0x87, 0x33, 0x5c, 0x6b, 0xf7, 0xbb, 0x5f, 0xfe, 0x7c, 0xb9, 0x76, 0x07, 0xef, 0x90, 0x82,
+	0x3f, 0xa6, 0xb9, 0x37, 0x83, 0x7f, 0xd2, 0xd0, 0x66, 0xc1, 0xec, 0xc5, 0x0f, 0x4a, 0x03, 0x96,
+	0x0f, 0x7c, 0xe3, 0xe1, 0xd5, 0x44, 0x8a, 0xb6, 0x23, 0x69, 0xef, 0xe1, 0xdd, 0x22, 0xda, 0x64,
+	0x26, 0xbc, 0x40, 0xfd, 0xbd, 0x86, 0xae, 0x9f, 0xcf, 0xc9, 0x76, 0x69, 0xd4, 0x85, 0x61, 0x6c,
+	0xec, 0xae, 0x70, 0x52, 0x41, 0xbd, 0x2b, 0xa1, 0x5a, 0xf8, 0x76, 0x11, 0x54, 0x0a, 0x32, 0x91,
+	0xb1, 0xbf, 0x45, 0xb5, 0xac, 0xec, 0xf8, 0xbd, 0x52, 0xdf, 0x73, 0x1d, 0x66, 0xdc, 0xbd, 0xf4,
+	0x9c, 0x22, 0xb0, 0x24, 0x41, 0x13, 0x1b, 0xa4, 0xf4, 0xeb, 0xa2, 0xf7, 0xf4, 0xd5, 0xa9, 0xa9,
+	0x1d, 0x9f, 0x9a, 0xda, 0x1f, 0xa7, 0xa6, 0xf6, 0xe3, 0x99, 0x59, 0x39, 0x3e, 0x33, 0x2b, 0xbf,
+	0x9e, 0x99, 0x95, 0xaf, 0xbb, 0x7e, 0x28, 0x82, 0xc9, 0xc0, 0x76, 0x59, 0x44, 0x04, 0x3b, 0x80,
+	0x38, 0x7c, 0x01, 0xf7, 0x0f, 0x89, 0x38, 0xbc, 0xef, 0x06, 0x34, 0x8c, 0xc9, 0xf4, 0x43, 0x72,
+	0x38, 0xf3, 0x28, 0x8e, 0xc6, 0xc0, 0x07, 0x35, 0xf9, 0x61, 0xf1, 0xe0, 0x9f, 0x01, 0x00, 0x05,
+	0x9e, 0x8f, 0x9b, 0x03, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +617,8 @@ type QueryClient interface {
 	MinGasPrice(ctx context.Context, in *QueryMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMinGasPriceResponse, error)
 	// RecommendedGasPrice queries the recommended gas price for the next n blocks.
 	RecommendedGasPrice(ctx context.Context, in *QueryRecommendedGasPriceRequest, opts ...grpc.CallOption) (*QueryRecommendedGasPriceResponse, error)
+	// GasUsage queries the gas utilization of the recent blocks and its short-horizon forecast.
+	GasUsage(ctx context.Context, in *QueryGasUsageRequest, opts ...grpc.CallOption) (*QueryGasUsageResponse, error)
 	// Params queries the parameters of x/feemodel module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -393,6 +649,15 @@ func (c *queryClient) RecommendedGasPrice(ctx context.Context, in *QueryRecommen
 	return out, nil
 }
 
+func (c *queryClient) GasUsage(ctx context.Context, in *QueryGasUsageRequest, opts ...grpc.CallOption) (*QueryGasUsageResponse, error) {
+	out := new(QueryGasUsageResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/GasUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/Params", in, out, opts...)
@@ -408,6 +673,8 @@ type QueryServer interface {
 	MinGasPrice(context.Context, *QueryMinGasPriceRequest) (*QueryMinGasPriceResponse, error)
 	// RecommendedGasPrice queries the recommended gas price for the next n blocks.
 	RecommendedGasPrice(context.Context, *QueryRecommendedGasPriceRequest) (*QueryRecommendedGasPriceResponse, error)
+	// GasUsage queries the gas utilization of the recent blocks and its short-horizon forecast.
+	GasUsage(context.Context, *QueryGasUsageRequest) (*QueryGasUsageResponse, error)
 	// Params queries the parameters of x/feemodel module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -422,6 +689,9 @@ func (*UnimplementedQueryServer) MinGasPrice(ctx context.Context, req *QueryMinG
 func (*UnimplementedQueryServer) RecommendedGasPrice(ctx context.Context, req *QueryRecommendedGasPriceRequest) (*QueryRecommendedGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecommendedGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) GasUsage(ctx context.Context, req *QueryGasUsageRequest) (*QueryGasUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasUsage not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -466,6 +736,24 @@ func _Query_RecommendedGasPrice_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/GasUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasUsage(ctx, req.(*QueryGasUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -496,6 +784,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecommendedGasPrice",
 			Handler:    _Query_RecommendedGasPrice_Handler,
 		},
+		{
+			MethodName: "GasUsage",
+			Handler:    _Query_GasUsage_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -642,7 +934,7 @@ func (m *QueryRecommendedGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGasUsageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -652,20 +944,25 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGasUsageRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGasUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ForecastBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ForecastBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *BlockGasUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -675,94 +972,374 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockGasUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.UtilizationPercent.Size()
+		i -= size
+		if _, err := m.UtilizationPercent.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryMinGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GasUsageForecast) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMinGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *GasUsageForecast) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRecommendedGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GasUsageForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AfterBlocks != 0 {
-		n += 1 + sovQuery(uint64(m.AfterBlocks))
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryRecommendedGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ShortEmaUtilizationPercent.Size()
+		i -= size
+		if _, err := m.ShortEmaUtilizationPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.Low.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Med.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.High.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.BlocksAhead != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksAhead))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGasUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Forecasts) > 0 {
+		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Congested {
+		i--
+		if m.Congested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.LongEmaUtilizationPercent.Size()
+		i -= size
+		if _, err := m.LongEmaUtilizationPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ShortEmaUtilizationPercent.Size()
+		i -= size
+		if _, err := m.ShortEmaUtilizationPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AverageUtilizationPercent.Size()
+		i -= size
+		if _, err := m.AverageUtilizationPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecommendedGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AfterBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AfterBlocks))
+	}
+	return n
+}
+
+func (m *QueryRecommendedGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Low.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Med.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForecastBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.ForecastBlocks))
+	}
+	return n
+}
+
+func (m *BlockGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	l = m.UtilizationPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GasUsageForecast) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksAhead != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksAhead))
+	}
+	l = m.ShortEmaUtilizationPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.AverageUtilizationPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ShortEmaUtilizationPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LongEmaUtilizationPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Congested {
+		n += 2
+	}
+	if len(m.Forecasts) > 0 {
+		for _, e := range m.Forecasts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
@@ -1133,6 +1710,573 @@ func (m *QueryRecommendedGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecastBlocks", wireType)
+			}
+			m.ForecastBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForecastBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockGasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockGasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilizationPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UtilizationPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasUsageForecast) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasUsageForecast: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasUsageForecast: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksAhead", wireType)
+			}
+			m.BlocksAhead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksAhead |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortEmaUtilizationPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShortEmaUtilizationPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, BlockGasUsage{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageUtilizationPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageUtilizationPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortEmaUtilizationPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShortEmaUtilizationPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongEmaUtilizationPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LongEmaUtilizationPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Congested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Congested = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forecasts = append(m.Forecasts, GasUsageForecast{})
+			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,63 @@
+package ante
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+// Keeper interface exposes methods required by ante handler decorator of fee referral.
+type Keeper interface {
+	ValidateReferral(ctx context.Context, feePayer sdk.AccAddress, referral types.FeePayerReferral) error
+}
+
+// ReferralDecorator rejects the transactions with the referral extension option if the referrer isn't registered or
+// the transaction executes something else than the wasm contracts.
+type ReferralDecorator struct {
+	keeper Keeper
+}
+
+// NewReferralDecorator creates ante decorator validating the referral extension option.
+func NewReferralDecorator(keeper Keeper) ReferralDecorator {
+	return ReferralDecorator{
+		keeper: keeper,
+	}
+}
+
+// AnteHandle handles transaction in ante decorator.
+func (rd ReferralDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	referral, found, err := types.GetFeePayerReferral(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if !found {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(cosmoserrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*wasmtypes.MsgExecuteContract); !ok {
+			return ctx, sdkerrors.Wrapf(
+				types.ErrInvalidReferral,
+				"referral is allowed for the wasm contract executions only, got %s", sdk.MsgTypeURL(msg),
+			)
+		}
+	}
+
+	if err := rd.keeper.ValidateReferral(ctx, feeTx.FeePayer(), referral); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
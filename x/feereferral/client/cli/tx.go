@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+// FlagAmount is the flag setting the coins sent to the executed contract.
+const FlagAmount = "amount"
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      types.ModuleName + " transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdRegisterReferrer(),
+		CmdUnregisterReferrer(),
+		CmdExecuteContract(),
+	)
+
+	return cmd
+}
+
+// CmdRegisterReferrer returns RegisterReferrer cobra command.
+func CmdRegisterReferrer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [fee_share] --from [referrer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Register the sender as the referrer receiving the part of the fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register the sender as the referrer receiving the part of the fees paid by the referred
+transactions, or change the fee share of the registered referrer. The fee share must not exceed the max_fee_share
+param.
+
+Example:
+$ %s tx %s register 0.1 --from [referrer]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			feeShare, err := sdkmath.LegacyNewDecFromStr(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid fee share")
+			}
+
+			msg := &types.MsgRegisterReferrer{
+				Sender:   clientCtx.GetFromAddress().String(),
+				FeeShare: feeShare,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdUnregisterReferrer returns UnregisterReferrer cobra command.
+func CmdUnregisterReferrer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister --from [referrer]",
+		Args:  cobra.NoArgs,
+		Short: "Unregister the sender as the referrer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unregister the sender as the referrer.
+
+Example:
+$ %s tx %s unregister --from [referrer]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgUnregisterReferrer{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdExecuteContract returns the command executing the wasm contract with the referral extension option set.
+func CmdExecuteContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [contract_address] [json_encoded_msg] [referrer] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Execute the wasm contract routing the part of the fee to the referrer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the wasm contract with the fee_payer_referral extension option set. The part of the
+fee paid by the transaction is routed to the registered referrer after the successful execution.
+
+Example:
+$ %s tx %s execute [contract_address] '{"action":{}}' [referrer] --%s 100ucore --from [sender]
+`,
+				version.AppName, types.ModuleName, FlagAmount,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			referrer, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return errors.Wrap(err, "invalid referrer")
+			}
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return errors.Wrap(err, "invalid amount")
+			}
+
+			referral, err := types.NewFeePayerReferralExtension(referrer)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &wasmtypes.MsgExecuteContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Msg:      wasmtypes.RawContractMessage(args[1]),
+				Funds:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithExtensionOptions(referral), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAmount, "", "Coins to send to the contract along with the message")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
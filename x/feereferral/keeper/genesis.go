@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+// InitGenesis initializes the feereferral module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, referrer := range genState.Referrers {
+		if err := k.SetReferrer(ctx, referrer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the feereferral module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := &types.GenesisState{
+		Params:    params,
+		Referrers: []types.Referrer{},
+	}
+	if err := k.Referrers.Walk(ctx, nil, func(_ sdk.AccAddress, referrer types.Referrer) (bool, error) {
+		genesis.Referrers = append(genesis.Referrers, referrer)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
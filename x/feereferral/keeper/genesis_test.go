@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+func TestGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	referrer1, _ := testApp.GenAccount(ctx)
+	referrer2, _ := testApp.GenAccount(ctx)
+	genState := types.GenesisState{
+		Params: types.Params{
+			MaxFeeShare: sdkmath.LegacyMustNewDecFromStr("0.3"),
+		},
+		Referrers: []types.Referrer{
+			{Address: referrer1.String(), FeeShare: sdkmath.LegacyMustNewDecFromStr("0.3")},
+			{Address: referrer2.String(), FeeShare: sdkmath.LegacyMustNewDecFromStr("0.05")},
+		},
+	}
+	requireT.NoError(genState.Validate())
+
+	requireT.NoError(testApp.FeeReferralKeeper.InitGenesis(ctx, genState))
+	exported, err := testApp.FeeReferralKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState.Params, exported.Params)
+	requireT.ElementsMatch(genState.Referrers, exported.Referrers)
+
+	// the duplicated referrers are rejected
+	genState.Referrers = append(genState.Referrers, types.Referrer{
+		Address:  referrer1.String(),
+		FeeShare: sdkmath.LegacyMustNewDecFromStr("0.1"),
+	})
+	requireT.ErrorIs(genState.Validate(), types.ErrInvalidInput)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryService serves grpc requests for the module.
+type QueryService struct {
+	keeper Keeper
+}
+
+// NewQueryService creates query service.
+func NewQueryService(keeper Keeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params returns the parameters of the module.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// Referrer returns the registered referrer.
+func (qs QueryService) Referrer(
+	ctx context.Context, req *types.QueryReferrerRequest,
+) (*types.QueryReferrerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	referrer, err := qs.keeper.GetReferrer(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReferrerResponse{
+		Referrer: referrer,
+	}, nil
+}
+
+// Referrers returns all the registered referrers.
+func (qs QueryService) Referrers(
+	ctx context.Context, req *types.QueryReferrersRequest,
+) (*types.QueryReferrersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	referrers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.Referrers,
+		req.Pagination,
+		func(_ sdk.AccAddress, referrer types.Referrer) (types.Referrer, error) {
+			return referrer, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReferrersResponse{
+		Referrers:  referrers,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdkstore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	authority    string
+
+	// codec
+	cdc codec.Codec
+
+	// keepers
+	bankKeeper types.BankKeeper
+
+	// fee collector module name
+	feeCollectorName string
+
+	// collections
+	Schema    collections.Schema
+	Params    collections.Item[types.Params]
+	Referrers collections.Map[sdk.AccAddress, types.Referrer]
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.Codec,
+	authority string,
+	bankKeeper types.BankKeeper,
+	feeCollectorName string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:     storeService,
+		cdc:              cdc,
+		authority:        authority,
+		bankKeeper:       bankKeeper,
+		feeCollectorName: feeCollectorName,
+
+		Params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			codec.CollValue[types.Params](cdc),
+		),
+		Referrers: collections.NewMap(
+			sb,
+			types.ReferrersKey,
+			"referrers",
+			sdk.AccAddressKey,
+			codec.CollValue[types.Referrer](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
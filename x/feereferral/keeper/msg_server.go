@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// UpdateParams is a governance operation that sets parameters of the module.
+func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateParams(ctx, req.Authority, req.Params); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// RegisterReferrer registers the sender as the referrer.
+func (ms MsgServer) RegisterReferrer(
+	ctx context.Context,
+	req *types.MsgRegisterReferrer,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.RegisterReferrer(ctx, sender, req.FeeShare); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// UnregisterReferrer unregisters the sender as the referrer.
+func (ms MsgServer) UnregisterReferrer(
+	ctx context.Context,
+	req *types.MsgUnregisterReferrer,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.UnregisterReferrer(ctx, sender); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
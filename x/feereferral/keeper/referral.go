@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+// RegisterReferrer registers the address as the referrer or changes the fee share of the registered one.
+func (k Keeper) RegisterReferrer(ctx context.Context, addr sdk.AccAddress, feeShare sdkmath.LegacyDec) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if feeShare.GT(params.MaxFeeShare) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidInput, "fee share %s exceeds the max fee share %s", feeShare, params.MaxFeeShare,
+		)
+	}
+
+	referrer := types.Referrer{
+		Address:  addr.String(),
+		FeeShare: feeShare,
+	}
+	if err := k.SetReferrer(ctx, referrer); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventReferrerRegistered{
+		Address:  referrer.Address,
+		FeeShare: referrer.FeeShare,
+	})
+}
+
+// UnregisterReferrer unregisters the referrer.
+func (k Keeper) UnregisterReferrer(ctx context.Context, addr sdk.AccAddress) error {
+	found, err := k.Referrers.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorsmod.Wrapf(types.ErrReferrerNotFound, "address: %s", addr)
+	}
+	if err := k.Referrers.Remove(ctx, addr); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventReferrerUnregistered{
+		Address: addr.String(),
+	})
+}
+
+// SetReferrer sets the registered referrer.
+func (k Keeper) SetReferrer(ctx context.Context, referrer types.Referrer) error {
+	if err := referrer.ValidateBasic(); err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(referrer.Address)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidInput, "invalid address %q: %s", referrer.Address, err)
+	}
+	return k.Referrers.Set(ctx, addr, referrer)
+}
+
+// GetReferrer returns the registered referrer.
+func (k Keeper) GetReferrer(ctx context.Context, addr sdk.AccAddress) (types.Referrer, error) {
+	referrer, err := k.Referrers.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Referrer{}, errorsmod.Wrapf(types.ErrReferrerNotFound, "address: %s", addr)
+		}
+		return types.Referrer{}, err
+	}
+	return referrer, nil
+}
+
+// ValidateReferral checks that the fee paid by the fee payer might be shared with the referrer.
+func (k Keeper) ValidateReferral(ctx context.Context, feePayer sdk.AccAddress, referral types.FeePayerReferral) error {
+	referrer, err := sdk.AccAddressFromBech32(referral.Referrer)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidReferral, "invalid referrer address %q: %s", referral.Referrer, err)
+	}
+	if referrer.Equals(feePayer) {
+		return errorsmod.Wrap(types.ErrInvalidReferral, "fee payer can't refer itself")
+	}
+	if _, err := k.GetReferrer(ctx, referrer); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PayReferralFee sends the part of the fee paid by the fee payer to the referrer. The part is defined by the fee share
+// of the referrer capped by the max fee share param. Nothing is paid if the referrer was unregistered by the executed
+// messages.
+func (k Keeper) PayReferralFee(
+	ctx context.Context,
+	feePayer sdk.AccAddress,
+	referral types.FeePayerReferral,
+	fee sdk.Coins,
+) error {
+	referrerAddr, err := sdk.AccAddressFromBech32(referral.Referrer)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidReferral, "invalid referrer address %q: %s", referral.Referrer, err)
+	}
+	referrer, err := k.Referrers.Get(ctx, referrerAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	feeShare := params.EffectiveFeeShare(referrer.FeeShare)
+	amount := sdk.NewCoins()
+	for _, coin := range fee {
+		amount = amount.Add(sdk.NewCoin(coin.Denom, sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(feeShare).TruncateInt()))
+	}
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, referrerAddr, amount); err != nil {
+		return errorsmod.Wrap(err, "failed to send the referral fee")
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventReferralFeePaid{
+		Referrer: referrer.Address,
+		FeePayer: feePayer.String(),
+		Amount:   amount,
+	})
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+func TestRegisterReferrer(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	msgServer := keeper.NewMsgServer(testApp.FeeReferralKeeper)
+	queryService := keeper.NewQueryService(testApp.FeeReferralKeeper)
+
+	referrer, _ := testApp.GenAccount(ctx)
+
+	// the fee share can't exceed the max one
+	_, err := msgServer.RegisterReferrer(ctx, &types.MsgRegisterReferrer{
+		Sender:   referrer.String(),
+		FeeShare: sdkmath.LegacyMustNewDecFromStr("0.3"),
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RegisterReferrer(ctx, &types.MsgRegisterReferrer{
+		Sender:   referrer.String(),
+		FeeShare: sdkmath.LegacyMustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+	events, err := event.FindTypedEvents[*types.EventReferrerRegistered](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(events, 1)
+	requireT.Equal(referrer.String(), events[0].Address)
+
+	// the registration updates the fee share
+	_, err = msgServer.RegisterReferrer(ctx, &types.MsgRegisterReferrer{
+		Sender:   referrer.String(),
+		FeeShare: sdkmath.LegacyMustNewDecFromStr("0.15"),
+	})
+	requireT.NoError(err)
+	referrerRes, err := queryService.Referrer(ctx, &types.QueryReferrerRequest{Address: referrer.String()})
+	requireT.NoError(err)
+	requireT.Equal("0.150000000000000000", referrerRes.Referrer.FeeShare.String())
+
+	referrersRes, err := queryService.Referrers(ctx, &types.QueryReferrersRequest{})
+	requireT.NoError(err)
+	requireT.Len(referrersRes.Referrers, 1)
+
+	_, err = msgServer.UnregisterReferrer(ctx, &types.MsgUnregisterReferrer{Sender: referrer.String()})
+	requireT.NoError(err)
+	_, err = queryService.Referrer(ctx, &types.QueryReferrerRequest{Address: referrer.String()})
+	requireT.ErrorIs(err, types.ErrReferrerNotFound)
+	_, err = msgServer.UnregisterReferrer(ctx, &types.MsgUnregisterReferrer{Sender: referrer.String()})
+	requireT.ErrorIs(err, types.ErrReferrerNotFound)
+}
+
+func TestPayReferralFee(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	feeReferralKeeper := testApp.FeeReferralKeeper
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	referrer, _ := testApp.GenAccount(ctx)
+	feePayer, _ := testApp.GenAccount(ctx)
+	referral := types.FeePayerReferral{Referrer: referrer.String()}
+
+	// the referrer must be registered and can't be the fee payer
+	requireT.ErrorIs(feeReferralKeeper.ValidateReferral(ctx, feePayer, referral), types.ErrReferrerNotFound)
+	requireT.NoError(feeReferralKeeper.RegisterReferrer(ctx, referrer, sdkmath.LegacyMustNewDecFromStr("0.2")))
+	requireT.NoError(feeReferralKeeper.ValidateReferral(ctx, feePayer, referral))
+	requireT.ErrorIs(feeReferralKeeper.ValidateReferral(ctx, referrer, referral), types.ErrInvalidReferral)
+
+	// the fee is already in the fee collector when the referral fee is paid
+	fee := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_005))
+	requireT.NoError(testApp.FundAccount(ctx, feePayer, fee))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, authtypes.FeeCollectorName, fee))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(feeReferralKeeper.PayReferralFee(ctx, feePayer, referral, fee))
+	requireT.Equal("201", testApp.BankKeeper.GetBalance(ctx, referrer, bondDenom).Amount.String())
+	events, err := event.FindTypedEvents[*types.EventReferralFeePaid](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(events, 1)
+	requireT.Equal(feePayer.String(), events[0].FeePayer)
+	requireT.Equal("201"+bondDenom, events[0].Amount.String())
+
+	// the lowered max fee share caps the fee share of the registered referrer
+	params := types.DefaultParams()
+	params.MaxFeeShare = sdkmath.LegacyMustNewDecFromStr("0.1")
+	requireT.NoError(feeReferralKeeper.UpdateParams(ctx, testApp.GovAuthority(), params))
+	requireT.NoError(feeReferralKeeper.PayReferralFee(ctx, feePayer, referral, fee))
+	requireT.Equal("301", testApp.BankKeeper.GetBalance(ctx, referrer, bondDenom).Amount.String())
+
+	// nothing is paid to the unregistered referrer
+	requireT.NoError(feeReferralKeeper.UnregisterReferrer(ctx, referrer))
+	requireT.NoError(feeReferralKeeper.PayReferralFee(ctx, feePayer, referral, fee))
+	requireT.Equal("301", testApp.BankKeeper.GetBalance(ctx, referrer, bondDenom).Amount.String())
+}
@@ -0,0 +1,56 @@
+package posthandler
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/feereferral/types"
+)
+
+// Keeper interface exposes methods required by post handler decorator of fee referral.
+type Keeper interface {
+	PayReferralFee(ctx context.Context, feePayer sdk.AccAddress, referral types.FeePayerReferral, fee sdk.Coins) error
+}
+
+// ReferralFeeDecorator routes the part of the fee paid by the successfully executed transaction to the referrer set
+// by the referral extension option.
+type ReferralFeeDecorator struct {
+	keeper Keeper
+}
+
+// NewReferralFeeDecorator creates post decorator paying the referral fee.
+func NewReferralFeeDecorator(keeper Keeper) ReferralFeeDecorator {
+	return ReferralFeeDecorator{
+		keeper: keeper,
+	}
+}
+
+// PostHandle handles transaction in post decorator.
+func (rd ReferralFeeDecorator) PostHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler,
+) (sdk.Context, error) {
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	referral, found, err := types.GetFeePayerReferral(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if !found {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(cosmoserrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+	if err := rd.keeper.PayReferralFee(ctx, feeTx.FeePayer(), referral, feeTx.GetFee()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
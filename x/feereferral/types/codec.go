@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// RegisterLegacyAminoCodec registers the amino types and interfaces.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, ModuleName+"/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterReferrer{}, ModuleName+"/MsgRegisterReferrer")
+	legacy.RegisterAminoMsg(cdc, &MsgUnregisterReferrer{}, ModuleName+"/MsgUnregisterReferrer")
+}
+
+// RegisterInterfaces registers the module's tx interfaces.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil), &FeePayerReferral{})
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidAuthority is returned when the authority is invalid.
+	ErrInvalidAuthority = sdkerrors.Register(ModuleName, 2, "invalid authority")
+
+	// ErrInvalidInput is returned when input validation fails.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 3, "invalid input")
+
+	// ErrReferrerNotFound is returned when the referrer isn't registered.
+	ErrReferrerNotFound = sdkerrors.Register(ModuleName, 4, "referrer not found")
+
+	// ErrInvalidReferral is returned when the transaction with the referral extension can't be referred.
+	ErrInvalidReferral = sdkerrors.Register(ModuleName, 5, "invalid referral")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		Referrers: []Referrer{},
+	}
+}
+
+// Validate validates genesis parameters.
+func (m GenesisState) Validate() error {
+	if err := m.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	addresses := make(map[string]struct{}, len(m.Referrers))
+	for _, referrer := range m.Referrers {
+		if err := referrer.ValidateBasic(); err != nil {
+			return err
+		}
+		if referrer.FeeShare.GT(m.Params.MaxFeeShare) {
+			return errorsmod.Wrapf(
+				ErrInvalidInput, "fee share of referrer %s exceeds the max fee share", referrer.Address,
+			)
+		}
+		if _, found := addresses[referrer.Address]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate referrer %s", referrer.Address)
+		}
+		addresses[referrer.Address] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "feereferral"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// KVStore keys.
+var (
+	ParamsKey    = collections.NewPrefix(0)
+	ReferrersKey = collections.NewPrefix(1) // Map: referrer -> fee share
+)
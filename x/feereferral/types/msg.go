@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type extendedMsg interface {
+	sdk.Msg
+	sdk.HasValidateBasic
+}
+
+var (
+	_ extendedMsg = &MsgUpdateParams{}
+	_ extendedMsg = &MsgRegisterReferrer{}
+	_ extendedMsg = &MsgUnregisterReferrer{}
+)
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return m.Params.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgRegisterReferrer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	return validateFeeShare("fee share", m.FeeShare)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUnregisterReferrer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	return nil
+}
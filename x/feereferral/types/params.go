@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultParams returns params with default values.
+func DefaultParams() Params {
+	return Params{
+		MaxFeeShare: sdkmath.LegacyMustNewDecFromStr("0.2"),
+	}
+}
+
+// ValidateBasic validates the params.
+func (p Params) ValidateBasic() error {
+	return validateFeeShare("max fee share", p.MaxFeeShare)
+}
+
+// EffectiveFeeShare returns the part of the fee routed to the referrer requesting the fee share.
+func (p Params) EffectiveFeeShare(feeShare sdkmath.LegacyDec) sdkmath.LegacyDec {
+	return sdkmath.LegacyMinDec(feeShare, p.MaxFeeShare)
+}
+
+func validateFeeShare(name string, feeShare sdkmath.LegacyDec) error {
+	if feeShare.IsNil() || feeShare.IsNegative() || feeShare.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidInput, "%s must be between 0 and 1, got %s", name, feeShare)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// FeePayerReferralTypeURL is the type URL of the referral transaction extension option.
+var FeePayerReferralTypeURL = "/" + proto.MessageName(&FeePayerReferral{})
+
+// IsFeePayerReferralExtension returns true if the transaction extension option is the referral. It is used as the
+// extension option checker of the ante handler.
+func IsFeePayerReferralExtension(option *codectypes.Any) bool {
+	return option.GetTypeUrl() == FeePayerReferralTypeURL
+}
+
+// NewFeePayerReferralExtension returns the transaction extension option routing the part of the fee to the referrer.
+func NewFeePayerReferralExtension(referrer sdk.AccAddress) (*codectypes.Any, error) {
+	return codectypes.NewAnyWithValue(&FeePayerReferral{Referrer: referrer.String()})
+}
+
+// ValidateBasic checks that the referrer fields are valid.
+func (r Referrer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid referrer address %q: %s", r.Address, err)
+	}
+	return validateFeeShare("fee share", r.FeeShare)
+}
+
+type extensionOptionsTx interface {
+	GetExtensionOptions() []*codectypes.Any
+}
+
+// GetFeePayerReferral returns the referral extension option of the transaction if it is set.
+func GetFeePayerReferral(tx sdk.Tx) (FeePayerReferral, bool, error) {
+	extTx, ok := tx.(extensionOptionsTx)
+	if !ok {
+		return FeePayerReferral{}, false, nil
+	}
+
+	var (
+		referral FeePayerReferral
+		found    bool
+	)
+	for _, option := range extTx.GetExtensionOptions() {
+		if !IsFeePayerReferralExtension(option) {
+			continue
+		}
+		if found {
+			return FeePayerReferral{}, false, errorsmod.Wrap(ErrInvalidReferral, "only one referral is allowed")
+		}
+		if err := proto.Unmarshal(option.Value, &referral); err != nil {
+			return FeePayerReferral{}, false, errorsmod.Wrapf(ErrInvalidReferral, "invalid referral: %s", err)
+		}
+		found = true
+	}
+
+	return referral, found, nil
+}
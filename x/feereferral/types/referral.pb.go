@@ -0,0 +1,552 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/feereferral/v1/referral.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeePayerReferral is the transaction extension option routing the part of the fee paid by the transaction to the
+// referrer after the successful execution.
+type FeePayerReferral struct {
+	// referrer is the address of the registered referrer receiving the part of the fee.
+	Referrer string `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+}
+
+func (m *FeePayerReferral) Reset()         { *m = FeePayerReferral{} }
+func (m *FeePayerReferral) String() string { return proto.CompactTextString(m) }
+func (*FeePayerReferral) ProtoMessage()    {}
+func (*FeePayerReferral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c191a055c21f20e, []int{0}
+}
+func (m *FeePayerReferral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePayerReferral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePayerReferral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePayerReferral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayerReferral.Merge(m, src)
+}
+func (m *FeePayerReferral) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePayerReferral) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayerReferral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayerReferral proto.InternalMessageInfo
+
+func (m *FeePayerReferral) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+// Referrer is the registered referrer.
+type Referrer struct {
+	// address is the address of the referrer receiving the part of the fee.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// fee_share is the part of the fee paid by the transaction requested by the referrer. The part routed to the
+	// referrer is capped by the max_fee_share param.
+	FeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee_share,json=feeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_share"`
+}
+
+func (m *Referrer) Reset()         { *m = Referrer{} }
+func (m *Referrer) String() string { return proto.CompactTextString(m) }
+func (*Referrer) ProtoMessage()    {}
+func (*Referrer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c191a055c21f20e, []int{1}
+}
+func (m *Referrer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Referrer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Referrer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Referrer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Referrer.Merge(m, src)
+}
+func (m *Referrer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Referrer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Referrer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Referrer proto.InternalMessageInfo
+
+func (m *Referrer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*FeePayerReferral)(nil), "coreum.feereferral.v1.FeePayerReferral")
+	proto.RegisterType((*Referrer)(nil), "coreum.feereferral.v1.Referrer")
+}
+
+func init() {
+	proto.RegisterFile("coreum/feereferral/v1/referral.proto", fileDescriptor_8c191a055c21f20e)
+}
+
+var fileDescriptor_8c191a055c21f20e = []byte{
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xbd, 0x4a, 0x33, 0x41,
+	0x14, 0x40, 0x77, 0xbe, 0xe2, 0x33, 0x99, 0x4a, 0x96, 0x08, 0x31, 0xc2, 0x44, 0xa2, 0x85, 0x4d,
+	0x66, 0x88, 0x3f, 0xd8, 0x6a, 0x10, 0xb1, 0x10, 0x94, 0x4d, 0x67, 0x13, 0x26, 0x93, 0xbb, 0x3f,
+	0xc4, 0xcd, 0x84, 0x99, 0xc9, 0xb2, 0x6b, 0xe5, 0x23, 0xf8, 0x30, 0x3e, 0x44, 0xca, 0x60, 0x25,
+	0x16, 0x41, 0x76, 0x5f, 0x44, 0xb2, 0xb3, 0x11, 0xad, 0xec, 0xee, 0xbd, 0x9c, 0x73, 0x8a, 0x8b,
+	0x0f, 0x85, 0x54, 0x30, 0x8f, 0x99, 0x0f, 0xa0, 0xc0, 0x07, 0xa5, 0xf8, 0x23, 0x4b, 0x7a, 0x6c,
+	0x33, 0xd3, 0x99, 0x92, 0x46, 0xba, 0x3b, 0x96, 0xa2, 0x3f, 0x28, 0x9a, 0xf4, 0x5a, 0xbb, 0x42,
+	0xea, 0x58, 0xea, 0x61, 0x09, 0x31, 0xbb, 0x58, 0xa3, 0xd5, 0x08, 0x64, 0x20, 0xed, 0x7d, 0x3d,
+	0xd9, 0x6b, 0xe7, 0x06, 0x6f, 0x5f, 0x03, 0xdc, 0xf3, 0x0c, 0x94, 0x57, 0x75, 0xdc, 0x53, 0x5c,
+	0xb3, 0x4d, 0x50, 0x4d, 0xb4, 0x8f, 0x8e, 0xea, 0xfd, 0xe6, 0xdb, 0x6b, 0xb7, 0x51, 0xd5, 0x2e,
+	0xc7, 0x63, 0x05, 0x5a, 0x0f, 0x8c, 0x8a, 0xa6, 0x81, 0xf7, 0x4d, 0x76, 0x9e, 0x11, 0xae, 0x79,
+	0xd5, 0xe2, 0x1e, 0xe3, 0x2d, 0x6e, 0xb9, 0x3f, 0x0b, 0x1b, 0xd0, 0xbd, 0xc0, 0x75, 0x1f, 0x60,
+	0xa8, 0x43, 0xae, 0xa0, 0xf9, 0xaf, 0xb4, 0x0e, 0x16, 0xab, 0xb6, 0xf3, 0xb1, 0x6a, 0xef, 0x59,
+	0x53, 0x8f, 0x27, 0x34, 0x92, 0x2c, 0xe6, 0x26, 0xa4, 0xb7, 0x10, 0x70, 0x91, 0x5d, 0x81, 0xf0,
+	0x6a, 0x3e, 0xc0, 0x60, 0x2d, 0xf5, 0xef, 0x16, 0x39, 0x41, 0xcb, 0x9c, 0xa0, 0xcf, 0x9c, 0xa0,
+	0x97, 0x82, 0x38, 0xcb, 0x82, 0x38, 0xef, 0x05, 0x71, 0x1e, 0xce, 0x82, 0xc8, 0x84, 0xf3, 0x11,
+	0x15, 0x32, 0x66, 0x46, 0x4e, 0x60, 0x1a, 0x3d, 0x41, 0x37, 0x65, 0x26, 0xed, 0x8a, 0x90, 0x47,
+	0x53, 0x96, 0x9c, 0xb3, 0xf4, 0xd7, 0xc7, 0x4d, 0x36, 0x03, 0x3d, 0xfa, 0x5f, 0x3e, 0xe9, 0xe4,
+	0x6b, 0x00, 0x7f, 0x0e, 0x5c, 0x75, 0x94, 0x01, 0x00, 0x00,
+}
+
+func (m *FeePayerReferral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayerReferral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayerReferral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintReferral(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Referrer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Referrer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Referrer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeShare.Size()
+		i -= size
+		if _, err := m.FeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReferral(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintReferral(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReferral(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReferral(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeePayerReferral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovReferral(uint64(l))
+	}
+	return n
+}
+
+func (m *Referrer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovReferral(uint64(l))
+	}
+	l = m.FeeShare.Size()
+	n += 1 + l + sovReferral(uint64(l))
+	return n
+}
+
+func sovReferral(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReferral(x uint64) (n int) {
+	return sovReferral(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeePayerReferral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReferral
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayerReferral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayerReferral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReferral(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Referrer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReferral
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Referrer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Referrer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReferral(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReferral(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReferral
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReferral
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReferral
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReferral
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReferral        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReferral          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReferral = fmt.Errorf("proto: unexpected end of group")
+)
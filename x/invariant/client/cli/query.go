@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+)
+
+// GetQueryCmd returns the parent command for all CLI query commands. The
+// provided clientCtx should have, at a minimum, a verifier, Tendermint RPC client,
+// and marshaler set.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "invariants",
+		Short:                      "Querying commands for the invariants of the app modules",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryInvariants())
+	cmd.AddCommand(CmdRunInvariants())
+
+	return cmd
+}
+
+// CmdQueryInvariants implements a command to fetch the registered invariants.
+func CmdQueryInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Query the registered invariants",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the registered invariants.
+
+Example:
+$ %s query invariants list
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Invariants(cmd.Context(), &types.QueryInvariantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRunInvariants implements a command to run the invariants against the current state.
+func CmdRunInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run [module] [route]",
+		Short: "Run the invariants against the current state",
+		Args:  cobra.MaximumNArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run the invariants against the current state. All the invariants are run if the module is not
+provided, all the invariants of the module are run if the route is not provided.
+The command fails if any of the invariants is broken.
+
+Example:
+$ %[1]s query invariants run
+$ %[1]s query invariants run assetft frozen-balances
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRunInvariantsRequest{}
+			if len(args) > 0 {
+				req.Module = args[0]
+			}
+			if len(args) > 1 {
+				req.Route = args[1]
+			}
+
+			res, err := queryClient.RunInvariants(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.PrintProto(res); err != nil {
+				return err
+			}
+			if res.Broken {
+				return errors.New("invariants are broken")
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetInvariants() []types.Invariant
+	RunInvariants(ctx sdk.Context, moduleName, routeName string) ([]types.InvariantResult, error)
+}
+
+// QueryService serves grpc query requests for the invariant module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Invariants queries the registered invariants.
+func (qs QueryService) Invariants(
+	_ context.Context,
+	_ *types.QueryInvariantsRequest,
+) (*types.QueryInvariantsResponse, error) {
+	return &types.QueryInvariantsResponse{
+		Invariants: qs.keeper.GetInvariants(),
+	}, nil
+}
+
+// RunInvariants runs the invariants against the current state.
+func (qs QueryService) RunInvariants(
+	ctx context.Context,
+	req *types.QueryRunInvariantsRequest,
+) (*types.QueryRunInvariantsResponse, error) {
+	results, err := qs.keeper.RunInvariants(sdk.UnwrapSDKContext(ctx), req.Module, req.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	broken := false
+	for _, result := range results {
+		broken = broken || result.Broken
+	}
+
+	return &types.QueryRunInvariantsResponse{
+		Results: results,
+		Broken:  broken,
+	}, nil
+}
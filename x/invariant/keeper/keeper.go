@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+)
+
+var _ types.Registry = &Keeper{}
+
+type route struct {
+	invariant types.Invariant
+	fn        types.InvariantFunc
+}
+
+// Keeper is the registry of the invariants of the app modules.
+type Keeper struct {
+	routes []route
+}
+
+// NewKeeper returns a new keeper object.
+func NewKeeper() *Keeper {
+	return &Keeper{}
+}
+
+// RegisterRoute registers the invariant of the module. It panics if the invariant is registered already.
+func (k *Keeper) RegisterRoute(moduleName, routeName string, invariant types.InvariantFunc) {
+	for _, r := range k.routes {
+		if r.invariant.Module == moduleName && r.invariant.Route == routeName {
+			panic(fmt.Sprintf("invariant %s/%s is already registered", moduleName, routeName))
+		}
+	}
+	k.routes = append(k.routes, route{
+		invariant: types.Invariant{
+			Module: moduleName,
+			Route:  routeName,
+		},
+		fn: invariant,
+	})
+}
+
+// GetInvariants returns the registered invariants in the registration order.
+func (k *Keeper) GetInvariants() []types.Invariant {
+	invariants := make([]types.Invariant, 0, len(k.routes))
+	for _, r := range k.routes {
+		invariants = append(invariants, r.invariant)
+	}
+
+	return invariants
+}
+
+// RunInvariants runs the invariants of the module, or the single invariant if the route is set. All the invariants
+// are run if the module is empty. The state changes made by the invariants are discarded.
+func (k *Keeper) RunInvariants(ctx sdk.Context, moduleName, routeName string) ([]types.InvariantResult, error) {
+	if moduleName == "" && routeName != "" {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "module must be set if the route is set")
+	}
+
+	results := make([]types.InvariantResult, 0)
+	for _, r := range k.routes {
+		if moduleName != "" && r.invariant.Module != moduleName {
+			continue
+		}
+		if routeName != "" && r.invariant.Route != routeName {
+			continue
+		}
+
+		cacheCtx, _ := ctx.CacheContext()
+		msg, broken := r.fn(cacheCtx)
+		results = append(results, types.InvariantResult{
+			Invariant: r.invariant,
+			Broken:    broken,
+			Message:   msg,
+		})
+	}
+	if len(results) == 0 && moduleName != "" {
+		return nil, sdkerrors.Wrapf(types.ErrInvariantNotFound, "module: %s, route: %s", moduleName, routeName)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/invariant/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+)
+
+func TestKeeper_RunInvariants(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	k := keeper.NewKeeper()
+	k.RegisterRoute("module1", "valid", func(ctx sdk.Context) (string, bool) {
+		return "valid", false
+	})
+	k.RegisterRoute("module1", "broken", func(ctx sdk.Context) (string, bool) {
+		return "broken", true
+	})
+	k.RegisterRoute("module2", "valid", func(ctx sdk.Context) (string, bool) {
+		return "valid", false
+	})
+	requireT.Panics(func() {
+		k.RegisterRoute("module2", "valid", func(ctx sdk.Context) (string, bool) {
+			return "valid", false
+		})
+	})
+
+	requireT.Equal([]types.Invariant{
+		{Module: "module1", Route: "valid"},
+		{Module: "module1", Route: "broken"},
+		{Module: "module2", Route: "valid"},
+	}, k.GetInvariants())
+
+	results, err := k.RunInvariants(ctx, "", "")
+	requireT.NoError(err)
+	requireT.Len(results, 3)
+	requireT.True(results[1].Broken)
+	requireT.Equal("broken", results[1].Message)
+
+	results, err = k.RunInvariants(ctx, "module1", "")
+	requireT.NoError(err)
+	requireT.Len(results, 2)
+
+	results, err = k.RunInvariants(ctx, "module2", "valid")
+	requireT.NoError(err)
+	requireT.Equal([]types.InvariantResult{
+		{Invariant: types.Invariant{Module: "module2", Route: "valid"}, Message: "valid"},
+	}, results)
+
+	_, err = k.RunInvariants(ctx, "module2", "broken")
+	requireT.ErrorIs(err, types.ErrInvariantNotFound)
+	_, err = k.RunInvariants(ctx, "", "valid")
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
+
+func TestKeeper_AppInvariants(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	results, err := testApp.InvariantKeeper.RunInvariants(ctx, "", "")
+	requireT.NoError(err)
+	requireT.NotEmpty(results)
+	for _, result := range results {
+		requireT.False(result.Broken, result.Message)
+	}
+}
@@ -0,0 +1,86 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightCheckInvariants      = "op_weight_check_invariants"
+	DefaultWeightCheckInvariants = 20
+)
+
+// opCheckInvariants is the name of the operation reported to the simulation.
+const opCheckInvariants = "check_invariants"
+
+// Keeper defines the methods of the invariant keeper used by the simulation.
+type Keeper interface {
+	RunInvariants(ctx sdk.Context, moduleName, routeName string) ([]types.InvariantResult, error)
+}
+
+// OperationFactory creates simulation operations.
+type OperationFactory struct {
+	appParams simtypes.AppParams
+	keeper    Keeper
+}
+
+// NewOperationFactory returns new instance of the OperationFactory.
+func NewOperationFactory(appParams simtypes.AppParams, keeper Keeper) *OperationFactory {
+	return &OperationFactory{
+		appParams: appParams,
+		keeper:    keeper,
+	}
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func (op *OperationFactory) WeightedOperations() simulation.WeightedOperations {
+	// make the weights updatable by the simulation
+	var weightCheckInvariants int
+	op.appParams.GetOrGenerate(OpWeightCheckInvariants, &weightCheckInvariants, nil,
+		func(_ *rand.Rand) {
+			weightCheckInvariants = DefaultWeightCheckInvariants
+		},
+	)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightCheckInvariants,
+			op.simulateCheckInvariants,
+		),
+	}
+}
+
+// simulateCheckInvariants runs all the registered invariants against the simulated state and fails the simulation
+// if any of them is broken. It doesn't deliver any transaction.
+func (op *OperationFactory) simulateCheckInvariants(
+	_ *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context,
+	_ []simtypes.Account, _ string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	results, err := op.keeper.RunInvariants(ctx, "", "")
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, opCheckInvariants, "can't run invariants"), nil, err
+	}
+
+	var broken []string
+	for _, result := range results {
+		if result.Broken {
+			broken = append(broken, result.Message)
+		}
+	}
+	if len(broken) > 0 {
+		return simtypes.NoOpMsg(types.ModuleName, opCheckInvariants, "broken invariants"), nil, fmt.Errorf(
+			"%d of %d invariants are broken at height %d:\n%s",
+			len(broken), len(results), ctx.BlockHeight(), strings.Join(broken, "\n"),
+		)
+	}
+
+	return simtypes.NewOperationMsgBasic(types.ModuleName, opCheckInvariants, "", true, nil), nil, nil
+}
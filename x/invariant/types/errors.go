@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidInput is returned if input data are invalid.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
+	// ErrInvariantNotFound is returned if the requested invariant is not registered.
+	ErrInvariantNotFound = sdkerrors.Register(ModuleName, 2, "invariant not found")
+)
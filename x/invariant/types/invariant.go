@@ -0,0 +1,21 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvariantFunc tests the invariant against the state. It returns the message describing the checked state
+// and the flag indicating whether the invariant is broken.
+type InvariantFunc func(ctx sdk.Context) (string, bool)
+
+// Registry is the registry the modules register their invariants in.
+type Registry interface {
+	RegisterRoute(moduleName, route string, invariant InvariantFunc)
+}
+
+// FormatInvariant returns the standardized invariant message.
+func FormatInvariant(moduleName, route, msg string) string {
+	return fmt.Sprintf("%s: %s invariant\n%s\n", moduleName, route, msg)
+}
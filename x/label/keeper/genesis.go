@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+// InitGenesis initializes the label module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, label := range genState.Labels {
+		if err := k.SetAddressLabel(ctx, label); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the label module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	genesis := types.DefaultGenesisState()
+	if err := k.Labels.Walk(ctx, nil, func(_ sdk.AccAddress, label types.AddressLabel) (bool, error) {
+		genesis.Labels = append(genesis.Labels, label)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+func TestGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	exchange, _ := testApp.GenAccount(ctx)
+	clearing, _ := testApp.GenAccount(ctx)
+	genState := types.GenesisState{
+		Labels: []types.AddressLabel{
+			{Address: exchange.String(), Name: "Exchange hot wallet", Category: "exchange"},
+			{Address: clearing.String(), Name: "Clearing account"},
+		},
+	}
+	requireT.NoError(genState.Validate())
+
+	requireT.NoError(testApp.LabelKeeper.InitGenesis(ctx, genState))
+	exported, err := testApp.LabelKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.ElementsMatch(genState.Labels, exported.Labels)
+
+	// the duplicated labels are rejected
+	genState.Labels = append(genState.Labels, types.AddressLabel{Address: clearing.String(), Name: "Duplicate"})
+	requireT.ErrorIs(genState.Validate(), types.ErrInvalidInput)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryService serves grpc requests for the module.
+type QueryService struct {
+	keeper Keeper
+}
+
+// NewQueryService creates query service.
+func NewQueryService(keeper Keeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// AddressLabel returns the label of the address.
+func (qs QueryService) AddressLabel(
+	ctx context.Context, req *types.QueryAddressLabelRequest,
+) (*types.QueryAddressLabelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	label, err := qs.keeper.GetAddressLabel(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAddressLabelResponse{
+		Label: label,
+	}, nil
+}
+
+// AddressLabels returns the labels of all the addresses.
+func (qs QueryService) AddressLabels(
+	ctx context.Context, req *types.QueryAddressLabelsRequest,
+) (*types.QueryAddressLabelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	labels, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.Labels,
+		req.Pagination,
+		func(_ sdk.AccAddress, label types.AddressLabel) (types.AddressLabel, error) {
+			return label, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAddressLabelsResponse{
+		Labels:     labels,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdkstore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	authority    string
+
+	// codec
+	cdc codec.BinaryCodec
+
+	// collections
+	Schema collections.Schema
+	Labels collections.Map[sdk.AccAddress, types.AddressLabel]
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.BinaryCodec,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService: storeService,
+		cdc:          cdc,
+		authority:    authority,
+
+		Labels: collections.NewMap(
+			sb,
+			types.AddressLabelKey,
+			"labels",
+			sdk.AccAddressKey,
+			codec.CollValue[types.AddressLabel](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
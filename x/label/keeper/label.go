@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+// SetAddressLabels is a governance operation that creates or overwrites the labels of the addresses.
+func (k Keeper) SetAddressLabels(ctx context.Context, authority string, labels []types.AddressLabel) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+	if err := types.ValidateAddressLabels(labels); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, label := range labels {
+		if err := k.SetAddressLabel(ctx, label); err != nil {
+			return err
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAddressLabelSet{
+			Address:  label.Address,
+			Name:     label.Name,
+			Category: label.Category,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveAddressLabels is a governance operation that removes the labels of the addresses.
+func (k Keeper) RemoveAddressLabels(ctx context.Context, authority string, addresses []string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, address := range addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidInput, "invalid address %q: %s", address, err)
+		}
+		found, err := k.Labels.Has(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return errorsmod.Wrapf(types.ErrLabelNotFound, "address: %s", address)
+		}
+		if err := k.Labels.Remove(ctx, addr); err != nil {
+			return err
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAddressLabelRemoved{
+			Address: address,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetAddressLabel sets the label of the address.
+func (k Keeper) SetAddressLabel(ctx context.Context, label types.AddressLabel) error {
+	if err := label.ValidateBasic(); err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(label.Address)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidInput, "invalid address %q: %s", label.Address, err)
+	}
+
+	return k.Labels.Set(ctx, addr, label)
+}
+
+// GetAddressLabel returns the label of the address.
+func (k Keeper) GetAddressLabel(ctx context.Context, addr sdk.AccAddress) (types.AddressLabel, error) {
+	label, err := k.Labels.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.AddressLabel{}, errorsmod.Wrapf(types.ErrLabelNotFound, "address: %s", addr)
+		}
+		return types.AddressLabel{}, err
+	}
+	return label, nil
+}
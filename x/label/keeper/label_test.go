@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/label/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+func TestSetAndRemoveAddressLabels(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	msgServer := keeper.NewMsgServer(testApp.LabelKeeper)
+	queryService := keeper.NewQueryService(testApp.LabelKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	exchange, _ := testApp.GenAccount(ctx)
+	clearing, _ := testApp.GenAccount(ctx)
+	labels := []types.AddressLabel{
+		{Address: exchange.String(), Name: "Exchange hot wallet", Category: "exchange"},
+		{Address: clearing.String(), Name: "Clearing account"},
+	}
+
+	// only the authority can set the labels
+	_, err := msgServer.SetAddressLabels(ctx, &types.MsgSetAddressLabels{
+		Authority: exchange.String(),
+		Labels:    labels,
+	})
+	requireT.ErrorIs(err, types.ErrInvalidAuthority)
+
+	// the labels must be valid
+	_, err = msgServer.SetAddressLabels(ctx, &types.MsgSetAddressLabels{
+		Authority: authority,
+		Labels:    []types.AddressLabel{{Address: exchange.String()}},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	_, err = msgServer.SetAddressLabels(ctx, &types.MsgSetAddressLabels{
+		Authority: authority,
+		Labels:    labels,
+	})
+	requireT.NoError(err)
+
+	labelRes, err := queryService.AddressLabel(ctx, &types.QueryAddressLabelRequest{Address: exchange.String()})
+	requireT.NoError(err)
+	requireT.Equal(labels[0], labelRes.Label)
+
+	// the labels are paginated
+	labelsRes, err := queryService.AddressLabels(ctx, &types.QueryAddressLabelsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	requireT.NoError(err)
+	requireT.Len(labelsRes.Labels, 1)
+	requireT.NotEmpty(labelsRes.Pagination.NextKey)
+	nextLabelsRes, err := queryService.AddressLabels(ctx, &types.QueryAddressLabelsRequest{
+		Pagination: &query.PageRequest{Key: labelsRes.Pagination.NextKey},
+	})
+	requireT.NoError(err)
+	requireT.ElementsMatch(labels, append(labelsRes.Labels, nextLabelsRes.Labels...))
+
+	// the label is overwritten
+	renamedLabel := types.AddressLabel{Address: exchange.String(), Name: "Exchange cold wallet", Category: "exchange"}
+	_, err = msgServer.SetAddressLabels(ctx, &types.MsgSetAddressLabels{
+		Authority: authority,
+		Labels:    []types.AddressLabel{renamedLabel},
+	})
+	requireT.NoError(err)
+	label, err := testApp.LabelKeeper.GetAddressLabel(ctx, exchange)
+	requireT.NoError(err)
+	requireT.Equal(renamedLabel, label)
+
+	// only the authority can remove the labels
+	_, err = msgServer.RemoveAddressLabels(ctx, &types.MsgRemoveAddressLabels{
+		Authority: exchange.String(),
+		Addresses: []string{exchange.String()},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidAuthority)
+
+	_, err = msgServer.RemoveAddressLabels(ctx, &types.MsgRemoveAddressLabels{
+		Authority: authority,
+		Addresses: []string{exchange.String()},
+	})
+	requireT.NoError(err)
+	_, err = testApp.LabelKeeper.GetAddressLabel(ctx, exchange)
+	requireT.ErrorIs(err, types.ErrLabelNotFound)
+
+	// the label of the not labeled address can't be removed
+	_, err = msgServer.RemoveAddressLabels(ctx, &types.MsgRemoveAddressLabels{
+		Authority: authority,
+		Addresses: []string{exchange.String()},
+	})
+	requireT.ErrorIs(err, types.ErrLabelNotFound)
+
+	_, err = queryService.AddressLabel(ctx, &types.QueryAddressLabelRequest{Address: clearing.String()})
+	requireT.NoError(err)
+	_, err = queryService.AddressLabel(ctx, &types.QueryAddressLabelRequest{Address: sdk.AccAddress("invalid").String()})
+	requireT.ErrorIs(err, types.ErrLabelNotFound)
+}
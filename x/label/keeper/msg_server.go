@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tokenize-x/tx-chain/v7/x/label/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// SetAddressLabels is a governance operation that creates or overwrites the labels of the addresses.
+func (ms MsgServer) SetAddressLabels(
+	ctx context.Context,
+	req *types.MsgSetAddressLabels,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.SetAddressLabels(ctx, req.Authority, req.Labels); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveAddressLabels is a governance operation that removes the labels of the addresses.
+func (ms MsgServer) RemoveAddressLabels(
+	ctx context.Context,
+	req *types.MsgRemoveAddressLabels,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.RemoveAddressLabels(ctx, req.Authority, req.Addresses); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
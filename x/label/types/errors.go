@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidAuthority is returned when the authority is invalid.
+	ErrInvalidAuthority = sdkerrors.Register(ModuleName, 2, "invalid authority")
+
+	// ErrInvalidInput is returned when input validation fails.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 3, "invalid input")
+
+	// ErrLabelNotFound is returned when the address is not labeled.
+	ErrLabelNotFound = sdkerrors.Register(ModuleName, 4, "label not found")
+)
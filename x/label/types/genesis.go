@@ -0,0 +1,13 @@
+package types
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Labels: []AddressLabel{},
+	}
+}
+
+// Validate validates genesis parameters.
+func (m GenesisState) Validate() error {
+	return validateUniqueAddressLabels(m.Labels)
+}
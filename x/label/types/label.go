@@ -0,0 +1,34 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxNameLength is the maximum length of the label name.
+	MaxNameLength = 64
+	// MaxCategoryLength is the maximum length of the label category.
+	MaxCategoryLength = 32
+)
+
+// ValidateBasic checks that the address label is valid.
+func (l AddressLabel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInput, "invalid address %q: %s", l.Address, err)
+	}
+	if strings.TrimSpace(l.Name) == "" {
+		return errorsmod.Wrapf(ErrInvalidInput, "name of the address %s must not be empty", l.Address)
+	}
+	if len(l.Name) > MaxNameLength {
+		return errorsmod.Wrapf(ErrInvalidInput, "name of the address %s must not exceed %d characters",
+			l.Address, MaxNameLength)
+	}
+	if len(l.Category) > MaxCategoryLength {
+		return errorsmod.Wrapf(ErrInvalidInput, "category of the address %s must not exceed %d characters",
+			l.Address, MaxCategoryLength)
+	}
+	return nil
+}
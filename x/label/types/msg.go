@@ -0,0 +1,68 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type extendedMsg interface {
+	sdk.Msg
+	sdk.HasValidateBasic
+}
+
+var (
+	_ extendedMsg = &MsgSetAddressLabels{}
+	_ extendedMsg = &MsgRemoveAddressLabels{}
+)
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgSetAddressLabels) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return ValidateAddressLabels(m.Labels)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgRemoveAddressLabels) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if len(m.Addresses) == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "addresses must not be empty")
+	}
+	addresses := make(map[string]struct{}, len(m.Addresses))
+	for _, address := range m.Addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid address %q: %s", address, err)
+		}
+		if _, found := addresses[address]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate address %s", address)
+		}
+		addresses[address] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateAddressLabels checks that the labels are valid and the addresses are not duplicated.
+func ValidateAddressLabels(labels []AddressLabel) error {
+	if len(labels) == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "labels must not be empty")
+	}
+	return validateUniqueAddressLabels(labels)
+}
+
+func validateUniqueAddressLabels(labels []AddressLabel) error {
+	addresses := make(map[string]struct{}, len(labels))
+	for _, label := range labels {
+		if err := label.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, found := addresses[label.Address]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate label of address %s", label.Address)
+		}
+		addresses[label.Address] = struct{}{}
+	}
+	return nil
+}
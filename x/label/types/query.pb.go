@@ -0,0 +1,1054 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/label/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryAddressLabelRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAddressLabelRequest) Reset()         { *m = QueryAddressLabelRequest{} }
+func (m *QueryAddressLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressLabelRequest) ProtoMessage()    {}
+func (*QueryAddressLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a2471339c276a4, []int{0}
+}
+func (m *QueryAddressLabelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressLabelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressLabelRequest.Merge(m, src)
+}
+func (m *QueryAddressLabelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressLabelRequest proto.InternalMessageInfo
+
+func (m *QueryAddressLabelRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryAddressLabelResponse struct {
+	Label AddressLabel `protobuf:"bytes,1,opt,name=label,proto3" json:"label"`
+}
+
+func (m *QueryAddressLabelResponse) Reset()         { *m = QueryAddressLabelResponse{} }
+func (m *QueryAddressLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressLabelResponse) ProtoMessage()    {}
+func (*QueryAddressLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a2471339c276a4, []int{1}
+}
+func (m *QueryAddressLabelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressLabelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressLabelResponse.Merge(m, src)
+}
+func (m *QueryAddressLabelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressLabelResponse proto.InternalMessageInfo
+
+func (m *QueryAddressLabelResponse) GetLabel() AddressLabel {
+	if m != nil {
+		return m.Label
+	}
+	return AddressLabel{}
+}
+
+type QueryAddressLabelsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAddressLabelsRequest) Reset()         { *m = QueryAddressLabelsRequest{} }
+func (m *QueryAddressLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressLabelsRequest) ProtoMessage()    {}
+func (*QueryAddressLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a2471339c276a4, []int{2}
+}
+func (m *QueryAddressLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressLabelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressLabelsRequest.Merge(m, src)
+}
+func (m *QueryAddressLabelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressLabelsRequest proto.InternalMessageInfo
+
+func (m *QueryAddressLabelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAddressLabelsResponse struct {
+	Labels []AddressLabel `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAddressLabelsResponse) Reset()         { *m = QueryAddressLabelsResponse{} }
+func (m *QueryAddressLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressLabelsResponse) ProtoMessage()    {}
+func (*QueryAddressLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a2471339c276a4, []int{3}
+}
+func (m *QueryAddressLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressLabelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressLabelsResponse.Merge(m, src)
+}
+func (m *QueryAddressLabelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressLabelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressLabelsResponse proto.InternalMessageInfo
+
+func (m *QueryAddressLabelsResponse) GetLabels() []AddressLabel {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *QueryAddressLabelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAddressLabelRequest)(nil), "coreum.label.v1.QueryAddressLabelRequest")
+	proto.RegisterType((*QueryAddressLabelResponse)(nil), "coreum.label.v1.QueryAddressLabelResponse")
+	proto.RegisterType((*QueryAddressLabelsRequest)(nil), "coreum.label.v1.QueryAddressLabelsRequest")
+	proto.RegisterType((*QueryAddressLabelsResponse)(nil), "coreum.label.v1.QueryAddressLabelsResponse")
+}
+
+func init() { proto.RegisterFile("coreum/label/v1/query.proto", fileDescriptor_f3a2471339c276a4) }
+
+var fileDescriptor_f3a2471339c276a4 = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xd5, 0x5d, 0x71, 0x54, 0x84, 0x41, 0xb0, 0x1b, 0x35, 0xab, 0x11, 0xd4, 0x5d,
+	0xd9, 0x79, 0x64, 0x15, 0x44, 0x3c, 0xb9, 0x07, 0x45, 0xf0, 0xa0, 0x39, 0x78, 0xf0, 0x36, 0xc9,
+	0x0e, 0xd9, 0x60, 0x9b, 0xc9, 0x66, 0x26, 0xa1, 0x55, 0xbc, 0x78, 0xf3, 0x56, 0xf0, 0x1b, 0x78,
+	0xf0, 0xb3, 0xf4, 0x58, 0xf0, 0xe2, 0x49, 0xa4, 0xf5, 0x83, 0x48, 0x66, 0xa6, 0x98, 0xda, 0x14,
+	0x73, 0x9b, 0xcc, 0x7b, 0xff, 0xf7, 0xff, 0xbd, 0xf7, 0x32, 0xf8, 0x5a, 0x2c, 0x0a, 0x5e, 0x0e,
+	0x61, 0xc0, 0x22, 0x3e, 0x80, 0x2a, 0x80, 0xd3, 0x92, 0x17, 0x63, 0x9a, 0x17, 0x42, 0x09, 0x72,
+	0xd9, 0x04, 0xa9, 0x0e, 0xd2, 0x2a, 0x70, 0xd7, 0xb2, 0x4d, 0x44, 0x67, 0xbb, 0xfb, 0xb1, 0x90,
+	0x43, 0x21, 0x21, 0x62, 0x92, 0x9b, 0x32, 0x50, 0x05, 0x11, 0x57, 0x2c, 0x80, 0x9c, 0x25, 0x69,
+	0xc6, 0x54, 0x2a, 0x32, 0x9b, 0x7b, 0x25, 0x11, 0x89, 0xd0, 0x47, 0xa8, 0x4f, 0xf6, 0xf6, 0x7a,
+	0x22, 0x44, 0x32, 0xe0, 0xc0, 0xf2, 0x14, 0x58, 0x96, 0x09, 0xa5, 0x25, 0xd2, 0x44, 0xfd, 0x87,
+	0xb8, 0xff, 0xba, 0xae, 0xfa, 0xf4, 0xf8, 0xb8, 0xe0, 0x52, 0xbe, 0xac, 0xad, 0x43, 0x7e, 0x5a,
+	0x72, 0xa9, 0x48, 0x1f, 0x9f, 0x63, 0xe6, 0xba, 0x8f, 0x6e, 0xa2, 0x7b, 0xe7, 0xc3, 0xe5, 0xa7,
+	0xff, 0x06, 0xef, 0xb4, 0xa8, 0x64, 0x2e, 0x32, 0xc9, 0xc9, 0x63, 0xbc, 0xa5, 0x3b, 0xd0, 0xa2,
+	0x0b, 0x87, 0x37, 0xe8, 0x3f, 0x0d, 0xd3, 0xa6, 0xea, 0xe8, 0xec, 0xf4, 0xe7, 0xae, 0x13, 0x1a,
+	0x85, 0x1f, 0xb7, 0xd4, 0x95, 0x4b, 0x9c, 0x67, 0x18, 0xff, 0x6d, 0xd9, 0x16, 0xbf, 0x43, 0xcd,
+	0x7c, 0x68, 0x3d, 0x1f, 0x6a, 0xc6, 0x6c, 0xe7, 0x43, 0x5f, 0xb1, 0x84, 0x5b, 0x6d, 0xd8, 0x50,
+	0xfa, 0x5f, 0x11, 0x76, 0xdb, 0x5c, 0x2c, 0xfe, 0x13, 0xbc, 0xad, 0x61, 0xea, 0xa6, 0xcf, 0x74,
+	0xe5, 0xb7, 0x12, 0xf2, 0x7c, 0x85, 0xb1, 0xa7, 0x19, 0xef, 0xfe, 0x97, 0xd1, 0x38, 0x37, 0x21,
+	0x0f, 0xbf, 0xf5, 0xf0, 0x96, 0x86, 0x24, 0x13, 0x84, 0x2f, 0x36, 0x1d, 0xc9, 0xde, 0x1a, 0xd0,
+	0xa6, 0x0d, 0xba, 0xfb, 0x5d, 0x52, 0x8d, 0xbb, 0xbf, 0xf7, 0xe9, 0xfb, 0xef, 0x2f, 0xbd, 0xdb,
+	0xe4, 0x16, 0xb4, 0xfe, 0x8f, 0x12, 0x3e, 0xd8, 0xed, 0x7f, 0x24, 0x9f, 0x11, 0xbe, 0xb4, 0x32,
+	0x3c, 0xd2, 0xc1, 0x68, 0xb9, 0x47, 0xf7, 0x7e, 0xa7, 0x5c, 0x4b, 0xb5, 0xab, 0xa9, 0x76, 0xc8,
+	0xd5, 0x0d, 0x54, 0x47, 0x2f, 0xa6, 0x73, 0x0f, 0xcd, 0xe6, 0x1e, 0xfa, 0x35, 0xf7, 0xd0, 0x64,
+	0xe1, 0x39, 0xb3, 0x85, 0xe7, 0xfc, 0x58, 0x78, 0xce, 0x5b, 0x48, 0x52, 0x75, 0x52, 0x46, 0x34,
+	0x16, 0x43, 0x50, 0xe2, 0x1d, 0xcf, 0xd2, 0xf7, 0xfc, 0x60, 0x04, 0x6a, 0x74, 0x10, 0x9f, 0xb0,
+	0x34, 0x83, 0xea, 0x11, 0x8c, 0x6c, 0x39, 0x35, 0xce, 0xb9, 0x8c, 0xb6, 0xf5, 0x93, 0x78, 0xf0,
+	0x67, 0x00, 0x71, 0x0b, 0x0c, 0x5a, 0xbf, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AddressLabel queries the label of the address.
+	AddressLabel(ctx context.Context, in *QueryAddressLabelRequest, opts ...grpc.CallOption) (*QueryAddressLabelResponse, error)
+	// AddressLabels queries the labels of all the addresses.
+	AddressLabels(ctx context.Context, in *QueryAddressLabelsRequest, opts ...grpc.CallOption) (*QueryAddressLabelsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AddressLabel(ctx context.Context, in *QueryAddressLabelRequest, opts ...grpc.CallOption) (*QueryAddressLabelResponse, error) {
+	out := new(QueryAddressLabelResponse)
+	err := c.cc.Invoke(ctx, "/coreum.label.v1.Query/AddressLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressLabels(ctx context.Context, in *QueryAddressLabelsRequest, opts ...grpc.CallOption) (*QueryAddressLabelsResponse, error) {
+	out := new(QueryAddressLabelsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.label.v1.Query/AddressLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AddressLabel queries the label of the address.
+	AddressLabel(context.Context, *QueryAddressLabelRequest) (*QueryAddressLabelResponse, error)
+	// AddressLabels queries the labels of all the addresses.
+	AddressLabels(context.Context, *QueryAddressLabelsRequest) (*QueryAddressLabelsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AddressLabel(ctx context.Context, req *QueryAddressLabelRequest) (*QueryAddressLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressLabel not implemented")
+}
+func (*UnimplementedQueryServer) AddressLabels(ctx context.Context, req *QueryAddressLabelsRequest) (*QueryAddressLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressLabels not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AddressLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.label.v1.Query/AddressLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressLabel(ctx, req.(*QueryAddressLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.label.v1.Query/AddressLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressLabels(ctx, req.(*QueryAddressLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.label.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddressLabel",
+			Handler:    _Query_AddressLabel_Handler,
+		},
+		{
+			MethodName: "AddressLabels",
+			Handler:    _Query_AddressLabels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/label/v1/query.proto",
+}
+
+func (m *QueryAddressLabelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressLabelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressLabelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressLabelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressLabelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressLabelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Label.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressLabelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressLabelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressLabelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressLabelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressLabelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressLabelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAddressLabelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressLabelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Label.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAddressLabelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressLabelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAddressLabelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressLabelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressLabelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressLabelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressLabelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Label.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressLabelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressLabelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressLabelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressLabelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressLabelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressLabelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, AddressLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,840 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/label/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgSetAddressLabels struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// labels are the labels to set.
+	Labels []AddressLabel `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels"`
+}
+
+func (m *MsgSetAddressLabels) Reset()         { *m = MsgSetAddressLabels{} }
+func (m *MsgSetAddressLabels) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAddressLabels) ProtoMessage()    {}
+func (*MsgSetAddressLabels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a44522d5ecbec3a9, []int{0}
+}
+func (m *MsgSetAddressLabels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAddressLabels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAddressLabels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAddressLabels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAddressLabels.Merge(m, src)
+}
+func (m *MsgSetAddressLabels) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAddressLabels) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAddressLabels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAddressLabels proto.InternalMessageInfo
+
+type MsgRemoveAddressLabels struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// addresses are the addresses whose labels are removed.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *MsgRemoveAddressLabels) Reset()         { *m = MsgRemoveAddressLabels{} }
+func (m *MsgRemoveAddressLabels) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAddressLabels) ProtoMessage()    {}
+func (*MsgRemoveAddressLabels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a44522d5ecbec3a9, []int{1}
+}
+func (m *MsgRemoveAddressLabels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAddressLabels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAddressLabels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAddressLabels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAddressLabels.Merge(m, src)
+}
+func (m *MsgRemoveAddressLabels) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAddressLabels) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAddressLabels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAddressLabels proto.InternalMessageInfo
+
+type EmptyResponse struct {
+}
+
+func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
+func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
+func (*EmptyResponse) ProtoMessage()    {}
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a44522d5ecbec3a9, []int{2}
+}
+func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmptyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmptyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyResponse.Merge(m, src)
+}
+func (m *EmptyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmptyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetAddressLabels)(nil), "coreum.label.v1.MsgSetAddressLabels")
+	proto.RegisterType((*MsgRemoveAddressLabels)(nil), "coreum.label.v1.MsgRemoveAddressLabels")
+	proto.RegisterType((*EmptyResponse)(nil), "coreum.label.v1.EmptyResponse")
+}
+
+func init() { proto.RegisterFile("coreum/label/v1/tx.proto", fileDescriptor_a44522d5ecbec3a9) }
+
+var fileDescriptor_a44522d5ecbec3a9 = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0xeb, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x16, 0x0b, 0x3d, 0x91, 0x6a, 0x5a, 0x34, 0x8d, 0x7a, 0x96, 0x22, 0x58, 0x8a,
+	0xcd, 0xd1, 0x0a, 0x0a, 0x9d, 0xb4, 0xe0, 0x66, 0x96, 0x74, 0x11, 0x07, 0x25, 0x4d, 0x8f, 0x6b,
+	0xb0, 0x97, 0x0b, 0xb9, 0x6b, 0x48, 0x9d, 0xc4, 0xd1, 0xc9, 0x3f, 0xc3, 0xb1, 0x83, 0x8b, 0x83,
+	0xab, 0x74, 0x2c, 0x4e, 0x4e, 0xa2, 0xed, 0xd0, 0x7f, 0x43, 0x92, 0x8b, 0x56, 0x9b, 0xe0, 0x6f,
+	0xf9, 0x2d, 0x21, 0x77, 0x9f, 0xef, 0x7b, 0xef, 0xfb, 0xde, 0x3d, 0x68, 0x78, 0x3c, 0x22, 0x4b,
+	0x86, 0x17, 0xee, 0x94, 0x2c, 0x70, 0x3c, 0xc0, 0x32, 0xb1, 0xc2, 0x88, 0x4b, 0xae, 0xd7, 0x15,
+	0xb1, 0x32, 0x62, 0xc5, 0x03, 0xf3, 0xaa, 0xcb, 0xfc, 0x80, 0xe3, 0xec, 0xab, 0x34, 0xe6, 0x8d,
+	0xd3, 0x68, 0x25, 0x56, 0xf0, 0xba, 0xc7, 0x05, 0xe3, 0x02, 0x33, 0x41, 0x53, 0xc4, 0x04, 0xcd,
+	0x41, 0x4b, 0x81, 0x97, 0xd9, 0x09, 0xab, 0x43, 0x8e, 0x9a, 0x94, 0x53, 0xae, 0xee, 0xd3, 0x3f,
+	0x75, 0xdb, 0xf9, 0x0c, 0x60, 0xc3, 0x16, 0x74, 0x42, 0xe4, 0xe3, 0xd9, 0x2c, 0x22, 0x42, 0x3c,
+	0x4d, 0xcb, 0x08, 0xfd, 0x01, 0xac, 0xb9, 0x4b, 0x39, 0xe7, 0x91, 0x2f, 0x57, 0x06, 0x68, 0x83,
+	0x6e, 0x6d, 0x6c, 0x7c, 0xfd, 0xd8, 0x6f, 0xe6, 0x29, 0x73, 0xf1, 0x44, 0x46, 0x7e, 0x40, 0x9d,
+	0xa3, 0x54, 0x7f, 0x04, 0xab, 0x99, 0x51, 0x61, 0x5c, 0x68, 0x57, 0xba, 0x97, 0x86, 0xb7, 0xac,
+	0x93, 0x5e, 0xad, 0xbf, 0xeb, 0x8c, 0x6b, 0x9b, 0xef, 0xb7, 0xb5, 0x0f, 0x87, 0x75, 0x0f, 0x38,
+	0x79, 0xdc, 0xe8, 0xde, 0xdb, 0xc3, 0xba, 0x77, 0xcc, 0xf8, 0xee, 0xb0, 0xee, 0xb5, 0xd4, 0x10,
+	0x4a, 0x7c, 0x76, 0x3e, 0x01, 0x78, 0xcd, 0x16, 0xd4, 0x21, 0x8c, 0xc7, 0xe4, 0x7c, 0x5a, 0x48,
+	0xe3, 0x14, 0x23, 0xaa, 0x8b, 0xff, 0xc7, 0xfd, 0x96, 0x8e, 0x70, 0xd1, 0xf8, 0xcd, 0x3f, 0xc6,
+	0x4b, 0x0c, 0x76, 0xea, 0xf0, 0xf2, 0x13, 0x16, 0xca, 0x95, 0x43, 0x44, 0xc8, 0x03, 0x41, 0x86,
+	0x5f, 0x00, 0xac, 0xd8, 0x82, 0xea, 0xcf, 0xe0, 0x95, 0xc2, 0x83, 0xdc, 0x29, 0x0c, 0xb2, 0x64,
+	0x1c, 0x26, 0x2a, 0xa8, 0xfe, 0xa9, 0xa0, 0xbf, 0x80, 0x8d, 0xb2, 0x51, 0xdd, 0x2d, 0x4b, 0x5e,
+	0x22, 0x3c, 0x2b, 0xbf, 0x79, 0xf1, 0x4d, 0xfa, 0x96, 0x63, 0x7b, 0xf3, 0x13, 0x69, 0x9b, 0x1d,
+	0x02, 0xdb, 0x1d, 0x02, 0x3f, 0x76, 0x08, 0xbc, 0xdf, 0x23, 0x6d, 0xbb, 0x47, 0xda, 0xb7, 0x3d,
+	0xd2, 0x9e, 0x63, 0xea, 0xcb, 0xf9, 0x72, 0x6a, 0x79, 0x9c, 0x61, 0xc9, 0x5f, 0x91, 0xc0, 0x7f,
+	0x4d, 0xfa, 0x09, 0x96, 0x49, 0xdf, 0x9b, 0xbb, 0x7e, 0x80, 0xe3, 0x87, 0x38, 0xc9, 0xf7, 0x5e,
+	0xae, 0x42, 0x22, 0xa6, 0xd5, 0x6c, 0x57, 0xef, 0xff, 0x1a, 0x00, 0xb7, 0x27, 0xf2, 0x31, 0x52,
+	0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetAddressLabels is a governance operation to create or overwrite the labels of the addresses.
+	SetAddressLabels(ctx context.Context, in *MsgSetAddressLabels, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// RemoveAddressLabels is a governance operation to remove the labels of the addresses.
+	RemoveAddressLabels(ctx context.Context, in *MsgRemoveAddressLabels, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetAddressLabels(ctx context.Context, in *MsgSetAddressLabels, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.label.v1.Msg/SetAddressLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAddressLabels(ctx context.Context, in *MsgRemoveAddressLabels, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.label.v1.Msg/RemoveAddressLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetAddressLabels is a governance operation to create or overwrite the labels of the addresses.
+	SetAddressLabels(context.Context, *MsgSetAddressLabels) (*EmptyResponse, error)
+	// RemoveAddressLabels is a governance operation to remove the labels of the addresses.
+	RemoveAddressLabels(context.Context, *MsgRemoveAddressLabels) (*EmptyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetAddressLabels(ctx context.Context, req *MsgSetAddressLabels) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressLabels not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAddressLabels(ctx context.Context, req *MsgRemoveAddressLabels) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAddressLabels not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetAddressLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAddressLabels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAddressLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.label.v1.Msg/SetAddressLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAddressLabels(ctx, req.(*MsgSetAddressLabels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAddressLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAddressLabels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAddressLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.label.v1.Msg/RemoveAddressLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAddressLabels(ctx, req.(*MsgRemoveAddressLabels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.label.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAddressLabels",
+			Handler:    _Msg_SetAddressLabels_Handler,
+		},
+		{
+			MethodName: "RemoveAddressLabels",
+			Handler:    _Msg_RemoveAddressLabels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/label/v1/tx.proto",
+}
+
+func (m *MsgSetAddressLabels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAddressLabels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAddressLabels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAddressLabels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAddressLabels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAddressLabels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAddressLabels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveAddressLabels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAddressLabels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAddressLabels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAddressLabels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, AddressLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAddressLabels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAddressLabels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAddressLabels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmptyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmptyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+// FlagNonce is the flag setting the nonce of the meta-transaction.
+const FlagNonce = "nonce"
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      types.ModuleName + " transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdSignMetaTx(),
+		CmdExecuteMetaTx(),
+	)
+
+	return cmd
+}
+
+// CmdSignMetaTx returns the command signing the meta-transaction.
+func CmdSignMetaTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [tx_file] --from [signer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sign the messages of the transaction as the meta-transaction executed by the relayer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign the messages of the transaction generated with --generate-only as the meta-transaction.
+The signed meta-transaction is printed to be passed to the relayer. The nonce is queried from the chain if the
+--%s flag is not set. The --%s flag sets the height after which the meta-transaction can't be executed.
+
+Example:
+$ %s tx bank send [signer] [recipient] 100ucore --generate-only > tx.json
+$ %s tx %s sign tx.json --from [signer] > meta-tx.json
+`,
+				FlagNonce, flags.FlagTimeoutHeight, version.AppName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			messages := make([]*codectypes.Any, 0, len(theTx.GetMsgs()))
+			for _, msg := range theTx.GetMsgs() {
+				msgAny, err := codectypes.NewAnyWithValue(msg)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				messages = append(messages, msgAny)
+			}
+
+			timeoutHeight, err := cmd.Flags().GetUint64(flags.FlagTimeoutHeight)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			nonce, err := cmd.Flags().GetUint64(FlagNonce)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if !cmd.Flags().Changed(FlagNonce) {
+				res, err := types.NewQueryClient(clientCtx).Nonce(cmd.Context(), &types.QueryNonceRequest{
+					Address: clientCtx.GetFromAddress().String(),
+				})
+				if err != nil {
+					return errors.Wrap(err, "failed to query the nonce")
+				}
+				nonce = res.Nonce
+			}
+
+			msg := &types.MsgExecuteMetaTx{
+				Signer:        clientCtx.GetFromAddress().String(),
+				Nonce:         nonce,
+				TimeoutHeight: timeoutHeight,
+				Messages:      messages,
+			}
+			signBytes, err := msg.GetSignDoc(clientCtx.ChainID).GetSignBytes()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			signature, pubKey, err := clientCtx.Keyring.Sign(
+				clientCtx.FromName, signBytes, signing.SignMode_SIGN_MODE_DIRECT,
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to sign the meta-transaction")
+			}
+			if msg.PubKey, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+				return errors.WithStack(err)
+			}
+			msg.Signature = signature
+
+			return clientCtx.PrintProto(msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagNonce, 0, "Nonce of the meta-transaction, queried from the chain if not set")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdExecuteMetaTx returns ExecuteMetaTx cobra command.
+func CmdExecuteMetaTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [meta_tx_file] --from [relayer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Execute the signed meta-transaction paying the fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the meta-transaction signed with the sign command. The relayer pays the fees and the
+messages are executed as the signer of the meta-transaction.
+
+Example:
+$ %s tx %s execute meta-tx.json --from [relayer]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			msg := &types.MsgExecuteMetaTx{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return errors.Wrap(err, "failed to decode the meta-transaction")
+			}
+			msg.Relayer = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
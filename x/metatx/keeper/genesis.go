@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+// InitGenesis initializes the metatx module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, nonce := range genState.Nonces {
+		addr, err := sdk.AccAddressFromBech32(nonce.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.Nonces.Set(ctx, addr, nonce.Nonce); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the metatx module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis := &types.GenesisState{
+		Params: params,
+		Nonces: []types.AccountNonce{},
+	}
+	if err := k.Nonces.Walk(ctx, nil, func(addr sdk.AccAddress, nonce uint64) (bool, error) {
+		genesis.Nonces = append(genesis.Nonces, types.AccountNonce{
+			Address: addr.String(),
+			Nonce:   nonce,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+func TestGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	signer1, _ := testApp.GenAccount(ctx)
+	signer2, _ := testApp.GenAccount(ctx)
+	genState := types.GenesisState{
+		Params: types.Params{
+			Enabled:           true,
+			AllowedMsgTypes:   []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			AllowedSendDenoms: []string{"ufttoken"},
+		},
+		Nonces: []types.AccountNonce{
+			{Address: signer1.String(), Nonce: 3},
+			{Address: signer2.String(), Nonce: 1},
+		},
+	}
+	requireT.NoError(genState.Validate())
+
+	requireT.NoError(testApp.MetaTxKeeper.InitGenesis(ctx, genState))
+	exported, err := testApp.MetaTxKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState.Params, exported.Params)
+	requireT.ElementsMatch(genState.Nonces, exported.Nonces)
+
+	// the duplicated nonces are rejected
+	genState.Nonces = append(genState.Nonces, types.AccountNonce{Address: signer1.String(), Nonce: 5})
+	requireT.ErrorIs(genState.Validate(), types.ErrInvalidInput)
+}
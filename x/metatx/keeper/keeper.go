@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdkstore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	authority    string
+
+	// codec
+	cdc codec.Codec
+
+	// keepers
+	accountKeeper types.AccountKeeper
+	msgRouter     types.MsgRouter
+
+	// collections
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	Nonces collections.Map[sdk.AccAddress, uint64]
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.Codec,
+	authority string,
+	accountKeeper types.AccountKeeper,
+	msgRouter types.MsgRouter,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService:  storeService,
+		cdc:           cdc,
+		authority:     authority,
+		accountKeeper: accountKeeper,
+		msgRouter:     msgRouter,
+
+		Params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			codec.CollValue[types.Params](cdc),
+		),
+		Nonces: collections.NewMap(
+			sb,
+			types.NoncesKey,
+			"nonces",
+			sdk.AccAddressKey,
+			collections.Uint64Value,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
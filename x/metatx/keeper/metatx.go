@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+// ExecuteMetaTx verifies the signature and the nonce of the meta-transaction and executes its messages as the
+// signer. The messages must be allowed by the params and signed by the signer only. The fees are paid by the relayer
+// submitting the transaction, so the signer doesn't need to hold the fee denom.
+func (k Keeper) ExecuteMetaTx(ctx context.Context, msg *types.MsgExecuteMetaTx) ([][]byte, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.Enabled {
+		return nil, types.ErrMetaTxDisabled
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	//nolint:gosec // the block height is never negative
+	if msg.TimeoutHeight != 0 && uint64(sdkCtx.BlockHeight()) > msg.TimeoutHeight {
+		return nil, errorsmod.Wrapf(
+			types.ErrMetaTxExpired, "timeout height %d, current height %d", msg.TimeoutHeight, sdkCtx.BlockHeight(),
+		)
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, cosmoserrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+	nonce, err := k.GetNonce(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Nonce != nonce {
+		return nil, errorsmod.Wrapf(types.ErrInvalidNonce, "expected %d, got %d", nonce, msg.Nonce)
+	}
+
+	pubKey, err := msg.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	// the key is checked by ValidateBasic too, but the signature must never be verified against the foreign key
+	if !sdk.AccAddress(pubKey.Address()).Equals(signer) {
+		return nil, errorsmod.Wrap(types.ErrInvalidInput, "public key doesn't match the signer address")
+	}
+	signBytes, err := msg.GetSignDoc(sdkCtx.ChainID()).GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx.GasMeter().ConsumeGas(authtypes.DefaultSigVerifyCostSecp256k1, "meta-tx signature verification")
+	if !pubKey.VerifySignature(signBytes, msg.Signature) {
+		return nil, types.ErrInvalidSignature
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+	for i, innerMsg := range msgs {
+		if err := params.ValidateMsgAllowed(innerMsg); err != nil {
+			return nil, err
+		}
+		signers, _, err := k.cdc.GetMsgV1Signers(innerMsg)
+		if err != nil {
+			return nil, err
+		}
+		if len(signers) != 1 || !bytes.Equal(signers[0], signer) {
+			return nil, errorsmod.Wrapf(
+				cosmoserrors.ErrUnauthorized, "message %d must be signed by the meta-transaction signer only", i,
+			)
+		}
+	}
+
+	if err := k.Nonces.Set(ctx, signer, nonce+1); err != nil {
+		return nil, err
+	}
+
+	// the account of the signer is created with the public key, so the signer might sign the transactions later
+	acc := k.accountKeeper.GetAccount(ctx, signer)
+	if acc == nil {
+		acc = k.accountKeeper.NewAccountWithAddress(ctx, signer)
+	}
+	if acc.GetPubKey() == nil {
+		if err := acc.SetPubKey(pubKey); err != nil {
+			return nil, err
+		}
+		k.accountKeeper.SetAccount(ctx, acc)
+	}
+
+	results := make([][]byte, 0, len(msgs))
+	msgTypes := make([]string, 0, len(msgs))
+	for i, innerMsg := range msgs {
+		handler := k.msgRouter.Handler(innerMsg)
+		if handler == nil {
+			return nil, errorsmod.Wrapf(cosmoserrors.ErrUnknownRequest, "no message handler found for %T", innerMsg)
+		}
+		res, err := handler(sdkCtx, innerMsg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message %d", i)
+		}
+		sdkCtx.EventManager().EmitEvents(res.GetEvents())
+		results = append(results, res.Data)
+		msgTypes = append(msgTypes, sdk.MsgTypeURL(innerMsg))
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventMetaTxExecuted{
+		Relayer:  msg.Relayer,
+		Signer:   msg.Signer,
+		Nonce:    msg.Nonce,
+		MsgTypes: msgTypes,
+	}); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetNonce returns the nonce of the signer expected by the next meta-transaction.
+func (k Keeper) GetNonce(ctx context.Context, signer sdk.AccAddress) (uint64, error) {
+	nonce, err := k.Nonces.Get(ctx, signer)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return nonce, err
+}
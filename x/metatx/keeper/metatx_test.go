@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/metatx/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+func newMetaTx(
+	t *testing.T,
+	ctx sdk.Context,
+	relayer sdk.AccAddress,
+	signerKey *secp256k1.PrivKey,
+	nonce uint64,
+	msgs ...sdk.Msg,
+) *types.MsgExecuteMetaTx {
+	requireT := require.New(t)
+
+	pubKey, err := codectypes.NewAnyWithValue(signerKey.PubKey())
+	requireT.NoError(err)
+	msg := &types.MsgExecuteMetaTx{
+		Relayer: relayer.String(),
+		Signer:  sdk.AccAddress(signerKey.PubKey().Address()).String(),
+		PubKey:  pubKey,
+		Nonce:   nonce,
+	}
+	for _, m := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(m)
+		requireT.NoError(err)
+		msg.Messages = append(msg.Messages, msgAny)
+	}
+	signBytes, err := msg.GetSignDoc(ctx.ChainID()).GetSignBytes()
+	requireT.NoError(err)
+	msg.Signature, err = signerKey.Sign(signBytes)
+	requireT.NoError(err)
+	requireT.NoError(msg.ValidateBasic())
+
+	return msg
+}
+
+//nolint:funlen // the test covers all the rejection cases
+func TestExecuteMetaTx(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithChainID("test-chain").WithBlockHeight(10)
+	msgServer := keeper.NewMsgServer(testApp.MetaTxKeeper)
+	queryService := keeper.NewQueryService(testApp.MetaTxKeeper)
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+	const ftDenom = "ufttoken"
+
+	// the signer has the FT tokens only and no account public key
+	relayer, _ := testApp.GenAccount(ctx)
+	signerKey := secp256k1.GenPrivKey()
+	signer := sdk.AccAddress(signerKey.PubKey().Address())
+	recipient, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, signer, sdk.NewCoins(
+		sdk.NewInt64Coin(ftDenom, 1_000),
+		sdk.NewInt64Coin(bondDenom, 1_000),
+	)))
+
+	send := func(denom string) *banktypes.MsgSend {
+		return &banktypes.MsgSend{
+			FromAddress: signer.String(),
+			ToAddress:   recipient.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+		}
+	}
+
+	// the meta-transactions are disabled by default
+	_, err = msgServer.ExecuteMetaTx(ctx, newMetaTx(t, ctx, relayer, signerKey, 0, send(ftDenom)))
+	requireT.ErrorIs(err, types.ErrMetaTxDisabled)
+
+	requireT.NoError(testApp.MetaTxKeeper.UpdateParams(ctx, testApp.GovAuthority(), types.Params{
+		Enabled:           true,
+		AllowedMsgTypes:   []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		AllowedSendDenoms: []string{ftDenom},
+	}))
+
+	// the relayer pays the fees, so only the meta-transaction is executed and the signer keeps the bond denom
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ExecuteMetaTx(ctx, newMetaTx(t, ctx, relayer, signerKey, 0, send(ftDenom), send(ftDenom)))
+	requireT.NoError(err)
+	requireT.Equal("800", testApp.BankKeeper.GetBalance(ctx, signer, ftDenom).Amount.String())
+	requireT.Equal("200", testApp.BankKeeper.GetBalance(ctx, recipient, ftDenom).Amount.String())
+	requireT.Equal("1000", testApp.BankKeeper.GetBalance(ctx, signer, bondDenom).Amount.String())
+	requireT.NotNil(testApp.AccountKeeper.GetAccount(ctx, signer).GetPubKey())
+
+	events, err := event.FindTypedEvents[*types.EventMetaTxExecuted](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(events, 1)
+	requireT.Equal(relayer.String(), events[0].Relayer)
+	requireT.Equal(signer.String(), events[0].Signer)
+	requireT.Len(events[0].MsgTypes, 2)
+
+	nonceRes, err := queryService.Nonce(ctx, &types.QueryNonceRequest{Address: signer.String()})
+	requireT.NoError(err)
+	requireT.EqualValues(1, nonceRes.Nonce)
+
+	// the meta-transaction can't be replayed
+	_, err = msgServer.ExecuteMetaTx(ctx, newMetaTx(t, ctx, relayer, signerKey, 0, send(ftDenom)))
+	requireT.ErrorIs(err, types.ErrInvalidNonce)
+
+	// the signature must be created by the signer for the chain
+	metaTx := newMetaTx(t, ctx, relayer, signerKey, 1, send(ftDenom))
+	_, err = msgServer.ExecuteMetaTx(ctx.WithChainID("other-chain"), metaTx)
+	requireT.ErrorIs(err, types.ErrInvalidSignature)
+	metaTx.Messages = newMetaTx(t, ctx, relayer, signerKey, 1, send(bondDenom)).Messages
+	_, err = msgServer.ExecuteMetaTx(ctx, metaTx)
+	requireT.ErrorIs(err, types.ErrInvalidSignature)
+
+	// the denoms and the message types must be allowed
+	_, err = msgServer.ExecuteMetaTx(ctx, newMetaTx(t, ctx, relayer, signerKey, 1, send(bondDenom)))
+	requireT.ErrorIs(err, types.ErrMsgNotAllowed)
+	_, err = msgServer.ExecuteMetaTx(ctx, newMetaTx(t, ctx, relayer, signerKey, 1, &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(signer, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100)))},
+		Outputs: []banktypes.Output{banktypes.NewOutput(recipient, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100)))},
+	}))
+	requireT.ErrorIs(err, types.ErrMsgNotAllowed)
+
+	// the messages must be signed by the signer of the meta-transaction
+	_, err = msgServer.ExecuteMetaTx(ctx, newMetaTx(t, ctx, relayer, signerKey, 1, &banktypes.MsgSend{
+		FromAddress: recipient.String(),
+		ToAddress:   signer.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100)),
+	}))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the expired meta-transaction is rejected
+	metaTx = newMetaTx(t, ctx, relayer, signerKey, 1, send(ftDenom))
+	metaTx.TimeoutHeight = 9
+	signBytes, err := metaTx.GetSignDoc(ctx.ChainID()).GetSignBytes()
+	requireT.NoError(err)
+	metaTx.Signature, err = signerKey.Sign(signBytes)
+	requireT.NoError(err)
+	_, err = msgServer.ExecuteMetaTx(ctx, metaTx)
+	requireT.ErrorIs(err, types.ErrMetaTxExpired)
+	_, err = msgServer.ExecuteMetaTx(ctx.WithBlockHeight(9), metaTx)
+	requireT.NoError(err)
+
+	// the meta-transactions can't be nested
+	nested := newMetaTx(t, ctx, relayer, signerKey, 2, send(ftDenom))
+	nestedAny, err := codectypes.NewAnyWithValue(nested)
+	requireT.NoError(err)
+	metaTx.Messages = []*codectypes.Any{nestedAny}
+	requireT.ErrorIs(metaTx.ValidateBasic(), types.ErrMsgNotAllowed)
+	requireT.ErrorIs(types.Params{
+		AllowedMsgTypes: []string{sdk.MsgTypeURL(&types.MsgExecuteMetaTx{})},
+	}.ValidateBasic(), types.ErrInvalidInput)
+}
+
+func TestExecuteMetaTx_PubKeyMismatch(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithChainID("test-chain").WithBlockHeight(10)
+	msgServer := keeper.NewMsgServer(testApp.MetaTxKeeper)
+
+	const ftDenom = "ufttoken"
+	requireT.NoError(testApp.MetaTxKeeper.UpdateParams(ctx, testApp.GovAuthority(), types.Params{
+		Enabled:           true,
+		AllowedMsgTypes:   []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		AllowedSendDenoms: []string{ftDenom},
+	}))
+
+	relayer, _ := testApp.GenAccount(ctx)
+	victim := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, victim, sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 1_000))))
+
+	// the attacker signs the meta-transaction of the victim with its own key
+	attackerKey := secp256k1.GenPrivKey()
+	attacker := sdk.AccAddress(attackerKey.PubKey().Address())
+	metaTx := newMetaTx(t, ctx, relayer, attackerKey, 0, &banktypes.MsgSend{
+		FromAddress: attacker.String(),
+		ToAddress:   attacker.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100)),
+	})
+	metaTx.Signer = victim.String()
+	metaTx.Messages = newMetaTx(t, ctx, relayer, attackerKey, 0, &banktypes.MsgSend{
+		FromAddress: victim.String(),
+		ToAddress:   attacker.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(ftDenom, 100)),
+	}).Messages
+	signBytes, err := metaTx.GetSignDoc(ctx.ChainID()).GetSignBytes()
+	requireT.NoError(err)
+	metaTx.Signature, err = attackerKey.Sign(signBytes)
+	requireT.NoError(err)
+
+	// the message is rejected by the keeper even if ValidateBasic is skipped
+	requireT.ErrorIs(metaTx.ValidateBasic(), types.ErrInvalidInput)
+	_, err = msgServer.ExecuteMetaTx(ctx, metaTx)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	requireT.Equal("1000", testApp.BankKeeper.GetBalance(ctx, victim, ftDenom).Amount.String())
+	if acc := testApp.AccountKeeper.GetAccount(ctx, victim); acc != nil {
+		requireT.Nil(acc.GetPubKey())
+	}
+}
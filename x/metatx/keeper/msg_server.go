@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tokenize-x/tx-chain/v7/x/metatx/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// UpdateParams is a governance operation that sets parameters of the module.
+func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateParams(ctx, req.Authority, req.Params); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// ExecuteMetaTx executes the messages of the meta-transaction as its signer.
+func (ms MsgServer) ExecuteMetaTx(
+	ctx context.Context,
+	req *types.MsgExecuteMetaTx,
+) (*types.MsgExecuteMetaTxResponse, error) {
+	results, err := ms.keeper.ExecuteMetaTx(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgExecuteMetaTxResponse{Results: results}, nil
+}
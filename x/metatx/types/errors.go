@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidAuthority is returned when the authority is invalid.
+	ErrInvalidAuthority = sdkerrors.Register(ModuleName, 2, "invalid authority")
+
+	// ErrInvalidInput is returned when input validation fails.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 3, "invalid input")
+
+	// ErrMetaTxDisabled is returned when the meta-transaction is submitted while they are disabled.
+	ErrMetaTxDisabled = sdkerrors.Register(ModuleName, 4, "meta-transactions are disabled")
+
+	// ErrInvalidSignature is returned when the signature of the meta-transaction is invalid.
+	ErrInvalidSignature = sdkerrors.Register(ModuleName, 5, "invalid meta-transaction signature")
+
+	// ErrInvalidNonce is returned when the nonce of the meta-transaction isn't the one expected by the chain.
+	ErrInvalidNonce = sdkerrors.Register(ModuleName, 6, "invalid meta-transaction nonce")
+
+	// ErrMetaTxExpired is returned when the meta-transaction is executed after its timeout height.
+	ErrMetaTxExpired = sdkerrors.Register(ModuleName, 7, "meta-transaction expired")
+
+	// ErrMsgNotAllowed is returned when the message isn't allowed to be executed by the meta-transaction.
+	ErrMsgNotAllowed = sdkerrors.Register(ModuleName, 8, "message not allowed")
+)
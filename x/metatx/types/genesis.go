@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Nonces: []AccountNonce{},
+	}
+}
+
+// Validate validates genesis parameters.
+func (m GenesisState) Validate() error {
+	if err := m.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	addresses := make(map[string]struct{}, len(m.Nonces))
+	for _, nonce := range m.Nonces {
+		if _, err := sdk.AccAddressFromBech32(nonce.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid address %q: %s", nonce.Address, err)
+		}
+		if _, found := addresses[nonce.Address]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate nonce of address %s", nonce.Address)
+		}
+		addresses[nonce.Address] = struct{}{}
+	}
+
+	return nil
+}
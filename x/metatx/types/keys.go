@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "metatx"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// KVStore keys.
+var (
+	ParamsKey = collections.NewPrefix(0)
+	NoncesKey = collections.NewPrefix(1) // Map: signer -> next nonce
+)
@@ -0,0 +1,6 @@
+package types
+
+// GetSignBytes returns the bytes signed by the signer of the meta-transaction.
+func (d SignDoc) GetSignBytes() ([]byte, error) {
+	return d.Marshal()
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+type extendedMsg interface {
+	sdk.Msg
+	sdk.HasValidateBasic
+}
+
+var (
+	_ extendedMsg = &MsgUpdateParams{}
+	_ extendedMsg = &MsgExecuteMetaTx{}
+
+	_ cdctypes.UnpackInterfacesMessage = &MsgExecuteMetaTx{}
+)
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return m.Params.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgExecuteMetaTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Relayer); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid relayer address: %s", err)
+	}
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	pubKey, err := m.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if !signer.Equals(sdk.AccAddress(pubKey.Address())) {
+		return errorsmod.Wrap(ErrInvalidInput, "public key doesn't match the signer address")
+	}
+	if len(m.Signature) == 0 {
+		return errorsmod.Wrap(ErrInvalidSignature, "signature must not be empty")
+	}
+
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "messages must not be empty")
+	}
+	for _, msg := range msgs {
+		if _, ok := msg.(*MsgExecuteMetaTx); ok {
+			return errorsmod.Wrap(ErrMsgNotAllowed, "meta-transactions can't be nested")
+		}
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetPubKey returns the public key of the signer.
+func (m *MsgExecuteMetaTx) GetPubKey() (cryptotypes.PubKey, error) {
+	if m.PubKey == nil {
+		return nil, errorsmod.Wrap(ErrInvalidInput, "public key must be set")
+	}
+	pubKey, ok := m.PubKey.GetCachedValue().(*secp256k1.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidInput, "public key must be secp256k1, got %s", m.PubKey.TypeUrl)
+	}
+	return pubKey, nil
+}
+
+// GetMessages returns the messages executed as the signer.
+func (m *MsgExecuteMetaTx) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "MsgExecuteMetaTx")
+}
+
+// GetSignDoc returns the document signed by the signer of the meta-transaction.
+func (m *MsgExecuteMetaTx) GetSignDoc(chainID string) SignDoc {
+	return SignDoc{
+		ChainId:       chainID,
+		Signer:        m.Signer,
+		Nonce:         m.Nonce,
+		TimeoutHeight: m.TimeoutHeight,
+		Messages:      m.Messages,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (m *MsgExecuteMetaTx) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(m.PubKey, &pubKey); err != nil {
+		return err
+	}
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
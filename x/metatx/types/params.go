@@ -0,0 +1,74 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/samber/lo"
+)
+
+// DefaultParams returns params with default values. The meta-transactions are disabled by default and no messages
+// are allowed.
+func DefaultParams() Params {
+	return Params{
+		Enabled:           false,
+		AllowedMsgTypes:   []string{},
+		AllowedSendDenoms: []string{},
+	}
+}
+
+// ValidateBasic validates the params.
+func (p Params) ValidateBasic() error {
+	msgTypes := make(map[string]struct{}, len(p.AllowedMsgTypes))
+	for _, msgType := range p.AllowedMsgTypes {
+		if msgType == "" {
+			return errorsmod.Wrap(ErrInvalidInput, "allowed message type must not be empty")
+		}
+		if msgType == sdk.MsgTypeURL(&MsgExecuteMetaTx{}) {
+			return errorsmod.Wrap(ErrInvalidInput, "meta-transactions can't be nested")
+		}
+		if _, found := msgTypes[msgType]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate allowed message type %s", msgType)
+		}
+		msgTypes[msgType] = struct{}{}
+	}
+
+	denoms := make(map[string]struct{}, len(p.AllowedSendDenoms))
+	for _, denom := range p.AllowedSendDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid allowed send denom %q: %s", denom, err)
+		}
+		if _, found := denoms[denom]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate allowed send denom %s", denom)
+		}
+		denoms[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateMsgAllowed checks that the message might be executed by the meta-transaction. The bank send messages might
+// transfer the allowed denoms only.
+func (p Params) ValidateMsgAllowed(msg sdk.Msg) error {
+	msgType := sdk.MsgTypeURL(msg)
+	if !lo.Contains(p.AllowedMsgTypes, msgType) {
+		return errorsmod.Wrapf(ErrMsgNotAllowed, "message type %s is not allowed", msgType)
+	}
+
+	var coins sdk.Coins
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		coins = m.Amount
+	case *banktypes.MsgMultiSend:
+		for _, input := range m.Inputs {
+			coins = coins.Add(input.Coins...)
+		}
+	}
+	for _, coin := range coins {
+		if !lo.Contains(p.AllowedSendDenoms, coin.Denom) {
+			return errorsmod.Wrapf(ErrMsgNotAllowed, "denom %s is not allowed to be sent", coin.Denom)
+		}
+	}
+
+	return nil
+}
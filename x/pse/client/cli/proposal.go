@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/proposal"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// CmdDraftDistributionScheduleProposal returns the command drafting the MsgUpdateDistributionSchedule proposal.
+func CmdDraftDistributionScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft-pse-schedule",
+		Args:  cobra.NoArgs,
+		Short: "Draft the proposal replacing the pse distribution schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Draft the proposal replacing the pse distribution schedule.
+The command prompts for the timestamp of each scheduled distribution and the allocation of every clearing account,
+validates the schedule and writes the proposal ready to be submitted with the "tx gov submit-proposal" command.
+
+Example:
+$ %s tx gov draft-pse-schedule --%s=schedule-proposal.json
+`,
+				version.AppName, proposal.FlagProposalFile,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			inBuf := bufio.NewReader(clientCtx.Input)
+
+			schedule, err := promptDistributionSchedule(inBuf)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateDistributionSchedule{
+				Authority: proposal.Authority(),
+				Schedule:  schedule,
+			}
+
+			return proposal.Draft(cmd, inBuf, clientCtx.Codec, msg)
+		},
+	}
+
+	proposal.AddFlags(cmd)
+
+	return cmd
+}
+
+func promptDistributionSchedule(inBuf *bufio.Reader) ([]types.ScheduledDistribution, error) {
+	var schedule []types.ScheduledDistribution
+	for {
+		value, err := proposal.PromptString(
+			inBuf,
+			fmt.Sprintf("Enter timestamp of distribution %d (unix seconds, empty to finish)", len(schedule)+1),
+			"",
+		)
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			return schedule, nil
+		}
+		timestamp, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timestamp %q", value)
+		}
+
+		clearingAccounts := types.GetAllClearingAccounts()
+		allocations := make([]types.ClearingAccountAllocation, 0, len(clearingAccounts))
+		for _, clearingAccount := range clearingAccounts {
+			value, err := proposal.PromptString(
+				inBuf, fmt.Sprintf("Enter %s allocation amount", clearingAccount), "",
+			)
+			if err != nil {
+				return nil, err
+			}
+			amount, ok := sdkmath.NewIntFromString(value)
+			if !ok {
+				return nil, errors.Errorf("invalid %s allocation amount %q", clearingAccount, value)
+			}
+			allocations = append(allocations, types.ClearingAccountAllocation{
+				ClearingAccount: clearingAccount,
+				Amount:          amount,
+			})
+		}
+
+		schedule = append(schedule, types.ScheduledDistribution{
+			Timestamp:   timestamp,
+			Allocations: allocations,
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/proposal"
+	"github.com/tokenize-x/tx-chain/v7/testutil/network"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/client/cli"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+func TestDraftDistributionScheduleProposal(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	clearingAccounts := types.GetAllClearingAccounts()
+	periodInput := func(timestamp uint64) []string {
+		lines := []string{fmt.Sprint(timestamp)}
+		for i := range clearingAccounts {
+			lines = append(lines, fmt.Sprint(1000*(i+1)))
+		}
+		return lines
+	}
+
+	lines := append(periodInput(1900000000), periodInput(1902592000)...)
+	lines = append(lines, "", "Update schedule", "Schedule for 2030", "", "1000udevcore", "n")
+
+	path := filepath.Join(t.TempDir(), "proposal.json")
+	inputCtx := ctx.WithInput(strings.NewReader(strings.Join(lines, "\n") + "\n"))
+	_, err := clitestutil.ExecTestCLICmd(
+		inputCtx, cli.CmdDraftDistributionScheduleProposal(), []string{fmt.Sprintf("--%s=%s", proposal.FlagProposalFile, path)},
+	)
+	requireT.NoError(err)
+
+	raw, err := os.ReadFile(path)
+	requireT.NoError(err)
+	var draft proposal.Proposal
+	requireT.NoError(json.Unmarshal(raw, &draft))
+	requireT.Equal("Update schedule", draft.Title)
+	requireT.Equal("Schedule for 2030", draft.Summary)
+	requireT.Equal("1000udevcore", draft.Deposit)
+	requireT.False(draft.Expedited)
+	requireT.Len(draft.Messages, 1)
+
+	var msg sdk.Msg
+	requireT.NoError(ctx.Codec.UnmarshalInterfaceJSON(draft.Messages[0], &msg))
+	scheduleMsg, ok := msg.(*types.MsgUpdateDistributionSchedule)
+	requireT.True(ok)
+	requireT.Equal(proposal.Authority(), scheduleMsg.Authority)
+	requireT.Len(scheduleMsg.Schedule, 2)
+	requireT.EqualValues(1902592000, scheduleMsg.Schedule[1].Timestamp)
+	requireT.Len(scheduleMsg.Schedule[1].Allocations, len(clearingAccounts))
+	requireT.Equal(clearingAccounts[1], scheduleMsg.Schedule[1].Allocations[1].ClearingAccount)
+	requireT.Equal(sdkmath.NewInt(2000).String(), scheduleMsg.Schedule[1].Allocations[1].Amount.String())
+
+	// unsorted schedule is rejected
+	lines = append(periodInput(1902592000), periodInput(1900000000)...)
+	lines = append(lines, "", "Update schedule", "Schedule for 2030", "", "1000udevcore", "n")
+	inputCtx = ctx.WithInput(strings.NewReader(strings.Join(lines, "\n") + "\n"))
+	_, err = clitestutil.ExecTestCLICmd(inputCtx, cli.CmdDraftDistributionScheduleProposal(), []string{
+		fmt.Sprintf("--%s=%s", proposal.FlagProposalFile, filepath.Join(t.TempDir(), "invalid.json")),
+	})
+	requireT.ErrorIs(err, types.ErrInvalidParam)
+}
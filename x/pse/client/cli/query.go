@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -12,6 +14,9 @@ import (
 	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
 )
 
+// FlagPageLimit is the flag defining the number of the items queried in one page.
+const FlagPageLimit = "page-limit"
+
 // GetQueryCmd returns the parent command for all CLI query commands. The
 // provided clientCtx should have, at a minimum, a verifier, Tendermint RPC client,
 // and marshaler set.
@@ -26,8 +31,17 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryScore())
+	cmd.AddCommand(CmdQueryScoresSnapshot())
 	cmd.AddCommand(CmdQueryScheduledDistributions())
 	cmd.AddCommand(CmdQueryClearingAccountBalances())
+	cmd.AddCommand(CmdQueryClearingAccountStatus())
+	cmd.AddCommand(CmdQueryRemainingUnlocks())
+	cmd.AddCommand(CmdQueryScoreCheckpoint())
+	cmd.AddCommand(CmdQueryNamedSchedules())
+	cmd.AddCommand(CmdQueryNamedSchedule())
+	cmd.AddCommand(CmdQueryDistributionPreference())
+	cmd.AddCommand(CmdQueryDistributionOptOuts())
+	cmd.AddCommand(CmdQueryDistributionOptOut())
 
 	return cmd
 }
@@ -98,6 +112,72 @@ func CmdQueryScore() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryScoresSnapshot implements a command to export the scores of all the accounts.
+func CmdQueryScoresSnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scores-snapshot",
+		Short: "Export the scores of all the accounts at the pinned height",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the current total scores of all the accounts of the %s module.
+The scores are streamed page by page as JSON lines. All the pages are queried at the same height, which is the
+latest one unless the --%s flag is set, so the node must keep the state of this height until the export finishes.
+
+Example:
+$ %s query %s scores-snapshot --%s 500 > scores.jsonl
+`,
+				types.ModuleName, flags.FlagHeight, version.AppName, types.ModuleName, FlagPageLimit,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageLimit, err := cmd.Flags().GetUint64(FlagPageLimit)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			req := &types.QueryScoresSnapshotRequest{
+				Height: clientCtx.Height,
+				Pagination: &query.PageRequest{
+					Limit: pageLimit,
+				},
+			}
+			for {
+				res, err := types.NewQueryClient(clientCtx).ScoresSnapshot(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+				for _, score := range res.Scores {
+					line, err := clientCtx.Codec.MarshalJSON(&score)
+					if err != nil {
+						return err
+					}
+					if _, err := fmt.Fprintln(out, string(line)); err != nil {
+						return err
+					}
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					return nil
+				}
+
+				// the next pages are pinned to the height of the first one
+				clientCtx = clientCtx.WithHeight(res.Height)
+				req.Height = res.Height
+				req.Pagination.Key = res.Pagination.NextKey
+			}
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagPageLimit, query.DefaultLimit, "Number of the scores queried in one page")
+
+	return cmd
+}
+
 // CmdQueryScheduledDistributions implements a command to fetch all future scheduled distributions.
 func CmdQueryScheduledDistributions() *cobra.Command {
 	cmd := &cobra.Command{
@@ -171,3 +251,315 @@ $ %[1]s query %s clearing-account-balances
 
 	return cmd
 }
+
+// CmdQueryClearingAccountStatus implements a command to fetch the balances of all PSE clearing accounts reconciled with
+// their remaining scheduled outflow.
+func CmdQueryClearingAccountStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clearing-account-status",
+		Short: "Query the balances of all PSE clearing accounts reconciled with the remaining scheduled distributions",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance, the remaining scheduled outflow and the surplus of all PSE clearing accounts.
+The negative surplus is the deficit of the clearing account.
+
+Example:
+$ %s query %s clearing-account-status
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClearingAccountStatus(cmd.Context(), &types.QueryClearingAccountStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRemainingUnlocks implements a command to fetch the total amount still scheduled to be distributed from all
+// PSE clearing accounts.
+func CmdQueryRemainingUnlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remaining-unlocks",
+		Short: "Query the total amount still scheduled to be distributed from all PSE clearing accounts",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount, the number of the distributions and the timestamps of the next and
+the last distribution remaining in the schedule for all PSE clearing accounts.
+
+Example:
+$ %s query %s remaining-unlocks
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RemainingUnlocks(cmd.Context(), &types.QueryRemainingUnlocksRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryScoreCheckpoint implements a command to fetch the score checkpoint recorded at the community distribution.
+func CmdQueryScoreCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "score-checkpoint [hash]",
+		Short: "Query the score checkpoint recorded at the community distribution by its hash",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the scores the community distribution was proportional to.
+The hash is reported in the checkpoint_hash attribute of the tx.pse.v1.EventScoreCheckpoint event.
+
+Example:
+$ %s query %s score-checkpoint [hash]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScoreCheckpoint(cmd.Context(), &types.QueryScoreCheckpointRequest{
+				Hash: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryNamedSchedules implements a command to fetch all the named distribution schedules.
+func CmdQueryNamedSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "named-schedules",
+		Short: "Query all the named distribution schedules",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the named distribution schedules processed independently of the main schedule.
+
+Example:
+$ %s query %s named-schedules
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NamedSchedules(cmd.Context(), &types.QueryNamedSchedulesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryNamedSchedule implements a command to fetch the named distribution schedule.
+func CmdQueryNamedSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "named-schedule [name]",
+		Short: "Query the named distribution schedule with the status of its clearing accounts",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the named distribution schedule with the addresses, balances and scheduled outflows of
+its clearing accounts. The schedule is funded by sending the tokens to the addresses of its clearing accounts.
+
+Example:
+$ %s query %s named-schedule partner-grant-2026
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NamedSchedule(cmd.Context(), &types.QueryNamedScheduleRequest{
+				Name: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDistributionPreference implements a command to query the distribution preference of the delegator.
+func CmdQueryDistributionPreference() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-preference [delegator_address]",
+		Short: "Query the validator the Community distribution payouts of the delegator are delegated to",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator the Community distribution payouts of the delegator are delegated to.
+The validator address is empty if the payouts are delegated to the validators of the delegator proportionally.
+
+Example:
+$ %s query %s distribution-preference [delegator_address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionPreference(cmd.Context(), &types.QueryDistributionPreferenceRequest{
+				DelegatorAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDistributionOptOuts implements a command to query the addresses opted out of the Community distributions.
+func CmdQueryDistributionOptOuts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-opt-outs",
+		Short: "Query the addresses opted out of the Community distributions",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses opted out of the Community distributions.
+
+Example:
+$ %s query %s distribution-opt-outs
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DistributionOptOuts(cmd.Context(), &types.QueryDistributionOptOutsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "distribution-opt-outs")
+
+	return cmd
+}
+
+// CmdQueryDistributionOptOut implements a command to query whether the address is opted out of the Community
+// distributions.
+func CmdQueryDistributionOptOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-opt-out [address]",
+		Short: "Query whether the address is opted out of the Community distributions",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the address is opted out of the Community distributions.
+
+Example:
+$ %s query %s distribution-opt-out [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionOptOut(cmd.Context(), &types.QueryDistributionOptOutRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,9 +1,12 @@
 package cli_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/stretchr/testify/require"
 
 	txchainclitestutil "github.com/tokenize-x/tx-chain/v7/testutil/cli"
@@ -82,3 +85,56 @@ func TestQueryClearingAccountBalances(t *testing.T) {
 		requireT.True(accountsFound[expectedAccount], "expected clearing account %s not found", expectedAccount)
 	}
 }
+
+func TestQueryClearingAccountStatus(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	var resp types.QueryClearingAccountStatusResponse
+	txchainclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryClearingAccountStatus(), []string{}, &resp)
+	requireT.Len(resp.Statuses, len(types.GetAllClearingAccounts()))
+
+	for _, status := range resp.Statuses {
+		requireT.Equal(status.Balance.Sub(status.ScheduledOutflow).String(), status.Surplus.String())
+	}
+}
+
+func TestQueryRemainingUnlocks(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	var resp types.QueryRemainingUnlocksResponse
+	txchainclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryRemainingUnlocks(), []string{}, &resp)
+	requireT.Len(resp.RemainingUnlocks, len(types.GetAllClearingAccounts()))
+
+	for _, unlock := range resp.RemainingUnlocks {
+		requireT.LessOrEqual(unlock.NextTimestamp, unlock.LastTimestamp)
+	}
+}
+
+func TestQueryScoresSnapshot(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	buf, err := clitestutil.ExecTestCLICmd(
+		ctx, cli.CmdQueryScoresSnapshot(), []string{fmt.Sprintf("--%s=1", cli.FlagPageLimit)},
+	)
+	requireT.NoError(err)
+
+	// the scores are streamed line by line
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	addresses := map[string]struct{}{}
+	for _, line := range lines {
+		var score types.AccountScore
+		requireT.NoError(ctx.Codec.UnmarshalJSON([]byte(line), &score))
+		requireT.NotContains(addresses, score.Address)
+		addresses[score.Address] = struct{}{}
+	}
+	requireT.Contains(addresses, testNetwork.Validators[0].Address.String())
+}
@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      types.ModuleName + " transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdFundDistribution())
+	cmd.AddCommand(CmdSetDistributionPreference())
+	cmd.AddCommand(CmdOptOutDistributions())
+	cmd.AddCommand(CmdOptInDistributions())
+
+	return cmd
+}
+
+// CmdFundDistribution returns FundDistribution cobra command.
+func CmdFundDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-distribution [period_timestamp] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add coins to the Community distribution of the scheduled period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add coins to the Community distribution of the scheduled period.
+The coins are escrowed until the period is distributed and refunded if the period is removed by governance.
+
+Example:
+$ %s tx %s fund-distribution 1767225600 100000000%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.DenomTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			periodTimestamp, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid period timestamp")
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgFundDistribution{
+				Sender:          clientCtx.GetFromAddress().String(),
+				PeriodTimestamp: periodTimestamp,
+				Amount:          amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSetDistributionPreference returns SetDistributionPreference cobra command.
+func CmdSetDistributionPreference() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-distribution-preference [validator_address] --from [delegator]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Set the validator the Community distribution payouts are delegated to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the validator the Community distribution payouts of the delegator are delegated to.
+If the validator address is not provided, the preference is cleared and the payouts are delegated to the validators
+of the delegator proportionally to the delegations.
+
+Example:
+$ %s tx %s set-distribution-preference [validator_address] --from [delegator]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			var validatorAddress string
+			if len(args) > 0 {
+				validatorAddress = args[0]
+			}
+
+			msg := &types.MsgSetDistributionPreference{
+				DelegatorAddress: clientCtx.GetFromAddress().String(),
+				ValidatorAddress: validatorAddress,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdOptOutDistributions returns OptOutDistributions cobra command.
+func CmdOptOutDistributions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opt-out-distributions --from [address]",
+		Args:  cobra.NoArgs,
+		Short: "Opt out of the Community distributions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt out of the Community distributions. The share of the distributions is sent to the community
+pool instead.
+
+Example:
+$ %s tx %s opt-out-distributions --from [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgOptOutDistributions{
+				Address: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdOptInDistributions returns OptInDistributions cobra command.
+func CmdOptInDistributions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opt-in-distributions --from [address]",
+		Args:  cobra.NoArgs,
+		Short: "Opt in to the Community distributions again",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in to the Community distributions again after opting out of them.
+
+Example:
+$ %s tx %s opt-in-distributions --from [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgOptInDistributions{
+				Address: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
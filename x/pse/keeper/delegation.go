@@ -11,7 +11,7 @@ import (
 	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
 )
 
-// SetDelegationTimeEntry saves DelegationTimeEntry into storages.
+// SetDelegationTimeEntry saves DelegationTimeEntry into storages and keeps the bucket of the validator up to date.
 func (k Keeper) SetDelegationTimeEntry(
 	ctx context.Context,
 	valAddr sdk.ValAddress,
@@ -19,7 +19,22 @@ func (k Keeper) SetDelegationTimeEntry(
 	entry types.DelegationTimeEntry,
 ) error {
 	key := collections.Join(delAddr, valAddr)
-	return k.DelegationTimeEntries.Set(ctx, key, entry)
+	sharesDelta := entry.Shares
+	prevEntry, err := k.DelegationTimeEntries.Get(ctx, key)
+	switch {
+	case err == nil:
+		sharesDelta = sharesDelta.Sub(prevEntry.Shares)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.DelegationTimeEntries.Set(ctx, key, entry); err != nil {
+		return err
+	}
+	if err := k.ValidatorDelegators.Set(ctx, collections.Join(valAddr, delAddr)); err != nil {
+		return err
+	}
+	return k.addValidatorShares(ctx, valAddr, sharesDelta)
 }
 
 // GetDelegationTimeEntry retrieves DelegationTimeEntry from storages.
@@ -32,14 +47,48 @@ func (k Keeper) GetDelegationTimeEntry(
 	return k.DelegationTimeEntries.Get(ctx, key)
 }
 
-// RemoveDelegationTimeEntry removes DelegationTimeEntry from storages.
+// RemoveDelegationTimeEntry removes DelegationTimeEntry from storages and from the bucket of the validator.
 func (k Keeper) RemoveDelegationTimeEntry(
 	ctx context.Context,
 	valAddr sdk.ValAddress,
 	delAddr sdk.AccAddress,
 ) error {
 	key := collections.Join(delAddr, valAddr)
-	return k.DelegationTimeEntries.Remove(ctx, key)
+	entry, err := k.DelegationTimeEntries.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := k.DelegationTimeEntries.Remove(ctx, key); err != nil {
+		return err
+	}
+	if err := k.ValidatorDelegators.Remove(ctx, collections.Join(valAddr, delAddr)); err != nil {
+		return err
+	}
+	return k.addValidatorShares(ctx, valAddr, entry.Shares.Neg())
+}
+
+// addValidatorShares adds the delta to the total shares of the validator bucket, the bucket is removed once it has no
+// shares left, so the distribution skips the validators having no delegations accruing the score.
+func (k Keeper) addValidatorShares(ctx context.Context, valAddr sdk.ValAddress, delta sdkmath.LegacyDec) error {
+	if delta.IsZero() {
+		return nil
+	}
+	shares, err := k.ValidatorShares.Get(ctx, valAddr)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		shares = sdkmath.LegacyZeroDec()
+	}
+	shares = shares.Add(delta)
+	if !shares.IsPositive() {
+		return k.ValidatorShares.Remove(ctx, valAddr)
+	}
+	return k.ValidatorShares.Set(ctx, valAddr, shares)
 }
 
 // CalculateDelegatorScore calculates the current total score for a delegator.
@@ -57,6 +106,11 @@ func (k Keeper) CalculateDelegatorScore(ctx context.Context, delAddr sdk.AccAddr
 		}
 	}
 
+	minDelegationAmount, err := k.getMinDelegationAmount(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
 	// Calculate current period score from delegations for this specific delegator
 	// Use prefix query to efficiently get only this delegator's entries
 	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
@@ -76,7 +130,7 @@ func (k Keeper) CalculateDelegatorScore(ctx context.Context, delAddr sdk.AccAddr
 		// Now we only iterate entries for this specific delegator
 		valAddr := kv.Key.K2()
 		delegationTimeEntry := kv.Value
-		addedScore, err := calculateAddedScore(ctx, k, valAddr, delegationTimeEntry)
+		addedScore, err := calculateAddedScore(ctx, k, valAddr, delegationTimeEntry, minDelegationAmount)
 		if err != nil {
 			return sdkmath.Int{}, err
 		}
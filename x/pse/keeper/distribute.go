@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -22,15 +23,25 @@ func (k Keeper) DistributeCommunityPSE(
 	if err != nil {
 		return err
 	}
-	finalScoreMap, err := newScoreMap(k.addressCodec, params.ExcludedAddresses)
+	minDelegationAmount := params.MinDelegationAmount
+	if minDelegationAmount.IsNil() {
+		minDelegationAmount = sdkmath.ZeroInt()
+	}
+	finalScoreMap, err := newScoreMap(k.addressCodec, params.ExcludedAddresses, minDelegationAmount)
 	if err != nil {
 		return err
 	}
 
-	allDelegationTimeEntries, err := finalScoreMap.iterateDelegationTimeEntries(ctx, k)
+	// the delegation time entries are bucketed per validator, so each validator is loaded once and the buckets are
+	// loaded in parallel.
+	currentBlockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	buckets, err := k.loadScoreBuckets(ctx, currentBlockTime, minDelegationAmount)
 	if err != nil {
 		return err
 	}
+	if err := finalScoreMap.addBucketScores(buckets); err != nil {
+		return err
+	}
 
 	// add uncalculated score to account score snapshot and total score per delegator.
 	// it calculates the score from the last delegation time entry up to the current block time, which
@@ -40,36 +51,63 @@ func (k Keeper) DistributeCommunityPSE(
 		return err
 	}
 
+	// The score of the delegators holding their delegations for a shorter time than the minimum delegation duration
+	// is forfeited, to prevent splitting the stake across the fresh accounts right before the distribution.
+	if minDelegationDuration := params.MinDelegationDuration; minDelegationDuration > 0 {
+		err = finalScoreMap.forfeitRecentDelegatorScores(currentBlockTime - int64(minDelegationDuration.Seconds()))
+		if err != nil {
+			return err
+		}
+	}
+
 	// Clear all account score snapshots.
 	// Excluded addresses should not have snapshots (cleared when added to exclusion list),
 	// but we clear unconditionally for all addresses.
 	if err := k.AccountScoreSnapshot.Clear(ctx, nil); err != nil {
 		return err
 	}
+	if err := k.ValidatorDelegatorScores.Clear(ctx, nil); err != nil {
+		return err
+	}
 
 	// reset all delegation time entries LastChangedUnixSec to the current block time.
-	currentBlockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
-	for _, kv := range allDelegationTimeEntries {
-		kv.Value.LastChangedUnixSec = currentBlockTime
-		err = k.DelegationTimeEntries.Set(ctx, kv.Key, kv.Value)
-		if err != nil {
-			return err
+	// The shares are not changed, so the entries are set directly keeping the validator buckets intact.
+	for _, bucket := range buckets {
+		for i, entry := range bucket.entries {
+			entry.LastChangedUnixSec = currentBlockTime
+			err = k.DelegationTimeEntries.Set(ctx, collections.Join(bucket.delegators[i], bucket.valAddr), entry)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
+	// record the final scores, so the proportionality of the payouts can be verified off-chain.
+	if err := k.recordScoreCheckpoint(ctx, finalScoreMap, scheduledAt); err != nil {
+		return err
+	}
+
 	// distribute total pse coin based on per delegator score.
 	totalPSEScore := finalScoreMap.totalScore
 
 	// leftover is the amount of pse coin that is not distributed to any delegator.
 	// It will be sent to CommunityPool.
-	// there are 2 sources of leftover:
+	// there are 3 sources of leftover:
 	// 1. rounding errors due to division.
 	// 2. some delegators have no delegation.
+	// 3. the shares of the addresses opted out of the distributions.
 	leftover := totalPSEAmount
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if totalPSEScore.IsPositive() {
 		err = finalScoreMap.walk(func(addr sdk.AccAddress, score sdkmath.Int) error {
 			userAmount := totalPSEAmount.Mul(score).Quo(totalPSEScore)
+			optedOut, err := k.IsDistributionOptedOut(ctx, addr)
+			if err != nil {
+				return err
+			}
+			if optedOut {
+				return nil
+			}
 			distributedAmount, err := k.distributeToDelegator(ctx, addr, userAmount, bondDenom)
 			if err != nil {
 				return err
@@ -82,7 +120,7 @@ func (k Keeper) DistributeCommunityPSE(
 				Amount:           userAmount,
 				ScheduledAt:      scheduledAt,
 			}); err != nil {
-				sdkCtx.Logger().Error("failed to emit community distributed event", "error", err)
+				k.logger.Error("failed to emit community distributed event", "error", err)
 			}
 			return nil
 		})
@@ -139,6 +177,19 @@ func (k Keeper) distributeToDelegator(
 	); err != nil {
 		return sdkmath.NewInt(0), err
 	}
+
+	// the whole payout is delegated to the validator chosen by the delegator, if any.
+	preferredVal, found, err := k.preferredValidator(ctx, delAddr)
+	if err != nil {
+		return sdkmath.NewInt(0), err
+	}
+	if found {
+		if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, preferredVal, true); err != nil {
+			return sdkmath.NewInt(0), err
+		}
+		return amount, nil
+	}
+
 	for _, delegation := range delegations {
 		// NOTE: this division will have rounding errors up to 1 subunit, which is acceptable and will be ignored.
 		// if that one subunit exists, it will remain in user balance as undelegated.
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
 	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
 	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
 	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
@@ -183,6 +185,56 @@ func TestKeeper_Distribute(t *testing.T) {
 				func(r *runEnv) { assertScoreResetAction(r) },
 			},
 		},
+		{
+			name: "test delegation below min delegation amount does not accrue score",
+			actions: []func(*runEnv){
+				func(r *runEnv) { setMinDelegationAmountAction(r, 950_000) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 1_100_000) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[0], 900_000) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { distributeAction(r, sdkmath.NewInt(1000)) },
+				func(r *runEnv) {
+					assertDistributionAction(r, map[*sdk.AccAddress]sdkmath.Int{
+						&r.delegators[0]: sdkmath.NewInt(1_100_523), // + 1000 * 1.1 / 2.1
+						&r.delegators[1]: sdkmath.NewInt(900_000),   // 900_000 is below 950_000
+					})
+				},
+				// the payouts are not compared with the min delegation amount, so only the rounding leftover remains
+				func(r *runEnv) { assertCommunityPoolBalanceAction(r, sdkmath.NewInt(1)) },
+				func(r *runEnv) { assertScoreResetAction(r) },
+			},
+		},
+		{
+			name: "test score of delegators below min delegation duration forfeited",
+			actions: []func(*runEnv){
+				func(r *runEnv) { setMinDelegationDurationAction(r, time.Second*10) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 1_100_000) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[0], 900_000) },
+				// the delegation duration is not reset by the delegation change
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 100_000) },
+				func(r *runEnv) { waitAction(r, time.Second*4) },
+				func(r *runEnv) { distributeAction(r, sdkmath.NewInt(1000)) },
+				func(r *runEnv) {
+					assertDistributionAction(r, map[*sdk.AccAddress]sdkmath.Int{
+						// + 1000 * (1.1 * 8 + 1.2 * 4) / (1.1 * 8 + 1.2 * 4 + 12)
+						&r.delegators[0]: sdkmath.NewInt(1_200_531),
+						// the delegation is held for 4 seconds only
+						&r.delegators[1]: sdkmath.NewInt(900_000),
+					})
+				},
+				func(r *runEnv) { assertScoreResetAction(r) },
+				// the score of delegators[1] counts once the delegation is held long enough
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { distributeAction(r, sdkmath.NewInt(1000)) },
+				func(r *runEnv) {
+					assertDistributionAction(r, map[*sdk.AccAddress]sdkmath.Int{
+						&r.delegators[0]: sdkmath.NewInt(1_200_531 + 387), // + 1000 * 1.2 / 3.1
+						&r.delegators[1]: sdkmath.NewInt(900_290),         // + 1000 * 0.9 / 3.1
+					})
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -231,6 +283,90 @@ func TestKeeper_Distribute(t *testing.T) {
 	}
 }
 
+func TestKeeper_DistributeScoreCheckpoint(t *testing.T) {
+	requireT := require.New(t)
+	startTime := time.Now().Round(time.Second)
+	testApp := simapp.New(simapp.WithStartTime(startTime))
+	ctx, _, err := testApp.BeginNextBlockAtTime(startTime)
+	requireT.NoError(err)
+	r := &runEnv{
+		testApp:  testApp,
+		ctx:      ctx,
+		requireT: requireT,
+	}
+
+	validatorOperator, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(
+		ctx, validatorOperator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))),
+	)
+	validator, err := testApp.AddValidator(ctx, validatorOperator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil)
+	requireT.NoError(err)
+	r.validators = append(r.validators, sdk.MustValAddressFromBech32(validator.GetOperator()))
+	for range 2 {
+		delegator, _ := testApp.GenAccount(ctx)
+		requireT.NoError(testApp.FundAccount(
+			ctx, delegator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))),
+		))
+		r.delegators = append(r.delegators, delegator)
+	}
+
+	delegateAction(r, r.delegators[0], r.validators[0], 1_100_000)
+	delegateAction(r, r.delegators[1], r.validators[0], 900_000)
+	waitAction(r, time.Second*8)
+	r.ctx = r.ctx.WithEventManager(sdk.NewEventManager())
+	distributeAction(r, sdkmath.NewInt(1000))
+
+	checkpointEvents, err := event.FindTypedEvents[*types.EventScoreCheckpoint](r.ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(checkpointEvents, 1)
+	checkpointEvent := checkpointEvents[0]
+	requireT.EqualValues(r.ctx.BlockTime().Unix(), checkpointEvent.ScheduledAt)
+
+	// the checkpoint is fetched by the hash from the event
+	queryService := keeper.NewQueryService(testApp.PSEKeeper)
+	res, err := queryService.ScoreCheckpoint(r.ctx, &types.QueryScoreCheckpointRequest{
+		Hash: checkpointEvent.CheckpointHash,
+	})
+	requireT.NoError(err)
+	checkpoint := res.Checkpoint
+	requireT.NoError(checkpoint.Validate())
+	hash, err := checkpoint.Hash()
+	requireT.NoError(err)
+	requireT.Equal(checkpointEvent.CheckpointHash, hex.EncodeToString(hash))
+	requireT.Equal(checkpointEvent.TotalScore, checkpoint.TotalScore)
+	requireT.EqualValues(len(checkpoint.Scores), checkpointEvent.Accounts)
+
+	// the payouts are proportional to the scores of the checkpoint
+	distributedEvents, err := event.FindTypedEvents[*types.EventCommunityDistributed](
+		r.ctx.EventManager().ABCIEvents(),
+	)
+	requireT.NoError(err)
+	requireT.NotEmpty(distributedEvents)
+	scores := make(map[string]sdkmath.Int)
+	for _, accountScore := range checkpoint.Scores {
+		scores[accountScore.Address] = accountScore.Score
+	}
+	for _, distributedEvent := range distributedEvents {
+		requireT.Equal(checkpoint.TotalScore, distributedEvent.TotalPseScore)
+		requireT.Equal(scores[distributedEvent.DelegatorAddress], distributedEvent.Score)
+		requireT.Equal(
+			sdkmath.NewInt(1000).Mul(distributedEvent.Score).Quo(checkpoint.TotalScore).String(),
+			distributedEvent.Amount.String(),
+		)
+	}
+	for _, delegator := range r.delegators {
+		requireT.Contains(scores, delegator.String())
+	}
+
+	// invalid and unknown hashes
+	_, err = queryService.ScoreCheckpoint(r.ctx, &types.QueryScoreCheckpointRequest{Hash: "invalid"})
+	requireT.Error(err)
+	_, err = queryService.ScoreCheckpoint(r.ctx, &types.QueryScoreCheckpointRequest{
+		Hash: hex.EncodeToString(make([]byte, 32)),
+	})
+	requireT.ErrorIs(err, types.ErrScoreCheckpointNotFound)
+}
+
 // Test_ExcludedAddress_FullLifecycle validates the complete lifecycle of excluded addresses.
 func Test_ExcludedAddress_FullLifecycle(t *testing.T) {
 	requireT := require.New(t)
@@ -12,10 +12,8 @@ import (
 
 // ProcessNextDistribution processes the next due distribution from the schedule.
 // Checks the earliest scheduled distribution and processes it if the current block time has passed its timestamp.
-// Only one distribution is processed per call. Should be called from EndBlock.
+// Only one distribution is processed per call. Called at the end of each distribution epoch.
 func (k Keeper) ProcessNextDistribution(ctx context.Context) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
 	// Peek at the next scheduled distribution
 	scheduledDistribution, shouldProcess, err := k.PeekNextAllocationSchedule(ctx)
 	if err != nil {
@@ -42,21 +40,42 @@ func (k Keeper) ProcessNextDistribution(ctx context.Context) error {
 		return err
 	}
 
+	// Get the amount escrowed against this timestamp, it's added to the Community allocation
+	communityFunding, err := k.GetDistributionFundingAmount(ctx, timestamp)
+	if err != nil {
+		return err
+	}
+
 	// Process all allocations scheduled for this timestamp
 	if err := k.distributeAllocatedTokens(
-		ctx, timestamp, bondDenom, params.ClearingAccountMappings, scheduledDistribution,
+		ctx, timestamp, bondDenom, params.ClearingAccountMappings, scheduledDistribution, communityFunding,
 	); err != nil {
 		return err
 	}
 
-	// Remove the completed distribution from the schedule
+	// Remove the completed distribution and its fundings from the schedule
 	if err := k.AllocationSchedule.Remove(ctx, timestamp); err != nil {
 		return err
 	}
+	if err := k.clearDistributionFundings(ctx, timestamp); err != nil {
+		return err
+	}
 
-	sdkCtx.Logger().Info("processed and removed allocation from schedule",
+	k.logger.Info("processed and removed allocation from schedule",
 		"timestamp", timestamp)
 
+	// Report the amounts left in the schedule to chart the emission curve
+	remainingUnlocks, err := k.GetRemainingUnlocks(ctx)
+	if err != nil {
+		return err
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRemainingUnlocks{
+		ProcessedAt:      timestamp,
+		RemainingUnlocks: remainingUnlocks,
+	}); err != nil {
+		k.logger.Error("failed to emit remaining unlocks event", "error", err)
+	}
+
 	return nil
 }
 
@@ -87,13 +106,18 @@ func (k Keeper) PeekNextAllocationSchedule(ctx context.Context) (types.Scheduled
 
 	// Check if distribution time has arrived
 	// Since the map is sorted by timestamp, if the first item is in the future, all items are
-	shouldProcess := timestamp <= uint64(sdkCtx.BlockTime().Unix())
+	dueTimestamp, err := k.dueTimestamp(ctx, timestamp)
+	if err != nil {
+		return types.ScheduledDistribution{}, false, err
+	}
+	shouldProcess := dueTimestamp <= uint64(sdkCtx.BlockTime().Unix())
 
 	return scheduledDist, shouldProcess, nil
 }
 
 // distributeAllocatedTokens transfers tokens from clearing accounts to their mapped recipients.
 // Processes all allocations within a single scheduled distribution.
+// The community funding escrowed against the distribution is distributed together with the Community allocation.
 // Any transfer failure indicates a state invariant violation (insufficient balance or invalid recipient).
 func (k Keeper) distributeAllocatedTokens(
 	ctx context.Context,
@@ -101,17 +125,18 @@ func (k Keeper) distributeAllocatedTokens(
 	bondDenom string,
 	clearingAccountMappings []types.ClearingAccountMapping,
 	scheduledDistribution types.ScheduledDistribution,
+	communityFunding sdkmath.Int,
 ) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	// Transfer tokens for each allocation in this distribution period
 	for _, allocation := range scheduledDistribution.Allocations {
-		if allocation.Amount.IsZero() {
-			continue
-		}
-
 		// Community clearing account has different distribution logic
 		if allocation.ClearingAccount == types.ClearingAccountCommunity {
-			if err := k.DistributeCommunityPSE(ctx, bondDenom, allocation.Amount, scheduledDistribution.Timestamp); err != nil {
+			communityAmount := allocation.Amount.Add(communityFunding)
+			if communityAmount.IsZero() {
+				continue
+			}
+			if err := k.DistributeCommunityPSE(ctx, bondDenom, communityAmount, scheduledDistribution.Timestamp); err != nil {
 				return errorsmod.Wrapf(
 					types.ErrTransferFailed,
 					"failed to distribute Community clearing account allocation: %v",
@@ -121,6 +146,10 @@ func (k Keeper) distributeAllocatedTokens(
 			continue
 		}
 
+		if allocation.Amount.IsZero() {
+			continue
+		}
+
 		// Find the recipient addresses mapped to this clearing account
 		// Note: Community clearing account is handled above and doesn't need a mapping.
 		// Mappings are validated on update and genesis, so they are guaranteed to exist.
@@ -188,7 +217,7 @@ func (k Keeper) distributeAllocatedTokens(
 				)
 			}
 
-			sdkCtx.Logger().Info("sent distribution remainder to community pool",
+			k.logger.Info("sent distribution remainder to community pool",
 				"clearing_account", allocation.ClearingAccount,
 				"remainder", remainder.String())
 		}
@@ -202,10 +231,10 @@ func (k Keeper) distributeAllocatedTokens(
 			ScheduledAt:         timestamp,
 			TotalAmount:         allocation.Amount,
 		}); err != nil {
-			sdkCtx.Logger().Error("failed to emit allocation completed event", "error", err)
+			k.logger.Error("failed to emit allocation completed event", "error", err)
 		}
 
-		sdkCtx.Logger().Info("allocated tokens",
+		k.logger.Info("allocated tokens",
 			"clearing_account", allocation.ClearingAccount,
 			"recipients", recipientAddrs,
 			"total_amount", allocation.Amount.String(),
@@ -253,9 +282,21 @@ func (k Keeper) GetDistributionSchedule(ctx context.Context) ([]types.ScheduledD
 	return schedule, nil
 }
 
+// ValidateStoredDistributionSchedule validates the stored distribution schedule. It is executed after the governance
+// proposals updating the params to detect the schedule which is not valid anymore.
+func (k Keeper) ValidateStoredDistributionSchedule(ctx context.Context) error {
+	schedule, err := k.GetDistributionSchedule(ctx)
+	if err != nil {
+		return err
+	}
+
+	return types.ValidateDistributionSchedule(schedule)
+}
+
 // UpdateDistributionSchedule updates the entire distribution schedule via governance.
 // This clears all existing distributions and replaces them with the new schedule.
 // The new schedule is validated for consistency with existing clearing account mappings.
+// The fundings of the distributions missing in the new schedule are refunded.
 func (k Keeper) UpdateDistributionSchedule(
 	ctx context.Context,
 	authority string,
@@ -266,6 +307,17 @@ func (k Keeper) UpdateDistributionSchedule(
 		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
 	}
 
+	// Refund the fundings of the removed distributions
+	newTimestamps := make(map[uint64]bool, len(newSchedule))
+	for _, scheduledDist := range newSchedule {
+		newTimestamps[scheduledDist.Timestamp] = true
+	}
+	if err := k.refundDistributionFundings(ctx, func(periodTimestamp uint64) bool {
+		return newTimestamps[periodTimestamp]
+	}); err != nil {
+		return err
+	}
+
 	// Clear all existing schedule entries
 	if err := k.AllocationSchedule.Clear(ctx, nil); err != nil {
 		return errorsmod.Wrap(err, "failed to clear existing allocation schedule")
@@ -276,11 +328,16 @@ func (k Keeper) UpdateDistributionSchedule(
 }
 
 // DisableDistributions is a governance operation that disables distributions.
+// All the fundings are refunded since the scheduled distributions are not processed anymore.
 func (k Keeper) DisableDistributions(ctx context.Context, authority string) error {
 	// Check authority
 	if k.authority != authority {
 		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
 	}
 
+	if err := k.refundDistributionFundings(ctx, func(uint64) bool { return false }); err != nil {
+		return err
+	}
+
 	return k.DistributionDisabled.Set(ctx, true)
 }
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// SetDistributionOptOut opts the address out of or in to the Community distributions. The score of the opted out
+// address is still accrued, but its share of each distribution is sent to the community pool instead.
+func (k Keeper) SetDistributionOptOut(ctx context.Context, addr sdk.AccAddress, optedOut bool) error {
+	if optedOut {
+		if err := k.DistributionOptOuts.Set(ctx, addr); err != nil {
+			return err
+		}
+	} else {
+		if err := k.DistributionOptOuts.Remove(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDistributionOptOutSet{
+		Address:  addr.String(),
+		OptedOut: optedOut,
+	})
+}
+
+// IsDistributionOptedOut checks if the address is opted out of the Community distributions.
+func (k Keeper) IsDistributionOptedOut(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.DistributionOptOuts.Has(ctx, addr)
+}
+
+// GetDistributionOptOuts returns all the addresses opted out of the Community distributions.
+func (k Keeper) GetDistributionOptOuts(ctx context.Context) ([]string, error) {
+	iter, err := k.DistributionOptOuts.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	addrs, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	optOuts := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		addrBech32, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return nil, err
+		}
+		optOuts = append(optOuts, addrBech32)
+	}
+
+	return optOuts, nil
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+func TestKeeper_DistributionOptOut(t *testing.T) {
+	requireT := require.New(t)
+	startTime := time.Now().Round(time.Second)
+	testApp := simapp.New(simapp.WithStartTime(startTime))
+	ctx, _, err := testApp.BeginNextBlockAtTime(startTime)
+	requireT.NoError(err)
+	r := &runEnv{
+		testApp:  testApp,
+		ctx:      ctx,
+		requireT: requireT,
+	}
+	msgServer := keeper.NewMsgServer(testApp.PSEKeeper)
+	queryService := keeper.NewQueryService(testApp.PSEKeeper)
+
+	validatorOperator, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(
+		ctx, validatorOperator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))),
+	)
+	validator, err := testApp.AddValidator(ctx, validatorOperator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil)
+	requireT.NoError(err)
+	r.validators = append(r.validators, sdk.MustValAddressFromBech32(validator.GetOperator()))
+	for range 2 {
+		delegator, _ := testApp.GenAccount(ctx)
+		r.delegators = append(r.delegators, delegator)
+	}
+	optedOut, optedIn := r.delegators[0], r.delegators[1]
+
+	queryOptOut := func(addr sdk.AccAddress) bool {
+		res, err := queryService.DistributionOptOut(r.ctx, &types.QueryDistributionOptOutRequest{
+			Address: addr.String(),
+		})
+		requireT.NoError(err)
+		return res.OptedOut
+	}
+
+	// nobody is opted out by default
+	requireT.False(queryOptOut(optedOut))
+
+	_, err = msgServer.OptOutDistributions(r.ctx, &types.MsgOptOutDistributions{Address: optedOut.String()})
+	requireT.NoError(err)
+	requireT.True(queryOptOut(optedOut))
+	requireT.False(queryOptOut(optedIn))
+
+	res, err := queryService.DistributionOptOuts(r.ctx, &types.QueryDistributionOptOutsRequest{
+		Pagination: &query.PageRequest{Limit: 10},
+	})
+	requireT.NoError(err)
+	requireT.Equal([]string{optedOut.String()}, res.Addresses)
+
+	// the share of the opted out address is sent to the community pool, the rest is split with the genesis validator
+	delegateAction(r, optedOut, r.validators[0], 1_000_000)
+	delegateAction(r, optedIn, r.validators[0], 1_000_000)
+	waitAction(r, time.Second*8)
+	distributeAction(r, sdkmath.NewInt(1000))
+	assertDistributionAction(r, map[*sdk.AccAddress]sdkmath.Int{
+		&r.delegators[0]: sdkmath.NewInt(1_000_000),
+		&r.delegators[1]: sdkmath.NewInt(1_000_333),
+	})
+	assertCommunityPoolBalanceAction(r, sdkmath.NewInt(333+1))
+
+	// the opt-out is exported
+	genesisState, err := testApp.PSEKeeper.ExportGenesis(r.ctx)
+	requireT.NoError(err)
+	requireT.Equal([]string{optedOut.String()}, genesisState.DistributionOptOuts)
+	requireT.NoError(genesisState.Validate())
+
+	// the address opted in again receives its share
+	_, err = msgServer.OptInDistributions(r.ctx, &types.MsgOptInDistributions{Address: optedOut.String()})
+	requireT.NoError(err)
+	requireT.False(queryOptOut(optedOut))
+
+	waitAction(r, time.Second*8)
+	distributeAction(r, sdkmath.NewInt(1000))
+	assertDistributionAction(r, map[*sdk.AccAddress]sdkmath.Int{
+		&r.delegators[0]: sdkmath.NewInt(1_000_333),
+		&r.delegators[1]: sdkmath.NewInt(1_000_666),
+	})
+
+	optOuts, err := testApp.PSEKeeper.GetDistributionOptOuts(r.ctx)
+	requireT.NoError(err)
+	requireT.Empty(optOuts)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// SetDistributionPreference sets the validator the Community distribution payouts of the delegator are delegated to.
+// The preference is cleared if the validator address is nil, so the payouts are delegated to the validators of the
+// delegator proportionally to the delegations again.
+func (k Keeper) SetDistributionPreference(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	var valAddrBech32 string
+	if valAddr == nil {
+		if err := k.DistributionPreferences.Remove(ctx, delAddr); err != nil {
+			return err
+		}
+	} else {
+		if _, err := k.stakingKeeper.GetValidator(ctx, valAddr); err != nil {
+			if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+				return errorsmod.Wrapf(types.ErrInvalidInput, "validator %s not found", valAddr)
+			}
+			return err
+		}
+		var err error
+		valAddrBech32, err = k.valAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return err
+		}
+		if err := k.DistributionPreferences.Set(ctx, delAddr, types.DistributionPreference{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddrBech32,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDistributionPreferenceSet{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddrBech32,
+	})
+}
+
+// GetDistributionPreferences returns the distribution preferences of all the delegators.
+func (k Keeper) GetDistributionPreferences(ctx context.Context) ([]types.DistributionPreference, error) {
+	iter, err := k.DistributionPreferences.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	return iter.Values()
+}
+
+// GetDistributionPreference returns the validator address the Community distribution payouts of the delegator are
+// delegated to, empty if the delegator has no preference.
+func (k Keeper) GetDistributionPreference(ctx context.Context, delAddr sdk.AccAddress) (string, error) {
+	preference, err := k.DistributionPreferences.Get(ctx, delAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return preference.ValidatorAddress, nil
+}
+
+// preferredValidator returns the validator the Community distribution payouts of the delegator are delegated to.
+// It returns false if the delegator has no preference, or the chosen validator doesn't exist anymore or is jailed,
+// so the payout is delegated to the validators of the delegator proportionally to the delegations instead.
+func (k Keeper) preferredValidator(
+	ctx context.Context, delAddr sdk.AccAddress,
+) (stakingtypes.Validator, bool, error) {
+	preference, err := k.DistributionPreferences.Get(ctx, delAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return stakingtypes.Validator{}, false, nil
+		}
+		return stakingtypes.Validator{}, false, err
+	}
+
+	valAddr, err := k.valAddressCodec.StringToBytes(preference.ValidatorAddress)
+	if err != nil {
+		return stakingtypes.Validator{}, false, err
+	}
+	val, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return stakingtypes.Validator{}, false, nil
+		}
+		return stakingtypes.Validator{}, false, err
+	}
+	if val.IsJailed() {
+		return stakingtypes.Validator{}, false, nil
+	}
+
+	return val, true, nil
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+func TestKeeper_DistributionPreference(t *testing.T) {
+	requireT := require.New(t)
+	startTime := time.Now().Round(time.Second)
+	testApp := simapp.New(simapp.WithStartTime(startTime))
+	ctx, _, err := testApp.BeginNextBlockAtTime(startTime)
+	requireT.NoError(err)
+	r := &runEnv{
+		testApp:  testApp,
+		ctx:      ctx,
+		requireT: requireT,
+	}
+	msgServer := keeper.NewMsgServer(testApp.PSEKeeper)
+	queryService := keeper.NewQueryService(testApp.PSEKeeper)
+
+	for range 2 {
+		validatorOperator, _ := testApp.GenAccount(ctx)
+		requireT.NoError(testApp.FundAccount(
+			ctx, validatorOperator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))),
+		)
+		validator, err := testApp.AddValidator(ctx, validatorOperator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil)
+		requireT.NoError(err)
+		r.validators = append(r.validators, sdk.MustValAddressFromBech32(validator.GetOperator()))
+	}
+	delegator, _ := testApp.GenAccount(ctx)
+	r.delegators = append(r.delegators, delegator)
+
+	setPreference := func(valAddr string) error {
+		_, err := msgServer.SetDistributionPreference(r.ctx, &types.MsgSetDistributionPreference{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: valAddr,
+		})
+		return err
+	}
+	queryPreference := func() string {
+		res, err := queryService.DistributionPreference(r.ctx, &types.QueryDistributionPreferenceRequest{
+			DelegatorAddress: delegator.String(),
+		})
+		requireT.NoError(err)
+		return res.ValidatorAddress
+	}
+	delegatedTokens := func(valAddr sdk.ValAddress) sdkmath.Int {
+		delegation, err := testApp.StakingKeeper.GetDelegation(r.ctx, delegator, valAddr)
+		requireT.NoError(err)
+		validator, err := testApp.StakingKeeper.GetValidator(r.ctx, valAddr)
+		requireT.NoError(err)
+		return validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	// no preference by default
+	requireT.Empty(queryPreference())
+
+	// unknown validator
+	unknownValidator := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.ErrorIs(setPreference(unknownValidator.String()), types.ErrInvalidInput)
+
+	// the payout is delegated to the chosen validator instead of the existing delegation
+	requireT.NoError(setPreference(r.validators[1].String()))
+	requireT.Equal(r.validators[1].String(), queryPreference())
+
+	delegateAction(r, delegator, r.validators[0], 1_000_000)
+	waitAction(r, time.Second*8)
+	distributeAction(r, sdkmath.NewInt(1000))
+	requireT.Equal(sdkmath.NewInt(1_000_000).String(), delegatedTokens(r.validators[0]).String())
+	requireT.True(delegatedTokens(r.validators[1]).IsPositive())
+
+	// the preference is exported
+	genesisState, err := testApp.PSEKeeper.ExportGenesis(r.ctx)
+	requireT.NoError(err)
+	requireT.Equal([]types.DistributionPreference{
+		{DelegatorAddress: delegator.String(), ValidatorAddress: r.validators[1].String()},
+	}, genesisState.DistributionPreferences)
+	requireT.NoError(genesisState.Validate())
+
+	// the preference is cleared
+	requireT.NoError(setPreference(""))
+	requireT.Empty(queryPreference())
+	preferences, err := testApp.PSEKeeper.GetDistributionPreferences(r.ctx)
+	requireT.NoError(err)
+	requireT.Empty(preferences)
+}
@@ -257,7 +257,7 @@ func TestDistribution_PrecisionWithMultipleRecipients(t *testing.T) {
 		"community pool should have received the distribution remainders")
 }
 
-func TestDistribution_EndBlockFailure(t *testing.T) {
+func TestDistribution_EpochFailure(t *testing.T) {
 	requireT := require.New(t)
 
 	testApp := simapp.New()
@@ -297,7 +297,7 @@ func TestDistribution_EndBlockFailure(t *testing.T) {
 
 	// Fund the clearing accounts
 	for _, clearingAccount := range types.GetAllClearingAccounts() {
-		// we skip team clearing account, so it will lead to not enough funds error in end block.
+		// we skip team clearing account, so it will lead to not enough funds error at the end of the epoch.
 		if clearingAccount == types.ClearingAccountTeam {
 			continue
 		}
@@ -328,8 +328,8 @@ func TestDistribution_EndBlockFailure(t *testing.T) {
 	// Save distribution schedule
 	err = pseKeeper.SaveDistributionSchedule(ctx, schedule)
 	requireT.NoError(err)
-	// Process distribution
-	err = testApp.FinalizeBlock()
+	// Process distribution at the end of the distribution epoch
+	err = testApp.FinalizeBlockAtTime(ctx.BlockTime().Add(time.Minute))
 	requireT.NoError(err)
 
 	// Verify disabled distributions is set to true
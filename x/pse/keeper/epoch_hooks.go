@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/blocktiming"
+	epochstypes "github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+const (
+	// DistributionEpochIdentifier is the identifier of the epoch at the end of which the due scheduled distributions
+	// are processed. The distributions used to be checked every block, so the shortest epoch is used to keep the delay
+	// between the scheduled and the actual distribution time close to what it was, without the store reads in every
+	// block. The hour epoch would delay the distributions by up to an hour.
+	DistributionEpochIdentifier = epochstypes.MinuteEpochID
+	// ReconciliationEpochIdentifier is the identifier of the epoch at the end of which the balances of the clearing
+	// accounts are reconciled with the remaining scheduled distributions.
+	ReconciliationEpochIdentifier = epochstypes.HourEpochID
+)
+
+// EpochHooks implements the epoch hooks interface.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks creates new epoch hooks.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd implements the epoch hooks interface. It processes the due scheduled distributions and warns about
+// the clearing accounts which can't cover the remaining scheduled distributions.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	switch epochIdentifier {
+	case DistributionEpochIdentifier:
+		return h.k.processDistributions(ctx)
+	case ReconciliationEpochIdentifier:
+		return h.k.reconcileClearingAccounts(ctx)
+	default:
+		return nil
+	}
+}
+
+// BeforeEpochStart implements the epoch hooks interface.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// EpochIdentifiers implements the epoch hooks interface.
+func (h EpochHooks) EpochIdentifiers() []string {
+	return []string{DistributionEpochIdentifier, ReconciliationEpochIdentifier}
+}
+
+// processDistributions processes the next due distribution of the named schedules and of the main schedule. If the
+// distribution of the main schedule fails, all the future distributions are disabled. Only the errors which can't be
+// recovered from, e.g. the store errors, are returned, they halt the chain the same way they did in EndBlock.
+func (k Keeper) processDistributions(ctx context.Context) error {
+	// Process the named schedules, they are independent of the main schedule and disabled individually
+	start := time.Now()
+	if err := k.ProcessNamedSchedules(ctx); err != nil {
+		return err
+	}
+	blocktiming.MeasureSince(ctx, start, types.ModuleName, "named_schedules")
+
+	// Process periodic distributions
+	disabled, err := k.DistributionDisabled.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if disabled {
+		k.logger.Info("skipping distribution because it was marked as disabled")
+		return nil
+	}
+	start = time.Now()
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+	err = k.ProcessNextDistribution(cacheCtx)
+	blocktiming.MeasureSince(ctx, start, types.ModuleName, "distribution")
+	if err != nil {
+		k.logger.Error("failed to process next distribution, disabling all future distributions", "error", err)
+		return k.DistributionDisabled.Set(ctx, true)
+	}
+	writeCache()
+
+	return nil
+}
+
+// reconcileClearingAccounts warns about the clearing accounts which can't cover the remaining scheduled
+// distributions. The reconciliation scans the whole remaining schedule, so it is executed once per epoch instead of
+// every block.
+func (k Keeper) reconcileClearingAccounts(ctx context.Context) error {
+	disabled, err := k.DistributionDisabled.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if disabled {
+		return nil
+	}
+
+	start := time.Now()
+	defer blocktiming.MeasureSince(ctx, start, types.ModuleName, "clearing_account_deficits")
+	return k.EmitClearingAccountDeficits(ctx)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CalculateBucketScores calculates the total score of the delegation time entries accrued up to the block time,
+// loading the entries through the validator buckets the way the Community distribution does.
+func (k Keeper) CalculateBucketScores(ctx context.Context) (sdkmath.Int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	scores, err := newScoreMap(k.addressCodec, params.ExcludedAddresses, params.MinDelegationAmount)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	buckets, err := k.loadScoreBuckets(ctx, sdk.UnwrapSDKContext(ctx).BlockTime().Unix(), params.MinDelegationAmount)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err := scores.addBucketScores(buckets); err != nil {
+		return sdkmath.Int{}, err
+	}
+	return scores.totalScore, nil
+}
+
+// CalculateEntryScores calculates the total score of the delegation time entries accrued up to the block time,
+// iterating all the entries and loading the validator of each of them, the way the Community distribution did before
+// the entries were bucketed per validator.
+func (k Keeper) CalculateEntryScores(ctx context.Context) (sdkmath.Int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	scores, err := newScoreMap(k.addressCodec, params.ExcludedAddresses, params.MinDelegationAmount)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	iter, err := k.DelegationTimeEntries.Iterate(ctx, nil)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		delAddr := kv.Key.K1()
+		if scores.isExcludedAddress(delAddr) {
+			continue
+		}
+		if err := scores.trackDelegatedSince(delAddr, kv.Value.DelegatedSinceUnixSec); err != nil {
+			return sdkmath.Int{}, err
+		}
+		score, err := calculateAddedScore(ctx, k, kv.Key.K2(), kv.Value, params.MinDelegationAmount)
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		if err := scores.addScore(delAddr, score); err != nil {
+			return sdkmath.Int{}, err
+		}
+	}
+	return scores.totalScore, nil
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// FundDistribution escrows the amount against the scheduled distribution. The escrowed amount is kept in the
+// Community clearing account and distributed together with the Community allocation of the distribution.
+func (k Keeper) FundDistribution(
+	ctx context.Context,
+	sender sdk.AccAddress,
+	periodTimestamp uint64,
+	amount sdk.Coin,
+) error {
+	disabled, err := k.DistributionDisabled.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if disabled {
+		return errorsmod.Wrap(types.ErrInvalidInput, "distributions are disabled")
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if amount.Denom != bondDenom {
+		return errorsmod.Wrapf(types.ErrInvalidInput, "denom must be %s, got %s", bondDenom, amount.Denom)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrap(types.ErrInvalidInput, "amount must be positive")
+	}
+
+	scheduled, err := k.AllocationSchedule.Has(ctx, periodTimestamp)
+	if err != nil {
+		return err
+	}
+	if !scheduled {
+		return errorsmod.Wrapf(types.ErrDistributionNotFound, "timestamp: %d", periodTimestamp)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.ClearingAccountCommunity, sdk.NewCoins(amount),
+	); err != nil {
+		return err
+	}
+
+	key := collections.Join(periodTimestamp, sender)
+	funded, err := k.DistributionFundings.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		funded = sdkmath.ZeroInt()
+	}
+	if err := k.DistributionFundings.Set(ctx, key, funded.Add(amount.Amount)); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDistributionFunded{
+		Funder:          sender.String(),
+		PeriodTimestamp: periodTimestamp,
+		Amount:          amount.Amount,
+	})
+}
+
+// GetDistributionFundingAmount returns the total amount escrowed against the scheduled distribution.
+func (k Keeper) GetDistributionFundingAmount(ctx context.Context, periodTimestamp uint64) (sdkmath.Int, error) {
+	total := sdkmath.ZeroInt()
+	err := k.DistributionFundings.Walk(
+		ctx,
+		collections.NewPrefixedPairRange[uint64, sdk.AccAddress](periodTimestamp),
+		func(_ collections.Pair[uint64, sdk.AccAddress], amount sdkmath.Int) (bool, error) {
+			total = total.Add(amount)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return total, nil
+}
+
+// GetDistributionFundings returns all the amounts escrowed against the scheduled distributions.
+func (k Keeper) GetDistributionFundings(ctx context.Context) ([]types.DistributionFunding, error) {
+	var fundings []types.DistributionFunding
+	err := k.DistributionFundings.Walk(
+		ctx,
+		nil,
+		func(key collections.Pair[uint64, sdk.AccAddress], amount sdkmath.Int) (bool, error) {
+			funder, err := k.addressCodec.BytesToString(key.K2())
+			if err != nil {
+				return false, err
+			}
+			fundings = append(fundings, types.DistributionFunding{
+				PeriodTimestamp: key.K1(),
+				Funder:          funder,
+				Amount:          amount,
+			})
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fundings, nil
+}
+
+// clearDistributionFundings removes the fundings of the processed scheduled distribution.
+func (k Keeper) clearDistributionFundings(ctx context.Context, periodTimestamp uint64) error {
+	return k.DistributionFundings.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](periodTimestamp))
+}
+
+// refundDistributionFundings returns the escrowed amounts of the scheduled distributions for which keep returns
+// false back to their funders.
+func (k Keeper) refundDistributionFundings(ctx context.Context, keep func(periodTimestamp uint64) bool) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	var refunds []collections.KeyValue[collections.Pair[uint64, sdk.AccAddress], sdkmath.Int]
+	err = k.DistributionFundings.Walk(
+		ctx,
+		nil,
+		func(key collections.Pair[uint64, sdk.AccAddress], amount sdkmath.Int) (bool, error) {
+			if !keep(key.K1()) {
+				refunds = append(refunds, collections.KeyValue[collections.Pair[uint64, sdk.AccAddress], sdkmath.Int]{
+					Key:   key,
+					Value: amount,
+				})
+			}
+			return false, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, refund := range refunds {
+		if err := k.DistributionFundings.Remove(ctx, refund.Key); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ClearingAccountCommunity,
+			refund.Key.K2(),
+			sdk.NewCoins(sdk.NewCoin(bondDenom, refund.Value)),
+		); err != nil {
+			return errorsmod.Wrapf(
+				types.ErrTransferFailed,
+				"failed to refund funding of distribution %d to '%s': %v",
+				refund.Key.K1(),
+				refund.Key.K2(),
+				err,
+			)
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventDistributionFundingRefunded{
+			Funder:          refund.Key.K2().String(),
+			PeriodTimestamp: refund.Key.K1(),
+			Amount:          refund.Value,
+		}); err != nil {
+			k.logger.Error("failed to emit distribution funding refunded event", "error", err)
+		}
+	}
+
+	return nil
+}
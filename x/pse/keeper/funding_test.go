@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+//nolint:funlen // the test covers the whole funding lifecycle
+func TestFundDistribution(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+	ctx = ctx.WithBlockTime(time.Now())
+	pseKeeper := testApp.PSEKeeper
+	msgServer := keeper.NewMsgServer(pseKeeper)
+	authority := testApp.GovAuthority()
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	var mappings []types.ClearingAccountMapping
+	for _, clearingAccount := range types.GetNonCommunityClearingAccounts() {
+		mappings = append(mappings, types.ClearingAccountMapping{
+			ClearingAccount:    clearingAccount,
+			RecipientAddresses: []string{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()},
+		})
+	}
+	params, err := pseKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.ClearingAccountMappings = mappings
+	requireT.NoError(pseKeeper.SetParams(ctx, params))
+
+	for _, clearingAccount := range types.GetAllClearingAccounts() {
+		fundAmount := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(10_000)))
+		requireT.NoError(testApp.BankKeeper.MintCoins(ctx, types.ModuleName, fundAmount))
+		requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, clearingAccount, fundAmount))
+	}
+
+	funder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	funderAmount := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(10_000)))
+	requireT.NoError(testApp.BankKeeper.MintCoins(ctx, types.ModuleName, funderAmount))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, funderAmount))
+
+	newDistribution := func(timestamp uint64) types.ScheduledDistribution {
+		allocations := make([]types.ClearingAccountAllocation, 0, len(types.GetAllClearingAccounts()))
+		for _, clearingAccount := range types.GetAllClearingAccounts() {
+			allocations = append(allocations, types.ClearingAccountAllocation{
+				ClearingAccount: clearingAccount,
+				Amount:          sdkmath.NewInt(1_000),
+			})
+		}
+		return types.ScheduledDistribution{Timestamp: timestamp, Allocations: allocations}
+	}
+
+	time1 := uint64(ctx.BlockTime().Add(time.Hour).Unix())
+	time2 := uint64(ctx.BlockTime().Add(2 * time.Hour).Unix())
+	time3 := uint64(ctx.BlockTime().Add(3 * time.Hour).Unix())
+	requireT.NoError(testApp.ExecuteGovProposal(ctx, &types.MsgUpdateDistributionSchedule{
+		Authority: authority,
+		Schedule:  []types.ScheduledDistribution{newDistribution(time1), newDistribution(time2)},
+	}))
+
+	communityAddr := testApp.AccountKeeper.GetModuleAddress(types.ClearingAccountCommunity)
+	communityBalance := func() sdkmath.Int {
+		return testApp.BankKeeper.GetBalance(ctx, communityAddr, bondDenom).Amount
+	}
+	funderBalance := func() sdkmath.Int {
+		return testApp.BankKeeper.GetBalance(ctx, funder, bondDenom).Amount
+	}
+	fund := func(timestamp uint64, amount sdk.Coin) error {
+		_, err := msgServer.FundDistribution(ctx, &types.MsgFundDistribution{
+			Sender:          funder.String(),
+			PeriodTimestamp: timestamp,
+			Amount:          amount,
+		})
+		return err
+	}
+
+	// funding of not scheduled distribution
+	requireT.ErrorIs(fund(time3, sdk.NewInt64Coin(bondDenom, 100)), types.ErrDistributionNotFound)
+	// funding with the wrong denom
+	requireT.ErrorIs(fund(time1, sdk.NewInt64Coin("denom", 100)), types.ErrInvalidInput)
+
+	// fund both distributions, the fundings of the same funder are summed up
+	requireT.NoError(fund(time1, sdk.NewInt64Coin(bondDenom, 500)))
+	requireT.NoError(fund(time1, sdk.NewInt64Coin(bondDenom, 200)))
+	requireT.NoError(fund(time2, sdk.NewInt64Coin(bondDenom, 300)))
+	requireT.Equal(sdkmath.NewInt(9_000).String(), funderBalance().String())
+	requireT.Equal(sdkmath.NewInt(11_000).String(), communityBalance().String())
+
+	amount, err := pseKeeper.GetDistributionFundingAmount(ctx, time1)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(700).String(), amount.String())
+
+	// the fundings are exported and imported
+	genesisState, err := pseKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.ElementsMatch([]types.DistributionFunding{
+		{PeriodTimestamp: time1, Funder: funder.String(), Amount: sdkmath.NewInt(700)},
+		{PeriodTimestamp: time2, Funder: funder.String(), Amount: sdkmath.NewInt(300)},
+	}, genesisState.DistributionFundings)
+	testApp2 := simapp.New()
+	ctx2 := testApp2.NewContext(false)
+	requireT.NoError(testApp2.PSEKeeper.InitGenesis(ctx2, *genesisState))
+	fundings2, err := testApp2.PSEKeeper.GetDistributionFundings(ctx2)
+	requireT.NoError(err)
+	requireT.ElementsMatch(genesisState.DistributionFundings, fundings2)
+
+	// the funding is distributed together with the Community allocation
+	ctx = ctx.WithBlockTime(time.Unix(int64(time1)+10, 0))
+	requireT.NoError(pseKeeper.ProcessNextDistribution(ctx))
+	requireT.Equal(sdkmath.NewInt(11_000-1_000-700).String(), communityBalance().String())
+	amount, err = pseKeeper.GetDistributionFundingAmount(ctx, time1)
+	requireT.NoError(err)
+	requireT.True(amount.IsZero())
+
+	// the funding is refunded once the distribution is removed from the schedule
+	requireT.NoError(testApp.ExecuteGovProposal(ctx, &types.MsgUpdateDistributionSchedule{
+		Authority: authority,
+		Schedule:  []types.ScheduledDistribution{newDistribution(time3)},
+	}))
+	requireT.Equal(sdkmath.NewInt(9_300).String(), funderBalance().String())
+	requireT.Equal(sdkmath.NewInt(11_000-1_000-700-300).String(), communityBalance().String())
+	fundings, err := pseKeeper.GetDistributionFundings(ctx)
+	requireT.NoError(err)
+	requireT.Empty(fundings)
+
+	// the fundings are refunded once the distributions are disabled
+	requireT.NoError(fund(time3, sdk.NewInt64Coin(bondDenom, 400)))
+	requireT.Equal(sdkmath.NewInt(8_900).String(), funderBalance().String())
+	requireT.NoError(testApp.ExecuteGovProposal(ctx, &types.MsgDisableDistributions{Authority: authority}))
+	requireT.Equal(sdkmath.NewInt(9_300).String(), funderBalance().String())
+	fundings, err = pseKeeper.GetDistributionFundings(ctx)
+	requireT.NoError(err)
+	requireT.Empty(fundings)
+
+	// funding is not allowed once the distributions are disabled
+	requireT.ErrorIs(fund(time3, sdk.NewInt64Coin(bondDenom, 100)), types.ErrInvalidInput)
+}
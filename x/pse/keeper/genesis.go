@@ -39,8 +39,9 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			return err
 		}
 		if err = k.SetDelegationTimeEntry(ctx, valAddr, delAddr, types.DelegationTimeEntry{
-			Shares:             delegationTimeEntryExported.Shares,
-			LastChangedUnixSec: delegationTimeEntryExported.LastChangedUnixSec,
+			Shares:                delegationTimeEntryExported.Shares,
+			LastChangedUnixSec:    delegationTimeEntryExported.LastChangedUnixSec,
+			DelegatedSinceUnixSec: delegationTimeEntryExported.DelegatedSinceUnixSec,
 		}); err != nil {
 			return err
 		}
@@ -57,6 +58,76 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
+	// Populate scores finalized by the delegations in the current period from genesis state
+	for _, score := range genState.ValidatorDelegatorScores {
+		valAddr, err := k.valAddressCodec.StringToBytes(score.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		delAddr, err := k.addressCodec.StringToBytes(score.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.ValidatorDelegatorScores.Set(
+			ctx, collections.Join(sdk.ValAddress(valAddr), sdk.AccAddress(delAddr)), score.Score,
+		); err != nil {
+			return err
+		}
+	}
+
+	// Populate distribution fundings from genesis state
+	for _, funding := range genState.DistributionFundings {
+		funder, err := k.addressCodec.StringToBytes(funding.Funder)
+		if err != nil {
+			return err
+		}
+		if err := k.DistributionFundings.Set(
+			ctx, collections.Join(funding.PeriodTimestamp, sdk.AccAddress(funder)), funding.Amount,
+		); err != nil {
+			return err
+		}
+	}
+
+	// Populate score checkpoints from genesis state, the hashes are recomputed from the content
+	for _, checkpoint := range genState.ScoreCheckpoints {
+		if _, err := k.SetScoreCheckpoint(ctx, checkpoint); err != nil {
+			return err
+		}
+	}
+
+	// Populate named schedules from genesis state
+	for _, schedule := range genState.NamedSchedules {
+		if err := k.NamedSchedules.Set(ctx, schedule.Name, schedule); err != nil {
+			return err
+		}
+	}
+
+	// Populate distribution preferences from genesis state
+	for _, preference := range genState.DistributionPreferences {
+		delAddr, err := k.addressCodec.StringToBytes(preference.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.DistributionPreferences.Set(ctx, delAddr, preference); err != nil {
+			return err
+		}
+	}
+
+	// Populate distribution opt-outs from genesis state
+	for _, optOut := range genState.DistributionOptOuts {
+		addr, err := k.addressCodec.StringToBytes(optOut)
+		if err != nil {
+			return err
+		}
+		if err := k.DistributionOptOuts.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetTimeScale(ctx, genState.TimeScale); err != nil {
+		return err
+	}
+
 	return k.DistributionDisabled.Set(ctx, genState.DistributionsDisabled)
 }
 
@@ -89,10 +160,11 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 				return false, err
 			}
 			delegationTimeEntriesExported = append(delegationTimeEntriesExported, types.DelegationTimeEntryExport{
-				ValidatorAddress:   valAddr,
-				DelegatorAddress:   delAddr,
-				Shares:             value.Shares,
-				LastChangedUnixSec: value.LastChangedUnixSec,
+				ValidatorAddress:      valAddr,
+				DelegatorAddress:      delAddr,
+				Shares:                value.Shares,
+				LastChangedUnixSec:    value.LastChangedUnixSec,
+				DelegatedSinceUnixSec: value.DelegatedSinceUnixSec,
 			})
 			return false, nil
 		})
@@ -118,10 +190,63 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	// Export scores finalized by the delegations in the current period
+	err = k.ValidatorDelegatorScores.Walk(ctx, nil,
+		func(key collections.Pair[sdk.ValAddress, sdk.AccAddress], value sdkmath.Int) (stop bool, err error) {
+			valAddr, err := k.valAddressCodec.BytesToString(key.K1())
+			if err != nil {
+				return false, err
+			}
+			delAddr, err := k.addressCodec.BytesToString(key.K2())
+			if err != nil {
+				return false, err
+			}
+			genesis.ValidatorDelegatorScores = append(genesis.ValidatorDelegatorScores, types.ValidatorDelegatorScore{
+				ValidatorAddress: valAddr,
+				DelegatorAddress: delAddr,
+				Score:            value,
+			})
+			return false, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
 	genesis.DistributionsDisabled, err = k.DistributionDisabled.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	fundings, err := k.GetDistributionFundings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis.DistributionFundings = append(genesis.DistributionFundings, fundings...)
+
+	genesis.ScoreCheckpoints, err = k.GetScoreCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.NamedSchedules, err = k.GetNamedSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.DistributionPreferences, err = k.GetDistributionPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.DistributionOptOuts, err = k.GetDistributionOptOuts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.TimeScale, err = k.GetTimeScale(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"sort"
 	"testing"
 	"time"
 
@@ -59,6 +60,36 @@ func TestGenesis(t *testing.T) {
 		},
 	}
 	genesisState.DistributionsDisabled = true
+	checkpointAddrs := []string{addr1, addr2}
+	sort.Strings(checkpointAddrs)
+	genesisState.ScoreCheckpoints = []types.ScoreCheckpoint{
+		{
+			ScheduledAt: uint64(now.Unix()),
+			TotalScore:  sdkmath.NewInt(300),
+			Scores: []types.AccountScore{
+				{Address: checkpointAddrs[0], Score: sdkmath.NewInt(100)},
+				{Address: checkpointAddrs[1], Score: sdkmath.NewInt(200)},
+			},
+		},
+	}
+
+	genesisState.NamedSchedules = []types.NamedSchedule{
+		{
+			Name: "partner-grant-2026",
+			ClearingAccountMappings: []types.ClearingAccountMapping{
+				{ClearingAccount: "grant", RecipientAddresses: []string{addr1}},
+			},
+			Distributions: []types.ScheduledDistribution{
+				{
+					Timestamp: uint64(now.Unix()),
+					Allocations: []types.ClearingAccountAllocation{
+						{ClearingAccount: "grant", Amount: sdkmath.NewInt(100)},
+					},
+				},
+			},
+			Disabled: true,
+		},
+	}
 
 	err := pseKeeper.InitGenesis(ctx, genesisState)
 	requireT.NoError(err)
@@ -76,6 +107,8 @@ func TestGenesis(t *testing.T) {
 	requireT.EqualExportedValues(&genesisState.Params, &got.Params)
 	requireT.EqualExportedValues(&genesisState.ScheduledDistributions, &got.ScheduledDistributions)
 	requireT.Equal(genesisState.DistributionsDisabled, got.DistributionsDisabled)
+	requireT.Equal(genesisState.ScoreCheckpoints, got.ScoreCheckpoints)
+	requireT.Equal(genesisState.NamedSchedules, got.NamedSchedules)
 }
 
 // TestGenesis_EmptyState tests that default genesis state is valid and can be imported/exported.
@@ -183,6 +216,24 @@ func TestGenesis_InvalidState(t *testing.T) {
 			},
 			expectError: "duplicate address",
 		},
+		{
+			name: "invalid_score_checkpoint_total",
+			modifyGenesis: func(gs *types.GenesisState) {
+				gs.ScoreCheckpoints = []types.ScoreCheckpoint{
+					{
+						ScheduledAt: uint64(time.Now().Unix()),
+						TotalScore:  sdkmath.NewInt(200),
+						Scores: []types.AccountScore{
+							{
+								Address: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+								Score:   sdkmath.NewInt(100),
+							},
+						},
+					},
+				}
+			},
+			expectError: "doesn't match the sum of the scores",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3,9 +3,18 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
 )
 
+// maxScoresSnapshotLimit is the maximum number of the scores returned in one page of the snapshot.
+const maxScoresSnapshotLimit = 1000
+
 // QueryService serves grpc requests for the module.
 type QueryService struct {
 	keeper Keeper
@@ -51,6 +60,55 @@ func (qs QueryService) Score(ctx context.Context, req *types.QueryScoreRequest)
 	}, nil
 }
 
+// ScoresSnapshot returns the current total scores of all the accounts. The request must be executed at the height
+// the snapshot is pinned to, so all the pages are read from the same state.
+func (qs QueryService) ScoresSnapshot(
+	ctx context.Context,
+	req *types.QueryScoresSnapshotRequest,
+) (*types.QueryScoresSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if req.Height != 0 && req.Height != height {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"the snapshot is pinned to height %d, but the query is executed at height %d", req.Height, height,
+		)
+	}
+
+	var (
+		key   []byte
+		limit uint64 = query.DefaultLimit
+	)
+	if req.Pagination != nil {
+		if req.Pagination.Offset != 0 || req.Pagination.Reverse || req.Pagination.CountTotal {
+			return nil, status.Error(codes.InvalidArgument, "only the key based pagination is supported")
+		}
+		key = req.Pagination.Key
+		if req.Pagination.Limit != 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+	if limit > maxScoresSnapshotLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be greater than %d", maxScoresSnapshotLimit)
+	}
+
+	scores, nextKey, err := qs.keeper.GetScoresPage(ctx, key, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScoresSnapshotResponse{
+		Height: height,
+		Scores: scores,
+		Pagination: &query.PageResponse{
+			NextKey: nextKey,
+		},
+	}, nil
+}
+
 // ScheduledDistributions returns all future allocation schedules.
 // Past scheduled distributions are automatically removed after processing,
 // so all scheduled distributions in storage are future scheduled distributions.
@@ -86,3 +144,156 @@ func (qs QueryService) ClearingAccountBalances(
 		Balances: balances,
 	}, nil
 }
+
+// ClearingAccountStatus returns the balances of all PSE clearing accounts reconciled with their remaining scheduled
+// outflow.
+func (qs QueryService) ClearingAccountStatus(
+	ctx context.Context,
+	req *types.QueryClearingAccountStatusRequest,
+) (*types.QueryClearingAccountStatusResponse, error) {
+	statuses, err := qs.keeper.GetClearingAccountStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryClearingAccountStatusResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+// RemainingUnlocks returns the total amount still scheduled to be distributed from each PSE clearing account.
+func (qs QueryService) RemainingUnlocks(
+	ctx context.Context,
+	req *types.QueryRemainingUnlocksRequest,
+) (*types.QueryRemainingUnlocksResponse, error) {
+	remainingUnlocks, err := qs.keeper.GetRemainingUnlocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRemainingUnlocksResponse{
+		RemainingUnlocks: remainingUnlocks,
+	}, nil
+}
+
+// ScoreCheckpoint returns the score checkpoint recorded at the community distribution by its hash.
+func (qs QueryService) ScoreCheckpoint(
+	ctx context.Context,
+	req *types.QueryScoreCheckpointRequest,
+) (*types.QueryScoreCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	hash, err := types.DecodeScoreCheckpointHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	checkpoint, err := qs.keeper.GetScoreCheckpoint(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryScoreCheckpointResponse{
+		Checkpoint: checkpoint,
+	}, nil
+}
+
+// NamedSchedules returns all the named distribution schedules.
+func (qs QueryService) NamedSchedules(
+	ctx context.Context,
+	req *types.QueryNamedSchedulesRequest,
+) (*types.QueryNamedSchedulesResponse, error) {
+	schedules, err := qs.keeper.GetNamedSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryNamedSchedulesResponse{
+		Schedules: schedules,
+	}, nil
+}
+
+// NamedSchedule returns the named distribution schedule with the status of its clearing accounts.
+func (qs QueryService) NamedSchedule(
+	ctx context.Context,
+	req *types.QueryNamedScheduleRequest,
+) (*types.QueryNamedScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	schedule, err := qs.keeper.GetNamedSchedule(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	clearingAccounts, err := qs.keeper.GetNamedScheduleClearingAccounts(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryNamedScheduleResponse{
+		Schedule:         schedule,
+		ClearingAccounts: clearingAccounts,
+	}, nil
+}
+
+// DistributionPreference returns the validator the Community distribution payouts of the delegator are delegated to.
+func (qs QueryService) DistributionPreference(
+	ctx context.Context,
+	req *types.QueryDistributionPreferenceRequest,
+) (*types.QueryDistributionPreferenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+	valAddr, err := qs.keeper.GetDistributionPreference(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDistributionPreferenceResponse{
+		ValidatorAddress: valAddr,
+	}, nil
+}
+
+// DistributionOptOuts returns the addresses opted out of the Community distributions.
+func (qs QueryService) DistributionOptOuts(
+	ctx context.Context,
+	req *types.QueryDistributionOptOutsRequest,
+) (*types.QueryDistributionOptOutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addrs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.DistributionOptOuts,
+		req.Pagination,
+		func(addr sdk.AccAddress, _ collections.NoValue) (string, error) {
+			return qs.keeper.addressCodec.BytesToString(addr)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryDistributionOptOutsResponse{
+		Addresses:  addrs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DistributionOptOut returns whether the address is opted out of the Community distributions.
+func (qs QueryService) DistributionOptOut(
+	ctx context.Context,
+	req *types.QueryDistributionOptOutRequest,
+) (*types.QueryDistributionOptOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	optedOut, err := qs.keeper.IsDistributionOptedOut(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDistributionOptOutResponse{
+		OptedOut: optedOut,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
@@ -439,3 +440,83 @@ func TestQueryClearingAccountBalances(t *testing.T) {
 		requireT.True(balanceMap[types.ClearingAccountInvestors].IsZero())
 	})
 }
+
+func TestQueryScoresSnapshot(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockTime(time.Now()).WithBlockHeight(10)
+	queryService := keeper.NewQueryService(testApp.PSEKeeper)
+
+	validators, err := testApp.StakingKeeper.GetAllValidators(ctx)
+	requireT.NoError(err)
+	requireT.NotEmpty(validators)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	requireT.NoError(err)
+
+	delegatorWithScore := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	delegator := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	undelegated1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	undelegated2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	for _, delAddr := range []sdk.AccAddress{delegatorWithScore, delegator} {
+		requireT.NoError(testApp.PSEKeeper.SetDelegationTimeEntry(ctx, valAddr, delAddr, types.DelegationTimeEntry{
+			LastChangedUnixSec: ctx.BlockTime().Unix(),
+			Shares:             sdkmath.LegacyNewDec(100),
+		}))
+	}
+	expectedScores := map[string]sdkmath.Int{
+		delegatorWithScore.String(): sdkmath.NewInt(10),
+		delegator.String():          sdkmath.NewInt(0),
+		undelegated1.String():       sdkmath.NewInt(100),
+		undelegated2.String():       sdkmath.NewInt(200),
+	}
+	for _, delAddr := range []sdk.AccAddress{delegatorWithScore, undelegated1, undelegated2} {
+		requireT.NoError(testApp.PSEKeeper.AccountScoreSnapshot.Set(ctx, delAddr, expectedScores[delAddr.String()]))
+	}
+
+	// all the scores are returned in one page
+	resp, err := queryService.ScoresSnapshot(ctx, &types.QueryScoresSnapshotRequest{})
+	requireT.NoError(err)
+	requireT.Equal(int64(10), resp.Height)
+	requireT.Empty(resp.Pagination.NextKey)
+	allScores := resp.Scores
+
+	// the scores are returned page by page, every account is returned once
+	var pagedScores []types.AccountScore
+	req := &types.QueryScoresSnapshotRequest{
+		Height:     10,
+		Pagination: &query.PageRequest{Limit: 1},
+	}
+	for {
+		resp, err := queryService.ScoresSnapshot(ctx, req)
+		requireT.NoError(err)
+		requireT.LessOrEqual(len(resp.Scores), 1)
+		pagedScores = append(pagedScores, resp.Scores...)
+		if len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination.Key = resp.Pagination.NextKey
+	}
+	requireT.Equal(allScores, pagedScores)
+
+	returnedScores := map[string]sdkmath.Int{}
+	for _, score := range allScores {
+		requireT.NotContains(returnedScores, score.Address)
+		returnedScores[score.Address] = score.Score
+	}
+	for addr, score := range expectedScores {
+		requireT.Contains(returnedScores, addr)
+		requireT.Equal(score.String(), returnedScores[addr].String())
+	}
+
+	// the query executed at the other height is rejected
+	_, err = queryService.ScoresSnapshot(ctx, &types.QueryScoresSnapshotRequest{Height: 9})
+	requireT.Error(err)
+
+	// the offset pagination is not supported
+	_, err = queryService.ScoresSnapshot(ctx, &types.QueryScoresSnapshotRequest{
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	requireT.Error(err)
+}
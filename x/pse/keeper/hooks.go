@@ -35,15 +35,16 @@ func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddre
 	delegationTimeEntry, err := h.k.GetDelegationTimeEntry(ctx, valAddr, delAddr)
 	if errors.Is(err, collections.ErrNotFound) {
 		delegationTimeEntry = types.DelegationTimeEntry{
-			LastChangedUnixSec: blockTimeUnixSeconds,
-			Shares:             delegation.Shares,
+			LastChangedUnixSec:    blockTimeUnixSeconds,
+			Shares:                delegation.Shares,
+			DelegatedSinceUnixSec: blockTimeUnixSeconds,
 		}
 	} else if err != nil {
 		return err
 	}
 
-	// Stop score addition for excluded addresses
-	isExcluded, err := h.k.IsExcludedAddress(ctx, delAddr)
+	// Stop score addition for excluded addresses and module accounts
+	isExcluded, err := h.k.IsScoreAccrualDisabled(ctx, delAddr)
 	if err != nil {
 		return err
 	}
@@ -59,16 +60,40 @@ func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddre
 		return err
 	}
 
-	addedScore, err := calculateAddedScore(ctx, h.k, valAddr, delegationTimeEntry)
+	minDelegationAmount, err := h.k.getMinDelegationAmount(ctx)
+	if err != nil {
+		return err
+	}
+	addedScore, err := calculateAddedScore(ctx, h.k, valAddr, delegationTimeEntry, minDelegationAmount)
 	if err != nil {
 		return err
 	}
 	newScore := lastScore.Add(addedScore)
+	if err := h.k.addValidatorDelegatorScore(ctx, valAddr, delAddr, addedScore); err != nil {
+		return err
+	}
 
-	// Update DelegationTimeEntry for non-excluded addresses
+	// Dust delegations don't accrue the score, so their entries are not stored at all
+	isDust, err := isBelowMinDelegationAmount(ctx, h.k, valAddr, delegation.Shares, minDelegationAmount)
+	if err != nil {
+		return err
+	}
+	if isDust {
+		if err := h.k.RemoveDelegationTimeEntry(ctx, valAddr, delAddr); err != nil {
+			return err
+		}
+		if newScore.IsZero() {
+			return nil
+		}
+		return h.k.AccountScoreSnapshot.Set(ctx, delAddr, newScore)
+	}
+
+	// Update DelegationTimeEntry for non-excluded addresses, the delegation is held continuously since the entry
+	// was created
 	if err := h.k.SetDelegationTimeEntry(ctx, valAddr, delAddr, types.DelegationTimeEntry{
-		LastChangedUnixSec: blockTimeUnixSeconds,
-		Shares:             delegation.Shares,
+		LastChangedUnixSec:    blockTimeUnixSeconds,
+		Shares:                delegation.Shares,
+		DelegatedSinceUnixSec: delegationTimeEntry.DelegatedSinceUnixSec,
 	}); err != nil {
 		return err
 	}
@@ -86,8 +111,8 @@ func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddre
 		return err
 	}
 
-	// Stop score addition for excluded addresses
-	isExcluded, err := h.k.IsExcludedAddress(ctx, delAddr)
+	// Stop score addition for excluded addresses and module accounts
+	isExcluded, err := h.k.IsScoreAccrualDisabled(ctx, delAddr)
 	if err != nil {
 		return err
 	}
@@ -103,11 +128,18 @@ func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddre
 		return err
 	}
 
-	addedScore, err := calculateAddedScore(ctx, h.k, valAddr, delegationTimeEntry)
+	minDelegationAmount, err := h.k.getMinDelegationAmount(ctx)
+	if err != nil {
+		return err
+	}
+	addedScore, err := calculateAddedScore(ctx, h.k, valAddr, delegationTimeEntry, minDelegationAmount)
 	if err != nil {
 		return err
 	}
 	newScore := lastScore.Add(addedScore)
+	if err := h.k.addValidatorDelegatorScore(ctx, valAddr, delAddr, addedScore); err != nil {
+		return err
+	}
 
 	// Remove DelegationTimeEntry for non-excluded addresses
 	if err := h.k.RemoveDelegationTimeEntry(ctx, valAddr, delAddr); err != nil {
@@ -117,11 +149,14 @@ func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddre
 	return h.k.AccountScoreSnapshot.Set(ctx, delAddr, newScore)
 }
 
+// calculateAddedScore calculates the score accrued by the delegation since the last change.
+// The delegations below the minimum delegation amount don't accrue the score.
 func calculateAddedScore(
 	ctx context.Context,
 	keeper Keeper,
 	valAddr sdk.ValAddress,
 	delegationTimeEntry types.DelegationTimeEntry,
+	minDelegationAmount sdkmath.Int,
 ) (sdkmath.Int, error) {
 	val, err := keeper.stakingKeeper.GetValidator(ctx, valAddr)
 	if err != nil {
@@ -129,17 +164,191 @@ func calculateAddedScore(
 	}
 
 	blockTimeUnixSeconds := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	return accruedScore(val, delegationTimeEntry, blockTimeUnixSeconds, minDelegationAmount), nil
+}
+
+// accruedScore calculates the score accrued by the delegation to the validator since the last change up to the block
+// time. It doesn't access the store, so the validator loaded once is reused for all its delegations.
+func accruedScore(
+	val stakingtypes.Validator,
+	delegationTimeEntry types.DelegationTimeEntry,
+	blockTimeUnixSeconds int64,
+	minDelegationAmount sdkmath.Int,
+) sdkmath.Int {
 	delegationDuration := blockTimeUnixSeconds - delegationTimeEntry.LastChangedUnixSec
 	previousDelegatedTokens := val.TokensFromShares(delegationTimeEntry.Shares).TruncateInt()
-	delegationScore := previousDelegatedTokens.MulRaw(delegationDuration)
-	return delegationScore, nil
+	if previousDelegatedTokens.LT(minDelegationAmount) {
+		return sdkmath.NewInt(0)
+	}
+	return previousDelegatedTokens.MulRaw(delegationDuration)
+}
+
+// isBelowMinDelegationAmount checks if the delegated tokens are below the minimum delegation amount.
+func isBelowMinDelegationAmount(
+	ctx context.Context,
+	keeper Keeper,
+	valAddr sdk.ValAddress,
+	shares sdkmath.LegacyDec,
+	minDelegationAmount sdkmath.Int,
+) (bool, error) {
+	if !minDelegationAmount.IsPositive() {
+		return false, nil
+	}
+	val, err := keeper.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return false, err
+	}
+	return val.TokensFromShares(shares).TruncateInt().LT(minDelegationAmount), nil
 }
 
-// BeforeValidatorSlashed implements the staking hooks interface.
+// BeforeValidatorSlashed implements the staking hooks interface. The score accrued by the delegations to the slashed
+// validator in the current distribution period is reduced by the slash fraction multiplied by the penalty multiplier.
+// It includes both the score finalized into the account score snapshot when the delegation was changed or removed
+// and the score accrued since the last change, which is finalized by the slashing. The future score is reduced by the
+// slashing itself, since the score is accrued by the delegated tokens.
 func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec) error {
+	multiplier, err := h.k.getSlashingScorePenaltyMultiplier(ctx)
+	if err != nil {
+		return err
+	}
+	penaltyRate := sdkmath.LegacyMinDec(fraction.Mul(multiplier), sdkmath.LegacyOneDec())
+	if !penaltyRate.IsPositive() {
+		return nil
+	}
+
+	// The delegators are collected first, since the state is updated below. The delegations removed in the current
+	// period have the finalized score only, the delegations never changed in the current period have the entry only.
+	var delegators []sdk.AccAddress
+	seen := map[string]struct{}{}
+	collect := func(key collections.Pair[sdk.ValAddress, sdk.AccAddress]) {
+		if _, found := seen[string(key.K2())]; found {
+			return
+		}
+		seen[string(key.K2())] = struct{}{}
+		delegators = append(delegators, key.K2())
+	}
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, sdk.AccAddress](valAddr)
+	err = h.k.ValidatorDelegatorScores.Walk(ctx, rng,
+		func(key collections.Pair[sdk.ValAddress, sdk.AccAddress], _ sdkmath.Int) (bool, error) {
+			collect(key)
+			return false, nil
+		})
+	if err != nil {
+		return err
+	}
+	err = h.k.ValidatorDelegators.Walk(ctx, rng,
+		func(key collections.Pair[sdk.ValAddress, sdk.AccAddress]) (bool, error) {
+			collect(key)
+			return false, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	minDelegationAmount, err := h.k.getMinDelegationAmount(ctx)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, delAddr := range delegators {
+		lastScore, err := h.k.AccountScoreSnapshot.Get(ctx, delAddr)
+		if errors.Is(err, collections.ErrNotFound) {
+			lastScore = sdkmath.NewInt(0)
+		} else if err != nil {
+			return err
+		}
+
+		finalizedScore, err := h.k.ValidatorDelegatorScores.Get(ctx, collections.Join(valAddr, delAddr))
+		if errors.Is(err, collections.ErrNotFound) {
+			finalizedScore = sdkmath.NewInt(0)
+		} else if err != nil {
+			return err
+		}
+
+		// The validator is not slashed yet, so the score is calculated from the tokens before the slashing
+		addedScore := sdkmath.NewInt(0)
+		delegationTimeEntry, err := h.k.GetDelegationTimeEntry(ctx, valAddr, delAddr)
+		switch {
+		case err == nil:
+			addedScore, err = calculateAddedScore(ctx, h.k, valAddr, delegationTimeEntry, minDelegationAmount)
+			if err != nil {
+				return err
+			}
+			delegationTimeEntry.LastChangedUnixSec = sdkCtx.BlockTime().Unix()
+			if err := h.k.DelegationTimeEntries.Set(
+				ctx, collections.Join(delAddr, valAddr), delegationTimeEntry,
+			); err != nil {
+				return err
+			}
+		case !errors.Is(err, collections.ErrNotFound):
+			return err
+		}
+
+		accruedScore := finalizedScore.Add(addedScore)
+		removedScore := penaltyRate.MulInt(accruedScore).TruncateInt()
+		newScore := lastScore.Add(addedScore).Sub(removedScore)
+
+		if err := h.k.setValidatorDelegatorScore(
+			ctx, valAddr, delAddr, accruedScore.Sub(removedScore),
+		); err != nil {
+			return err
+		}
+		if err := h.k.AccountScoreSnapshot.Set(ctx, delAddr, newScore); err != nil {
+			return err
+		}
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventScoreSlashed{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			SlashFraction:    fraction,
+			PenaltyRate:      penaltyRate,
+			AccruedScore:     accruedScore,
+			RemovedScore:     removedScore,
+			Score:            newScore,
+		}); err != nil {
+			h.k.logger.Error("failed to emit score slashed event", "error", err)
+		}
+	}
+
 	return nil
 }
 
+// addValidatorDelegatorScore adds the score finalized into the account score snapshot by the delegation to the score
+// finalized by the delegation in the current distribution period.
+func (k Keeper) addValidatorDelegatorScore(
+	ctx context.Context,
+	valAddr sdk.ValAddress,
+	delAddr sdk.AccAddress,
+	score sdkmath.Int,
+) error {
+	if !score.IsPositive() {
+		return nil
+	}
+	key := collections.Join(valAddr, delAddr)
+	finalizedScore, err := k.ValidatorDelegatorScores.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		finalizedScore = sdkmath.NewInt(0)
+	} else if err != nil {
+		return err
+	}
+	return k.ValidatorDelegatorScores.Set(ctx, key, finalizedScore.Add(score))
+}
+
+// setValidatorDelegatorScore sets the score finalized by the delegation in the current distribution period, the zero
+// score is removed.
+func (k Keeper) setValidatorDelegatorScore(
+	ctx context.Context,
+	valAddr sdk.ValAddress,
+	delAddr sdk.AccAddress,
+	score sdkmath.Int,
+) error {
+	key := collections.Join(valAddr, delAddr)
+	if !score.IsPositive() {
+		return k.ValidatorDelegatorScores.Remove(ctx, key)
+	}
+	return k.ValidatorDelegatorScores.Set(ctx, key, score)
+}
+
 // The following hooks don't need to be implemented.
 
 // AfterValidatorCreated implements the staking hooks interface.
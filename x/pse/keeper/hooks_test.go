@@ -7,9 +7,15 @@ import (
 	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
@@ -176,6 +182,34 @@ func TestKeeper_Hooks(t *testing.T) {
 				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(11*8)) },
 			},
 		},
+		{
+			name: "delegation tokenized by liquid staking provider",
+			actions: []func(*runEnv){
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 11) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[0], 3) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { tokenizeAction(r, r.delegators[0], r.validators[0], 11) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(11*8)) },
+				func(r *runEnv) { assertNoScoreAction(r, r.custodian) },
+				func(r *runEnv) { waitAction(r, time.Second*5) },
+				func(r *runEnv) { tokenizeAction(r, r.delegators[1], r.validators[0], 3) },
+				func(r *runEnv) { assertNoScoreAction(r, r.custodian) },
+				func(r *runEnv) { redeemAction(r, r.delegators[0], r.validators[0], 14) },
+				func(r *runEnv) { waitAction(r, time.Second*4) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 1) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(11*8+14*4)) },
+				func(r *runEnv) { assertNoScoreAction(r, r.custodian) },
+			},
+		},
+		{
+			name: "delegation held by module account",
+			actions: []func(*runEnv){
+				func(r *runEnv) { delegateAction(r, r.moduleAccount, r.validators[0], 10) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { delegateAction(r, r.moduleAccount, r.validators[0], 1) },
+				func(r *runEnv) { assertNoScoreAction(r, r.moduleAccount) },
+			},
+		},
 		{
 			name: "new delegation with time rounding down",
 			actions: []func(*runEnv){
@@ -185,6 +219,100 @@ func TestKeeper_Hooks(t *testing.T) {
 				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(11*7)) },
 			},
 		},
+		{
+			name: "dust delegation doesn't accrue score",
+			actions: []func(*runEnv){
+				func(r *runEnv) { setMinDelegationAmountAction(r, 10) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 9) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[0], 12) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { assertNoScoreAction(r, r.delegators[0]) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 1) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[0], 1) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(0)) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[1], sdkmath.NewInt(12*8)) },
+				func(r *runEnv) { waitAction(r, time.Second*5) },
+				func(r *runEnv) { undelegateAction(r, r.delegators[0], r.validators[0], 1) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(10*5)) },
+				func(r *runEnv) { assertDelegationTimeEntriesCountAction(r, r.delegators[0], 0) },
+			},
+		},
+		{
+			name: "min delegation amount increase removes dust entries",
+			actions: []func(*runEnv){
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 9) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[0], 12) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { setMinDelegationAmountAction(r, 10) },
+				func(r *runEnv) { assertDelegationTimeEntriesCountAction(r, r.delegators[0], 0) },
+				func(r *runEnv) { assertDelegationTimeEntriesCountAction(r, r.delegators[1], 1) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[0], 1) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[1], sdkmath.NewInt(12*8)) },
+			},
+		},
+		{
+			name: "validator slashed, score accrued since last change is reduced",
+			actions: []func(*runEnv){
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 10) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[1], 10) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { slashAction(r, r.validators[0], sdkmath.LegacyNewDecWithPrec(5, 1)) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(10*8/2)) },
+				func(r *runEnv) { delegateAction(r, r.delegators[1], r.validators[1], 1) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[1], sdkmath.NewInt(10*8)) },
+			},
+		},
+		{
+			name: "validator slashed, penalty multiplier is applied and capped",
+			actions: []func(*runEnv){
+				func(r *runEnv) { setSlashingScorePenaltyMultiplierAction(r, sdkmath.LegacyNewDec(3)) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 10) },
+				func(r *runEnv) { waitAction(r, time.Second*10) },
+				func(r *runEnv) { slashAction(r, r.validators[0], sdkmath.LegacyNewDecWithPrec(1, 1)) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(10*10*7/10)) },
+				func(r *runEnv) { waitAction(r, time.Second*10) },
+				func(r *runEnv) { slashAction(r, r.validators[0], sdkmath.LegacyNewDecWithPrec(5, 1)) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(0)) },
+			},
+		},
+		{
+			name: "validator slashed, score finalized by the modified delegation is reduced",
+			actions: []func(*runEnv){
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 10) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[1], 10) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 1) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[1], 1) },
+				func(r *runEnv) { waitAction(r, time.Second*2) },
+				func(r *runEnv) { slashAction(r, r.validators[0], sdkmath.LegacyNewDecWithPrec(5, 1)) },
+				// the score of the other validator is kept, the score accrued with the slashed one is halved
+				func(r *runEnv) {
+					assertScoreAction(r, r.delegators[0], sdkmath.NewInt(10*8+(10*8+11*2)/2))
+				},
+			},
+		},
+		{
+			name: "validator slashed, score finalized by the removed delegation is reduced",
+			actions: []func(*runEnv){
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 10) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { undelegateAction(r, r.delegators[0], r.validators[0], 10) },
+				func(r *runEnv) { assertDelegationTimeEntriesCountAction(r, r.delegators[0], 0) },
+				func(r *runEnv) { slashAction(r, r.validators[0], sdkmath.LegacyNewDecWithPrec(5, 1)) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(10*8/2)) },
+			},
+		},
+		{
+			name: "validator slashed, zero multiplier disables the penalty",
+			actions: []func(*runEnv){
+				func(r *runEnv) { setSlashingScorePenaltyMultiplierAction(r, sdkmath.LegacyZeroDec()) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 10) },
+				func(r *runEnv) { waitAction(r, time.Second*8) },
+				func(r *runEnv) { slashAction(r, r.validators[0], sdkmath.LegacyNewDecWithPrec(5, 1)) },
+				func(r *runEnv) { delegateAction(r, r.delegators[0], r.validators[0], 1) },
+				func(r *runEnv) { assertScoreAction(r, r.delegators[0], sdkmath.NewInt(10*8)) },
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -224,6 +352,14 @@ func TestKeeper_Hooks(t *testing.T) {
 				runContext.delegators = append(runContext.delegators, delegator)
 			}
 
+			// add interchain account of the liquid staking provider holding the tokenized delegations in custody.
+			registerInterchainAccount(runContext)
+
+			// add module account delegating on its own.
+			moduleAccount := authtypes.NewEmptyModuleAccount("custody")
+			testApp.AccountKeeper.SetAccount(ctx, testApp.AccountKeeper.NewAccount(ctx, moduleAccount))
+			runContext.moduleAccount = moduleAccount.GetAddress()
+
 			// run actions.
 			for _, action := range tc.actions {
 				action(runContext)
@@ -237,7 +373,11 @@ type runEnv struct {
 	ctx        sdk.Context
 	delegators []sdk.AccAddress
 	validators []sdk.ValAddress
-	requireT   *require.Assertions
+	// custodian is the interchain account controlled by the liquid staking provider.
+	custodian       sdk.AccAddress
+	custodianPortID string
+	moduleAccount   sdk.AccAddress
+	requireT        *require.Assertions
 }
 
 func assertScoreAction(r *runEnv, delAddr sdk.AccAddress, expectedScore sdkmath.Int) {
@@ -248,6 +388,17 @@ func assertScoreAction(r *runEnv, delAddr sdk.AccAddress, expectedScore sdkmath.
 	r.requireT.Equal(expectedScore, score)
 }
 
+func assertNoScoreAction(r *runEnv, delAddr sdk.AccAddress) {
+	_, err := r.testApp.PSEKeeper.AccountScoreSnapshot.Get(r.ctx, delAddr)
+	r.requireT.ErrorIs(err, collections.ErrNotFound)
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
+	iter, err := r.testApp.PSEKeeper.DelegationTimeEntries.Iterate(r.ctx, rng)
+	r.requireT.NoError(err)
+	defer iter.Close()
+	r.requireT.False(iter.Valid())
+}
+
 func assertDistributionAction(r *runEnv, balances map[*sdk.AccAddress]sdkmath.Int) {
 	stakingQuerier := stakingkeeper.NewQuerier(r.testApp.StakingKeeper)
 	for addr, expectedBalance := range balances {
@@ -289,6 +440,45 @@ func assertScoreResetAction(r *runEnv) {
 	r.requireT.NoError(err)
 }
 
+func assertDelegationTimeEntriesCountAction(r *runEnv, delAddr sdk.AccAddress, expectedCount int) {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
+	iter, err := r.testApp.PSEKeeper.DelegationTimeEntries.Iterate(r.ctx, rng)
+	r.requireT.NoError(err)
+	defer iter.Close()
+	keys, err := iter.Keys()
+	r.requireT.NoError(err)
+	r.requireT.Len(keys, expectedCount)
+}
+
+func setMinDelegationAmountAction(r *runEnv, amount int64) {
+	r.requireT.NoError(r.testApp.PSEKeeper.UpdateMinDelegationAmount(
+		r.ctx,
+		r.testApp.GovAuthority(),
+		sdkmath.NewInt(amount),
+	))
+}
+
+func setMinDelegationDurationAction(r *runEnv, duration time.Duration) {
+	r.requireT.NoError(r.testApp.PSEKeeper.UpdateMinDelegationDuration(
+		r.ctx,
+		r.testApp.GovAuthority(),
+		duration,
+	))
+}
+
+func setSlashingScorePenaltyMultiplierAction(r *runEnv, multiplier sdkmath.LegacyDec) {
+	r.requireT.NoError(r.testApp.PSEKeeper.UpdateSlashingScorePenaltyMultiplier(
+		r.ctx,
+		r.testApp.GovAuthority(),
+		multiplier,
+	))
+}
+
+// slashAction invokes the hook executed by the staking module before the validator is slashed.
+func slashAction(r *runEnv, valAddr sdk.ValAddress, fraction sdkmath.LegacyDec) {
+	r.requireT.NoError(r.testApp.PSEKeeper.Hooks().BeforeValidatorSlashed(r.ctx, valAddr, fraction))
+}
+
 func delegateAction(r *runEnv, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount int64) {
 	mintAndSendCoin(r, delAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(amount))))
 	msg := &stakingtypes.MsgDelegate{
@@ -300,6 +490,94 @@ func delegateAction(r *runEnv, delAddr sdk.AccAddress, valAddr sdk.ValAddress, a
 	r.requireT.NoError(err)
 }
 
+// registerInterchainAccount opens the interchain account channel on the host side, so the account of the liquid
+// staking provider is created by the ICA host the same way as when the channel is opened by the relayer.
+func registerInterchainAccount(r *runEnv) {
+	const (
+		clientID     = "07-tendermint-0"
+		connectionID = "connection-0"
+		channelID    = "channel-0"
+	)
+
+	r.testApp.IBCKeeper.ConnectionKeeper.SetConnection(r.ctx, connectionID, connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN,
+		clientID,
+		connectiontypes.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		connectiontypes.GetCompatibleVersions(),
+		0,
+	))
+
+	provider, _ := r.testApp.GenAccount(r.ctx)
+	portID, err := icatypes.NewControllerPortID(provider.String())
+	r.requireT.NoError(err)
+	counterparty := channeltypes.NewCounterparty(portID, channelID)
+	version, err := r.testApp.ICAHostKeeper.OnChanOpenTry(
+		r.ctx,
+		channeltypes.ORDERED,
+		[]string{connectionID},
+		icatypes.HostPortID,
+		channelID,
+		counterparty,
+		icatypes.NewDefaultMetadataString(connectionID, connectionID),
+	)
+	r.requireT.NoError(err)
+	r.testApp.IBCKeeper.ChannelKeeper.SetChannel(r.ctx, icatypes.HostPortID, channelID, channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.ORDERED, counterparty, []string{connectionID}, version,
+	))
+	r.requireT.NoError(r.testApp.ICAHostKeeper.OnChanOpenConfirm(r.ctx, icatypes.HostPortID, channelID))
+
+	metadata, err := icatypes.MetadataFromVersion(version)
+	r.requireT.NoError(err)
+	r.custodian = sdk.MustAccAddressFromBech32(metadata.Address)
+	r.custodianPortID = portID
+}
+
+// icaExecuteAction executes the messages by the interchain account of the liquid staking provider, the way the ICA
+// host executes the packet sent by the controller.
+func icaExecuteAction(r *runEnv, msgs ...proto.Message) {
+	data, err := icatypes.SerializeCosmosTx(r.testApp.AppCodec(), msgs, icatypes.EncodingProtobuf)
+	r.requireT.NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	channelID, found := r.testApp.ICAHostKeeper.GetActiveChannelID(r.ctx, "connection-0", r.custodianPortID)
+	r.requireT.True(found)
+	_, err = r.testApp.ICAHostKeeper.OnRecvPacket(r.ctx, channeltypes.Packet{
+		SourcePort:         r.custodianPortID,
+		SourceChannel:      channelID,
+		DestinationPort:    icatypes.HostPortID,
+		DestinationChannel: channelID,
+		Data:               packetData.GetBytes(),
+	})
+	r.requireT.NoError(err)
+}
+
+// tokenizeAction tokenizes the delegation by the liquid staking provider: the delegator undelegates and deposits the
+// tokens, which are delegated by the interchain account of the provider holding them in custody.
+func tokenizeAction(r *runEnv, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount int64) {
+	undelegateAction(r, delAddr, valAddr, amount)
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)
+	mintAndSendCoin(r, delAddr, sdk.NewCoins(coin))
+	r.requireT.NoError(r.testApp.BankKeeper.SendCoins(r.ctx, delAddr, r.custodian, sdk.NewCoins(coin)))
+	icaExecuteAction(r, &stakingtypes.MsgDelegate{
+		DelegatorAddress: r.custodian.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           coin,
+	})
+}
+
+// redeemAction redeems the tokenized delegation: the interchain account of the liquid staking provider undelegates
+// the tokens, and the delegator delegates them again.
+func redeemAction(r *runEnv, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount int64) {
+	icaExecuteAction(r, &stakingtypes.MsgUndelegate{
+		DelegatorAddress: r.custodian.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+	})
+	delegateAction(r, delAddr, valAddr, amount)
+}
+
 func undelegateAction(r *runEnv, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount int64) {
 	msg := &stakingtypes.MsgUndelegate{
 		DelegatorAddress: delAddr.String(),
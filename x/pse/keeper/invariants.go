@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	invarianttypes "github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// ClearingAccountBalancesInvariantRoute is the route of the clearing account balances invariant.
+const ClearingAccountBalancesInvariantRoute = "clearing-account-balances"
+
+// RegisterInvariants registers the pse module invariants.
+func RegisterInvariants(ir invarianttypes.Registry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, ClearingAccountBalancesInvariantRoute, ClearingAccountBalancesInvariant(k))
+}
+
+// ClearingAccountBalancesInvariant checks that each clearing account holds enough funds to cover all its scheduled
+// allocations, including the fundings escrowed in the Community clearing account. The check is skipped once the
+// distributions are disabled, since the schedule isn't processed anymore.
+func ClearingAccountBalancesInvariant(k Keeper) invarianttypes.InvariantFunc {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken, err := k.clearingAccountBalancesInvariant(ctx)
+		if err != nil {
+			return invarianttypes.FormatInvariant(
+				types.ModuleName, ClearingAccountBalancesInvariantRoute, fmt.Sprintf("failed to check: %s", err),
+			), true
+		}
+
+		return invarianttypes.FormatInvariant(types.ModuleName, ClearingAccountBalancesInvariantRoute, msg), broken
+	}
+}
+
+func (k Keeper) clearingAccountBalancesInvariant(ctx sdk.Context) (string, bool, error) {
+	disabled, err := k.DistributionDisabled.Get(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	if disabled {
+		return "distributions are disabled", false, nil
+	}
+
+	schedule, err := k.GetDistributionSchedule(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	scheduled := make(map[string]sdkmath.Int)
+	for _, distribution := range schedule {
+		for _, allocation := range distribution.Allocations {
+			total, ok := scheduled[allocation.ClearingAccount]
+			if !ok {
+				total = sdkmath.ZeroInt()
+			}
+			scheduled[allocation.ClearingAccount] = total.Add(allocation.Amount)
+		}
+	}
+
+	// the fundings are escrowed in the Community clearing account
+	fundings, err := k.GetDistributionFundings(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	for _, funding := range fundings {
+		total, ok := scheduled[types.ClearingAccountCommunity]
+		if !ok {
+			total = sdkmath.ZeroInt()
+		}
+		scheduled[types.ClearingAccountCommunity] = total.Add(funding.Amount)
+	}
+
+	balances, err := k.GetClearingAccountBalances(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	var violations []string
+	for _, balance := range balances {
+		total, ok := scheduled[balance.ClearingAccount]
+		if !ok {
+			continue
+		}
+		if balance.Balance.LT(total) {
+			violations = append(violations, fmt.Sprintf(
+				"clearing account %s: scheduled %s is greater than the balance %s",
+				balance.ClearingAccount, total, balance.Balance,
+			))
+		}
+		delete(scheduled, balance.ClearingAccount)
+	}
+	unknown := make([]string, 0, len(scheduled))
+	for clearingAccount := range scheduled {
+		unknown = append(unknown, fmt.Sprintf("clearing account %s is unknown", clearingAccount))
+	}
+	sort.Strings(unknown)
+	violations = append(violations, unknown...)
+
+	if len(violations) > 0 {
+		return strings.Join(violations, "\n"), true, nil
+	}
+
+	return fmt.Sprintf("%d scheduled distributions are covered by the clearing account balances", len(schedule)),
+		false, nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+func TestClearingAccountBalancesInvariant(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockTime(time.Now())
+	pseKeeper := testApp.PSEKeeper
+	invariant := keeper.ClearingAccountBalancesInvariant(pseKeeper)
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	fundAmount := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1000)))
+	requireT.NoError(testApp.BankKeeper.MintCoins(ctx, types.ModuleName, fundAmount))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToModule(
+		ctx, types.ModuleName, types.ClearingAccountFoundation, fundAmount,
+	))
+
+	requireT.NoError(pseKeeper.SaveDistributionSchedule(ctx, []types.ScheduledDistribution{
+		{
+			Timestamp: uint64(ctx.BlockTime().Add(time.Hour).Unix()),
+			Allocations: []types.ClearingAccountAllocation{
+				{ClearingAccount: types.ClearingAccountFoundation, Amount: sdkmath.NewInt(600)},
+			},
+		},
+		{
+			Timestamp: uint64(ctx.BlockTime().Add(2 * time.Hour).Unix()),
+			Allocations: []types.ClearingAccountAllocation{
+				{ClearingAccount: types.ClearingAccountFoundation, Amount: sdkmath.NewInt(400)},
+			},
+		},
+	}))
+	_, broken := invariant(ctx)
+	requireT.False(broken)
+
+	// the total scheduled amount exceeds the balance
+	requireT.NoError(pseKeeper.SaveDistributionSchedule(ctx, []types.ScheduledDistribution{
+		{
+			Timestamp: uint64(ctx.BlockTime().Add(3 * time.Hour).Unix()),
+			Allocations: []types.ClearingAccountAllocation{
+				{ClearingAccount: types.ClearingAccountFoundation, Amount: sdkmath.NewInt(1)},
+			},
+		},
+	}))
+	msg, broken := invariant(ctx)
+	requireT.True(broken)
+	requireT.Contains(msg, types.ClearingAccountFoundation)
+
+	// the schedule isn't checked once the distributions are disabled
+	requireT.NoError(pseKeeper.DistributionDisabled.Set(ctx, true))
+	_, broken = invariant(ctx)
+	requireT.False(broken)
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	addresscodec "cosmossdk.io/core/address"
 	sdkstore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,7 @@ import (
 type Keeper struct {
 	storeService sdkstore.KVStoreService
 	authority    string
+	logger       log.Logger
 
 	// codec
 	cdc             codec.BinaryCodec
@@ -36,6 +38,25 @@ type Keeper struct {
 	AccountScoreSnapshot  collections.Map[sdk.AccAddress, sdkmath.Int]
 	AllocationSchedule    collections.Map[uint64, types.ScheduledDistribution] // Map: timestamp -> ScheduledDistribution
 	DistributionDisabled  collections.Item[bool]
+	// Map: (timestamp, funder) -> amount escrowed against the scheduled distribution
+	DistributionFundings collections.Map[collections.Pair[uint64, sdk.AccAddress], sdkmath.Int]
+	// Map: checkpoint hash -> scores the community distribution was proportional to
+	ScoreCheckpoints collections.Map[[]byte, types.ScoreCheckpoint]
+	// Map: schedule name -> distribution program processed independently of the main schedule
+	NamedSchedules collections.Map[string, types.NamedSchedule]
+	// Map: delegator -> validator the Community distribution payouts are delegated to
+	DistributionPreferences collections.Map[sdk.AccAddress, types.DistributionPreference]
+	// KeySet: addresses opted out of the Community distributions
+	DistributionOptOuts collections.KeySet[sdk.AccAddress]
+	// KeySet: (validator, delegator) of the delegation time entries, buckets the entries per validator
+	ValidatorDelegators collections.KeySet[collections.Pair[sdk.ValAddress, sdk.AccAddress]]
+	// Map: validator -> total shares of the delegation time entries of its bucket
+	ValidatorShares collections.Map[sdk.ValAddress, sdkmath.LegacyDec]
+	// Map: (validator, delegator) -> score finalized into the account score snapshot by the delegation in the current
+	// distribution period, the slashing of the validator penalizes it together with the score accrued since then
+	ValidatorDelegatorScores collections.Map[collections.Pair[sdk.ValAddress, sdk.AccAddress], sdkmath.Int]
+	// Item: dev-only fast clock of the distribution schedule, see SetTimeScale
+	TimeScale collections.Item[types.TimeScale]
 }
 
 // NewKeeper returns a new keeper object providing storage options required by the module.
@@ -49,6 +70,7 @@ func NewKeeper(
 	stakingKeeper types.StakingQuerier,
 	addressCodec addresscodec.Codec,
 	valAddressCodec addresscodec.Codec,
+	logger log.Logger,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
@@ -57,6 +79,7 @@ func NewKeeper(
 		addressCodec:       addressCodec,
 		valAddressCodec:    valAddressCodec,
 		authority:          authority,
+		logger:             logger,
 		accountKeeper:      accountKeeper,
 		bankKeeper:         bankKeeper,
 		distributionKeeper: distributionKeeper,
@@ -95,6 +118,66 @@ func NewKeeper(
 			"distribution_disabled",
 			codec.BoolValue,
 		),
+		DistributionFundings: collections.NewMap(
+			sb,
+			types.DistributionFundingKey,
+			"distribution_fundings",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+			sdk.IntValue,
+		),
+		ScoreCheckpoints: collections.NewMap(
+			sb,
+			types.ScoreCheckpointKey,
+			"score_checkpoints",
+			collections.BytesKey,
+			codec.CollValue[types.ScoreCheckpoint](cdc),
+		),
+		NamedSchedules: collections.NewMap(
+			sb,
+			types.NamedScheduleKey,
+			"named_schedules",
+			collections.StringKey,
+			codec.CollValue[types.NamedSchedule](cdc),
+		),
+		DistributionPreferences: collections.NewMap(
+			sb,
+			types.DistributionPreferenceKey,
+			"distribution_preferences",
+			sdk.AccAddressKey,
+			codec.CollValue[types.DistributionPreference](cdc),
+		),
+		DistributionOptOuts: collections.NewKeySet(
+			sb,
+			types.DistributionOptOutKey,
+			"distribution_opt_outs",
+			sdk.AccAddressKey,
+		),
+		ValidatorDelegators: collections.NewKeySet(
+			sb,
+			types.ValidatorDelegatorKey,
+			"validator_delegators",
+			collections.PairKeyCodec(sdk.ValAddressKey, sdk.AccAddressKey),
+		),
+		ValidatorShares: collections.NewMap(
+			sb,
+			types.ValidatorSharesKey,
+			"validator_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
+		ValidatorDelegatorScores: collections.NewMap(
+			sb,
+			types.ValidatorDelegatorScoreKey,
+			"validator_delegator_scores",
+			collections.PairKeyCodec(sdk.ValAddressKey, sdk.AccAddressKey),
+			sdk.IntValue,
+		),
+		TimeScale: collections.NewItem(
+			sb,
+			types.TimeScaleKey,
+			"time_scale",
+			codec.CollValue[types.TimeScale](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -106,6 +189,11 @@ func NewKeeper(
 	return k
 }
 
+// Logger returns the module logger.
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
 // GetClearingAccountBalances returns the current balances of all PSE clearing accounts in the bond denom.
 func (k Keeper) GetClearingAccountBalances(ctx context.Context) ([]types.ClearingAccountBalance, error) {
 	// Get bond denom from staking params
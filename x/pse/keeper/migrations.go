@@ -1,10 +1,46 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
+	keeper Keeper
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator() Migrator {
-	return Migrator{}
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the default minimum delegation amount accruing the score.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.MinDelegationAmount = types.DefaultParams().MinDelegationAmount
+
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate2to3 migrates from version 2 to 3. It sets the multiplier of the score penalty applied on slashing.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.SlashingScorePenaltyMultiplier = types.DefaultParams().SlashingScorePenaltyMultiplier
+
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate3to4 migrates from version 3 to 4. It buckets the existing delegation time entries per validator.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return m.keeper.buildScoreBuckets(ctx)
 }
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	params, err := testApp.PSEKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.MinDelegationAmount = sdkmath.Int{}
+	requireT.NoError(testApp.PSEKeeper.SetParams(ctx, params))
+
+	requireT.NoError(keeper.NewMigrator(testApp.PSEKeeper).Migrate1to2(ctx))
+
+	params, err = testApp.PSEKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.True(params.MinDelegationAmount.IsZero())
+}
+
+func TestMigrate2to3(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	params, err := testApp.PSEKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.SlashingScorePenaltyMultiplier = sdkmath.LegacyDec{}
+	requireT.NoError(testApp.PSEKeeper.SetParams(ctx, params))
+
+	requireT.NoError(keeper.NewMigrator(testApp.PSEKeeper).Migrate2to3(ctx))
+
+	params, err = testApp.PSEKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.LegacyOneDec(), params.SlashingScorePenaltyMultiplier)
+}
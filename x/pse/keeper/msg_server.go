@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
 )
 
@@ -80,3 +82,142 @@ func (ms MsgServer) DisableDistributions(
 	}
 	return &types.EmptyResponse{}, nil
 }
+
+// FundDistribution escrows additional coins against a scheduled distribution.
+func (ms MsgServer) FundDistribution(
+	goCtx context.Context,
+	req *types.MsgFundDistribution,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.FundDistribution(goCtx, sender, req.PeriodTimestamp, req.Amount); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateMinDelegationAmount is a governance operation that updates the minimum delegation amount accruing the score.
+func (ms MsgServer) UpdateMinDelegationAmount(
+	goCtx context.Context,
+	req *types.MsgUpdateMinDelegationAmount,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateMinDelegationAmount(goCtx, req.Authority, req.MinDelegationAmount); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateSlashingPenalty is a governance operation that updates the multiplier of the score penalty applied on
+// slashing.
+func (ms MsgServer) UpdateSlashingPenalty(
+	goCtx context.Context,
+	req *types.MsgUpdateSlashingPenalty,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateSlashingScorePenaltyMultiplier(
+		goCtx, req.Authority, req.SlashingScorePenaltyMultiplier,
+	); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateMinDelegationDuration is a governance operation that updates the minimum duration of the continuous
+// delegation required for the score to count toward the community distribution.
+func (ms MsgServer) UpdateMinDelegationDuration(
+	goCtx context.Context,
+	req *types.MsgUpdateMinDelegationDuration,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateMinDelegationDuration(goCtx, req.Authority, req.MinDelegationDuration); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// SetNamedSchedule is a governance operation that creates or replaces the named distribution schedule.
+func (ms MsgServer) SetNamedSchedule(
+	goCtx context.Context,
+	req *types.MsgSetNamedSchedule,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.SetNamedSchedule(goCtx, req.Authority, req.Schedule); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveNamedSchedule is a governance operation that removes the named distribution schedule.
+func (ms MsgServer) RemoveNamedSchedule(
+	goCtx context.Context,
+	req *types.MsgRemoveNamedSchedule,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.RemoveNamedSchedule(goCtx, req.Authority, req.Name); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// SetDistributionPreference sets the validator the Community distribution payouts of the delegator are delegated to.
+func (ms MsgServer) SetDistributionPreference(
+	goCtx context.Context,
+	req *types.MsgSetDistributionPreference,
+) (*types.EmptyResponse, error) {
+	delAddr, err := ms.keeper.addressCodec.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	var valAddr sdk.ValAddress
+	if req.ValidatorAddress != "" {
+		if valAddr, err = ms.keeper.valAddressCodec.StringToBytes(req.ValidatorAddress); err != nil {
+			return nil, err
+		}
+	}
+	if err := ms.keeper.SetDistributionPreference(goCtx, delAddr, valAddr); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// ReallocateClearingFunds is a governance operation that moves the share of the balance and of the remaining
+// scheduled allocations from one clearing account to another.
+func (ms MsgServer) ReallocateClearingFunds(
+	goCtx context.Context,
+	req *types.MsgReallocateClearingFunds,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.ReallocateClearingFunds(
+		goCtx, req.Authority, req.FromClearingAccount, req.ToClearingAccount, req.Share,
+	); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// OptOutDistributions excludes the sender from the Community distributions.
+func (ms MsgServer) OptOutDistributions(
+	goCtx context.Context,
+	req *types.MsgOptOutDistributions,
+) (*types.EmptyResponse, error) {
+	addr, err := ms.keeper.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.SetDistributionOptOut(goCtx, addr, true); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// OptInDistributions includes the sender in the Community distributions again.
+func (ms MsgServer) OptInDistributions(
+	goCtx context.Context,
+	req *types.MsgOptInDistributions,
+) (*types.EmptyResponse, error) {
+	addr, err := ms.keeper.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.SetDistributionOptOut(goCtx, addr, false); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
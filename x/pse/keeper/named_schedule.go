@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// SetNamedSchedule creates or replaces the named schedule via governance. The replaced schedule is enabled again.
+// The remaining balances of the clearing accounts removed from the schedule are sent to the community pool.
+func (k Keeper) SetNamedSchedule(ctx context.Context, authority string, schedule types.NamedSchedule) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	schedule.Disabled = false
+	if err := schedule.ValidateBasic(); err != nil {
+		return err
+	}
+
+	current, err := k.NamedSchedules.Get(ctx, schedule.Name)
+	if err == nil {
+		clearingAccounts := make(map[string]bool, len(schedule.ClearingAccountMappings))
+		for _, mapping := range schedule.ClearingAccountMappings {
+			clearingAccounts[mapping.ClearingAccount] = true
+		}
+		for _, mapping := range current.ClearingAccountMappings {
+			if clearingAccounts[mapping.ClearingAccount] {
+				continue
+			}
+			if err := k.releaseNamedScheduleClearingAccount(ctx, schedule.Name, mapping.ClearingAccount); err != nil {
+				return err
+			}
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	return k.NamedSchedules.Set(ctx, schedule.Name, schedule)
+}
+
+// RemoveNamedSchedule removes the named schedule via governance. The remaining balances of the clearing accounts of
+// the schedule are sent to the community pool.
+func (k Keeper) RemoveNamedSchedule(ctx context.Context, authority, name string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	schedule, err := k.GetNamedSchedule(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, mapping := range schedule.ClearingAccountMappings {
+		if err := k.releaseNamedScheduleClearingAccount(ctx, name, mapping.ClearingAccount); err != nil {
+			return err
+		}
+	}
+
+	return k.NamedSchedules.Remove(ctx, name)
+}
+
+// GetNamedSchedule returns the named schedule.
+func (k Keeper) GetNamedSchedule(ctx context.Context, name string) (types.NamedSchedule, error) {
+	schedule, err := k.NamedSchedules.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.NamedSchedule{}, errorsmod.Wrapf(types.ErrNamedScheduleNotFound, "name: %s", name)
+		}
+		return types.NamedSchedule{}, err
+	}
+	return schedule, nil
+}
+
+// GetNamedSchedules returns all the named schedules sorted by name.
+func (k Keeper) GetNamedSchedules(ctx context.Context) ([]types.NamedSchedule, error) {
+	schedules := make([]types.NamedSchedule, 0)
+	err := k.NamedSchedules.Walk(ctx, nil, func(_ string, schedule types.NamedSchedule) (bool, error) {
+		schedules = append(schedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// GetNamedScheduleClearingAccounts returns the addresses and balances of the clearing accounts of the named schedule
+// together with the total amount the remaining distributions transfer from them.
+func (k Keeper) GetNamedScheduleClearingAccounts(
+	ctx context.Context,
+	schedule types.NamedSchedule,
+) ([]types.NamedScheduleClearingAccount, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outflows := make(map[string]sdkmath.Int, len(schedule.ClearingAccountMappings))
+	for _, distribution := range schedule.Distributions {
+		for _, allocation := range distribution.Allocations {
+			outflow, ok := outflows[allocation.ClearingAccount]
+			if !ok {
+				outflow = sdkmath.ZeroInt()
+			}
+			outflows[allocation.ClearingAccount] = outflow.Add(allocation.Amount)
+		}
+	}
+
+	clearingAccounts := make([]types.NamedScheduleClearingAccount, 0, len(schedule.ClearingAccountMappings))
+	for _, mapping := range schedule.ClearingAccountMappings {
+		addr := types.NamedScheduleClearingAccountAddress(schedule.Name, mapping.ClearingAccount)
+		outflow, ok := outflows[mapping.ClearingAccount]
+		if !ok {
+			outflow = sdkmath.ZeroInt()
+		}
+		clearingAccounts = append(clearingAccounts, types.NamedScheduleClearingAccount{
+			ClearingAccount:  mapping.ClearingAccount,
+			Address:          addr.String(),
+			Balance:          k.bankKeeper.GetBalance(ctx, addr, bondDenom).Amount,
+			ScheduledOutflow: outflow,
+		})
+	}
+
+	return clearingAccounts, nil
+}
+
+// ProcessNamedSchedules processes the next due distribution of each enabled named schedule. The schedules are
+// processed independently of each other and of the main schedule, so the schedule which distribution fails is
+// disabled without affecting the others. Called at the end of each distribution epoch.
+func (k Keeper) ProcessNamedSchedules(ctx context.Context) error {
+	schedules, err := k.GetNamedSchedules(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := uint64(sdkCtx.BlockTime().Unix())
+	for _, schedule := range schedules {
+		if schedule.Disabled || len(schedule.Distributions) == 0 {
+			continue
+		}
+		dueTimestamp, err := k.dueTimestamp(ctx, schedule.Distributions[0].Timestamp)
+		if err != nil {
+			return err
+		}
+		if dueTimestamp > blockTime {
+			continue
+		}
+
+		cacheCtx, writeCache := sdkCtx.CacheContext()
+		if err := k.distributeNamedScheduleAllocations(cacheCtx, schedule); err != nil {
+			k.logger.Error("failed to process next distribution of named schedule, disabling the schedule",
+				"schedule", schedule.Name, "error", err)
+			schedule.Disabled = true
+		} else {
+			writeCache()
+			k.logger.Info("processed and removed allocation from named schedule",
+				"schedule", schedule.Name, "timestamp", schedule.Distributions[0].Timestamp)
+			schedule.Distributions = schedule.Distributions[1:]
+		}
+
+		if err := k.NamedSchedules.Set(ctx, schedule.Name, schedule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// distributeNamedScheduleAllocations transfers the tokens of the first scheduled distribution of the named schedule
+// from its clearing accounts to their recipients. The allocated amount is split equally among the recipients and
+// the remainder is sent to the community pool.
+func (k Keeper) distributeNamedScheduleAllocations(ctx sdk.Context, schedule types.NamedSchedule) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	scheduledDistribution := schedule.Distributions[0]
+	for _, allocation := range scheduledDistribution.Allocations {
+		// Mappings are validated when the schedule is set, so they are guaranteed to exist.
+		var recipientAddrs []string
+		for _, mapping := range schedule.ClearingAccountMappings {
+			if mapping.ClearingAccount == allocation.ClearingAccount {
+				recipientAddrs = mapping.RecipientAddresses
+				break
+			}
+		}
+		if len(recipientAddrs) == 0 {
+			return errorsmod.Wrapf(
+				types.ErrTransferFailed,
+				"no recipients found for clearing account '%s'",
+				allocation.ClearingAccount,
+			)
+		}
+
+		clearingAccountAddr := types.NamedScheduleClearingAccountAddress(schedule.Name, allocation.ClearingAccount)
+		numRecipients := sdkmath.NewInt(int64(len(recipientAddrs)))
+		amountPerRecipient := allocation.Amount.Quo(numRecipients)
+		remainder := allocation.Amount.Mod(numRecipients)
+
+		for _, recipientAddr := range recipientAddrs {
+			// Safe to use Must* because addresses are validated when the schedule is set
+			recipient := sdk.MustAccAddressFromBech32(recipientAddr)
+			if err := k.bankKeeper.SendCoins(
+				ctx,
+				clearingAccountAddr,
+				recipient,
+				sdk.NewCoins(sdk.NewCoin(bondDenom, amountPerRecipient)),
+			); err != nil {
+				return errorsmod.Wrapf(
+					types.ErrTransferFailed,
+					"failed to transfer from clearing account '%s' to recipient '%s': %v",
+					allocation.ClearingAccount,
+					recipientAddr,
+					err,
+				)
+			}
+		}
+
+		if !remainder.IsZero() {
+			if err := k.distributionKeeper.FundCommunityPool(
+				ctx, sdk.NewCoins(sdk.NewCoin(bondDenom, remainder)), clearingAccountAddr,
+			); err != nil {
+				return errorsmod.Wrapf(
+					types.ErrTransferFailed,
+					"failed to send remainder to community pool from clearing account '%s': %v",
+					allocation.ClearingAccount,
+					err,
+				)
+			}
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventAllocationDistributed{
+			ClearingAccount:     allocation.ClearingAccount,
+			RecipientAddresses:  recipientAddrs,
+			AmountPerRecipient:  amountPerRecipient,
+			CommunityPoolAmount: remainder,
+			ScheduledAt:         scheduledDistribution.Timestamp,
+			TotalAmount:         allocation.Amount,
+			ScheduleName:        schedule.Name,
+		}); err != nil {
+			k.logger.Error("failed to emit allocation completed event", "error", err)
+		}
+	}
+
+	return nil
+}
+
+// releaseNamedScheduleClearingAccount sends the remaining balance of the clearing account of the named schedule to
+// the community pool.
+func (k Keeper) releaseNamedScheduleClearingAccount(ctx context.Context, scheduleName, clearingAccount string) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	addr := types.NamedScheduleClearingAccountAddress(scheduleName, clearingAccount)
+	balance := k.bankKeeper.GetBalance(ctx, addr, bondDenom)
+	if balance.IsZero() {
+		return nil
+	}
+
+	if err := k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(balance), addr); err != nil {
+		return errorsmod.Wrapf(
+			types.ErrTransferFailed,
+			"failed to release clearing account '%s' of schedule '%s': %v",
+			clearingAccount,
+			scheduleName,
+			err,
+		)
+	}
+
+	k.logger.Info("released clearing account of named schedule to community pool",
+		"schedule", scheduleName,
+		"clearing_account", clearingAccount,
+		"amount", balance.Amount.String())
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+//nolint:funlen // the test covers the whole lifecycle of the named schedules
+func TestNamedSchedules(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Now()
+	ctx := testApp.NewContext(false).WithBlockTime(startTime)
+	pseKeeper := testApp.PSEKeeper
+	bankKeeper := testApp.BankKeeper
+	authority := testApp.GovAuthority()
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	recipient1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient3 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	t1 := uint64(startTime.Add(time.Hour).Unix())
+	t2 := uint64(startTime.Add(2 * time.Hour).Unix())
+
+	grantSchedule := types.NamedSchedule{
+		Name: "partner-grant-2026",
+		ClearingAccountMappings: []types.ClearingAccountMapping{
+			{
+				ClearingAccount:    "grant",
+				RecipientAddresses: []string{recipient1.String(), recipient2.String()},
+			},
+		},
+		Distributions: []types.ScheduledDistribution{
+			{
+				Timestamp: t1,
+				Allocations: []types.ClearingAccountAllocation{
+					{ClearingAccount: "grant", Amount: sdkmath.NewInt(501)},
+				},
+			},
+			{
+				Timestamp: t2,
+				Allocations: []types.ClearingAccountAllocation{
+					{ClearingAccount: "grant", Amount: sdkmath.NewInt(400)},
+				},
+			},
+		},
+	}
+	ecosystemSchedule := types.NamedSchedule{
+		Name: "ecosystem",
+		ClearingAccountMappings: []types.ClearingAccountMapping{
+			{
+				ClearingAccount:    "builders",
+				RecipientAddresses: []string{recipient3.String()},
+			},
+		},
+		Distributions: []types.ScheduledDistribution{
+			{
+				Timestamp: t1,
+				Allocations: []types.ClearingAccountAllocation{
+					{ClearingAccount: "builders", Amount: sdkmath.NewInt(100)},
+				},
+			},
+		},
+	}
+
+	// only governance can set the schedule
+	requireT.ErrorIs(
+		pseKeeper.SetNamedSchedule(ctx, recipient1.String(), grantSchedule),
+		types.ErrInvalidAuthority,
+	)
+	requireT.NoError(pseKeeper.SetNamedSchedule(ctx, authority, grantSchedule))
+	requireT.NoError(pseKeeper.SetNamedSchedule(ctx, authority, ecosystemSchedule))
+
+	// only the grant schedule is funded
+	grantAddr := types.NamedScheduleClearingAccountAddress(grantSchedule.Name, "grant")
+	fundAmount := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000)))
+	requireT.NoError(bankKeeper.MintCoins(ctx, types.ModuleName, fundAmount))
+	requireT.NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, grantAddr, fundAmount))
+
+	schedules, err := pseKeeper.GetNamedSchedules(ctx)
+	requireT.NoError(err)
+	requireT.Equal([]types.NamedSchedule{ecosystemSchedule, grantSchedule}, schedules)
+
+	clearingAccounts, err := pseKeeper.GetNamedScheduleClearingAccounts(ctx, grantSchedule)
+	requireT.NoError(err)
+	requireT.Equal([]types.NamedScheduleClearingAccount{
+		{
+			ClearingAccount:  "grant",
+			Address:          grantAddr.String(),
+			Balance:          sdkmath.NewInt(1_000),
+			ScheduledOutflow: sdkmath.NewInt(901),
+		},
+	}, clearingAccounts)
+
+	// nothing is distributed before the scheduled time
+	requireT.NoError(pseKeeper.ProcessNamedSchedules(ctx))
+	requireT.True(bankKeeper.GetBalance(ctx, recipient1, bondDenom).IsZero())
+
+	// the failing ecosystem schedule is disabled without affecting the grant schedule
+	ctx = ctx.WithBlockTime(time.Unix(int64(t1), 0))
+	requireT.NoError(pseKeeper.ProcessNamedSchedules(ctx))
+	requireT.Equal(sdkmath.NewInt(250), bankKeeper.GetBalance(ctx, recipient1, bondDenom).Amount)
+	requireT.Equal(sdkmath.NewInt(250), bankKeeper.GetBalance(ctx, recipient2, bondDenom).Amount)
+	requireT.Equal(sdkmath.NewInt(499), bankKeeper.GetBalance(ctx, grantAddr, bondDenom).Amount)
+
+	storedGrantSchedule, err := pseKeeper.GetNamedSchedule(ctx, grantSchedule.Name)
+	requireT.NoError(err)
+	requireT.False(storedGrantSchedule.Disabled)
+	requireT.Equal(grantSchedule.Distributions[1:], storedGrantSchedule.Distributions)
+
+	storedEcosystemSchedule, err := pseKeeper.GetNamedSchedule(ctx, ecosystemSchedule.Name)
+	requireT.NoError(err)
+	requireT.True(storedEcosystemSchedule.Disabled)
+	requireT.Len(storedEcosystemSchedule.Distributions, 1)
+
+	// the disabled schedule is not processed anymore
+	ctx = ctx.WithBlockTime(time.Unix(int64(t2), 0))
+	requireT.NoError(pseKeeper.ProcessNamedSchedules(ctx))
+	requireT.Equal(sdkmath.NewInt(450), bankKeeper.GetBalance(ctx, recipient1, bondDenom).Amount)
+	requireT.True(bankKeeper.GetBalance(ctx, recipient3, bondDenom).IsZero())
+
+	// the replaced schedule is enabled again
+	builderAddr := types.NamedScheduleClearingAccountAddress(ecosystemSchedule.Name, "builders")
+	requireT.NoError(bankKeeper.MintCoins(ctx, types.ModuleName, fundAmount))
+	requireT.NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, builderAddr, fundAmount))
+	requireT.NoError(pseKeeper.SetNamedSchedule(ctx, authority, ecosystemSchedule))
+	requireT.NoError(pseKeeper.ProcessNamedSchedules(ctx))
+	requireT.Equal(sdkmath.NewInt(100), bankKeeper.GetBalance(ctx, recipient3, bondDenom).Amount)
+
+	// the clearing account removed from the schedule is released to the community pool
+	ecosystemSchedule.ClearingAccountMappings[0].ClearingAccount = "grants"
+	ecosystemSchedule.Distributions = nil
+	requireT.NoError(pseKeeper.SetNamedSchedule(ctx, authority, ecosystemSchedule))
+	requireT.True(bankKeeper.GetBalance(ctx, builderAddr, bondDenom).IsZero())
+
+	// the clearing accounts of the removed schedule are released to the community pool
+	requireT.NoError(pseKeeper.RemoveNamedSchedule(ctx, authority, grantSchedule.Name))
+	requireT.True(bankKeeper.GetBalance(ctx, grantAddr, bondDenom).IsZero())
+	_, err = pseKeeper.GetNamedSchedule(ctx, grantSchedule.Name)
+	requireT.ErrorIs(err, types.ErrNamedScheduleNotFound)
+	requireT.ErrorIs(
+		pseKeeper.RemoveNamedSchedule(ctx, authority, grantSchedule.Name),
+		types.ErrNamedScheduleNotFound,
+	)
+}
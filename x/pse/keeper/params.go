@@ -3,11 +3,14 @@ package keeper
 import (
 	"context"
 	"errors"
+	"time"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	"github.com/samber/lo"
 
 	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
@@ -64,6 +67,11 @@ func (k Keeper) UpdateExcludedAddresses(
 			return err
 		}
 
+		// Custody accounts never accrue the score, even if they are not excluded
+		if isCustodyAccount(k.accountKeeper.GetAccount(ctx, addr)) {
+			continue
+		}
+
 		// Query all current delegations for this address
 		delAddrBech32, err := k.addressCodec.BytesToString(addr)
 		if err != nil {
@@ -86,8 +94,9 @@ func (k Keeper) UpdateExcludedAddresses(
 
 			// Set entry with current block time and current shares
 			if err := k.SetDelegationTimeEntry(ctx, valAddr, addr, types.DelegationTimeEntry{
-				LastChangedUnixSec: currentBlockTime,
-				Shares:             delegation.Delegation.Shares,
+				LastChangedUnixSec:    currentBlockTime,
+				Shares:                delegation.Delegation.Shares,
+				DelegatedSinceUnixSec: currentBlockTime,
 			}); err != nil {
 				return err
 			}
@@ -132,14 +141,31 @@ func (k Keeper) removeExcludedAccountData(ctx context.Context, addrStr string) e
 	if err != nil {
 		return err
 	}
-	defer iter.Close()
-
-	for ; iter.Valid(); iter.Next() {
-		kv, err := iter.KeyValue()
-		if err != nil {
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.RemoveDelegationTimeEntry(ctx, key.K2(), key.K1()); err != nil {
 			return err
 		}
-		if err := k.DelegationTimeEntries.Remove(ctx, kv.Key); err != nil {
+	}
+
+	// Remove the scores finalized in the current period, they are keyed by validator first, but the exclusion is a
+	// rare governance action, so the full scan is acceptable.
+	var scoreKeys []collections.Pair[sdk.ValAddress, sdk.AccAddress]
+	err = k.ValidatorDelegatorScores.Walk(ctx, nil,
+		func(key collections.Pair[sdk.ValAddress, sdk.AccAddress], _ sdkmath.Int) (bool, error) {
+			if key.K2().Equals(sdk.AccAddress(addr)) {
+				scoreKeys = append(scoreKeys, key)
+			}
+			return false, nil
+		})
+	if err != nil {
+		return err
+	}
+	for _, key := range scoreKeys {
+		if err := k.ValidatorDelegatorScores.Remove(ctx, key); err != nil {
 			return err
 		}
 	}
@@ -173,6 +199,138 @@ func (k Keeper) UpdateClearingAccountMappings(
 	return k.SetParams(ctx, params)
 }
 
+// UpdateMinDelegationAmount updates the minimum delegation amount accruing the score via governance.
+// The delegation time entries below the new amount are removed, so they stop accruing the score immediately.
+func (k Keeper) UpdateMinDelegationAmount(ctx context.Context, authority string, amount sdkmath.Int) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	return k.SetMinDelegationAmount(ctx, amount)
+}
+
+// SetMinDelegationAmount sets the minimum delegation amount accruing the score and removes the delegation time entries
+// below it.
+func (k Keeper) SetMinDelegationAmount(ctx context.Context, amount sdkmath.Int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.MinDelegationAmount = amount
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	return k.removeDustDelegationTimeEntries(ctx, amount)
+}
+
+// UpdateSlashingScorePenaltyMultiplier updates the multiplier of the score penalty applied on slashing via governance.
+func (k Keeper) UpdateSlashingScorePenaltyMultiplier(
+	ctx context.Context,
+	authority string,
+	multiplier sdkmath.LegacyDec,
+) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+	if err := types.ValidateSlashingScorePenaltyMultiplier(multiplier); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.SlashingScorePenaltyMultiplier = multiplier
+
+	return k.SetParams(ctx, params)
+}
+
+// UpdateMinDelegationDuration updates the minimum duration of the continuous delegation required for the score to
+// count toward the community distribution via governance.
+func (k Keeper) UpdateMinDelegationDuration(ctx context.Context, authority string, duration time.Duration) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	params.MinDelegationDuration = duration
+
+	return k.SetParams(ctx, params)
+}
+
+// removeDustDelegationTimeEntries removes the delegation time entries of the delegations below the minimum delegation
+// amount. Such delegations don't accrue the score, so the entries are recreated by the hooks only once the delegation
+// reaches the amount.
+func (k Keeper) removeDustDelegationTimeEntries(ctx context.Context, minDelegationAmount sdkmath.Int) error {
+	if !minDelegationAmount.IsPositive() {
+		return nil
+	}
+
+	iter, err := k.DelegationTimeEntries.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var dustKeys []collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		isDust, err := isBelowMinDelegationAmount(ctx, k, kv.Key.K2(), kv.Value.Shares, minDelegationAmount)
+		if err != nil {
+			return err
+		}
+		if isDust {
+			dustKeys = append(dustKeys, kv.Key)
+		}
+	}
+
+	for _, key := range dustKeys {
+		if err := k.RemoveDelegationTimeEntry(ctx, key.K2(), key.K1()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getMinDelegationAmount returns the minimum delegation amount accruing the score.
+// Returns zero if params are not initialized (e.g., during genesis).
+func (k Keeper) getMinDelegationAmount(ctx context.Context) (sdkmath.Int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdkmath.ZeroInt(), nil
+		}
+		return sdkmath.Int{}, err
+	}
+	if params.MinDelegationAmount.IsNil() {
+		return sdkmath.ZeroInt(), nil
+	}
+	return params.MinDelegationAmount, nil
+}
+
+// getSlashingScorePenaltyMultiplier returns the multiplier of the score penalty applied on slashing.
+// Returns zero if params are not initialized (e.g., during genesis).
+func (k Keeper) getSlashingScorePenaltyMultiplier(ctx context.Context) (sdkmath.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdkmath.LegacyZeroDec(), nil
+		}
+		return sdkmath.LegacyDec{}, err
+	}
+	if params.SlashingScorePenaltyMultiplier.IsNil() {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+	return params.SlashingScorePenaltyMultiplier, nil
+}
+
 // IsExcludedAddress checks if the given address is in the excluded addresses list.
 // Returns false if params are not initialized (e.g., during genesis).
 func (k Keeper) IsExcludedAddress(ctx context.Context, addr sdk.AccAddress) (bool, error) {
@@ -198,3 +356,28 @@ func (k Keeper) IsExcludedAddress(ctx context.Context, addr sdk.AccAddress) (boo
 	}
 	return false, nil
 }
+
+// IsScoreAccrualDisabled checks if the delegations of the given address must not accrue the score.
+// Besides the excluded addresses, the score is not accrued for the delegations held in custody on behalf of other
+// users, so the score can't be attributed to the real owner. The accrual is resumed once the stake is redeemed and
+// delegated by the regular account.
+func (k Keeper) IsScoreAccrualDisabled(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	if isCustodyAccount(k.accountKeeper.GetAccount(ctx, addr)) {
+		return true, nil
+	}
+
+	return k.IsExcludedAddress(ctx, addr)
+}
+
+// isCustodyAccount checks if the account holds the delegations in custody on behalf of other users. These are the
+// module accounts and the interchain accounts created by the ICA host, which the liquid staking providers
+// (e.g. Quicksilver or Stride) use to delegate the deposits of their users. The staking module doesn't support the LSM
+// share tokenization, so there are no tokenize share record accounts to handle.
+func isCustodyAccount(acc sdk.AccountI) bool {
+	switch acc.(type) {
+	case sdk.ModuleAccountI, *icatypes.InterchainAccount:
+		return true
+	default:
+		return false
+	}
+}
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -226,3 +228,39 @@ func TestUpdateClearingMappings_Authority(t *testing.T) {
 	err = pseKeeper.UpdateClearingAccountMappings(ctx, correctAuthority, mappings)
 	requireT.NoError(err, "should accept correct authority")
 }
+
+func TestSetMinDelegationAmount(t *testing.T) {
+	requireT := require.New(t)
+
+	startTime := time.Now().Round(time.Second)
+	testApp := simapp.New(simapp.WithStartTime(startTime))
+	ctx, _, err := testApp.BeginNextBlockAtTime(startTime)
+	requireT.NoError(err)
+	r := &runEnv{
+		testApp:  testApp,
+		ctx:      ctx,
+		requireT: requireT,
+	}
+
+	validatorOperator, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(
+		ctx, validatorOperator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))),
+	))
+	validator, err := testApp.AddValidator(ctx, validatorOperator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil)
+	requireT.NoError(err)
+	valAddr := sdk.MustValAddressFromBech32(validator.GetOperator())
+
+	dustDelegator, _ := testApp.GenAccount(ctx)
+	delegator, _ := testApp.GenAccount(ctx)
+	delegateAction(r, dustDelegator, valAddr, 999_999)
+	delegateAction(r, delegator, valAddr, 1_000_000)
+	assertDelegationTimeEntriesCountAction(r, dustDelegator, 1)
+
+	requireT.NoError(testApp.PSEKeeper.SetMinDelegationAmount(r.ctx, sdkmath.NewInt(1_000_000)))
+
+	params, err := testApp.PSEKeeper.GetParams(r.ctx)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(1_000_000), params.MinDelegationAmount)
+	assertDelegationTimeEntriesCountAction(r, dustDelegator, 0)
+	assertDelegationTimeEntriesCountAction(r, delegator, 1)
+}
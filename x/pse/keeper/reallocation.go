@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// ReallocateClearingFunds moves the share of the balance and of each remaining scheduled allocation from one
+// clearing account to another via governance. The moved amounts are truncated. The amounts escrowed by the
+// distribution fundings are kept in the Community clearing account. Since each scheduled distribution must allocate
+// a positive amount from every clearing account, the whole allocation can be moved only if no distributions remain.
+func (k Keeper) ReallocateClearingFunds(
+	ctx context.Context,
+	authority, fromClearingAccount, toClearingAccount string,
+	share sdkmath.LegacyDec,
+) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+
+	schedule, err := k.GetDistributionSchedule(ctx)
+	if err != nil {
+		return err
+	}
+
+	scheduledMoved := sdkmath.ZeroInt()
+	reallocated := make([]types.ReallocatedAllocation, 0, len(schedule))
+	for i, scheduledDist := range schedule {
+		allocations := make([]types.ClearingAccountAllocation, len(scheduledDist.Allocations))
+		copy(allocations, scheduledDist.Allocations)
+
+		var moved sdkmath.Int
+		for j, allocation := range allocations {
+			if allocation.ClearingAccount == fromClearingAccount {
+				moved = share.MulInt(allocation.Amount).TruncateInt()
+				allocations[j].Amount = allocation.Amount.Sub(moved)
+			}
+		}
+		if moved.IsNil() || moved.IsZero() {
+			continue
+		}
+		for j, allocation := range allocations {
+			if allocation.ClearingAccount == toClearingAccount {
+				allocations[j].Amount = allocation.Amount.Add(moved)
+			}
+		}
+
+		schedule[i].Allocations = allocations
+		scheduledMoved = scheduledMoved.Add(moved)
+		reallocated = append(reallocated, types.ReallocatedAllocation{
+			Timestamp: scheduledDist.Timestamp,
+			Amount:    moved,
+		})
+	}
+	if err := types.ValidateDistributionSchedule(schedule); err != nil {
+		return errorsmod.Wrap(err, "reallocated distribution schedule is invalid")
+	}
+	if err := k.SaveDistributionSchedule(ctx, schedule); err != nil {
+		return err
+	}
+
+	balanceMoved, err := k.movableClearingAccountBalance(ctx, fromClearingAccount)
+	if err != nil {
+		return err
+	}
+	balanceMoved = share.MulInt(balanceMoved).TruncateInt()
+	if balanceMoved.IsPositive() {
+		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx, fromClearingAccount, toClearingAccount, sdk.NewCoins(sdk.NewCoin(bondDenom, balanceMoved)),
+		); err != nil {
+			return errorsmod.Wrapf(types.ErrTransferFailed, "failed to reallocate balance: %s", err)
+		}
+	}
+
+	k.logger.Info("reallocated clearing account funds",
+		"from", fromClearingAccount,
+		"to", toClearingAccount,
+		"share", share.String(),
+		"balance_moved", balanceMoved.String(),
+		"scheduled_moved", scheduledMoved.String())
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventClearingFundsReallocated{
+		FromClearingAccount: fromClearingAccount,
+		ToClearingAccount:   toClearingAccount,
+		Share:               share,
+		BalanceMoved:        balanceMoved,
+		ScheduledMoved:      scheduledMoved,
+		Allocations:         reallocated,
+	})
+}
+
+// movableClearingAccountBalance returns the balance of the clearing account which may be reallocated. The amounts
+// escrowed by the distribution fundings are excluded from the balance of the Community clearing account.
+func (k Keeper) movableClearingAccountBalance(ctx context.Context, clearingAccount string) (sdkmath.Int, error) {
+	balances, err := k.GetClearingAccountBalances(ctx)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	balance := sdkmath.ZeroInt()
+	for _, b := range balances {
+		if b.ClearingAccount == clearingAccount {
+			balance = b.Balance
+		}
+	}
+	if clearingAccount != types.ClearingAccountCommunity {
+		return balance, nil
+	}
+
+	err = k.DistributionFundings.Walk(
+		ctx,
+		nil,
+		func(_ collections.Pair[uint64, sdk.AccAddress], amount sdkmath.Int) (bool, error) {
+			balance = balance.Sub(amount)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return sdkmath.MaxInt(balance, sdkmath.ZeroInt()), nil
+}
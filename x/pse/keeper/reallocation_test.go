@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+func TestReallocateClearingFunds(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockTime(time.Now())
+	pseKeeper := testApp.PSEKeeper
+	authority := testApp.GovAuthority()
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	fundClearingAccount := func(clearingAccount string, amount int64) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+		requireT.NoError(testApp.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, clearingAccount, coins))
+	}
+	getBalance := func(clearingAccount string) string {
+		addr := testApp.AccountKeeper.GetModuleAddress(clearingAccount)
+		return testApp.BankKeeper.GetBalance(ctx, addr, bondDenom).Amount.String()
+	}
+	fundClearingAccount(types.ClearingAccountPartnership, 1_001)
+	fundClearingAccount(types.ClearingAccountFoundation, 2_000)
+	fundClearingAccount(types.ClearingAccountCommunity, 3_000)
+
+	time1 := uint64(ctx.BlockTime().Add(time.Hour).Unix())
+	time2 := uint64(ctx.BlockTime().Add(2 * time.Hour).Unix())
+	schedule := make([]types.ScheduledDistribution, 0, 2)
+	for i, timestamp := range []uint64{time1, time2} {
+		allocations := make([]types.ClearingAccountAllocation, 0, len(types.GetAllClearingAccounts()))
+		for _, clearingAccount := range types.GetAllClearingAccounts() {
+			allocations = append(allocations, types.ClearingAccountAllocation{
+				ClearingAccount: clearingAccount,
+				Amount:          sdkmath.NewInt(int64(500 + i)),
+			})
+		}
+		schedule = append(schedule, types.ScheduledDistribution{Timestamp: timestamp, Allocations: allocations})
+	}
+	requireT.NoError(pseKeeper.SaveDistributionSchedule(ctx, schedule))
+
+	// the escrowed funding isn't moved from the Community account
+	funder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	funding := sdk.NewInt64Coin(bondDenom, 1_000)
+	requireT.NoError(testApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(funding)))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, sdk.NewCoins(funding)))
+	requireT.NoError(pseKeeper.FundDistribution(ctx, funder, time2, funding))
+
+	// only governance can reallocate the funds
+	requireT.ErrorIs(pseKeeper.ReallocateClearingFunds(
+		ctx, funder.String(), types.ClearingAccountPartnership, types.ClearingAccountFoundation, sdkmath.LegacyOneDec(),
+	), types.ErrInvalidAuthority)
+
+	// the whole allocation can't be moved while the distributions remain
+	requireT.ErrorIs(pseKeeper.ReallocateClearingFunds(
+		ctx, authority, types.ClearingAccountPartnership, types.ClearingAccountFoundation, sdkmath.LegacyOneDec(),
+	), types.ErrInvalidParam)
+	requireT.Equal("1001", getBalance(types.ClearingAccountPartnership))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(pseKeeper.ReallocateClearingFunds(
+		ctx, authority, types.ClearingAccountPartnership, types.ClearingAccountFoundation,
+		sdkmath.LegacyMustNewDecFromStr("0.5"),
+	))
+	requireT.Equal("501", getBalance(types.ClearingAccountPartnership))
+	requireT.Equal("2500", getBalance(types.ClearingAccountFoundation))
+
+	storedSchedule, err := pseKeeper.GetDistributionSchedule(ctx)
+	requireT.NoError(err)
+	requireT.Len(storedSchedule, 2)
+	for i, expected := range [][2]int64{{250, 750}, {251, 751}} {
+		for _, allocation := range storedSchedule[i].Allocations {
+			switch allocation.ClearingAccount {
+			case types.ClearingAccountPartnership:
+				requireT.Equal(expected[0], allocation.Amount.Int64())
+			case types.ClearingAccountFoundation:
+				requireT.Equal(expected[1], allocation.Amount.Int64())
+			default:
+				requireT.Equal(int64(500+i), allocation.Amount.Int64())
+			}
+		}
+	}
+
+	events, err := event.FindTypedEvents[*types.EventClearingFundsReallocated](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(events, 1)
+	requireT.Equal(types.ClearingAccountPartnership, events[0].FromClearingAccount)
+	requireT.Equal(types.ClearingAccountFoundation, events[0].ToClearingAccount)
+	requireT.Equal("500", events[0].BalanceMoved.String())
+	requireT.Equal("500", events[0].ScheduledMoved.String())
+	requireT.Equal([]types.ReallocatedAllocation{
+		{Timestamp: time1, Amount: sdkmath.NewInt(250)},
+		{Timestamp: time2, Amount: sdkmath.NewInt(250)},
+	}, events[0].Allocations)
+
+	// the Community balance is reallocated without the escrowed funding
+	requireT.NoError(pseKeeper.ReallocateClearingFunds(
+		ctx, authority, types.ClearingAccountCommunity, types.ClearingAccountTeam,
+		sdkmath.LegacyMustNewDecFromStr("0.1"),
+	))
+	requireT.Equal("3700", getBalance(types.ClearingAccountCommunity))
+	requireT.Equal("300", getBalance(types.ClearingAccountTeam))
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// GetClearingAccountStatuses returns the balance of each clearing account reconciled with the total amount the
+// remaining scheduled distributions transfer from it. The amounts escrowed against the scheduled distributions are
+// kept in the Community clearing account, so they are added to its scheduled outflow.
+func (k Keeper) GetClearingAccountStatuses(ctx context.Context) ([]types.ClearingAccountStatus, error) {
+	balances, err := k.GetClearingAccountBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outflows := make(map[string]sdkmath.Int, len(balances))
+	err = k.AllocationSchedule.Walk(ctx, nil, func(_ uint64, scheduledDist types.ScheduledDistribution) (bool, error) {
+		for _, allocation := range scheduledDist.Allocations {
+			outflow, ok := outflows[allocation.ClearingAccount]
+			if !ok {
+				outflow = sdkmath.ZeroInt()
+			}
+			outflows[allocation.ClearingAccount] = outflow.Add(allocation.Amount)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fundings := sdkmath.ZeroInt()
+	err = k.DistributionFundings.Walk(
+		ctx,
+		nil,
+		func(_ collections.Pair[uint64, sdk.AccAddress], amount sdkmath.Int) (bool, error) {
+			fundings = fundings.Add(amount)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]types.ClearingAccountStatus, 0, len(balances))
+	for _, balance := range balances {
+		outflow, ok := outflows[balance.ClearingAccount]
+		if !ok {
+			outflow = sdkmath.ZeroInt()
+		}
+		if balance.ClearingAccount == types.ClearingAccountCommunity {
+			outflow = outflow.Add(fundings)
+		}
+		statuses = append(statuses, types.ClearingAccountStatus{
+			ClearingAccount:  balance.ClearingAccount,
+			Balance:          balance.Balance,
+			ScheduledOutflow: outflow,
+			Surplus:          balance.Balance.Sub(outflow),
+		})
+	}
+
+	return statuses, nil
+}
+
+// GetRemainingUnlocks returns the total amount the remaining scheduled distributions transfer from each clearing
+// account together with the number of the distributions and the timestamps of the next and the last one. Unlike the
+// scheduled outflow of the clearing account status, the amounts escrowed against the distributions aren't included,
+// so the result follows the emission curve of the schedule.
+func (k Keeper) GetRemainingUnlocks(ctx context.Context) ([]types.RemainingUnlock, error) {
+	clearingAccounts := types.GetAllClearingAccounts()
+	unlocks := make(map[string]*types.RemainingUnlock, len(clearingAccounts))
+	for _, account := range clearingAccounts {
+		unlocks[account] = &types.RemainingUnlock{
+			ClearingAccount: account,
+			Amount:          sdkmath.ZeroInt(),
+		}
+	}
+
+	// the schedule is sorted by timestamp ascending
+	err := k.AllocationSchedule.Walk(
+		ctx,
+		nil,
+		func(timestamp uint64, scheduledDist types.ScheduledDistribution) (bool, error) {
+			for _, allocation := range scheduledDist.Allocations {
+				unlock, ok := unlocks[allocation.ClearingAccount]
+				if !ok {
+					continue
+				}
+				if unlock.Distributions == 0 {
+					unlock.NextTimestamp = timestamp
+				}
+				unlock.Amount = unlock.Amount.Add(allocation.Amount)
+				unlock.Distributions++
+				unlock.LastTimestamp = timestamp
+			}
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]types.RemainingUnlock, 0, len(clearingAccounts))
+	for _, account := range clearingAccounts {
+		result = append(result, *unlocks[account])
+	}
+
+	return result, nil
+}
+
+// EmitClearingAccountDeficits emits the deficit event for each clearing account which balance doesn't cover its
+// remaining scheduled outflow. Called by the epoch hooks at the end of the reconciliation epoch.
+func (k Keeper) EmitClearingAccountDeficits(ctx context.Context) error {
+	statuses, err := k.GetClearingAccountStatuses(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, status := range statuses {
+		if !status.Surplus.IsNegative() {
+			continue
+		}
+
+		k.logger.Warn("clearing account balance doesn't cover the scheduled distributions",
+			"clearing_account", status.ClearingAccount,
+			"balance", status.Balance,
+			"scheduled_outflow", status.ScheduledOutflow,
+		)
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventClearingAccountDeficit{
+			ClearingAccount:  status.ClearingAccount,
+			Balance:          status.Balance,
+			ScheduledOutflow: status.ScheduledOutflow,
+			Deficit:          status.Surplus.Neg(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	epochstypes "github.com/tokenize-x/tx-chain/v7/x/epochs/types"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+func TestClearingAccountStatus(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockTime(time.Now())
+	pseKeeper := testApp.PSEKeeper
+	queryService := keeper.NewQueryService(pseKeeper)
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	// the Foundation account covers the schedule, the Team account doesn't
+	fundClearingAccount := func(clearingAccount string, amount int64) {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+		requireT.NoError(testApp.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, clearingAccount, coins))
+	}
+	fundClearingAccount(types.ClearingAccountFoundation, 5_000)
+	fundClearingAccount(types.ClearingAccountTeam, 1_500)
+	fundClearingAccount(types.ClearingAccountCommunity, 2_000)
+
+	time1 := uint64(ctx.BlockTime().Add(time.Hour).Unix())
+	time2 := uint64(ctx.BlockTime().Add(2 * time.Hour).Unix())
+	schedule := make([]types.ScheduledDistribution, 0, 2)
+	for _, timestamp := range []uint64{time1, time2} {
+		schedule = append(schedule, types.ScheduledDistribution{
+			Timestamp: timestamp,
+			Allocations: []types.ClearingAccountAllocation{
+				{ClearingAccount: types.ClearingAccountFoundation, Amount: sdkmath.NewInt(2_000)},
+				{ClearingAccount: types.ClearingAccountTeam, Amount: sdkmath.NewInt(1_000)},
+				{ClearingAccount: types.ClearingAccountCommunity, Amount: sdkmath.NewInt(1_000)},
+			},
+		})
+	}
+	requireT.NoError(pseKeeper.SaveDistributionSchedule(ctx, schedule))
+
+	// the funding is kept in the Community account and distributed with its allocation
+	funder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	funding := sdk.NewInt64Coin(bondDenom, 500)
+	requireT.NoError(testApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(funding)))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, sdk.NewCoins(funding)))
+	requireT.NoError(pseKeeper.FundDistribution(ctx, funder, time2, funding))
+
+	resp, err := queryService.ClearingAccountStatus(ctx, &types.QueryClearingAccountStatusRequest{})
+	requireT.NoError(err)
+	requireT.Len(resp.Statuses, len(types.GetAllClearingAccounts()))
+
+	statuses := make(map[string]types.ClearingAccountStatus)
+	for _, status := range resp.Statuses {
+		statuses[status.ClearingAccount] = status
+	}
+
+	requireStatus := func(clearingAccount string, balance, outflow, surplus int64) {
+		status := statuses[clearingAccount]
+		requireT.Equal(sdkmath.NewInt(balance).String(), status.Balance.String(), clearingAccount)
+		requireT.Equal(sdkmath.NewInt(outflow).String(), status.ScheduledOutflow.String(), clearingAccount)
+		requireT.Equal(sdkmath.NewInt(surplus).String(), status.Surplus.String(), clearingAccount)
+	}
+	requireStatus(types.ClearingAccountFoundation, 5_000, 4_000, 1_000)
+	requireStatus(types.ClearingAccountTeam, 1_500, 2_000, -500)
+	requireStatus(types.ClearingAccountCommunity, 2_500, 2_500, 0)
+	requireStatus(types.ClearingAccountAlliance, 0, 0, 0)
+
+	// the deficit event is emitted at the end of the reconciliation epoch only
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(pseKeeper.EpochHooks().AfterEpochEnd(ctx, epochstypes.DayEpochID, 1))
+	requireT.Empty(ctx.EventManager().Events())
+
+	// the deficit event is emitted for the Team account only
+	requireT.NoError(pseKeeper.EpochHooks().AfterEpochEnd(ctx, keeper.ReconciliationEpochIdentifier, 1))
+	deficits, err := event.FindTypedEvents[*types.EventClearingAccountDeficit](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(deficits, 1)
+	requireT.Equal(types.ClearingAccountTeam, deficits[0].ClearingAccount)
+	requireT.Equal("1500", deficits[0].Balance.String())
+	requireT.Equal("2000", deficits[0].ScheduledOutflow.String())
+	requireT.Equal("500", deficits[0].Deficit.String())
+}
+
+func TestRemainingUnlocks(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false).WithBlockTime(time.Now())
+	pseKeeper := testApp.PSEKeeper
+	queryService := keeper.NewQueryService(pseKeeper)
+
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	params, err := pseKeeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.ClearingAccountMappings = nil
+	for _, clearingAccount := range types.GetNonCommunityClearingAccounts() {
+		params.ClearingAccountMappings = append(params.ClearingAccountMappings, types.ClearingAccountMapping{
+			ClearingAccount:    clearingAccount,
+			RecipientAddresses: []string{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()},
+		})
+	}
+	requireT.NoError(pseKeeper.SetParams(ctx, params))
+
+	for _, clearingAccount := range types.GetAllClearingAccounts() {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000))
+		requireT.NoError(testApp.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, clearingAccount, coins))
+	}
+
+	time1 := uint64(ctx.BlockTime().Add(time.Hour).Unix())
+	time2 := uint64(ctx.BlockTime().Add(2 * time.Hour).Unix())
+	time3 := uint64(ctx.BlockTime().Add(3 * time.Hour).Unix())
+	requireT.NoError(pseKeeper.SaveDistributionSchedule(ctx, []types.ScheduledDistribution{
+		{
+			Timestamp: time1,
+			Allocations: []types.ClearingAccountAllocation{
+				{ClearingAccount: types.ClearingAccountFoundation, Amount: sdkmath.NewInt(1_000)},
+				{ClearingAccount: types.ClearingAccountTeam, Amount: sdkmath.NewInt(500)},
+			},
+		},
+		{
+			Timestamp: time2,
+			Allocations: []types.ClearingAccountAllocation{
+				{ClearingAccount: types.ClearingAccountFoundation, Amount: sdkmath.NewInt(2_000)},
+			},
+		},
+		{
+			Timestamp: time3,
+			Allocations: []types.ClearingAccountAllocation{
+				{ClearingAccount: types.ClearingAccountFoundation, Amount: sdkmath.NewInt(3_000)},
+				{ClearingAccount: types.ClearingAccountTeam, Amount: sdkmath.NewInt(700)},
+			},
+		},
+	}))
+
+	requireUnlocks := func(
+		remainingUnlocks []types.RemainingUnlock,
+		clearingAccount string,
+		amount int64,
+		distributions, nextTimestamp, lastTimestamp uint64,
+	) {
+		requireT.Len(remainingUnlocks, len(types.GetAllClearingAccounts()))
+		for _, unlock := range remainingUnlocks {
+			if unlock.ClearingAccount != clearingAccount {
+				continue
+			}
+			requireT.Equal(sdkmath.NewInt(amount).String(), unlock.Amount.String(), clearingAccount)
+			requireT.Equal(distributions, unlock.Distributions, clearingAccount)
+			requireT.Equal(nextTimestamp, unlock.NextTimestamp, clearingAccount)
+			requireT.Equal(lastTimestamp, unlock.LastTimestamp, clearingAccount)
+			return
+		}
+		requireT.Failf("clearing account not found", clearingAccount)
+	}
+
+	resp, err := queryService.RemainingUnlocks(ctx, &types.QueryRemainingUnlocksRequest{})
+	requireT.NoError(err)
+	requireUnlocks(resp.RemainingUnlocks, types.ClearingAccountFoundation, 6_000, 3, time1, time3)
+	requireUnlocks(resp.RemainingUnlocks, types.ClearingAccountTeam, 1_200, 2, time1, time3)
+	requireUnlocks(resp.RemainingUnlocks, types.ClearingAccountAlliance, 0, 0, 0, 0)
+
+	// the event with the remaining amounts is emitted after the processed distribution
+	ctx = ctx.WithBlockTime(time.Unix(int64(time1), 0)).WithEventManager(sdk.NewEventManager())
+	requireT.NoError(pseKeeper.ProcessNextDistribution(ctx))
+	events, err := event.FindTypedEvents[*types.EventRemainingUnlocks](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(events, 1)
+	requireT.Equal(time1, events[0].ProcessedAt)
+	requireUnlocks(events[0].RemainingUnlocks, types.ClearingAccountFoundation, 5_000, 2, time2, time3)
+	requireUnlocks(events[0].RemainingUnlocks, types.ClearingAccountTeam, 700, 1, time3, time3)
+
+	resp, err = queryService.RemainingUnlocks(ctx, &types.QueryRemainingUnlocksRequest{})
+	requireT.NoError(err)
+	requireT.Equal(events[0].RemainingUnlocks, resp.RemainingUnlocks)
+}
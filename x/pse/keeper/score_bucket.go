@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// scoreBucket holds the delegation time entries of a single validator together with the scores accrued by them.
+type scoreBucket struct {
+	valAddr    sdk.ValAddress
+	delegators []sdk.AccAddress
+	entries    []types.DelegationTimeEntry
+	scores     []sdkmath.Int
+	gasUsed    storetypes.Gas
+	err        error
+}
+
+// loadScoreBuckets loads the buckets of the validators having the delegation time entries and calculates the scores
+// accrued by the entries up to the block time. Each validator is loaded once for all the entries of its bucket, and
+// the validators having no entries, e.g. the ones delegated only by the excluded addresses, have no bucket and are
+// skipped. The buckets are loaded by the pool of workers, each reading through its own branch of the store with its
+// own gas meter. The buckets are returned in the order of the validator addresses and the gas consumed by them is
+// charged in the same order, so the outcome doesn't depend on the scheduling of the workers.
+func (k Keeper) loadScoreBuckets(
+	ctx context.Context,
+	blockTimeUnixSec int64,
+	minDelegationAmount sdkmath.Int,
+) ([]*scoreBucket, error) {
+	iter, err := k.ValidatorShares.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	valAddrs, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// the branches are created upfront, so the parent store is only read by the workers
+	workerCtxs := make([]sdk.Context, min(runtime.GOMAXPROCS(0), len(valAddrs)))
+	for i := range workerCtxs {
+		workerCtxs[i] = sdkCtx.
+			WithMultiStore(sdkCtx.MultiStore().CacheMultiStore()).
+			WithEventManager(sdk.NewEventManager())
+	}
+
+	buckets := make([]*scoreBucket, len(valAddrs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(len(workerCtxs))
+	for _, workerCtx := range workerCtxs {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				buckets[i] = k.loadScoreBucket(
+					workerCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()),
+					valAddrs[i],
+					blockTimeUnixSec,
+					minDelegationAmount,
+				)
+			}
+		}()
+	}
+	for i := range valAddrs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, bucket := range buckets {
+		if bucket.err != nil {
+			return nil, bucket.err
+		}
+		sdkCtx.GasMeter().ConsumeGas(bucket.gasUsed, "pse score bucket")
+	}
+	return buckets, nil
+}
+
+func (k Keeper) loadScoreBucket(
+	ctx sdk.Context,
+	valAddr sdk.ValAddress,
+	blockTimeUnixSec int64,
+	minDelegationAmount sdkmath.Int,
+) (bucket *scoreBucket) {
+	bucket = &scoreBucket{
+		valAddr: valAddr,
+	}
+	// the panic can't be recovered by the caller once it happens in the worker, so it is returned as the error
+	defer func() {
+		if r := recover(); r != nil {
+			bucket.err = fmt.Errorf("failed to load the score bucket of the validator %s: %v", valAddr, r)
+		}
+		bucket.gasUsed = ctx.GasMeter().GasConsumed()
+	}()
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		bucket.err = err
+		return bucket
+	}
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, sdk.AccAddress](valAddr)
+	bucket.err = k.ValidatorDelegators.Walk(ctx, rng,
+		func(key collections.Pair[sdk.ValAddress, sdk.AccAddress]) (bool, error) {
+			entry, err := k.DelegationTimeEntries.Get(ctx, collections.Join(key.K2(), valAddr))
+			if err != nil {
+				return false, err
+			}
+			bucket.delegators = append(bucket.delegators, key.K2())
+			bucket.entries = append(bucket.entries, entry)
+			bucket.scores = append(
+				bucket.scores, accruedScore(validator, entry, blockTimeUnixSec, minDelegationAmount),
+			)
+			return false, nil
+		})
+	return bucket
+}
+
+// buildScoreBuckets rebuilds the validator buckets from the delegation time entries.
+func (k Keeper) buildScoreBuckets(ctx context.Context) error {
+	if err := k.ValidatorDelegators.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.ValidatorShares.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	// the buckets are written once the entries are walked, so the store isn't modified during the iteration
+	var keys []collections.Pair[sdk.ValAddress, sdk.AccAddress]
+	var valAddrs []sdk.ValAddress
+	shares := make(map[string]sdkmath.LegacyDec)
+	err := k.DelegationTimeEntries.Walk(ctx, nil,
+		func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], entry types.DelegationTimeEntry) (bool, error) {
+			valAddr := key.K2()
+			keys = append(keys, collections.Join(valAddr, key.K1()))
+			total, found := shares[valAddr.String()]
+			if !found {
+				total = sdkmath.LegacyZeroDec()
+				valAddrs = append(valAddrs, valAddr)
+			}
+			shares[valAddr.String()] = total.Add(entry.Shares)
+			return false, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.ValidatorDelegators.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+	for _, valAddr := range valAddrs {
+		if err := k.addValidatorShares(ctx, valAddr, shares[valAddr.String()]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
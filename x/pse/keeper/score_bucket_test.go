@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+func TestScoreBuckets(t *testing.T) {
+	requireT := require.New(t)
+	r := newScoreBucketsEnv(t, 3, 3)
+
+	// the self-delegations of the validators are dust, so the validators have no buckets
+	setMinDelegationAmountAction(r, 1_000_000)
+	for _, valAddr := range r.validators {
+		assertScoreBucketAction(r, valAddr, nil)
+	}
+
+	delegateAction(r, r.delegators[0], r.validators[0], 1_000_000)
+	delegateAction(r, r.delegators[1], r.validators[0], 2_000_000)
+	delegateAction(r, r.delegators[0], r.validators[1], 3_000_000)
+	delegateAction(r, r.delegators[2], r.validators[1], 999_999)
+	assertScoreBucketAction(r, r.validators[0], []sdk.AccAddress{r.delegators[0], r.delegators[1]})
+	assertScoreBucketAction(r, r.validators[1], []sdk.AccAddress{r.delegators[0]})
+	assertScoreBucketAction(r, r.validators[2], nil)
+
+	// the bucket follows the redelegations and the undelegations
+	redelegateAction(r, r.delegators[0], r.validators[1], r.validators[2], 3_000_000)
+	undelegateAction(r, r.delegators[1], r.validators[0], 1_500_000)
+	assertScoreBucketAction(r, r.validators[0], []sdk.AccAddress{r.delegators[0]})
+	assertScoreBucketAction(r, r.validators[1], nil)
+	assertScoreBucketAction(r, r.validators[2], []sdk.AccAddress{r.delegators[0]})
+
+	// the excluded addresses are removed from the buckets
+	requireT.NoError(r.testApp.PSEKeeper.UpdateExcludedAddresses(
+		r.ctx, r.testApp.GovAuthority(), []string{r.delegators[0].String()}, nil,
+	))
+	for _, valAddr := range r.validators {
+		assertScoreBucketAction(r, valAddr, nil)
+	}
+
+	// the distribution skips the validators without the buckets and keeps the buckets intact
+	delegateAction(r, r.delegators[2], r.validators[1], 1)
+	waitAction(r, time.Second*10)
+	distributeAction(r, sdkmath.NewInt(1000))
+	assertScoreBucketAction(r, r.validators[1], []sdk.AccAddress{r.delegators[2]})
+	entry, err := r.testApp.PSEKeeper.GetDelegationTimeEntry(r.ctx, r.validators[1], r.delegators[2])
+	requireT.NoError(err)
+	requireT.Equal(r.ctx.BlockTime().Unix(), entry.LastChangedUnixSec)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	requireT := require.New(t)
+	r := newScoreBucketsEnv(t, 2, 2)
+
+	setMinDelegationAmountAction(r, 1_000_000)
+	delegateAction(r, r.delegators[0], r.validators[0], 1_000_000)
+	delegateAction(r, r.delegators[1], r.validators[0], 2_000_000)
+	delegateAction(r, r.delegators[1], r.validators[1], 3_000_000)
+
+	// the buckets are missing before the migration
+	pseKeeper := r.testApp.PSEKeeper
+	requireT.NoError(pseKeeper.ValidatorDelegators.Clear(r.ctx, nil))
+	requireT.NoError(pseKeeper.ValidatorShares.Clear(r.ctx, nil))
+
+	requireT.NoError(keeper.NewMigrator(pseKeeper).Migrate3to4(r.ctx))
+	assertScoreBucketAction(r, r.validators[0], []sdk.AccAddress{r.delegators[0], r.delegators[1]})
+	assertScoreBucketAction(r, r.validators[1], []sdk.AccAddress{r.delegators[1]})
+}
+
+func TestScoreBuckets_MatchEntries(t *testing.T) {
+	requireT := require.New(t)
+	r := newScoreBucketsEnv(t, 3, 6)
+
+	setMinDelegationAmountAction(r, 1_000_000)
+	for i, delegator := range r.delegators {
+		delegateAction(r, delegator, r.validators[i%len(r.validators)], int64(1_000_000*(i+1)))
+	}
+	delegateAction(r, r.delegators[0], r.validators[2], 500_000)
+	waitAction(r, time.Hour)
+
+	// the buckets give the same scores as the iteration over all the entries
+	bucketScore, err := r.testApp.PSEKeeper.CalculateBucketScores(r.ctx)
+	requireT.NoError(err)
+	entryScore, err := r.testApp.PSEKeeper.CalculateEntryScores(r.ctx)
+	requireT.NoError(err)
+	requireT.True(bucketScore.IsPositive())
+	requireT.Equal(entryScore.String(), bucketScore.String())
+
+	// the buckets are loaded in parallel, but neither the score nor the gas depend on the scheduling of the workers
+	gasUsed := make([]storetypes.Gas, 0, 3)
+	for range 3 {
+		ctx := r.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		bucketScore, err = r.testApp.PSEKeeper.CalculateBucketScores(ctx)
+		requireT.NoError(err)
+		requireT.Equal(entryScore.String(), bucketScore.String())
+		gasUsed = append(gasUsed, ctx.GasMeter().GasConsumed())
+	}
+	requireT.Positive(gasUsed[0])
+	requireT.Equal([]storetypes.Gas{gasUsed[0], gasUsed[0], gasUsed[0]}, gasUsed)
+}
+
+// The benchmark compares the score calculation of the Community distribution loading the delegation time entries
+// through the validator buckets in parallel with the iteration over all the entries loading the validator of each of
+// them, which was used before the entries were bucketed, on the same delegator set, e.g.
+// `go test -run ^$ -bench CommunityScores -cpu 1,4 ./x/pse/keeper/`.
+func BenchmarkCommunityScores(b *testing.B) {
+	for _, validators := range []int{20, 100} {
+		for _, delegators := range []int{1_000, 5_000} {
+			r := newScoreBucketsEnv(b, validators, 0)
+			for i := range delegators {
+				delegator := sdk.AccAddress(fmt.Sprintf("delegator-%d", i))
+				delegateAction(r, delegator, r.validators[i%len(r.validators)], 1_000_000)
+			}
+			waitAction(r, time.Hour)
+
+			for _, tc := range []struct {
+				name      string
+				calculate func(ctx context.Context) (sdkmath.Int, error)
+			}{
+				{name: "buckets", calculate: r.testApp.PSEKeeper.CalculateBucketScores},
+				{name: "entries", calculate: r.testApp.PSEKeeper.CalculateEntryScores},
+			} {
+				b.Run(fmt.Sprintf("validators-%d/delegators-%d/%s", validators, delegators, tc.name), func(b *testing.B) {
+					for range b.N {
+						_, err := tc.calculate(r.ctx)
+						r.requireT.NoError(err)
+					}
+				})
+			}
+		}
+	}
+}
+
+func newScoreBucketsEnv(t testing.TB, validators, delegators int) *runEnv {
+	requireT := require.New(t)
+	startTime := time.Now().Round(time.Second)
+	testApp := simapp.New(simapp.WithStartTime(startTime))
+	ctx, _, err := testApp.BeginNextBlockAtTime(startTime)
+	requireT.NoError(err)
+	r := &runEnv{
+		testApp:  testApp,
+		ctx:      ctx,
+		requireT: requireT,
+	}
+
+	for range validators {
+		validatorOperator, _ := testApp.GenAccount(ctx)
+		requireT.NoError(testApp.FundAccount(
+			ctx, validatorOperator, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))),
+		)
+		validator, err := testApp.AddValidator(ctx, validatorOperator, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), nil)
+		requireT.NoError(err)
+		r.validators = append(r.validators, sdk.MustValAddressFromBech32(validator.GetOperator()))
+	}
+	for range delegators {
+		delegator, _ := testApp.GenAccount(ctx)
+		r.delegators = append(r.delegators, delegator)
+	}
+
+	return r
+}
+
+// assertScoreBucketAction checks that the bucket of the validator contains the delegators only and its shares are the
+// total shares of their delegation time entries.
+func assertScoreBucketAction(r *runEnv, valAddr sdk.ValAddress, delegators []sdk.AccAddress) {
+	pseKeeper := r.testApp.PSEKeeper
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, sdk.AccAddress](valAddr)
+	iter, err := pseKeeper.ValidatorDelegators.Iterate(r.ctx, rng)
+	r.requireT.NoError(err)
+	keys, err := iter.Keys()
+	r.requireT.NoError(err)
+
+	bucketDelegators := make([]sdk.AccAddress, 0, len(keys))
+	for _, key := range keys {
+		bucketDelegators = append(bucketDelegators, key.K2())
+	}
+	r.requireT.ElementsMatch(delegators, bucketDelegators)
+
+	shares, err := pseKeeper.ValidatorShares.Get(r.ctx, valAddr)
+	if len(delegators) == 0 {
+		r.requireT.ErrorIs(err, collections.ErrNotFound)
+		return
+	}
+	r.requireT.NoError(err)
+	expectedShares := sdkmath.LegacyZeroDec()
+	for _, delAddr := range delegators {
+		var entry types.DelegationTimeEntry
+		entry, err = pseKeeper.GetDelegationTimeEntry(r.ctx, valAddr, delAddr)
+		r.requireT.NoError(err)
+		expectedShares = expectedShares.Add(entry.Shares)
+	}
+	r.requireT.Equal(expectedShares.String(), shares.String())
+}
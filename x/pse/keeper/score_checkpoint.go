@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// recordScoreCheckpoint stores the final scores the community distribution is proportional to and emits the event
+// with the hash the checkpoint can be fetched by.
+func (k Keeper) recordScoreCheckpoint(ctx context.Context, scores *scoreMap, scheduledAt uint64) error {
+	checkpoint := types.ScoreCheckpoint{
+		ScheduledAt: scheduledAt,
+		TotalScore:  scores.totalScore,
+		Scores:      make([]types.AccountScore, 0),
+	}
+	if err := scores.walk(func(addr sdk.AccAddress, score sdkmath.Int) error {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return err
+		}
+		checkpoint.Scores = append(checkpoint.Scores, types.AccountScore{
+			Address: addrStr,
+			Score:   score,
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+	sort.Slice(checkpoint.Scores, func(i, j int) bool {
+		return checkpoint.Scores[i].Address < checkpoint.Scores[j].Address
+	})
+
+	hash, err := k.SetScoreCheckpoint(ctx, checkpoint)
+	if err != nil {
+		return err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventScoreCheckpoint{
+		ScheduledAt:    checkpoint.ScheduledAt,
+		TotalScore:     checkpoint.TotalScore,
+		Accounts:       uint64(len(checkpoint.Scores)),
+		CheckpointHash: hex.EncodeToString(hash),
+	}); err != nil {
+		k.logger.Error("failed to emit score checkpoint event", "error", err)
+	}
+
+	return nil
+}
+
+// SetScoreCheckpoint stores the score checkpoint under its hash and returns the hash.
+func (k Keeper) SetScoreCheckpoint(ctx context.Context, checkpoint types.ScoreCheckpoint) ([]byte, error) {
+	hash, err := checkpoint.Hash()
+	if err != nil {
+		return nil, err
+	}
+	if err := k.ScoreCheckpoints.Set(ctx, hash, checkpoint); err != nil {
+		return nil, err
+	}
+	return hash, nil
+}
+
+// GetScoreCheckpoint returns the score checkpoint by its hash.
+func (k Keeper) GetScoreCheckpoint(ctx context.Context, hash []byte) (types.ScoreCheckpoint, error) {
+	checkpoint, err := k.ScoreCheckpoints.Get(ctx, hash)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ScoreCheckpoint{}, errorsmod.Wrapf(
+				types.ErrScoreCheckpointNotFound, "hash: %s", hex.EncodeToString(hash),
+			)
+		}
+		return types.ScoreCheckpoint{}, err
+	}
+	return checkpoint, nil
+}
+
+// GetScoreCheckpoints returns all the score checkpoints ordered by their hashes.
+func (k Keeper) GetScoreCheckpoints(ctx context.Context) ([]types.ScoreCheckpoint, error) {
+	checkpoints := make([]types.ScoreCheckpoint, 0)
+	err := k.ScoreCheckpoints.Walk(ctx, nil, func(_ []byte, checkpoint types.ScoreCheckpoint) (bool, error) {
+		checkpoints = append(checkpoints, checkpoint)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
@@ -3,12 +3,9 @@ package keeper
 import (
 	"context"
 
-	"cosmossdk.io/collections"
 	addresscodec "cosmossdk.io/core/address"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
 )
 
 type scoreMap struct {
@@ -16,13 +13,20 @@ type scoreMap struct {
 		addr  sdk.AccAddress
 		score sdkmath.Int
 	}
-	indexMap          map[string]int
-	addressCodec      addresscodec.Codec
-	totalScore        sdkmath.Int
-	excludedAddresses []sdk.AccAddress
+	indexMap            map[string]int
+	addressCodec        addresscodec.Codec
+	totalScore          sdkmath.Int
+	excludedAddresses   []sdk.AccAddress
+	minDelegationAmount sdkmath.Int
+	// delegatedSince is the earliest time since which the delegator holds any of its delegations continuously
+	delegatedSince map[string]int64
 }
 
-func newScoreMap(addressCodec addresscodec.Codec, excludedAddressesStr []string) (*scoreMap, error) {
+func newScoreMap(
+	addressCodec addresscodec.Codec,
+	excludedAddressesStr []string,
+	minDelegationAmount sdkmath.Int,
+) (*scoreMap, error) {
 	excludedAddresses := make([]sdk.AccAddress, len(excludedAddressesStr))
 	for i, addr := range excludedAddressesStr {
 		var err error
@@ -36,10 +40,12 @@ func newScoreMap(addressCodec addresscodec.Codec, excludedAddressesStr []string)
 			addr  sdk.AccAddress
 			score sdkmath.Int
 		}, 0),
-		indexMap:          make(map[string]int),
-		addressCodec:      addressCodec,
-		totalScore:        sdkmath.NewInt(0),
-		excludedAddresses: excludedAddresses,
+		indexMap:            make(map[string]int),
+		addressCodec:        addressCodec,
+		totalScore:          sdkmath.NewInt(0),
+		excludedAddresses:   excludedAddresses,
+		minDelegationAmount: minDelegationAmount,
+		delegatedSince:      make(map[string]int64),
 	}, nil
 }
 
@@ -106,42 +112,54 @@ func (m *scoreMap) iterateAccountScoreSnapshot(ctx context.Context, k Keeper) er
 	return nil
 }
 
-func (m *scoreMap) iterateDelegationTimeEntries(ctx context.Context, k Keeper) (
-	[]collections.KeyValue[collections.Pair[sdk.AccAddress, sdk.ValAddress], types.DelegationTimeEntry], error,
-) {
-	var allDelegationTimeEntries []collections.KeyValue[
-		collections.Pair[sdk.AccAddress, sdk.ValAddress],
-		types.DelegationTimeEntry,
-	]
-	delegationTimeEntriesIterator, err := k.DelegationTimeEntries.Iterate(ctx, nil)
+// addBucketScores adds the scores calculated by the validator buckets.
+func (m *scoreMap) addBucketScores(buckets []*scoreBucket) error {
+	for _, bucket := range buckets {
+		for i, delAddr := range bucket.delegators {
+			if m.isExcludedAddress(delAddr) {
+				continue
+			}
+			if err := m.trackDelegatedSince(delAddr, bucket.entries[i].DelegatedSinceUnixSec); err != nil {
+				return err
+			}
+			if err := m.addScore(delAddr, bucket.scores[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *scoreMap) trackDelegatedSince(addr sdk.AccAddress, delegatedSince int64) error {
+	key, err := m.addressCodec.BytesToString(addr)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if since, found := m.delegatedSince[key]; !found || delegatedSince < since {
+		m.delegatedSince[key] = delegatedSince
 	}
-	defer delegationTimeEntriesIterator.Close()
+	return nil
+}
 
-	for ; delegationTimeEntriesIterator.Valid(); delegationTimeEntriesIterator.Next() {
-		kv, err := delegationTimeEntriesIterator.KeyValue()
+// forfeitRecentDelegatorScores removes the scores of the delegators which don't hold any of their delegations
+// continuously since the given time, including the delegators having no delegations at all.
+func (m *scoreMap) forfeitRecentDelegatorScores(minDelegatedSince int64) error {
+	items := m.items[:0]
+	m.indexMap = make(map[string]int)
+	for _, item := range m.items {
+		key, err := m.addressCodec.BytesToString(item.addr)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		allDelegationTimeEntries = append(allDelegationTimeEntries, kv)
-		delAddr := kv.Key.K1()
-		valAddr := kv.Key.K2()
-		if m.isExcludedAddress(delAddr) {
+		if since, found := m.delegatedSince[key]; !found || since > minDelegatedSince {
+			m.totalScore = m.totalScore.Sub(item.score)
 			continue
 		}
-
-		delegationTimeEntry := kv.Value
-		delegationScore, err := calculateAddedScore(ctx, k, valAddr, delegationTimeEntry)
-		if err != nil {
-			return nil, err
-		}
-		err = m.addScore(delAddr, delegationScore)
-		if err != nil {
-			return nil, err
-		}
+		items = append(items, item)
+		m.indexMap[key] = len(items) - 1
 	}
-	return allDelegationTimeEntries, nil
+	m.items = items
+	return nil
 }
 
 func (m *scoreMap) isExcludedAddress(addr sdk.AccAddress) bool {
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// The accounts are paginated in two phases. First the delegators having the delegation time entries are returned,
+// then the accounts having only the accumulated score. The phase is the first byte of the pagination key.
+const (
+	scoresPhaseDelegators byte = iota
+	scoresPhaseSnapshot
+)
+
+// GetScoresPage returns the current total scores of the accounts starting from the pagination key. The key of the
+// next page is nil if all the scores are returned.
+func (k Keeper) GetScoresPage(
+	ctx context.Context,
+	key []byte,
+	limit uint64,
+) ([]types.AccountScore, []byte, error) {
+	if limit == 0 {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidInput, "limit must be positive")
+	}
+
+	phase := scoresPhaseDelegators
+	var start sdk.AccAddress
+	if len(key) > 0 {
+		phase, start = key[0], key[1:]
+		if phase > scoresPhaseSnapshot || len(start) == 0 {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidInput, "invalid pagination key")
+		}
+	}
+
+	var (
+		addresses []sdk.AccAddress
+		nextKey   []byte
+		err       error
+	)
+	if phase == scoresPhaseDelegators {
+		addresses, nextKey, err = k.delegatorsPage(ctx, start, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		start = nil
+	}
+	// the snapshot accounts are queried even if the page is full, to return the key of the next page
+	if nextKey == nil {
+		var snapshotAddresses []sdk.AccAddress
+		snapshotAddresses, nextKey, err = k.snapshotAccountsPage(ctx, start, limit-uint64(len(addresses)))
+		if err != nil {
+			return nil, nil, err
+		}
+		addresses = append(addresses, snapshotAddresses...)
+	}
+
+	scores := make([]types.AccountScore, 0, len(addresses))
+	for _, addr := range addresses {
+		score, err := k.CalculateDelegatorScore(ctx, addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		addrString, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		scores = append(scores, types.AccountScore{
+			Address: addrString,
+			Score:   score,
+		})
+	}
+
+	return scores, nextKey, nil
+}
+
+// delegatorsPage returns the distinct delegators having the delegation time entries.
+func (k Keeper) delegatorsPage(
+	ctx context.Context,
+	start sdk.AccAddress,
+	limit uint64,
+) ([]sdk.AccAddress, []byte, error) {
+	var rng collections.Ranger[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	if start != nil {
+		rng = new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).
+			StartInclusive(collections.PairPrefix[sdk.AccAddress, sdk.ValAddress](start))
+	}
+	iter, err := k.DelegationTimeEntries.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var addresses []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+		delAddr := key.K1()
+		if len(addresses) > 0 && addresses[len(addresses)-1].Equals(delAddr) {
+			continue
+		}
+		if uint64(len(addresses)) == limit {
+			return addresses, scoresPageKey(scoresPhaseDelegators, delAddr), nil
+		}
+		addresses = append(addresses, delAddr)
+	}
+
+	return addresses, nil, nil
+}
+
+// snapshotAccountsPage returns the accounts having the accumulated score, but no delegation time entries.
+func (k Keeper) snapshotAccountsPage(
+	ctx context.Context,
+	start sdk.AccAddress,
+	limit uint64,
+) ([]sdk.AccAddress, []byte, error) {
+	var rng collections.Ranger[sdk.AccAddress]
+	if start != nil {
+		rng = new(collections.Range[sdk.AccAddress]).StartInclusive(start)
+	}
+	iter, err := k.AccountScoreSnapshot.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var addresses []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		addr, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+		hasEntries, err := k.hasDelegationTimeEntries(ctx, addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if hasEntries {
+			continue
+		}
+		if uint64(len(addresses)) == limit {
+			return addresses, scoresPageKey(scoresPhaseSnapshot, addr), nil
+		}
+		addresses = append(addresses, addr)
+	}
+
+	return addresses, nil, nil
+}
+
+func (k Keeper) hasDelegationTimeEntries(ctx context.Context, delAddr sdk.AccAddress) (bool, error) {
+	iter, err := k.DelegationTimeEntries.Iterate(
+		ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr),
+	)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
+
+func scoresPageKey(phase byte, addr sdk.AccAddress) []byte {
+	return append([]byte{phase}, addr...)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// timeScaleChainIDs are the chain IDs the fast clock can be enabled on. The fast clock changes the blocks the
+// distributions are processed in, so it must never be enabled on the networks holding real value.
+var timeScaleChainIDs = map[string]struct{}{
+	string(constant.ChainIDDev): {},
+}
+
+// SetTimeScale sets the dev-only fast clock. The distance between the origin and the timestamps of the scheduled
+// distributions is divided by the divisor, so the schedule is processed divisor times faster. It is meant for
+// exercising the full schedule on the development networks, that's why it can be enabled only on the allowed ones.
+// The fast clock is set from genesis and stored in the state, so all the nodes of the network use the same values.
+func (k Keeper) SetTimeScale(ctx context.Context, timeScale types.TimeScale) error {
+	if err := timeScale.Validate(); err != nil {
+		return err
+	}
+	if !timeScale.Enabled() {
+		return k.TimeScale.Remove(ctx)
+	}
+	chainID := sdk.UnwrapSDKContext(ctx).ChainID()
+	if _, ok := timeScaleChainIDs[chainID]; !ok {
+		return errorsmod.Wrapf(types.ErrInvalidInput, "time scale can't be enabled on %s", chainID)
+	}
+
+	return k.TimeScale.Set(ctx, timeScale)
+}
+
+// GetTimeScale returns the dev-only fast clock, the divisor is zero if the fast clock is disabled.
+func (k Keeper) GetTimeScale(ctx context.Context) (types.TimeScale, error) {
+	timeScale, err := k.TimeScale.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TimeScale{}, nil
+	}
+	return timeScale, err
+}
+
+// dueTimestamp returns the block time the distribution scheduled at the timestamp is due at.
+func (k Keeper) dueTimestamp(ctx context.Context, timestamp uint64) (uint64, error) {
+	timeScale, err := k.GetTimeScale(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !timeScale.Enabled() || timestamp <= timeScale.Origin {
+		return timestamp, nil
+	}
+
+	return timeScale.Origin + (timestamp-timeScale.Origin)/timeScale.Divisor, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+func TestSetTimeScale(t *testing.T) {
+	testCases := []struct {
+		name      string
+		chainID   constant.ChainID
+		timeScale types.TimeScale
+		wantErr   bool
+	}{
+		{name: "devnet", chainID: constant.ChainIDDev, timeScale: types.TimeScale{Origin: 1, Divisor: 720}},
+		{name: "disabled on mainnet", chainID: constant.ChainIDMain, timeScale: types.TimeScale{}},
+		{
+			name:      "custom chain",
+			chainID:   "custom-1",
+			timeScale: types.TimeScale{Origin: 1, Divisor: 2},
+			wantErr:   true,
+		},
+		{
+			name:      "mainnet",
+			chainID:   constant.ChainIDMain,
+			timeScale: types.TimeScale{Origin: 1, Divisor: 720},
+			wantErr:   true,
+		},
+		{
+			name:      "testnet",
+			chainID:   constant.ChainIDTest,
+			timeScale: types.TimeScale{Origin: 1, Divisor: 720},
+			wantErr:   true,
+		},
+		{
+			name:      "divisor one",
+			chainID:   constant.ChainIDDev,
+			timeScale: types.TimeScale{Origin: 1, Divisor: 1},
+			wantErr:   true,
+		},
+		{
+			name:      "zero origin",
+			chainID:   constant.ChainIDDev,
+			timeScale: types.TimeScale{Divisor: 720},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testApp := simapp.New()
+			ctx := testApp.NewContext(false).WithChainID(string(tc.chainID))
+			pseKeeper := testApp.PSEKeeper
+
+			err := pseKeeper.SetTimeScale(ctx, tc.timeScale)
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+
+			timeScale, err := pseKeeper.GetTimeScale(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.timeScale, timeScale)
+		})
+	}
+}
+
+func TestTimeScale_PeekNextAllocationSchedule(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	origin := time.Now().Truncate(time.Second)
+	ctx := testApp.NewContext(false).WithBlockTime(origin).WithChainID(string(constant.ChainIDDev))
+	pseKeeper := testApp.PSEKeeper
+
+	// with the divisor of 720 the monthly distribution is processed each hour
+	allocations := make([]types.ClearingAccountAllocation, 0, len(types.GetAllClearingAccounts()))
+	for _, clearingAccount := range types.GetAllClearingAccounts() {
+		allocations = append(allocations, types.ClearingAccountAllocation{
+			ClearingAccount: clearingAccount,
+			Amount:          sdkmath.NewInt(100),
+		})
+	}
+	scheduledAt := origin.Add(30 * 24 * time.Hour)
+	requireT.NoError(pseKeeper.SaveDistributionSchedule(ctx, []types.ScheduledDistribution{
+		{Timestamp: uint64(scheduledAt.Unix()), Allocations: allocations},
+	}))
+
+	// the fast clock is disabled by default
+	_, shouldProcess, err := pseKeeper.PeekNextAllocationSchedule(ctx.WithBlockTime(origin.Add(time.Hour)))
+	requireT.NoError(err)
+	requireT.False(shouldProcess)
+
+	// the fast clock is set from genesis
+	genesis, err := pseKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	genesis.TimeScale = types.TimeScale{Origin: uint64(origin.Unix()), Divisor: 720}
+	requireT.NoError(genesis.Validate())
+	requireT.NoError(pseKeeper.InitGenesis(ctx, *genesis))
+
+	_, shouldProcess, err = pseKeeper.PeekNextAllocationSchedule(ctx.WithBlockTime(origin.Add(59 * time.Minute)))
+	requireT.NoError(err)
+	requireT.False(shouldProcess)
+
+	distribution, shouldProcess, err := pseKeeper.PeekNextAllocationSchedule(ctx.WithBlockTime(origin.Add(time.Hour)))
+	requireT.NoError(err)
+	requireT.True(shouldProcess)
+	requireT.EqualValues(scheduledAt.Unix(), distribution.Timestamp)
+
+	exported, err := pseKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genesis.TimeScale, exported.TimeScale)
+
+	// the genesis enabling the fast clock on other chains is rejected
+	requireT.ErrorIs(
+		pseKeeper.InitGenesis(ctx.WithChainID(string(constant.ChainIDMain)), *genesis),
+		types.ErrInvalidInput,
+	)
+}
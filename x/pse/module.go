@@ -18,6 +18,7 @@ import (
 
 	"github.com/tokenize-x/tx-chain/v7/x/pse/client/cli"
 	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/simulation"
 	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
 )
 
@@ -26,9 +27,7 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
-
-	_ appmodule.HasEndBlocker = AppModule{}
-	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.AppModule        = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the module.
@@ -69,7 +68,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 
 // GetTxCmd returns the root tx command for the module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns no root query command for the module.
@@ -86,13 +85,24 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingQuerier
 }
 
 // NewAppModule creates a new AppModule object.
-func NewAppModule(keeper keeper.Keeper) AppModule {
+func NewAppModule(
+	keeper keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingQuerier,
+) AppModule {
 	return AppModule{
-		keeper: keeper,
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 }
 
@@ -100,6 +110,17 @@ func NewAppModule(keeper keeper.Keeper) AppModule {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(errorsmod.Wrapf(err, "can't register module %s migrations", types.ModuleName))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(errorsmod.Wrapf(err, "can't register module %s migrations", types.ModuleName))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(errorsmod.Wrapf(err, "can't register module %s migrations", types.ModuleName))
+	}
 }
 
 // Name returns the module's name.
@@ -132,30 +153,7 @@ func (am AppModule) IsAppModule() {}
 func (am AppModule) IsOnePerModuleType() {}
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
-
-// EndBlock returns the end blocker for the module. It returns no validator
-// updates.
-func (am AppModule) EndBlock(c context.Context) error {
-	// Process periodic distributions
-	disabled, err := am.keeper.DistributionDisabled.Get(c)
-	if err != nil {
-		return err
-	}
-	ctx := sdk.UnwrapSDKContext(c)
-	if disabled {
-		ctx.Logger().Info("skipping distribution because it was marked as disabled")
-		return nil
-	}
-	cacheCtx, writeCache := ctx.CacheContext()
-	err = am.keeper.ProcessNextDistribution(cacheCtx) //nolint:contextcheck // this is correct context passing
-	if err != nil {
-		ctx.Logger().Error("failed to process next distribution, disabling all future distributions", "error", err)
-		return am.keeper.DistributionDisabled.Set(c, true)
-	}
-	writeCache()
-	return nil
-}
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // AppModuleSimulation functions
 
@@ -169,5 +167,12 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 
 // WeightedOperations returns the all the module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+	return simulation.NewOperationFactory(
+		simState.AppParams,
+		simState.TxConfig,
+		am.keeper,
+		am.accountKeeper,
+		am.bankKeeper,
+		am.stakingKeeper,
+	).WeightedOperations()
 }
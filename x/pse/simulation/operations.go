@@ -0,0 +1,223 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/pse/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/pse/types"
+)
+
+// Message types.
+var (
+	TypeMsgSetDistributionPreference = sdk.MsgTypeURL(&types.MsgSetDistributionPreference{})
+	TypeMsgOptOutDistributions       = sdk.MsgTypeURL(&types.MsgOptOutDistributions{})
+	TypeMsgOptInDistributions        = sdk.MsgTypeURL(&types.MsgOptInDistributions{})
+	TypeMsgFundDistribution          = sdk.MsgTypeURL(&types.MsgFundDistribution{})
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgSetDistributionPreference      = "op_weight_msg_set_distribution_preference"
+	DefaultWeightMsgSetDistributionPreference = 50
+	OpWeightMsgOptOutDistributions            = "op_weight_msg_opt_out_distributions"
+	DefaultWeightMsgOptOutDistributions       = 20
+	OpWeightMsgOptInDistributions             = "op_weight_msg_opt_in_distributions"
+	DefaultWeightMsgOptInDistributions        = 20
+	OpWeightMsgFundDistribution               = "op_weight_msg_fund_distribution"
+	DefaultWeightMsgFundDistribution          = 30
+)
+
+// OperationFactory creates simulation messages.
+type OperationFactory struct {
+	appParams simtypes.AppParams
+	txConfig  client.TxConfig
+	keeper    keeper.Keeper
+	ak        types.AccountKeeper
+	bk        types.BankKeeper
+	sk        types.StakingQuerier
+}
+
+// NewOperationFactory returns new instance of the OperationFactory.
+func NewOperationFactory(
+	appParams simtypes.AppParams,
+	txConfig client.TxConfig,
+	keeper keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingQuerier,
+) *OperationFactory {
+	return &OperationFactory{
+		appParams: appParams,
+		txConfig:  txConfig,
+		keeper:    keeper,
+		ak:        ak,
+		bk:        bk,
+		sk:        sk,
+	}
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func (op *OperationFactory) WeightedOperations() simulation.WeightedOperations {
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgSetDistributionPreference, DefaultWeightMsgSetDistributionPreference),
+			op.simulateMsgSetDistributionPreference,
+		),
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgOptOutDistributions, DefaultWeightMsgOptOutDistributions),
+			op.simulateMsgOptDistributions(true),
+		),
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgOptInDistributions, DefaultWeightMsgOptInDistributions),
+			op.simulateMsgOptDistributions(false),
+		),
+		simulation.NewWeightedOperation(
+			op.weight(OpWeightMsgFundDistribution, DefaultWeightMsgFundDistribution),
+			op.simulateMsgFundDistribution,
+		),
+	}
+}
+
+// weight returns the weight of the operation, making it updatable by the simulation.
+func (op *OperationFactory) weight(key string, defaultWeight int) int {
+	var weight int
+	op.appParams.GetOrGenerate(key, &weight, nil,
+		func(_ *rand.Rand) {
+			weight = defaultWeight
+		},
+	)
+	return weight
+}
+
+func (op *OperationFactory) simulateMsgSetDistributionPreference(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, _ string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	senderAcc, _ := simtypes.RandomAcc(r, accs)
+	if op.ak.GetAccount(ctx, senderAcc.Address) == nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetDistributionPreference, "account not found"), nil, nil
+	}
+
+	msg := &types.MsgSetDistributionPreference{
+		DelegatorAddress: senderAcc.Address.String(),
+	}
+	// the preference is cleared sometimes, otherwise one of the validators the sender delegates to is chosen
+	if r.Intn(4) != 0 {
+		res, err := op.sk.DelegatorDelegations(ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: senderAcc.Address.String(),
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, TypeMsgSetDistributionPreference, "can't get delegations",
+			), nil, err
+		}
+		if len(res.DelegationResponses) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetDistributionPreference, "no delegations"), nil, nil
+		}
+		delegation := res.DelegationResponses[r.Intn(len(res.DelegationResponses))].Delegation
+		msg.ValidatorAddress = delegation.ValidatorAddress
+	}
+
+	return op.deliver(r, app, ctx, senderAcc, msg)
+}
+
+func (op *OperationFactory) simulateMsgOptDistributions(optOut bool) simtypes.Operation {
+	typeMsg := TypeMsgOptInDistributions
+	if optOut {
+		typeMsg = TypeMsgOptOutDistributions
+	}
+
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		senderAcc, _ := simtypes.RandomAcc(r, accs)
+		if op.ak.GetAccount(ctx, senderAcc.Address) == nil {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsg, "account not found"), nil, nil
+		}
+		optedOut, err := op.keeper.IsDistributionOptedOut(ctx, senderAcc.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsg, "can't get opt out"), nil, err
+		}
+		if optedOut == optOut {
+			return simtypes.NoOpMsg(types.ModuleName, typeMsg, "already set"), nil, nil
+		}
+
+		var msg sdk.Msg = &types.MsgOptInDistributions{Address: senderAcc.Address.String()}
+		if optOut {
+			msg = &types.MsgOptOutDistributions{Address: senderAcc.Address.String()}
+		}
+
+		return op.deliver(r, app, ctx, senderAcc, msg)
+	}
+}
+
+func (op *OperationFactory) simulateMsgFundDistribution(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, _ string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	disabled, err := op.keeper.DistributionDisabled.Get(ctx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgFundDistribution, "can't get disabled flag"), nil, err
+	}
+	if disabled {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgFundDistribution, "distributions disabled"), nil, nil
+	}
+	schedule, err := op.keeper.GetDistributionSchedule(ctx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgFundDistribution, "can't get schedule"), nil, err
+	}
+	if len(schedule) == 0 {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgFundDistribution, "no scheduled distributions"), nil, nil
+	}
+
+	senderAcc, _ := simtypes.RandomAcc(r, accs)
+	if op.ak.GetAccount(ctx, senderAcc.Address) == nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgFundDistribution, "account not found"), nil, nil
+	}
+	bondDenom, err := op.sk.BondDenom(ctx)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgFundDistribution, "can't get bond denom"), nil, err
+	}
+	spendable := op.bk.SpendableCoins(ctx, senderAcc.Address).AmountOf(bondDenom)
+	// the funding is limited to the small part of the balance, so the account keeps the funds for the other operations
+	if !spendable.QuoRaw(100).IsPositive() {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgFundDistribution, "insufficient balance"), nil, nil
+	}
+	amount, err := simtypes.RandPositiveInt(r, spendable.QuoRaw(100))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgFundDistribution, "can't generate amount"), nil, err
+	}
+
+	return op.deliver(r, app, ctx, senderAcc, &types.MsgFundDistribution{
+		Sender:          senderAcc.Address.String(),
+		PeriodTimestamp: schedule[r.Intn(len(schedule))].Timestamp,
+		Amount:          sdk.NewCoin(bondDenom, amount),
+	})
+}
+
+func (op *OperationFactory) deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	senderAcc simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTx(simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         op.txConfig,
+		Msg:           msg,
+		Context:       ctx,
+		SimAccount:    senderAcc,
+		AccountKeeper: op.ak,
+		ModuleName:    types.ModuleName,
+	}, sdk.Coins{})
+}
@@ -189,44 +189,337 @@ func (m *ScheduledDistribution) GetAllocations() []ClearingAccountAllocation {
 	return nil
 }
 
+// RemainingUnlock aggregates the distributions still scheduled to transfer the tokens from a clearing account.
+type RemainingUnlock struct {
+	// clearing_account is the name of the clearing account.
+	ClearingAccount string `protobuf:"bytes,1,opt,name=clearing_account,json=clearingAccount,proto3" json:"clearing_account,omitempty" yaml:"clearing_account"`
+	// amount is the total amount the remaining scheduled distributions transfer from the clearing account.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	// distributions is the number of the remaining scheduled distributions allocating from the clearing account.
+	Distributions uint64 `protobuf:"varint,3,opt,name=distributions,proto3" json:"distributions,omitempty" yaml:"distributions"`
+	// next_timestamp is the Unix timestamp of the next scheduled distribution allocating from the clearing account.
+	// It's zero if nothing is scheduled.
+	NextTimestamp uint64 `protobuf:"varint,4,opt,name=next_timestamp,json=nextTimestamp,proto3" json:"next_timestamp,omitempty" yaml:"next_timestamp"`
+	// last_timestamp is the Unix timestamp of the last scheduled distribution allocating from the clearing account.
+	// It's zero if nothing is scheduled.
+	LastTimestamp uint64 `protobuf:"varint,5,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty" yaml:"last_timestamp"`
+}
+
+func (m *RemainingUnlock) Reset()         { *m = RemainingUnlock{} }
+func (m *RemainingUnlock) String() string { return proto.CompactTextString(m) }
+func (*RemainingUnlock) ProtoMessage()    {}
+func (*RemainingUnlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a549fe743b42ab69, []int{3}
+}
+func (m *RemainingUnlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemainingUnlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemainingUnlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemainingUnlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemainingUnlock.Merge(m, src)
+}
+func (m *RemainingUnlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemainingUnlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemainingUnlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemainingUnlock proto.InternalMessageInfo
+
+func (m *RemainingUnlock) GetClearingAccount() string {
+	if m != nil {
+		return m.ClearingAccount
+	}
+	return ""
+}
+
+func (m *RemainingUnlock) GetDistributions() uint64 {
+	if m != nil {
+		return m.Distributions
+	}
+	return 0
+}
+
+func (m *RemainingUnlock) GetNextTimestamp() uint64 {
+	if m != nil {
+		return m.NextTimestamp
+	}
+	return 0
+}
+
+func (m *RemainingUnlock) GetLastTimestamp() uint64 {
+	if m != nil {
+		return m.LastTimestamp
+	}
+	return 0
+}
+
+// DistributionFunding defines the amount escrowed by a funder against a scheduled distribution period.
+type DistributionFunding struct {
+	// period_timestamp is the timestamp of the funded scheduled distribution.
+	PeriodTimestamp uint64 `protobuf:"varint,1,opt,name=period_timestamp,json=periodTimestamp,proto3" json:"period_timestamp,omitempty" yaml:"period_timestamp"`
+	// funder is the address which escrowed the amount and receives the refund if the period is removed.
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty" yaml:"funder"`
+	// amount is the escrowed amount of the bond denom.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *DistributionFunding) Reset()         { *m = DistributionFunding{} }
+func (m *DistributionFunding) String() string { return proto.CompactTextString(m) }
+func (*DistributionFunding) ProtoMessage()    {}
+func (*DistributionFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a549fe743b42ab69, []int{4}
+}
+func (m *DistributionFunding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionFunding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionFunding.Merge(m, src)
+}
+func (m *DistributionFunding) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionFunding proto.InternalMessageInfo
+
+func (m *DistributionFunding) GetPeriodTimestamp() uint64 {
+	if m != nil {
+		return m.PeriodTimestamp
+	}
+	return 0
+}
+
+func (m *DistributionFunding) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+// NamedSchedule defines a distribution program processed independently of the main distribution schedule.
+// Each named schedule distributes the tokens from its own clearing accounts to its own recipients, so new programs
+// are added without changing the main schedule.
+type NamedSchedule struct {
+	// name is the unique name of the schedule, e.g. "partner-grant-2026".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// clearing_account_mappings defines the clearing accounts of the schedule and their recipients.
+	// The clearing accounts of the named schedule are the addresses derived from the schedule and clearing account
+	// names, they are funded by sending the tokens to them.
+	ClearingAccountMappings []ClearingAccountMapping `protobuf:"bytes,2,rep,name=clearing_account_mappings,json=clearingAccountMappings,proto3" json:"clearing_account_mappings" yaml:"clearing_account_mappings"`
+	// distributions contains the remaining scheduled distributions sorted by timestamp in ascending order.
+	// Each distribution may allocate the tokens from any subset of the clearing accounts of the schedule.
+	Distributions []ScheduledDistribution `protobuf:"bytes,3,rep,name=distributions,proto3" json:"distributions" yaml:"distributions"`
+	// disabled is set once the distribution of the schedule fails, the schedule is not processed anymore until it is
+	// updated by governance.
+	Disabled bool `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty" yaml:"disabled"`
+}
+
+func (m *NamedSchedule) Reset()         { *m = NamedSchedule{} }
+func (m *NamedSchedule) String() string { return proto.CompactTextString(m) }
+func (*NamedSchedule) ProtoMessage()    {}
+func (*NamedSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a549fe743b42ab69, []int{5}
+}
+func (m *NamedSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamedSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamedSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedSchedule.Merge(m, src)
+}
+func (m *NamedSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedSchedule proto.InternalMessageInfo
+
+func (m *NamedSchedule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamedSchedule) GetClearingAccountMappings() []ClearingAccountMapping {
+	if m != nil {
+		return m.ClearingAccountMappings
+	}
+	return nil
+}
+
+func (m *NamedSchedule) GetDistributions() []ScheduledDistribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+func (m *NamedSchedule) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+// DistributionPreference defines the validator the Community distribution payouts of the delegator are delegated to.
+type DistributionPreference struct {
+	// delegator_address is the address of the delegator receiving the payouts.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the address of the validator the payouts are delegated to.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *DistributionPreference) Reset()         { *m = DistributionPreference{} }
+func (m *DistributionPreference) String() string { return proto.CompactTextString(m) }
+func (*DistributionPreference) ProtoMessage()    {}
+func (*DistributionPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a549fe743b42ab69, []int{6}
+}
+func (m *DistributionPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionPreference.Merge(m, src)
+}
+func (m *DistributionPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionPreference proto.InternalMessageInfo
+
+func (m *DistributionPreference) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DistributionPreference) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ClearingAccountMapping)(nil), "tx.pse.v1.ClearingAccountMapping")
 	proto.RegisterType((*ClearingAccountAllocation)(nil), "tx.pse.v1.ClearingAccountAllocation")
 	proto.RegisterType((*ScheduledDistribution)(nil), "tx.pse.v1.ScheduledDistribution")
+	proto.RegisterType((*RemainingUnlock)(nil), "tx.pse.v1.RemainingUnlock")
+	proto.RegisterType((*DistributionFunding)(nil), "tx.pse.v1.DistributionFunding")
+	proto.RegisterType((*NamedSchedule)(nil), "tx.pse.v1.NamedSchedule")
+	proto.RegisterType((*DistributionPreference)(nil), "tx.pse.v1.DistributionPreference")
 }
 
 func init() { proto.RegisterFile("tx/pse/v1/distribution.proto", fileDescriptor_a549fe743b42ab69) }
 
 var fileDescriptor_a549fe743b42ab69 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x77, 0xaa, 0x14, 0x76, 0x8a, 0x58, 0xe2, 0x56, 0xdb, 0x55, 0x92, 0x12, 0x3c, 0xf4,
-	0xb2, 0x33, 0xb4, 0x82, 0x82, 0x78, 0xd9, 0x28, 0x4a, 0x0f, 0x5e, 0x52, 0x4f, 0x5e, 0x96, 0xd9,
-	0xc9, 0x90, 0x1d, 0x9a, 0xcc, 0x0c, 0x99, 0xb7, 0xcb, 0xd6, 0x4f, 0xe1, 0x37, 0xf1, 0xe2, 0xc5,
-	0x6f, 0x50, 0x6f, 0xc5, 0x93, 0x78, 0x08, 0xb2, 0xfb, 0x0d, 0xf2, 0x09, 0x24, 0x99, 0x90, 0xd6,
-	0x45, 0x6f, 0xbd, 0x25, 0xef, 0xff, 0x7f, 0x3f, 0xde, 0xff, 0xcd, 0xc3, 0x4f, 0x60, 0x49, 0x8d,
-	0x15, 0x74, 0x71, 0x4c, 0x13, 0x69, 0xa1, 0x90, 0xd3, 0x39, 0x48, 0xad, 0x88, 0x29, 0x34, 0x68,
-	0xaf, 0x0f, 0x4b, 0x62, 0xac, 0x20, 0x8b, 0xe3, 0xe1, 0x20, 0xd5, 0xa9, 0x6e, 0xaa, 0xb4, 0xfe,
-	0x72, 0x86, 0xe1, 0x01, 0xd7, 0x36, 0xd7, 0x76, 0xe2, 0x04, 0xf7, 0xe3, 0xa4, 0xf0, 0x3b, 0xc2,
-	0x0f, 0x5f, 0x67, 0x82, 0x15, 0x52, 0xa5, 0x63, 0xce, 0xf5, 0x5c, 0xc1, 0x7b, 0x66, 0x8c, 0x54,
-	0xa9, 0xf7, 0x16, 0xef, 0xf2, 0x56, 0x99, 0x30, 0x27, 0xed, 0xa3, 0x43, 0x74, 0xd4, 0x8f, 0x1e,
-	0x57, 0x65, 0xf0, 0xe8, 0x82, 0xe5, 0xd9, 0xcb, 0x70, 0xd3, 0x11, 0xc6, 0xf7, 0xf9, 0xdf, 0x38,
-	0x2f, 0xc5, 0x0f, 0x0a, 0xc1, 0xa5, 0x91, 0x42, 0xc1, 0x84, 0x25, 0x49, 0x21, 0xac, 0x15, 0x76,
-	0x7f, 0xeb, 0xf0, 0xce, 0x51, 0x3f, 0x7a, 0x5e, 0x95, 0xc1, 0xd0, 0xa1, 0xfe, 0x61, 0x0a, 0x7f,
-	0x7c, 0x1d, 0x0d, 0xda, 0x79, 0xc7, 0xae, 0x78, 0x06, 0x35, 0x3b, 0xf6, 0x3a, 0xf7, 0xb8, 0x33,
-	0x7f, 0x43, 0xf8, 0x60, 0x23, 0xcb, 0x38, 0xcb, 0x34, 0x67, 0xf5, 0xae, 0x6e, 0x2d, 0xce, 0x07,
-	0xbc, 0xcd, 0xf2, 0xa6, 0x7b, 0xab, 0xe9, 0x7e, 0x75, 0x59, 0x06, 0xbd, 0x5f, 0x65, 0xb0, 0xe7,
-	0xe6, 0xb4, 0xc9, 0x39, 0x91, 0x9a, 0xe6, 0x0c, 0x66, 0xe4, 0x54, 0x41, 0x55, 0x06, 0xf7, 0x1c,
-	0xda, 0x35, 0xd5, 0x89, 0x70, 0x9b, 0xe8, 0x54, 0x41, 0xdc, 0xb2, 0xc2, 0x2f, 0x08, 0xef, 0x9d,
-	0xf1, 0x99, 0x48, 0xe6, 0x99, 0x48, 0xde, 0xdc, 0x78, 0x63, 0xef, 0x04, 0xf7, 0x41, 0xe6, 0xc2,
-	0x02, 0xcb, 0x4d, 0x33, 0xf0, 0xdd, 0x68, 0x50, 0x95, 0xc1, 0xae, 0xa3, 0x76, 0x52, 0x18, 0x5f,
-	0xdb, 0xbc, 0x29, 0xde, 0x61, 0x5d, 0x72, 0xb7, 0xea, 0x9d, 0x93, 0xa7, 0xa4, 0xbb, 0x13, 0xf2,
-	0xdf, 0x35, 0x45, 0xc3, 0x3a, 0x4e, 0x55, 0x06, 0x5e, 0x3b, 0xf5, 0x35, 0x26, 0x8c, 0x6f, 0x42,
-	0xa3, 0x77, 0x97, 0x2b, 0x1f, 0x5d, 0xad, 0x7c, 0xf4, 0x7b, 0xe5, 0xa3, 0xcf, 0x6b, 0xbf, 0x77,
-	0xb5, 0xf6, 0x7b, 0x3f, 0xd7, 0x7e, 0xef, 0xe3, 0x28, 0x95, 0x30, 0x9b, 0x4f, 0x09, 0xd7, 0x39,
-	0x05, 0x7d, 0x2e, 0x94, 0xfc, 0x24, 0x46, 0x4b, 0x0a, 0xcb, 0x11, 0x9f, 0x31, 0xa9, 0xe8, 0xe2,
-	0x05, 0x75, 0xe7, 0x0c, 0x17, 0x46, 0xd8, 0xe9, 0x76, 0x73, 0x89, 0xcf, 0xfe, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x58, 0x32, 0x8e, 0xed, 0xe5, 0x02, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xd3, 0x52, 0x35, 0x53, 0xa5, 0x69, 0xdd, 0xb4, 0x4d, 0x42, 0x89, 0xc3, 0xc0, 0xa1,
+	0x1c, 0x62, 0xab, 0x05, 0x81, 0x84, 0x00, 0x35, 0x06, 0x15, 0xf5, 0x00, 0x42, 0x6e, 0xe1, 0xc0,
+	0x25, 0x9a, 0xd8, 0x53, 0x67, 0xa8, 0x3d, 0xb6, 0xec, 0x49, 0x94, 0xf2, 0x07, 0xb8, 0xf2, 0x4f,
+	0xb8, 0x70, 0xd9, 0x7f, 0xd0, 0xbd, 0x55, 0x2b, 0xad, 0xb4, 0xda, 0x83, 0xb5, 0x6a, 0x0f, 0x7b,
+	0xf7, 0x4a, 0x7b, 0x5e, 0xd9, 0x33, 0x71, 0x1c, 0x37, 0x91, 0xf6, 0xd0, 0xc3, 0xde, 0x92, 0xf7,
+	0x7d, 0xef, 0x7b, 0xf3, 0xde, 0x7c, 0x6f, 0x0c, 0x0e, 0xd8, 0x44, 0xf3, 0x43, 0xac, 0x8d, 0x8f,
+	0x34, 0x8b, 0x84, 0x2c, 0x20, 0x83, 0x11, 0x23, 0x1e, 0x55, 0xfd, 0xc0, 0x63, 0x9e, 0x5c, 0x61,
+	0x13, 0xd5, 0x0f, 0xb1, 0x3a, 0x3e, 0x6a, 0xd5, 0x6d, 0xcf, 0xf6, 0xd2, 0xa8, 0x96, 0xfc, 0xe2,
+	0x84, 0x56, 0xd3, 0xf4, 0x42, 0xd7, 0x0b, 0xfb, 0x1c, 0xe0, 0x7f, 0x38, 0x04, 0x9f, 0x4a, 0x60,
+	0xef, 0x47, 0x07, 0xa3, 0x80, 0x50, 0xbb, 0x67, 0x9a, 0xde, 0x88, 0xb2, 0x5f, 0x90, 0xef, 0x13,
+	0x6a, 0xcb, 0xa7, 0x60, 0xcb, 0x14, 0x48, 0x1f, 0x71, 0xa8, 0x21, 0x75, 0xa4, 0xc3, 0x8a, 0xfe,
+	0x71, 0x1c, 0x29, 0xfb, 0xd7, 0xc8, 0x75, 0xbe, 0x85, 0x45, 0x06, 0x34, 0x6a, 0xe6, 0xbc, 0x9c,
+	0x6c, 0x83, 0x9d, 0x00, 0x9b, 0xc4, 0x27, 0x98, 0xb2, 0x3e, 0xb2, 0xac, 0x00, 0x87, 0x21, 0x0e,
+	0x1b, 0xe5, 0xce, 0xca, 0x61, 0x45, 0xff, 0x3a, 0x8e, 0x94, 0x16, 0x97, 0x5a, 0x40, 0x82, 0xcf,
+	0xfe, 0xef, 0xd6, 0xc5, 0x79, 0x7b, 0x3c, 0x78, 0xce, 0x12, 0x6d, 0x43, 0xce, 0xd8, 0xbd, 0x8c,
+	0xfc, 0x44, 0x02, 0xcd, 0x42, 0x2f, 0x3d, 0xc7, 0xf1, 0x4c, 0x94, 0xcc, 0xea, 0xd1, 0xda, 0xb9,
+	0x00, 0x6b, 0xc8, 0x4d, 0xb3, 0xcb, 0x69, 0xf6, 0x77, 0x37, 0x91, 0x52, 0x7a, 0x19, 0x29, 0xbb,
+	0xfc, 0x9c, 0xa1, 0x75, 0xa5, 0x12, 0x4f, 0x73, 0x11, 0x1b, 0xaa, 0x67, 0x94, 0xc5, 0x91, 0x52,
+	0xe5, 0xd2, 0x3c, 0x29, 0xe9, 0x08, 0x88, 0x8e, 0xce, 0x28, 0x33, 0x84, 0x16, 0xfc, 0x4f, 0x02,
+	0xbb, 0xe7, 0xe6, 0x10, 0x5b, 0x23, 0x07, 0x5b, 0x3f, 0xe5, 0xee, 0x58, 0x3e, 0x06, 0x15, 0x46,
+	0x5c, 0x1c, 0x32, 0xe4, 0xfa, 0xe9, 0x81, 0x57, 0xf5, 0x7a, 0x1c, 0x29, 0x5b, 0x5c, 0x35, 0x83,
+	0xa0, 0x31, 0xa3, 0xc9, 0x03, 0xb0, 0x81, 0xb2, 0xce, 0xf9, 0xa8, 0x37, 0x8e, 0x3f, 0x57, 0x33,
+	0x9f, 0xa8, 0x4b, 0xc7, 0xa4, 0xb7, 0x92, 0x76, 0xe2, 0x48, 0x91, 0xc5, 0xa9, 0x67, 0x32, 0xd0,
+	0xc8, 0x8b, 0xc2, 0x37, 0x65, 0x50, 0x33, 0xb0, 0x8b, 0x08, 0x25, 0xd4, 0xfe, 0x9d, 0x3a, 0x9e,
+	0x79, 0xf5, 0x61, 0xcf, 0x58, 0xfe, 0x01, 0x54, 0xf3, 0xdb, 0x13, 0x36, 0x56, 0xd2, 0x69, 0x36,
+	0xe2, 0x48, 0xa9, 0xf3, 0xfc, 0x39, 0x18, 0x1a, 0xf3, 0x74, 0xf9, 0x04, 0x6c, 0x52, 0x3c, 0x61,
+	0xfd, 0xd9, 0x75, 0xac, 0xa6, 0x02, 0xcd, 0x38, 0x52, 0x76, 0xb9, 0xc0, 0x3c, 0x0e, 0x8d, 0x6a,
+	0x12, 0xb8, 0xc8, 0xee, 0xe5, 0x04, 0x6c, 0x3a, 0x28, 0xcc, 0x2b, 0x7c, 0x54, 0x54, 0x98, 0xc7,
+	0xa1, 0x51, 0x4d, 0x02, 0x99, 0x02, 0x7c, 0x2b, 0x81, 0x9d, 0xbc, 0x3d, 0x4e, 0x47, 0xd4, 0x12,
+	0xcb, 0xea, 0xe3, 0x80, 0x78, 0x56, 0xbf, 0x68, 0x96, 0xdc, 0xe4, 0x8b, 0x0c, 0x68, 0xd4, 0x78,
+	0x68, 0x76, 0xc2, 0x1e, 0x58, 0xbb, 0x1c, 0x51, 0x0b, 0x07, 0x62, 0xf2, 0x5f, 0xcc, 0x86, 0xcb,
+	0xe3, 0xcb, 0x57, 0x52, 0x24, 0xe6, 0x2e, 0x6f, 0xe5, 0x11, 0x17, 0xe4, 0x79, 0x19, 0x54, 0x7f,
+	0x45, 0x2e, 0xb6, 0xa6, 0x5b, 0x22, 0x7f, 0x06, 0x56, 0x29, 0x72, 0xb1, 0x30, 0x58, 0x2d, 0x8e,
+	0x94, 0x0d, 0x71, 0x09, 0xc8, 0xc5, 0xd0, 0x48, 0x41, 0xf9, 0x1f, 0x09, 0x34, 0x8b, 0x86, 0xeb,
+	0xbb, 0xfc, 0x85, 0x9b, 0x2e, 0xc6, 0xa7, 0xcb, 0x17, 0x43, 0xbc, 0x85, 0xfa, 0xa1, 0xd8, 0x8a,
+	0xce, 0x62, 0x0b, 0x67, 0x8a, 0xd0, 0xd8, 0x37, 0x17, 0x2a, 0x84, 0xb2, 0xf5, 0xd0, 0x7d, 0x49,
+	0xf1, 0x4e, 0xae, 0xf8, 0xc2, 0x07, 0x40, 0x3f, 0x10, 0xb5, 0xdf, 0xcb, 0xa3, 0x1a, 0x58, 0xb7,
+	0x48, 0x88, 0x06, 0x0e, 0xb6, 0x52, 0x77, 0xae, 0xeb, 0x3b, 0x71, 0xa4, 0xd4, 0xb2, 0xd4, 0x14,
+	0x81, 0x46, 0x46, 0x82, 0xaf, 0x25, 0xb0, 0x97, 0x2f, 0xf7, 0x5b, 0x80, 0x2f, 0x71, 0x80, 0xa9,
+	0x89, 0x65, 0x04, 0xb6, 0x2d, 0xec, 0x60, 0x1b, 0x31, 0x2f, 0x98, 0xbe, 0xc9, 0x62, 0xda, 0x5f,
+	0xc5, 0x91, 0xd2, 0x10, 0xa2, 0x45, 0xca, 0x72, 0x87, 0x6c, 0x65, 0x5c, 0x11, 0x97, 0xff, 0x02,
+	0xdb, 0x63, 0xe4, 0x10, 0x6b, 0xae, 0x04, 0x77, 0xde, 0xf7, 0xb3, 0x12, 0x0f, 0x28, 0x49, 0x89,
+	0x4f, 0x44, 0x89, 0x3f, 0xa6, 0x60, 0xa1, 0xd6, 0xb8, 0x10, 0xd7, 0x7f, 0xbe, 0xb9, 0x6b, 0x4b,
+	0xb7, 0x77, 0x6d, 0xe9, 0xd5, 0x5d, 0x5b, 0xfa, 0xf7, 0xbe, 0x5d, 0xba, 0xbd, 0x6f, 0x97, 0x5e,
+	0xdc, 0xb7, 0x4b, 0x7f, 0x76, 0x6d, 0xc2, 0x86, 0xa3, 0x81, 0x6a, 0x7a, 0xae, 0xc6, 0xbc, 0x2b,
+	0x4c, 0xc9, 0xdf, 0xb8, 0x3b, 0xd1, 0xd8, 0xa4, 0x6b, 0x0e, 0x11, 0xa1, 0xda, 0xf8, 0x1b, 0x8d,
+	0x7f, 0x7f, 0xd9, 0xb5, 0x8f, 0xc3, 0xc1, 0x5a, 0xfa, 0xe9, 0xfc, 0xf2, 0xdd, 0x00, 0xe0, 0xbc,
+	0xbf, 0xe0, 0x96, 0x07, 0x00, 0x00,
 }
 
 func (m *ClearingAccountMapping) Marshal() (dAtA []byte, err error) {
@@ -335,91 +628,889 @@ func (m *ScheduledDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemainingUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemainingUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemainingUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastTimestamp != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.LastTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextTimestamp != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.NextTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Distributions != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Distributions))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClearingAccount) > 0 {
+		i -= len(m.ClearingAccount)
+		copy(dAtA[i:], m.ClearingAccount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ClearingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PeriodTimestamp != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PeriodTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamedSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ClearingAccountMappings) > 0 {
+		for iNdEx := len(m.ClearingAccountMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClearingAccountMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClearingAccountMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.RecipientAddresses) > 0 {
+		for _, s := range m.RecipientAddresses {
+			l = len(s)
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClearingAccountAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ScheduledDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovDistribution(uint64(m.Timestamp))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemainingUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Distributions != 0 {
+		n += 1 + sovDistribution(uint64(m.Distributions))
+	}
+	if m.NextTimestamp != 0 {
+		n += 1 + sovDistribution(uint64(m.NextTimestamp))
+	}
+	if m.LastTimestamp != 0 {
+		n += 1 + sovDistribution(uint64(m.LastTimestamp))
+	}
+	return n
+}
+
+func (m *DistributionFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodTimestamp != 0 {
+		n += 1 + sovDistribution(uint64(m.PeriodTimestamp))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *NamedSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.ClearingAccountMappings) > 0 {
+		for _, e := range m.ClearingAccountMappings {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Disabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *DistributionPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClearingAccountMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearingAccountMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearingAccountMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddresses = append(m.RecipientAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearingAccountAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearingAccountAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearingAccountAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, ClearingAccountAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemainingUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemainingUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemainingUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			m.Distributions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Distributions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ClearingAccountMapping) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClearingAccount)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	if len(m.RecipientAddresses) > 0 {
-		for _, s := range m.RecipientAddresses {
-			l = len(s)
-			n += 1 + l + sovDistribution(uint64(l))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTimestamp", wireType)
+			}
+			m.NextTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+			}
+			m.LastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *ClearingAccountAllocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClearingAccount)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	return n
-}
 
-func (m *ScheduledDistribution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		n += 1 + sovDistribution(uint64(m.Timestamp))
-	}
-	if len(m.Allocations) > 0 {
-		for _, e := range m.Allocations {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ClearingAccountMapping) Unmarshal(dAtA []byte) error {
+func (m *DistributionFunding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -442,15 +1533,34 @@ func (m *ClearingAccountMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearingAccountMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionFunding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearingAccountMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionFunding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTimestamp", wireType)
+			}
+			m.PeriodTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -478,11 +1588,11 @@ func (m *ClearingAccountMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -510,7 +1620,9 @@ func (m *ClearingAccountMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddresses = append(m.RecipientAddresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -533,7 +1645,7 @@ func (m *ClearingAccountMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearingAccountAllocation) Unmarshal(dAtA []byte) error {
+func (m *NamedSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -556,15 +1668,15 @@ func (m *ClearingAccountAllocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearingAccountAllocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamedSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearingAccountAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamedSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -592,13 +1704,13 @@ func (m *ClearingAccountAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccountMappings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -608,26 +1720,80 @@ func (m *ClearingAccountAllocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClearingAccountMappings = append(m.ClearingAccountMappings, ClearingAccountMapping{})
+			if err := m.ClearingAccountMappings[len(m.ClearingAccountMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, ScheduledDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -649,7 +1815,7 @@ func (m *ClearingAccountAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduledDistribution) Unmarshal(dAtA []byte) error {
+func (m *DistributionPreference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -672,17 +1838,17 @@ func (m *ScheduledDistribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduledDistribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionPreference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduledDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionPreference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.Timestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -692,16 +1858,29 @@ func (m *ScheduledDistribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -711,25 +1890,23 @@ func (m *ScheduledDistribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allocations = append(m.Allocations, ClearingAccountAllocation{})
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22,4 +22,13 @@ var (
 
 	// ErrInvalidParam is returned when a parameter is invalid.
 	ErrInvalidParam = sdkerrors.Register(ModuleName, 7, "invalid parameter")
+
+	// ErrDistributionNotFound is returned when the scheduled distribution doesn't exist.
+	ErrDistributionNotFound = sdkerrors.Register(ModuleName, 8, "scheduled distribution not found")
+
+	// ErrScoreCheckpointNotFound is returned when the score checkpoint doesn't exist.
+	ErrScoreCheckpointNotFound = sdkerrors.Register(ModuleName, 9, "score checkpoint not found")
+
+	// ErrNamedScheduleNotFound is returned when the named schedule doesn't exist.
+	ErrNamedScheduleNotFound = sdkerrors.Register(ModuleName, 10, "named schedule not found")
 )
@@ -46,6 +46,8 @@ type EventAllocationDistributed struct {
 	// total_amount is the total amount allocated from the clearing account.
 	// This equals: (amount_per_recipient * num_recipients) + community_pool_amount.
 	TotalAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=total_amount,json=totalAmount,proto3,customtype=cosmossdk.io/math.Int" json:"total_amount"`
+	// schedule_name is the name of the named schedule the allocation belongs to, it is empty for the main schedule.
+	ScheduleName string `protobuf:"bytes,7,opt,name=schedule_name,json=scheduleName,proto3" json:"schedule_name,omitempty"`
 }
 
 func (m *EventAllocationDistributed) Reset()         { *m = EventAllocationDistributed{} }
@@ -102,6 +104,13 @@ func (m *EventAllocationDistributed) GetScheduledAt() uint64 {
 	return 0
 }
 
+func (m *EventAllocationDistributed) GetScheduleName() string {
+	if m != nil {
+		return m.ScheduleName
+	}
+	return ""
+}
+
 type EventCommunityDistributed struct {
 	DelegatorAddress string                `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Score            cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=score,proto3,customtype=cosmossdk.io/math.Int" json:"score"`
@@ -158,254 +167,3192 @@ func (m *EventCommunityDistributed) GetScheduledAt() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*EventAllocationDistributed)(nil), "tx.pse.v1.EventAllocationDistributed")
-	proto.RegisterType((*EventCommunityDistributed)(nil), "tx.pse.v1.EventCommunityDistributed")
+// EventDistributionFunded is emitted when coins are escrowed against a scheduled distribution.
+type EventDistributionFunded struct {
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	// period_timestamp is the timestamp of the funded scheduled distribution.
+	PeriodTimestamp uint64                `protobuf:"varint,2,opt,name=period_timestamp,json=periodTimestamp,proto3" json:"period_timestamp,omitempty"`
+	Amount          cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func init() { proto.RegisterFile("tx/pse/v1/event.proto", fileDescriptor_01c86a7bc3b1fadd) }
+func (m *EventDistributionFunded) Reset()         { *m = EventDistributionFunded{} }
+func (m *EventDistributionFunded) String() string { return proto.CompactTextString(m) }
+func (*EventDistributionFunded) ProtoMessage()    {}
+func (*EventDistributionFunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{2}
+}
+func (m *EventDistributionFunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDistributionFunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDistributionFunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDistributionFunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDistributionFunded.Merge(m, src)
+}
+func (m *EventDistributionFunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDistributionFunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDistributionFunded.DiscardUnknown(m)
+}
 
-var fileDescriptor_01c86a7bc3b1fadd = []byte{
-	// 472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6a, 0xdb, 0x4e,
-	0x10, 0xc6, 0x2d, 0x3b, 0x31, 0x78, 0x93, 0x3f, 0xc9, 0x7f, 0x63, 0x83, 0x62, 0xa8, 0xe2, 0xe6,
-	0xe4, 0x1e, 0x2c, 0x11, 0x42, 0xe9, 0xb5, 0x72, 0x6b, 0x4a, 0x4e, 0x75, 0x9d, 0x5b, 0x2f, 0x62,
-	0xbd, 0x1a, 0xec, 0x25, 0x92, 0x46, 0x68, 0xc7, 0xc6, 0xe9, 0x53, 0xf4, 0xde, 0xd7, 0xe8, 0xa1,
-	0x8f, 0x90, 0x63, 0xe8, 0xa9, 0xf4, 0x10, 0x8a, 0xfd, 0x22, 0x45, 0x5a, 0x4b, 0xf4, 0x54, 0xd4,
-	0x9b, 0x34, 0x33, 0xbf, 0xef, 0xdb, 0x9d, 0x8f, 0x65, 0x3d, 0xda, 0x78, 0xa9, 0x06, 0x6f, 0x7d,
-	0xe5, 0xc1, 0x1a, 0x12, 0x72, 0xd3, 0x0c, 0x09, 0x79, 0x87, 0x36, 0x6e, 0xaa, 0xc1, 0x5d, 0x5f,
-	0xf5, 0xbb, 0x0b, 0x5c, 0x60, 0x51, 0xf5, 0xf2, 0x2f, 0x33, 0xd0, 0x3f, 0x97, 0xa8, 0x63, 0xd4,
-	0x81, 0x69, 0x98, 0x1f, 0xd3, 0xba, 0xfc, 0xd2, 0x62, 0xfd, 0x49, 0xae, 0xe5, 0x47, 0x11, 0x4a,
-	0x41, 0x0a, 0x93, 0xb7, 0x4a, 0x53, 0xa6, 0xe6, 0x2b, 0x82, 0x90, 0xbf, 0x60, 0xa7, 0x32, 0x02,
-	0x91, 0xa9, 0x64, 0x11, 0x08, 0x29, 0x71, 0x95, 0x90, 0x6d, 0x0d, 0xac, 0x61, 0x67, 0x76, 0x52,
-	0xd6, 0x7d, 0x53, 0xe6, 0x37, 0xec, 0x2c, 0x03, 0xa9, 0x52, 0x05, 0x09, 0x05, 0x22, 0x0c, 0x33,
-	0xd0, 0x1a, 0xb4, 0xdd, 0x1c, 0xb4, 0x86, 0x9d, 0xb1, 0xfd, 0xfd, 0xeb, 0xa8, 0xbb, 0x37, 0xf6,
-	0x4d, 0xef, 0x96, 0x72, 0x7a, 0xc6, 0x2b, 0xc8, 0x2f, 0x19, 0xfe, 0x9e, 0x75, 0x45, 0x9c, 0x8b,
-	0x06, 0x29, 0x64, 0x41, 0x35, 0x60, 0xb7, 0x72, 0xe7, 0xf1, 0xb3, 0x87, 0xa7, 0x8b, 0xc6, 0xcf,
-	0xa7, 0x8b, 0x9e, 0xd1, 0xd3, 0xe1, 0x9d, 0xab, 0xd0, 0x8b, 0x05, 0x2d, 0xdd, 0x9b, 0x84, 0x66,
-	0xdc, 0xa0, 0x53, 0xc8, 0x66, 0x25, 0xc8, 0x3f, 0xb0, 0x9e, 0xc4, 0x38, 0x5e, 0x25, 0x8a, 0xee,
-	0x83, 0x14, 0x31, 0x0a, 0xcc, 0x90, 0x7d, 0x50, 0x47, 0xf1, 0xac, 0x62, 0xa7, 0x88, 0x91, 0x5f,
-	0x90, 0xfc, 0x39, 0x3b, 0xd6, 0x72, 0x09, 0xe1, 0x2a, 0x82, 0x30, 0x10, 0x64, 0x1f, 0x0e, 0xac,
-	0xe1, 0xc1, 0xec, 0xa8, 0xaa, 0xf9, 0xc4, 0x5f, 0xb3, 0x63, 0x42, 0x12, 0x95, 0x59, 0xbb, 0x8e,
-	0xd9, 0x51, 0x81, 0x18, 0x93, 0xcb, 0x6f, 0x4d, 0x76, 0x5e, 0xa4, 0xf3, 0xa6, 0x3c, 0xc1, 0x9f,
-	0xe1, 0x4c, 0xd8, 0xff, 0x21, 0x44, 0xb0, 0x10, 0x84, 0x59, 0xb9, 0x71, 0x93, 0xce, 0x5f, 0xf6,
-	0x7d, 0x5a, 0x21, 0xfb, 0x3a, 0xbf, 0x66, 0x87, 0x5a, 0x62, 0x06, 0x76, 0xb3, 0xce, 0xf9, 0xcc,
-	0x2c, 0x9f, 0xb0, 0x13, 0x73, 0xb7, 0x54, 0x43, 0x60, 0xf0, 0x5a, 0xe9, 0xfc, 0x57, 0x50, 0x53,
-	0x0d, 0xb7, 0x85, 0xcc, 0x4b, 0xd6, 0xfe, 0x97, 0x24, 0xf6, 0xc3, 0x35, 0x96, 0x3f, 0x7e, 0xf7,
-	0xb0, 0x75, 0xac, 0xc7, 0xad, 0x63, 0xfd, 0xda, 0x3a, 0xd6, 0xe7, 0x9d, 0xd3, 0x78, 0xdc, 0x39,
-	0x8d, 0x1f, 0x3b, 0xa7, 0xf1, 0x71, 0xb4, 0x50, 0xb4, 0x5c, 0xcd, 0x5d, 0x89, 0xb1, 0x47, 0x78,
-	0x07, 0x89, 0xfa, 0x04, 0xa3, 0x8d, 0x47, 0x9b, 0x91, 0x5c, 0x0a, 0x95, 0x78, 0xeb, 0x57, 0x9e,
-	0x79, 0x66, 0x74, 0x9f, 0x82, 0x9e, 0xb7, 0x8b, 0x87, 0x72, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x37, 0x56, 0xf1, 0x27, 0x7d, 0x03, 0x00, 0x00,
+var xxx_messageInfo_EventDistributionFunded proto.InternalMessageInfo
+
+func (m *EventDistributionFunded) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
 }
 
-func (m *EventAllocationDistributed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDistributionFunded) GetPeriodTimestamp() uint64 {
+	if m != nil {
+		return m.PeriodTimestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventAllocationDistributed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventDistributionFundingRefunded is emitted when the escrowed coins are refunded because the funded scheduled
+// distribution was removed.
+type EventDistributionFundingRefunded struct {
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	// period_timestamp is the timestamp of the removed scheduled distribution.
+	PeriodTimestamp uint64                `protobuf:"varint,2,opt,name=period_timestamp,json=periodTimestamp,proto3" json:"period_timestamp,omitempty"`
+	Amount          cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *EventAllocationDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalAmount.Size()
-		i -= size
-		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventDistributionFundingRefunded) Reset()         { *m = EventDistributionFundingRefunded{} }
+func (m *EventDistributionFundingRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventDistributionFundingRefunded) ProtoMessage()    {}
+func (*EventDistributionFundingRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{3}
+}
+func (m *EventDistributionFundingRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDistributionFundingRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDistributionFundingRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.ScheduledAt != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ScheduledAt))
-		i--
-		dAtA[i] = 0x28
+}
+func (m *EventDistributionFundingRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDistributionFundingRefunded.Merge(m, src)
+}
+func (m *EventDistributionFundingRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDistributionFundingRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDistributionFundingRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDistributionFundingRefunded proto.InternalMessageInfo
+
+func (m *EventDistributionFundingRefunded) GetFunder() string {
+	if m != nil {
+		return m.Funder
 	}
-	{
-		size := m.CommunityPoolAmount.Size()
-		i -= size
-		if _, err := m.CommunityPoolAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *EventDistributionFundingRefunded) GetPeriodTimestamp() uint64 {
+	if m != nil {
+		return m.PeriodTimestamp
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.AmountPerRecipient.Size()
-		i -= size
-		if _, err := m.AmountPerRecipient.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// EventClearingAccountDeficit is emitted at the end of the block when the balance of the clearing account doesn't
+// cover its remaining scheduled outflow.
+type EventClearingAccountDeficit struct {
+	// clearing_account is the name of the clearing account.
+	ClearingAccount string `protobuf:"bytes,1,opt,name=clearing_account,json=clearingAccount,proto3" json:"clearing_account,omitempty"`
+	// balance is the current balance of the clearing account in the bond denom.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// scheduled_outflow is the total amount the remaining scheduled distributions transfer from the clearing account.
+	ScheduledOutflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=scheduled_outflow,json=scheduledOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"scheduled_outflow"`
+	// deficit is the scheduled outflow minus the balance.
+	Deficit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=deficit,proto3,customtype=cosmossdk.io/math.Int" json:"deficit"`
+}
+
+func (m *EventClearingAccountDeficit) Reset()         { *m = EventClearingAccountDeficit{} }
+func (m *EventClearingAccountDeficit) String() string { return proto.CompactTextString(m) }
+func (*EventClearingAccountDeficit) ProtoMessage()    {}
+func (*EventClearingAccountDeficit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{4}
+}
+func (m *EventClearingAccountDeficit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClearingAccountDeficit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClearingAccountDeficit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.RecipientAddresses) > 0 {
-		for iNdEx := len(m.RecipientAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RecipientAddresses[iNdEx])
-			copy(dAtA[i:], m.RecipientAddresses[iNdEx])
-			i = encodeVarintEvent(dAtA, i, uint64(len(m.RecipientAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+}
+func (m *EventClearingAccountDeficit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClearingAccountDeficit.Merge(m, src)
+}
+func (m *EventClearingAccountDeficit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClearingAccountDeficit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClearingAccountDeficit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClearingAccountDeficit proto.InternalMessageInfo
+
+func (m *EventClearingAccountDeficit) GetClearingAccount() string {
+	if m != nil {
+		return m.ClearingAccount
+	}
+	return ""
+}
+
+// EventScoreSlashed is emitted when the score of the delegator is reduced because the validator it delegates to is
+// slashed.
+type EventScoreSlashed struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// slash_fraction is the fraction of the tokens of the validator being slashed.
+	SlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction"`
+	// penalty_rate is the part of the score accrued by the delegation which is removed.
+	PenaltyRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=penalty_rate,json=penaltyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"penalty_rate"`
+	// accrued_score is the score accrued by the delegation in the current distribution period.
+	AccruedScore cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=accrued_score,json=accruedScore,proto3,customtype=cosmossdk.io/math.Int" json:"accrued_score"`
+	// removed_score is the score removed from the delegator.
+	RemovedScore cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=removed_score,json=removedScore,proto3,customtype=cosmossdk.io/math.Int" json:"removed_score"`
+	// score is the accumulated score of the delegator after the reduction.
+	Score cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=score,proto3,customtype=cosmossdk.io/math.Int" json:"score"`
+}
+
+func (m *EventScoreSlashed) Reset()         { *m = EventScoreSlashed{} }
+func (m *EventScoreSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventScoreSlashed) ProtoMessage()    {}
+func (*EventScoreSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{5}
+}
+func (m *EventScoreSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScoreSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScoreSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ClearingAccount) > 0 {
-		i -= len(m.ClearingAccount)
-		copy(dAtA[i:], m.ClearingAccount)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClearingAccount)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventScoreSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScoreSlashed.Merge(m, src)
+}
+func (m *EventScoreSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScoreSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScoreSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScoreSlashed proto.InternalMessageInfo
+
+func (m *EventScoreSlashed) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventCommunityDistributed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventScoreSlashed) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventCommunityDistributed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventScoreCheckpoint is emitted once per community distribution with the total score the distributed amounts are
+// proportional to. The full score checkpoint can be fetched by its hash.
+type EventScoreCheckpoint struct {
+	// scheduled_at is the Unix timestamp when the distribution was scheduled to occur.
+	ScheduledAt uint64                `protobuf:"varint,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	TotalScore  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_score,json=totalScore,proto3,customtype=cosmossdk.io/math.Int" json:"total_score"`
+	// accounts is the number of accounts in the checkpoint.
+	Accounts uint64 `protobuf:"varint,3,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	// checkpoint_hash is the hex encoded sha256 hash of the protobuf encoding of the score checkpoint.
+	CheckpointHash string `protobuf:"bytes,4,opt,name=checkpoint_hash,json=checkpointHash,proto3" json:"checkpoint_hash,omitempty"`
 }
 
-func (m *EventCommunityDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScheduledAt != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ScheduledAt))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TotalPseScore.Size()
-		i -= size
-		if _, err := m.TotalPseScore.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventScoreCheckpoint) Reset()         { *m = EventScoreCheckpoint{} }
+func (m *EventScoreCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*EventScoreCheckpoint) ProtoMessage()    {}
+func (*EventScoreCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{6}
+}
+func (m *EventScoreCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScoreCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScoreCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Score.Size()
-		i -= size
-		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *EventScoreCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScoreCheckpoint.Merge(m, src)
+}
+func (m *EventScoreCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScoreCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScoreCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScoreCheckpoint proto.InternalMessageInfo
+
+func (m *EventScoreCheckpoint) GetScheduledAt() uint64 {
+	if m != nil {
+		return m.ScheduledAt
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventScoreCheckpoint) GetAccounts() uint64 {
+	if m != nil {
+		return m.Accounts
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventScoreCheckpoint) GetCheckpointHash() string {
+	if m != nil {
+		return m.CheckpointHash
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventAllocationDistributed) Size() (n int) {
-	if m == nil {
-		return 0
+
+// EventRemainingUnlocks is emitted after each processed distribution period with the amounts still scheduled to be
+// distributed from the clearing accounts, so the emission curve can be charted.
+type EventRemainingUnlocks struct {
+	// processed_at is the Unix timestamp the processed distribution was scheduled to occur at.
+	ProcessedAt uint64 `protobuf:"varint,1,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	// remaining_unlocks contains the remaining unlock of all PSE clearing accounts.
+	RemainingUnlocks []RemainingUnlock `protobuf:"bytes,2,rep,name=remaining_unlocks,json=remainingUnlocks,proto3" json:"remaining_unlocks"`
+}
+
+func (m *EventRemainingUnlocks) Reset()         { *m = EventRemainingUnlocks{} }
+func (m *EventRemainingUnlocks) String() string { return proto.CompactTextString(m) }
+func (*EventRemainingUnlocks) ProtoMessage()    {}
+func (*EventRemainingUnlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{7}
+}
+func (m *EventRemainingUnlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemainingUnlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemainingUnlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemainingUnlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemainingUnlocks.Merge(m, src)
+}
+func (m *EventRemainingUnlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemainingUnlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemainingUnlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemainingUnlocks proto.InternalMessageInfo
+
+func (m *EventRemainingUnlocks) GetProcessedAt() uint64 {
+	if m != nil {
+		return m.ProcessedAt
+	}
+	return 0
+}
+
+func (m *EventRemainingUnlocks) GetRemainingUnlocks() []RemainingUnlock {
+	if m != nil {
+		return m.RemainingUnlocks
+	}
+	return nil
+}
+
+// EventDistributionPreferenceSet is emitted when the delegator sets or clears the validator the Community
+// distribution payouts are delegated to.
+type EventDistributionPreferenceSet struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the chosen validator, empty if the preference is cleared.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *EventDistributionPreferenceSet) Reset()         { *m = EventDistributionPreferenceSet{} }
+func (m *EventDistributionPreferenceSet) String() string { return proto.CompactTextString(m) }
+func (*EventDistributionPreferenceSet) ProtoMessage()    {}
+func (*EventDistributionPreferenceSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{8}
+}
+func (m *EventDistributionPreferenceSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDistributionPreferenceSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDistributionPreferenceSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDistributionPreferenceSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDistributionPreferenceSet.Merge(m, src)
+}
+func (m *EventDistributionPreferenceSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDistributionPreferenceSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDistributionPreferenceSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDistributionPreferenceSet proto.InternalMessageInfo
+
+func (m *EventDistributionPreferenceSet) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *EventDistributionPreferenceSet) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// EventClearingFundsReallocated is emitted when the funds are reallocated between the clearing accounts via
+// governance.
+type EventClearingFundsReallocated struct {
+	// from_clearing_account is the clearing account the funds are moved from.
+	FromClearingAccount string `protobuf:"bytes,1,opt,name=from_clearing_account,json=fromClearingAccount,proto3" json:"from_clearing_account,omitempty"`
+	// to_clearing_account is the clearing account the funds are moved to.
+	ToClearingAccount string `protobuf:"bytes,2,opt,name=to_clearing_account,json=toClearingAccount,proto3" json:"to_clearing_account,omitempty"`
+	// share is the part of the balance and of each remaining scheduled allocation which is moved.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+	// balance_moved is the amount transferred between the clearing accounts.
+	BalanceMoved cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=balance_moved,json=balanceMoved,proto3,customtype=cosmossdk.io/math.Int" json:"balance_moved"`
+	// scheduled_moved is the total amount moved between the remaining scheduled allocations.
+	ScheduledMoved cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=scheduled_moved,json=scheduledMoved,proto3,customtype=cosmossdk.io/math.Int" json:"scheduled_moved"`
+	// allocations contains the amount moved in each remaining scheduled distribution.
+	Allocations []ReallocatedAllocation `protobuf:"bytes,6,rep,name=allocations,proto3" json:"allocations"`
+}
+
+func (m *EventClearingFundsReallocated) Reset()         { *m = EventClearingFundsReallocated{} }
+func (m *EventClearingFundsReallocated) String() string { return proto.CompactTextString(m) }
+func (*EventClearingFundsReallocated) ProtoMessage()    {}
+func (*EventClearingFundsReallocated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{9}
+}
+func (m *EventClearingFundsReallocated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClearingFundsReallocated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClearingFundsReallocated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClearingFundsReallocated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClearingFundsReallocated.Merge(m, src)
+}
+func (m *EventClearingFundsReallocated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClearingFundsReallocated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClearingFundsReallocated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClearingFundsReallocated proto.InternalMessageInfo
+
+func (m *EventClearingFundsReallocated) GetFromClearingAccount() string {
+	if m != nil {
+		return m.FromClearingAccount
+	}
+	return ""
+}
+
+func (m *EventClearingFundsReallocated) GetToClearingAccount() string {
+	if m != nil {
+		return m.ToClearingAccount
+	}
+	return ""
+}
+
+func (m *EventClearingFundsReallocated) GetAllocations() []ReallocatedAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+// ReallocatedAllocation is the amount moved between the allocations of the scheduled distribution.
+type ReallocatedAllocation struct {
+	// timestamp is the Unix timestamp of the scheduled distribution.
+	Timestamp uint64                `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ReallocatedAllocation) Reset()         { *m = ReallocatedAllocation{} }
+func (m *ReallocatedAllocation) String() string { return proto.CompactTextString(m) }
+func (*ReallocatedAllocation) ProtoMessage()    {}
+func (*ReallocatedAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{10}
+}
+func (m *ReallocatedAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReallocatedAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReallocatedAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReallocatedAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReallocatedAllocation.Merge(m, src)
+}
+func (m *ReallocatedAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReallocatedAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReallocatedAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReallocatedAllocation proto.InternalMessageInfo
+
+func (m *ReallocatedAllocation) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// EventDistributionOptOutSet is emitted when the address opts out of or opts in to the Community distributions.
+type EventDistributionOptOutSet struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// opted_out is true if the address opted out of the distributions, false if it opted in again.
+	OptedOut bool `protobuf:"varint,2,opt,name=opted_out,json=optedOut,proto3" json:"opted_out,omitempty"`
+}
+
+func (m *EventDistributionOptOutSet) Reset()         { *m = EventDistributionOptOutSet{} }
+func (m *EventDistributionOptOutSet) String() string { return proto.CompactTextString(m) }
+func (*EventDistributionOptOutSet) ProtoMessage()    {}
+func (*EventDistributionOptOutSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01c86a7bc3b1fadd, []int{11}
+}
+func (m *EventDistributionOptOutSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDistributionOptOutSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDistributionOptOutSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDistributionOptOutSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDistributionOptOutSet.Merge(m, src)
+}
+func (m *EventDistributionOptOutSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDistributionOptOutSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDistributionOptOutSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDistributionOptOutSet proto.InternalMessageInfo
+
+func (m *EventDistributionOptOutSet) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventDistributionOptOutSet) GetOptedOut() bool {
+	if m != nil {
+		return m.OptedOut
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EventAllocationDistributed)(nil), "tx.pse.v1.EventAllocationDistributed")
+	proto.RegisterType((*EventCommunityDistributed)(nil), "tx.pse.v1.EventCommunityDistributed")
+	proto.RegisterType((*EventDistributionFunded)(nil), "tx.pse.v1.EventDistributionFunded")
+	proto.RegisterType((*EventDistributionFundingRefunded)(nil), "tx.pse.v1.EventDistributionFundingRefunded")
+	proto.RegisterType((*EventClearingAccountDeficit)(nil), "tx.pse.v1.EventClearingAccountDeficit")
+	proto.RegisterType((*EventScoreSlashed)(nil), "tx.pse.v1.EventScoreSlashed")
+	proto.RegisterType((*EventScoreCheckpoint)(nil), "tx.pse.v1.EventScoreCheckpoint")
+	proto.RegisterType((*EventRemainingUnlocks)(nil), "tx.pse.v1.EventRemainingUnlocks")
+	proto.RegisterType((*EventDistributionPreferenceSet)(nil), "tx.pse.v1.EventDistributionPreferenceSet")
+	proto.RegisterType((*EventClearingFundsReallocated)(nil), "tx.pse.v1.EventClearingFundsReallocated")
+	proto.RegisterType((*ReallocatedAllocation)(nil), "tx.pse.v1.ReallocatedAllocation")
+	proto.RegisterType((*EventDistributionOptOutSet)(nil), "tx.pse.v1.EventDistributionOptOutSet")
+}
+
+func init() { proto.RegisterFile("tx/pse/v1/event.proto", fileDescriptor_01c86a7bc3b1fadd) }
+
+var fileDescriptor_01c86a7bc3b1fadd = []byte{
+	// 1091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0xf9, 0xd3, 0xbc, 0x38, 0x4d, 0x3c, 0x89, 0x85, 0x9b, 0x34, 0x6e, 0xea, 0x1e,
+	0x08, 0x87, 0xd8, 0x34, 0x15, 0xaa, 0xb8, 0x20, 0xec, 0x26, 0xa1, 0xad, 0x68, 0x13, 0x36, 0xc0,
+	0x81, 0xcb, 0x6a, 0x32, 0xfb, 0x6c, 0xaf, 0xb2, 0xbb, 0xb3, 0x9a, 0x99, 0x35, 0x09, 0x1f, 0x00,
+	0x89, 0x1b, 0x07, 0xbe, 0x06, 0xb7, 0x0a, 0x38, 0x21, 0x8e, 0x3d, 0x56, 0x3d, 0x21, 0x0e, 0x15,
+	0x4a, 0x3e, 0x06, 0x17, 0xb4, 0x33, 0xbb, 0x6b, 0xc7, 0x09, 0xb0, 0x91, 0x38, 0xf4, 0x66, 0xbf,
+	0x79, 0xbf, 0x37, 0xef, 0xef, 0xef, 0xcd, 0x42, 0x55, 0x9d, 0xb4, 0x22, 0x89, 0xad, 0xc1, 0xfd,
+	0x16, 0x0e, 0x30, 0x54, 0xcd, 0x48, 0x70, 0xc5, 0xc9, 0x9c, 0x3a, 0x69, 0x46, 0x12, 0x9b, 0x83,
+	0xfb, 0xab, 0x2b, 0x3d, 0xde, 0xe3, 0x5a, 0xda, 0x4a, 0x7e, 0x19, 0x85, 0xd5, 0x5b, 0x8c, 0xcb,
+	0x80, 0x4b, 0xc7, 0x1c, 0x98, 0x3f, 0xe9, 0xd1, 0xed, 0xa1, 0x49, 0xd7, 0x93, 0x4a, 0x78, 0x47,
+	0xb1, 0xf2, 0x78, 0x68, 0x4e, 0x1b, 0xbf, 0x95, 0x60, 0x75, 0x37, 0xb9, 0xa9, 0xed, 0xfb, 0x9c,
+	0xd1, 0xe4, 0x64, 0x27, 0xd3, 0x42, 0x97, 0xbc, 0x07, 0x4b, 0xcc, 0x47, 0x2a, 0xbc, 0xb0, 0xe7,
+	0x50, 0xc6, 0x78, 0x1c, 0xaa, 0x9a, 0xb5, 0x61, 0x6d, 0xce, 0xd9, 0x8b, 0x99, 0xbc, 0x6d, 0xc4,
+	0xe4, 0x09, 0x2c, 0x0b, 0x64, 0x5e, 0xe4, 0x61, 0xa8, 0x1c, 0xea, 0xba, 0x02, 0xa5, 0x44, 0x59,
+	0x9b, 0xdc, 0x28, 0x6d, 0xce, 0x75, 0x6a, 0xaf, 0x5f, 0x6c, 0xad, 0xa4, 0x6e, 0xb5, 0xcd, 0xd9,
+	0xa1, 0x4a, 0xd0, 0x36, 0xc9, 0x41, 0xed, 0x0c, 0x43, 0xf6, 0x61, 0x85, 0x06, 0x89, 0x51, 0x27,
+	0x42, 0xe1, 0xe4, 0x0a, 0xb5, 0x52, 0x72, 0x73, 0x67, 0xfd, 0xe5, 0x9b, 0x3b, 0x13, 0x7f, 0xbc,
+	0xb9, 0x53, 0x35, 0xf6, 0xa4, 0x7b, 0xdc, 0xf4, 0x78, 0x2b, 0xa0, 0xaa, 0xdf, 0x7c, 0x12, 0x2a,
+	0x9b, 0x18, 0xe8, 0x01, 0x0a, 0x3b, 0x03, 0x92, 0xcf, 0xa0, 0xca, 0x78, 0x10, 0xc4, 0xa1, 0xa7,
+	0x4e, 0x9d, 0x88, 0x73, 0xdf, 0x31, 0x4a, 0xb5, 0xa9, 0x22, 0x16, 0x97, 0x73, 0xec, 0x01, 0xe7,
+	0x7e, 0x5b, 0x23, 0xc9, 0x5d, 0x28, 0x4b, 0xd6, 0x47, 0x37, 0xf6, 0xd1, 0x75, 0xa8, 0xaa, 0x4d,
+	0x6f, 0x58, 0x9b, 0x53, 0xf6, 0x7c, 0x2e, 0x6b, 0x2b, 0xf2, 0x31, 0x94, 0x15, 0x57, 0x34, 0xbf,
+	0x6c, 0xa6, 0xc8, 0x65, 0xf3, 0x1a, 0x92, 0x5e, 0x72, 0x0f, 0x16, 0x32, 0x83, 0x4e, 0x48, 0x03,
+	0xac, 0xcd, 0xea, 0xdc, 0xe7, 0x37, 0x3f, 0xa7, 0x01, 0x36, 0x7e, 0x99, 0x84, 0x5b, 0xba, 0x84,
+	0x8f, 0x32, 0x37, 0x47, 0x2b, 0xb8, 0x0b, 0x15, 0x17, 0x7d, 0xec, 0x51, 0xc5, 0x45, 0x56, 0x16,
+	0x53, 0xc2, 0x7f, 0x29, 0xca, 0x52, 0x0e, 0x49, 0xe5, 0xe4, 0x01, 0x4c, 0x4b, 0xc6, 0x05, 0xd6,
+	0x26, 0x8b, 0x04, 0x61, 0x74, 0xc9, 0x2e, 0x2c, 0x9a, 0x04, 0x44, 0x12, 0x1d, 0x03, 0x2f, 0x54,
+	0xc2, 0x05, 0x8d, 0x3a, 0x90, 0x78, 0xa8, 0xcd, 0x7c, 0x00, 0x33, 0xd7, 0x29, 0x57, 0xaa, 0x5c,
+	0xa0, 0x42, 0x8d, 0x1f, 0x2d, 0x78, 0x47, 0xa7, 0x6e, 0x67, 0x64, 0x32, 0xf6, 0xe2, 0xd0, 0x45,
+	0x97, 0xbc, 0x0f, 0x33, 0xdd, 0xe4, 0x97, 0xf8, 0xcf, 0x6c, 0xa5, 0x7a, 0xc9, 0xb0, 0x44, 0x28,
+	0x3c, 0xee, 0x3a, 0xca, 0x0b, 0x50, 0x2a, 0x1a, 0x44, 0x3a, 0x5d, 0x53, 0xf6, 0xa2, 0x91, 0x7f,
+	0x9e, 0x89, 0x47, 0x42, 0x2a, 0x5d, 0x23, 0xa4, 0xc6, 0x4f, 0x16, 0x6c, 0x5c, 0xe9, 0x6f, 0xe2,
+	0x06, 0x76, 0xdf, 0x5e, 0xc7, 0xbf, 0x9d, 0x84, 0x35, 0xd3, 0xa3, 0x17, 0x59, 0x63, 0x07, 0xbb,
+	0x1e, 0xf3, 0xd4, 0x75, 0x78, 0xe6, 0x21, 0xcc, 0x1e, 0x51, 0x9f, 0x86, 0xac, 0x60, 0x2f, 0x66,
+	0xda, 0xe4, 0x29, 0x54, 0x86, 0xfd, 0xc0, 0x63, 0xd5, 0xf5, 0xf9, 0xd7, 0xc5, 0xa2, 0x58, 0xca,
+	0x71, 0xfb, 0x06, 0x96, 0x38, 0xe1, 0x1a, 0xd7, 0x8b, 0xf5, 0x64, 0xa6, 0xdd, 0xf8, 0xab, 0x04,
+	0x15, 0x9d, 0x08, 0xdd, 0xda, 0x87, 0x3e, 0x95, 0xfd, 0xff, 0x6f, 0x48, 0x9f, 0x43, 0x65, 0x40,
+	0x7d, 0xcf, 0xbd, 0x60, 0xc6, 0x24, 0xe9, 0xee, 0xeb, 0x17, 0x5b, 0xeb, 0xa9, 0x99, 0x2f, 0x33,
+	0x9d, 0x31, 0x7b, 0x83, 0x31, 0x39, 0x79, 0x0a, 0x37, 0x65, 0xe2, 0xa1, 0xd3, 0x15, 0x94, 0x25,
+	0xad, 0x96, 0xa6, 0xeb, 0x5e, 0x1a, 0xec, 0xda, 0xe5, 0x60, 0x3f, 0xc5, 0x1e, 0x65, 0xa7, 0x3b,
+	0xc8, 0xec, 0x05, 0x0d, 0xdd, 0x4b, 0x91, 0x64, 0x0f, 0xca, 0x11, 0x86, 0xd4, 0x57, 0xa7, 0x8e,
+	0xa0, 0x0a, 0xd3, 0xb4, 0x15, 0xb2, 0x34, 0x9f, 0x02, 0x6d, 0xaa, 0x90, 0x74, 0x60, 0x81, 0x32,
+	0x26, 0x62, 0x74, 0x53, 0x46, 0x99, 0x2e, 0x92, 0xff, 0x72, 0x8a, 0x31, 0x84, 0xd2, 0x81, 0x05,
+	0x81, 0x01, 0x1f, 0xe4, 0x36, 0x0a, 0x31, 0x73, 0x39, 0xc5, 0x18, 0x1b, 0x39, 0x21, 0xce, 0x16,
+	0x27, 0xc4, 0xc6, 0xaf, 0x16, 0xac, 0x0c, 0xab, 0xff, 0xa8, 0x8f, 0xec, 0x38, 0xe2, 0xde, 0x15,
+	0x5c, 0x65, 0x5d, 0xde, 0x26, 0x1f, 0x81, 0x59, 0x0d, 0xce, 0x35, 0x78, 0x18, 0x34, 0xc2, 0x38,
+	0xbc, 0x0a, 0x37, 0xd2, 0xc9, 0x92, 0xba, 0x8c, 0x53, 0x76, 0xfe, 0x9f, 0xbc, 0x0b, 0x8b, 0x2c,
+	0x77, 0xc6, 0xe9, 0x53, 0xd9, 0x37, 0xf5, 0xb1, 0x6f, 0x0e, 0xc5, 0x8f, 0xa9, 0xec, 0x37, 0xbe,
+	0xb3, 0xa0, 0xaa, 0x03, 0xb0, 0x31, 0xa0, 0x5e, 0xe8, 0x85, 0xbd, 0x2f, 0x42, 0x9f, 0xb3, 0x63,
+	0x99, 0x44, 0x10, 0x09, 0xce, 0x92, 0x05, 0x3e, 0x1a, 0x41, 0x2e, 0x6b, 0x2b, 0xf2, 0x0c, 0x2a,
+	0x22, 0x83, 0x39, 0xb1, 0xc1, 0xe9, 0xf7, 0xc1, 0xfc, 0xf6, 0x6a, 0x33, 0x7f, 0xe1, 0x34, 0xc7,
+	0x4c, 0x77, 0xa6, 0x92, 0x18, 0xed, 0x25, 0x31, 0x76, 0x63, 0xe3, 0x67, 0x0b, 0xea, 0x97, 0xc8,
+	0xf0, 0x40, 0x60, 0x17, 0x05, 0x86, 0x0c, 0x0f, 0x51, 0xbd, 0xa5, 0x73, 0xd5, 0xf8, 0xa1, 0x04,
+	0xeb, 0x17, 0xd8, 0x30, 0xa1, 0x70, 0x69, 0x23, 0x35, 0x6f, 0x30, 0x74, 0xc9, 0x36, 0x54, 0xbb,
+	0x82, 0x07, 0xce, 0x3f, 0x90, 0xe2, 0x72, 0x72, 0x38, 0x46, 0xa5, 0xa4, 0x09, 0xcb, 0x8a, 0x5f,
+	0x46, 0x68, 0x3f, 0xed, 0x8a, 0xe2, 0xe3, 0xfa, 0x1f, 0xc2, 0xb4, 0xec, 0xd3, 0x7c, 0x27, 0x17,
+	0x1a, 0x45, 0x83, 0x48, 0x06, 0x28, 0x65, 0x55, 0x47, 0x8f, 0x44, 0x31, 0x12, 0x2c, 0xa7, 0x98,
+	0x67, 0x09, 0x84, 0xec, 0xc1, 0xe2, 0xb0, 0xe5, 0x8d, 0x95, 0x42, 0xa3, 0x7c, 0x33, 0x47, 0x19,
+	0x3b, 0x8f, 0x61, 0x9e, 0xe6, 0x6f, 0x57, 0x59, 0x9b, 0xd1, 0xfd, 0xb4, 0x71, 0xa1, 0x9f, 0xf2,
+	0xbc, 0x0e, 0x1f, 0xb9, 0x69, 0x57, 0x8d, 0x42, 0x1b, 0x3e, 0x54, 0xaf, 0xd4, 0x25, 0xb7, 0x61,
+	0x6e, 0xb8, 0x18, 0x4d, 0x63, 0x0f, 0x05, 0x23, 0x2b, 0x71, 0xf2, 0x3a, 0x2b, 0x31, 0x48, 0x1f,
+	0xde, 0xa3, 0xdd, 0xbb, 0x1f, 0xa9, 0xfd, 0x58, 0x25, 0x9d, 0xbb, 0x0d, 0xb3, 0x45, 0xfb, 0x35,
+	0x53, 0x24, 0x6b, 0x30, 0xc7, 0x23, 0x65, 0x96, 0x9b, 0xf6, 0xe5, 0x86, 0x7d, 0x43, 0x0b, 0xf6,
+	0x63, 0xd5, 0xf9, 0xe4, 0xe5, 0x59, 0xdd, 0x7a, 0x75, 0x56, 0xb7, 0xfe, 0x3c, 0xab, 0x5b, 0xdf,
+	0x9f, 0xd7, 0x27, 0x5e, 0x9d, 0xd7, 0x27, 0x7e, 0x3f, 0xaf, 0x4f, 0x7c, 0xb5, 0xd5, 0xf3, 0x54,
+	0x3f, 0x3e, 0x6a, 0x32, 0x1e, 0xb4, 0x14, 0x3f, 0xc6, 0xd0, 0xfb, 0x06, 0xb7, 0x4e, 0x5a, 0xea,
+	0x64, 0x8b, 0xf5, 0xa9, 0x17, 0xb6, 0x06, 0x0f, 0x5b, 0xe6, 0x0b, 0x42, 0x9d, 0x46, 0x28, 0x8f,
+	0x66, 0xf4, 0x87, 0xc3, 0x83, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x65, 0xe5, 0x11, 0x03, 0xab,
+	0x0c, 0x00, 0x00,
+}
+
+func (m *EventAllocationDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAllocationDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAllocationDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClearingAccount)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ScheduleName) > 0 {
+		i -= len(m.ScheduleName)
+		copy(dAtA[i:], m.ScheduleName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ScheduleName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ScheduledAt != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.CommunityPoolAmount.Size()
+		i -= size
+		if _, err := m.CommunityPoolAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AmountPerRecipient.Size()
+		i -= size
+		if _, err := m.AmountPerRecipient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.RecipientAddresses) > 0 {
-		for _, s := range m.RecipientAddresses {
-			l = len(s)
-			n += 1 + l + sovEvent(uint64(l))
+		for iNdEx := len(m.RecipientAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecipientAddresses[iNdEx])
+			copy(dAtA[i:], m.RecipientAddresses[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.RecipientAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClearingAccount) > 0 {
+		i -= len(m.ClearingAccount)
+		copy(dAtA[i:], m.ClearingAccount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClearingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommunityDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommunityDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommunityDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledAt != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalPseScore.Size()
+		i -= size
+		if _, err := m.TotalPseScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDistributionFunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDistributionFunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDistributionFunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PeriodTimestamp != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PeriodTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDistributionFundingRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDistributionFundingRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDistributionFundingRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PeriodTimestamp != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PeriodTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClearingAccountDeficit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClearingAccountDeficit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClearingAccountDeficit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deficit.Size()
+		i -= size
+		if _, err := m.Deficit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ScheduledOutflow.Size()
+		i -= size
+		if _, err := m.ScheduledOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClearingAccount) > 0 {
+		i -= len(m.ClearingAccount)
+		copy(dAtA[i:], m.ClearingAccount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClearingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScoreSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScoreSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScoreSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RemovedScore.Size()
+		i -= size
+		if _, err := m.RemovedScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AccruedScore.Size()
+		i -= size
+		if _, err := m.AccruedScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PenaltyRate.Size()
+		i -= size
+		if _, err := m.PenaltyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScoreCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScoreCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScoreCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CheckpointHash) > 0 {
+		i -= len(m.CheckpointHash)
+		copy(dAtA[i:], m.CheckpointHash)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CheckpointHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Accounts != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Accounts))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalScore.Size()
+		i -= size
+		if _, err := m.TotalScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ScheduledAt != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemainingUnlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemainingUnlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemainingUnlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingUnlocks) > 0 {
+		for iNdEx := len(m.RemainingUnlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingUnlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ProcessedAt != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProcessedAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDistributionPreferenceSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDistributionPreferenceSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDistributionPreferenceSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClearingFundsReallocated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClearingFundsReallocated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClearingFundsReallocated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.ScheduledMoved.Size()
+		i -= size
+		if _, err := m.ScheduledMoved.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BalanceMoved.Size()
+		i -= size
+		if _, err := m.BalanceMoved.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToClearingAccount) > 0 {
+		i -= len(m.ToClearingAccount)
+		copy(dAtA[i:], m.ToClearingAccount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ToClearingAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromClearingAccount) > 0 {
+		i -= len(m.FromClearingAccount)
+		copy(dAtA[i:], m.FromClearingAccount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromClearingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReallocatedAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReallocatedAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReallocatedAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDistributionOptOutSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDistributionOptOutSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDistributionOptOutSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptedOut {
+		i--
+		if m.OptedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventAllocationDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.RecipientAddresses) > 0 {
+		for _, s := range m.RecipientAddresses {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.AmountPerRecipient.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CommunityPoolAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.ScheduledAt != 0 {
+		n += 1 + sovEvent(uint64(m.ScheduledAt))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ScheduleName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommunityDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TotalPseScore.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.ScheduledAt != 0 {
+		n += 1 + sovEvent(uint64(m.ScheduledAt))
+	}
+	return n
+}
+
+func (m *EventDistributionFunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PeriodTimestamp != 0 {
+		n += 1 + sovEvent(uint64(m.PeriodTimestamp))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventDistributionFundingRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PeriodTimestamp != 0 {
+		n += 1 + sovEvent(uint64(m.PeriodTimestamp))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventClearingAccountDeficit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ScheduledOutflow.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Deficit.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventScoreSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PenaltyRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.AccruedScore.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RemovedScore.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventScoreCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledAt != 0 {
+		n += 1 + sovEvent(uint64(m.ScheduledAt))
+	}
+	l = m.TotalScore.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Accounts != 0 {
+		n += 1 + sovEvent(uint64(m.Accounts))
+	}
+	l = len(m.CheckpointHash)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemainingUnlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProcessedAt != 0 {
+		n += 1 + sovEvent(uint64(m.ProcessedAt))
+	}
+	if len(m.RemainingUnlocks) > 0 {
+		for _, e := range m.RemainingUnlocks {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDistributionPreferenceSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventClearingFundsReallocated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ToClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BalanceMoved.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ScheduledMoved.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReallocatedAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovEvent(uint64(m.Timestamp))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventDistributionOptOutSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.OptedOut {
+		n += 2
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAllocationDistributed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAllocationDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddresses = append(m.RecipientAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommunityDistributed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommunityDistributed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommunityDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPseScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPseScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDistributionFunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDistributionFunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDistributionFunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTimestamp", wireType)
+			}
+			m.PeriodTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDistributionFundingRefunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDistributionFundingRefunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDistributionFundingRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTimestamp", wireType)
+			}
+			m.PeriodTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClearingAccountDeficit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClearingAccountDeficit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClearingAccountDeficit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deficit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deficit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScoreSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScoreSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScoreSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PenaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemovedScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScoreCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScoreCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScoreCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			m.Accounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRemainingUnlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRemainingUnlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRemainingUnlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedAt", wireType)
+			}
+			m.ProcessedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUnlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingUnlocks = append(m.RemainingUnlocks, RemainingUnlock{})
+			if err := m.RemainingUnlocks[len(m.RemainingUnlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDistributionPreferenceSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDistributionPreferenceSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDistributionPreferenceSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = m.AmountPerRecipient.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CommunityPoolAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.ScheduledAt != 0 {
-		n += 1 + sovEvent(uint64(m.ScheduledAt))
-	}
-	l = m.TotalAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
 
-func (m *EventCommunityDistributed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = m.Score.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.TotalPseScore.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Amount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.ScheduledAt != 0 {
-		n += 1 + sovEvent(uint64(m.ScheduledAt))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
+func (m *EventClearingFundsReallocated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -428,15 +3375,15 @@ func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAllocationDistributed: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClearingFundsReallocated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAllocationDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClearingFundsReallocated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromClearingAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -464,11 +3411,11 @@ func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			m.FromClearingAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToClearingAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -496,11 +3443,11 @@ func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddresses = append(m.RecipientAddresses, string(dAtA[iNdEx:postIndex]))
+			m.ToClearingAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -528,13 +3475,13 @@ func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountPerRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceMoved", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -562,15 +3509,15 @@ func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BalanceMoved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMoved", wireType)
 			}
-			m.ScheduledAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -580,16 +3527,31 @@ func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledAt |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledMoved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -599,23 +3561,23 @@ func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allocations = append(m.Allocations, ReallocatedAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -640,7 +3602,7 @@ func (m *EventAllocationDistributed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCommunityDistributed) Unmarshal(dAtA []byte) error {
+func (m *ReallocatedAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -663,17 +3625,17 @@ func (m *EventCommunityDistributed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCommunityDistributed: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReallocatedAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCommunityDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReallocatedAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -683,27 +3645,14 @@ func (m *EventCommunityDistributed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -731,47 +3680,63 @@ func (m *EventCommunityDistributed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPseScore", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDistributionOptOutSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalPseScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDistributionOptOutSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDistributionOptOutSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -799,15 +3764,13 @@ func (m *EventCommunityDistributed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedOut", wireType)
 			}
-			m.ScheduledAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -817,11 +3780,12 @@ func (m *EventCommunityDistributed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledAt |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.OptedOut = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2,16 +2,23 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesisState returns genesis state with default values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:                 DefaultParams(),
-		ScheduledDistributions: []ScheduledDistribution{},
-		DelegationTimeEntries:  []DelegationTimeEntryExport{},
-		AccountScores:          []AccountScore{},
-		DistributionsDisabled:  false,
+		Params:                   DefaultParams(),
+		ScheduledDistributions:   []ScheduledDistribution{},
+		DelegationTimeEntries:    []DelegationTimeEntryExport{},
+		AccountScores:            []AccountScore{},
+		ValidatorDelegatorScores: []ValidatorDelegatorScore{},
+		DistributionsDisabled:    false,
+		DistributionFundings:     []DistributionFunding{},
+		ScoreCheckpoints:         []ScoreCheckpoint{},
+		NamedSchedules:           []NamedSchedule{},
+		DistributionPreferences:  []DistributionPreference{},
+		DistributionOptOuts:      []string{},
 	}
 }
 
@@ -59,5 +66,98 @@ func (m *GenesisState) Validate() error {
 		}
 	}
 
+	// Validate scores finalized by the delegations in the current period
+	for _, score := range m.ValidatorDelegatorScores {
+		if score.ValidatorAddress == "" {
+			return errorsmod.Wrapf(ErrInvalidInput, "validator address cannot be empty")
+		}
+		if score.DelegatorAddress == "" {
+			return errorsmod.Wrapf(ErrInvalidInput, "delegator address cannot be empty")
+		}
+		if score.Score.IsNil() {
+			return errorsmod.Wrapf(ErrInvalidInput, "score cannot be nil")
+		}
+		if score.Score.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidInput, "score cannot be negative")
+		}
+	}
+
+	// Validate distribution fundings
+	scheduledTimestamps := make(map[uint64]bool)
+	for _, scheduledDist := range m.ScheduledDistributions {
+		scheduledTimestamps[scheduledDist.Timestamp] = true
+	}
+	type fundingKey struct {
+		timestamp uint64
+		funder    string
+	}
+	seenFundings := make(map[fundingKey]bool)
+	for _, funding := range m.DistributionFundings {
+		if !scheduledTimestamps[funding.PeriodTimestamp] {
+			return errorsmod.Wrapf(
+				ErrInvalidInput, "funded distribution %d is not scheduled", funding.PeriodTimestamp,
+			)
+		}
+		if funding.Funder == "" {
+			return errorsmod.Wrapf(ErrInvalidInput, "funder cannot be empty")
+		}
+		key := fundingKey{timestamp: funding.PeriodTimestamp, funder: funding.Funder}
+		if seenFundings[key] {
+			return errorsmod.Wrapf(
+				ErrInvalidInput, "duplicate funding of distribution %d by %s", funding.PeriodTimestamp, funding.Funder,
+			)
+		}
+		seenFundings[key] = true
+		if funding.Amount.IsNil() || !funding.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidInput, "funding amount must be positive")
+		}
+	}
+
+	// Validate score checkpoints
+	for _, checkpoint := range m.ScoreCheckpoints {
+		if err := checkpoint.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the fast clock
+	if err := m.TimeScale.Validate(); err != nil {
+		return err
+	}
+
+	// Validate named schedules
+	if err := ValidateNamedSchedules(m.NamedSchedules); err != nil {
+		return errorsmod.Wrapf(err, "invalid named schedules")
+	}
+
+	// Validate distribution preferences
+	seenPreferences := make(map[string]bool, len(m.DistributionPreferences))
+	for _, preference := range m.DistributionPreferences {
+		if _, err := sdk.AccAddressFromBech32(preference.DelegatorAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid delegator address: %s", err)
+		}
+		if _, err := sdk.ValAddressFromBech32(preference.ValidatorAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid validator address: %s", err)
+		}
+		if seenPreferences[preference.DelegatorAddress] {
+			return errorsmod.Wrapf(
+				ErrInvalidInput, "duplicate distribution preference of %s", preference.DelegatorAddress,
+			)
+		}
+		seenPreferences[preference.DelegatorAddress] = true
+	}
+
+	// Validate distribution opt-outs
+	seenOptOuts := make(map[string]bool, len(m.DistributionOptOuts))
+	for _, optOut := range m.DistributionOptOuts {
+		if _, err := sdk.AccAddressFromBech32(optOut); err != nil {
+			return errorsmod.Wrapf(ErrInvalidInput, "invalid distribution opt-out address: %s", err)
+		}
+		if seenOptOuts[optOut] {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate distribution opt-out of %s", optOut)
+		}
+		seenOptOuts[optOut] = true
+	}
+
 	return nil
 }
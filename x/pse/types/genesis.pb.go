@@ -37,6 +37,20 @@ type GenesisState struct {
 	DelegationTimeEntries  []DelegationTimeEntryExport `protobuf:"bytes,3,rep,name=delegation_time_entries,json=delegationTimeEntries,proto3" json:"delegation_time_entries" yaml:"delegation_time_entries"`
 	AccountScores          []AccountScore              `protobuf:"bytes,4,rep,name=account_scores,json=accountScores,proto3" json:"account_scores" yaml:"account_scores"`
 	DistributionsDisabled  bool                        `protobuf:"varint,5,opt,name=distributions_disabled,json=distributionsDisabled,proto3" json:"distributions_disabled,omitempty" yaml:"distributions_disabled"`
+	// distribution_fundings contains the amounts escrowed against the scheduled distributions.
+	DistributionFundings []DistributionFunding `protobuf:"bytes,6,rep,name=distribution_fundings,json=distributionFundings,proto3" json:"distribution_fundings" yaml:"distribution_fundings"`
+	// score_checkpoints contains the score checkpoints recorded at the community distributions.
+	ScoreCheckpoints []ScoreCheckpoint `protobuf:"bytes,7,rep,name=score_checkpoints,json=scoreCheckpoints,proto3" json:"score_checkpoints" yaml:"score_checkpoints"`
+	// named_schedules contains the distribution programs processed independently of the main schedule.
+	NamedSchedules []NamedSchedule `protobuf:"bytes,8,rep,name=named_schedules,json=namedSchedules,proto3" json:"named_schedules" yaml:"named_schedules"`
+	// distribution_preferences contains the validators the Community distribution payouts are delegated to.
+	DistributionPreferences []DistributionPreference `protobuf:"bytes,9,rep,name=distribution_preferences,json=distributionPreferences,proto3" json:"distribution_preferences" yaml:"distribution_preferences"`
+	// distribution_opt_outs contains the addresses opted out of the Community distributions.
+	DistributionOptOuts []string `protobuf:"bytes,10,rep,name=distribution_opt_outs,json=distributionOptOuts,proto3" json:"distribution_opt_outs,omitempty" yaml:"distribution_opt_outs"`
+	// validator_delegator_scores contains the scores finalized by the delegations in the current distribution period.
+	ValidatorDelegatorScores []ValidatorDelegatorScore `protobuf:"bytes,11,rep,name=validator_delegator_scores,json=validatorDelegatorScores,proto3" json:"validator_delegator_scores" yaml:"validator_delegator_scores"`
+	// time_scale is the dev-only fast clock of the distribution schedule, it is disabled if the divisor is zero.
+	TimeScale TimeScale `protobuf:"bytes,12,opt,name=time_scale,json=timeScale,proto3" json:"time_scale" yaml:"time_scale"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,18 +121,124 @@ func (m *GenesisState) GetDistributionsDisabled() bool {
 	return false
 }
 
+func (m *GenesisState) GetDistributionFundings() []DistributionFunding {
+	if m != nil {
+		return m.DistributionFundings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScoreCheckpoints() []ScoreCheckpoint {
+	if m != nil {
+		return m.ScoreCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNamedSchedules() []NamedSchedule {
+	if m != nil {
+		return m.NamedSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionPreferences() []DistributionPreference {
+	if m != nil {
+		return m.DistributionPreferences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionOptOuts() []string {
+	if m != nil {
+		return m.DistributionOptOuts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorDelegatorScores() []ValidatorDelegatorScore {
+	if m != nil {
+		return m.ValidatorDelegatorScores
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimeScale() TimeScale {
+	if m != nil {
+		return m.TimeScale
+	}
+	return TimeScale{}
+}
+
+// TimeScale is the dev-only fast clock of the distribution schedule. The distance between the origin and the
+// scheduled timestamps is divided by the divisor, so the schedule is processed divisor times faster.
+type TimeScale struct {
+	// origin is the Unix timestamp the fast clock starts at.
+	Origin uint64 `protobuf:"varint,1,opt,name=origin,proto3" json:"origin,omitempty" yaml:"origin"`
+	// divisor divides the distance between the origin and the scheduled timestamps, zero disables the fast clock.
+	Divisor uint64 `protobuf:"varint,2,opt,name=divisor,proto3" json:"divisor,omitempty" yaml:"divisor"`
+}
+
+func (m *TimeScale) Reset()         { *m = TimeScale{} }
+func (m *TimeScale) String() string { return proto.CompactTextString(m) }
+func (*TimeScale) ProtoMessage()    {}
+func (*TimeScale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d215b1db402695da, []int{1}
+}
+func (m *TimeScale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeScale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeScale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeScale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeScale.Merge(m, src)
+}
+func (m *TimeScale) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeScale) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeScale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeScale proto.InternalMessageInfo
+
+func (m *TimeScale) GetOrigin() uint64 {
+	if m != nil {
+		return m.Origin
+	}
+	return 0
+}
+
+func (m *TimeScale) GetDivisor() uint64 {
+	if m != nil {
+		return m.Divisor
+	}
+	return 0
+}
+
 type DelegationTimeEntryExport struct {
-	ValidatorAddress   string                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
-	DelegatorAddress   string                      `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
-	Shares             cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares" yaml:"shares"`
-	LastChangedUnixSec int64                       `protobuf:"varint,4,opt,name=last_changed_unix_sec,json=lastChangedUnixSec,proto3" json:"last_changed_unix_sec,omitempty" yaml:"last_changed_unix_sec"`
+	ValidatorAddress      string                      `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	DelegatorAddress      string                      `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Shares                cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares" yaml:"shares"`
+	LastChangedUnixSec    int64                       `protobuf:"varint,4,opt,name=last_changed_unix_sec,json=lastChangedUnixSec,proto3" json:"last_changed_unix_sec,omitempty" yaml:"last_changed_unix_sec"`
+	DelegatedSinceUnixSec int64                       `protobuf:"varint,5,opt,name=delegated_since_unix_sec,json=delegatedSinceUnixSec,proto3" json:"delegated_since_unix_sec,omitempty" yaml:"delegated_since_unix_sec"`
 }
 
 func (m *DelegationTimeEntryExport) Reset()         { *m = DelegationTimeEntryExport{} }
 func (m *DelegationTimeEntryExport) String() string { return proto.CompactTextString(m) }
 func (*DelegationTimeEntryExport) ProtoMessage()    {}
 func (*DelegationTimeEntryExport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d215b1db402695da, []int{1}
+	return fileDescriptor_d215b1db402695da, []int{2}
 }
 func (m *DelegationTimeEntryExport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,6 +288,67 @@ func (m *DelegationTimeEntryExport) GetLastChangedUnixSec() int64 {
 	return 0
 }
 
+func (m *DelegationTimeEntryExport) GetDelegatedSinceUnixSec() int64 {
+	if m != nil {
+		return m.DelegatedSinceUnixSec
+	}
+	return 0
+}
+
+// ValidatorDelegatorScore is the score finalized by the delegation to the validator in the current distribution period.
+type ValidatorDelegatorScore struct {
+	ValidatorAddress string                `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	DelegatorAddress string                `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Score            cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=score,proto3,customtype=cosmossdk.io/math.Int" json:"score" yaml:"score"`
+}
+
+func (m *ValidatorDelegatorScore) Reset()         { *m = ValidatorDelegatorScore{} }
+func (m *ValidatorDelegatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDelegatorScore) ProtoMessage()    {}
+func (*ValidatorDelegatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d215b1db402695da, []int{3}
+}
+func (m *ValidatorDelegatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDelegatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDelegatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDelegatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDelegatorScore.Merge(m, src)
+}
+func (m *ValidatorDelegatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDelegatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDelegatorScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDelegatorScore proto.InternalMessageInfo
+
+func (m *ValidatorDelegatorScore) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorDelegatorScore) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
 type AccountScore struct {
 	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 	Score   cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=score,proto3,customtype=cosmossdk.io/math.Int" json:"score" yaml:"score"`
@@ -177,7 +358,7 @@ func (m *AccountScore) Reset()         { *m = AccountScore{} }
 func (m *AccountScore) String() string { return proto.CompactTextString(m) }
 func (*AccountScore) ProtoMessage()    {}
 func (*AccountScore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d215b1db402695da, []int{2}
+	return fileDescriptor_d215b1db402695da, []int{4}
 }
 func (m *AccountScore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,58 +394,147 @@ func (m *AccountScore) GetAddress() string {
 	return ""
 }
 
+// ScoreCheckpoint is the snapshot of the final scores the community distribution is proportional to.
+// It is identified by the sha256 hash of its protobuf encoding.
+type ScoreCheckpoint struct {
+	// scheduled_at is the Unix timestamp of the distribution the checkpoint is recorded at.
+	ScheduledAt uint64 `protobuf:"varint,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty" yaml:"scheduled_at"`
+	// total_score is the sum of the scores of all the accounts.
+	TotalScore cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_score,json=totalScore,proto3,customtype=cosmossdk.io/math.Int" json:"total_score" yaml:"total_score"`
+	// scores contains the scores of the accounts sorted by address.
+	Scores []AccountScore `protobuf:"bytes,3,rep,name=scores,proto3" json:"scores" yaml:"scores"`
+}
+
+func (m *ScoreCheckpoint) Reset()         { *m = ScoreCheckpoint{} }
+func (m *ScoreCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*ScoreCheckpoint) ProtoMessage()    {}
+func (*ScoreCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d215b1db402695da, []int{5}
+}
+func (m *ScoreCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoreCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoreCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreCheckpoint.Merge(m, src)
+}
+func (m *ScoreCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreCheckpoint proto.InternalMessageInfo
+
+func (m *ScoreCheckpoint) GetScheduledAt() uint64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
+}
+
+func (m *ScoreCheckpoint) GetScores() []AccountScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tx.pse.v1.GenesisState")
+	proto.RegisterType((*TimeScale)(nil), "tx.pse.v1.TimeScale")
 	proto.RegisterType((*DelegationTimeEntryExport)(nil), "tx.pse.v1.DelegationTimeEntryExport")
+	proto.RegisterType((*ValidatorDelegatorScore)(nil), "tx.pse.v1.ValidatorDelegatorScore")
 	proto.RegisterType((*AccountScore)(nil), "tx.pse.v1.AccountScore")
+	proto.RegisterType((*ScoreCheckpoint)(nil), "tx.pse.v1.ScoreCheckpoint")
 }
 
 func init() { proto.RegisterFile("tx/pse/v1/genesis.proto", fileDescriptor_d215b1db402695da) }
 
 var fileDescriptor_d215b1db402695da = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xdf, 0x6a, 0xd3, 0x50,
-	0x1c, 0x6e, 0xd6, 0x6e, 0xba, 0xb3, 0x3f, 0xb8, 0xb0, 0xae, 0xdd, 0xdc, 0x92, 0x1a, 0x44, 0x8a,
-	0xd0, 0x84, 0x4d, 0x41, 0xd0, 0xab, 0xc5, 0x8e, 0x31, 0xf0, 0x42, 0x53, 0x05, 0x19, 0x48, 0x38,
-	0x4d, 0x7e, 0xa4, 0x87, 0x35, 0x39, 0x25, 0xe7, 0xb4, 0xa4, 0xde, 0x89, 0x3e, 0x80, 0xf8, 0x12,
-	0xbe, 0x80, 0x0f, 0xb1, 0xcb, 0xe1, 0x95, 0x78, 0x11, 0x64, 0x7b, 0x83, 0x3c, 0x81, 0x24, 0x27,
-	0xed, 0xb2, 0x75, 0xd3, 0xbb, 0xe6, 0xfb, 0x7d, 0xbf, 0xef, 0xfb, 0xf5, 0xe3, 0x4b, 0x50, 0x8d,
-	0x47, 0xc6, 0x80, 0x81, 0x31, 0xda, 0x35, 0x3c, 0x08, 0x80, 0x11, 0xa6, 0x0f, 0x42, 0xca, 0xa9,
-	0xbc, 0xc8, 0x23, 0x7d, 0xc0, 0x40, 0x1f, 0xed, 0x6e, 0xad, 0x7b, 0xd4, 0xa3, 0x19, 0x6a, 0xa4,
-	0xbf, 0x04, 0x61, 0x6b, 0xd3, 0xa1, 0xcc, 0xa7, 0xcc, 0x16, 0x03, 0xf1, 0x90, 0x8f, 0x36, 0x2e,
-	0x45, 0x07, 0x38, 0xc4, 0xfe, 0x04, 0xdf, 0xbe, 0xc4, 0x5d, 0xc2, 0x78, 0x48, 0xba, 0x43, 0x4e,
-	0x68, 0x20, 0xa6, 0xda, 0xe7, 0x0a, 0x5a, 0x3e, 0x14, 0x37, 0x74, 0x38, 0xe6, 0x20, 0x1b, 0x68,
-	0x41, 0xac, 0xd7, 0xa5, 0x86, 0xd4, 0x5c, 0xda, 0x5b, 0xd3, 0xa7, 0x37, 0xe9, 0xaf, 0xb3, 0x81,
-	0x59, 0x39, 0x8d, 0xd5, 0x92, 0x95, 0xd3, 0xe4, 0x4f, 0x12, 0xaa, 0x31, 0xa7, 0x07, 0xee, 0xb0,
-	0x0f, 0xae, 0x5d, 0xb4, 0x60, 0xf5, 0xb9, 0x46, 0xb9, 0xb9, 0xb4, 0xd7, 0x28, 0x48, 0x74, 0x26,
-	0xcc, 0x76, 0x81, 0x68, 0x3e, 0x4a, 0x15, 0x93, 0x58, 0x55, 0xc6, 0xd8, 0xef, 0x3f, 0xd7, 0x6e,
-	0x91, 0xd3, 0xac, 0x0d, 0x76, 0xd3, 0x3a, 0x93, 0xbf, 0x48, 0xa8, 0xe6, 0x42, 0x1f, 0x3c, 0x9c,
-	0x3e, 0xdb, 0x9c, 0xf8, 0x60, 0x43, 0xc0, 0x43, 0x02, 0xac, 0x5e, 0xce, 0x6e, 0x78, 0x58, 0xb8,
-	0xa1, 0x3d, 0x65, 0xbe, 0x25, 0x3e, 0x1c, 0x04, 0x3c, 0x1c, 0x1f, 0x44, 0x03, 0x1a, 0xf2, 0xeb,
-	0x77, 0xdc, 0x22, 0xa9, 0x59, 0x55, 0x77, 0x46, 0x82, 0x00, 0x93, 0x3f, 0xa0, 0x55, 0xec, 0x38,
-	0x74, 0x18, 0x70, 0x9b, 0x39, 0x34, 0x04, 0x56, 0xaf, 0x64, 0xe6, 0xb5, 0x82, 0xf9, 0xbe, 0x20,
-	0x74, 0xd2, 0xb9, 0xb9, 0x93, 0xfb, 0x55, 0x85, 0xdf, 0xd5, 0x65, 0xcd, 0x5a, 0xc1, 0x05, 0x32,
-	0x93, 0xdf, 0xa3, 0x8d, 0x2b, 0x79, 0xa4, 0xe9, 0xe0, 0x6e, 0x1f, 0xdc, 0xfa, 0x7c, 0x43, 0x6a,
-	0xde, 0x35, 0x1f, 0x24, 0xb1, 0xba, 0x93, 0x5f, 0x7e, 0x23, 0x2f, 0x3d, 0xbc, 0x38, 0x68, 0x4f,
-	0xf0, 0x6f, 0x65, 0xb4, 0x79, 0x6b, 0x2a, 0x32, 0x46, 0x6b, 0x23, 0xdc, 0x27, 0x2e, 0xe6, 0x34,
-	0xb4, 0xb1, 0xeb, 0x86, 0xc0, 0x44, 0x3b, 0x16, 0xcd, 0xa7, 0x49, 0xac, 0xd6, 0x85, 0xe5, 0x0c,
-	0x45, 0xfb, 0xf9, 0xa3, 0xb5, 0x9e, 0x57, 0x74, 0x5f, 0x40, 0x1d, 0x1e, 0x92, 0xc0, 0xb3, 0xee,
-	0x4d, 0xb9, 0x39, 0x9e, 0x5a, 0xe4, 0x91, 0x16, 0x2c, 0xe6, 0xae, 0x5b, 0xcc, 0x50, 0xfe, 0x61,
-	0x31, 0xe5, 0x4e, 0x2c, 0x8e, 0xd1, 0x02, 0xeb, 0xe1, 0x30, 0x6b, 0x44, 0xaa, 0x6b, 0xa6, 0xd9,
-	0xff, 0x8e, 0xd5, 0xfb, 0x62, 0x9f, 0xb9, 0x27, 0x3a, 0xa1, 0x86, 0x8f, 0x79, 0x4f, 0x7f, 0x05,
-	0x1e, 0x76, 0xc6, 0x6d, 0x70, 0x92, 0x58, 0x5d, 0xc9, 0x2b, 0x99, 0xad, 0xa6, 0x7e, 0x28, 0xf7,
-	0x6b, 0x83, 0x63, 0xe5, 0x8a, 0x72, 0x07, 0x55, 0xfb, 0x98, 0x71, 0xdb, 0xe9, 0xe1, 0xc0, 0x03,
-	0xd7, 0x1e, 0x06, 0x24, 0xb2, 0x19, 0x38, 0xf5, 0x4a, 0x43, 0x6a, 0x96, 0xcd, 0x46, 0x12, 0xab,
-	0xdb, 0x42, 0xe7, 0x46, 0x9a, 0x66, 0xc9, 0x29, 0xfe, 0x52, 0xc0, 0xef, 0x02, 0x12, 0x75, 0xc0,
-	0xd1, 0xbe, 0x4b, 0x68, 0xb9, 0xd8, 0x16, 0xb9, 0x8d, 0xee, 0x5c, 0x4d, 0xff, 0x71, 0x12, 0xab,
-	0xab, 0x79, 0x75, 0xfe, 0x17, 0xc8, 0x64, 0x55, 0x7e, 0x83, 0xe6, 0xb3, 0x7e, 0xe5, 0xf1, 0xbe,
-	0xc8, 0x63, 0xa8, 0xce, 0xc6, 0x70, 0x14, 0xf0, 0x24, 0x56, 0x97, 0x27, 0xef, 0x24, 0x0d, 0xa1,
-	0xf8, 0xff, 0x8f, 0x02, 0x6e, 0x09, 0x25, 0xf3, 0xf0, 0xf4, 0x5c, 0x91, 0xce, 0xce, 0x15, 0xe9,
-	0xcf, 0xb9, 0x22, 0x7d, 0xbd, 0x50, 0x4a, 0x67, 0x17, 0x4a, 0xe9, 0xd7, 0x85, 0x52, 0x3a, 0x6e,
-	0x79, 0x84, 0xf7, 0x86, 0x5d, 0xdd, 0xa1, 0xbe, 0xc1, 0xe9, 0x09, 0x04, 0xe4, 0x23, 0xb4, 0x22,
-	0x83, 0x47, 0x2d, 0xa7, 0x87, 0x49, 0x60, 0x8c, 0x9e, 0x19, 0xe2, 0xeb, 0xc4, 0xc7, 0x03, 0x60,
-	0xdd, 0x85, 0xec, 0xa3, 0xf4, 0xe4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0x0d, 0x7b, 0xb3,
-	0x21, 0x05, 0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x89, 0x53, 0x4f, 0xfe, 0xb4, 0x99, 0x26, 0xf1, 0x26, 0x34, 0xb6, 0x33, 0x54,
+	0x90, 0x22, 0x62, 0xab, 0x05, 0x09, 0x54, 0xc4, 0x21, 0x5b, 0xb7, 0x55, 0x25, 0xd4, 0x96, 0x35,
+	0x54, 0xa8, 0x02, 0xad, 0x26, 0xbb, 0x53, 0x7b, 0x88, 0xbd, 0x63, 0xed, 0x8c, 0x2d, 0x87, 0x1b,
+	0x12, 0x9c, 0xb8, 0xf0, 0x11, 0xb8, 0xf1, 0x05, 0xb8, 0xf1, 0x05, 0x7a, 0xac, 0x38, 0x21, 0x0e,
+	0x16, 0x4a, 0xbe, 0x00, 0xf2, 0x8d, 0x1b, 0xda, 0x99, 0x59, 0xef, 0xf8, 0xcf, 0x36, 0x17, 0xa4,
+	0xde, 0xec, 0xf7, 0x7e, 0xef, 0xf7, 0x7b, 0xf3, 0xde, 0xbc, 0xb7, 0x03, 0x8a, 0x62, 0x50, 0xeb,
+	0x72, 0x52, 0xeb, 0xdf, 0xae, 0x35, 0x49, 0x48, 0x38, 0xe5, 0xd5, 0x6e, 0xc4, 0x04, 0x83, 0x05,
+	0x31, 0xa8, 0x76, 0x39, 0xa9, 0xf6, 0x6f, 0xef, 0x6d, 0x35, 0x59, 0x93, 0x49, 0x6b, 0x2d, 0xfe,
+	0xa5, 0x00, 0x7b, 0xbb, 0x3e, 0xe3, 0x1d, 0xc6, 0x3d, 0xe5, 0x50, 0x7f, 0xb4, 0x6b, 0x27, 0x25,
+	0xed, 0xe2, 0x08, 0x77, 0x12, 0xfb, 0x8d, 0xd4, 0x1e, 0x50, 0x2e, 0x22, 0x7a, 0xd2, 0x13, 0x94,
+	0x85, 0xca, 0x8b, 0x7e, 0x01, 0x60, 0xed, 0xa1, 0xca, 0xa1, 0x21, 0xb0, 0x20, 0xb0, 0x06, 0xf2,
+	0x2a, 0xdc, 0xb6, 0x2a, 0xd6, 0xe1, 0xea, 0x9d, 0xcd, 0xea, 0x38, 0xa7, 0xea, 0x53, 0xe9, 0x70,
+	0x96, 0x5e, 0x0e, 0xcb, 0x0b, 0xae, 0x86, 0xc1, 0xef, 0x2d, 0x50, 0xe4, 0x7e, 0x8b, 0x04, 0xbd,
+	0x36, 0x09, 0x3c, 0x53, 0x82, 0xdb, 0x8b, 0x95, 0xdc, 0xe1, 0xea, 0x9d, 0x8a, 0x41, 0xd1, 0x48,
+	0x90, 0x75, 0x03, 0xe8, 0xbc, 0x13, 0x33, 0x8e, 0x86, 0xe5, 0xd2, 0x19, 0xee, 0xb4, 0xef, 0xa2,
+	0x0c, 0x3a, 0xe4, 0xee, 0xf0, 0x79, 0xe1, 0x1c, 0xfe, 0x60, 0x81, 0x62, 0x40, 0xda, 0xa4, 0x89,
+	0xe3, 0xff, 0x9e, 0xa0, 0x1d, 0xe2, 0x91, 0x50, 0x44, 0x94, 0x70, 0x3b, 0x27, 0x73, 0xb8, 0x69,
+	0xe4, 0x50, 0x1f, 0x23, 0xbf, 0xa0, 0x1d, 0x72, 0x3f, 0x14, 0xd1, 0xd9, 0xfd, 0x41, 0x97, 0x45,
+	0x62, 0x3a, 0x8f, 0x0c, 0x4a, 0xe4, 0x6e, 0x07, 0x33, 0x14, 0x94, 0x70, 0xf8, 0x0d, 0xd8, 0xc0,
+	0xbe, 0xcf, 0x7a, 0xa1, 0xf0, 0xb8, 0xcf, 0x22, 0xc2, 0xed, 0x25, 0x29, 0x5e, 0x34, 0xc4, 0x8f,
+	0x15, 0xa0, 0x11, 0xfb, 0x9d, 0x7d, 0xad, 0xb7, 0xad, 0xf4, 0x26, 0x83, 0x91, 0xbb, 0x8e, 0x0d,
+	0x30, 0x87, 0x5f, 0x81, 0x9d, 0x89, 0x7a, 0xc4, 0xd5, 0xc1, 0x27, 0x6d, 0x12, 0xd8, 0xcb, 0x15,
+	0xeb, 0xf0, 0x8a, 0x73, 0x30, 0x1a, 0x96, 0xf7, 0x75, 0xe6, 0x73, 0x71, 0x71, 0xe2, 0xa6, 0xa3,
+	0xae, 0xed, 0xf0, 0x0c, 0x4c, 0x38, 0xbc, 0x17, 0xbd, 0x30, 0xa0, 0x61, 0x93, 0xdb, 0x79, 0x99,
+	0x7f, 0xc9, 0x2c, 0x9e, 0x81, 0x7b, 0xa0, 0x60, 0xce, 0x4d, 0x7d, 0x8c, 0x1b, 0xb3, 0xe2, 0x63,
+	0x2a, 0xe4, 0x6e, 0x05, 0xb3, 0xa1, 0x1c, 0x52, 0xb0, 0x29, 0x8f, 0xeb, 0xf9, 0x2d, 0xe2, 0x9f,
+	0x76, 0x19, 0x0d, 0x05, 0xb7, 0x57, 0xa4, 0xec, 0xde, 0xc4, 0xbd, 0x61, 0x11, 0xb9, 0x37, 0x86,
+	0x38, 0x15, 0x2d, 0x69, 0x27, 0x37, 0x66, 0x8a, 0x02, 0xb9, 0xd7, 0xf8, 0x64, 0x08, 0x87, 0x18,
+	0x5c, 0x0d, 0x71, 0x87, 0x04, 0x5e, 0x72, 0x8b, 0xb8, 0x7d, 0x45, 0x0a, 0xd9, 0x86, 0xd0, 0xe3,
+	0x18, 0x91, 0xdc, 0x52, 0xa7, 0xa4, 0x65, 0x76, 0x94, 0xcc, 0x54, 0x38, 0x72, 0x37, 0x42, 0x13,
+	0xce, 0xe1, 0x8f, 0x16, 0xb0, 0x27, 0x8e, 0xdf, 0x8d, 0xc8, 0x0b, 0x12, 0x91, 0xd0, 0x27, 0xdc,
+	0x2e, 0x48, 0xb1, 0x83, 0x8c, 0x62, 0x3e, 0x1d, 0x23, 0x9d, 0x77, 0xb5, 0x6a, 0x79, 0x4e, 0x3d,
+	0x0d, 0x42, 0xe4, 0x16, 0x83, 0xb9, 0x04, 0x1c, 0xb6, 0xa7, 0x1a, 0xca, 0xba, 0xc2, 0x63, 0x3d,
+	0xc1, 0x6d, 0x50, 0xc9, 0x1d, 0x16, 0x9c, 0x8f, 0x33, 0x9a, 0x95, 0xc0, 0xd0, 0x1f, 0xbf, 0x1d,
+	0x6d, 0xe9, 0xed, 0x72, 0x1c, 0x04, 0x11, 0xe1, 0xbc, 0x21, 0x22, 0x1a, 0x36, 0xdd, 0xeb, 0x26,
+	0xfe, 0x49, 0x57, 0x3c, 0xe9, 0x09, 0x0e, 0x7f, 0xb2, 0xc0, 0x5e, 0x1f, 0xb7, 0x69, 0x80, 0x05,
+	0x8b, 0x3c, 0x3d, 0x1b, 0x2c, 0x4a, 0x86, 0x60, 0x55, 0x9e, 0x1b, 0x19, 0xe7, 0x7e, 0x96, 0x80,
+	0xeb, 0x09, 0x56, 0xcd, 0xc3, 0x2d, 0x7d, 0xf0, 0x03, 0x95, 0x5b, 0x36, 0x27, 0x72, 0xed, 0xfe,
+	0x7c, 0x0e, 0x0e, 0x1f, 0x03, 0x20, 0xa7, 0x95, 0xfb, 0xb8, 0x4d, 0xec, 0x35, 0xb9, 0xc5, 0xb6,
+	0x0c, 0xf1, 0x78, 0x62, 0x1b, 0xb1, 0xcf, 0xd9, 0xd5, 0x72, 0x9b, 0x4a, 0x2e, 0x8d, 0x42, 0x6e,
+	0x41, 0x24, 0x28, 0x14, 0x80, 0xc2, 0x38, 0x04, 0xde, 0x02, 0x79, 0x16, 0xd1, 0x26, 0x0d, 0xe5,
+	0x7a, 0x5c, 0x72, 0x36, 0x47, 0xc3, 0xf2, 0xba, 0x0a, 0x57, 0x76, 0xe4, 0x6a, 0x00, 0x7c, 0x1f,
+	0xac, 0x04, 0xb4, 0x4f, 0x39, 0x8b, 0xec, 0x45, 0x89, 0x85, 0xa3, 0x61, 0x79, 0x23, 0xa9, 0xba,
+	0x74, 0x20, 0x37, 0x81, 0xa0, 0x7f, 0x72, 0x60, 0x37, 0x73, 0x31, 0x41, 0x0c, 0x36, 0xd3, 0x62,
+	0x60, 0xd5, 0x11, 0x99, 0x41, 0xc1, 0xf9, 0x30, 0x9d, 0x82, 0x19, 0x48, 0x76, 0x1f, 0xaf, 0x8d,
+	0xb1, 0xda, 0x1e, 0x4b, 0xa4, 0x55, 0x4e, 0x24, 0x16, 0xa7, 0x25, 0x66, 0x20, 0xaf, 0x91, 0x18,
+	0x63, 0x13, 0x89, 0xe7, 0x20, 0xcf, 0x5b, 0x38, 0x92, 0x4b, 0x39, 0xe6, 0x75, 0xe2, 0xfa, 0xff,
+	0x35, 0x2c, 0xbf, 0xa5, 0xe2, 0x79, 0x70, 0x5a, 0xa5, 0xac, 0xd6, 0xc1, 0xa2, 0x55, 0xfd, 0x8c,
+	0x34, 0xb1, 0x7f, 0x56, 0x27, 0x7e, 0x5a, 0x5f, 0x15, 0x1a, 0xeb, 0x01, 0xad, 0x57, 0x27, 0xbe,
+	0xab, 0x19, 0x61, 0x03, 0x6c, 0xb7, 0x31, 0x17, 0x9e, 0xdf, 0xc2, 0x61, 0x93, 0x04, 0x5e, 0x2f,
+	0xa4, 0x03, 0x8f, 0x13, 0xdf, 0x5e, 0xaa, 0x58, 0x87, 0x39, 0xa7, 0x92, 0xde, 0xf8, 0xb9, 0x30,
+	0xe4, 0xc2, 0xd8, 0x7e, 0x4f, 0x99, 0xbf, 0x0c, 0xe9, 0xa0, 0x41, 0x7c, 0xf8, 0x35, 0xb0, 0xf5,
+	0x21, 0xe2, 0xb1, 0xa7, 0xa1, 0x4f, 0x52, 0xde, 0x65, 0xc9, 0xfb, 0xb6, 0x31, 0xa6, 0x19, 0xc8,
+	0xf4, 0x73, 0x41, 0x82, 0x46, 0xec, 0xd1, 0xec, 0xe8, 0xf7, 0x45, 0x50, 0xcc, 0x98, 0x04, 0xf8,
+	0x6d, 0x76, 0xc3, 0x3f, 0xbd, 0xa4, 0xe1, 0xfb, 0xba, 0x3a, 0xcf, 0xa6, 0x3a, 0xfc, 0x26, 0x3b,
+	0xff, 0x39, 0x58, 0x96, 0x83, 0xab, 0x1b, 0xff, 0x89, 0x6e, 0xfc, 0xf6, 0x6c, 0xe3, 0x1f, 0x85,
+	0x62, 0x34, 0x2c, 0xaf, 0x19, 0x6b, 0xdd, 0xec, 0xf8, 0xa3, 0x50, 0xb8, 0x8a, 0x09, 0xfd, 0x6a,
+	0x81, 0x35, 0xf3, 0x63, 0x0a, 0xeb, 0x60, 0x65, 0xb2, 0x50, 0xef, 0xa5, 0xf3, 0x76, 0x69, 0xca,
+	0x49, 0x68, 0x9a, 0xe9, 0xe2, 0xff, 0x96, 0xe9, 0xbf, 0x16, 0xb8, 0x3a, 0xf5, 0xfd, 0x82, 0x77,
+	0xc1, 0x5a, 0xfa, 0xca, 0xc1, 0x42, 0x6f, 0x93, 0xe2, 0x68, 0x58, 0xbe, 0x3e, 0xfd, 0x06, 0xc2,
+	0x02, 0xb9, 0xab, 0xe3, 0xbf, 0xc7, 0x02, 0x9e, 0x80, 0x55, 0xc1, 0x04, 0x6e, 0x7b, 0x66, 0xa2,
+	0xc7, 0x97, 0x25, 0x0a, 0xf5, 0x92, 0x4b, 0x23, 0xa7, 0xd3, 0x05, 0xd2, 0xa7, 0x8a, 0xf9, 0x00,
+	0xe4, 0xf5, 0xf6, 0xce, 0xbd, 0xfe, 0x09, 0xb3, 0xad, 0x77, 0xe8, 0xba, 0x51, 0x07, 0x8e, 0x5c,
+	0x1d, 0xed, 0x3c, 0x7c, 0x79, 0x5e, 0xb2, 0x5e, 0x9d, 0x97, 0xac, 0xbf, 0xcf, 0x4b, 0xd6, 0xcf,
+	0x17, 0xa5, 0x85, 0x57, 0x17, 0xa5, 0x85, 0x3f, 0x2f, 0x4a, 0x0b, 0xcf, 0x8f, 0x9a, 0x54, 0xb4,
+	0x7a, 0x27, 0x55, 0x9f, 0x75, 0x6a, 0x82, 0x9d, 0x92, 0x90, 0x7e, 0x47, 0x8e, 0x06, 0x35, 0x31,
+	0x38, 0xf2, 0x5b, 0x98, 0x86, 0xb5, 0xfe, 0x47, 0x35, 0xf5, 0x70, 0x15, 0x67, 0x5d, 0xc2, 0x4f,
+	0xf2, 0xf2, 0xbd, 0xfa, 0xc1, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x9a, 0x28, 0xc3, 0x3c,
+	0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -287,6 +557,95 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TimeScale.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.ValidatorDelegatorScores) > 0 {
+		for iNdEx := len(m.ValidatorDelegatorScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorDelegatorScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DistributionOptOuts) > 0 {
+		for iNdEx := len(m.DistributionOptOuts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DistributionOptOuts[iNdEx])
+			copy(dAtA[i:], m.DistributionOptOuts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DistributionOptOuts[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DistributionPreferences) > 0 {
+		for iNdEx := len(m.DistributionPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.NamedSchedules) > 0 {
+		for iNdEx := len(m.NamedSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamedSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ScoreCheckpoints) > 0 {
+		for iNdEx := len(m.ScoreCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScoreCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DistributionFundings) > 0 {
+		for iNdEx := len(m.DistributionFundings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionFundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.DistributionsDisabled {
 		i--
 		if m.DistributionsDisabled {
@@ -352,6 +711,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TimeScale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeScale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Divisor != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Divisor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Origin != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationTimeEntryExport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -372,6 +764,11 @@ func (m *DelegationTimeEntryExport) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.DelegatedSinceUnixSec != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelegatedSinceUnixSec))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.LastChangedUnixSec != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastChangedUnixSec))
 		i--
@@ -404,7 +801,7 @@ func (m *DelegationTimeEntryExport) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountScore) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorDelegatorScore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -414,12 +811,12 @@ func (m *AccountScore) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountScore) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorDelegatorScore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorDelegatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -433,27 +830,126 @@ func (m *AccountScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+	dAtA[i] = 0x1a
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *AccountScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoreCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoreCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalScore.Size()
+		i -= size
+		if _, err := m.TotalScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ScheduledAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
@@ -484,6 +980,59 @@ func (m *GenesisState) Size() (n int) {
 	if m.DistributionsDisabled {
 		n += 2
 	}
+	if len(m.DistributionFundings) > 0 {
+		for _, e := range m.DistributionFundings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScoreCheckpoints) > 0 {
+		for _, e := range m.ScoreCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NamedSchedules) > 0 {
+		for _, e := range m.NamedSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionPreferences) > 0 {
+		for _, e := range m.DistributionPreferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionOptOuts) > 0 {
+		for _, s := range m.DistributionOptOuts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorDelegatorScores) > 0 {
+		for _, e := range m.ValidatorDelegatorScores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TimeScale.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TimeScale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Origin != 0 {
+		n += 1 + sovGenesis(uint64(m.Origin))
+	}
+	if m.Divisor != 0 {
+		n += 1 + sovGenesis(uint64(m.Divisor))
+	}
 	return n
 }
 
@@ -506,31 +1055,601 @@ func (m *DelegationTimeEntryExport) Size() (n int) {
 	if m.LastChangedUnixSec != 0 {
 		n += 1 + sovGenesis(uint64(m.LastChangedUnixSec))
 	}
-	return n
-}
+	if m.DelegatedSinceUnixSec != 0 {
+		n += 1 + sovGenesis(uint64(m.DelegatedSinceUnixSec))
+	}
+	return n
+}
+
+func (m *ValidatorDelegatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AccountScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Score.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ScoreCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledAt != 0 {
+		n += 1 + sovGenesis(uint64(m.ScheduledAt))
+	}
+	l = m.TotalScore.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledDistributions = append(m.ScheduledDistributions, ScheduledDistribution{})
+			if err := m.ScheduledDistributions[len(m.ScheduledDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTimeEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationTimeEntries = append(m.DelegationTimeEntries, DelegationTimeEntryExport{})
+			if err := m.DelegationTimeEntries[len(m.DelegationTimeEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountScores = append(m.AccountScores, AccountScore{})
+			if err := m.AccountScores[len(m.AccountScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionsDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DistributionsDisabled = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionFundings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionFundings = append(m.DistributionFundings, DistributionFunding{})
+			if err := m.DistributionFundings[len(m.DistributionFundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScoreCheckpoints = append(m.ScoreCheckpoints, ScoreCheckpoint{})
+			if err := m.ScoreCheckpoints[len(m.ScoreCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamedSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamedSchedules = append(m.NamedSchedules, NamedSchedule{})
+			if err := m.NamedSchedules[len(m.NamedSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionPreferences = append(m.DistributionPreferences, DistributionPreference{})
+			if err := m.DistributionPreferences[len(m.DistributionPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionOptOuts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionOptOuts = append(m.DistributionOptOuts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDelegatorScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDelegatorScores = append(m.ValidatorDelegatorScores, ValidatorDelegatorScore{})
+			if err := m.ValidatorDelegatorScores[len(m.ValidatorDelegatorScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeScale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeScale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeScale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeScale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeScale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			m.Origin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Origin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Divisor", wireType)
+			}
+			m.Divisor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Divisor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AccountScore) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Score.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *DelegationTimeEntryExport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -553,17 +1672,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationTimeEntryExport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationTimeEntryExport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -573,30 +1692,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDistributions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -606,31 +1724,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScheduledDistributions = append(m.ScheduledDistributions, ScheduledDistribution{})
-			if err := m.ScheduledDistributions[len(m.ScheduledDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationTimeEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -640,31 +1756,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationTimeEntries = append(m.DelegationTimeEntries, DelegationTimeEntryExport{})
-			if err := m.DelegationTimeEntries[len(m.DelegationTimeEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountScores", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangedUnixSec", wireType)
 			}
-			var msglen int
+			m.LastChangedUnixSec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -674,31 +1790,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastChangedUnixSec |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountScores = append(m.AccountScores, AccountScore{})
-			if err := m.AccountScores[len(m.AccountScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionsDisabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedSinceUnixSec", wireType)
 			}
-			var v int
+			m.DelegatedSinceUnixSec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -708,12 +1809,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DelegatedSinceUnixSec |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DistributionsDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -735,7 +1835,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationTimeEntryExport) Unmarshal(dAtA []byte) error {
+func (m *ValidatorDelegatorScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -758,10 +1858,10 @@ func (m *DelegationTimeEntryExport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationTimeEntryExport: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorDelegatorScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationTimeEntryExport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorDelegatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -830,7 +1930,7 @@ func (m *DelegationTimeEntryExport) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -858,15 +1958,65 @@ func (m *DelegationTimeEntryExport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastChangedUnixSec", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastChangedUnixSec = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -876,11 +2026,58 @@ func (m *DelegationTimeEntryExport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastChangedUnixSec |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -902,7 +2099,7 @@ func (m *DelegationTimeEntryExport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountScore) Unmarshal(dAtA []byte) error {
+func (m *ScoreCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -925,15 +2122,34 @@ func (m *AccountScore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountScore: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScoreCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountScore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScoreCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalScore", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -961,13 +2177,15 @@ func (m *AccountScore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -977,23 +2195,23 @@ func (m *AccountScore) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scores = append(m.Scores, AccountScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12,9 +12,19 @@ const (
 
 // KVStore keys.
 var (
-	ParamsKey               = collections.NewPrefix(0)
-	StakingTimeKey          = collections.NewPrefix(1)
-	AccountScoreKey         = collections.NewPrefix(2)
-	AllocationScheduleKey   = collections.NewPrefix(3) // Map: timestamp -> ScheduledDistribution
-	DistributionDisabledKey = collections.NewPrefix(4)
+	ParamsKey                 = collections.NewPrefix(0)
+	StakingTimeKey            = collections.NewPrefix(1)
+	AccountScoreKey           = collections.NewPrefix(2)
+	AllocationScheduleKey     = collections.NewPrefix(3) // Map: timestamp -> ScheduledDistribution
+	DistributionDisabledKey   = collections.NewPrefix(4)
+	DistributionFundingKey    = collections.NewPrefix(5)  // Map: (timestamp, funder) -> escrowed amount
+	ScoreCheckpointKey        = collections.NewPrefix(6)  // Map: checkpoint hash -> ScoreCheckpoint
+	NamedScheduleKey          = collections.NewPrefix(7)  // Map: schedule name -> NamedSchedule
+	DistributionPreferenceKey = collections.NewPrefix(8)  // Map: delegator -> DistributionPreference
+	DistributionOptOutKey     = collections.NewPrefix(9)  // KeySet: addresses opted out of the Community distributions
+	ValidatorDelegatorKey     = collections.NewPrefix(10) // KeySet: (validator, delegator) of delegation time entries
+	ValidatorSharesKey        = collections.NewPrefix(11) // Map: validator -> total shares of delegation time entries
+	// Map: (validator, delegator) -> score finalized by the delegation in the current distribution period
+	ValidatorDelegatorScoreKey = collections.NewPrefix(12)
+	TimeScaleKey               = collections.NewPrefix(13) // Item: dev-only fast clock of the distribution schedule
 )
@@ -1,10 +1,12 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/samber/lo"
 )
 
 type extendedMsg interface {
@@ -16,6 +18,16 @@ var (
 	_ extendedMsg = &MsgUpdateExcludedAddresses{}
 	_ extendedMsg = &MsgUpdateClearingAccountMappings{}
 	_ extendedMsg = &MsgUpdateDistributionSchedule{}
+	_ extendedMsg = &MsgFundDistribution{}
+	_ extendedMsg = &MsgUpdateMinDelegationAmount{}
+	_ extendedMsg = &MsgUpdateSlashingPenalty{}
+	_ extendedMsg = &MsgUpdateMinDelegationDuration{}
+	_ extendedMsg = &MsgSetNamedSchedule{}
+	_ extendedMsg = &MsgRemoveNamedSchedule{}
+	_ extendedMsg = &MsgSetDistributionPreference{}
+	_ extendedMsg = &MsgReallocateClearingFunds{}
+	_ extendedMsg = &MsgOptOutDistributions{}
+	_ extendedMsg = &MsgOptInDistributions{}
 )
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
@@ -23,6 +35,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateExcludedAddresses{}, ModuleName+"/MsgUpdateExcludedAddresses")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateClearingAccountMappings{}, ModuleName+"/MsgUpdateClearingAccountMappings")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateDistributionSchedule{}, ModuleName+"/MsgUpdateDistributionSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgFundDistribution{}, ModuleName+"/MsgFundDistribution")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMinDelegationAmount{}, ModuleName+"/MsgUpdateMinDelegationAmount")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateSlashingPenalty{}, ModuleName+"/MsgUpdateSlashingPenalty")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMinDelegationDuration{}, ModuleName+"/MsgUpdateMinDelegationDuration")
+	legacy.RegisterAminoMsg(cdc, &MsgSetNamedSchedule{}, ModuleName+"/MsgSetNamedSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveNamedSchedule{}, ModuleName+"/MsgRemoveNamedSchedule")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDistributionPreference{}, ModuleName+"/MsgSetDistributionPreference")
+	legacy.RegisterAminoMsg(cdc, &MsgReallocateClearingFunds{}, ModuleName+"/MsgReallocateClearingFunds")
+	legacy.RegisterAminoMsg(cdc, &MsgOptOutDistributions{}, ModuleName+"/MsgOptOutDistributions")
+	legacy.RegisterAminoMsg(cdc, &MsgOptInDistributions{}, ModuleName+"/MsgOptInDistributions")
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -122,3 +144,128 @@ func (m *MsgUpdateDistributionSchedule) ValidateBasic() error {
 	// Validate the schedule (includes all clearing account validation)
 	return ValidateDistributionSchedule(m.Schedule)
 }
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgFundDistribution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if m.PeriodTimestamp == 0 {
+		return cosmoserrors.ErrInvalidRequest.Wrap("period timestamp cannot be zero")
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return cosmoserrors.ErrInvalidCoins.Wrapf("invalid amount: %s", err)
+	}
+	if !m.Amount.IsPositive() {
+		return cosmoserrors.ErrInvalidCoins.Wrap("amount must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateMinDelegationAmount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateMinDelegationAmount(m.MinDelegationAmount)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateSlashingPenalty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateSlashingScorePenaltyMultiplier(m.SlashingScorePenaltyMultiplier)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateMinDelegationDuration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateMinDelegationDuration(m.MinDelegationDuration)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgSetNamedSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if m.Schedule.Disabled {
+		return cosmoserrors.ErrInvalidRequest.Wrap("schedule can't be set as disabled")
+	}
+
+	return m.Schedule.ValidateBasic()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgRemoveNamedSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateNamedScheduleName(m.Name)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgSetDistributionPreference) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if m.ValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+			return cosmoserrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgReallocateClearingFunds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if !lo.Contains(GetAllClearingAccounts(), m.FromClearingAccount) {
+		return cosmoserrors.ErrInvalidRequest.Wrapf("invalid clearing account: %s", m.FromClearingAccount)
+	}
+	if !lo.Contains(GetAllClearingAccounts(), m.ToClearingAccount) {
+		return cosmoserrors.ErrInvalidRequest.Wrapf("invalid clearing account: %s", m.ToClearingAccount)
+	}
+	if m.FromClearingAccount == m.ToClearingAccount {
+		return cosmoserrors.ErrInvalidRequest.Wrap("clearing accounts must be different")
+	}
+
+	if m.Share.IsNil() || !m.Share.IsPositive() || m.Share.GT(sdkmath.LegacyOneDec()) {
+		return cosmoserrors.ErrInvalidRequest.Wrapf("share must be in (0, 1], got %s", m.Share)
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgOptOutDistributions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgOptInDistributions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
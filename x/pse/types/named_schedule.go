@@ -0,0 +1,77 @@
+package types
+
+import (
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/samber/lo"
+)
+
+// namedScheduleNameRegex defines the allowed names of the named schedules and their clearing accounts.
+var namedScheduleNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// NamedScheduleClearingAccountAddress returns the address of the clearing account of the named schedule.
+// The address is derived from the module, schedule and clearing account names, so nobody owns its private key and
+// the tokens sent to it are spent only by the distributions of the schedule.
+func NamedScheduleClearingAccountAddress(scheduleName, clearingAccount string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(scheduleName), []byte(clearingAccount))
+}
+
+// ValidateNamedScheduleName validates the name of the named schedule.
+func ValidateNamedScheduleName(name string) error {
+	if !namedScheduleNameRegex.MatchString(name) {
+		return errorsmod.Wrapf(
+			ErrInvalidParam, "schedule name must match %s, got '%s'", namedScheduleNameRegex.String(), name,
+		)
+	}
+	return nil
+}
+
+// ValidateBasic validates the named schedule.
+func (s NamedSchedule) ValidateBasic() error {
+	if err := ValidateNamedScheduleName(s.Name); err != nil {
+		return err
+	}
+
+	if len(s.ClearingAccountMappings) == 0 {
+		return errorsmod.Wrapf(ErrInvalidParam, "schedule %s must have at least one clearing account", s.Name)
+	}
+	if err := validateClearingAccountMappings(s.ClearingAccountMappings); err != nil {
+		return errorsmod.Wrapf(err, "schedule %s", s.Name)
+	}
+	for _, mapping := range s.ClearingAccountMappings {
+		if !namedScheduleNameRegex.MatchString(mapping.ClearingAccount) {
+			return errorsmod.Wrapf(
+				ErrInvalidParam,
+				"schedule %s: clearing account name must match %s, got '%s'",
+				s.Name, namedScheduleNameRegex.String(), mapping.ClearingAccount,
+			)
+		}
+	}
+
+	clearingAccounts := lo.Map(s.ClearingAccountMappings, func(mapping ClearingAccountMapping, _ int) string {
+		return mapping.ClearingAccount
+	})
+	if err := validateScheduledDistributions(s.Distributions, clearingAccounts, false); err != nil {
+		return errorsmod.Wrapf(err, "schedule %s", s.Name)
+	}
+
+	return nil
+}
+
+// ValidateNamedSchedules validates the named schedules and checks that their names are unique.
+func ValidateNamedSchedules(schedules []NamedSchedule) error {
+	seenNames := make(map[string]bool, len(schedules))
+	for _, schedule := range schedules {
+		if err := schedule.ValidateBasic(); err != nil {
+			return err
+		}
+		if seenNames[schedule.Name] {
+			return errorsmod.Wrapf(ErrInvalidParam, "duplicate schedule name %s", schedule.Name)
+		}
+		seenNames[schedule.Name] = true
+	}
+	return nil
+}
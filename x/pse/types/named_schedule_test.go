@@ -0,0 +1,122 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamedScheduleValidation(t *testing.T) {
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	validSchedule := func() NamedSchedule {
+		return NamedSchedule{
+			Name: "partner-grant-2026",
+			ClearingAccountMappings: []ClearingAccountMapping{
+				{ClearingAccount: "grant", RecipientAddresses: []string{recipient}},
+				{ClearingAccount: "bonus", RecipientAddresses: []string{recipient}},
+			},
+			Distributions: []ScheduledDistribution{
+				{
+					Timestamp: 1,
+					Allocations: []ClearingAccountAllocation{
+						{ClearingAccount: "grant", Amount: sdkmath.NewInt(100)},
+					},
+				},
+				{
+					Timestamp: 2,
+					Allocations: []ClearingAccountAllocation{
+						{ClearingAccount: "grant", Amount: sdkmath.NewInt(100)},
+						{ClearingAccount: "bonus", Amount: sdkmath.NewInt(10)},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(schedule *NamedSchedule)
+		errMsg   string
+		expectOK bool
+	}{
+		{
+			name:     "valid",
+			modify:   func(*NamedSchedule) {},
+			expectOK: true,
+		},
+		{
+			name: "valid_without_distributions",
+			modify: func(schedule *NamedSchedule) {
+				schedule.Distributions = nil
+			},
+			expectOK: true,
+		},
+		{
+			name: "invalid_name",
+			modify: func(schedule *NamedSchedule) {
+				schedule.Name = "Partner Grant"
+			},
+			errMsg: "schedule name must match",
+		},
+		{
+			name: "no_clearing_accounts",
+			modify: func(schedule *NamedSchedule) {
+				schedule.ClearingAccountMappings = nil
+				schedule.Distributions = nil
+			},
+			errMsg: "must have at least one clearing account",
+		},
+		{
+			name: "invalid_clearing_account_name",
+			modify: func(schedule *NamedSchedule) {
+				schedule.ClearingAccountMappings[0].ClearingAccount = "Grant"
+				schedule.Distributions = nil
+			},
+			errMsg: "clearing account name must match",
+		},
+		{
+			name: "unknown_clearing_account",
+			modify: func(schedule *NamedSchedule) {
+				schedule.Distributions[0].Allocations[0].ClearingAccount = ClearingAccountFoundation
+			},
+			errMsg: "invalid clearing account",
+		},
+		{
+			name: "duplicate_timestamp",
+			modify: func(schedule *NamedSchedule) {
+				schedule.Distributions[1].Timestamp = 1
+			},
+			errMsg: "duplicate timestamp",
+		},
+		{
+			name: "zero_amount",
+			modify: func(schedule *NamedSchedule) {
+				schedule.Distributions[0].Allocations[0].Amount = sdkmath.ZeroInt()
+			},
+			errMsg: "amount cannot be zero",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			schedule := validSchedule()
+			tc.modify(&schedule)
+			err := schedule.ValidateBasic()
+			if tc.expectOK {
+				requireT.NoError(err)
+				return
+			}
+			requireT.ErrorIs(err, ErrInvalidParam)
+			requireT.ErrorContains(err, tc.errMsg)
+		})
+	}
+
+	// the names of the schedules must be unique
+	require.ErrorContains(t, ValidateNamedSchedules([]NamedSchedule{validSchedule(), validSchedule()}),
+		"duplicate schedule name")
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/samber/lo"
 )
@@ -45,6 +48,11 @@ func DefaultParams() Params {
 	return Params{
 		ExcludedAddresses:       []string{},
 		ClearingAccountMappings: []ClearingAccountMapping{},
+		MinDelegationAmount:     sdkmath.ZeroInt(),
+		// by default the score is reduced with the same rate as the tokens
+		SlashingScorePenaltyMultiplier: sdkmath.LegacyOneDec(),
+		// the score of all the delegators counts by default
+		MinDelegationDuration: 0,
 	}
 }
 
@@ -56,7 +64,57 @@ func (p Params) ValidateBasic() error {
 	}
 
 	// Validate sub account mappings
-	return validateClearingAccountMappings(p.ClearingAccountMappings)
+	if err := validateClearingAccountMappings(p.ClearingAccountMappings); err != nil {
+		return err
+	}
+
+	// The unset amount is treated as zero, which disables the threshold
+	if !p.MinDelegationAmount.IsNil() {
+		if err := ValidateMinDelegationAmount(p.MinDelegationAmount); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateMinDelegationDuration(p.MinDelegationDuration); err != nil {
+		return err
+	}
+
+	// The unset multiplier is treated as zero, which disables the penalty
+	if p.SlashingScorePenaltyMultiplier.IsNil() {
+		return nil
+	}
+	return ValidateSlashingScorePenaltyMultiplier(p.SlashingScorePenaltyMultiplier)
+}
+
+// ValidateMinDelegationAmount validates the minimum delegation amount accruing the score.
+func ValidateMinDelegationAmount(amount sdkmath.Int) error {
+	if amount.IsNil() {
+		return errorsmod.Wrap(ErrInvalidParam, "min delegation amount cannot be nil")
+	}
+	if amount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidParam, "min delegation amount cannot be negative: %s", amount)
+	}
+	return nil
+}
+
+// ValidateSlashingScorePenaltyMultiplier validates the multiplier of the score penalty applied on slashing.
+func ValidateSlashingScorePenaltyMultiplier(multiplier sdkmath.LegacyDec) error {
+	if multiplier.IsNil() {
+		return errorsmod.Wrap(ErrInvalidParam, "slashing score penalty multiplier cannot be nil")
+	}
+	if multiplier.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidParam, "slashing score penalty multiplier cannot be negative: %s", multiplier)
+	}
+	return nil
+}
+
+// ValidateMinDelegationDuration validates the minimum duration of the continuous delegation required for the score to
+// count toward the community distribution.
+func ValidateMinDelegationDuration(duration time.Duration) error {
+	if duration < 0 {
+		return errorsmod.Wrapf(ErrInvalidParam, "min delegation duration cannot be negative: %s", duration)
+	}
+	return nil
 }
 
 func validateExcludedAddresses(addresses []string) error {
@@ -126,8 +184,16 @@ func validateClearingAccountMappings(mappings []ClearingAccountMapping) error {
 // ValidateDistributionSchedule validates the allocation schedule.
 func ValidateDistributionSchedule(schedule []ScheduledDistribution) error {
 	// All clearing accounts (including Community) should be in the schedule
-	allClearingAccounts := GetAllClearingAccounts()
+	return validateScheduledDistributions(schedule, GetAllClearingAccounts(), true)
+}
 
+// validateScheduledDistributions validates the distributions allocating the tokens from the allowed clearing
+// accounts. If requireAll is set, each distribution must allocate the tokens from all the allowed clearing accounts.
+func validateScheduledDistributions(
+	schedule []ScheduledDistribution,
+	allClearingAccounts []string,
+	requireAll bool,
+) error {
 	seenTimestamps := make(map[uint64]bool)
 	var lastTime uint64
 
@@ -168,11 +234,11 @@ func ValidateDistributionSchedule(schedule []ScheduledDistribution) error {
 				return errorsmod.Wrapf(ErrInvalidParam, "period %d, allocation %d: clearing_account cannot be empty", i, j)
 			}
 
-			// Explicitly validate clearing account is one of the PSE clearing accounts
+			// Explicitly validate clearing account is one of the allowed clearing accounts
 			// Only these accounts are allowed, no other module accounts
 			if !allowedClearingAccounts[alloc.ClearingAccount] {
 				return errorsmod.Wrapf(ErrInvalidParam,
-					"period %d, allocation %d: invalid clearing account '%s'. Only PSE clearing accounts are allowed: %v",
+					"period %d, allocation %d: invalid clearing account '%s'. Only these clearing accounts are allowed: %v",
 					i, j, alloc.ClearingAccount, allClearingAccounts)
 			}
 
@@ -206,6 +272,10 @@ func ValidateDistributionSchedule(schedule []ScheduledDistribution) error {
 			}
 		}
 
+		if !requireAll {
+			continue
+		}
+
 		// Explicitly validate that ALL PSE clearing accounts are present in this period
 		// Each period must have exactly one allocation for each clearing account
 		for _, requiredAccount := range allClearingAccounts {
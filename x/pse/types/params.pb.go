@@ -4,19 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +38,17 @@ type Params struct {
 	// clearing_account_mappings defines the mapping between clearing accounts and their sub accounts (multisig wallets).
 	// These mappings can be modified via governance proposals.
 	ClearingAccountMappings []ClearingAccountMapping `protobuf:"bytes,2,rep,name=clearing_account_mappings,json=clearingAccountMappings,proto3" json:"clearing_account_mappings" yaml:"clearing_account_mappings"`
+	// min_delegation_amount is the minimum amount of the delegation to accrue the score.
+	// The delegations below the amount don't accrue the score. Zero disables the threshold.
+	MinDelegationAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_delegation_amount" yaml:"min_delegation_amount"`
+	// slashing_score_penalty_multiplier defines how much of the score accrued by the delegations to the slashed
+	// validator is removed. The removed part of the score is the slash fraction multiplied by this value, capped at
+	// one. Zero disables the penalty.
+	SlashingScorePenaltyMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slashing_score_penalty_multiplier,json=slashingScorePenaltyMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_score_penalty_multiplier" yaml:"slashing_score_penalty_multiplier"`
+	// min_delegation_duration is the minimum duration of the continuous delegation of the delegator required for its
+	// score to count toward the community distribution. The score of the delegators delegating for a shorter time is
+	// forfeited at the distribution. Zero disables the requirement.
+	MinDelegationDuration time.Duration `protobuf:"bytes,5,opt,name=min_delegation_duration,json=minDelegationDuration,proto3,stdduration" json:"min_delegation_duration" yaml:"min_delegation_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,6 +98,13 @@ func (m *Params) GetClearingAccountMappings() []ClearingAccountMapping {
 	return nil
 }
 
+func (m *Params) GetMinDelegationDuration() time.Duration {
+	if m != nil {
+		return m.MinDelegationDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "tx.pse.v1.Params")
 }
@@ -89,28 +112,40 @@ func init() {
 func init() { proto.RegisterFile("tx/pse/v1/params.proto", fileDescriptor_b70a3fad281b1b5f) }
 
 var fileDescriptor_b70a3fad281b1b5f = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4f, 0x32, 0x31,
-	0x1c, 0xc6, 0xef, 0x5e, 0x12, 0x12, 0xee, 0x9d, 0xbc, 0x10, 0x05, 0x62, 0x0e, 0xbc, 0x89, 0xe5,
-	0xda, 0xa0, 0x31, 0x26, 0x6e, 0xe0, 0xe0, 0x64, 0x62, 0x70, 0x73, 0xb9, 0x94, 0xb6, 0x39, 0x1a,
-	0xb9, 0xb6, 0xb9, 0x7f, 0x8f, 0x1c, 0x7e, 0x01, 0x57, 0x3f, 0x8c, 0x1f, 0x82, 0x91, 0x38, 0x39,
-	0x11, 0x03, 0xdf, 0x80, 0xc9, 0xd1, 0x40, 0x0f, 0x1d, 0xd4, 0xad, 0x7d, 0x9e, 0x5f, 0x9e, 0x3e,
-	0x7d, 0xbc, 0x43, 0x53, 0x60, 0x0d, 0x1c, 0x4f, 0x7b, 0x58, 0x93, 0x8c, 0xa4, 0x80, 0x74, 0xa6,
-	0x8c, 0xf2, 0x6b, 0xa6, 0x40, 0x1a, 0x38, 0x9a, 0xf6, 0x5a, 0x4d, 0xaa, 0x20, 0x55, 0x10, 0xef,
-	0x0c, 0x6c, 0x2f, 0x96, 0x6a, 0xd5, 0x13, 0x95, 0x28, 0xab, 0x6f, 0x4f, 0xa5, 0x7a, 0xfc, 0x9d,
-	0xc9, 0x04, 0x98, 0x4c, 0x8c, 0x72, 0x23, 0x94, 0xb4, 0x6e, 0xf8, 0xe1, 0x7a, 0xd5, 0xdb, 0xdd,
-	0x53, 0x3e, 0xf3, 0x7c, 0x5e, 0xd0, 0x49, 0xce, 0x38, 0x8b, 0x09, 0x63, 0x19, 0x07, 0xe0, 0xd0,
-	0x70, 0x3b, 0x95, 0x6e, 0x6d, 0x70, 0xbe, 0x59, 0xb6, 0x9b, 0x33, 0x92, 0x4e, 0x2e, 0xc3, 0x9f,
-	0x4c, 0xf8, 0xfa, 0x12, 0xd5, 0xcb, 0x26, 0x7d, 0x2b, 0xde, 0x99, 0x4c, 0xc8, 0x64, 0x78, 0xb0,
-	0x87, 0xfb, 0x7b, 0xd6, 0x7f, 0x72, 0xbd, 0x26, 0x9d, 0x70, 0xb2, 0xf5, 0x63, 0x42, 0xa9, 0xca,
-	0xa5, 0x89, 0x53, 0xa2, 0xb5, 0x90, 0x09, 0x34, 0xfe, 0x75, 0x2a, 0xdd, 0xff, 0xa7, 0x27, 0xe8,
-	0xeb, 0xbf, 0xe8, 0xaa, 0x64, 0xfb, 0x16, 0xbd, 0xb1, 0xe4, 0xa0, 0x3b, 0x5f, 0xb6, 0x9d, 0xcd,
-	0xb2, 0xdd, 0xb1, 0xa5, 0xfe, 0x4c, 0x0c, 0x87, 0x47, 0xf4, 0xd7, 0x04, 0x18, 0x5c, 0xcf, 0x57,
-	0x81, 0xbb, 0x58, 0x05, 0xee, 0xfb, 0x2a, 0x70, 0x9f, 0xd7, 0x81, 0xb3, 0x58, 0x07, 0xce, 0xdb,
-	0x3a, 0x70, 0xee, 0xa3, 0x44, 0x98, 0x71, 0x3e, 0x42, 0x54, 0xa5, 0xd8, 0xa8, 0x07, 0x2e, 0xc5,
-	0x23, 0x8f, 0x0a, 0x6c, 0x8a, 0x88, 0x8e, 0x89, 0x90, 0x78, 0x7a, 0x81, 0xed, 0xa6, 0x66, 0xa6,
-	0x39, 0x8c, 0xaa, 0xbb, 0x29, 0xcf, 0x3e, 0x03, 0x00, 0x00, 0xff, 0xff, 0xca, 0xf7, 0xa8, 0xea,
-	0xbe, 0x01, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xca, 0x26, 0x35, 0x3b, 0x11, 0x56, 0xd6, 0x8e, 0x29, 0xe9, 0x7a, 0x8a, 0x90,
+	0x6a, 0x6b, 0x43, 0x08, 0x89, 0x5b, 0x4b, 0x25, 0x34, 0xc1, 0xa4, 0x29, 0xbb, 0x21, 0xa1, 0xc8,
+	0x75, 0x4c, 0x6a, 0x2d, 0xb6, 0xa3, 0xd8, 0xa9, 0x52, 0x24, 0xc4, 0x91, 0x2b, 0x47, 0xee, 0x5c,
+	0x78, 0x00, 0x1e, 0x62, 0xc7, 0x89, 0x13, 0xe2, 0x10, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x94, 0x38,
+	0xe9, 0x80, 0x15, 0x71, 0x8b, 0xbf, 0xef, 0xe7, 0xff, 0xf7, 0xf7, 0x3f, 0x9f, 0x79, 0x4f, 0x65,
+	0x30, 0x96, 0x04, 0xce, 0x8e, 0x60, 0x8c, 0x12, 0xc4, 0x24, 0x88, 0x13, 0xa1, 0x84, 0xd5, 0x52,
+	0x19, 0x88, 0x25, 0x01, 0xb3, 0xa3, 0xfd, 0x2e, 0x16, 0x92, 0x09, 0xe9, 0x97, 0x0d, 0xa8, 0x0f,
+	0x9a, 0xda, 0xdf, 0x0d, 0x45, 0x28, 0x74, 0xbd, 0xf8, 0xaa, 0xaa, 0x76, 0x28, 0x44, 0x18, 0x11,
+	0x58, 0x9e, 0x26, 0xe9, 0x6b, 0x18, 0xa4, 0x09, 0x52, 0x54, 0xf0, 0xaa, 0x7f, 0x70, 0x3d, 0x33,
+	0xa0, 0x52, 0x25, 0x74, 0x92, 0x5e, 0x77, 0xfb, 0x9f, 0xb6, 0xcc, 0xed, 0xb3, 0xd2, 0x8a, 0x15,
+	0x98, 0x16, 0xc9, 0x70, 0x94, 0x06, 0x24, 0xf0, 0x51, 0x10, 0x24, 0x44, 0x4a, 0x22, 0x3b, 0x46,
+	0xaf, 0xe9, 0xb6, 0x46, 0x8f, 0x56, 0xb9, 0xd3, 0x9d, 0x23, 0x16, 0x3d, 0xe9, 0xdf, 0x64, 0xfa,
+	0x5f, 0xbf, 0x0c, 0x76, 0x2b, 0xa7, 0x43, 0x5d, 0x3c, 0x57, 0x09, 0xe5, 0xa1, 0x77, 0xa7, 0x86,
+	0x87, 0x35, 0x6b, 0xbd, 0x37, 0xcc, 0x2e, 0x8e, 0x08, 0x2a, 0xfa, 0x3e, 0xc2, 0x58, 0xa4, 0x5c,
+	0xf9, 0x0c, 0xc5, 0x31, 0xe5, 0xa1, 0xec, 0xdc, 0xea, 0x35, 0xdd, 0x9d, 0xe3, 0x43, 0xb0, 0xce,
+	0x03, 0x3c, 0xad, 0xd8, 0xa1, 0x46, 0x4f, 0x35, 0x39, 0x72, 0x2f, 0x73, 0xa7, 0xb1, 0xca, 0x9d,
+	0x9e, 0x36, 0xf5, 0x4f, 0xc5, 0xbe, 0xb7, 0x87, 0x37, 0x2a, 0x48, 0xeb, 0x9d, 0xd9, 0x66, 0x94,
+	0xfb, 0x01, 0x89, 0x48, 0x58, 0x06, 0xe6, 0x23, 0x56, 0x00, 0x9d, 0x66, 0xcf, 0x70, 0x5b, 0xa3,
+	0xe7, 0xc5, 0x84, 0xef, 0xb9, 0xd3, 0xd6, 0x2f, 0x93, 0xc1, 0x05, 0xa0, 0x02, 0x32, 0xa4, 0xa6,
+	0xe0, 0x84, 0xab, 0x55, 0xee, 0x1c, 0xe8, 0xd1, 0x1b, 0x35, 0x8a, 0x48, 0xcc, 0x2a, 0x92, 0x13,
+	0xae, 0xbc, 0xbb, 0x8c, 0xf2, 0xf1, 0x1a, 0x1a, 0x96, 0x8c, 0xf5, 0xd9, 0x30, 0x0f, 0x65, 0x84,
+	0xe4, 0xb4, 0x30, 0x2e, 0xb1, 0x48, 0x88, 0x1f, 0x13, 0x8e, 0x22, 0x35, 0xf7, 0x59, 0x1a, 0x29,
+	0x1a, 0x47, 0x94, 0x24, 0x9d, 0xdb, 0xa5, 0x9b, 0x57, 0x95, 0x9b, 0xfb, 0x37, 0xdd, 0xbc, 0x20,
+	0x21, 0xc2, 0xf3, 0x31, 0xc1, 0xab, 0xdc, 0x71, 0xb5, 0xa7, 0xff, 0xaa, 0xfe, 0xee, 0x6f, 0x4c,
+	0xb0, 0x67, 0xd7, 0x37, 0xce, 0x8b, 0x0b, 0x67, 0x9a, 0x3f, 0x5d, 0xe3, 0xd6, 0x5b, 0x73, 0xef,
+	0xaf, 0x77, 0xd6, 0x5b, 0xd6, 0xd9, 0xea, 0x19, 0xee, 0xce, 0x71, 0x17, 0xe8, 0x35, 0x04, 0xf5,
+	0x1a, 0x82, 0x71, 0x05, 0x8c, 0x1e, 0x54, 0xbf, 0xca, 0xde, 0x98, 0x57, 0xad, 0xd3, 0xff, 0xf8,
+	0xc3, 0x31, 0xbc, 0xf6, 0x1f, 0x39, 0xad, 0x25, 0x9e, 0x5d, 0x2e, 0x6c, 0xe3, 0x6a, 0x61, 0x1b,
+	0x3f, 0x17, 0xb6, 0xf1, 0x61, 0x69, 0x37, 0xae, 0x96, 0x76, 0xe3, 0xdb, 0xd2, 0x6e, 0xbc, 0x1c,
+	0x84, 0x54, 0x4d, 0xd3, 0x09, 0xc0, 0x82, 0x41, 0x25, 0x2e, 0x08, 0xa7, 0x6f, 0xc8, 0x20, 0x83,
+	0x2a, 0x1b, 0xe0, 0x29, 0xa2, 0x1c, 0xce, 0x1e, 0x43, 0xbd, 0xfe, 0x6a, 0x1e, 0x13, 0x39, 0xd9,
+	0x2e, 0xed, 0x3d, 0xfc, 0x35, 0x00, 0x77, 0xa3, 0xab, 0x47, 0x89, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -133,6 +168,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinDelegationDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDelegationDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashingScorePenaltyMultiplier.Size()
+		i -= size
+		if _, err := m.SlashingScorePenaltyMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinDelegationAmount.Size()
+		i -= size
+		if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.ClearingAccountMappings) > 0 {
 		for iNdEx := len(m.ClearingAccountMappings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -188,6 +251,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.MinDelegationAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashingScorePenaltyMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDelegationDuration)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -292,6 +361,107 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingScorePenaltyMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingScorePenaltyMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinDelegationDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
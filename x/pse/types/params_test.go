@@ -16,6 +16,9 @@ func TestDefaultParams(t *testing.T) {
 	params := DefaultParams()
 	requireT.Empty(params.ExcludedAddresses)
 	requireT.Empty(params.ClearingAccountMappings)
+	requireT.True(params.MinDelegationAmount.IsZero())
+	requireT.Equal(sdkmath.LegacyOneDec(), params.SlashingScorePenaltyMultiplier)
+	requireT.Zero(params.MinDelegationDuration)
 
 	// DefaultParams returns empty mappings - valid for genesis
 	// Tests and actual usage should call UpdateClearingAccountMappings to set proper values
@@ -647,3 +650,46 @@ func TestParamsValidation_CompleteScenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsValidation_MinDelegationAmount(t *testing.T) {
+	requireT := require.New(t)
+
+	params := DefaultParams()
+	params.MinDelegationAmount = sdkmath.NewInt(1_000_000)
+	requireT.NoError(params.ValidateBasic())
+
+	// unset amount disables the threshold
+	params.MinDelegationAmount = sdkmath.Int{}
+	requireT.NoError(params.ValidateBasic())
+
+	params.MinDelegationAmount = sdkmath.NewInt(-1)
+	requireT.ErrorIs(params.ValidateBasic(), ErrInvalidParam)
+}
+
+func TestParamsValidation_SlashingScorePenaltyMultiplier(t *testing.T) {
+	requireT := require.New(t)
+
+	params := DefaultParams()
+	params.SlashingScorePenaltyMultiplier = sdkmath.LegacyNewDec(2)
+	requireT.NoError(params.ValidateBasic())
+
+	// zero multiplier disables the penalty
+	params.SlashingScorePenaltyMultiplier = sdkmath.LegacyZeroDec()
+	requireT.NoError(params.ValidateBasic())
+
+	params.SlashingScorePenaltyMultiplier = sdkmath.LegacyNewDec(-1)
+	requireT.ErrorIs(params.ValidateBasic(), ErrInvalidParam)
+
+	requireT.ErrorIs(ValidateSlashingScorePenaltyMultiplier(sdkmath.LegacyDec{}), ErrInvalidParam)
+}
+
+func TestParamsValidation_MinDelegationDuration(t *testing.T) {
+	requireT := require.New(t)
+
+	params := DefaultParams()
+	params.MinDelegationDuration = time.Hour
+	requireT.NoError(params.ValidateBasic())
+
+	params.MinDelegationDuration = -time.Second
+	requireT.ErrorIs(params.ValidateBasic(), ErrInvalidParam)
+}
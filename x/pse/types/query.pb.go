@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -201,6 +202,126 @@ func (m *QueryScoreResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryScoreResponse proto.InternalMessageInfo
 
+// QueryScoresSnapshotRequest defines the request type for querying the scores of all the accounts.
+type QueryScoresSnapshotRequest struct {
+	// height is the height the snapshot is pinned to. The query must be executed at this height, so all the pages
+	// are read from the same state. The height of the query is used if it is zero.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// pagination defines an optional pagination for the request, only the key based pagination is supported.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScoresSnapshotRequest) Reset()         { *m = QueryScoresSnapshotRequest{} }
+func (m *QueryScoresSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScoresSnapshotRequest) ProtoMessage()    {}
+func (*QueryScoresSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{4}
+}
+func (m *QueryScoresSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScoresSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScoresSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScoresSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoresSnapshotRequest.Merge(m, src)
+}
+func (m *QueryScoresSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScoresSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoresSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScoresSnapshotRequest proto.InternalMessageInfo
+
+func (m *QueryScoresSnapshotRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryScoresSnapshotRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScoresSnapshotResponse defines the response type for querying the scores of all the accounts.
+type QueryScoresSnapshotResponse struct {
+	// height is the height the snapshot is taken at, it must be set in the requests of the next pages.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// scores contains the current total scores of the accounts in the order of the addresses.
+	Scores []AccountScore `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores" yaml:"scores"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScoresSnapshotResponse) Reset()         { *m = QueryScoresSnapshotResponse{} }
+func (m *QueryScoresSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScoresSnapshotResponse) ProtoMessage()    {}
+func (*QueryScoresSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{5}
+}
+func (m *QueryScoresSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScoresSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScoresSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScoresSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoresSnapshotResponse.Merge(m, src)
+}
+func (m *QueryScoresSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScoresSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoresSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScoresSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryScoresSnapshotResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryScoresSnapshotResponse) GetScores() []AccountScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+func (m *QueryScoresSnapshotResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryScheduledDistributionsRequest defines the request type for querying future scheduled distributions.
 type QueryScheduledDistributionsRequest struct {
 }
@@ -209,7 +330,7 @@ func (m *QueryScheduledDistributionsRequest) Reset()         { *m = QuerySchedul
 func (m *QueryScheduledDistributionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryScheduledDistributionsRequest) ProtoMessage()    {}
 func (*QueryScheduledDistributionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bf0a69d5178bfb9, []int{4}
+	return fileDescriptor_1bf0a69d5178bfb9, []int{6}
 }
 func (m *QueryScheduledDistributionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +372,7 @@ func (m *QueryScheduledDistributionsResponse) Reset()         { *m = QuerySchedu
 func (m *QueryScheduledDistributionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryScheduledDistributionsResponse) ProtoMessage()    {}
 func (*QueryScheduledDistributionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bf0a69d5178bfb9, []int{5}
+	return fileDescriptor_1bf0a69d5178bfb9, []int{7}
 }
 func (m *QueryScheduledDistributionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +423,7 @@ func (m *QueryClearingAccountBalancesRequest) Reset()         { *m = QueryCleari
 func (m *QueryClearingAccountBalancesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClearingAccountBalancesRequest) ProtoMessage()    {}
 func (*QueryClearingAccountBalancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bf0a69d5178bfb9, []int{6}
+	return fileDescriptor_1bf0a69d5178bfb9, []int{8}
 }
 func (m *QueryClearingAccountBalancesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +464,7 @@ func (m *ClearingAccountBalance) Reset()         { *m = ClearingAccountBalance{}
 func (m *ClearingAccountBalance) String() string { return proto.CompactTextString(m) }
 func (*ClearingAccountBalance) ProtoMessage()    {}
 func (*ClearingAccountBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bf0a69d5178bfb9, []int{7}
+	return fileDescriptor_1bf0a69d5178bfb9, []int{9}
 }
 func (m *ClearingAccountBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +510,7 @@ func (m *QueryClearingAccountBalancesResponse) Reset()         { *m = QueryClear
 func (m *QueryClearingAccountBalancesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClearingAccountBalancesResponse) ProtoMessage()    {}
 func (*QueryClearingAccountBalancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1bf0a69d5178bfb9, []int{8}
+	return fileDescriptor_1bf0a69d5178bfb9, []int{10}
 }
 func (m *QueryClearingAccountBalancesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,682 +546,4744 @@ func (m *QueryClearingAccountBalancesResponse) GetBalances() []ClearingAccountBa
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "tx.pse.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "tx.pse.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryScoreRequest)(nil), "tx.pse.v1.QueryScoreRequest")
-	proto.RegisterType((*QueryScoreResponse)(nil), "tx.pse.v1.QueryScoreResponse")
-	proto.RegisterType((*QueryScheduledDistributionsRequest)(nil), "tx.pse.v1.QueryScheduledDistributionsRequest")
-	proto.RegisterType((*QueryScheduledDistributionsResponse)(nil), "tx.pse.v1.QueryScheduledDistributionsResponse")
-	proto.RegisterType((*QueryClearingAccountBalancesRequest)(nil), "tx.pse.v1.QueryClearingAccountBalancesRequest")
-	proto.RegisterType((*ClearingAccountBalance)(nil), "tx.pse.v1.ClearingAccountBalance")
-	proto.RegisterType((*QueryClearingAccountBalancesResponse)(nil), "tx.pse.v1.QueryClearingAccountBalancesResponse")
+// QueryClearingAccountStatusRequest defines the request type for querying clearing account status.
+type QueryClearingAccountStatusRequest struct {
 }
 
-func init() { proto.RegisterFile("tx/pse/v1/query.proto", fileDescriptor_1bf0a69d5178bfb9) }
-
-var fileDescriptor_1bf0a69d5178bfb9 = []byte{
-	// 708 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8e, 0x7b, 0x6f, 0xff, 0xa6, 0xba, 0xb7, 0xb7, 0xd3, 0x26, 0x69, 0x73, 0x13, 0x27, 0x0c,
-	0x29, 0xaa, 0x10, 0xf1, 0xa8, 0xed, 0x02, 0x09, 0x09, 0x09, 0x0c, 0x02, 0x75, 0x07, 0x46, 0x50,
-	0x89, 0x4d, 0x34, 0xb1, 0x47, 0x89, 0xd5, 0xc4, 0xe3, 0x7a, 0x26, 0x55, 0x0a, 0x02, 0x21, 0x9e,
-	0x00, 0x89, 0x47, 0x40, 0x42, 0x3c, 0x00, 0x1b, 0xde, 0xa0, 0xcb, 0x0a, 0x36, 0x88, 0x45, 0x84,
-	0x5a, 0x9e, 0x20, 0x2f, 0x00, 0xb2, 0x67, 0x9c, 0x3a, 0xa9, 0x1b, 0x75, 0x67, 0x9f, 0xf3, 0x9d,
-	0xef, 0x7c, 0xe7, 0x1c, 0x7f, 0x06, 0x59, 0xd1, 0xc3, 0x3e, 0xa7, 0xf8, 0x60, 0x13, 0xef, 0x77,
-	0x69, 0x70, 0x68, 0xf8, 0x01, 0x13, 0x0c, 0xce, 0x8b, 0x9e, 0xe1, 0x73, 0x6a, 0x1c, 0x6c, 0x16,
-	0x56, 0x9a, 0xac, 0xc9, 0xa2, 0x28, 0x0e, 0x9f, 0x24, 0xa0, 0x50, 0x6c, 0x32, 0xd6, 0x6c, 0x53,
-	0x4c, 0x7c, 0x17, 0x13, 0xcf, 0x63, 0x82, 0x08, 0x97, 0x79, 0x5c, 0x65, 0xd7, 0x6c, 0xc6, 0x3b,
-	0x8c, 0xd7, 0x65, 0x99, 0x7c, 0x51, 0xa9, 0xdc, 0x59, 0x43, 0x9f, 0x04, 0xa4, 0x13, 0xc7, 0x8b,
-	0x67, 0x71, 0xc7, 0xe5, 0x22, 0x70, 0x1b, 0xdd, 0x90, 0x51, 0x66, 0xd1, 0x0a, 0x80, 0x8f, 0x43,
-	0x79, 0x8f, 0xa2, 0x12, 0x8b, 0xee, 0x77, 0x29, 0x17, 0x68, 0x17, 0x2c, 0x8f, 0x44, 0xb9, 0xcf,
-	0x3c, 0x4e, 0xe1, 0x1d, 0x30, 0x23, 0xa9, 0x57, 0xb5, 0x8a, 0xb6, 0xb1, 0xb0, 0xb5, 0x64, 0x0c,
-	0xa7, 0x31, 0x24, 0xd4, 0xcc, 0x1e, 0xf5, 0xcb, 0x99, 0x41, 0xbf, 0xfc, 0xcf, 0x21, 0xe9, 0xb4,
-	0x6f, 0x21, 0x09, 0x47, 0x96, 0xaa, 0x43, 0x35, 0xb0, 0x14, 0x11, 0x3f, 0xb1, 0x59, 0x40, 0x55,
-	0x37, 0xb8, 0x0a, 0x66, 0x89, 0xe3, 0x04, 0x94, 0x4b, 0xde, 0x79, 0x2b, 0x7e, 0x45, 0x3b, 0x4a,
-	0x9d, 0x82, 0x2b, 0x19, 0xdb, 0x60, 0x9a, 0x87, 0x01, 0x89, 0x36, 0x4b, 0x61, 0xcb, 0x1f, 0xfd,
-	0x72, 0x56, 0xae, 0x83, 0x3b, 0x7b, 0x86, 0xcb, 0x70, 0x87, 0x88, 0x96, 0xb1, 0xe3, 0x09, 0x4b,
-	0x62, 0x51, 0x15, 0x20, 0x45, 0xd5, 0xa2, 0x4e, 0xb7, 0x4d, 0x9d, 0xfb, 0x89, 0x65, 0x0c, 0x07,
-	0xff, 0xad, 0x81, 0xab, 0x13, 0x61, 0x4a, 0xc2, 0x1b, 0x0d, 0xe4, 0x79, 0x0c, 0xa9, 0x27, 0xf7,
-	0x1a, 0xce, 0xf0, 0xd7, 0xc6, 0xc2, 0x56, 0x25, 0xb1, 0x9b, 0x54, 0x32, 0x73, 0x5d, 0xad, 0xaa,
-	0x24, 0x57, 0x15, 0xd3, 0x8d, 0xb2, 0x21, 0x2b, 0xc7, 0x53, 0xa5, 0xc0, 0xa7, 0x20, 0xeb, 0xb8,
-	0x9c, 0x34, 0xc6, 0x2b, 0x56, 0xa7, 0x2a, 0xda, 0xc6, 0x9c, 0x59, 0x19, 0xf4, 0xcb, 0x45, 0xc9,
-	0x9c, 0x0a, 0x43, 0xd6, 0x8a, 0x8a, 0x8f, 0xd0, 0xa2, 0x75, 0xb5, 0x80, 0x7b, 0x6d, 0x4a, 0x02,
-	0xd7, 0x6b, 0xde, 0xb5, 0x6d, 0xd6, 0xf5, 0x84, 0x49, 0xda, 0xc4, 0xb3, 0xe9, 0x70, 0x51, 0x5f,
-	0x34, 0x90, 0x4b, 0x87, 0xc0, 0x07, 0xe0, 0x3f, 0x5b, 0x65, 0xea, 0x44, 0xa6, 0xd4, 0xa5, 0xfe,
-	0x1f, 0xf4, 0xcb, 0x79, 0xa9, 0x69, 0x1c, 0x81, 0xac, 0x45, 0x7b, 0x94, 0x0e, 0xee, 0x82, 0xd9,
-	0x86, 0xa4, 0x8c, 0x46, 0x9a, 0x37, 0x6f, 0x4f, 0x3c, 0xf4, 0xa0, 0x5f, 0xfe, 0x57, 0x72, 0xab,
-	0x2a, 0xf4, 0xf5, 0x73, 0x0d, 0x28, 0x8b, 0x84, 0x1f, 0x42, 0xcc, 0x86, 0x5e, 0x83, 0xea, 0xe4,
-	0x11, 0xd5, 0x91, 0x9f, 0x81, 0x39, 0x55, 0x12, 0x1f, 0xf5, 0x4a, 0xe2, 0xa8, 0xe9, 0xd5, 0x66,
-	0x5e, 0x5d, 0x75, 0x71, 0x44, 0x0b, 0x47, 0xd6, 0x90, 0x6b, 0xeb, 0xe3, 0xdf, 0x60, 0x3a, 0x12,
-	0x00, 0x1b, 0x60, 0x46, 0xfa, 0x06, 0x96, 0x12, 0xcc, 0xe7, 0x0d, 0x59, 0xd0, 0x2f, 0x4a, 0x4b,
-	0xa9, 0x68, 0xed, 0xed, 0xb7, 0x5f, 0xef, 0xa7, 0x96, 0xe1, 0x12, 0x1e, 0xff, 0x0b, 0xc0, 0x16,
-	0x98, 0x8e, 0xec, 0x03, 0x8b, 0xe3, 0x1c, 0x49, 0x13, 0x16, 0x4a, 0x17, 0x64, 0x55, 0x03, 0x14,
-	0x35, 0x28, 0xc2, 0x42, 0xa2, 0x41, 0x64, 0x2c, 0xfc, 0x52, 0x99, 0xf5, 0x15, 0xfc, 0xa0, 0x81,
-	0x5c, 0xba, 0x6f, 0x60, 0xed, 0x3c, 0xfb, 0x04, 0x1b, 0x16, 0x8c, 0xcb, 0xc2, 0x95, 0xba, 0xeb,
-	0x91, 0xba, 0x2a, 0x44, 0x23, 0xea, 0x52, 0xed, 0x09, 0x3f, 0x69, 0x20, 0x7f, 0xc1, 0xe5, 0xe1,
-	0xb9, 0xbe, 0x93, 0x5d, 0x50, 0xc0, 0x97, 0xc6, 0x2b, 0xa1, 0x37, 0x22, 0xa1, 0xd7, 0x60, 0x35,
-	0x21, 0x74, 0xdc, 0x0a, 0xf5, 0xf8, 0x43, 0x31, 0x1f, 0x1e, 0x9d, 0xe8, 0xda, 0xf1, 0x89, 0xae,
-	0xfd, 0x3c, 0xd1, 0xb5, 0x77, 0xa7, 0x7a, 0xe6, 0xf8, 0x54, 0xcf, 0x7c, 0x3f, 0xd5, 0x33, 0xcf,
-	0x6b, 0x4d, 0x57, 0xb4, 0xba, 0x0d, 0xc3, 0x66, 0x1d, 0x2c, 0xd8, 0x1e, 0xf5, 0xdc, 0x17, 0xb4,
-	0xd6, 0xc3, 0xa2, 0x57, 0xb3, 0x5b, 0xc4, 0xf5, 0xf0, 0xc1, 0x4d, 0x2c, 0xf9, 0xc5, 0xa1, 0x4f,
-	0x79, 0x63, 0x26, 0xfa, 0xd9, 0x6f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x80, 0x33, 0xdd, 0x72,
-	0x95, 0x06, 0x00, 0x00,
+func (m *QueryClearingAccountStatusRequest) Reset()         { *m = QueryClearingAccountStatusRequest{} }
+func (m *QueryClearingAccountStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClearingAccountStatusRequest) ProtoMessage()    {}
+func (*QueryClearingAccountStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{11}
+}
+func (m *QueryClearingAccountStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClearingAccountStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClearingAccountStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClearingAccountStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClearingAccountStatusRequest.Merge(m, src)
+}
+func (m *QueryClearingAccountStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClearingAccountStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClearingAccountStatusRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryClearingAccountStatusRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Score queries the current total score of an account (delegator).
-	Score(ctx context.Context, in *QueryScoreRequest, opts ...grpc.CallOption) (*QueryScoreResponse, error)
-	// ScheduledDistributions queries all future scheduled distributions.
-	ScheduledDistributions(ctx context.Context, in *QueryScheduledDistributionsRequest, opts ...grpc.CallOption) (*QueryScheduledDistributionsResponse, error)
-	// ClearingAccountBalances queries the current balances of all PSE clearing accounts.
-	ClearingAccountBalances(ctx context.Context, in *QueryClearingAccountBalancesRequest, opts ...grpc.CallOption) (*QueryClearingAccountBalancesResponse, error)
+// ClearingAccountStatus represents the balance of a single clearing account reconciled with its remaining scheduled
+// outflow.
+type ClearingAccountStatus struct {
+	// clearing_account is the name of the clearing account.
+	ClearingAccount string `protobuf:"bytes,1,opt,name=clearing_account,json=clearingAccount,proto3" json:"clearing_account,omitempty" yaml:"clearing_account"`
+	// balance is the current balance of the clearing account in the bond denom.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance" yaml:"balance"`
+	// scheduled_outflow is the total amount the remaining scheduled distributions transfer from the clearing account.
+	// For the Community clearing account it includes the amounts escrowed against the scheduled distributions.
+	ScheduledOutflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=scheduled_outflow,json=scheduledOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"scheduled_outflow" yaml:"scheduled_outflow"`
+	// surplus is the balance minus the scheduled outflow. The negative value is the deficit of the clearing account.
+	Surplus cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=surplus,proto3,customtype=cosmossdk.io/math.Int" json:"surplus" yaml:"surplus"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ClearingAccountStatus) Reset()         { *m = ClearingAccountStatus{} }
+func (m *ClearingAccountStatus) String() string { return proto.CompactTextString(m) }
+func (*ClearingAccountStatus) ProtoMessage()    {}
+func (*ClearingAccountStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{12}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ClearingAccountStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClearingAccountStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClearingAccountStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ClearingAccountStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearingAccountStatus.Merge(m, src)
+}
+func (m *ClearingAccountStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClearingAccountStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearingAccountStatus.DiscardUnknown(m)
 }
 
-func (c *queryClient) Score(ctx context.Context, in *QueryScoreRequest, opts ...grpc.CallOption) (*QueryScoreResponse, error) {
-	out := new(QueryScoreResponse)
-	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/Score", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClearingAccountStatus proto.InternalMessageInfo
+
+func (m *ClearingAccountStatus) GetClearingAccount() string {
+	if m != nil {
+		return m.ClearingAccount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ScheduledDistributions(ctx context.Context, in *QueryScheduledDistributionsRequest, opts ...grpc.CallOption) (*QueryScheduledDistributionsResponse, error) {
-	out := new(QueryScheduledDistributionsResponse)
-	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/ScheduledDistributions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryClearingAccountStatusResponse defines the response type for querying clearing account status.
+type QueryClearingAccountStatusResponse struct {
+	// statuses contains the status of all PSE clearing accounts.
+	Statuses []ClearingAccountStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses" yaml:"statuses"`
 }
 
-func (c *queryClient) ClearingAccountBalances(ctx context.Context, in *QueryClearingAccountBalancesRequest, opts ...grpc.CallOption) (*QueryClearingAccountBalancesResponse, error) {
-	out := new(QueryClearingAccountBalancesResponse)
-	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/ClearingAccountBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClearingAccountStatusResponse) Reset()         { *m = QueryClearingAccountStatusResponse{} }
+func (m *QueryClearingAccountStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClearingAccountStatusResponse) ProtoMessage()    {}
+func (*QueryClearingAccountStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{13}
+}
+func (m *QueryClearingAccountStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClearingAccountStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClearingAccountStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryClearingAccountStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClearingAccountStatusResponse.Merge(m, src)
+}
+func (m *QueryClearingAccountStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClearingAccountStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClearingAccountStatusResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Score queries the current total score of an account (delegator).
-	Score(context.Context, *QueryScoreRequest) (*QueryScoreResponse, error)
-	// ScheduledDistributions queries all future scheduled distributions.
-	ScheduledDistributions(context.Context, *QueryScheduledDistributionsRequest) (*QueryScheduledDistributionsResponse, error)
-	// ClearingAccountBalances queries the current balances of all PSE clearing accounts.
-	ClearingAccountBalances(context.Context, *QueryClearingAccountBalancesRequest) (*QueryClearingAccountBalancesResponse, error)
+var xxx_messageInfo_QueryClearingAccountStatusResponse proto.InternalMessageInfo
+
+func (m *QueryClearingAccountStatusResponse) GetStatuses() []ClearingAccountStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryRemainingUnlocksRequest defines the request type for querying the remaining unlocks of the clearing accounts.
+type QueryRemainingUnlocksRequest struct {
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRemainingUnlocksRequest) Reset()         { *m = QueryRemainingUnlocksRequest{} }
+func (m *QueryRemainingUnlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingUnlocksRequest) ProtoMessage()    {}
+func (*QueryRemainingUnlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{14}
 }
-func (*UnimplementedQueryServer) Score(ctx context.Context, req *QueryScoreRequest) (*QueryScoreResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Score not implemented")
+func (m *QueryRemainingUnlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ScheduledDistributions(ctx context.Context, req *QueryScheduledDistributionsRequest) (*QueryScheduledDistributionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduledDistributions not implemented")
+func (m *QueryRemainingUnlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingUnlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ClearingAccountBalances(ctx context.Context, req *QueryClearingAccountBalancesRequest) (*QueryClearingAccountBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearingAccountBalances not implemented")
+func (m *QueryRemainingUnlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingUnlocksRequest.Merge(m, src)
+}
+func (m *QueryRemainingUnlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingUnlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingUnlocksRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryRemainingUnlocksRequest proto.InternalMessageInfo
+
+// QueryRemainingUnlocksResponse defines the response type for querying the remaining unlocks of the clearing accounts.
+type QueryRemainingUnlocksResponse struct {
+	// remaining_unlocks contains the remaining unlock of all PSE clearing accounts.
+	RemainingUnlocks []RemainingUnlock `protobuf:"bytes,1,rep,name=remaining_unlocks,json=remainingUnlocks,proto3" json:"remaining_unlocks" yaml:"remaining_unlocks"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tx.pse.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryRemainingUnlocksResponse) Reset()         { *m = QueryRemainingUnlocksResponse{} }
+func (m *QueryRemainingUnlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingUnlocksResponse) ProtoMessage()    {}
+func (*QueryRemainingUnlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{15}
+}
+func (m *QueryRemainingUnlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingUnlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingUnlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_Score_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScoreRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Score(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tx.pse.v1.Query/Score",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Score(ctx, req.(*QueryScoreRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryRemainingUnlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingUnlocksResponse.Merge(m, src)
 }
-
-func _Query_ScheduledDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryScheduledDistributionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ScheduledDistributions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tx.pse.v1.Query/ScheduledDistributions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ScheduledDistributions(ctx, req.(*QueryScheduledDistributionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryRemainingUnlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingUnlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingUnlocksResponse.DiscardUnknown(m)
 }
 
-func _Query_ClearingAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClearingAccountBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClearingAccountBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tx.pse.v1.Query/ClearingAccountBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClearingAccountBalances(ctx, req.(*QueryClearingAccountBalancesRequest))
+var xxx_messageInfo_QueryRemainingUnlocksResponse proto.InternalMessageInfo
+
+func (m *QueryRemainingUnlocksResponse) GetRemainingUnlocks() []RemainingUnlock {
+	if m != nil {
+		return m.RemainingUnlocks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tx.pse.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Score",
-			Handler:    _Query_Score_Handler,
-		},
-		{
-			MethodName: "ScheduledDistributions",
-			Handler:    _Query_ScheduledDistributions_Handler,
-		},
-		{
-			MethodName: "ClearingAccountBalances",
-			Handler:    _Query_ClearingAccountBalances_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tx/pse/v1/query.proto",
+// QueryScoreCheckpointRequest defines the request type for querying the score checkpoint.
+type QueryScoreCheckpointRequest struct {
+	// hash is the hex encoded hash of the checkpoint emitted in EventScoreCheckpoint.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryScoreCheckpointRequest) Reset()         { *m = QueryScoreCheckpointRequest{} }
+func (m *QueryScoreCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScoreCheckpointRequest) ProtoMessage()    {}
+func (*QueryScoreCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{16}
+}
+func (m *QueryScoreCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScoreCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScoreCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryScoreCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoreCheckpointRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryScoreCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScoreCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoreCheckpointRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryScoreCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryScoreCheckpointRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryScoreCheckpointResponse defines the response type for querying the score checkpoint.
+type QueryScoreCheckpointResponse struct {
+	Checkpoint ScoreCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryScoreCheckpointResponse) Reset()         { *m = QueryScoreCheckpointResponse{} }
+func (m *QueryScoreCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScoreCheckpointResponse) ProtoMessage()    {}
+func (*QueryScoreCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{17}
+}
+func (m *QueryScoreCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScoreCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScoreCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryScoreCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScoreCheckpointResponse.Merge(m, src)
+}
+func (m *QueryScoreCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScoreCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScoreCheckpointResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryScoreCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryScoreCheckpointResponse) GetCheckpoint() ScoreCheckpoint {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return dAtA[:n], nil
+	return ScoreCheckpoint{}
 }
 
-func (m *QueryScoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNamedSchedulesRequest defines the request type for querying the named schedules.
+type QueryNamedSchedulesRequest struct {
 }
 
-func (m *QueryScoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryNamedSchedulesRequest) Reset()         { *m = QueryNamedSchedulesRequest{} }
+func (m *QueryNamedSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamedSchedulesRequest) ProtoMessage()    {}
+func (*QueryNamedSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{18}
 }
-
-func (m *QueryScoreResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNamedSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamedSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamedSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryNamedSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamedSchedulesRequest.Merge(m, src)
+}
+func (m *QueryNamedSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNamedSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamedSchedulesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryScoreResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryNamedSchedulesRequest proto.InternalMessageInfo
+
+// QueryNamedSchedulesResponse defines the response type for querying the named schedules.
+type QueryNamedSchedulesResponse struct {
+	// schedules contains all the named schedules sorted by name.
+	Schedules []NamedSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules" yaml:"schedules"`
 }
 
-func (m *QueryScoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Score.Size()
-		i -= size
-		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryNamedSchedulesResponse) Reset()         { *m = QueryNamedSchedulesResponse{} }
+func (m *QueryNamedSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamedSchedulesResponse) ProtoMessage()    {}
+func (*QueryNamedSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{19}
+}
+func (m *QueryNamedSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamedSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamedSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryScheduledDistributionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNamedSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamedSchedulesResponse.Merge(m, src)
 }
-
-func (m *QueryScheduledDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNamedSchedulesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryScheduledDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryNamedSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamedSchedulesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScheduledDistributionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNamedSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryNamedSchedulesResponse) GetSchedules() []NamedSchedule {
+	if m != nil {
+		return m.Schedules
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryScheduledDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNamedScheduleRequest defines the request type for querying the named schedule.
+type QueryNamedScheduleRequest struct {
+	// name is the name of the schedule.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueryScheduledDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DisableDistributions {
-		i--
-		if m.DisableDistributions {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ScheduledDistributions) > 0 {
-		for iNdEx := len(m.ScheduledDistributions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ScheduledDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryNamedScheduleRequest) Reset()         { *m = QueryNamedScheduleRequest{} }
+func (m *QueryNamedScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamedScheduleRequest) ProtoMessage()    {}
+func (*QueryNamedScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{20}
+}
+func (m *QueryNamedScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamedScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamedScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryClearingAccountBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNamedScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamedScheduleRequest.Merge(m, src)
 }
-
-func (m *QueryClearingAccountBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNamedScheduleRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryClearingAccountBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryNamedScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamedScheduleRequest.DiscardUnknown(m)
 }
 
-func (m *ClearingAccountBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNamedScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryNamedScheduleRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ClearingAccountBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// NamedScheduleClearingAccount represents the clearing account of the named schedule.
+type NamedScheduleClearingAccount struct {
+	// clearing_account is the name of the clearing account within the schedule.
+	ClearingAccount string `protobuf:"bytes,1,opt,name=clearing_account,json=clearingAccount,proto3" json:"clearing_account,omitempty" yaml:"clearing_account"`
+	// address is the address of the clearing account, the schedule is funded by sending the tokens to it.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// balance is the current balance of the clearing account in the bond denom.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance" yaml:"balance"`
+	// scheduled_outflow is the total amount the remaining distributions of the schedule transfer from the account.
+	ScheduledOutflow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=scheduled_outflow,json=scheduledOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"scheduled_outflow" yaml:"scheduled_outflow"`
 }
 
-func (m *ClearingAccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *NamedScheduleClearingAccount) Reset()         { *m = NamedScheduleClearingAccount{} }
+func (m *NamedScheduleClearingAccount) String() string { return proto.CompactTextString(m) }
+func (*NamedScheduleClearingAccount) ProtoMessage()    {}
+func (*NamedScheduleClearingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{21}
+}
+func (m *NamedScheduleClearingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedScheduleClearingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamedScheduleClearingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ClearingAccount) > 0 {
-		i -= len(m.ClearingAccount)
-		copy(dAtA[i:], m.ClearingAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClearingAccount)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *NamedScheduleClearingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedScheduleClearingAccount.Merge(m, src)
+}
+func (m *NamedScheduleClearingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedScheduleClearingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedScheduleClearingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedScheduleClearingAccount proto.InternalMessageInfo
+
+func (m *NamedScheduleClearingAccount) GetClearingAccount() string {
+	if m != nil {
+		return m.ClearingAccount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryClearingAccountBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NamedScheduleClearingAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryClearingAccountBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNamedScheduleResponse defines the response type for querying the named schedule.
+type QueryNamedScheduleResponse struct {
+	// schedule is the named schedule.
+	Schedule NamedSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule" yaml:"schedule"`
+	// clearing_accounts contains the clearing accounts of the schedule.
+	ClearingAccounts []NamedScheduleClearingAccount `protobuf:"bytes,2,rep,name=clearing_accounts,json=clearingAccounts,proto3" json:"clearing_accounts" yaml:"clearing_accounts"`
 }
 
-func (m *QueryClearingAccountBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryNamedScheduleResponse) Reset()         { *m = QueryNamedScheduleResponse{} }
+func (m *QueryNamedScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamedScheduleResponse) ProtoMessage()    {}
+func (*QueryNamedScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{22}
+}
+func (m *QueryNamedScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNamedScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNamedScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryNamedScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamedScheduleResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryNamedScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryNamedScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamedScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryScoreRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryNamedScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryNamedScheduleResponse) GetSchedule() NamedSchedule {
+	if m != nil {
+		return m.Schedule
 	}
-	return n
+	return NamedSchedule{}
 }
 
-func (m *QueryScoreResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNamedScheduleResponse) GetClearingAccounts() []NamedScheduleClearingAccount {
+	if m != nil {
+		return m.ClearingAccounts
 	}
-	var l int
-	_ = l
-	l = m.Score.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryScheduledDistributionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryDistributionPreferenceRequest defines the request type for querying the distribution preference.
+type QueryDistributionPreferenceRequest struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryScheduledDistributionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ScheduledDistributions) > 0 {
-		for _, e := range m.ScheduledDistributions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDistributionPreferenceRequest) Reset()         { *m = QueryDistributionPreferenceRequest{} }
+func (m *QueryDistributionPreferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionPreferenceRequest) ProtoMessage()    {}
+func (*QueryDistributionPreferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{23}
+}
+func (m *QueryDistributionPreferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionPreferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionPreferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DisableDistributions {
-		n += 2
-	}
-	return n
+}
+func (m *QueryDistributionPreferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionPreferenceRequest.Merge(m, src)
+}
+func (m *QueryDistributionPreferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionPreferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionPreferenceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClearingAccountBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDistributionPreferenceRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionPreferenceRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *ClearingAccountBalance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+// QueryDistributionPreferenceResponse defines the response type for querying the distribution preference.
+type QueryDistributionPreferenceResponse struct {
+	// validator_address is the validator the payouts are delegated to, empty if the payouts are delegated to the
+	// validators of the delegator proportionally to the delegations.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryDistributionPreferenceResponse) Reset()         { *m = QueryDistributionPreferenceResponse{} }
+func (m *QueryDistributionPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionPreferenceResponse) ProtoMessage()    {}
+func (*QueryDistributionPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{24}
+}
+func (m *QueryDistributionPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionPreferenceResponse.Merge(m, src)
+}
+func (m *QueryDistributionPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionPreferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionPreferenceResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionPreferenceResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryDistributionOptOutsRequest defines the request type for querying the addresses opted out of the distributions.
+type QueryDistributionOptOutsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDistributionOptOutsRequest) Reset()         { *m = QueryDistributionOptOutsRequest{} }
+func (m *QueryDistributionOptOutsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionOptOutsRequest) ProtoMessage()    {}
+func (*QueryDistributionOptOutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{25}
+}
+func (m *QueryDistributionOptOutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionOptOutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionOptOutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionOptOutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionOptOutsRequest.Merge(m, src)
+}
+func (m *QueryDistributionOptOutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionOptOutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionOptOutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionOptOutsRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionOptOutsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDistributionOptOutsResponse defines the response type for querying the addresses opted out of the
+// distributions.
+type QueryDistributionOptOutsResponse struct {
+	// addresses contains the addresses opted out of the Community distributions.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDistributionOptOutsResponse) Reset()         { *m = QueryDistributionOptOutsResponse{} }
+func (m *QueryDistributionOptOutsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionOptOutsResponse) ProtoMessage()    {}
+func (*QueryDistributionOptOutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{26}
+}
+func (m *QueryDistributionOptOutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionOptOutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionOptOutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionOptOutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionOptOutsResponse.Merge(m, src)
+}
+func (m *QueryDistributionOptOutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionOptOutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionOptOutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionOptOutsResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionOptOutsResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryDistributionOptOutsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDistributionOptOutRequest defines the request type for querying the distribution opt-out of the address.
+type QueryDistributionOptOutRequest struct {
+	// address is the queried address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDistributionOptOutRequest) Reset()         { *m = QueryDistributionOptOutRequest{} }
+func (m *QueryDistributionOptOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionOptOutRequest) ProtoMessage()    {}
+func (*QueryDistributionOptOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{27}
+}
+func (m *QueryDistributionOptOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionOptOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionOptOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionOptOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionOptOutRequest.Merge(m, src)
+}
+func (m *QueryDistributionOptOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionOptOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionOptOutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionOptOutRequest proto.InternalMessageInfo
+
+func (m *QueryDistributionOptOutRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryDistributionOptOutResponse defines the response type for querying the distribution opt-out of the address.
+type QueryDistributionOptOutResponse struct {
+	// opted_out is true if the address is opted out of the Community distributions.
+	OptedOut bool `protobuf:"varint,1,opt,name=opted_out,json=optedOut,proto3" json:"opted_out,omitempty"`
+}
+
+func (m *QueryDistributionOptOutResponse) Reset()         { *m = QueryDistributionOptOutResponse{} }
+func (m *QueryDistributionOptOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionOptOutResponse) ProtoMessage()    {}
+func (*QueryDistributionOptOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bf0a69d5178bfb9, []int{28}
+}
+func (m *QueryDistributionOptOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionOptOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionOptOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionOptOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionOptOutResponse.Merge(m, src)
+}
+func (m *QueryDistributionOptOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionOptOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionOptOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionOptOutResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionOptOutResponse) GetOptedOut() bool {
+	if m != nil {
+		return m.OptedOut
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "tx.pse.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tx.pse.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryScoreRequest)(nil), "tx.pse.v1.QueryScoreRequest")
+	proto.RegisterType((*QueryScoreResponse)(nil), "tx.pse.v1.QueryScoreResponse")
+	proto.RegisterType((*QueryScoresSnapshotRequest)(nil), "tx.pse.v1.QueryScoresSnapshotRequest")
+	proto.RegisterType((*QueryScoresSnapshotResponse)(nil), "tx.pse.v1.QueryScoresSnapshotResponse")
+	proto.RegisterType((*QueryScheduledDistributionsRequest)(nil), "tx.pse.v1.QueryScheduledDistributionsRequest")
+	proto.RegisterType((*QueryScheduledDistributionsResponse)(nil), "tx.pse.v1.QueryScheduledDistributionsResponse")
+	proto.RegisterType((*QueryClearingAccountBalancesRequest)(nil), "tx.pse.v1.QueryClearingAccountBalancesRequest")
+	proto.RegisterType((*ClearingAccountBalance)(nil), "tx.pse.v1.ClearingAccountBalance")
+	proto.RegisterType((*QueryClearingAccountBalancesResponse)(nil), "tx.pse.v1.QueryClearingAccountBalancesResponse")
+	proto.RegisterType((*QueryClearingAccountStatusRequest)(nil), "tx.pse.v1.QueryClearingAccountStatusRequest")
+	proto.RegisterType((*ClearingAccountStatus)(nil), "tx.pse.v1.ClearingAccountStatus")
+	proto.RegisterType((*QueryClearingAccountStatusResponse)(nil), "tx.pse.v1.QueryClearingAccountStatusResponse")
+	proto.RegisterType((*QueryRemainingUnlocksRequest)(nil), "tx.pse.v1.QueryRemainingUnlocksRequest")
+	proto.RegisterType((*QueryRemainingUnlocksResponse)(nil), "tx.pse.v1.QueryRemainingUnlocksResponse")
+	proto.RegisterType((*QueryScoreCheckpointRequest)(nil), "tx.pse.v1.QueryScoreCheckpointRequest")
+	proto.RegisterType((*QueryScoreCheckpointResponse)(nil), "tx.pse.v1.QueryScoreCheckpointResponse")
+	proto.RegisterType((*QueryNamedSchedulesRequest)(nil), "tx.pse.v1.QueryNamedSchedulesRequest")
+	proto.RegisterType((*QueryNamedSchedulesResponse)(nil), "tx.pse.v1.QueryNamedSchedulesResponse")
+	proto.RegisterType((*QueryNamedScheduleRequest)(nil), "tx.pse.v1.QueryNamedScheduleRequest")
+	proto.RegisterType((*NamedScheduleClearingAccount)(nil), "tx.pse.v1.NamedScheduleClearingAccount")
+	proto.RegisterType((*QueryNamedScheduleResponse)(nil), "tx.pse.v1.QueryNamedScheduleResponse")
+	proto.RegisterType((*QueryDistributionPreferenceRequest)(nil), "tx.pse.v1.QueryDistributionPreferenceRequest")
+	proto.RegisterType((*QueryDistributionPreferenceResponse)(nil), "tx.pse.v1.QueryDistributionPreferenceResponse")
+	proto.RegisterType((*QueryDistributionOptOutsRequest)(nil), "tx.pse.v1.QueryDistributionOptOutsRequest")
+	proto.RegisterType((*QueryDistributionOptOutsResponse)(nil), "tx.pse.v1.QueryDistributionOptOutsResponse")
+	proto.RegisterType((*QueryDistributionOptOutRequest)(nil), "tx.pse.v1.QueryDistributionOptOutRequest")
+	proto.RegisterType((*QueryDistributionOptOutResponse)(nil), "tx.pse.v1.QueryDistributionOptOutResponse")
+}
+
+func init() { proto.RegisterFile("tx/pse/v1/query.proto", fileDescriptor_1bf0a69d5178bfb9) }
+
+var fileDescriptor_1bf0a69d5178bfb9 = []byte{
+	// 1600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x6d, 0xc7, 0xb1, 0x26, 0x48, 0x62, 0x6d, 0xfc, 0xa1, 0xd0, 0xb2, 0xec, 0xac, 0x3f,
+	0x63, 0xc7, 0x22, 0xec, 0x1c, 0x82, 0x37, 0xc0, 0xfb, 0x11, 0x25, 0x48, 0x90, 0xc3, 0x5b, 0x3b,
+	0x34, 0xd2, 0x00, 0xbd, 0xa8, 0x94, 0xb4, 0x91, 0x08, 0x4b, 0xa4, 0xa2, 0xa5, 0x5c, 0xa7, 0x6e,
+	0x8a, 0xa2, 0x3d, 0x04, 0x6d, 0x0f, 0x2d, 0xd0, 0x4b, 0x6f, 0x2d, 0xd0, 0x4b, 0x7f, 0x40, 0x0f,
+	0xed, 0x1f, 0x28, 0x72, 0x0c, 0xda, 0x4b, 0xd1, 0x83, 0x51, 0xc4, 0xfd, 0x05, 0xbe, 0xf4, 0xd0,
+	0x43, 0x0b, 0xee, 0x0e, 0x29, 0x92, 0x22, 0x25, 0x37, 0x68, 0x80, 0xde, 0xc4, 0xdd, 0x99, 0x67,
+	0x9e, 0x99, 0x9d, 0x99, 0x9d, 0x15, 0x4c, 0x38, 0xfb, 0x5a, 0x93, 0x33, 0x6d, 0x6f, 0x43, 0x7b,
+	0xd4, 0x66, 0xad, 0xc7, 0xf9, 0x66, 0xcb, 0x76, 0x6c, 0x92, 0x72, 0xf6, 0xf3, 0x4d, 0xce, 0xf2,
+	0x7b, 0x1b, 0xea, 0x78, 0xd5, 0xae, 0xda, 0x62, 0x55, 0x73, 0x7f, 0x49, 0x01, 0x35, 0x5b, 0xb5,
+	0xed, 0x6a, 0x9d, 0x69, 0x46, 0xd3, 0xd4, 0x0c, 0xcb, 0xb2, 0x1d, 0xc3, 0x31, 0x6d, 0x8b, 0xe3,
+	0xee, 0xc5, 0xb2, 0xcd, 0x1b, 0x36, 0x2f, 0x4a, 0x35, 0xf9, 0x81, 0x5b, 0xab, 0xf2, 0x4b, 0x2b,
+	0x19, 0x9c, 0x49, 0x93, 0xda, 0xde, 0x46, 0x89, 0x39, 0xc6, 0x86, 0xd6, 0x34, 0xaa, 0xa6, 0x25,
+	0x70, 0x50, 0x76, 0xb2, 0x43, 0xae, 0x69, 0xb4, 0x8c, 0x86, 0x87, 0x91, 0xed, 0xac, 0x57, 0x4c,
+	0xee, 0xb4, 0xcc, 0x52, 0x3b, 0xa0, 0x35, 0xd5, 0xd9, 0xad, 0x32, 0x8b, 0x71, 0x13, 0xd5, 0xe8,
+	0x38, 0x90, 0x7b, 0xae, 0xc1, 0x6d, 0x81, 0xa5, 0xb3, 0x47, 0x6d, 0xc6, 0x1d, 0xfa, 0x00, 0x2e,
+	0x84, 0x56, 0x79, 0xd3, 0xb6, 0x38, 0x23, 0xff, 0x83, 0x11, 0x69, 0x33, 0xa3, 0xcc, 0x29, 0x2b,
+	0x67, 0x36, 0xd3, 0x79, 0x3f, 0x24, 0x79, 0x29, 0x5a, 0x98, 0x78, 0x76, 0x38, 0x3b, 0x70, 0x7c,
+	0x38, 0x7b, 0xf6, 0xb1, 0xd1, 0xa8, 0x5f, 0xa7, 0x52, 0x9c, 0xea, 0xa8, 0x47, 0xd7, 0x21, 0x2d,
+	0x80, 0x77, 0xca, 0x76, 0x8b, 0xa1, 0x35, 0x92, 0x81, 0xd3, 0x46, 0xa5, 0xd2, 0x62, 0x5c, 0xe2,
+	0xa6, 0x74, 0xef, 0x93, 0xde, 0x05, 0x12, 0x14, 0x47, 0x1a, 0x57, 0xe1, 0x14, 0x77, 0x17, 0xa4,
+	0x74, 0x61, 0xc6, 0x35, 0xf9, 0xf3, 0xe1, 0xec, 0x84, 0x8c, 0x22, 0xaf, 0xec, 0xe6, 0x4d, 0x5b,
+	0x6b, 0x18, 0x4e, 0x2d, 0x7f, 0xd7, 0x72, 0x74, 0x29, 0x4b, 0xdf, 0x01, 0xb5, 0x03, 0xc5, 0x77,
+	0x2c, 0xa3, 0xc9, 0x6b, 0xb6, 0xe3, 0x51, 0x98, 0x84, 0x91, 0x1a, 0x33, 0xab, 0x35, 0x47, 0x60,
+	0x0e, 0xe9, 0xf8, 0x45, 0x6e, 0x03, 0x74, 0x4e, 0x20, 0x33, 0x28, 0xbc, 0x5e, 0xca, 0xe3, 0xe1,
+	0xb9, 0xc7, 0x95, 0x97, 0x19, 0x82, 0xc7, 0x95, 0xdf, 0x36, 0xaa, 0x9e, 0x5b, 0x7a, 0x40, 0x93,
+	0x7e, 0xaf, 0xc0, 0x74, 0xac, 0x79, 0x74, 0x29, 0xd9, 0xfe, 0x88, 0xa0, 0xcf, 0x33, 0x83, 0x73,
+	0x43, 0x2b, 0x67, 0x36, 0xa7, 0x02, 0x11, 0xbf, 0x51, 0x2e, 0xdb, 0x6d, 0xcb, 0x11, 0x88, 0xd1,
+	0xb8, 0x4b, 0x25, 0xaa, 0xa3, 0x36, 0xb9, 0x13, 0xf2, 0x63, 0x48, 0xf8, 0xb1, 0xdc, 0xd7, 0x0f,
+	0x49, 0x2e, 0xe4, 0xc8, 0x02, 0x50, 0xf4, 0xa3, 0xc6, 0x2a, 0xed, 0x3a, 0xab, 0xdc, 0x0a, 0x24,
+	0x9b, 0x9f, 0x3f, 0x7f, 0x28, 0x30, 0xdf, 0x53, 0x0c, 0xdd, 0x7e, 0x4f, 0x81, 0x29, 0xee, 0x89,
+	0x14, 0x83, 0x79, 0xeb, 0xa6, 0x82, 0xeb, 0xf0, 0x5c, 0xc0, 0xe1, 0x58, 0xb0, 0xc2, 0x22, 0x7a,
+	0x3e, 0xe3, 0x79, 0x2e, 0x85, 0xc2, 0x68, 0x54, 0x9f, 0xe4, 0xb1, 0x54, 0xc8, 0x7d, 0x98, 0xa8,
+	0x98, 0xdc, 0x28, 0x45, 0x35, 0xc4, 0x61, 0x8f, 0x16, 0xe6, 0x8e, 0x0f, 0x67, 0xb3, 0x12, 0x39,
+	0x56, 0x8c, 0xea, 0xe3, 0xb8, 0x1e, 0x82, 0xa5, 0x8b, 0x18, 0x80, 0x9b, 0x75, 0x66, 0xb4, 0x4c,
+	0xab, 0x8a, 0x87, 0x55, 0x30, 0xea, 0x86, 0x55, 0x66, 0x7e, 0xa0, 0xbe, 0x53, 0x60, 0x32, 0x5e,
+	0x84, 0xdc, 0x86, 0xb1, 0x32, 0xee, 0x14, 0x0d, 0xb9, 0x85, 0x09, 0x3f, 0x7d, 0x7c, 0x38, 0x3b,
+	0x25, 0x39, 0x45, 0x25, 0xa8, 0x7e, 0xbe, 0x1c, 0x86, 0x23, 0x0f, 0xe0, 0x74, 0x49, 0x42, 0x0a,
+	0x97, 0x52, 0x85, 0x7f, 0xf7, 0xac, 0x97, 0xe3, 0xc3, 0xd9, 0x73, 0x12, 0x1b, 0xb5, 0xe8, 0x0f,
+	0xdf, 0xac, 0x03, 0x66, 0x8a, 0x5b, 0x4f, 0x1e, 0x1a, 0x7d, 0x17, 0x16, 0x7a, 0xbb, 0x88, 0x87,
+	0xfc, 0x3a, 0x8c, 0xa2, 0x8a, 0x77, 0xa8, 0x97, 0x02, 0x87, 0x1a, 0xaf, 0x5d, 0x98, 0xc2, 0x53,
+	0x3d, 0x1f, 0xe2, 0xc2, 0xa9, 0xee, 0x63, 0xd1, 0x79, 0xb8, 0x14, 0x67, 0x7f, 0xc7, 0x31, 0x9c,
+	0xb6, 0x1f, 0xe0, 0xa7, 0x43, 0x30, 0x11, 0x2b, 0xf0, 0x8f, 0x8f, 0x2f, 0x71, 0x20, 0xdd, 0xa9,
+	0x0d, 0xbb, 0xed, 0x3c, 0xac, 0xdb, 0x6f, 0x89, 0xd2, 0x4d, 0x15, 0xee, 0xf4, 0x33, 0x91, 0x09,
+	0x17, 0x83, 0xaf, 0x1f, 0x35, 0x36, 0xe6, 0x4b, 0x6c, 0x49, 0x01, 0xd7, 0x1d, 0xde, 0x6e, 0x35,
+	0xeb, 0x6d, 0x9e, 0x19, 0xfe, 0x4b, 0xee, 0xa0, 0x56, 0x97, 0x3b, 0xde, 0xfa, 0x01, 0x76, 0x8e,
+	0x84, 0xe3, 0xc2, 0x64, 0xb9, 0x0f, 0xa3, 0x5c, 0xac, 0xb0, 0xb8, 0x0e, 0x10, 0xab, 0x1b, 0xcd,
+	0x15, 0x4f, 0x9f, 0xea, 0x3e, 0x14, 0xcd, 0x41, 0x56, 0x18, 0xd7, 0x59, 0xc3, 0x30, 0x2d, 0xd3,
+	0xaa, 0xde, 0xb7, 0xea, 0x76, 0x79, 0xd7, 0x4f, 0x93, 0x8f, 0x14, 0x98, 0x49, 0x10, 0x40, 0x62,
+	0x26, 0xa4, 0x5b, 0xde, 0x5e, 0xb1, 0x2d, 0x37, 0x91, 0xa1, 0x1a, 0x60, 0x18, 0xd1, 0x2f, 0xcc,
+	0x21, 0x37, 0x3c, 0x90, 0x2e, 0x08, 0xaa, 0x8f, 0xb5, 0x22, 0x26, 0xe9, 0x46, 0xf0, 0xae, 0xb8,
+	0x59, 0x63, 0xe5, 0xdd, 0xa6, 0x6d, 0x5a, 0xfe, 0x5d, 0x45, 0x60, 0xb8, 0x66, 0xf0, 0x1a, 0xde,
+	0x95, 0xe2, 0x37, 0x7d, 0x13, 0xb2, 0xf1, 0x2a, 0xfe, 0xcd, 0x0d, 0x65, 0x7f, 0x15, 0x6f, 0x6f,
+	0x35, 0xd4, 0x5a, 0x43, 0x7a, 0x85, 0x61, 0x97, 0xb6, 0x1e, 0xd0, 0xa1, 0x59, 0xbc, 0x3f, 0x5f,
+	0x33, 0x1a, 0xac, 0xe2, 0x75, 0x62, 0x3f, 0x7e, 0x36, 0x4c, 0xc7, 0xee, 0xa2, 0xf9, 0x6d, 0x48,
+	0x79, 0x89, 0xe6, 0x05, 0x2d, 0x13, 0xb0, 0x1e, 0xd2, 0x2a, 0x64, 0x30, 0x64, 0x63, 0xe1, 0x1c,
+	0xe6, 0x54, 0xef, 0x80, 0x50, 0x0d, 0x2e, 0x76, 0x1b, 0x0c, 0x44, 0xc8, 0x32, 0x1a, 0xcc, 0x8b,
+	0x90, 0xfb, 0x9b, 0xfe, 0x3e, 0x08, 0xd9, 0x90, 0x70, 0x24, 0x97, 0xfe, 0xb6, 0x7e, 0x70, 0xab,
+	0x33, 0xcd, 0xc8, 0x7e, 0xb0, 0xda, 0xa9, 0x11, 0xdc, 0x70, 0x6b, 0x64, 0x1c, 0x6b, 0xe4, 0x86,
+	0x5c, 0xda, 0x71, 0x5c, 0x0c, 0x7f, 0xf2, 0x09, 0x76, 0x95, 0xa1, 0x57, 0xdf, 0x55, 0x86, 0x5f,
+	0x71, 0x57, 0xa1, 0x47, 0x4a, 0x5c, 0xfa, 0xf8, 0xf9, 0xf1, 0x7f, 0x18, 0xf5, 0x54, 0x30, 0x39,
+	0x93, 0xd3, 0x23, 0x5a, 0xed, 0xb8, 0xee, 0x56, 0x3b, 0xfe, 0x24, 0x7b, 0x90, 0x8e, 0x1e, 0x94,
+	0x37, 0x40, 0x2d, 0x27, 0xe1, 0x46, 0xef, 0xa1, 0x48, 0xe1, 0x76, 0xe1, 0x51, 0x7d, 0x2c, 0x72,
+	0xf2, 0x9c, 0xde, 0xc3, 0x16, 0x17, 0x1c, 0x05, 0xb6, 0x5b, 0xec, 0x21, 0x6b, 0x31, 0xab, 0xec,
+	0x67, 0xe7, 0x1a, 0xa4, 0x2b, 0xac, 0xce, 0xaa, 0x86, 0x63, 0xb7, 0x8a, 0xe1, 0xc1, 0x77, 0xcc,
+	0xdf, 0xc0, 0xb4, 0xa0, 0x3a, 0xcc, 0xf7, 0x84, 0xc4, 0x00, 0xae, 0x41, 0x7a, 0xcf, 0xa8, 0x9b,
+	0x95, 0x38, 0x4c, 0x7f, 0xc3, 0xc3, 0x34, 0x61, 0xb6, 0x0b, 0x73, 0xab, 0xe9, 0x6c, 0xb5, 0x1d,
+	0xaf, 0x9e, 0x23, 0x73, 0xaf, 0xf2, 0xd2, 0x73, 0xef, 0x87, 0x0a, 0xcc, 0x25, 0xdb, 0x42, 0xf2,
+	0x59, 0x48, 0x21, 0x65, 0xec, 0x0e, 0x29, 0xbd, 0xb3, 0x10, 0x19, 0x5d, 0x07, 0x5f, 0x7e, 0x74,
+	0xbd, 0x0e, 0xb9, 0x04, 0x2a, 0xfd, 0x1f, 0x22, 0xff, 0x49, 0x0c, 0x99, 0xef, 0xc5, 0x34, 0xa4,
+	0xec, 0xa6, 0x23, 0x8b, 0x42, 0xa8, 0x8f, 0xea, 0xa3, 0x62, 0x61, 0xab, 0xed, 0x6c, 0xfe, 0x76,
+	0x0e, 0x4e, 0x09, 0x00, 0x52, 0x82, 0x11, 0xf9, 0x54, 0x22, 0x33, 0x81, 0x54, 0xec, 0x7e, 0x83,
+	0xa9, 0xb9, 0xa4, 0x6d, 0x69, 0x8f, 0x5e, 0x7c, 0xff, 0xc7, 0x5f, 0x3f, 0x1b, 0xbc, 0x40, 0xd2,
+	0x5a, 0xf4, 0x45, 0x48, 0x6a, 0x70, 0x4a, 0x34, 0x74, 0x92, 0x8d, 0x62, 0x04, 0xdf, 0x5d, 0xea,
+	0x4c, 0xc2, 0x2e, 0x1a, 0xa0, 0xc2, 0x40, 0x96, 0xa8, 0x01, 0x03, 0xe2, 0x39, 0xa1, 0x1d, 0x60,
+	0x58, 0x9e, 0x90, 0x0f, 0x14, 0x38, 0x17, 0x7e, 0xd2, 0x90, 0xc5, 0x58, 0xd4, 0xe8, 0x8b, 0x4b,
+	0x5d, 0xea, 0x27, 0xd6, 0x8f, 0x05, 0x2f, 0x72, 0xcf, 0xe4, 0x57, 0x0a, 0x4c, 0xc6, 0xbf, 0x34,
+	0xc8, 0x7a, 0xb7, 0x99, 0x1e, 0x0f, 0x17, 0x35, 0x7f, 0x52, 0x71, 0x64, 0xb7, 0x2a, 0xd8, 0x2d,
+	0x10, 0x1a, 0x62, 0x17, 0xfb, 0xa0, 0x21, 0x5f, 0x2b, 0x30, 0x95, 0x30, 0x2b, 0x93, 0x2e, 0xbb,
+	0xbd, 0xdf, 0x0d, 0xaa, 0x76, 0x62, 0x79, 0x24, 0x7a, 0x45, 0x10, 0x5d, 0x22, 0x0b, 0x01, 0xa2,
+	0xd1, 0x9e, 0x56, 0xf4, 0x46, 0x6b, 0xf2, 0xa5, 0x92, 0x34, 0x35, 0x5f, 0xe9, 0x63, 0x38, 0x34,
+	0x7d, 0xab, 0xeb, 0x27, 0x94, 0xee, 0x11, 0xcd, 0x2e, 0x92, 0x72, 0xa6, 0x23, 0x1f, 0x2b, 0x30,
+	0x16, 0x1d, 0xd6, 0xc8, 0x72, 0xd4, 0x5e, 0xc2, 0xbc, 0xa7, 0xae, 0xf4, 0x17, 0x44, 0x4e, 0x0b,
+	0x82, 0x53, 0x8e, 0x64, 0x03, 0x9c, 0xba, 0xa6, 0x38, 0xf2, 0x89, 0x02, 0xe7, 0x23, 0x33, 0x14,
+	0x89, 0xcf, 0xf0, 0xae, 0x79, 0x4e, 0x5d, 0xee, 0x2b, 0x87, 0x54, 0xd6, 0x04, 0x95, 0x45, 0x32,
+	0x1f, 0x2d, 0x85, 0x62, 0x67, 0x4e, 0xe3, 0xda, 0x81, 0x3b, 0x10, 0xca, 0xca, 0x0c, 0x4f, 0x63,
+	0xdd, 0x95, 0x19, 0x3b, 0xcb, 0xa9, 0x4b, 0xfd, 0xc4, 0x7a, 0x54, 0xa6, 0x3b, 0x6a, 0x55, 0x8a,
+	0xfe, 0x98, 0x46, 0x9e, 0x2a, 0x70, 0x36, 0xa4, 0x4e, 0x16, 0x7a, 0xa2, 0x7b, 0x1c, 0x16, 0xfb,
+	0x48, 0x21, 0x85, 0xcb, 0x82, 0xc2, 0x3c, 0xb9, 0x94, 0x4c, 0x41, 0x3b, 0x70, 0x17, 0x9e, 0x90,
+	0x6f, 0x15, 0x98, 0x8c, 0xbf, 0x44, 0xbb, 0x7b, 0x44, 0xcf, 0xfb, 0x5b, 0xcd, 0x9f, 0x54, 0x1c,
+	0x49, 0xfe, 0x57, 0x90, 0xfc, 0x17, 0xb9, 0xa6, 0xc5, 0xff, 0x45, 0x57, 0x6c, 0xfa, 0x3a, 0x5c,
+	0x3b, 0xe8, 0x1a, 0x0d, 0x9e, 0x90, 0xcf, 0x15, 0xb8, 0x10, 0x73, 0x7f, 0x92, 0xd5, 0x5e, 0x44,
+	0xc2, 0x17, 0xba, 0xba, 0x76, 0x22, 0x59, 0x64, 0xbc, 0x22, 0x18, 0x53, 0x32, 0x97, 0xc4, 0xd8,
+	0x6e, 0x3a, 0xee, 0x35, 0xc7, 0xc9, 0x17, 0x0a, 0x90, 0x6e, 0x24, 0x72, 0xb9, 0xbf, 0x35, 0x8f,
+	0xd8, 0xea, 0x49, 0x44, 0x91, 0xd7, 0xa6, 0xe0, 0x75, 0x85, 0xac, 0xf6, 0xe3, 0xd5, 0xb9, 0xa1,
+	0x0a, 0x77, 0x9e, 0xbd, 0xc8, 0x29, 0xcf, 0x5f, 0xe4, 0x94, 0x5f, 0x5e, 0xe4, 0x94, 0x4f, 0x8f,
+	0x72, 0x03, 0xcf, 0x8f, 0x72, 0x03, 0x3f, 0x1d, 0xe5, 0x06, 0xde, 0x58, 0xaf, 0x9a, 0x4e, 0xad,
+	0x5d, 0xca, 0x97, 0xed, 0x86, 0xe6, 0xd8, 0xbb, 0xcc, 0x32, 0xdf, 0x66, 0xeb, 0xfb, 0x9a, 0xb3,
+	0xbf, 0x5e, 0xae, 0x19, 0xa6, 0xa5, 0xed, 0x5d, 0xd3, 0xa4, 0x15, 0xe7, 0x71, 0x93, 0xf1, 0xd2,
+	0x88, 0xf8, 0xc3, 0xf4, 0xea, 0x9f, 0x03, 0x00, 0xf5, 0xe8, 0xc3, 0x38, 0x1e, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Score queries the current total score of an account (delegator).
+	Score(ctx context.Context, in *QueryScoreRequest, opts ...grpc.CallOption) (*QueryScoreResponse, error)
+	// ScoresSnapshot queries the current total scores of all the accounts at the pinned height.
+	ScoresSnapshot(ctx context.Context, in *QueryScoresSnapshotRequest, opts ...grpc.CallOption) (*QueryScoresSnapshotResponse, error)
+	// ScheduledDistributions queries all future scheduled distributions.
+	ScheduledDistributions(ctx context.Context, in *QueryScheduledDistributionsRequest, opts ...grpc.CallOption) (*QueryScheduledDistributionsResponse, error)
+	// ClearingAccountBalances queries the current balances of all PSE clearing accounts.
+	ClearingAccountBalances(ctx context.Context, in *QueryClearingAccountBalancesRequest, opts ...grpc.CallOption) (*QueryClearingAccountBalancesResponse, error)
+	// ClearingAccountStatus queries the balance of each PSE clearing account reconciled with its remaining scheduled
+	// outflow.
+	ClearingAccountStatus(ctx context.Context, in *QueryClearingAccountStatusRequest, opts ...grpc.CallOption) (*QueryClearingAccountStatusResponse, error)
+	// RemainingUnlocks queries the total amount still scheduled to be distributed from each PSE clearing account.
+	RemainingUnlocks(ctx context.Context, in *QueryRemainingUnlocksRequest, opts ...grpc.CallOption) (*QueryRemainingUnlocksResponse, error)
+	// ScoreCheckpoint queries the score checkpoint recorded at the community distribution by its hash.
+	ScoreCheckpoint(ctx context.Context, in *QueryScoreCheckpointRequest, opts ...grpc.CallOption) (*QueryScoreCheckpointResponse, error)
+	// NamedSchedules queries all the named distribution schedules.
+	NamedSchedules(ctx context.Context, in *QueryNamedSchedulesRequest, opts ...grpc.CallOption) (*QueryNamedSchedulesResponse, error)
+	// NamedSchedule queries the named distribution schedule with the status of its clearing accounts.
+	NamedSchedule(ctx context.Context, in *QueryNamedScheduleRequest, opts ...grpc.CallOption) (*QueryNamedScheduleResponse, error)
+	// DistributionPreference queries the validator the Community distribution payouts of the delegator are
+	// delegated to.
+	DistributionPreference(ctx context.Context, in *QueryDistributionPreferenceRequest, opts ...grpc.CallOption) (*QueryDistributionPreferenceResponse, error)
+	// DistributionOptOuts queries the addresses opted out of the Community distributions.
+	DistributionOptOuts(ctx context.Context, in *QueryDistributionOptOutsRequest, opts ...grpc.CallOption) (*QueryDistributionOptOutsResponse, error)
+	// DistributionOptOut queries whether the address is opted out of the Community distributions.
+	DistributionOptOut(ctx context.Context, in *QueryDistributionOptOutRequest, opts ...grpc.CallOption) (*QueryDistributionOptOutResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Score(ctx context.Context, in *QueryScoreRequest, opts ...grpc.CallOption) (*QueryScoreResponse, error) {
+	out := new(QueryScoreResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/Score", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScoresSnapshot(ctx context.Context, in *QueryScoresSnapshotRequest, opts ...grpc.CallOption) (*QueryScoresSnapshotResponse, error) {
+	out := new(QueryScoresSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/ScoresSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledDistributions(ctx context.Context, in *QueryScheduledDistributionsRequest, opts ...grpc.CallOption) (*QueryScheduledDistributionsResponse, error) {
+	out := new(QueryScheduledDistributionsResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/ScheduledDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClearingAccountBalances(ctx context.Context, in *QueryClearingAccountBalancesRequest, opts ...grpc.CallOption) (*QueryClearingAccountBalancesResponse, error) {
+	out := new(QueryClearingAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/ClearingAccountBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClearingAccountStatus(ctx context.Context, in *QueryClearingAccountStatusRequest, opts ...grpc.CallOption) (*QueryClearingAccountStatusResponse, error) {
+	out := new(QueryClearingAccountStatusResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/ClearingAccountStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemainingUnlocks(ctx context.Context, in *QueryRemainingUnlocksRequest, opts ...grpc.CallOption) (*QueryRemainingUnlocksResponse, error) {
+	out := new(QueryRemainingUnlocksResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/RemainingUnlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScoreCheckpoint(ctx context.Context, in *QueryScoreCheckpointRequest, opts ...grpc.CallOption) (*QueryScoreCheckpointResponse, error) {
+	out := new(QueryScoreCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/ScoreCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamedSchedules(ctx context.Context, in *QueryNamedSchedulesRequest, opts ...grpc.CallOption) (*QueryNamedSchedulesResponse, error) {
+	out := new(QueryNamedSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/NamedSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NamedSchedule(ctx context.Context, in *QueryNamedScheduleRequest, opts ...grpc.CallOption) (*QueryNamedScheduleResponse, error) {
+	out := new(QueryNamedScheduleResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/NamedSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionPreference(ctx context.Context, in *QueryDistributionPreferenceRequest, opts ...grpc.CallOption) (*QueryDistributionPreferenceResponse, error) {
+	out := new(QueryDistributionPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/DistributionPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionOptOuts(ctx context.Context, in *QueryDistributionOptOutsRequest, opts ...grpc.CallOption) (*QueryDistributionOptOutsResponse, error) {
+	out := new(QueryDistributionOptOutsResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/DistributionOptOuts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionOptOut(ctx context.Context, in *QueryDistributionOptOutRequest, opts ...grpc.CallOption) (*QueryDistributionOptOutResponse, error) {
+	out := new(QueryDistributionOptOutResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Query/DistributionOptOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Score queries the current total score of an account (delegator).
+	Score(context.Context, *QueryScoreRequest) (*QueryScoreResponse, error)
+	// ScoresSnapshot queries the current total scores of all the accounts at the pinned height.
+	ScoresSnapshot(context.Context, *QueryScoresSnapshotRequest) (*QueryScoresSnapshotResponse, error)
+	// ScheduledDistributions queries all future scheduled distributions.
+	ScheduledDistributions(context.Context, *QueryScheduledDistributionsRequest) (*QueryScheduledDistributionsResponse, error)
+	// ClearingAccountBalances queries the current balances of all PSE clearing accounts.
+	ClearingAccountBalances(context.Context, *QueryClearingAccountBalancesRequest) (*QueryClearingAccountBalancesResponse, error)
+	// ClearingAccountStatus queries the balance of each PSE clearing account reconciled with its remaining scheduled
+	// outflow.
+	ClearingAccountStatus(context.Context, *QueryClearingAccountStatusRequest) (*QueryClearingAccountStatusResponse, error)
+	// RemainingUnlocks queries the total amount still scheduled to be distributed from each PSE clearing account.
+	RemainingUnlocks(context.Context, *QueryRemainingUnlocksRequest) (*QueryRemainingUnlocksResponse, error)
+	// ScoreCheckpoint queries the score checkpoint recorded at the community distribution by its hash.
+	ScoreCheckpoint(context.Context, *QueryScoreCheckpointRequest) (*QueryScoreCheckpointResponse, error)
+	// NamedSchedules queries all the named distribution schedules.
+	NamedSchedules(context.Context, *QueryNamedSchedulesRequest) (*QueryNamedSchedulesResponse, error)
+	// NamedSchedule queries the named distribution schedule with the status of its clearing accounts.
+	NamedSchedule(context.Context, *QueryNamedScheduleRequest) (*QueryNamedScheduleResponse, error)
+	// DistributionPreference queries the validator the Community distribution payouts of the delegator are
+	// delegated to.
+	DistributionPreference(context.Context, *QueryDistributionPreferenceRequest) (*QueryDistributionPreferenceResponse, error)
+	// DistributionOptOuts queries the addresses opted out of the Community distributions.
+	DistributionOptOuts(context.Context, *QueryDistributionOptOutsRequest) (*QueryDistributionOptOutsResponse, error)
+	// DistributionOptOut queries whether the address is opted out of the Community distributions.
+	DistributionOptOut(context.Context, *QueryDistributionOptOutRequest) (*QueryDistributionOptOutResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Score(ctx context.Context, req *QueryScoreRequest) (*QueryScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Score not implemented")
+}
+func (*UnimplementedQueryServer) ScoresSnapshot(ctx context.Context, req *QueryScoresSnapshotRequest) (*QueryScoresSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScoresSnapshot not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledDistributions(ctx context.Context, req *QueryScheduledDistributionsRequest) (*QueryScheduledDistributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledDistributions not implemented")
+}
+func (*UnimplementedQueryServer) ClearingAccountBalances(ctx context.Context, req *QueryClearingAccountBalancesRequest) (*QueryClearingAccountBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearingAccountBalances not implemented")
+}
+func (*UnimplementedQueryServer) ClearingAccountStatus(ctx context.Context, req *QueryClearingAccountStatusRequest) (*QueryClearingAccountStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearingAccountStatus not implemented")
+}
+func (*UnimplementedQueryServer) RemainingUnlocks(ctx context.Context, req *QueryRemainingUnlocksRequest) (*QueryRemainingUnlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemainingUnlocks not implemented")
+}
+func (*UnimplementedQueryServer) ScoreCheckpoint(ctx context.Context, req *QueryScoreCheckpointRequest) (*QueryScoreCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScoreCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) NamedSchedules(ctx context.Context, req *QueryNamedSchedulesRequest) (*QueryNamedSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamedSchedules not implemented")
+}
+func (*UnimplementedQueryServer) NamedSchedule(ctx context.Context, req *QueryNamedScheduleRequest) (*QueryNamedScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamedSchedule not implemented")
+}
+func (*UnimplementedQueryServer) DistributionPreference(ctx context.Context, req *QueryDistributionPreferenceRequest) (*QueryDistributionPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionPreference not implemented")
+}
+func (*UnimplementedQueryServer) DistributionOptOuts(ctx context.Context, req *QueryDistributionOptOutsRequest) (*QueryDistributionOptOutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionOptOuts not implemented")
+}
+func (*UnimplementedQueryServer) DistributionOptOut(ctx context.Context, req *QueryDistributionOptOutRequest) (*QueryDistributionOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionOptOut not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Score_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Score(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/Score",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Score(ctx, req.(*QueryScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScoresSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScoresSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScoresSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/ScoresSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScoresSnapshot(ctx, req.(*QueryScoresSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledDistributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/ScheduledDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledDistributions(ctx, req.(*QueryScheduledDistributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClearingAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClearingAccountBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClearingAccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/ClearingAccountBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClearingAccountBalances(ctx, req.(*QueryClearingAccountBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClearingAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClearingAccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClearingAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/ClearingAccountStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClearingAccountStatus(ctx, req.(*QueryClearingAccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemainingUnlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingUnlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemainingUnlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/RemainingUnlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemainingUnlocks(ctx, req.(*QueryRemainingUnlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScoreCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScoreCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScoreCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/ScoreCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScoreCheckpoint(ctx, req.(*QueryScoreCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamedSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamedSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamedSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/NamedSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamedSchedules(ctx, req.(*QueryNamedSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NamedSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamedScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamedSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/NamedSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamedSchedule(ctx, req.(*QueryNamedScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/DistributionPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionPreference(ctx, req.(*QueryDistributionPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionOptOuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionOptOutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionOptOuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/DistributionOptOuts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionOptOuts(ctx, req.(*QueryDistributionOptOutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionOptOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Query/DistributionOptOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionOptOut(ctx, req.(*QueryDistributionOptOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tx.pse.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Score",
+			Handler:    _Query_Score_Handler,
+		},
+		{
+			MethodName: "ScoresSnapshot",
+			Handler:    _Query_ScoresSnapshot_Handler,
+		},
+		{
+			MethodName: "ScheduledDistributions",
+			Handler:    _Query_ScheduledDistributions_Handler,
+		},
+		{
+			MethodName: "ClearingAccountBalances",
+			Handler:    _Query_ClearingAccountBalances_Handler,
+		},
+		{
+			MethodName: "ClearingAccountStatus",
+			Handler:    _Query_ClearingAccountStatus_Handler,
+		},
+		{
+			MethodName: "RemainingUnlocks",
+			Handler:    _Query_RemainingUnlocks_Handler,
+		},
+		{
+			MethodName: "ScoreCheckpoint",
+			Handler:    _Query_ScoreCheckpoint_Handler,
+		},
+		{
+			MethodName: "NamedSchedules",
+			Handler:    _Query_NamedSchedules_Handler,
+		},
+		{
+			MethodName: "NamedSchedule",
+			Handler:    _Query_NamedSchedule_Handler,
+		},
+		{
+			MethodName: "DistributionPreference",
+			Handler:    _Query_DistributionPreference_Handler,
+		},
+		{
+			MethodName: "DistributionOptOuts",
+			Handler:    _Query_DistributionOptOuts_Handler,
+		},
+		{
+			MethodName: "DistributionOptOut",
+			Handler:    _Query_DistributionOptOut_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tx/pse/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScoresSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScoresSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScoresSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScoresSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScoresSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScoresSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledDistributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledDistributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledDistributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledDistributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledDistributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledDistributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableDistributions {
+		i--
+		if m.DisableDistributions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ScheduledDistributions) > 0 {
+		for iNdEx := len(m.ScheduledDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClearingAccountBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClearingAccountBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClearingAccountBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearingAccountBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearingAccountBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearingAccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClearingAccount) > 0 {
+		i -= len(m.ClearingAccount)
+		copy(dAtA[i:], m.ClearingAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClearingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClearingAccountBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClearingAccountBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClearingAccountBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClearingAccountStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClearingAccountStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClearingAccountStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearingAccountStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearingAccountStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearingAccountStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Surplus.Size()
+		i -= size
+		if _, err := m.Surplus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ScheduledOutflow.Size()
+		i -= size
+		if _, err := m.ScheduledOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClearingAccount) > 0 {
+		i -= len(m.ClearingAccount)
+		copy(dAtA[i:], m.ClearingAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClearingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClearingAccountStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClearingAccountStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClearingAccountStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingUnlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingUnlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingUnlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingUnlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingUnlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingUnlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingUnlocks) > 0 {
+		for iNdEx := len(m.RemainingUnlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingUnlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScoreCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScoreCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScoreCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScoreCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScoreCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScoreCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamedSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamedSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamedSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamedSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamedSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamedSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamedScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamedScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamedScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamedScheduleClearingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedScheduleClearingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedScheduleClearingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ScheduledOutflow.Size()
+		i -= size
+		if _, err := m.ScheduledOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClearingAccount) > 0 {
+		i -= len(m.ClearingAccount)
+		copy(dAtA[i:], m.ClearingAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClearingAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamedScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamedScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamedScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClearingAccounts) > 0 {
+		for iNdEx := len(m.ClearingAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClearingAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionPreferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionPreferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionPreferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionOptOutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionOptOutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionOptOutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionOptOutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionOptOutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionOptOutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionOptOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionOptOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionOptOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionOptOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionOptOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptedOut {
+		i--
+		if m.OptedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Score.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScoresSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScoresSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledDistributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduledDistributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledDistributions) > 0 {
+		for _, e := range m.ScheduledDistributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DisableDistributions {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryClearingAccountBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ClearingAccountBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClearingAccountBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClearingAccountStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ClearingAccountStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ScheduledOutflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Surplus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClearingAccountStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRemainingUnlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRemainingUnlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemainingUnlocks) > 0 {
+		for _, e := range m.RemainingUnlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScoreCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScoreCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNamedSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNamedSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNamedScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NamedScheduleClearingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.ClearingAccount)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ScheduledOutflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNamedScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClearingAccounts) > 0 {
+		for _, e := range m.ClearingAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDistributionPreferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionOptOutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionOptOutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionOptOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionOptOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OptedOut {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScoreResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScoreResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScoresSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScoresSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScoresSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScoresSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScoresSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScoresSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scores = append(m.Scores, AccountScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledDistributionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledDistributionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledDistributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledDistributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledDistributions = append(m.ScheduledDistributions, ScheduledDistribution{})
+			if err := m.ScheduledDistributions[len(m.ScheduledDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableDistributions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableDistributions = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClearingAccountBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClearingAccountBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClearingAccountBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearingAccountBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearingAccountBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearingAccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClearingAccountBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClearingAccountBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClearingAccountBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, ClearingAccountBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClearingAccountStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClearingAccountStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClearingAccountStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClearingAccountStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearingAccountStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearingAccountStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Surplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClearingAccountStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClearingAccountStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClearingAccountStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, ClearingAccountStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainingUnlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainingUnlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainingUnlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainingUnlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainingUnlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainingUnlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUnlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingUnlocks = append(m.RemainingUnlocks, RemainingUnlock{})
+			if err := m.RemainingUnlocks[len(m.RemainingUnlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScoreCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScoreCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScoreCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScoreCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScoreCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScoreCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamedSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamedSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamedSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNamedSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamedSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamedSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, NamedSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryClearingAccountBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryNamedScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNamedScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNamedScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *NamedScheduleClearingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,25 +5293,157 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamedScheduleClearingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamedScheduleClearingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ScheduledOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1150,7 +5465,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNamedScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1173,15 +5488,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNamedScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNamedScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1208,7 +5523,41 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingAccounts = append(m.ClearingAccounts, NamedScheduleClearingAccount{})
+			if err := m.ClearingAccounts[len(m.ClearingAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1233,7 +5582,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScoreRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionPreferenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1256,15 +5605,15 @@ func (m *QueryScoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionPreferenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionPreferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1292,7 +5641,7 @@ func (m *QueryScoreRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1315,7 +5664,7 @@ func (m *QueryScoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScoreResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionPreferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1338,15 +5687,15 @@ func (m *QueryScoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionPreferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1374,9 +5723,7 @@ func (m *QueryScoreResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1399,7 +5746,7 @@ func (m *QueryScoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledDistributionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionOptOutsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1422,12 +5769,48 @@ func (m *QueryScheduledDistributionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledDistributionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionOptOutsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledDistributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionOptOutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1449,7 +5832,7 @@ func (m *QueryScheduledDistributionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScheduledDistributionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionOptOutsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1472,17 +5855,17 @@ func (m *QueryScheduledDistributionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScheduledDistributionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionOptOutsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScheduledDistributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionOptOutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledDistributions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1492,31 +5875,29 @@ func (m *QueryScheduledDistributionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScheduledDistributions = append(m.ScheduledDistributions, ScheduledDistribution{})
-			if err := m.ScheduledDistributions[len(m.ScheduledDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableDistributions", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1526,62 +5907,28 @@ func (m *QueryScheduledDistributionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableDistributions = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryClearingAccountBalancesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClearingAccountBalancesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClearingAccountBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1603,7 +5950,7 @@ func (m *QueryClearingAccountBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearingAccountBalance) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionOptOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1626,47 +5973,15 @@ func (m *ClearingAccountBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearingAccountBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionOptOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearingAccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionOptOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClearingAccount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClearingAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1694,9 +6009,7 @@ func (m *ClearingAccountBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1719,7 +6032,7 @@ func (m *ClearingAccountBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClearingAccountBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionOptOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1742,17 +6055,17 @@ func (m *QueryClearingAccountBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClearingAccountBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionOptOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClearingAccountBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionOptOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedOut", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1762,26 +6075,12 @@ func (m *QueryClearingAccountBalancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Balances = append(m.Balances, ClearingAccountBalance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.OptedOut = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// Hash returns the sha256 hash of the protobuf encoding of the checkpoint.
+func (m ScoreCheckpoint) Hash() ([]byte, error) {
+	bz, err := m.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// DecodeScoreCheckpointHash decodes the hex encoded hash of the score checkpoint.
+func DecodeScoreCheckpointHash(hash string) ([]byte, error) {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) != sha256.Size {
+		return nil, errorsmod.Wrapf(ErrInvalidInput, "invalid score checkpoint hash %q", hash)
+	}
+	return bz, nil
+}
+
+// Validate validates the score checkpoint.
+func (m ScoreCheckpoint) Validate() error {
+	if m.TotalScore.IsNil() || m.TotalScore.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidInput, "total score of checkpoint %d must not be negative", m.ScheduledAt)
+	}
+	total := sdkmath.ZeroInt()
+	for i, accountScore := range m.Scores {
+		if accountScore.Address == "" {
+			return errorsmod.Wrapf(ErrInvalidInput, "address cannot be empty")
+		}
+		if i > 0 && m.Scores[i-1].Address >= accountScore.Address {
+			return errorsmod.Wrapf(ErrInvalidInput, "scores of checkpoint %d must be sorted by address", m.ScheduledAt)
+		}
+		if accountScore.Score.IsNil() || accountScore.Score.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidInput, "score cannot be negative")
+		}
+		total = total.Add(accountScore.Score)
+	}
+	if !total.Equal(m.TotalScore) {
+		return errorsmod.Wrapf(
+			ErrInvalidInput, "total score of checkpoint %d doesn't match the sum of the scores", m.ScheduledAt,
+		)
+	}
+	return nil
+}
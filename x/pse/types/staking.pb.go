@@ -30,6 +30,9 @@ type DelegationTimeEntry struct {
 	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
 	// last block timestamp where the delegators balance changed.
 	LastChangedUnixSec int64 `protobuf:"varint,2,opt,name=last_changed_unix_sec,json=lastChangedUnixSec,proto3" json:"last_changed_unix_sec,omitempty"`
+	// block timestamp since which the delegation is held continuously, it is kept when the delegation changes.
+	// Zero means the delegation is held since before the timestamp was tracked.
+	DelegatedSinceUnixSec int64 `protobuf:"varint,3,opt,name=delegated_since_unix_sec,json=delegatedSinceUnixSec,proto3" json:"delegated_since_unix_sec,omitempty"`
 }
 
 func (m *DelegationTimeEntry) Reset()         { *m = DelegationTimeEntry{} }
@@ -72,26 +75,28 @@ func init() {
 func init() { proto.RegisterFile("tx/pse/v1/staking.proto", fileDescriptor_36586b90c03866cb) }
 
 var fileDescriptor_36586b90c03866cb = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x2c, 0x90, 0xb1, 0x4a, 0x33, 0x41,
-	0x10, 0xc7, 0x6f, 0xbf, 0x0f, 0x82, 0xb9, 0xf2, 0x54, 0x8c, 0x11, 0xee, 0x82, 0x55, 0x9a, 0xdb,
-	0xe5, 0xb0, 0x10, 0x2c, 0x63, 0x44, 0x04, 0xab, 0xa8, 0x8d, 0xcd, 0xb1, 0xd9, 0x0c, 0x7b, 0x4b,
-	0x72, 0xbb, 0x47, 0x76, 0x12, 0x36, 0x3e, 0x81, 0xa5, 0x2f, 0x20, 0xe4, 0x21, 0x7c, 0x88, 0x94,
-	0xc1, 0x4a, 0x2c, 0x82, 0x24, 0x8d, 0x8f, 0x21, 0xc9, 0xa6, 0x9b, 0x99, 0xdf, 0xcc, 0x0f, 0xe6,
-	0x1f, 0x9e, 0xa0, 0x63, 0x95, 0x05, 0x36, 0xcd, 0x98, 0x45, 0x3e, 0x54, 0x5a, 0xd2, 0x6a, 0x6c,
-	0xd0, 0x44, 0x75, 0x74, 0xb4, 0xb2, 0x40, 0xa7, 0x59, 0xf3, 0x48, 0x1a, 0x69, 0x76, 0x53, 0xb6,
-	0xad, 0xfc, 0x42, 0xf3, 0x54, 0x18, 0x5b, 0x1a, 0x9b, 0x7b, 0xe0, 0x1b, 0x8f, 0xce, 0xdf, 0x49,
-	0x78, 0xd8, 0x85, 0x11, 0x48, 0x8e, 0xca, 0xe8, 0x47, 0x55, 0xc2, 0x8d, 0xc6, 0xf1, 0x2c, 0xba,
-	0x0b, 0x6b, 0xb6, 0xe0, 0x63, 0xb0, 0x0d, 0xd2, 0x22, 0xed, 0x7a, 0x27, 0x5b, 0xac, 0x92, 0xe0,
-	0x7b, 0x95, 0x9c, 0xf9, 0x6b, 0x3b, 0x18, 0x52, 0x65, 0x58, 0xc9, 0xb1, 0xa0, 0xf7, 0x20, 0xb9,
-	0x98, 0x75, 0x41, 0x7c, 0x7e, 0xa4, 0xe1, 0x5e, 0xde, 0x05, 0xd1, 0xdb, 0x0b, 0xa2, 0x2c, 0x3c,
-	0x1e, 0x71, 0x8b, 0xb9, 0x28, 0xb8, 0x96, 0x30, 0xc8, 0x27, 0x5a, 0xb9, 0xdc, 0x82, 0x68, 0xfc,
-	0x6b, 0x91, 0xf6, 0xff, 0x5e, 0xb4, 0x85, 0xd7, 0x9e, 0x3d, 0x69, 0xe5, 0x1e, 0x40, 0x5c, 0x1d,
-	0xbc, 0xce, 0x93, 0xe0, 0x77, 0x9e, 0x04, 0x9d, 0xdb, 0xc5, 0x3a, 0x26, 0xcb, 0x75, 0x4c, 0x7e,
-	0xd6, 0x31, 0x79, 0xdb, 0xc4, 0xc1, 0x72, 0x13, 0x07, 0x5f, 0x9b, 0x38, 0x78, 0x4e, 0xa5, 0xc2,
-	0x62, 0xd2, 0xa7, 0xc2, 0x94, 0x0c, 0xcd, 0x10, 0xb4, 0x7a, 0x81, 0xd4, 0x31, 0x74, 0xa9, 0x28,
-	0xb8, 0xd2, 0x6c, 0x7a, 0xc9, 0x7c, 0x5e, 0x38, 0xab, 0xc0, 0xf6, 0x6b, 0xbb, 0x7f, 0x2f, 0xfe,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x62, 0x8b, 0xd8, 0x88, 0x46, 0x01, 0x00, 0x00,
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0x2a, 0x41,
+	0x14, 0x86, 0x77, 0x2e, 0x09, 0xb9, 0x6c, 0xb9, 0x4a, 0x44, 0x4c, 0x76, 0x89, 0x15, 0xcd, 0xee,
+	0x64, 0x63, 0x41, 0x62, 0x89, 0x18, 0x63, 0x62, 0x05, 0xda, 0xd8, 0x6c, 0x86, 0xd9, 0x93, 0xd9,
+	0x09, 0xec, 0xcc, 0x86, 0x39, 0x90, 0xc5, 0x27, 0xb0, 0xf4, 0x11, 0x78, 0x08, 0x1f, 0x82, 0x92,
+	0x58, 0x18, 0x63, 0x41, 0x0c, 0x34, 0x3e, 0x86, 0x81, 0x21, 0xda, 0xcd, 0x9c, 0xef, 0x7c, 0x7f,
+	0x4e, 0x7e, 0xf7, 0x04, 0x4b, 0x5a, 0x18, 0xa0, 0xb3, 0x98, 0x1a, 0x64, 0x23, 0xa9, 0x44, 0x54,
+	0x4c, 0x34, 0x6a, 0xaf, 0x86, 0x65, 0x54, 0x18, 0x88, 0x66, 0x71, 0xf3, 0x58, 0x68, 0xa1, 0xf7,
+	0x53, 0xba, 0x7b, 0xd9, 0x85, 0xe6, 0x29, 0xd7, 0x26, 0xd7, 0x26, 0xb1, 0xc0, 0x7e, 0x2c, 0x3a,
+	0x7f, 0x27, 0xee, 0x51, 0x0f, 0xc6, 0x20, 0x18, 0x4a, 0xad, 0xee, 0x65, 0x0e, 0xd7, 0x0a, 0x27,
+	0x73, 0xef, 0xd6, 0xad, 0x9a, 0x8c, 0x4d, 0xc0, 0x34, 0x48, 0x8b, 0xb4, 0x6b, 0xdd, 0x78, 0xb9,
+	0x0e, 0x9c, 0xcf, 0x75, 0x70, 0x66, 0x6d, 0x93, 0x8e, 0x22, 0xa9, 0x69, 0xce, 0x30, 0x8b, 0xee,
+	0x40, 0x30, 0x3e, 0xef, 0x01, 0x7f, 0x7b, 0x0d, 0xdd, 0x43, 0x78, 0x0f, 0x78, 0xff, 0x10, 0xe0,
+	0xc5, 0x6e, 0x7d, 0xcc, 0x0c, 0x26, 0x3c, 0x63, 0x4a, 0x40, 0x9a, 0x4c, 0x95, 0x2c, 0x13, 0x03,
+	0xbc, 0xf1, 0xaf, 0x45, 0xda, 0x95, 0xbe, 0xb7, 0x83, 0x57, 0x96, 0x3d, 0x28, 0x59, 0x0e, 0x80,
+	0x7b, 0x1d, 0xb7, 0x91, 0xda, 0xa3, 0x20, 0x4d, 0x8c, 0x54, 0x1c, 0xfe, 0xac, 0xca, 0xde, 0xaa,
+	0xff, 0xf2, 0xc1, 0x0e, 0x1f, 0xc4, 0xcb, 0xff, 0xcf, 0x8b, 0xc0, 0xf9, 0x5e, 0x04, 0x4e, 0xf7,
+	0x66, 0xb9, 0xf1, 0xc9, 0x6a, 0xe3, 0x93, 0xaf, 0x8d, 0x4f, 0x5e, 0xb6, 0xbe, 0xb3, 0xda, 0xfa,
+	0xce, 0xc7, 0xd6, 0x77, 0x1e, 0x43, 0x21, 0x31, 0x9b, 0x0e, 0x23, 0xae, 0x73, 0x8a, 0x7a, 0x04,
+	0x4a, 0x3e, 0x41, 0x58, 0x52, 0x2c, 0x43, 0x9e, 0x31, 0xa9, 0xe8, 0xac, 0x43, 0x6d, 0xd1, 0x38,
+	0x2f, 0xc0, 0x0c, 0xab, 0xfb, 0xa2, 0x2e, 0x7e, 0x06, 0x00, 0xd0, 0x1f, 0x20, 0xf5, 0x7f, 0x01,
+	0x00, 0x00,
 }
 
 func (m *DelegationTimeEntry) Marshal() (dAtA []byte, err error) {
@@ -114,6 +119,11 @@ func (m *DelegationTimeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegatedSinceUnixSec != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.DelegatedSinceUnixSec))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.LastChangedUnixSec != 0 {
 		i = encodeVarintStaking(dAtA, i, uint64(m.LastChangedUnixSec))
 		i--
@@ -154,6 +164,9 @@ func (m *DelegationTimeEntry) Size() (n int) {
 	if m.LastChangedUnixSec != 0 {
 		n += 1 + sovStaking(uint64(m.LastChangedUnixSec))
 	}
+	if m.DelegatedSinceUnixSec != 0 {
+		n += 1 + sovStaking(uint64(m.DelegatedSinceUnixSec))
+	}
 	return n
 }
 
@@ -245,6 +258,25 @@ func (m *DelegationTimeEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedSinceUnixSec", wireType)
+			}
+			m.DelegatedSinceUnixSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegatedSinceUnixSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Enabled returns true if the fast clock is enabled.
+func (t TimeScale) Enabled() bool {
+	return t.Divisor != 0
+}
+
+// Validate validates the fast clock.
+func (t TimeScale) Validate() error {
+	if !t.Enabled() {
+		return nil
+	}
+	if t.Divisor == 1 {
+		return errorsmod.Wrap(ErrInvalidInput, "time scale divisor must be greater than 1")
+	}
+	if t.Origin == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "time scale origin must be set")
+	}
+
+	return nil
+}
@@ -5,25 +5,31 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -254,21 +260,30 @@ func (m *MsgUpdateDistributionSchedule) GetSchedule() []ScheduledDistribution {
 	return nil
 }
 
-type EmptyResponse struct {
+// MsgFundDistribution escrows additional coins against a scheduled distribution period.
+// The escrowed coins are distributed together with the Community allocation of that period.
+// If the period is removed from the schedule via governance, the escrowed coins are refunded to the sender.
+type MsgFundDistribution struct {
+	// sender is the address funding the distribution.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// period_timestamp is the timestamp of the scheduled distribution to fund.
+	PeriodTimestamp uint64 `protobuf:"varint,2,opt,name=period_timestamp,json=periodTimestamp,proto3" json:"period_timestamp,omitempty" yaml:"period_timestamp"`
+	// amount is the amount to add to the Community distribution of the period, it must be in the bond denom.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
-func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
-func (*EmptyResponse) ProtoMessage()    {}
-func (*EmptyResponse) Descriptor() ([]byte, []int) {
+func (m *MsgFundDistribution) Reset()         { *m = MsgFundDistribution{} }
+func (m *MsgFundDistribution) String() string { return proto.CompactTextString(m) }
+func (*MsgFundDistribution) ProtoMessage()    {}
+func (*MsgFundDistribution) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7fbcd921f59054cd, []int{4}
 }
-func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFundDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFundDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EmptyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFundDistribution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -278,558 +293,3154 @@ func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EmptyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EmptyResponse.Merge(m, src)
+func (m *MsgFundDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundDistribution.Merge(m, src)
 }
-func (m *EmptyResponse) XXX_Size() int {
+func (m *MsgFundDistribution) XXX_Size() int {
 	return m.Size()
 }
-func (m *EmptyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EmptyResponse.DiscardUnknown(m)
+func (m *MsgFundDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundDistribution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFundDistribution proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgDisableDistributions)(nil), "tx.pse.v1.MsgDisableDistributions")
-	proto.RegisterType((*MsgUpdateExcludedAddresses)(nil), "tx.pse.v1.MsgUpdateExcludedAddresses")
-	proto.RegisterType((*MsgUpdateClearingAccountMappings)(nil), "tx.pse.v1.MsgUpdateClearingAccountMappings")
-	proto.RegisterType((*MsgUpdateDistributionSchedule)(nil), "tx.pse.v1.MsgUpdateDistributionSchedule")
-	proto.RegisterType((*EmptyResponse)(nil), "tx.pse.v1.EmptyResponse")
+func (m *MsgFundDistribution) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("tx/pse/v1/tx.proto", fileDescriptor_7fbcd921f59054cd) }
-
-var fileDescriptor_7fbcd921f59054cd = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x31, 0x6f, 0xda, 0x40,
-	0x18, 0x86, 0x71, 0x50, 0xab, 0x70, 0x55, 0x9b, 0xc6, 0x45, 0x82, 0xba, 0x8d, 0x21, 0x56, 0x2b,
-	0x21, 0x2a, 0x6c, 0x91, 0x54, 0x89, 0xc4, 0x06, 0x4d, 0xd4, 0x89, 0x85, 0x24, 0x1d, 0x22, 0x55,
-	0xd4, 0xf8, 0x2e, 0xe6, 0x54, 0xec, 0xb3, 0x7c, 0x67, 0x04, 0x9d, 0xda, 0x4e, 0x55, 0xa7, 0xfe,
-	0x14, 0x86, 0xfe, 0x88, 0x4c, 0x55, 0xd4, 0xa9, 0x13, 0xaa, 0xa0, 0x12, 0x43, 0x37, 0x7e, 0x41,
-	0x84, 0x6d, 0x8c, 0x09, 0x36, 0x03, 0x0b, 0xba, 0xe3, 0x7b, 0xef, 0x7d, 0xee, 0xfd, 0xf8, 0x38,
-	0xc0, 0xb3, 0x9e, 0x62, 0x51, 0xa4, 0x74, 0xcb, 0x0a, 0xeb, 0xc9, 0x96, 0x4d, 0x18, 0xe1, 0x53,
-	0xb3, 0x15, 0x45, 0x72, 0xb7, 0x2c, 0xec, 0xaa, 0x06, 0x36, 0x89, 0xe2, 0x7e, 0x7a, 0x55, 0x21,
-	0xad, 0x13, 0x9d, 0xb8, 0x4b, 0x65, 0xb6, 0xf2, 0xbf, 0x7d, 0xaa, 0x11, 0x6a, 0x10, 0xda, 0xf4,
-	0x0a, 0xde, 0xc6, 0x2f, 0x65, 0xbc, 0x9d, 0x62, 0x50, 0x7d, 0x86, 0x31, 0xa8, 0xee, 0x17, 0x9e,
-	0x2f, 0xd8, 0x10, 0x53, 0x66, 0xe3, 0x96, 0xc3, 0x30, 0x31, 0xbd, 0xaa, 0xf4, 0x85, 0x03, 0x99,
-	0x3a, 0xd5, 0x4f, 0x30, 0x55, 0x5b, 0x1d, 0x74, 0x12, 0x12, 0x50, 0xfe, 0x08, 0xa4, 0x54, 0x87,
-	0xb5, 0x89, 0x8d, 0x59, 0x3f, 0xcb, 0xe5, 0xb9, 0x42, 0xaa, 0x96, 0xfd, 0xfd, 0xb3, 0x94, 0xf6,
-	0xb9, 0x55, 0x08, 0x6d, 0x44, 0xe9, 0x19, 0xb3, 0xb1, 0xa9, 0x37, 0x16, 0xd2, 0x8a, 0xfc, 0x75,
-	0x32, 0x28, 0x2e, 0xf6, 0xdf, 0x27, 0x83, 0xe2, 0xb3, 0xd9, 0x0d, 0x62, 0x38, 0xd2, 0xaf, 0x2d,
-	0x20, 0xd4, 0xa9, 0x7e, 0x61, 0x41, 0x95, 0xa1, 0xd3, 0x9e, 0xd6, 0x71, 0x20, 0x82, 0xbe, 0x3b,
-	0xda, 0xf8, 0x1a, 0xfc, 0x7b, 0xf0, 0x58, 0x9d, 0x9b, 0x34, 0x19, 0x69, 0xaa, 0x10, 0x66, 0xb7,
-	0xf2, 0xc9, 0x42, 0xaa, 0x76, 0x38, 0x1d, 0xe6, 0x32, 0x7d, 0xd5, 0xe8, 0x54, 0xa4, 0xbb, 0x0a,
-	0x29, 0xd6, 0xf9, 0x51, 0x20, 0x3d, 0x27, 0x55, 0x08, 0xf9, 0x2b, 0xf0, 0x64, 0xe9, 0xb0, 0x8d,
-	0x0c, 0xd2, 0x45, 0xd9, 0xa4, 0x4b, 0x38, 0x9a, 0x0e, 0x73, 0x42, 0x04, 0xc1, 0x13, 0xc5, 0x43,
-	0x76, 0x43, 0x90, 0x86, 0xab, 0xad, 0x94, 0x57, 0xbb, 0x29, 0xfa, 0xdd, 0x8c, 0xe9, 0x98, 0xf4,
-	0x9f, 0x03, 0xf9, 0xa0, 0xfc, 0xa6, 0x83, 0xd4, 0x99, 0x77, 0x55, 0xd3, 0x88, 0x63, 0xb2, 0xba,
-	0x6a, 0x59, 0xd8, 0xd4, 0x37, 0x6f, 0xeb, 0x3b, 0xb0, 0x6d, 0xf8, 0x1e, 0x6e, 0x3b, 0x1f, 0x1c,
-	0xec, 0xcb, 0xc1, 0x28, 0xcb, 0xd1, 0xb4, 0x5a, 0xe6, 0x7a, 0x98, 0x4b, 0x4c, 0x87, 0xb9, 0x1d,
-	0xaf, 0x27, 0x73, 0x03, 0xa9, 0x11, 0x78, 0x55, 0x8e, 0x57, 0x73, 0xbe, 0x58, 0xca, 0x19, 0x13,
-	0x44, 0xfa, 0xc7, 0x81, 0xbd, 0x40, 0x14, 0x9e, 0xac, 0x33, 0xad, 0x8d, 0xa0, 0xd3, 0x41, 0x1b,
-	0x47, 0xbd, 0x00, 0xdb, 0xd4, 0xf7, 0xf0, 0xa3, 0xe6, 0x43, 0x51, 0xe7, 0xf6, 0x30, 0xcc, 0xbc,
-	0x9b, 0x74, 0x7e, 0x5e, 0x6a, 0x04, 0x56, 0x95, 0xd7, 0xab, 0x49, 0xf7, 0x97, 0x92, 0x46, 0x85,
-	0x90, 0x76, 0xc0, 0xc3, 0x53, 0xc3, 0x62, 0xfd, 0x06, 0xa2, 0x16, 0x31, 0x29, 0x3a, 0xf8, 0x96,
-	0x04, 0xc9, 0x3a, 0xd5, 0xf9, 0x4b, 0x90, 0x89, 0xfb, 0xeb, 0xbc, 0x0c, 0x5d, 0x37, 0x7e, 0x5e,
-	0x84, 0x6c, 0x48, 0xb6, 0xc4, 0xe0, 0xaf, 0xc0, 0xde, 0xfa, 0x29, 0x7a, 0x15, 0x45, 0x88, 0x11,
-	0xaf, 0xe1, 0x7c, 0x00, 0xc2, 0x9a, 0xdf, 0xaf, 0x10, 0x05, 0x89, 0x52, 0xae, 0x21, 0x9c, 0x83,
-	0x74, 0xe4, 0x23, 0x27, 0x2d, 0x7b, 0x47, 0x69, 0xe2, 0x5d, 0x85, 0x7b, 0x9f, 0x27, 0x83, 0x22,
-	0x57, 0x7b, 0x7b, 0x3d, 0x12, 0xb9, 0x9b, 0x91, 0xc8, 0xfd, 0x1d, 0x89, 0xdc, 0x8f, 0xb1, 0x98,
-	0xb8, 0x19, 0x8b, 0x89, 0x3f, 0x63, 0x31, 0x71, 0x59, 0xd2, 0x31, 0x6b, 0x3b, 0x2d, 0x59, 0x23,
-	0x86, 0xc2, 0xc8, 0x47, 0x64, 0xe2, 0x4f, 0xa8, 0xd4, 0x53, 0x58, 0xaf, 0xa4, 0xb5, 0x55, 0x6c,
-	0x2a, 0xdd, 0x63, 0xc5, 0x7b, 0x9e, 0x59, 0xdf, 0x42, 0xb4, 0x75, 0xdf, 0x7d, 0x95, 0x0f, 0x6f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x96, 0xa7, 0x0d, 0x6a, 0x31, 0x06, 0x00, 0x00,
+func (m *MsgFundDistribution) GetPeriodTimestamp() uint64 {
+	if m != nil {
+		return m.PeriodTimestamp
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgFundDistribution) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgUpdateMinDelegationAmount is a governance operation to update the minimum delegation amount accruing the score.
+// The delegation time entries below the new amount are removed, so they stop accruing the score immediately.
+type MsgUpdateMinDelegationAmount struct {
+	// authority is the address authorized to update the amount (governance module address).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// min_delegation_amount is the new minimum delegation amount, zero disables the threshold.
+	MinDelegationAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_delegation_amount"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateExcludedAddresses is a governance operation to update the list of excluded addresses.
-	UpdateExcludedAddresses(ctx context.Context, in *MsgUpdateExcludedAddresses, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// UpdateClearingAccountMappings is a governance operation to update clearing account to recipient mappings.
-	UpdateClearingAccountMappings(ctx context.Context, in *MsgUpdateClearingAccountMappings, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// UpdateDistributionSchedule is a governance operation to update the distribution schedule.
-	UpdateDistributionSchedule(ctx context.Context, in *MsgUpdateDistributionSchedule, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// DisableDistributions is a governance operation to disable distributions.
-	DisableDistributions(ctx context.Context, in *MsgDisableDistributions, opts ...grpc.CallOption) (*EmptyResponse, error)
+func (m *MsgUpdateMinDelegationAmount) Reset()         { *m = MsgUpdateMinDelegationAmount{} }
+func (m *MsgUpdateMinDelegationAmount) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinDelegationAmount) ProtoMessage()    {}
+func (*MsgUpdateMinDelegationAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{5}
+}
+func (m *MsgUpdateMinDelegationAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinDelegationAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinDelegationAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMinDelegationAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinDelegationAmount.Merge(m, src)
+}
+func (m *MsgUpdateMinDelegationAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinDelegationAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinDelegationAmount.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgUpdateMinDelegationAmount proto.InternalMessageInfo
+
+func (m *MsgUpdateMinDelegationAmount) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgUpdateSlashingPenalty is a governance operation to update the multiplier of the score penalty
+// applied to the delegators of the slashed validators.
+type MsgUpdateSlashingPenalty struct {
+	// authority is the address authorized to update the multiplier (governance module address).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// slashing_score_penalty_multiplier is the new multiplier, zero disables the penalty.
+	SlashingScorePenaltyMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slashing_score_penalty_multiplier,json=slashingScorePenaltyMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_score_penalty_multiplier"`
 }
 
-func (c *msgClient) UpdateExcludedAddresses(ctx context.Context, in *MsgUpdateExcludedAddresses, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateExcludedAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSlashingPenalty) Reset()         { *m = MsgUpdateSlashingPenalty{} }
+func (m *MsgUpdateSlashingPenalty) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSlashingPenalty) ProtoMessage()    {}
+func (*MsgUpdateSlashingPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{6}
+}
+func (m *MsgUpdateSlashingPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSlashingPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSlashingPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateSlashingPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSlashingPenalty.Merge(m, src)
+}
+func (m *MsgUpdateSlashingPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSlashingPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSlashingPenalty.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateClearingAccountMappings(ctx context.Context, in *MsgUpdateClearingAccountMappings, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateClearingAccountMappings", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateSlashingPenalty proto.InternalMessageInfo
+
+func (m *MsgUpdateSlashingPenalty) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateDistributionSchedule(ctx context.Context, in *MsgUpdateDistributionSchedule, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateDistributionSchedule", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgUpdateMinDelegationDuration is a governance operation to update the minimum duration of the continuous
+// delegation required for the score to count toward the community distribution.
+type MsgUpdateMinDelegationDuration struct {
+	// authority is the address authorized to update the duration (governance module address).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// min_delegation_duration is the new duration, zero disables the requirement.
+	MinDelegationDuration time.Duration `protobuf:"bytes,2,opt,name=min_delegation_duration,json=minDelegationDuration,proto3,stdduration" json:"min_delegation_duration"`
+}
+
+func (m *MsgUpdateMinDelegationDuration) Reset()         { *m = MsgUpdateMinDelegationDuration{} }
+func (m *MsgUpdateMinDelegationDuration) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinDelegationDuration) ProtoMessage()    {}
+func (*MsgUpdateMinDelegationDuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{7}
+}
+func (m *MsgUpdateMinDelegationDuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinDelegationDuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinDelegationDuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateMinDelegationDuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinDelegationDuration.Merge(m, src)
+}
+func (m *MsgUpdateMinDelegationDuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinDelegationDuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinDelegationDuration.DiscardUnknown(m)
 }
 
-func (c *msgClient) DisableDistributions(ctx context.Context, in *MsgDisableDistributions, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/DisableDistributions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateMinDelegationDuration proto.InternalMessageInfo
+
+func (m *MsgUpdateMinDelegationDuration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateExcludedAddresses is a governance operation to update the list of excluded addresses.
-	UpdateExcludedAddresses(context.Context, *MsgUpdateExcludedAddresses) (*EmptyResponse, error)
-	// UpdateClearingAccountMappings is a governance operation to update clearing account to recipient mappings.
-	UpdateClearingAccountMappings(context.Context, *MsgUpdateClearingAccountMappings) (*EmptyResponse, error)
-	// UpdateDistributionSchedule is a governance operation to update the distribution schedule.
-	UpdateDistributionSchedule(context.Context, *MsgUpdateDistributionSchedule) (*EmptyResponse, error)
-	// DisableDistributions is a governance operation to disable distributions.
-	DisableDistributions(context.Context, *MsgDisableDistributions) (*EmptyResponse, error)
+func (m *MsgUpdateMinDelegationDuration) GetMinDelegationDuration() time.Duration {
+	if m != nil {
+		return m.MinDelegationDuration
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSetNamedSchedule is a governance operation to create or replace the named distribution schedule.
+// The replaced schedule is enabled again. The remaining balances of the clearing accounts removed from the schedule
+// are sent to the community pool.
+type MsgSetNamedSchedule struct {
+	// authority is the address authorized to set the schedule (governance module address).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// schedule is the named schedule to create or replace.
+	Schedule NamedSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
 }
 
-func (*UnimplementedMsgServer) UpdateExcludedAddresses(ctx context.Context, req *MsgUpdateExcludedAddresses) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateExcludedAddresses not implemented")
+func (m *MsgSetNamedSchedule) Reset()         { *m = MsgSetNamedSchedule{} }
+func (m *MsgSetNamedSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetNamedSchedule) ProtoMessage()    {}
+func (*MsgSetNamedSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{8}
 }
-func (*UnimplementedMsgServer) UpdateClearingAccountMappings(ctx context.Context, req *MsgUpdateClearingAccountMappings) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClearingAccountMappings not implemented")
+func (m *MsgSetNamedSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateDistributionSchedule(ctx context.Context, req *MsgUpdateDistributionSchedule) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDistributionSchedule not implemented")
+func (m *MsgSetNamedSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetNamedSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DisableDistributions(ctx context.Context, req *MsgDisableDistributions) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableDistributions not implemented")
+func (m *MsgSetNamedSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetNamedSchedule.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetNamedSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetNamedSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetNamedSchedule.DiscardUnknown(m)
 }
 
-func _Msg_UpdateExcludedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateExcludedAddresses)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateExcludedAddresses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tx.pse.v1.Msg/UpdateExcludedAddresses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateExcludedAddresses(ctx, req.(*MsgUpdateExcludedAddresses))
+var xxx_messageInfo_MsgSetNamedSchedule proto.InternalMessageInfo
+
+func (m *MsgSetNamedSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateClearingAccountMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClearingAccountMappings)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateClearingAccountMappings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tx.pse.v1.Msg/UpdateClearingAccountMappings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClearingAccountMappings(ctx, req.(*MsgUpdateClearingAccountMappings))
+func (m *MsgSetNamedSchedule) GetSchedule() NamedSchedule {
+	if m != nil {
+		return m.Schedule
 	}
-	return interceptor(ctx, in, info, handler)
+	return NamedSchedule{}
 }
 
-func _Msg_UpdateDistributionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDistributionSchedule)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDistributionSchedule(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tx.pse.v1.Msg/UpdateDistributionSchedule",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDistributionSchedule(ctx, req.(*MsgUpdateDistributionSchedule))
+// MsgRemoveNamedSchedule is a governance operation to remove the named distribution schedule.
+// The remaining balances of the clearing accounts of the schedule are sent to the community pool.
+type MsgRemoveNamedSchedule struct {
+	// authority is the address authorized to remove the schedule (governance module address).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the schedule to remove.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgRemoveNamedSchedule) Reset()         { *m = MsgRemoveNamedSchedule{} }
+func (m *MsgRemoveNamedSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveNamedSchedule) ProtoMessage()    {}
+func (*MsgRemoveNamedSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{9}
+}
+func (m *MsgRemoveNamedSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveNamedSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveNamedSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveNamedSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveNamedSchedule.Merge(m, src)
+}
+func (m *MsgRemoveNamedSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveNamedSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveNamedSchedule.DiscardUnknown(m)
 }
 
-func _Msg_DisableDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisableDistributions)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveNamedSchedule proto.InternalMessageInfo
+
+func (m *MsgRemoveNamedSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DisableDistributions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tx.pse.v1.Msg/DisableDistributions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DisableDistributions(ctx, req.(*MsgDisableDistributions))
+	return ""
+}
+
+func (m *MsgRemoveNamedSchedule) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tx.pse.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateExcludedAddresses",
-			Handler:    _Msg_UpdateExcludedAddresses_Handler,
-		},
-		{
-			MethodName: "UpdateClearingAccountMappings",
-			Handler:    _Msg_UpdateClearingAccountMappings_Handler,
-		},
-		{
-			MethodName: "UpdateDistributionSchedule",
-			Handler:    _Msg_UpdateDistributionSchedule_Handler,
-		},
-		{
-			MethodName: "DisableDistributions",
-			Handler:    _Msg_DisableDistributions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tx/pse/v1/tx.proto",
+// MsgSetDistributionPreference sets the validator the Community distribution payouts of the delegator are delegated
+// to. By default, the payouts are delegated to the validators of the delegator proportionally to the delegations.
+type MsgSetDistributionPreference struct {
+	// delegator_address is the address of the delegator receiving the payouts.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator the payouts are delegated to, empty to clear the preference.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *MsgDisableDistributions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetDistributionPreference) Reset()         { *m = MsgSetDistributionPreference{} }
+func (m *MsgSetDistributionPreference) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDistributionPreference) ProtoMessage()    {}
+func (*MsgSetDistributionPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{10}
+}
+func (m *MsgSetDistributionPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDistributionPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDistributionPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDisableDistributions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetDistributionPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDistributionPreference.Merge(m, src)
+}
+func (m *MsgSetDistributionPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDistributionPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDistributionPreference.DiscardUnknown(m)
 }
 
-func (m *MsgDisableDistributions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSetDistributionPreference proto.InternalMessageInfo
+
+func (m *MsgSetDistributionPreference) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateExcludedAddresses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetDistributionPreference) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateExcludedAddresses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReallocateClearingFunds is a governance operation to move the share of the balance and of the remaining
+// scheduled allocations from one clearing account to another. The balance and the schedule are updated atomically.
+// The amounts escrowed by the distribution fundings are never moved from the Community clearing account.
+type MsgReallocateClearingFunds struct {
+	// authority is the address authorized to reallocate the funds (governance module address).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// from_clearing_account is the clearing account the funds are moved from.
+	FromClearingAccount string `protobuf:"bytes,2,opt,name=from_clearing_account,json=fromClearingAccount,proto3" json:"from_clearing_account,omitempty"`
+	// to_clearing_account is the clearing account the funds are moved to.
+	ToClearingAccount string `protobuf:"bytes,3,opt,name=to_clearing_account,json=toClearingAccount,proto3" json:"to_clearing_account,omitempty"`
+	// share is the part of the balance and of each remaining scheduled allocation which is moved, in (0, 1].
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
 }
 
-func (m *MsgUpdateExcludedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddressesToRemove) > 0 {
-		for iNdEx := len(m.AddressesToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddressesToRemove[iNdEx])
-			copy(dAtA[i:], m.AddressesToRemove[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AddressesToRemove[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgReallocateClearingFunds) Reset()         { *m = MsgReallocateClearingFunds{} }
+func (m *MsgReallocateClearingFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgReallocateClearingFunds) ProtoMessage()    {}
+func (*MsgReallocateClearingFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{11}
+}
+func (m *MsgReallocateClearingFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReallocateClearingFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReallocateClearingFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AddressesToAdd) > 0 {
-		for iNdEx := len(m.AddressesToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddressesToAdd[iNdEx])
-			copy(dAtA[i:], m.AddressesToAdd[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AddressesToAdd[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+}
+func (m *MsgReallocateClearingFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReallocateClearingFunds.Merge(m, src)
+}
+func (m *MsgReallocateClearingFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReallocateClearingFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReallocateClearingFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReallocateClearingFunds proto.InternalMessageInfo
+
+func (m *MsgReallocateClearingFunds) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgReallocateClearingFunds) GetFromClearingAccount() string {
+	if m != nil {
+		return m.FromClearingAccount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateClearingAccountMappings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReallocateClearingFunds) GetToClearingAccount() string {
+	if m != nil {
+		return m.ToClearingAccount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateClearingAccountMappings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOptOutDistributions excludes the sender from the Community distributions. The score of the sender is still
+// accrued, but its share of each distribution is sent to the community pool instead of being paid out.
+type MsgOptOutDistributions struct {
+	// address is the address opting out of the distributions.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgUpdateClearingAccountMappings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Mappings) > 0 {
-		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgOptOutDistributions) Reset()         { *m = MsgOptOutDistributions{} }
+func (m *MsgOptOutDistributions) String() string { return proto.CompactTextString(m) }
+func (*MsgOptOutDistributions) ProtoMessage()    {}
+func (*MsgOptOutDistributions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{12}
+}
+func (m *MsgOptOutDistributions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOptOutDistributions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOptOutDistributions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgOptOutDistributions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOptOutDistributions.Merge(m, src)
+}
+func (m *MsgOptOutDistributions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOptOutDistributions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOptOutDistributions.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDistributionSchedule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOptOutDistributions proto.InternalMessageInfo
+
+func (m *MsgOptOutDistributions) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateDistributionSchedule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOptInDistributions includes the sender, which opted out before, in the Community distributions again.
+type MsgOptInDistributions struct {
+	// address is the address opting in to the distributions.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgUpdateDistributionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Schedule) > 0 {
-		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgOptInDistributions) Reset()         { *m = MsgOptInDistributions{} }
+func (m *MsgOptInDistributions) String() string { return proto.CompactTextString(m) }
+func (*MsgOptInDistributions) ProtoMessage()    {}
+func (*MsgOptInDistributions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{13}
+}
+func (m *MsgOptInDistributions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOptInDistributions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOptInDistributions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgOptInDistributions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOptInDistributions.Merge(m, src)
+}
+func (m *MsgOptInDistributions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOptInDistributions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOptInDistributions.DiscardUnknown(m)
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOptInDistributions proto.InternalMessageInfo
+
+func (m *MsgOptInDistributions) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EmptyResponse struct {
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
+func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
+func (*EmptyResponse) ProtoMessage()    {}
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fbcd921f59054cd, []int{14}
+}
+func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmptyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmptyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyResponse.Merge(m, src)
+}
+func (m *EmptyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmptyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgDisableDistributions)(nil), "tx.pse.v1.MsgDisableDistributions")
+	proto.RegisterType((*MsgUpdateExcludedAddresses)(nil), "tx.pse.v1.MsgUpdateExcludedAddresses")
+	proto.RegisterType((*MsgUpdateClearingAccountMappings)(nil), "tx.pse.v1.MsgUpdateClearingAccountMappings")
+	proto.RegisterType((*MsgUpdateDistributionSchedule)(nil), "tx.pse.v1.MsgUpdateDistributionSchedule")
+	proto.RegisterType((*MsgFundDistribution)(nil), "tx.pse.v1.MsgFundDistribution")
+	proto.RegisterType((*MsgUpdateMinDelegationAmount)(nil), "tx.pse.v1.MsgUpdateMinDelegationAmount")
+	proto.RegisterType((*MsgUpdateSlashingPenalty)(nil), "tx.pse.v1.MsgUpdateSlashingPenalty")
+	proto.RegisterType((*MsgUpdateMinDelegationDuration)(nil), "tx.pse.v1.MsgUpdateMinDelegationDuration")
+	proto.RegisterType((*MsgSetNamedSchedule)(nil), "tx.pse.v1.MsgSetNamedSchedule")
+	proto.RegisterType((*MsgRemoveNamedSchedule)(nil), "tx.pse.v1.MsgRemoveNamedSchedule")
+	proto.RegisterType((*MsgSetDistributionPreference)(nil), "tx.pse.v1.MsgSetDistributionPreference")
+	proto.RegisterType((*MsgReallocateClearingFunds)(nil), "tx.pse.v1.MsgReallocateClearingFunds")
+	proto.RegisterType((*MsgOptOutDistributions)(nil), "tx.pse.v1.MsgOptOutDistributions")
+	proto.RegisterType((*MsgOptInDistributions)(nil), "tx.pse.v1.MsgOptInDistributions")
+	proto.RegisterType((*EmptyResponse)(nil), "tx.pse.v1.EmptyResponse")
+}
+
+func init() { proto.RegisterFile("tx/pse/v1/tx.proto", fileDescriptor_7fbcd921f59054cd) }
+
+var fileDescriptor_7fbcd921f59054cd = []byte{
+	// 1357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0x8f, 0x13, 0x7e, 0xed, 0x20, 0xbe, 0x24, 0x0e, 0xf9, 0xee, 0x66, 0x03, 0x9b, 0x8d, 0x5b,
+	0x44, 0x1a, 0xb4, 0x36, 0x09, 0x2d, 0x48, 0xdb, 0x4a, 0x55, 0x96, 0x40, 0x15, 0x89, 0x05, 0xb4,
+	0x01, 0x0e, 0x48, 0xad, 0x99, 0xb5, 0x27, 0x5e, 0x0b, 0xdb, 0x63, 0x79, 0x66, 0xb7, 0xd9, 0x9e,
+	0x4a, 0x2f, 0x95, 0x7a, 0xaa, 0xd4, 0x4b, 0xff, 0x84, 0x1e, 0x39, 0x70, 0x69, 0xff, 0x02, 0x4e,
+	0x15, 0xe2, 0x54, 0xf5, 0x90, 0x56, 0x50, 0x89, 0x43, 0x4f, 0xcd, 0x81, 0x73, 0x65, 0x7b, 0xbc,
+	0xeb, 0x1f, 0x63, 0x83, 0x36, 0xbd, 0x44, 0xb6, 0xdf, 0x9b, 0xcf, 0x7b, 0x9f, 0xcf, 0xcc, 0xbc,
+	0xf7, 0xb2, 0x40, 0xa4, 0x7b, 0x8a, 0x4b, 0x90, 0x32, 0x58, 0x57, 0xe8, 0x9e, 0xec, 0x7a, 0x98,
+	0x62, 0xb1, 0xe4, 0x3f, 0x11, 0x24, 0x0f, 0xd6, 0xab, 0x73, 0xd0, 0x36, 0x1d, 0xac, 0x04, 0x7f,
+	0x43, 0x6b, 0xf5, 0x8c, 0x81, 0x0d, 0x1c, 0x3c, 0x2a, 0xfe, 0x13, 0xfb, 0xba, 0xa8, 0x61, 0x62,
+	0x63, 0xa2, 0x86, 0x86, 0xf0, 0x85, 0x99, 0xca, 0xe1, 0x9b, 0x62, 0x13, 0xc3, 0x0f, 0x63, 0x13,
+	0x83, 0x19, 0x6a, 0xcc, 0xd0, 0x85, 0x41, 0x02, 0x5d, 0x44, 0xe1, 0xba, 0xa2, 0x61, 0xd3, 0x89,
+	0xec, 0x06, 0xc6, 0x86, 0x85, 0x94, 0xe0, 0xad, 0xdb, 0xdf, 0x55, 0xf4, 0xbe, 0x07, 0xa9, 0x89,
+	0x23, 0xfb, 0xd9, 0x71, 0xee, 0xba, 0x49, 0xa8, 0x67, 0x76, 0xfb, 0x63, 0xab, 0xf4, 0x58, 0x00,
+	0xe5, 0x36, 0x31, 0xb6, 0x4c, 0x02, 0xbb, 0x16, 0xda, 0x8a, 0x39, 0x10, 0xf1, 0x0a, 0x28, 0xc1,
+	0x3e, 0xed, 0x61, 0xcf, 0xa4, 0xc3, 0x8a, 0x50, 0x17, 0x56, 0x4b, 0xad, 0xca, 0x8b, 0xa7, 0x8d,
+	0x33, 0x2c, 0xef, 0x4d, 0x5d, 0xf7, 0x10, 0x21, 0x3b, 0xd4, 0x33, 0x1d, 0xa3, 0x33, 0x76, 0x6d,
+	0xca, 0xdf, 0xbc, 0x7e, 0xb2, 0x36, 0x7e, 0xff, 0xee, 0xf5, 0x93, 0xb5, 0x25, 0x3f, 0x83, 0x9c,
+	0x38, 0xd2, 0xaf, 0xd3, 0xa0, 0xda, 0x26, 0xc6, 0x3d, 0x57, 0x87, 0x14, 0x5d, 0xdf, 0xd3, 0xac,
+	0xbe, 0x8e, 0x74, 0x86, 0x8e, 0x26, 0x4e, 0x43, 0xfc, 0x1c, 0xcc, 0xc2, 0x08, 0x44, 0xa5, 0x58,
+	0x85, 0xba, 0x5e, 0x99, 0xae, 0xcf, 0xac, 0x96, 0x5a, 0x97, 0x0f, 0xf6, 0x97, 0xcb, 0x43, 0x68,
+	0x5b, 0x4d, 0x29, 0xed, 0x21, 0xe5, 0x22, 0xff, 0x6f, 0xe4, 0x7a, 0x17, 0x6f, 0xea, 0xba, 0xb8,
+	0x0b, 0xe6, 0x13, 0x8b, 0x3d, 0x64, 0xe3, 0x01, 0xaa, 0xcc, 0x04, 0x11, 0xae, 0x1c, 0xec, 0x2f,
+	0x57, 0x39, 0x11, 0x42, 0xa7, 0xfc, 0x20, 0x73, 0xb1, 0x20, 0x9d, 0xc0, 0xb7, 0xb9, 0x9e, 0x55,
+	0xb3, 0xc6, 0xd4, 0xcc, 0x51, 0x4c, 0xfa, 0x5b, 0x00, 0xf5, 0x91, 0xf9, 0x9a, 0x85, 0xa0, 0x8f,
+	0xbd, 0xa9, 0x69, 0xb8, 0xef, 0xd0, 0x36, 0x74, 0x5d, 0xd3, 0x31, 0x26, 0x97, 0xf5, 0x3e, 0x38,
+	0x61, 0x33, 0x8c, 0x40, 0xce, 0x93, 0x1b, 0x2b, 0xf2, 0xe8, 0x2a, 0xc8, 0xfc, 0x68, 0xad, 0xf2,
+	0xb3, 0xfd, 0xe5, 0xa9, 0x83, 0xfd, 0xe5, 0xd3, 0xa1, 0x26, 0x11, 0x80, 0xd4, 0x19, 0x61, 0x35,
+	0xaf, 0x66, 0x79, 0xbe, 0x9f, 0xe0, 0x99, 0x43, 0x44, 0xfa, 0x4b, 0x00, 0xe7, 0x46, 0x4e, 0xf1,
+	0x93, 0xb5, 0xa3, 0xf5, 0x90, 0xde, 0xb7, 0xd0, 0xc4, 0x54, 0xef, 0x81, 0x13, 0x84, 0x61, 0x30,
+	0xaa, 0xf5, 0x18, 0xd5, 0x08, 0x5e, 0x8f, 0xc7, 0x4c, 0x33, 0x8d, 0xd6, 0x4b, 0x9d, 0x11, 0x54,
+	0xf3, 0xc3, 0x2c, 0xd3, 0x95, 0x04, 0x53, 0x1e, 0x09, 0xe9, 0x8d, 0x00, 0xe6, 0xdb, 0xc4, 0xb8,
+	0xd1, 0x77, 0x12, 0x01, 0xc5, 0x4b, 0xe0, 0x18, 0x41, 0x8e, 0x8e, 0xbc, 0xb7, 0x32, 0x63, 0x7e,
+	0xe2, 0x0d, 0x30, 0xeb, 0x22, 0xcf, 0xc4, 0xba, 0x4a, 0x4d, 0x1b, 0x11, 0x0a, 0x6d, 0xb7, 0x32,
+	0x5d, 0x17, 0x56, 0x8f, 0xb4, 0x96, 0xc6, 0x17, 0x23, 0xed, 0x21, 0x75, 0x4e, 0x87, 0x9f, 0xee,
+	0x46, 0x5f, 0xc4, 0x4f, 0xc0, 0x31, 0x68, 0xfb, 0x5b, 0x51, 0x99, 0xa9, 0x0b, 0xab, 0x27, 0x37,
+	0x16, 0x65, 0x16, 0xd6, 0x2f, 0x55, 0x32, 0x2b, 0x55, 0xf2, 0x35, 0x6c, 0x3a, 0xad, 0x92, 0xaf,
+	0xca, 0x4f, 0xaf, 0x9f, 0xac, 0x09, 0x1d, 0xb6, 0xa6, 0x79, 0xc1, 0x57, 0x81, 0xa5, 0xe4, 0x4b,
+	0x50, 0x66, 0x12, 0xa4, 0x09, 0x4a, 0xff, 0x08, 0xe0, 0xec, 0x48, 0x9a, 0xb6, 0xe9, 0x6c, 0x21,
+	0x0b, 0x19, 0x41, 0x85, 0xdb, 0x0c, 0x90, 0x26, 0xde, 0x5e, 0x1d, 0x2c, 0xd8, 0xa6, 0xa3, 0xea,
+	0x23, 0x3c, 0x95, 0xd1, 0x99, 0x0e, 0x30, 0x2e, 0xf9, 0x39, 0xff, 0xbe, 0xbf, 0xbc, 0x10, 0xe2,
+	0x10, 0xfd, 0x91, 0x6c, 0x62, 0xc5, 0x86, 0xb4, 0x27, 0x6f, 0x3b, 0xf4, 0xc5, 0xd3, 0x06, 0x60,
+	0x01, 0xb6, 0x1d, 0x1a, 0x52, 0x9b, 0xb7, 0xb3, 0xd9, 0x35, 0x2f, 0x67, 0x77, 0xbb, 0x9e, 0xd8,
+	0x6d, 0x0e, 0x25, 0xe9, 0xdb, 0x69, 0x50, 0x19, 0x39, 0xec, 0x58, 0x90, 0xf4, 0x4c, 0xc7, 0xb8,
+	0x83, 0x1c, 0x68, 0xd1, 0xe1, 0xc4, 0x7c, 0x1f, 0x0b, 0x60, 0x85, 0x30, 0x2c, 0x95, 0x68, 0xd8,
+	0x43, 0xaa, 0x1b, 0x42, 0xaa, 0x76, 0xdf, 0xa2, 0xa6, 0x6b, 0x99, 0xc8, 0x63, 0xe4, 0xaf, 0x30,
+	0xf2, 0x4b, 0x59, 0xf2, 0x37, 0x91, 0x01, 0xb5, 0xe1, 0x16, 0xd2, 0x62, 0x12, 0x6c, 0x21, 0x2d,
+	0x94, 0xa0, 0x16, 0x05, 0xd8, 0xf1, 0xf1, 0x59, 0xc6, 0xed, 0x11, 0x7a, 0x53, 0xc9, 0xaa, 0x71,
+	0x36, 0xa1, 0x46, 0x8a, 0xac, 0xbf, 0xfb, 0x35, 0xbe, 0x54, 0x5b, 0xac, 0xcf, 0x4d, 0xac, 0xc7,
+	0x43, 0x50, 0x4e, 0xed, 0x7f, 0xd4, 0x3a, 0x2b, 0xd3, 0xec, 0x40, 0x87, 0xbd, 0x55, 0x8e, 0x7a,
+	0xab, 0x1c, 0xc5, 0x6c, 0x9d, 0xf2, 0xf5, 0xf9, 0xf1, 0x8f, 0x65, 0x21, 0xa4, 0xbd, 0x60, 0xf3,
+	0x32, 0x6b, 0x7e, 0x94, 0x65, 0x2b, 0xe5, 0xef, 0x7d, 0xb4, 0x4c, 0xfa, 0x25, 0xbc, 0xea, 0x3b,
+	0x88, 0xde, 0x82, 0x36, 0xd2, 0x0f, 0x5d, 0xc7, 0x3e, 0x4d, 0xd4, 0x31, 0x9f, 0x59, 0x25, 0x56,
+	0xc7, 0x12, 0x31, 0xe2, 0x37, 0x75, 0x5c, 0xb1, 0xd6, 0xb2, 0x3c, 0xa2, 0xeb, 0x9a, 0x4e, 0x52,
+	0xfa, 0x41, 0x00, 0xff, 0x6f, 0x13, 0x23, 0xec, 0x5e, 0xff, 0x4d, 0xfe, 0x22, 0x38, 0xe2, 0x40,
+	0x3b, 0xcc, 0xbd, 0xd4, 0x09, 0x9e, 0x9b, 0x8d, 0x6c, 0x4a, 0x55, 0x96, 0x12, 0x27, 0xb4, 0x74,
+	0x10, 0x16, 0x91, 0x1d, 0x44, 0xe3, 0xb5, 0xe5, 0x8e, 0x87, 0x76, 0x91, 0x87, 0x1c, 0x0d, 0x89,
+	0xd7, 0xc1, 0x1c, 0x3b, 0x08, 0xd8, 0x53, 0x59, 0x17, 0x7e, 0x6b, 0x8e, 0xb3, 0xa3, 0x25, 0xec,
+	0xbb, 0x78, 0x0b, 0xcc, 0x0d, 0xa0, 0x65, 0xea, 0x09, 0x98, 0xf0, 0x4a, 0xad, 0xbc, 0x78, 0xda,
+	0x38, 0xc7, 0x60, 0xee, 0x47, 0x3e, 0x29, 0xbc, 0x41, 0xea, 0x7b, 0xf3, 0x63, 0x9f, 0x66, 0x36,
+	0xb3, 0x78, 0x15, 0xc9, 0xe5, 0x24, 0xfd, 0x1c, 0x0e, 0x56, 0x1d, 0x04, 0x2d, 0x0b, 0x6b, 0xb1,
+	0x16, 0xea, 0xd7, 0xd8, 0xc9, 0x27, 0x80, 0x0d, 0xb0, 0xb0, 0xeb, 0x61, 0x5b, 0xd5, 0x18, 0x9a,
+	0x0a, 0xc3, 0x8e, 0xcc, 0xf6, 0x67, 0xde, 0x37, 0xa6, 0x9a, 0xb5, 0x28, 0x83, 0x79, 0x8a, 0xb3,
+	0x2b, 0x66, 0x82, 0x15, 0x73, 0x14, 0xa7, 0xfd, 0x6f, 0x82, 0xa3, 0xa4, 0x07, 0x3d, 0x54, 0x39,
+	0x72, 0xa8, 0x72, 0x14, 0x82, 0x14, 0xcd, 0x50, 0x39, 0xe2, 0x48, 0xc3, 0xe0, 0x14, 0xdf, 0x76,
+	0xe9, 0xed, 0x3e, 0x4d, 0x8e, 0xc5, 0x1b, 0xe0, 0xf8, 0xbb, 0x9e, 0x8f, 0xc8, 0xb1, 0x79, 0xd1,
+	0x4f, 0xe0, 0x78, 0x6c, 0xf3, 0xa2, 0xb3, 0xca, 0x09, 0x20, 0x7d, 0x09, 0x16, 0x42, 0xcb, 0xb6,
+	0x73, 0xf8, 0xc8, 0x6b, 0xe9, 0xc8, 0x8b, 0xe3, 0xc8, 0x29, 0x7c, 0xe9, 0x34, 0x38, 0x75, 0xdd,
+	0x76, 0xe9, 0xb0, 0x83, 0x88, 0x8b, 0x1d, 0x82, 0x36, 0xde, 0x94, 0xc0, 0x4c, 0x9b, 0x18, 0xe2,
+	0x03, 0x50, 0xce, 0x9b, 0xce, 0xcf, 0xc7, 0x2a, 0x49, 0xfe, 0x48, 0x5a, 0x8d, 0x17, 0x9c, 0x44,
+	0x0c, 0x71, 0x17, 0x9c, 0x2b, 0x1e, 0x54, 0x2f, 0xf2, 0x22, 0xe4, 0x38, 0x17, 0xc4, 0x79, 0x08,
+	0xaa, 0x05, 0x23, 0xe2, 0x2a, 0x2f, 0x08, 0xcf, 0xb3, 0x20, 0xc2, 0x5d, 0x70, 0x86, 0xfb, 0x7f,
+	0x94, 0x94, 0xc4, 0xe6, 0xf9, 0x14, 0xa0, 0xde, 0x04, 0xb3, 0x99, 0x99, 0xaf, 0x96, 0x44, 0x4c,
+	0xdb, 0x0b, 0xd0, 0xbe, 0x00, 0x8b, 0xf9, 0x83, 0xd4, 0x05, 0x9e, 0x08, 0x1c, 0xc7, 0x02, 0xfc,
+	0xfb, 0x60, 0x81, 0x3f, 0xb4, 0xbc, 0xc7, 0xc3, 0x4e, 0x39, 0x15, 0xe0, 0x76, 0xc1, 0x52, 0xd1,
+	0x08, 0xf0, 0xc1, 0x5b, 0x33, 0x8f, 0x5c, 0x8b, 0x95, 0xce, 0xb4, 0xdc, 0x94, 0xd2, 0x69, 0x7b,
+	0x01, 0x5a, 0x07, 0xcc, 0xf3, 0x7a, 0xe0, 0x4a, 0x12, 0x90, 0xe3, 0x52, 0xbc, 0x7b, 0xf9, 0x1d,
+	0xec, 0x42, 0x26, 0x55, 0xbe, 0x63, 0x01, 0xfe, 0x03, 0x50, 0xce, 0x6b, 0x16, 0xe7, 0xd3, 0x79,
+	0x73, 0xdd, 0x8a, 0xf5, 0xe0, 0x55, 0xd3, 0x94, 0x1e, 0x1c, 0x97, 0x02, 0xcc, 0x3b, 0x40, 0xe4,
+	0x94, 0xc9, 0x7a, 0x06, 0x72, 0xdb, 0x79, 0x47, 0xc4, 0xea, 0xd1, 0xaf, 0xfd, 0xbe, 0xd1, 0xfa,
+	0xec, 0xd9, 0xcb, 0x9a, 0xf0, 0xfc, 0x65, 0x4d, 0xf8, 0xf3, 0x65, 0x4d, 0xf8, 0xfe, 0x55, 0x6d,
+	0xea, 0xf9, 0xab, 0xda, 0xd4, 0x6f, 0xaf, 0x6a, 0x53, 0x0f, 0x1a, 0x86, 0x49, 0x7b, 0xfd, 0xae,
+	0xac, 0x61, 0x5b, 0xa1, 0xf8, 0x11, 0x72, 0xcc, 0xaf, 0x50, 0x63, 0x4f, 0xa1, 0x7b, 0x0d, 0xad,
+	0x07, 0x4d, 0x47, 0x19, 0x5c, 0x55, 0xc2, 0xdf, 0x5b, 0xe8, 0xd0, 0x45, 0xa4, 0x7b, 0x2c, 0x18,
+	0x1d, 0x2f, 0xff, 0x3b, 0x00, 0xe6, 0x4a, 0xfc, 0x92, 0x42, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateExcludedAddresses is a governance operation to update the list of excluded addresses.
+	UpdateExcludedAddresses(ctx context.Context, in *MsgUpdateExcludedAddresses, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateClearingAccountMappings is a governance operation to update clearing account to recipient mappings.
+	UpdateClearingAccountMappings(ctx context.Context, in *MsgUpdateClearingAccountMappings, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateDistributionSchedule is a governance operation to update the distribution schedule.
+	UpdateDistributionSchedule(ctx context.Context, in *MsgUpdateDistributionSchedule, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// DisableDistributions is a governance operation to disable distributions.
+	DisableDistributions(ctx context.Context, in *MsgDisableDistributions, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// FundDistribution escrows additional coins which are added to the Community distribution of the scheduled period.
+	FundDistribution(ctx context.Context, in *MsgFundDistribution, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateMinDelegationAmount is a governance operation to update the minimum delegation amount accruing the score.
+	UpdateMinDelegationAmount(ctx context.Context, in *MsgUpdateMinDelegationAmount, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateSlashingPenalty is a governance operation to update the multiplier of the score penalty
+	// applied to the delegators of the slashed validators.
+	UpdateSlashingPenalty(ctx context.Context, in *MsgUpdateSlashingPenalty, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateMinDelegationDuration is a governance operation to update the minimum duration of the continuous
+	// delegation required for the score to count toward the community distribution.
+	UpdateMinDelegationDuration(ctx context.Context, in *MsgUpdateMinDelegationDuration, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SetNamedSchedule is a governance operation to create or replace the named distribution schedule.
+	SetNamedSchedule(ctx context.Context, in *MsgSetNamedSchedule, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// RemoveNamedSchedule is a governance operation to remove the named distribution schedule.
+	RemoveNamedSchedule(ctx context.Context, in *MsgRemoveNamedSchedule, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SetDistributionPreference sets the validator the Community distribution payouts of the delegator are
+	// delegated to.
+	SetDistributionPreference(ctx context.Context, in *MsgSetDistributionPreference, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ReallocateClearingFunds is a governance operation to move the share of the balance and of the remaining
+	// scheduled allocations from one clearing account to another.
+	ReallocateClearingFunds(ctx context.Context, in *MsgReallocateClearingFunds, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// OptOutDistributions excludes the sender from the Community distributions, its share is sent to the community
+	// pool instead.
+	OptOutDistributions(ctx context.Context, in *MsgOptOutDistributions, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// OptInDistributions includes the sender, which opted out before, in the Community distributions again.
+	OptInDistributions(ctx context.Context, in *MsgOptInDistributions, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateExcludedAddresses(ctx context.Context, in *MsgUpdateExcludedAddresses, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateExcludedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgDisableDistributions) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) UpdateClearingAccountMappings(ctx context.Context, in *MsgUpdateClearingAccountMappings, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateClearingAccountMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateDistributionSchedule(ctx context.Context, in *MsgUpdateDistributionSchedule, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateDistributionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateExcludedAddresses) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DisableDistributions(ctx context.Context, in *MsgDisableDistributions, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/DisableDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) FundDistribution(ctx context.Context, in *MsgFundDistribution, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/FundDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AddressesToAdd) > 0 {
-		for _, s := range m.AddressesToAdd {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMinDelegationAmount(ctx context.Context, in *MsgUpdateMinDelegationAmount, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateMinDelegationAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AddressesToRemove) > 0 {
-		for _, s := range m.AddressesToRemove {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSlashingPenalty(ctx context.Context, in *MsgUpdateSlashingPenalty, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateSlashingPenalty", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateClearingAccountMappings) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateMinDelegationDuration(ctx context.Context, in *MsgUpdateMinDelegationDuration, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/UpdateMinDelegationDuration", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetNamedSchedule(ctx context.Context, in *MsgSetNamedSchedule, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/SetNamedSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Mappings) > 0 {
-		for _, e := range m.Mappings {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) RemoveNamedSchedule(ctx context.Context, in *MsgRemoveNamedSchedule, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/RemoveNamedSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateDistributionSchedule) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetDistributionPreference(ctx context.Context, in *MsgSetDistributionPreference, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/SetDistributionPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ReallocateClearingFunds(ctx context.Context, in *MsgReallocateClearingFunds, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/ReallocateClearingFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Schedule) > 0 {
-		for _, e := range m.Schedule {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) OptOutDistributions(ctx context.Context, in *MsgOptOutDistributions, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/OptOutDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OptInDistributions(ctx context.Context, in *MsgOptInDistributions, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/tx.pse.v1.Msg/OptInDistributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateExcludedAddresses is a governance operation to update the list of excluded addresses.
+	UpdateExcludedAddresses(context.Context, *MsgUpdateExcludedAddresses) (*EmptyResponse, error)
+	// UpdateClearingAccountMappings is a governance operation to update clearing account to recipient mappings.
+	UpdateClearingAccountMappings(context.Context, *MsgUpdateClearingAccountMappings) (*EmptyResponse, error)
+	// UpdateDistributionSchedule is a governance operation to update the distribution schedule.
+	UpdateDistributionSchedule(context.Context, *MsgUpdateDistributionSchedule) (*EmptyResponse, error)
+	// DisableDistributions is a governance operation to disable distributions.
+	DisableDistributions(context.Context, *MsgDisableDistributions) (*EmptyResponse, error)
+	// FundDistribution escrows additional coins which are added to the Community distribution of the scheduled period.
+	FundDistribution(context.Context, *MsgFundDistribution) (*EmptyResponse, error)
+	// UpdateMinDelegationAmount is a governance operation to update the minimum delegation amount accruing the score.
+	UpdateMinDelegationAmount(context.Context, *MsgUpdateMinDelegationAmount) (*EmptyResponse, error)
+	// UpdateSlashingPenalty is a governance operation to update the multiplier of the score penalty
+	// applied to the delegators of the slashed validators.
+	UpdateSlashingPenalty(context.Context, *MsgUpdateSlashingPenalty) (*EmptyResponse, error)
+	// UpdateMinDelegationDuration is a governance operation to update the minimum duration of the continuous
+	// delegation required for the score to count toward the community distribution.
+	UpdateMinDelegationDuration(context.Context, *MsgUpdateMinDelegationDuration) (*EmptyResponse, error)
+	// SetNamedSchedule is a governance operation to create or replace the named distribution schedule.
+	SetNamedSchedule(context.Context, *MsgSetNamedSchedule) (*EmptyResponse, error)
+	// RemoveNamedSchedule is a governance operation to remove the named distribution schedule.
+	RemoveNamedSchedule(context.Context, *MsgRemoveNamedSchedule) (*EmptyResponse, error)
+	// SetDistributionPreference sets the validator the Community distribution payouts of the delegator are
+	// delegated to.
+	SetDistributionPreference(context.Context, *MsgSetDistributionPreference) (*EmptyResponse, error)
+	// ReallocateClearingFunds is a governance operation to move the share of the balance and of the remaining
+	// scheduled allocations from one clearing account to another.
+	ReallocateClearingFunds(context.Context, *MsgReallocateClearingFunds) (*EmptyResponse, error)
+	// OptOutDistributions excludes the sender from the Community distributions, its share is sent to the community
+	// pool instead.
+	OptOutDistributions(context.Context, *MsgOptOutDistributions) (*EmptyResponse, error)
+	// OptInDistributions includes the sender, which opted out before, in the Community distributions again.
+	OptInDistributions(context.Context, *MsgOptInDistributions) (*EmptyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateExcludedAddresses(ctx context.Context, req *MsgUpdateExcludedAddresses) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExcludedAddresses not implemented")
+}
+func (*UnimplementedMsgServer) UpdateClearingAccountMappings(ctx context.Context, req *MsgUpdateClearingAccountMappings) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClearingAccountMappings not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDistributionSchedule(ctx context.Context, req *MsgUpdateDistributionSchedule) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDistributionSchedule not implemented")
+}
+func (*UnimplementedMsgServer) DisableDistributions(ctx context.Context, req *MsgDisableDistributions) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableDistributions not implemented")
+}
+func (*UnimplementedMsgServer) FundDistribution(ctx context.Context, req *MsgFundDistribution) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundDistribution not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMinDelegationAmount(ctx context.Context, req *MsgUpdateMinDelegationAmount) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinDelegationAmount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSlashingPenalty(ctx context.Context, req *MsgUpdateSlashingPenalty) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSlashingPenalty not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMinDelegationDuration(ctx context.Context, req *MsgUpdateMinDelegationDuration) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinDelegationDuration not implemented")
+}
+func (*UnimplementedMsgServer) SetNamedSchedule(ctx context.Context, req *MsgSetNamedSchedule) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamedSchedule not implemented")
+}
+func (*UnimplementedMsgServer) RemoveNamedSchedule(ctx context.Context, req *MsgRemoveNamedSchedule) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNamedSchedule not implemented")
+}
+func (*UnimplementedMsgServer) SetDistributionPreference(ctx context.Context, req *MsgSetDistributionPreference) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDistributionPreference not implemented")
+}
+func (*UnimplementedMsgServer) ReallocateClearingFunds(ctx context.Context, req *MsgReallocateClearingFunds) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReallocateClearingFunds not implemented")
+}
+func (*UnimplementedMsgServer) OptOutDistributions(ctx context.Context, req *MsgOptOutDistributions) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptOutDistributions not implemented")
+}
+func (*UnimplementedMsgServer) OptInDistributions(ctx context.Context, req *MsgOptInDistributions) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptInDistributions not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateExcludedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateExcludedAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateExcludedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/UpdateExcludedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateExcludedAddresses(ctx, req.(*MsgUpdateExcludedAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClearingAccountMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClearingAccountMappings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClearingAccountMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/UpdateClearingAccountMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClearingAccountMappings(ctx, req.(*MsgUpdateClearingAccountMappings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDistributionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDistributionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDistributionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/UpdateDistributionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDistributionSchedule(ctx, req.(*MsgUpdateDistributionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableDistributions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/DisableDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableDistributions(ctx, req.(*MsgDisableDistributions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/FundDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundDistribution(ctx, req.(*MsgFundDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMinDelegationAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinDelegationAmount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinDelegationAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/UpdateMinDelegationAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinDelegationAmount(ctx, req.(*MsgUpdateMinDelegationAmount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSlashingPenalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSlashingPenalty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSlashingPenalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/UpdateSlashingPenalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSlashingPenalty(ctx, req.(*MsgUpdateSlashingPenalty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMinDelegationDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinDelegationDuration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinDelegationDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/UpdateMinDelegationDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinDelegationDuration(ctx, req.(*MsgUpdateMinDelegationDuration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNamedSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNamedSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNamedSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/SetNamedSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNamedSchedule(ctx, req.(*MsgSetNamedSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveNamedSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveNamedSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveNamedSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/RemoveNamedSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveNamedSchedule(ctx, req.(*MsgRemoveNamedSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDistributionPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDistributionPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDistributionPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/SetDistributionPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDistributionPreference(ctx, req.(*MsgSetDistributionPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReallocateClearingFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReallocateClearingFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReallocateClearingFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/ReallocateClearingFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReallocateClearingFunds(ctx, req.(*MsgReallocateClearingFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OptOutDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOptOutDistributions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OptOutDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/OptOutDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptOutDistributions(ctx, req.(*MsgOptOutDistributions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OptInDistributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOptInDistributions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OptInDistributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tx.pse.v1.Msg/OptInDistributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptInDistributions(ctx, req.(*MsgOptInDistributions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tx.pse.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateExcludedAddresses",
+			Handler:    _Msg_UpdateExcludedAddresses_Handler,
+		},
+		{
+			MethodName: "UpdateClearingAccountMappings",
+			Handler:    _Msg_UpdateClearingAccountMappings_Handler,
+		},
+		{
+			MethodName: "UpdateDistributionSchedule",
+			Handler:    _Msg_UpdateDistributionSchedule_Handler,
+		},
+		{
+			MethodName: "DisableDistributions",
+			Handler:    _Msg_DisableDistributions_Handler,
+		},
+		{
+			MethodName: "FundDistribution",
+			Handler:    _Msg_FundDistribution_Handler,
+		},
+		{
+			MethodName: "UpdateMinDelegationAmount",
+			Handler:    _Msg_UpdateMinDelegationAmount_Handler,
+		},
+		{
+			MethodName: "UpdateSlashingPenalty",
+			Handler:    _Msg_UpdateSlashingPenalty_Handler,
+		},
+		{
+			MethodName: "UpdateMinDelegationDuration",
+			Handler:    _Msg_UpdateMinDelegationDuration_Handler,
+		},
+		{
+			MethodName: "SetNamedSchedule",
+			Handler:    _Msg_SetNamedSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveNamedSchedule",
+			Handler:    _Msg_RemoveNamedSchedule_Handler,
+		},
+		{
+			MethodName: "SetDistributionPreference",
+			Handler:    _Msg_SetDistributionPreference_Handler,
+		},
+		{
+			MethodName: "ReallocateClearingFunds",
+			Handler:    _Msg_ReallocateClearingFunds_Handler,
+		},
+		{
+			MethodName: "OptOutDistributions",
+			Handler:    _Msg_OptOutDistributions_Handler,
+		},
+		{
+			MethodName: "OptInDistributions",
+			Handler:    _Msg_OptInDistributions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tx/pse/v1/tx.proto",
+}
+
+func (m *MsgDisableDistributions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableDistributions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableDistributions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateExcludedAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateExcludedAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateExcludedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressesToRemove) > 0 {
+		for iNdEx := len(m.AddressesToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddressesToRemove[iNdEx])
+			copy(dAtA[i:], m.AddressesToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddressesToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddressesToAdd) > 0 {
+		for iNdEx := len(m.AddressesToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddressesToAdd[iNdEx])
+			copy(dAtA[i:], m.AddressesToAdd[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddressesToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClearingAccountMappings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClearingAccountMappings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClearingAccountMappings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDistributionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDistributionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDistributionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PeriodTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PeriodTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinDelegationAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinDelegationAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinDelegationAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinDelegationAmount.Size()
+		i -= size
+		if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSlashingPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSlashingPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSlashingPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashingScorePenaltyMultiplier.Size()
+		i -= size
+		if _, err := m.SlashingScorePenaltyMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinDelegationDuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinDelegationDuration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinDelegationDuration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinDelegationDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDelegationDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNamedSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNamedSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNamedSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveNamedSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveNamedSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveNamedSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDistributionPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDistributionPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDistributionPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReallocateClearingFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReallocateClearingFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReallocateClearingFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToClearingAccount) > 0 {
+		i -= len(m.ToClearingAccount)
+		copy(dAtA[i:], m.ToClearingAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToClearingAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromClearingAccount) > 0 {
+		i -= len(m.FromClearingAccount)
+		copy(dAtA[i:], m.FromClearingAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromClearingAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOptOutDistributions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOptOutDistributions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOptOutDistributions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOptInDistributions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOptInDistributions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOptInDistributions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDisableDistributions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateExcludedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddressesToAdd) > 0 {
+		for _, s := range m.AddressesToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddressesToRemove) > 0 {
+		for _, s := range m.AddressesToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateClearingAccountMappings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateDistributionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PeriodTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.PeriodTimestamp))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateMinDelegationAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinDelegationAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateSlashingPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SlashingScorePenaltyMultiplier.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateMinDelegationDuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDelegationDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetNamedSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveNamedSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDistributionPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReallocateClearingFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToClearingAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgOptOutDistributions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOptInDistributions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDisableDistributions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableDistributions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableDistributions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateExcludedAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateExcludedAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateExcludedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressesToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressesToAdd = append(m.AddressesToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressesToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressesToRemove = append(m.AddressesToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateClearingAccountMappings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateClearingAccountMappings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateClearingAccountMappings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, ClearingAccountMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDistributionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDistributionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDistributionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, ScheduledDistribution{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodTimestamp", wireType)
+			}
+			m.PeriodTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinDelegationAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinDelegationAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinDelegationAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSlashingPenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSlashingPenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSlashingPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingScorePenaltyMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingScorePenaltyMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinDelegationDuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinDelegationDuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinDelegationDuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinDelegationDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNamedSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNamedSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNamedSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *EmptyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDisableDistributions) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveNamedSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -852,10 +3463,10 @@ func (m *MsgDisableDistributions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableDistributions: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveNamedSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableDistributions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveNamedSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -890,6 +3501,38 @@ func (m *MsgDisableDistributions) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -911,7 +3554,7 @@ func (m *MsgDisableDistributions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateExcludedAddresses) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDistributionPreference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -934,15 +3577,15 @@ func (m *MsgUpdateExcludedAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateExcludedAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDistributionPreference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateExcludedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDistributionPreference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -970,43 +3613,11 @@ func (m *MsgUpdateExcludedAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressesToAdd", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddressesToAdd = append(m.AddressesToAdd, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressesToRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1034,7 +3645,7 @@ func (m *MsgUpdateExcludedAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressesToRemove = append(m.AddressesToRemove, string(dAtA[iNdEx:postIndex]))
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1057,7 +3668,7 @@ func (m *MsgUpdateExcludedAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClearingAccountMappings) Unmarshal(dAtA []byte) error {
+func (m *MsgReallocateClearingFunds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1080,10 +3691,10 @@ func (m *MsgUpdateClearingAccountMappings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClearingAccountMappings: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReallocateClearingFunds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClearingAccountMappings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReallocateClearingFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1120,9 +3731,9 @@ func (m *MsgUpdateClearingAccountMappings) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromClearingAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1132,23 +3743,87 @@ func (m *MsgUpdateClearingAccountMappings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mappings = append(m.Mappings, ClearingAccountMapping{})
-			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FromClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToClearingAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToClearingAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1173,7 +3848,7 @@ func (m *MsgUpdateClearingAccountMappings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDistributionSchedule) Unmarshal(dAtA []byte) error {
+func (m *MsgOptOutDistributions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1196,15 +3871,15 @@ func (m *MsgUpdateDistributionSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDistributionSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOptOutDistributions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDistributionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOptOutDistributions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1232,13 +3907,63 @@ func (m *MsgUpdateDistributionSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptInDistributions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptInDistributions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptInDistributions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1248,25 +3973,23 @@ func (m *MsgUpdateDistributionSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = append(m.Schedule, ScheduledDistribution{})
-			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+// InitGenesis initializes the treasury module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) error {
+	for _, stream := range genState.Streams {
+		if err := k.SetStream(ctx, stream); err != nil {
+			return err
+		}
+	}
+
+	return k.StreamSequence.Set(ctx, genState.StreamSequence)
+}
+
+// ExportGenesis returns the treasury module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	genesis := types.DefaultGenesisState()
+
+	if err := k.Streams.Walk(ctx, nil, func(_ uint64, stream types.Stream) (bool, error) {
+		genesis.Streams = append(genesis.Streams, stream)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var err error
+	genesis.StreamSequence, err = k.StreamSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+func TestGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	recipient, _ := testApp.GenAccount(ctx)
+	genState := types.GenesisState{
+		Streams: []types.Stream{
+			{
+				ID:                1,
+				Recipient:         recipient.String(),
+				Amount:            sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+				Interval:          time.Hour,
+				NextPaymentTime:   time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				RemainingPayments: 3,
+			},
+			{
+				ID:                3,
+				Recipient:         recipient.String(),
+				Amount:            sdk.NewCoins(sdk.NewInt64Coin("denom1", 10), sdk.NewInt64Coin("denom2", 20)),
+				Interval:          24 * time.Hour,
+				NextPaymentTime:   time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+				RemainingPayments: 12,
+			},
+		},
+		StreamSequence: 4,
+	}
+	requireT.NoError(genState.Validate())
+
+	requireT.NoError(testApp.TreasuryKeeper.InitGenesis(ctx, genState))
+	exported, err := testApp.TreasuryKeeper.ExportGenesis(ctx)
+	requireT.NoError(err)
+	requireT.Equal(genState, *exported)
+
+	// the payment index is restored
+	ctx = ctx.WithBlockTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	requireT.NoError(testApp.FundAccount(
+		ctx, testApp.TreasuryKeeper.Address(), sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+	))
+	requireT.NoError(testApp.TreasuryKeeper.PayStreams(ctx))
+	requireT.Equal("100denom1", testApp.BankKeeper.GetAllBalances(ctx, recipient).String())
+}
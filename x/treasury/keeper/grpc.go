@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryService serves grpc requests for the module.
+type QueryService struct {
+	keeper Keeper
+}
+
+// NewQueryService creates query service.
+func NewQueryService(keeper Keeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Treasury returns the address and the assets of the treasury.
+func (qs QueryService) Treasury(
+	ctx context.Context, req *types.QueryTreasuryRequest,
+) (*types.QueryTreasuryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	committed, err := qs.keeper.GetCommitted(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTreasuryResponse{
+		Address:   qs.keeper.Address().String(),
+		Balances:  qs.keeper.GetBalances(sdkCtx),
+		Committed: committed,
+	}, nil
+}
+
+// Stream returns the stream.
+func (qs QueryService) Stream(ctx context.Context, req *types.QueryStreamRequest) (*types.QueryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stream, err := qs.keeper.GetStream(sdk.UnwrapSDKContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStreamResponse{
+		Stream: stream,
+	}, nil
+}
+
+// Streams returns all the active streams.
+func (qs QueryService) Streams(
+	ctx context.Context, req *types.QueryStreamsRequest,
+) (*types.QueryStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	streams, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.Streams,
+		req.Pagination,
+		func(_ uint64, stream types.Stream) (types.Stream, error) {
+			return stream, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStreamsResponse{
+		Streams:    streams,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkstore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+// Keeper of the module.
+type Keeper struct {
+	storeService sdkstore.KVStoreService
+	authority    string
+	logger       log.Logger
+
+	// codec
+	cdc codec.BinaryCodec
+
+	// keepers
+	bankKeeper types.BankKeeper
+
+	// collections
+	Schema         collections.Schema
+	Streams        collections.Map[uint64, types.Stream]
+	StreamPayments collections.KeySet[collections.Pair[time.Time, uint64]]
+	StreamSequence collections.Sequence
+}
+
+// NewKeeper returns a new keeper object providing storage options required by the module.
+func NewKeeper(
+	storeService sdkstore.KVStoreService,
+	cdc codec.BinaryCodec,
+	authority string,
+	bankKeeper types.BankKeeper,
+	logger log.Logger,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService: storeService,
+		authority:    authority,
+		logger:       logger,
+		cdc:          cdc,
+		bankKeeper:   bankKeeper,
+
+		Streams: collections.NewMap(
+			sb,
+			types.StreamKey,
+			"streams",
+			collections.Uint64Key,
+			codec.CollValue[types.Stream](cdc),
+		),
+		StreamPayments: collections.NewKeySet(
+			sb,
+			types.StreamPaymentKey,
+			"stream_payments",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		StreamSequence: collections.NewSequence(
+			sb,
+			types.StreamSequenceKey,
+			"stream_sequence",
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
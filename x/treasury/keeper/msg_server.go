@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper Keeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// Spend is a governance operation sending the assets of the treasury to the recipient.
+func (ms MsgServer) Spend(ctx context.Context, req *types.MsgSpend) (*types.EmptyResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.Spend(sdk.UnwrapSDKContext(ctx), req.Authority, recipient, req.Amount); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
+
+// CreateStream is a governance operation creating the stream.
+func (ms MsgServer) CreateStream(
+	ctx context.Context,
+	req *types.MsgCreateStream,
+) (*types.MsgCreateStreamResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ms.keeper.CreateStream(
+		sdk.UnwrapSDKContext(ctx), req.Authority, recipient, req.Amount, req.Interval, req.StartTime, req.Payments,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateStreamResponse{StreamID: id}, nil
+}
+
+// CancelStream is a governance operation removing the stream.
+func (ms MsgServer) CancelStream(ctx context.Context, req *types.MsgCancelStream) (*types.EmptyResponse, error) {
+	if err := ms.keeper.CancelStream(sdk.UnwrapSDKContext(ctx), req.Authority, req.StreamID); err != nil {
+		return nil, err
+	}
+	return &types.EmptyResponse{}, nil
+}
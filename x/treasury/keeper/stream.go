@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+// CreateStream is a governance operation creating the stream paying the amount to the recipient once per interval.
+// The first payment is made at the start time, or in the end of the current block if the start time has passed.
+func (k Keeper) CreateStream(
+	ctx sdk.Context,
+	authority string,
+	recipient sdk.AccAddress,
+	amount sdk.Coins,
+	interval time.Duration,
+	startTime time.Time,
+	payments uint64,
+) (uint64, error) {
+	if err := k.validateAuthority(authority); err != nil {
+		return 0, err
+	}
+
+	id, err := k.StreamSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	stream := types.Stream{
+		ID:                id,
+		Recipient:         recipient.String(),
+		Amount:            amount,
+		Interval:          interval,
+		NextPaymentTime:   startTime,
+		RemainingPayments: payments,
+	}
+	if err := stream.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	if err := k.SetStream(ctx, stream); err != nil {
+		return 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventStreamCreated{
+		ID:        id,
+		Recipient: stream.Recipient,
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// CancelStream is a governance operation removing the stream. The payments not made yet stay in the treasury.
+func (k Keeper) CancelStream(ctx sdk.Context, authority string, id uint64) error {
+	if err := k.validateAuthority(authority); err != nil {
+		return err
+	}
+
+	stream, err := k.GetStream(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return k.removeStream(ctx, stream, types.RemovalReasonCancelled)
+}
+
+// GetStream returns the stream.
+func (k Keeper) GetStream(ctx sdk.Context, id uint64) (types.Stream, error) {
+	stream, err := k.Streams.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Stream{}, sdkerrors.Wrapf(types.ErrStreamNotFound, "stream %d not found", id)
+		}
+		return types.Stream{}, err
+	}
+	return stream, nil
+}
+
+// SetStream stores the stream together with its payment index, but does not make any checks, should not be used
+// directly outside the module except for genesis.
+func (k Keeper) SetStream(ctx sdk.Context, stream types.Stream) error {
+	if err := k.Streams.Set(ctx, stream.ID, stream); err != nil {
+		return err
+	}
+	return k.StreamPayments.Set(ctx, collections.Join(stream.NextPaymentTime, stream.ID))
+}
+
+// GetCommitted returns the total amount of the payments left in the active streams.
+func (k Keeper) GetCommitted(ctx sdk.Context) (sdk.Coins, error) {
+	committed := sdk.NewCoins()
+	if err := k.Streams.Walk(ctx, nil, func(_ uint64, stream types.Stream) (bool, error) {
+		committed = committed.Add(stream.Committed()...)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	return committed, nil
+}
+
+// PayStreams makes the due payments of the streams. At most MaxStreamPaymentsPerBlock payments are made, the rest
+// is made by the next calls. Each stream is paid at most once per call. Should be called from EndBlock.
+func (k Keeper) PayStreams(ctx sdk.Context) error {
+	keys := make([]collections.Pair[time.Time, uint64], 0)
+	err := k.StreamPayments.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		if key.K1().After(ctx.BlockTime()) || len(keys) == types.MaxStreamPaymentsPerBlock {
+			return true, nil
+		}
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		stream, err := k.GetStream(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if err := k.payStream(ctx, stream); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) payStream(ctx sdk.Context, stream types.Stream) error {
+	if err := k.StreamPayments.Remove(ctx, collections.Join(stream.NextPaymentTime, stream.ID)); err != nil {
+		return err
+	}
+	recipient, err := sdk.AccAddressFromBech32(stream.Recipient)
+	if err != nil {
+		return err
+	}
+
+	// the failure of the payment must not halt the chain, e.g. if the treasury doesn't hold enough assets, in that
+	// case the payment is postponed by the interval, so the governance can fund the treasury or cancel the stream
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		cacheCtx, types.ModuleName, recipient, stream.Amount,
+	); err != nil {
+		k.logger.Error("failed to make the payment of the stream", "streamID", stream.ID, "error", err)
+		stream.NextPaymentTime = stream.NextPaymentTime.Add(stream.Interval)
+		if err := k.SetStream(ctx, stream); err != nil {
+			return err
+		}
+		return ctx.EventManager().EmitTypedEvent(&types.EventStreamPaymentPostponed{
+			ID:     stream.ID,
+			Reason: err.Error(),
+		})
+	}
+	writeCache()
+
+	stream.RemainingPayments--
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventStreamPaid{
+		ID:                stream.ID,
+		Recipient:         stream.Recipient,
+		Amount:            stream.Amount,
+		RemainingPayments: stream.RemainingPayments,
+	}); err != nil {
+		return err
+	}
+
+	if stream.RemainingPayments == 0 {
+		return k.removeStream(ctx, stream, types.RemovalReasonCompleted)
+	}
+	stream.NextPaymentTime = stream.NextPaymentTime.Add(stream.Interval)
+	return k.SetStream(ctx, stream)
+}
+
+func (k Keeper) removeStream(ctx sdk.Context, stream types.Stream, reason string) error {
+	if err := k.Streams.Remove(ctx, stream.ID); err != nil {
+		return err
+	}
+	if err := k.StreamPayments.Remove(ctx, collections.Join(stream.NextPaymentTime, stream.ID)); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventStreamRemoved{
+		ID:     stream.ID,
+		Reason: reason,
+	})
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+// Address returns the address of the treasury module account holding the assets.
+func (k Keeper) Address() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// GetBalances returns the assets held by the treasury.
+func (k Keeper) GetBalances(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.Address())
+}
+
+// Spend is a governance operation sending the assets of the treasury to the recipient.
+func (k Keeper) Spend(ctx sdk.Context, authority string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.validateAuthority(authority); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSpent{
+		Recipient: recipient.String(),
+		Amount:    amount,
+	})
+}
+
+func (k Keeper) validateAuthority(authority string) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/pkg/config/constant"
+	"github.com/tokenize-x/tx-chain/v7/testutil/event"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	psetypes "github.com/tokenize-x/tx-chain/v7/x/pse/types"
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/treasury/types"
+)
+
+func TestKeeper_Spend(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Time: time.Now().UTC()})
+	treasuryKeeper := testApp.TreasuryKeeper
+
+	// the treasury is funded by the pse clearing account the same way the allocations are distributed to the
+	// recipients of the clearing account mappings
+	funds := sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1_000), sdk.NewInt64Coin("ftdenom", 500))
+	requireT.NoError(testApp.BankKeeper.MintCoins(ctx, psetypes.ModuleName, funds))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToModule(
+		ctx, psetypes.ModuleName, psetypes.ClearingAccountFoundation, funds,
+	))
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx, psetypes.ClearingAccountFoundation, treasuryKeeper.Address(), funds,
+	))
+	requireT.Equal(funds.String(), treasuryKeeper.GetBalances(ctx).String())
+
+	recipient, _ := testApp.GenAccount(ctx)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 300), sdk.NewInt64Coin("ftdenom", 500))
+	requireT.ErrorIs(
+		treasuryKeeper.Spend(ctx, recipient.String(), recipient, amount),
+		types.ErrInvalidAuthority,
+	)
+	requireT.NoError(treasuryKeeper.Spend(ctx, testApp.GovAuthority(), recipient, amount))
+	requireT.Equal(amount.String(), testApp.BankKeeper.GetAllBalances(ctx, recipient).String())
+	requireT.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 700)).String(),
+		treasuryKeeper.GetBalances(ctx).String(),
+	)
+
+	// the treasury can't spend more than it holds
+	requireT.ErrorIs(
+		treasuryKeeper.Spend(ctx, testApp.GovAuthority(), recipient, amount),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+}
+
+func TestKeeper_Streams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Now().UTC()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Time: startTime})
+	treasuryKeeper := testApp.TreasuryKeeper
+	requireT.NoError(testApp.FundAccount(
+		ctx, treasuryKeeper.Address(), sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 250)),
+	))
+
+	recipient, _ := testApp.GenAccount(ctx)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 100))
+	interval := 30 * 24 * time.Hour
+	_, err := treasuryKeeper.CreateStream(ctx, recipient.String(), recipient, amount, interval, startTime, 3)
+	requireT.ErrorIs(err, types.ErrInvalidAuthority)
+	id, err := treasuryKeeper.CreateStream(ctx, testApp.GovAuthority(), recipient, amount, interval, startTime, 3)
+	requireT.NoError(err)
+
+	res, err := keeper.NewQueryService(treasuryKeeper).Treasury(ctx, &types.QueryTreasuryRequest{})
+	requireT.NoError(err)
+	requireT.Equal(treasuryKeeper.Address().String(), res.Address)
+	requireT.Equal("300"+constant.DenomDev, res.Committed.String())
+
+	// the first payment is made at the start time, only once per block
+	requireT.NoError(treasuryKeeper.PayStreams(ctx))
+	requireT.NoError(treasuryKeeper.PayStreams(ctx))
+	requireT.Equal(amount.String(), testApp.BankKeeper.GetAllBalances(ctx, recipient).String())
+	stream, err := treasuryKeeper.GetStream(ctx, id)
+	requireT.NoError(err)
+	requireT.Equal(uint64(2), stream.RemainingPayments)
+	requireT.Equal(startTime.Add(interval), stream.NextPaymentTime)
+
+	// the payment isn't made before the interval passes
+	ctx = ctx.WithBlockTime(startTime.Add(interval - time.Second))
+	requireT.NoError(treasuryKeeper.PayStreams(ctx))
+	requireT.Equal(amount.String(), testApp.BankKeeper.GetAllBalances(ctx, recipient).String())
+
+	ctx = ctx.WithBlockTime(startTime.Add(interval))
+	requireT.NoError(treasuryKeeper.PayStreams(ctx))
+	requireT.Equal("200"+constant.DenomDev, testApp.BankKeeper.GetAllBalances(ctx, recipient).String())
+
+	// the treasury doesn't hold enough assets, so the payment is postponed
+	ctx = ctx.WithBlockTime(startTime.Add(2 * interval)).WithEventManager(sdk.NewEventManager())
+	requireT.NoError(treasuryKeeper.PayStreams(ctx))
+	requireT.Equal("200"+constant.DenomDev, testApp.BankKeeper.GetAllBalances(ctx, recipient).String())
+	postponedEvents, err := event.FindTypedEvents[*types.EventStreamPaymentPostponed](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Len(postponedEvents, 1)
+	stream, err = treasuryKeeper.GetStream(ctx, id)
+	requireT.NoError(err)
+	requireT.Equal(uint64(1), stream.RemainingPayments)
+	requireT.Equal(startTime.Add(3*interval), stream.NextPaymentTime)
+
+	// the last payment removes the stream
+	requireT.NoError(testApp.FundAccount(ctx, treasuryKeeper.Address(), amount))
+	ctx = ctx.WithBlockTime(startTime.Add(3 * interval))
+	requireT.NoError(treasuryKeeper.PayStreams(ctx))
+	requireT.Equal("300"+constant.DenomDev, testApp.BankKeeper.GetAllBalances(ctx, recipient).String())
+	_, err = treasuryKeeper.GetStream(ctx, id)
+	requireT.ErrorIs(err, types.ErrStreamNotFound)
+	iter, err := treasuryKeeper.StreamPayments.Iterate(ctx, nil)
+	requireT.NoError(err)
+	keys, err := iter.Keys()
+	requireT.NoError(err)
+	requireT.Empty(keys)
+}
+
+func TestKeeper_CancelStream(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Now().UTC()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{Time: startTime})
+	treasuryKeeper := testApp.TreasuryKeeper
+	funds := sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1_000))
+	requireT.NoError(testApp.FundAccount(ctx, treasuryKeeper.Address(), funds))
+
+	recipient, _ := testApp.GenAccount(ctx)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 100))
+	id, err := treasuryKeeper.CreateStream(
+		ctx, testApp.GovAuthority(), recipient, amount, time.Hour, startTime.Add(time.Hour), 5,
+	)
+	requireT.NoError(err)
+
+	requireT.ErrorIs(treasuryKeeper.CancelStream(ctx, recipient.String(), id), types.ErrInvalidAuthority)
+	requireT.ErrorIs(treasuryKeeper.CancelStream(ctx, testApp.GovAuthority(), id+1), types.ErrStreamNotFound)
+	requireT.NoError(treasuryKeeper.CancelStream(ctx, testApp.GovAuthority(), id))
+
+	// the cancelled stream isn't paid
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	requireT.NoError(treasuryKeeper.PayStreams(ctx))
+	requireT.True(testApp.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+	requireT.Equal(funds.String(), treasuryKeeper.GetBalances(ctx).String())
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesisState returns genesis state with default values.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Streams:        []Stream{},
+		StreamSequence: 1,
+	}
+}
+
+// Validate validates genesis parameters.
+func (m GenesisState) Validate() error {
+	if m.StreamSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidInput, "stream sequence must be positive")
+	}
+
+	streamIDs := make(map[uint64]struct{}, len(m.Streams))
+	for _, stream := range m.Streams {
+		if err := stream.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid stream %d", stream.ID)
+		}
+		if stream.ID >= m.StreamSequence {
+			return errorsmod.Wrapf(ErrInvalidInput, "stream ID %d is not lower than the stream sequence", stream.ID)
+		}
+		if _, found := streamIDs[stream.ID]; found {
+			return errorsmod.Wrapf(ErrInvalidInput, "duplicate stream %d", stream.ID)
+		}
+		streamIDs[stream.ID] = struct{}{}
+	}
+
+	return nil
+}
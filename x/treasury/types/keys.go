@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "treasury"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// KVStore keys.
+var (
+	StreamKey         = collections.NewPrefix(0) // Map: stream ID -> Stream
+	StreamPaymentKey  = collections.NewPrefix(1) // KeySet: (next payment time, stream ID)
+	StreamSequenceKey = collections.NewPrefix(2)
+)
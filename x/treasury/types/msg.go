@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type extendedMsg interface {
+	sdk.Msg
+	sdk.HasValidateBasic
+}
+
+var (
+	_ extendedMsg = &MsgSpend{}
+	_ extendedMsg = &MsgCreateStream{}
+	_ extendedMsg = &MsgCancelStream{}
+)
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgSpend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return validateSpend(m.Recipient, m.Amount)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgCreateStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if m.StartTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "start time must be set")
+	}
+	return validateStream(m.Recipient, m.Amount, m.Interval, m.Payments)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgCancelStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if m.StreamID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "stream ID must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxStreamPaymentsPerBlock is the maximum number of the stream payments made in one block. The rest is made in
+	// the next blocks.
+	MaxStreamPaymentsPerBlock = 100
+
+	// RemovalReasonCancelled is the reason of the stream removal when it's cancelled by the governance.
+	RemovalReasonCancelled = "cancelled"
+	// RemovalReasonCompleted is the reason of the stream removal when all its payments are made.
+	RemovalReasonCompleted = "completed"
+)
+
+// ValidateBasic checks that the stream fields are valid.
+func (s Stream) ValidateBasic() error {
+	if s.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "stream ID must be positive")
+	}
+	if s.NextPaymentTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "next payment time must be set")
+	}
+	return validateStream(s.Recipient, s.Amount, s.Interval, s.RemainingPayments)
+}
+
+// Committed returns the total amount of the payments left in the stream.
+func (s Stream) Committed() sdk.Coins {
+	committed := sdk.NewCoins()
+	for _, coin := range s.Amount {
+		committed = committed.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(s.RemainingPayments))))
+	}
+	return committed
+}
+
+func validateStream(recipient string, amount sdk.Coins, interval time.Duration, payments uint64) error {
+	if err := validateSpend(recipient, amount); err != nil {
+		return err
+	}
+	if interval <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "interval must be positive")
+	}
+	if payments == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "number of payments must be positive")
+	}
+	return nil
+}
+
+func validateSpend(recipient string, amount sdk.Coins) error {
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid recipient address %q: %s", recipient, err)
+	}
+	if err := amount.Validate(); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, err.Error())
+	}
+	if amount.IsZero() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "amount must be positive")
+	}
+	return nil
+}
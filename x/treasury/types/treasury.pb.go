@@ -0,0 +1,581 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/treasury/v1/treasury.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Stream is the recurring spend of the treasury approved by the governance. The amount is paid to the recipient
+// once per interval until all the payments are made or the stream is cancelled.
+type Stream struct {
+	ID        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is paid to the recipient on every payment.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// interval is the time between the payments.
+	Interval time.Duration `protobuf:"bytes,4,opt,name=interval,proto3,stdduration" json:"interval"`
+	// next_payment_time is the time after which the next payment is made.
+	NextPaymentTime time.Time `protobuf:"bytes,5,opt,name=next_payment_time,json=nextPaymentTime,proto3,stdtime" json:"next_payment_time"`
+	// remaining_payments is the number of the payments left, the stream is removed once it reaches zero.
+	RemainingPayments uint64 `protobuf:"varint,6,opt,name=remaining_payments,json=remainingPayments,proto3" json:"remaining_payments,omitempty"`
+}
+
+func (m *Stream) Reset()         { *m = Stream{} }
+func (m *Stream) String() string { return proto.CompactTextString(m) }
+func (*Stream) ProtoMessage()    {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff7f8371f4a26620, []int{0}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(m, src)
+}
+func (m *Stream) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stream proto.InternalMessageInfo
+
+func (m *Stream) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Stream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Stream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Stream) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Stream) GetNextPaymentTime() time.Time {
+	if m != nil {
+		return m.NextPaymentTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetRemainingPayments() uint64 {
+	if m != nil {
+		return m.RemainingPayments
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Stream)(nil), "coreum.treasury.v1.Stream")
+}
+
+func init() { proto.RegisterFile("coreum/treasury/v1/treasury.proto", fileDescriptor_ff7f8371f4a26620) }
+
+var fileDescriptor_ff7f8371f4a26620 = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
+	0x10, 0x3d, 0x3b, 0xc1, 0x4a, 0x4c, 0x81, 0xb2, 0x8a, 0x90, 0x73, 0x85, 0x7d, 0x50, 0x5d, 0x63,
+	0x2f, 0x17, 0x24, 0x28, 0x11, 0x26, 0x0d, 0x12, 0x45, 0xe4, 0x50, 0xd1, 0x9c, 0xd6, 0xf6, 0xe2,
+	0xac, 0x92, 0xdd, 0xb5, 0x76, 0xc7, 0xd6, 0x1d, 0x5f, 0x91, 0x92, 0x6f, 0xa0, 0xe6, 0x23, 0x52,
+	0x46, 0x54, 0x54, 0x09, 0xba, 0xfb, 0x10, 0xd0, 0x7a, 0xf7, 0xee, 0x10, 0x54, 0xde, 0x99, 0x37,
+	0xef, 0xcd, 0xbc, 0x27, 0x87, 0xcf, 0x2a, 0xa9, 0x68, 0xc7, 0x31, 0x28, 0x4a, 0x74, 0xa7, 0x96,
+	0xb8, 0x9f, 0x6d, 0xdf, 0x59, 0xab, 0x24, 0x48, 0x84, 0xec, 0x48, 0xb6, 0x6d, 0xf7, 0xb3, 0x71,
+	0x5c, 0x49, 0xcd, 0xa5, 0xc6, 0x25, 0xd1, 0x14, 0xf7, 0xb3, 0x92, 0x02, 0x99, 0xe1, 0x4a, 0x32,
+	0x61, 0x39, 0xe3, 0x13, 0x8b, 0xcf, 0x87, 0x0a, 0xdb, 0xc2, 0x41, 0xc7, 0x8d, 0x6c, 0xa4, 0xed,
+	0x9b, 0x97, 0xeb, 0xc6, 0x8d, 0x94, 0xcd, 0x35, 0xc5, 0x43, 0x55, 0x76, 0x9f, 0x71, 0xdd, 0x29,
+	0x02, 0x4c, 0x6e, 0x04, 0x93, 0x7f, 0x71, 0x60, 0x9c, 0x6a, 0x20, 0xbc, 0xb5, 0x03, 0xcf, 0x7f,
+	0xfb, 0x61, 0x70, 0x61, 0x4e, 0xe4, 0xe8, 0x69, 0xe8, 0xb3, 0x3a, 0xf2, 0x26, 0xde, 0x74, 0x3f,
+	0x0f, 0x56, 0xf7, 0x89, 0xff, 0xfe, 0xac, 0xf0, 0x59, 0x8d, 0x5e, 0x85, 0x87, 0x8a, 0x56, 0xac,
+	0x65, 0x54, 0x40, 0xe4, 0x4f, 0xbc, 0xe9, 0x61, 0x1e, 0xfd, 0xf8, 0x9e, 0x1e, 0xbb, 0xf3, 0xde,
+	0xd6, 0xb5, 0xa2, 0x5a, 0x5f, 0x80, 0x62, 0xa2, 0x29, 0x76, 0xa3, 0xa8, 0x0a, 0x03, 0xc2, 0x65,
+	0x27, 0x20, 0xda, 0x9b, 0xec, 0x4d, 0x1f, 0x9f, 0x9e, 0x64, 0x8e, 0x61, 0xdc, 0x67, 0xce, 0x7d,
+	0xf6, 0x4e, 0x32, 0x91, 0xbf, 0xb8, 0xbd, 0x4f, 0x46, 0xdf, 0x1e, 0x92, 0x69, 0xc3, 0xe0, 0xb2,
+	0x2b, 0xb3, 0x4a, 0x72, 0xe7, 0xde, 0x7d, 0x52, 0x5d, 0x5f, 0x61, 0x58, 0xb6, 0x54, 0x0f, 0x04,
+	0x5d, 0x38, 0x69, 0xf4, 0x26, 0x3c, 0x60, 0x02, 0xa8, 0xea, 0xc9, 0x75, 0xb4, 0x3f, 0xf1, 0x86,
+	0x35, 0xd6, 0x73, 0xb6, 0xf1, 0x9c, 0x9d, 0xb9, 0x4c, 0xf2, 0x03, 0xb3, 0xe6, 0xeb, 0x43, 0xe2,
+	0x15, 0x5b, 0x12, 0x3a, 0x0f, 0x8f, 0x04, 0x5d, 0xc0, 0xbc, 0x25, 0x4b, 0x4e, 0x05, 0xcc, 0x4d,
+	0x40, 0xd1, 0xa3, 0x41, 0x69, 0xfc, 0x9f, 0xd2, 0xc7, 0x4d, 0x7a, 0x56, 0xea, 0xc6, 0x48, 0x3d,
+	0x31, 0xf4, 0x73, 0xcb, 0x36, 0x38, 0x4a, 0x43, 0xa4, 0x28, 0x27, 0x4c, 0x30, 0xd1, 0x6c, 0x64,
+	0x75, 0x14, 0x98, 0x5c, 0x8b, 0xa3, 0x2d, 0xe2, 0x18, 0x3a, 0xff, 0x70, 0xbb, 0x8a, 0xbd, 0xbb,
+	0x55, 0xec, 0xfd, 0x5a, 0xc5, 0xde, 0xcd, 0x3a, 0x1e, 0xdd, 0xad, 0xe3, 0xd1, 0xcf, 0x75, 0x3c,
+	0xfa, 0x74, 0xfa, 0x57, 0x1a, 0x20, 0xaf, 0xa8, 0x60, 0x5f, 0x68, 0xba, 0xc0, 0xb0, 0x48, 0xab,
+	0x4b, 0xc2, 0x04, 0xee, 0x5f, 0xe3, 0xc5, 0xee, 0x0f, 0x1c, 0xd2, 0x29, 0x83, 0xe1, 0xd6, 0x97,
+	0x7f, 0x06, 0x00, 0x3d, 0x66, 0x2e, 0xa6, 0xa1, 0x02, 0x00, 0x00,
+}
+
+func (m *Stream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingPayments != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.RemainingPayments))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextPaymentTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextPaymentTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTreasury(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTreasury(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTreasury(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTreasury(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTreasury(uint64(m.ID))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTreasury(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextPaymentTime)
+	n += 1 + l + sovTreasury(uint64(l))
+	if m.RemainingPayments != 0 {
+		n += 1 + sovTreasury(uint64(m.RemainingPayments))
+	}
+	return n
+}
+
+func sovTreasury(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTreasury(x uint64) (n int) {
+	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Stream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextPaymentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPayments", wireType)
+			}
+			m.RemainingPayments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingPayments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTreasury(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTreasury
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTreasury
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTreasury
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTreasury        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTreasury          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTreasury = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/tokenize-x/tx-chain/v7/x/txtrace/types"
+)
+
+// FlagStateHeight is the flag of the height of the state the transaction is traced against.
+const FlagStateHeight = "state-height"
+
+// GetQueryCmd returns the parent command for all CLI query commands. The
+// provided clientCtx should have, at a minimum, a verifier, Tendermint RPC client,
+// and marshaler set.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the tracing of the transactions",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryTraceTx())
+	cmd.AddCommand(CmdQueryTraceTxFile())
+
+	return cmd
+}
+
+// CmdQueryTraceTx implements a command to trace the transaction included in the block.
+func CmdQueryTraceTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx [tx-hash]",
+		Short: "Trace the transaction included in the block",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Re-execute the transaction included in the block against the state of the previous block and
+print the gas consumed by each ante decorator, the events of each message and the error the transaction failed with.
+The transactions executed before it in the same block aren't re-executed. The tracing must be enabled on the node.
+
+Example:
+$ %[1]s query %[2]s tx 9C7A2B4E3F...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return errors.Wrapf(err, "invalid tx hash %s", args[0])
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			resTx, err := node.Tx(cmd.Context(), hash, false)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get tx %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TraceTx(cmd.Context(), &types.QueryTraceTxRequest{
+				TxBytes: resTx.Tx,
+				Height:  resTx.Height - 1,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryTraceTxFile implements a command to trace the signed transaction stored in the file.
+func CmdQueryTraceTxFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-file [file]",
+		Short: "Trace the signed transaction stored in the file",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the signed transaction stored in the JSON file against the state at the height,
+the latest one by default, and print the gas consumed by each ante decorator, the events of each message and the error
+the transaction failed with. The state changes are discarded. The tracing must be enabled on the node.
+
+Example:
+$ %[1]s query %[2]s tx-file signed.json --%[3]s 1000
+`,
+				version.AppName, types.ModuleName, FlagStateHeight,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(FlagStateHeight)
+			if err != nil {
+				return err
+			}
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return errors.Wrap(err, "failed to encode tx")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TraceTx(cmd.Context(), &types.QueryTraceTxRequest{
+				TxBytes: txBytes,
+				Height:  height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStateHeight, 0, "The height of the state the transaction is executed against, latest if 0")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tokenize-x/tx-chain/v7/x/txtrace/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	TraceTx(txBytes []byte, height int64) (types.QueryTraceTxResponse, error)
+}
+
+// QueryService serves grpc query requests for the txtrace module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// TraceTx re-executes the transaction against the historical state and returns the trace of the execution.
+func (qs QueryService) TraceTx(
+	_ context.Context,
+	req *types.QueryTraceTxRequest,
+) (*types.QueryTraceTxResponse, error) {
+	res, err := qs.keeper.TraceTx(req.TxBytes, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
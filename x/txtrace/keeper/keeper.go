@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"reflect"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+
+	"github.com/tokenize-x/tx-chain/v7/x/txtrace/types"
+)
+
+// Keeper re-executes the transactions against the historical state to trace them.
+type Keeper struct {
+	enabled         bool
+	contextProvider types.ContextProvider
+	txDecoder       sdk.TxDecoder
+	msgRouter       types.MsgRouter
+	anteDecorators  []sdk.AnteDecorator
+}
+
+// NewKeeper returns a new keeper object. The tracing is rejected if it isn't enabled.
+func NewKeeper(
+	enabled bool,
+	contextProvider types.ContextProvider,
+	txDecoder sdk.TxDecoder,
+	msgRouter types.MsgRouter,
+) *Keeper {
+	return &Keeper{
+		enabled:         enabled,
+		contextProvider: contextProvider,
+		txDecoder:       txDecoder,
+		msgRouter:       msgRouter,
+	}
+}
+
+// SetAnteDecorators sets the decorators of the ante handler the transactions are traced through.
+func (k *Keeper) SetAnteDecorators(anteDecorators []sdk.AnteDecorator) {
+	k.anteDecorators = anteDecorators
+}
+
+// TraceTx executes the transaction against the committed state at the height, the latest one if the height is 0,
+// as if it was included in the next block. The state changes are discarded.
+func (k *Keeper) TraceTx(txBytes []byte, height int64) (types.QueryTraceTxResponse, error) {
+	if !k.enabled {
+		return types.QueryTraceTxResponse{}, types.ErrTracingDisabled
+	}
+	if len(txBytes) == 0 {
+		return types.QueryTraceTxResponse{}, sdkerrors.Wrap(types.ErrInvalidInput, "tx bytes must not be empty")
+	}
+	if height < 0 {
+		return types.QueryTraceTxResponse{}, sdkerrors.Wrap(types.ErrInvalidInput, "height must not be negative")
+	}
+
+	tx, err := k.txDecoder(txBytes)
+	if err != nil {
+		return types.QueryTraceTxResponse{}, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid tx: %s", err)
+	}
+	ctx, err := k.contextProvider(height, false)
+	if err != nil {
+		return types.QueryTraceTxResponse{}, err
+	}
+
+	res := types.QueryTraceTxResponse{
+		Height: ctx.BlockHeight(),
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		res.GasWanted = feeTx.GetGas()
+	}
+
+	ctx = ctx.
+		WithBlockHeight(ctx.BlockHeight() + 1).
+		WithIsCheckTx(false).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithTxBytes(txBytes).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+
+	var failedDecorator string
+	anteCtx, err := k.traceAnte(ctx, tx, &res, &failedDecorator)
+	if anteCtx.GasMeter() != nil {
+		ctx = anteCtx
+	}
+	if err != nil {
+		res.GasUsed = ctx.GasMeter().GasConsumed()
+		res.Error = newTraceError(err)
+		res.Error.AnteDecorator = failedDecorator
+		return res, nil
+	}
+
+	for i, msg := range tx.GetMsgs() {
+		trace, err := k.traceMsg(ctx, msg)
+		res.Messages = append(res.Messages, trace)
+		if err != nil {
+			res.Error = newTraceError(err)
+			res.Error.MessageIndex = uint32(i)
+			break
+		}
+	}
+	res.GasUsed = ctx.GasMeter().GasConsumed()
+
+	return res, nil
+}
+
+func (k *Keeper) traceAnte(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	res *types.QueryTraceTxResponse,
+	failedDecorator *string,
+) (sdk.Context, error) {
+	handler := sdk.AnteHandler(func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	for i := len(k.anteDecorators) - 1; i >= 0; i-- {
+		handler = traceAnteDecorator(k.anteDecorators[i], handler, res, failedDecorator)
+	}
+
+	return handler(ctx, tx, false)
+}
+
+// traceAnteDecorator wraps the decorator to record the gas it consumes before passing the transaction to the next
+// one. The name of the innermost decorator returning the error is stored in failedDecorator.
+func traceAnteDecorator(
+	decorator sdk.AnteDecorator,
+	next sdk.AnteHandler,
+	res *types.QueryTraceTxResponse,
+	failedDecorator *string,
+) sdk.AnteHandler {
+	name := decoratorName(decorator)
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+		res.AnteDecorators = append(res.AnteDecorators, types.AnteDecoratorTrace{Name: name})
+		index := len(res.AnteDecorators) - 1
+		gasMeter := ctx.GasMeter()
+		gasBefore := gasMeter.GasConsumed()
+		nextCalled := false
+
+		defer func() {
+			if r := recover(); r != nil {
+				newCtx, err = ctx, panicError(r)
+			}
+			if !nextCalled {
+				res.AnteDecorators[index].GasConsumed = gasConsumed(gasMeter, gasBefore, newCtx.GasMeter())
+			}
+			if err != nil && *failedDecorator == "" {
+				*failedDecorator = name
+			}
+		}()
+
+		tracedNext := func(nextCtx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			nextCalled = true
+			res.AnteDecorators[index].GasConsumed = gasConsumed(gasMeter, gasBefore, nextCtx.GasMeter())
+			return next(nextCtx, tx, simulate)
+		}
+
+		return decorator.AnteHandle(ctx, tx, simulate, tracedNext)
+	}
+}
+
+func (k *Keeper) traceMsg(ctx sdk.Context, msg sdk.Msg) (trace types.MessageTrace, err error) {
+	trace.TypeUrl = sdk.MsgTypeURL(msg)
+	handler := k.msgRouter.Handler(msg)
+	if handler == nil {
+		return trace, sdkerrors.Wrapf(cosmoserrors.ErrUnknownRequest, "no message handler found for %s", trace.TypeUrl)
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+		trace.GasConsumed = ctx.GasMeter().GasConsumed() - gasBefore
+	}()
+
+	msgRes, err := handler(ctx.WithEventManager(sdk.NewEventManager()), msg)
+	if msgRes != nil {
+		trace.Events = msgRes.Events
+	}
+
+	return trace, err
+}
+
+// gasConsumed returns the gas consumed since the gas meter had consumed gasBefore, including the gas consumed by the
+// new gas meter if the decorator replaced it.
+func gasConsumed(gasMeter storetypes.GasMeter, gasBefore storetypes.Gas, newGasMeter storetypes.GasMeter) uint64 {
+	consumed := gasMeter.GasConsumed() - gasBefore
+	if newGasMeter != nil && newGasMeter != gasMeter {
+		consumed += newGasMeter.GasConsumed()
+	}
+
+	return consumed
+}
+
+func decoratorName(decorator sdk.AnteDecorator) string {
+	t := reflect.TypeOf(decorator)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
+
+func panicError(r any) error {
+	if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+		return sdkerrors.Wrapf(cosmoserrors.ErrOutOfGas, "out of gas in location: %s", oog.Descriptor)
+	}
+
+	return sdkerrors.Wrapf(cosmoserrors.ErrPanic, "%v", r)
+}
+
+func newTraceError(err error) *types.TraceError {
+	codespace, code, log := sdkerrors.ABCIInfo(err, false)
+	traceErr := &types.TraceError{
+		Codespace: codespace,
+		Code:      code,
+		Log:       log,
+	}
+
+	// the reason is empty for the errors not registered by the modules
+	var registeredErr *sdkerrors.Error
+	if errors.As(err, &registeredErr) {
+		traceErr.Reason = registeredErr.Error()
+	}
+
+	return traceErr
+}
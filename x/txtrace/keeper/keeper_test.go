@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/app"
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	"github.com/tokenize-x/tx-chain/v7/x/txtrace/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/txtrace/types"
+)
+
+func TestKeeper_TraceTx(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New(simapp.WithAppOption(app.TxTraceEnabledAppOption, true))
+	queryService := keeper.NewQueryService(testApp.TxTraceKeeper)
+	commit := func() {
+		requireT.NoError(testApp.FinalizeBlock())
+		_, err := testApp.Commit()
+		requireT.NoError(err)
+	}
+	commit()
+
+	ctx := testApp.NewUncachedContext(false, tmproto.Header{Height: testApp.LastBlockHeight(), Time: time.Now()})
+	bondDenom, err := testApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+
+	issuer, _ := testApp.GenAccount(ctx)
+	holder, holderPriv := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, holder, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000))))
+	poor, poorPriv := testApp.GenAccount(ctx)
+	recipient, _ := testApp.GenAccount(ctx)
+
+	denom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_freezing},
+	})
+	requireT.NoError(err)
+	requireT.NoError(testApp.BankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	commit()
+
+	genTx := func(priv *secp256k1.PrivKey, msg sdk.Msg) []byte {
+		tx, err := testApp.GenTx(ctx, sdk.NewInt64Coin(bondDenom, 1_000_000), 300_000, priv, msg)
+		requireT.NoError(err)
+		txBytes, err := testApp.TxConfig().TxEncoder()(tx)
+		requireT.NoError(err)
+		return txBytes
+	}
+	sendTx := genTx(holderPriv, &banktypes.MsgSend{
+		FromAddress: holder.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 10)),
+	})
+
+	// successful tx
+	res, err := queryService.TraceTx(ctx, &types.QueryTraceTxRequest{TxBytes: sendTx})
+	requireT.NoError(err)
+	requireT.Nil(res.Error)
+	requireT.Equal(testApp.LastBlockHeight(), res.Height)
+	requireT.EqualValues(300_000, res.GasWanted)
+	requireT.Positive(res.GasUsed)
+	requireT.NotEmpty(res.AnteDecorators)
+	requireT.True(strings.HasSuffix(res.AnteDecorators[0].Name, ".SetUpContextDecorator"))
+	requireT.Len(res.Messages, 1)
+	requireT.Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), res.Messages[0].TypeUrl)
+	requireT.Positive(res.Messages[0].GasConsumed)
+	requireT.NotEmpty(res.Messages[0].Events)
+
+	// the state isn't changed
+	requireT.True(testApp.BankKeeper.GetBalance(ctx, recipient, denom).IsZero())
+
+	// the tx blocked by the global freeze
+	heightBeforeFreeze := testApp.LastBlockHeight()
+	requireT.NoError(testApp.AssetFTKeeper.GloballyFreeze(ctx, issuer, denom))
+	commit()
+
+	res, err = queryService.TraceTx(ctx, &types.QueryTraceTxRequest{TxBytes: sendTx})
+	requireT.NoError(err)
+	requireT.NotNil(res.Error)
+	requireT.Empty(res.Error.AnteDecorator)
+	requireT.EqualValues(0, res.Error.MessageIndex)
+	requireT.Equal(assetfttypes.ModuleName, res.Error.Codespace)
+	requireT.Equal(assetfttypes.ErrGloballyFrozen.ABCICode(), res.Error.Code)
+	requireT.Equal(assetfttypes.ErrGloballyFrozen.Error(), res.Error.Reason)
+	requireT.Contains(res.Error.Log, "is globally frozen")
+	requireT.Len(res.Messages, 1)
+
+	// the same tx traced against the state before the freeze
+	res, err = queryService.TraceTx(ctx, &types.QueryTraceTxRequest{TxBytes: sendTx, Height: heightBeforeFreeze})
+	requireT.NoError(err)
+	requireT.Nil(res.Error)
+	requireT.Equal(heightBeforeFreeze, res.Height)
+
+	// the tx failed in the ante handler
+	res, err = queryService.TraceTx(ctx, &types.QueryTraceTxRequest{TxBytes: genTx(poorPriv, &banktypes.MsgSend{
+		FromAddress: poor.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1)),
+	})})
+	requireT.NoError(err)
+	requireT.NotNil(res.Error)
+	requireT.True(strings.HasSuffix(res.Error.AnteDecorator, ".DeductFeeDecorator"), res.Error.AnteDecorator)
+	requireT.Equal(cosmoserrors.ErrInsufficientFunds.ABCICode(), res.Error.Code)
+	requireT.Empty(res.Messages)
+	requireT.True(strings.HasSuffix(res.AnteDecorators[len(res.AnteDecorators)-1].Name, ".DeductFeeDecorator"))
+
+	// invalid input
+	_, err = queryService.TraceTx(ctx, &types.QueryTraceTxRequest{})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	_, err = queryService.TraceTx(ctx, &types.QueryTraceTxRequest{TxBytes: sendTx, Height: -1})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
+
+func TestKeeper_TraceTx_Disabled(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	_, err := keeper.NewQueryService(testApp.TxTraceKeeper).TraceTx(
+		testApp.NewContext(false), &types.QueryTraceTxRequest{TxBytes: []byte{0x01}},
+	)
+	requireT.ErrorIs(err, types.ErrTracingDisabled)
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidInput is returned if input data are invalid.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
+	// ErrTracingDisabled is returned if the tracing of the transactions is disabled on the node.
+	ErrTracingDisabled = sdkerrors.Register(ModuleName, 2, "tx tracing is disabled")
+)
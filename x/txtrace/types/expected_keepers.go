@@ -0,0 +1,15 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContextProvider creates the context of the committed state at the height, the latest one if the height is 0.
+// It matches the BaseApp.CreateQueryContext.
+type ContextProvider func(height int64, prove bool) (sdk.Context, error)
+
+// MsgRouter returns the handlers of the messages.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
@@ -0,0 +1,63 @@
+package wasm
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+	"github.com/tokenize-x/tx-chain/v7/x/wasm/types"
+)
+
+// executionMsgTypeURLs are the messages executing the smart contract code.
+var executionMsgTypeURLs = map[string]struct{}{
+	sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}):             {},
+	sdk.MsgTypeURL(&wasmtypes.MsgInstantiateContract{}):         {},
+	sdk.MsgTypeURL(&wasmtypes.MsgInstantiateContract2{}):        {},
+	sdk.MsgTypeURL(&wasmtypes.MsgMigrateContract{}):             {},
+	sdk.MsgTypeURL(&wasmtypes.MsgSudoContract{}):                {},
+	sdk.MsgTypeURL(&wasmtypes.MsgStoreAndInstantiateContract{}): {},
+	sdk.MsgTypeURL(&wasmtypes.MsgStoreAndMigrateContract{}):     {},
+}
+
+// CircuitBreaker rejects the messages executing the smart contracts while the execution is paused by the governance.
+// Since it is applied by the message router, the messages are rejected no matter whether they are sent in the
+// transaction directly, wrapped by authz or executed by the governance proposal.
+type CircuitBreaker struct {
+	customParamsKeeper types.CustomParamsKeeper
+}
+
+// NewCircuitBreaker returns the new instance of the CircuitBreaker.
+func NewCircuitBreaker(customParamsKeeper types.CustomParamsKeeper) CircuitBreaker {
+	return CircuitBreaker{
+		customParamsKeeper: customParamsKeeper,
+	}
+}
+
+// IsAllowed implements the baseapp.CircuitBreaker interface.
+func (cb CircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	if _, ok := executionMsgTypeURLs[typeURL]; !ok {
+		return true, nil
+	}
+
+	if err := validateNotPaused(sdk.UnwrapSDKContext(ctx), cb.customParamsKeeper); err != nil {
+		return false, sdkerrors.Wrapf(err, "message %s is not allowed", typeURL)
+	}
+
+	return true, nil
+}
+
+// validateNotPaused returns an error if the execution of the smart contracts is paused by the governance.
+func validateNotPaused(ctx sdk.Context, customParamsKeeper types.CustomParamsKeeper) error {
+	params, err := customParamsKeeper.GetWasmParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.Paused {
+		return customparamstypes.ErrWasmPaused
+	}
+
+	return nil
+}
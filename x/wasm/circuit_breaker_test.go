@@ -0,0 +1,40 @@
+package wasm_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+)
+
+func TestCircuitBreaker_WasmPaused(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := &wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: contract.String(),
+		Msg:      []byte("{}"),
+	}
+	handler := testApp.MsgServiceRouter().Handler(msg)
+	requireT.NotNil(handler)
+
+	// the execution fails on the missing contract
+	_, err := handler(ctx, msg)
+	requireT.ErrorIs(err, wasmtypes.ErrNoSuchContractFn(contract.String()))
+
+	// the execution is rejected before reaching the contract
+	requireT.NoError(testApp.CustomParamsKeeper.SetWasmParams(ctx, customparamstypes.WasmParams{Paused: true}))
+	_, err = handler(ctx, msg)
+	requireT.ErrorIs(err, customparamstypes.ErrWasmPaused)
+}
@@ -0,0 +1,247 @@
+package wasm
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibccallbackstypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	"github.com/tokenize-x/tx-chain/v7/x/wasm/types"
+)
+
+var _ ibccallbackstypes.ContractKeeper = IBCCallbacksContractKeeper{}
+
+// IBCCallbacksContractKeeper is the contract keeper of the IBC callbacks middleware, executing the callbacks of the
+// smart contracts by the wrapped wasm keeper. The callbacks of the ICS-20 transfers of the asset ft tokens with the
+// block_smart_contracts feature are rejected, unless the contract is the admin of the token, and the gas of the
+// callbacks is limited by the max callback gas of the IBC params. The callbacks are rejected while the execution of
+// the smart contracts is paused by the governance.
+type IBCCallbacksContractKeeper struct {
+	contractKeeper     ibccallbackstypes.ContractKeeper
+	assetFTKeeper      types.AssetFTKeeper
+	customParamsKeeper types.CustomParamsKeeper
+}
+
+// NewIBCCallbacksContractKeeper returns the new instance of the IBCCallbacksContractKeeper.
+func NewIBCCallbacksContractKeeper(
+	contractKeeper ibccallbackstypes.ContractKeeper,
+	assetFTKeeper types.AssetFTKeeper,
+	customParamsKeeper types.CustomParamsKeeper,
+) IBCCallbacksContractKeeper {
+	return IBCCallbacksContractKeeper{
+		contractKeeper:     contractKeeper,
+		assetFTKeeper:      assetFTKeeper,
+		customParamsKeeper: customParamsKeeper,
+	}
+}
+
+// IBCSendPacketCallback implements the ContractKeeper interface. The rejected callback fails the packet send.
+func (k IBCCallbacksContractKeeper) IBCSendPacketCallback(
+	cachedCtx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	packetData []byte,
+	contractAddress,
+	packetSenderAddress string,
+	version string,
+) error {
+	if err := validateNotPaused(cachedCtx, k.customParamsKeeper); err != nil {
+		return err
+	}
+	if err := k.validateSentDenom(cachedCtx, sourcePort, packetData, contractAddress, version); err != nil {
+		return err
+	}
+
+	return k.withCallbackGasLimit(cachedCtx, func(ctx sdk.Context) error {
+		return k.contractKeeper.IBCSendPacketCallback(
+			ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, contractAddress,
+			packetSenderAddress, version,
+		)
+	})
+}
+
+// IBCOnAcknowledgementPacketCallback implements the ContractKeeper interface.
+func (k IBCCallbacksContractKeeper) IBCOnAcknowledgementPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+	version string,
+) error {
+	if err := validateNotPaused(cachedCtx, k.customParamsKeeper); err != nil {
+		return err
+	}
+	if err := k.validateSentDenom(
+		cachedCtx, packet.GetSourcePort(), packet.GetData(), contractAddress, version,
+	); err != nil {
+		return err
+	}
+
+	return k.withCallbackGasLimit(cachedCtx, func(ctx sdk.Context) error {
+		return k.contractKeeper.IBCOnAcknowledgementPacketCallback(
+			ctx, packet, acknowledgement, relayer, contractAddress, packetSenderAddress, version,
+		)
+	})
+}
+
+// IBCOnTimeoutPacketCallback implements the ContractKeeper interface.
+func (k IBCCallbacksContractKeeper) IBCOnTimeoutPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+	version string,
+) error {
+	if err := validateNotPaused(cachedCtx, k.customParamsKeeper); err != nil {
+		return err
+	}
+	if err := k.validateSentDenom(
+		cachedCtx, packet.GetSourcePort(), packet.GetData(), contractAddress, version,
+	); err != nil {
+		return err
+	}
+
+	return k.withCallbackGasLimit(cachedCtx, func(ctx sdk.Context) error {
+		return k.contractKeeper.IBCOnTimeoutPacketCallback(
+			ctx, packet, relayer, contractAddress, packetSenderAddress, version,
+		)
+	})
+}
+
+// IBCReceivePacketCallback implements the ContractKeeper interface.
+func (k IBCCallbacksContractKeeper) IBCReceivePacketCallback(
+	cachedCtx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+	contractAddress string,
+	version string,
+) error {
+	if err := validateNotPaused(cachedCtx, k.customParamsKeeper); err != nil {
+		return err
+	}
+	if err := k.validateReceivedDenom(cachedCtx, packet, contractAddress, version); err != nil {
+		return err
+	}
+
+	return k.withCallbackGasLimit(cachedCtx, func(ctx sdk.Context) error {
+		return k.contractKeeper.IBCReceivePacketCallback(ctx, packet, ack, contractAddress, version)
+	})
+}
+
+// validateSentDenom validates the denom of the transfer sent from this chain. Only the native denoms might be the
+// asset ft tokens, the other ones are the vouchers sent back.
+func (k IBCCallbacksContractKeeper) validateSentDenom(
+	ctx sdk.Context,
+	sourcePort string,
+	packetData []byte,
+	contractAddress string,
+	version string,
+) error {
+	if sourcePort != ibctransfertypes.PortID {
+		return nil
+	}
+	data, err := ibctransfertypes.UnmarshalPacketData(packetData, version, "")
+	if err != nil {
+		return nil //nolint:nilerr // the packets of the other applications aren't validated
+	}
+	if !data.Token.Denom.IsNative() {
+		return nil
+	}
+
+	return k.validateDenom(ctx, data.Token.Denom.Base, contractAddress)
+}
+
+// validateReceivedDenom validates the denom of the transfer received by this chain. Only the denoms returning to
+// this chain might be the asset ft tokens, the other ones are minted as the vouchers.
+func (k IBCCallbacksContractKeeper) validateReceivedDenom(
+	ctx sdk.Context,
+	packet ibcexported.PacketI,
+	contractAddress string,
+	version string,
+) error {
+	if packet.GetDestPort() != ibctransfertypes.PortID {
+		return nil
+	}
+	data, err := ibctransfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	if err != nil {
+		return nil //nolint:nilerr // the packets of the other applications aren't validated
+	}
+	denom := data.Token.Denom
+	if !denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) || len(denom.Trace) != 1 {
+		return nil
+	}
+
+	return k.validateDenom(ctx, denom.Base, contractAddress)
+}
+
+func (k IBCCallbacksContractKeeper) validateDenom(ctx sdk.Context, denom, contractAddress string) error {
+	def, err := k.assetFTKeeper.GetDefinition(ctx, denom)
+	if err != nil {
+		if sdkerrors.IsOf(err, assetfttypes.ErrInvalidDenom, assetfttypes.ErrTokenNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !def.IsFeatureEnabled(assetfttypes.Feature_block_smart_contracts) {
+		return nil
+	}
+
+	contract, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid contract address %q", contractAddress)
+	}
+	if def.HasAdminPrivileges(contract) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(
+		cosmoserrors.ErrUnauthorized,
+		"ibc callbacks of smart contracts are disabled for %s",
+		denom,
+	)
+}
+
+// withCallbackGasLimit executes the callback with the gas limited by the max callback gas of the IBC params. The
+// callback running out of the limited gas fails with the error, so the relayer doesn't retry it.
+func (k IBCCallbacksContractKeeper) withCallbackGasLimit(
+	ctx sdk.Context,
+	callback func(ctx sdk.Context) error,
+) (err error) {
+	params, err := k.customParamsKeeper.GetIBCParams(ctx)
+	if err != nil {
+		return err
+	}
+	gasMeter := ctx.GasMeter()
+	if params.MaxCallbackGas == 0 || params.MaxCallbackGas >= gasMeter.GasRemaining() {
+		return callback(ctx)
+	}
+
+	limitedGasMeter := storetypes.NewGasMeter(params.MaxCallbackGas)
+	defer func() {
+		gasMeter.ConsumeGas(limitedGasMeter.GasConsumedToLimit(), "ibc callback")
+
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok || !limitedGasMeter.IsOutOfGas() {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(
+				ibccallbackstypes.ErrCallbackOutOfGas,
+				"ibc callback exceeded the max callback gas %d",
+				params.MaxCallbackGas,
+			)
+		}
+	}()
+
+	return callback(ctx.WithGasMeter(limitedGasMeter))
+}
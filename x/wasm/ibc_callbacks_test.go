@@ -0,0 +1,216 @@
+package wasm_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibccallbackstypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+	cwasm "github.com/tokenize-x/tx-chain/v7/x/wasm"
+)
+
+type contractKeeperMock struct {
+	gas   uint64
+	calls int
+}
+
+func (m *contractKeeperMock) IBCSendPacketCallback(
+	ctx sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, _ []byte, _, _, _ string,
+) error {
+	return m.call(ctx)
+}
+
+func (m *contractKeeperMock) IBCOnAcknowledgementPacketCallback(
+	ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, _, _, _ string,
+) error {
+	return m.call(ctx)
+}
+
+func (m *contractKeeperMock) IBCOnTimeoutPacketCallback(
+	ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, _, _, _ string,
+) error {
+	return m.call(ctx)
+}
+
+func (m *contractKeeperMock) IBCReceivePacketCallback(
+	ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, _, _ string,
+) error {
+	return m.call(ctx)
+}
+
+func (m *contractKeeperMock) call(ctx sdk.Context) error {
+	m.calls++
+	ctx.GasMeter().ConsumeGas(m.gas, "callback")
+	return nil
+}
+
+func TestIBCCallbacksContractKeeper_BlockSmartContracts(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issue := func(subunit string, features ...assetfttypes.Feature) string {
+		denom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdkmath.NewInt(1000),
+			Features:      features,
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	blockedDenom := issue("blocked", assetfttypes.Feature_ibc, assetfttypes.Feature_block_smart_contracts)
+	allowedDenom := issue("allowed", assetfttypes.Feature_ibc)
+
+	contractKeeper := &contractKeeperMock{}
+	keeper := cwasm.NewIBCCallbacksContractKeeper(contractKeeper, testApp.AssetFTKeeper, testApp.CustomParamsKeeper)
+
+	packet := func(denom string) channeltypes.Packet {
+		return channeltypes.Packet{
+			SourcePort:         ibctransfertypes.PortID,
+			SourceChannel:      "channel-0",
+			DestinationPort:    ibctransfertypes.PortID,
+			DestinationChannel: "channel-1",
+			Data: ibctransfertypes.NewFungibleTokenPacketData(
+				denom, "1", issuer.String(), contract.String(), "",
+			).GetBytes(),
+		}
+	}
+	sourceCallbacks := func(denom, contractAddress string) []error {
+		p := packet(denom)
+		return []error{
+			keeper.IBCSendPacketCallback(
+				ctx, p.SourcePort, p.SourceChannel, clienttypes.ZeroHeight(), 0, p.Data, contractAddress,
+				contractAddress, ibctransfertypes.V1,
+			),
+			keeper.IBCOnAcknowledgementPacketCallback(
+				ctx, p, nil, issuer, contractAddress, contractAddress, ibctransfertypes.V1,
+			),
+			keeper.IBCOnTimeoutPacketCallback(ctx, p, issuer, contractAddress, contractAddress, ibctransfertypes.V1),
+		}
+	}
+
+	// the callbacks of the transfers of the blocked denom are rejected
+	for _, err := range sourceCallbacks(blockedDenom, contract.String()) {
+		requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	}
+	requireT.Zero(contractKeeper.calls)
+
+	// the contract being the admin of the token is allowed
+	for _, err := range sourceCallbacks(blockedDenom, issuer.String()) {
+		requireT.NoError(err)
+	}
+	requireT.Equal(3, contractKeeper.calls)
+
+	// the other denoms are allowed
+	for _, err := range sourceCallbacks(allowedDenom, contract.String()) {
+		requireT.NoError(err)
+	}
+	for _, err := range sourceCallbacks("ucore", contract.String()) {
+		requireT.NoError(err)
+	}
+	requireT.Equal(9, contractKeeper.calls)
+
+	// the blocked denom returning to the chain is rejected
+	ack := channeltypes.NewResultAcknowledgement([]byte{1})
+	returned := packet(ibctransfertypes.PortID + "/channel-0/" + blockedDenom)
+	requireT.ErrorIs(
+		keeper.IBCReceivePacketCallback(ctx, returned, ack, contract.String(), ibctransfertypes.V1),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.Equal(9, contractKeeper.calls)
+
+	// the voucher of the denom is not the asset ft token
+	voucher := packet(blockedDenom)
+	requireT.NoError(keeper.IBCReceivePacketCallback(ctx, voucher, ack, contract.String(), ibctransfertypes.V1))
+	requireT.Equal(10, contractKeeper.calls)
+}
+
+func TestIBCCallbacksContractKeeper_MaxCallbackGas(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	// the store access is free to count only the gas consumed by the callbacks
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{}).
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithGasMeter(storetypes.NewGasMeter(10_000))
+
+	contractKeeper := &contractKeeperMock{gas: 2_000}
+	keeper := cwasm.NewIBCCallbacksContractKeeper(contractKeeper, testApp.AssetFTKeeper, testApp.CustomParamsKeeper)
+	receive := func() error {
+		return keeper.IBCReceivePacketCallback(
+			ctx, channeltypes.Packet{}, channeltypes.NewResultAcknowledgement([]byte{1}), "", ibctransfertypes.V1,
+		)
+	}
+
+	// the gas of the callback isn't limited by default
+	requireT.NoError(receive())
+	requireT.EqualValues(2_000, ctx.GasMeter().GasConsumed())
+
+	ibcParams, err := testApp.CustomParamsKeeper.GetIBCParams(ctx)
+	requireT.NoError(err)
+	ibcParams.MaxCallbackGas = 1_000
+	requireT.NoError(testApp.CustomParamsKeeper.SetIBCParams(ctx, ibcParams))
+
+	// the callback exceeding the max callback gas fails and consumes the max callback gas
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(10_000))
+	requireT.ErrorIs(receive(), ibccallbackstypes.ErrCallbackOutOfGas)
+	requireT.EqualValues(1_000, ctx.GasMeter().GasConsumed())
+
+	// the callback within the max callback gas succeeds
+	contractKeeper.gas = 500
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(10_000))
+	requireT.NoError(receive())
+	requireT.EqualValues(500, ctx.GasMeter().GasConsumed())
+}
+
+func TestIBCCallbacksContractKeeper_WasmPaused(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	contractKeeper := &contractKeeperMock{}
+	keeper := cwasm.NewIBCCallbacksContractKeeper(contractKeeper, testApp.AssetFTKeeper, testApp.CustomParamsKeeper)
+	callbacks := func() []error {
+		p := channeltypes.Packet{}
+		return []error{
+			keeper.IBCSendPacketCallback(ctx, "", "", clienttypes.ZeroHeight(), 0, nil, "", "", ibctransfertypes.V1),
+			keeper.IBCOnAcknowledgementPacketCallback(ctx, p, nil, nil, "", "", ibctransfertypes.V1),
+			keeper.IBCOnTimeoutPacketCallback(ctx, p, nil, "", "", ibctransfertypes.V1),
+			keeper.IBCReceivePacketCallback(
+				ctx, p, channeltypes.NewResultAcknowledgement([]byte{1}), "", ibctransfertypes.V1,
+			),
+		}
+	}
+
+	for _, err := range callbacks() {
+		requireT.NoError(err)
+	}
+	requireT.Equal(4, contractKeeper.calls)
+
+	// the callbacks are rejected before reaching the contract
+	requireT.NoError(testApp.CustomParamsKeeper.SetWasmParams(ctx, customparamstypes.WasmParams{Paused: true}))
+	for _, err := range callbacks() {
+		requireT.ErrorIs(err, customparamstypes.ErrWasmPaused)
+	}
+	requireT.Equal(4, contractKeeper.calls)
+}
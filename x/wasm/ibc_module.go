@@ -0,0 +1,138 @@
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/tokenize-x/tx-chain/v7/x/wasm/types"
+)
+
+var _ porttypes.IBCModule = PausableIBCModule{}
+
+// PausableIBCModule wraps the IBC module of the smart contracts and rejects the IBC calls executing the contracts while
+// the execution is paused by the governance. The received packets are acknowledged with the error. The channel
+// handshakes, acknowledgements and timeouts fail, so they might be relayed again once the execution is resumed.
+type PausableIBCModule struct {
+	app                porttypes.IBCModule
+	customParamsKeeper types.CustomParamsKeeper
+}
+
+// NewPausableIBCModule returns the new instance of the PausableIBCModule.
+func NewPausableIBCModule(app porttypes.IBCModule, customParamsKeeper types.CustomParamsKeeper) PausableIBCModule {
+	return PausableIBCModule{
+		app:                app,
+		customParamsKeeper: customParamsKeeper,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (m PausableIBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return "", err
+	}
+	return m.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (m PausableIBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return "", err
+	}
+	return m.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (m PausableIBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return err
+	}
+	return m.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (m PausableIBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return err
+	}
+	return m.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (m PausableIBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return err
+	}
+	return m.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (m PausableIBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return err
+	}
+	return m.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (m PausableIBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return m.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (m PausableIBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return err
+	}
+	return m.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (m PausableIBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := validateNotPaused(ctx, m.customParamsKeeper); err != nil {
+		return err
+	}
+	return m.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
+}
@@ -0,0 +1,116 @@
+package wasm_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+	cwasm "github.com/tokenize-x/tx-chain/v7/x/wasm"
+)
+
+var _ porttypes.IBCModule = &ibcModuleMock{}
+
+type ibcModuleMock struct {
+	calls int
+}
+
+func (m *ibcModuleMock) OnChanOpenInit(
+	_ sdk.Context, _ channeltypes.Order, _ []string, _, _ string, _ channeltypes.Counterparty, version string,
+) (string, error) {
+	m.calls++
+	return version, nil
+}
+
+func (m *ibcModuleMock) OnChanOpenTry(
+	_ sdk.Context, _ channeltypes.Order, _ []string, _, _ string, _ channeltypes.Counterparty, version string,
+) (string, error) {
+	m.calls++
+	return version, nil
+}
+
+func (m *ibcModuleMock) OnChanOpenAck(_ sdk.Context, _, _, _, _ string) error {
+	m.calls++
+	return nil
+}
+
+func (m *ibcModuleMock) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	m.calls++
+	return nil
+}
+
+func (m *ibcModuleMock) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	m.calls++
+	return nil
+}
+
+func (m *ibcModuleMock) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	m.calls++
+	return nil
+}
+
+func (m *ibcModuleMock) OnRecvPacket(
+	_ sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	m.calls++
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func (m *ibcModuleMock) OnAcknowledgementPacket(
+	_ sdk.Context, _ string, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress,
+) error {
+	m.calls++
+	return nil
+}
+
+func (m *ibcModuleMock) OnTimeoutPacket(_ sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	m.calls++
+	return nil
+}
+
+func TestPausableIBCModule(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	app := &ibcModuleMock{}
+	module := cwasm.NewPausableIBCModule(app, testApp.CustomParamsKeeper)
+	call := func() ([]error, ibcexported.Acknowledgement) {
+		_, initErr := module.OnChanOpenInit(ctx, channeltypes.UNORDERED, nil, "", "", channeltypes.Counterparty{}, "")
+		_, tryErr := module.OnChanOpenTry(ctx, channeltypes.UNORDERED, nil, "", "", channeltypes.Counterparty{}, "")
+		ack := module.OnRecvPacket(ctx, "", channeltypes.Packet{}, nil)
+		return []error{
+			initErr,
+			tryErr,
+			module.OnChanOpenAck(ctx, "", "", "", ""),
+			module.OnChanOpenConfirm(ctx, "", ""),
+			module.OnChanCloseInit(ctx, "", ""),
+			module.OnChanCloseConfirm(ctx, "", ""),
+			module.OnAcknowledgementPacket(ctx, "", channeltypes.Packet{}, nil, nil),
+			module.OnTimeoutPacket(ctx, "", channeltypes.Packet{}, nil),
+		}, ack
+	}
+
+	errs, ack := call()
+	for _, err := range errs {
+		requireT.NoError(err)
+	}
+	requireT.True(ack.Success())
+	requireT.Equal(9, app.calls)
+
+	// the calls are rejected before reaching the contracts, the received packet is acknowledged with the error
+	requireT.NoError(testApp.CustomParamsKeeper.SetWasmParams(ctx, customparamstypes.WasmParams{Paused: true}))
+	errs, ack = call()
+	for _, err := range errs {
+		requireT.ErrorIs(err, customparamstypes.ErrWasmPaused)
+	}
+	requireT.False(ack.Success())
+	requireT.Equal(9, app.calls)
+}
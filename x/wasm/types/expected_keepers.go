@@ -3,10 +3,27 @@ package types
 import (
 	"context"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	assetfttypes "github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 )
 
 // WasmKeeper defines methods required from the WASM keeper.
 type WasmKeeper interface {
 	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	IsPinnedCode(ctx context.Context, codeID uint64) bool
+}
+
+// CustomParamsKeeper defines methods required from the custom params keeper.
+type CustomParamsKeeper interface {
+	GetWasmParams(ctx sdk.Context) (customparamstypes.WasmParams, error)
+	GetIBCParams(ctx sdk.Context) (customparamstypes.IBCParams, error)
+}
+
+// AssetFTKeeper defines methods required from the asset ft keeper.
+type AssetFTKeeper interface {
+	GetDefinition(ctx sdk.Context, denom string) (assetfttypes.Definition, error)
 }
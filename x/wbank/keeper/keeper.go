@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	sdkstore "cosmossdk.io/core/store"
 	sdkerrors "cosmossdk.io/errors"
@@ -12,6 +13,8 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/tokenize-x/tx-chain/v7/x/wasm"
 	cwasmtypes "github.com/tokenize-x/tx-chain/v7/x/wasm/types"
@@ -21,9 +24,10 @@ import (
 // BaseKeeperWrapper is a wrapper of the cosmos-sdk bank module.
 type BaseKeeperWrapper struct {
 	bankkeeper.BaseKeeper
-	ak         banktypes.AccountKeeper
-	wasmKeeper cwasmtypes.WasmKeeper
-	ftProvider types.FungibleTokenProvider
+	ak                 banktypes.AccountKeeper
+	wasmKeeper         cwasmtypes.WasmKeeper
+	ftProvider         types.FungibleTokenProvider
+	customParamsKeeper types.CustomParamsKeeper
 }
 
 // NewKeeper returns a new BaseKeeperWrapper instance.
@@ -34,14 +38,16 @@ func NewKeeper(
 	wasmKeeper cwasmtypes.WasmKeeper,
 	blockedAddrs map[string]bool,
 	ftProvider types.FungibleTokenProvider,
+	customParamsKeeper types.CustomParamsKeeper,
 	authority string,
 	logger log.Logger,
 ) BaseKeeperWrapper {
 	return BaseKeeperWrapper{
-		BaseKeeper: bankkeeper.NewBaseKeeper(cdc, storeService, ak, blockedAddrs, authority, logger),
-		ak:         ak,
-		wasmKeeper: wasmKeeper,
-		ftProvider: ftProvider,
+		BaseKeeper:         bankkeeper.NewBaseKeeper(cdc, storeService, ak, blockedAddrs, authority, logger),
+		ak:                 ak,
+		wasmKeeper:         wasmKeeper,
+		ftProvider:         ftProvider,
+		customParamsKeeper: customParamsKeeper,
 	}
 }
 
@@ -57,13 +63,51 @@ func (k BaseKeeperWrapper) SendCoinsFromModuleToAccount(
 		panic(sdkerrors.Wrapf(cosmoserrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
 	}
 
-	if k.BlockedAddr(recipientAddr) {
+	isBlocked, err := k.IsBlockedAddr(ctx, recipientAddr)
+	if err != nil {
+		return err
+	}
+	if isBlocked {
 		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
 	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
 }
 
+// IsBlockedAddr checks if the address is not allowed to receive funds. The address is blocked if it is the module
+// account address blocked by the app or if it is added to the blocked addresses by the governance.
+func (k BaseKeeperWrapper) IsBlockedAddr(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	if k.BlockedAddr(addr) {
+		return true, nil
+	}
+
+	return k.isGovernanceBlockedAddr(ctx, addr)
+}
+
+// isGovernanceBlockedAddr checks if the address is added to the blocked addresses by the governance.
+func (k BaseKeeperWrapper) isGovernanceBlockedAddr(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	params, err := k.customParamsKeeper.GetBankParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return false, err
+	}
+
+	return params.IsBlockedAddress(addr), nil
+}
+
+// validateNotGovernanceBlocked returns an error if the address is added to the blocked addresses by the governance.
+// The module accounts blocked by the app aren't rejected, because the modules send the funds to each other.
+func (k BaseKeeperWrapper) validateNotGovernanceBlocked(ctx context.Context, addr sdk.AccAddress) error {
+	isBlocked, err := k.isGovernanceBlockedAddr(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if isBlocked {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "%s is not allowed to receive funds", addr)
+	}
+
+	return nil
+}
+
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
 // It will panic if either module account does not exist.
 // !!! The code is the copy of the corresponding func of the bank module !!!
@@ -97,11 +141,15 @@ func (k BaseKeeperWrapper) SendCoinsFromAccountToModule(
 	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
-// SendCoins is a BaseKeeper SendCoins wrapped method.
+// SendCoins is a BaseKeeper SendCoins wrapped method. It rejects the recipients added to the blocked addresses by the
+// governance, so the funds sent by the modules, e.g. the IBC unescrow and the payouts, can't reach them either.
 //
 //nolint:contextcheck // this is correct context passing.
 func (k BaseKeeperWrapper) SendCoins(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateNotGovernanceBlocked(ctx, toAddr); err != nil {
+		return err
+	}
 	if k.isSmartContract(ctx, fromAddr) {
 		ctx = cwasmtypes.WithSmartContractSender(ctx, fromAddr.String())
 	}
@@ -125,7 +173,8 @@ func (k BaseKeeperWrapper) DelegateCoinsFromAccountToModule(
 	return k.BaseKeeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
 }
 
-// InputOutputCoins is a BaseKeeper InputOutputCoins wrapped method.
+// InputOutputCoins is a BaseKeeper InputOutputCoins wrapped method. It rejects the outputs to the addresses added to
+// the blocked addresses by the governance.
 //
 //nolint:contextcheck // this is correct context passing.
 func (k BaseKeeperWrapper) InputOutputCoins(
@@ -145,6 +194,9 @@ func (k BaseKeeperWrapper) InputOutputCoins(
 		if err != nil {
 			return err
 		}
+		if err := k.validateNotGovernanceBlocked(ctx, addr); err != nil {
+			return err
+		}
 		if k.isSmartContract(ctx, addr) {
 			ctx = cwasmtypes.WithSmartContractRecipient(ctx, output.Address)
 		}
@@ -220,6 +272,28 @@ func (k BaseKeeperWrapper) SpendableBalanceByDenom(
 	}, nil
 }
 
+// BlockedAddresses implements a gRPC query handler for retrieving the addresses not allowed to receive funds.
+func (k BaseKeeperWrapper) BlockedAddresses(
+	ctx context.Context, req *types.QueryBlockedAddressesRequest,
+) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	moduleAccountAddresses := lo.Keys(k.GetBlockedAddresses())
+	sort.Strings(moduleAccountAddresses)
+
+	params, err := k.customParamsKeeper.GetBankParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockedAddressesResponse{
+		ModuleAccountAddresses: moduleAccountAddresses,
+		GovernanceAddresses:    params.BlockedAddresses,
+	}, nil
+}
+
 func (k BaseKeeperWrapper) isSmartContract(ctx sdk.Context, addr sdk.AccAddress) bool {
 	return wasm.IsSmartContract(ctx, addr, k.wasmKeeper)
 }
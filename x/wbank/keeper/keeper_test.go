@@ -8,12 +8,17 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
 	"github.com/tokenize-x/tx-chain/v7/x/asset/ft/types"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+	wbankkeeper "github.com/tokenize-x/tx-chain/v7/x/wbank/keeper"
+	wbanktypes "github.com/tokenize-x/tx-chain/v7/x/wbank/types"
 )
 
 func TestBaseKeeperWrapper_SpendableBalances(t *testing.T) {
@@ -188,3 +193,80 @@ func TestBaseKeeperWrapper_SpendableBalanceByDenom(t *testing.T) {
 	balance = bankKeeper.GetBalance(ctx, recipient, nativeDenom)
 	requireT.Equal(coinToMindAndSend, balance)
 }
+
+func TestBaseKeeperWrapper_BlockedAddresses(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false)
+
+	bankKeeper := testApp.BankKeeper
+	customParamsKeeper := testApp.CustomParamsKeeper
+	msgServer := wbankkeeper.NewMsgServerImpl(bankKeeper)
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	blocked := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	moduleAddress := authtypes.NewModuleAddress(minttypes.ModuleName)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	requireT.NoError(testApp.FundAccount(ctx, sender, amount.MulInt(sdkmath.NewInt(10))))
+	requireT.NoError(bankKeeper.MintCoins(ctx, minttypes.ModuleName, amount.MulInt(sdkmath.NewInt(10))))
+
+	// module accounts are blocked by default
+	_, err := msgServer.Send(ctx, banktypes.NewMsgSend(sender, moduleAddress, amount))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the address is not blocked until it is added by the governance
+	_, err = msgServer.Send(ctx, banktypes.NewMsgSend(sender, blocked, amount))
+	requireT.NoError(err)
+
+	requireT.NoError(customParamsKeeper.SetBankParams(ctx, customparamstypes.BankParams{
+		BlockedAddresses: []string{blocked.String()},
+	}))
+
+	isBlocked, err := bankKeeper.IsBlockedAddr(ctx, blocked)
+	requireT.NoError(err)
+	requireT.True(isBlocked)
+	isBlocked, err = bankKeeper.IsBlockedAddr(ctx, moduleAddress)
+	requireT.NoError(err)
+	requireT.True(isBlocked)
+	isBlocked, err = bankKeeper.IsBlockedAddr(ctx, recipient)
+	requireT.NoError(err)
+	requireT.False(isBlocked)
+
+	_, err = msgServer.Send(ctx, banktypes.NewMsgSend(sender, blocked, amount))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	_, err = msgServer.MultiSend(ctx, &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{banktypes.NewInput(sender, amount.MulInt(sdkmath.NewInt(2)))},
+		Outputs: []banktypes.Output{
+			banktypes.NewOutput(recipient, amount),
+			banktypes.NewOutput(blocked, amount),
+		},
+	})
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	requireT.ErrorIs(
+		bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, blocked, amount),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, recipient, amount))
+
+	// the keeper level transfers are rejected too, so the funds sent by the modules can't reach the blocked address
+	requireT.ErrorIs(bankKeeper.SendCoins(ctx, sender, blocked, amount), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(
+		bankKeeper.InputOutputCoins(
+			ctx, banktypes.NewInput(sender, amount), []banktypes.Output{banktypes.NewOutput(blocked, amount)},
+		),
+		cosmoserrors.ErrUnauthorized,
+	)
+	// the module accounts blocked by the app still receive the funds from the modules
+	requireT.NoError(bankKeeper.SendCoinsFromAccountToModule(ctx, sender, minttypes.ModuleName, amount))
+
+	res, err := bankKeeper.BlockedAddresses(ctx, &wbanktypes.QueryBlockedAddressesRequest{})
+	requireT.NoError(err)
+	requireT.Contains(res.ModuleAccountAddresses, moduleAddress.String())
+	requireT.Len(res.ModuleAccountAddresses, len(bankKeeper.GetBlockedAddresses()))
+	requireT.Equal([]string{blocked.String()}, res.GovernanceAddresses)
+}
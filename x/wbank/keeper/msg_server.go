@@ -54,7 +54,11 @@ func (k msgServer) Send(ctx context.Context, msg *types.MsgSend) (*types.MsgSend
 		return nil, err
 	}
 
-	if k.Keeper.BlockedAddr(to) {
+	isBlocked, err := k.Keeper.IsBlockedAddr(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	if isBlocked {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
@@ -108,7 +112,11 @@ func (k msgServer) MultiSend(ctx context.Context, msg *types.MsgMultiSend) (*typ
 			return nil, err
 		}
 
-		if k.Keeper.BlockedAddr(accAddr) {
+		isBlocked, err := k.Keeper.IsBlockedAddr(ctx, accAddr)
+		if err != nil {
+			return nil, err
+		}
+		if isBlocked {
 			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", out.Address)
 		}
 	}
@@ -1,16 +1,20 @@
 package wbank
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/tokenize-x/tx-chain/v7/x/wbank/keeper"
+	"github.com/tokenize-x/tx-chain/v7/x/wbank/types"
 )
 
 // AppModuleBasic defines the basic application module used by the wrapped bank module.
@@ -37,11 +41,20 @@ func NewAppModule(
 	}
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bank module and its wrapper.
+func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	am.AppModule.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// copied the bank's RegisterServices to replace with the keeper wrapper
 	banktypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper, am.legacySubspace)
 	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
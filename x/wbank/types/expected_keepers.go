@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 )
 
 // FungibleTokenProvider defines an interface to interact with the fungible token functionality.
@@ -12,3 +14,8 @@ type FungibleTokenProvider interface {
 	GetSpendableBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
 	GetDEXLockedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
+
+// CustomParamsKeeper defines the custom params keeper interface required for the module.
+type CustomParamsKeeper interface {
+	GetBankParams(ctx sdk.Context) (customparamstypes.BankParams, error)
+}
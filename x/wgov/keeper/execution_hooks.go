@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// ProposalExecutionHook is executed after the message of the passed proposal is executed.
+type ProposalExecutionHook func(ctx sdk.Context, proposalID uint64, msg sdk.Msg) error
+
+// ExecutionHooks runs the hooks registered for the messages of the passed proposals. It is plugged into the gov
+// keeper as the gov hooks, so the hooks are executed at the end of the voting period, after the proposal messages.
+type ExecutionHooks struct {
+	proposals collections.Map[uint64, govv1.Proposal]
+	hooks     map[string][]ProposalExecutionHook
+}
+
+var _ govtypes.GovHooks = ExecutionHooks{}
+
+// NewExecutionHooks returns the new execution hooks reading the proposals from the gov keeper collection.
+func NewExecutionHooks(proposals collections.Map[uint64, govv1.Proposal]) ExecutionHooks {
+	return ExecutionHooks{
+		proposals: proposals,
+		hooks:     map[string][]ProposalExecutionHook{},
+	}
+}
+
+// Register registers the hook executed for every message of the passed proposal having the type of the provided
+// message. The hooks of the same message type are executed in the registration order.
+func (h ExecutionHooks) Register(msg sdk.Msg, hook ProposalExecutionHook) {
+	msgType := sdk.MsgTypeURL(msg)
+	h.hooks[msgType] = append(h.hooks[msgType], hook)
+}
+
+// AfterProposalVotingPeriodEnded implements the gov hooks interface. The hooks are executed only if the proposal
+// passed. The failure of the hook is logged and the state changes done by it are discarded, but it affects neither
+// the proposal nor the other hooks.
+func (h ExecutionHooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
+	if len(h.hooks) == 0 {
+		return nil
+	}
+
+	proposal, err := h.proposals.Get(ctx, proposalID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to get proposal %d", proposalID)
+	}
+	if proposal.Status != govv1.StatusPassed {
+		return nil
+	}
+
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to get messages of proposal %d", proposalID)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, msg := range msgs {
+		msgType := sdk.MsgTypeURL(msg)
+		for _, hook := range h.hooks[msgType] {
+			cacheCtx, writeCache := sdkCtx.CacheContext()
+			if err := safeExecuteHook(cacheCtx, proposalID, msg, hook); err != nil {
+				sdkCtx.Logger().Error(
+					"proposal execution hook failed",
+					"proposalID", proposalID,
+					"msgType", msgType,
+					"error", err,
+				)
+				continue
+			}
+			writeCache()
+		}
+	}
+
+	return nil
+}
+
+// AfterProposalSubmission implements the gov hooks interface.
+func (h ExecutionHooks) AfterProposalSubmission(_ context.Context, _ uint64) error {
+	return nil
+}
+
+// AfterProposalDeposit implements the gov hooks interface.
+func (h ExecutionHooks) AfterProposalDeposit(_ context.Context, _ uint64, _ sdk.AccAddress) error {
+	return nil
+}
+
+// AfterProposalVote implements the gov hooks interface.
+func (h ExecutionHooks) AfterProposalVote(_ context.Context, _ uint64, _ sdk.AccAddress) error {
+	return nil
+}
+
+// AfterProposalFailedMinDeposit implements the gov hooks interface.
+func (h ExecutionHooks) AfterProposalFailedMinDeposit(_ context.Context, _ uint64) error {
+	return nil
+}
+
+func safeExecuteHook(ctx sdk.Context, proposalID uint64, msg sdk.Msg, hook ProposalExecutionHook) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("hook panicked: %v", r)
+		}
+	}()
+
+	return hook(ctx, proposalID, msg)
+}
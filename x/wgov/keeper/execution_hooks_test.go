@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	"github.com/tokenize-x/tx-chain/v7/x/wgov/keeper"
+)
+
+func TestExecutionHooks(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+	govKeeper := testApp.GovKeeper
+
+	address := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: address.String(),
+		ToAddress:   address.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+	}
+	multiSendMsg := &banktypes.MsgMultiSend{}
+
+	var executed []string
+	hooks := keeper.NewExecutionHooks(govKeeper.Proposals)
+	hooks.Register(&banktypes.MsgSend{}, func(ctx sdk.Context, proposalID uint64, msg sdk.Msg) error {
+		executed = append(executed, "first")
+		requireT.Equal(sendMsg, msg)
+		return govKeeper.Constitution.Set(ctx, "first")
+	})
+	hooks.Register(&banktypes.MsgSend{}, func(ctx sdk.Context, _ uint64, _ sdk.Msg) error {
+		executed = append(executed, "failing")
+		// the changes of the failing hook are discarded
+		requireT.NoError(govKeeper.Constitution.Set(ctx, "failing"))
+		return errors.New("hook failed")
+	})
+	hooks.Register(&banktypes.MsgSend{}, func(ctx sdk.Context, _ uint64, _ sdk.Msg) error {
+		executed = append(executed, "panicking")
+		requireT.NoError(govKeeper.Constitution.Set(ctx, "panicking"))
+		panic("hook panicked")
+	})
+	hooks.Register(&banktypes.MsgSend{}, func(ctx sdk.Context, _ uint64, _ sdk.Msg) error {
+		executed = append(executed, "last")
+		constitution, err := govKeeper.Constitution.Get(ctx)
+		requireT.NoError(err)
+		requireT.Equal("first", constitution)
+		return nil
+	})
+
+	proposal, err := govv1.NewProposal(
+		[]sdk.Msg{sendMsg, multiSendMsg}, 1, ctx.BlockTime(), ctx.BlockTime().Add(time.Hour), "", "title", "summary",
+		address, false,
+	)
+	requireT.NoError(err)
+
+	// the hooks are not executed for the rejected proposal
+	proposal.Status = govv1.StatusRejected
+	requireT.NoError(govKeeper.SetProposal(ctx, proposal))
+	requireT.NoError(hooks.AfterProposalVotingPeriodEnded(ctx, proposal.Id))
+	requireT.Empty(executed)
+
+	// the hooks are executed for the passed proposal, and the failures don't affect the other hooks
+	proposal.Status = govv1.StatusPassed
+	requireT.NoError(govKeeper.SetProposal(ctx, proposal))
+	requireT.NoError(hooks.AfterProposalVotingPeriodEnded(ctx, proposal.Id))
+	requireT.Equal([]string{"first", "failing", "panicking", "last"}, executed)
+
+	constitution, err := govKeeper.Constitution.Get(ctx)
+	requireT.NoError(err)
+	requireT.Equal("first", constitution)
+}
@@ -0,0 +1,72 @@
+package wibctransfer
+
+import (
+	"encoding/json"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/tokenize-x/tx-chain/v7/x/wibctransfer/types"
+)
+
+var _ porttypes.IBCModule = BlockedAddrMiddleware{}
+
+// BlockedAddrMiddleware rejects the incoming IBC transfer packets sending the tokens to the addresses not allowed to
+// receive funds.
+type BlockedAddrMiddleware struct {
+	porttypes.IBCModule
+
+	bankKeeper types.BlockedAddrKeeper
+}
+
+// NewBlockedAddrMiddleware returns middleware checking the receivers of the incoming packets against the blocked
+// addresses.
+func NewBlockedAddrMiddleware(module porttypes.IBCModule, bankKeeper types.BlockedAddrKeeper) BlockedAddrMiddleware {
+	return BlockedAddrMiddleware{
+		IBCModule:  module,
+		bankKeeper: bankKeeper,
+	}
+}
+
+// OnRecvPacket rejects the packet if the receiver is blocked and calls the upper implementation otherwise. The
+// transfer module checks only the module accounts blocked by the app, the addresses added to the blocked addresses by
+// the governance are checked here. Packets which can't be decoded are passed as is, so the upper implementation
+// reports the error.
+func (im BlockedAddrMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	isBlocked, err := im.bankKeeper.IsBlockedAddr(ctx, receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if !isBlocked {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"ibc", "transfer", "blocked", "rejected"}, 1, []metrics.Label{
+		telemetry.NewLabel("channel", packet.GetDestChannel()),
+	})
+
+	return errorAcknowledgement(
+		ctx, data, sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "%s is not allowed to receive funds", data.Receiver),
+	)
+}
@@ -0,0 +1,85 @@
+package wibctransfer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+type blockedAddrKeeperMock struct {
+	blocked map[string]bool
+}
+
+func (k blockedAddrKeeperMock) IsBlockedAddr(_ context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.blocked[addr.String()], nil
+}
+
+func TestBlockedAddrMiddleware_OnRecvPacket(t *testing.T) {
+	blockedAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	allowedAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	packet := func(receiver string) channeltypes.Packet {
+		return channeltypes.Packet{
+			DestinationChannel: "channel-0",
+			Data: ibctransfertypes.NewFungibleTokenPacketData(
+				"uatom", "1", "sender", receiver, "",
+			).GetBytes(),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		packet      channeltypes.Packet
+		expectedAck bool
+	}{
+		{
+			name:        "allowed_receiver",
+			packet:      packet(allowedAddr),
+			expectedAck: true,
+		},
+		{
+			name:        "blocked_receiver",
+			packet:      packet(blockedAddr),
+			expectedAck: false,
+		},
+		{
+			name:        "invalid_receiver",
+			packet:      packet("receiver"),
+			expectedAck: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+
+			module := &ibcModuleMock{}
+			middleware := NewBlockedAddrMiddleware(module, blockedAddrKeeperMock{
+				blocked: map[string]bool{blockedAddr: true},
+			})
+
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			ack := middleware.OnRecvPacket(ctx, ibctransfertypes.V1, tt.packet, nil)
+			requireT.Equal(tt.expectedAck, ack.Success())
+			if tt.expectedAck {
+				requireT.Len(module.receivedPackets, 1)
+				requireT.Empty(ctx.EventManager().Events())
+				return
+			}
+
+			requireT.Empty(module.receivedPackets)
+			events := ctx.EventManager().Events()
+			requireT.Len(events, 1)
+			requireT.Equal(ibctransfertypes.EventTypePacket, events[0].Type)
+			ackErr, ok := events[0].GetAttribute(ibctransfertypes.AttributeKeyAckError)
+			requireT.True(ok)
+			requireT.Contains(ackErr.Value, cosmoserrors.ErrUnauthorized.Error())
+		})
+	}
+}
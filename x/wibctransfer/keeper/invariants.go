@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	invarianttypes "github.com/tokenize-x/tx-chain/v7/x/invariant/types"
+	"github.com/tokenize-x/tx-chain/v7/x/wibctransfer/types"
+)
+
+// EscrowParityInvariantRoute is the route of the escrow parity invariant.
+const EscrowParityInvariantRoute = "escrow-parity"
+
+// RegisterInvariants registers the IBC transfer module invariants.
+func RegisterInvariants(
+	ir invarianttypes.Registry,
+	k TransferKeeperWrapper,
+	channelKeeper types.ChannelKeeper,
+	bankKeeper types.BankKeeper,
+) {
+	ir.RegisterRoute(
+		ibctransfertypes.ModuleName, EscrowParityInvariantRoute, EscrowParityInvariant(k, channelKeeper, bankKeeper),
+	)
+}
+
+// EscrowParityInvariant checks that the escrow accounts of the transfer channels hold at least the total escrow
+// tracked by the transfer module for each denom. The balances might be greater than the tracked amount since anyone
+// can send the funds to the escrow account directly.
+func EscrowParityInvariant(
+	k TransferKeeperWrapper,
+	channelKeeper types.ChannelKeeper,
+	bankKeeper types.BankKeeper,
+) invarianttypes.InvariantFunc {
+	return func(ctx sdk.Context) (string, bool) {
+		totalEscrowed := k.GetAllTotalEscrowed(ctx)
+		channels := channelKeeper.GetAllChannelsWithPortPrefix(ctx, ibctransfertypes.PortID)
+
+		var violations []string
+		for _, escrowed := range totalEscrowed {
+			balance := sdk.NewCoin(escrowed.Denom, sdkmath.ZeroInt())
+			for _, channel := range channels {
+				escrowAddr := ibctransfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId)
+				balance = balance.Add(bankKeeper.GetBalance(ctx, escrowAddr, escrowed.Denom))
+			}
+			if balance.IsLT(escrowed) {
+				violations = append(violations, fmt.Sprintf(
+					"denom %s: total escrow %s is greater than the escrow accounts balance %s",
+					escrowed.Denom, escrowed, balance,
+				))
+			}
+		}
+
+		if len(violations) > 0 {
+			return invarianttypes.FormatInvariant(
+				ibctransfertypes.ModuleName, EscrowParityInvariantRoute, strings.Join(violations, "\n"),
+			), true
+		}
+
+		return invarianttypes.FormatInvariant(
+			ibctransfertypes.ModuleName,
+			EscrowParityInvariantRoute,
+			fmt.Sprintf("%d escrowed denoms are held by %d channel escrow accounts", len(totalEscrowed), len(channels)),
+		), false
+	}
+}
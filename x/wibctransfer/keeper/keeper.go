@@ -4,13 +4,16 @@ import (
 	"context"
 
 	"cosmossdk.io/core/store"
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/hashicorp/go-metrics"
 
 	"github.com/tokenize-x/tx-chain/v7/x/wibctransfer/types"
 )
@@ -18,6 +21,8 @@ import (
 // TransferKeeperWrapper is a wrapper of the IBC transfer keeper.
 type TransferKeeperWrapper struct {
 	ibctransferkeeper.Keeper
+
+	customParamsKeeper types.CustomParamsKeeper
 }
 
 // NewTransferKeeperWrapper returns a new TransferKeeperWrapper instance.
@@ -31,6 +36,7 @@ func NewTransferKeeperWrapper(
 	authKeeper ibctransfertypes.AccountKeeper,
 	bankKeeper ibctransfertypes.BankKeeper,
 	authority string,
+	customParamsKeeper types.CustomParamsKeeper,
 ) TransferKeeperWrapper {
 	return TransferKeeperWrapper{
 		Keeper: ibctransferkeeper.NewKeeper(
@@ -44,6 +50,7 @@ func NewTransferKeeperWrapper(
 			bankKeeper,
 			authority,
 		),
+		customParamsKeeper: customParamsKeeper,
 	}
 }
 
@@ -51,7 +58,24 @@ func NewTransferKeeperWrapper(
 func (k TransferKeeperWrapper) Transfer(
 	ctx context.Context, msg *ibctransfertypes.MsgTransfer,
 ) (*ibctransfertypes.MsgTransferResponse, error) {
-	ctx = types.WithPurpose(sdk.UnwrapSDKContext(ctx), types.PurposeOut)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ibcParams, err := k.customParamsKeeper.GetIBCParams(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	if ibcParams.IsMemoTooLong(msg.Memo) {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", "transfer", "memo", "rejected"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("direction", "out")},
+		)
+		return nil, sdkerrors.Wrapf(
+			ibctransfertypes.ErrInvalidMemo,
+			"memo length %d exceeds the maximum %d", len(msg.Memo), ibcParams.MaxMemoLength,
+		)
+	}
+
+	ctx = types.WithPurpose(sdkCtx, types.PurposeOut)
 	//nolint:contextcheck // this is correct context passing
 	return k.Keeper.Transfer(ctx, msg)
 }
@@ -0,0 +1,76 @@
+package wibctransfer
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/hashicorp/go-metrics"
+
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+	"github.com/tokenize-x/tx-chain/v7/x/wibctransfer/types"
+)
+
+var _ porttypes.IBCModule = MemoMiddleware{}
+
+// MemoMiddleware enforces the maximum memo length of the incoming IBC transfer packets.
+type MemoMiddleware struct {
+	porttypes.IBCModule
+
+	customParamsKeeper types.CustomParamsKeeper
+}
+
+// NewMemoMiddleware returns middleware enforcing the memo length of the incoming packets.
+func NewMemoMiddleware(module porttypes.IBCModule, customParamsKeeper types.CustomParamsKeeper) MemoMiddleware {
+	return MemoMiddleware{
+		IBCModule:          module,
+		customParamsKeeper: customParamsKeeper,
+	}
+}
+
+// OnRecvPacket rejects or truncates the memo exceeding the maximum length and calls the upper implementation.
+// Packets which can't be decoded as the transfer packet data are passed as is, so the upper implementation
+// reports the error.
+func (im MemoMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	ibcParams, err := im.customParamsKeeper.GetIBCParams(ctx)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if !ibcParams.IsMemoTooLong(data.Memo) {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel("direction", "in"),
+		telemetry.NewLabel("channel", packet.GetDestChannel()),
+	}
+	if ibcParams.IncomingMemoPolicy == customparamstypes.MEMO_POLICY_TRUNCATE {
+		telemetry.IncrCounterWithLabels([]string{"ibc", "transfer", "memo", "truncated"}, 1, labels)
+		// invalid UTF-8 sequence left after cutting a multibyte character is dropped
+		data.Memo = strings.ToValidUTF8(data.Memo[:ibcParams.MaxMemoLength], "")
+		packet.Data = data.GetBytes()
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"ibc", "transfer", "memo", "rejected"}, 1, labels)
+	return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(
+		ibctransfertypes.ErrInvalidMemo,
+		"memo length %d exceeds the maximum %d", len(data.Memo), ibcParams.MaxMemoLength,
+	))
+}
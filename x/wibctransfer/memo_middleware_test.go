@@ -0,0 +1,124 @@
+package wibctransfer
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+)
+
+type customParamsKeeperMock struct {
+	params customparamstypes.IBCParams
+}
+
+func (k customParamsKeeperMock) GetIBCParams(sdk.Context) (customparamstypes.IBCParams, error) {
+	return k.params, nil
+}
+
+type ibcModuleMock struct {
+	porttypes.IBCModule
+
+	receivedPackets []channeltypes.Packet
+}
+
+func (m *ibcModuleMock) OnRecvPacket(
+	_ sdk.Context, _ string, packet channeltypes.Packet, _ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	m.receivedPackets = append(m.receivedPackets, packet)
+	return channeltypes.NewResultAcknowledgement([]byte{0x01})
+}
+
+func TestMemoMiddleware_OnRecvPacket(t *testing.T) {
+	const maxMemoLength = 5
+
+	packetWithMemo := func(memo string) channeltypes.Packet {
+		return channeltypes.Packet{
+			DestinationChannel: "channel-0",
+			Data: ibctransfertypes.NewFungibleTokenPacketData(
+				"denom", "1", "sender", "receiver", memo,
+			).GetBytes(),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		params       customparamstypes.IBCParams
+		packet       channeltypes.Packet
+		expectedAck  bool
+		expectedMemo string
+	}{
+		{
+			name:         "limit_disabled",
+			params:       customparamstypes.DefaultIBCParams(),
+			packet:       packetWithMemo("long memo"),
+			expectedAck:  true,
+			expectedMemo: "long memo",
+		},
+		{
+			name: "memo_within_limit",
+			params: customparamstypes.IBCParams{
+				MaxMemoLength: maxMemoLength,
+			},
+			packet:       packetWithMemo("memo"),
+			expectedAck:  true,
+			expectedMemo: "memo",
+		},
+		{
+			name: "memo_rejected",
+			params: customparamstypes.IBCParams{
+				MaxMemoLength:      maxMemoLength,
+				IncomingMemoPolicy: customparamstypes.MEMO_POLICY_REJECT,
+			},
+			packet:      packetWithMemo("long memo"),
+			expectedAck: false,
+		},
+		{
+			name: "memo_truncated",
+			params: customparamstypes.IBCParams{
+				MaxMemoLength:      maxMemoLength,
+				IncomingMemoPolicy: customparamstypes.MEMO_POLICY_TRUNCATE,
+			},
+			packet:       packetWithMemo("long memo"),
+			expectedAck:  true,
+			expectedMemo: "long ",
+		},
+		{
+			name: "memo_truncated_inside_multibyte_character",
+			params: customparamstypes.IBCParams{
+				MaxMemoLength:      maxMemoLength,
+				IncomingMemoPolicy: customparamstypes.MEMO_POLICY_TRUNCATE,
+			},
+			packet:       packetWithMemo("abcdéf"),
+			expectedAck:  true,
+			expectedMemo: "abcd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+
+			module := &ibcModuleMock{}
+			middleware := NewMemoMiddleware(module, customParamsKeeperMock{params: tt.params})
+
+			ack := middleware.OnRecvPacket(sdk.Context{}, ibctransfertypes.V1, tt.packet, nil)
+			requireT.Equal(tt.expectedAck, ack.Success())
+			if !tt.expectedAck {
+				requireT.Empty(module.receivedPackets)
+				return
+			}
+
+			requireT.Len(module.receivedPackets, 1)
+			var data ibctransfertypes.FungibleTokenPacketData
+			requireT.NoError(json.Unmarshal(module.receivedPackets[0].GetData(), &data))
+			requireT.Equal(tt.expectedMemo, data.Memo)
+		})
+	}
+}
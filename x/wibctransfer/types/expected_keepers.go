@@ -0,0 +1,35 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper.
+type ChannelKeeper interface {
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+}
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// BlockedAddrKeeper defines the expected keeper checking the addresses not allowed to receive funds.
+type BlockedAddrKeeper interface {
+	IsBlockedAddr(ctx context.Context, addr sdk.AccAddress) (bool, error)
+}
+
+// CustomParamsKeeper defines the expected custom params keeper.
+type CustomParamsKeeper interface {
+	GetIBCParams(ctx sdk.Context) (customparamstypes.IBCParams, error)
+}
+
+// FTKeeper defines the expected fungible token keeper.
+type FTKeeper interface {
+	ValidateWhitelistedReceiver(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error
+}
@@ -0,0 +1,99 @@
+package wibctransfer
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/tokenize-x/tx-chain/v7/x/wibctransfer/types"
+)
+
+var _ porttypes.IBCModule = WhitelistMiddleware{}
+
+// WhitelistMiddleware rejects the incoming IBC transfer packets returning the fungible tokens to the receivers which
+// aren't whitelisted to receive them.
+type WhitelistMiddleware struct {
+	porttypes.IBCModule
+
+	ftKeeper types.FTKeeper
+}
+
+// NewWhitelistMiddleware returns middleware checking the whitelisted balance of the receivers of the incoming packets.
+func NewWhitelistMiddleware(module porttypes.IBCModule, ftKeeper types.FTKeeper) WhitelistMiddleware {
+	return WhitelistMiddleware{
+		IBCModule: module,
+		ftKeeper:  ftKeeper,
+	}
+}
+
+// OnRecvPacket rejects the packet if the receiver isn't whitelisted to receive the token and calls the upper
+// implementation otherwise. The same check is done by the fungible token module when the token is unescrowed, but
+// rejecting the packet here skips the rest of the transfer stack, including the packet forwarding and the hooks.
+// Packets which can't be decoded are passed as is, so the upper implementation reports the error.
+func (im WhitelistMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	// Only the tokens returning to this chain are unescrowed in the original denom, the vouchers of the tokens
+	// issued on the other chains are never whitelisted.
+	denom := ibctransfertypes.ExtractDenomFromPath(data.Denom)
+	if !denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	denom.Trace = denom.Trace[1:]
+
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	err = im.ftKeeper.ValidateWhitelistedReceiver(ctx, receiver, sdk.Coin{Denom: denom.IBCDenom(), Amount: amount})
+	if err == nil {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"ibc", "transfer", "whitelist", "rejected"}, 1, []metrics.Label{
+		telemetry.NewLabel("channel", packet.GetDestChannel()),
+	})
+
+	return errorAcknowledgement(ctx, data, err)
+}
+
+// errorAcknowledgement returns the error acknowledgement of the rejected packet. The error acknowledgement contains
+// the error code only, so the reason is reported in the same event the transfer module emits for the received packets.
+func errorAcknowledgement(
+	ctx sdk.Context, data ibctransfertypes.FungibleTokenPacketData, err error,
+) ibcexported.Acknowledgement {
+	ack := channeltypes.NewErrorAcknowledgement(err)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		ibctransfertypes.EventTypePacket,
+		sdk.NewAttribute(ibctransfertypes.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(ibctransfertypes.AttributeKeyReceiver, data.Receiver),
+		sdk.NewAttribute(ibctransfertypes.AttributeKeyDenom, data.Denom),
+		sdk.NewAttribute(ibctransfertypes.AttributeKeyAmount, data.Amount),
+		sdk.NewAttribute(ibctransfertypes.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(ibctransfertypes.AttributeKeyAckSuccess, strconv.FormatBool(ack.Success())),
+		sdk.NewAttribute(ibctransfertypes.AttributeKeyAckError, err.Error()),
+	))
+
+	return ack
+}
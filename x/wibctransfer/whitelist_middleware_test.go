@@ -0,0 +1,129 @@
+package wibctransfer
+
+import (
+	"testing"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotWhitelisted = sdkerrors.Register("wibctransfertest", 1, "not whitelisted")
+
+type ftKeeperMock struct {
+	whitelisted map[string]sdkmath.Int
+	validated   []sdk.Coin
+}
+
+func (k *ftKeeperMock) ValidateWhitelistedReceiver(_ sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	k.validated = append(k.validated, coin)
+	if k.whitelisted[addr.String()].GTE(coin.Amount) {
+		return nil
+	}
+	return errNotWhitelisted
+}
+
+func TestWhitelistMiddleware_OnRecvPacket(t *testing.T) {
+	const denom = "uabc-devcore1"
+
+	whitelistedAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	notWhitelistedAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	packet := func(denom, amount, receiver string) channeltypes.Packet {
+		return channeltypes.Packet{
+			SourcePort:         ibctransfertypes.PortID,
+			SourceChannel:      "channel-1",
+			DestinationPort:    ibctransfertypes.PortID,
+			DestinationChannel: "channel-0",
+			Data: ibctransfertypes.NewFungibleTokenPacketData(
+				denom, amount, "sender", receiver, "",
+			).GetBytes(),
+		}
+	}
+
+	tests := []struct {
+		name              string
+		packet            channeltypes.Packet
+		expectedAck       bool
+		expectedValidated []sdk.Coin
+	}{
+		{
+			name:              "whitelisted_receiver",
+			packet:            packet("transfer/channel-1/"+denom, "100", whitelistedAddr),
+			expectedAck:       true,
+			expectedValidated: []sdk.Coin{sdk.NewInt64Coin(denom, 100)},
+		},
+		{
+			name:              "whitelisted_limit_exceeded",
+			packet:            packet("transfer/channel-1/"+denom, "101", whitelistedAddr),
+			expectedAck:       false,
+			expectedValidated: []sdk.Coin{sdk.NewInt64Coin(denom, 101)},
+		},
+		{
+			name:              "not_whitelisted_receiver",
+			packet:            packet("transfer/channel-1/"+denom, "1", notWhitelistedAddr),
+			expectedAck:       false,
+			expectedValidated: []sdk.Coin{sdk.NewInt64Coin(denom, 1)},
+		},
+		{
+			name:        "multihop_token",
+			packet:      packet("transfer/channel-1/transfer/channel-5/uatom", "1", notWhitelistedAddr),
+			expectedAck: false,
+			expectedValidated: []sdk.Coin{sdk.NewInt64Coin(ibctransfertypes.NewDenom(
+				"uatom", ibctransfertypes.NewHop(ibctransfertypes.PortID, "channel-5"),
+			).IBCDenom(), 1)},
+		},
+		{
+			name:        "voucher_of_other_chain_token",
+			packet:      packet("uatom", "1", notWhitelistedAddr),
+			expectedAck: true,
+		},
+		{
+			name:        "token_returned_over_other_channel",
+			packet:      packet("transfer/channel-2/"+denom, "1", notWhitelistedAddr),
+			expectedAck: true,
+		},
+		{
+			name:        "invalid_receiver",
+			packet:      packet("transfer/channel-1/"+denom, "1", "receiver"),
+			expectedAck: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+
+			module := &ibcModuleMock{}
+			ftKeeper := &ftKeeperMock{
+				whitelisted: map[string]sdkmath.Int{
+					whitelistedAddr:    sdkmath.NewInt(100),
+					notWhitelistedAddr: sdkmath.ZeroInt(),
+				},
+			}
+			middleware := NewWhitelistMiddleware(module, ftKeeper)
+
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			ack := middleware.OnRecvPacket(ctx, ibctransfertypes.V1, tt.packet, nil)
+			requireT.Equal(tt.expectedAck, ack.Success())
+			requireT.Equal(tt.expectedValidated, ftKeeper.validated)
+			if tt.expectedAck {
+				requireT.Len(module.receivedPackets, 1)
+				requireT.Empty(ctx.EventManager().Events())
+				return
+			}
+
+			requireT.Empty(module.receivedPackets)
+			events := ctx.EventManager().Events()
+			requireT.Len(events, 1)
+			requireT.Equal(ibctransfertypes.EventTypePacket, events[0].Type)
+			ackErr, ok := events[0].GetAttribute(ibctransfertypes.AttributeKeyAckError)
+			requireT.True(ok)
+			requireT.Equal(errNotWhitelisted.Error(), ackErr.Value)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wstakingtypes "github.com/tokenize-x/tx-chain/v7/x/wstaking/types"
+)
+
+// ApplyMinCommissionRate raises the commission rate of the validators below the global min commission rate to it,
+// when the activation height of the rate is reached. The max rate is raised too if it is below the min rate.
+// Should be called from BeginBlock.
+func ApplyMinCommissionRate(
+	ctx sdk.Context,
+	stakingKeeper wstakingtypes.StakingKeeper,
+	customParamsKeeper wstakingtypes.CustomParamsKeeper,
+) error {
+	params, err := customParamsKeeper.GetCommissionParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.MinCommissionRate.IsPositive() || ctx.BlockHeight() != params.ActivationHeight {
+		return nil
+	}
+
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+	for _, validator := range validators {
+		rates := validator.Commission.CommissionRates
+		if rates.Rate.GTE(params.MinCommissionRate) {
+			continue
+		}
+
+		rates.Rate = params.MinCommissionRate
+		if rates.MaxRate.LT(params.MinCommissionRate) {
+			rates.MaxRate = params.MinCommissionRate
+		}
+		validator.Commission.CommissionRates = rates
+		validator.Commission.UpdateTime = ctx.BlockTime()
+		if err := stakingKeeper.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		ctx.Logger().Info(
+			"validator commission rate raised to the global min commission rate",
+			"validator", validator.OperatorAddress,
+			"rate", rates.Rate,
+		)
+	}
+
+	return nil
+}
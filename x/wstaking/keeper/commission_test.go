@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tokenize-x/tx-chain/v7/testutil/simapp"
+	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
+	"github.com/tokenize-x/tx-chain/v7/x/wstaking/keeper"
+)
+
+func TestMinCommissionRate(t *testing.T) {
+	requireT := require.New(t)
+
+	simApp := simapp.New()
+	ctx := simApp.NewContext(false).WithBlockHeight(10).WithBlockTime(time.Now())
+	msgServer := keeper.NewMsgServerImpl(stakingkeeper.NewMsgServerImpl(simApp.StakingKeeper), simApp.CustomParamsKeeper)
+
+	bondDenom, err := simApp.StakingKeeper.BondDenom(ctx)
+	requireT.NoError(err)
+	newOperator := func() sdk.AccAddress {
+		operator, _ := simApp.GenAccount(ctx)
+		requireT.NoError(simApp.FundAccount(ctx, operator, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100_000_000))))
+		return operator
+	}
+	selfDelegation := sdk.NewInt64Coin(bondDenom, 10_000_000)
+
+	lowRate := sdkmath.LegacyMustNewDecFromStr("0.01")
+	highRate := sdkmath.LegacyMustNewDecFromStr("0.1")
+	minRate := sdkmath.LegacyMustNewDecFromStr("0.05")
+
+	lowValidator, err := simApp.AddValidator(ctx, newOperator(), selfDelegation, &stakingtypes.CommissionRates{
+		Rate:          lowRate,
+		MaxRate:       lowRate,
+		MaxChangeRate: lowRate,
+	})
+	requireT.NoError(err)
+	highValidator, err := simApp.AddValidator(ctx, newOperator(), selfDelegation, &stakingtypes.CommissionRates{
+		Rate:    highRate,
+		MaxRate: highRate,
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(simApp.CustomParamsKeeper.SetCommissionParams(ctx, customparamstypes.CommissionParams{
+		MinCommissionRate: minRate,
+		ActivationHeight:  20,
+	}))
+
+	commissionRates := func(validator stakingtypes.Validator) stakingtypes.CommissionRates {
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		requireT.NoError(err)
+		validator, err = simApp.StakingKeeper.GetValidator(ctx, valAddr)
+		requireT.NoError(err)
+		return validator.Commission.CommissionRates
+	}
+	createValidator := func(rate sdkmath.LegacyDec) error {
+		operator := newOperator()
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(operator).String(),
+			ed25519.GenPrivKey().PubKey(),
+			selfDelegation,
+			stakingtypes.Description{Moniker: "moniker"},
+			stakingtypes.CommissionRates{Rate: rate, MaxRate: highRate, MaxChangeRate: highRate},
+			sdkmath.OneInt(),
+		)
+		requireT.NoError(err)
+		_, err = msgServer.CreateValidator(ctx, msg)
+		return err
+	}
+
+	// before the activation height the floor is not enforced
+	requireT.NoError(keeper.ApplyMinCommissionRate(ctx, simApp.StakingKeeper, simApp.CustomParamsKeeper))
+	requireT.Equal(lowRate.String(), commissionRates(lowValidator).Rate.String())
+	requireT.NoError(createValidator(lowRate))
+
+	// at the activation height the commission rate of the validators below the floor is raised
+	ctx = ctx.WithBlockHeight(20)
+	requireT.NoError(keeper.ApplyMinCommissionRate(ctx, simApp.StakingKeeper, simApp.CustomParamsKeeper))
+	rates := commissionRates(lowValidator)
+	requireT.Equal(minRate.String(), rates.Rate.String())
+	requireT.Equal(minRate.String(), rates.MaxRate.String())
+	rates = commissionRates(highValidator)
+	requireT.Equal(highRate.String(), rates.Rate.String())
+	requireT.Equal(highRate.String(), rates.MaxRate.String())
+
+	// the validators below the floor can't be created
+	requireT.ErrorIs(createValidator(lowRate), stakingtypes.ErrCommissionLTMinRate)
+	requireT.NoError(createValidator(minRate))
+
+	// the commission rate can't be edited below the floor
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(ctx.BlockTime().Add(48 * time.Hour))
+	editRate := sdkmath.LegacyMustNewDecFromStr("0.04")
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(
+		highValidator.OperatorAddress, stakingtypes.Description{Moniker: "moniker"}, &editRate, nil,
+	))
+	requireT.ErrorIs(err, stakingtypes.ErrCommissionLTMinRate)
+	editRate = sdkmath.LegacyMustNewDecFromStr("0.09")
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(
+		highValidator.OperatorAddress, stakingtypes.Description{Moniker: "moniker"}, &editRate, nil,
+	))
+	requireT.NoError(err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -45,5 +46,38 @@ func (s MsgServer) CreateValidator(
 		)
 	}
 
+	if err := s.validateCommissionRate(ctx, msg.Commission.Rate); err != nil {
+		return nil, err
+	}
+
 	return s.MsgServer.CreateValidator(goCtx, msg)
 }
+
+// EditValidator defines wrapped method for editing an existing validator.
+func (s MsgServer) EditValidator(
+	goCtx context.Context, msg *stakingtypes.MsgEditValidator,
+) (*stakingtypes.MsgEditValidatorResponse, error) {
+	if msg.CommissionRate != nil {
+		if err := s.validateCommissionRate(sdk.UnwrapSDKContext(goCtx), *msg.CommissionRate); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.MsgServer.EditValidator(goCtx, msg)
+}
+
+func (s MsgServer) validateCommissionRate(ctx sdk.Context, rate sdkmath.LegacyDec) error {
+	params, err := s.customParamsKeeper.GetCommissionParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.IsMinCommissionRateActive(ctx.BlockHeight()) && rate.LT(params.MinCommissionRate) {
+		return sdkerrors.Wrapf(
+			stakingtypes.ErrCommissionLTMinRate,
+			"commission rate must be greater than or equal to global min commission rate %s, got %s",
+			params.MinCommissionRate, rate,
+		)
+	}
+
+	return nil
+}
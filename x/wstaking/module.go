@@ -1,9 +1,11 @@
 package wstaking
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
@@ -63,3 +65,13 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", stakingtypes.ModuleName, err))
 	}
 }
+
+// BeginBlock returns the begin blocker for the module. It raises the commission rate of the validators below the
+// global min commission rate at its activation height.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	if err := am.AppModule.BeginBlock(ctx); err != nil {
+		return err
+	}
+
+	return keeper.ApplyMinCommissionRate(sdk.UnwrapSDKContext(ctx), am.stakingKeeper, am.customParamsKeeper)
+}
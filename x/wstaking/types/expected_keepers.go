@@ -1,7 +1,10 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	customparamstypes "github.com/tokenize-x/tx-chain/v7/x/customparams/types"
 )
@@ -9,4 +12,11 @@ import (
 // CustomParamsKeeper defines the custom params keeper interface required for the module.
 type CustomParamsKeeper interface {
 	GetStakingParams(ctx sdk.Context) (customparamstypes.StakingParams, error)
+	GetCommissionParams(ctx sdk.Context) (customparamstypes.CommissionParams, error)
+}
+
+// StakingKeeper defines the staking keeper interface required for the module.
+type StakingKeeper interface {
+	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
+	SetValidator(ctx context.Context, validator stakingtypes.Validator) error
 }